@@ -0,0 +1,67 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+// serviceConfig is the part of a mo-service config file mo-backup needs.
+type serviceConfig struct {
+	DataDir      string               `toml:"data-dir"`
+	FileServices []fileservice.Config `toml:"fileservice"`
+}
+
+// newFileService creates the file service a backup is read from or written
+// to. If configFile is set, the file service named fsName is taken from that
+// mo-service config file, so S3 and the other remote backends, encryption
+// and tiering are supported; otherwise dir is used as a local disk.
+func newFileService(dir, configFile, fsName string) (fileservice.FileService, error) {
+	if configFile == "" {
+		if dir == "" {
+			return nil, moerr.NewInvalidInputNoCtx("a directory or a config file is required")
+		}
+		return fileservice.NewFileService(fileservice.Config{
+			Name:    fsName,
+			Backend: "DISK",
+			DataDir: dir,
+		}, nil)
+	}
+	var cfg serviceConfig
+	if _, err := toml.DecodeFile(configFile, &cfg); err != nil {
+		return nil, err
+	}
+	if cfg.DataDir == "" {
+		cfg.DataDir = "./mo-data"
+	}
+	for _, fsCfg := range cfg.FileServices {
+		if !strings.EqualFold(fsCfg.Name, fsName) {
+			continue
+		}
+		if fsCfg.DataDir == "" {
+			fsCfg.DataDir = filepath.Join(cfg.DataDir, strings.ToLower(fsCfg.Name))
+		}
+		if dir != "" {
+			fsCfg.DataDir = dir
+		}
+		return fileservice.NewFileService(fsCfg, nil)
+	}
+	return nil, moerr.NewInvalidInputNoCtx("file service %s not found in %s", fsName, configFile)
+}
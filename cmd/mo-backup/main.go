@@ -0,0 +1,111 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+)
+
+const usage = `usage:
+  mo-backup backup -src <data dir> -dst <backup dir> [-incremental]
+  mo-backup restore -src <backup dir> -dst <data dir> [-name <backup>]
+  mo-backup list -src <backup dir>
+
+-src-config and -dst-config take a mo-service config file instead of a
+directory, the file service named by -src-fs and -dst-fs (default SHARED) is
+used, e.g. to back up from or restore to S3.`
+
+// mo-backup takes a physical backup of a stopped instance, or restores one
+// into the shared storage of a new instance. A running DN is backed up by the
+// inspect command "backup".
+func main() {
+	var (
+		src, dst, name       string
+		srcConfig, dstConfig string
+		srcFSName, dstFSName string
+		incremental          bool
+		err                  error
+		srcFS, dstFS         fileservice.FileService
+	)
+	start := time.Now()
+	defer func() {
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "mo-backup error: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stdout, "mo-backup success, cost %v\n", time.Since(start))
+	}()
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+	flags.StringVar(&src, "src", "", "source directory")
+	flags.StringVar(&dst, "dst", "", "target directory")
+	flags.StringVar(&srcConfig, "src-config", "", "mo-service config file of the source file service")
+	flags.StringVar(&dstConfig, "dst-config", "", "mo-service config file of the target file service")
+	flags.StringVar(&srcFSName, "src-fs", defines.SharedFileServiceName, "name of the source file service")
+	flags.StringVar(&dstFSName, "dst-fs", defines.SharedFileServiceName, "name of the target file service")
+	flags.StringVar(&name, "name", "", "backup to restore, default the latest")
+	flags.BoolVar(&incremental, "incremental", false, "copy only the files not in the previous backups")
+	if err = flags.Parse(os.Args[2:]); err != nil {
+		return
+	}
+
+	ctx := context.Background()
+	var manifest *db.BackupManifest
+	switch os.Args[1] {
+	case "backup", "restore", "list":
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if srcFS, err = newFileService(src, srcConfig, srcFSName); err != nil {
+		return
+	}
+	if os.Args[1] != "list" {
+		if dstFS, err = newFileService(dst, dstConfig, dstFSName); err != nil {
+			return
+		}
+	}
+	switch os.Args[1] {
+	case "backup":
+		manifest, err = db.Backup(ctx, srcFS, dstFS, incremental)
+	case "restore":
+		manifest, err = db.Restore(ctx, srcFS, dstFS, name)
+	case "list":
+		var manifests []*db.BackupManifest
+		if manifests, err = db.ListBackups(ctx, srcFS); err != nil {
+			return
+		}
+		for _, m := range manifests {
+			fmt.Fprintf(os.Stdout, "%s\tts=%s\tprevious=%s\tobjects=%d\n",
+				m.Name, m.TS.ToString(), m.Previous, len(m.Objects))
+		}
+		return
+	}
+	if err == nil {
+		fmt.Fprintf(os.Stdout, "%s %s: ts=%s, objects=%d\n",
+			os.Args[1], manifest.Name, manifest.TS.ToString(), len(manifest.Objects))
+	}
+}
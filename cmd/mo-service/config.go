@@ -184,6 +184,10 @@ func (c *Config) createFileService(defaultName string, perfCounterSet *perfcount
 			config.Name = defines.SharedFileServiceName
 		}
 
+		// the catalog of tiered storage is shared by the services
+		config.Tiering.ServiceType = serviceType.String()
+		config.Tiering.NodeID = nodeUUID
+
 		counterSet := new(perfcounter.CounterSet)
 		service, err := fileservice.NewFileService(
 			config,
@@ -151,6 +151,13 @@ func startService(ctx context.Context, cfg *Config, stopper *stopper.Stopper, gl
 	if err != nil {
 		return err
 	}
+	// stop the background jobs of the file services, like moving cold objects
+	if err := stopper.RunNamedTask("file-service", func(ctx context.Context) {
+		<-ctx.Done()
+		fs.Close()
+	}); err != nil {
+		return err
+	}
 
 	etlFS, err := fileservice.Get[fileservice.FileService](fs, defines.ETLFileServiceName)
 	if err != nil {
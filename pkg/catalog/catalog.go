@@ -202,6 +202,9 @@ func genUpdateConstraint(rows [][]any) []UpdateConstraint {
 		cmds[i].TableName = string(row[MO_TABLES_REL_NAME_IDX].([]byte))
 		cmds[i].DatabaseName = string(row[MO_TABLES_RELDATABASE_IDX].([]byte))
 		cmds[i].Constraint = row[MO_TABLES_UPDATE_CONSTRAINT].([]byte)
+		if len(row) > MO_TABLES_ALTER_TABLE {
+			cmds[i].AlterTable = row[MO_TABLES_ALTER_TABLE].([]byte)
+		}
 	}
 	return cmds
 }
@@ -14,8 +14,74 @@
 
 package catalog
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
 
 func TestGenBlockMeta(t *testing.T) {
 	_ = GenBlockInfo(nil)
 }
+
+func TestTTLCutoff(t *testing.T) {
+	ttl := &plan.TTLDef{ColName: "ts", ExpireSeconds: 24 * 60 * 60}
+	now := time.Now()
+
+	cutoff := TTLCutoff(ttl, types.T_timestamp, now)
+	require.True(t, TTLExpired(cutoff, types.UnixToTimestamp(now.Add(-48*time.Hour).Unix())))
+	require.False(t, TTLExpired(cutoff, types.UnixToTimestamp(now.Add(-time.Hour).Unix())))
+
+	cutoff = TTLCutoff(ttl, types.T_datetime, now)
+	require.True(t, TTLExpired(cutoff, types.DatetimeFromUnix(time.Local, now.Add(-48*time.Hour).Unix())))
+	require.False(t, TTLExpired(cutoff, types.DatetimeFromUnix(time.Local, now.Unix())))
+
+	cutoff = TTLCutoff(ttl, types.T_date, now)
+	require.True(t, TTLExpired(cutoff, types.DatetimeFromUnix(time.Local, now.Add(-72*time.Hour).Unix()).ToDate()))
+	require.False(t, TTLExpired(cutoff, types.DatetimeFromUnix(time.Local, now.Unix()).ToDate()))
+
+	require.Nil(t, TTLCutoff(ttl, types.T_int64, now))
+
+	// datetime is compared in the time zone of the ttl, not of the host
+	ttl = &plan.TTLDef{ColName: "ts", ExpireSeconds: 60 * 60, TimeZone: "+08:00"}
+	now = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	cutoff = TTLCutoff(ttl, types.T_datetime, now)
+	require.Equal(t, "2023-05-01 19:00:00", cutoff.(types.Datetime).String())
+	ttl.TimeZone = ""
+	cutoff = TTLCutoff(ttl, types.T_datetime, now)
+	require.Equal(t, "2023-05-01 11:00:00", cutoff.(types.Datetime).String())
+}
+
+func TestTTLTimeZone(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	require.Equal(t, "UTC", TTLTimeZone(nil, now))
+	require.Equal(t, "UTC", TTLTimeZone(time.UTC, now))
+	require.Equal(t, "+08:00", TTLTimeZone(time.FixedZone("FixedZone", 8*3600), now))
+	require.Equal(t, "-05:30", TTLTimeZone(time.FixedZone("FixedZone", -(5*3600+30*60)), now))
+	for _, tz := range []string{"UTC", "+08:00", "-05:30"} {
+		require.Equal(t, tz, TTLTimeZone(ttlLocation(tz), now))
+	}
+	require.Equal(t, time.UTC, ttlLocation("no/such zone"))
+}
+
+func TestGetTTLDef(t *testing.T) {
+	ttl, err := GetTTLDef(nil)
+	require.NoError(t, err)
+	require.Nil(t, ttl)
+
+	c := &engine.ConstraintDef{
+		Cts: []engine.Constraint{
+			&engine.TTLDef{Ttl: &plan.TTLDef{ColName: "ts", ExpireSeconds: 60}},
+		},
+	}
+	data, err := c.MarshalBinary()
+	require.NoError(t, err)
+	ttl, err = GetTTLDef(data)
+	require.NoError(t, err)
+	require.Equal(t, "ts", ttl.ColName)
+	require.Equal(t, int64(60), ttl.ExpireSeconds)
+}
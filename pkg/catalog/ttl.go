@@ -0,0 +1,119 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// GetTTLDef returns the ttl definition stored in the constraint of a table,
+// nil if the table has no ttl.
+func GetTTLDef(constraint []byte) (*plan.TTLDef, error) {
+	if len(constraint) == 0 {
+		return nil, nil
+	}
+	c := new(engine.ConstraintDef)
+	if err := c.UnmarshalBinary(constraint); err != nil {
+		return nil, err
+	}
+	if def := c.GetTTLDef(); def != nil {
+		return def.Ttl, nil
+	}
+	return nil, nil
+}
+
+// TTLTimeZone returns the time zone stored in the ttl of a table created in
+// loc. A named zone is stored by its name, other zones, like the local time
+// zone of the host or a session offset, by their offset at now, e.g. "+08:00".
+func TTLTimeZone(loc *time.Location, now time.Time) string {
+	if loc == nil {
+		return "UTC"
+	}
+	switch name := loc.String(); name {
+	case "UTC":
+		return name
+	case "Local", "FixedZone", "":
+	default:
+		if _, err := time.LoadLocation(name); err == nil {
+			return name
+		}
+	}
+	_, offset := now.In(loc).Zone()
+	sign := byte('+')
+	if offset < 0 {
+		sign = '-'
+		offset = -offset
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+// ttlLocation returns the time zone stored in the ttl, UTC if the ttl has no
+// time zone or it is unknown.
+func ttlLocation(tz string) *time.Location {
+	if len(tz) == 6 && (tz[0] == '+' || tz[0] == '-') && tz[3] == ':' {
+		hour, err1 := strconv.Atoi(tz[1:3])
+		minute, err2 := strconv.Atoi(tz[4:6])
+		if err1 == nil && err2 == nil {
+			offset := hour*3600 + minute*60
+			if tz[0] == '-' {
+				offset = -offset
+			}
+			return time.FixedZone(tz, offset)
+		}
+		return time.UTC
+	}
+	if loc, err := time.LoadLocation(tz); err == nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// TTLCutoff returns the oldest value of the ttl column which is still alive
+// at now. Values of the ttl column less than the cutoff are expired. Datetime
+// and date have no time zone, they are compared in the time zone stored in the
+// ttl, so CN and DN find out the same expired rows whatever their hosts are.
+func TTLCutoff(ttl *plan.TTLDef, oid types.T, now time.Time) any {
+	expireAt := now.Add(-time.Duration(ttl.ExpireSeconds) * time.Second)
+	switch oid {
+	case types.T_timestamp:
+		return types.UnixNanoToTimestamp(expireAt.UnixNano())
+	case types.T_datetime:
+		loc := ttlLocation(ttl.TimeZone)
+		return types.DatetimeFromUnixWithNsec(loc, expireAt.Unix(), int64(expireAt.Nanosecond()))
+	case types.T_date:
+		loc := ttlLocation(ttl.TimeZone)
+		return types.DatetimeFromUnix(loc, expireAt.Unix()).ToDate()
+	}
+	return nil
+}
+
+// TTLExpired returns true if v, a value of the ttl column, is before the cutoff.
+func TTLExpired(cutoff any, v any) bool {
+	switch c := cutoff.(type) {
+	case types.Timestamp:
+		return v.(types.Timestamp) < c
+	case types.Datetime:
+		return v.(types.Datetime) < c
+	case types.Date:
+		return v.(types.Date) < c
+	}
+	return false
+}
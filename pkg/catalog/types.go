@@ -43,6 +43,10 @@ const (
 	ExternalFilePath         = "__mo_filepath"
 	IndexTableNamePrefix     = "__mo_index_unique__"
 	AutoIncrTableName        = "%!%mo_increment_columns"
+	// PrefixConvertColName is the prefix of the hidden column holding the values
+	// of a column converted to a new type by alter table. The hidden column
+	// replaces the column after all the rows are converted in background.
+	PrefixConvertColName = "__mo_conv_"
 )
 
 var AutoIncrColumnNames = []string{Row_ID, "name", "offset", "step"}
@@ -51,6 +55,20 @@ func ContainExternalHidenCol(col string) bool {
 	return col == ExternalFilePath
 }
 
+// ConvertColName returns the name of the hidden column converting the column
+func ConvertColName(col string) string {
+	return PrefixConvertColName + col
+}
+
+// ConvertedColName returns the name of the column converted by the hidden
+// column, false if the column is not a converting column
+func ConvertedColName(name string) (string, bool) {
+	if !strings.HasPrefix(name, PrefixConvertColName) {
+		return "", false
+	}
+	return strings.TrimPrefix(name, PrefixConvertColName), true
+}
+
 func IsHiddenTable(name string) bool {
 	if strings.HasPrefix(name, IndexTableNamePrefix) {
 		return true
@@ -185,8 +203,15 @@ const (
 // index use to update constraint
 const (
 	MO_TABLES_UPDATE_CONSTRAINT = 4
+	// the update batch of alter table carries a marshaled api.AlterTableReq
+	// after the constraint
+	MO_TABLES_ALTER_TABLE = 5
 )
 
+// attribute name of the alter table request in the update batch, it is not
+// a column of mo_tables
+const SystemRelAttr_AlterTable = "alter_table"
+
 // column's index in catalog table
 const (
 	MO_DATABASE_DAT_ID_IDX           = 0
@@ -374,6 +399,7 @@ type UpdateConstraint struct {
 	TableName    string
 	DatabaseName string
 	Constraint   []byte
+	AlterTable   []byte // marshaled api.AlterTableReq, empty if only the constraint is updated
 }
 
 type DropOrTruncateTable struct {
@@ -0,0 +1,191 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+)
+
+// Message is a message stored in a partition of a Broker
+type Message struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+}
+
+type groupOffsetKey struct {
+	group     string
+	topic     string
+	partition int32
+}
+
+// Broker is an in-process stand-in of a kafka cluster. Topics are created
+// on the first produce and split into partitions, a message goes to the
+// partition chosen by the hash of its key. Consumer groups commit the
+// offsets they have read.
+type Broker struct {
+	partitions int32
+
+	mu struct {
+		sync.Mutex
+		topics  map[string][][]Message
+		offsets map[groupOffsetKey]int64
+	}
+}
+
+// NewBroker creates a Broker whose topics have the specified partitions
+func NewBroker(partitions int32) *Broker {
+	if partitions <= 0 {
+		partitions = 1
+	}
+	b := &Broker{partitions: partitions}
+	b.mu.topics = make(map[string][][]Message)
+	b.mu.offsets = make(map[groupOffsetKey]int64)
+	return b
+}
+
+// Produce appends a message to the topic and returns its partition and offset
+func (b *Broker) Produce(topic string, key, value []byte) (int32, int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	partitions, ok := b.mu.topics[topic]
+	if !ok {
+		partitions = make([][]Message, b.partitions)
+		b.mu.topics[topic] = partitions
+	}
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	p := int32(h.Sum32() % uint32(b.partitions))
+	offset := int64(len(partitions[p]))
+	partitions[p] = append(partitions[p], Message{
+		Topic:     topic,
+		Partition: p,
+		Offset:    offset,
+		Key:       key,
+		Value:     value,
+	})
+	return p, offset
+}
+
+// Fetch returns at most max messages of the partition from offset
+func (b *Broker) Fetch(topic string, partition int32, offset int64, max int) []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	partitions, ok := b.mu.topics[topic]
+	if !ok || partition < 0 || partition >= int32(len(partitions)) {
+		return nil
+	}
+	messages := partitions[partition]
+	if offset < 0 || offset >= int64(len(messages)) {
+		return nil
+	}
+	end := int64(len(messages))
+	if max > 0 && offset+int64(max) < end {
+		end = offset + int64(max)
+	}
+	return append([]Message(nil), messages[offset:end]...)
+}
+
+// Topics returns the sorted names of the topics
+func (b *Broker) Topics() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	topics := make([]string, 0, len(b.mu.topics))
+	for topic := range b.mu.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+// Partitions returns the number of partitions of each topic
+func (b *Broker) Partitions() int32 {
+	return b.partitions
+}
+
+// HighWatermark returns the offset of the next message of the partition
+func (b *Broker) HighWatermark(topic string, partition int32) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	partitions, ok := b.mu.topics[topic]
+	if !ok || partition < 0 || partition >= int32(len(partitions)) {
+		return 0
+	}
+	return int64(len(partitions[partition]))
+}
+
+// CommitOffset commits the next offset to be read by the consumer group
+func (b *Broker) CommitOffset(group, topic string, partition int32, offset int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.mu.offsets[groupOffsetKey{group: group, topic: topic, partition: partition}] = offset
+}
+
+// CommittedOffset returns the offset committed by the consumer group
+func (b *Broker) CommittedOffset(group, topic string, partition int32) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.mu.offsets[groupOffsetKey{group: group, topic: topic, partition: partition}]
+}
+
+// BrokerSink produces the events to a Broker, the events of a table go to
+// the topic "prefix.database.table".
+type BrokerSink struct {
+	broker  *Broker
+	prefix  string
+	encoder Encoder
+}
+
+var _ Sink = (*BrokerSink)(nil)
+
+// NewBrokerSink creates a BrokerSink
+func NewBrokerSink(broker *Broker, prefix string, encoder Encoder) *BrokerSink {
+	return &BrokerSink{
+		broker:  broker,
+		prefix:  prefix,
+		encoder: encoder,
+	}
+}
+
+// Topic returns the topic of the events of the table
+func (s *BrokerSink) Topic(database, table string) string {
+	return fmt.Sprintf("%s.%s.%s", s.prefix, database, table)
+}
+
+func (s *BrokerSink) Send(ctx context.Context, events []Event) error {
+	for _, e := range events {
+		key, value, err := s.encoder.Encode(e)
+		if err != nil {
+			return err
+		}
+		s.broker.Produce(s.Topic(e.Database, e.Table), key, value)
+	}
+	return nil
+}
+
+// Flush does nothing, produced messages are visible at once
+func (s *BrokerSink) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (s *BrokerSink) Close() error {
+	return nil
+}
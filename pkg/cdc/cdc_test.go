@@ -0,0 +1,389 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lni/vfs"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONEncoder(t *testing.T) {
+	e := Event{
+		Op:       OpUpdate,
+		Database: "db1",
+		Table:    "t1",
+		TxnID:    "txn1",
+		CommitTS: types.BuildTS(int64(time.Second*3), 1),
+		Lsn:      10,
+		Key:      Row{"id": 1},
+		Before:   Row{"id": 1, "v": "a"},
+		After:    Row{"id": 1, "v": "b"},
+	}
+	key, value, err := JSONEncoder{}.Encode(e)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, string(key))
+
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal(value, &envelope))
+	assert.Equal(t, "u", envelope["op"])
+	assert.Equal(t, map[string]any{"id": float64(1), "v": "a"}, envelope["before"])
+	assert.Equal(t, map[string]any{"id": float64(1), "v": "b"}, envelope["after"])
+	source := envelope["source"].(map[string]any)
+	assert.Equal(t, "db1", source["db"])
+	assert.Equal(t, "t1", source["table"])
+	assert.Equal(t, float64(3000), source["ts_ms"])
+	assert.Equal(t, float64(10), source["lsn"])
+
+	key, value, err = JSONEncoder{}.Encode(Event{Op: OpDelete})
+	require.NoError(t, err)
+	assert.Empty(t, key)
+	require.NoError(t, json.Unmarshal(value, &envelope))
+	assert.Equal(t, "d", envelope["op"])
+	assert.Nil(t, envelope["after"])
+}
+
+func TestBroker(t *testing.T) {
+	b := NewBroker(4)
+	p1, o1 := b.Produce("topic", []byte("k1"), []byte("v1"))
+	p2, o2 := b.Produce("topic", []byte("k1"), []byte("v2"))
+	assert.Equal(t, p1, p2)
+	assert.Equal(t, int64(0), o1)
+	assert.Equal(t, int64(1), o2)
+	assert.Equal(t, int64(2), b.HighWatermark("topic", p1))
+	assert.Equal(t, []string{"topic"}, b.Topics())
+
+	messages := b.Fetch("topic", p1, 1, 10)
+	require.Equal(t, 1, len(messages))
+	assert.Equal(t, "v2", string(messages[0].Value))
+	assert.Empty(t, b.Fetch("topic", p1, 2, 10))
+	assert.Empty(t, b.Fetch("unknown", 0, 0, 10))
+
+	assert.Equal(t, int64(0), b.CommittedOffset("g", "topic", p1))
+	b.CommitOffset("g", "topic", p1, 2)
+	assert.Equal(t, int64(2), b.CommittedOffset("g", "topic", p1))
+}
+
+func TestFileSink(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cdc.json")
+	sink, err := NewFileSink(path, JSONEncoder{})
+	require.NoError(t, err)
+	require.NoError(t, sink.Send(ctx, []Event{
+		{Op: OpInsert, Database: "db1", Table: "t1", After: Row{"id": 1}},
+		{Op: OpDelete, Database: "db1", Table: "t1", Before: Row{"id": 1}},
+	}))
+	require.NoError(t, sink.Flush(ctx))
+	require.NoError(t, sink.Close())
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var ops []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var envelope map[string]any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &envelope))
+		ops = append(ops, envelope["op"].(string))
+	}
+	assert.Equal(t, []string{"c", "d"}, ops)
+}
+
+func TestMergeUpdates(t *testing.T) {
+	events := []Event{
+		{Op: OpDelete, TableID: 1, Key: Row{"id": 1}, Before: Row{"id": 1, "v": 1}},
+		{Op: OpDelete, TableID: 1},
+		{Op: OpInsert, TableID: 2, Key: Row{"id": 1}, After: Row{"id": 1, "v": 3}},
+		{Op: OpInsert, TableID: 1, Key: Row{"id": 1}, After: Row{"id": 1, "v": 2}},
+	}
+	merged := mergeUpdates(events)
+	require.Equal(t, 3, len(merged))
+	assert.Equal(t, OpUpdate, merged[0].Op)
+	assert.Equal(t, Row{"id": 1, "v": 1}, merged[0].Before)
+	assert.Equal(t, Row{"id": 1, "v": 2}, merged[0].After)
+	assert.Equal(t, OpDelete, merged[1].Op)
+	assert.Equal(t, OpInsert, merged[2].Op)
+}
+
+// startTAE starts a TAE writing its WAL to a logservice, and returns the
+// read only client of the logservice
+func startTAE(t *testing.T, ctx context.Context) (*db.DB, logservice.Client, func()) {
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	service, ccfg, err := logservice.NewTestService(vfs.NewStrictMem())
+	require.NoError(t, err)
+	blockio.Start()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	opts.LogStoreT = options.LogstoreLogservice
+	opts.Lc = func() (logservice.Client, error) {
+		return logservice.NewClient(ctx, ccfg)
+	}
+	tae, err := db.Open(t.TempDir(), opts)
+	require.NoError(t, err)
+	rcfg := ccfg
+	rcfg.ReadOnly = true
+	client, err := logservice.NewClient(ctx, rcfg)
+	require.NoError(t, err)
+	return tae, client, func() {
+		assert.NoError(t, client.Close())
+		assert.NoError(t, tae.Close())
+		blockio.Stop()
+		blockio.ResetPipeline()
+		assert.NoError(t, service.Close())
+	}
+}
+
+func consumeAll(t *testing.T, ctx context.Context, consumer *Consumer) {
+	for {
+		n, err := consumer.Poll(ctx)
+		require.NoError(t, err)
+		if n == 0 {
+			return
+		}
+	}
+}
+
+func fetchOps(t *testing.T, broker *Broker, topic string) ([]string, []map[string]any) {
+	var ops []string
+	var envelopes []map[string]any
+	for _, m := range broker.Fetch(topic, 0, 0, 100) {
+		envelope := make(map[string]any)
+		require.NoError(t, json.Unmarshal(m.Value, &envelope))
+		ops = append(ops, envelope["op"].(string))
+		envelopes = append(envelopes, envelope)
+	}
+	return ops, envelopes
+}
+
+func TestConsumeTAELog(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	tae, client, stop := startTAE(t, ctx)
+	defer stop()
+
+	schema := catalog.MockSchema(2, 0)
+	schema.Name = "t1"
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.CreateDatabase("db1", "", "")
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(catalog.MockBatch(schema, 3)))
+	require.NoError(t, txn.Commit())
+
+	pk := schema.GetSingleSortKey().Name
+	col := schema.ColDefs[1]
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("db1")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName("t1")
+	require.NoError(t, err)
+	require.NoError(t, rel.UpdateByFilter(handle.NewEQFilter(int32(1)), uint16(col.Idx), int32(100), false))
+	require.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(int32(2))))
+	require.NoError(t, txn.Commit())
+
+	broker := NewBroker(1)
+	sink := NewBrokerSink(broker, "mo", JSONEncoder{})
+	consumer, err := NewConsumer("cdc", client, NewTAEDecoder(nil), sink, WithReadSize(1024*1024))
+	require.NoError(t, err)
+	consumeAll(t, ctx, consumer)
+
+	messages := broker.Fetch(sink.Topic("db1", "t1"), 0, 0, 100)
+	require.Equal(t, 5, len(messages))
+	var ops []string
+	var last map[string]any
+	for _, m := range messages {
+		last = make(map[string]any)
+		require.NoError(t, json.Unmarshal(m.Value, &last))
+		ops = append(ops, last["op"].(string))
+	}
+	assert.Equal(t, []string{"c", "c", "c", "u", "d"}, ops)
+	assert.Equal(t, float64(2), last["before"].(map[string]any)[pk])
+
+	var update map[string]any
+	require.NoError(t, json.Unmarshal(messages[3].Value, &update))
+	assert.Equal(t, `{"`+pk+`":1}`, string(messages[3].Key))
+	assert.Equal(t, float64(100), update["after"].(map[string]any)[col.Name])
+
+	// the logs not consumed are kept by the truncation
+	checkpoint, err := client.(logservice.ConsumerClient).GetConsumerLsn(ctx, "cdc")
+	require.NoError(t, err)
+	assert.Equal(t, consumer.next, checkpoint)
+	require.NoError(t, consumer.Remove(ctx))
+	checkpoint, err = client.(logservice.ConsumerClient).GetConsumerLsn(ctx, "cdc")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), checkpoint)
+}
+
+func TestConsumeTAELogS3Blocks(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	tae, client, stop := startTAE(t, ctx)
+	defer stop()
+
+	schema := catalog.MockSchema(2, 0)
+	schema.Name = "t1"
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	pk := schema.GetSingleSortKey().Name
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.CreateDatabase("db1", "", "")
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(catalog.MockBatch(schema, 10)))
+	require.NoError(t, txn.Commit())
+
+	// the block is written to S3 by the compaction, whose rows are not changes
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("db1")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName("t1")
+	require.NoError(t, err)
+	it := rel.MakeBlockIt()
+	require.True(t, it.Valid())
+	task, err := jobs.NewCompactBlockTask(nil, txn, it.GetBlock().GetMeta().(*catalog.BlockEntry), tae.Scheduler)
+	require.NoError(t, err)
+	require.NoError(t, task.OnExec())
+	metaLoc := task.GetNewBlock().GetMetaLoc()
+	require.NoError(t, txn.Commit())
+
+	// the block is added to t2 as if written to S3 by a CN
+	schema2 := catalog.MockSchema(2, 0)
+	schema2.Name = "t2"
+	schema2.BlockMaxRows = 10
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("db1")
+	require.NoError(t, err)
+	rel, err = database.CreateRelation(schema2)
+	require.NoError(t, err)
+	txn.SetPKDedupSkip(txnif.PKDedupSkipWorkSpace)
+	require.NoError(t, rel.AddBlksWithMetaLoc(nil, []objectio.Location{metaLoc}))
+	require.NoError(t, txn.Commit())
+
+	// the deleted row is read from the compacted block
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("db1")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName("t1")
+	require.NoError(t, err)
+	require.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(int32(1))))
+	require.NoError(t, txn.Commit())
+
+	broker := NewBroker(1)
+	sink := NewBrokerSink(broker, "mo", JSONEncoder{})
+	decoder := NewTAEDecoder(nil, WithFileService(tae.Fs.Service))
+	consumer, err := NewConsumer("cdc", client, decoder, sink)
+	require.NoError(t, err)
+	consumeAll(t, ctx, consumer)
+
+	ops, envelopes := fetchOps(t, broker, sink.Topic("db1", "t1"))
+	require.Equal(t, 11, len(ops))
+	assert.Equal(t, "d", ops[10])
+	assert.Equal(t, float64(1), envelopes[10]["before"].(map[string]any)[pk])
+	messages := broker.Fetch(sink.Topic("db1", "t1"), 0, 10, 1)
+	assert.Equal(t, `{"`+pk+`":1}`, string(messages[0].Key))
+
+	ops, envelopes = fetchOps(t, broker, sink.Topic("db1", "t2"))
+	require.Equal(t, 10, len(ops))
+	for i, op := range ops {
+		assert.Equal(t, "c", op)
+		assert.Equal(t, float64(i), envelopes[i]["after"].(map[string]any)[pk])
+	}
+}
+
+func TestConsumeTAELogSnapshot(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	tae, client, stop := startTAE(t, ctx)
+	defer stop()
+
+	schema := catalog.MockSchema(2, 0)
+	schema.Name = "t1"
+	schema.BlockMaxRows = 10
+	pk := schema.GetSingleSortKey().Name
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err := txn.CreateDatabase("db1", "", "")
+	require.NoError(t, err)
+	rel, err := database.CreateRelation(schema)
+	require.NoError(t, err)
+	require.NoError(t, rel.Append(catalog.MockBatch(schema, 3)))
+	require.NoError(t, txn.Commit())
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("db1")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName("t1")
+	require.NoError(t, err)
+	require.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(int32(0))))
+	require.NoError(t, txn.Commit())
+
+	broker := NewBroker(1)
+	sink := NewBrokerSink(broker, "mo", JSONEncoder{})
+	consumer, err := NewConsumer("cdc", client, NewTAEDecoder(nil), sink,
+		WithSnapshot(NewTAESnapshotter(tae)))
+	require.NoError(t, err)
+	consumeAll(t, ctx, consumer)
+
+	// the changes before the snapshot are not sent again
+	ops, _ := fetchOps(t, broker, sink.Topic("db1", "t1"))
+	assert.Equal(t, []string{"c", "c"}, ops)
+	snapshot, err := client.(logservice.ConsumerClient).GetConsumerLsn(ctx, "cdc"+snapshotSuffix)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), snapshot)
+
+	// the before image of the deleted row is known from the snapshot
+	txn, err = tae.StartTxn(nil)
+	require.NoError(t, err)
+	database, err = txn.GetDatabase("db1")
+	require.NoError(t, err)
+	rel, err = database.GetRelationByName("t1")
+	require.NoError(t, err)
+	require.NoError(t, rel.DeleteByFilter(handle.NewEQFilter(int32(2))))
+	require.NoError(t, txn.Commit())
+	consumeAll(t, ctx, consumer)
+	ops, envelopes := fetchOps(t, broker, sink.Topic("db1", "t1"))
+	require.Equal(t, []string{"c", "c", "d"}, ops)
+	assert.Equal(t, float64(2), envelopes[2]["before"].(map[string]any)[pk])
+	require.NoError(t, consumer.Remove(ctx))
+}
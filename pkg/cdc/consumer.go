@@ -0,0 +1,315 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	logpb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultReadSize           = 4 * 1024 * 1024
+	defaultPollInterval       = time.Second
+	defaultLeaseRenewInterval = time.Minute
+
+	// snapshotSuffix is appended to the name of a consumer taking the initial
+	// snapshot, the logs from the start of the snapshot are kept under it.
+	snapshotSuffix = "/snapshot"
+)
+
+// Option is the option of the Consumer
+type Option func(*Consumer)
+
+// WithReadSize sets the max bytes of the log records read each time
+func WithReadSize(size uint64) Option {
+	return func(c *Consumer) {
+		c.readSize = size
+	}
+}
+
+// WithPollInterval sets the interval to poll the log shard when all the
+// records have been consumed
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *Consumer) {
+		c.pollInterval = interval
+	}
+}
+
+// WithLeaseRenewInterval sets the interval to renew the lease of the
+// consumer when there is nothing to consume. It must be far less than the
+// consumer-lease-ttl of the logservice, or the logs are not kept for the
+// consumer any more.
+func WithLeaseRenewInterval(interval time.Duration) Option {
+	return func(c *Consumer) {
+		c.leaseRenewInterval = interval
+	}
+}
+
+// WithSnapshot makes a new consumer send the rows read by snapshotter as
+// inserts before the changes in the log
+func WithSnapshot(snapshotter Snapshotter) Option {
+	return func(c *Consumer) {
+		c.snapshotter = snapshotter
+	}
+}
+
+// WithLogger sets the logger of the Consumer
+func WithLogger(logger *zap.Logger) Option {
+	return func(c *Consumer) {
+		c.logger = logger
+	}
+}
+
+// Consumer reads the log records of a log shard, decodes them into change
+// events and sends the events to the sink. The next lsn to read is
+// checkpointed into the logservice after the events are flushed, and the
+// logservice keeps the logs from it when truncating. Setting the checkpoint
+// renews the lease of the consumer, a consumer that stops renewing it for
+// the consumer-lease-ttl of the logservice is abandoned and its logs are
+// truncated. Events are delivered at least once: events after the
+// checkpoint are sent again after a restart.
+//
+// With a Snapshotter, a new consumer first sends the rows of the tables as
+// of the snapshot timestamp, then the changes committed after it. The logs
+// from before the snapshot are kept while it is taken, and an interrupted
+// snapshot is taken again from the beginning.
+type Consumer struct {
+	name               string
+	client             logservice.Client
+	checkpoint         logservice.ConsumerClient
+	decoder            Decoder
+	sink               Sink
+	snapshotter        Snapshotter
+	logger             *zap.Logger
+	readSize           uint64
+	pollInterval       time.Duration
+	leaseRenewInterval time.Duration
+
+	// next is the next lsn to read, 0 means the checkpoint is not loaded yet
+	next uint64
+	// renewed is the last time the checkpoint was set
+	renewed time.Time
+	// snapshotTS is the timestamp of the initial snapshot taken, the changes
+	// committed before it are in the snapshot
+	snapshotTS types.TS
+}
+
+// NewConsumer creates a Consumer named name. The client must support
+// consumer checkpoints.
+func NewConsumer(
+	name string,
+	client logservice.Client,
+	decoder Decoder,
+	sink Sink,
+	opts ...Option) (*Consumer, error) {
+	checkpoint, ok := client.(logservice.ConsumerClient)
+	if !ok {
+		return nil, moerr.NewNotSupportedNoCtx("log client without consumer checkpoints")
+	}
+	c := &Consumer{
+		name:               name,
+		client:             client,
+		checkpoint:         checkpoint,
+		decoder:            decoder,
+		sink:               sink,
+		readSize:           defaultReadSize,
+		pollInterval:       defaultPollInterval,
+		leaseRenewInterval: defaultLeaseRenewInterval,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.logger == nil {
+		c.logger = logutil.GetGlobalLogger().Named("cdc")
+	}
+	c.logger = c.logger.With(zap.String("consumer", name))
+	return c, nil
+}
+
+// Run consumes the log until ctx is done
+func (c *Consumer) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			n, err := c.Poll(ctx)
+			if err != nil {
+				c.logger.Error("consume log failed", zap.Error(err))
+			}
+			if n == 0 || err != nil {
+				timer.Reset(c.pollInterval)
+			} else {
+				timer.Reset(0)
+			}
+		}
+	}
+}
+
+// Poll reads a batch of log records and sends their events to the sink,
+// it returns the number of records read.
+func (c *Consumer) Poll(ctx context.Context) (int, error) {
+	if c.next == 0 {
+		if err := c.loadCheckpoint(ctx); err != nil {
+			return 0, err
+		}
+	}
+	records, _, err := c.client.Read(ctx, c.next, c.readSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(records) == 0 {
+		if time.Since(c.renewed) >= c.leaseRenewInterval {
+			if err = c.setCheckpoint(ctx, c.decoder.SafeLsn(c.next)); err != nil {
+				return 0, err
+			}
+		}
+		return 0, nil
+	}
+	next := records[len(records)-1].Lsn + 1
+	var events []Event
+	for _, rec := range records {
+		if rec.GetType() != logpb.UserRecord {
+			continue
+		}
+		recEvents, err := c.decoder.Decode(rec.Lsn, rec)
+		if err != nil {
+			return 0, err
+		}
+		for _, e := range recEvents {
+			if e.CommitTS.Greater(c.snapshotTS) {
+				events = append(events, e)
+			}
+		}
+	}
+	if len(events) > 0 {
+		if err = c.sink.Send(ctx, events); err != nil {
+			return 0, err
+		}
+		if err = c.sink.Flush(ctx); err != nil {
+			return 0, err
+		}
+	}
+	if err = c.setCheckpoint(ctx, c.decoder.SafeLsn(next)); err != nil {
+		return 0, err
+	}
+	c.next = next
+	return len(records), nil
+}
+
+func (c *Consumer) setCheckpoint(ctx context.Context, lsn uint64) error {
+	if err := c.checkpoint.SetConsumerLsn(ctx, c.name, lsn); err != nil {
+		return err
+	}
+	c.renewed = time.Now()
+	return nil
+}
+
+// loadCheckpoint loads the checkpoint of the consumer, a new consumer
+// starts from the oldest log kept and is registered at once, after taking
+// the initial snapshot if any.
+func (c *Consumer) loadCheckpoint(ctx context.Context) error {
+	next, err := c.checkpoint.GetConsumerLsn(ctx, c.name)
+	if err != nil {
+		return err
+	}
+	if next == 0 {
+		if next, err = c.start(ctx); err != nil {
+			return err
+		}
+	}
+	c.logger.Info("cdc consumer started", zap.Uint64("lsn", next))
+	c.next = next
+	return nil
+}
+
+// start registers a new consumer and returns the lsn to start from
+func (c *Consumer) start(ctx context.Context) (uint64, error) {
+	if c.snapshotter == nil {
+		truncated, err := c.client.GetTruncatedLsn(ctx)
+		if err != nil {
+			return 0, err
+		}
+		next := truncated + 1
+		return next, c.setCheckpoint(ctx, next)
+	}
+
+	// the logs from the start are kept under the snapshot name while the
+	// snapshot is taken. All the transactions in the logs before the start
+	// have been committed before the snapshot timestamp.
+	pin := c.name + snapshotSuffix
+	next, err := c.checkpoint.GetConsumerLsn(ctx, pin)
+	if err != nil {
+		return 0, err
+	}
+	if next == 0 {
+		truncated, err := c.client.GetTruncatedLsn(ctx)
+		if err != nil {
+			return 0, err
+		}
+		next = truncated + 1
+		if err = c.checkpoint.SetConsumerLsn(ctx, pin, next); err != nil {
+			return 0, err
+		}
+	}
+	observer, _ := c.decoder.(rowObserver)
+	ts, err := c.snapshotter.Snapshot(ctx, func(events []Event) error {
+		if observer != nil {
+			observer.Observe(events)
+		}
+		return c.sink.Send(ctx, events)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err = c.sink.Flush(ctx); err != nil {
+		return 0, err
+	}
+	c.logger.Info("cdc snapshot taken", zap.String("ts", ts.ToString()))
+	if err = c.setCheckpoint(ctx, next); err != nil {
+		return 0, err
+	}
+	c.snapshotTS = ts
+	return next, c.checkpoint.SetConsumerLsn(ctx, pin, 0)
+}
+
+// rowObserver is implemented by decoders remembering the rows of the
+// snapshot, e.g. the TAEDecoder
+type rowObserver interface {
+	Observe(events []Event)
+}
+
+// Next returns the next lsn to read, 0 if the checkpoint is not loaded yet
+func (c *Consumer) Next() uint64 {
+	return c.next
+}
+
+// Remove removes the checkpoint of the consumer, the logservice no longer
+// keeps logs for it.
+func (c *Consumer) Remove(ctx context.Context) error {
+	if err := c.checkpoint.SetConsumerLsn(ctx, c.name+snapshotSuffix, 0); err != nil {
+		return err
+	}
+	return c.checkpoint.SetConsumerLsn(ctx, c.name, 0)
+}
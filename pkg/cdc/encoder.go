@@ -0,0 +1,88 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const connectorName = "matrixone"
+
+// debeziumOps are the op codes used by debezium
+var debeziumOps = map[Op]string{
+	OpInsert: "c",
+	OpUpdate: "u",
+	OpDelete: "d",
+}
+
+type jsonSource struct {
+	Connector string `json:"connector"`
+	DB        string `json:"db"`
+	Table     string `json:"table"`
+	DBID      uint64 `json:"db_id"`
+	TableID   uint64 `json:"table_id"`
+	TsMs      int64  `json:"ts_ms"`
+	CommitTS  string `json:"commit_ts"`
+	TxnID     string `json:"txId"`
+	Lsn       uint64 `json:"lsn"`
+	RowID     string `json:"row_id"`
+}
+
+type jsonEnvelope struct {
+	Before Row        `json:"before"`
+	After  Row        `json:"after"`
+	Source jsonSource `json:"source"`
+	Op     string     `json:"op"`
+}
+
+// JSONEncoder encodes events in the debezium json format without schemas.
+// The key of the message is the json of the primary key, or empty if the
+// key is unknown.
+type JSONEncoder struct{}
+
+var _ Encoder = JSONEncoder{}
+
+func (JSONEncoder) Encode(e Event) ([]byte, []byte, error) {
+	var key []byte
+	if len(e.Key) > 0 {
+		var err error
+		if key, err = json.Marshal(e.Key); err != nil {
+			return nil, nil, err
+		}
+	}
+	ts := e.CommitTS.ToTimestamp()
+	value, err := json.Marshal(jsonEnvelope{
+		Before: e.Before,
+		After:  e.After,
+		Source: jsonSource{
+			Connector: connectorName,
+			DB:        e.Database,
+			Table:     e.Table,
+			DBID:      e.DatabaseID,
+			TableID:   e.TableID,
+			TsMs:      ts.PhysicalTime / int64(time.Millisecond),
+			CommitTS:  e.CommitTS.ToString(),
+			TxnID:     e.TxnID,
+			Lsn:       e.Lsn,
+			RowID:     e.RowID.String(),
+		},
+		Op: debeziumOps[e.Op],
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
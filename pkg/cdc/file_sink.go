@@ -0,0 +1,74 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"os"
+)
+
+// FileSink appends the encoded events to a local file, one message per line
+type FileSink struct {
+	file    *os.File
+	writer  *bufio.Writer
+	encoder Encoder
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink creates a FileSink appending to the file of path
+func NewFileSink(path string, encoder Encoder) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{
+		file:    file,
+		writer:  bufio.NewWriter(file),
+		encoder: encoder,
+	}, nil
+}
+
+func (s *FileSink) Send(ctx context.Context, events []Event) error {
+	for _, e := range events {
+		_, value, err := s.encoder.Encode(e)
+		if err != nil {
+			return err
+		}
+		if _, err = s.writer.Write(value); err != nil {
+			return err
+		}
+		if err = s.writer.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *FileSink) Flush(ctx context.Context) error {
+	if err := s.writer.Flush(); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+func (s *FileSink) Close() error {
+	if err := s.writer.Flush(); err != nil {
+		_ = s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
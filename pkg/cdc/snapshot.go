@@ -0,0 +1,126 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+)
+
+// TxnStarter starts TAE transactions, e.g. the TAE of a DN
+type TxnStarter interface {
+	StartTxn(info []byte) (txnif.AsyncTxn, error)
+}
+
+// TAESnapshotter reads the initial snapshot from a TAE, the rows of all the
+// tables are read in a transaction as of its start timestamp.
+type TAESnapshotter struct {
+	tae TxnStarter
+}
+
+var _ Snapshotter = (*TAESnapshotter)(nil)
+
+// NewTAESnapshotter creates a TAESnapshotter reading from tae
+func NewTAESnapshotter(tae TxnStarter) *TAESnapshotter {
+	return &TAESnapshotter{tae: tae}
+}
+
+func (s *TAESnapshotter) Snapshot(ctx context.Context, fn func(events []Event) error) (types.TS, error) {
+	txn, err := s.tae.StartTxn(nil)
+	if err != nil {
+		return types.TS{}, err
+	}
+	defer func() {
+		_ = txn.Rollback()
+	}()
+	ts := txn.GetStartTS()
+	for _, name := range txn.DatabaseNames() {
+		if err = ctx.Err(); err != nil {
+			return types.TS{}, err
+		}
+		database, err := txn.GetDatabase(name)
+		if err != nil {
+			return types.TS{}, err
+		}
+		it := database.MakeRelationIt()
+		for ; it.Valid(); it.Next() {
+			rel := it.GetRelation()
+			if rel.GetMeta().(*catalog.TableEntry).IsVirtual() {
+				continue
+			}
+			if err = snapshotRelation(database, rel, ts, fn); err != nil {
+				return types.TS{}, err
+			}
+		}
+	}
+	return ts, nil
+}
+
+// snapshotRelation calls fn on the rows of each block of rel
+func snapshotRelation(database handle.Database, rel handle.Relation, ts types.TS, fn func(events []Event) error) error {
+	schema := rel.Schema().(*catalog.Schema)
+	attrs := schema.Attrs()
+	info := &tableInfo{name: schema.Name}
+	if schema.HasPK() {
+		info.pk = schema.GetSingleSortKey().Name
+	}
+	it := rel.MakeBlockIt()
+	for ; it.Valid(); it.Next() {
+		blk := it.GetBlock()
+		view, err := blk.GetColumnDataByNames(attrs)
+		if err != nil {
+			return err
+		}
+		if view == nil {
+			continue
+		}
+		data := containers.NewBatch()
+		for _, attr := range attrs {
+			data.AddVector(attr, view.GetColumnData(schema.GetColIdx(attr)))
+		}
+		id := blk.Fingerprint()
+		var events []Event
+		for i := 0; i < data.Length(); i++ {
+			if view.DeleteMask != nil && view.DeleteMask.Contains(uint32(i)) {
+				continue
+			}
+			row := makeRow(data, i)
+			events = append(events, Event{
+				Op:         OpInsert,
+				DatabaseID: database.GetID(),
+				TableID:    rel.ID(),
+				Database:   database.GetName(),
+				Table:      schema.Name,
+				CommitTS:   ts,
+				RowID:      *objectio.NewRowid(&id.BlockID, uint32(i)),
+				Key:        info.key(row),
+				After:      row,
+			})
+		}
+		view.Close()
+		if len(events) > 0 {
+			if err = fn(events); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,621 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	logpb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/logservicedriver"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/updates"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+const defaultMaxRowImages = 1 << 20
+
+// TableResolver returns the names of a table that the decoder has not seen
+// created in the log
+type TableResolver func(dbID, tableID uint64) (database, table string, ok bool)
+
+// SchemaResolver returns the schema of a table that the decoder has not seen
+// created in the log
+type SchemaResolver func(dbID, tableID uint64) (*catalog.Schema, bool)
+
+// CatalogResolvers returns the resolvers looking up the tables in c
+func CatalogResolvers(c *catalog.Catalog) (TableResolver, SchemaResolver) {
+	lookup := func(dbID, tableID uint64) (*catalog.DBEntry, *catalog.TableEntry, bool) {
+		db, err := c.GetDatabaseByID(dbID)
+		if err != nil {
+			return nil, nil, false
+		}
+		table, err := db.GetTableEntryByID(tableID)
+		if err != nil {
+			return nil, nil, false
+		}
+		return db, table, true
+	}
+	tables := func(dbID, tableID uint64) (string, string, bool) {
+		db, table, ok := lookup(dbID, tableID)
+		if !ok {
+			return "", "", false
+		}
+		return db.GetName(), table.GetLastestSchema().Name, true
+	}
+	schemas := func(dbID, tableID uint64) (*catalog.Schema, bool) {
+		_, table, ok := lookup(dbID, tableID)
+		if !ok {
+			return nil, false
+		}
+		return table.GetLastestSchema(), true
+	}
+	return tables, schemas
+}
+
+// DecoderOption is the option of the TAEDecoder
+type DecoderOption func(*TAEDecoder)
+
+// WithFileService sets the file service that the blocks written to S3 are
+// read from. Without it, the rows of the blocks written to S3 by CNs are
+// not decoded, and the rows deleted from the blocks are only known if their
+// inserts were decoded.
+func WithFileService(fs fileservice.FileService) DecoderOption {
+	return func(d *TAEDecoder) {
+		d.fs = fs
+	}
+}
+
+// WithSchemaResolver sets the resolver of the schemas of the tables created
+// before the decoded logs, they are needed to read the blocks written to S3
+func WithSchemaResolver(resolver SchemaResolver) DecoderOption {
+	return func(d *TAEDecoder) {
+		d.schemaResolver = resolver
+	}
+}
+
+type tableInfo struct {
+	dbID     uint64
+	name     string
+	pk       string
+	schema   *catalog.Schema
+	rowImage map[types.Rowid]Row
+}
+
+type preparedTxn struct {
+	lsn    uint64
+	events []Event
+}
+
+// txnBlocks are the blocks created and dropped by a transaction
+type txnBlocks struct {
+	created []*common.ID
+	locs    []objectio.Location
+	dropped bool
+}
+
+// loadedBlock is the last block read from S3 to find the deleted rows
+type loadedBlock struct {
+	loc  objectio.Location
+	rows []Row
+}
+
+// TAEDecoder decodes the TAE WAL records written by the logservice driver
+// of a DN into change events.
+//
+// Inserts and deletes are decoded from the append and delete commands of
+// transactions, and the rows of the blocks written to S3 by CNs are read
+// from the file service when the transactions adding the blocks are decoded.
+// Blocks created by transactions that also drop blocks are the results of
+// flushes and merges, their rows are not changes.
+//
+// A delete followed by an insert of the same primary key in a transaction is
+// merged into an update. The before image of a deleted row is taken from the
+// inserts decoded or observed, or read from the block of the row if the block
+// has been written to S3 since the decoder started. It is unknown only for
+// the rows of appendable blocks that were neither decoded nor observed.
+type TAEDecoder struct {
+	resolver       TableResolver
+	schemaResolver SchemaResolver
+	fs             fileservice.FileService
+	maxRowImages   int
+	rowImages      int
+	dbs            map[uint64]string
+	tables         map[uint64]*tableInfo
+	// the locations of the blocks written to S3
+	blocks map[types.Blockid]objectio.Location
+	loaded loadedBlock
+	// 2PC transactions prepared and not committed yet
+	prepared map[string]preparedTxn
+}
+
+var _ Decoder = (*TAEDecoder)(nil)
+
+// NewTAEDecoder creates a TAEDecoder, resolver can be nil
+func NewTAEDecoder(resolver TableResolver, opts ...DecoderOption) *TAEDecoder {
+	d := &TAEDecoder{
+		resolver:     resolver,
+		maxRowImages: defaultMaxRowImages,
+		dbs:          make(map[uint64]string),
+		tables:       make(map[uint64]*tableInfo),
+		blocks:       make(map[types.Blockid]objectio.Location),
+		prepared:     make(map[string]preparedTxn),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Observe remembers the rows inserted by the events, e.g. the rows of the
+// initial snapshot, as the before images of later deletes.
+func (d *TAEDecoder) Observe(events []Event) {
+	for _, e := range events {
+		if e.Op != OpInsert && e.Op != OpUpdate {
+			continue
+		}
+		info := d.getTable(e.DatabaseID, e.TableID)
+		if info.name == "" {
+			info.name = e.Table
+		}
+		if d.dbs[e.DatabaseID] == "" {
+			d.dbs[e.DatabaseID] = e.Database
+		}
+		d.remember(info, e.RowID, e.After)
+	}
+}
+
+func (d *TAEDecoder) Decode(lsn uint64, rec logpb.LogRecord) ([]Event, error) {
+	var events []Event
+	var err error
+	decodeErr := logservicedriver.DecodeRecord(rec, func(e *entry.Entry) {
+		if err != nil {
+			return
+		}
+		var txnEvents []Event
+		switch e.Info.Group {
+		case wal.GroupPrepare:
+			txnEvents, err = d.decodeTxnRecord(lsn, e.Entry.GetPayload())
+		case wal.GroupC:
+			txnEvents, err = d.decodeTxnState(e.Entry.GetPayload())
+		}
+		events = append(events, txnEvents...)
+	})
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+	return events, err
+}
+
+func (d *TAEDecoder) SafeLsn(next uint64) uint64 {
+	for _, txn := range d.prepared {
+		if txn.lsn < next {
+			next = txn.lsn
+		}
+	}
+	return next
+}
+
+func decodeTxnPayload(payload []byte) (any, error) {
+	if len(payload) < 4 {
+		return nil, moerr.NewInternalErrorNoCtx("bad txn record size %d", len(payload))
+	}
+	head := objectio.DecodeIOEntryHeader(payload)
+	return objectio.GetIOEntryCodec(*head).Decode(payload[4:])
+}
+
+func (d *TAEDecoder) decodeTxnRecord(lsn uint64, payload []byte) ([]Event, error) {
+	v, err := decodeTxnPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	cmd, ok := v.(*txnbase.TxnCmd)
+	if !ok {
+		return nil, nil
+	}
+	defer cmd.Close()
+	var events []Event
+	var blocks txnBlocks
+	if err = d.decodeCmds(cmd.ComposedCmd, lsn, &events, &blocks); err != nil {
+		return nil, err
+	}
+	if !blocks.dropped {
+		for i, id := range blocks.created {
+			if err = d.decodeBlock(id, blocks.locs[i], lsn, &events); err != nil {
+				return nil, err
+			}
+		}
+	}
+	events = mergeUpdates(events)
+	if cmd.Is2PC() {
+		d.prepared[cmd.ID] = preparedTxn{lsn: lsn, events: events}
+		return nil, nil
+	}
+	for i := range events {
+		events[i].TxnID = cmd.ID
+		events[i].CommitTS = cmd.PrepareTS
+	}
+	return events, nil
+}
+
+func (d *TAEDecoder) decodeTxnState(payload []byte) ([]Event, error) {
+	v, err := decodeTxnPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	cmd, ok := v.(*txnbase.TxnStateCmd)
+	if !ok {
+		return nil, nil
+	}
+	txn, ok := d.prepared[cmd.ID]
+	if !ok {
+		return nil, nil
+	}
+	delete(d.prepared, cmd.ID)
+	if cmd.State != txnif.TxnStateCommitted {
+		return nil, nil
+	}
+	for i := range txn.events {
+		txn.events[i].TxnID = cmd.ID
+		txn.events[i].CommitTS = cmd.CommitTs
+	}
+	return txn.events, nil
+}
+
+func (d *TAEDecoder) decodeCmds(cmds *txnbase.ComposedCmd, lsn uint64, events *[]Event, blocks *txnBlocks) error {
+	if cmds == nil {
+		return nil
+	}
+	for _, cmd := range cmds.Cmds {
+		switch c := cmd.(type) {
+		case *txnbase.ComposedCmd:
+			if err := d.decodeCmds(c, lsn, events, blocks); err != nil {
+				return err
+			}
+		case *catalog.EntryCommand[*catalog.EmptyMVCCNode, *catalog.DBNode]:
+			if name := c.GetNode().GetName(); name != "" {
+				d.dbs[c.GetID().DbID] = name
+			}
+		case *catalog.EntryCommand[*catalog.TableMVCCNode, *catalog.TableNode]:
+			d.onTableCmd(c)
+		case *catalog.EntryCommand[*catalog.MetadataMVCCNode, *catalog.BlockNode]:
+			d.onBlockCmd(c, blocks)
+		case *txnimpl.AppendCmd:
+			d.decodeAppend(c, lsn, events)
+		case *updates.UpdateCmd:
+			if c.GetType() == updates.IOET_WALTxnCommand_DeleteNode {
+				if err := d.decodeDelete(c, lsn, events); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// onBlockCmd remembers the locations of the blocks written to S3, and
+// collects the blocks created and dropped by the transaction
+func (d *TAEDecoder) onBlockCmd(cmd *catalog.EntryCommand[*catalog.MetadataMVCCNode, *catalog.BlockNode], blocks *txnBlocks) {
+	id := cmd.GetID()
+	node := cmd.GetMVCCNode()
+	if node.HasDropIntent() {
+		blocks.dropped = true
+		delete(d.blocks, id.BlockID)
+		return
+	}
+	loc := node.BaseNode.MetaLoc
+	if loc.IsEmpty() {
+		return
+	}
+	d.blocks[id.BlockID] = loc
+	if node.IsCreating() {
+		blocks.created = append(blocks.created, id)
+		blocks.locs = append(blocks.locs, loc)
+	}
+}
+
+func (d *TAEDecoder) onTableCmd(cmd *catalog.EntryCommand[*catalog.TableMVCCNode, *catalog.TableNode]) {
+	id := cmd.GetID()
+	node := cmd.GetMVCCNode()
+	if node.HasDropCommitted() {
+		if info, ok := d.tables[id.TableID]; ok {
+			d.rowImages -= len(info.rowImage)
+			delete(d.tables, id.TableID)
+		}
+		return
+	}
+	schema := node.BaseNode.Schema
+	if schema == nil {
+		return
+	}
+	info := d.getTable(id.DbID, id.TableID)
+	info.name = schema.Name
+	info.schema = schema
+	info.pk = ""
+	if schema.HasPK() {
+		info.pk = schema.GetSingleSortKey().Name
+	}
+}
+
+func (d *TAEDecoder) getTable(dbID, tableID uint64) *tableInfo {
+	info, ok := d.tables[tableID]
+	if !ok {
+		info = &tableInfo{
+			dbID:     dbID,
+			rowImage: make(map[types.Rowid]Row),
+		}
+		d.tables[tableID] = info
+	}
+	return info
+}
+
+func (d *TAEDecoder) newEvent(op Op, id *common.ID, lsn uint64) (Event, *tableInfo) {
+	info := d.getTable(id.DbID, id.TableID)
+	e := Event{
+		Op:         op,
+		DatabaseID: id.DbID,
+		TableID:    id.TableID,
+		Database:   d.dbs[id.DbID],
+		Table:      info.name,
+		Lsn:        lsn,
+	}
+	if (e.Database == "" || e.Table == "") && d.resolver != nil {
+		if db, table, ok := d.resolver(id.DbID, id.TableID); ok {
+			e.Database, e.Table = db, table
+			d.dbs[id.DbID], info.name = db, table
+		}
+	}
+	if e.Database == "" {
+		e.Database = fmt.Sprintf("db_%d", id.DbID)
+	}
+	if e.Table == "" {
+		e.Table = fmt.Sprintf("table_%d", id.TableID)
+	}
+	return e, info
+}
+
+func (d *TAEDecoder) decodeAppend(cmd *txnimpl.AppendCmd, lsn uint64, events *[]Event) {
+	data := cmd.Data
+	if data == nil {
+		return
+	}
+	for _, appended := range cmd.Infos {
+		dest := appended.GetDest()
+		srcOff, srcLen := appended.GetSrcOff(), appended.GetSrcLen()
+		for i := srcOff; i < srcOff+srcLen; i++ {
+			if data.IsDeleted(int(i)) {
+				continue
+			}
+			e, info := d.newEvent(OpInsert, dest, lsn)
+			e.RowID = *objectio.NewRowid(&dest.BlockID, appended.GetDestOff()+i-srcOff)
+			e.After = makeRow(data, int(i))
+			e.Key = info.key(e.After)
+			d.remember(info, e.RowID, e.After)
+			*events = append(*events, e)
+		}
+	}
+}
+
+func (d *TAEDecoder) remember(info *tableInfo, id types.Rowid, row Row) {
+	if _, ok := info.rowImage[id]; ok {
+		info.rowImage[id] = row
+		return
+	}
+	if d.rowImages < d.maxRowImages {
+		info.rowImage[id] = row
+		d.rowImages++
+	}
+}
+
+// decodeBlock decodes the rows of a block written to S3 as inserts
+func (d *TAEDecoder) decodeBlock(id *common.ID, loc objectio.Location, lsn uint64, events *[]Event) error {
+	if d.fs == nil {
+		return nil
+	}
+	rows, err := d.loadBlock(id, loc)
+	if err != nil {
+		return err
+	}
+	for i, row := range rows {
+		e, info := d.newEvent(OpInsert, id, lsn)
+		e.RowID = *objectio.NewRowid(&id.BlockID, uint32(i))
+		e.After = row
+		e.Key = info.key(row)
+		d.remember(info, e.RowID, row)
+		*events = append(*events, e)
+	}
+	return nil
+}
+
+// loadBlock reads the rows of the block at loc, the schema of the table
+// must be known
+func (d *TAEDecoder) loadBlock(id *common.ID, loc objectio.Location) ([]Row, error) {
+	if bytes.Equal(d.loaded.loc, loc) {
+		return d.loaded.rows, nil
+	}
+	schema := d.getSchema(id)
+	if schema == nil {
+		return nil, moerr.NewInternalErrorNoCtx(
+			"cdc: unknown schema of table %d, the block %s cannot be decoded",
+			id.TableID, id.BlockID.String())
+	}
+	seqnums := make([]uint16, 0, len(schema.ColDefs))
+	typs := make([]types.Type, 0, len(schema.ColDefs))
+	fills := make([][]byte, 0, len(schema.ColDefs))
+	for _, def := range schema.ColDefs {
+		if def.IsPhyAddr() {
+			continue
+		}
+		seqnums = append(seqnums, def.SeqNum)
+		typs = append(typs, def.Type)
+		fills = append(fills, def.FillValue())
+	}
+	reader, err := blockio.NewObjectReader(d.fs, loc)
+	if err != nil {
+		return nil, err
+	}
+	bat, err := reader.LoadColumnsWithFills(context.Background(), seqnums, typs, fills, loc.ID(), nil)
+	if err != nil {
+		return nil, err
+	}
+	data := containers.NewBatch()
+	for i, attr := range schema.Attrs() {
+		data.AddVector(attr, containers.ToDNVector(bat.Vecs[i]))
+	}
+	rows := make([]Row, data.Length())
+	for i := range rows {
+		rows[i] = makeRow(data, i)
+	}
+	d.loaded = loadedBlock{loc: loc, rows: rows}
+	return rows, nil
+}
+
+func (d *TAEDecoder) getSchema(id *common.ID) *catalog.Schema {
+	info := d.getTable(id.DbID, id.TableID)
+	if info.schema == nil && d.schemaResolver != nil {
+		if schema, ok := d.schemaResolver(id.DbID, id.TableID); ok {
+			info.schema = schema
+			if schema.HasPK() {
+				info.pk = schema.GetSingleSortKey().Name
+			}
+		}
+	}
+	return info.schema
+}
+
+func (d *TAEDecoder) decodeDelete(cmd *updates.UpdateCmd, lsn uint64, events *[]Event) error {
+	node := cmd.GetDeleteNode()
+	dest := cmd.GetDest()
+	if node == nil || dest == nil {
+		return nil
+	}
+	it := node.GetDeleteMaskLocked().Iterator()
+	for it.HasNext() {
+		offset := it.Next()
+		e, info := d.newEvent(OpDelete, dest, lsn)
+		e.RowID = *objectio.NewRowid(&dest.BlockID, offset)
+		if row, ok := info.rowImage[e.RowID]; ok {
+			delete(info.rowImage, e.RowID)
+			d.rowImages--
+			e.Before = row
+		} else if loc, ok := d.blocks[dest.BlockID]; ok && d.fs != nil {
+			rows, err := d.loadBlock(dest, loc)
+			if err != nil {
+				return err
+			}
+			if int(offset) < len(rows) {
+				e.Before = rows[offset]
+			}
+		}
+		e.Key = info.key(e.Before)
+		*events = append(*events, e)
+	}
+	return nil
+}
+
+func (info *tableInfo) key(row Row) Row {
+	if info.pk == "" || row == nil {
+		return nil
+	}
+	v, ok := row[info.pk]
+	if !ok {
+		return nil
+	}
+	return Row{info.pk: v}
+}
+
+// mergeUpdates merges a delete and a later insert of the same key in a
+// transaction into an update, at the position of the delete.
+func mergeUpdates(events []Event) []Event {
+	deletes := make(map[string]int)
+	for i, e := range events {
+		if e.Op == OpDelete && e.Key != nil {
+			deletes[keyString(e)] = i
+		}
+	}
+	if len(deletes) == 0 {
+		return events
+	}
+	merged := make([]Event, 0, len(events))
+	positions := make(map[int]int)
+	for i, e := range events {
+		if e.Op == OpInsert && e.Key != nil {
+			if d, ok := deletes[keyString(e)]; ok && d < i {
+				delete(deletes, keyString(e))
+				update := &merged[positions[d]]
+				update.Op = OpUpdate
+				update.After = e.After
+				update.RowID = e.RowID
+				continue
+			}
+		}
+		positions[i] = len(merged)
+		merged = append(merged, e)
+	}
+	return merged
+}
+
+func keyString(e Event) string {
+	return fmt.Sprintf("%d/%v", e.TableID, e.Key)
+}
+
+// makeRow returns the row of the batch, the row id column is skipped
+func makeRow(data *containers.Batch, i int) Row {
+	row := make(Row, len(data.Attrs))
+	for j, attr := range data.Attrs {
+		if attr == catalog.PhyAddrColumnName {
+			continue
+		}
+		row[attr] = rowValue(data.Vecs[j], i)
+	}
+	return row
+}
+
+func rowValue(vec containers.Vector, i int) any {
+	if vec.IsNull(i) {
+		return nil
+	}
+	typ := vec.GetType()
+	v := vec.Get(i)
+	switch val := v.(type) {
+	case []byte:
+		switch typ.Oid {
+		case types.T_json:
+			return types.DecodeJson(val).String()
+		case types.T_char, types.T_varchar, types.T_text:
+			return string(val)
+		}
+		return append([]byte(nil), val...)
+	case types.Decimal64:
+		return val.Format(typ.Scale)
+	case types.Decimal128:
+		return val.Format(typ.Scale)
+	case fmt.Stringer:
+		return val.String()
+	}
+	return v
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	logpb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+// Op is the operation of a change event
+type Op uint8
+
+const (
+	OpInsert Op = iota + 1
+	OpUpdate
+	OpDelete
+)
+
+func (op Op) String() string {
+	switch op {
+	case OpInsert:
+		return "insert"
+	case OpUpdate:
+		return "update"
+	case OpDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// Row is a row image, column name -> value
+type Row map[string]any
+
+// Event is a row level change of a table made by a committed transaction
+type Event struct {
+	Op         Op
+	DatabaseID uint64
+	TableID    uint64
+	Database   string
+	Table      string
+	TxnID      string
+	// CommitTS is the commit timestamp of the transaction
+	CommitTS types.TS
+	// Lsn is the lsn of the log record that the event is decoded from
+	Lsn uint64
+	// RowID is the row id of the deleted row, or of the inserted row
+	RowID types.Rowid
+	// Key is the primary key of the row, nil if it is unknown
+	Key Row
+	// Before is nil for inserts, and for deletes of rows that are not known
+	// by the decoder
+	Before Row
+	// After is nil for deletes
+	After Row
+}
+
+// Decoder decodes log records into change events
+type Decoder interface {
+	// Decode decodes the user record at lsn and returns the events of the
+	// transactions committed by it.
+	Decode(lsn uint64, rec logpb.LogRecord) ([]Event, error)
+	// SafeLsn returns the lsn to restart decoding from without losing the
+	// events of transactions not committed yet, next is the next lsn to read.
+	SafeLsn(next uint64) uint64
+}
+
+// Snapshotter reads the rows of the tables as of a timestamp, the initial
+// snapshot of a new consumer
+type Snapshotter interface {
+	// Snapshot calls fn on the insert events of the rows as of the returned
+	// timestamp, in batches.
+	Snapshot(ctx context.Context, fn func(events []Event) error) (types.TS, error)
+}
+
+// Encoder encodes events into messages
+type Encoder interface {
+	// Encode returns the key and the value of the message of the event
+	Encode(e Event) (key []byte, value []byte, err error)
+}
+
+// Sink receives the change events
+type Sink interface {
+	// Send sends events to the sink
+	Send(ctx context.Context, events []Event) error
+	// Flush makes the sent events durable
+	Flush(ctx context.Context) error
+	// Close closes the sink
+	Close() error
+}
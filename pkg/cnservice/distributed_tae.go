@@ -74,6 +74,9 @@ func (s *service) initDistributedTAE(
 	usePushModel := s.cfg.TurnOnPushModel
 	cnEngine := pu.StorageEngine.(*disttae.Engine)
 	cnEngine.SetPushModelFlag(usePushModel)
+	if s.cfg.ReadOnly.Enable {
+		cnEngine.SetUnusedTableTimeout(s.cfg.ReadOnly.UnusedTableTimeout.Duration)
+	}
 	if usePushModel {
 		logutil.Info("cn turn push model on.")
 		err = cnEngine.InitLogTailPushModel(
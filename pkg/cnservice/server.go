@@ -99,6 +99,7 @@ func NewService(
 
 	cfg.Frontend.SetDefaultValues()
 	cfg.Frontend.SetMaxMessageSize(uint64(cfg.RPC.MaxMessageSize))
+	cfg.Frontend.ReadOnly = cfg.ReadOnly.Enable
 	frontend.InitServerVersion(pu.SV.MoVersion)
 
 	// Init the autoIncrCacheManager after the default value is set before the init of moserver.
@@ -168,13 +169,28 @@ func (s *service) Start() error {
 	if err := s.startCNStoreHeartbeat(); err != nil {
 		return err
 	}
+	if err := s.stopper.RunNamedTask("cnservice-reload-hot-keys", s.reloadHotKeys); err != nil {
+		return err
+	}
+	if err := s.initReplicationService(); err != nil {
+		return err
+	}
+	if err := s.replication.Start(); err != nil {
+		return err
+	}
 	return s.server.Start()
 }
 
 func (s *service) Close() error {
 	defer logutil.LogClose(s.logger, "cnservice")()
 
+	s.saveHotKeys()
 	s.stopper.Stop()
+	if s.replication != nil {
+		if err := s.replication.Close(); err != nil {
+			return err
+		}
+	}
 	if err := s.stopFrontend(); err != nil {
 		return err
 	}
@@ -473,6 +489,11 @@ func (s *service) getTxnClient() (c client.TxnClient, err error) {
 			opts = append(opts,
 				client.WithEnableRefreshExpression())
 		}
+		if s.cfg.ReadOnly.Enable {
+			opts = append(opts,
+				client.WithSnapshotLag(s.cfg.ReadOnly.LogtailLag.Duration),
+				client.WithReadOnly())
+		}
 		opts = append(opts, client.WithLockService(s.lockService))
 		c = client.NewTxnClient(
 			sender,
@@ -0,0 +1,92 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/util/file"
+	"go.uber.org/zap"
+)
+
+func getHotKeysFile(uuid string) string {
+	return filepath.Join(metadataDir, uuid+".hot-keys")
+}
+
+func (s *service) getHotKeysFS() (fileservice.HotKeysFileService, bool) {
+	if s.cfg.Cache.HotKeys <= 0 || s.metadataFS == nil {
+		return nil, false
+	}
+	fs, err := fileservice.Get[fileservice.HotKeysFileService](s.fileService, defines.SharedFileServiceName)
+	if err != nil {
+		return nil, false
+	}
+	return fs, true
+}
+
+// saveHotKeys saves the hottest keys of the memory cache of the shared file service,
+// to be reloaded by the next start.
+func (s *service) saveHotKeys() {
+	fs, ok := s.getHotKeysFS()
+	if !ok {
+		return
+	}
+	keys := fs.HotKeys(s.cfg.Cache.HotKeys)
+	if err := file.WriteFile(s.metadataFS,
+		getHotKeysFile(s.cfg.UUID),
+		fileservice.EncodeHotKeys(keys)); err != nil {
+		s.logger.Error("save hot cache keys failed", zap.Error(err))
+		return
+	}
+	s.logger.Info("hot cache keys saved", zap.Int("keys", len(keys)))
+}
+
+// reloadHotKeys reads the objects of the saved hot keys into the caches
+func (s *service) reloadHotKeys(ctx context.Context) {
+	fs, ok := s.getHotKeysFS()
+	if !ok {
+		return
+	}
+	data, err := file.ReadFile(s.metadataFS, getHotKeysFile(s.cfg.UUID))
+	if err != nil {
+		s.logger.Error("read hot cache keys failed", zap.Error(err))
+		return
+	}
+	if len(data) == 0 {
+		return
+	}
+	keys, err := fileservice.DecodeHotKeys(data)
+	if err != nil {
+		s.logger.Error("decode hot cache keys failed", zap.Error(err))
+		return
+	}
+	start := time.Now()
+	n, err := fileservice.ReloadHotKeys(ctx, fs, keys, objectio.HotKeyConstructor)
+	if err != nil {
+		s.logger.Error("reload hot cache keys failed",
+			zap.Int("reloaded", n),
+			zap.Error(err))
+		return
+	}
+	s.logger.Info("hot cache keys reloaded",
+		zap.Int("keys", len(keys)),
+		zap.Int("reloaded", n),
+		zap.Duration("cost", time.Since(start)))
+}
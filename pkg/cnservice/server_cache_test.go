@@ -0,0 +1,86 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveAndReloadHotKeys(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "shared")
+	metadataFS, err := fileservice.NewMemoryFS(defines.LocalFileServiceName, fileservice.DisabledCacheConfig, nil)
+	assert.NoError(t, err)
+	newService := func() (*service, *fileservice.LocalFS) {
+		sharedFS, err := fileservice.NewLocalFS(defines.SharedFileServiceName, dir,
+			fileservice.CacheConfig{MemoryCapacity: 1 << 20}, nil)
+		assert.NoError(t, err)
+		sharedFS.SetAsyncUpdate(false)
+		fs, err := fileservice.NewFileServices(defines.SharedFileServiceName, sharedFS, metadataFS)
+		assert.NoError(t, err)
+		s := &service{logger: logutil.GetPanicLogger(), metadataFS: metadataFS, fileService: fs}
+		s.cfg = &Config{UUID: "cn1"}
+		s.cfg.Cache.HotKeys = 10
+		return s, sharedFS
+	}
+
+	s, sharedFS := newService()
+	mp := mpool.MustNewZero()
+	bat := testutil.NewBatch([]types.Type{types.T_int64.ToType(), types.T_varchar.ToType()}, false, 8192, mp)
+	defer bat.Clean(mp)
+	writer, err := objectio.NewObjectWriterSpecial(objectio.WriterNormal, "object", sharedFS)
+	assert.NoError(t, err)
+	_, err = writer.Write(bat)
+	assert.NoError(t, err)
+	blocks, err := writer.WriteEnd(ctx)
+	assert.NoError(t, err)
+	reader, err := objectio.NewObjectReaderWithStr("object", sharedFS)
+	assert.NoError(t, err)
+	metaExt := blocks[0].GetExtent()
+	reader.CacheMetaExtent(&metaExt)
+	vec, err := reader.ReadOneBlock(ctx, []uint16{0, 1}, []types.Type{types.T_int64.ToType(), types.T_varchar.ToType()}, nil, 0, mp)
+	assert.NoError(t, err)
+	origin := vec.Entries[0].ObjectBytes
+	saved := sharedFS.HotKeys(-1)
+	// the meta and the two columns
+	assert.Equal(t, 3, len(saved))
+	s.saveHotKeys()
+
+	s, sharedFS = newService()
+	assert.Empty(t, sharedFS.HotKeys(-1))
+	s.reloadHotKeys(ctx)
+	// the object header is cached too by looking up the compression of the keys
+	keys := sharedFS.HotKeys(-1)
+	for _, key := range saved {
+		assert.Contains(t, keys, key)
+	}
+	reader, err = objectio.NewObjectReaderWithStr("object", sharedFS)
+	assert.NoError(t, err)
+	reader.CacheMetaExtent(&metaExt)
+	vec, err = reader.ReadOneBlock(ctx, []uint16{0}, []types.Type{types.T_int64.ToType()}, nil, 0, mp)
+	assert.NoError(t, err)
+	assert.Equal(t, origin, vec.Entries[0].ObjectBytes)
+}
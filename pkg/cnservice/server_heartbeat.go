@@ -72,6 +72,7 @@ func (s *service) heartbeat(ctx context.Context) {
 		CtlAddress:         s.cfg.Ctl.Address.ServiceAddress,
 		Role:               s.metadata.Role,
 		TaskServiceCreated: s.GetTaskRunner() != nil,
+		ReadOnly:           s.cfg.ReadOnly.Enable,
 	}
 	cb, err := s._hakeeperClient.SendCNHeartbeat(ctx2, hb)
 	if err != nil {
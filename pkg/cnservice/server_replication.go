@@ -0,0 +1,103 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cnservice
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/replication"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+func (s *service) initReplicationService() error {
+	pu := config.NewParameterUnit(
+		&s.cfg.Frontend,
+		nil,
+		nil,
+		nil)
+	pu.StorageEngine = s.storeEngine
+	pu.TxnClient = s._txnClient
+	pu.FileService = s.fileService
+	pu.LockService = s.lockService
+	ieFactory := func() ie.InternalExecutor {
+		return frontend.NewInternalExecutor(pu, s.mo.GetRoutineManager().GetAutoIncrCacheManager())
+	}
+
+	// the blocks written by the CNs are read from the shared file service
+	fs, err := fileservice.Get[fileservice.FileService](s.fileService, defines.SharedFileServiceName)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), config.ParameterUnitKey, pu)
+	s.replication = replication.NewService(
+		s.cfg.Replication,
+		replication.NewInternalExecutor(ieFactory),
+		replication.WithLogger(s.logger),
+		replication.WithPublisher(
+			replication.NewSQLSource(ieFactory),
+			replication.NewSQLChangeStore(ieFactory),
+			replication.NewSQLTableResolver(ctx, ieFactory),
+			s.newReplicationLogClient,
+			cdc.WithFileService(fs),
+			cdc.WithSchemaResolver(replication.NewEngineSchemaResolver(ctx, s.storeEngine, s._txnClient))))
+	runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.ReplicationService, s.replication)
+	return nil
+}
+
+// newReplicationLogClient creates a read-only client of the log shard of the
+// DN shard, the publisher reads the committed changes from the log shard.
+func (s *service) newReplicationLogClient(ctx context.Context) (logservice.Client, error) {
+	hakeeper, err := s.getHAKeeperClient()
+	if err != nil {
+		return nil, err
+	}
+	state, err := hakeeper.GetClusterState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(state.ClusterInfo.DNShards) == 0 {
+		return nil, moerr.NewInvalidState(ctx, "no dn shard")
+	}
+	shard := state.ClusterInfo.DNShards[0]
+	var replicaID uint64
+	for _, store := range state.DNState.Stores {
+		for _, info := range store.Shards {
+			if info.ShardID == shard.ShardID {
+				replicaID = info.ReplicaID
+			}
+		}
+	}
+	if replicaID == 0 {
+		return nil, moerr.NewInvalidState(ctx, "no replica of dn shard %d", shard.ShardID)
+	}
+	return logservice.NewClient(ctx, logservice.ClientConfig{
+		Tag:              "replication",
+		ReadOnly:         true,
+		LogShardID:       shard.LogShardID,
+		DNReplicaID:      replicaID,
+		DiscoveryAddress: s.cfg.HAKeeper.ClientConfig.DiscoveryAddress,
+		ServiceAddresses: s.cfg.HAKeeper.ClientConfig.ServiceAddresses,
+		MaxMessageSize:   int(s.cfg.RPC.MaxMessageSize),
+	})
+}
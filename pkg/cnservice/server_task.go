@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	"github.com/matrixorigin/matrixone/pkg/util/export"
 	"github.com/matrixorigin/matrixone/pkg/util/file"
@@ -72,6 +73,8 @@ func (s *service) initTaskServiceHolder() {
 				return s.task.storageFactory
 			})
 	}
+	// the tasks are created by the statements, e.g. alter table
+	runtime.ProcessLevelRuntime().SetGlobalVariables(runtime.TaskService, s.task.holder)
 
 	if err := s.stopper.RunTask(s.waitSystemInitCompleted); err != nil {
 		panic(err)
@@ -87,7 +90,39 @@ func (s *service) createTaskService(command *logservicepb.CreateTaskService) {
 		s.logger.Error("create task service failed", zap.Error(err))
 		return
 	}
+	// the read-only cn can not commit anything, so it neither runs the tasks
+	// nor upgrades the system tables
+	if s.cfg.ReadOnly.Enable {
+		return
+	}
 	s.startTaskRunner()
+	if err := s.stopper.RunNamedTask("cnservice-upgrade-system-tables", s.upgradeSystemTables); err != nil {
+		s.logger.Error("start upgrade system tables task failed", zap.Error(err))
+	}
+}
+
+// upgradeSystemTables upgrades the system tables created by an older version.
+// it runs on every start after the system init, which runs once for the cluster.
+// all cns do it, the upgrades are idempotent
+func (s *service) upgradeSystemTables(ctx context.Context) {
+	s.waitSystemInitCompleted(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+
+	pu := config.NewParameterUnit(
+		&s.cfg.Frontend,
+		nil,
+		nil,
+		nil)
+	pu.StorageEngine = s.storeEngine
+	pu.TxnClient = s._txnClient
+	pu.FileService = s.fileService
+	pu.LockService = s.lockService
+	ctx = context.WithValue(ctx, config.ParameterUnitKey, pu)
+	if err := frontend.UpgradeTablesInSystem(ctx, s.mo.GetRoutineManager().GetAutoIncrCacheManager()); err != nil {
+		s.logger.Error("upgrade system tables failed", zap.Error(err))
+	}
 }
 
 func (s *service) startTaskRunner() {
@@ -255,4 +290,7 @@ func (s *service) registerExecutorsLocked() {
 	// init metric task
 	s.task.runner.RegisterExecutor(task.TaskCode_MetricStorageUsage,
 		metric.GetMetricStorageUsageExecutor(ieFactory))
+	// init the executor converting the columns changed by alter table
+	s.task.runner.RegisterExecutor(task.TaskCode_ConvertColumn,
+		compile.ConvertColumnExecutor(s.storeEngine, s._txnClient, s.fileService))
 }
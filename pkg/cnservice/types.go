@@ -34,6 +34,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/replication"
 	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/rpc"
@@ -46,6 +47,9 @@ import (
 var (
 	defaultListenAddress    = "127.0.0.1:6002"
 	defaultCtlListenAddress = "127.0.0.1:19958"
+	defaultHotKeys          = 65536
+	defaultReadOnlyLag      = time.Second
+	defaultReadOnlyUnused   = time.Minute * 5
 	// TODO(fagongzi): make rc and pessimistic as default
 	defaultTxnIsolation = txn.TxnIsolation_SI
 	defaultTxnMode      = txn.TxnMode_Optimistic
@@ -183,6 +187,33 @@ type Config struct {
 
 	// Ctl ctl service config. CtlService is used to handle ctl request. See mo_ctl for detail.
 	Ctl ctlservice.Config `toml:"ctl"`
+
+	// Cache cache config of the cn
+	Cache struct {
+		// HotKeys is the max number of memory cache keys saved at shutdown, and reloaded
+		// in background at startup. Default is 65536, negative value to disable.
+		HotKeys int `toml:"hot-keys"`
+	} `toml:"cache"`
+
+	// ReadOnly read-only mode of the cn. The read-only cn only executes the read-only
+	// statements with its own caches, and never commits anything to DN. It is labeled
+	// with "read-only" in HAKeeper, so the proxy routes the read-only sessions to it.
+	ReadOnly struct {
+		// Enable enable the read-only mode
+		Enable bool `toml:"enable"`
+		// LogtailLag is the lag of the snapshot timestamp of the transactions, so the
+		// transactions do not need to wait for the latest logtail. Default is 1s.
+		LogtailLag toml.Duration `toml:"logtail-lag"`
+		// UnusedTableTimeout is how long a table is not read before its log tail is
+		// unsubscribed, so the cn only receives the log tail of the tables being read.
+		// Default is 5m.
+		UnusedTableTimeout toml.Duration `toml:"unused-table-timeout"`
+	} `toml:"read-only"`
+
+	// Replication replication config of the cn. The cn publishes the publications to
+	// the remote subscriptions of other clusters, and replicates the remote subscriptions
+	// of the cluster if the subscriber is enabled.
+	Replication replication.Config `toml:"replication"`
 }
 
 func (c *Config) Validate() error {
@@ -234,6 +265,15 @@ func (c *Config) Validate() error {
 	if c.TaskRunner.RetryInterval.Duration == 0 {
 		c.TaskRunner.RetryInterval.Duration = time.Second
 	}
+	if c.Cache.HotKeys == 0 {
+		c.Cache.HotKeys = defaultHotKeys
+	}
+	if c.ReadOnly.Enable && c.ReadOnly.LogtailLag.Duration == 0 {
+		c.ReadOnly.LogtailLag.Duration = defaultReadOnlyLag
+	}
+	if c.ReadOnly.Enable && c.ReadOnly.UnusedTableTimeout.Duration == 0 {
+		c.ReadOnly.UnusedTableTimeout.Duration = defaultReadOnlyUnused
+	}
 	if c.Engine.Type == "" {
 		c.Engine.Type = EngineDistributedTAE
 	}
@@ -300,6 +340,7 @@ type service struct {
 	moCluster              clusterservice.MOCluster
 	lockService            lockservice.LockService
 	ctlservice             ctlservice.CtlService
+	replication            *replication.Service
 
 	stopper *stopper.Stopper
 	aicm    *defines.AutoIncrCacheManager
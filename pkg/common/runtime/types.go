@@ -28,6 +28,10 @@ const (
 	LockService = "lock-service"
 	// CtlService ctl service
 	CtlService = "ctl-service"
+	// ReplicationService replication service
+	ReplicationService = "replication-service"
+	// TaskService task service holder
+	TaskService = "task-service"
 	// TxnOptions options used to create txn
 	TxnOptions = "txn-options"
 	// TxnMode runtime default txn mode
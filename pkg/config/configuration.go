@@ -310,8 +310,23 @@ type FrontendParameters struct {
 	// is needed, such as update the salt.
 	ProxyEnabled bool `toml:"proxy-enabled"`
 
+	// ProxySecret is shared with the proxy, which sends a tag of the salt
+	// made by the secret to prove the connection comes from it. The TLS
+	// information of the clients sent by the proxy is trusted only if the
+	// tag is verified.
+	ProxySecret string `toml:"proxy-secret"`
+
 	// SkipCheckPrivilege denotes the privilege check should be passed.
 	SkipCheckPrivilege bool `toml:"skipCheckPrivilege"`
+
+	// ReadOnly denotes only the read-only statements can be executed, it is set
+	// on the read-only cn.
+	ReadOnly bool `toml:"read-only"`
+
+	// PlanCacheReplan denotes a custom plan is built for the execution of the
+	// prepared statement, if the selectivity of the range filters with the
+	// bound values is far from the one of the cached plan.
+	PlanCacheReplan bool `toml:"plan-cache-replan"`
 }
 
 func (fp *FrontendParameters) SetDefaultValues() {
@@ -102,6 +102,11 @@ type Config struct {
 		GlobalMinCount      int64         `toml:"global-min-count"`
 	}
 
+	GC struct {
+		// PITRWindow is how long history is retained for point-in-time recovery
+		PITRWindow toml.Duration `toml:"pitr-window"`
+	}
+
 	LogtailServer struct {
 		ListenAddress              string        `toml:"listen-address"`
 		ServiceAddress             string        `toml:"service-address"`
@@ -134,6 +134,9 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		IncrementalInterval: s.cfg.Ckp.IncrementalInterval.Duration,
 		GlobalMinCount:      s.cfg.Ckp.GlobalMinCount,
 	}
+	gcCfg := &options.GCCfg{
+		PITRWindow: s.cfg.GC.PITRWindow.Duration,
+	}
 	logtailServerAddr := s.cfg.LogtailServer.ListenAddress
 	logtailServerCfg := &options.LogtailServerCfg{
 		RpcMaxMessageSize:        int64(s.cfg.LogtailServer.RpcMaxMessageSize),
@@ -157,6 +160,7 @@ func (s *store) newTAEStorage(shard metadata.DNShard, factory logservice.ClientF
 		fs,
 		s.rt,
 		ckpcfg,
+		gcCfg,
 		logtailServerAddr,
 		logtailServerCfg,
 		options.LogstoreType(s.cfg.Txn.Storage.LogBackend))
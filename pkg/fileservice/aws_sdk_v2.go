@@ -0,0 +1,491 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	stdhttp "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+)
+
+// AwsSDKv2 is an ObjectStorage implementation of S3 and the S3 compatible
+// services, using the AWS SDK
+type AwsSDKv2 struct {
+	client      *s3.Client
+	bucket      string
+	listMaxKeys int32
+	// the service does not support multi-object delete, like GCS
+	singleDelete bool
+}
+
+var _ ObjectStorage = new(AwsSDKv2)
+
+type awsArguments struct {
+	endpoint            string
+	region              string
+	bucket              string
+	apiKey              string
+	apiSecret           string
+	roleARN             string
+	externalID          string
+	sharedConfigProfile string
+	isMinio             bool
+	singleDelete        bool
+}
+
+func newAwsSDKv2(ctx context.Context, args awsArguments) (*AwsSDKv2, error) {
+	endpoint := args.endpoint
+	region := args.region
+	bucket := args.bucket
+
+	if endpoint != "" {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if u.Scheme == "" {
+			u.Scheme = "https"
+		}
+		endpoint = u.String()
+	}
+
+	if region == "" {
+		// try to get region from bucket
+		resp, err := stdhttp.Head("https://" + bucket + ".s3.amazonaws.com")
+		if err == nil {
+			if value := resp.Header.Get("x-amz-bucket-region"); value != "" {
+				region = value
+			}
+		}
+	}
+
+	var credentialProvider aws.CredentialsProvider
+
+	loadConfigOptions := []func(*config.LoadOptions) error{
+		config.WithLogger(logutil.GetS3Logger()),
+		config.WithClientLogMode(
+			aws.LogSigning |
+				aws.LogRetries |
+				aws.LogRequest |
+				aws.LogResponse |
+				aws.LogDeprecatedUsage |
+				aws.LogRequestEventMessage |
+				aws.LogResponseEventMessage,
+		),
+	}
+	if args.sharedConfigProfile != "" {
+		loadConfigOptions = append(loadConfigOptions,
+			config.WithSharedConfigProfile(args.sharedConfigProfile),
+		)
+	}
+
+	if args.apiKey != "" && args.apiSecret != "" {
+		// static
+		credentialProvider = credentials.NewStaticCredentialsProvider(args.apiKey, args.apiSecret, "")
+	}
+
+	if args.roleARN != "" {
+		// role arn
+		awsConfig, err := config.LoadDefaultConfig(ctx, loadConfigOptions...)
+		if err != nil {
+			return nil, err
+		}
+
+		stsSvc := sts.NewFromConfig(awsConfig, func(options *sts.Options) {
+			if region == "" {
+				options.Region = "ap-northeast-1"
+			} else {
+				options.Region = region
+			}
+		})
+		credentialProvider = stscreds.NewAssumeRoleProvider(
+			stsSvc,
+			args.roleARN,
+			func(opts *stscreds.AssumeRoleOptions) {
+				if args.externalID != "" {
+					opts.ExternalID = &args.externalID
+				}
+			},
+		)
+		// validate
+		_, err = credentialProvider.Retrieve(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if credentialProvider != nil {
+		credentialProvider = aws.NewCredentialsCache(credentialProvider)
+	}
+
+	if credentialProvider != nil {
+		loadConfigOptions = append(loadConfigOptions,
+			config.WithCredentialsProvider(
+				credentialProvider,
+			),
+		)
+	}
+	config, err := config.LoadDefaultConfig(ctx, loadConfigOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	s3Options := []func(*s3.Options){}
+
+	if credentialProvider != nil {
+		s3Options = append(s3Options,
+			func(opt *s3.Options) {
+				opt.Credentials = credentialProvider
+			},
+		)
+	}
+
+	if endpoint != "" {
+		if args.isMinio {
+			// for minio
+			s3Options = append(s3Options,
+				s3.WithEndpointResolver(
+					s3.EndpointResolverFunc(
+						func(
+							region string,
+							_ s3.EndpointResolverOptions,
+						) (
+							ep aws.Endpoint,
+							err error,
+						) {
+							ep.URL = endpoint
+							ep.Source = aws.EndpointSourceCustom
+							ep.HostnameImmutable = true
+							ep.SigningRegion = region
+							return
+						},
+					),
+				),
+			)
+		} else {
+			s3Options = append(s3Options,
+				s3.WithEndpointResolver(
+					s3.EndpointResolverFromURL(endpoint),
+				),
+			)
+		}
+	}
+
+	if region != "" {
+		s3Options = append(s3Options,
+			func(opt *s3.Options) {
+				opt.Region = region
+			},
+		)
+	}
+
+	client := s3.NewFromConfig(
+		config,
+		s3Options...,
+	)
+
+	_, err = client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: ptrTo(bucket),
+	})
+	if err != nil {
+		return nil, moerr.NewInternalErrorNoCtx("bad s3 config: %v", err)
+	}
+
+	return &AwsSDKv2{
+		client:       client,
+		bucket:       bucket,
+		singleDelete: args.singleDelete,
+	}, nil
+}
+
+func (a *AwsSDKv2) List(
+	ctx context.Context,
+	prefix string,
+	fn func(bool, string, int64) (bool, error),
+) error {
+	var marker *string
+	for {
+		output, err := a.client.ListObjects(
+			ctx,
+			&s3.ListObjectsInput{
+				Bucket:    ptrTo(a.bucket),
+				Delimiter: ptrTo("/"),
+				Prefix:    ptrTo(prefix),
+				Marker:    marker,
+				MaxKeys:   a.listMaxKeys,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range output.Contents {
+			more, err := fn(false, *obj.Key, obj.Size)
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		for _, prefix := range output.CommonPrefixes {
+			more, err := fn(true, *prefix.Prefix, 0)
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		if !output.IsTruncated {
+			break
+		}
+		marker = output.NextMarker
+	}
+	return nil
+}
+
+func (a *AwsSDKv2) Stat(ctx context.Context, key string) (int64, error) {
+	output, err := a.client.HeadObject(
+		ctx,
+		&s3.HeadObjectInput{
+			Bucket: ptrTo(a.bucket),
+			Key:    ptrTo(key),
+		},
+	)
+	if err != nil {
+		return 0, a.mapError(err, key)
+	}
+	return output.ContentLength, nil
+}
+
+func (a *AwsSDKv2) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := a.Stat(ctx, key)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *AwsSDKv2) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	expire *time.Time,
+) error {
+	_, err := a.client.PutObject(
+		ctx,
+		&s3.PutObjectInput{
+			Bucket:        ptrTo(a.bucket),
+			Key:           ptrTo(key),
+			Body:          r,
+			ContentLength: size,
+			Expires:       expire,
+		},
+	)
+	return err
+}
+
+func (a *AwsSDKv2) Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
+	r, err := newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			var rang string
+			if max >= 0 {
+				rang = fmt.Sprintf("bytes=%d-%d", offset, max-1)
+			} else {
+				rang = fmt.Sprintf("bytes=%d-", offset)
+			}
+			output, err := a.client.GetObject(ctx, &s3.GetObjectInput{
+				Bucket: ptrTo(a.bucket),
+				Key:    ptrTo(key),
+				Range:  &rang,
+			})
+			if err != nil {
+				return nil, a.mapError(err, key)
+			}
+			return output.Body, nil
+		},
+		min,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if max >= 0 {
+		return &readCloser{
+			r:         io.LimitReader(r, max-min),
+			closeFunc: r.Close,
+		}, nil
+	}
+	return r, nil
+}
+
+func (a *AwsSDKv2) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if len(keys) == 1 || a.singleDelete {
+		for _, key := range keys {
+			_, err := a.client.DeleteObject(
+				ctx,
+				&s3.DeleteObjectInput{
+					Bucket: ptrTo(a.bucket),
+					Key:    ptrTo(key),
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	objs := make([]types.ObjectIdentifier, 0, 1000)
+	for _, key := range keys {
+		objs = append(objs, types.ObjectIdentifier{Key: ptrTo(key)})
+		if len(objs) == 1000 {
+			if err := a.deleteMultiObj(ctx, objs); err != nil {
+				return err
+			}
+			objs = objs[:0]
+		}
+	}
+	if err := a.deleteMultiObj(ctx, objs); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *AwsSDKv2) deleteMultiObj(ctx context.Context, objs []types.ObjectIdentifier) error {
+	output, err := a.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+		Bucket: ptrTo(a.bucket),
+		Delete: &types.Delete{
+			Objects: objs,
+			// In quiet mode the response includes only keys where the delete action encountered an error.
+			Quiet: true,
+		},
+	})
+	// delete api failed
+	if err != nil {
+		return err
+	}
+	// delete api success, but with delete file failed.
+	message := strings.Builder{}
+	if len(output.Errors) > 0 {
+		for _, Error := range output.Errors {
+			if *Error.Code == (*types.NoSuchKey)(nil).ErrorCode() {
+				continue
+			}
+			message.WriteString(fmt.Sprintf("%s: %s, %s;", *Error.Key, *Error.Code, *Error.Message))
+		}
+	}
+	if message.Len() > 0 {
+		return moerr.NewInternalErrorNoCtx("S3 Delete failed: %s", message.String())
+	}
+	return nil
+}
+
+func (a *AwsSDKv2) NewMultipartWriter(ctx context.Context, key string, expire *time.Time) (MultipartWriter, error) {
+	output, err := a.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:  ptrTo(a.bucket),
+		Key:     ptrTo(key),
+		Expires: expire,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &awsMultipartWriter{
+		sdk:      a,
+		key:      key,
+		uploadID: output.UploadId,
+	}, nil
+}
+
+type awsMultipartWriter struct {
+	sdk      *AwsSDKv2
+	key      string
+	uploadID *string
+	parts    []types.CompletedPart
+}
+
+func (w *awsMultipartWriter) WritePart(ctx context.Context, data []byte) error {
+	partNumber := int32(len(w.parts) + 1)
+	output, err := w.sdk.client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:        ptrTo(w.sdk.bucket),
+		Key:           ptrTo(w.key),
+		UploadId:      w.uploadID,
+		PartNumber:    partNumber,
+		Body:          bytes.NewReader(data),
+		ContentLength: int64(len(data)),
+	})
+	if err != nil {
+		return err
+	}
+	w.parts = append(w.parts, types.CompletedPart{
+		ETag:       output.ETag,
+		PartNumber: partNumber,
+	})
+	return nil
+}
+
+func (w *awsMultipartWriter) Complete(ctx context.Context) error {
+	_, err := w.sdk.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   ptrTo(w.sdk.bucket),
+		Key:      ptrTo(w.key),
+		UploadId: w.uploadID,
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: w.parts,
+		},
+	})
+	return err
+}
+
+func (w *awsMultipartWriter) Abort(ctx context.Context) error {
+	_, err := w.sdk.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   ptrTo(w.sdk.bucket),
+		Key:      ptrTo(w.key),
+		UploadId: w.uploadID,
+	})
+	return err
+}
+
+func (a *AwsSDKv2) mapError(err error, key string) error {
+	if err == nil {
+		return nil
+	}
+	var httpError *http.ResponseError
+	if errors.As(err, &httpError) {
+		if httpError.Response.StatusCode == 404 {
+			return moerr.NewFileNotFoundNoCtx(key)
+		}
+	}
+	return err
+}
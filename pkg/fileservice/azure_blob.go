@@ -0,0 +1,385 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const azureAPIVersion = "2020-10-02"
+
+// the shared key of the storage account is read from this environment variable
+const azureStorageKeyEnv = "AZURE_STORAGE_KEY"
+
+// AzureConfig azure blob storage config
+type AzureConfig struct {
+	// Endpoint defaults to https://<Account>.blob.core.windows.net
+	Endpoint  string `toml:"endpoint"`
+	Account   string `toml:"account"`
+	Container string `toml:"container"`
+	// KeyPrefix enables multiple fs instances in one container
+	KeyPrefix string `toml:"key-prefix"`
+}
+
+// AzureBlob is an ObjectStorage implementation of Azure Blob Storage,
+// using the REST API with shared key authorization
+type AzureBlob struct {
+	client    *http.Client
+	endpoint  string
+	account   string
+	key       []byte
+	container string
+}
+
+var _ ObjectStorage = new(AzureBlob)
+
+// NewAzureBlob creates an AzureBlob client
+// endpoint defaults to https://<account>.blob.core.windows.net
+// accountKey is the base64 encoded shared key of the account
+func NewAzureBlob(endpoint, account, accountKey, container string) (*AzureBlob, error) {
+	if account == "" || container == "" {
+		return nil, moerr.NewInvalidInputNoCtx("azure account and container are required")
+	}
+	key, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return nil, moerr.NewInvalidInputNoCtx("bad azure account key: %v", err)
+	}
+	if endpoint == "" {
+		endpoint = "https://" + account + ".blob.core.windows.net"
+	}
+	return &AzureBlob{
+		client:    &http.Client{},
+		endpoint:  strings.TrimRight(endpoint, "/"),
+		account:   account,
+		key:       key,
+		container: container,
+	}, nil
+}
+
+type azureListResult struct {
+	Blobs struct {
+		Blob []struct {
+			Name       string `xml:"Name"`
+			Properties struct {
+				ContentLength int64 `xml:"Content-Length"`
+			} `xml:"Properties"`
+		} `xml:"Blob"`
+		BlobPrefix []struct {
+			Name string `xml:"Name"`
+		} `xml:"BlobPrefix"`
+	} `xml:"Blobs"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+func (a *AzureBlob) List(
+	ctx context.Context,
+	prefix string,
+	fn func(bool, string, int64) (bool, error),
+) error {
+	marker := ""
+	for {
+		query := url.Values{
+			"restype":   {"container"},
+			"comp":      {"list"},
+			"prefix":    {prefix},
+			"delimiter": {"/"},
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := a.do(ctx, http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return err
+		}
+		var result azureListResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, blob := range result.Blobs.Blob {
+			more, err := fn(false, blob.Name, blob.Properties.ContentLength)
+			if err != nil || !more {
+				return err
+			}
+		}
+		for _, prefix := range result.Blobs.BlobPrefix {
+			more, err := fn(true, prefix.Name, 0)
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		if result.NextMarker == "" {
+			break
+		}
+		marker = result.NextMarker
+	}
+	return nil
+}
+
+func (a *AzureBlob) Stat(ctx context.Context, key string) (int64, error) {
+	resp, err := a.do(ctx, http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.ContentLength, nil
+}
+
+func (a *AzureBlob) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := a.Stat(ctx, key)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *AzureBlob) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	expire *time.Time,
+) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && int64(len(data)) != size {
+		return moerr.NewSizeNotMatchNoCtx(key)
+	}
+	resp, err := a.do(ctx, http.MethodPut, key, nil, http.Header{
+		"x-ms-blob-type": {"BlockBlob"},
+		// never overwrite
+		"If-None-Match": {"*"},
+	}, data)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (a *AzureBlob) Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
+	var rang string
+	if max >= 0 {
+		rang = fmt.Sprintf("bytes=%d-%d", min, max-1)
+	} else {
+		rang = fmt.Sprintf("bytes=%d-", min)
+	}
+	resp, err := a.do(ctx, http.MethodGet, key, nil, http.Header{
+		"x-ms-range": {rang},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (a *AzureBlob) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		resp, err := a.do(ctx, http.MethodDelete, key, nil, nil, nil)
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+	}
+	return nil
+}
+
+func (a *AzureBlob) NewMultipartWriter(ctx context.Context, key string, expire *time.Time) (MultipartWriter, error) {
+	return &azureMultipartWriter{
+		blob:   a,
+		key:    key,
+		prefix: uuid.New().String(),
+	}, nil
+}
+
+type azureMultipartWriter struct {
+	blob     *AzureBlob
+	key      string
+	prefix   string
+	blockIDs []string
+}
+
+func (w *azureMultipartWriter) WritePart(ctx context.Context, data []byte) error {
+	// block ids of a blob must be of the same length
+	blockID := base64.StdEncoding.EncodeToString(
+		[]byte(fmt.Sprintf("%s-%08d", w.prefix, len(w.blockIDs))),
+	)
+	resp, err := w.blob.do(ctx, http.MethodPut, w.key, url.Values{
+		"comp":    {"block"},
+		"blockid": {blockID},
+	}, nil, data)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	w.blockIDs = append(w.blockIDs, blockID)
+	return nil
+}
+
+func (w *azureMultipartWriter) Complete(ctx context.Context) error {
+	buf := new(bytes.Buffer)
+	buf.WriteString(`<?xml version="1.0" encoding="utf-8"?><BlockList>`)
+	for _, id := range w.blockIDs {
+		buf.WriteString("<Latest>" + id + "</Latest>")
+	}
+	buf.WriteString("</BlockList>")
+	resp, err := w.blob.do(ctx, http.MethodPut, w.key, url.Values{
+		"comp": {"blocklist"},
+	}, http.Header{
+		"If-None-Match": {"*"},
+	}, buf.Bytes())
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (w *azureMultipartWriter) Abort(ctx context.Context) error {
+	// uncommitted blocks are garbage collected by the service
+	return nil
+}
+
+func (a *AzureBlob) do(
+	ctx context.Context,
+	method string,
+	key string,
+	query url.Values,
+	header http.Header,
+	body []byte,
+) (*http.Response, error) {
+	path := "/" + a.container
+	if key != "" {
+		path += "/" + key
+	}
+	u, err := url.Parse(a.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = strings.TrimRight(u.Path, "/") + path
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range header {
+		req.Header[http.CanonicalHeaderKey(k)] = vs
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("Authorization", "SharedKey "+a.account+":"+a.sign(req, u.Path, query))
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return nil, moerr.NewFileNotFoundNoCtx(key)
+		case http.StatusConflict, http.StatusPreconditionFailed:
+			if method == http.MethodPut {
+				return nil, moerr.NewFileAlreadyExistsNoCtx(key)
+			}
+		case http.StatusRequestedRangeNotSatisfiable:
+			return nil, moerr.NewEmptyRangeNoCtx(key)
+		}
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, moerr.NewInternalErrorNoCtx("azure blob %s %s: %s %s", method, key, resp.Status, msg)
+	}
+	return resp, nil
+}
+
+// sign returns the shared key signature of the request
+func (a *AzureBlob) sign(req *http.Request, path string, query url.Values) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+	headers := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		if k := strings.ToLower(k); strings.HasPrefix(k, "x-ms-") {
+			headers = append(headers, k)
+		}
+	}
+	sort.Strings(headers)
+
+	buf := new(strings.Builder)
+	for _, s := range []string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		"", // Date, x-ms-date is used
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+	} {
+		buf.WriteString(s)
+		buf.WriteByte('\n')
+	}
+	for _, k := range headers {
+		buf.WriteString(k + ":" + strings.TrimSpace(req.Header.Get(k)) + "\n")
+	}
+	buf.WriteString("/" + a.account + path)
+	names := make([]string, 0, len(query))
+	for k := range query {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		buf.WriteString("\n" + strings.ToLower(k) + ":" + strings.Join(values, ","))
+	}
+
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(buf.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
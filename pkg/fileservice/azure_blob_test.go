@@ -0,0 +1,244 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAzureBlobServer is an in-memory Azure Blob Storage server for tests
+type fakeAzureBlobServer struct {
+	verifier *AzureBlob
+	mu       sync.Mutex
+	blobs    map[string][]byte
+	blocks   map[string][]byte
+}
+
+func newFakeAzureBlobServer(t *testing.T, account string, key string) *httptest.Server {
+	verifier, err := NewAzureBlob("http://localhost", account, key, "container")
+	assert.Nil(t, err)
+	fake := &fakeAzureBlobServer{
+		verifier: verifier,
+		blobs:    make(map[string][]byte),
+		blocks:   make(map[string][]byte),
+	}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (f *fakeAzureBlobServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	expected := "SharedKey " + f.verifier.account + ":" + f.verifier.sign(r, r.URL.Path, query)
+	if r.Header.Get("Authorization") != expected {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	switch r.Method {
+
+	case http.MethodGet:
+		if query.Get("comp") == "list" {
+			f.list(w, query)
+			return
+		}
+		data, ok := f.blobs[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var min, max int
+		rang := strings.TrimPrefix(r.Header.Get("x-ms-range"), "bytes=")
+		start, end, _ := strings.Cut(rang, "-")
+		min, _ = strconv.Atoi(start)
+		max = len(data)
+		if end != "" {
+			n, _ := strconv.Atoi(end)
+			if n+1 < max {
+				max = n + 1
+			}
+		}
+		if min >= len(data) {
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = w.Write(data[min:max])
+
+	case http.MethodHead:
+		data, ok := f.blobs[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		switch query.Get("comp") {
+		case "block":
+			f.blocks[key+"/"+query.Get("blockid")] = body
+			w.WriteHeader(http.StatusCreated)
+			return
+		case "blocklist":
+			var list struct {
+				Latest []string `xml:"Latest"`
+			}
+			if err := xml.Unmarshal(body, &list); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			body = body[:0]
+			for _, id := range list.Latest {
+				block, ok := f.blocks[key+"/"+id]
+				if !ok {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				body = append(body, block...)
+			}
+		}
+		if _, ok := f.blobs[key]; ok && r.Header.Get("If-None-Match") == "*" {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		f.blobs[key] = body
+		w.WriteHeader(http.StatusCreated)
+
+	case http.MethodDelete:
+		if _, ok := f.blobs[key]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.blobs, key)
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+// list returns at most 2 entries in a page, to test paging
+func (f *fakeAzureBlobServer) list(w http.ResponseWriter, query map[string][]string) {
+	get := func(k string) string {
+		if len(query[k]) == 0 {
+			return ""
+		}
+		return query[k][0]
+	}
+	prefix, delimiter, marker := get("prefix"), get("delimiter"), get("marker")
+
+	var names []string
+	seen := make(map[string]bool)
+	for name := range f.blobs {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if i := strings.Index(name[len(prefix):], delimiter); delimiter != "" && i >= 0 {
+			name = name[:len(prefix)+i+1]
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	buf := new(strings.Builder)
+	buf.WriteString(`<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+	n := 0
+	more := false
+	for _, name := range names {
+		if marker != "" && name <= marker {
+			continue
+		}
+		if n == 2 {
+			more = true
+			break
+		}
+		n++
+		marker = name
+		if strings.HasSuffix(name, delimiter) {
+			fmt.Fprintf(buf, "<BlobPrefix><Name>%s</Name></BlobPrefix>", name)
+		} else {
+			fmt.Fprintf(buf, "<Blob><Name>%s</Name><Properties><Content-Length>%d</Content-Length></Properties></Blob>",
+				name, len(f.blobs[name]))
+		}
+	}
+	buf.WriteString("</Blobs>")
+	if more {
+		// the marker is the last returned name in this fake server
+		fmt.Fprintf(buf, "<NextMarker>%s</NextMarker>", marker)
+	}
+	buf.WriteString("</EnumerationResults>")
+	_, _ = w.Write([]byte(buf.String()))
+}
+
+func TestAzureBlobFS(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef"))
+	server := newFakeAzureBlobServer(t, "account", key)
+
+	t.Run("file service", func(t *testing.T) {
+		testFileService(t, func(name string) FileService {
+			storage, err := NewAzureBlob(server.URL, "account", key, "container")
+			assert.Nil(t, err)
+			return newObjectStorageFS(name, storage, uuid.New().String())
+		})
+	})
+
+	t.Run("bad key", func(t *testing.T) {
+		badKey := base64.StdEncoding.EncodeToString([]byte("fedcba9876543210"))
+		storage, err := NewAzureBlob(server.URL, "account", badKey, "container")
+		assert.Nil(t, err)
+		_, err = storage.Exists(context.Background(), "foo")
+		assert.Error(t, err)
+	})
+
+	t.Run("multipart", func(t *testing.T) {
+		ctx := context.Background()
+		storage, err := NewAzureBlob(server.URL, "account", key, "container")
+		assert.Nil(t, err)
+		w, err := storage.NewMultipartWriter(ctx, "multipart", nil)
+		assert.Nil(t, err)
+		assert.Nil(t, w.WritePart(ctx, []byte("foo")))
+		assert.Nil(t, w.WritePart(ctx, []byte("bar")))
+		exists, err := storage.Exists(ctx, "multipart")
+		assert.Nil(t, err)
+		assert.False(t, exists)
+		assert.Nil(t, w.Complete(ctx))
+
+		r, err := storage.Read(ctx, "multipart", 2, 5)
+		assert.Nil(t, err)
+		data, err := io.ReadAll(r)
+		assert.Nil(t, err)
+		assert.Nil(t, r.Close())
+		assert.Equal(t, []byte("oba"), data)
+	})
+}
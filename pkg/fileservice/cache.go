@@ -76,6 +76,8 @@ type ObjectCache interface {
 	Capacity() int64
 	Used() int64
 	Available() int64
+	// Range calls fn on cached objects, from the most recently used, until fn returns false
+	Range(fn func(key any, size int64) bool)
 }
 
 // FileContentCache caches contents of files
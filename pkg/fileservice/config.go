@@ -15,8 +15,11 @@
 package fileservice
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
@@ -28,20 +31,42 @@ const (
 	diskETLFileServiceBackend = "DISK-ETL"
 	s3FileServiceBackend      = "S3"
 	minioFileServiceBackend   = "MINIO"
+	gcsFileServiceBackend     = "GCS"
+	azureFileServiceBackend   = "AZURE"
+	hdfsFileServiceBackend    = "HDFS"
 )
 
 // Config fileService config
 type Config struct {
 	// Name name of fileservice, describe what an instance of fileservice is used for
 	Name string `toml:"name"`
-	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO]
+	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO|GCS|AZURE|HDFS]
 	Backend string `toml:"backend"`
-	// S3 used to create fileservice using s3 as the backend
+	// S3 used to create fileservice using s3, minio or gcs as the backend.
+	// GCS is accessed through its S3 interoperability API only, there is no
+	// native GCS client: s3.endpoint has to be set to the interoperability
+	// endpoint, like https://storage.googleapis.com, and the credentials have
+	// to be HMAC keys, given by the shared config profile or the AWS
+	// environment variables. Service accounts and OAuth are not supported.
 	S3 S3Config `toml:"s3"`
+	// Azure used to create fileservice using azure blob storage as the backend
+	Azure AzureConfig `toml:"azure"`
+	// HDFS used to create fileservice using hdfs as the backend
+	HDFS HDFSConfig `toml:"hdfs"`
 	// Cache specifies configs for cache
 	Cache CacheConfig `toml:"cache"`
 	// DataDir used to create fileservice using DISK as the backend
 	DataDir string `toml:"data-dir"`
+	// Encryption specifies configs for data-at-rest encryption
+	Encryption EncryptionConfig `toml:"encryption"`
+	// Tiering specifies configs for moving cold objects to an archival file service
+	Tiering TieringConfig `toml:"tiering"`
+}
+
+// EncryptionConfig encryption config
+type EncryptionConfig struct {
+	// KeyFile is the key file of FileKeyManager. Files are not encrypted if it is empty.
+	KeyFile string `toml:"key-file"`
 }
 
 // NewFileServicesFunc creates a new *FileServices
@@ -49,6 +74,49 @@ type NewFileServicesFunc = func(defaultName string) (*FileServices, error)
 
 // NewFileService create file service from config
 func NewFileService(cfg Config, perfCounterSets []*perfcounter.CounterSet) (FileService, error) {
+	fs, err := newFileService(cfg, perfCounterSets)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Tiering.Cold != nil {
+		fs, err = newTieredFileService(cfg, fs, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Encryption.KeyFile != "" {
+		keyManager, err := NewFileKeyManager(cfg.Encryption.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		fs = Encrypted(fs, keyManager)
+	}
+	return fs, nil
+}
+
+func newTieredFileService(cfg Config, hot FileService, perfCounterSets []*perfcounter.CounterSet) (FileService, error) {
+	coldCfg := *cfg.Tiering.Cold
+	if coldCfg.Name == "" {
+		coldCfg.Name = cfg.Name + "-cold"
+	}
+	if strings.EqualFold(coldCfg.Name, cfg.Name) {
+		return nil, moerr.NewInvalidInputNoCtx("cold tier of %s must have a different name", cfg.Name)
+	}
+	cold, err := newFileService(coldCfg, perfCounterSets)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	fs, err := NewTieredFS(ctx, cfg.Name, hot, cold, cfg.Tiering)
+	if err != nil {
+		return nil, err
+	}
+	fs.Start(cfg.Tiering.CheckInterval.Duration)
+	return fs, nil
+}
+
+func newFileService(cfg Config, perfCounterSets []*perfcounter.CounterSet) (FileService, error) {
 	if cfg.Name == "" {
 		panic("empty name")
 	}
@@ -63,6 +131,12 @@ func NewFileService(cfg Config, perfCounterSets []*perfcounter.CounterSet) (File
 		return newMinioFileService(cfg, perfCounterSets)
 	case s3FileServiceBackend:
 		return newS3FileService(cfg, perfCounterSets)
+	case gcsFileServiceBackend:
+		return newGCSFileService(cfg, perfCounterSets)
+	case azureFileServiceBackend:
+		return newAzureFileService(cfg, perfCounterSets)
+	case hdfsFileServiceBackend:
+		return newHDFSFileService(cfg, perfCounterSets)
 	default:
 		return nil, moerr.NewInternalErrorNoCtx("file service backend %s not implemented", cfg.Backend)
 	}
@@ -140,3 +214,67 @@ func newS3FileService(cfg Config, perfCounters []*perfcounter.CounterSet) (FileS
 	}
 	return fs, nil
 }
+
+func newGCSFileService(cfg Config, perfCounters []*perfcounter.CounterSet) (FileService, error) {
+	if cfg.S3.Endpoint == "" {
+		return nil, moerr.NewBadConfigNoCtx(
+			"gcs file service %s requires the s3 interoperability endpoint", cfg.Name)
+	}
+	fs, err := newS3FS([]string{
+		"shared-config-profile=" + cfg.S3.SharedConfigProfile,
+		"name=" + cfg.Name,
+		"endpoint=" + cfg.S3.Endpoint,
+		"region=auto",
+		"bucket=" + cfg.S3.Bucket,
+		"prefix=" + cfg.S3.KeyPrefix,
+		"single-delete=true",
+	})
+	if err != nil {
+		return nil, err
+	}
+	fs.perfCounterSets = perfCounters
+	if err := fs.initCaches(cfg.Cache); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func newAzureFileService(cfg Config, perfCounters []*perfcounter.CounterSet) (FileService, error) {
+	storage, err := NewAzureBlob(
+		cfg.Azure.Endpoint,
+		cfg.Azure.Account,
+		os.Getenv(azureStorageKeyEnv),
+		cfg.Azure.Container,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newObjectStorageFileService(cfg.Name, storage, cfg.Azure.KeyPrefix, cfg.Cache, perfCounters)
+}
+
+func newHDFSFileService(cfg Config, perfCounters []*perfcounter.CounterSet) (FileService, error) {
+	storage, err := NewHDFS(
+		cfg.HDFS.Endpoint,
+		cfg.HDFS.Root,
+		cfg.HDFS.User,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return newObjectStorageFileService(cfg.Name, storage, "", cfg.Cache, perfCounters)
+}
+
+func newObjectStorageFileService(
+	name string,
+	storage ObjectStorage,
+	keyPrefix string,
+	cacheConfig CacheConfig,
+	perfCounters []*perfcounter.CounterSet,
+) (FileService, error) {
+	fs := newObjectStorageFS(name, storage, keyPrefix)
+	fs.perfCounterSets = perfCounters
+	if err := fs.initCaches(cacheConfig); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
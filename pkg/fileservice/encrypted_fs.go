@@ -0,0 +1,656 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"path"
+	"sort"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+)
+
+// An encrypted file is a fixed size header followed by the encrypted chunks.
+//
+// header:
+//
+//	| magic (4) | version (1) | key id length (1) | encrypted key length (2) |
+//	| content size (8) | account id (4) | key id | encrypted data key | padding |
+//
+// The account id is absent in version 1 headers.
+// Each file has its own random data key, which is encrypted by a key
+// encryption key in the KeyManager. The content is split into chunks of
+// _EncryptionChunkSize bytes, each chunk is sealed by AES-GCM with its index
+// as the nonce, so a range read only decrypts the chunks it covers.
+//
+// Headers are cached by file, so a read costs one upstream request when the
+// header is cached. Decrypted objects are cached in the memory cache of the
+// upstream instance, keyed by the ranges of the content.
+const (
+	_EncryptionMagic      = "MOEF"
+	_EncryptionVersion    = 2
+	_EncryptionHeaderSize = 256
+	_EncryptionChunkSize  = 64 * 1024
+	_EncryptionTagSize    = 16
+	_EncryptionChunkSpan  = _EncryptionChunkSize + _EncryptionTagSize
+	_DataKeySize          = 32
+
+	maxKeyIDLength = 64
+
+	maxCachedEncryptionHeaders = 64 * 1024
+)
+
+type encryptedFS struct {
+	upstream   FileService
+	keyManager KeyManager
+	// memCache is the memory cache of the upstream instance, nil if it has none
+	memCache *MemCache
+
+	headers struct {
+		sync.Mutex
+		m map[string]*encryptionHeader
+	}
+}
+
+// Encrypted returns a FileService that encrypts the files written to the
+// upstream instance and decrypts the files read from it
+func Encrypted(upstream FileService, keyManager KeyManager) FileService {
+	e := &encryptedFS{
+		upstream:   upstream,
+		keyManager: keyManager,
+	}
+	if holder, ok := upstream.(memoryCacheHolder); ok {
+		e.memCache = holder.memoryCache()
+	}
+	e.headers.m = make(map[string]*encryptionHeader)
+	return e
+}
+
+// memoryCacheHolder is implemented by the FileServices with a memory cache
+type memoryCacheHolder interface {
+	memoryCache() *MemCache
+}
+
+// KeyRotatingFileService is a FileService able to re-encrypt its files
+type KeyRotatingFileService interface {
+	FileService
+
+	// RotateKey re-encrypts the file with a new data key under the current
+	// key of its account. It returns false if the file already uses the current key.
+	RotateKey(ctx context.Context, filePath string) (bool, error)
+	// ReloadKeys reloads the keys of the KeyManager if it supports reloading
+	ReloadKeys() error
+}
+
+var _ CachingFileService = new(encryptedFS)
+var _ ReplaceableFileService = new(encryptedFS)
+var _ HotKeysFileService = new(encryptedFS)
+var _ KeyRotatingFileService = new(encryptedFS)
+var _ ClosableFileService = new(encryptedFS)
+
+type encryptionHeader struct {
+	accountID    uint32
+	keyID        string
+	encryptedKey []byte
+	size         int64
+}
+
+func (e *encryptedFS) Name() string {
+	return e.upstream.Name()
+}
+
+func (e *encryptedFS) Write(ctx context.Context, vector IOVector) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	vector, err := e.encryptVector(ctx, vector)
+	if err != nil {
+		return err
+	}
+	e.dropHeader(vector.FilePath)
+	return e.upstream.Write(ctx, vector)
+}
+
+func (e *encryptedFS) Replace(ctx context.Context, vector IOVector) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	fs, ok := e.upstream.(ReplaceableFileService)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "replace files of %s", e.upstream.Name())
+	}
+	vector, err := e.encryptVector(ctx, vector)
+	if err != nil {
+		return err
+	}
+	defer e.dropHeader(vector.FilePath)
+	return fs.Replace(ctx, vector)
+}
+
+// encryptVector returns the vector to write the encrypted content of vector
+func (e *encryptedFS) encryptVector(ctx context.Context, vector IOVector) (IOVector, error) {
+	sort.Slice(vector.Entries, func(i, j int) bool {
+		return vector.Entries[i].Offset < vector.Entries[j].Offset
+	})
+	content, err := io.ReadAll(newIOEntriesReader(ctx, vector.Entries))
+	if err != nil {
+		return vector, err
+	}
+	var accountID uint32
+	if v, ok := ctx.Value(defines.TenantIDKey{}).(uint32); ok {
+		accountID = v
+	}
+	data, err := e.encrypt(ctx, accountID, content)
+	if err != nil {
+		return vector, err
+	}
+	vector.Entries = []IOEntry{
+		{
+			Offset: 0,
+			Size:   int64(len(data)),
+			Data:   data,
+		},
+	}
+	return vector, nil
+}
+
+// encrypt encrypts content with a new data key under the current key of the account
+func (e *encryptedFS) encrypt(ctx context.Context, accountID uint32, content []byte) ([]byte, error) {
+	keyID, err := e.keyManager.CurrentKeyID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, _DataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	encryptedKey, err := e.keyManager.EncryptKey(ctx, keyID, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	header := encryptionHeader{
+		accountID:    accountID,
+		keyID:        keyID,
+		encryptedKey: encryptedKey,
+		size:         int64(len(content)),
+	}
+	data, err := header.encode(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i*_EncryptionChunkSize < len(content); i++ {
+		end := (i + 1) * _EncryptionChunkSize
+		if end > len(content) {
+			end = len(content)
+		}
+		data = aead.Seal(data, chunkNonce(i), content[i*_EncryptionChunkSize:end], sizeBytes(header.size))
+	}
+	return data, nil
+}
+
+func (e *encryptedFS) Read(ctx context.Context, vector *IOVector) (err error) {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+
+	if e.memCache != nil {
+		if err := e.memCache.Read(ctx, vector); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				return
+			}
+			err = e.memCache.Update(ctx, vector, false)
+		}()
+	}
+
+	retry, err := e.read(ctx, vector, false)
+	if retry {
+		// the file is rewritten since the header or the data is cached,
+		// by a key rotation for example
+		e.dropHeader(vector.FilePath)
+		_, err = e.read(ctx, vector, true)
+	}
+	return err
+}
+
+// read reads the entries of vector not done. It returns true if the content
+// fails to decrypt and the read should be retried bypassing the caches.
+func (e *encryptedFS) read(ctx context.Context, vector *IOVector, noCache bool) (bool, error) {
+	header, cached, err := e.readHeader(ctx, vector, noCache)
+	if err != nil {
+		return false, err
+	}
+	dataKey, err := e.keyManager.DecryptKey(ctx, header.keyID, header.encryptedKey)
+	if err != nil {
+		return false, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return false, err
+	}
+
+	// read the chunks covering each entry
+	indexes := make([]int, 0, len(vector.Entries))
+	firstChunks := make([]int64, 0, len(vector.Entries))
+	upstreamEntries := make([]IOEntry, 0, len(vector.Entries))
+	for i := range vector.Entries {
+		entry := &vector.Entries[i]
+		if entry.done {
+			continue
+		}
+		if entry.Size == 0 {
+			return false, moerr.NewEmptyRangeNoCtx(vector.FilePath)
+		}
+		size := entry.Size
+		if size < 0 {
+			size = header.size - entry.Offset
+		}
+		if entry.Offset < 0 || size <= 0 || entry.Offset+size > header.size {
+			return false, moerr.NewUnexpectedEOFNoCtx(vector.FilePath)
+		}
+		first := entry.Offset / _EncryptionChunkSize
+		last := (entry.Offset + size - 1) / _EncryptionChunkSize
+		start := _EncryptionHeaderSize + first*_EncryptionChunkSpan
+		end := _EncryptionHeaderSize + last*_EncryptionChunkSpan +
+			chunkContentSize(header.size, last) + _EncryptionTagSize
+		indexes = append(indexes, i)
+		firstChunks = append(firstChunks, first)
+		upstreamEntries = append(upstreamEntries, IOEntry{
+			Offset: start,
+			Size:   end - start,
+		})
+	}
+	if len(upstreamEntries) == 0 {
+		return false, nil
+	}
+	err = e.upstream.Read(ctx, &IOVector{
+		FilePath:   vector.FilePath,
+		Entries:    upstreamEntries,
+		NoCache:    vector.NoCache || noCache,
+		Preloading: vector.Preloading,
+	})
+	if moerr.IsMoErrCode(err, moerr.ErrUnexpectedEOF) && cached {
+		// a cached header of a rewritten file
+		return true, err
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// decrypt all entries before setting any of them, a failed read may be retried
+	datas := make([][]byte, len(indexes))
+	for n, i := range indexes {
+		entry := vector.Entries[i]
+		encrypted := upstreamEntries[n].Data
+		content := make([]byte, 0, len(encrypted))
+		for chunk := firstChunks[n]; len(encrypted) > 0; chunk++ {
+			span := chunkContentSize(header.size, chunk) + _EncryptionTagSize
+			if int64(len(encrypted)) < span {
+				return false, moerr.NewUnexpectedEOFNoCtx(vector.FilePath)
+			}
+			content, err = aead.Open(content, chunkNonce(int(chunk)), encrypted[:span], sizeBytes(header.size))
+			if err != nil {
+				return !noCache, moerr.NewInternalError(ctx, "decrypt %s: %v", vector.FilePath, err)
+			}
+			encrypted = encrypted[span:]
+		}
+		skip := entry.Offset - firstChunks[n]*_EncryptionChunkSize
+		size := entry.Size
+		if size < 0 {
+			size = header.size - entry.Offset
+		}
+		datas[n] = content[skip : skip+size]
+	}
+
+	for n, i := range indexes {
+		entry := vector.Entries[i]
+		data := datas[n]
+		setData := true
+		if w := entry.WriterForRead; w != nil {
+			setData = false
+			if _, err := w.Write(data); err != nil {
+				return false, err
+			}
+		}
+		if ptr := entry.ReadCloserForRead; ptr != nil {
+			setData = false
+			*ptr = io.NopCloser(bytes.NewReader(data))
+		}
+		if setData {
+			if int64(len(entry.Data)) < entry.Size || entry.Size < 0 {
+				entry.Data = data
+				if entry.Size < 0 {
+					entry.Size = int64(len(data))
+				}
+			} else {
+				copy(entry.Data, data)
+			}
+		}
+		if err := entry.setObjectBytesFromData(); err != nil {
+			return false, err
+		}
+		vector.Entries[i] = entry
+	}
+	return false, nil
+}
+
+// readHeader returns the header of the file, and whether it is from the header cache
+func (e *encryptedFS) readHeader(ctx context.Context, vector *IOVector, noCache bool) (*encryptionHeader, bool, error) {
+	key, err := e.headerKey(vector.FilePath)
+	if err != nil {
+		return nil, false, err
+	}
+	if !noCache {
+		e.headers.Lock()
+		header, ok := e.headers.m[key]
+		e.headers.Unlock()
+		if ok {
+			return header, true, nil
+		}
+	}
+
+	headerVector := &IOVector{
+		FilePath: vector.FilePath,
+		Entries: []IOEntry{
+			{Offset: 0, Size: _EncryptionHeaderSize},
+		},
+		NoCache:    vector.NoCache || noCache,
+		Preloading: vector.Preloading,
+	}
+	if err := e.upstream.Read(ctx, headerVector); err != nil {
+		return nil, false, err
+	}
+	header, err := decodeEncryptionHeader(ctx, headerVector.Entries[0].Data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	e.headers.Lock()
+	defer e.headers.Unlock()
+	if len(e.headers.m) >= maxCachedEncryptionHeaders {
+		// evict an arbitrary header
+		for k := range e.headers.m {
+			delete(e.headers.m, k)
+			break
+		}
+	}
+	e.headers.m[key] = header
+	return header, false, nil
+}
+
+func (e *encryptedFS) headerKey(filePath string) (string, error) {
+	parsed, err := ParsePath(filePath)
+	if err != nil {
+		return "", err
+	}
+	return parsed.File, nil
+}
+
+func (e *encryptedFS) dropHeader(filePath string) {
+	key, err := e.headerKey(filePath)
+	if err != nil {
+		return
+	}
+	e.headers.Lock()
+	defer e.headers.Unlock()
+	delete(e.headers.m, key)
+}
+
+// RotateKey re-encrypts the file with a new data key if the current key of
+// its account is not the key of the file. The upstream instance must be a
+// ReplaceableFileService, and the old key must be kept until the rotation is done.
+func (e *encryptedFS) RotateKey(ctx context.Context, filePath string) (bool, error) {
+	replaceable, ok := e.upstream.(ReplaceableFileService)
+	if !ok {
+		return false, moerr.NewNotSupported(ctx, "replace files of %s", e.upstream.Name())
+	}
+	header, _, err := e.readHeader(ctx, &IOVector{FilePath: filePath}, true)
+	if err != nil {
+		return false, err
+	}
+	keyID, err := e.keyManager.CurrentKeyID(ctx, header.accountID)
+	if err != nil {
+		return false, err
+	}
+	if keyID == header.keyID {
+		return false, nil
+	}
+	var content []byte
+	if header.size > 0 {
+		vec := &IOVector{
+			FilePath: filePath,
+			Entries: []IOEntry{
+				{Offset: 0, Size: header.size},
+			},
+			NoCache: true,
+		}
+		if _, err := e.read(ctx, vec, true); err != nil {
+			return false, err
+		}
+		content = vec.Entries[0].Data
+	}
+	data, err := e.encrypt(ctx, header.accountID, content)
+	if err != nil {
+		return false, err
+	}
+	defer e.dropHeader(filePath)
+	err = replaceable.Replace(ctx, IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{Offset: 0, Size: int64(len(data)), Data: data},
+		},
+	})
+	return err == nil, err
+}
+
+func (e *encryptedFS) ReloadKeys() error {
+	if m, ok := e.keyManager.(interface{ Reload() error }); ok {
+		return m.Reload()
+	}
+	return nil
+}
+
+// RotateKeys re-encrypts the files under dirPath of fs by RotateKey,
+// it returns the number of re-encrypted files
+func RotateKeys(ctx context.Context, fs KeyRotatingFileService, dirPath string) (int, error) {
+	entries, err := fs.List(ctx, dirPath)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+		filePath := path.Join(dirPath, entry.Name)
+		if entry.IsDir {
+			rotated, err := RotateKeys(ctx, fs, filePath)
+			n += rotated
+			if err != nil {
+				return n, err
+			}
+			continue
+		}
+		rotated, err := fs.RotateKey(ctx, filePath)
+		if err != nil {
+			return n, err
+		}
+		if rotated {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (e *encryptedFS) List(ctx context.Context, dirPath string) ([]DirEntry, error) {
+	entries, err := e.upstream.List(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if !entries[i].IsDir {
+			entries[i].Size = contentSize(entries[i].Size)
+		}
+	}
+	return entries, nil
+}
+
+func (e *encryptedFS) Delete(ctx context.Context, filePaths ...string) error {
+	for _, filePath := range filePaths {
+		e.dropHeader(filePath)
+	}
+	return e.upstream.Delete(ctx, filePaths...)
+}
+
+func (e *encryptedFS) StatFile(ctx context.Context, filePath string) (*DirEntry, error) {
+	entry, err := e.upstream.StatFile(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	entry.Size = contentSize(entry.Size)
+	return entry, nil
+}
+
+func (e *encryptedFS) Preload(ctx context.Context, filePath string) error {
+	return e.upstream.Preload(ctx, filePath)
+}
+
+func (e *encryptedFS) FlushCache() {
+	if fs, ok := e.upstream.(CachingFileService); ok {
+		fs.FlushCache()
+	}
+}
+
+func (e *encryptedFS) SetAsyncUpdate(b bool) {
+	if fs, ok := e.upstream.(CachingFileService); ok {
+		fs.SetAsyncUpdate(b)
+	}
+}
+
+func (e *encryptedFS) Close() {
+	if fs, ok := e.upstream.(ClosableFileService); ok {
+		fs.Close()
+	}
+}
+
+func (e *encryptedFS) HotKeys(limit int) []HotKey {
+	if e.memCache == nil {
+		return nil
+	}
+	return e.memCache.HotKeys(limit)
+}
+
+func (h *encryptionHeader) encode(ctx context.Context) ([]byte, error) {
+	if len(h.keyID) > maxKeyIDLength ||
+		20+len(h.keyID)+len(h.encryptedKey) > _EncryptionHeaderSize {
+		return nil, moerr.NewInternalError(ctx, "encryption header of key %s is too large", h.keyID)
+	}
+	data := make([]byte, _EncryptionHeaderSize, _EncryptionHeaderSize+encryptedSize(h.size))
+	copy(data, _EncryptionMagic)
+	data[4] = _EncryptionVersion
+	data[5] = byte(len(h.keyID))
+	binary.BigEndian.PutUint16(data[6:8], uint16(len(h.encryptedKey)))
+	binary.BigEndian.PutUint64(data[8:16], uint64(h.size))
+	binary.BigEndian.PutUint32(data[16:20], h.accountID)
+	copy(data[20:], h.keyID)
+	copy(data[20+len(h.keyID):], h.encryptedKey)
+	return data, nil
+}
+
+func decodeEncryptionHeader(ctx context.Context, data []byte) (*encryptionHeader, error) {
+	if len(data) < _EncryptionHeaderSize ||
+		string(data[:4]) != _EncryptionMagic {
+		return nil, moerr.NewInternalError(ctx, "file is not encrypted")
+	}
+	header := &encryptionHeader{
+		size: int64(binary.BigEndian.Uint64(data[8:16])),
+	}
+	start := 16
+	switch data[4] {
+	case 1:
+	case _EncryptionVersion:
+		header.accountID = binary.BigEndian.Uint32(data[16:20])
+		start = 20
+	default:
+		return nil, moerr.NewInternalError(ctx, "unknown encryption version %d", data[4])
+	}
+	keyIDLen := int(data[5])
+	keyLen := int(binary.BigEndian.Uint16(data[6:8]))
+	if start+keyIDLen+keyLen > _EncryptionHeaderSize {
+		return nil, moerr.NewInternalError(ctx, "bad encryption header")
+	}
+	header.keyID = string(data[start : start+keyIDLen])
+	header.encryptedKey = append([]byte(nil), data[start+keyIDLen:start+keyIDLen+keyLen]...)
+	return header, nil
+}
+
+func chunkNonce(chunk int) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[4:], uint64(chunk))
+	return nonce
+}
+
+func sizeBytes(size int64) []byte {
+	bs := make([]byte, 8)
+	binary.BigEndian.PutUint64(bs, uint64(size))
+	return bs
+}
+
+func chunkContentSize(size int64, chunk int64) int64 {
+	if rest := size - chunk*_EncryptionChunkSize; rest < _EncryptionChunkSize {
+		return rest
+	}
+	return _EncryptionChunkSize
+}
+
+// encryptedSize returns the size of the encrypted chunks of size bytes content
+func encryptedSize(size int64) int64 {
+	chunks := (size + _EncryptionChunkSize - 1) / _EncryptionChunkSize
+	return size + chunks*_EncryptionTagSize
+}
+
+// contentSize returns the content size of an encrypted file of size bytes
+func contentSize(size int64) int64 {
+	size -= _EncryptionHeaderSize
+	if size <= 0 {
+		return 0
+	}
+	chunks := (size + _EncryptionChunkSpan - 1) / _EncryptionChunkSpan
+	return size - chunks*_EncryptionTagSize
+}
@@ -0,0 +1,373 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/stretchr/testify/assert"
+)
+
+const testKeyFile = `
+default = "k1"
+[keys]
+k1 = "000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f"
+k2 = "0f0e0d0c0b0a09080706050403020100"
+[accounts]
+%s
+`
+
+func newTestKeyManager(t *testing.T, dir string, accounts string) *FileKeyManager {
+	path := filepath.Join(dir, "keys.toml")
+	err := os.WriteFile(path, []byte(fmt.Sprintf(testKeyFile, accounts)), 0600)
+	assert.Nil(t, err)
+	m, err := NewFileKeyManager(path)
+	assert.Nil(t, err)
+	return m
+}
+
+func TestEncryptedFS(t *testing.T) {
+
+	t.Run("file service", func(t *testing.T) {
+		keyManager := newTestKeyManager(t, t.TempDir(), "")
+		testFileService(t, func(name string) FileService {
+			upstream, err := NewMemoryFS(name, DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			return Encrypted(upstream, keyManager)
+		})
+	})
+
+	t.Run("caching local file service", func(t *testing.T) {
+		keyManager := newTestKeyManager(t, t.TempDir(), "")
+		testFileService(t, func(name string) FileService {
+			upstream, err := NewLocalFS(name, t.TempDir(), CacheConfig{
+				MemoryCapacity: 128 * 1024,
+			}, nil)
+			assert.Nil(t, err)
+			return Encrypted(upstream, keyManager)
+		})
+	})
+
+}
+
+func TestEncryptedFSChunks(t *testing.T) {
+	ctx := context.Background()
+	upstream, err := NewMemoryFS("memory", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+	fs := Encrypted(upstream, newTestKeyManager(t, t.TempDir(), ""))
+
+	data := make([]byte, _EncryptionChunkSize*3+42)
+	_, err = rand.Read(data)
+	assert.Nil(t, err)
+	err = fs.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 0, Size: int64(len(data)), Data: data},
+		},
+	})
+	assert.Nil(t, err)
+
+	entry, err := fs.StatFile(ctx, "foo")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), entry.Size)
+
+	// the content is not stored in plaintext
+	raw := &IOVector{
+		FilePath: "foo",
+		Entries:  []IOEntry{{Offset: 0, Size: -1}},
+	}
+	assert.Nil(t, upstream.Read(ctx, raw))
+	assert.False(t, bytes.Contains(raw.Entries[0].Data, data[:64]))
+
+	vec := &IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 1, Size: 10},
+			{Offset: _EncryptionChunkSize - 5, Size: _EncryptionChunkSize + 10},
+			{Offset: _EncryptionChunkSize * 3, Size: -1},
+		},
+	}
+	assert.Nil(t, fs.Read(ctx, vec))
+	assert.Equal(t, data[1:11], vec.Entries[0].Data)
+	assert.Equal(t, data[_EncryptionChunkSize-5:_EncryptionChunkSize*2+5], vec.Entries[1].Data)
+	assert.Equal(t, data[_EncryptionChunkSize*3:], vec.Entries[2].Data)
+
+	// tampered content
+	tampered := raw.Entries[0].Data
+	tampered[len(tampered)-1] ^= 1
+	err = upstream.Write(ctx, IOVector{
+		FilePath: "bar",
+		Entries: []IOEntry{
+			{Offset: 0, Size: int64(len(tampered)), Data: tampered},
+		},
+	})
+	assert.Nil(t, err)
+	vec = &IOVector{
+		FilePath: "bar",
+		Entries:  []IOEntry{{Offset: _EncryptionChunkSize * 3, Size: 1}},
+	}
+	assert.Error(t, fs.Read(ctx, vec))
+}
+
+func TestEncryptedFSKeys(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	keyManager := newTestKeyManager(t, dir, `1 = "k2"`)
+	upstream, err := NewMemoryFS("memory", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+	fs := Encrypted(upstream, keyManager)
+
+	write := func(ctx context.Context, name string) {
+		err := fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{Offset: 0, Size: 3, Data: []byte(name)},
+			},
+		})
+		assert.Nil(t, err)
+	}
+	keyOf := func(name string) string {
+		vec := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Offset: 0, Size: _EncryptionHeaderSize}},
+		}
+		assert.Nil(t, upstream.Read(ctx, vec))
+		header, err := decodeEncryptionHeader(ctx, vec.Entries[0].Data)
+		assert.Nil(t, err)
+		return header.keyID
+	}
+	read := func(name string) {
+		vec := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Offset: 0, Size: -1}},
+		}
+		assert.Nil(t, fs.Read(ctx, vec))
+		assert.Equal(t, []byte(name), vec.Entries[0].Data)
+	}
+
+	// per account keys
+	write(ctx, "sys")
+	write(context.WithValue(ctx, defines.TenantIDKey{}, uint32(1)), "acc")
+	assert.Equal(t, "k1", keyOf("sys"))
+	assert.Equal(t, "k2", keyOf("acc"))
+
+	// rotate the default key
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "keys.toml"), []byte(`
+default = "k3"
+[keys]
+k1 = "000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f"
+k2 = "0f0e0d0c0b0a09080706050403020100"
+k3 = "101112131415161718191a1b1c1d1e1f"
+[accounts]
+1 = "k2"
+`), 0600))
+	assert.Nil(t, keyManager.Reload())
+	write(ctx, "new")
+	assert.Equal(t, "k3", keyOf("new"))
+	for _, name := range []string{"sys", "acc", "new"} {
+		read(name)
+	}
+
+	// a removed key cannot decrypt
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "keys.toml"), []byte(`
+default = "k2"
+[keys]
+k2 = "0f0e0d0c0b0a09080706050403020100"
+`), 0600))
+	assert.Nil(t, keyManager.Reload())
+	vec := &IOVector{
+		FilePath: "sys",
+		Entries:  []IOEntry{{Offset: 0, Size: -1}},
+	}
+	assert.Error(t, fs.Read(ctx, vec))
+}
+
+type countingFS struct {
+	FileService
+	reads int
+}
+
+func (c *countingFS) Read(ctx context.Context, vector *IOVector) error {
+	c.reads++
+	return c.FileService.Read(ctx, vector)
+}
+
+func (c *countingFS) Replace(ctx context.Context, vector IOVector) error {
+	return c.FileService.(ReplaceableFileService).Replace(ctx, vector)
+}
+
+func TestEncryptedFSHeaderCache(t *testing.T) {
+	ctx := context.Background()
+	memFS, err := NewMemoryFS("memory", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+	upstream := &countingFS{FileService: memFS}
+	fs := Encrypted(upstream, newTestKeyManager(t, t.TempDir(), ""))
+
+	write := func(data string) {
+		err := fs.(ReplaceableFileService).Replace(ctx, IOVector{
+			FilePath: "foo",
+			Entries: []IOEntry{
+				{Offset: 0, Size: int64(len(data)), Data: []byte(data)},
+			},
+		})
+		assert.Nil(t, err)
+	}
+	read := func() string {
+		vec := &IOVector{
+			FilePath: "foo",
+			Entries:  []IOEntry{{Offset: 0, Size: -1}},
+		}
+		assert.Nil(t, fs.Read(ctx, vec))
+		return string(vec.Entries[0].Data)
+	}
+
+	write("foo")
+	assert.Equal(t, "foo", read())
+	assert.Equal(t, 2, upstream.reads)
+	// the header is cached
+	assert.Equal(t, "foo", read())
+	assert.Equal(t, 3, upstream.reads)
+
+	// rewritten by another instance, the cached header is stale
+	other := Encrypted(upstream, newTestKeyManager(t, t.TempDir(), ""))
+	err = other.(ReplaceableFileService).Replace(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 0, Size: 3, Data: []byte("bar")},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "bar", read())
+	err = other.(ReplaceableFileService).Replace(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 0, Size: 6, Data: []byte("foobar")},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "foobar", read())
+}
+
+func TestEncryptedFSHotKeys(t *testing.T) {
+	ctx := context.Background()
+	upstream, err := NewLocalFS("local", t.TempDir(), CacheConfig{
+		MemoryCapacity: 128 * 1024,
+	}, nil)
+	assert.Nil(t, err)
+	fs := Encrypted(upstream, newTestKeyManager(t, t.TempDir(), ""))
+	upstream.SetAsyncUpdate(false)
+
+	err = fs.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 0, Size: 6, Data: []byte("foobar")},
+		},
+	})
+	assert.Nil(t, err)
+	toObjectBytes := func(r io.Reader, data []byte) ([]byte, int64, error) {
+		return data, int64(len(data)), nil
+	}
+	vec := &IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 3, Size: 3, ToObjectBytes: toObjectBytes},
+		},
+	}
+	assert.Nil(t, fs.Read(ctx, vec))
+	assert.Equal(t, []byte("bar"), vec.Entries[0].ObjectBytes)
+
+	// decrypted objects are cached by the ranges of the content
+	keys := fs.(HotKeysFileService).HotKeys(-1)
+	assert.Equal(t, 1, len(keys))
+	assert.Equal(t, int64(3), keys[0].Offset)
+	assert.Equal(t, int64(3), keys[0].Size)
+	vec = &IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 3, Size: 3, ToObjectBytes: toObjectBytes},
+		},
+	}
+	assert.Nil(t, fs.Read(ctx, vec))
+	assert.True(t, vec.Entries[0].done)
+	assert.Equal(t, []byte("bar"), vec.Entries[0].ObjectBytes)
+}
+
+func TestEncryptedFSRotateKeys(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	keyManager := newTestKeyManager(t, dir, `1 = "k2"`)
+	upstream, err := NewMemoryFS("memory", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+	fs := Encrypted(upstream, keyManager).(KeyRotatingFileService)
+
+	write := func(ctx context.Context, name string) {
+		err := fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{Offset: 0, Size: int64(len(name)), Data: []byte(name)},
+			},
+		})
+		assert.Nil(t, err)
+	}
+	write(ctx, "a/sys")
+	write(ctx, "a/b/sys")
+	write(context.WithValue(ctx, defines.TenantIDKey{}, uint32(1)), "a/acc")
+
+	// nothing to rotate
+	n, err := RotateKeys(ctx, fs, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, n)
+
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "keys.toml"), []byte(`
+default = "k3"
+[keys]
+k1 = "000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f"
+k2 = "0f0e0d0c0b0a09080706050403020100"
+k3 = "101112131415161718191a1b1c1d1e1f"
+[accounts]
+1 = "k2"
+`), 0600))
+	assert.Nil(t, fs.ReloadKeys())
+	n, err = RotateKeys(ctx, fs, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+
+	// the files are readable without the old key
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "keys.toml"), []byte(`
+default = "k3"
+[keys]
+k2 = "0f0e0d0c0b0a09080706050403020100"
+k3 = "101112131415161718191a1b1c1d1e1f"
+[accounts]
+1 = "k2"
+`), 0600))
+	assert.Nil(t, fs.ReloadKeys())
+	for _, name := range []string{"a/sys", "a/b/sys", "a/acc"} {
+		vec := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Offset: 0, Size: -1}},
+		}
+		assert.Nil(t, fs.Read(ctx, vec))
+		assert.Equal(t, []byte(name), vec.Entries[0].Data)
+	}
+}
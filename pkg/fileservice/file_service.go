@@ -76,6 +76,10 @@ type IOVector struct {
 	NoCache bool
 	// Preloading indicates whether the I/O is for preloading
 	Preloading bool
+	// StatementID is the id of the statement that issues the I/O
+	// the I/O is counted in the counter set registered for it, see perfcounter.RegisterStatement
+	// optional, for I/O not running under the statement's context, like prefetching
+	StatementID string
 }
 
 type IOEntry struct {
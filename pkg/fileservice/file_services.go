@@ -43,6 +43,17 @@ func NewFileServices(defaultName string, fss ...FileService) (*FileServices, err
 
 var _ FileService = &FileServices{}
 
+var _ ClosableFileService = &FileServices{}
+
+// Close closes the file services running background jobs
+func (f *FileServices) Close() {
+	for _, fs := range f.mappings {
+		if fs, ok := fs.(ClosableFileService); ok {
+			fs.Close()
+		}
+	}
+}
+
 func (f *FileServices) Delete(ctx context.Context, filePaths ...string) error {
 	for _, filePath := range filePaths {
 		if err := f.deleteSingle(ctx, filePath); err != nil {
@@ -0,0 +1,356 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// temporary files of uploading objects are named <key><hdfsTempInfix><uuid>
+const hdfsTempInfix = ".mo-tmp-"
+
+// HDFSConfig hdfs config
+type HDFSConfig struct {
+	// Endpoint is the WebHDFS address of the name node, like http://localhost:9870
+	Endpoint string `toml:"endpoint"`
+	// User is the user name of the requests
+	User string `toml:"user"`
+	// Root is the directory of the fs instance
+	Root string `toml:"root"`
+}
+
+// HDFS is an ObjectStorage implementation of HDFS, using the WebHDFS REST API.
+// Keys are paths relative to the root directory.
+// Objects are written to temporary files and renamed, to be visible atomically.
+type HDFS struct {
+	client   *http.Client
+	endpoint string
+	root     string
+	user     string
+}
+
+var _ ObjectStorage = new(HDFS)
+
+// NewHDFS creates an HDFS client
+// endpoint is the http address of the name node, like http://localhost:9870
+func NewHDFS(endpoint string, root string, user string) (*HDFS, error) {
+	if endpoint == "" {
+		return nil, moerr.NewInvalidInputNoCtx("hdfs endpoint is required")
+	}
+	return &HDFS{
+		client: &http.Client{
+			// redirections to data nodes are handled by do
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		endpoint: strings.TrimRight(endpoint, "/"),
+		root:     path.Join("/", root),
+		user:     user,
+	}, nil
+}
+
+type hdfsFileStatus struct {
+	PathSuffix string `json:"pathSuffix"`
+	Type       string `json:"type"`
+	Length     int64  `json:"length"`
+}
+
+func (h *HDFS) List(
+	ctx context.Context,
+	prefix string,
+	fn func(bool, string, int64) (bool, error),
+) error {
+	dir, namePrefix := path.Split(prefix)
+	resp, err := h.do(ctx, http.MethodGet, dir, "LISTSTATUS", nil, nil)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	var result struct {
+		FileStatuses struct {
+			FileStatus []hdfsFileStatus `json:"FileStatus"`
+		} `json:"FileStatuses"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	for _, status := range result.FileStatuses.FileStatus {
+		if !strings.HasPrefix(status.PathSuffix, namePrefix) ||
+			strings.Contains(status.PathSuffix, hdfsTempInfix) {
+			continue
+		}
+		var more bool
+		if status.Type == "DIRECTORY" {
+			more, err = fn(true, dir+status.PathSuffix+"/", 0)
+		} else {
+			more, err = fn(false, dir+status.PathSuffix, status.Length)
+		}
+		if err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *HDFS) Stat(ctx context.Context, key string) (int64, error) {
+	resp, err := h.do(ctx, http.MethodGet, key, "GETFILESTATUS", nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	var result struct {
+		FileStatus hdfsFileStatus `json:"FileStatus"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	if result.FileStatus.Type != "FILE" {
+		return 0, moerr.NewFileNotFoundNoCtx(key)
+	}
+	return result.FileStatus.Length, nil
+}
+
+func (h *HDFS) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := h.Stat(ctx, key)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (h *HDFS) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	expire *time.Time,
+) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && int64(len(data)) != size {
+		return moerr.NewSizeNotMatchNoCtx(key)
+	}
+	w, err := h.NewMultipartWriter(ctx, key, expire)
+	if err != nil {
+		return err
+	}
+	if err := w.WritePart(ctx, data); err != nil {
+		_ = w.Abort(ctx)
+		return err
+	}
+	return w.Complete(ctx)
+}
+
+func (h *HDFS) Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
+	query := url.Values{
+		"offset": {strconv.FormatInt(min, 10)},
+	}
+	if max >= 0 {
+		if max <= min {
+			return nil, moerr.NewEmptyRangeNoCtx(key)
+		}
+		query.Set("length", strconv.FormatInt(max-min, 10))
+	}
+	resp, err := h.do(ctx, http.MethodGet, key, "OPEN", query, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (h *HDFS) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		resp, err := h.do(ctx, http.MethodDelete, key, "DELETE", url.Values{
+			"recursive": {"false"},
+		}, nil)
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+	}
+	return nil
+}
+
+func (h *HDFS) NewMultipartWriter(ctx context.Context, key string, expire *time.Time) (MultipartWriter, error) {
+	return &hdfsMultipartWriter{
+		hdfs:    h,
+		key:     key,
+		tmpPath: key + hdfsTempInfix + uuid.New().String(),
+	}, nil
+}
+
+type hdfsMultipartWriter struct {
+	hdfs    *HDFS
+	key     string
+	tmpPath string
+	created bool
+}
+
+func (w *hdfsMultipartWriter) WritePart(ctx context.Context, data []byte) error {
+	var resp *http.Response
+	var err error
+	if !w.created {
+		resp, err = w.hdfs.do(ctx, http.MethodPut, w.tmpPath, "CREATE", url.Values{
+			"overwrite": {"false"},
+		}, data)
+	} else {
+		resp, err = w.hdfs.do(ctx, http.MethodPost, w.tmpPath, "APPEND", nil, data)
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	w.created = true
+	return nil
+}
+
+func (w *hdfsMultipartWriter) Complete(ctx context.Context) error {
+	if !w.created {
+		if err := w.WritePart(ctx, nil); err != nil {
+			return err
+		}
+	}
+	resp, err := w.hdfs.do(ctx, http.MethodPut, w.tmpPath, "RENAME", url.Values{
+		"destination": {w.hdfs.fullPath(w.key)},
+	}, nil)
+	if err != nil {
+		_ = w.Abort(ctx)
+		return err
+	}
+	defer resp.Body.Close()
+	var result struct {
+		Boolean bool `json:"boolean"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		_ = w.Abort(ctx)
+		return err
+	}
+	if !result.Boolean {
+		// rename does not overwrite
+		_ = w.Abort(ctx)
+		return moerr.NewFileAlreadyExistsNoCtx(w.key)
+	}
+	return nil
+}
+
+func (w *hdfsMultipartWriter) Abort(ctx context.Context) error {
+	return w.hdfs.Delete(ctx, w.tmpPath)
+}
+
+func (h *HDFS) fullPath(key string) string {
+	return path.Join(h.root, key)
+}
+
+func (h *HDFS) do(
+	ctx context.Context,
+	method string,
+	key string,
+	op string,
+	query url.Values,
+	body []byte,
+) (*http.Response, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("op", op)
+	if h.user != "" {
+		query.Set("user.name", h.user)
+	}
+	u, err := url.Parse(h.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = strings.TrimRight(u.Path, "/") + "/webhdfs/v1" + h.fullPath(key)
+	u.RawQuery = query.Encode()
+	location := u.String()
+
+	// CREATE, APPEND and OPEN are redirected to data nodes,
+	// the content is sent to the redirected location only
+	var resp *http.Response
+	for i := 0; i < 2; i++ {
+		var reqBody io.Reader
+		if i > 0 || op != "CREATE" && op != "APPEND" {
+			reqBody = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, location, reqBody)
+		if err != nil {
+			return nil, err
+		}
+		if reqBody != nil && len(body) > 0 {
+			req.Header.Set("Content-Type", "application/octet-stream")
+		}
+		resp, err = h.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusTemporaryRedirect {
+			break
+		}
+		redirect, err := resp.Location()
+		resp.Body.Close()
+		if err != nil {
+			return nil, moerr.NewInternalErrorNoCtx("hdfs %s %s: bad redirection: %v", op, key, err)
+		}
+		location = redirect.String()
+	}
+
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		var remote struct {
+			RemoteException struct {
+				Exception string `json:"exception"`
+				Message   string `json:"message"`
+			} `json:"RemoteException"`
+		}
+		_ = json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&remote)
+		switch remote.RemoteException.Exception {
+		case "FileNotFoundException":
+			return nil, moerr.NewFileNotFoundNoCtx(key)
+		case "FileAlreadyExistsException":
+			return nil, moerr.NewFileAlreadyExistsNoCtx(key)
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, moerr.NewFileNotFoundNoCtx(key)
+		}
+		return nil, moerr.NewInternalErrorNoCtx("hdfs %s %s: %s %s",
+			op, key, resp.Status, remote.RemoteException.Message)
+	}
+	return resp, nil
+}
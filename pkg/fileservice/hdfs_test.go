@@ -0,0 +1,234 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeWebHDFSServer is an in-memory WebHDFS server for tests.
+// Name node requests of CREATE, APPEND and OPEN are redirected to /datanode.
+type fakeWebHDFSServer struct {
+	mu    sync.Mutex
+	files map[string][]byte
+}
+
+func newFakeWebHDFSServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(&fakeWebHDFSServer{
+		files: make(map[string][]byte),
+	})
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (f *fakeWebHDFSServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	query := r.URL.Query()
+	op := query.Get("op")
+
+	if strings.HasPrefix(r.URL.Path, "/webhdfs/v1/") {
+		switch op {
+		case "CREATE", "APPEND", "OPEN":
+			w.Header().Set("Location", "/datanode"+r.URL.Path+"?"+r.URL.RawQuery)
+			w.WriteHeader(http.StatusTemporaryRedirect)
+			return
+		}
+	}
+	path := "/" + strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/datanode"), "/webhdfs/v1/")
+
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"RemoteException":{"exception":"FileNotFoundException","message":"not found"}}`))
+	}
+
+	switch op {
+
+	case "CREATE":
+		if _, ok := f.files[path]; ok {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"RemoteException":{"exception":"FileAlreadyExistsException","message":"exists"}}`))
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		f.files[path] = data
+		w.WriteHeader(http.StatusCreated)
+
+	case "APPEND":
+		if _, ok := f.files[path]; !ok {
+			notFound()
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		f.files[path] = append(f.files[path], data...)
+
+	case "OPEN":
+		data, ok := f.files[path]
+		if !ok {
+			notFound()
+			return
+		}
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		if offset > len(data) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"RemoteException":{"exception":"IOException","message":"offset out of range"}}`))
+			return
+		}
+		data = data[offset:]
+		if s := query.Get("length"); s != "" {
+			length, _ := strconv.Atoi(s)
+			if length < len(data) {
+				data = data[:length]
+			}
+		}
+		_, _ = w.Write(data)
+
+	case "GETFILESTATUS":
+		if data, ok := f.files[path]; ok {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"FileStatus": hdfsFileStatus{Type: "FILE", Length: int64(len(data))},
+			})
+			return
+		}
+		if len(f.children(path)) > 0 {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"FileStatus": hdfsFileStatus{Type: "DIRECTORY"},
+			})
+			return
+		}
+		notFound()
+
+	case "LISTSTATUS":
+		statuses := f.children(path)
+		if len(statuses) == 0 {
+			notFound()
+			return
+		}
+		var result struct {
+			FileStatuses struct {
+				FileStatus []hdfsFileStatus `json:"FileStatus"`
+			} `json:"FileStatuses"`
+		}
+		result.FileStatuses.FileStatus = statuses
+		_ = json.NewEncoder(w).Encode(result)
+
+	case "DELETE":
+		_, ok := f.files[path]
+		delete(f.files, path)
+		_ = json.NewEncoder(w).Encode(map[string]bool{"boolean": ok})
+
+	case "RENAME":
+		data, ok := f.files[path]
+		_, exists := f.files[query.Get("destination")]
+		if !ok || exists {
+			_ = json.NewEncoder(w).Encode(map[string]bool{"boolean": false})
+			return
+		}
+		delete(f.files, path)
+		f.files[query.Get("destination")] = data
+		_ = json.NewEncoder(w).Encode(map[string]bool{"boolean": true})
+
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+// children returns the statuses of the files and directories in dir
+func (f *fakeWebHDFSServer) children(dir string) []hdfsFileStatus {
+	dir = strings.TrimSuffix(dir, "/") + "/"
+	var statuses []hdfsFileStatus
+	seen := make(map[string]bool)
+	for path, data := range f.files {
+		if !strings.HasPrefix(path, dir) {
+			continue
+		}
+		name, _, isDir := strings.Cut(path[len(dir):], "/")
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		status := hdfsFileStatus{PathSuffix: name, Type: "FILE", Length: int64(len(data))}
+		if isDir {
+			status = hdfsFileStatus{PathSuffix: name, Type: "DIRECTORY"}
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].PathSuffix < statuses[j].PathSuffix
+	})
+	return statuses
+}
+
+func TestHDFSFS(t *testing.T) {
+	server := newFakeWebHDFSServer(t)
+
+	t.Run("file service", func(t *testing.T) {
+		testFileService(t, func(name string) FileService {
+			storage, err := NewHDFS(server.URL, uuid.New().String(), "mo")
+			assert.Nil(t, err)
+			return newObjectStorageFS(name, storage, "")
+		})
+	})
+
+	t.Run("temporary files", func(t *testing.T) {
+		ctx := context.Background()
+		storage, err := NewHDFS(server.URL, "tmp", "mo")
+		assert.Nil(t, err)
+		w, err := storage.NewMultipartWriter(ctx, "foo", nil)
+		assert.Nil(t, err)
+		assert.Nil(t, w.WritePart(ctx, []byte("foo")))
+		assert.Nil(t, w.WritePart(ctx, []byte("bar")))
+
+		// not visible before completed
+		exists, err := storage.Exists(ctx, "foo")
+		assert.Nil(t, err)
+		assert.False(t, exists)
+		n := 0
+		err = storage.List(ctx, "", func(bool, string, int64) (bool, error) {
+			n++
+			return true, nil
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, n)
+
+		assert.Nil(t, w.Complete(ctx))
+		size, err := storage.Stat(ctx, "foo")
+		assert.Nil(t, err)
+		assert.Equal(t, int64(6), size)
+
+		// no overwriting
+		err = storage.Write(ctx, "foo", strings.NewReader("baz"), 3, nil)
+		assert.Error(t, err)
+		r, err := storage.Read(ctx, "foo", 0, -1)
+		assert.Nil(t, err)
+		data, err := io.ReadAll(r)
+		assert.Nil(t, err)
+		assert.Nil(t, r.Close())
+		assert.Equal(t, []byte("foobar"), data)
+	})
+}
@@ -0,0 +1,201 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+// HotKey is a key of the memory cache, with the size of the cached object
+type HotKey struct {
+	IOVectorCacheKey
+	ObjectSize int64
+}
+
+// HotKeysFileService is a FileService whose memory cache keys can be saved and reloaded
+type HotKeysFileService interface {
+	FileService
+
+	// HotKeys returns at most limit keys of the memory cache, the hottest first
+	HotKeys(limit int) []HotKey
+}
+
+// HotKeyConstructor returns the ToObjectBytes function to reload the object of a key in fs
+type HotKeyConstructor = func(
+	ctx context.Context,
+	fs FileService,
+	key HotKey,
+) (func(r io.Reader, data []byte) ([]byte, int64, error), error)
+
+const hotKeysVersion = 1
+
+// HotKeys returns at most limit keys of the cache, the hottest first
+func (m *MemCache) HotKeys(limit int) []HotKey {
+	var keys []HotKey
+	m.objCache.Range(func(key any, size int64) bool {
+		if limit >= 0 && len(keys) >= limit {
+			return false
+		}
+		if k, ok := key.(IOVectorCacheKey); ok {
+			keys = append(keys, HotKey{
+				IOVectorCacheKey: k,
+				ObjectSize:       size,
+			})
+		}
+		return true
+	})
+	return keys
+}
+
+// EncodeHotKeys encodes keys in a compact form.
+// Paths are stored once, entries refer to them by index.
+func EncodeHotKeys(keys []HotKey) []byte {
+	buf := []byte{hotKeysVersion}
+	pathIndexes := make(map[string]int)
+	var paths []string
+	for _, key := range keys {
+		if _, ok := pathIndexes[key.Path]; !ok {
+			pathIndexes[key.Path] = len(paths)
+			paths = append(paths, key.Path)
+		}
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(paths)))
+	for _, p := range paths {
+		buf = binary.AppendUvarint(buf, uint64(len(p)))
+		buf = append(buf, p...)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(keys)))
+	for _, key := range keys {
+		buf = binary.AppendUvarint(buf, uint64(pathIndexes[key.Path]))
+		buf = binary.AppendVarint(buf, key.Offset)
+		buf = binary.AppendVarint(buf, key.Size)
+		buf = binary.AppendVarint(buf, key.ObjectSize)
+	}
+	return buf
+}
+
+// DecodeHotKeys decodes keys encoded by EncodeHotKeys
+func DecodeHotKeys(data []byte) ([]HotKey, error) {
+	if len(data) == 0 || data[0] != hotKeysVersion {
+		return nil, moerr.NewInternalErrorNoCtx("bad hot keys version")
+	}
+	data = data[1:]
+	bad := false
+	uvarint := func() uint64 {
+		v, n := binary.Uvarint(data)
+		if n <= 0 {
+			bad = true
+			return 0
+		}
+		data = data[n:]
+		return v
+	}
+	varint := func() int64 {
+		v, n := binary.Varint(data)
+		if n <= 0 {
+			bad = true
+			return 0
+		}
+		data = data[n:]
+		return v
+	}
+
+	numPaths := uvarint()
+	var paths []string
+	for i := uint64(0); i < numPaths && !bad; i++ {
+		l := uvarint()
+		if l > uint64(len(data)) {
+			bad = true
+			break
+		}
+		paths = append(paths, string(data[:l]))
+		data = data[l:]
+	}
+	numKeys := uvarint()
+	var keys []HotKey
+	for i := uint64(0); i < numKeys && !bad; i++ {
+		idx := uvarint()
+		offset, size, objectSize := varint(), varint(), varint()
+		if bad || idx >= uint64(len(paths)) {
+			bad = true
+			break
+		}
+		keys = append(keys, HotKey{
+			IOVectorCacheKey: IOVectorCacheKey{
+				Path:   paths[idx],
+				Offset: offset,
+				Size:   size,
+			},
+			ObjectSize: objectSize,
+		})
+	}
+	if bad {
+		return nil, moerr.NewInternalErrorNoCtx("bad hot keys data")
+	}
+	return keys, nil
+}
+
+// ReloadHotKeys reads the objects of keys into the caches of fs.
+// keys are read from the coldest, to restore the order of the memory cache.
+// Keys failing to reload, like the ones of deleted files, are skipped.
+// It returns the number of reloaded keys.
+func ReloadHotKeys(
+	ctx context.Context,
+	fs FileService,
+	keys []HotKey,
+	constructor HotKeyConstructor,
+) (int, error) {
+	n := 0
+	for i := len(keys) - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return n, err
+		}
+		key := keys[i]
+		toObjectBytes, err := constructor(ctx, fs, key)
+		if err == nil {
+			err = fs.Read(ctx, &IOVector{
+				FilePath: JoinPath(fs.Name(), key.Path),
+				Entries: []IOEntry{
+					{
+						Offset:        key.Offset,
+						Size:          key.Size,
+						ToObjectBytes: toObjectBytes,
+					},
+				},
+			})
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return n, ctx.Err()
+			}
+			if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+				logutil.Warn("skip reloading hot cache key",
+					zap.String("path", key.Path),
+					zap.Int64("offset", key.Offset),
+					zap.Int64("size", key.Size),
+					zap.Error(err))
+			}
+			continue
+		}
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHotKeysEncoding(t *testing.T) {
+	keys := []HotKey{
+		{IOVectorCacheKey: IOVectorCacheKey{Path: "foo", Offset: 0, Size: 3}, ObjectSize: 8},
+		{IOVectorCacheKey: IOVectorCacheKey{Path: "bar", Offset: 42, Size: 1}, ObjectSize: 1},
+		{IOVectorCacheKey: IOVectorCacheKey{Path: "foo", Offset: 3, Size: -1}, ObjectSize: 100},
+	}
+	data := EncodeHotKeys(keys)
+	decoded, err := DecodeHotKeys(data)
+	assert.Nil(t, err)
+	assert.Equal(t, keys, decoded)
+
+	decoded, err = DecodeHotKeys(EncodeHotKeys(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(decoded))
+
+	_, err = DecodeHotKeys(data[:len(data)-1])
+	assert.Error(t, err)
+	_, err = DecodeHotKeys(nil)
+	assert.Error(t, err)
+}
+
+func TestReloadHotKeys(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "fs")
+	newFS := func() *LocalFS {
+		fs, err := NewLocalFS("test", dir, CacheConfig{MemoryCapacity: 1 << 20}, nil)
+		assert.Nil(t, err)
+		fs.SetAsyncUpdate(false)
+		return fs
+	}
+	toObjectBytes := func(r io.Reader, data []byte) ([]byte, int64, error) {
+		if len(data) == 0 {
+			var err error
+			data, err = io.ReadAll(r)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		return data, int64(len(data)), nil
+	}
+
+	fs := newFS()
+	for _, name := range []string{"a", "b", "c"} {
+		assert.Nil(t, fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{Offset: 0, Size: 3, Data: []byte(name + name + name)},
+			},
+		}))
+	}
+	for _, name := range []string{"c", "a", "b"} {
+		assert.Nil(t, fs.Read(ctx, &IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{Offset: 0, Size: 2, ToObjectBytes: toObjectBytes},
+			},
+		}))
+	}
+	keys := fs.HotKeys(-1)
+	assert.Equal(t, 3, len(keys))
+	assert.Equal(t, "b", keys[0].Path)
+	assert.Equal(t, "a", keys[1].Path)
+	assert.Equal(t, "c", keys[2].Path)
+	assert.Equal(t, int64(2), keys[0].ObjectSize)
+	assert.Equal(t, 2, len(fs.HotKeys(2)))
+
+	// deleted files are skipped
+	assert.Nil(t, fs.Delete(ctx, "a"))
+
+	fs2 := newFS()
+	keys, err := DecodeHotKeys(EncodeHotKeys(keys))
+	assert.Nil(t, err)
+	// a bad key is skipped
+	keys = append(keys, HotKey{IOVectorCacheKey: IOVectorCacheKey{Path: "bad"}})
+	n, err := ReloadHotKeys(ctx, fs2, keys, func(_ context.Context, _ FileService, key HotKey) (func(io.Reader, []byte) ([]byte, int64, error), error) {
+		if key.Path == "bad" {
+			return nil, moerr.NewInternalErrorNoCtx("bad key")
+		}
+		return toObjectBytes, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, n)
+	reloaded := fs2.HotKeys(-1)
+	assert.Equal(t, 2, len(reloaded))
+	assert.Equal(t, "b", reloaded[0].Path)
+	assert.Equal(t, "c", reloaded[1].Path)
+}
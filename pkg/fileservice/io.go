@@ -44,6 +44,22 @@ func (c *countingReader) Read(data []byte) (int, error) {
 	return n, err
 }
 
+// callbackReader calls fn with the number of bytes of every read
+type callbackReader struct {
+	r  io.Reader
+	fn func(int64)
+}
+
+var _ io.Reader = new(callbackReader)
+
+func (c *callbackReader) Read(data []byte) (int, error) {
+	n, err := c.r.Read(data)
+	if n > 0 {
+		c.fn(int64(n))
+	}
+	return n, err
+}
+
 type writeCloser struct {
 	w         io.Writer
 	closeFunc func() error
@@ -0,0 +1,183 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// KeyManager is the interface of a key management service.
+// Data keys are encrypted by the key encryption keys in the KeyManager,
+// the key encryption keys never leave it.
+type KeyManager interface {
+	// CurrentKeyID returns the id of the key to encrypt new data keys of the account
+	CurrentKeyID(ctx context.Context, accountID uint32) (string, error)
+	// EncryptKey encrypts a data key with the key encryption key keyID
+	EncryptKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	// DecryptKey decrypts a data key encrypted by the key encryption key keyID
+	DecryptKey(ctx context.Context, keyID string, encrypted []byte) ([]byte, error)
+}
+
+// FileKeyManager is a KeyManager backed by a local key file.
+//
+// The key file is in toml format:
+//
+//	# key of the accounts not listed in [accounts]
+//	default = "k2"
+//	[keys]
+//	k1 = "<hex encoded 16, 24 or 32 bytes AES key>"
+//	k2 = "<hex encoded 16, 24 or 32 bytes AES key>"
+//	[accounts]
+//	1 = "k1"
+//
+// To rotate a key, add a new key and point default or the account to it,
+// then call Reload. The old keys must be kept as long as there are files
+// encrypted with them, RotateKeys re-encrypts the existing files with the
+// new keys, e.g. by the dn inspect command "rotate-keys".
+type FileKeyManager struct {
+	path string
+
+	sync.RWMutex
+	defaultKey string
+	keys       map[string]cipher.AEAD
+	accounts   map[uint32]string
+}
+
+var _ KeyManager = new(FileKeyManager)
+
+type keyFile struct {
+	Default  string            `toml:"default"`
+	Keys     map[string]string `toml:"keys"`
+	Accounts map[string]string `toml:"accounts"`
+}
+
+// NewFileKeyManager loads the keys in the key file path
+func NewFileKeyManager(path string) (*FileKeyManager, error) {
+	m := &FileKeyManager{
+		path: path,
+	}
+	if err := m.Reload(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Reload reloads the key file
+func (m *FileKeyManager) Reload() error {
+	var file keyFile
+	if _, err := toml.DecodeFile(m.path, &file); err != nil {
+		return moerr.NewInternalErrorNoCtx("load key file %s: %v", m.path, err)
+	}
+	keys := make(map[string]cipher.AEAD, len(file.Keys))
+	for id, str := range file.Keys {
+		if len(id) > maxKeyIDLength {
+			return moerr.NewInternalErrorNoCtx("key id %s is too long", id)
+		}
+		key, err := hex.DecodeString(str)
+		if err != nil {
+			return moerr.NewInternalErrorNoCtx("bad key %s: %v", id, err)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return moerr.NewInternalErrorNoCtx("bad key %s: %v", id, err)
+		}
+		keys[id] = aead
+	}
+	if _, ok := keys[file.Default]; !ok {
+		return moerr.NewInternalErrorNoCtx("default key %q is not found", file.Default)
+	}
+	accounts := make(map[uint32]string, len(file.Accounts))
+	for str, id := range file.Accounts {
+		accountID, err := strconv.ParseUint(str, 10, 32)
+		if err != nil {
+			return moerr.NewInternalErrorNoCtx("bad account id %s", str)
+		}
+		if _, ok := keys[id]; !ok {
+			return moerr.NewInternalErrorNoCtx("key %q of account %s is not found", id, str)
+		}
+		accounts[uint32(accountID)] = id
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.defaultKey = file.Default
+	m.keys = keys
+	m.accounts = accounts
+	return nil
+}
+
+func (m *FileKeyManager) CurrentKeyID(_ context.Context, accountID uint32) (string, error) {
+	m.RLock()
+	defer m.RUnlock()
+	if id, ok := m.accounts[accountID]; ok {
+		return id, nil
+	}
+	return m.defaultKey, nil
+}
+
+func (m *FileKeyManager) EncryptKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	aead, err := m.getKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+func (m *FileKeyManager) DecryptKey(ctx context.Context, keyID string, encrypted []byte) ([]byte, error) {
+	aead, err := m.getKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(encrypted) < aead.NonceSize() {
+		return nil, moerr.NewInternalError(ctx, "bad encrypted key")
+	}
+	nonce, ciphertext := encrypted[:aead.NonceSize()], encrypted[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, ciphertext, []byte(keyID))
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "decrypt key with %s: %v", keyID, err)
+	}
+	return dataKey, nil
+}
+
+func (m *FileKeyManager) getKey(ctx context.Context, keyID string) (cipher.AEAD, error) {
+	m.RLock()
+	defer m.RUnlock()
+	aead, ok := m.keys[keyID]
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "key %q is not found", keyID)
+	}
+	return aead, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
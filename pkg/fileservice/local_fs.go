@@ -162,6 +162,7 @@ func (l *LocalFS) Name() string {
 }
 
 func (l *LocalFS) Write(ctx context.Context, vector IOVector) error {
+	ctx = vector.withStatement(ctx)
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -264,6 +265,7 @@ func (l *LocalFS) write(ctx context.Context, vector IOVector) error {
 }
 
 func (l *LocalFS) Read(ctx context.Context, vector *IOVector) (err error) {
+	ctx = vector.withStatement(ctx)
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -795,6 +797,19 @@ func (l *LocalFS) SetAsyncUpdate(b bool) {
 	l.asyncUpdate = b
 }
 
+var _ HotKeysFileService = new(LocalFS)
+
+func (l *LocalFS) HotKeys(limit int) []HotKey {
+	if l.memCache == nil {
+		return nil
+	}
+	return l.memCache.HotKeys(limit)
+}
+
+func (l *LocalFS) memoryCache() *MemCache {
+	return l.memCache
+}
+
 func entryIsDir(path string, name string, entry fs.FileInfo) (bool, error) {
 	if entry.IsDir() {
 		return true, nil
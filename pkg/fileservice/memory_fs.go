@@ -124,6 +124,7 @@ func (m *MemoryFS) List(ctx context.Context, dirPath string) (entries []DirEntry
 }
 
 func (m *MemoryFS) Write(ctx context.Context, vector IOVector) error {
+	ctx = vector.withStatement(ctx)
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -190,6 +191,7 @@ func (m *MemoryFS) write(ctx context.Context, vector IOVector) error {
 }
 
 func (m *MemoryFS) Read(ctx context.Context, vector *IOVector) (err error) {
+	ctx = vector.withStatement(ctx)
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -52,3 +52,8 @@ func (c *Clock) Available() int64 {
 	//TODO implement me
 	panic("implement me")
 }
+
+func (c *Clock) Range(fn func(key any, size int64) bool) {
+	//TODO implement me
+	panic("implement me")
+}
@@ -137,3 +137,14 @@ func (l *LRU) Available() int64 {
 	defer l.Unlock()
 	return l.capacity - l.size
 }
+
+func (l *LRU) Range(fn func(key any, size int64) bool) {
+	l.Lock()
+	defer l.Unlock()
+	for elem := l.evicts.Front(); elem != nil; elem = elem.Next() {
+		item := elem.Value.(*lruItem)
+		if !fn(item.Key, item.Size) {
+			return
+		}
+	}
+}
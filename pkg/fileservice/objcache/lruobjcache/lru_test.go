@@ -35,6 +35,28 @@ func TestLRU(t *testing.T) {
 	assert.True(t, ok)
 }
 
+func TestLRURange(t *testing.T) {
+	l := New(3)
+	l.Set(1, []byte{1}, 1, false)
+	l.Set(2, []byte{2}, 1, false)
+	l.Set(3, []byte{3}, 1, true)
+	l.Get(1, false)
+
+	var keys []any
+	l.Range(func(key any, size int64) bool {
+		keys = append(keys, key)
+		return true
+	})
+	assert.Equal(t, []any{1, 2, 3}, keys)
+
+	keys = keys[:0]
+	l.Range(func(key any, size int64) bool {
+		keys = append(keys, key)
+		return false
+	})
+	assert.Equal(t, []any{1}, keys)
+}
+
 func BenchmarkLRUSet(b *testing.B) {
 	const capacity = 1024
 	l := New(capacity)
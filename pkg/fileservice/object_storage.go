@@ -0,0 +1,76 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ObjectStorage is the client interface of an object storage service.
+// Keys are full object keys, "/" is the delimiter of sub prefixes.
+// Methods return ErrFileNotFound if the requested object does not exist.
+type ObjectStorage interface {
+	// List lists the objects and the sub prefixes directly under prefix
+	// fn returns false to stop listing
+	List(
+		ctx context.Context,
+		prefix string,
+		fn func(isPrefix bool, key string, size int64) (bool, error),
+	) error
+
+	// Stat returns the size of an object
+	Stat(ctx context.Context, key string) (size int64, err error)
+
+	// Exists reports whether an object exists
+	Exists(ctx context.Context, key string) (bool, error)
+
+	// Write writes an object of size bytes
+	Write(
+		ctx context.Context,
+		key string,
+		r io.Reader,
+		size int64,
+		expire *time.Time,
+	) error
+
+	// Read reads the range [min, max) of an object
+	// max < 0 means reading to the end
+	Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error)
+
+	// Delete deletes objects, missing objects are ignored
+	Delete(ctx context.Context, keys ...string) error
+
+	// NewMultipartWriter starts a multipart upload of an object
+	// the object is visible only after the upload is completed
+	NewMultipartWriter(ctx context.Context, key string, expire *time.Time) (MultipartWriter, error)
+}
+
+// MultipartWriter uploads an object part by part
+type MultipartWriter interface {
+	// WritePart uploads the next part
+	WritePart(ctx context.Context, data []byte) error
+	// Complete commits the uploaded parts as the object
+	Complete(ctx context.Context) error
+	// Abort discards the uploaded parts
+	Abort(ctx context.Context) error
+}
+
+const (
+	// objects larger than this are uploaded by parts
+	_MultipartThreshold = 64 << 20
+	_MultipartPartSize  = 16 << 20
+)
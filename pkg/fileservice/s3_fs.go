@@ -17,12 +17,8 @@ package fileservice
 import (
 	"bytes"
 	"context"
-	"errors"
-	"fmt"
 	"io"
 	"math"
-	stdhttp "net/http"
-	"net/url"
 	"os"
 	pathpkg "path"
 	"sort"
@@ -34,22 +30,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"go.uber.org/zap"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
-	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/s3/types"
-	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
-// S3FS is a FileService implementation backed by S3
+// S3FS is a FileService implementation backed by S3 or other object storage services
 type S3FS struct {
 	name      string
-	s3Client  *s3.Client
-	bucket    string
+	storage   ObjectStorage
 	keyPrefix string
 
 	memCache              *MemCache
@@ -58,7 +45,6 @@ type S3FS struct {
 	writeDiskCacheOnWrite bool
 
 	perfCounterSets []*perfcounter.CounterSet
-	listMaxKeys     int32
 }
 
 // key mapping scheme:
@@ -203,48 +189,19 @@ func (s *S3FS) List(ctx context.Context, dirPath string) (entries []DirEntry, er
 	if prefix != "" {
 		prefix += "/"
 	}
-	var marker *string
-
-	for {
-		output, err := s.s3ListObjects(
-			ctx,
-			&s3.ListObjectsInput{
-				Bucket:    ptrTo(s.bucket),
-				Delimiter: ptrTo("/"),
-				Prefix:    ptrTo(prefix),
-				Marker:    marker,
-				MaxKeys:   s.listMaxKeys,
-			},
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, obj := range output.Contents {
-			filePath := s.keyToPath(*obj.Key)
-			filePath = strings.TrimRight(filePath, "/")
-			_, name := pathpkg.Split(filePath)
-			entries = append(entries, DirEntry{
-				Name:  name,
-				IsDir: false,
-				Size:  obj.Size,
-			})
-		}
-
-		for _, prefix := range output.CommonPrefixes {
-			filePath := s.keyToPath(*prefix.Prefix)
-			filePath = strings.TrimRight(filePath, "/")
-			_, name := pathpkg.Split(filePath)
-			entries = append(entries, DirEntry{
-				Name:  name,
-				IsDir: true,
-			})
-		}
-
-		if !output.IsTruncated {
-			break
-		}
-		marker = output.NextMarker
+	err = s.storageList(ctx, prefix, func(isPrefix bool, key string, size int64) (bool, error) {
+		filePath := s.keyToPath(key)
+		filePath = strings.TrimRight(filePath, "/")
+		_, name := pathpkg.Split(filePath)
+		entries = append(entries, DirEntry{
+			Name:  name,
+			IsDir: isPrefix,
+			Size:  size,
+		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return
@@ -269,31 +226,23 @@ func (s *S3FS) StatFile(ctx context.Context, filePath string) (*DirEntry, error)
 	}
 	key := s.pathToKey(path.File)
 
-	output, err := s.s3HeadObject(
-		ctx,
-		&s3.HeadObjectInput{
-			Bucket: ptrTo(s.bucket),
-			Key:    ptrTo(key),
-		},
-	)
+	size, err := s.storageStat(ctx, key)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return nil, moerr.NewFileNotFound(ctx, filePath)
+	}
 	if err != nil {
-		var httpError *http.ResponseError
-		if errors.As(err, &httpError) {
-			if httpError.Response.StatusCode == 404 {
-				return nil, moerr.NewFileNotFound(ctx, filePath)
-			}
-		}
 		return nil, err
 	}
 
 	return &DirEntry{
 		Name:  pathpkg.Base(filePath),
 		IsDir: false,
-		Size:  output.ContentLength,
+		Size:  size,
 	}, nil
 }
 
 func (s *S3FS) Write(ctx context.Context, vector IOVector) error {
+	ctx = vector.withStatement(ctx)
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -309,26 +258,11 @@ func (s *S3FS) Write(ctx context.Context, vector IOVector) error {
 		return err
 	}
 	key := s.pathToKey(path.File)
-	output, err := s.s3HeadObject(
-		ctx,
-		&s3.HeadObjectInput{
-			Bucket: ptrTo(s.bucket),
-			Key:    ptrTo(key),
-		},
-	)
+	exists, err := s.storageExists(ctx, key)
 	if err != nil {
-		var httpError *http.ResponseError
-		if errors.As(err, &httpError) {
-			if httpError.Response.StatusCode == 404 {
-				// key not exists, ok
-				err = nil
-			}
-		}
-		if err != nil {
-			return err
-		}
+		return err
 	}
-	if output != nil {
+	if exists {
 		// key existed
 		return moerr.NewFileAlreadyExistsNoCtx(path.File)
 	}
@@ -382,32 +316,23 @@ func (s *S3FS) write(ctx context.Context, vector IOVector) (err error) {
 	}
 
 	// put
-	content, err := io.ReadAll(r)
-	if err != nil {
-		return err
-	}
 	var expire *time.Time
 	if !vector.ExpireAt.IsZero() {
 		expire = &vector.ExpireAt
 	}
-	_, err = s.s3PutObject(
-		ctx,
-		&s3.PutObjectInput{
-			Bucket:        ptrTo(s.bucket),
-			Key:           ptrTo(key),
-			Body:          bytes.NewReader(content),
-			ContentLength: size,
-			Expires:       expire,
-		},
-	)
+	// large objects are uploaded part by part as they are read
+	if size > _MultipartThreshold {
+		return s.storageMultipartWrite(ctx, key, r, expire)
+	}
+	content, err := io.ReadAll(r)
 	if err != nil {
 		return err
 	}
-
-	return nil
+	return s.storageWrite(ctx, key, bytes.NewReader(content), size, expire)
 }
 
 func (s *S3FS) Read(ctx context.Context, vector *IOVector) (err error) {
+	ctx = vector.withStatement(ctx)
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -511,39 +436,9 @@ func (s *S3FS) read(ctx context.Context, vector *IOVector) error {
 		}
 
 		if readToEnd {
-			r, err := s.s3GetObject(
-				ctx,
-				min,
-				-1,
-				&s3.GetObjectInput{
-					Bucket: ptrTo(s.bucket),
-					Key:    ptrTo(key),
-				},
-			)
-			err = s.mapError(err, key)
-			if err != nil {
-				return nil, err
-			}
-			return r, nil
-		}
-
-		r, err := s.s3GetObject(
-			ctx,
-			min,
-			max,
-			&s3.GetObjectInput{
-				Bucket: ptrTo(s.bucket),
-				Key:    ptrTo(key),
-			},
-		)
-		err = s.mapError(err, key)
-		if err != nil {
-			return nil, err
+			return s.storageRead(ctx, key, min, -1)
 		}
-		return &readCloser{
-			r:         io.LimitReader(r, int64(max-min)),
-			closeFunc: r.Close,
-		}, nil
+		return s.storageRead(ctx, key, min, max)
 	}
 
 	// a function to get data lazily
@@ -568,7 +463,6 @@ func (s *S3FS) read(ctx context.Context, vector *IOVector) error {
 		}
 		defer reader.Close()
 		bs, err = io.ReadAll(reader)
-		err = s.mapError(err, key)
 		if err != nil {
 			return nil, err
 		}
@@ -639,7 +533,6 @@ func (s *S3FS) read(ctx context.Context, vector *IOVector) error {
 				}
 				defer reader.Close()
 				_, err = io.Copy(w, reader)
-				err = s.mapError(err, key)
 				if err != nil {
 					return err
 				}
@@ -719,80 +612,15 @@ func (s *S3FS) Delete(ctx context.Context, filePaths ...string) error {
 	if len(filePaths) == 0 {
 		return nil
 	}
-	if len(filePaths) == 1 {
-		return s.deleteSingle(ctx, filePaths[0])
-	}
-
-	objs := make([]types.ObjectIdentifier, 0, 1000)
+	keys := make([]string, 0, len(filePaths))
 	for _, filePath := range filePaths {
 		path, err := ParsePathAtService(filePath, s.name)
 		if err != nil {
 			return err
 		}
-		objs = append(objs, types.ObjectIdentifier{Key: ptrTo(s.pathToKey(path.File))})
-		if len(objs) == 1000 {
-			if err := s.deleteMultiObj(ctx, objs); err != nil {
-				return err
-			}
-			objs = objs[:0]
-		}
-	}
-	if err := s.deleteMultiObj(ctx, objs); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (s *S3FS) deleteMultiObj(ctx context.Context, objs []types.ObjectIdentifier) error {
-	ctx, span := trace.Start(ctx, "S3FS.deleteMultiObj")
-	defer span.End()
-	output, err := s.s3DeleteObjects(ctx, &s3.DeleteObjectsInput{
-		Bucket: ptrTo(s.bucket),
-		Delete: &types.Delete{
-			Objects: objs,
-			// In quiet mode the response includes only keys where the delete action encountered an error.
-			Quiet: true,
-		},
-	})
-	// delete api failed
-	if err != nil {
-		return err
-	}
-	// delete api success, but with delete file failed.
-	message := strings.Builder{}
-	if len(output.Errors) > 0 {
-		for _, Error := range output.Errors {
-			if *Error.Code == (*types.NoSuchKey)(nil).ErrorCode() {
-				continue
-			}
-			message.WriteString(fmt.Sprintf("%s: %s, %s;", *Error.Key, *Error.Code, *Error.Message))
-		}
-	}
-	if message.Len() > 0 {
-		return moerr.NewInternalErrorNoCtx("S3 Delete failed: %s", message.String())
-	}
-	return nil
-}
-
-func (s *S3FS) deleteSingle(ctx context.Context, filePath string) error {
-	ctx, span := trace.Start(ctx, "S3FS.deleteSingle")
-	defer span.End()
-	path, err := ParsePathAtService(filePath, s.name)
-	if err != nil {
-		return err
-	}
-	_, err = s.s3DeleteObject(
-		ctx,
-		&s3.DeleteObjectInput{
-			Bucket: ptrTo(s.bucket),
-			Key:    ptrTo(s.pathToKey(path.File)),
-		},
-	)
-	if err != nil {
-		return err
+		keys = append(keys, s.pathToKey(path.File))
 	}
-
-	return nil
+	return s.storageDelete(ctx, keys...)
 }
 
 func (s *S3FS) pathToKey(filePath string) string {
@@ -805,19 +633,6 @@ func (s *S3FS) keyToPath(key string) string {
 	return path
 }
 
-func (s *S3FS) mapError(err error, path string) error {
-	if err == nil {
-		return nil
-	}
-	var httpError *http.ResponseError
-	if errors.As(err, &httpError) {
-		if httpError.Response.StatusCode == 404 {
-			return moerr.NewFileNotFoundNoCtx(path)
-		}
-	}
-	return err
-}
-
 var _ ETLFileService = new(S3FS)
 
 func (*S3FS) ETLCompatible() {}
@@ -834,12 +649,32 @@ func (s *S3FS) SetAsyncUpdate(b bool) {
 	s.asyncUpdate = b
 }
 
+var _ HotKeysFileService = new(S3FS)
+
+func (s *S3FS) HotKeys(limit int) []HotKey {
+	if s.memCache == nil {
+		return nil
+	}
+	return s.memCache.HotKeys(limit)
+}
+
+func (s *S3FS) memoryCache() *MemCache {
+	return s.memCache
+}
+
+var _ ReplaceableFileService = new(S3FS)
+
+// Replace overwrites the object, a PUT of an existing key replaces it atomically
+func (s *S3FS) Replace(ctx context.Context, vector IOVector) error {
+	return s.write(ctx, vector)
+}
+
 func newS3FS(arguments []string) (*S3FS, error) {
 	if len(arguments) == 0 {
 		return nil, moerr.NewInvalidInputNoCtx("invalid S3 arguments")
 	}
 
-	var endpoint, region, bucket, apiKey, apiSecret, prefix, roleARN, externalID, name, sharedConfigProfile, isMinio string
+	var endpoint, region, bucket, apiKey, apiSecret, prefix, roleARN, externalID, name, sharedConfigProfile, isMinio, singleDelete string
 	for _, pair := range arguments {
 		key, value, ok := strings.Cut(pair, "=")
 		if !ok {
@@ -868,243 +703,155 @@ func newS3FS(arguments []string) (*S3FS, error) {
 			sharedConfigProfile = value
 		case "is-minio":
 			isMinio = value
+		case "single-delete":
+			singleDelete = value
 		default:
 			return nil, moerr.NewInvalidInputNoCtx("invalid S3 argument: %s", pair)
 		}
 	}
 
-	if endpoint != "" {
-		u, err := url.Parse(endpoint)
-		if err != nil {
-			return nil, err
-		}
-		if u.Scheme == "" {
-			u.Scheme = "https"
-		}
-		endpoint = u.String()
-	}
-
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
-	if region == "" {
-		// try to get region from bucket
-		resp, err := stdhttp.Head("https://" + bucket + ".s3.amazonaws.com")
-		if err == nil {
-			if value := resp.Header.Get("x-amz-bucket-region"); value != "" {
-				region = value
-			}
-		}
-	}
-
-	var credentialProvider aws.CredentialsProvider
-
-	loadConfigOptions := []func(*config.LoadOptions) error{
-		config.WithLogger(logutil.GetS3Logger()),
-		config.WithClientLogMode(
-			aws.LogSigning |
-				aws.LogRetries |
-				aws.LogRequest |
-				aws.LogResponse |
-				aws.LogDeprecatedUsage |
-				aws.LogRequestEventMessage |
-				aws.LogResponseEventMessage,
-		),
-	}
-	if sharedConfigProfile != "" {
-		loadConfigOptions = append(loadConfigOptions,
-			config.WithSharedConfigProfile(sharedConfigProfile),
-		)
-	}
-
-	if apiKey != "" && apiSecret != "" {
-		// static
-		credentialProvider = credentials.NewStaticCredentialsProvider(apiKey, apiSecret, "")
-	}
-
-	if roleARN != "" {
-		// role arn
-		awsConfig, err := config.LoadDefaultConfig(ctx, loadConfigOptions...)
-		if err != nil {
-			return nil, err
-		}
-
-		stsSvc := sts.NewFromConfig(awsConfig, func(options *sts.Options) {
-			if region == "" {
-				options.Region = "ap-northeast-1"
-			} else {
-				options.Region = region
-			}
-		})
-		credentialProvider = stscreds.NewAssumeRoleProvider(
-			stsSvc,
-			roleARN,
-			func(opts *stscreds.AssumeRoleOptions) {
-				if externalID != "" {
-					opts.ExternalID = &externalID
-				}
-			},
-		)
-		// validate
-		_, err = credentialProvider.Retrieve(ctx)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	if credentialProvider != nil {
-		credentialProvider = aws.NewCredentialsCache(credentialProvider)
-	}
-
-	if credentialProvider != nil {
-		loadConfigOptions = append(loadConfigOptions,
-			config.WithCredentialsProvider(
-				credentialProvider,
-			),
-		)
-	}
-	config, err := config.LoadDefaultConfig(ctx, loadConfigOptions...)
+	storage, err := newAwsSDKv2(ctx, awsArguments{
+		endpoint:            endpoint,
+		region:              region,
+		bucket:              bucket,
+		apiKey:              apiKey,
+		apiSecret:           apiSecret,
+		roleARN:             roleARN,
+		externalID:          externalID,
+		sharedConfigProfile: sharedConfigProfile,
+		isMinio:             isMinio != "",
+		singleDelete:        singleDelete != "",
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	s3Options := []func(*s3.Options){}
-
-	if credentialProvider != nil {
-		s3Options = append(s3Options,
-			func(opt *s3.Options) {
-				opt.Credentials = credentialProvider
-			},
-		)
-	}
-
-	if endpoint != "" {
-		if isMinio != "" {
-			// for minio
-			s3Options = append(s3Options,
-				s3.WithEndpointResolver(
-					s3.EndpointResolverFunc(
-						func(
-							region string,
-							_ s3.EndpointResolverOptions,
-						) (
-							ep aws.Endpoint,
-							err error,
-						) {
-							ep.URL = endpoint
-							ep.Source = aws.EndpointSourceCustom
-							ep.HostnameImmutable = true
-							ep.SigningRegion = region
-							return
-						},
-					),
-				),
-			)
-		} else {
-			s3Options = append(s3Options,
-				s3.WithEndpointResolver(
-					s3.EndpointResolverFromURL(endpoint),
-				),
-			)
-		}
-	}
-
-	if region != "" {
-		s3Options = append(s3Options,
-			func(opt *s3.Options) {
-				opt.Region = region
-			},
-		)
-	}
-
-	client := s3.NewFromConfig(
-		config,
-		s3Options...,
-	)
-
-	_, err = client.HeadBucket(ctx, &s3.HeadBucketInput{
-		Bucket: ptrTo(bucket),
-	})
-	if err != nil {
-		return nil, moerr.NewInternalErrorNoCtx("bad s3 config: %v", err)
-	}
+	return newObjectStorageFS(name, storage, prefix), nil
+}
 
-	fs := &S3FS{
+func newObjectStorageFS(name string, storage ObjectStorage, keyPrefix string) *S3FS {
+	return &S3FS{
 		name:        name,
-		s3Client:    client,
-		bucket:      bucket,
-		keyPrefix:   prefix,
+		storage:     storage,
+		keyPrefix:   keyPrefix,
 		asyncUpdate: true,
 	}
-
-	return fs, nil
-
 }
 
-func (s *S3FS) s3ListObjects(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
+func (s *S3FS) storageList(ctx context.Context, prefix string, fn func(bool, string, int64) (bool, error)) error {
 	FSProfileHandler.AddSample()
 	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
 		counter.FileService.S3.List.Add(1)
 	}, s.perfCounterSets...)
-	return s.s3Client.ListObjects(ctx, params, optFns...)
+	return s.storage.List(ctx, prefix, fn)
 }
 
-func (s *S3FS) s3HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+func (s *S3FS) storageStat(ctx context.Context, key string) (int64, error) {
 	FSProfileHandler.AddSample()
 	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
 		counter.FileService.S3.Head.Add(1)
 	}, s.perfCounterSets...)
-	return s.s3Client.HeadObject(ctx, params, optFns...)
+	return s.storage.Stat(ctx, key)
 }
 
-func (s *S3FS) s3PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+func (s *S3FS) storageExists(ctx context.Context, key string) (bool, error) {
+	FSProfileHandler.AddSample()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Head.Add(1)
+	}, s.perfCounterSets...)
+	return s.storage.Exists(ctx, key)
+}
+
+func (s *S3FS) storageWrite(ctx context.Context, key string, r io.Reader, size int64, expire *time.Time) error {
 	FSProfileHandler.AddSample()
 	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
 		counter.FileService.S3.Put.Add(1)
 	}, s.perfCounterSets...)
-	return s.s3Client.PutObject(ctx, params, optFns...)
+	if size >= 0 {
+		s.addWriteBytes(ctx, size)
+	} else {
+		r = &callbackReader{
+			r: r,
+			fn: func(n int64) {
+				s.addWriteBytes(ctx, n)
+			},
+		}
+	}
+	return s.storage.Write(ctx, key, r, size, expire)
+}
+
+func (s *S3FS) storageMultipartWrite(ctx context.Context, key string, r io.Reader, expire *time.Time) (err error) {
+	w, err := s.storage.NewMultipartWriter(ctx, key, expire)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = w.Abort(ctx)
+		}
+	}()
+	part := make([]byte, _MultipartPartSize)
+	for {
+		n, readErr := io.ReadFull(r, part)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return readErr
+		}
+		if n > 0 {
+			FSProfileHandler.AddSample()
+			perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+				counter.FileService.S3.Put.Add(1)
+			}, s.perfCounterSets...)
+			if err = w.WritePart(ctx, part[:n]); err != nil {
+				return err
+			}
+			s.addWriteBytes(ctx, int64(n))
+		}
+		if readErr != nil {
+			break
+		}
+	}
+	return w.Complete(ctx)
 }
 
-func (s *S3FS) s3GetObject(ctx context.Context, min int64, max int64, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (io.ReadCloser, error) {
+func (s *S3FS) storageRead(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
 	FSProfileHandler.AddSample()
 	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
 		counter.FileService.S3.Get.Add(1)
 	}, s.perfCounterSets...)
-	r, err := newRetryableReader(
-		func(offset int64) (io.ReadCloser, error) {
-			var rang string
-			if max >= 0 {
-				rang = fmt.Sprintf("bytes=%d-%d", offset, max)
-			} else {
-				rang = fmt.Sprintf("bytes=%d-", offset)
-			}
-			params.Range = &rang
-			output, err := s.s3Client.GetObject(ctx, params, optFns...)
-			if err != nil {
-				return nil, err
-			}
-			return output.Body, nil
-		},
-		min,
-	)
+	r, err := s.storage.Read(ctx, key, min, max)
 	if err != nil {
 		return nil, err
 	}
-	return r, nil
+	return &readCloser{
+		r: &callbackReader{
+			r: r,
+			fn: func(n int64) {
+				perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+					counter.FileService.S3.ReadBytes.Add(n)
+				}, s.perfCounterSets...)
+			},
+		},
+		closeFunc: r.Close,
+	}, nil
 }
 
-func (s *S3FS) s3DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
-	FSProfileHandler.AddSample()
+func (s *S3FS) addWriteBytes(ctx context.Context, n int64) {
 	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
-		counter.FileService.S3.DeleteMulti.Add(1)
+		counter.FileService.S3.WriteBytes.Add(n)
 	}, s.perfCounterSets...)
-	return s.s3Client.DeleteObjects(ctx, params, optFns...)
 }
 
-func (s *S3FS) s3DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+func (s *S3FS) storageDelete(ctx context.Context, keys ...string) error {
 	FSProfileHandler.AddSample()
 	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
-		counter.FileService.S3.Delete.Add(1)
+		if len(keys) == 1 {
+			counter.FileService.S3.Delete.Add(1)
+		} else {
+			counter.FileService.S3.DeleteMulti.Add(1)
+		}
 	}, s.perfCounterSets...)
-	return s.s3Client.DeleteObject(ctx, params, optFns...)
+	return s.storage.Delete(ctx, keys...)
 }
@@ -15,19 +15,23 @@
 package fileservice
 
 import (
+	"bytes"
 	"context"
 	"encoding/csv"
 	"encoding/json"
 	"errors"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/stretchr/testify/assert"
 )
@@ -103,7 +107,7 @@ func TestS3FS(t *testing.T) {
 				true,
 			)
 			assert.Nil(t, err)
-			fs.listMaxKeys = 5 // to test continuation
+			fs.storage.(*AwsSDKv2).listMaxKeys = 5 // to test continuation
 
 			return fs
 		})
@@ -341,6 +345,18 @@ func TestDynamicS3OptsNoRegion(t *testing.T) {
 	})
 }
 
+func TestGCSFSNoEndpoint(t *testing.T) {
+	// gcs is only accessed through the s3 interoperability endpoint
+	_, err := NewFileService(Config{
+		Name:    "gcs",
+		Backend: gcsFileServiceBackend,
+		S3: S3Config{
+			Bucket: "foo",
+		},
+	}, nil)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrBadConfig))
+}
+
 func TestS3FSMinioServer(t *testing.T) {
 
 	// find minio executable
@@ -463,3 +479,128 @@ func BenchmarkS3FS(b *testing.B) {
 		return fs
 	})
 }
+
+// memObjectStorage is an ObjectStorage in memory
+type memObjectStorage struct {
+	sync.Mutex
+	objects map[string][]byte
+}
+
+var _ ObjectStorage = new(memObjectStorage)
+
+func (m *memObjectStorage) List(ctx context.Context, prefix string, fn func(bool, string, int64) (bool, error)) error {
+	m.Lock()
+	defer m.Unlock()
+	for key, data := range m.objects {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if more, err := fn(false, key, int64(len(data))); err != nil || !more {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memObjectStorage) Stat(ctx context.Context, key string) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return 0, moerr.NewFileNotFound(ctx, key)
+	}
+	return int64(len(data)), nil
+}
+
+func (m *memObjectStorage) Exists(ctx context.Context, key string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	_, ok := m.objects[key]
+	return ok, nil
+}
+
+func (m *memObjectStorage) Write(ctx context.Context, key string, r io.Reader, size int64, expire *time.Time) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.objects[key] = data
+	return nil
+}
+
+func (m *memObjectStorage) Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
+	m.Lock()
+	defer m.Unlock()
+	data, ok := m.objects[key]
+	if !ok {
+		return nil, moerr.NewFileNotFound(ctx, key)
+	}
+	if max < 0 || max > int64(len(data)) {
+		max = int64(len(data))
+	}
+	return io.NopCloser(bytes.NewReader(data[min:max])), nil
+}
+
+func (m *memObjectStorage) Delete(ctx context.Context, keys ...string) error {
+	m.Lock()
+	defer m.Unlock()
+	for _, key := range keys {
+		delete(m.objects, key)
+	}
+	return nil
+}
+
+func (m *memObjectStorage) NewMultipartWriter(ctx context.Context, key string, expire *time.Time) (MultipartWriter, error) {
+	panic("not implemented")
+}
+
+func TestS3FSIOCounters(t *testing.T) {
+	ctx := context.Background()
+	fs := newObjectStorageFS("s3", &memObjectStorage{
+		objects: make(map[string][]byte),
+	}, "")
+
+	var ctxSet perfcounter.CounterSet
+	err := fs.Write(perfcounter.WithCounterSet(ctx, &ctxSet), IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Size: 4, Data: []byte("abcd")},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), ctxSet.FileService.S3.Put.Load())
+	assert.Equal(t, int64(4), ctxSet.FileService.S3.WriteBytes.Load())
+
+	// the io of a vector with a statement id is counted in the registered counter set,
+	// without the statement in the context
+	var stmtSet perfcounter.CounterSet
+	perfcounter.RegisterStatement("stmt", &stmtSet)
+	defer perfcounter.UnregisterStatement("stmt")
+	vec := &IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Offset: 1, Size: 2},
+		},
+		StatementID: "stmt",
+	}
+	err = fs.Read(ctx, vec)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("bc"), vec.Entries[0].Data)
+	assert.Equal(t, int64(1), stmtSet.FileService.S3.Get.Load())
+	assert.Equal(t, int64(2), stmtSet.FileService.S3.ReadBytes.Load())
+	assert.Equal(t, int64(0), ctxSet.FileService.S3.ReadBytes.Load())
+
+	// counted once with the statement in the context too
+	err = fs.Read(perfcounter.WithStatementID(ctx, "stmt"), &IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{Size: 4},
+		},
+		StatementID: "stmt",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), stmtSet.FileService.S3.Get.Load())
+	assert.Equal(t, int64(6), stmtSet.FileService.S3.ReadBytes.Load())
+}
@@ -0,0 +1,999 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"go.uber.org/zap"
+)
+
+// Tier is the storage tier of an object
+type Tier uint8
+
+const (
+	HotTier Tier = iota
+	ColdTier
+)
+
+func (t Tier) String() string {
+	switch t {
+	case HotTier:
+		return "hot"
+	case ColdTier:
+		return "cold"
+	}
+	return "unknown"
+}
+
+// snapshots of the tier catalog are saved in this dir of the hot tier
+const tierCatalogDir = "tiering"
+
+// changes of the catalog made by the services not owning it are saved in this dir
+var tierJournalDir = path.Join(tierCatalogDir, "journal")
+
+const defaultTieringCheckInterval = time.Minute
+
+// TieringPolicy decides when an object moves to the cold tier
+type TieringPolicy struct {
+	// ColdAfter is the minimum age of cold objects, objects never move if it is zero
+	ColdAfter toml.Duration `toml:"cold-after"`
+	// MinAccesses objects read at least MinAccesses times in the last ColdAfter stay hot
+	MinAccesses int `toml:"min-accesses"`
+}
+
+// TieringConfig tiered storage config
+type TieringConfig struct {
+	// Cold is the archival tier, tiering is disabled if it is nil
+	Cold *Config `toml:"cold"`
+	// CheckInterval is the interval of moving cold objects
+	CheckInterval toml.Duration `toml:"check-interval"`
+	// Default is the policy of objects without an account or table policy
+	Default TieringPolicy `toml:"default"`
+	// Accounts are policies by account id
+	Accounts map[string]TieringPolicy `toml:"accounts"`
+	// Tables are policies by table id, prior to account policies
+	Tables map[string]TieringPolicy `toml:"tables"`
+	// Owner is the type of the service owning the catalog, DN by default.
+	// The owner merges the changes of the other services into the catalog and moves cold objects,
+	// the other services report their changes and reload the catalog saved by the owner
+	Owner string `toml:"owner"`
+
+	// ServiceType and NodeID are the service creating the file service, set by the service.
+	// The file service owns the catalog if ServiceType is empty
+	ServiceType string `toml:"-"`
+	NodeID      string `toml:"-"`
+}
+
+const defaultTieringOwner = "DN"
+
+// TieringTagKey is the context key of the TieringTag of written objects
+type TieringTagKey struct{}
+
+// TieringTag tells which account and table an object belongs to
+type TieringTag struct {
+	AccountID uint32
+	TableID   uint64
+}
+
+// WithTieringTag returns a context to write objects of the table
+func WithTieringTag(ctx context.Context, accountID uint32, tableID uint64) context.Context {
+	return context.WithValue(ctx, TieringTagKey{}, TieringTag{
+		AccountID: accountID,
+		TableID:   tableID,
+	})
+}
+
+func tieringTagFromContext(ctx context.Context) TieringTag {
+	if tag, ok := ctx.Value(TieringTagKey{}).(TieringTag); ok {
+		return tag
+	}
+	var tag TieringTag
+	if accountID, ok := ctx.Value(defines.TenantIDKey{}).(uint32); ok {
+		tag.AccountID = accountID
+	}
+	return tag
+}
+
+// tierEntry is the catalog entry of an object
+type tierEntry struct {
+	Tier      Tier       `json:"tier"`
+	Tag       TieringTag `json:"tag"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	// access statistics are not persisted
+	accesses    int
+	windowStart time.Time
+}
+
+// tierRecord is a change of the catalog made by a service not owning it
+type tierRecord struct {
+	File      string     `json:"file"`
+	Deleted   bool       `json:"deleted,omitempty"`
+	Tag       TieringTag `json:"tag"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// TieredFS is a FileService placing objects in a hot tier and an archival cold tier.
+// New objects are written to the hot tier, and move to the cold tier by the TieringPolicy.
+// A catalog tracks where each object lives, readers are unaware of the tiers.
+// Objects not in the catalog, like the ones written before tiering, are looked up in both tiers.
+//
+// The catalog is shared by the services on the same storage. Only the owner moves objects and
+// saves the catalog, the other services journal their writes and deletes for the owner to merge.
+type TieredFS struct {
+	name   string
+	hot    FileService
+	cold   FileService
+	owner  bool
+	nodeID string
+
+	defaultPolicy TieringPolicy
+	accounts      map[uint32]TieringPolicy
+	tables        map[uint64]TieringPolicy
+	now           func() time.Time
+
+	mu struct {
+		sync.Mutex
+		entries map[string]*tierEntry
+		dirty   bool
+		// changes not saved, of the services not owning the catalog
+		journal []tierRecord
+		// saved journals not merged by the owner yet, by name
+		journals   map[string][]tierRecord
+		journalSeq int64
+	}
+	// serializes moving and saving
+	moveMu sync.Mutex
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	wg       sync.WaitGroup
+}
+
+var _ CachingFileService = new(TieredFS)
+
+// NewTieredFS creates a TieredFS named name, and loads the catalog from the hot tier
+func NewTieredFS(
+	ctx context.Context,
+	name string,
+	hot FileService,
+	cold FileService,
+	config TieringConfig,
+) (*TieredFS, error) {
+	t := &TieredFS{
+		name:          name,
+		hot:           hot,
+		cold:          cold,
+		defaultPolicy: config.Default,
+		accounts:      make(map[uint32]TieringPolicy),
+		tables:        make(map[uint64]TieringPolicy),
+		now:           time.Now,
+		stop:          make(chan struct{}),
+	}
+	if config.ServiceType == "" {
+		t.owner = true
+	} else {
+		owner := config.Owner
+		if owner == "" {
+			owner = defaultTieringOwner
+		}
+		t.owner = strings.EqualFold(owner, config.ServiceType)
+	}
+	t.nodeID = config.NodeID
+	if t.nodeID == "" {
+		t.nodeID = uuid.NewString()
+	}
+	t.mu.entries = make(map[string]*tierEntry)
+	t.mu.journals = make(map[string][]tierRecord)
+	for k, policy := range config.Accounts {
+		id, err := strconv.ParseUint(k, 10, 32)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("bad account id of tiering policy: %s", k)
+		}
+		t.accounts[uint32(id)] = policy
+	}
+	for k, policy := range config.Tables {
+		id, err := strconv.ParseUint(k, 10, 64)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("bad table id of tiering policy: %s", k)
+		}
+		t.tables[id] = policy
+	}
+	if err := t.loadCatalog(ctx); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Start starts syncing the catalog every interval in background,
+// the owner moves cold objects too
+func (t *TieredFS) Start(interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultTieringCheckInterval
+	}
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-t.stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), interval*10)
+				if !t.owner {
+					if err := t.syncCatalog(ctx); err != nil {
+						logutil.Error("sync tier catalog failed", zap.String("fs", t.name), zap.Error(err))
+					}
+					cancel()
+					continue
+				}
+				moved, err := t.MoveColdObjects(ctx)
+				cancel()
+				if err != nil {
+					logutil.Error("move cold objects failed", zap.String("fs", t.name), zap.Error(err))
+				} else if moved > 0 {
+					logutil.Info("moved cold objects", zap.String("fs", t.name), zap.Int("count", moved))
+				}
+			}
+		}
+	}()
+}
+
+var _ ClosableFileService = new(TieredFS)
+
+// Close stops the background jobs, and saves the changes of the catalog not saved yet
+func (t *TieredFS) Close() {
+	t.stopOnce.Do(func() {
+		close(t.stop)
+	})
+	t.wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	var err error
+	if t.owner {
+		t.moveMu.Lock()
+		err = t.saveCatalog(ctx)
+		t.moveMu.Unlock()
+	} else {
+		err = t.flushJournal(ctx)
+	}
+	if err != nil {
+		logutil.Error("save tier catalog failed", zap.String("fs", t.name), zap.Error(err))
+	}
+}
+
+func (t *TieredFS) Name() string {
+	return t.name
+}
+
+func (t *TieredFS) tierFS(tier Tier) FileService {
+	if tier == ColdTier {
+		return t.cold
+	}
+	return t.hot
+}
+
+// toTierPath returns the file name and the path in the tier
+func (t *TieredFS) toTierPath(filePath string, tier Tier) (string, string, error) {
+	parsed, err := ParsePathAtService(filePath, t.name)
+	if err != nil {
+		return "", "", err
+	}
+	file := parsed.File
+	parsed.Service = t.tierFS(tier).Name()
+	parsed.ServiceArguments = nil
+	return file, parsed.String(), nil
+}
+
+// lookup returns the tiers to access an object, in order
+func (t *TieredFS) lookup(file string, access bool) []Tier {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entry, ok := t.mu.entries[file]
+	if !ok {
+		return []Tier{HotTier, ColdTier}
+	}
+	if access {
+		entry.accesses++
+	}
+	if entry.Tier == ColdTier {
+		return []Tier{ColdTier}
+	}
+	// the object may be moved concurrently
+	return []Tier{HotTier, ColdTier}
+}
+
+// found records the tier of an object not in the catalog
+func (t *TieredFS) found(file string, tier Tier) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if entry, ok := t.mu.entries[file]; ok {
+		entry.Tier = tier
+		return
+	}
+	now := t.now()
+	t.mu.entries[file] = &tierEntry{
+		Tier:        tier,
+		CreatedAt:   now,
+		windowStart: now,
+	}
+	t.mu.dirty = true
+}
+
+func (t *TieredFS) Write(ctx context.Context, vector IOVector) error {
+	file, p, err := t.toTierPath(vector.FilePath, HotTier)
+	if err != nil {
+		return err
+	}
+	if file == tierCatalogDir || strings.HasPrefix(file, tierCatalogDir+"/") {
+		return moerr.NewInvalidInput(ctx, "%s is reserved by tiered file service", tierCatalogDir)
+	}
+	// the hot tier does not know objects moved to the cold tier
+	if tier, ok := t.Tier(vector.FilePath); ok && tier == ColdTier {
+		return moerr.NewFileAlreadyExistsNoCtx(vector.FilePath)
+	}
+	vector.FilePath = p
+	if err := t.hot.Write(ctx, vector); err != nil {
+		return err
+	}
+
+	now := t.now()
+	tag := tieringTagFromContext(ctx)
+	t.mu.Lock()
+	t.mu.entries[file] = &tierEntry{
+		Tier:        HotTier,
+		Tag:         tag,
+		CreatedAt:   now,
+		windowStart: now,
+	}
+	t.mu.dirty = true
+	if !t.owner {
+		t.mu.journal = append(t.mu.journal, tierRecord{
+			File:      file,
+			Tag:       tag,
+			CreatedAt: now,
+		})
+	}
+	t.mu.Unlock()
+	return nil
+}
+
+func (t *TieredFS) Read(ctx context.Context, vector *IOVector) (err error) {
+	file, _, err := t.toTierPath(vector.FilePath, HotTier)
+	if err != nil {
+		return err
+	}
+	filePath := vector.FilePath
+	defer func() {
+		vector.FilePath = filePath
+	}()
+	tiers := t.lookup(file, !vector.Preloading)
+	for i, tier := range tiers {
+		_, vector.FilePath, _ = t.toTierPath(filePath, tier)
+		err = t.tierFS(tier).Read(ctx, vector)
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) && i < len(tiers)-1 {
+			continue
+		}
+		if err == nil && len(tiers) > 1 {
+			t.found(file, tier)
+		}
+		return err
+	}
+	return
+}
+
+func (t *TieredFS) List(ctx context.Context, dirPath string) ([]DirEntry, error) {
+	dir, hotPath, err := t.toTierPath(dirPath, HotTier)
+	if err != nil {
+		return nil, err
+	}
+	_, coldPath, _ := t.toTierPath(dirPath, ColdTier)
+	hotEntries, err := t.hot.List(ctx, hotPath)
+	if err != nil {
+		return nil, err
+	}
+	coldEntries, err := t.cold.List(ctx, coldPath)
+	if err != nil {
+		return nil, err
+	}
+
+	isRoot := strings.Trim(dir, "/") == ""
+	seen := make(map[string]bool, len(hotEntries)+len(coldEntries))
+	entries := make([]DirEntry, 0, len(hotEntries)+len(coldEntries))
+	for _, entry := range append(hotEntries, coldEntries...) {
+		if isRoot && entry.Name == tierCatalogDir {
+			continue
+		}
+		// a moving object may be in both tiers
+		if seen[entry.Name] {
+			continue
+		}
+		seen[entry.Name] = true
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (t *TieredFS) Delete(ctx context.Context, filePaths ...string) error {
+	for _, filePath := range filePaths {
+		file, _, err := t.toTierPath(filePath, HotTier)
+		if err != nil {
+			return err
+		}
+		for _, tier := range t.lookup(file, false) {
+			_, p, _ := t.toTierPath(filePath, tier)
+			err := t.tierFS(tier).Delete(ctx, p)
+			if err != nil && !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+				return err
+			}
+		}
+		t.mu.Lock()
+		if _, ok := t.mu.entries[file]; ok {
+			delete(t.mu.entries, file)
+			t.mu.dirty = true
+		}
+		if !t.owner {
+			t.mu.journal = append(t.mu.journal, tierRecord{
+				File:    file,
+				Deleted: true,
+			})
+		}
+		t.mu.Unlock()
+	}
+	return nil
+}
+
+func (t *TieredFS) StatFile(ctx context.Context, filePath string) (entry *DirEntry, err error) {
+	file, _, err := t.toTierPath(filePath, HotTier)
+	if err != nil {
+		return nil, err
+	}
+	tiers := t.lookup(file, false)
+	for i, tier := range tiers {
+		_, p, _ := t.toTierPath(filePath, tier)
+		entry, err = t.tierFS(tier).StatFile(ctx, p)
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) && i < len(tiers)-1 {
+			continue
+		}
+		if err == nil {
+			entry.Name = path.Base(file)
+		}
+		return
+	}
+	return
+}
+
+var _ ReplaceableFileService = new(TieredFS)
+
+// Replace replaces the object in its tier, a new object is written to the hot tier
+func (t *TieredFS) Replace(ctx context.Context, vector IOVector) error {
+	file, _, err := t.toTierPath(vector.FilePath, HotTier)
+	if err != nil {
+		return err
+	}
+	target := HotTier
+	for _, tier := range t.lookup(file, false) {
+		_, p, _ := t.toTierPath(vector.FilePath, tier)
+		_, err := t.tierFS(tier).StatFile(ctx, p)
+		if err == nil {
+			target = tier
+			break
+		}
+		if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return err
+		}
+	}
+	fs, ok := t.tierFS(target).(ReplaceableFileService)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "replace files of %s", t.tierFS(target).Name())
+	}
+	_, vector.FilePath, _ = t.toTierPath(vector.FilePath, target)
+	if err := fs.Replace(ctx, vector); err != nil {
+		return err
+	}
+	t.found(file, target)
+	return nil
+}
+
+func (t *TieredFS) Preload(ctx context.Context, filePath string) error {
+	file, _, err := t.toTierPath(filePath, HotTier)
+	if err != nil {
+		return err
+	}
+	tier := t.lookup(file, false)[0]
+	_, p, _ := t.toTierPath(filePath, tier)
+	return t.tierFS(tier).Preload(ctx, p)
+}
+
+func (t *TieredFS) FlushCache() {
+	for _, fs := range []FileService{t.hot, t.cold} {
+		if fs, ok := fs.(CachingFileService); ok {
+			fs.FlushCache()
+		}
+	}
+}
+
+func (t *TieredFS) SetAsyncUpdate(b bool) {
+	for _, fs := range []FileService{t.hot, t.cold} {
+		if fs, ok := fs.(CachingFileService); ok {
+			fs.SetAsyncUpdate(b)
+		}
+	}
+}
+
+var _ HotKeysFileService = new(TieredFS)
+
+// HotKeys returns the keys of the memory caches of both tiers, alternately
+func (t *TieredFS) HotKeys(limit int) []HotKey {
+	var tiers [][]HotKey
+	for _, fs := range []FileService{t.hot, t.cold} {
+		if fs, ok := fs.(HotKeysFileService); ok {
+			tiers = append(tiers, fs.HotKeys(limit))
+		}
+	}
+	var keys []HotKey
+	for i := 0; limit < 0 || len(keys) < limit; i++ {
+		added := false
+		for _, tierKeys := range tiers {
+			if i < len(tierKeys) && (limit < 0 || len(keys) < limit) {
+				keys = append(keys, tierKeys[i])
+				added = true
+			}
+		}
+		if !added {
+			break
+		}
+	}
+	return keys
+}
+
+// memoryCache returns the memory cache of the hot tier
+func (t *TieredFS) memoryCache() *MemCache {
+	if holder, ok := t.hot.(memoryCacheHolder); ok {
+		return holder.memoryCache()
+	}
+	return nil
+}
+
+// Tier returns the tier of an object in the catalog
+func (t *TieredFS) Tier(filePath string) (Tier, bool) {
+	parsed, err := ParsePathAtService(filePath, t.name)
+	if err != nil {
+		return 0, false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entry, ok := t.mu.entries[parsed.File]
+	if !ok {
+		return 0, false
+	}
+	return entry.Tier, true
+}
+
+func (t *TieredFS) policyOf(tag TieringTag) TieringPolicy {
+	if policy, ok := t.tables[tag.TableID]; ok && tag.TableID != 0 {
+		return policy
+	}
+	if policy, ok := t.accounts[tag.AccountID]; ok {
+		return policy
+	}
+	return t.defaultPolicy
+}
+
+// isCold reports whether a hot object should move to the cold tier,
+// and starts a new access window if the last one is over
+func (t *TieredFS) isCold(entry *tierEntry, now time.Time) bool {
+	policy := t.policyOf(entry.Tag)
+	coldAfter := policy.ColdAfter.Duration
+	if coldAfter <= 0 || now.Sub(entry.CreatedAt) < coldAfter {
+		return false
+	}
+	if policy.MinAccesses <= 0 {
+		return true
+	}
+	if now.Sub(entry.windowStart) < coldAfter {
+		return false
+	}
+	if entry.accesses < policy.MinAccesses {
+		return true
+	}
+	entry.accesses = 0
+	entry.windowStart = now
+	return false
+}
+
+// MoveColdObjects merges the journals of the other services, moves cold objects to the cold tier,
+// and saves the catalog. Only the owner of the catalog moves objects
+func (t *TieredFS) MoveColdObjects(ctx context.Context) (moved int, err error) {
+	if !t.owner {
+		return 0, moerr.NewNotSupported(ctx, "move cold objects of %s on a service not owning the catalog", t.name)
+	}
+	t.moveMu.Lock()
+	defer t.moveMu.Unlock()
+
+	journals, err := t.mergeJournals(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := t.now()
+	var files []string
+	t.mu.Lock()
+	for file, entry := range t.mu.entries {
+		if entry.Tier == HotTier && t.isCold(entry, now) {
+			files = append(files, file)
+		}
+	}
+	t.mu.Unlock()
+	sort.Strings(files)
+
+	for _, file := range files {
+		if err := t.moveToCold(ctx, file); err != nil {
+			return moved, err
+		}
+		moved++
+	}
+
+	if err := t.saveCatalog(ctx); err != nil {
+		return moved, err
+	}
+	// merged journals are deleted after saved in the catalog,
+	// the other services keep their changes until then
+	for _, name := range journals {
+		if err := t.hot.Delete(ctx, JoinPath(t.hot.Name(), path.Join(tierJournalDir, name))); err != nil &&
+			!moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return moved, err
+		}
+	}
+	return moved, nil
+}
+
+func (t *TieredFS) moveToCold(ctx context.Context, file string) error {
+	hotPath := JoinPath(t.hot.Name(), file)
+	stat, err := t.hot.StatFile(ctx, hotPath)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		// deleted
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// the object is copied as it is read
+	var reader io.ReadCloser
+	err = t.hot.Read(ctx, &IOVector{
+		FilePath: hotPath,
+		Entries: []IOEntry{
+			{Offset: 0, Size: stat.Size, ReadCloserForRead: &reader},
+		},
+		NoCache: true,
+	})
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	err = t.cold.Write(ctx, IOVector{
+		FilePath: JoinPath(t.cold.Name(), file),
+		Entries: []IOEntry{
+			{Offset: 0, Size: stat.Size, ReaderForWrite: reader},
+		},
+	})
+	// already copied by an interrupted moving
+	if err != nil && !moerr.IsMoErrCode(err, moerr.ErrFileAlreadyExists) {
+		return err
+	}
+
+	t.mu.Lock()
+	entry, ok := t.mu.entries[file]
+	if ok {
+		entry.Tier = ColdTier
+		t.mu.dirty = true
+	}
+	t.mu.Unlock()
+	if !ok {
+		// deleted while moving
+		return t.cold.Delete(ctx, JoinPath(t.cold.Name(), file))
+	}
+
+	// readers retry the cold tier if the object is deleted after looking up
+	err = t.hot.Delete(ctx, JoinPath(t.hot.Name(), file))
+	if err != nil && !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return err
+	}
+	return nil
+}
+
+// saveCatalog saves a new catalog snapshot to the hot tier, and deletes the old ones
+func (t *TieredFS) saveCatalog(ctx context.Context) error {
+	t.mu.Lock()
+	if !t.mu.dirty {
+		t.mu.Unlock()
+		return nil
+	}
+	data, err := json.Marshal(t.mu.entries)
+	t.mu.dirty = false
+	t.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	olds, err := t.catalogSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+	seq := t.now().UnixNano()
+	if len(olds) > 0 {
+		if last, err := strconv.ParseInt(olds[len(olds)-1], 10, 64); err == nil && last >= seq {
+			seq = last + 1
+		}
+	}
+	name := fmt.Sprintf("%020d", seq)
+	err = t.hot.Write(ctx, IOVector{
+		FilePath: JoinPath(t.hot.Name(), path.Join(tierCatalogDir, name)),
+		Entries: []IOEntry{
+			{Offset: 0, Size: int64(len(data)), Data: data},
+		},
+	})
+	if err != nil {
+		t.mu.Lock()
+		t.mu.dirty = true
+		t.mu.Unlock()
+		return err
+	}
+	for _, old := range olds {
+		if err := t.hot.Delete(ctx, JoinPath(t.hot.Name(), path.Join(tierCatalogDir, old))); err != nil &&
+			!moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// catalogSnapshots returns the names of catalog snapshots, in order
+func (t *TieredFS) catalogSnapshots(ctx context.Context) ([]string, error) {
+	return t.listCatalogFiles(ctx, tierCatalogDir)
+}
+
+// listCatalogFiles returns the names of the files in dir of the hot tier, in order
+func (t *TieredFS) listCatalogFiles(ctx context.Context, dir string) ([]string, error) {
+	entries, err := t.hot.List(ctx, JoinPath(t.hot.Name(), dir))
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir {
+			names = append(names, entry.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (t *TieredFS) loadCatalog(ctx context.Context) error {
+	entries, err := t.readCatalog(ctx)
+	if err != nil || entries == nil {
+		return err
+	}
+	t.mu.Lock()
+	t.mu.entries = entries
+	t.mu.Unlock()
+	return nil
+}
+
+// readCatalog reads the latest catalog snapshot, it returns nil if there is none
+func (t *TieredFS) readCatalog(ctx context.Context) (map[string]*tierEntry, error) {
+	names, err := t.catalogSnapshots(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	vec := &IOVector{
+		FilePath: JoinPath(t.hot.Name(), path.Join(tierCatalogDir, names[len(names)-1])),
+		Entries: []IOEntry{
+			{Offset: 0, Size: -1},
+		},
+	}
+	if err := t.hot.Read(ctx, vec); err != nil {
+		return nil, err
+	}
+	entries := make(map[string]*tierEntry)
+	if err := json.Unmarshal(vec.Entries[0].Data, &entries); err != nil {
+		return nil, err
+	}
+	now := t.now()
+	for _, entry := range entries {
+		entry.windowStart = now
+	}
+	return entries, nil
+}
+
+// flushJournal saves the changes of the catalog made by this service for the owner to merge
+func (t *TieredFS) flushJournal(ctx context.Context) error {
+	t.mu.Lock()
+	records := t.mu.journal
+	t.mu.journal = nil
+	seq := t.now().UnixNano()
+	if seq <= t.mu.journalSeq {
+		seq = t.mu.journalSeq + 1
+	}
+	t.mu.journalSeq = seq
+	t.mu.Unlock()
+	if len(records) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(records)
+	if err == nil {
+		name := fmt.Sprintf("%s-%020d", t.nodeID, seq)
+		err = t.hot.Write(ctx, IOVector{
+			FilePath: JoinPath(t.hot.Name(), path.Join(tierJournalDir, name)),
+			Entries: []IOEntry{
+				{Offset: 0, Size: int64(len(data)), Data: data},
+			},
+		})
+		if err == nil {
+			t.mu.Lock()
+			t.mu.journals[name] = records
+			t.mu.Unlock()
+			return nil
+		}
+	}
+	t.mu.Lock()
+	t.mu.journal = append(records, t.mu.journal...)
+	t.mu.Unlock()
+	return err
+}
+
+// syncCatalog saves the changes of this service, and reloads the catalog saved by the owner
+// with the changes of this service not merged yet
+func (t *TieredFS) syncCatalog(ctx context.Context) error {
+	if err := t.flushJournal(ctx); err != nil {
+		return err
+	}
+	// the owner saves the catalog before deleting the merged journals,
+	// so a journal not listed here is in the catalog read below
+	names, err := t.listCatalogFiles(ctx, tierJournalDir)
+	if err != nil {
+		return err
+	}
+	entries, err := t.readCatalog(ctx)
+	if err != nil || entries == nil {
+		return err
+	}
+	listed := make(map[string]bool, len(names))
+	for _, name := range names {
+		listed[name] = true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var pending []string
+	for name := range t.mu.journals {
+		if !listed[name] {
+			delete(t.mu.journals, name)
+			continue
+		}
+		pending = append(pending, name)
+	}
+	sort.Strings(pending)
+	var records []tierRecord
+	for _, name := range pending {
+		records = append(records, t.mu.journals[name]...)
+	}
+	records = append(records, t.mu.journal...)
+	now := t.now()
+	for _, record := range records {
+		if record.Deleted {
+			delete(entries, record.File)
+			continue
+		}
+		if _, ok := entries[record.File]; ok {
+			continue
+		}
+		if entry, ok := t.mu.entries[record.File]; ok {
+			entries[record.File] = entry
+			continue
+		}
+		entries[record.File] = &tierEntry{
+			Tier:        HotTier,
+			Tag:         record.Tag,
+			CreatedAt:   record.CreatedAt,
+			windowStart: now,
+		}
+	}
+	t.mu.entries = entries
+	return nil
+}
+
+// mergeJournals applies the journals of the other services to the catalog, and returns their names
+func (t *TieredFS) mergeJournals(ctx context.Context) ([]string, error) {
+	names, err := t.listCatalogFiles(ctx, tierJournalDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		vec := &IOVector{
+			FilePath: JoinPath(t.hot.Name(), path.Join(tierJournalDir, name)),
+			Entries: []IOEntry{
+				{Offset: 0, Size: -1},
+			},
+			NoCache: true,
+		}
+		if err := t.hot.Read(ctx, vec); err != nil {
+			return nil, err
+		}
+		var records []tierRecord
+		if err := json.Unmarshal(vec.Entries[0].Data, &records); err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			if record.Deleted {
+				// the deleting service may not know the object was moved
+				err := t.cold.Delete(ctx, JoinPath(t.cold.Name(), record.File))
+				if err != nil && !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+					return nil, err
+				}
+				t.mu.Lock()
+				delete(t.mu.entries, record.File)
+				t.mu.dirty = true
+				t.mu.Unlock()
+				continue
+			}
+			t.mu.Lock()
+			if entry, ok := t.mu.entries[record.File]; ok {
+				// found by reading before merged
+				if entry.Tag == (TieringTag{}) {
+					entry.Tag = record.Tag
+				}
+				if record.CreatedAt.Before(entry.CreatedAt) {
+					entry.CreatedAt = record.CreatedAt
+				}
+			} else {
+				t.mu.entries[record.File] = &tierEntry{
+					Tier:        HotTier,
+					Tag:         record.Tag,
+					CreatedAt:   record.CreatedAt,
+					windowStart: t.now(),
+				}
+			}
+			t.mu.dirty = true
+			t.mu.Unlock()
+		}
+	}
+	return names, nil
+}
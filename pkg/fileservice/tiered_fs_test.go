@@ -0,0 +1,313 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestTieredFS(t *testing.T, name string, hot FileService, cold FileService, config TieringConfig) *TieredFS {
+	fs, err := NewTieredFS(context.Background(), name, hot, cold, config)
+	assert.Nil(t, err)
+	return fs
+}
+
+func TestTieredFS(t *testing.T) {
+
+	t.Run("file service", func(t *testing.T) {
+		testFileService(t, func(name string) FileService {
+			hot, err := NewMemoryFS(name, DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			cold, err := NewMemoryFS("cold", DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			return newTestTieredFS(t, name, hot, cold, TieringConfig{})
+		})
+	})
+
+	t.Run("cold file service", func(t *testing.T) {
+		testFileService(t, func(name string) FileService {
+			hot, err := NewMemoryFS(name, DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			cold, err := NewLocalFS("cold", t.TempDir(), DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			// everything moves to the cold tier on every read
+			fs := newTestTieredFS(t, name, hot, cold, TieringConfig{
+				Default: TieringPolicy{ColdAfter: toml.Duration{Duration: time.Nanosecond}},
+			})
+			return &movingFS{TieredFS: fs}
+		})
+	})
+
+}
+
+// movingFS moves cold objects before reading
+type movingFS struct {
+	*TieredFS
+}
+
+func (m *movingFS) Read(ctx context.Context, vector *IOVector) error {
+	if _, err := m.MoveColdObjects(ctx); err != nil {
+		return err
+	}
+	return m.TieredFS.Read(ctx, vector)
+}
+
+func TestTieredFSPolicy(t *testing.T) {
+	ctx := context.Background()
+	hot, err := NewMemoryFS("hot", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+	cold, err := NewMemoryFS("cold", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+	hour := toml.Duration{Duration: time.Hour}
+	fs := newTestTieredFS(t, "hot", hot, cold, TieringConfig{
+		Default: TieringPolicy{ColdAfter: hour},
+		Accounts: map[string]TieringPolicy{
+			"1": {ColdAfter: toml.Duration{Duration: 2 * time.Hour}},
+		},
+		Tables: map[string]TieringPolicy{
+			"42": {},
+			"43": {ColdAfter: hour, MinAccesses: 2},
+		},
+	})
+	now := time.Now()
+	fs.now = func() time.Time {
+		return now
+	}
+
+	write := func(ctx context.Context, name string) {
+		err := fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{Offset: 0, Size: int64(len(name)), Data: []byte(name)},
+			},
+		})
+		assert.Nil(t, err)
+	}
+	read := func(name string) {
+		vec := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Offset: 0, Size: -1}},
+		}
+		assert.Nil(t, fs.Read(ctx, vec))
+		assert.Equal(t, []byte(name), vec.Entries[0].Data)
+	}
+	tierOf := func(name string) Tier {
+		tier, ok := fs.Tier(name)
+		assert.True(t, ok)
+		return tier
+	}
+	move := func(d time.Duration, expected int) {
+		now = now.Add(d)
+		moved, err := fs.MoveColdObjects(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, moved)
+	}
+
+	write(ctx, "default")
+	write(context.WithValue(ctx, defines.TenantIDKey{}, uint32(1)), "account")
+	write(WithTieringTag(ctx, 1, 42), "pinned")
+	write(WithTieringTag(ctx, 0, 43), "hot")
+	write(WithTieringTag(ctx, 0, 43), "unread")
+
+	move(time.Minute, 0)
+	read("hot")
+	read("hot")
+
+	move(time.Hour, 2)
+	assert.Equal(t, ColdTier, tierOf("default"))
+	assert.Equal(t, ColdTier, tierOf("unread"))
+	assert.Equal(t, HotTier, tierOf("hot"))
+	assert.Equal(t, HotTier, tierOf("account"))
+	// moved objects are readable and not in the hot tier
+	read("default")
+	_, err = hot.StatFile(ctx, "hot:default")
+	assert.ErrorContains(t, err, "not found")
+
+	// no accesses in the next window
+	move(time.Hour, 2)
+	assert.Equal(t, ColdTier, tierOf("hot"))
+	assert.Equal(t, ColdTier, tierOf("account"))
+	assert.Equal(t, HotTier, tierOf("pinned"))
+
+	// names of cold objects are not reusable
+	err = fs.Write(ctx, IOVector{
+		FilePath: "default",
+		Entries:  []IOEntry{{Offset: 0, Size: 1, Data: []byte("x")}},
+	})
+	assert.Error(t, err)
+
+	// the catalog is reloaded
+	entries, err := fs.List(ctx, "")
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(entries))
+	fs2 := newTestTieredFS(t, "hot", hot, cold, TieringConfig{})
+	tier, ok := fs2.Tier("pinned")
+	assert.True(t, ok)
+	assert.Equal(t, HotTier, tier)
+	tier, ok = fs2.Tier("account")
+	assert.True(t, ok)
+	assert.Equal(t, ColdTier, tier)
+
+	// objects not in the catalog are found in both tiers
+	assert.Nil(t, cold.Write(ctx, IOVector{
+		FilePath: "cold:legacy",
+		Entries:  []IOEntry{{Offset: 0, Size: 6, Data: []byte("legacy")}},
+	}))
+	read("legacy")
+	assert.Equal(t, ColdTier, tierOf("legacy"))
+
+	assert.Nil(t, fs.Delete(ctx, "default", "pinned"))
+	_, err = cold.StatFile(ctx, "cold:default")
+	assert.ErrorContains(t, err, "not found")
+	_, err = hot.StatFile(ctx, "hot:pinned")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestTieredFSHotKeys(t *testing.T) {
+	ctx := context.Background()
+	hot, err := NewLocalFS("hot", t.TempDir(), CacheConfig{MemoryCapacity: 1 << 20}, nil)
+	assert.Nil(t, err)
+	hot.SetAsyncUpdate(false)
+	cold, err := NewLocalFS("cold", t.TempDir(), CacheConfig{MemoryCapacity: 1 << 20}, nil)
+	assert.Nil(t, err)
+	cold.SetAsyncUpdate(false)
+	fs := newTestTieredFS(t, "tiered", hot, cold, TieringConfig{
+		Default: TieringPolicy{ColdAfter: toml.Duration{Duration: time.Nanosecond}},
+	})
+	toObjectBytes := func(r io.Reader, data []byte) ([]byte, int64, error) {
+		return data, int64(len(data)), nil
+	}
+	write := func(name string) {
+		assert.Nil(t, fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Offset: 0, Size: 3, Data: []byte(name)}},
+		}))
+	}
+	read := func(name string) {
+		assert.Nil(t, fs.Read(ctx, &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Offset: 0, Size: 3, ToObjectBytes: toObjectBytes}},
+		}))
+	}
+
+	write("foo")
+	_, err = fs.MoveColdObjects(ctx)
+	assert.Nil(t, err)
+	write("bar")
+	read("foo")
+	read("bar")
+
+	keys := fs.HotKeys(-1)
+	assert.Equal(t, 2, len(keys))
+	assert.Equal(t, "bar", keys[0].Path)
+	assert.Equal(t, "foo", keys[1].Path)
+	assert.Equal(t, 1, len(fs.HotKeys(1)))
+}
+
+func TestTieredFSSharedCatalog(t *testing.T) {
+	ctx := context.Background()
+	hot, err := NewMemoryFS("hot", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+	cold, err := NewMemoryFS("cold", DisabledCacheConfig, nil)
+	assert.Nil(t, err)
+	config := TieringConfig{
+		Default:     TieringPolicy{ColdAfter: toml.Duration{Duration: time.Hour}},
+		ServiceType: "DN",
+		NodeID:      "dn",
+	}
+	owner := newTestTieredFS(t, "hot", hot, cold, config)
+	config.ServiceType = "CN"
+	config.NodeID = "cn"
+	other := newTestTieredFS(t, "hot", hot, cold, config)
+	assert.True(t, owner.owner)
+	assert.False(t, other.owner)
+	now := time.Now()
+	owner.now = func() time.Time {
+		return now
+	}
+
+	write := func(fs *TieredFS, name string) {
+		assert.Nil(t, fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Offset: 0, Size: int64(len(name)), Data: []byte(name)}},
+		}))
+	}
+	read := func(fs *TieredFS, name string) {
+		vec := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Offset: 0, Size: -1}},
+		}
+		assert.Nil(t, fs.Read(ctx, vec))
+		assert.Equal(t, []byte(name), vec.Entries[0].Data)
+	}
+
+	// only the owner moves objects
+	_, err = other.MoveColdObjects(ctx)
+	assert.Error(t, err)
+
+	write(other, "foo")
+	write(other, "bar")
+	write(owner, "baz")
+	assert.Nil(t, other.syncCatalog(ctx))
+	_, ok := owner.Tier("foo")
+	assert.False(t, ok)
+
+	// the journal is merged and moved by the owner
+	now = now.Add(2 * time.Hour)
+	moved, err := owner.MoveColdObjects(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, moved)
+	names, err := owner.listCatalogFiles(ctx, tierJournalDir)
+	assert.Nil(t, err)
+	assert.Empty(t, names)
+
+	// the other service reloads the catalog
+	tier, ok := other.Tier("foo")
+	assert.True(t, ok)
+	assert.Equal(t, HotTier, tier)
+	assert.Nil(t, other.syncCatalog(ctx))
+	tier, ok = other.Tier("baz")
+	assert.True(t, ok)
+	assert.Equal(t, ColdTier, tier)
+	read(other, "foo")
+
+	// deletes of the other service are merged
+	assert.Nil(t, other.Delete(ctx, "bar"))
+	write(other, "qux")
+	other.Close()
+	_, err = owner.MoveColdObjects(ctx)
+	assert.Nil(t, err)
+	_, ok = owner.Tier("bar")
+	assert.False(t, ok)
+	_, ok = owner.Tier("qux")
+	assert.True(t, ok)
+	_, err = cold.StatFile(ctx, "cold:bar")
+	assert.ErrorContains(t, err, "not found")
+	owner.Close()
+
+	fs := newTestTieredFS(t, "hot", hot, cold, TieringConfig{})
+	tier, ok = fs.Tier("foo")
+	assert.True(t, ok)
+	assert.Equal(t, ColdTier, tier)
+	_, ok = fs.Tier("bar")
+	assert.False(t, ok)
+}
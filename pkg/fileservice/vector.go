@@ -14,6 +14,12 @@
 
 package fileservice
 
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+)
+
 func (v *IOVector) allDone() bool {
 	for _, entry := range v.Entries {
 		if !entry.done {
@@ -22,3 +28,12 @@ func (v *IOVector) allDone() bool {
 	}
 	return true
 }
+
+// withStatement returns ctx that counts the I/O in the counter set of v.StatementID
+func (v *IOVector) withStatement(ctx context.Context) context.Context {
+	if v.StatementID == "" ||
+		perfcounter.StatementIDFromContext(ctx) == v.StatementID {
+		return ctx
+	}
+	return perfcounter.WithStatementID(ctx, v.StatementID)
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
 	"github.com/matrixorigin/matrixone/pkg/util/metric/mometric"
 	"github.com/matrixorigin/matrixone/pkg/util/sysview"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
@@ -782,7 +783,8 @@ var (
 				login_type  varchar(16),
 				creator int signed,
 				owner int signed,
-				default_role int signed
+				default_role int signed,
+				tls_require varchar(1024)
     		);`,
 		`create table mo_account(
 				account_id int signed auto_increment primary key,
@@ -983,9 +985,25 @@ var (
 				login_type,
 				creator,
 				owner,
-				default_role
-    		) values(%d,"%s","%s","%s","%s","%s",%s,"%s",%d,%d,%d);`
+				default_role,
+				tls_require
+    		) values(%d,"%s","%s","%s","%s","%s",%s,"%s",%d,%d,%d,"%s");`
 	initMoUserWithoutIDFormat = `insert into mo_catalog.mo_user(
+				user_host,
+				user_name,
+				authentication_string,
+				status,
+				created_time,
+				expired_time,
+				login_type,
+				creator,
+				owner,
+				default_role,
+				tls_require
+    		) values("%s","%s","%s","%s","%s",%s,"%s",%d,%d,%d,"%s");`
+	// initMoUserWithoutTLSRequireFormat is for the mo_user without the
+	// column tls_require, which is created before the column is added.
+	initMoUserWithoutTLSRequireFormat = `insert into mo_catalog.mo_user(
 				user_host,
 				user_name,
 				authentication_string,
@@ -1029,7 +1047,11 @@ const (
 
 	deleteAccountFromMoAccountFormat = `delete from mo_catalog.mo_account where account_name = "%s";`
 
-	getPasswordOfUserFormat = `select user_id,authentication_string,default_role from mo_catalog.mo_user where user_name = "%s";`
+	getPasswordOfUserFormat = `select user_id,authentication_string,default_role,login_type from mo_catalog.mo_user where user_name = "%s";`
+
+	getPasswordAndTLSRequireOfUserFormat = `select user_id,authentication_string,default_role,login_type,tls_require from mo_catalog.mo_user where user_name = "%s";`
+
+	checkTLSRequireOfMoUserFormat = `select attname from mo_catalog.mo_columns where att_database = "mo_catalog" and att_relname = "mo_user" and attname = "tls_require";`
 
 	updatePasswordOfUserFormat = `update mo_catalog.mo_user set authentication_string = "%s" where user_name = "%s";`
 
@@ -1290,6 +1312,8 @@ const (
 	getAccountIdAndStatusFormat = `select account_id,status from mo_catalog.mo_account where account_name = '%s';`
 	getPubInfoForSubFormat      = `select database_name,all_account,account_list from mo_catalog.mo_pubs where pub_name = "%s";`
 	getDbPubCountFormat         = `select count(1) from mo_catalog.mo_pubs where database_name = '%s';`
+
+	getAllAccountIdNamesSql = `select account_id,account_name from mo_catalog.mo_account;`
 )
 
 var (
@@ -1422,6 +1446,14 @@ func getSqlForPasswordOfUser(ctx context.Context, user string) (string, error) {
 	return fmt.Sprintf(getPasswordOfUserFormat, user), nil
 }
 
+func getSqlForPasswordAndTLSRequireOfUser(ctx context.Context, user string) (string, error) {
+	err := inputNameIsInvalid(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(getPasswordAndTLSRequireOfUserFormat, user), nil
+}
+
 func getSqlForUpdatePasswordOfUser(ctx context.Context, password, user string) (string, error) {
 	err := inputNameIsInvalid(ctx, user)
 	if err != nil {
@@ -6523,8 +6555,8 @@ func createTablesInMoCatalog(ctx context.Context, bh BackgroundExec, tenant *Ten
 	//encryption the password
 	encryption := HashPassWord(defaultPassword)
 
-	initMoUser1 := fmt.Sprintf(initMoUserFormat, rootID, rootHost, rootName, encryption, rootStatus, types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType, rootCreatorID, rootOwnerRoleID, rootDefaultRoleID)
-	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, encryption, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID)
+	initMoUser1 := fmt.Sprintf(initMoUserFormat, rootID, rootHost, rootName, encryption, rootStatus, types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType, rootCreatorID, rootOwnerRoleID, rootDefaultRoleID, tlsRequireNone)
+	initMoUser2 := fmt.Sprintf(initMoUserFormat, dumpID, dumpHost, dumpName, encryption, dumpStatus, types.CurrentTimestamp().String2(time.UTC, 0), dumpExpiredTime, dumpLoginType, dumpCreatorID, dumpOwnerRoleID, dumpDefaultRoleID, tlsRequireNone)
 	addSqlIntoSet(initMoUser1)
 	addSqlIntoSet(initMoUser2)
 
@@ -6902,7 +6934,7 @@ func createTablesInMoCatalogOfGeneralTenant2(bh BackgroundExec, ca *tree.CreateA
 	//the first user id in the general tenant
 	initMoUser1 := fmt.Sprintf(initMoUserFormat, newTenant.GetUserID(), rootHost, name, encryption, status,
 		types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType,
-		newTenant.GetUserID(), newTenant.GetDefaultRoleID(), accountAdminRoleID)
+		newTenant.GetUserID(), newTenant.GetDefaultRoleID(), accountAdminRoleID, tlsRequireNone)
 	addSqlIntoSet(initMoUser1)
 
 	//step4: add new entries to the mo_role_privs
@@ -6980,6 +7012,80 @@ func createTablesInSystemOfGeneralTenant(ctx context.Context, bh BackgroundExec,
 	return err
 }
 
+// UpgradeTablesInSystem recreates the tables in the database system of all the accounts,
+// which are created by an older version without the columns added since.
+// the system init and the account creation create them with IF NOT EXISTS,
+// which keeps the ones created before the upgrade.
+func UpgradeTablesInSystem(ctx context.Context, aicm *defines.AutoIncrCacheManager) error {
+	pu := config.GetParameterUnit(ctx)
+	ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(sysAccountID))
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, uint32(rootID))
+	ctx = context.WithValue(ctx, defines.RoleIDKey{}, uint32(moAdminRoleID))
+
+	mp, err := mpool.NewMPool("upgrade_tables_in_system", 0, mpool.NoFixed)
+	if err != nil {
+		return err
+	}
+	defer mpool.DeleteMPool(mp)
+	upstream := &Session{connectCtx: ctx, autoIncrCacheManager: aicm}
+	bh := NewBackgroundHandler(ctx, upstream, mp, pu)
+	defer bh.Close()
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, getAllAccountIdNamesSql); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	type account struct {
+		id   int64
+		name string
+	}
+	var accounts []account
+	if execResultArrayHasData(erArray) {
+		for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+			var a account
+			if a.id, err = erArray[0].GetInt64(ctx, i, 0); err != nil {
+				return err
+			}
+			if a.name, err = erArray[0].GetString(ctx, i, 1); err != nil {
+				return err
+			}
+			accounts = append(accounts, a)
+		}
+	}
+
+	for _, a := range accounts {
+		//SWITCH TO THE CONTEXT of the account
+		accountCtx := context.WithValue(ctx, defines.TenantIDKey{}, uint32(a.id))
+		bh.ClearExecResultSet()
+		if err = bh.Exec(accountCtx, motrace.SqlCheckStatementTableUpgraded()); err != nil {
+			return err
+		}
+		erArray, err = getResultSet(accountCtx, bh)
+		if err != nil {
+			return err
+		}
+		if execResultArrayHasData(erArray) {
+			continue
+		}
+		name := a.name
+		if a.id == sysAccountID {
+			name = table.AccountAll
+		}
+		for _, sql := range motrace.GetUpgradeSchemaForAccount(accountCtx, name) {
+			bh.ClearExecResultSet()
+			if err = bh.Exec(accountCtx, sql); err != nil {
+				return err
+			}
+		}
+		logutil.Infof("upgraded the tables in the database system of the account %s", a.name)
+	}
+	return nil
+}
+
 // createTablesInInformationSchemaOfGeneralTenant creates the database information_schema and the views or tables.
 func createTablesInInformationSchemaOfGeneralTenant(ctx context.Context, bh BackgroundExec, newTenant *TenantInfo) error {
 	ctx, span := trace.Debug(ctx, "createTablesInInformationSchemaOfGeneralTenant")
@@ -7058,6 +7164,10 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 	var newRoleId int64
 	var status string
 	var sql string
+	var authenticator Authenticator
+	var encryption string
+	var requirement tlsRequirement
+	var hasTLSRequire bool
 
 	err = normalizeNamesOfUsers(ctx, cu.Users)
 	if err != nil {
@@ -7127,6 +7237,27 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 		}
 	}
 
+	//the REQUIRE clause
+	requirement, err = newTLSRequirement(ctx, cu.TlsOpts)
+	if err != nil {
+		goto handleFailed
+	}
+	//the mo_user created before the column tls_require is added has no such column
+	hasTLSRequire, err = hasTLSRequireColumn(tenant.GetTenantID(), func(sql string) ([]ExecResult, error) {
+		bh.ClearExecResultSet()
+		if err := bh.Exec(ctx, sql); err != nil {
+			return nil, err
+		}
+		return getResultSet(ctx, bh)
+	})
+	if err != nil {
+		goto handleFailed
+	}
+	if !hasTLSRequire && requirement.typ != tlsRequireNone {
+		err = moerr.NewNotSupported(ctx, "REQUIRE in the account whose mo_user has no column tls_require")
+		goto handleFailed
+	}
+
 	//TODO: get password_option or lock_option. there is no field in mo_user to store it.
 	status = userStatusUnlock
 	if cu.MiscOpt != nil {
@@ -7195,28 +7326,45 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *tree.Cr
 			goto handleFailed
 		}
 
-		if user.AuthOption.Typ != tree.AccountIdentifiedByPassword {
+		if user.AuthOption.Typ != tree.AccountIdentifiedByPassword &&
+			user.AuthOption.Typ != tree.AccountIdentifiedWithAuthString {
 			err = moerr.NewInternalError(ctx, "only support password verification now")
 			goto handleFailed
 		}
 
-		password := user.AuthOption.Str
-		if len(password) == 0 {
-			err = moerr.NewInternalError(ctx, "password is empty string")
+		//the authentication plugin makes the authentication string
+		plugin := user.AuthOption.Plugin
+		if len(plugin) == 0 {
+			plugin = AuthNativePassword
+		}
+		authenticator, err = getAuthenticator(ctx, plugin)
+		if err != nil {
+			goto handleFailed
+		}
+		if len(loginTypeOfPlugin(plugin)) > maxLoginTypeLength {
+			err = moerr.NewInternalError(ctx, "the name of the authentication plugin %s is too long", plugin)
+			goto handleFailed
+		}
+		encryption, err = authenticator.AuthString(ctx, user.AuthOption)
+		if err != nil {
 			goto handleFailed
 		}
-
-		//encryption the password
-		encryption := HashPassWord(password)
 
 		//TODO: get comment or attribute. there is no field in mo_user to store it.
 		host = user.Hostname
 		if len(user.Hostname) == 0 || user.Hostname == "%" {
 			host = rootHost
 		}
-		initMoUser1 := fmt.Sprintf(initMoUserWithoutIDFormat, host, user.Username, encryption, status,
-			types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, rootLoginType,
-			tenant.GetUserID(), tenant.GetDefaultRoleID(), newRoleId)
+		var initMoUser1 string
+		if hasTLSRequire {
+			initMoUser1 = fmt.Sprintf(initMoUserWithoutIDFormat, host, user.Username, encryption, status,
+				types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, loginTypeOfPlugin(plugin),
+				tenant.GetUserID(), tenant.GetDefaultRoleID(), newRoleId, requirement.String())
+		} else {
+			initMoUser1 = fmt.Sprintf(initMoUserWithoutTLSRequireFormat, host, user.Username, encryption, status,
+				types.CurrentTimestamp().String2(time.UTC, 0), rootExpiredTime, loginTypeOfPlugin(plugin),
+				tenant.GetUserID(), tenant.GetDefaultRoleID(), newRoleId)
+		}
 
 		bh.ClearExecResultSet()
 		err = bh.Exec(ctx, initMoUser1)
@@ -0,0 +1,383 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	AuthCachingSha2Password string = "caching_sha2_password"
+	AuthClearPassword       string = "mysql_clear_password"
+	AuthLDAPSimple          string = "authentication_ldap_simple"
+)
+
+const (
+	// authMoreDataHeader is the header of the AuthMoreData packet
+	authMoreDataHeader byte = 0x01
+
+	// the status and the request in the caching_sha2_password exchange
+	sha2RequestPublicKey          byte = 0x02
+	sha2FastAuthSuccess           byte = 0x03
+	sha2PerformFullAuthentication byte = 0x04
+
+	// sha2RSAKeyBits is the size of the RSA key used to encrypt the password
+	// in the full authentication without TLS.
+	sha2RSAKeyBits = 2048
+
+	// maxLoginTypeLength is the length of login_type in mo_user
+	maxLoginTypeLength = 16
+)
+
+// loginTypes maps the authentication plugins to the login_type stored in
+// mo_user. The other plugins are stored with their names.
+var loginTypes = map[string]string{
+	AuthNativePassword:      rootLoginType,
+	AuthCachingSha2Password: "SHA2_PASSWORD",
+	AuthLDAPSimple:          "LDAP",
+}
+
+// loginTypeOfPlugin returns the login_type of the authentication plugin.
+func loginTypeOfPlugin(plugin string) string {
+	if t, ok := loginTypes[plugin]; ok {
+		return t
+	}
+	return plugin
+}
+
+// pluginOfLoginType returns the authentication plugin of the login_type. The
+// users created before the authentication plugins use mysql_native_password.
+func pluginOfLoginType(loginType string) string {
+	if len(loginType) == 0 {
+		return AuthNativePassword
+	}
+	for plugin, t := range loginTypes {
+		if strings.EqualFold(t, loginType) {
+			return plugin
+		}
+	}
+	return loginType
+}
+
+// AuthConn is the connection on which the authenticator exchanges the
+// authentication packets with the client.
+type AuthConn interface {
+	// Salt returns the salt sent to the client in the handshake.
+	Salt() []byte
+	// IsTLS returns true if the connection is encrypted by TLS.
+	IsTLS() bool
+	// WritePacket writes the payload of a packet to the client.
+	WritePacket(payload []byte) error
+	// ReadPacket reads the payload of a packet from the client.
+	ReadPacket() ([]byte, error)
+}
+
+// Authenticator is an authentication plugin which checks the credentials of
+// the client for the users identified with it.
+type Authenticator interface {
+	// Name returns the name of the authentication plugin.
+	Name() string
+	// ClientPlugin returns the authentication plugin the client uses to
+	// make the auth response.
+	ClientPlugin() string
+	// AuthString makes the authentication string stored in mo_user from
+	// the auth option of CREATE USER.
+	AuthString(ctx context.Context, option *tree.AccountIdentified) (string, error)
+	// Authenticate checks the auth response of the client made by the
+	// ClientPlugin with the authentication string of the account.
+	Authenticate(ctx context.Context, conn AuthConn, account, authString string, authResponse []byte) error
+}
+
+var authenticators = struct {
+	sync.RWMutex
+	plugins map[string]Authenticator
+}{plugins: make(map[string]Authenticator)}
+
+func init() {
+	RegisterAuthenticator(&nativePasswordAuthenticator{})
+	RegisterAuthenticator(newCachingSha2PasswordAuthenticator())
+}
+
+// RegisterAuthenticator registers the authentication plugin. The plugin with
+// the same name is replaced.
+func RegisterAuthenticator(a Authenticator) {
+	authenticators.Lock()
+	defer authenticators.Unlock()
+	authenticators.plugins[a.Name()] = a
+}
+
+func getAuthenticator(ctx context.Context, plugin string) (Authenticator, error) {
+	authenticators.RLock()
+	defer authenticators.RUnlock()
+	a, ok := authenticators.plugins[plugin]
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "unsupported authentication plugin %s", plugin)
+	}
+	return a, nil
+}
+
+// passwordAuthString makes the authentication string of the password:
+// *SHA1(SHA1(password)).
+func passwordAuthString(ctx context.Context, option *tree.AccountIdentified) (string, error) {
+	if option.Typ != tree.AccountIdentifiedByPassword {
+		return "", moerr.NewInternalError(ctx, "the authentication plugin %s only supports the password", option.Plugin)
+	}
+	if len(option.Str) == 0 {
+		return "", moerr.NewInternalError(ctx, "password is empty string")
+	}
+	return HashPassWord(option.Str), nil
+}
+
+// nativePasswordAuthenticator implements mysql_native_password.
+type nativePasswordAuthenticator struct{}
+
+func (a *nativePasswordAuthenticator) Name() string {
+	return AuthNativePassword
+}
+
+func (a *nativePasswordAuthenticator) ClientPlugin() string {
+	return AuthNativePassword
+}
+
+func (a *nativePasswordAuthenticator) AuthString(ctx context.Context, option *tree.AccountIdentified) (string, error) {
+	return passwordAuthString(ctx, option)
+}
+
+func (a *nativePasswordAuthenticator) Authenticate(ctx context.Context, conn AuthConn, account, authString string, authResponse []byte) error {
+	var pwd []byte
+	if len(authString) != 0 {
+		pwd, _ = GetPassWord(authString)
+	}
+	if !checkNativePassword(pwd, conn.Salt(), authResponse) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	return nil
+}
+
+// sha2CacheEntry is the entry of the fast authentication cache.
+type sha2CacheEntry struct {
+	// authString is the authentication string the entry is made from. The
+	// entry is stale after the password is changed.
+	authString string
+	// digest is SHA256(SHA256(password))
+	digest []byte
+}
+
+// cachingSha2PasswordAuthenticator implements caching_sha2_password. The
+// password is stored in the same form as mysql_native_password. The first
+// login of an account does the full authentication, in which the client
+// sends the password over TLS or encrypted by the RSA public key of the
+// server. The digest of the password is cached after that, then the later
+// logins are checked by the scramble of the client only.
+type cachingSha2PasswordAuthenticator struct {
+	// cache is the fast authentication cache, account -> *sha2CacheEntry
+	cache sync.Map
+
+	keyOnce sync.Once
+	key     *rsa.PrivateKey
+	keyErr  error
+	// publicKey is the PEM encoded public key of key
+	publicKey []byte
+}
+
+func newCachingSha2PasswordAuthenticator() *cachingSha2PasswordAuthenticator {
+	return &cachingSha2PasswordAuthenticator{}
+}
+
+func (a *cachingSha2PasswordAuthenticator) Name() string {
+	return AuthCachingSha2Password
+}
+
+func (a *cachingSha2PasswordAuthenticator) ClientPlugin() string {
+	return AuthCachingSha2Password
+}
+
+func (a *cachingSha2PasswordAuthenticator) AuthString(ctx context.Context, option *tree.AccountIdentified) (string, error) {
+	return passwordAuthString(ctx, option)
+}
+
+func (a *cachingSha2PasswordAuthenticator) Authenticate(ctx context.Context, conn AuthConn, account, authString string, authResponse []byte) error {
+	// the client sends nothing for the empty password
+	if len(authResponse) == 0 {
+		if len(authString) == 0 {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+
+	if a.fastAuthenticate(account, authString, conn.Salt(), authResponse) {
+		return conn.WritePacket([]byte{authMoreDataHeader, sha2FastAuthSuccess})
+	}
+
+	if err := conn.WritePacket([]byte{authMoreDataHeader, sha2PerformFullAuthentication}); err != nil {
+		return err
+	}
+	password, err := a.readPassword(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if len(password) == 0 || HashPassWordWithByte(password) != authString {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	a.cache.Store(account, &sha2CacheEntry{
+		authString: authString,
+		digest:     sha256Sum(sha256Sum(password)),
+	})
+	return nil
+}
+
+// fastAuthenticate checks the scramble with the cached digest of the password.
+// scramble = SHA256(password) XOR SHA256(SHA256(SHA256(password)), salt)
+func (a *cachingSha2PasswordAuthenticator) fastAuthenticate(account, authString string, salt, scramble []byte) bool {
+	v, ok := a.cache.Load(account)
+	if !ok {
+		return false
+	}
+	entry := v.(*sha2CacheEntry)
+	if entry.authString != authString || len(scramble) != sha256.Size {
+		return false
+	}
+	h := sha256.New()
+	h.Write(entry.digest)
+	h.Write(salt)
+	hash1 := h.Sum(nil)
+	for i := range hash1 {
+		hash1[i] ^= scramble[i]
+	}
+	return bytes.Equal(sha256Sum(hash1), entry.digest)
+}
+
+// readPassword reads the password of the full authentication. The client sends
+// the password in clear text over TLS. Otherwise, it asks for the public key
+// and sends the password encrypted by it.
+func (a *cachingSha2PasswordAuthenticator) readPassword(ctx context.Context, conn AuthConn) ([]byte, error) {
+	data, err := conn.ReadPacket()
+	if err != nil {
+		return nil, err
+	}
+	if conn.IsTLS() {
+		return bytes.TrimRight(data, "\x00"), nil
+	}
+
+	if err = a.initKey(); err != nil {
+		return nil, err
+	}
+	// the client may have the public key already
+	if len(data) == 1 && data[0] == sha2RequestPublicKey {
+		payload := append([]byte{authMoreDataHeader}, a.publicKey...)
+		if err = conn.WritePacket(payload); err != nil {
+			return nil, err
+		}
+		if data, err = conn.ReadPacket(); err != nil {
+			return nil, err
+		}
+	}
+
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, a.key, data, nil)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "decrypt the password failed. error:%v", err)
+	}
+	salt := conn.Salt()
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	return bytes.TrimRight(plain, "\x00"), nil
+}
+
+func (a *cachingSha2PasswordAuthenticator) initKey() error {
+	a.keyOnce.Do(func() {
+		a.key, a.keyErr = rsa.GenerateKey(rand.Reader, sha2RSAKeyBits)
+		if a.keyErr != nil {
+			return
+		}
+		var der []byte
+		der, a.keyErr = x509.MarshalPKIXPublicKey(&a.key.PublicKey)
+		if a.keyErr != nil {
+			return
+		}
+		a.publicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	})
+	return a.keyErr
+}
+
+func sha256Sum(data []byte) []byte {
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+// LDAPClient is the client of the LDAP directory.
+type LDAPClient interface {
+	// Bind binds the distinguished name with the password. It returns an
+	// error if the directory rejects the credentials.
+	Bind(ctx context.Context, dn, password string) error
+}
+
+// ldapAuthenticator implements authentication_ldap_simple. The user is created
+// with IDENTIFIED WITH authentication_ldap_simple AS 'dn', and authenticated by
+// binding the dn with the password from the client. The client sends the
+// password in clear text, so the connection should be encrypted by TLS.
+type ldapAuthenticator struct {
+	client LDAPClient
+}
+
+// NewLDAPAuthenticator returns the authentication_ldap_simple plugin which
+// authenticates the users against the directory of the client. It is
+// registered by RegisterAuthenticator.
+func NewLDAPAuthenticator(client LDAPClient) Authenticator {
+	return &ldapAuthenticator{client: client}
+}
+
+func (a *ldapAuthenticator) Name() string {
+	return AuthLDAPSimple
+}
+
+func (a *ldapAuthenticator) ClientPlugin() string {
+	return AuthClearPassword
+}
+
+func (a *ldapAuthenticator) AuthString(ctx context.Context, option *tree.AccountIdentified) (string, error) {
+	if option.Typ != tree.AccountIdentifiedWithAuthString || len(option.Str) == 0 {
+		return "", moerr.NewInternalError(ctx, "the authentication plugin %s needs the dn of the user", AuthLDAPSimple)
+	}
+	return option.Str, nil
+}
+
+func (a *ldapAuthenticator) Authenticate(ctx context.Context, conn AuthConn, account, authString string, authResponse []byte) error {
+	// never accept the password in clear text over the plain connection
+	if !conn.IsTLS() {
+		return moerr.NewInternalError(ctx, "the authentication plugin %s needs the TLS connection", AuthLDAPSimple)
+	}
+	password := string(bytes.TrimRight(authResponse, "\x00"))
+	// the empty password is an unauthenticated bind in LDAP
+	if len(password) == 0 {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	if err := a.client.Bind(ctx, authString, password); err != nil {
+		return moerr.NewInternalError(ctx, "check password failed. error:%v", err)
+	}
+	return nil
+}
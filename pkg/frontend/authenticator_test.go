@@ -0,0 +1,234 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAuthConn simulates the client in the authentication exchange. The
+// client answers every packet from the server by respond.
+type fakeAuthConn struct {
+	salt    []byte
+	tls     bool
+	written [][]byte
+	respond func(packet []byte) []byte
+}
+
+func (c *fakeAuthConn) Salt() []byte {
+	return c.salt
+}
+
+func (c *fakeAuthConn) IsTLS() bool {
+	return c.tls
+}
+
+func (c *fakeAuthConn) WritePacket(payload []byte) error {
+	c.written = append(c.written, payload)
+	return nil
+}
+
+func (c *fakeAuthConn) ReadPacket() ([]byte, error) {
+	return c.respond(c.written[len(c.written)-1]), nil
+}
+
+// fakeLDAPClient is the in-process LDAP directory, dn -> password.
+type fakeLDAPClient map[string]string
+
+func (c fakeLDAPClient) Bind(ctx context.Context, dn, password string) error {
+	if pwd, ok := c[dn]; !ok || pwd != password {
+		return moerr.NewInternalError(ctx, "invalid credentials")
+	}
+	return nil
+}
+
+func testSalt(t *testing.T) []byte {
+	salt := make([]byte, 20)
+	_, err := rand.Read(salt)
+	require.NoError(t, err)
+	return salt
+}
+
+// scrambleSha2 makes the auth response of caching_sha2_password
+func scrambleSha2(password string, salt []byte) []byte {
+	hash1 := sha256.Sum256([]byte(password))
+	hash2 := sha256.Sum256(hash1[:])
+	h := sha256.New()
+	h.Write(hash2[:])
+	h.Write(salt)
+	hash3 := h.Sum(nil)
+	for i := range hash3 {
+		hash3[i] ^= hash1[i]
+	}
+	return hash3
+}
+
+func TestLoginType(t *testing.T) {
+	for _, plugin := range []string{AuthNativePassword, AuthCachingSha2Password, AuthLDAPSimple, "auth_test"} {
+		assert.Equal(t, plugin, pluginOfLoginType(loginTypeOfPlugin(plugin)))
+	}
+	assert.Equal(t, rootLoginType, loginTypeOfPlugin(AuthNativePassword))
+	assert.Equal(t, AuthNativePassword, pluginOfLoginType(""))
+}
+
+func TestNativePasswordAuthenticator(t *testing.T) {
+	ctx := context.TODO()
+	a, err := getAuthenticator(ctx, AuthNativePassword)
+	require.NoError(t, err)
+	authString, err := a.AuthString(ctx, &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: "111"})
+	require.NoError(t, err)
+	assert.Equal(t, HashPassWord("111"), authString)
+
+	salt := testSalt(t)
+	conn := &fakeAuthConn{salt: salt}
+	auth := func(password string) []byte {
+		hash1 := HashSha1([]byte(password))
+		hash3 := HashSha1(append(append([]byte{}, salt...), HashSha1(hash1)...))
+		for i := range hash3 {
+			hash3[i] ^= hash1[i]
+		}
+		return hash3
+	}
+	assert.NoError(t, a.Authenticate(ctx, conn, "sys:u1", authString, auth("111")))
+	assert.Error(t, a.Authenticate(ctx, conn, "sys:u1", authString, auth("222")))
+	assert.Empty(t, conn.written)
+}
+
+func TestCachingSha2PasswordAuthenticator(t *testing.T) {
+	ctx := context.TODO()
+	a := newCachingSha2PasswordAuthenticator()
+	_, err := a.AuthString(ctx, &tree.AccountIdentified{Typ: tree.AccountIdentifiedWithAuthString, Str: "111"})
+	assert.Error(t, err)
+	authString, err := a.AuthString(ctx, &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: "111"})
+	require.NoError(t, err)
+
+	salt := testSalt(t)
+	// the full authentication over TLS
+	conn := &fakeAuthConn{salt: salt, tls: true, respond: func(packet []byte) []byte {
+		assert.Equal(t, []byte{authMoreDataHeader, sha2PerformFullAuthentication}, packet)
+		return []byte("111\x00")
+	}}
+	require.NoError(t, a.Authenticate(ctx, conn, "sys:u1", authString, scrambleSha2("111", salt)))
+	assert.Len(t, conn.written, 1)
+
+	// the fast authentication with the cache
+	salt = testSalt(t)
+	conn = &fakeAuthConn{salt: salt}
+	require.NoError(t, a.Authenticate(ctx, conn, "sys:u1", authString, scrambleSha2("111", salt)))
+	assert.Equal(t, [][]byte{{authMoreDataHeader, sha2FastAuthSuccess}}, conn.written)
+
+	// the wrong password falls back to the full authentication
+	conn = &fakeAuthConn{salt: salt, tls: true, respond: func(packet []byte) []byte {
+		return []byte("222\x00")
+	}}
+	assert.Error(t, a.Authenticate(ctx, conn, "sys:u1", authString, scrambleSha2("222", salt)))
+
+	// the cache is stale after the password is changed
+	newAuthString := HashPassWord("333")
+	conn = &fakeAuthConn{salt: salt, tls: true, respond: func(packet []byte) []byte {
+		return []byte("111\x00")
+	}}
+	assert.Error(t, a.Authenticate(ctx, conn, "sys:u1", newAuthString, scrambleSha2("111", salt)))
+	assert.Equal(t, []byte{authMoreDataHeader, sha2PerformFullAuthentication}, conn.written[0])
+
+	// the empty password
+	assert.Error(t, a.Authenticate(ctx, conn, "sys:u1", authString, nil))
+	assert.NoError(t, a.Authenticate(ctx, conn, "sys:u1", "", nil))
+}
+
+func TestCachingSha2PasswordAuthenticatorRSA(t *testing.T) {
+	ctx := context.TODO()
+	a := newCachingSha2PasswordAuthenticator()
+	authString := HashPassWord("111")
+	salt := testSalt(t)
+
+	encrypt := func(publicKey []byte, password string) []byte {
+		block, _ := pem.Decode(publicKey)
+		require.NotNil(t, block)
+		pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+		require.NoError(t, err)
+		plain := []byte(password + "\x00")
+		for i := range plain {
+			plain[i] ^= salt[i%len(salt)]
+		}
+		data, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, pub.(*rsa.PublicKey), plain, nil)
+		require.NoError(t, err)
+		return data
+	}
+	conn := &fakeAuthConn{salt: salt, respond: func(packet []byte) []byte {
+		if packet[1] == sha2PerformFullAuthentication {
+			return []byte{sha2RequestPublicKey}
+		}
+		assert.Equal(t, authMoreDataHeader, packet[0])
+		return encrypt(packet[1:], "111")
+	}}
+	require.NoError(t, a.Authenticate(ctx, conn, "acc1:u1", authString, scrambleSha2("111", salt)))
+	assert.Len(t, conn.written, 2)
+
+	// the client has the public key already
+	conn = &fakeAuthConn{salt: salt, respond: func(packet []byte) []byte {
+		return encrypt(a.publicKey, "222")
+	}}
+	assert.Error(t, a.Authenticate(ctx, conn, "acc1:u2", authString, scrambleSha2("222", salt)))
+}
+
+func TestLDAPAuthenticator(t *testing.T) {
+	ctx := context.TODO()
+	a := NewLDAPAuthenticator(fakeLDAPClient{"uid=u1,dc=example": "111"})
+	assert.Equal(t, AuthClearPassword, a.ClientPlugin())
+
+	_, err := a.AuthString(ctx, &tree.AccountIdentified{Typ: tree.AccountIdentifiedByPassword, Str: "111"})
+	assert.Error(t, err)
+	authString, err := a.AuthString(ctx, &tree.AccountIdentified{
+		Typ:    tree.AccountIdentifiedWithAuthString,
+		Str:    "uid=u1,dc=example",
+		Plugin: AuthLDAPSimple,
+	})
+	require.NoError(t, err)
+
+	// the password in clear text is refused over the plain connection
+	conn := &fakeAuthConn{salt: testSalt(t)}
+	assert.Error(t, a.Authenticate(ctx, conn, "sys:u1", authString, []byte("111\x00")))
+
+	conn.tls = true
+	assert.NoError(t, a.Authenticate(ctx, conn, "sys:u1", authString, []byte("111\x00")))
+	assert.Error(t, a.Authenticate(ctx, conn, "sys:u1", authString, []byte("222\x00")))
+	assert.Error(t, a.Authenticate(ctx, conn, "sys:u1", authString, []byte{0}))
+	assert.Error(t, a.Authenticate(ctx, conn, "sys:u2", "uid=u2,dc=example", []byte("111\x00")))
+
+	_, err = getAuthenticator(ctx, AuthLDAPSimple)
+	assert.Error(t, err)
+	RegisterAuthenticator(a)
+	defer func() {
+		authenticators.Lock()
+		delete(authenticators.plugins, AuthLDAPSimple)
+		authenticators.Unlock()
+	}()
+	got, err := getAuthenticator(ctx, AuthLDAPSimple)
+	require.NoError(t, err)
+	assert.Equal(t, a, got)
+}
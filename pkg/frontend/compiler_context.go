@@ -391,6 +391,7 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 	var primarykey *plan2.PrimaryKeyDef
 	var indexes []*plan2.IndexDef
 	var refChildTbls []uint64
+	var ttl *plan2.TTLDef
 	var subscriptionName string
 	var pubAccountId int32 = -1
 	if sub != nil {
@@ -462,6 +463,8 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
 					primarykey = k.Pkey
+				case *engine.TTLDef:
+					ttl = k.Ttl
 				}
 			}
 		} else if commnetDef, ok := def.(*engine.CommentDef); ok {
@@ -525,6 +528,7 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
 		Version:      schemaVersion,
+		Ttl:          ttl,
 	}
 	return obj, tableDef
 }
@@ -673,6 +677,10 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef, e *plan2.Expr) (stats
 	return stats
 }
 
+func (tcc *TxnCompilerContext) ResolveParams() []*plan2.Expr {
+	return nil
+}
+
 func (tcc *TxnCompilerContext) GetProcess() *process.Process {
 	tcc.mu.Lock()
 	defer tcc.mu.Unlock()
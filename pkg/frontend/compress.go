@@ -0,0 +1,241 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// compressedHeaderLength is the length of the header of the compressed packet:
+	// int<3> length of the compressed payload, int<1> sequence id and
+	// int<3> length of the payload before compression.
+	compressedHeaderLength = 7
+
+	// minCompressLength is the length under which the payload is sent without
+	// compression, the same as mysql.
+	minCompressLength = 50
+
+	// defaultZstdCompressionLevel is the default compression level of the mysql client.
+	defaultZstdCompressionLevel = 3
+)
+
+var (
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+
+	zstdEncoders = struct {
+		sync.Mutex
+		encoders map[zstd.EncoderLevel]*zstd.Encoder
+	}{encoders: make(map[zstd.EncoderLevel]*zstd.Encoder)}
+)
+
+// getZstdDecoder returns the shared zstd decoder, DecodeAll of which can be
+// called concurrently.
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil,
+			zstd.WithDecoderMaxMemory(uint64(MaxPayloadSize)))
+	})
+	return zstdDecoder, zstdDecoderErr
+}
+
+// getZstdEncoder returns the shared zstd encoder of the compression level,
+// EncodeAll of which can be called concurrently.
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	l := zstd.EncoderLevelFromZstd(level)
+	zstdEncoders.Lock()
+	defer zstdEncoders.Unlock()
+	if e, ok := zstdEncoders.encoders[l]; ok {
+		return e, nil
+	}
+	e, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(l))
+	if err != nil {
+		return nil, err
+	}
+	zstdEncoders.encoders[l] = e
+	return e, nil
+}
+
+// compressedConn implements the compressed packet framing of the mysql protocol
+// on the connection, the mysql packets are read and written through it as the
+// connection without compression. A compressed packet may contain several mysql
+// packets or a part of a mysql packet.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_basic_compression.html
+type compressedConn struct {
+	net.Conn
+	zstd      bool
+	zstdLevel int
+
+	// sequenceID is the sequence id of the next compressed packet to write. It
+	// follows the sequence id of the last compressed packet read, which is reset
+	// by the client at the beginning of every command.
+	sequenceID atomic.Uint32
+
+	// readBuf is the uncompressed data which is not read yet
+	readBuf []byte
+	// writeMu serializes the writes, the proxy writes the connection in
+	// another goroutine.
+	writeMu sync.Mutex
+}
+
+func newCompressedConn(conn net.Conn, capability uint32, zstdLevel uint8) *compressedConn {
+	c := &compressedConn{
+		Conn:      conn,
+		zstd:      capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0,
+		zstdLevel: int(zstdLevel),
+	}
+	if c.zstdLevel == 0 {
+		c.zstdLevel = defaultZstdCompressionLevel
+	}
+	return c
+}
+
+// Read reads the uncompressed data.
+func (c *compressedConn) Read(p []byte) (int, error) {
+	for len(c.readBuf) == 0 {
+		if err := c.readPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.readBuf)
+	c.readBuf = c.readBuf[n:]
+	return n, nil
+}
+
+func (c *compressedConn) readPacket() error {
+	var header [compressedHeaderLength]byte
+	if _, err := io.ReadFull(c.Conn, header[:]); err != nil {
+		return err
+	}
+	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	c.sequenceID.Store(uint32(header[3]) + 1)
+	uncompressedLength := int(uint32(header[4]) | uint32(header[5])<<8 | uint32(header[6])<<16)
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.Conn, payload); err != nil {
+		return err
+	}
+	// the payload is not compressed
+	if uncompressedLength == 0 {
+		c.readBuf = payload
+		return nil
+	}
+	data, err := c.decompress(payload, uncompressedLength)
+	if err != nil {
+		return err
+	}
+	if len(data) != uncompressedLength {
+		return moerr.NewInternalErrorNoCtx("invalid compressed packet, uncompressed length %d, expected %d",
+			len(data), uncompressedLength)
+	}
+	c.readBuf = data
+	return nil
+}
+
+func (c *compressedConn) decompress(payload []byte, uncompressedLength int) ([]byte, error) {
+	if c.zstd {
+		d, err := getZstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		return d.DecodeAll(payload, make([]byte, 0, uncompressedLength))
+	}
+	r, err := zlib.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	// read one more byte to find the payload longer than expected
+	data := make([]byte, uncompressedLength+1)
+	n, err := io.ReadFull(r, data)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+// Write compresses the data and writes it in one or more compressed packets.
+func (c *compressedConn) Write(p []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	written := 0
+	for len(p) > 0 {
+		n := len(p)
+		if n > int(MaxPayloadSize) {
+			n = int(MaxPayloadSize)
+		}
+		if err := c.writePacket(p[:n]); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func (c *compressedConn) writePacket(data []byte) error {
+	payload, uncompressedLength := data, 0
+	if len(data) >= minCompressLength {
+		compressed, err := c.compress(data)
+		if err != nil {
+			return err
+		}
+		// send the data without compression if it can not be compressed
+		if len(compressed) < len(data) {
+			payload, uncompressedLength = compressed, len(data)
+		}
+	}
+
+	packet := make([]byte, compressedHeaderLength, compressedHeaderLength+len(payload))
+	packet[0] = byte(len(payload))
+	packet[1] = byte(len(payload) >> 8)
+	packet[2] = byte(len(payload) >> 16)
+	packet[3] = byte(c.sequenceID.Add(1) - 1)
+	packet[4] = byte(uncompressedLength)
+	packet[5] = byte(uncompressedLength >> 8)
+	packet[6] = byte(uncompressedLength >> 16)
+	packet = append(packet, payload...)
+	_, err := c.Conn.Write(packet)
+	return err
+}
+
+func (c *compressedConn) compress(data []byte) ([]byte, error) {
+	if c.zstd {
+		e, err := getZstdEncoder(c.zstdLevel)
+		if err != nil {
+			return nil, err
+		}
+		return e.EncodeAll(data, make([]byte, 0, len(data))), nil
+	}
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
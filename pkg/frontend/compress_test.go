@@ -0,0 +1,149 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedConn(t *testing.T) {
+	for _, capability := range []uint32{CLIENT_COMPRESS, CLIENT_ZSTD_COMPRESSION_ALGORITHM} {
+		client, server := net.Pipe()
+		cc := newCompressedConn(client, capability, 0)
+		sc := newCompressedConn(server, capability, 0)
+
+		small := []byte{1, 0, 0, 0, byte(COM_PING)}
+		large := bytes.Repeat([]byte("matrixone"), 1<<20)
+		done := make(chan error, 1)
+		go func() {
+			_, err := cc.Write(small)
+			if err == nil {
+				_, err = cc.Write(large)
+			}
+			done <- err
+		}()
+		data := make([]byte, len(small)+len(large))
+		_, err := io.ReadFull(sc, data)
+		require.NoError(t, err)
+		require.NoError(t, <-done)
+		assert.Equal(t, small, data[:len(small)])
+		assert.Equal(t, large, data[len(small):])
+
+		go func() {
+			_, err := sc.Write(large)
+			done <- err
+		}()
+		data = make([]byte, len(large))
+		_, err = io.ReadFull(cc, data)
+		require.NoError(t, err)
+		require.NoError(t, <-done)
+		assert.Equal(t, large, data)
+
+		require.NoError(t, cc.Close())
+		require.NoError(t, sc.Close())
+	}
+}
+
+func TestCompressedConnFraming(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	sc := newCompressedConn(server, CLIENT_COMPRESS, 0)
+	defer sc.Close()
+
+	// the client starts a command with a compressed packet without compression
+	go func() {
+		_, _ = client.Write([]byte{5, 0, 0, 3, 0, 0, 0, 1, 0, 0, 0, byte(COM_PING)})
+	}()
+	data := make([]byte, 5)
+	_, err := io.ReadFull(sc, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 0, 0, 0, byte(COM_PING)}, data)
+
+	payload := bytes.Repeat([]byte{1}, 100)
+	go func() {
+		_, _ = sc.Write(payload)
+		_, _ = sc.Write(payload[:minCompressLength-1])
+	}()
+	header := make([]byte, compressedHeaderLength)
+	_, err = io.ReadFull(client, header)
+	require.NoError(t, err)
+	length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+	// the sequence id follows the packet of the client
+	assert.Equal(t, byte(4), header[3])
+	assert.Equal(t, len(payload), int(header[4])|int(header[5])<<8|int(header[6])<<16)
+	compressed := make([]byte, length)
+	_, err = io.ReadFull(client, compressed)
+	require.NoError(t, err)
+	r, err := zlib.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	uncompressed, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, payload, uncompressed)
+
+	// the short payload is not compressed
+	_, err = io.ReadFull(client, header)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{minCompressLength - 1, 0, 0, 5, 0, 0, 0}, header)
+}
+
+func TestCompressedConnInvalidPacket(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	sc := newCompressedConn(server, CLIENT_COMPRESS, 0)
+	defer sc.Close()
+
+	go func() {
+		_, _ = client.Write([]byte{3, 0, 0, 0, 10, 0, 0, 1, 2, 3})
+	}()
+	_, err := sc.Read(make([]byte, 10))
+	assert.Error(t, err)
+}
+
+func TestEnableCompression(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RawConn().Return(server).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	var conn net.Conn
+	ioses.EXPECT().UseConn(gomock.Any()).Do(func(c net.Conn) { conn = c }).Times(1)
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+
+	proto.capability = DefaultCapability &^ (CLIENT_COMPRESS | CLIENT_ZSTD_COMPRESSION_ALGORITHM)
+	assert.False(t, proto.EnableCompression())
+
+	proto.capability = DefaultCapability & (CLIENT_PROTOCOL_41 | CLIENT_ZSTD_COMPRESSION_ALGORITHM)
+	proto.zstdCompressionLevel = 7
+	assert.True(t, proto.EnableCompression())
+	cc, ok := conn.(*compressedConn)
+	require.True(t, ok)
+	assert.True(t, cc.zstd)
+	assert.Equal(t, 7, cc.zstdLevel)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -91,6 +92,12 @@ type TxnComputationWrapper struct {
 	proc    *process.Process
 	ses     *Session
 	compile *compile.Compile
+	// deps are the tables used by the cached plan, nil if the plan is not
+	// cached.
+	deps *planDeps
+	// paramStmt is the statement whose literals could be replaced by the
+	// parameters, nil if it has no generic plan.
+	paramStmt *paramStmt
 
 	uuid uuid.UUID
 }
@@ -194,7 +201,28 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		cwft.ses.GetTxnHandler().AttachTempStorageToTxnCtx()
 	}
 	cacheHit := cwft.plan != nil
-	if !cacheHit {
+	if cacheHit && !cwft.deps.valid(cwft.ses.GetTxnCompileCtx(), getCatalogVersion(cwft.ses)) {
+		// the tables are changed, build the plan again, and it is cached
+		// after the execution.
+		cwft.plan = nil
+		cacheHit = false
+	}
+	// bound is true if the plan is bound from the generic plan
+	bound := false
+	if !cacheHit && cwft.paramStmt != nil {
+		cwft.ses.accountId = getAccountId(requestCtx)
+		if generic, _, genErr := buildGenericPlan(requestCtx, cwft.ses, cwft.paramStmt); genErr == nil {
+			cwft.plan, genErr = bindGenericPlan(requestCtx, cwft.ses, cwft.paramStmt, generic)
+			bound = genErr == nil
+		}
+		if !bound {
+			// the literals matter to the plan, it is built as usual and
+			// cached by the text of the sql.
+			cwft.plan = nil
+			cwft.paramStmt = nil
+		}
+	}
+	if !cacheHit && !bound {
 		cwft.plan, err = buildPlan(requestCtx, cwft.ses, cwft.ses.GetTxnCompileCtx(), cwft.stmt)
 	} else if cwft.ses != nil && cwft.ses.GetTenantInfo() != nil {
 		cwft.ses.accountId = getAccountId(requestCtx)
@@ -217,13 +245,12 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 			return nil, err
 		}
 
-		// TODO check if schema change, obj.Obj is zero all the time in 0.6
-		// for _, obj := range preparePlan.GetSchemas() {
-		// 	newObj, _ := cwft.ses.txnCompileCtx.Resolve(obj.SchemaName, obj.ObjName)
-		// 	if newObj == nil || newObj.Obj != obj.Obj {
-		// 		return nil, moerr.NewInternalError("", fmt.Sprintf(ctx, "table '%s' has been changed, please reset Prepare statement '%s'", obj.ObjName, stmtName))
-		// 	}
-		// }
+		// prepare the statement again if the tables are changed
+		if !prepareStmt.deps.valid(cwft.ses.GetTxnCompileCtx(), getCatalogVersion(cwft.ses)) {
+			if err = rePrepareStmt(requestCtx, cwft.ses, prepareStmt); err != nil {
+				return nil, err
+			}
+		}
 
 		preparePlan := prepareStmt.PreparePlan.GetDcl().GetPrepare()
 		if len(executePlan.Args) != len(preparePlan.ParamTypes) {
@@ -235,7 +262,15 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		resetParamRule := plan2.NewResetParamRefRule(requestCtx, executePlan.Args)
 		resetVarRule := plan2.NewResetVarRefRule(cwft.ses.GetTxnCompileCtx(), cwft.ses.GetTxnCompileCtx().GetProcess())
 		constantFoldRule := plan2.NewConstantFoldRule(cwft.ses.GetTxnCompileCtx())
-		vp := plan2.NewVisitPlan(newPlan, []plan2.VisitPlanRule{resetParamRule, resetVarRule, constantFoldRule})
+		rules := []plan2.VisitPlanRule{resetParamRule, resetVarRule, constantFoldRule}
+		if sv := cwft.ses.GetParameterUnit().SV; sv != nil && sv.PlanCacheReplan {
+			if customPlan := buildCustomPlan(requestCtx, cwft.ses, prepareStmt.PrepareStmt, preparePlan.Plan, executePlan.Args); customPlan != nil {
+				// the parameters are bound in the custom plan
+				newPlan = customPlan
+				rules = rules[1:]
+			}
+		}
+		vp := plan2.NewVisitPlan(newPlan, rules)
 		err = vp.Visit(requestCtx)
 		if err != nil {
 			return nil, err
@@ -260,7 +295,8 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 			return nil, err
 		}
 		*/
-	} else {
+	} else if !bound {
+		// the rules of the bound plan are applied when it is bound
 		var vp *plan2.VisitPlan
 		if cacheHit {
 			vp = plan2.NewVisitPlan(cwft.plan, []plan2.VisitPlanRule{plan2.NewResetVarRefRule(cwft.ses.GetTxnCompileCtx(), cwft.ses.GetTxnCompileCtx().GetProcess()), plan2.NewRecomputeRealTimeRelatedFuncRule(cwft.ses.GetTxnCompileCtx().GetProcess())})
@@ -279,6 +315,13 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 	if err != nil {
 		return nil, err
 	}
+	if stm := motrace.StatementFromContext(requestCtx); stm != nil {
+		// the io of the query is counted in the statement info,
+		// including prefetching and remote pipelines, which find it by the statement id
+		stmID := uuid.UUID(stm.StatementID).String()
+		perfcounter.RegisterStatement(stmID, &stm.IOCounterSet)
+		txnCtx = perfcounter.WithStatementID(txnCtx, stmID)
+	}
 	addr := ""
 	if len(cwft.ses.GetParameterUnit().ClusterNodes) > 0 {
 		addr = cwft.ses.GetParameterUnit().ClusterNodes[0].Addr
@@ -0,0 +1,307 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+)
+
+// stmtCursor is the read-only cursor opened by COM_STMT_EXECUTE with the
+// CURSOR_TYPE_READ_ONLY flag. The pipeline of the statement runs in the
+// background and is suspended once the rows requested by the client are
+// buffered, each COM_STMT_FETCH resumes it until the next rows are produced.
+// The statement, and its transaction in autocommit mode, ends when the last
+// row is fetched or the cursor is closed. Any other command of the session
+// first buffers the rest of the rows and ends the statement, so the
+// statements of a session never overlap.
+type stmtCursor struct {
+	// stmtName is the name of the prepared statement of the cursor
+	stmtName string
+	// columns of the result set
+	mrs *MysqlResultSet
+	// cancel cancels the context of the pipeline
+	cancel context.CancelFunc
+	// finish ends the statement with the error of the pipeline, it is only
+	// called by the goroutine of the session
+	finish func(err error) error
+
+	mu struct {
+		sync.Mutex
+		cond *sync.Cond
+		rows [][]interface{}
+		// want is the number of the rows buffered before the pipeline is
+		// suspended, -1 if the pipeline is never suspended
+		want int
+		// done is true once the pipeline has returned err
+		done bool
+		err  error
+		// closed makes the pipeline stop at the next rows
+		closed bool
+	}
+}
+
+func newStmtCursor(stmtName string, mrs *MysqlResultSet, cancel context.CancelFunc) *stmtCursor {
+	c := &stmtCursor{stmtName: stmtName, mrs: mrs, cancel: cancel}
+	c.mu.cond = sync.NewCond(&c.mu.Mutex)
+	return c
+}
+
+// start runs the pipeline in the background until the first rows are
+// buffered, the error of the pipeline is returned if it fails before.
+func (c *stmtCursor) start(run func() error) error {
+	go func() {
+		err := run()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.mu.done = true
+		c.mu.err = err
+		c.mu.cond.Broadcast()
+	}()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.mu.rows) == 0 && !c.mu.done {
+		c.mu.cond.Wait()
+	}
+	if c.mu.done {
+		return c.mu.err
+	}
+	return nil
+}
+
+// running returns true if the pipeline has not returned
+func (c *stmtCursor) running() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return !c.mu.done
+}
+
+// fill appends the rows of the batch of the pipeline to the cursor
+func (c *stmtCursor) fill(ses *Session, bat *batch.Batch) error {
+	rows := make([][]interface{}, 0, bat.Length())
+	for j := 0; j < bat.Length(); j++ {
+		if bat.Zs[j] <= 0 {
+			continue
+		}
+		row := make([]interface{}, len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			rowIndex := j
+			if vec.IsConst() {
+				rowIndex = 0
+			}
+			if err := extractRowFromVector(ses, vec, i, row, rowIndex); err != nil {
+				return err
+			}
+		}
+		for k := int64(0); k < bat.Zs[j]; k++ {
+			rows = append(rows, row)
+		}
+	}
+	return c.append(rows)
+}
+
+// append buffers the rows filled by the pipeline and suspends the pipeline
+// until the rows are fetched. The bytes in the rows reference the vectors
+// of the batch, so they are copied.
+func (c *stmtCursor) append(rows [][]interface{}) error {
+	copied := make([][]interface{}, len(rows))
+	for i, row := range rows {
+		copied[i] = make([]interface{}, len(row))
+		for j, v := range row {
+			if b, ok := v.([]byte); ok {
+				v = append([]byte(nil), b...)
+			}
+			copied[i][j] = v
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.closed {
+		return moerr.NewQueryInterrupted(context.TODO())
+	}
+	c.mu.rows = append(c.mu.rows, copied...)
+	c.mu.cond.Broadcast()
+	for !c.mu.closed && c.mu.want >= 0 && len(c.mu.rows) >= c.mu.want {
+		c.mu.cond.Wait()
+	}
+	if c.mu.closed {
+		return moerr.NewQueryInterrupted(context.TODO())
+	}
+	return nil
+}
+
+// fetch resumes the pipeline until n rows are buffered, and returns the
+// result set of the next n rows at most and true if all the rows have been
+// fetched. All the rows are fetched if n is not positive.
+func (c *stmtCursor) fetch(n int) (*MysqlResultSet, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if n <= 0 {
+		n = -1
+	}
+	c.mu.want = n
+	c.mu.cond.Broadcast()
+	for !c.mu.done && (n < 0 || len(c.mu.rows) < n) {
+		c.mu.cond.Wait()
+	}
+	c.mu.want = 0
+	if c.mu.done && c.mu.err != nil {
+		return nil, true, c.mu.err
+	}
+	end := n
+	if n < 0 || end > len(c.mu.rows) {
+		end = len(c.mu.rows)
+	}
+	mrs := &MysqlResultSet{
+		Columns:    c.mrs.Columns,
+		Name2Index: c.mrs.Name2Index,
+		Data:       c.mu.rows[:end:end],
+	}
+	// release the rows fetched
+	c.mu.rows = append([][]interface{}(nil), c.mu.rows[end:]...)
+	return mrs, c.mu.done && len(c.mu.rows) == 0, nil
+}
+
+// drain buffers all the rows of the pipeline and waits for it to return
+func (c *stmtCursor) drain() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mu.want = -1
+	c.mu.cond.Broadcast()
+	for !c.mu.done {
+		c.mu.cond.Wait()
+	}
+}
+
+// close stops the pipeline and waits for it to return, the rows not
+// fetched are dropped.
+func (c *stmtCursor) close() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mu.closed = true
+	c.mu.rows = nil
+	c.mu.cond.Broadcast()
+	for !c.mu.done {
+		c.mu.cond.Wait()
+	}
+}
+
+// end ends the statement of the cursor once the pipeline has returned
+func (c *stmtCursor) end() error {
+	c.mu.Lock()
+	err := c.mu.err
+	c.mu.Unlock()
+	if c.cancel != nil {
+		defer c.cancel()
+	}
+	if c.finish == nil {
+		return nil
+	}
+	finish := c.finish
+	c.finish = nil
+	return finish(err)
+}
+
+// settleCursor ends the statement of the running cursor of the session
+// before the session runs the command. The cursor is closed if the command
+// quits, resets the session or closes, resets or executes the statement of
+// the cursor again, otherwise the rest of its rows are buffered for the
+// following COM_STMT_FETCH.
+func (ses *Session) settleCursor(req *Request) error {
+	c := ses.cursor
+	if c == nil || req.GetCmd() == COM_STMT_FETCH {
+		return nil
+	}
+	closing := false
+	switch req.GetCmd() {
+	case COM_QUIT, COM_RESET_CONNECTION:
+		closing = true
+	case COM_STMT_EXECUTE, COM_STMT_RESET, COM_STMT_CLOSE:
+		if data, ok := req.GetData().([]byte); ok && len(data) >= 4 {
+			closing = getPrepareStmtName(binary.LittleEndian.Uint32(data)) == c.stmtName
+		}
+	}
+	if closing {
+		c.close()
+	} else {
+		c.drain()
+	}
+	ses.cursor = nil
+	return c.end()
+}
+
+// detachedContext keeps the values of the parent but is never canceled, the
+// pipeline of a cursor outlives the request executing the statement
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+func (c detachedContext) Value(key any) any         { return c.parent.Value(key) }
+
+// handleStmtFetch sends the next rows of the open cursor of the prepared
+// statement, the EOF or OK packet after the rows tells the client whether the
+// cursor has more rows.
+func (mce *MysqlCmdExecutor) handleStmtFetch(requestCtx context.Context, data []byte) error {
+	// see https://dev.mysql.com/doc/internals/en/com-stmt-fetch.html
+	if len(data) < 8 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	numRows := binary.LittleEndian.Uint32(data[4:8])
+
+	ses := mce.GetSession()
+	preStmt, err := ses.GetPrepareStmt(getPrepareStmtName(stmtID))
+	if err != nil {
+		return err
+	}
+	if preStmt.cursor == nil {
+		return moerr.NewInvalidState(requestCtx, "the statement has no open cursor")
+	}
+	cursor := preStmt.cursor
+	mrs, last, err := cursor.fetch(int(numRows))
+	if last {
+		// the statement ends with the pipeline
+		preStmt.cursor = nil
+		if ses.cursor == cursor {
+			ses.cursor = nil
+		}
+		if endErr := cursor.end(); err == nil {
+			err = endErr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	proto := ses.GetMysqlProtocol()
+	if err = proto.SendResultSetTextBatchRowSpeedup(mrs, uint64(len(mrs.Data))); err != nil {
+		return err
+	}
+	status := SERVER_STATUS_CURSOR_EXISTS
+	if last {
+		status = SERVER_STATUS_LAST_ROW_SENT
+	}
+	return proto.sendEOFOrOkPacket(0, status)
+}
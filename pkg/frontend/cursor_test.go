@@ -0,0 +1,275 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCursor() *stmtCursor {
+	mrs := &MysqlResultSet{}
+	mrs.AddColumn(&MysqlColumn{
+		ColumnImpl: ColumnImpl{
+			name:       "a",
+			columnType: defines.MYSQL_TYPE_VARCHAR,
+		},
+	})
+	return newStmtCursor(getPrepareStmtName(1), mrs, func() {})
+}
+
+// startTestCursor starts the cursor with a pipeline producing the rows one
+// by one, and returns the number of the rows produced
+func startTestCursor(t *testing.T, c *stmtCursor, rows []string, err error) *atomic.Int32 {
+	produced := new(atomic.Int32)
+	require.NoError(t, c.start(func() error {
+		for _, r := range rows {
+			produced.Add(1)
+			if err := c.append([][]interface{}{{[]byte(r)}}); err != nil {
+				return err
+			}
+		}
+		return err
+	}))
+	return produced
+}
+
+func TestStmtCursor(t *testing.T) {
+	c := newTestCursor()
+	row := []interface{}{[]byte("a")}
+	go func() {
+		_ = c.start(func() error {
+			if err := c.append([][]interface{}{row}); err != nil {
+				return err
+			}
+			// the row is reused by the output of the pipeline
+			row[0].([]byte)[0] = 'b'
+			return c.append([][]interface{}{row, row})
+		})
+	}()
+
+	mrs, last, err := c.fetch(2)
+	require.NoError(t, err)
+	assert.False(t, last)
+	assert.Equal(t, [][]interface{}{{[]byte("a")}, {[]byte("b")}}, mrs.Data)
+	assert.Equal(t, c.mrs.Columns, mrs.Columns)
+
+	mrs, last, err = c.fetch(2)
+	require.NoError(t, err)
+	assert.True(t, last)
+	assert.Equal(t, [][]interface{}{{[]byte("b")}}, mrs.Data)
+}
+
+func TestStmtCursorSuspend(t *testing.T) {
+	c := newTestCursor()
+	produced := startTestCursor(t, c, []string{"a", "b", "c", "d", "e", "f", "g"}, nil)
+	// the pipeline is suspended after the first rows
+	assert.Equal(t, int32(1), produced.Load())
+	assert.True(t, c.running())
+
+	mrs, last, err := c.fetch(3)
+	require.NoError(t, err)
+	assert.False(t, last)
+	assert.Equal(t, [][]interface{}{{[]byte("a")}, {[]byte("b")}, {[]byte("c")}}, mrs.Data)
+	// only the rows fetched are produced
+	assert.Equal(t, int32(3), produced.Load())
+
+	mrs, last, err = c.fetch(2)
+	require.NoError(t, err)
+	assert.False(t, last)
+	assert.Equal(t, [][]interface{}{{[]byte("d")}, {[]byte("e")}}, mrs.Data)
+	assert.Equal(t, int32(5), produced.Load())
+
+	mrs, last, err = c.fetch(5)
+	require.NoError(t, err)
+	assert.True(t, last)
+	assert.Equal(t, [][]interface{}{{[]byte("f")}, {[]byte("g")}}, mrs.Data)
+	assert.False(t, c.running())
+}
+
+func TestStmtCursorDrain(t *testing.T) {
+	c := newTestCursor()
+	produced := startTestCursor(t, c, []string{"a", "b", "c"}, nil)
+	c.drain()
+	assert.Equal(t, int32(3), produced.Load())
+	assert.False(t, c.running())
+
+	mrs, last, err := c.fetch(2)
+	require.NoError(t, err)
+	assert.False(t, last)
+	assert.Len(t, mrs.Data, 2)
+	mrs, last, err = c.fetch(2)
+	require.NoError(t, err)
+	assert.True(t, last)
+	assert.Len(t, mrs.Data, 1)
+}
+
+func TestStmtCursorClose(t *testing.T) {
+	c := newTestCursor()
+	canceled := false
+	c.cancel = func() { canceled = true }
+	var ended error
+	c.finish = func(err error) error {
+		ended = err
+		return nil
+	}
+	produced := startTestCursor(t, c, []string{"a", "b", "c"}, nil)
+	c.close()
+	assert.True(t, canceled)
+	assert.False(t, c.running())
+	assert.Equal(t, int32(1), produced.Load())
+	// the pipeline is interrupted by the close
+	require.NoError(t, c.end())
+	assert.True(t, moerr.IsMoErrCode(ended, moerr.ErrQueryInterrupted))
+	// the statement ends once
+	ended = nil
+	require.NoError(t, c.end())
+	assert.Nil(t, ended)
+}
+
+func TestStmtCursorError(t *testing.T) {
+	c := newTestCursor()
+	startTestCursor(t, c, []string{"a", "b"}, moerr.NewInternalErrorNoCtx("pipeline failed"))
+	mrs, last, err := c.fetch(1)
+	require.NoError(t, err)
+	assert.False(t, last)
+	assert.Len(t, mrs.Data, 1)
+	_, last, err = c.fetch(5)
+	assert.Error(t, err)
+	assert.True(t, last)
+
+	// the pipeline failing before the first rows fails the statement
+	c = newTestCursor()
+	err = c.start(func() error { return moerr.NewInternalErrorNoCtx("pipeline failed") })
+	assert.Error(t, err)
+}
+
+func TestSettleCursor(t *testing.T) {
+	stmtID := make([]byte, 4)
+	binary.LittleEndian.PutUint32(stmtID, 1)
+	otherID := make([]byte, 4)
+	binary.LittleEndian.PutUint32(otherID, 2)
+	cases := []struct {
+		cmd    CommandType
+		data   interface{}
+		closed bool
+	}{
+		{cmd: COM_QUERY, data: []byte("select 1")},
+		{cmd: COM_STMT_EXECUTE, data: otherID},
+		{cmd: COM_STMT_EXECUTE, data: stmtID, closed: true},
+		{cmd: COM_STMT_CLOSE, data: stmtID, closed: true},
+		{cmd: COM_QUIT, closed: true},
+	}
+	for _, tc := range cases {
+		ses := &Session{}
+		c := newTestCursor()
+		produced := startTestCursor(t, c, []string{"a", "b", "c"}, nil)
+		ses.cursor = c
+		req := &Request{}
+		req.SetCmd(tc.cmd)
+		req.SetData(tc.data)
+		err := ses.settleCursor(req)
+		assert.Nil(t, ses.cursor)
+		assert.False(t, c.running())
+		if tc.closed {
+			require.NoError(t, err)
+			assert.Equal(t, int32(1), produced.Load())
+		} else {
+			// the rest of the rows are kept for the following fetches
+			require.NoError(t, err)
+			mrs, last, err := c.fetch(0)
+			require.NoError(t, err)
+			assert.True(t, last)
+			assert.Len(t, mrs.Data, 3)
+		}
+	}
+
+	// the fetch keeps the cursor running
+	ses := &Session{}
+	c := newTestCursor()
+	startTestCursor(t, c, []string{"a", "b"}, nil)
+	ses.cursor = c
+	req := &Request{}
+	req.SetCmd(COM_STMT_FETCH)
+	require.NoError(t, ses.settleCursor(req))
+	assert.True(t, c.running())
+	c.close()
+}
+
+func TestHandleStmtFetch(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil, nil)
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+	var gSys GlobalSystemVariables
+	InitGlobalSystemVariables(&gSys)
+	ses := NewSession(proto, nil, pu, &gSys, true, nil)
+	proto.SetSession(ses)
+	ses.SetRequestContext(ctx)
+	ses.SetCmd(COM_STMT_FETCH)
+	mce := &MysqlCmdExecutor{}
+	mce.SetSession(ses)
+
+	fetch := func(stmtID uint32, n uint32) error {
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint32(data, stmtID)
+		binary.LittleEndian.PutUint32(data[4:], n)
+		return mce.handleStmtFetch(ctx, data)
+	}
+
+	stmt := &PrepareStmt{Name: getPrepareStmtName(1)}
+	require.NoError(t, ses.SetPrepareStmt(stmt.Name, stmt))
+	err = fetch(1, 1)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidState))
+
+	cursor := newTestCursor()
+	ended := false
+	cursor.finish = func(err error) error {
+		ended = true
+		return err
+	}
+	startTestCursor(t, cursor, []string{"a", "b", "c"}, nil)
+	stmt.cursor = cursor
+	ses.cursor = cursor
+	require.NoError(t, fetch(1, 2))
+	assert.NotNil(t, stmt.cursor)
+	assert.False(t, ended)
+	// the statement ends after the last row is sent
+	require.NoError(t, fetch(1, 2))
+	assert.Nil(t, stmt.cursor)
+	assert.Nil(t, ses.cursor)
+	assert.True(t, ended)
+
+	assert.Error(t, fetch(2, 1))
+	assert.Error(t, mce.handleStmtFetch(ctx, []byte{1}))
+}
@@ -66,6 +66,10 @@ func administrativeCommandIsUnsupportedInTxnErrorInfo() string {
 	return "administrative command is unsupported in transactions"
 }
 
+func readOnlyCNErrorInfo(stmt tree.Statement) string {
+	return fmt.Sprintf("%s on the read-only cn, only the read-only statements are allowed", stmt.GetStatementType())
+}
+
 func parameterModificationInTxnErrorInfo() string {
 	return "Uncommitted transaction exists. Please commit or rollback first."
 }
@@ -356,6 +360,10 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	if bat == nil {
 		return nil
 	}
+	// the rows are kept in the cursor until fetched by the client
+	if cursor := ses.cursor; cursor != nil {
+		return cursor.fill(ses, bat)
+	}
 
 	begin := time.Now()
 	proto := ses.GetMysqlProtocol()
@@ -1013,8 +1021,67 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(requestCtx context.Context, stmt
 	return nil
 }
 
-func doPrepareStmt(ctx context.Context, ses *Session, st *tree.PrepareStmt) (*PrepareStmt, error) {
+// buildPreparePlan builds the plan of the prepare statement. The plan of the
+// same statement prepared before in the session is reused if the tables used
+// by it are not changed.
+func buildPreparePlan(ctx context.Context, ses *Session, st tree.Prepare, name string, stmt tree.Statement) (*plan2.Plan, *planDeps, error) {
+	tcc := ses.GetTxnCompileCtx()
+	if cached := ses.getCachedPreparePlan(ses.GetDatabaseName(), stmt); cached != nil && cached.deps.valid(tcc, getCatalogVersion(ses)) {
+		prepare := cached.plans[0].GetDcl().GetPrepare()
+		preparePlan := &plan2.Plan{
+			Plan: &plan.Plan_Dcl{
+				Dcl: &plan.DataControl{
+					DclType: plan.DataControl_PREPARE,
+					Control: &plan.DataControl_Prepare{
+						Prepare: &plan.Prepare{
+							Name:       name,
+							Schemas:    prepare.Schemas,
+							Plan:       prepare.Plan,
+							ParamTypes: prepare.ParamTypes,
+						},
+					},
+				},
+			},
+		}
+		if ses.GetTenantInfo() != nil {
+			ses.accountId = getAccountId(ctx)
+			if err := authenticateCanExecuteStatementAndPlan(ctx, ses, st, preparePlan); err != nil {
+				return nil, nil, err
+			}
+		}
+		return preparePlan, cached.deps, nil
+	}
+
+	preparePlan, err := buildPlan(ctx, ses, tcc, st)
+	if err != nil {
+		return nil, nil, err
+	}
+	prepare := preparePlan.GetDcl().GetPrepare()
+	if _, ok := prepare.GetPlan().GetPlan().(*plan.Plan_Query); ok && checkNodeCanCache(prepare.Plan) {
+		ses.cachePreparePlan(ses.GetDatabaseName(), stmt, preparePlan)
+	}
+	return preparePlan, collectPlanDeps(preparePlan), nil
+}
+
+// rePrepareStmt prepares the statement again, it is called when the tables
+// used by the plan are changed.
+func rePrepareStmt(ctx context.Context, ses *Session, prepareStmt *PrepareStmt) error {
+	st := tree.NewPrepareStmt(tree.Identifier(prepareStmt.Name), prepareStmt.PrepareStmt)
 	preparePlan, err := buildPlan(ctx, ses, ses.GetTxnCompileCtx(), st)
+	if err != nil {
+		return err
+	}
+	oldParams := prepareStmt.PreparePlan.GetDcl().GetPrepare().GetParamTypes()
+	if len(preparePlan.GetDcl().GetPrepare().GetParamTypes()) != len(oldParams) {
+		return moerr.NewInvalidInput(ctx, "prepared statement '%s' needs to be re-prepared", prepareStmt.Name)
+	}
+	prepareStmt.PreparePlan = preparePlan
+	prepareStmt.deps = collectPlanDeps(preparePlan)
+	return nil
+}
+
+func doPrepareStmt(ctx context.Context, ses *Session, st *tree.PrepareStmt) (*PrepareStmt, error) {
+	preparePlan, deps, err := buildPreparePlan(ctx, ses, st, string(st.Name), st.Stmt)
 	if err != nil {
 		return nil, err
 	}
@@ -1023,6 +1090,7 @@ func doPrepareStmt(ctx context.Context, ses *Session, st *tree.PrepareStmt) (*Pr
 		Name:        preparePlan.GetDcl().GetPrepare().GetName(),
 		PreparePlan: preparePlan,
 		PrepareStmt: st.Stmt,
+		deps:        deps,
 	}
 
 	err = ses.SetPrepareStmt(preparePlan.GetDcl().GetPrepare().GetName(), prepareStmt)
@@ -1044,7 +1112,13 @@ func doPrepareString(ctx context.Context, ses *Session, st *tree.PrepareString)
 		return nil, err
 	}
 
-	preparePlan, err := buildPlan(ses.GetRequestContext(), ses, ses.GetTxnCompileCtx(), st)
+	var preparePlan *plan2.Plan
+	var deps *planDeps
+	if len(stmts) == 1 {
+		preparePlan, deps, err = buildPreparePlan(ses.GetRequestContext(), ses, st, string(st.Name), stmts[0])
+	} else {
+		preparePlan, err = buildPlan(ses.GetRequestContext(), ses, ses.GetTxnCompileCtx(), st)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1053,6 +1127,7 @@ func doPrepareString(ctx context.Context, ses *Session, st *tree.PrepareString)
 		Name:        preparePlan.GetDcl().GetPrepare().GetName(),
 		PreparePlan: preparePlan,
 		PrepareStmt: stmts[0],
+		deps:        deps,
 	}
 
 	err = ses.SetPrepareStmt(preparePlan.GetDcl().GetPrepare().GetName(), prepareStmt)
@@ -1074,9 +1149,36 @@ func doDeallocate(ctx context.Context, ses *Session, st *tree.Deallocate) error
 }
 
 func doReset(ctx context.Context, ses *Session, st *tree.Reset) error {
+	// reset closes the open cursor of the statement and releases the long data
+	if prepareStmt, err := ses.GetPrepareStmt(string(st.Name)); err == nil {
+		prepareStmt.cursor = nil
+		prepareStmt.resetLongData()
+	}
 	return nil
 }
 
+// endCursorStatement returns the function ending the statement of the cursor
+// with the error of its pipeline, as the statement without a cursor ends in
+// doComQuery
+func endCursorStatement(requestCtx context.Context, ses *Session, stmt tree.Statement, cw ComputationWrapper) func(error) error {
+	return func(err error) error {
+		if err != nil {
+			if ses.InMultiStmtTransactionMode() && ses.InActiveTransaction() {
+				ses.cleanCache()
+				ses.SetOptionBits(OPTION_ATTACH_ABORT_TRANSACTION_ERROR)
+			}
+			if txnErr := ses.TxnRollbackSingleStatement(stmt); txnErr != nil {
+				return txnErr
+			}
+			return err
+		}
+		if cwft, ok := cw.(*TxnComputationWrapper); ok {
+			_ = cwft.RecordExecPlan(requestCtx)
+		}
+		return ses.TxnCommitSingleStatement(stmt)
+	}
+}
+
 // handleDeallocate
 func (mce *MysqlCmdExecutor) handleDeallocate(ctx context.Context, st *tree.Deallocate) error {
 	return doDeallocate(ctx, mce.GetSession(), st)
@@ -1523,10 +1625,11 @@ GetComputationWrapper gets the execs from the computation engine
 */
 var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *process.Process, ses *Session) ([]ComputationWrapper, error) {
 	var cw []ComputationWrapper = nil
-	if cached := ses.getCachedPlan(sql); cached != nil {
+	if cached := ses.getCachedPlan(db, sql); cached != nil {
 		for i, stmt := range cached.stmts {
 			tcw := InitTxnComputationWrapper(ses, stmt, proc)
 			tcw.plan = cached.plans[i]
+			tcw.deps = cached.deps
 			cw = append(cw, tcw)
 		}
 		return cw, nil
@@ -1556,6 +1659,11 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 	for _, stmt := range stmts {
 		cw = append(cw, InitTxnComputationWrapper(ses, stmt, proc))
 	}
+	if len(cw) == 1 {
+		if tcw, ok := cw[0].(*TxnComputationWrapper); ok {
+			tcw.paramStmt = newParamStmt(tcw.stmt)
+		}
+	}
 	return cw, nil
 }
 
@@ -2214,6 +2322,18 @@ func (mce *MysqlCmdExecutor) canExecuteStatementInUncommittedTransaction(request
 	return nil
 }
 
+// canExecuteStatementInReadOnlyCN checks the statement can be executed on the read-only cn
+func (mce *MysqlCmdExecutor) canExecuteStatementInReadOnlyCN(requestCtx context.Context, stmt tree.Statement) error {
+	can, err := IsReadOnlyStatement(mce.GetSession(), stmt)
+	if err != nil {
+		return err
+	}
+	if !can {
+		return moerr.NewNotSupported(requestCtx, readOnlyCNErrorInfo(stmt))
+	}
+	return nil
+}
+
 func (mce *MysqlCmdExecutor) processLoadLocal(ctx context.Context, param *tree.ExternParam, writer *io.PipeWriter) (err error) {
 	ses := mce.GetSession()
 	proto := ses.GetMysqlProtocol()
@@ -2442,6 +2562,15 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 			}
 		}
 
+		//only the read-only statements can be executed on the read-only cn
+		if pu := ses.GetParameterUnit(); pu != nil && pu.SV.ReadOnly {
+			err = mce.canExecuteStatementInReadOnlyCN(requestCtx, stmt)
+			if err != nil {
+				logStatementStatus(requestCtx, ses, stmt, fail, err)
+				return err
+			}
+		}
+
 		/*
 				if it is in an active or multi-statement transaction, we check the type of the statement.
 				Then we decide that if we can execute the statement.
@@ -2536,6 +2665,12 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				err = moerr.NewInternalError(proc.Ctx, "only admin can create subscription")
 				return err
 			}
+			if st.SubscriptionOption != nil && st.SubscriptionOption.Remote {
+				selfHandle = true
+				if err = mce.handleCreateRemoteSubscription(requestCtx, st); err != nil {
+					return err
+				}
+			}
 		case *tree.DropDatabase:
 			err := inputNameIsInvalid(proc.Ctx, string(st.Name))
 			if err != nil {
@@ -2867,13 +3002,24 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				}
 			}
 
+			ep := ses.GetExportParam()
 			/*
-				mysql COM_QUERY response: End after the column has been sent.
-				send EOF packet
+				mysql COM_STMT_EXECUTE response with a read-only cursor: the rows
+				are kept in the cursor, they are sent by COM_STMT_FETCH.
 			*/
-			err = proto.SendEOFPacketIf(0, 0)
-			if err != nil {
-				goto handleFailed
+			var cursor *stmtCursor
+			if ses.cursorStmt != nil && !ep.Outfile && ses.GetShowStmtType() != ShowTableStatus {
+				cursor = newStmtCursor(ses.cursorStmt.Name, mrs, ses.cursorCancel)
+				ses.cursorCancel = nil
+			} else {
+				/*
+					mysql COM_QUERY response: End after the column has been sent.
+					send EOF packet
+				*/
+				err = proto.SendEOFPacketIf(0, 0)
+				if err != nil {
+					goto handleFailed
+				}
 			}
 
 			runBegin := time.Now()
@@ -2881,7 +3027,6 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				Step 2: Start pipeline
 				Producing the data row and sending the data row
 			*/
-			ep := ses.GetExportParam()
 			if ep.Outfile {
 				ep.DefaultBufSize = pu.SV.ExportDataDefaultFlushSize
 				initExportFileParam(ep, mrs)
@@ -2889,7 +3034,16 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 					goto handleFailed
 				}
 			}
-			if err = runner.Run(0); err != nil {
+			if cursor != nil {
+				/*
+					With a cursor, the pipeline is suspended once the first rows
+					are produced, it is resumed by COM_STMT_FETCH.
+				*/
+				ses.cursor = cursor
+				if err = cursor.start(func() error { return runner.Run(0) }); err != nil {
+					goto handleFailed
+				}
+			} else if err = runner.Run(0); err != nil {
 				goto handleFailed
 			}
 
@@ -2922,16 +3076,23 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 				Step 3: Say goodbye
 				mysql COM_QUERY response: End after the data row has been sent.
 				After all row data has been sent, it sends the EOF or OK packet.
+				With a cursor, the packet ends the column definitions.
 			*/
-			err = proto.sendEOFOrOkPacket(0, 0)
+			if cursor != nil {
+				ses.cursorStmt.cursor = cursor
+				err = proto.sendEOFOrOkPacket(0, SERVER_STATUS_CURSOR_EXISTS)
+			} else {
+				err = proto.sendEOFOrOkPacket(0, 0)
+			}
 			if err != nil {
 				goto handleFailed
 			}
 
 			/*
 				Step 4: Serialize the execution plan by json
+				With a cursor, it is serialized when the statement ends.
 			*/
-			if cwft, ok := cw.(*TxnComputationWrapper); ok {
+			if cwft, ok := cw.(*TxnComputationWrapper); ok && cursor == nil {
 				_ = cwft.RecordExecPlan(requestCtx)
 			}
 		//just status, no result set
@@ -3096,7 +3257,16 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	handleSucceeded:
 		//load data handle txn failure internally
 		incStatementCounter(tenant, stmt)
-		txnErr = ses.TxnCommitSingleStatement(stmt)
+		if cursor := ses.cursor; cursor != nil {
+			// the statement of the cursor ends after its rows are fetched
+			cursor.finish = endCursorStatement(requestCtx, ses, stmt, cw)
+			if !cursor.running() {
+				ses.cursor = nil
+				txnErr = cursor.end()
+			}
+		} else {
+			txnErr = ses.TxnCommitSingleStatement(stmt)
+		}
 		if txnErr != nil {
 			logStatementStatus(requestCtx, ses, stmt, fail, txnErr)
 			return txnErr
@@ -3145,6 +3315,9 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 
 		case *tree.DropDatabase:
 			deleteRecordToMoMysqlCompatbilityMode(requestCtx, ses, stmt)
+			if err2 = doDropRemoteSubscription(requestCtx, ses, stmt.(*tree.DropDatabase)); err2 != nil {
+				logErrorf(ses.GetDebugString(), "drop remote subscription. error:%v", err2)
+			}
 			resp := mce.setResponse(i, len(cws), rspLen)
 			if err2 = mce.GetSession().GetMysqlProtocol().SendResponse(requestCtx, resp); err2 != nil {
 				retErr = moerr.NewInternalError(requestCtx, "routine send response failed. error:%v ", err2)
@@ -3198,6 +3371,13 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	handleFailed:
 		incStatementCounter(tenant, stmt)
 		incStatementErrorsCounter(tenant, stmt)
+		if cursor := ses.cursor; cursor != nil {
+			// the statement fails with the cursor
+			cursor.close()
+			_ = cursor.end()
+			ses.cursor = nil
+			ses.cursorStmt.cursor = nil
+		}
 		/*
 			Cases    | set Autocommit = 1/0 | BEGIN statement |
 			---------------------------------------------------
@@ -3223,18 +3403,20 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, sql string)
 	handleNext:
 	} // end of for
 
-	if canCache && !ses.isCached(sql) {
+	if canCache && !ses.isCached(ses.GetDatabaseName(), sql) {
 		plans := make([]*plan.Plan, len(cws))
 		stmts := make([]tree.Statement, len(cws))
 		for i, cw := range cws {
-			if cwft, ok := cw.(*TxnComputationWrapper); ok && checkNodeCanCache(cwft.plan) {
+			// the plan bound from the generic plan is cached as the plan of
+			// the parameterized statement
+			if cwft, ok := cw.(*TxnComputationWrapper); ok && cwft.paramStmt == nil && checkNodeCanCache(cwft.plan) {
 				plans[i] = cwft.plan
 				stmts[i] = cwft.stmt
 			} else {
 				return nil
 			}
 		}
-		ses.cachePlan(sql, stmts, plans)
+		ses.cachePlan(ses.GetDatabaseName(), sql, stmts, plans)
 	}
 
 	return nil
@@ -3367,6 +3549,9 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 
 	var sql string
 	logDebugf(ses.GetDebugString(), "cmd %v", req.GetCmd())
+	if cursorErr := ses.settleCursor(req); cursorErr != nil {
+		logErrorf(ses.GetDebugString(), "end the statement of the cursor failed: %v", cursorErr)
+	}
 	ses.SetCmd(req.GetCmd())
 	doComQuery := mce.GetDoQueryFunc()
 	switch req.GetCmd() {
@@ -3431,11 +3616,23 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 
 	case COM_STMT_EXECUTE:
 		ses.SetCmd(COM_STMT_EXECUTE)
+		defer func() {
+			ses.cursorStmt = nil
+			// the cancel is taken by the cursor if it is opened
+			if ses.cursorCancel != nil {
+				ses.cursorCancel()
+				ses.cursorCancel = nil
+			}
+		}()
 		data := req.GetData().([]byte)
 		sql, err = mce.parseStmtExecute(requestCtx, data)
 		if err != nil {
 			return NewGeneralErrorResponse(COM_STMT_EXECUTE, err), nil
 		}
+		if ses.cursorStmt != nil {
+			// the pipeline of the cursor outlives the request
+			requestCtx, ses.cursorCancel = context.WithCancel(detachedContext{parent: requestCtx})
+		}
 		err = doComQuery(requestCtx, sql)
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
@@ -3457,6 +3654,24 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		}
 		return resp, nil
 
+	case COM_STMT_SEND_LONG_DATA:
+		data := req.GetData().([]byte)
+		// COM_STMT_SEND_LONG_DATA has no response, the error is returned by
+		// the next COM_STMT_EXECUTE of the statement
+		err = mce.handleStmtSendLongData(requestCtx, data)
+		if err != nil {
+			logErrorf(ses.GetDebugString(), "send long data failed. error:%v", err)
+		}
+		return nil, nil
+
+	case COM_STMT_FETCH:
+		data := req.GetData().([]byte)
+		err = mce.handleStmtFetch(requestCtx, data)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_FETCH, err)
+		}
+		return resp, nil
+
 	case COM_STMT_RESET:
 		data := req.GetData().([]byte)
 
@@ -3471,6 +3686,22 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		}
 		return resp, nil
 
+	case COM_RESET_CONNECTION:
+		err = ses.ResetSession()
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_RESET_CONNECTION, err)
+		} else {
+			resp = NewGeneralOkResponse(COM_RESET_CONNECTION)
+		}
+		return resp, nil
+
+	case COM_CHANGE_USER:
+		data := req.GetData().([]byte)
+		// the response is sent by ChangeUser, the connection is closed if
+		// the authentication fails.
+		err = ses.GetMysqlProtocol().ChangeUser(requestCtx, data)
+		return nil, err
+
 	default:
 		resp = NewGeneralErrorResponse(req.GetCmd(), moerr.NewInternalError(requestCtx, "unsupported command. 0x%x", req.GetCmd()))
 	}
@@ -3492,10 +3723,20 @@ func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data [
 	if err != nil {
 		return "", err
 	}
+	// the long data is released by the execution
+	defer preStmt.resetLongData()
+	if preStmt.longDataErr != nil {
+		return "", preStmt.longDataErr
+	}
 	names, vars, err := ses.GetMysqlProtocol().ParseExecuteData(requestCtx, preStmt, data, pos)
 	if err != nil {
 		return "", err
 	}
+	// the execution closes the open cursor of the statement
+	preStmt.cursor = nil
+	if data[pos]&CURSOR_TYPE_READ_ONLY != 0 {
+		ses.cursorStmt = preStmt
+	}
 	sql := fmt.Sprintf("execute %s", stmtName)
 	varStrings := make([]string, len(names))
 	if len(names) > 0 {
@@ -3512,6 +3753,35 @@ func (mce *MysqlCmdExecutor) parseStmtExecute(requestCtx context.Context, data [
 	return sql, nil
 }
 
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(requestCtx context.Context, data []byte) error {
+	// see https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+	if len(data) < 6 {
+		return moerr.NewInvalidInput(requestCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	paramID := binary.LittleEndian.Uint16(data[4:6])
+
+	ses := mce.GetSession()
+	preStmt, err := ses.GetPrepareStmt(getPrepareStmtName(stmtID))
+	if err != nil {
+		return err
+	}
+	dcPrepare, ok := preStmt.PreparePlan.GetDcl().Control.(*plan.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(requestCtx, "can not get Prepare plan in prepareStmt")
+	}
+	if int(paramID) >= len(dcPrepare.Prepare.ParamTypes) {
+		preStmt.longDataErr = moerr.NewInvalidInput(requestCtx, "parameter %d of COM_STMT_SEND_LONG_DATA is out of range", paramID)
+		return preStmt.longDataErr
+	}
+	val, err := ses.GetSessionVar("max_allowed_packet")
+	if err != nil {
+		return err
+	}
+	preStmt.appendLongData(requestCtx, paramID, data[6:], val.(int64))
+	return preStmt.longDataErr
+}
+
 func (mce *MysqlCmdExecutor) SetCancelFunc(cancelFunc context.CancelFunc) {
 	mce.mu.Lock()
 	defer mce.mu.Unlock()
@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"testing"
@@ -37,12 +38,14 @@ import (
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/testutil/testengine"
 	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -1169,6 +1172,46 @@ func Test_StatementClassify(t *testing.T) {
 	}
 }
 
+func Test_IsReadOnlyStatement(t *testing.T) {
+	type arg struct {
+		stmt tree.Statement
+		want bool
+	}
+
+	args := []arg{
+		{&tree.Select{}, true},
+		{&tree.ValuesStatement{}, true},
+		{&tree.ShowTables{}, true},
+		{&tree.ExplainStmt{}, true},
+		{&tree.SetVar{}, true},
+		{&tree.Use{}, true},
+		{&tree.BeginTransaction{}, true},
+		{&tree.Execute{}, true},
+		{&tree.PrepareStmt{Stmt: &tree.Select{}}, true},
+		{&tree.Insert{}, false},
+		{&tree.Update{}, false},
+		{&tree.Delete{}, false},
+		{&tree.Load{}, false},
+		{&tree.CreateTable{}, false},
+		{&tree.DropDatabase{}, false},
+		{&tree.CreateAccount{}, false},
+		{&tree.SetPassword{}, false},
+		{&tree.PrepareStmt{Stmt: &tree.Insert{}}, false},
+	}
+
+	for _, a := range args {
+		ret, err := IsReadOnlyStatement(nil, a.stmt)
+		assert.Nil(t, err)
+		assert.Equal(t, a.want, ret, a.stmt.GetStatementType())
+	}
+
+	analyze := &tree.ExplainAnalyze{}
+	analyze.Statement = &tree.Delete{}
+	ret, err := IsReadOnlyStatement(nil, analyze)
+	assert.Nil(t, err)
+	assert.False(t, ret)
+}
+
 func TestMysqlCmdExecutor_HandleShowBackendServers(t *testing.T) {
 	ctx := context.TODO()
 	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
@@ -1280,3 +1323,150 @@ func TestMysqlCmdExecutor_HandleShowBackendServers(t *testing.T) {
 		require.Equal(t, "addr1", row[1])
 	})
 }
+
+func TestMysqlCmdExecutor_HandleStmtSendLongData(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil, nil)
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+	var gSys GlobalSystemVariables
+	InitGlobalSystemVariables(&gSys)
+	ses := NewSession(proto, nil, pu, &gSys, true, nil)
+	proto.SetSession(ses)
+	ses.SetRequestContext(ctx)
+	mce := &MysqlCmdExecutor{}
+	mce.SetSession(ses)
+
+	st := tree.NewPrepareString(tree.Identifier(getPrepareStmtName(1)), "select ?, ?")
+	stmts, err := mysql.Parse(ctx, st.Sql, 1)
+	require.NoError(t, err)
+	preparePlan, err := buildPlan(ctx, nil, plan.NewEmptyCompilerContext(), st)
+	require.NoError(t, err)
+	stmt := &PrepareStmt{
+		Name:        preparePlan.GetDcl().GetPrepare().GetName(),
+		PreparePlan: preparePlan,
+		PrepareStmt: stmts[0],
+	}
+	require.NoError(t, ses.SetPrepareStmt(stmt.Name, stmt))
+
+	sendLongData := func(paramID uint16, data string) error {
+		packet := make([]byte, 6)
+		binary.LittleEndian.PutUint32(packet, 1)
+		binary.LittleEndian.PutUint16(packet[4:], paramID)
+		return mce.handleStmtSendLongData(ctx, append(packet, data...))
+	}
+	execute := func(values ...byte) (string, error) {
+		packet := make([]byte, 4)
+		binary.LittleEndian.PutUint32(packet, 1)
+		packet = append(packet, 0)          // flag
+		packet = append(packet, 0, 0, 0, 0) // iteration-count
+		packet = append(packet, 0)          // null bitmap
+		packet = append(packet, 1)          // new param bound flag
+		packet = append(packet, uint8(defines.MYSQL_TYPE_BLOB), 0, uint8(defines.MYSQL_TYPE_TINY), 0)
+		packet = append(packet, values...)
+		return mce.parseStmtExecute(ctx, packet)
+	}
+
+	require.NoError(t, sendLongData(0, "abc"))
+	require.NoError(t, sendLongData(0, "def"))
+	// the first param is not in the packet
+	sql, err := execute(10)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("execute %s using @%s,@%s", stmt.Name,
+		getPrepareStmtSessionVarName(0), getPrepareStmtSessionVarName(1)), sql)
+	_, val, err := ses.GetUserDefinedVar(getPrepareStmtSessionVarName(0))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("abcdef"), val)
+	_, val, err = ses.GetUserDefinedVar(getPrepareStmtSessionVarName(1))
+	require.NoError(t, err)
+	assert.Equal(t, int8(10), val)
+	// the long data is released by the execution
+	assert.Nil(t, stmt.longData)
+
+	// run the statement with the long data bound
+	tcc := InitTxnCompilerContext(nil, "")
+	tcc.SetSession(ses)
+	proc := testutil.NewProcess()
+	tcc.SetProcess(proc)
+	executeStmts, err := mysql.Parse(ctx, sql, 1)
+	require.NoError(t, err)
+	executePlan, err := buildPlan(ctx, nil, tcc, executeStmts[0])
+	require.NoError(t, err)
+	pn := plan.DeepCopyPlan(preparePlan.GetDcl().GetPrepare().Plan)
+	vp := plan.NewVisitPlan(pn, []plan.VisitPlanRule{
+		plan.NewResetParamRefRule(ctx, executePlan.GetDcl().GetExecute().Args),
+		plan.NewResetVarRefRule(tcc, proc),
+		plan.NewConstantFoldRule(tcc),
+	})
+	require.NoError(t, vp.Visit(ctx))
+	var rows [][]string
+	eng, _, _ := testengine.New(ctx)
+	c := compile.New("", "", st.Sql, "", ctx, eng, proc, stmts[0])
+	require.NoError(t, c.Compile(ctx, pn, nil, func(_ any, bat *batch.Batch) error {
+		if bat == nil || bat.Length() == 0 {
+			return nil
+		}
+		rows = append(rows, []string{bat.Vecs[0].String(), bat.Vecs[1].String()})
+		return nil
+	}))
+	require.NoError(t, c.Run(0))
+	assert.Equal(t, [][]string{{"abcdef", "10"}}, rows)
+
+	assert.Error(t, sendLongData(2, "abc"))
+	_, err = execute(10)
+	assert.Error(t, err)
+	_, err = execute(3, 'a', 'b', 'c', 10)
+	assert.NoError(t, err)
+
+	require.NoError(t, sendLongData(0, "abc"))
+	require.NoError(t, doReset(ctx, ses, &tree.Reset{Name: tree.Identifier(stmt.Name)}))
+	assert.Nil(t, stmt.longData)
+
+	stmt.appendLongData(ctx, 0, []byte("abc"), 4)
+	stmt.appendLongData(ctx, 0, []byte("def"), 4)
+	assert.True(t, moerr.IsMoErrCode(stmt.longDataErr, moerr.ErrInvalidInput))
+	assert.Nil(t, stmt.longData)
+
+	assert.Error(t, mce.handleStmtSendLongData(ctx, []byte{1}))
+}
+
+func TestMysqlCmdExecutor_ResetConnection(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Ref().AnyTimes()
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil, nil)
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+	var gSys GlobalSystemVariables
+	InitGlobalSystemVariables(&gSys)
+	ses := NewSession(proto, nil, pu, &gSys, true, nil)
+	proto.SetSession(ses)
+	ses.SetRequestContext(ctx)
+	mce := &MysqlCmdExecutor{}
+	mce.SetSession(ses)
+
+	require.NoError(t, ses.SetUserDefinedVar("a", int64(1)))
+	require.NoError(t, ses.SetPrepareStmt(getPrepareStmtName(1), &PrepareStmt{Name: getPrepareStmtName(1)}))
+	resp, err := mce.ExecRequest(ctx, ses, &Request{cmd: COM_RESET_CONNECTION, data: []byte{}})
+	require.NoError(t, err)
+	assert.Equal(t, OkResponse, resp.category)
+	_, v, err := ses.GetUserDefinedVar("a")
+	require.NoError(t, err)
+	assert.Nil(t, v)
+	_, err = ses.GetPrepareStmt(getPrepareStmtName(1))
+	assert.Error(t, err)
+}
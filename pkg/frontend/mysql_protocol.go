@@ -17,10 +17,13 @@ package frontend
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
 	"crypto/sha1"
+	"crypto/tls"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math"
 	"math/rand"
 	"net"
@@ -56,7 +59,9 @@ var DefaultCapability = CLIENT_LONG_PASSWORD |
 	CLIENT_PLUGIN_AUTH |
 	CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA |
 	CLIENT_DEPRECATE_EOF |
-	CLIENT_CONNECT_ATTRS
+	CLIENT_CONNECT_ATTRS |
+	CLIENT_COMPRESS |
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM
 
 // DefaultClientConnStatus default server status
 var DefaultClientConnStatus = SERVER_STATUS_AUTOCOMMIT
@@ -185,6 +190,9 @@ type MysqlProtocol interface {
 	GetStats() string
 
 	ParseExecuteData(ctx context.Context, stmt *PrepareStmt, data []byte, pos int) (names []string, vars []any, err error)
+
+	//ChangeUser handles COM_CHANGE_USER and sends the response
+	ChangeUser(ctx context.Context, payload []byte) error
 }
 
 var _ MysqlProtocol = &MysqlProtocolImpl{}
@@ -294,6 +302,20 @@ type MysqlProtocolImpl struct {
 	// can pass to the server at connect time.
 	connectAttrs map[string]string
 
+	// the compression level of zstd asked by the client
+	zstdCompressionLevel uint8
+
+	// the authentication method of the authResponse
+	clientPluginName string
+
+	// the TLS information of the client sent by the proxy in the
+	// connection attributes of the handshake response
+	proxyTLSInfo clientTLSInfo
+
+	// fromProxy is true if the connection comes from the proxy, which is
+	// authenticated by the proxy tag.
+	fromProxy bool
+
 	//for debug
 	debugStats
 
@@ -419,6 +441,18 @@ type response41 struct {
 	clientPluginName  string
 	isAskForTlsHeader bool
 	connectAttrs      map[string]string
+	// zstdCompressionLevel is set if CLIENT_ZSTD_COMPRESSION_ALGORITHM is set
+	zstdCompressionLevel uint8
+}
+
+// the payload of COM_CHANGE_USER
+type changeUserRequest struct {
+	username         string
+	authResponse     []byte
+	database         string
+	collationID      int
+	clientPluginName string
+	connectAttrs     map[string]string
 }
 
 // handshake response 320
@@ -520,8 +554,8 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 		err = moerr.NewInternalError(requestCtx, "malform packet")
 		return
 	}
-	if flag != 0 {
-		// TODO only support CURSOR_TYPE_NO_CURSOR flag now
+	if flag&^CURSOR_TYPE_READ_ONLY != 0 {
+		// only support CURSOR_TYPE_NO_CURSOR and CURSOR_TYPE_READ_ONLY flag now
 		err = moerr.NewInvalidInput(requestCtx, "unsupported Prepare flag '%v'", flag)
 		return
 	}
@@ -560,8 +594,19 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(requestCtx context.Context, stmt *
 			varName := getPrepareStmtSessionVarName(i)
 			names[i] = varName
 
-			// TODO :if params had received via COM_STMT_SEND_LONG_DATA, use them directly.
+			// the params received via COM_STMT_SEND_LONG_DATA are not in the packet.
 			// ref https://dev.mysql.com/doc/internals/en/com-stmt-send-long-data.html
+			if val, ok := stmt.longData[uint16(i)]; ok {
+				vars[i] = val
+				if i<<1 < len(stmt.ParamTypes) {
+					switch defines.MysqlType(stmt.ParamTypes[i<<1]) {
+					case defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB, defines.MYSQL_TYPE_TEXT:
+					default:
+						vars[i] = string(val)
+					}
+				}
+				continue
+			}
 
 			if nullBitmaps[i>>3]&(1<<(uint(i)%8)) > 0 {
 				vars[i] = nil
@@ -1045,6 +1090,10 @@ func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
 // hash2 = SHA1(hash1)
 // check(hash2, hpwd)
 func (mp *MysqlProtocolImpl) checkPassword(pwd, salt, auth []byte) bool {
+	return checkNativePassword(pwd, salt, auth)
+}
+
+func checkNativePassword(pwd, salt, auth []byte) bool {
 	sha := sha1.New()
 	_, err := sha.Write(salt)
 	if err != nil {
@@ -1075,22 +1124,46 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	var psw []byte
 	var err error
 	var tenant *TenantInfo
+	var authString, plugin, tlsRequire string
+	var authenticator Authenticator
+	var requirement tlsRequirement
 
 	ses := mp.GetSession()
 	if !mp.GetSkipCheckUser() {
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 1")
-		psw, err = ses.AuthenticateUser(mp.GetUserName())
+		authString, plugin, tlsRequire, err = ses.AuthenticateUser(mp.GetUserName())
 		if err != nil {
 			return err
 		}
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 2")
 
+		authenticator, err = getAuthenticator(ctx, plugin)
+		if err != nil {
+			return err
+		}
+		//the client makes the auth response with another authentication method
+		if mp.clientPluginName != authenticator.ClientPlugin() {
+			if authResponse, err = mp.negotiateAuthenticationMethod(ctx, authenticator.ClientPlugin()); err != nil {
+				return moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+			}
+			mp.clientPluginName = authenticator.ClientPlugin()
+		}
+
 		//TO Check password
-		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
-			logInfof(mp.getDebugStringUnsafe(), "check password succeeded")
-		} else {
-			return moerr.NewInternalError(ctx, "check password failed")
+		tenant = ses.GetTenantInfo()
+		account := tenant.GetTenant() + ":" + tenant.GetUser()
+		if err = authenticator.Authenticate(ctx, &mysqlAuthConn{ctx: ctx, mp: mp}, account, authString, authResponse); err != nil {
+			return err
+		}
+
+		//the user created with the REQUIRE clause
+		if requirement, err = parseTLSRequirement(ctx, tlsRequire); err != nil {
+			return err
 		}
+		if err = requirement.check(ctx, mp.clientTLSInfo()); err != nil {
+			return err
+		}
+		logInfof(mp.getDebugStringUnsafe(), "check password succeeded")
 	} else {
 		logDebugf(mp.getDebugStringUnsafe(), "skip authenticate user")
 		//Get tenant info
@@ -1114,6 +1187,22 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	return nil
 }
 
+// clientTLSInfo returns the TLS information of the client. The proxy
+// terminates TLS and sends it to the server, so the TLS of the connection
+// from the proxy is the one between the proxy and the server.
+func (mp *MysqlProtocolImpl) clientTLSInfo() clientTLSInfo {
+	if mp.fromProxy {
+		return mp.proxyTLSInfo
+	}
+	if mp.IsTlsEstablished() {
+		if conn, ok := mp.tcpConn.RawConn().(*tls.Conn); ok {
+			return newClientTLSInfo(conn.ConnectionState())
+		}
+		return clientTLSInfo{tls: true}
+	}
+	return clientTLSInfo{}
+}
+
 func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
 	var err error
 	if len(payload) < 2 {
@@ -1150,6 +1239,14 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		mp.username = resp41.username
 		mp.database = resp41.database
 		mp.connectAttrs = resp41.connectAttrs
+		if mp.fromProxy {
+			mp.proxyTLSInfo = clientTLSInfoFromAttrs(resp41.connectAttrs)
+		}
+		mp.zstdCompressionLevel = resp41.zstdCompressionLevel
+		mp.clientPluginName = resp41.clientPluginName
+		if len(mp.clientPluginName) == 0 {
+			mp.clientPluginName = AuthNativePassword
+		}
 	} else {
 		var resp320 response320
 		var ok2 bool
@@ -1171,6 +1268,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		mp.maxClientPacketSize = resp320.maxPacketSize
 		mp.username = resp320.username
 		mp.database = resp320.database
+		mp.clientPluginName = AuthNativePassword
 	}
 	return false, nil
 }
@@ -1180,12 +1278,7 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	mp.incDebugCount(0)
 	if err := mp.authenticateUser(ctx, mp.authResponse); err != nil {
 		logutil.Errorf("authenticate user failed.error:%v", err)
-		fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
-		tipsFormat := "Access denied for user %s. %s"
-		msg := fmt.Sprintf(tipsFormat, mp.username, err.Error())
-		err2 := mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], msg)
-		if err2 != nil {
-			logutil.Errorf("send err packet failed.error:%v", err2)
+		if err2 := mp.sendAccessDeniedPacket(err); err2 != nil {
 			return err2
 		}
 		return err
@@ -1199,9 +1292,147 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	mp.EnableCompression()
 	return nil
 }
 
+// sendAccessDeniedPacket sends the error of the authentication to the client.
+func (mp *MysqlProtocolImpl) sendAccessDeniedPacket(err error) error {
+	fail := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
+	tipsFormat := "Access denied for user %s. %s"
+	msg := fmt.Sprintf(tipsFormat, mp.username, err.Error())
+	err2 := mp.sendErrPacket(fail.ErrorCode, fail.SqlStates[0], msg)
+	if err2 != nil {
+		logutil.Errorf("send err packet failed.error:%v", err2)
+	}
+	return err2
+}
+
+// ChangeUser handles COM_CHANGE_USER. The session is reset, then the user in
+// the payload is authenticated in the same way as the handshake. The account,
+// the user and the role of the session are switched to the new ones. If the
+// authentication fails, the error is sent to the client and returned, and the
+// connection should be closed.
+func (mp *MysqlProtocolImpl) ChangeUser(ctx context.Context, payload []byte) error {
+	req, err := mp.analyseChangeUser(ctx, payload)
+	if err != nil {
+		return err
+	}
+
+	ses := mp.GetSession()
+	if err = ses.ResetSession(); err != nil {
+		logutil.Errorf("reset session failed.error:%v", err)
+	}
+
+	mp.username = req.username
+	mp.authResponse = req.authResponse
+	mp.clientPluginName = req.clientPluginName
+	if len(req.connectAttrs) != 0 {
+		mp.connectAttrs = req.connectAttrs
+	}
+	if nameAndCharset, ok := collationID2CharsetAndName[req.collationID]; ok {
+		mp.collationID = req.collationID
+		mp.collationName = nameAndCharset.collationName
+		mp.charset = nameAndCharset.charset
+	}
+	if err = mp.authenticateUser(ctx, mp.authResponse); err != nil {
+		logutil.Errorf("change user failed.error:%v", err)
+		if err2 := mp.sendAccessDeniedPacket(err); err2 != nil {
+			return err2
+		}
+		return err
+	}
+	ses.SetDatabaseName(req.database)
+	ses.UpdateDebugString()
+	logInfof(mp.getDebugStringUnsafe(), "change user succeeded")
+	return mp.sendOKPacket(0, 0, 0, 0, "")
+}
+
+// analyseChangeUser analyses the payload of COM_CHANGE_USER
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_change_user.html
+func (mp *MysqlProtocolImpl) analyseChangeUser(ctx context.Context, data []byte) (changeUserRequest, error) {
+	var req changeUserRequest
+	var ok bool
+	pos := 0
+
+	//string[NUL]        user
+	req.username, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return req, moerr.NewInternalError(ctx, "get username failed")
+	}
+
+	if mp.capability&CLIENT_SECURE_CONNECTION != 0 {
+		//int<1>             auth plugin data length
+		//string[$len]       auth plugin data
+		var l uint8
+		l, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return req, moerr.NewInternalError(ctx, "get length of auth-response failed")
+		}
+		req.authResponse, pos, ok = mp.readCountOfBytes(data, pos, int(l))
+		if !ok {
+			return req, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+	} else {
+		//string[NUL]        auth plugin data
+		var auth string
+		auth, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return req, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+		req.authResponse = []byte(auth)
+	}
+
+	//string[NUL]        database
+	req.database, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return req, moerr.NewInternalError(ctx, "get database failed")
+	}
+
+	// the fields below are optional
+	req.collationID = mp.collationID
+	req.clientPluginName = AuthNativePassword
+	if pos == len(data) {
+		return req, nil
+	}
+	//int<2>             character set
+	var collationID uint16
+	collationID, pos, ok = mp.io.ReadUint16(data, pos)
+	if !ok {
+		return req, moerr.NewInternalError(ctx, "get character set failed")
+	}
+	req.collationID = int(collationID)
+
+	//string[NUL]        auth plugin name
+	if mp.capability&CLIENT_PLUGIN_AUTH != 0 && pos < len(data) {
+		req.clientPluginName, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return req, moerr.NewInternalError(ctx, "get auth plugin name failed")
+		}
+	}
+
+	// client connection attributes
+	if mp.capability&CLIENT_CONNECT_ATTRS != 0 && pos < len(data) {
+		var err error
+		if req.connectAttrs, _, err = mp.readConnectAttrs(ctx, data, pos); err != nil {
+			return req, err
+		}
+	}
+	return req, nil
+}
+
+// EnableCompression switches the connection to the compressed protocol if the
+// client asked for it in the handshake response. The packets after the OK packet
+// of the authentication are compressed.
+func (mp *MysqlProtocolImpl) EnableCompression() bool {
+	if mp.capability&(CLIENT_COMPRESS|CLIENT_ZSTD_COMPRESSION_ALGORITHM) == 0 {
+		return false
+	}
+	mp.tcpConn.UseConn(newCompressedConn(mp.tcpConn.RawConn(), mp.capability, mp.zstdCompressionLevel))
+	logDebugf(mp.getDebugStringUnsafe(), "enable compression")
+	return true
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1231,7 +1462,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((mp.capability>>16)&0xFFFF))
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
@@ -1362,44 +1593,50 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		if !ok {
 			return false, info, moerr.NewInternalError(ctx, "get auth plugin name failed")
 		}
-
-		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
-			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
-				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
-			}
-			info.clientPluginName = AuthNativePassword
-		}
 	}
 
 	// client connection attributes
 	if info.capabilities&CLIENT_CONNECT_ATTRS != 0 {
-		var l uint64
-		var ok bool
-		l, pos, ok = mp.readIntLenEnc(data, pos)
-		if !ok {
-			return false, info, moerr.NewInternalError(ctx, "get length of client-connect-attrs failed")
+		var err error
+		if info.connectAttrs, pos, err = mp.readConnectAttrs(ctx, data, pos); err != nil {
+			return false, info, err
 		}
-		endPos := pos + int(l)
-		info.connectAttrs = make(map[string]string)
-		var key, value string
-		for pos < endPos {
-			key, pos, ok = mp.readStringLenEnc(data, pos)
-			if !ok {
-				return false, info, moerr.NewInternalError(ctx, "get connect-attrs key failed")
-			}
-			value, pos, ok = mp.readStringLenEnc(data, pos)
-			if !ok {
-				return false, info, moerr.NewInternalError(ctx, "get connect-attrs value failed")
-			}
-			info.connectAttrs[key] = value
+	}
+
+	//int<1>             zstd compression level
+	if info.capabilities&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		info.zstdCompressionLevel, _, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get zstd compression level failed")
 		}
 	}
 
 	return true, info, nil
 }
 
+// readConnectAttrs reads the client connection attributes
+func (mp *MysqlProtocolImpl) readConnectAttrs(ctx context.Context, data []byte, pos int) (map[string]string, int, error) {
+	l, pos, ok := mp.readIntLenEnc(data, pos)
+	if !ok {
+		return nil, 0, moerr.NewInternalError(ctx, "get length of client-connect-attrs failed")
+	}
+	endPos := pos + int(l)
+	attrs := make(map[string]string)
+	var key, value string
+	for pos < endPos {
+		key, pos, ok = mp.readStringLenEnc(data, pos)
+		if !ok {
+			return nil, 0, moerr.NewInternalError(ctx, "get connect-attrs key failed")
+		}
+		value, pos, ok = mp.readStringLenEnc(data, pos)
+		if !ok {
+			return nil, 0, moerr.NewInternalError(ctx, "get connect-attrs value failed")
+		}
+		attrs[key] = value
+	}
+	return attrs, pos, nil
+}
+
 /*
 //the server does something after receiving a handshake response41 from the client
 //like check user and password
@@ -1537,14 +1774,19 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
 	}
+	return mp.readAuthPacket(ctx)
+}
 
+// readAuthPacket reads the payload of the packet from the client during the
+// authentication.
+func (mp *MysqlProtocolImpl) readAuthPacket(ctx context.Context) ([]byte, error) {
 	read, err := mp.tcpConn.Read(goetty.ReadOptions{})
 	if err != nil {
 		return nil, err
@@ -1568,6 +1810,31 @@ func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context)
 	return data, nil
 }
 
+// mysqlAuthConn is the AuthConn on the connection of the protocol
+type mysqlAuthConn struct {
+	ctx context.Context
+	mp  *MysqlProtocolImpl
+}
+
+func (c *mysqlAuthConn) Salt() []byte {
+	return c.mp.GetSalt()
+}
+
+// IsTLS returns true if the connection is encrypted all the way from the
+// client. Behind the proxy, the hop between the client and the proxy should
+// be encrypted too.
+func (c *mysqlAuthConn) IsTLS() bool {
+	return c.mp.IsTlsEstablished() && c.mp.clientTLSInfo().tls
+}
+
+func (c *mysqlAuthConn) WritePacket(payload []byte) error {
+	return c.mp.writePackets(payload)
+}
+
+func (c *mysqlAuthConn) ReadPacket() ([]byte, error) {
+	return c.mp.readAuthPacket(c.ctx)
+}
+
 // make a OK packet
 func (mp *MysqlProtocolImpl) makeOKPayload(affectedRows, lastInsertId uint64, statusFlags, warnings uint16, message string) []byte {
 	data := make([]byte, HeaderOffset+128+len(message)+10)
@@ -2189,8 +2456,8 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 	var err error = nil
 
 	binary := false
-	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE use binaryRow
-	if CommandType(cmd) == COM_STMT_EXECUTE {
+	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE and COM_STMT_FETCH use binaryRow
+	if CommandType(cmd) == COM_STMT_EXECUTE || CommandType(cmd) == COM_STMT_FETCH {
 		binary = true
 	}
 
@@ -2613,7 +2880,25 @@ func (mp *MysqlProtocolImpl) tryUpdateSalt(rs goetty.IOSession) {
 		logErrorf(mp.GetDebugString(), "failed to get salt: %v", err)
 	} else {
 		mp.SetSalt(data)
+		if mp.SV != nil && len(mp.SV.ProxySecret) != 0 {
+			mp.fromProxy = mp.checkProxyTag(rs, data)
+		}
+	}
+}
+
+// checkProxyTag reads the proxy tag after the salt, and returns true if it
+// is made by the proxy secret.
+func (mp *MysqlProtocolImpl) checkProxyTag(rs goetty.IOSession, salt []byte) bool {
+	tag := make([]byte, ProxyTagLength)
+	if _, err := io.ReadFull(rs.RawConn(), tag); err != nil {
+		logErrorf(mp.GetDebugString(), "failed to get proxy tag: %v", err)
+		return false
+	}
+	if !hmac.Equal(tag, ProxyTag(mp.SV.ProxySecret, salt)) {
+		logErrorf(mp.GetDebugString(), "the proxy tag does not match")
+		return false
 	}
+	return true
 }
 
 /*
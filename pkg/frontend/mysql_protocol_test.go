@@ -2648,3 +2648,43 @@ func TestMysqlProtocolImpl_Close(t *testing.T) {
 	assert.Nil(t, proto.lenEncBuffer)
 	assert.Nil(t, proto.binaryNullBuffer)
 }
+
+func Test_analyseChangeUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	sv, err := getSystemVariables("test/system_vars_config.toml")
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol(0, ioses, 1024, sv)
+	proto.collationID = int(Utf8mb4CollationID)
+
+	authResp := []byte{1, 2, 3, 4}
+	data := append([]byte("acc1:u1:r1"), 0)
+	data = append(data, byte(len(authResp)))
+	data = append(data, authResp...)
+	data = append(data, "db1"...)
+	data = append(data, 0)
+
+	// the packet of the old client without the optional fields
+	req, err := proto.analyseChangeUser(context.TODO(), data)
+	require.NoError(t, err)
+	assert.Equal(t, "acc1:u1:r1", req.username)
+	assert.Equal(t, authResp, req.authResponse)
+	assert.Equal(t, "db1", req.database)
+	assert.Equal(t, int(Utf8mb4CollationID), req.collationID)
+	assert.Equal(t, AuthNativePassword, req.clientPluginName)
+
+	data = append(data, utf8mb4BinCollationID, 0)
+	data = append(data, AuthCachingSha2Password...)
+	data = append(data, 0)
+	data = append(data, 4, 1, 'a', 1, 'b')
+	req, err = proto.analyseChangeUser(context.TODO(), data)
+	require.NoError(t, err)
+	assert.Equal(t, int(utf8mb4BinCollationID), req.collationID)
+	assert.Equal(t, AuthCachingSha2Password, req.clientPluginName)
+	assert.Equal(t, map[string]string{"a": "b"}, req.connectAttrs)
+
+	_, err = proto.analyseChangeUser(context.TODO(), data[:12])
+	assert.Error(t, err)
+}
@@ -16,15 +16,605 @@ package frontend
 
 import (
 	"container/list"
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
-	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const (
+	// planCacheStatsCheckInterval is the interval to check the rows of the
+	// tables used by a cached plan.
+	planCacheStatsCheckInterval = 10 * time.Second
+	// planCacheStatsDriftRatio is the ratio of the rows of a table changed,
+	// after which the cached plan is re-optimized.
+	planCacheStatsDriftRatio = 2
+	// planCacheStatsMinRows is the rows under which a table is regarded as
+	// small, the changes of the rows of small tables are ignored.
+	planCacheStatsMinRows = 1000
+	// planCacheReplanBuckets is the distance of the selectivity buckets of
+	// the generic plan and the bound values, from which a custom plan is
+	// built for the execution of the prepared statement.
+	planCacheReplanBuckets = 2
 )
 
+// selectivityBuckets are the upper bounds of the selectivity buckets.
+var selectivityBuckets = []float64{0.0001, 0.001, 0.01, 0.1}
+
+// rangeFuncs are the functions of the range filters.
+var rangeFuncs = map[string]struct{}{
+	"<":       {},
+	"<=":      {},
+	">":       {},
+	">=":      {},
+	"between": {},
+}
+
+// planDep is a table used by a cached plan.
+type planDep struct {
+	ref     *plan.ObjectRef
+	tableID uint64
+	version uint32
+	// tableCnt is the rows of the table when the plan is built, it is zero
+	// if the plan does not scan the table.
+	tableCnt float64
+}
+
+// planDeps are the tables used by a cached plan. The plan is stale if any
+// of the tables is dropped, altered or its rows change a lot. The deps are
+// shared by the sessions using the same cached plan, so the states are
+// atomic.
+type planDeps struct {
+	deps  []planDep
+	stale atomic.Bool
+	// catalogVersion is the version of the catalog when the tables are
+	// checked last time, the tables are not checked again until the
+	// catalog is changed.
+	catalogVersion atomic.Uint64
+	// statsCheckedAt is the unix nanoseconds when the rows of the tables
+	// are checked last time.
+	statsCheckedAt atomic.Int64
+}
+
+func newPlanDeps(deps []planDep) *planDeps {
+	pd := &planDeps{deps: deps}
+	pd.statsCheckedAt.Store(time.Now().UnixNano())
+	return pd
+}
+
+// collectPlanDeps collects the tables used by the plan.
+func collectPlanDeps(p *plan.Plan) *planDeps {
+	return newPlanDeps(appendPlanDeps(nil, p))
+}
+
+// collectPlansDeps collects the tables used by the plans.
+func collectPlansDeps(plans []*plan.Plan) *planDeps {
+	var deps []planDep
+	for _, p := range plans {
+		deps = appendPlanDeps(deps, p)
+	}
+	return newPlanDeps(deps)
+}
+
+func appendPlanDeps(deps []planDep, p *plan.Plan) []planDep {
+	if p == nil {
+		return deps
+	}
+	switch pp := p.Plan.(type) {
+	case *plan.Plan_Dcl:
+		if prepare := pp.Dcl.GetPrepare(); prepare != nil {
+			return appendPlanDeps(deps, prepare.Plan)
+		}
+	case *plan.Plan_Query:
+		seen := make(map[uint64]int)
+		add := func(ref *plan.ObjectRef, def *plan.TableDef, tableCnt float64) {
+			if ref == nil || def == nil || def.TblId == 0 {
+				return
+			}
+			if i, ok := seen[def.TblId]; ok {
+				if tableCnt > deps[i].tableCnt {
+					deps[i].tableCnt = tableCnt
+				}
+				return
+			}
+			seen[def.TblId] = len(deps)
+			deps = append(deps, planDep{
+				ref:      ref,
+				tableID:  def.TblId,
+				version:  def.Version,
+				tableCnt: tableCnt,
+			})
+		}
+		for _, node := range pp.Query.Nodes {
+			var tableCnt float64
+			if node.NodeType == plan.Node_TABLE_SCAN && node.Stats != nil {
+				tableCnt = node.Stats.TableCnt
+			}
+			add(node.ObjRef, node.TableDef, tableCnt)
+			if node.InsertCtx != nil {
+				add(node.InsertCtx.Ref, node.InsertCtx.TableDef, 0)
+			}
+		}
+	}
+	return deps
+}
+
+// valid checks whether the cached plan could be used. The plan is stale if
+// any table is dropped or recreated, its schema is changed, or its rows
+// drift a lot since the plan is built. Once the plan is stale, it is never
+// valid again. The tables are only resolved again if the catalog version
+// is changed since the last check, or it is unknown (zero).
+func (pd *planDeps) valid(ctx plan2.CompilerContext, catalogVersion uint64) bool {
+	if pd == nil {
+		return true
+	}
+	if pd.stale.Load() {
+		return false
+	}
+	if catalogVersion == 0 || pd.catalogVersion.Load() != catalogVersion {
+		for _, dep := range pd.deps {
+			_, def := ctx.Resolve(dep.ref.SchemaName, dep.ref.ObjName)
+			if def == nil || def.TblId != dep.tableID || def.Version != dep.version {
+				pd.stale.Store(true)
+				return false
+			}
+		}
+		if catalogVersion != 0 {
+			pd.catalogVersion.Store(catalogVersion)
+		}
+	}
+	// only one session checks the rows in an interval
+	checkedAt := pd.statsCheckedAt.Load()
+	now := time.Now().UnixNano()
+	if time.Duration(now-checkedAt) < planCacheStatsCheckInterval ||
+		!pd.statsCheckedAt.CompareAndSwap(checkedAt, now) {
+		return true
+	}
+	for _, dep := range pd.deps {
+		if dep.tableCnt == 0 {
+			continue
+		}
+		stats := ctx.Stats(dep.ref, nil)
+		if stats == nil {
+			continue
+		}
+		if statsDrifted(dep.tableCnt, stats.TableCnt) {
+			pd.stale.Store(true)
+			return false
+		}
+	}
+	return true
+}
+
+// getCatalogVersion returns the version of the catalog seen by the txn of
+// the session. It returns zero if the engine does not version its catalog,
+// or the catalog has changes newer than the snapshot of the txn, in which
+// case the tables must be resolved in the txn.
+func getCatalogVersion(ses *Session) uint64 {
+	cv, ok := ses.GetStorage().(engine.CatalogVersioner)
+	if !ok {
+		return 0
+	}
+	version, ts := cv.CatalogVersion()
+	if version == 0 {
+		return 0
+	}
+	_, op, err := ses.GetTxnHandler().GetTxn()
+	if err != nil || op == nil || op.Txn().SnapshotTS.Less(ts) {
+		return 0
+	}
+	return version
+}
+
+// statsDrifted returns true if the rows of a table drift a lot from the
+// rows when the plan is built.
+func statsDrifted(planned, current float64) bool {
+	if planned < planCacheStatsMinRows {
+		planned = planCacheStatsMinRows
+	}
+	if current < planCacheStatsMinRows {
+		current = planCacheStatsMinRows
+	}
+	return current > planned*planCacheStatsDriftRatio ||
+		current*planCacheStatsDriftRatio < planned
+}
+
+// selectivityBucket returns the bucket of the selectivity.
+func selectivityBucket(selectivity float64) int {
+	for i, bound := range selectivityBuckets {
+		if selectivity < bound {
+			return i
+		}
+	}
+	return len(selectivityBuckets)
+}
+
+// hasParam returns true if the expression refers to a parameter.
+func hasParam(e *plan.Expr) bool {
+	switch exprImpl := e.Expr.(type) {
+	case *plan.Expr_P:
+		return true
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if hasParam(arg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasRangeParam returns true if the expression has a range filter on a
+// parameter.
+func hasRangeParam(e *plan.Expr) bool {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok {
+		return false
+	}
+	if _, ok := rangeFuncs[f.F.Func.GetObjName()]; ok && hasParam(e) {
+		return true
+	}
+	for _, arg := range f.F.Args {
+		if hasRangeParam(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// needCustomPlan returns true if the selectivity of a range filter on the
+// parameters with the bound values is far from the one of the generic plan,
+// which means the generic plan may be bad for the values.
+func needCustomPlan(ctx context.Context, tcc plan2.CompilerContext, generic *plan.Plan, params []*plan.Expr) bool {
+	qry := generic.GetQuery()
+	if qry == nil {
+		return false
+	}
+	for _, node := range qry.Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN || node.ObjRef == nil || node.Stats == nil {
+			continue
+		}
+		ranged := false
+		for _, filter := range node.FilterList {
+			if hasRangeParam(filter) {
+				ranged = true
+				break
+			}
+		}
+		if !ranged {
+			continue
+		}
+		resetParamRule := plan2.NewResetParamRefRule(ctx, params)
+		filters := make([]*plan.Expr, 0, len(node.FilterList))
+		for _, filter := range node.FilterList {
+			e, err := resetParamRule.ApplyExpr(plan2.DeepCopyExpr(filter))
+			if err != nil {
+				return false
+			}
+			filters = append(filters, e)
+		}
+		monoExpr, _ := plan2.HandleFiltersForZM(filters, tcc.GetProcess())
+		stats := tcc.Stats(node.ObjRef, monoExpr)
+		if stats == nil {
+			continue
+		}
+		distance := selectivityBucket(stats.Selectivity) - selectivityBucket(node.Stats.Selectivity)
+		if distance >= planCacheReplanBuckets || -distance >= planCacheReplanBuckets {
+			return true
+		}
+	}
+	return false
+}
+
+// buildCustomPlan builds the plan of the prepared select statement with the
+// values of the parameters, if the generic plan may be bad for them. It
+// returns nil if the generic plan is used. The custom plan is not cached.
+func buildCustomPlan(ctx context.Context, ses *Session, stmt tree.Statement, generic *plan.Plan, args []*plan.Expr) *plan.Plan {
+	if _, ok := stmt.(*tree.Select); !ok || len(args) == 0 {
+		return nil
+	}
+	tcc := ses.GetTxnCompileCtx()
+	resetVarRule := plan2.NewResetVarRefRule(tcc, tcc.GetProcess())
+	params := make([]*plan.Expr, len(args))
+	for i, arg := range args {
+		e, err := resetVarRule.ApplyExpr(plan2.DeepCopyExpr(arg))
+		if err != nil {
+			return nil
+		}
+		if _, ok := e.Expr.(*plan.Expr_C); !ok {
+			return nil
+		}
+		params[i] = e
+	}
+	if !needCustomPlan(ctx, tcc, generic, params) {
+		return nil
+	}
+	customPlan, err := buildPlan(ctx, ses, &paramValuesContext{TxnCompilerContext: tcc, params: params}, stmt)
+	if err != nil {
+		return nil
+	}
+	return customPlan
+}
+
+// paramValuesContext is the compiler context to build a plan with the
+// values of the parameters bound.
+type paramValuesContext struct {
+	*TxnCompilerContext
+	params []*plan.Expr
+}
+
+func (pvc *paramValuesContext) ResolveParams() []*plan.Expr {
+	return pvc.params
+}
+
+// paramStmt is a statement whose literals in the filters could be replaced
+// by parameters, so the statements only differing in the literals share
+// the same generic plan.
+type paramStmt struct {
+	stmt tree.Statement
+	// literals are the replaced literals, in the order of the parameters.
+	literals []tree.Expr
+	params   []*tree.ParamExpr
+	// sets put an expression to the place of a literal.
+	sets []func(tree.Expr)
+}
+
+// newParamStmt returns the parameterized statement, or nil if the
+// statement has no literal to replace.
+func newParamStmt(stmt tree.Statement) *paramStmt {
+	ps := &paramStmt{stmt: stmt}
+	switch st := stmt.(type) {
+	case *tree.Select:
+		if st.Ep != nil {
+			return nil
+		}
+		if sc, ok := st.Select.(*tree.SelectClause); ok && sc.Where != nil {
+			ps.collect(sc.Where.Expr)
+		}
+	case *tree.Update:
+		if st.Where != nil {
+			ps.collect(st.Where.Expr)
+		}
+	case *tree.Delete:
+		if st.Where != nil {
+			ps.collect(st.Where.Expr)
+		}
+	}
+	if len(ps.literals) == 0 {
+		return nil
+	}
+	return ps
+}
+
+// collect collects the literals compared with the columns in the filter.
+func (ps *paramStmt) collect(e tree.Expr) {
+	switch expr := e.(type) {
+	case *tree.AndExpr:
+		ps.collect(expr.Left)
+		ps.collect(expr.Right)
+	case *tree.OrExpr:
+		ps.collect(expr.Left)
+		ps.collect(expr.Right)
+	case *tree.XorExpr:
+		ps.collect(expr.Left)
+		ps.collect(expr.Right)
+	case *tree.NotExpr:
+		ps.collect(expr.Expr)
+	case *tree.ParenExpr:
+		ps.collect(expr.Expr)
+	case *tree.ComparisonExpr:
+		if expr.SubOp == tree.ANY || expr.SubOp == tree.SOME || expr.SubOp == tree.ALL {
+			return
+		}
+		switch expr.Op {
+		case tree.EQUAL, tree.NOT_EQUAL, tree.LESS_THAN, tree.LESS_THAN_EQUAL,
+			tree.GREAT_THAN, tree.GREAT_THAN_EQUAL:
+			if isParamLiteral(expr.Left) == isParamLiteral(expr.Right) {
+				return
+			}
+			if isParamLiteral(expr.Left) {
+				ps.add(expr.Left, func(e tree.Expr) { expr.Left = e })
+			} else {
+				ps.add(expr.Right, func(e tree.Expr) { expr.Right = e })
+			}
+		case tree.IN, tree.NOT_IN:
+			tuple, ok := expr.Right.(*tree.Tuple)
+			if !ok || isParamLiteral(expr.Left) {
+				return
+			}
+			for i, item := range tuple.Exprs {
+				if isParamLiteral(item) {
+					i := i
+					ps.add(item, func(e tree.Expr) { tuple.Exprs[i] = e })
+				}
+			}
+		}
+	case *tree.RangeCond:
+		if isParamLiteral(expr.Left) {
+			return
+		}
+		if isParamLiteral(expr.From) {
+			ps.add(expr.From, func(e tree.Expr) { expr.From = e })
+		}
+		if isParamLiteral(expr.To) {
+			ps.add(expr.To, func(e tree.Expr) { expr.To = e })
+		}
+	}
+}
+
+func (ps *paramStmt) add(literal tree.Expr, set func(tree.Expr)) {
+	ps.literals = append(ps.literals, literal)
+	ps.params = append(ps.params, tree.NewParamExpr(len(ps.literals)))
+	ps.sets = append(ps.sets, set)
+}
+
+// isParamLiteral returns true if the expression is a literal which could
+// be replaced by a parameter.
+func isParamLiteral(e tree.Expr) bool {
+	v, ok := e.(*tree.NumVal)
+	if !ok {
+		return false
+	}
+	switch v.ValType {
+	case tree.P_int64, tree.P_uint64, tree.P_float64, tree.P_decimal, tree.P_char:
+		return true
+	}
+	return false
+}
+
+// parameterize replaces the literals by the parameters.
+func (ps *paramStmt) parameterize() {
+	for i, set := range ps.sets {
+		set(ps.params[i])
+	}
+}
+
+// restore puts the literals back.
+func (ps *paramStmt) restore() {
+	for i, set := range ps.sets {
+		set(ps.literals[i])
+	}
+}
+
+// digest returns the text of the parameterized statement.
+func (ps *paramStmt) digest() string {
+	ps.parameterize()
+	defer ps.restore()
+	return tree.String(ps.stmt, dialect.MYSQL)
+}
+
+// buildGenericPlan builds the plan of the parameterized statement, which
+// is shared by the statements only differing in the literals. The plan is
+// cached as the plan of the same prepared statement.
+func buildGenericPlan(ctx context.Context, ses *Session, ps *paramStmt) (*plan.Plan, *planDeps, error) {
+	db := ses.GetDatabaseName()
+	tcc := ses.GetTxnCompileCtx()
+	ps.parameterize()
+	defer ps.restore()
+	if cached := ses.getCachedPreparePlan(db, ps.stmt); cached != nil && cached.deps.valid(tcc, getCatalogVersion(ses)) {
+		return cached.plans[0].GetDcl().GetPrepare().Plan, cached.deps, nil
+	}
+	preparePlan, err := plan2.BuildPlan(tcc, tree.NewPrepareStmt(tree.Identifier(""), ps.stmt))
+	if err != nil {
+		return nil, nil, err
+	}
+	prepare := preparePlan.GetDcl().GetPrepare()
+	if len(prepare.ParamTypes) != len(ps.literals) || !checkNodeCanCache(prepare.Plan) {
+		// some parameters are folded away, the literals matter to the plan
+		return nil, nil, moerr.NewInvalidInput(ctx, "cannot build generic plan")
+	}
+	ses.cachePreparePlan(db, ps.stmt, preparePlan)
+	return prepare.Plan, collectPlanDeps(preparePlan), nil
+}
+
+// bindGenericPlan binds the literals of the statement to the generic plan.
+func bindGenericPlan(ctx context.Context, ses *Session, ps *paramStmt, generic *plan.Plan) (*plan.Plan, error) {
+	tcc := ses.GetTxnCompileCtx()
+	args, err := plan2.BindConstantExprs(tcc, ps.literals)
+	if err != nil {
+		return nil, err
+	}
+	if sv := ses.GetParameterUnit().SV; sv != nil && sv.PlanCacheReplan {
+		if customPlan := buildCustomPlan(ctx, ses, ps.stmt, generic, args); customPlan != nil {
+			return customPlan, nil
+		}
+	}
+	newPlan := plan2.DeepCopyPlan(generic)
+	vp := plan2.NewVisitPlan(newPlan, []plan2.VisitPlanRule{
+		plan2.NewResetParamRefRule(ctx, args),
+		plan2.NewResetVarRefRule(tcc, tcc.GetProcess()),
+		plan2.NewConstantFoldRule(tcc),
+		plan2.NewRecomputeRealTimeRelatedFuncRule(tcc.GetProcess()),
+	})
+	if err = vp.Visit(ctx); err != nil {
+		return nil, err
+	}
+	return newPlan, nil
+}
+
+// planCacheKey returns the key of the plan of the sql in the database. The
+// sql is normalized, so the same statement with different spaces shares the
+// same plan.
+func planCacheKey(db, sql string) string {
+	return db + "\x00" + normalizeSQL(sql)
+}
+
+// normalizeSQL collapses the spaces out of the quoted strings and comments,
+// and removes the trailing semicolons.
+func normalizeSQL(sql string) string {
+	var b strings.Builder
+	b.Grow(len(sql))
+	space := false
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			space = true
+			continue
+		case c == '\'' || c == '"' || c == '`':
+			j := i + 1
+			for ; j < len(sql) && sql[j] != c; j++ {
+				if sql[j] == '\\' && c != '`' {
+					j++
+				}
+			}
+			if j >= len(sql) {
+				j = len(sql) - 1
+			}
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(sql[i : j+1])
+			i = j
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			j := strings.Index(sql[i+2:], "*/")
+			if j < 0 {
+				j = len(sql)
+			} else {
+				j += i + 4
+			}
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(sql[i:j])
+			i = j - 1
+		case c == '#' || (c == '-' && i+1 < len(sql) && sql[i+1] == '-'):
+			// the line comment is kept with its newline
+			j := strings.IndexByte(sql[i:], '\n')
+			if j < 0 {
+				j = len(sql)
+			} else {
+				j += i + 1
+			}
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(sql[i:j])
+			i = j - 1
+		default:
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteByte(c)
+		}
+		space = false
+	}
+	return strings.TrimRight(b.String(), "; \t\n\r")
+}
+
 type cachedPlan struct {
 	sql   string
 	stmts []tree.Statement
 	plans []*plan.Plan
+	deps  *planDeps
 }
 
 // planCache uses LRU to cache plan for the same sql
@@ -45,7 +635,11 @@ func (pc *planCache) cache(sql string, stmts []tree.Statement, plans []*plan.Pla
 		pc.cachePool = make(map[string]*list.Element)
 		pc.lruList = list.New()
 	}
-	element := pc.lruList.PushFront(&cachedPlan{sql: sql, stmts: stmts, plans: plans})
+	if element, ok := pc.cachePool[sql]; ok {
+		// replace the stale plan
+		pc.lruList.Remove(element)
+	}
+	element := pc.lruList.PushFront(&cachedPlan{sql: sql, stmts: stmts, plans: plans, deps: collectPlansDeps(plans)})
 	pc.cachePool[sql] = element
 	if pc.lruList.Len() > pc.capacity {
 		toRemove := pc.lruList.Back()
@@ -54,12 +648,17 @@ func (pc *planCache) cache(sql string, stmts []tree.Statement, plans []*plan.Pla
 	}
 }
 
-// get gets a cached plan by its sql
+// get gets a cached plan by its sql, the stale plan is removed.
 func (pc *planCache) get(sql string) *cachedPlan {
 	if pc.cachePool == nil {
 		return nil
 	}
 	if element, ok := pc.cachePool[sql]; ok {
+		if element.Value.(*cachedPlan).deps.stale.Load() {
+			pc.lruList.Remove(element)
+			delete(pc.cachePool, sql)
+			return nil
+		}
 		pc.lruList.MoveToFront(element)
 		cp := element.Value.(*cachedPlan)
 		return cp
@@ -71,8 +670,8 @@ func (pc *planCache) isCached(sql string) bool {
 	if pc.cachePool == nil {
 		return false
 	}
-	_, isCached := pc.cachePool[sql]
-	return isCached
+	element, isCached := pc.cachePool[sql]
+	return isCached && !element.Value.(*cachedPlan).deps.stale.Load()
 }
 
 func (pc *planCache) clean() {
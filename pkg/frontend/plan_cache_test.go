@@ -15,8 +15,16 @@
 package frontend
 
 import (
-	"github.com/stretchr/testify/require"
+	"context"
 	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_BasicGet(t *testing.T) {
@@ -91,3 +99,208 @@ func Test_CleanCache(t *testing.T) {
 	require.NotNil(t, pc.get("3"))
 	require.NotNil(t, pc.get("4"))
 }
+
+func Test_NormalizeSQL(t *testing.T) {
+	cases := []struct {
+		sql      string
+		expected string
+	}{
+		{"select 1", "select 1"},
+		{"  select\t1 ,\n 2 ; ", "select 1 , 2"},
+		{"select 'a  b',  \"c  d\"", "select 'a  b', \"c  d\""},
+		{"select 'a\\'  b'  from t", "select 'a\\'  b' from t"},
+		{"select /*+  hint  */  1", "select /*+  hint  */ 1"},
+		{"select 1 -- a  b\n  from t", "select 1 -- a  b\n from t"},
+		{"select 1 #  c\nfrom t;;", "select 1 #  c\nfrom t"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.expected, normalizeSQL(c.sql), c.sql)
+	}
+	require.Equal(t, planCacheKey("db", "select  1;"), planCacheKey("db", "select 1"))
+	require.NotEqual(t, planCacheKey("db1", "select 1"), planCacheKey("db2", "select 1"))
+}
+
+func Test_StatsDrifted(t *testing.T) {
+	require.False(t, statsDrifted(0, 500))
+	require.False(t, statsDrifted(10000, 15000))
+	require.True(t, statsDrifted(10000, 30000))
+	require.True(t, statsDrifted(10000, 4000))
+	require.True(t, statsDrifted(0, 5000))
+}
+
+func Test_SelectivityBucket(t *testing.T) {
+	require.Equal(t, 0, selectivityBucket(0.00001))
+	require.Equal(t, 2, selectivityBucket(0.005))
+	require.Equal(t, 4, selectivityBucket(0.5))
+	require.Equal(t, 4, selectivityBucket(1))
+}
+
+func Test_HasRangeParam(t *testing.T) {
+	param := &plan.Expr{Expr: &plan.Expr_P{P: &plan.ParamRef{Pos: 0}}}
+	col := &plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{}}}
+	fn := func(name string, args ...*plan.Expr) *plan.Expr {
+		return &plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{
+			Func: &plan.ObjectRef{ObjName: name},
+			Args: args,
+		}}}
+	}
+	require.True(t, hasRangeParam(fn("<", col, param)))
+	require.True(t, hasRangeParam(fn("and", fn(">=", col, fn("cast", param)), fn("=", col, col))))
+	require.False(t, hasRangeParam(fn("=", col, param)))
+	require.False(t, hasRangeParam(fn("<", col, col)))
+}
+
+// testPlanCacheCompilerContext resolves the tables for the plan cache.
+type testPlanCacheCompilerContext struct {
+	plan2.CompilerContext
+	tables   map[string]*plan.TableDef
+	rows     float64
+	resolved int
+}
+
+func (c *testPlanCacheCompilerContext) Resolve(_ string, tableName string) (*plan.ObjectRef, *plan.TableDef) {
+	c.resolved++
+	def, ok := c.tables[tableName]
+	if !ok {
+		return nil, nil
+	}
+	return &plan.ObjectRef{ObjName: tableName}, def
+}
+
+func (c *testPlanCacheCompilerContext) Stats(_ *plan.ObjectRef, _ *plan.Expr) *plan.Stats {
+	return &plan.Stats{TableCnt: c.rows}
+}
+
+func Test_PlanDeps(t *testing.T) {
+	p := &plan.Plan{
+		Plan: &plan.Plan_Query{
+			Query: &plan.Query{
+				Nodes: []*plan.Node{
+					{
+						NodeType: plan.Node_TABLE_SCAN,
+						ObjRef:   &plan.ObjectRef{SchemaName: "db", ObjName: "t1"},
+						TableDef: &plan.TableDef{TblId: 1, Version: 1},
+						Stats:    &plan.Stats{TableCnt: 10000},
+					},
+					{
+						NodeType: plan.Node_PROJECT,
+					},
+					{
+						NodeType: plan.Node_INSERT,
+						InsertCtx: &plan.InsertCtx{
+							Ref:      &plan.ObjectRef{SchemaName: "db", ObjName: "t2"},
+							TableDef: &plan.TableDef{TblId: 2, Version: 1},
+						},
+					},
+				},
+			},
+		},
+	}
+	pd := collectPlansDeps([]*plan.Plan{p})
+	require.Equal(t, 2, len(pd.deps))
+	require.Equal(t, float64(10000), pd.deps[0].tableCnt)
+
+	ctx := &testPlanCacheCompilerContext{
+		tables: map[string]*plan.TableDef{
+			"t1": {TblId: 1, Version: 1},
+			"t2": {TblId: 2, Version: 1},
+		},
+		rows: 10000,
+	}
+	require.True(t, pd.valid(ctx, 0))
+	require.Equal(t, 2, ctx.resolved)
+
+	// the tables are not resolved again until the catalog is changed
+	require.True(t, pd.valid(ctx, 1))
+	require.Equal(t, 4, ctx.resolved)
+	require.True(t, pd.valid(ctx, 1))
+	require.Equal(t, 4, ctx.resolved)
+	require.True(t, pd.valid(ctx, 2))
+	require.Equal(t, 6, ctx.resolved)
+
+	// the rows are checked after the interval
+	ctx.rows = 100000
+	require.True(t, pd.valid(ctx, 2))
+	pd.statsCheckedAt.Store(time.Now().Add(-planCacheStatsCheckInterval).UnixNano())
+	require.False(t, pd.valid(ctx, 2))
+	require.True(t, pd.stale.Load())
+
+	// the schema of the table is changed
+	pd = collectPlansDeps([]*plan.Plan{p})
+	ctx.tables["t2"] = &plan.TableDef{TblId: 2, Version: 2}
+	require.False(t, pd.valid(ctx, 3))
+
+	// the table is dropped
+	pd = collectPlansDeps([]*plan.Plan{p})
+	delete(ctx.tables, "t2")
+	require.False(t, pd.valid(ctx, 0))
+
+	// the plan without deps is always valid
+	var nilDeps *planDeps
+	require.True(t, nilDeps.valid(ctx, 0))
+}
+
+func Test_ParamStmt(t *testing.T) {
+	parse := func(sql string) tree.Statement {
+		stmts, err := mysql.Parse(context.TODO(), sql, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(stmts))
+		return stmts[0]
+	}
+	cases := []struct {
+		sql      string
+		digest   string
+		literals int
+	}{
+		{"select a from t where a = 1 and b > 'x'", "select a from t where a = ? and b > ?", 2},
+		{"select a from t where 2 <= a or b in (1, 2, c)", "select a from t where ? <= a or b in (?, ?, c)", 3},
+		{"select a from t where not (a between 1 and 10)", "select a from t where not (a between ? and ?)", 2},
+		{"delete from t where a = 1.5", "delete from t where a = ?", 1},
+		{"update t set b = 1 where a <> 2", "update t set b = 1 where a != ?", 1},
+	}
+	for _, c := range cases {
+		stmt := parse(c.sql)
+		text := tree.String(stmt, dialect.MYSQL)
+		ps := newParamStmt(stmt)
+		require.NotNil(t, ps, c.sql)
+		require.Equal(t, c.digest, ps.digest(), c.sql)
+		require.Equal(t, c.literals, len(ps.literals), c.sql)
+		// the literals are put back
+		require.Equal(t, text, tree.String(stmt, dialect.MYSQL), c.sql)
+	}
+
+	// the statements only differing in the literals have the same digest
+	require.Equal(t,
+		newParamStmt(parse("select a from t where a = 1")).digest(),
+		newParamStmt(parse("select a from t where a = 100")).digest())
+
+	// no literal is compared with the columns
+	for _, sql := range []string{
+		"select a from t",
+		"select a from t where 1 = 1",
+		"select a from t where a like 'x%'",
+		"select a from t where a = any (select b from t2)",
+		"insert into t values (1)",
+	} {
+		require.Nil(t, newParamStmt(parse(sql)), sql)
+	}
+}
+
+func Test_RemoveStalePlan(t *testing.T) {
+	pc := newPlanCache(3)
+	pc.cache("1", nil, nil)
+	cp := pc.get("1")
+	require.NotNil(t, cp)
+	cp.deps.stale.Store(true)
+	require.False(t, pc.isCached("1"))
+	require.Nil(t, pc.get("1"))
+
+	pc.cache("1", nil, nil)
+	require.True(t, pc.isCached("1"))
+
+	// the stale plan is replaced
+	pc.get("1").deps.stale.Store(true)
+	pc.cache("1", nil, nil)
+	require.Equal(t, 1, pc.lruList.Len())
+	require.True(t, pc.isCached("1"))
+}
@@ -470,6 +470,10 @@ func (fp *FakeProtocol) ParseExecuteData(ctx context.Context, stmt *PrepareStmt,
 	return nil, nil, nil
 }
 
+func (fp *FakeProtocol) ChangeUser(ctx context.Context, payload []byte) error {
+	return nil
+}
+
 func (fp *FakeProtocol) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
 	return nil
 }
@@ -0,0 +1,153 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/replication"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var (
+	showRemoteSubscriptionOutputColumns = [2]Column{
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Status",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+		&MysqlColumn{
+			ColumnImpl: ColumnImpl{
+				name:       "Lag",
+				columnType: defines.MYSQL_TYPE_VARCHAR,
+			},
+		},
+	}
+)
+
+// getReplicationService is the replication service of the cn, it is a variable
+// so the tests can replace it.
+var getReplicationService = func(ctx context.Context) (remoteSubscriptionService, error) {
+	s, ok := replication.GetService()
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "replication service is not started")
+	}
+	return s, nil
+}
+
+// remoteSubscriptionService saves the remote subscriptions
+type remoteSubscriptionService interface {
+	Subscribe(ctx context.Context, name, endpoint, publication string, conflict replication.ConflictMode) error
+	Unsubscribe(ctx context.Context, name string) error
+	Subscriptions(ctx context.Context) ([]replication.Status, error)
+}
+
+func (mce *MysqlCmdExecutor) handleCreateRemoteSubscription(ctx context.Context, cd *tree.CreateDatabase) error {
+	return doCreateRemoteSubscription(ctx, mce.GetSession(), cd)
+}
+
+// doCreateRemoteSubscription creates the database of the subscription of a
+// publication of another cluster, the database is filled by the replication
+// service.
+func doCreateRemoteSubscription(ctx context.Context, ses *Session, cd *tree.CreateDatabase) error {
+	tenantInfo := ses.GetTenantInfo()
+	if tenantInfo == nil || !tenantInfo.IsSysTenant() || !tenantInfo.IsAdminRole() {
+		return moerr.NewInternalError(ctx, "only the admin of the sys account can create remote subscription")
+	}
+	opt := cd.SubscriptionOption
+	conflict, err := replication.ParseConflictMode(ctx, opt.Conflict)
+	if err != nil {
+		return err
+	}
+	service, err := getReplicationService(ctx)
+	if err != nil {
+		return err
+	}
+
+	name := string(cd.Name)
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	if err = bh.Exec(ctx, fmt.Sprintf("create database `%s`", name)); err != nil {
+		return err
+	}
+	if err = service.Subscribe(ctx, name, string(opt.From), string(opt.Publication), conflict); err != nil {
+		if dropErr := bh.Exec(ctx, fmt.Sprintf("drop database `%s`", name)); dropErr != nil {
+			logErrorf(ses.GetDebugString(), "drop database of remote subscription %s failed. error:%v", name, dropErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// doDropRemoteSubscription removes the remote subscription of the dropped
+// database if it exists.
+func doDropRemoteSubscription(ctx context.Context, ses *Session, dd *tree.DropDatabase) error {
+	tenantInfo := ses.GetTenantInfo()
+	if tenantInfo == nil || !tenantInfo.IsSysTenant() {
+		return nil
+	}
+	service, err := getReplicationService(ctx)
+	if err != nil {
+		// no remote subscription without the replication service
+		return nil
+	}
+	return service.Unsubscribe(ctx, string(dd.Name))
+}
+
+// addRemoteSubscriptions adds the remote subscriptions of the sys account to
+// the result set of show subscriptions, with the status and the lag of the
+// replication. The columns are only added if there is a remote subscription.
+func addRemoteSubscriptions(ctx context.Context, ses *Session, rs *MysqlResultSet) error {
+	tenantInfo := ses.GetTenantInfo()
+	if tenantInfo == nil || !tenantInfo.IsSysTenant() {
+		return nil
+	}
+	service, err := getReplicationService(ctx)
+	if err != nil {
+		return nil
+	}
+	statuses, err := service.Subscriptions(ctx)
+	if err != nil || len(statuses) == 0 {
+		return err
+	}
+
+	rs.AddColumn(showRemoteSubscriptionOutputColumns[0])
+	rs.AddColumn(showRemoteSubscriptionOutputColumns[1])
+	for i := range rs.Data {
+		rs.Data[i] = append(rs.Data[i], "", nil)
+	}
+	for _, status := range statuses {
+		row := make([]interface{}, 4)
+		row[0] = status.Name
+		row[1] = status.Endpoint
+		switch {
+		case status.Error != "":
+			row[2] = "error: " + status.Error
+			row[3] = status.Lag.String()
+		case status.Running:
+			row[2] = "running"
+			row[3] = status.Lag.String()
+		default:
+			// replicated by another cn
+			row[2] = "not running on this cn"
+		}
+		rs.AddRow(row)
+	}
+	return nil
+}
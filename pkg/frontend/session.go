@@ -38,6 +38,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/txn/clock"
@@ -102,6 +103,15 @@ type Session struct {
 
 	prepareStmts map[string]*PrepareStmt
 	lastStmtId   uint32
+	// cursorStmt is the prepared statement executed with a read-only cursor
+	// by the current COM_STMT_EXECUTE
+	cursorStmt *PrepareStmt
+	// cursorCancel cancels the context of the current COM_STMT_EXECUTE with
+	// a read-only cursor, the pipeline of the statement outlives the request
+	cursorCancel context.CancelFunc
+	// cursor is filled with the result set of the statement instead of
+	// sending it to the client, it is running until the statement ends
+	cursor *stmtCursor
 
 	requestCtx context.Context
 	connectCtx context.Context
@@ -173,6 +183,11 @@ type Session struct {
 
 	planCache *planCache
 
+	// preparePlanCache caches the plans of the prepared statements by the
+	// statements, so the same statement is not planned again when it is
+	// prepared again.
+	preparePlanCache *planCache
+
 	statsCache *plan2.StatsCache
 
 	autoIncrCacheManager *defines.AutoIncrCacheManager
@@ -333,9 +348,10 @@ func NewSession(proto Protocol, mp *mpool.MPool, pu *config.ParameterUnit, gSysV
 			msgs:   make([]string, 0, MoDefaultErrorCount),
 			maxCnt: MoDefaultErrorCount,
 		},
-		cache:     &privilegeCache{},
-		blockIdx:  0,
-		planCache: newPlanCache(100),
+		cache:            &privilegeCache{},
+		blockIdx:         0,
+		planCache:        newPlanCache(100),
+		preparePlanCache: newPlanCache(100),
 	}
 	if flag {
 		ses.sysVars = gSysVars.CopySysVarsToSession()
@@ -376,6 +392,11 @@ func NewSession(proto Protocol, mp *mpool.MPool, pu *config.ParameterUnit, gSysV
 }
 
 func (ses *Session) Close() {
+	if ses.cursor != nil {
+		// the statement of the cursor ends with the session
+		ses.cursor.close()
+		ses.cursor = nil
+	}
 	if ses.flag {
 		mp := ses.GetMemPool()
 		mpool.DeleteMPool(mp)
@@ -408,6 +429,7 @@ func (ses *Session) Close() {
 	ses.QueryId = nil
 	ses.p = nil
 	ses.planCache = nil
+	ses.preparePlanCache = nil
 	ses.statsCache = nil
 	ses.seqCurValues = nil
 	ses.seqLastValue = ""
@@ -479,28 +501,48 @@ func (ses *Session) IsBackgroundSession() bool {
 	return ses.isBackgroundSession
 }
 
-func (ses *Session) cachePlan(sql string, stmts []tree.Statement, plans []*plan.Plan) {
+func (ses *Session) cachePlan(db, sql string, stmts []tree.Statement, plans []*plan.Plan) {
+	key := planCacheKey(db, sql)
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.planCache.cache(key, stmts, plans)
+}
+
+func (ses *Session) getCachedPlan(db, sql string) *cachedPlan {
+	key := planCacheKey(db, sql)
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
-	ses.planCache.cache(sql, stmts, plans)
+	return ses.planCache.get(key)
 }
 
-func (ses *Session) getCachedPlan(sql string) *cachedPlan {
+func (ses *Session) isCached(db, sql string) bool {
+	key := planCacheKey(db, sql)
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
-	return ses.planCache.get(sql)
+	return ses.planCache.isCached(key)
 }
 
-func (ses *Session) isCached(sql string) bool {
+// cachePreparePlan caches the plan of the prepared statement.
+func (ses *Session) cachePreparePlan(db string, stmt tree.Statement, preparePlan *plan.Plan) {
+	key := planCacheKey(db, tree.String(stmt, dialect.MYSQL))
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
-	return ses.planCache.isCached(sql)
+	ses.preparePlanCache.cache(key, []tree.Statement{stmt}, []*plan.Plan{preparePlan})
+}
+
+// getCachedPreparePlan gets the cached plan of the prepared statement.
+func (ses *Session) getCachedPreparePlan(db string, stmt tree.Statement) *cachedPlan {
+	key := planCacheKey(db, tree.String(stmt, dialect.MYSQL))
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	return ses.preparePlanCache.get(key)
 }
 
 func (ses *Session) cleanCache() {
 	ses.mu.Lock()
 	defer ses.mu.Unlock()
 	ses.planCache.clean()
+	ses.preparePlanCache.clean()
 }
 
 func (ses *Session) setSkipCheckPrivilege(b bool) {
@@ -608,6 +650,38 @@ func (ses *Session) InvalidatePrivilegeCache() {
 	ses.cache.invalidate()
 }
 
+// ResetSession resets the session to the state after the connection is
+// established. The open transaction is rolled back, and the session variables,
+// user defined variables, prepared statements, temporary tables and caches of
+// the session are dropped. The account of the session is not changed.
+func (ses *Session) ResetSession() error {
+	err := ses.TxnRollback()
+	ses.ClearOptionBits(OPTION_NOT_AUTOCOMMIT)
+	ses.SetOptionBits(OPTION_AUTOCOMMIT)
+	ses.GetTxnHandler().SetTempEngine(nil)
+
+	ses.mu.Lock()
+	defer ses.mu.Unlock()
+	ses.sysVars = ses.gSysVars.CopySysVarsToSession()
+	ses.userDefinedVars = make(map[string]interface{})
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.cursorStmt = nil
+	ses.cursor = nil
+	ses.seqCurValues = make(map[uint64]string)
+	ses.seqLastValue = ""
+	ses.lastInsertID = 0
+	ses.timeZone = time.Local
+	ses.InitTempEngine = false
+	ses.tempTablestorage = nil
+	if ee, ok := ses.storage.(*engine.EntireEngine); ok {
+		ee.TempEngine = nil
+	}
+	ses.planCache.clean()
+	ses.preparePlanCache.clean()
+	ses.cache.invalidate()
+	return err
+}
+
 // GetBackgroundExec generates a background executor
 func (ses *Session) GetBackgroundExec(ctx context.Context) BackgroundExec {
 	return NewBackgroundHandler(
@@ -1121,8 +1195,10 @@ func (ses *Session) skipAuthForSpecialUser() bool {
 	return false
 }
 
-// AuthenticateUser verifies the password of the user.
-func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
+// AuthenticateUser checks the account and the user, and returns the
+// authentication string, the authentication plugin and the tls requirement
+// of the user.
+func (ses *Session) AuthenticateUser(userInput string) (string, string, string, error) {
 	var defaultRoleID int64
 	var defaultRole string
 	var tenant *TenantInfo
@@ -1130,7 +1206,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	var rsset []ExecResult
 	var tenantID int64
 	var userID int64
-	var pwd, accountStatus string
+	var pwd, loginType, tlsRequire, accountStatus string
 	var accountVersion uint64
 	var pwdBytes []byte
 	var isSpecial bool
@@ -1139,7 +1215,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	//Get tenant info
 	tenant, err = GetTenantInfo(ses.GetRequestContext(), userInput)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 
 	ses.SetTenantInfo(tenant)
@@ -1151,7 +1227,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	isSpecial, pwdBytes, specialAccount = isSpecialUser(tenant.GetUser())
 	if isSpecial && specialAccount.IsMoAdminRole() {
 		ses.SetTenantInfo(specialAccount)
-		return GetPassWord(HashPassWordWithByte(pwdBytes))
+		return HashPassWordWithByte(pwdBytes), AuthNativePassword, "", nil
 	}
 
 	ses.SetTenantInfo(tenant)
@@ -1162,7 +1238,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	sysTenantCtx = context.WithValue(sysTenantCtx, defines.RoleIDKey{}, uint32(moAdminRoleID))
 	sqlForCheckTenant, err := getSqlForCheckTenant(sysTenantCtx, tenant.GetTenant())
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 	pu := ses.GetParameterUnit()
 	mp := ses.GetMemPool()
@@ -1174,32 +1250,32 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		pu,
 		sqlForCheckTenant)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 	if !execResultArrayHasData(rsset) {
-		return nil, moerr.NewInternalError(sysTenantCtx, "there is no tenant %s", tenant.GetTenant())
+		return "", "", "", moerr.NewInternalError(sysTenantCtx, "there is no tenant %s", tenant.GetTenant())
 	}
 
 	//account id
 	tenantID, err = rsset[0].GetInt64(sysTenantCtx, 0, 0)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 
 	//account status
 	accountStatus, err = rsset[0].GetString(sysTenantCtx, 0, 2)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 
 	//account version
 	accountVersion, err = rsset[0].GetUint64(sysTenantCtx, 0, 3)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 
 	if strings.ToLower(accountStatus) == tree.AccountStatusSuspend.String() {
-		return nil, moerr.NewInternalError(sysTenantCtx, "Account %s is suspended", tenant.GetTenant())
+		return "", "", "", moerr.NewInternalError(sysTenantCtx, "Account %s is suspended", tenant.GetTenant())
 	}
 
 	tenant.SetTenantID(uint32(tenantID))
@@ -1210,9 +1286,20 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 
 	logDebugf(sessionInfo, "check user of %s exists", tenant)
 	//Get the password of the user in an independent session
-	sqlForPasswordOfUser, err := getSqlForPasswordOfUser(tenantCtx, tenant.GetUser())
+	hasTLSRequire, err := hasTLSRequireColumn(uint32(tenantID), func(sql string) ([]ExecResult, error) {
+		return executeSQLInBackgroundSession(tenantCtx, ses, mp, pu, sql)
+	})
 	if err != nil {
-		return nil, err
+		return "", "", "", err
+	}
+	var sqlForPasswordOfUser string
+	if hasTLSRequire {
+		sqlForPasswordOfUser, err = getSqlForPasswordAndTLSRequireOfUser(tenantCtx, tenant.GetUser())
+	} else {
+		sqlForPasswordOfUser, err = getSqlForPasswordOfUser(tenantCtx, tenant.GetUser())
+	}
+	if err != nil {
+		return "", "", "", err
 	}
 	rsset, err = executeSQLInBackgroundSession(
 		tenantCtx,
@@ -1221,27 +1308,40 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		pu,
 		sqlForPasswordOfUser)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 	if !execResultArrayHasData(rsset) {
-		return nil, moerr.NewInternalError(tenantCtx, "there is no user %s", tenant.GetUser())
+		return "", "", "", moerr.NewInternalError(tenantCtx, "there is no user %s", tenant.GetUser())
 	}
 
 	userID, err = rsset[0].GetInt64(tenantCtx, 0, 0)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 
 	pwd, err = rsset[0].GetString(tenantCtx, 0, 1)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
+	}
+
+	loginType, err = rsset[0].GetString(tenantCtx, 0, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	//the mo_user without the column tls_require has no user requiring TLS
+	if hasTLSRequire {
+		tlsRequire, err = rsset[0].GetString(tenantCtx, 0, 4)
+		if err != nil {
+			return "", "", "", err
+		}
 	}
 
 	//the default_role in the mo_user table.
 	//the default_role is always valid. public or other valid role.
 	defaultRoleID, err = rsset[0].GetInt64(tenantCtx, 0, 2)
 	if err != nil {
-		return nil, err
+		return "", "", "", err
 	}
 
 	tenant.SetUserID(uint32(userID))
@@ -1263,7 +1363,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 		//step4 : check role exists or not
 		sqlForCheckRoleExists, err := getSqlForRoleIdOfRole(tenantCtx, tenant.GetDefaultRole())
 		if err != nil {
-			return nil, err
+			return "", "", "", err
 		}
 		rsset, err = executeSQLInBackgroundSession(
 			tenantCtx,
@@ -1272,18 +1372,18 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sqlForCheckRoleExists)
 		if err != nil {
-			return nil, err
+			return "", "", "", err
 		}
 
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "there is no role %s", tenant.GetDefaultRole())
+			return "", "", "", moerr.NewInternalError(tenantCtx, "there is no role %s", tenant.GetDefaultRole())
 		}
 
 		logDebugf(sessionInfo, "check granted role of user %s.", tenant)
 		//step4.2 : check the role has been granted to the user or not
 		sqlForRoleOfUser, err := getSqlForRoleOfUser(tenantCtx, userID, tenant.GetDefaultRole())
 		if err != nil {
-			return nil, err
+			return "", "", "", err
 		}
 		rsset, err = executeSQLInBackgroundSession(
 			tenantCtx,
@@ -1292,16 +1392,16 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sqlForRoleOfUser)
 		if err != nil {
-			return nil, err
+			return "", "", "", err
 		}
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "the role %s has not been granted to the user %s",
+			return "", "", "", moerr.NewInternalError(tenantCtx, "the role %s has not been granted to the user %s",
 				tenant.GetDefaultRole(), tenant.GetUser())
 		}
 
 		defaultRoleID, err = rsset[0].GetInt64(tenantCtx, 0, 0)
 		if err != nil {
-			return nil, err
+			return "", "", "", err
 		}
 		tenant.SetDefaultRoleID(uint32(defaultRoleID))
 	} else {
@@ -1315,15 +1415,15 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 			pu,
 			sql)
 		if err != nil {
-			return nil, err
+			return "", "", "", err
 		}
 		if !execResultArrayHasData(rsset) {
-			return nil, moerr.NewInternalError(tenantCtx, "get the default role of the user %s failed", tenant.GetUser())
+			return "", "", "", moerr.NewInternalError(tenantCtx, "get the default role of the user %s failed", tenant.GetUser())
 		}
 
 		defaultRole, err = rsset[0].GetString(tenantCtx, 0, 0)
 		if err != nil {
-			return nil, err
+			return "", "", "", err
 		}
 		tenant.SetDefaultRole(defaultRole)
 	}
@@ -1331,7 +1431,7 @@ func (ses *Session) AuthenticateUser(userInput string) ([]byte, error) {
 	ses.getRoutineManager().accountRoutine.recordRountine(tenantID, ses.getRoutin(), accountVersion)
 	logInfo(sessionInfo, tenant.String())
 
-	return GetPassWord(pwd)
+	return pwd, pluginOfLoginType(loginType), tlsRequire, nil
 }
 
 func (ses *Session) GetPrivilege() *privilege {
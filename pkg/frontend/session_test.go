@@ -724,3 +724,32 @@ func TestSetTempTableStorage(t *testing.T) {
 
 	assert.Equal(t, defines.TEMPORARY_TABLE_DN_ADDR, dnStore.TxnServiceAddress)
 }
+
+func TestSession_ResetSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	ses.SetRequestContext(context.Background())
+
+	assert.NoError(t, ses.SetUserDefinedVar("a", int64(1)))
+	ses.SetSysVar("sql_mode", "ANSI")
+	assert.NoError(t, ses.SetPrepareStmt("stmt1", &PrepareStmt{Name: "stmt1"}))
+	ses.SetLastInsertID(10)
+	ses.SetTimeZone(time.UTC)
+	assert.NoError(t, ses.SetAutocommit(false))
+	ses.EnableInitTempEngine()
+	ses.GetPrivilegeCache().add(objectTypeTable, privilegeLevelStar, "db", "t", PrivilegeTypeSelect)
+
+	assert.NoError(t, ses.ResetSession())
+	_, v, err := ses.GetUserDefinedVar("a")
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	assert.Equal(t, GSysVariables.CopySysVarsToSession()["sql_mode"], ses.GetSysVar("sql_mode"))
+	_, err = ses.GetPrepareStmt("stmt1")
+	assert.Error(t, err)
+	assert.Zero(t, ses.GetLastInsertID())
+	assert.Equal(t, time.Local, ses.GetTimeZone())
+	assert.False(t, ses.OptionBitsIsSet(OPTION_NOT_AUTOCOMMIT))
+	assert.False(t, ses.IfInitedTempEngine())
+	assert.False(t, ses.GetPrivilegeCache().has(objectTypeTable, privilegeLevelStar, "db", "t", PrivilegeTypeSelect))
+}
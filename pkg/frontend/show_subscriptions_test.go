@@ -18,10 +18,12 @@ import (
 	"context"
 	"github.com/golang/mock/gomock"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/replication"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestDoShowSubscriptions(t *testing.T) {
@@ -91,3 +93,70 @@ func TestDoShowSubscriptions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, output[1], got)
 }
+
+type testRemoteSubscriptionService struct {
+	statuses []replication.Status
+}
+
+func (s *testRemoteSubscriptionService) Subscribe(ctx context.Context, name, endpoint, publication string, conflict replication.ConflictMode) error {
+	return nil
+}
+
+func (s *testRemoteSubscriptionService) Unsubscribe(ctx context.Context, name string) error {
+	return nil
+}
+
+func (s *testRemoteSubscriptionService) Subscriptions(ctx context.Context) ([]replication.Status, error) {
+	return s.statuses, nil
+}
+
+func TestDoShowRemoteSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	tenant := &TenantInfo{
+		Tenant:        sysAccountName,
+		User:          rootName,
+		DefaultRole:   moAdminRoleName,
+		TenantID:      sysAccountID,
+		UserID:        rootID,
+		DefaultRoleID: moAdminRoleID,
+	}
+	ses.SetTenantInfo(tenant)
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	service := &testRemoteSubscriptionService{statuses: []replication.Status{
+		{Name: "dr1", Endpoint: "10.0.0.1:7001", Running: true, Lag: time.Second},
+		{Name: "dr2", Endpoint: "10.0.0.2:7001"},
+	}}
+	serviceStub := gostub.Stub(&getReplicationService, func(context.Context) (remoteSubscriptionService, error) {
+		return service, nil
+	})
+	defer serviceStub.Reset()
+
+	sql, err := getSqlForShowSubscriptions(ctx, tenant.TenantID)
+	require.NoError(t, err)
+	bh.sql2result[sql] = &MysqlResultSet{}
+	bh.sql2result["begin;"] = nil
+	bh.sql2result["commit;"] = nil
+	bh.sql2result["rollback;"] = nil
+
+	err = doShowSubscriptions(ctx, ses, &tree.ShowSubscriptions{})
+	require.NoError(t, err)
+	rs := ses.GetMysqlResultSet()
+	require.Equal(t, uint64(4), rs.GetColumnCount())
+	require.Equal(t, uint64(2), rs.GetRowCount())
+	row, err := rs.GetRow(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"dr1", "10.0.0.1:7001", "running", "1s"}, row)
+	row, err = rs.GetRow(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"dr2", "10.0.0.2:7001", "not running on this cn", nil}, row)
+}
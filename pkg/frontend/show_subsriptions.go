@@ -104,6 +104,10 @@ func doShowSubscriptions(ctx context.Context, ses *Session, sp *tree.ShowSubscri
 			rs.AddRow(row)
 		}
 	}
+	err = addRemoteSubscriptions(ctx, ses, rs)
+	if err != nil {
+		goto handleFailed
+	}
 	ses.SetMysqlResultSet(rs)
 	err = bh.Exec(ctx, "commit;")
 	if err != nil {
@@ -52,6 +52,36 @@ func IsPrepareStatement(stmt tree.Statement) bool {
 	return false
 }
 
+// IsReadOnlyStatement checks the statement modifies neither the data nor the metadata,
+// so it can be executed on the read-only cn.
+func IsReadOnlyStatement(ses *Session, stmt tree.Statement) (bool, error) {
+	switch st := stmt.(type) {
+	case *tree.ExplainAnalyze:
+		return IsReadOnlyStatement(ses, st.Statement)
+	case *tree.PrepareStmt:
+		return IsReadOnlyStatement(ses, st.Stmt)
+	case *tree.PrepareString:
+		v, err := ses.GetGlobalVar("lower_case_table_names")
+		if err != nil {
+			return false, err
+		}
+		preStmt, err := mysql.ParseOne(ses.requestCtx, st.Sql, v.(int64))
+		if err != nil {
+			return false, err
+		}
+		return IsReadOnlyStatement(ses, preStmt)
+	case *tree.SetPassword, *tree.SetDefaultRole,
+		*tree.CreateProcedure, *tree.DropProcedure, *tree.CallStmt,
+		*tree.LockTableStmt, *tree.UnLockTableStmt:
+		return false, nil
+	}
+	switch stmt.GetQueryType() {
+	case tree.QueryTypeDDL, tree.QueryTypeDML, tree.QueryTypeDCL:
+		return false, nil
+	}
+	return true, nil
+}
+
 // IsDDL checks the statement is the DDL statement.
 func IsDDL(stmt tree.Statement) bool {
 	switch stmt.(type) {
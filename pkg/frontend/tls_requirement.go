@@ -0,0 +1,255 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509/pkix"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// The connection attributes which carry the TLS information of the client,
+// when the proxy terminates TLS. They are trusted only if the connection is
+// authenticated by the proxy tag, and the proxy drops them from the
+// connection attributes of the clients.
+const (
+	ProxyAttrPrefix      = "_proxy_"
+	ProxyAttrTLS         = "_proxy_tls"
+	ProxyAttrX509Subject = "_proxy_x509_subject"
+	ProxyAttrX509Issuer  = "_proxy_x509_issuer"
+)
+
+// accountsWithTLSRequire are the accounts whose mo_user has the column
+// tls_require. The mo_user of the accounts created before the column is
+// added has no such column, whose users can not be created with REQUIRE.
+var accountsWithTLSRequire sync.Map
+
+// hasTLSRequireColumn returns true if the mo_user of the account has the
+// column tls_require. Only the accounts having it are cached, since the
+// column could not be removed.
+func hasTLSRequireColumn(accountID uint32, exec func(sql string) ([]ExecResult, error)) (bool, error) {
+	if _, ok := accountsWithTLSRequire.Load(accountID); ok {
+		return true, nil
+	}
+	rs, err := exec(checkTLSRequireOfMoUserFormat)
+	if err != nil {
+		return false, err
+	}
+	if !execResultArrayHasData(rs) {
+		return false, nil
+	}
+	accountsWithTLSRequire.Store(accountID, struct{}{})
+	return true, nil
+}
+
+// ProxyTagLength is the length of the proxy tag.
+const ProxyTagLength = sha256.Size
+
+// ProxyTag returns the tag sent by the proxy after the salt when it
+// connects to the server, which proves that the proxy knows the secret.
+func ProxyTag(secret string, salt []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(salt)
+	return h.Sum(nil)
+}
+
+// The types of the REQUIRE clause of the user.
+const (
+	tlsRequireNone = ""
+	tlsRequireSSL  = "SSL"
+	tlsRequireX509 = "X509"
+)
+
+// maxTLSRequireLength is the length of the column tls_require in mo_user.
+const maxTLSRequireLength = 1024
+
+// tlsRequirement is the REQUIRE clause of the user. It is saved in the column
+// tls_require of mo_user as "", "SSL", "X509" or "X509?issuer=..&subject=..".
+type tlsRequirement struct {
+	typ     string
+	subject string
+	issuer  string
+}
+
+// newTLSRequirement makes the tlsRequirement from the REQUIRE clause.
+// REQUIRE SUBJECT and REQUIRE ISSUER imply REQUIRE X509.
+func newTLSRequirement(ctx context.Context, opts []tree.TlsOption) (tlsRequirement, error) {
+	var r tlsRequirement
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case *tree.TlsOptionNone:
+			r.typ = tlsRequireNone
+		case *tree.TlsOptionSSL:
+			r.typ = tlsRequireSSL
+		case *tree.TlsOptionX509:
+			r.typ = tlsRequireX509
+		case *tree.TlsOptionSubject:
+			r.typ = tlsRequireX509
+			r.subject = o.Subject
+		case *tree.TlsOptionIssuer:
+			r.typ = tlsRequireX509
+			r.issuer = o.Issuer
+		case *tree.TlsOptionCipher:
+			return r, moerr.NewNotSupported(ctx, "REQUIRE CIPHER")
+		case *tree.TlsOptionSan:
+			return r, moerr.NewNotSupported(ctx, "REQUIRE SAN")
+		}
+	}
+	if len(r.String()) > maxTLSRequireLength {
+		return r, moerr.NewInternalError(ctx, "the subject or issuer of REQUIRE is too long")
+	}
+	return r, nil
+}
+
+// parseTLSRequirement parses the tlsRequirement from the column tls_require.
+func parseTLSRequirement(ctx context.Context, s string) (tlsRequirement, error) {
+	var r tlsRequirement
+	typ, query, _ := strings.Cut(s, "?")
+	switch typ {
+	case tlsRequireNone, tlsRequireSSL:
+		r.typ = typ
+	case tlsRequireX509:
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return r, moerr.NewInternalError(ctx, "invalid tls requirement %s", s)
+		}
+		r.typ = typ
+		r.subject = values.Get("subject")
+		r.issuer = values.Get("issuer")
+	default:
+		return r, moerr.NewInternalError(ctx, "invalid tls requirement %s", s)
+	}
+	return r, nil
+}
+
+// String returns the value saved in the column tls_require.
+func (r tlsRequirement) String() string {
+	if r.typ != tlsRequireX509 {
+		return r.typ
+	}
+	values := url.Values{}
+	if len(r.subject) != 0 {
+		values.Set("subject", r.subject)
+	}
+	if len(r.issuer) != 0 {
+		values.Set("issuer", r.issuer)
+	}
+	if len(values) == 0 {
+		return r.typ
+	}
+	return r.typ + "?" + values.Encode()
+}
+
+// clientTLSInfo is the TLS information of the client connection.
+type clientTLSInfo struct {
+	// tls is true if the client connects with TLS.
+	tls bool
+	// hasCert is true if the client presents a verified certificate.
+	hasCert bool
+	// subject and issuer are the distinguished names of the certificate.
+	subject string
+	issuer  string
+}
+
+// newClientTLSInfo makes the clientTLSInfo from the TLS connection state.
+func newClientTLSInfo(state tls.ConnectionState) clientTLSInfo {
+	info := clientTLSInfo{tls: true}
+	if len(state.PeerCertificates) > 0 {
+		info.hasCert = true
+		info.subject = X509NameString(state.PeerCertificates[0].Subject)
+		info.issuer = X509NameString(state.PeerCertificates[0].Issuer)
+	}
+	return info
+}
+
+// clientTLSInfoFromAttrs makes the clientTLSInfo from the connection
+// attributes, which are set by the proxy.
+func clientTLSInfoFromAttrs(attrs map[string]string) clientTLSInfo {
+	info := clientTLSInfo{tls: attrs[ProxyAttrTLS] == "1"}
+	subject, ok := attrs[ProxyAttrX509Subject]
+	if info.tls && ok {
+		info.hasCert = true
+		info.subject = subject
+		info.issuer = attrs[ProxyAttrX509Issuer]
+	}
+	return info
+}
+
+// check returns an error if the client does not meet the requirement.
+func (r tlsRequirement) check(ctx context.Context, info clientTLSInfo) error {
+	switch r.typ {
+	case tlsRequireNone:
+		return nil
+	case tlsRequireSSL:
+		if !info.tls {
+			return moerr.NewInternalError(ctx, "the user requires SSL connection")
+		}
+		return nil
+	}
+	if !info.tls || !info.hasCert {
+		return moerr.NewInternalError(ctx, "the user requires X509 certificate")
+	}
+	if len(r.subject) != 0 && r.subject != info.subject {
+		return moerr.NewInternalError(ctx, "the subject of X509 certificate does not match")
+	}
+	if len(r.issuer) != 0 && r.issuer != info.issuer {
+		return moerr.NewInternalError(ctx, "the issuer of X509 certificate does not match")
+	}
+	return nil
+}
+
+// shortNamesOfAttributes are the short names of the attributes in the
+// distinguished name, which are same as the ones of OpenSSL.
+var shortNamesOfAttributes = map[string]string{
+	"2.5.4.3":                    "CN",
+	"2.5.4.5":                    "serialNumber",
+	"2.5.4.6":                    "C",
+	"2.5.4.7":                    "L",
+	"2.5.4.8":                    "ST",
+	"2.5.4.9":                    "street",
+	"2.5.4.10":                   "O",
+	"2.5.4.11":                   "OU",
+	"2.5.4.17":                   "postalCode",
+	"0.9.2342.19200300.100.1.25": "DC",
+	"1.2.840.113549.1.9.1":       "emailAddress",
+}
+
+// X509NameString returns the distinguished name in the form of
+// "/C=CN/O=MatrixOrigin/CN=user", which is used in REQUIRE SUBJECT and
+// REQUIRE ISSUER, like MySQL.
+func X509NameString(name pkix.Name) string {
+	var sb strings.Builder
+	for _, attr := range name.Names {
+		oid := attr.Type.String()
+		if short, ok := shortNamesOfAttributes[oid]; ok {
+			oid = short
+		}
+		sb.WriteByte('/')
+		sb.WriteString(oid)
+		sb.WriteByte('=')
+		if s, ok := attr.Value.(string); ok {
+			sb.WriteString(s)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,182 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSRequirement(t *testing.T) {
+	ctx := context.TODO()
+	kases := []struct {
+		opts []tree.TlsOption
+		want string
+	}{
+		{nil, ""},
+		{[]tree.TlsOption{&tree.TlsOptionNone{}}, ""},
+		{[]tree.TlsOption{&tree.TlsOptionSSL{}}, "SSL"},
+		{[]tree.TlsOption{&tree.TlsOptionX509{}}, "X509"},
+		{[]tree.TlsOption{&tree.TlsOptionSubject{Subject: "/C=CN/CN=u1"}}, "X509?subject=%2FC%3DCN%2FCN%3Du1"},
+		{[]tree.TlsOption{
+			&tree.TlsOptionSubject{Subject: "/CN=u1&'\""},
+			&tree.TlsOptionIssuer{Issuer: "/CN=ca"},
+		}, "X509?issuer=%2FCN%3Dca&subject=%2FCN%3Du1%26%27%22"},
+	}
+	for _, kase := range kases {
+		r, err := newTLSRequirement(ctx, kase.opts)
+		require.NoError(t, err)
+		assert.Equal(t, kase.want, r.String())
+		r2, err := parseTLSRequirement(ctx, r.String())
+		require.NoError(t, err)
+		assert.Equal(t, r, r2)
+	}
+
+	_, err := newTLSRequirement(ctx, []tree.TlsOption{&tree.TlsOptionCipher{Cipher: "AES"}})
+	assert.Error(t, err)
+	_, err = parseTLSRequirement(ctx, "X500")
+	assert.Error(t, err)
+}
+
+func TestTLSRequirement_Check(t *testing.T) {
+	ctx := context.TODO()
+	none := clientTLSInfo{}
+	ssl := clientTLSInfo{tls: true}
+	cert := clientTLSInfo{tls: true, hasCert: true, subject: "/CN=u1", issuer: "/CN=ca"}
+
+	r := tlsRequirement{}
+	assert.NoError(t, r.check(ctx, none))
+
+	r = tlsRequirement{typ: tlsRequireSSL}
+	assert.Error(t, r.check(ctx, none))
+	assert.NoError(t, r.check(ctx, ssl))
+
+	r = tlsRequirement{typ: tlsRequireX509}
+	assert.Error(t, r.check(ctx, ssl))
+	assert.NoError(t, r.check(ctx, cert))
+
+	r = tlsRequirement{typ: tlsRequireX509, subject: "/CN=u1", issuer: "/CN=ca"}
+	assert.NoError(t, r.check(ctx, cert))
+	r.subject = "/CN=u2"
+	assert.Error(t, r.check(ctx, cert))
+	r = tlsRequirement{typ: tlsRequireX509, issuer: "/CN=ca2"}
+	assert.Error(t, r.check(ctx, cert))
+}
+
+func TestClientTLSInfo(t *testing.T) {
+	name := pkix.Name{
+		Country:      []string{"CN"},
+		Organization: []string{"MO"},
+		CommonName:   "u1",
+	}
+	// the names are filled when the certificate is parsed.
+	name.Names = []pkix.AttributeTypeAndValue{
+		{Type: []int{2, 5, 4, 6}, Value: "CN"},
+		{Type: []int{2, 5, 4, 10}, Value: "MO"},
+		{Type: []int{2, 5, 4, 3}, Value: "u1"},
+	}
+	assert.Equal(t, "/C=CN/O=MO/CN=u1", X509NameString(name))
+
+	info := newClientTLSInfo(tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{{Subject: name, Issuer: pkix.Name{}}},
+	})
+	assert.Equal(t, clientTLSInfo{tls: true, hasCert: true, subject: "/C=CN/O=MO/CN=u1"}, info)
+
+	attrs := map[string]string{
+		ProxyAttrTLS:         "1",
+		ProxyAttrX509Subject: "/CN=u1",
+		ProxyAttrX509Issuer:  "/CN=ca",
+	}
+	want := clientTLSInfo{tls: true, hasCert: true, subject: "/CN=u1", issuer: "/CN=ca"}
+	assert.Equal(t, want, clientTLSInfoFromAttrs(attrs))
+	assert.Equal(t, clientTLSInfo{tls: true}, clientTLSInfoFromAttrs(map[string]string{ProxyAttrTLS: "1"}))
+	assert.Equal(t, clientTLSInfo{}, clientTLSInfoFromAttrs(nil))
+
+	// the attributes are trusted only if the connection is from the proxy.
+	sv := &config.FrontendParameters{ProxyEnabled: true}
+	mp := &MysqlProtocolImpl{SV: sv, proxyTLSInfo: want}
+	assert.Equal(t, clientTLSInfo{}, mp.clientTLSInfo())
+	mp.fromProxy = true
+	assert.Equal(t, want, mp.clientTLSInfo())
+}
+
+func TestCheckProxyTag(t *testing.T) {
+	salt := []byte("01234567890123456789")
+	sv := &config.FrontendParameters{ProxySecret: "secret"}
+	check := func(tag []byte) bool {
+		server, client := net.Pipe()
+		defer func() {
+			_ = server.Close()
+			_ = client.Close()
+		}()
+		go func() {
+			_, _ = client.Write(tag)
+		}()
+		rs := goetty.NewIOSession(goetty.WithSessionConn(0, server))
+		mp := &MysqlProtocolImpl{SV: sv}
+		return mp.checkProxyTag(rs, salt)
+	}
+	assert.True(t, check(ProxyTag("secret", salt)))
+	assert.False(t, check(ProxyTag("other", salt)))
+	assert.Equal(t, ProxyTagLength, len(ProxyTag("", salt)))
+}
+
+func TestHasTLSRequireColumn(t *testing.T) {
+	var executed int
+	exec := func(rows [][]interface{}) func(string) ([]ExecResult, error) {
+		return func(sql string) ([]ExecResult, error) {
+			executed++
+			require.Equal(t, checkTLSRequireOfMoUserFormat, sql)
+			mrs := &MysqlResultSet{}
+			col := &MysqlColumn{}
+			col.SetName("attname")
+			col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+			mrs.AddColumn(col)
+			for _, row := range rows {
+				mrs.AddRow(row)
+			}
+			return []ExecResult{mrs}, nil
+		}
+	}
+
+	// the column is absent, it is checked every time
+	has, err := hasTLSRequireColumn(1000, exec(nil))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = hasTLSRequireColumn(1000, exec(nil))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, 2, executed)
+
+	// the column is present, it is cached
+	has, err = hasTLSRequireColumn(1001, exec([][]interface{}{{"tls_require"}}))
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = hasTLSRequireColumn(1001, exec(nil))
+	require.NoError(t, err)
+	require.True(t, has)
+	require.Equal(t, 3, executed)
+}
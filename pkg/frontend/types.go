@@ -18,6 +18,7 @@ import (
 	"context"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -87,6 +88,42 @@ type PrepareStmt struct {
 	PreparePlan *plan.Plan
 	PrepareStmt tree.Statement
 	ParamTypes  []byte
+	// cursor is the open cursor of the last execution
+	cursor *stmtCursor
+	// longData holds the parameters sent by COM_STMT_SEND_LONG_DATA, they are
+	// bound and released by the next execution
+	longData map[uint16][]byte
+	// longDataErr is the error of COM_STMT_SEND_LONG_DATA. The command has no
+	// response, so the error is returned by the next execution.
+	longDataErr error
+	// deps are the tables used by the plan, the statement is prepared again
+	// if they are changed.
+	deps *planDeps
+}
+
+// appendLongData appends the chunk of the parameter, the total size of the
+// parameter is limited by maxSize.
+func (prepareStmt *PrepareStmt) appendLongData(ctx context.Context, paramID uint16, data []byte, maxSize int64) {
+	if prepareStmt.longDataErr != nil {
+		return
+	}
+	if prepareStmt.longData == nil {
+		prepareStmt.longData = make(map[uint16][]byte)
+	}
+	val := prepareStmt.longData[paramID]
+	if int64(len(val)+len(data)) > maxSize {
+		prepareStmt.longDataErr = moerr.NewInvalidInput(ctx,
+			"parameter %d of prepared statement which is set through COM_STMT_SEND_LONG_DATA is longer than 'max_allowed_packet' bytes", paramID)
+		prepareStmt.longData = nil
+		return
+	}
+	prepareStmt.longData[paramID] = append(val, data...)
+}
+
+// resetLongData releases the parameters sent by COM_STMT_SEND_LONG_DATA.
+func (prepareStmt *PrepareStmt) resetLongData() {
+	prepareStmt.longData = nil
+	prepareStmt.longDataErr = nil
 }
 
 /*
@@ -20,11 +20,13 @@ import (
 )
 
 // parseCNStores returns all working and expired stores' ids.
+// the read-only stores do not run tasks, they are taken as expired,
+// so the tasks allocated to them are allocated again.
 func parseCNStores(cfg hakeeper.Config, infos pb.CNState, currentTick uint64) ([]string, []string) {
 	working := make([]string, 0)
 	expired := make([]string, 0)
 	for uuid, storeInfo := range infos.Stores {
-		if cfg.CNStoreExpired(storeInfo.Tick, currentTick) {
+		if cfg.CNStoreExpired(storeInfo.Tick, currentTick) || storeInfo.ReadOnly {
 			expired = append(expired, uuid)
 		} else {
 			working = append(working, uuid)
@@ -55,6 +55,14 @@ func TestParseCNStores(t *testing.T) {
 			expectedWorking: []string{"b"},
 			expectedExpired: []string{"a"},
 		},
+		{
+			infos: pb.CNState{Stores: map[string]pb.CNStoreInfo{
+				"a": {Tick: expiredTick, ReadOnly: true}}},
+			currentTick: expiredTick + 1,
+
+			expectedWorking: []string{},
+			expectedExpired: []string{"a"},
+		},
 	}
 
 	for _, c := range cases {
@@ -86,12 +86,25 @@ type Client interface {
 	GetTSOTimestamp(ctx context.Context, count uint64) (uint64, error)
 }
 
+// ConsumerClient is implemented by clients that can record the progress of
+// log consumers, e.g. change data capture. The Log Service keeps the logs
+// that are still to be read by any consumer when truncating.
+type ConsumerClient interface {
+	// SetConsumerLsn records lsn as the next Lsn to be read by the specified
+	// consumer. Setting lsn to 0 removes the consumer.
+	SetConsumerLsn(ctx context.Context, consumer string, lsn Lsn) error
+	// GetConsumerLsn returns the next Lsn to be read by the specified consumer,
+	// or 0 if the consumer is unknown.
+	GetConsumerLsn(ctx context.Context, consumer string) (Lsn, error)
+}
+
 type managedClient struct {
 	cfg    ClientConfig
 	client *client
 }
 
 var _ Client = (*managedClient)(nil)
+var _ ConsumerClient = (*managedClient)(nil)
 
 // NewClient creates a Log Service client. Each returned client can be used
 // to synchronously issue requests to the Log Service. To send multiple requests
@@ -207,6 +220,38 @@ func (c *managedClient) GetTSOTimestamp(ctx context.Context, count uint64) (uint
 	}
 }
 
+func (c *managedClient) SetConsumerLsn(ctx context.Context, consumer string, lsn Lsn) error {
+	for {
+		if err := c.prepareClient(ctx); err != nil {
+			return err
+		}
+		err := c.client.setConsumerLsn(ctx, consumer, lsn)
+		if err != nil {
+			c.resetClient()
+		}
+		if c.isRetryableError(err) {
+			continue
+		}
+		return err
+	}
+}
+
+func (c *managedClient) GetConsumerLsn(ctx context.Context, consumer string) (Lsn, error) {
+	for {
+		if err := c.prepareClient(ctx); err != nil {
+			return 0, err
+		}
+		v, err := c.client.getConsumerLsn(ctx, consumer)
+		if err != nil {
+			c.resetClient()
+		}
+		if c.isRetryableError(err) {
+			continue
+		}
+		return v, err
+	}
+}
+
 func (c *managedClient) isRetryableError(err error) bool {
 	/*
 		old code, obviously strange
@@ -374,6 +419,19 @@ func (c *client) getTruncatedLsn(ctx context.Context) (Lsn, error) {
 	return c.doGetTruncatedLsn(ctx)
 }
 
+func (c *client) setConsumerLsn(ctx context.Context, consumer string, lsn Lsn) error {
+	_, _, err := c.consumerRequest(ctx, pb.SET_CONSUMER_LSN, consumer, lsn)
+	return err
+}
+
+func (c *client) getConsumerLsn(ctx context.Context, consumer string) (Lsn, error) {
+	resp, _, err := c.consumerRequest(ctx, pb.GET_CONSUMER_LSN, consumer, 0)
+	if err != nil {
+		return 0, err
+	}
+	return resp.LogResponse.Lsn, nil
+}
+
 func (c *client) getTSOTimestamp(ctx context.Context, count uint64) (uint64, error) {
 	return c.tsoRequest(ctx, count)
 }
@@ -407,6 +465,25 @@ func (c *client) request(ctx context.Context,
 			MaxSize: maxSize,
 		},
 	}
+	return c.send(ctx, req, payload)
+}
+
+func (c *client) consumerRequest(ctx context.Context,
+	mt pb.MethodType, consumer string, lsn Lsn) (pb.Response, []pb.LogRecord, error) {
+	req := pb.Request{
+		Method: mt,
+		LogRequest: pb.LogRequest{
+			ShardID:  c.cfg.LogShardID,
+			DNID:     c.cfg.DNReplicaID,
+			Lsn:      lsn,
+			Consumer: consumer,
+		},
+	}
+	return c.send(ctx, req, nil)
+}
+
+func (c *client) send(ctx context.Context,
+	req pb.Request, payload []byte) (pb.Response, []pb.LogRecord, error) {
 	r := c.pool.Get().(*RPCRequest)
 	defer r.Release()
 	r.Request = req
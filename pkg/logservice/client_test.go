@@ -301,6 +301,34 @@ func TestClientTruncate(t *testing.T) {
 	runClientTest(t, false, nil, fn)
 }
 
+func TestClientConsumerLsn(t *testing.T) {
+	fn := func(t *testing.T, s *Service, cfg ClientConfig, c Client) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		cc, ok := c.(ConsumerClient)
+		require.True(t, ok)
+		lsn, err := cc.GetConsumerLsn(ctx, "cdc")
+		require.NoError(t, err)
+		assert.Equal(t, Lsn(0), lsn)
+
+		require.NoError(t, cc.SetConsumerLsn(ctx, "cdc", 3))
+		lsn, err = cc.GetConsumerLsn(ctx, "cdc")
+		require.NoError(t, err)
+		assert.Equal(t, Lsn(3), lsn)
+
+		rec := c.GetLogRecord(16)
+		rand.Read(rec.Payload())
+		lsn, err = c.Append(ctx, rec)
+		require.NoError(t, err)
+		require.NoError(t, c.Truncate(ctx, lsn))
+		// logs from lsn 3 are kept for the consumer
+		truncatable, err := s.store.getTruncatableLsn(ctx, cfg.LogShardID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(2), truncatable)
+	}
+	runClientTest(t, false, nil, fn)
+}
+
 func TestReadOnlyClientRejectWriteRequests(t *testing.T) {
 	fn := func(t *testing.T, s *Service, cfg ClientConfig, c Client) {
 		rec := c.GetLogRecord(16)
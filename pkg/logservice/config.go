@@ -40,7 +40,12 @@ const (
 	defaultHeartbeatInterval   = time.Second
 	defaultLogDBBufferSize     = 768 * 1024
 	defaultTruncateInterval    = 10 * time.Second
+	defaultConsumerLeaseTTL    = 24 * time.Hour
 	defaultMaxExportedSnapshot = 20
+	defaultSnapshotChunkSize   = 4 * 1024 * 1024
+	defaultMaxSharedSnapshot   = 2
+	defaultRestoreTimeout      = 30 * time.Second
+	defaultUploadTimeout       = 10 * time.Minute
 	defaultMaxMessageSize      = 1024 * 1024 * 100
 )
 
@@ -67,6 +72,27 @@ type Config struct {
 	// already MaxExportedSnapshot exported snapshots, no exported snapshot will
 	// be generated.
 	MaxExportedSnapshot int `toml:"max-exported-snapshot"`
+	// SharedSnapshot is the config of the snapshots shared by the log stores
+	// through the shared file service. The exported snapshots are uploaded by
+	// the leader replicas, a log store rebuilt with an empty data dir restores
+	// its replicas from them instead of receiving the snapshots from the other
+	// replicas.
+	SharedSnapshot struct {
+		// Enable enables the shared snapshots.
+		Enable bool `toml:"enable"`
+		// ChunkSize is the max size of the objects the snapshot files are split
+		// into. The default value is 4MiB.
+		ChunkSize toml.ByteSize `toml:"chunk-size"`
+		// MaxSnapshots is the max count of the shared snapshots kept for each
+		// shard. The default value is 2.
+		MaxSnapshots int `toml:"max-snapshots"`
+		// RestoreTimeout is the timeout of restoring the replicas from the shared
+		// snapshots when the log store starts. The default value is 30s.
+		RestoreTimeout toml.Duration `toml:"restore-timeout"`
+		// UploadTimeout is the timeout of uploading a snapshot to the shared file
+		// service, which runs in background. The default value is 10m.
+		UploadTimeout toml.Duration `toml:"upload-timeout"`
+	} `toml:"shared-snapshot"`
 	// ServiceAddress is log service's service address that can be reached by
 	// other nodes such as DN nodes.
 	ServiceAddress string `toml:"logservice-address"`
@@ -109,6 +135,10 @@ type Config struct {
 	// TruncateInterval is the interval of how often log service should
 	// process truncate.
 	TruncateInterval toml.Duration `toml:"truncate-interval"`
+	// ConsumerLeaseTTL is how long the logs are kept for a log consumer, e.g.
+	// change data capture, that stops renewing its lease by setting its lsn.
+	// An abandoned consumer no longer keeps logs from being truncated.
+	ConsumerLeaseTTL toml.Duration `toml:"consumer-lease-ttl"`
 
 	RPC struct {
 		// MaxMessageSize is the max size for RPC message. The default value is 10MiB.
@@ -338,6 +368,18 @@ func (c *Config) Fill() {
 	if c.MaxExportedSnapshot == 0 {
 		c.MaxExportedSnapshot = defaultMaxExportedSnapshot
 	}
+	if c.SharedSnapshot.ChunkSize == 0 {
+		c.SharedSnapshot.ChunkSize = toml.ByteSize(defaultSnapshotChunkSize)
+	}
+	if c.SharedSnapshot.MaxSnapshots == 0 {
+		c.SharedSnapshot.MaxSnapshots = defaultMaxSharedSnapshot
+	}
+	if c.SharedSnapshot.RestoreTimeout.Duration == 0 {
+		c.SharedSnapshot.RestoreTimeout.Duration = defaultRestoreTimeout
+	}
+	if c.SharedSnapshot.UploadTimeout.Duration == 0 {
+		c.SharedSnapshot.UploadTimeout.Duration = defaultUploadTimeout
+	}
 	if len(c.ServiceAddress) == 0 {
 		c.ServiceAddress = defaultServiceAddress
 		c.ServiceListenAddress = defaultServiceAddress
@@ -391,6 +433,9 @@ func (c *Config) Fill() {
 	if c.TruncateInterval.Duration == 0 {
 		c.TruncateInterval.Duration = defaultTruncateInterval
 	}
+	if c.ConsumerLeaseTTL.Duration == 0 {
+		c.ConsumerLeaseTTL.Duration = defaultConsumerLeaseTTL
+	}
 	if c.RPC.MaxMessageSize == 0 {
 		c.RPC.MaxMessageSize = toml.ByteSize(defaultMaxMessageSize)
 	}
@@ -37,6 +37,11 @@ type leaseHolderIDQuery struct{}
 type indexQuery struct{}
 type truncatedLsnQuery struct{}
 type leaseHistoryQuery struct{ lsn uint64 }
+type consumerLsnQuery struct{ consumer string }
+
+// truncatableLsnQuery ignores the consumers whose leases were last renewed
+// before expiredBefore, 0 means no consumer is ignored.
+type truncatableLsnQuery struct{ expiredBefore int64 }
 
 func getAppendCmd(cmd []byte, replicaID uint64) []byte {
 	if len(cmd) < headerSize+8 {
@@ -55,6 +60,12 @@ func parseTruncatedLsn(cmd []byte) uint64 {
 	return binaryEnc.Uint64(cmd[headerSize:])
 }
 
+func parseConsumerLsnCmd(cmd []byte) (string, uint64, int64) {
+	return string(cmd[headerSize+16:]),
+		binaryEnc.Uint64(cmd[headerSize:]),
+		int64(binaryEnc.Uint64(cmd[headerSize+8:]))
+}
+
 func parseLeaseHolderID(cmd []byte) uint64 {
 	return binaryEnc.Uint64(cmd[headerSize:])
 }
@@ -77,6 +88,17 @@ func getSetTruncatedLsnCmd(lsn uint64) []byte {
 	return cmd
 }
 
+// getSetConsumerLsnCmd returns the cmd to set the lsn of the consumer, now is
+// the unix time in nanoseconds that the lease of the consumer is renewed at.
+func getSetConsumerLsnCmd(consumer string, lsn uint64, now int64) []byte {
+	cmd := make([]byte, headerSize+16+len(consumer))
+	binaryEnc.PutUint32(cmd, uint32(pb.ConsumerLSNUpdate))
+	binaryEnc.PutUint64(cmd[headerSize:], lsn)
+	binaryEnc.PutUint64(cmd[headerSize+8:], uint64(now))
+	copy(cmd[headerSize+16:], consumer)
+	return cmd
+}
+
 func getTsoUpdateCmd(count uint64) []byte {
 	cmd := make([]byte, headerSize+8)
 	binaryEnc.PutUint32(cmd, uint32(pb.TSOUpdate))
@@ -94,8 +116,10 @@ var _ sm.IStateMachine = (*stateMachine)(nil)
 
 func newStateMachine(shardID uint64, replicaID uint64) sm.IStateMachine {
 	state := pb.RSMState{
-		Tso:          1,
-		LeaseHistory: make(map[uint64]uint64),
+		Tso:           1,
+		LeaseHistory:  make(map[uint64]uint64),
+		ConsumerLsn:   make(map[string]uint64),
+		ConsumerLease: make(map[string]int64),
 	}
 	return &stateMachine{
 		shardID:   shardID,
@@ -138,12 +162,49 @@ func (s *stateMachine) handleTruncateLsn(cmd []byte) sm.Result {
 	lsn := parseTruncatedLsn(cmd)
 	if lsn > s.state.TruncatedLsn {
 		s.state.TruncatedLsn = lsn
-		s.truncateLeaseHistory(lsn)
+		s.truncateLeaseHistory(s.getTruncatableLsn(0))
 		return sm.Result{}
 	}
 	return sm.Result{Value: s.state.TruncatedLsn}
 }
 
+// handleConsumerLsn records the next lsn to be read by the consumer and
+// renews its lease, lsn 0 removes the consumer.
+func (s *stateMachine) handleConsumerLsn(cmd []byte) sm.Result {
+	consumer, lsn, now := parseConsumerLsnCmd(cmd)
+	if lsn == 0 {
+		delete(s.state.ConsumerLsn, consumer)
+		delete(s.state.ConsumerLease, consumer)
+		return sm.Result{}
+	}
+	if s.state.ConsumerLsn == nil {
+		s.state.ConsumerLsn = make(map[string]uint64)
+	}
+	if s.state.ConsumerLease == nil {
+		s.state.ConsumerLease = make(map[string]int64)
+	}
+	s.state.ConsumerLsn[consumer] = lsn
+	s.state.ConsumerLease[consumer] = now
+	return sm.Result{}
+}
+
+// getTruncatableLsn returns the largest lsn that can be removed from the log,
+// logs that are still to be read by consumers are kept. Consumers whose
+// leases were last renewed before expiredBefore are abandoned and no longer
+// keep logs, 0 means no consumer is abandoned.
+func (s *stateMachine) getTruncatableLsn(expiredBefore int64) uint64 {
+	lsn := s.state.TruncatedLsn
+	for consumer, next := range s.state.ConsumerLsn {
+		if lease, ok := s.state.ConsumerLease[consumer]; ok && lease < expiredBefore {
+			continue
+		}
+		if next-1 < lsn {
+			lsn = next - 1
+		}
+	}
+	return lsn
+}
+
 // handleUserUpdate returns an empty sm.Result on success or it returns a
 // sm.Result value with the Value field set to the current leaseholder ID
 // to indicate rejection by mismatched leaseholder ID.
@@ -180,6 +241,8 @@ func (s *stateMachine) Update(e sm.Entry) (sm.Result, error) {
 		return s.handleUserUpdate(cmd), nil
 	case pb.TSOUpdate:
 		return s.handleTsoUpdate(cmd), nil
+	case pb.ConsumerLSNUpdate:
+		return s.handleConsumerLsn(cmd), nil
 	default:
 		panic("unknown entry type")
 	}
@@ -195,6 +258,10 @@ func (s *stateMachine) Lookup(query interface{}) (interface{}, error) {
 	} else if v, ok := query.(leaseHistoryQuery); ok {
 		lease, _ := s.getLeaseHistory(v.lsn)
 		return lease, nil
+	} else if v, ok := query.(consumerLsnQuery); ok {
+		return s.state.ConsumerLsn[v.consumer], nil
+	} else if v, ok := query.(truncatableLsnQuery); ok {
+		return s.getTruncatableLsn(v.expiredBefore), nil
 	}
 	panic("unknown lookup command type")
 }
@@ -163,6 +163,44 @@ func TestTruncatedIndexCanBeUpdated(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestConsumerLsnCanBeUpdated(t *testing.T) {
+	tsm := newStateMachine(1, 2).(*stateMachine)
+	_, err := tsm.Update(sm.Entry{Cmd: getSetTruncatedLsnCmd(200)})
+	assert.NoError(t, err)
+	v, err := tsm.Lookup(truncatableLsnQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(200), v)
+
+	_, err = tsm.Update(sm.Entry{Cmd: getSetConsumerLsnCmd("cdc1", 101, 10)})
+	assert.NoError(t, err)
+	_, err = tsm.Update(sm.Entry{Cmd: getSetConsumerLsnCmd("cdc2", 151, 20)})
+	assert.NoError(t, err)
+	v, err = tsm.Lookup(consumerLsnQuery{consumer: "cdc2"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(151), v)
+	v, err = tsm.Lookup(truncatableLsnQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), v)
+	// the lease of cdc1 expired
+	v, err = tsm.Lookup(truncatableLsnQuery{expiredBefore: 15})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(150), v)
+	// both leases expired
+	v, err = tsm.Lookup(truncatableLsnQuery{expiredBefore: 25})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(200), v)
+
+	_, err = tsm.Update(sm.Entry{Cmd: getSetConsumerLsnCmd("cdc1", 0, 30)})
+	assert.NoError(t, err)
+	v, err = tsm.Lookup(consumerLsnQuery{consumer: "cdc1"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), v)
+	assert.NotContains(t, tsm.state.ConsumerLease, "cdc1")
+	v, err = tsm.Lookup(truncatableLsnQuery{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(150), v)
+}
+
 func TestStateMachineUserUpdate(t *testing.T) {
 	cmd := make([]byte, headerSize+8+1)
 	binaryEnc.PutUint32(cmd, uint32(pb.UserEntryUpdate))
@@ -31,8 +31,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 )
@@ -103,7 +105,17 @@ func NewService(
 	if service.runtime == nil {
 		service.runtime = runtime.DefaultRuntime()
 	}
-	store, err := newLogStore(cfg, service.getTaskService, service.runtime)
+	var storeOpts []storeOption
+	var restored []metadata.LogShard
+	if cfg.SharedSnapshot.Enable {
+		sharedFS, err := fileservice.Get[fileservice.FileService](fileService, defines.SharedFileServiceName)
+		if err != nil {
+			return nil, err
+		}
+		storeOpts = append(storeOpts, withSharedFS(sharedFS))
+		restored = restoreReplicas(context.Background(), cfg, sharedFS, service.runtime.Logger().RawLogger())
+	}
+	store, err := newLogStore(cfg, service.getTaskService, service.runtime, storeOpts...)
 	if err != nil {
 		service.runtime.Logger().Error("failed to create log store", zap.Error(err))
 		return nil, err
@@ -111,6 +123,9 @@ func NewService(
 	if err := store.loadMetadata(); err != nil {
 		return nil, err
 	}
+	for _, rec := range restored {
+		store.addMetadata(rec.ShardID, rec.ReplicaID)
+	}
 	if err := store.startReplicas(); err != nil {
 		return nil, err
 	}
@@ -247,6 +262,10 @@ func (s *Service) handle(ctx context.Context, req pb.Request,
 		return s.handleTruncate(ctx, req), pb.LogRecordResponse{}
 	case pb.GET_TRUNCATE:
 		return s.handleGetTruncatedIndex(ctx, req), pb.LogRecordResponse{}
+	case pb.SET_CONSUMER_LSN:
+		return s.handleSetConsumerLsn(ctx, req), pb.LogRecordResponse{}
+	case pb.GET_CONSUMER_LSN:
+		return s.handleGetConsumerLsn(ctx, req), pb.LogRecordResponse{}
 	case pb.CONNECT:
 		return s.handleConnect(ctx, req), pb.LogRecordResponse{}
 	case pb.CONNECT_RO:
@@ -383,6 +402,27 @@ func (s *Service) handleGetTruncatedIndex(ctx context.Context, req pb.Request) p
 	return resp
 }
 
+func (s *Service) handleSetConsumerLsn(ctx context.Context, req pb.Request) pb.Response {
+	r := req.LogRequest
+	resp := getResponse(req)
+	if err := s.store.setConsumerLsn(ctx, r.ShardID, r.Consumer, r.Lsn); err != nil {
+		resp.ErrorCode, resp.ErrorMessage = toErrorCode(err)
+	}
+	return resp
+}
+
+func (s *Service) handleGetConsumerLsn(ctx context.Context, req pb.Request) pb.Response {
+	r := req.LogRequest
+	resp := getResponse(req)
+	lsn, err := s.store.getConsumerLsn(ctx, r.ShardID, r.Consumer)
+	if err != nil {
+		resp.ErrorCode, resp.ErrorMessage = toErrorCode(err)
+	} else {
+		resp.LogResponse.Lsn = lsn
+	}
+	return resp
+}
+
 // TODO: add tests to see what happens when request is sent to non hakeeper stores
 func (s *Service) handleLogHeartbeat(ctx context.Context, req pb.Request) pb.Response {
 	hb := req.LogHeartbeat
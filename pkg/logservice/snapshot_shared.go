@@ -0,0 +1,434 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"sort"
+
+	"github.com/lni/dragonboat/v4/tools"
+	"github.com/lni/vfs"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"go.uber.org/zap"
+)
+
+const (
+	// sharedSnapshotRoot is the dir of the shared snapshots in the shared
+	// file service.
+	sharedSnapshotRoot = "logservice-snapshot"
+	// sharedSnapshotManifest is the name of the manifest of a shared snapshot,
+	// it is written after all the chunks, a shared snapshot without manifest
+	// is incomplete.
+	sharedSnapshotManifest = "manifest"
+	// restoreDirName is the local dir the shared snapshots are downloaded to.
+	restoreDirName = "restore"
+)
+
+// sharedSnapshotChunk is a chunk of a snapshot file.
+type sharedSnapshotChunk struct {
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum"`
+}
+
+// sharedSnapshotFile is a file of the snapshot dir exported by dragonboat,
+// each chunk of the file is saved as an object named <file>.<chunk>.
+type sharedSnapshotFile struct {
+	Name   string                `json:"name"`
+	Size   int64                 `json:"size"`
+	Chunks []sharedSnapshotChunk `json:"chunks"`
+}
+
+// sharedManifest describes a snapshot uploaded to the shared file service.
+type sharedManifest struct {
+	ShardID   uint64               `json:"shard-id"`
+	ReplicaID uint64               `json:"replica-id"`
+	Index     uint64               `json:"index"`
+	Files     []sharedSnapshotFile `json:"files"`
+}
+
+func sharedShardDir(shardID uint64) string {
+	return fmt.Sprintf("%s/shard-%d", sharedSnapshotRoot, shardID)
+}
+
+func sharedSnapshotDir(shardID uint64, index uint64) string {
+	return fmt.Sprintf("%s/"+snapshotPathPattern, sharedShardDir(shardID), index)
+}
+
+func chunkPath(dir string, name string, chunk int) string {
+	return fmt.Sprintf("%s/%s.%d", dir, name, chunk)
+}
+
+// sharedSnapshotIndexes returns the indexes of the shared snapshots of the
+// shard, the latest one first.
+func sharedSnapshotIndexes(ctx context.Context,
+	fs fileservice.FileService, shardID uint64) ([]uint64, error) {
+	entries, err := fs.List(ctx, sharedShardDir(shardID))
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir {
+			continue
+		}
+		var index uint64
+		if _, err := fmt.Sscanf(entry.Name, snapshotPathPattern, &index); err != nil {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] > indexes[j] })
+	return indexes, nil
+}
+
+// removeSharedDir removes all the objects in the dir.
+func removeSharedDir(ctx context.Context, fs fileservice.FileService, dir string) error {
+	entries, err := fs.List(ctx, dir)
+	if err != nil {
+		return err
+	}
+	paths := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir {
+			paths = append(paths, dir+"/"+entry.Name)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return fs.Delete(ctx, paths...)
+}
+
+// uploadSnapshot uploads the snapshot exported to the local dir to the shared
+// file service. The files are split into chunks of chunkSize bytes, and the
+// manifest with the checksums of the chunks is written at last.
+func uploadSnapshot(ctx context.Context, fs fileservice.FileService, localFS vfs.FS,
+	localDir string, shardID uint64, replicaID uint64, index uint64, chunkSize int64) error {
+	dir := sharedSnapshotDir(shardID, index)
+	manifestPath := dir + "/" + sharedSnapshotManifest
+	if _, err := fs.StatFile(ctx, manifestPath); err == nil {
+		// uploaded by another replica
+		return nil
+	} else if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return err
+	}
+	// objects left by an interrupted upload
+	if err := removeSharedDir(ctx, fs, dir); err != nil {
+		return err
+	}
+
+	names, err := localFS.List(localDir)
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	manifest := sharedManifest{
+		ShardID:   shardID,
+		ReplicaID: replicaID,
+		Index:     index,
+	}
+	buf := make([]byte, chunkSize)
+	for _, name := range names {
+		fp := localFS.PathJoin(localDir, name)
+		info, err := localFS.Stat(fp)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			continue
+		}
+		file, err := uploadFile(ctx, fs, localFS, fp, dir, name, buf)
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, file)
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return fs.Write(ctx, fileservice.IOVector{
+		FilePath: manifestPath,
+		Entries: []fileservice.IOEntry{
+			{Size: int64(len(data)), Data: data},
+		},
+	})
+}
+
+func uploadFile(ctx context.Context, fs fileservice.FileService, localFS vfs.FS,
+	fp string, dir string, name string, buf []byte) (sharedSnapshotFile, error) {
+	file := sharedSnapshotFile{Name: name}
+	f, err := localFS.Open(fp)
+	if err != nil {
+		return file, err
+	}
+	defer f.Close()
+	for {
+		n, err := io.ReadFull(f, buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return file, err
+		}
+		if err := fs.Write(ctx, fileservice.IOVector{
+			FilePath: chunkPath(dir, name, len(file.Chunks)),
+			Entries: []fileservice.IOEntry{
+				{Size: int64(n), Data: buf[:n]},
+			},
+		}); err != nil {
+			return file, err
+		}
+		file.Size += int64(n)
+		file.Chunks = append(file.Chunks, sharedSnapshotChunk{
+			Size:     int64(n),
+			Checksum: crc32.ChecksumIEEE(buf[:n]),
+		})
+	}
+	return file, nil
+}
+
+// downloadSnapshot downloads the latest complete shared snapshot of the shard
+// to a sub dir of the local dir, the checksums of all chunks are verified. It
+// returns the dir of the downloaded snapshot and the index of the snapshot.
+func downloadSnapshot(ctx context.Context, fs fileservice.FileService,
+	localFS vfs.FS, shardID uint64, localDir string) (string, uint64, error) {
+	indexes, err := sharedSnapshotIndexes(ctx, fs, shardID)
+	if err != nil {
+		return "", 0, err
+	}
+	for _, index := range indexes {
+		dir := sharedSnapshotDir(shardID, index)
+		vec := &fileservice.IOVector{
+			FilePath: dir + "/" + sharedSnapshotManifest,
+			Entries:  []fileservice.IOEntry{{Size: -1}},
+		}
+		if err := fs.Read(ctx, vec); err != nil {
+			if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+				// incomplete
+				continue
+			}
+			return "", 0, err
+		}
+		var manifest sharedManifest
+		if err := json.Unmarshal(vec.Entries[0].Data, &manifest); err != nil {
+			return "", 0, err
+		}
+		target := localFS.PathJoin(localDir, fmt.Sprintf(snapshotPathPattern, index))
+		if err := localFS.RemoveAll(target); err != nil {
+			return "", 0, err
+		}
+		if err := localFS.MkdirAll(target, defaultExportedDirMode); err != nil {
+			return "", 0, err
+		}
+		for _, file := range manifest.Files {
+			if err := downloadFile(ctx, fs, localFS, dir, target, file); err != nil {
+				return "", 0, err
+			}
+		}
+		return target, index, nil
+	}
+	// no complete snapshot
+	return "", 0, moerr.NewFileNotFoundNoCtx(sharedShardDir(shardID))
+}
+
+func downloadFile(ctx context.Context, fs fileservice.FileService, localFS vfs.FS,
+	dir string, target string, file sharedSnapshotFile) error {
+	f, err := localFS.Create(localFS.PathJoin(target, file.Name))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	for i, chunk := range file.Chunks {
+		path := chunkPath(dir, file.Name, i)
+		vec := &fileservice.IOVector{
+			FilePath: path,
+			Entries:  []fileservice.IOEntry{{Size: -1}},
+		}
+		if err := fs.Read(ctx, vec); err != nil {
+			return err
+		}
+		data := vec.Entries[0].Data
+		if int64(len(data)) != chunk.Size || crc32.ChecksumIEEE(data) != chunk.Checksum {
+			return moerr.NewInternalErrorNoCtx("corrupted shared snapshot chunk %s", path)
+		}
+		if _, err := f.Write(data); err != nil {
+			return err
+		}
+	}
+	return f.Sync()
+}
+
+// removeSharedSnapshots removes the shared snapshots of the shard except the
+// latest count ones.
+func removeSharedSnapshots(ctx context.Context,
+	fs fileservice.FileService, shardID uint64, count int) error {
+	indexes, err := sharedSnapshotIndexes(ctx, fs, shardID)
+	if err != nil {
+		return err
+	}
+	if len(indexes) <= count {
+		return nil
+	}
+	for _, index := range indexes[count:] {
+		dir := sharedSnapshotDir(shardID, index)
+		// remove the manifest first, so the snapshot is never seen as complete
+		if err := fs.Delete(ctx, dir+"/"+sharedSnapshotManifest); err != nil &&
+			!moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return err
+		}
+		if err := removeSharedDir(ctx, fs, dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// shareSnapshot uploads the exported snapshot to the shared file service in
+// background if the replica is the leader of the shard, and removes the old
+// ones. The upload is skipped if the last one of the shard is not done.
+func (l *store) shareSnapshot(shardID uint64, replicaID uint64, index uint64) {
+	if l.sharedFS == nil {
+		return
+	}
+	leaderID, _, ok, err := l.nh.GetLeaderID(shardID)
+	if err != nil || !ok || leaderID != replicaID {
+		return
+	}
+	if _, loaded := l.sharing.LoadOrStore(shardID, struct{}{}); loaded {
+		return
+	}
+	dir := l.snapshotMgr.snapshotPath(nodeID{shardID: shardID, replicaID: replicaID},
+		snapshotIndex(index))
+	cfg := l.cfg.SharedSnapshot
+	if err := l.stopper.RunNamedTask("share-snapshot", func(ctx context.Context) {
+		defer l.sharing.Delete(shardID)
+		ctx, cancel := context.WithTimeout(ctx, cfg.UploadTimeout.Duration)
+		defer cancel()
+		if err := uploadSnapshot(ctx, l.sharedFS, l.cfg.FS, dir,
+			shardID, replicaID, index, int64(cfg.ChunkSize)); err != nil {
+			l.runtime.Logger().Error("upload snapshot failed",
+				zap.Uint64("shard ID", shardID),
+				zap.Uint64("index", index),
+				zap.Error(err))
+			return
+		}
+		if err := removeSharedSnapshots(ctx, l.sharedFS, shardID, cfg.MaxSnapshots); err != nil {
+			l.runtime.Logger().Error("remove shared snapshots failed",
+				zap.Uint64("shard ID", shardID),
+				zap.Error(err))
+		}
+	}); err != nil {
+		l.sharing.Delete(shardID)
+		l.runtime.Logger().Error("failed to start uploading snapshot",
+			zap.Uint64("shard ID", shardID),
+			zap.Error(err))
+	}
+}
+
+// isSharing returns true if a snapshot of the shard is being uploaded.
+func (l *store) isSharing(shardID uint64) bool {
+	_, ok := l.sharing.Load(shardID)
+	return ok
+}
+
+// restoreReplicas restores the replicas of a store rebuilt with an empty data
+// dir from the shared snapshots. The replicas assigned to the store are read
+// from the HAKeeper, the latest shared snapshot of each shard is imported, so
+// the replica only needs the logs after the snapshot from the other replicas.
+// It returns the replicas restored, the replicas failed to restore are added
+// back by the HAKeeper and catch up from the other replicas as usual.
+// Only the replicas assigned to the UUID of the store are restored. The
+// replicas added to the store later, e.g. a store rebuilt with a new UUID,
+// receive the snapshots from the other replicas, as dragonboat imports a
+// snapshot for a new replica only when the NodeHost is not running.
+func restoreReplicas(ctx context.Context, cfg Config,
+	fs fileservice.FileService, logger *zap.Logger) []metadata.LogShard {
+	found, err := exist(cfg.FS.PathJoin(cfg.DataDir, logMetadataFilename), cfg.FS)
+	if err != nil || found {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.SharedSnapshot.RestoreTimeout.Duration)
+	defer cancel()
+	client, err := NewLogHAKeeperClient(ctx, cfg.GetHAKeeperClientConfig())
+	if err != nil {
+		logger.Warn("failed to create hakeeper client, replicas not restored", zap.Error(err))
+		return nil
+	}
+	defer client.Close()
+	state, err := client.GetClusterState(ctx)
+	if err != nil {
+		logger.Warn("failed to get cluster state, replicas not restored", zap.Error(err))
+		return nil
+	}
+
+	localDir := cfg.FS.PathJoin(cfg.SnapshotExportDir, restoreDirName)
+	defer func() {
+		if err := cfg.FS.RemoveAll(localDir); err != nil {
+			logger.Error("failed to remove downloaded snapshots", zap.Error(err))
+		}
+	}()
+	var restored []metadata.LogShard
+	for shardID, info := range state.LogState.Shards {
+		if shardID == hakeeper.DefaultHAKeeperShardID {
+			continue
+		}
+		for replicaID, uuid := range info.Replicas {
+			if uuid != cfg.UUID {
+				continue
+			}
+			index, err := restoreReplica(ctx, cfg, fs, localDir, shardID, replicaID, info.Replicas)
+			if err != nil {
+				logger.Warn("failed to restore replica from shared snapshot",
+					zap.Uint64("shard ID", shardID),
+					zap.Uint64("replica ID", replicaID),
+					zap.Error(err))
+				continue
+			}
+			logger.Info("replica restored from shared snapshot",
+				zap.Uint64("shard ID", shardID),
+				zap.Uint64("replica ID", replicaID),
+				zap.Uint64("index", index))
+			rec := metadata.LogShard{}
+			rec.ShardID = shardID
+			rec.ReplicaID = replicaID
+			restored = append(restored, rec)
+		}
+	}
+	return restored
+}
+
+func restoreReplica(ctx context.Context, cfg Config, fs fileservice.FileService,
+	localDir string, shardID uint64, replicaID uint64, members map[uint64]string) (uint64, error) {
+	dir, index, err := downloadSnapshot(ctx, fs, cfg.FS, shardID, localDir)
+	if err != nil {
+		return 0, err
+	}
+	nhConfig := getNodeHostConfig(cfg)
+	// replicas are addressed by the UUIDs of the stores
+	nhConfig.RaftAddress = cfg.UUID
+	if err := tools.ImportSnapshot(nhConfig, dir, members, replicaID); err != nil {
+		return 0, err
+	}
+	return index, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lni/dragonboat/v4"
+	"github.com/lni/goutils/leaktest"
+	"github.com/lni/vfs"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSharedFS(t *testing.T) fileservice.FileService {
+	fs, err := fileservice.NewMemoryFS(defines.SharedFileServiceName, fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	return fs
+}
+
+func writeTestSnapshot(t *testing.T, fs vfs.FS, index uint64) (string, map[string][]byte) {
+	dir := fs.PathJoin("exported", fmt.Sprintf(snapshotPathPattern, index))
+	require.NoError(t, fs.MkdirAll(dir, defaultExportedDirMode))
+	files := map[string][]byte{
+		fmt.Sprintf(snapshotPathPattern, index) + ".gbsnap": bytes.Repeat([]byte{byte(index)}, 2500),
+		"snapshot.metadata": []byte("metadata"),
+	}
+	for name, data := range files {
+		f, err := fs.Create(fs.PathJoin(dir, name))
+		require.NoError(t, err)
+		_, err = f.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+	return dir, files
+}
+
+func TestSharedSnapshotUploadAndDownload(t *testing.T) {
+	ctx := context.Background()
+	sharedFS := newTestSharedFS(t)
+	localFS := vfs.NewMem()
+
+	_, _, err := downloadSnapshot(ctx, sharedFS, localFS, 1, "restore")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+
+	for _, index := range []uint64{10, 20, 30} {
+		dir, _ := writeTestSnapshot(t, localFS, index)
+		require.NoError(t, uploadSnapshot(ctx, sharedFS, localFS, dir, 1, 2, index, 1024))
+	}
+	// uploaded by another replica
+	dir, files := writeTestSnapshot(t, localFS, 30)
+	require.NoError(t, uploadSnapshot(ctx, sharedFS, localFS, dir, 1, 3, 30, 1024))
+
+	// the gbsnap file is split into 3 chunks
+	entries, err := sharedFS.List(ctx, sharedSnapshotDir(1, 30))
+	require.NoError(t, err)
+	assert.Equal(t, 5, len(entries))
+
+	// the manifest of the latest snapshot is missing
+	require.NoError(t, sharedFS.Delete(ctx, sharedSnapshotDir(1, 30)+"/"+sharedSnapshotManifest))
+	target, index, err := downloadSnapshot(ctx, sharedFS, localFS, 1, "restore")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), index)
+
+	require.NoError(t, uploadSnapshot(ctx, sharedFS, localFS, dir, 1, 2, 30, 1024))
+	target, index, err = downloadSnapshot(ctx, sharedFS, localFS, 1, "restore")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(30), index)
+	for name, data := range files {
+		f, err := localFS.Open(localFS.PathJoin(target, name))
+		require.NoError(t, err)
+		buf := new(bytes.Buffer)
+		_, err = buf.ReadFrom(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		assert.Equal(t, data, buf.Bytes())
+	}
+
+	require.NoError(t, removeSharedSnapshots(ctx, sharedFS, 1, 2))
+	indexes, err := sharedSnapshotIndexes(ctx, sharedFS, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{30, 20}, indexes)
+}
+
+func TestSharedSnapshotCorruptedChunk(t *testing.T) {
+	ctx := context.Background()
+	sharedFS := newTestSharedFS(t)
+	localFS := vfs.NewMem()
+
+	dir, _ := writeTestSnapshot(t, localFS, 10)
+	require.NoError(t, uploadSnapshot(ctx, sharedFS, localFS, dir, 1, 2, 10, 1024))
+
+	path := chunkPath(sharedSnapshotDir(1, 10), fmt.Sprintf(snapshotPathPattern, 10)+".gbsnap", 1)
+	require.NoError(t, sharedFS.Delete(ctx, path))
+	data := make([]byte, 1024)
+	require.NoError(t, sharedFS.Write(ctx, fileservice.IOVector{
+		FilePath: path,
+		Entries:  []fileservice.IOEntry{{Size: int64(len(data)), Data: data}},
+	}))
+	_, _, err := downloadSnapshot(ctx, sharedFS, localFS, 1, "restore")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrInternal))
+}
+
+func TestRestoreReplicaFromSharedSnapshot(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx, cancel := context.WithTimeout(context.Background(), testIOTimeout)
+	defer cancel()
+	sharedFS := newTestSharedFS(t)
+
+	cfg := getStoreTestConfig()
+	cfg.SharedSnapshot.Enable = true
+	// the tee logdb can not be reopened after the snapshot is imported
+	cfg.UseTeeLogDB = false
+	s1, err := newLogStore(cfg, nil, runtime.DefaultRuntime(), withSharedFS(sharedFS))
+	require.NoError(t, err)
+	peers := map[uint64]dragonboat.Target{2: s1.nh.ID()}
+	require.NoError(t, s1.startReplica(1, 2, peers, false))
+	require.NoError(t, s1.getOrExtendDNLease(ctx, 1, 100))
+	for i := 0; i < 10; i++ {
+		_, err := s1.append(ctx, 1, getTestUserEntry())
+		require.NoError(t, err)
+	}
+	// the leader uploads the exported snapshot in background
+	require.NoError(t, s1.exportSnapshot(ctx, 1, 2))
+	index := s1.shardSnapshotInfo.getSnapshotIndex(1)
+	for s1.isSharing(1) {
+		select {
+		case <-ctx.Done():
+			t.Fatal("upload snapshot timeout")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	indexes, err := sharedSnapshotIndexes(ctx, sharedFS, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{index}, indexes)
+	require.NoError(t, s1.close())
+
+	// the store is rebuilt with an empty disk
+	cfg.FS = vfs.NewStrictMem()
+	restored, err := restoreReplica(ctx, cfg, sharedFS, "restore", 1, 2,
+		map[uint64]string{2: cfg.UUID})
+	require.NoError(t, err)
+	assert.Equal(t, index, restored)
+
+	s2, err := newLogStore(cfg, nil, runtime.DefaultRuntime())
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, s2.close())
+	}()
+	require.NoError(t, s2.startReplica(1, 2, nil, false))
+	v, err := s2.read(ctx, 1, indexQuery{})
+	require.NoError(t, err)
+	assert.True(t, v.(uint64) >= index)
+	// the lease holder is restored from the snapshot
+	_, err = s2.append(ctx, 1, getTestUserEntry())
+	assert.NoError(t, err)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper/bootstrap"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper/checkers"
@@ -138,11 +139,26 @@ type store struct {
 	}
 	shardSnapshotInfo shardSnapshotInfo
 	snapshotMgr       *snapshotManager
+	// sharedFS is the file service the exported snapshots are uploaded to, it
+	// is nil if the shared snapshots are disabled.
+	sharedFS fileservice.FileService
+	// sharing is the set of shards whose snapshots are being uploaded to the
+	// shared file service, shardID -> struct{}.
+	sharing sync.Map
+}
+
+type storeOption func(*store)
+
+// withSharedFS sets the file service the exported snapshots are uploaded to.
+func withSharedFS(fs fileservice.FileService) storeOption {
+	return func(l *store) {
+		l.sharedFS = fs
+	}
 }
 
 func newLogStore(cfg Config,
 	taskServiceGetter func() taskservice.TaskService,
-	rt runtime.Runtime) (*store, error) {
+	rt runtime.Runtime, opts ...storeOption) (*store, error) {
 	nh, err := dragonboat.NewNodeHost(getNodeHostConfig(cfg))
 	if err != nil {
 		return nil, err
@@ -166,6 +182,9 @@ func newLogStore(cfg Config,
 		shardSnapshotInfo: newShardSnapshotInfo(),
 		snapshotMgr:       newSnapshotManager(&cfg),
 	}
+	for _, opt := range opts {
+		opt(ls)
+	}
 	ls.mu.metadata = metadata.LogStore{UUID: cfg.UUID}
 	if err := ls.stopper.RunNamedTask("truncation-worker", func(ctx context.Context) {
 		rt.SubLogger(runtime.SystemInit).Info("logservice truncation worker started")
@@ -403,6 +422,42 @@ func (l *store) getTruncatedLsn(ctx context.Context,
 	return v.(uint64), nil
 }
 
+func (l *store) setConsumerLsn(ctx context.Context,
+	shardID uint64, consumer string, lsn Lsn) error {
+	session := l.nh.GetNoOPSession(shardID)
+	cmd := getSetConsumerLsnCmd(consumer, lsn, time.Now().UnixNano())
+	if _, err := l.propose(ctx, session, cmd); err != nil {
+		l.runtime.Logger().Error("propose set consumer lsn cmd failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (l *store) getConsumerLsn(ctx context.Context,
+	shardID uint64, consumer string) (Lsn, error) {
+	v, err := l.read(ctx, shardID, consumerLsnQuery{consumer: consumer})
+	if err != nil {
+		return 0, err
+	}
+	return v.(uint64), nil
+}
+
+// getTruncatableLsn returns the truncated lsn set by the DN, or the lsn
+// before the slowest consumer if it is smaller. Consumers that have not
+// renewed their leases within ConsumerLeaseTTL are ignored.
+func (l *store) getTruncatableLsn(ctx context.Context,
+	shardID uint64) (uint64, error) {
+	var expiredBefore int64
+	if ttl := l.cfg.ConsumerLeaseTTL.Duration; ttl > 0 {
+		expiredBefore = time.Now().Add(-ttl).UnixNano()
+	}
+	v, err := l.read(ctx, shardID, truncatableLsnQuery{expiredBefore: expiredBefore})
+	if err != nil {
+		return 0, err
+	}
+	return v.(uint64), nil
+}
+
 func (l *store) tsoUpdate(ctx context.Context, count uint64) (uint64, error) {
 	cmd := getTsoUpdateCmd(count)
 	session := l.nh.GetNoOPSession(firstLogShardID)
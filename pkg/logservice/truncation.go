@@ -140,6 +140,7 @@ func (l *store) exportSnapshot(ctx context.Context, shardID uint64, replicaID ui
 	}
 	// forward the snapshot index.
 	l.shardSnapshotInfo.forwardSnapshot(shardID, idx)
+	l.shareSnapshot(shardID, replicaID, idx)
 	return nil
 }
 
@@ -198,9 +199,10 @@ func (l *store) processShardTruncateLog(ctx context.Context, shardID uint64) err
 	ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
 	defer cancel()
 
-	lsnInSM, err := l.getTruncatedLsn(ctx, shardID)
+	// logs not read by the consumers are kept
+	lsnInSM, err := l.getTruncatableLsn(ctx, shardID)
 	if err != nil {
-		l.runtime.Logger().Error("get truncated lsn in state machine failed",
+		l.runtime.Logger().Error("get truncatable lsn in state machine failed",
 			zap.Uint64("shard ID", shardID), zap.Error(err))
 		return err
 	}
@@ -212,6 +214,10 @@ func (l *store) processShardTruncateLog(ctx context.Context, shardID uint64) err
 	replicaID := uint64(l.getReplicaID(shardID))
 	dir, lsn := l.snapshotMgr.EvalImportSnapshot(shardID, replicaID, lsnInSM)
 	if l.shouldDoImport(shardID, lsn, dir) {
+		// the exported snapshots are kept until the upload is done
+		if l.isSharing(shardID) {
+			return nil
+		}
 		if err := l.importSnapshot(ctx, shardID, replicaID, lsn, dir); err != nil {
 			l.runtime.Logger().Error("do truncate log failed",
 				zap.Uint64("shard ID", shardID),
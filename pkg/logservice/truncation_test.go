@@ -194,6 +194,13 @@ func TestTruncationImportSnapshot(t *testing.T) {
 
 		_, idx = s.store.snapshotMgr.EvalImportSnapshot(1, 1, 14)
 		assert.Equal(t, uint64(14), idx)
+		// the snapshots are not imported while uploading
+		s.store.sharing.Store(uint64(1), struct{}{})
+		err = s.store.processShardTruncateLog(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, s.store.snapshotMgr.Count(1, 1))
+		s.store.sharing.Delete(uint64(1))
+
 		err = s.store.processShardTruncateLog(ctx, 1)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, s.store.snapshotMgr.Count(1, 1))
@@ -50,6 +50,10 @@ func (bm BlockObject) MustGetColumn(seqnum uint16) ColumnMeta {
 	return meta
 }
 
+func (bm BlockObject) HasColumn(seqnum uint16) bool {
+	return seqnum <= bm.GetMaxSeqnum()
+}
+
 func (bm BlockObject) GetColumn(seqnum uint16) (ColumnMeta, error) {
 	if seqnum >= bm.BlockHeader().MetaColumnCount() {
 		return nil, moerr.NewInternalErrorNoCtx("ObjectIO: bad index: %d, "+
@@ -15,9 +15,13 @@
 package objectio
 
 import (
+	"context"
 	"io"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 )
 
 type CacheConstructor = func(r io.Reader, buf []byte) ([]byte, int64, error)
@@ -56,6 +60,50 @@ func constructorFactory(size int64, algo uint8) CacheConstructor {
 	}
 }
 
+// HotKeyConstructor returns the constructor to reload a cached object of the key.
+// The compression algorithm is taken from the extent of the key in the object,
+// which is looked up in the object header and meta.
+func HotKeyConstructor(ctx context.Context, fs fileservice.FileService, key fileservice.HotKey) (CacheConstructor, error) {
+	matches := func(ext Extent) bool {
+		return int64(ext.Offset()) == key.Offset && int64(ext.Length()) == key.Size
+	}
+	headerExt := NewExtent(compress.None, 0, HeaderSize, HeaderSize)
+	if matches(headerExt) {
+		return constructorFactory(int64(headerExt.OriginSize()), headerExt.Alg()), nil
+	}
+	v, err := ReadExtent(ctx, key.Path, &headerExt, false, fs, constructorFactory)
+	if err != nil {
+		return nil, err
+	}
+	header := Header(v)
+	if len(header) < HeaderSize || types.DecodeUint64(header[:8]) != Magic {
+		return nil, moerr.NewInternalError(ctx, "%s is not an object", key.Path)
+	}
+	metaExt := header.Extent()
+	if matches(metaExt) {
+		return constructorFactory(int64(metaExt.OriginSize()), metaExt.Alg()), nil
+	}
+	meta, err := ReadObjectMeta(ctx, key.Path, &metaExt, false, fs)
+	if err != nil {
+		return nil, err
+	}
+	for _, ext := range []Extent{meta.BlockHeader().BFExtent(), meta.BlockHeader().ZoneMapArea()} {
+		if matches(ext) {
+			return constructorFactory(int64(ext.OriginSize()), ext.Alg()), nil
+		}
+	}
+	for i := uint32(0); i < meta.BlockCount(); i++ {
+		blk := meta.GetBlockMeta(i)
+		for seqnum := uint16(0); seqnum < blk.GetMetaColumnCount(); seqnum++ {
+			if ext := blk.ColumnMeta(seqnum).Location(); matches(ext) {
+				return constructorFactory(int64(ext.OriginSize()), ext.Alg()), nil
+			}
+		}
+	}
+	return nil, moerr.NewInternalError(ctx, "extent %d-%d is not found in object %s",
+		key.Offset, key.Size, key.Path)
+}
+
 func Decode(buf []byte) (any, error) {
 	header := DecodeIOEntryHeader(buf)
 	codec := GetIOEntryCodec(*header)
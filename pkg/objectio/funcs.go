@@ -21,8 +21,10 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 )
 
@@ -38,6 +40,8 @@ func ReadExtent(
 		FilePath: name,
 		Entries:  make([]fileservice.IOEntry, 1),
 		NoCache:  noLRUCache,
+
+		StatementID: perfcounter.StatementIDFromContext(ctx),
 	}
 
 	ioVec.Entries[0] = fileservice.IOEntry{
@@ -120,6 +124,7 @@ func ReadOneBlockWithMeta(
 	blk uint16,
 	idxs []uint16,
 	typs []types.Type,
+	fills [][]byte,
 	m *mpool.MPool,
 	fs fileservice.FileService,
 	factory CacheConstructorFactory,
@@ -127,6 +132,8 @@ func ReadOneBlockWithMeta(
 	ioVec = &fileservice.IOVector{
 		FilePath: name,
 		Entries:  make([]fileservice.IOEntry, 0),
+
+		StatementID: perfcounter.StatementIDFromContext(ctx),
 	}
 	var filledEntries []fileservice.IOEntry
 	blkmeta := meta.GetBlockMeta(uint32(blk))
@@ -194,8 +201,15 @@ func ReadOneBlockWithMeta(
 					meta.BlockHeader().BlockID().String(), filledEntries[i].Size, typs[i])
 				buf := &bytes.Buffer{}
 				buf.Write(EncodeIOEntryHeader(&IOEntryHeader{Type: IOET_ColData, Version: IOET_ColumnData_CurrVer}))
-				err = containers.FillCNConstVector(length, typs[i], nil, m).MarshalBinaryWithBuffer(buf)
-				if err != nil {
+				var fill []byte
+				if len(fills) > 0 {
+					fill = fills[i]
+				}
+				var vec *vector.Vector
+				if vec, err = containers.FillCNConstVector(length, typs[i], fill, m); err != nil {
+					return
+				}
+				if err = vec.MarshalBinaryWithBuffer(buf); err != nil {
 					return
 				}
 				filledEntries[i].ObjectBytes = buf.Bytes()
@@ -220,6 +234,8 @@ func ReadMultiBlocksWithMeta(
 	ioVec = &fileservice.IOVector{
 		FilePath: name,
 		Entries:  make([]fileservice.IOEntry, 0),
+
+		StatementID: perfcounter.StatementIDFromContext(ctx),
 	}
 	for _, opt := range options {
 		for seqnum := range opt.Idxes {
@@ -256,6 +272,8 @@ func ReadAllBlocksWithMeta(
 		FilePath: name,
 		Entries:  make([]fileservice.IOEntry, 0, len(cols)*int(meta.BlockCount())),
 		NoCache:  noLRUCache,
+
+		StatementID: perfcounter.StatementIDFromContext(ctx),
 	}
 	for blk := uint32(0); blk < meta.BlockCount(); blk++ {
 		for _, seqnum := range cols {
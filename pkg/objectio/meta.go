@@ -44,6 +44,10 @@ func (o objectMetaV1) MustGetColumn(seqnum uint16) ColumnMeta {
 	return GetObjectColumnMeta(seqnum, o[headerLen:])
 }
 
+func (o objectMetaV1) HasColumn(seqnum uint16) bool {
+	return seqnum < o.BlockHeader().MetaColumnCount()
+}
+
 func (o objectMetaV1) ObjectColumnMeta(seqnum uint16) ColumnMeta {
 	return GetObjectColumnMeta(seqnum, o[headerLen:])
 }
@@ -198,6 +202,7 @@ func (bm BlockObject) ToColumnZoneMaps(seqnums []uint16) []ZoneMap {
 		}
 		if idx > maxseq {
 			zms[i] = index.DecodeZM(EmptyZm[:])
+			continue
 		}
 		column := bm.MustGetColumn(idx)
 		zms[i] = index.DecodeZM(column.ZoneMap())
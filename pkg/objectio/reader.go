@@ -134,6 +134,7 @@ func (r *objectReaderV1) ReadOneBlock(
 	ctx context.Context,
 	idxs []uint16,
 	typs []types.Type,
+	fills [][]byte,
 	blk uint16,
 	m *mpool.MPool,
 ) (ioVec *fileservice.IOVector, err error) {
@@ -141,7 +142,7 @@ func (r *objectReaderV1) ReadOneBlock(
 	if meta, err = r.ReadMeta(ctx, m); err != nil {
 		return
 	}
-	return ReadOneBlockWithMeta(ctx, &meta, r.name, blk, idxs, typs, m, r.fs, constructorFactory)
+	return ReadOneBlockWithMeta(ctx, &meta, r.name, blk, idxs, typs, fills, m, r.fs, constructorFactory)
 }
 
 func (r *objectReaderV1) ReadAll(
@@ -47,6 +47,8 @@ var BuildZM = index.BuildZM
 
 type ColumnMetaFetcher interface {
 	MustGetColumn(idx uint16) ColumnMeta
+	// HasColumn returns false if the column was added after the data was written
+	HasColumn(idx uint16) bool
 }
 
 type WriteOptions struct {
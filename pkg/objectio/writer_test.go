@@ -116,7 +116,7 @@ func TestNewObjectWriter(t *testing.T) {
 	idxs[1] = 2
 	idxs[2] = 3
 	typs := []types.Type{types.T_int8.ToType(), types.T_int32.ToType(), types.T_int64.ToType()}
-	vec, err := objectReader.ReadOneBlock(context.Background(), idxs, typs, 0, pool)
+	vec, err := objectReader.ReadOneBlock(context.Background(), idxs, typs, nil, 0, pool)
 	assert.Nil(t, err)
 
 	obj, err := Decode(vec.Entries[0].ObjectBytes)
@@ -156,7 +156,7 @@ func TestNewObjectWriter(t *testing.T) {
 	idxs[0] = 0
 	idxs[1] = 2
 	idxs[2] = 3
-	vec, err = objectReader.ReadOneBlock(context.Background(), idxs, typs, 0, pool)
+	vec, err = objectReader.ReadOneBlock(context.Background(), idxs, typs, nil, 0, pool)
 	assert.Nil(t, err)
 
 	obj, err = Decode(vec.Entries[0].ObjectBytes)
@@ -30,8 +30,8 @@ func NewUpdateConstraintReq(did, tid uint64, cstr string) *AlterTableReq {
 
 func NewUpdateCommentReq(did, tid uint64, comment string) *AlterTableReq {
 	return &AlterTableReq{
-		TableId: did,
-		DbId:    tid,
+		DbId:    did,
+		TableId: tid,
 		Kind:    AlterKind_UpdateComment,
 		Operation: &AlterTableReq_UpdateComment{
 			&AlterTableComment{Comment: comment},
@@ -41,8 +41,8 @@ func NewUpdateCommentReq(did, tid uint64, comment string) *AlterTableReq {
 
 func NewRenameTableReq(did, tid uint64, old, new string) *AlterTableReq {
 	return &AlterTableReq{
-		TableId: did,
-		DbId:    tid,
+		DbId:    did,
+		TableId: tid,
 		Kind:    AlterKind_RenameTable,
 		Operation: &AlterTableReq_RenameTable{
 			&AlterTableRenameTable{OldName: old, NewName: new},
@@ -52,8 +52,8 @@ func NewRenameTableReq(did, tid uint64, old, new string) *AlterTableReq {
 
 func NewAddColumnReq(did, tid uint64, name string, typ *plan.Type, insertAt int32) *AlterTableReq {
 	return &AlterTableReq{
-		TableId: did,
-		DbId:    tid,
+		DbId:    did,
+		TableId: tid,
 		Kind:    AlterKind_AddColumn,
 		Operation: &AlterTableReq_AddColumn{
 			&AlterTableAddColumn{
@@ -72,10 +72,26 @@ func NewAddColumnReq(did, tid uint64, name string, typ *plan.Type, insertAt int3
 	}
 }
 
+// NewAddColumnDefReq adds a column with the full definition, including the
+// default value and the comment
+func NewAddColumnDefReq(did, tid uint64, col *plan.ColDef, insertAt int32) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_AddColumn,
+		Operation: &AlterTableReq_AddColumn{
+			&AlterTableAddColumn{
+				Column:         col,
+				InsertPosition: insertAt,
+			},
+		},
+	}
+}
+
 func NewRemoveColumnReq(did, tid uint64, idx, seqnum uint32) *AlterTableReq {
 	return &AlterTableReq{
-		TableId: did,
-		DbId:    tid,
+		DbId:    did,
+		TableId: tid,
 		Kind:    AlterKind_DropColumn,
 		Operation: &AlterTableReq_DropColumn{
 			&AlterTableDropColumn{
@@ -86,6 +102,51 @@ func NewRemoveColumnReq(did, tid uint64, idx, seqnum uint32) *AlterTableReq {
 	}
 }
 
+func NewRenameColumnReq(did, tid uint64, oldname, newname string, seqnum uint32) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_RenameColumn,
+		Operation: &AlterTableReq_RenameColumn{
+			&AlterTableRenameColumn{
+				OldName:     oldname,
+				NewName:     newname,
+				SequenceNum: seqnum,
+			},
+		},
+	}
+}
+
+// NewModifyColumnReq replaces the definition of the column identified by seqnum,
+// a negative insertAt keeps the position of the column
+func NewModifyColumnReq(did, tid uint64, col *plan.ColDef, seqnum uint32, insertAt int32) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_ModifyColumn,
+		Operation: &AlterTableReq_ModifyColumn{
+			&AlterTableModifyColumn{
+				Column:         col,
+				SequenceNum:    seqnum,
+				InsertPosition: insertAt,
+			},
+		},
+	}
+}
+
+// NewUpdatePartitionReq replaces the partition info of the table, the info is
+// the marshaled plan.PartitionByDef
+func NewUpdatePartitionReq(did, tid uint64, info string) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_UpdatePartition,
+		Operation: &AlterTableReq_UpdatePartition{
+			&AlterTablePartition{PartitionInfo: info},
+		},
+	}
+}
+
 func (m *SyncLogTailReq) MarshalBinary() ([]byte, error) {
 	return m.Marshal()
 }
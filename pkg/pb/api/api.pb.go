@@ -66,6 +66,9 @@ const (
 	AlterKind_RenameTable      AlterKind = 3
 	AlterKind_UpdateComment    AlterKind = 4
 	AlterKind_UpdateConstraint AlterKind = 5
+	AlterKind_RenameColumn     AlterKind = 6
+	AlterKind_ModifyColumn     AlterKind = 7
+	AlterKind_UpdatePartition  AlterKind = 8
 )
 
 var AlterKind_name = map[int32]string{
@@ -75,6 +78,9 @@ var AlterKind_name = map[int32]string{
 	3: "RenameTable",
 	4: "UpdateComment",
 	5: "UpdateConstraint",
+	6: "RenameColumn",
+	7: "ModifyColumn",
+	8: "UpdatePartition",
 }
 
 var AlterKind_value = map[string]int32{
@@ -84,6 +90,9 @@ var AlterKind_value = map[string]int32{
 	"RenameTable":      3,
 	"UpdateComment":    4,
 	"UpdateConstraint": 5,
+	"RenameColumn":     6,
+	"ModifyColumn":     7,
+	"UpdatePartition":  8,
 }
 
 func (x AlterKind) String() string {
@@ -1077,6 +1086,179 @@ func (m *AlterTableDropColumn) GetSequenceNum() uint32 {
 	return 0
 }
 
+type AlterTableRenameColumn struct {
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	SequenceNum          uint32   `protobuf:"varint,3,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableRenameColumn) Reset()         { *m = AlterTableRenameColumn{} }
+func (m *AlterTableRenameColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRenameColumn) ProtoMessage()    {}
+func (*AlterTableRenameColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+}
+func (m *AlterTableRenameColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableRenameColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableRenameColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableRenameColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRenameColumn.Merge(m, src)
+}
+func (m *AlterTableRenameColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableRenameColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRenameColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableRenameColumn proto.InternalMessageInfo
+
+func (m *AlterTableRenameColumn) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *AlterTableRenameColumn) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+func (m *AlterTableRenameColumn) GetSequenceNum() uint32 {
+	if m != nil {
+		return m.SequenceNum
+	}
+	return 0
+}
+
+type AlterTableModifyColumn struct {
+	Column               *plan.ColDef `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	SequenceNum          uint32       `protobuf:"varint,2,opt,name=sequence_num,json=sequenceNum,proto3" json:"sequence_num,omitempty"`
+	InsertPosition       int32        `protobuf:"varint,3,opt,name=insert_position,json=insertPosition,proto3" json:"insert_position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AlterTableModifyColumn) Reset()         { *m = AlterTableModifyColumn{} }
+func (m *AlterTableModifyColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableModifyColumn) ProtoMessage()    {}
+func (*AlterTableModifyColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+}
+func (m *AlterTableModifyColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableModifyColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableModifyColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableModifyColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableModifyColumn.Merge(m, src)
+}
+func (m *AlterTableModifyColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableModifyColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableModifyColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableModifyColumn proto.InternalMessageInfo
+
+func (m *AlterTableModifyColumn) GetColumn() *plan.ColDef {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+func (m *AlterTableModifyColumn) GetSequenceNum() uint32 {
+	if m != nil {
+		return m.SequenceNum
+	}
+	return 0
+}
+
+func (m *AlterTableModifyColumn) GetInsertPosition() int32 {
+	if m != nil {
+		return m.InsertPosition
+	}
+	return 0
+}
+
+type AlterTablePartition struct {
+	PartitionInfo        string   `protobuf:"bytes,1,opt,name=partition_info,json=partitionInfo,proto3" json:"partition_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTablePartition) Reset()         { *m = AlterTablePartition{} }
+func (m *AlterTablePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTablePartition) ProtoMessage()    {}
+func (*AlterTablePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+}
+func (m *AlterTablePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTablePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTablePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTablePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTablePartition.Merge(m, src)
+}
+func (m *AlterTablePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTablePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTablePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTablePartition proto.InternalMessageInfo
+
+func (m *AlterTablePartition) GetPartitionInfo() string {
+	if m != nil {
+		return m.PartitionInfo
+	}
+	return ""
+}
+
 type AlterTableReq struct {
 	TableId uint64    `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	DbId    uint64    `protobuf:"varint,2,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
@@ -1087,6 +1269,9 @@ type AlterTableReq struct {
 	//	*AlterTableReq_RenameTable
 	//	*AlterTableReq_UpdateComment
 	//	*AlterTableReq_UpdateCstr
+	//	*AlterTableReq_RenameColumn
+	//	*AlterTableReq_ModifyColumn
+	//	*AlterTableReq_UpdatePartition
 	Operation            isAlterTableReq_Operation `protobuf_oneof:"operation"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -1097,7 +1282,7 @@ func (m *AlterTableReq) Reset()         { *m = AlterTableReq{} }
 func (m *AlterTableReq) String() string { return proto.CompactTextString(m) }
 func (*AlterTableReq) ProtoMessage()    {}
 func (*AlterTableReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 func (m *AlterTableReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,12 +1332,24 @@ type AlterTableReq_UpdateComment struct {
 type AlterTableReq_UpdateCstr struct {
 	UpdateCstr *AlterTableConstraint `protobuf:"bytes,8,opt,name=update_cstr,json=updateCstr,proto3,oneof" json:"update_cstr,omitempty"`
 }
+type AlterTableReq_RenameColumn struct {
+	RenameColumn *AlterTableRenameColumn `protobuf:"bytes,9,opt,name=rename_column,json=renameColumn,proto3,oneof" json:"rename_column,omitempty"`
+}
+type AlterTableReq_ModifyColumn struct {
+	ModifyColumn *AlterTableModifyColumn `protobuf:"bytes,10,opt,name=modify_column,json=modifyColumn,proto3,oneof" json:"modify_column,omitempty"`
+}
+type AlterTableReq_UpdatePartition struct {
+	UpdatePartition *AlterTablePartition `protobuf:"bytes,11,opt,name=update_partition,json=updatePartition,proto3,oneof" json:"update_partition,omitempty"`
+}
 
-func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()     {}
-func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()    {}
-func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()   {}
-func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation() {}
-func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()       {}
+func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()     {}
+func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation()   {}
+func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_RenameColumn) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_ModifyColumn) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_UpdatePartition) isAlterTableReq_Operation() {}
 
 func (m *AlterTableReq) GetOperation() isAlterTableReq_Operation {
 	if m != nil {
@@ -1217,6 +1414,27 @@ func (m *AlterTableReq) GetUpdateCstr() *AlterTableConstraint {
 	return nil
 }
 
+func (m *AlterTableReq) GetRenameColumn() *AlterTableRenameColumn {
+	if x, ok := m.GetOperation().(*AlterTableReq_RenameColumn); ok {
+		return x.RenameColumn
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetModifyColumn() *AlterTableModifyColumn {
+	if x, ok := m.GetOperation().(*AlterTableReq_ModifyColumn); ok {
+		return x.ModifyColumn
+	}
+	return nil
+}
+
+func (m *AlterTableReq) GetUpdatePartition() *AlterTablePartition {
+	if x, ok := m.GetOperation().(*AlterTableReq_UpdatePartition); ok {
+		return x.UpdatePartition
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1225,6 +1443,9 @@ func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 		(*AlterTableReq_RenameTable)(nil),
 		(*AlterTableReq_UpdateComment)(nil),
 		(*AlterTableReq_UpdateCstr)(nil),
+		(*AlterTableReq_RenameColumn)(nil),
+		(*AlterTableReq_ModifyColumn)(nil),
+		(*AlterTableReq_UpdatePartition)(nil),
 	}
 }
 
@@ -1242,7 +1463,7 @@ func (m *SchemaExtra) Reset()         { *m = SchemaExtra{} }
 func (m *SchemaExtra) String() string { return proto.CompactTextString(m) }
 func (*SchemaExtra) ProtoMessage()    {}
 func (*SchemaExtra) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 func (m *SchemaExtra) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1525,7 @@ func (m *Int64Map) Reset()         { *m = Int64Map{} }
 func (m *Int64Map) String() string { return proto.CompactTextString(m) }
 func (*Int64Map) ProtoMessage()    {}
 func (*Int64Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 func (m *Int64Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1359,6 +1580,9 @@ func init() {
 	proto.RegisterType((*AlterTableRenameTable)(nil), "api.AlterTableRenameTable")
 	proto.RegisterType((*AlterTableAddColumn)(nil), "api.AlterTableAddColumn")
 	proto.RegisterType((*AlterTableDropColumn)(nil), "api.AlterTableDropColumn")
+	proto.RegisterType((*AlterTableRenameColumn)(nil), "api.AlterTableRenameColumn")
+	proto.RegisterType((*AlterTableModifyColumn)(nil), "api.AlterTableModifyColumn")
+	proto.RegisterType((*AlterTablePartition)(nil), "api.AlterTablePartition")
 	proto.RegisterType((*AlterTableReq)(nil), "api.AlterTableReq")
 	proto.RegisterType((*SchemaExtra)(nil), "api.SchemaExtra")
 	proto.RegisterType((*Int64Map)(nil), "api.Int64Map")
@@ -1368,91 +1592,99 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x6e, 0xdb, 0xc6,
-	0x12, 0x16, 0xf5, 0xaf, 0xa1, 0x24, 0xd3, 0x1b, 0x9f, 0x03, 0xc5, 0xe7, 0x1c, 0x47, 0x87, 0x69,
-	0x53, 0x37, 0x6d, 0x6c, 0xc0, 0x09, 0x8a, 0xb4, 0x08, 0x12, 0xc4, 0x72, 0x50, 0x0b, 0x8d, 0xe3,
-	0x80, 0x71, 0x12, 0x20, 0x28, 0x40, 0xac, 0xc8, 0x8d, 0xbc, 0x10, 0xb9, 0x5c, 0x93, 0x2b, 0x47,
-	0xba, 0x2c, 0xd0, 0xbe, 0x40, 0x9f, 0xa0, 0xb7, 0x45, 0x5f, 0xa4, 0x37, 0x05, 0xfa, 0x08, 0x45,
-	0x7a, 0xd3, 0xf6, 0x29, 0x8a, 0x9d, 0x25, 0x25, 0x39, 0x6d, 0x73, 0x9b, 0x1b, 0x61, 0xe6, 0x9b,
-	0x99, 0xd5, 0xec, 0xcc, 0xc7, 0x99, 0x85, 0x16, 0x95, 0x7c, 0x47, 0xa6, 0x89, 0x4a, 0x48, 0x85,
-	0x4a, 0xbe, 0x79, 0x63, 0xcc, 0xd5, 0xe9, 0x74, 0xb4, 0x13, 0x24, 0xf1, 0xee, 0x38, 0x19, 0x27,
-	0xbb, 0x68, 0x1b, 0x4d, 0x5f, 0xa2, 0x86, 0x0a, 0x4a, 0x26, 0x66, 0x73, 0x4d, 0xf1, 0x98, 0x65,
-	0x8a, 0xc6, 0x32, 0x07, 0x40, 0x46, 0x54, 0x18, 0xd9, 0xfd, 0xc1, 0x82, 0xfa, 0x33, 0x16, 0xa8,
-	0x24, 0x25, 0x04, 0xaa, 0x21, 0x55, 0xb4, 0x67, 0xf5, 0xad, 0xed, 0xb6, 0x87, 0x32, 0xd9, 0x82,
-	0xaa, 0x9a, 0x4b, 0xd6, 0x2b, 0xf7, 0xad, 0x6d, 0x7b, 0x0f, 0x76, 0x30, 0xf2, 0x64, 0x2e, 0x99,
-	0x87, 0x38, 0xd9, 0x84, 0xa6, 0x98, 0x46, 0x11, 0x1d, 0x45, 0xac, 0x57, 0xe9, 0x5b, 0xdb, 0x4d,
-	0x6f, 0xa1, 0x13, 0x07, 0x2a, 0x22, 0x93, 0xbd, 0x2a, 0x1e, 0xa7, 0x45, 0x72, 0x19, 0x9a, 0x3c,
-	0xf3, 0x83, 0x44, 0x64, 0xaa, 0x57, 0x43, 0xef, 0x06, 0xcf, 0x06, 0x5a, 0xd5, 0xce, 0x11, 0x13,
-	0xbd, 0x7a, 0xdf, 0xda, 0xee, 0x78, 0x5a, 0xd4, 0xe9, 0xd0, 0x94, 0xd1, 0x5e, 0xc3, 0xa4, 0xa3,
-	0x65, 0xf7, 0x2e, 0xd4, 0xf6, 0xa9, 0x0a, 0x4e, 0xc9, 0x06, 0xd4, 0xa8, 0x52, 0x69, 0xd6, 0xb3,
-	0xfa, 0x95, 0xed, 0x96, 0x67, 0x14, 0x72, 0x05, 0xaa, 0xe7, 0x2c, 0xc8, 0x7a, 0xe5, 0x7e, 0x65,
-	0xdb, 0xde, 0xb3, 0x77, 0x74, 0xdd, 0xcc, 0xe5, 0x3c, 0x34, 0xb8, 0xcf, 0xa0, 0x71, 0xa2, 0x73,
-	0x1b, 0x1e, 0x90, 0x4b, 0x50, 0x0b, 0x47, 0x3e, 0x0f, 0xf1, 0xba, 0x55, 0xaf, 0x1a, 0x8e, 0x86,
-	0xa1, 0x06, 0x15, 0x82, 0x65, 0x03, 0x2a, 0x0d, 0xfe, 0x1f, 0xda, 0x92, 0xa6, 0x8a, 0x2b, 0x9e,
-	0x08, 0x6d, 0xab, 0xa0, 0xcd, 0x5e, 0x60, 0xc3, 0xd0, 0xfd, 0xd6, 0x82, 0xee, 0x93, 0xb9, 0x08,
-	0x1e, 0x26, 0xe3, 0x13, 0xca, 0x23, 0x8f, 0x9d, 0x91, 0x1b, 0xd0, 0x08, 0x84, 0x7f, 0x4a, 0xcf,
-	0x19, 0xfe, 0x83, 0xbd, 0xb7, 0xb1, 0xb3, 0xec, 0xc3, 0x49, 0x21, 0x79, 0xf5, 0x40, 0x1c, 0xd2,
-	0x73, 0x96, 0xbb, 0xbf, 0xa2, 0x42, 0xe5, 0xb5, 0xfe, 0x47, 0xf7, 0xe7, 0x54, 0x28, 0xe2, 0x42,
-	0x4d, 0x2d, 0x8a, 0x6e, 0xef, 0xb5, 0xf1, 0xaa, 0xf9, 0xd5, 0x3c, 0x63, 0x72, 0xbf, 0x84, 0xb5,
-	0x0b, 0x39, 0x65, 0x52, 0x5f, 0x25, 0x98, 0x48, 0x3f, 0x4a, 0x02, 0xaa, 0x33, 0xc7, 0xcc, 0x5a,
-	0x9e, 0x1d, 0x4c, 0xe4, 0xc3, 0x1c, 0x22, 0xd7, 0xa0, 0x19, 0x24, 0x71, 0x4c, 0x45, 0x58, 0xd4,
-	0x11, 0xf0, 0xf0, 0x07, 0x42, 0xa5, 0x73, 0x6f, 0x61, 0x73, 0xef, 0xc2, 0xfa, 0xe3, 0x94, 0x69,
-	0x95, 0xab, 0xe7, 0x29, 0x57, 0x6c, 0x10, 0x87, 0xe4, 0x43, 0x00, 0xa6, 0xfd, 0xfc, 0x88, 0x67,
-	0x0a, 0x7b, 0x73, 0x31, 0xbc, 0x85, 0xd6, 0x87, 0x3c, 0x53, 0xee, 0x4f, 0x65, 0xa8, 0x21, 0x48,
-	0x6e, 0x16, 0x41, 0xc8, 0x34, 0x9d, 0x52, 0x77, 0x6f, 0x63, 0x19, 0x64, 0x7e, 0x91, 0x73, 0x26,
-	0x5c, 0x8b, 0x9a, 0x4a, 0x78, 0xcb, 0x65, 0xb3, 0x1a, 0xa8, 0x0f, 0x43, 0x72, 0x05, 0x6c, 0xcd,
-	0xdd, 0x11, 0xcd, 0xd8, 0xb2, 0x5d, 0x50, 0x40, 0xc3, 0x90, 0xfc, 0x0f, 0xc0, 0xc4, 0x0a, 0x1a,
-	0x33, 0xe4, 0x67, 0xcb, 0x6b, 0x21, 0xf2, 0x88, 0xc6, 0x8c, 0x5c, 0x85, 0xce, 0x22, 0x1e, 0x3d,
-	0x6a, 0xe8, 0xd1, 0x2e, 0x40, 0x74, 0xfa, 0x0f, 0xb4, 0x5e, 0xf2, 0xe2, 0x88, 0x3a, 0x3a, 0x34,
-	0x35, 0x80, 0xc6, 0xff, 0x42, 0x65, 0x44, 0x15, 0x32, 0xb7, 0xb8, 0x3f, 0xd2, 0xd6, 0xd3, 0x30,
-	0xb9, 0x0a, 0x5d, 0x39, 0xf1, 0x83, 0x53, 0x16, 0x4c, 0xfc, 0xd1, 0xdc, 0x0f, 0x45, 0xaf, 0xd9,
-	0xb7, 0xb6, 0x6b, 0x9e, 0x2d, 0x27, 0x03, 0x0d, 0xee, 0xcf, 0x0f, 0x84, 0xbb, 0x0b, 0xad, 0xc5,
-	0xbd, 0x09, 0x40, 0x7d, 0x28, 0x32, 0x96, 0x2a, 0xa7, 0xa4, 0xe5, 0x03, 0x16, 0x31, 0xc5, 0x1c,
-	0x4b, 0xcb, 0x4f, 0x65, 0x48, 0x15, 0x73, 0xca, 0xee, 0xd7, 0x16, 0x00, 0x86, 0xcb, 0x84, 0x0b,
-	0x45, 0x3e, 0x82, 0x7a, 0xcc, 0x85, 0xaf, 0xb2, 0xb7, 0xb2, 0xaf, 0x16, 0x73, 0x71, 0x92, 0xa1,
-	0x33, 0x9d, 0x69, 0xe7, 0xf2, 0x5b, 0x9d, 0xe9, 0xec, 0x24, 0x2b, 0x2e, 0x57, 0xf9, 0xdb, 0xcb,
-	0x99, 0x34, 0xa8, 0xa2, 0x51, 0x32, 0x1e, 0x4c, 0xe4, 0x3b, 0x4b, 0xe3, 0x1b, 0x0b, 0xec, 0x23,
-	0xa6, 0xa8, 0xee, 0xd9, 0xbb, 0xcc, 0xe3, 0x36, 0x6c, 0xdc, 0x8f, 0x14, 0x4b, 0xf1, 0xd3, 0xc4,
-	0x49, 0x97, 0x52, 0xdd, 0x9e, 0x3e, 0xd8, 0xc1, 0x42, 0xcb, 0xf2, 0x91, 0xbb, 0x0a, 0xb9, 0x37,
-	0x60, 0x7d, 0x35, 0x32, 0x8e, 0x99, 0x50, 0xa4, 0x07, 0x8d, 0xc0, 0x88, 0xf9, 0xa7, 0x5b, 0xa8,
-	0xee, 0x11, 0xfc, 0x6b, 0xe9, 0xee, 0x31, 0x4d, 0x4b, 0x14, 0xf5, 0x87, 0x92, 0x44, 0xa1, 0xe1,
-	0x69, 0x1e, 0x93, 0x44, 0x21, 0xd2, 0xf4, 0x32, 0x34, 0x05, 0x7b, 0x65, 0x4c, 0x65, 0x63, 0x12,
-	0xec, 0x95, 0x36, 0xb9, 0x21, 0x5c, 0x5a, 0x1e, 0x77, 0x3f, 0x0c, 0x07, 0x49, 0x34, 0x8d, 0x05,
-	0x79, 0x0f, 0xea, 0x01, 0x4a, 0x79, 0x19, 0xdb, 0x66, 0x21, 0x0c, 0x92, 0xe8, 0x80, 0xbd, 0xf4,
-	0x72, 0x1b, 0xf9, 0x00, 0xd6, 0x38, 0xd2, 0xd5, 0x97, 0x49, 0x86, 0x23, 0x12, 0x8f, 0xaf, 0x79,
-	0x5d, 0x03, 0x3f, 0xce, 0x51, 0xf7, 0xc5, 0x6a, 0x75, 0x0e, 0xd2, 0x44, 0xe6, 0x7f, 0x73, 0x05,
-	0xec, 0x28, 0x19, 0xf3, 0x80, 0x46, 0x3e, 0x0f, 0x67, 0xf8, 0x5f, 0x1d, 0x0f, 0x72, 0x68, 0x18,
-	0xce, 0xf4, 0x1c, 0xcb, 0xd8, 0xd9, 0x94, 0x89, 0x80, 0xf9, 0x62, 0x1a, 0xe3, 0xf1, 0x1d, 0xcf,
-	0x2e, 0xb0, 0x47, 0xd3, 0xd8, 0xfd, 0xbe, 0x02, 0x9d, 0xd5, 0x8a, 0x9c, 0x5d, 0x18, 0x19, 0xd6,
-	0xc5, 0x91, 0xb1, 0x58, 0x06, 0xe5, 0x95, 0x65, 0xe0, 0x42, 0x75, 0xc2, 0x85, 0x19, 0x20, 0xdd,
-	0xbd, 0x2e, 0xb6, 0x16, 0x4f, 0xfc, 0x82, 0x8b, 0xd0, 0x43, 0x1b, 0xf9, 0x14, 0x80, 0x86, 0xa1,
-	0x9f, 0x17, 0xa5, 0x8a, 0x45, 0xe9, 0x2d, 0x3d, 0x2f, 0x96, 0xef, 0xb0, 0xe4, 0xb5, 0xe8, 0xa2,
-	0x96, 0x77, 0xc0, 0x0e, 0xd3, 0x44, 0x16, 0xb1, 0x35, 0x8c, 0xbd, 0xfc, 0x46, 0xec, 0xb2, 0x28,
-	0x87, 0x25, 0x0f, 0xc2, 0x65, 0x89, 0xee, 0x41, 0x3b, 0xc5, 0x2e, 0xfb, 0x66, 0x0f, 0xd4, 0x31,
-	0x7c, 0xf3, 0x8d, 0xf0, 0x15, 0x22, 0x1c, 0x96, 0x3c, 0x3b, 0x5d, 0xe1, 0xc5, 0x3d, 0xe8, 0x4e,
-	0x71, 0x76, 0xf8, 0x05, 0xa3, 0xcc, 0xb8, 0xfa, 0xf7, 0x1b, 0x47, 0xe4, 0xd4, 0x3b, 0x2c, 0x79,
-	0x1d, 0xe3, 0x5f, 0x70, 0xf1, 0x0e, 0xd8, 0xc5, 0x01, 0x99, 0x4a, 0x71, 0x86, 0xfd, 0x35, 0xff,
-	0x25, 0xe5, 0x75, 0xfe, 0xf9, 0x01, 0x99, 0x4a, 0xf7, 0x6d, 0x68, 0x25, 0x92, 0xa5, 0xb8, 0x73,
-	0xdc, 0xaf, 0x2c, 0xb0, 0x9f, 0x04, 0xa7, 0x2c, 0xa6, 0x0f, 0x66, 0x2a, 0xa5, 0xe4, 0x1a, 0xac,
-	0x09, 0x36, 0x53, 0xba, 0x34, 0x7e, 0xc6, 0xce, 0x74, 0x87, 0x0d, 0x07, 0x3a, 0x1a, 0x1e, 0x24,
-	0xd1, 0x13, 0x04, 0x71, 0x52, 0xa7, 0x89, 0x94, 0x2c, 0xf4, 0xcd, 0x6b, 0xa0, 0x8c, 0xaf, 0x81,
-	0x76, 0x0e, 0xde, 0xc7, 0x47, 0xc1, 0xfb, 0xd0, 0x35, 0x25, 0xf6, 0x83, 0x53, 0x2a, 0xc6, 0x2c,
-	0xcc, 0x1f, 0x2a, 0x1d, 0x83, 0x0e, 0x0c, 0xe8, 0x86, 0xd0, 0x1c, 0x0a, 0xf5, 0xc9, 0xad, 0x23,
-	0x2a, 0x89, 0x0b, 0x56, 0x9c, 0x6f, 0x2f, 0xb3, 0x88, 0x0a, 0xcb, 0xce, 0x91, 0xd9, 0x63, 0x56,
-	0xbc, 0x79, 0x0b, 0xea, 0x46, 0xd1, 0x4f, 0x97, 0x09, 0x9b, 0x63, 0x86, 0x15, 0x4f, 0x8b, 0xfa,
-	0x75, 0x72, 0x4e, 0xa3, 0xa9, 0xf9, 0xaa, 0x2a, 0x9e, 0x51, 0x3e, 0x2b, 0xdf, 0xb6, 0xae, 0x1f,
-	0x40, 0xfd, 0x58, 0x0e, 0x92, 0x90, 0x91, 0x06, 0x54, 0x1e, 0x25, 0xd2, 0x29, 0x91, 0x75, 0x68,
-	0x1f, 0xcb, 0xcf, 0x99, 0xca, 0xf7, 0xb4, 0xf3, 0x5b, 0x83, 0xb4, 0xa1, 0x71, 0x2c, 0x71, 0xa9,
-	0x3a, 0xbf, 0x37, 0x88, 0x03, 0xf6, 0xb1, 0x7c, 0x9c, 0x62, 0xe1, 0xb9, 0x72, 0xfe, 0x68, 0x5c,
-	0x3f, 0x83, 0xd6, 0x82, 0x88, 0xc4, 0x86, 0xc6, 0x50, 0x9c, 0xd3, 0x88, 0x87, 0x4e, 0x89, 0x74,
-	0xa0, 0xb5, 0xa0, 0x9b, 0x63, 0x91, 0x2e, 0xc0, 0x92, 0x41, 0x4e, 0x99, 0xac, 0x81, 0xbd, 0x42,
-	0x09, 0xa7, 0x42, 0xd6, 0xa1, 0xf3, 0x74, 0xb5, 0xab, 0x4e, 0x95, 0x6c, 0x80, 0x53, 0x40, 0x45,
-	0xef, 0x9c, 0xda, 0xfe, 0xdd, 0x1f, 0x5f, 0x6f, 0x59, 0x3f, 0xbf, 0xde, 0xb2, 0x7e, 0x79, 0xbd,
-	0x55, 0xfa, 0xee, 0xd7, 0x2d, 0xeb, 0xc5, 0xc7, 0x2b, 0xaf, 0xd0, 0x98, 0xaa, 0x94, 0xcf, 0x92,
-	0x94, 0x8f, 0xb9, 0x28, 0x14, 0xc1, 0x76, 0xe5, 0x64, 0xbc, 0x2b, 0x47, 0xbb, 0x54, 0xf2, 0x51,
-	0x1d, 0x9f, 0x9b, 0x37, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x73, 0xb2, 0x8f, 0xcc, 0x0a,
-	0x00, 0x00,
+	// 1470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0xdb, 0xca,
+	0x15, 0x16, 0xf5, 0xaf, 0x43, 0x49, 0xa6, 0x27, 0x6e, 0xc0, 0x38, 0xad, 0xa3, 0x32, 0x6d, 0xea,
+	0xa6, 0x8d, 0x0d, 0x38, 0x41, 0x91, 0x16, 0x41, 0x82, 0x58, 0x0e, 0x6a, 0xa1, 0x71, 0x6c, 0x30,
+	0x4e, 0x02, 0x04, 0x05, 0x88, 0x11, 0x39, 0x96, 0x09, 0x91, 0xc3, 0x31, 0x39, 0x72, 0xac, 0x65,
+	0x81, 0x76, 0xd3, 0x65, 0x9f, 0xa0, 0xeb, 0xf6, 0x45, 0xba, 0x29, 0xd0, 0x47, 0x28, 0xd2, 0x4d,
+	0xef, 0x7d, 0x82, 0xbb, 0xbc, 0x98, 0x33, 0xa4, 0x44, 0x3b, 0x46, 0x2e, 0xee, 0xdd, 0x64, 0x23,
+	0x9c, 0xf3, 0x9d, 0x1f, 0x9e, 0x73, 0xe6, 0x9b, 0x1f, 0x41, 0x87, 0x8a, 0x70, 0x4b, 0xa4, 0x89,
+	0x4c, 0x48, 0x8d, 0x8a, 0x70, 0xfd, 0xc1, 0x24, 0x94, 0xa7, 0xb3, 0xf1, 0x96, 0x9f, 0xc4, 0xdb,
+	0x93, 0x64, 0x92, 0x6c, 0xa3, 0x6d, 0x3c, 0x3b, 0x41, 0x0d, 0x15, 0x94, 0x74, 0xcc, 0xfa, 0x8a,
+	0x0c, 0x63, 0x96, 0x49, 0x1a, 0x8b, 0x1c, 0x00, 0x11, 0x51, 0xae, 0x65, 0xe7, 0x9f, 0x06, 0x34,
+	0xdf, 0x32, 0x5f, 0x26, 0x29, 0x21, 0x50, 0x0f, 0xa8, 0xa4, 0xb6, 0x31, 0x30, 0x36, 0xbb, 0x2e,
+	0xca, 0x64, 0x03, 0xea, 0x72, 0x2e, 0x98, 0x5d, 0x1d, 0x18, 0x9b, 0xe6, 0x0e, 0x6c, 0x61, 0xe4,
+	0xf1, 0x5c, 0x30, 0x17, 0x71, 0xb2, 0x0e, 0x6d, 0x3e, 0x8b, 0x22, 0x3a, 0x8e, 0x98, 0x5d, 0x1b,
+	0x18, 0x9b, 0x6d, 0x77, 0xa1, 0x13, 0x0b, 0x6a, 0x3c, 0x13, 0x76, 0x1d, 0xd3, 0x29, 0x91, 0xdc,
+	0x82, 0x76, 0x98, 0x79, 0x7e, 0xc2, 0x33, 0x69, 0x37, 0xd0, 0xbb, 0x15, 0x66, 0x43, 0xa5, 0x2a,
+	0xe7, 0x88, 0x71, 0xbb, 0x39, 0x30, 0x36, 0x7b, 0xae, 0x12, 0x55, 0x39, 0x34, 0x65, 0xd4, 0x6e,
+	0xe9, 0x72, 0x94, 0xec, 0x3c, 0x85, 0xc6, 0x2e, 0x95, 0xfe, 0x29, 0x59, 0x83, 0x06, 0x95, 0x32,
+	0xcd, 0x6c, 0x63, 0x50, 0xdb, 0xec, 0xb8, 0x5a, 0x21, 0x77, 0xa0, 0x7e, 0xce, 0xfc, 0xcc, 0xae,
+	0x0e, 0x6a, 0x9b, 0xe6, 0x8e, 0xb9, 0xa5, 0xe6, 0xa6, 0x9b, 0x73, 0xd1, 0xe0, 0xbc, 0x85, 0xd6,
+	0xb1, 0xaa, 0x6d, 0xb4, 0x47, 0x6e, 0x40, 0x23, 0x18, 0x7b, 0x61, 0x80, 0xed, 0xd6, 0xdd, 0x7a,
+	0x30, 0x1e, 0x05, 0x0a, 0x94, 0x08, 0x56, 0x35, 0x28, 0x15, 0xf8, 0x53, 0xe8, 0x0a, 0x9a, 0xca,
+	0x50, 0x86, 0x09, 0x57, 0xb6, 0x1a, 0xda, 0xcc, 0x05, 0x36, 0x0a, 0x9c, 0xbf, 0x19, 0xd0, 0x7f,
+	0x3d, 0xe7, 0xfe, 0xcb, 0x64, 0x72, 0x4c, 0xc3, 0xc8, 0x65, 0x67, 0xe4, 0x01, 0xb4, 0x7c, 0xee,
+	0x9d, 0xd2, 0x73, 0x86, 0x5f, 0x30, 0x77, 0xd6, 0xb6, 0x96, 0xeb, 0x70, 0x5c, 0x48, 0x6e, 0xd3,
+	0xe7, 0xfb, 0xf4, 0x9c, 0xe5, 0xee, 0x1f, 0x28, 0x97, 0x76, 0xf5, 0xf3, 0xee, 0xef, 0x28, 0x97,
+	0xc4, 0x81, 0x86, 0x5c, 0x0c, 0xdd, 0xdc, 0xe9, 0x62, 0xab, 0x79, 0x6b, 0xae, 0x36, 0x39, 0x7f,
+	0x84, 0x95, 0x4b, 0x35, 0x65, 0x42, 0xb5, 0xe2, 0x4f, 0x85, 0x17, 0x25, 0x3e, 0x55, 0x95, 0x63,
+	0x65, 0x1d, 0xd7, 0xf4, 0xa7, 0xe2, 0x65, 0x0e, 0x91, 0x7b, 0xd0, 0xf6, 0x93, 0x38, 0xa6, 0x3c,
+	0x28, 0xe6, 0x08, 0x98, 0xfc, 0x05, 0x97, 0xe9, 0xdc, 0x5d, 0xd8, 0x9c, 0xa7, 0xb0, 0x7a, 0x94,
+	0x32, 0xa5, 0x86, 0xf2, 0x5d, 0x1a, 0x4a, 0x36, 0x8c, 0x03, 0xf2, 0x4b, 0x00, 0xa6, 0xfc, 0xbc,
+	0x28, 0xcc, 0xa4, 0x6d, 0x7c, 0x12, 0xde, 0x41, 0xeb, 0xcb, 0x30, 0x93, 0xce, 0xbf, 0xab, 0xd0,
+	0x40, 0x90, 0x3c, 0x2c, 0x82, 0x90, 0x69, 0xaa, 0xa4, 0xfe, 0xce, 0xda, 0x32, 0x48, 0xff, 0x22,
+	0xe7, 0x3a, 0xac, 0x10, 0x15, 0x95, 0xb0, 0xcb, 0xe5, 0x62, 0xb5, 0x50, 0x1f, 0x05, 0xe4, 0x0e,
+	0x98, 0x8a, 0xbb, 0x63, 0x9a, 0xb1, 0xe5, 0x72, 0x41, 0x01, 0x8d, 0x02, 0xf2, 0x13, 0x00, 0x1d,
+	0xcb, 0x69, 0xcc, 0x90, 0x9f, 0x1d, 0xb7, 0x83, 0xc8, 0x2b, 0x1a, 0x33, 0x72, 0x17, 0x7a, 0x8b,
+	0x78, 0xf4, 0x68, 0xa0, 0x47, 0xb7, 0x00, 0xd1, 0xe9, 0x36, 0x74, 0x4e, 0xc2, 0x22, 0x45, 0x13,
+	0x1d, 0xda, 0x0a, 0x40, 0xe3, 0x8f, 0xa1, 0x36, 0xa6, 0x12, 0x99, 0x5b, 0xf4, 0x8f, 0xb4, 0x75,
+	0x15, 0x4c, 0xee, 0x42, 0x5f, 0x4c, 0x3d, 0xff, 0x94, 0xf9, 0x53, 0x6f, 0x3c, 0xf7, 0x02, 0x6e,
+	0xb7, 0x07, 0xc6, 0x66, 0xc3, 0x35, 0xc5, 0x74, 0xa8, 0xc0, 0xdd, 0xf9, 0x1e, 0x77, 0xb6, 0xa1,
+	0xb3, 0xe8, 0x9b, 0x00, 0x34, 0x47, 0x3c, 0x63, 0xa9, 0xb4, 0x2a, 0x4a, 0xde, 0x63, 0x11, 0x93,
+	0xcc, 0x32, 0x94, 0xfc, 0x46, 0x04, 0x54, 0x32, 0xab, 0xea, 0xfc, 0xd9, 0x00, 0xc0, 0x70, 0x91,
+	0x84, 0x5c, 0x92, 0x5f, 0x41, 0x33, 0x0e, 0xb9, 0x27, 0xb3, 0xcf, 0xb2, 0xaf, 0x11, 0x87, 0xfc,
+	0x38, 0x43, 0x67, 0x7a, 0xa1, 0x9c, 0xab, 0x9f, 0x75, 0xa6, 0x17, 0xc7, 0x59, 0xd1, 0x5c, 0xed,
+	0xda, 0xe6, 0x74, 0x19, 0x54, 0xd2, 0x28, 0x99, 0x0c, 0xa7, 0xe2, 0x8b, 0x95, 0xf1, 0x17, 0x03,
+	0xcc, 0x03, 0x26, 0xa9, 0x5a, 0xb3, 0x2f, 0x59, 0xc7, 0x63, 0x58, 0x7b, 0x1e, 0x49, 0x96, 0xe2,
+	0xd6, 0xc4, 0x93, 0x2e, 0xa5, 0x6a, 0x79, 0x06, 0x60, 0xfa, 0x0b, 0x2d, 0xcb, 0x8f, 0xdc, 0x32,
+	0xe4, 0x3c, 0x80, 0xd5, 0x72, 0x64, 0x1c, 0x33, 0x2e, 0x89, 0x0d, 0x2d, 0x5f, 0x8b, 0xf9, 0xd6,
+	0x2d, 0x54, 0xe7, 0x00, 0x7e, 0xb4, 0x74, 0x77, 0x99, 0xa2, 0x25, 0x8a, 0x6a, 0xa3, 0x24, 0x51,
+	0xa0, 0x79, 0x9a, 0xc7, 0x24, 0x51, 0x80, 0x34, 0xbd, 0x05, 0x6d, 0xce, 0x3e, 0x68, 0x53, 0x55,
+	0x9b, 0x38, 0xfb, 0xa0, 0x4c, 0x4e, 0x00, 0x37, 0x96, 0xe9, 0x9e, 0x07, 0xc1, 0x30, 0x89, 0x66,
+	0x31, 0x27, 0x3f, 0x83, 0xa6, 0x8f, 0x52, 0x3e, 0xc6, 0xae, 0xbe, 0x10, 0x86, 0x49, 0xb4, 0xc7,
+	0x4e, 0xdc, 0xdc, 0x46, 0x7e, 0x01, 0x2b, 0x21, 0xd2, 0xd5, 0x13, 0x49, 0x86, 0x47, 0x24, 0xa6,
+	0x6f, 0xb8, 0x7d, 0x0d, 0x1f, 0xe5, 0xa8, 0xf3, 0xbe, 0x3c, 0x9d, 0xbd, 0x34, 0x11, 0xf9, 0x67,
+	0xee, 0x80, 0x19, 0x25, 0x93, 0xd0, 0xa7, 0x91, 0x17, 0x06, 0x17, 0xf8, 0xad, 0x9e, 0x0b, 0x39,
+	0x34, 0x0a, 0x2e, 0xd4, 0x39, 0x96, 0xb1, 0xb3, 0x19, 0xe3, 0x3e, 0xf3, 0xf8, 0x2c, 0xc6, 0xf4,
+	0x3d, 0xd7, 0x2c, 0xb0, 0x57, 0xb3, 0xd8, 0x39, 0x83, 0x9b, 0x57, 0x07, 0x92, 0x67, 0xff, 0x41,
+	0x13, 0xf9, 0xe4, 0x93, 0xb5, 0x4f, 0x3f, 0xf9, 0x57, 0xa3, 0xfc, 0xcd, 0x83, 0x24, 0x08, 0x4f,
+	0xe6, 0xdf, 0x6b, 0x70, 0xdf, 0xdd, 0xd6, 0x75, 0xb3, 0xad, 0x5d, 0x3b, 0xdb, 0x27, 0xe5, 0x15,
+	0x3c, 0x2a, 0xee, 0x2a, 0xf2, 0x73, 0xe8, 0x97, 0x2e, 0x33, 0x7e, 0x92, 0xe4, 0x23, 0xe8, 0x2d,
+	0xaf, 0x33, 0x7e, 0x92, 0x38, 0xdf, 0xd4, 0xa1, 0x57, 0x1e, 0xdf, 0xd9, 0xa5, 0x03, 0xd7, 0xb8,
+	0x7c, 0xe0, 0x2e, 0xae, 0xd2, 0x6a, 0xe9, 0x2a, 0x75, 0xa0, 0x3e, 0x0d, 0xb9, 0x3e, 0x7e, 0xfb,
+	0x3b, 0x7d, 0xdc, 0x18, 0x98, 0xf1, 0x0f, 0x21, 0x0f, 0x5c, 0xb4, 0x91, 0xdf, 0x02, 0xd0, 0x20,
+	0xf0, 0xf2, 0xc9, 0xd4, 0x71, 0x32, 0xf6, 0xd2, 0xf3, 0x32, 0xf9, 0xf6, 0x2b, 0x6e, 0x87, 0x16,
+	0x0a, 0x79, 0x02, 0x66, 0x90, 0x26, 0xa2, 0x88, 0x6d, 0x60, 0xec, 0xad, 0x2b, 0xb1, 0x4b, 0x4a,
+	0xed, 0x57, 0x5c, 0x08, 0x16, 0x1a, 0x79, 0x06, 0xdd, 0x14, 0x29, 0xe1, 0xe9, 0x5b, 0xb4, 0x89,
+	0xe1, 0xeb, 0x57, 0xc2, 0x4b, 0xdb, 0x68, 0xbf, 0xe2, 0x9a, 0xe9, 0x52, 0x25, 0xcf, 0xa0, 0x3f,
+	0xc3, 0x93, 0xd7, 0x2b, 0xf6, 0xa3, 0x3e, 0xec, 0x6f, 0x5e, 0x49, 0x91, 0x6f, 0xdc, 0xfd, 0x8a,
+	0xdb, 0xd3, 0xfe, 0x39, 0xa0, 0xea, 0x2f, 0x12, 0x64, 0x32, 0xb5, 0xdb, 0xd7, 0xd6, 0xbf, 0x3c,
+	0x30, 0x54, 0xfd, 0x79, 0x82, 0x4c, 0xa6, 0x64, 0x17, 0x7a, 0x79, 0xfd, 0x79, 0xff, 0x1d, 0x8c,
+	0xbf, 0x7d, 0x6d, 0x03, 0x8b, 0x09, 0x74, 0xd3, 0x92, 0xae, 0x72, 0xc4, 0x48, 0xd1, 0x22, 0x07,
+	0x5c, 0x9b, 0xa3, 0x4c, 0x63, 0x95, 0x23, 0x2e, 0xe9, 0xe4, 0x05, 0x58, 0x79, 0x17, 0x0b, 0xfa,
+	0xd8, 0xe6, 0xb5, 0xcb, 0xb8, 0x60, 0xe0, 0x7e, 0xc5, 0x5d, 0xd1, 0x31, 0x0b, 0x68, 0xd7, 0x84,
+	0x4e, 0x22, 0x58, 0x8a, 0x0f, 0x10, 0xe7, 0x4f, 0x06, 0x98, 0xaf, 0xfd, 0x53, 0x16, 0xd3, 0x17,
+	0x17, 0x32, 0xa5, 0xe4, 0x1e, 0xac, 0x70, 0x76, 0x21, 0x55, 0x95, 0x5e, 0xc6, 0xce, 0xd4, 0xbe,
+	0xd0, 0x07, 0x42, 0x4f, 0xc1, 0xc3, 0x24, 0x7a, 0x8d, 0x20, 0x5e, 0xdb, 0x69, 0x22, 0x04, 0x0b,
+	0x3c, 0xfd, 0x34, 0xac, 0xe2, 0xd3, 0xb0, 0x9b, 0x83, 0xcf, 0x15, 0xa6, 0xe8, 0xaf, 0xbb, 0xf5,
+	0xfc, 0x53, 0xca, 0x27, 0x2c, 0xc8, 0x5f, 0xad, 0x3d, 0x8d, 0x0e, 0x35, 0xe8, 0x04, 0xd0, 0x1e,
+	0x71, 0xf9, 0x9b, 0x47, 0x07, 0x54, 0x10, 0x07, 0x8c, 0x38, 0x7f, 0xca, 0xe8, 0x57, 0x49, 0x61,
+	0xd9, 0x3a, 0xd0, 0x8f, 0x1a, 0x23, 0x5e, 0x7f, 0x04, 0x4d, 0xad, 0xa8, 0x77, 0xec, 0x94, 0xcd,
+	0xb1, 0xc2, 0x9a, 0xab, 0x44, 0xf5, 0x54, 0x3d, 0xa7, 0xd1, 0x4c, 0x1f, 0x28, 0x35, 0x57, 0x2b,
+	0xbf, 0xab, 0x3e, 0x36, 0xee, 0xef, 0x41, 0xf3, 0x50, 0x0c, 0x93, 0x80, 0x91, 0x16, 0xd4, 0x5e,
+	0x25, 0xc2, 0xaa, 0x90, 0x55, 0xe8, 0x1e, 0x8a, 0xdf, 0x33, 0x99, 0x3f, 0xda, 0xac, 0xff, 0xb7,
+	0x48, 0x17, 0x5a, 0x87, 0x02, 0x5f, 0x58, 0xd6, 0x57, 0x2d, 0x62, 0x81, 0x79, 0x28, 0x8e, 0x52,
+	0xe4, 0x51, 0x28, 0xad, 0xaf, 0x5b, 0xf7, 0xff, 0x61, 0x40, 0x67, 0xb1, 0xb1, 0x88, 0x09, 0xad,
+	0x11, 0x3f, 0xa7, 0x51, 0x18, 0x58, 0x15, 0xd2, 0x83, 0xce, 0x62, 0xfb, 0x58, 0x06, 0xe9, 0x03,
+	0x2c, 0x77, 0x84, 0x55, 0x25, 0x2b, 0x60, 0x96, 0x28, 0x6e, 0xd5, 0xc8, 0x2a, 0xf4, 0xde, 0x94,
+	0x59, 0x6a, 0xd5, 0xc9, 0x1a, 0x58, 0x05, 0x54, 0x70, 0xd1, 0x6a, 0x10, 0x0b, 0xba, 0x65, 0x6e,
+	0x59, 0x4d, 0x85, 0x94, 0x99, 0x62, 0xb5, 0xc8, 0x0d, 0x58, 0x79, 0x73, 0x79, 0x9d, 0xad, 0xf6,
+	0xee, 0xd3, 0x7f, 0x7d, 0xdc, 0x30, 0xfe, 0xf3, 0x71, 0xc3, 0xf8, 0xef, 0xc7, 0x8d, 0xca, 0xdf,
+	0xff, 0xb7, 0x61, 0xbc, 0xff, 0x75, 0xe9, 0xcf, 0x4c, 0x4c, 0x65, 0x1a, 0x5e, 0x24, 0x69, 0x38,
+	0x09, 0x79, 0xa1, 0x70, 0xb6, 0x2d, 0xa6, 0x93, 0x6d, 0x31, 0xde, 0xa6, 0x22, 0x1c, 0x37, 0xf1,
+	0x5f, 0xcb, 0xc3, 0x6f, 0x07, 0x00, 0x5a, 0x12, 0x1d, 0xe2, 0x13, 0x0d, 0x00, 0x00,
 }
 
 func (m *Vector) Marshal() (dAtA []byte, err error) {
@@ -2243,6 +2475,135 @@ func (m *AlterTableDropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTableRenameColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableRenameColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableRenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SequenceNum != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SequenceNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableModifyColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InsertPosition != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.InsertPosition))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SequenceNum != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SequenceNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Column != nil {
+		{
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTablePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTablePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTablePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartitionInfo) > 0 {
+		i -= len(m.PartitionInfo)
+		copy(dAtA[i:], m.PartitionInfo)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.PartitionInfo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableReq) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2399,10 +2760,73 @@ func (m *AlterTableReq_UpdateCstr) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableReq_RenameColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_RenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameColumn != nil {
+		{
+			size, err := m.RenameColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_ModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_ModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyColumn != nil {
+		{
+			size, err := m.ModifyColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableReq_UpdatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_UpdatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePartition != nil {
+		{
+			size, err := m.UpdatePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2850,6 +3274,67 @@ func (m *AlterTableDropColumn) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTableRenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SequenceNum != 0 {
+		n += 1 + sovApi(uint64(m.SequenceNum))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SequenceNum != 0 {
+		n += 1 + sovApi(uint64(m.SequenceNum))
+	}
+	if m.InsertPosition != 0 {
+		n += 1 + sovApi(uint64(m.InsertPosition))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTablePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PartitionInfo)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableReq) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2934,6 +3419,42 @@ func (m *AlterTableReq_UpdateCstr) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTableReq_RenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameColumn != nil {
+		l = m.RenameColumn.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_ModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifyColumn != nil {
+		l = m.ModifyColumn.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableReq_UpdatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePartition != nil {
+		l = m.UpdatePartition.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
 func (m *SchemaExtra) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -5032,7 +5553,7 @@ func (m *AlterTableDropColumn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
+func (m *AlterTableRenameColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5055,17 +5576,17 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableRenameColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableRenameColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
-			m.TableId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -5075,16 +5596,29 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TableId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
-			m.DbId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -5094,16 +5628,29 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DbId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
 			}
-			m.Kind = 0
+			m.SequenceNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -5113,14 +5660,65 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Kind |= AlterKind(b&0x7F) << shift
+				m.SequenceNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableModifyColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableModifyColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableModifyColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5147,17 +5745,18 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterTableAddColumn{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Column == nil {
+				m.Column = &plan.ColDef{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Operation = &AlterTableReq_AddColumn{v}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropColumn", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNum", wireType)
 			}
-			var msglen int
+			m.SequenceNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApi
@@ -5167,12 +5766,276 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SequenceNum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsertPosition", wireType)
+			}
+			m.InsertPosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InsertPosition |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTablePartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTablePartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTablePartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbId", wireType)
+			}
+			m.DbId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DbId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= AlterKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableAddColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_AddColumn{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApi
 			}
 			postIndex := iNdEx + msglen
@@ -5293,6 +6156,111 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &AlterTableReq_UpdateCstr{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableRenameColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_RenameColumn{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableModifyColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_ModifyColumn{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTablePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_UpdatePartition{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -28,6 +28,9 @@ const (
 	// HeaderSize is the size of the header for each logservice and
 	// hakeeper command.
 	HeaderSize = 4
+	// ReadOnlyLabelKey is the label key of the read-only CN stores. The label is
+	// maintained by HAKeeper according to the heartbeats, and can not be set by users.
+	ReadOnlyLabelKey = "read-only"
 )
 
 // ResizePayload resizes the payload length to length bytes.
@@ -82,6 +85,8 @@ func (s *CNState) Update(hb CNStoreHeartbeat, tick uint64) {
 	storeInfo.CtlAddress = hb.CtlAddress
 	storeInfo.Role = hb.Role
 	storeInfo.TaskServiceCreated = hb.TaskServiceCreated
+	storeInfo.ReadOnly = hb.ReadOnly
+	storeInfo.Labels = setReadOnlyLabel(storeInfo.Labels, hb.ReadOnly)
 	s.Stores[hb.UUID] = storeInfo
 }
 
@@ -93,10 +98,28 @@ func (s *CNState) UpdateLabel(label CNStoreLabel) {
 	if !ok {
 		return
 	}
-	storeInfo.Labels = label.Labels
+	storeInfo.Labels = setReadOnlyLabel(label.Labels, storeInfo.ReadOnly)
 	s.Stores[label.UUID] = storeInfo
 }
 
+// setReadOnlyLabel adds or removes the ReadOnlyLabelKey label. The labels are
+// copied if they need to be changed.
+func setReadOnlyLabel(labels map[string]metadata.LabelList, readOnly bool) map[string]metadata.LabelList {
+	if _, ok := labels[ReadOnlyLabelKey]; ok == readOnly {
+		return labels
+	}
+	newLabels := make(map[string]metadata.LabelList, len(labels)+1)
+	for k, v := range labels {
+		if k != ReadOnlyLabelKey {
+			newLabels[k] = v
+		}
+	}
+	if readOnly {
+		newLabels[ReadOnlyLabelKey] = metadata.LabelList{Labels: []string{"true"}}
+	}
+	return newLabels
+}
+
 // NewDNState creates a new DNState.
 func NewDNState() DNState {
 	return DNState{
@@ -32,6 +32,7 @@ const (
 	TruncateLSNUpdate   UpdateType = 1
 	UserEntryUpdate     UpdateType = 2
 	TSOUpdate           UpdateType = 3
+	ConsumerLSNUpdate   UpdateType = 4
 )
 
 var UpdateType_name = map[int32]string{
@@ -39,6 +40,7 @@ var UpdateType_name = map[int32]string{
 	1: "TruncateLSNUpdate",
 	2: "UserEntryUpdate",
 	3: "TSOUpdate",
+	4: "ConsumerLSNUpdate",
 }
 
 var UpdateType_value = map[string]int32{
@@ -46,6 +48,7 @@ var UpdateType_value = map[string]int32{
 	"TruncateLSNUpdate":   1,
 	"UserEntryUpdate":     2,
 	"TSOUpdate":           3,
+	"ConsumerLSNUpdate":   4,
 }
 
 func (x UpdateType) String() string {
@@ -100,6 +103,8 @@ const (
 	CN_ALLOCATE_ID      MethodType = 13
 	GET_CLUSTER_STATE   MethodType = 14
 	UPDATE_CN_LABEL     MethodType = 15
+	SET_CONSUMER_LSN    MethodType = 16
+	GET_CONSUMER_LSN    MethodType = 17
 )
 
 var MethodType_name = map[int32]string{
@@ -119,6 +124,8 @@ var MethodType_name = map[int32]string{
 	13: "CN_ALLOCATE_ID",
 	14: "GET_CLUSTER_STATE",
 	15: "UPDATE_CN_LABEL",
+	16: "SET_CONSUMER_LSN",
+	17: "GET_CONSUMER_LSN",
 }
 
 var MethodType_value = map[string]int32{
@@ -138,6 +145,8 @@ var MethodType_value = map[string]int32{
 	"CN_ALLOCATE_ID":      13,
 	"GET_CLUSTER_STATE":   14,
 	"UPDATE_CN_LABEL":     15,
+	"SET_CONSUMER_LSN":    16,
+	"GET_CONSUMER_LSN":    17,
 }
 
 func (x MethodType) String() string {
@@ -853,6 +862,7 @@ type CNStoreHeartbeat struct {
 	CtlAddress           string          `protobuf:"bytes,5,opt,name=CtlAddress,proto3" json:"CtlAddress,omitempty"`
 	Role                 metadata.CNRole `protobuf:"varint,6,opt,name=Role,proto3,enum=metadata.CNRole" json:"Role,omitempty"`
 	TaskServiceCreated   bool            `protobuf:"varint,7,opt,name=TaskServiceCreated,proto3" json:"TaskServiceCreated,omitempty"`
+	ReadOnly             bool            `protobuf:"varint,8,opt,name=ReadOnly,proto3" json:"ReadOnly,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -940,6 +950,13 @@ func (m *CNStoreHeartbeat) GetTaskServiceCreated() bool {
 	return false
 }
 
+func (m *CNStoreHeartbeat) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
 // CNAllocateID is the periodic message sent tp the HAKeeper by CN stores.
 type CNAllocateID struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -1258,6 +1275,8 @@ type RSMState struct {
 	LeaseHolderID        uint64            `protobuf:"varint,3,opt,name=LeaseHolderID,proto3" json:"LeaseHolderID,omitempty"`
 	TruncatedLsn         uint64            `protobuf:"varint,4,opt,name=TruncatedLsn,proto3" json:"TruncatedLsn,omitempty"`
 	LeaseHistory         map[uint64]uint64 `protobuf:"bytes,5,rep,name=LeaseHistory,proto3" json:"LeaseHistory,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ConsumerLsn          map[string]uint64 `protobuf:"bytes,6,rep,name=ConsumerLsn,proto3" json:"ConsumerLsn,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ConsumerLease        map[string]int64  `protobuf:"bytes,7,rep,name=ConsumerLease,proto3" json:"ConsumerLease,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1331,6 +1350,20 @@ func (m *RSMState) GetLeaseHistory() map[uint64]uint64 {
 	return nil
 }
 
+func (m *RSMState) GetConsumerLsn() map[string]uint64 {
+	if m != nil {
+		return m.ConsumerLsn
+	}
+	return nil
+}
+
+func (m *RSMState) GetConsumerLease() map[string]int64 {
+	if m != nil {
+		return m.ConsumerLease
+	}
+	return nil
+}
+
 // LogRecord is what we store into the LogService.
 type LogRecord struct {
 	Lsn                  uint64     `protobuf:"varint,1,opt,name=Lsn,proto3" json:"Lsn,omitempty"`
@@ -1402,6 +1435,7 @@ type LogRequest struct {
 	MaxSize              uint64   `protobuf:"varint,4,opt,name=MaxSize,proto3" json:"MaxSize,omitempty"`
 	DNShardID            uint64   `protobuf:"varint,5,opt,name=DNShardID,proto3" json:"DNShardID,omitempty"`
 	DNID                 uint64   `protobuf:"varint,6,opt,name=DNID,proto3" json:"DNID,omitempty"`
+	Consumer             string   `protobuf:"bytes,7,opt,name=Consumer,proto3" json:"Consumer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1482,6 +1516,13 @@ func (m *LogRequest) GetDNID() uint64 {
 	return 0
 }
 
+func (m *LogRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
 type TsoRequest struct {
 	Count                uint64   `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2566,6 +2607,7 @@ type CNStoreInfo struct {
 	Role                 metadata.CNRole               `protobuf:"varint,6,opt,name=Role,proto3,enum=metadata.CNRole" json:"Role,omitempty"`
 	TaskServiceCreated   bool                          `protobuf:"varint,7,opt,name=TaskServiceCreated,proto3" json:"TaskServiceCreated,omitempty"`
 	Labels               map[string]metadata.LabelList `protobuf:"bytes,8,rep,name=Labels,proto3" json:"Labels" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ReadOnly             bool                          `protobuf:"varint,9,opt,name=ReadOnly,proto3" json:"ReadOnly,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -2660,6 +2702,13 @@ func (m *CNStoreInfo) GetLabels() map[string]metadata.LabelList {
 	return nil
 }
 
+func (m *CNStoreInfo) GetReadOnly() bool {
+	if m != nil {
+		return m.ReadOnly
+	}
+	return false
+}
+
 // CNState contains all CN details known to the HAKeeper.
 type CNState struct {
 	// Stores is keyed by CN store UUID.
@@ -3601,6 +3650,8 @@ func init() {
 	proto.RegisterType((*DNShardInfo)(nil), "logservice.DNShardInfo")
 	proto.RegisterType((*DNStoreHeartbeat)(nil), "logservice.DNStoreHeartbeat")
 	proto.RegisterType((*RSMState)(nil), "logservice.RSMState")
+	proto.RegisterMapType((map[string]int64)(nil), "logservice.RSMState.ConsumerLeaseEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "logservice.RSMState.ConsumerLsnEntry")
 	proto.RegisterMapType((map[uint64]uint64)(nil), "logservice.RSMState.LeaseHistoryEntry")
 	proto.RegisterType((*LogRecord)(nil), "logservice.LogRecord")
 	proto.RegisterType((*LogRequest)(nil), "logservice.LogRequest")
@@ -3649,194 +3700,201 @@ func init() {
 func init() { proto.RegisterFile("logservice.proto", fileDescriptor_fd1040c5381ab5a7) }
 
 var fileDescriptor_fd1040c5381ab5a7 = []byte{
-	// 2990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x1a, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x55, 0xbb, 0xa4, 0xf8, 0xf1, 0x28, 0xc9, 0xab, 0x91, 0x64, 0x33, 0x4a, 0x2a, 0xab, 0x1b, 0x37,
-	0x70, 0x98, 0x86, 0x06, 0x24, 0x24, 0x48, 0x1a, 0xc5, 0x06, 0xc5, 0xa5, 0x2d, 0xc6, 0x34, 0xe5,
-	0x0c, 0xa9, 0x1e, 0x02, 0x04, 0xea, 0x8a, 0x1c, 0x53, 0xac, 0x48, 0x2e, 0xbb, 0xbb, 0x74, 0xac,
-	0x9e, 0x7a, 0x69, 0x81, 0xa2, 0xbd, 0x14, 0xcd, 0x21, 0x28, 0x8a, 0x1e, 0x7a, 0xe9, 0xa1, 0xd7,
-	0x1c, 0xda, 0x63, 0x0f, 0x05, 0x72, 0x29, 0x90, 0x1f, 0x50, 0x04, 0x6d, 0xae, 0x3d, 0xf4, 0xd6,
-	0x73, 0x31, 0x5f, 0xbb, 0x33, 0xe4, 0x52, 0xb6, 0x13, 0x37, 0x28, 0x92, 0x13, 0x77, 0xde, 0xd7,
-	0xbe, 0x79, 0xf3, 0xbe, 0xe6, 0x2d, 0xc1, 0x1a, 0x78, 0xbd, 0x80, 0xf8, 0x0f, 0xfb, 0x1d, 0x52,
-	0x1e, 0xfb, 0x5e, 0xe8, 0x21, 0x88, 0x21, 0x9b, 0xaf, 0xf6, 0xfa, 0xe1, 0xe9, 0xe4, 0xa4, 0xdc,
-	0xf1, 0x86, 0x37, 0x7a, 0x5e, 0xcf, 0xbb, 0xc1, 0x48, 0x4e, 0x26, 0x0f, 0xd8, 0x8a, 0x2d, 0xd8,
-	0x13, 0x67, 0xdd, 0x5c, 0x19, 0x92, 0xd0, 0xed, 0xba, 0xa1, 0xcb, 0xd7, 0xf6, 0x1f, 0x53, 0x90,
-	0xad, 0x36, 0x5b, 0xa1, 0xe7, 0x13, 0x84, 0x20, 0x7d, 0x74, 0x54, 0x77, 0x8a, 0xc6, 0xb6, 0x71,
-	0x3d, 0x8f, 0xd9, 0x33, 0x7a, 0x09, 0x56, 0x5a, 0xfc, 0x4d, 0x95, 0x6e, 0xd7, 0x27, 0x41, 0x50,
-	0x34, 0x19, 0x76, 0x0a, 0x8a, 0xb6, 0x00, 0x5a, 0xef, 0x36, 0x24, 0x4d, 0x8a, 0xd1, 0x28, 0x10,
-	0x54, 0x06, 0xd4, 0xf0, 0x3a, 0x67, 0x53, 0xb2, 0xd2, 0x8c, 0x2e, 0x01, 0x43, 0xe5, 0x55, 0xc3,
-	0x81, 0xa4, 0x5b, 0xe4, 0xf2, 0x62, 0x08, 0xba, 0x06, 0x69, 0xec, 0x0d, 0x48, 0x31, 0xb3, 0x6d,
-	0x5c, 0x5f, 0xd9, 0xb1, 0xca, 0xd1, 0xb6, 0xaa, 0x4d, 0x0a, 0xc7, 0x0c, 0x4b, 0x77, 0xd4, 0xee,
-	0x77, 0xce, 0x8a, 0xd9, 0x6d, 0xe3, 0x7a, 0x1a, 0xb3, 0x67, 0xf4, 0x0a, 0x2c, 0xb6, 0x42, 0x37,
-	0x24, 0xc5, 0x1c, 0x63, 0xdd, 0x28, 0x2b, 0xe6, 0x6d, 0x7a, 0x5d, 0xc2, 0x90, 0x98, 0xd3, 0xa0,
-	0xb7, 0x21, 0xd3, 0x70, 0x4f, 0xc8, 0x20, 0x28, 0xe6, 0xb7, 0x53, 0xd7, 0x0b, 0x3b, 0x57, 0x55,
-	0x6a, 0x61, 0xb7, 0x32, 0xa7, 0xa8, 0x8d, 0x42, 0xff, 0x7c, 0x3f, 0xfd, 0xc9, 0x67, 0x57, 0x17,
-	0xb0, 0x60, 0xda, 0x6c, 0x42, 0x41, 0x41, 0x22, 0x0b, 0x52, 0x67, 0xe4, 0x5c, 0xd8, 0x97, 0x3e,
-	0xa2, 0x97, 0x61, 0xf1, 0xa1, 0x3b, 0x98, 0x10, 0x66, 0xd5, 0xc2, 0xce, 0x5a, 0xbc, 0x0f, 0xc6,
-	0xd7, 0xe8, 0x07, 0x21, 0xe6, 0x14, 0xdf, 0x33, 0xdf, 0x30, 0xec, 0xbf, 0x98, 0x90, 0x75, 0x9e,
-	0xc1, 0x69, 0x49, 0xbb, 0xa4, 0x92, 0xec, 0x92, 0x7e, 0x02, 0xbb, 0xbc, 0x06, 0x99, 0xd6, 0xa9,
-	0xeb, 0x77, 0xe9, 0xd1, 0x50, 0xbb, 0x5c, 0x51, 0xa9, 0x9d, 0x26, 0xc3, 0xd5, 0x47, 0x0f, 0x3c,
-	0x69, 0x0f, 0x4e, 0x8c, 0x76, 0x60, 0xbd, 0xe1, 0xf5, 0x42, 0xb7, 0x3f, 0xa0, 0x0a, 0x11, 0x5f,
-	0x6a, 0x99, 0x61, 0x5a, 0x26, 0xe2, 0xe6, 0x78, 0x4e, 0xf6, 0x09, 0x3d, 0x27, 0x37, 0xed, 0x39,
-	0xf6, 0x5f, 0x0d, 0xc8, 0x35, 0xbc, 0xde, 0xff, 0x81, 0x11, 0xf7, 0x20, 0x87, 0xc9, 0x78, 0xd0,
-	0xef, 0xb8, 0xd2, 0x8c, 0x9b, 0x2a, 0x7d, 0xc3, 0xeb, 0x09, 0xb4, 0x62, 0xc9, 0x88, 0xc3, 0xfe,
-	0xb7, 0x01, 0x4b, 0x74, 0x1f, 0xd2, 0xd4, 0xa8, 0x08, 0x59, 0xbe, 0xe0, 0xdb, 0x49, 0x63, 0xb9,
-	0x44, 0xfb, 0xca, 0x8b, 0x4c, 0xf6, 0xa2, 0x97, 0xa6, 0x5e, 0x14, 0x49, 0x29, 0x4b, 0x42, 0xe6,
-	0xb1, 0xf1, 0xeb, 0xd0, 0x3a, 0x2c, 0xd6, 0xc6, 0x5e, 0xe7, 0x54, 0x6c, 0x97, 0x2f, 0xd0, 0x26,
-	0xe4, 0x1a, 0xc4, 0xed, 0x12, 0xbf, 0xee, 0xb0, 0x2d, 0xa7, 0x71, 0xb4, 0x66, 0xf6, 0x21, 0xfe,
-	0x90, 0x05, 0x2f, 0xb5, 0x0f, 0xf1, 0x87, 0x9b, 0x6f, 0xc1, 0xb2, 0xf6, 0x02, 0x35, 0x24, 0xd2,
-	0x3c, 0x24, 0xd6, 0xd5, 0x90, 0xc8, 0xab, 0xde, 0xff, 0x10, 0x56, 0x74, 0x9b, 0xa0, 0xdb, 0xba,
-	0x09, 0x98, 0x98, 0xc2, 0x4e, 0x71, 0xde, 0xe6, 0xf6, 0x73, 0xd4, 0x86, 0x9f, 0x7e, 0x76, 0xd5,
-	0xc0, 0xba, 0xe9, 0x5e, 0x80, 0xbc, 0x14, 0xeb, 0xb0, 0xf7, 0xa6, 0x71, 0x0c, 0xb0, 0x3f, 0x34,
-	0xc1, 0x12, 0xb1, 0x7e, 0x40, 0x5c, 0x3f, 0x3c, 0x21, 0x6e, 0xf8, 0x35, 0x4c, 0x96, 0x65, 0x40,
-	0x6d, 0x37, 0x90, 0xb2, 0xab, 0x3e, 0x71, 0x43, 0xd2, 0x65, 0x81, 0x96, 0xc3, 0x09, 0x18, 0xfb,
-	0x75, 0x58, 0xaa, 0x36, 0x2b, 0x83, 0x81, 0xd7, 0x71, 0x43, 0x52, 0x77, 0x12, 0xb2, 0xdb, 0x3a,
-	0x2c, 0xee, 0xbb, 0x61, 0xe7, 0x54, 0x98, 0x94, 0x2f, 0xec, 0x9f, 0x9a, 0xb0, 0x2a, 0x03, 0xf0,
-	0x62, 0x7b, 0x6e, 0x43, 0x01, 0xbb, 0x0f, 0x42, 0xdd, 0x98, 0x2a, 0x28, 0xc1, 0xe2, 0xa9, 0x44,
-	0x8b, 0x5f, 0x83, 0xe5, 0x3b, 0x5e, 0x10, 0xf4, 0xc7, 0xba, 0x31, 0x75, 0xe0, 0x97, 0x0b, 0xc8,
-	0x39, 0xf6, 0xcb, 0xcc, 0xb5, 0x5f, 0x0d, 0x0a, 0x4a, 0xa6, 0xbc, 0x20, 0x7c, 0x2f, 0xf6, 0xce,
-	0x3f, 0x9b, 0x60, 0x39, 0xcf, 0xd2, 0x3b, 0xe3, 0xdc, 0x9e, 0x7a, 0x9a, 0xdc, 0x9e, 0xbc, 0xfd,
-	0xf4, 0xbc, 0xed, 0xcf, 0xad, 0x05, 0x8b, 0x4f, 0x5d, 0x0b, 0x32, 0x4f, 0x18, 0x18, 0xd9, 0x99,
-	0x5a, 0xf0, 0x73, 0x13, 0x72, 0xb8, 0x75, 0x8f, 0xa7, 0x63, 0x0b, 0x52, 0xed, 0xc0, 0x93, 0xa9,
-	0xa8, 0x1d, 0x78, 0xd4, 0x7f, 0xeb, 0xa3, 0x2e, 0x79, 0x24, 0xfd, 0x97, 0x2d, 0xa8, 0x2f, 0x35,
-	0x88, 0x1b, 0x90, 0x03, 0x6f, 0xc0, 0x13, 0x1f, 0xcf, 0x88, 0x3a, 0x10, 0xd9, 0xb0, 0xd4, 0xf6,
-	0x27, 0x23, 0x1a, 0x1b, 0xdd, 0x46, 0x30, 0x12, 0xd9, 0x51, 0x83, 0xa1, 0x77, 0x60, 0x89, 0x33,
-	0xf5, 0x83, 0xd0, 0xf3, 0xcf, 0x85, 0xcf, 0x69, 0xb9, 0x59, 0x6a, 0x57, 0x56, 0x09, 0x79, 0x6e,
-	0xd6, 0x78, 0x37, 0x6f, 0xc1, 0xea, 0x0c, 0xc9, 0xe3, 0xb2, 0x6b, 0x5a, 0xcd, 0xae, 0xef, 0x43,
-	0x9e, 0x39, 0x78, 0xc7, 0xf3, 0xbb, 0x94, 0x91, 0x2a, 0x2d, 0x18, 0xa9, 0xae, 0x25, 0x48, 0xb7,
-	0xcf, 0xc7, 0x9c, 0x6f, 0x65, 0xe7, 0xb2, 0xa6, 0x23, 0xe3, 0xa1, 0x58, 0xcc, 0x68, 0xa8, 0xf7,
-	0x39, 0x6e, 0xe8, 0x32, 0xc3, 0x2c, 0x61, 0xf6, 0x6c, 0x7f, 0x64, 0x00, 0x30, 0xf9, 0x3f, 0x9a,
-	0x90, 0x80, 0x39, 0x68, 0xd3, 0x1d, 0x12, 0xe9, 0xa0, 0xf4, 0x59, 0x8d, 0x00, 0x53, 0x8f, 0x00,
-	0xa1, 0x4e, 0x2a, 0x56, 0xa7, 0x08, 0xd9, 0x7b, 0xee, 0xa3, 0x56, 0xff, 0xc7, 0x44, 0x58, 0x56,
-	0x2e, 0x69, 0xb4, 0x48, 0x27, 0x75, 0x44, 0xed, 0x89, 0x01, 0x4c, 0xb5, 0x66, 0xdd, 0x61, 0x3e,
-	0x93, 0xc6, 0xec, 0xd9, 0xb6, 0x01, 0xda, 0x81, 0x27, 0x35, 0x5b, 0x87, 0xc5, 0xaa, 0x37, 0x19,
-	0x85, 0x62, 0xf3, 0x7c, 0x61, 0xff, 0xcb, 0xa0, 0xd9, 0x8e, 0x45, 0x19, 0xeb, 0xcc, 0x12, 0x23,
-	0x6c, 0x17, 0xf2, 0x87, 0x63, 0xe2, 0xbb, 0x61, 0xdf, 0x1b, 0x09, 0x43, 0x6d, 0xe8, 0x0d, 0x23,
-	0xe3, 0x3d, 0x1c, 0xe3, 0x98, 0x0e, 0xed, 0x47, 0x2d, 0x26, 0x0f, 0xb7, 0x6b, 0x09, 0x2d, 0x26,
-	0x23, 0xf8, 0x0a, 0xfb, 0xcc, 0x5f, 0xa6, 0x21, 0x2b, 0xed, 0xc1, 0xb2, 0x0f, 0x7b, 0x8c, 0x32,
-	0x53, 0x0c, 0x40, 0x65, 0xc8, 0xdc, 0x23, 0xe1, 0xa9, 0xd7, 0x4d, 0x72, 0x0c, 0x8e, 0x61, 0x8e,
-	0x21, 0xa8, 0xd0, 0x9e, 0xea, 0x05, 0xec, 0x40, 0x0b, 0x3a, 0x4f, 0x8c, 0x15, 0x7b, 0x54, 0xbd,
-	0xa6, 0xc2, 0xea, 0x7d, 0x94, 0xe6, 0xd8, 0xd1, 0x17, 0x76, 0xbe, 0x35, 0x5d, 0xef, 0xb5, 0x5c,
-	0x88, 0x35, 0x16, 0x74, 0x13, 0x0a, 0xd5, 0x66, 0x2c, 0x61, 0x91, 0x49, 0x78, 0x21, 0xc1, 0xe6,
-	0xb1, 0x00, 0x95, 0x81, 0xf2, 0x3b, 0x0a, 0x7f, 0x66, 0x96, 0xdf, 0x99, 0xe1, 0x57, 0x18, 0xd0,
-	0xeb, 0xaa, 0xb3, 0xb1, 0x94, 0x34, 0x65, 0x80, 0x18, 0x8b, 0x55, 0xb7, 0xdc, 0xd3, 0xab, 0x2d,
-	0x6b, 0x6c, 0xa7, 0x5a, 0x1d, 0x15, 0x8f, 0xf5, 0xda, 0xbc, 0xa7, 0x7b, 0x6f, 0x31, 0x9f, 0xc4,
-	0x1d, 0xe3, 0xb1, 0x46, 0x6d, 0xb7, 0xa0, 0xc0, 0x0e, 0x21, 0x18, 0x7b, 0xa3, 0x80, 0x5c, 0x50,
-	0xa9, 0x44, 0x9c, 0x9a, 0x5a, 0x9c, 0x36, 0xdc, 0x20, 0x8c, 0xa3, 0x57, 0x2e, 0xed, 0x32, 0x20,
-	0x45, 0x5d, 0x45, 0xf6, 0xed, 0xbe, 0xaf, 0xf8, 0x9a, 0x5c, 0xda, 0xff, 0x49, 0xd3, 0xea, 0x2c,
-	0xc8, 0x9e, 0xad, 0x53, 0xbe, 0x00, 0xf9, 0x9a, 0xef, 0x7b, 0x7e, 0xd5, 0xeb, 0x12, 0xa6, 0xe6,
-	0x32, 0x8e, 0x01, 0x34, 0x93, 0xb3, 0xc5, 0x3d, 0x12, 0x04, 0x6e, 0x8f, 0x88, 0xd6, 0x41, 0x83,
-	0xd1, 0x42, 0x53, 0x0f, 0x0e, 0x2a, 0x77, 0x09, 0x19, 0x13, 0x9f, 0x39, 0x55, 0x0e, 0x2b, 0x10,
-	0x74, 0x4b, 0xb3, 0xa0, 0xf0, 0x9a, 0x2b, 0x33, 0x7e, 0xcf, 0xd1, 0xc2, 0xf1, 0x35, 0x9b, 0xd3,
-	0x03, 0xf4, 0x86, 0x43, 0x77, 0xd4, 0xe5, 0x1d, 0x55, 0x36, 0xe1, 0x00, 0x15, 0x3c, 0xd6, 0xa8,
-	0xd1, 0x9b, 0x50, 0x60, 0xae, 0x24, 0x5e, 0x9f, 0x9b, 0x7d, 0xbd, 0x82, 0xc6, 0x2a, 0x2d, 0xda,
-	0x87, 0x95, 0xea, 0x60, 0x12, 0x84, 0xc4, 0x77, 0x08, 0x2d, 0xc8, 0x81, 0xf0, 0x1d, 0xad, 0x33,
-	0xd2, 0x29, 0xf0, 0x14, 0x07, 0xba, 0x09, 0xf9, 0xb8, 0x47, 0x07, 0xc6, 0xbe, 0xad, 0xb2, 0x47,
-	0xc8, 0x77, 0x27, 0xc4, 0x3f, 0xc7, 0x24, 0x98, 0x0c, 0x42, 0x1c, 0xb3, 0xa0, 0x9b, 0x00, 0x8a,
-	0xe7, 0x17, 0x98, 0x80, 0x2d, 0x55, 0xc0, 0xac, 0x23, 0x61, 0x98, 0xf2, 0xfe, 0x53, 0xd2, 0x39,
-	0x23, 0x3e, 0xbf, 0x9c, 0x2d, 0x25, 0x18, 0x4f, 0xc1, 0x63, 0x8d, 0xda, 0x7e, 0x87, 0xb5, 0xab,
-	0xbc, 0xc8, 0x45, 0x66, 0x79, 0x8d, 0x26, 0x48, 0x0a, 0x09, 0x8a, 0x06, 0x4b, 0xdb, 0x1b, 0x33,
-	0x87, 0x49, 0xb1, 0xe2, 0x28, 0x25, 0xad, 0xfd, 0xa2, 0x76, 0x10, 0xb4, 0xd6, 0x7c, 0x9f, 0xa5,
-	0x65, 0x51, 0x6b, 0xd8, 0xc2, 0xbe, 0x03, 0xcb, 0xb4, 0x5f, 0x6a, 0xbb, 0x27, 0x03, 0x72, 0x14,
-	0x10, 0x9f, 0xde, 0xb2, 0xe8, 0xef, 0x28, 0x2e, 0x98, 0xd1, 0x9a, 0xe2, 0xee, 0xbb, 0x41, 0xf0,
-	0x81, 0xe7, 0x77, 0x45, 0x3f, 0x17, 0xad, 0xed, 0x5f, 0x18, 0x54, 0x4b, 0xd6, 0x28, 0x26, 0xd6,
-	0xab, 0xf9, 0x05, 0x57, 0x6b, 0x39, 0x53, 0x53, 0x2d, 0x67, 0x7c, 0x17, 0x4c, 0xab, 0x77, 0xc1,
-	0x2d, 0x96, 0xda, 0xf5, 0xca, 0xab, 0x40, 0xec, 0xdf, 0x98, 0xd4, 0x87, 0x47, 0x0f, 0xfa, 0xbd,
-	0xea, 0xa9, 0x3b, 0xea, 0x11, 0xb4, 0x1b, 0x69, 0x27, 0x2e, 0x6e, 0x6b, 0x7a, 0x57, 0xc1, 0x50,
-	0xb1, 0x05, 0xf9, 0x3e, 0xf6, 0x00, 0x38, 0xbb, 0xd2, 0x8d, 0xe8, 0xe9, 0x5b, 0x79, 0x05, 0x8b,
-	0x72, 0x85, 0x1e, 0xb5, 0x61, 0xa5, 0x3e, 0xea, 0x87, 0x7d, 0x77, 0x70, 0x8f, 0x0c, 0x4f, 0x88,
-	0x2f, 0x8b, 0xee, 0x77, 0xe7, 0x49, 0x28, 0xeb, 0xe4, 0xbc, 0xf3, 0x9a, 0x92, 0xb1, 0x59, 0x81,
-	0xb5, 0x04, 0xb2, 0xa7, 0xba, 0xdb, 0xbe, 0x0c, 0xcb, 0xad, 0xd3, 0x49, 0xd8, 0xf5, 0x3e, 0x18,
-	0xf1, 0xc9, 0x04, 0x3d, 0x1b, 0xfa, 0x10, 0x1d, 0x99, 0x5c, 0xda, 0xbf, 0x4e, 0xc1, 0xa5, 0x56,
-	0xe7, 0x94, 0x74, 0x27, 0x03, 0x22, 0xa2, 0x3c, 0xf1, 0x74, 0xaf, 0xc1, 0xf2, 0xbe, 0xe7, 0x85,
-	0x41, 0xe8, 0xbb, 0xe3, 0x71, 0x7f, 0xd4, 0x63, 0x2f, 0xcd, 0x61, 0x1d, 0x48, 0x53, 0x83, 0x68,
-	0x9a, 0x99, 0x41, 0x53, 0xcc, 0xa0, 0x5a, 0x6a, 0x50, 0xd0, 0x58, 0xa5, 0xe5, 0x39, 0x29, 0x36,
-	0x95, 0xa8, 0xc6, 0xc5, 0x79, 0xa6, 0xc4, 0xfa, 0xe9, 0xdf, 0x9a, 0xda, 0xb1, 0x28, 0xc5, 0xcf,
-	0xe9, 0x89, 0x41, 0x21, 0xc0, 0x53, 0x16, 0xba, 0x0b, 0xab, 0xfc, 0x2e, 0xa1, 0x5c, 0x2e, 0x44,
-	0x66, 0xd5, 0x3a, 0x82, 0x19, 0x22, 0x3c, 0xcb, 0x47, 0xb5, 0x71, 0xc8, 0x80, 0x84, 0x44, 0x14,
-	0x3e, 0x91, 0x60, 0x35, 0x6d, 0x34, 0x02, 0xac, 0xd3, 0xdb, 0x83, 0x04, 0x6d, 0xd0, 0x2e, 0xa4,
-	0x69, 0xa0, 0x0a, 0xf7, 0xd6, 0x84, 0x69, 0x11, 0x2e, 0x9c, 0x9c, 0x11, 0xb3, 0x9b, 0x83, 0x1b,
-	0x9c, 0xd1, 0xae, 0xf9, 0xc4, 0x0d, 0xa4, 0xaf, 0x68, 0x30, 0xea, 0x2e, 0xda, 0xeb, 0x2f, 0x70,
-	0x17, 0x57, 0xaf, 0x1c, 0xd1, 0x58, 0xc6, 0x88, 0xc7, 0x32, 0xe8, 0x6d, 0xc8, 0x09, 0x1a, 0x39,
-	0x20, 0x7a, 0x5e, 0x3b, 0x06, 0xdd, 0xdb, 0xe4, 0xcd, 0x57, 0xb2, 0xd8, 0xbf, 0x4f, 0xd1, 0xa6,
-	0x8a, 0xbf, 0x90, 0xe6, 0x6b, 0x39, 0x19, 0x33, 0x94, 0xc9, 0xd8, 0x37, 0x6a, 0x36, 0x82, 0x2a,
-	0x51, 0x53, 0x9f, 0x63, 0xe6, 0x7c, 0x31, 0xa1, 0xd3, 0x62, 0xe3, 0xb6, 0xaf, 0xae, 0xa7, 0xff,
-	0xad, 0xc1, 0x27, 0xfd, 0x62, 0xac, 0xcd, 0x54, 0x90, 0xc5, 0x6b, 0x66, 0xac, 0x4d, 0x6f, 0x9c,
-	0x9c, 0x42, 0x53, 0x8d, 0x83, 0x36, 0x31, 0x14, 0x14, 0x64, 0x82, 0x6a, 0xaf, 0xea, 0xaa, 0x5d,
-	0x99, 0xb3, 0x7b, 0x55, 0xbd, 0x8f, 0x4d, 0x36, 0x0e, 0x79, 0x26, 0x3e, 0xf4, 0x0d, 0x9a, 0x60,
-	0xd0, 0x53, 0x75, 0x9e, 0xe4, 0x54, 0x9d, 0xff, 0xed, 0xa9, 0x3a, 0xc9, 0xa7, 0xfa, 0x27, 0x63,
-	0xba, 0x7d, 0x44, 0xaf, 0x41, 0x4e, 0x10, 0x4b, 0x3d, 0xd7, 0x12, 0x04, 0xc9, 0x1c, 0x23, 0x49,
-	0x29, 0x5b, 0x55, 0xb2, 0x99, 0xb3, 0x6c, 0x55, 0x9d, 0x4d, 0x92, 0xa2, 0x37, 0xd8, 0x54, 0x43,
-	0xf0, 0x71, 0x6f, 0x58, 0x4f, 0xba, 0x2e, 0x0a, 0xc6, 0x98, 0xd8, 0xfe, 0x99, 0x01, 0x05, 0xa1,
-	0x3a, 0x73, 0xc8, 0x37, 0x99, 0xde, 0xdc, 0xad, 0x0c, 0xe1, 0x56, 0x51, 0xc4, 0x09, 0x8c, 0xd6,
-	0xf4, 0x45, 0xe4, 0x68, 0x8f, 0x2b, 0xc1, 0x79, 0xb9, 0xf2, 0x45, 0x25, 0x5a, 0x05, 0x4a, 0x63,
-	0x8e, 0x19, 0xec, 0x5f, 0x19, 0xb0, 0x21, 0xda, 0x0b, 0xa1, 0x8f, 0xbc, 0x13, 0xbe, 0x04, 0x2b,
-	0xcd, 0xc9, 0xf0, 0xf0, 0x41, 0x2c, 0x9c, 0x47, 0xcb, 0x14, 0x94, 0x76, 0x02, 0x0c, 0x12, 0xe9,
-	0xcf, 0xbb, 0x3d, 0x1d, 0x88, 0x4a, 0x60, 0x49, 0xbe, 0x68, 0x0a, 0xca, 0x5b, 0xbf, 0x19, 0xb8,
-	0xfd, 0x13, 0x93, 0x4f, 0xde, 0x2f, 0x0c, 0xd7, 0xaf, 0xf7, 0xf8, 0xf6, 0x63, 0x53, 0x7c, 0x47,
-	0xa2, 0xa1, 0x77, 0x33, 0xca, 0x38, 0xdc, 0x35, 0xb6, 0x67, 0x7c, 0x8c, 0xc5, 0x1e, 0x23, 0xd1,
-	0x63, 0x8f, 0xdb, 0xfe, 0x66, 0x14, 0xba, 0xe6, 0x45, 0xfc, 0x73, 0x63, 0xb7, 0x05, 0x05, 0x45,
-	0x78, 0x42, 0xe7, 0x59, 0xd6, 0x63, 0x77, 0xee, 0x27, 0x12, 0x25, 0x78, 0x99, 0xd0, 0x0b, 0x13,
-	0xc2, 0xe3, 0x84, 0x26, 0x65, 0x84, 0xbf, 0xa5, 0xf4, 0xcb, 0x58, 0xa2, 0xe7, 0xdc, 0xd2, 0x42,
-	0x2f, 0xb1, 0x8a, 0xc4, 0x68, 0x79, 0x5d, 0x56, 0x83, 0x75, 0x37, 0xca, 0x8a, 0x62, 0xc6, 0xb4,
-	0x96, 0x90, 0x0b, 0xe5, 0xd5, 0x42, 0xe6, 0xcf, 0xd7, 0xe3, 0x03, 0x15, 0xbd, 0xec, 0x7a, 0xd2,
-	0x31, 0x48, 0xcf, 0x89, 0x0e, 0x7f, 0x37, 0x2a, 0xac, 0xa2, 0x87, 0x5d, 0x4b, 0x28, 0xa7, 0xf2,
-	0x65, 0xb2, 0x04, 0xdf, 0x90, 0x5f, 0x0a, 0x79, 0xe3, 0xa1, 0xf5, 0x86, 0x72, 0x6c, 0xa0, 0x7d,
-	0x2d, 0x6c, 0x0a, 0xff, 0x14, 0xbd, 0x98, 0xb8, 0xca, 0x66, 0x19, 0xf7, 0xd6, 0x74, 0x67, 0xa9,
-	0x53, 0xe1, 0x04, 0x4e, 0x54, 0x9b, 0xba, 0x65, 0x8a, 0xa9, 0xc0, 0x63, 0x9b, 0x54, 0x9d, 0xcb,
-	0xfe, 0x7b, 0x16, 0x2c, 0xa9, 0x6f, 0x34, 0x4a, 0x4f, 0x3a, 0xd3, 0xcb, 0x90, 0x69, 0x92, 0x47,
-	0x61, 0x74, 0xd7, 0x14, 0x2b, 0x74, 0x08, 0x05, 0xfe, 0xb4, 0x7f, 0x7e, 0x97, 0x9c, 0x8b, 0x1c,
-	0xfd, 0x6a, 0x92, 0x39, 0xa2, 0x59, 0xb8, 0x42, 0xcf, 0x2f, 0x64, 0xaa, 0x84, 0xa8, 0xc1, 0x4d,
-	0x2b, 0x0d, 0x6e, 0x64, 0xed, 0xc5, 0x2f, 0x65, 0xed, 0xcc, 0x17, 0xb6, 0x76, 0x17, 0xac, 0xa9,
-	0x2e, 0x9a, 0x56, 0x73, 0xba, 0xd5, 0x9d, 0x0b, 0xb7, 0x3a, 0xcd, 0xa4, 0x06, 0xff, 0x8c, 0x44,
-	0x54, 0x57, 0x0b, 0x0d, 0xef, 0x3c, 0x5f, 0xb9, 0x50, 0x7c, 0x44, 0xcd, 0xed, 0x18, 0x73, 0xab,
-	0x4e, 0x9d, 0x7f, 0x62, 0xa7, 0x56, 0xc2, 0x0e, 0xbe, 0x50, 0xd8, 0x15, 0x9e, 0x22, 0xec, 0xa6,
-	0x92, 0xc4, 0xd2, 0x53, 0x27, 0x89, 0x99, 0x08, 0x58, 0xfe, 0x22, 0x11, 0xb0, 0x79, 0x13, 0xac,
-	0x69, 0x87, 0x4c, 0xfe, 0x16, 0x9a, 0xfc, 0xe1, 0x65, 0xf3, 0x7d, 0xd8, 0x48, 0x3c, 0xe5, 0xa7,
-	0x4c, 0xb8, 0xda, 0xf8, 0x4f, 0x11, 0xbf, 0xc7, 0xbe, 0x9a, 0xcf, 0xa9, 0x0e, 0x8f, 0x53, 0xce,
-	0xae, 0x43, 0x41, 0xfd, 0xe0, 0xfe, 0x25, 0xbe, 0x2b, 0xda, 0xbf, 0x33, 0x61, 0x3d, 0x69, 0xd2,
-	0x77, 0xc1, 0x3c, 0xf9, 0xfe, 0xcc, 0x1f, 0x17, 0xca, 0x8f, 0x9b, 0x1b, 0xea, 0x7f, 0x60, 0x98,
-	0xa9, 0xf2, 0xcf, 0xe6, 0x6f, 0x0c, 0xed, 0xc7, 0xff, 0x8d, 0xe1, 0xa2, 0x66, 0x59, 0xb1, 0xa8,
-	0x62, 0xeb, 0xd2, 0x0f, 0x00, 0x8e, 0xc6, 0x5d, 0x37, 0xe4, 0xd3, 0x95, 0x2b, 0xb0, 0xa6, 0x7d,
-	0x51, 0xe4, 0x28, 0x6b, 0x01, 0x6d, 0xc0, 0xaa, 0xfc, 0x8a, 0xd8, 0x68, 0x35, 0x05, 0xd8, 0x40,
-	0x6b, 0x70, 0x89, 0xba, 0x23, 0xd3, 0x47, 0x00, 0x4d, 0xb4, 0x0c, 0xf9, 0x76, 0xeb, 0x50, 0x2c,
-	0x53, 0xa5, 0x32, 0xe4, 0xa3, 0x7f, 0xa1, 0xa0, 0x4b, 0x50, 0x68, 0x7a, 0xfe, 0xd0, 0x1d, 0xb0,
-	0xa5, 0xb5, 0x80, 0x2c, 0x58, 0x6a, 0xf7, 0x87, 0xc4, 0x9b, 0x84, 0x1c, 0x62, 0x94, 0xfe, 0x60,
-	0x02, 0xc4, 0xf3, 0x72, 0xb4, 0x02, 0xd0, 0x6e, 0x1d, 0x1e, 0x1f, 0xdd, 0x77, 0x2a, 0xed, 0x9a,
-	0xb5, 0x80, 0x00, 0x32, 0x95, 0xfb, 0xf7, 0x6b, 0x4d, 0xc7, 0x32, 0x50, 0x0e, 0xd2, 0xb8, 0x56,
-	0x71, 0x2c, 0x13, 0x2d, 0x41, 0xae, 0x8d, 0x8f, 0x9a, 0x55, 0x4a, 0x93, 0xa2, 0x42, 0xef, 0xd4,
-	0xda, 0xc7, 0x11, 0x24, 0x8d, 0x0a, 0x90, 0xad, 0x1e, 0x36, 0x9b, 0xb5, 0x6a, 0xdb, 0x5a, 0xa4,
-	0x22, 0xc5, 0xe2, 0x18, 0x1f, 0x5a, 0x19, 0xb4, 0x0a, 0xcb, 0x8d, 0xc3, 0x3b, 0xc7, 0x07, 0xb5,
-	0x0a, 0x6e, 0xef, 0xd7, 0x2a, 0x6d, 0x2b, 0x4b, 0x25, 0x54, 0x9b, 0x0a, 0x24, 0x47, 0x21, 0x8e,
-	0x0a, 0xc9, 0x23, 0x04, 0x2b, 0xd5, 0x83, 0x5a, 0xf5, 0xee, 0xf1, 0x41, 0xe5, 0x6e, 0xad, 0x76,
-	0xbf, 0x86, 0x2d, 0xa0, 0x06, 0xa4, 0x6f, 0xae, 0x36, 0x8e, 0x5a, 0xed, 0x1a, 0x3e, 0x76, 0x6a,
-	0xed, 0x4a, 0xbd, 0xd1, 0xb2, 0x0a, 0x94, 0x98, 0x22, 0x5a, 0x07, 0x15, 0xec, 0x1c, 0xd7, 0x9b,
-	0xb7, 0x0f, 0xad, 0x25, 0x26, 0xa0, 0x79, 0x5c, 0x69, 0x34, 0x0e, 0xa9, 0x96, 0xc7, 0x75, 0xc7,
-	0x5a, 0xa6, 0x86, 0x56, 0x05, 0xb4, 0xda, 0x54, 0xff, 0x15, 0x66, 0x68, 0x66, 0x81, 0xe3, 0x6a,
-	0xf3, 0xb8, 0x51, 0xd9, 0xaf, 0x35, 0xac, 0x4b, 0xa5, 0x26, 0x40, 0xfc, 0x19, 0x94, 0xee, 0x8a,
-	0x9e, 0x05, 0x87, 0x58, 0x0b, 0xd4, 0x24, 0xf5, 0x51, 0x48, 0xfc, 0x91, 0x3b, 0xb0, 0x0c, 0x6a,
-	0x78, 0x76, 0xb2, 0xd1, 0x29, 0xad, 0x8a, 0x2f, 0xca, 0x98, 0xfc, 0x90, 0x74, 0x42, 0xd2, 0xb5,
-	0x52, 0xa5, 0x12, 0xe4, 0xa3, 0xaf, 0x85, 0x94, 0xbd, 0x45, 0x42, 0xb6, 0xb2, 0x16, 0x28, 0x3b,
-	0x9f, 0xfd, 0x70, 0x80, 0x51, 0xfa, 0xd0, 0x04, 0x24, 0x33, 0xbb, 0xe2, 0x40, 0xf4, 0xb4, 0xfa,
-	0x9d, 0x33, 0xd5, 0x6f, 0x94, 0x0f, 0x59, 0x91, 0xdf, 0x6c, 0xc0, 0xaa, 0x33, 0x03, 0x36, 0xd1,
-	0x65, 0x7a, 0x51, 0xed, 0x4d, 0xc3, 0x53, 0xf4, 0xed, 0x77, 0x48, 0x18, 0xb9, 0x63, 0x1a, 0x3d,
-	0x37, 0x93, 0xbe, 0x04, 0x6a, 0x91, 0x1a, 0xb5, 0x45, 0xb8, 0x33, 0x09, 0x58, 0x06, 0x15, 0x61,
-	0x5d, 0xbf, 0xcc, 0x08, 0x4c, 0x16, 0x5d, 0x85, 0xe7, 0x5b, 0x24, 0x9c, 0xad, 0x9d, 0x82, 0x20,
-	0x87, 0x36, 0xe1, 0xb2, 0x20, 0x88, 0x92, 0xaf, 0xc0, 0xe5, 0xa9, 0x09, 0xf9, 0xb3, 0xb0, 0x9a,
-	0x05, 0xa5, 0x8f, 0x0c, 0x58, 0xd6, 0x6a, 0x3b, 0x3d, 0x39, 0x09, 0x10, 0x5d, 0xbc, 0xb5, 0x40,
-	0xf5, 0x97, 0x40, 0x6d, 0x32, 0x6a, 0x19, 0xe8, 0x3b, 0xf0, 0xed, 0x19, 0x94, 0x4c, 0xd1, 0x98,
-	0x74, 0x48, 0xff, 0x21, 0xe9, 0x5a, 0x26, 0x7a, 0x1e, 0xae, 0xcc, 0x90, 0xdd, 0x76, 0xfb, 0x03,
-	0x7a, 0x90, 0xea, 0x3b, 0xf1, 0x64, 0x34, 0xa2, 0x82, 0xd3, 0xa5, 0x93, 0xa4, 0xee, 0x82, 0x9a,
-	0x46, 0x83, 0xc6, 0x3a, 0x4e, 0x63, 0xa4, 0x24, 0x63, 0x06, 0xd3, 0x0a, 0xbd, 0xf1, 0x98, 0x6a,
-	0x55, 0x3a, 0x05, 0x6b, 0x7a, 0x14, 0x4e, 0x5d, 0xa2, 0xd2, 0xed, 0x8a, 0xf4, 0x63, 0x2d, 0x50,
-	0xab, 0x61, 0x32, 0xf4, 0x1e, 0x12, 0x09, 0x32, 0x68, 0x6c, 0xb5, 0x42, 0xd7, 0x0f, 0x25, 0xc4,
-	0xa4, 0x27, 0x4e, 0xa5, 0x4a, 0x40, 0x8a, 0x4a, 0xb9, 0xdb, 0x1f, 0x0c, 0xde, 0xf3, 0x86, 0x27,
-	0x7d, 0x62, 0xa5, 0x4b, 0x6f, 0x69, 0x23, 0x64, 0x8a, 0xa6, 0x05, 0x87, 0x43, 0xac, 0x05, 0x9a,
-	0x83, 0x9c, 0xa6, 0x5c, 0x1a, 0x74, 0x59, 0x8d, 0x96, 0xe6, 0x7e, 0xed, 0xd3, 0x7f, 0x6e, 0x2d,
-	0x7c, 0xf2, 0xf9, 0x96, 0xf1, 0xe9, 0xe7, 0x5b, 0xc6, 0x3f, 0x3e, 0xdf, 0x32, 0xde, 0xdb, 0x55,
-	0xfe, 0xd1, 0x3a, 0x74, 0x43, 0xbf, 0xff, 0xc8, 0xf3, 0xfb, 0xbd, 0xfe, 0x48, 0x2e, 0x46, 0xe4,
-	0xc6, 0xf8, 0xac, 0x77, 0x63, 0x7c, 0x72, 0x23, 0x4e, 0xa9, 0x27, 0x19, 0xf6, 0x77, 0xd6, 0xdd,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x05, 0x97, 0x31, 0x4a, 0x2d, 0x2b, 0x00, 0x00,
+	// 3097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x1a, 0x4d, 0x6c, 0x23, 0x57,
+	0x39, 0xe3, 0x7f, 0x7f, 0x4e, 0xb2, 0x93, 0xb7, 0xd9, 0x5d, 0x37, 0x2d, 0xd9, 0x30, 0xdd, 0x96,
+	0x6d, 0x4a, 0xbd, 0x52, 0x56, 0xad, 0x5a, 0xba, 0xdd, 0xc5, 0xf1, 0x78, 0x13, 0x77, 0x9d, 0xc9,
+	0x76, 0xc6, 0xe1, 0x50, 0xa9, 0x8a, 0x26, 0xf6, 0x5b, 0xc7, 0xc4, 0xf6, 0x98, 0x99, 0xf1, 0x76,
+	0xc3, 0x89, 0x0b, 0x5c, 0xe0, 0x82, 0xe0, 0x80, 0x10, 0xe2, 0xc2, 0x91, 0x0b, 0x87, 0x1e, 0xe0,
+	0x82, 0xc4, 0x01, 0xa9, 0x17, 0xa4, 0x4a, 0x5c, 0x51, 0x05, 0xbd, 0x72, 0xe0, 0xc6, 0x19, 0xbd,
+	0xbf, 0x99, 0xf7, 0x3c, 0xe3, 0x64, 0xd3, 0x2e, 0x08, 0xb5, 0x27, 0xcf, 0xfb, 0xfe, 0xe6, 0x9b,
+	0xef, 0xff, 0xbd, 0x67, 0xd0, 0x87, 0x5e, 0x3f, 0xc0, 0xfe, 0xe3, 0x41, 0x17, 0xd7, 0x26, 0xbe,
+	0x17, 0x7a, 0x08, 0x62, 0xc8, 0xda, 0x6b, 0xfd, 0x41, 0x78, 0x3c, 0x3d, 0xaa, 0x75, 0xbd, 0xd1,
+	0xad, 0xbe, 0xd7, 0xf7, 0x6e, 0x51, 0x92, 0xa3, 0xe9, 0x23, 0xba, 0xa2, 0x0b, 0xfa, 0xc4, 0x58,
+	0xd7, 0x96, 0x47, 0x38, 0x74, 0x7b, 0x6e, 0xe8, 0xb2, 0xb5, 0xf1, 0xdb, 0x2c, 0x14, 0x1b, 0x96,
+	0x13, 0x7a, 0x3e, 0x46, 0x08, 0x72, 0x07, 0x07, 0x2d, 0xb3, 0xaa, 0x6d, 0x68, 0x37, 0xcb, 0x36,
+	0x7d, 0x46, 0x2f, 0xc3, 0xb2, 0xc3, 0xde, 0x54, 0xef, 0xf5, 0x7c, 0x1c, 0x04, 0xd5, 0x0c, 0xc5,
+	0xce, 0x40, 0xd1, 0x3a, 0x80, 0xf3, 0x5e, 0x5b, 0xd0, 0x64, 0x29, 0x8d, 0x04, 0x41, 0x35, 0x40,
+	0x6d, 0xaf, 0x7b, 0x32, 0x23, 0x2b, 0x47, 0xe9, 0x52, 0x30, 0x44, 0x5e, 0x23, 0x1c, 0x0a, 0xba,
+	0x3c, 0x93, 0x17, 0x43, 0xd0, 0x0d, 0xc8, 0xd9, 0xde, 0x10, 0x57, 0x0b, 0x1b, 0xda, 0xcd, 0xe5,
+	0x2d, 0xbd, 0x16, 0x7d, 0x56, 0xc3, 0x22, 0x70, 0x9b, 0x62, 0xc9, 0x17, 0x75, 0x06, 0xdd, 0x93,
+	0x6a, 0x71, 0x43, 0xbb, 0x99, 0xb3, 0xe9, 0x33, 0x7a, 0x15, 0xf2, 0x4e, 0xe8, 0x86, 0xb8, 0x5a,
+	0xa2, 0xac, 0x57, 0x6a, 0x92, 0x79, 0x2d, 0xaf, 0x87, 0x29, 0xd2, 0x66, 0x34, 0xe8, 0x1d, 0x28,
+	0xb4, 0xdd, 0x23, 0x3c, 0x0c, 0xaa, 0xe5, 0x8d, 0xec, 0xcd, 0xca, 0xd6, 0x75, 0x99, 0x9a, 0xdb,
+	0xad, 0xc6, 0x28, 0x9a, 0xe3, 0xd0, 0x3f, 0xdd, 0xce, 0x7d, 0xfc, 0xe9, 0xf5, 0x05, 0x9b, 0x33,
+	0xad, 0x59, 0x50, 0x91, 0x90, 0x48, 0x87, 0xec, 0x09, 0x3e, 0xe5, 0xf6, 0x25, 0x8f, 0xe8, 0x15,
+	0xc8, 0x3f, 0x76, 0x87, 0x53, 0x4c, 0xad, 0x5a, 0xd9, 0xba, 0x1c, 0x7f, 0x07, 0xe5, 0x6b, 0x0f,
+	0x82, 0xd0, 0x66, 0x14, 0xdf, 0xca, 0xbc, 0xa9, 0x19, 0x7f, 0xca, 0x40, 0xd1, 0x7c, 0x06, 0xde,
+	0x12, 0x76, 0xc9, 0xa6, 0xd9, 0x25, 0xf7, 0x14, 0x76, 0x79, 0x1d, 0x0a, 0xce, 0xb1, 0xeb, 0xf7,
+	0x88, 0x6b, 0x88, 0x5d, 0xae, 0xc9, 0xd4, 0xa6, 0x45, 0x71, 0xad, 0xf1, 0x23, 0x4f, 0xd8, 0x83,
+	0x11, 0xa3, 0x2d, 0x58, 0x6d, 0x7b, 0xfd, 0xd0, 0x1d, 0x0c, 0x89, 0x42, 0xd8, 0x17, 0x5a, 0x16,
+	0xa8, 0x96, 0xa9, 0xb8, 0x39, 0x91, 0x53, 0x7c, 0xca, 0xc8, 0x29, 0xcd, 0x46, 0x8e, 0xf1, 0x67,
+	0x0d, 0x4a, 0x6d, 0xaf, 0xff, 0x7f, 0x60, 0xc4, 0x3b, 0x50, 0xb2, 0xf1, 0x64, 0x38, 0xe8, 0xba,
+	0xc2, 0x8c, 0x6b, 0x32, 0x7d, 0xdb, 0xeb, 0x73, 0xb4, 0x64, 0xc9, 0x88, 0xc3, 0xf8, 0x97, 0x06,
+	0x8b, 0xe4, 0x3b, 0x84, 0xa9, 0x51, 0x15, 0x8a, 0x6c, 0xc1, 0x3e, 0x27, 0x67, 0x8b, 0x25, 0xda,
+	0x96, 0x5e, 0x94, 0xa1, 0x2f, 0x7a, 0x79, 0xe6, 0x45, 0x91, 0x94, 0x9a, 0x20, 0xa4, 0x11, 0x1b,
+	0xbf, 0x0e, 0xad, 0x42, 0xbe, 0x39, 0xf1, 0xba, 0xc7, 0xfc, 0x73, 0xd9, 0x02, 0xad, 0x41, 0xa9,
+	0x8d, 0xdd, 0x1e, 0xf6, 0x5b, 0x26, 0xfd, 0xe4, 0x9c, 0x1d, 0xad, 0xa9, 0x7d, 0xb0, 0x3f, 0xaa,
+	0xe6, 0xb9, 0x7d, 0xb0, 0x3f, 0x5a, 0x7b, 0x1b, 0x96, 0x94, 0x17, 0xc8, 0x29, 0x91, 0x63, 0x29,
+	0xb1, 0x2a, 0xa7, 0x44, 0x59, 0x8e, 0xfe, 0xc7, 0xb0, 0xac, 0xda, 0x04, 0xdd, 0x57, 0x4d, 0x40,
+	0xc5, 0x54, 0xb6, 0xaa, 0xf3, 0x3e, 0x6e, 0xbb, 0x44, 0x6c, 0xf8, 0xc9, 0xa7, 0xd7, 0x35, 0x5b,
+	0x35, 0xdd, 0x0b, 0x50, 0x16, 0x62, 0x4d, 0xfa, 0xde, 0x9c, 0x1d, 0x03, 0x8c, 0xdf, 0x65, 0x40,
+	0xe7, 0xb9, 0xbe, 0x8b, 0x5d, 0x3f, 0x3c, 0xc2, 0x6e, 0xf8, 0x25, 0x2c, 0x96, 0x35, 0x40, 0x1d,
+	0x37, 0x10, 0xb2, 0x1b, 0x3e, 0x76, 0x43, 0xdc, 0xa3, 0x89, 0x56, 0xb2, 0x53, 0x30, 0xc4, 0xf7,
+	0x36, 0x76, 0x7b, 0xfb, 0xe3, 0xe1, 0x29, 0x4d, 0xb3, 0x92, 0x1d, 0xad, 0x8d, 0x37, 0x60, 0xb1,
+	0x61, 0xd5, 0x87, 0x43, 0xaf, 0xeb, 0x86, 0xb8, 0x65, 0xa6, 0x54, 0xbe, 0x55, 0xc8, 0x6f, 0xbb,
+	0x61, 0xf7, 0x98, 0x9b, 0x9b, 0x2d, 0x8c, 0x1f, 0x66, 0x60, 0x45, 0x24, 0xe7, 0xd9, 0xb6, 0xde,
+	0x80, 0x8a, 0xed, 0x3e, 0x0a, 0x55, 0x43, 0xcb, 0xa0, 0x14, 0x6f, 0x64, 0x53, 0xbd, 0x71, 0x03,
+	0x96, 0x76, 0xbc, 0x20, 0x18, 0x4c, 0x54, 0x43, 0xab, 0xc0, 0x2f, 0x96, 0xac, 0x73, 0x6c, 0x5b,
+	0x98, 0x67, 0x5b, 0xa3, 0x09, 0x15, 0xd3, 0x7a, 0x9a, 0xd4, 0x3e, 0x3b, 0x72, 0xff, 0x90, 0x01,
+	0xdd, 0x7c, 0x96, 0x91, 0x1b, 0xd7, 0xfd, 0xec, 0x45, 0xea, 0x7e, 0xfa, 0xe7, 0xe7, 0xe6, 0x86,
+	0xd6, 0xbc, 0x3e, 0x91, 0xbf, 0x70, 0x9f, 0x28, 0x3c, 0x65, 0xd2, 0x14, 0x13, 0x7d, 0xe2, 0x37,
+	0x39, 0x28, 0xd9, 0xce, 0x1e, 0x2b, 0xd5, 0x3a, 0x64, 0x3b, 0x81, 0x27, 0xca, 0x54, 0x27, 0xf0,
+	0x48, 0xfc, 0xb6, 0xc6, 0x3d, 0xfc, 0x44, 0xc4, 0x2f, 0x5d, 0x90, 0x58, 0x6a, 0x63, 0x37, 0xc0,
+	0xbb, 0xde, 0x90, 0x15, 0x45, 0x56, 0x2d, 0x55, 0x20, 0x32, 0x60, 0xb1, 0xe3, 0x4f, 0xc7, 0x24,
+	0x37, 0x7a, 0xed, 0x60, 0xcc, 0x2b, 0xa7, 0x02, 0x43, 0xef, 0xc2, 0x22, 0x63, 0x1a, 0x04, 0xa1,
+	0xe7, 0x9f, 0x56, 0xf3, 0xc9, 0xba, 0x2d, 0xb4, 0xab, 0xc9, 0x84, 0xac, 0x6e, 0x2b, 0xbc, 0x68,
+	0x07, 0x2a, 0x0d, 0x6f, 0x1c, 0x4c, 0x47, 0xd8, 0x27, 0xaf, 0x2b, 0x50, 0x51, 0x2f, 0xa5, 0x8a,
+	0x92, 0xe8, 0x98, 0x24, 0x99, 0x13, 0xed, 0xc1, 0x52, 0xb4, 0x24, 0x2f, 0xa8, 0x16, 0xa9, 0xa8,
+	0x6f, 0x9c, 0x2d, 0x8a, 0x50, 0x32, 0x61, 0x2a, 0xf7, 0xda, 0x3d, 0x58, 0x49, 0xa8, 0x7e, 0x5e,
+	0x47, 0xc8, 0x49, 0x1d, 0x61, 0xed, 0x2e, 0xe8, 0xb3, 0x0a, 0xa7, 0x97, 0x9a, 0x39, 0xfc, 0xdf,
+	0x06, 0x94, 0xd4, 0xf2, 0x3c, 0x09, 0x59, 0xb9, 0x27, 0x7d, 0x00, 0x65, 0x9a, 0xfa, 0x5d, 0xcf,
+	0xef, 0x11, 0x46, 0x62, 0x5f, 0xae, 0x3a, 0x31, 0xd8, 0x26, 0xe4, 0x3a, 0xa7, 0x13, 0xc6, 0xb7,
+	0xbc, 0x75, 0x55, 0xb1, 0x13, 0xe5, 0x21, 0x58, 0x9b, 0xd2, 0x90, 0xbc, 0x34, 0xdd, 0xd0, 0xa5,
+	0x21, 0xb3, 0x68, 0xd3, 0x67, 0xe3, 0x23, 0x0d, 0x80, 0xca, 0xff, 0xde, 0x14, 0x07, 0x34, 0x75,
+	0x2d, 0x77, 0x84, 0x45, 0xea, 0x92, 0x67, 0xb9, 0x36, 0x64, 0xd4, 0xda, 0xc0, 0xd5, 0xc9, 0xc6,
+	0xea, 0x54, 0xa1, 0xb8, 0xe7, 0x3e, 0x71, 0x06, 0xdf, 0xc7, 0x3c, 0xe6, 0xc4, 0x92, 0xd4, 0x11,
+	0x91, 0xbe, 0x26, 0xef, 0xd8, 0x31, 0x80, 0xaa, 0x66, 0xb5, 0x4c, 0x9a, 0x4d, 0x39, 0x9b, 0x3e,
+	0x93, 0xf2, 0x2f, 0x6c, 0xc7, 0xb3, 0x27, 0x5a, 0x1b, 0x06, 0x40, 0x27, 0xf0, 0x84, 0xd6, 0xab,
+	0x90, 0x6f, 0x78, 0xd3, 0x71, 0xc8, 0x0d, 0xc3, 0x16, 0xc6, 0x3f, 0x35, 0xd2, 0x23, 0x68, 0x6d,
+	0xa2, 0xb3, 0x6e, 0x6a, 0x5d, 0xba, 0x0d, 0xe5, 0xfd, 0x09, 0xf6, 0xdd, 0x70, 0xe0, 0x8d, 0xab,
+	0x99, 0xe4, 0x4c, 0xd5, 0xb0, 0x28, 0xef, 0xfe, 0xc4, 0x8e, 0xe9, 0xd0, 0x76, 0x34, 0xb4, 0xb3,
+	0x22, 0x75, 0x23, 0x65, 0x68, 0xa7, 0x04, 0xff, 0xc3, 0xc9, 0xfd, 0x27, 0x39, 0x28, 0x0a, 0x7b,
+	0xd0, 0x9a, 0x4d, 0x1f, 0xa3, 0x7a, 0x1e, 0x03, 0x50, 0x0d, 0x0a, 0x7b, 0x38, 0x3c, 0xf6, 0x7a,
+	0x69, 0x41, 0xc3, 0x30, 0x34, 0x68, 0x38, 0x15, 0xba, 0x23, 0x47, 0x08, 0x75, 0x76, 0x45, 0xe5,
+	0x89, 0xb1, 0xfc, 0x1b, 0xe5, 0x88, 0xaa, 0xd3, 0x09, 0x2a, 0x6a, 0x0e, 0x34, 0x2c, 0x2a, 0x5b,
+	0x5f, 0x9b, 0xe1, 0x57, 0x3b, 0x88, 0xad, 0xb0, 0xa0, 0xbb, 0x50, 0x69, 0x58, 0xb1, 0x84, 0x3c,
+	0x95, 0xf0, 0x42, 0x8a, 0xcd, 0x63, 0x01, 0x32, 0x03, 0xe1, 0x37, 0x25, 0xfe, 0x42, 0x92, 0xdf,
+	0x4c, 0xf0, 0x4b, 0x0c, 0xe8, 0x0d, 0x39, 0xd8, 0xaa, 0xc5, 0xa4, 0x01, 0x62, 0xac, 0x2d, 0x87,
+	0xe5, 0x1d, 0x75, 0x46, 0xa9, 0x96, 0x92, 0xc3, 0xa3, 0x8c, 0xb7, 0x15, 0x6a, 0xc6, 0x1d, 0x87,
+	0x52, 0xb5, 0x9c, 0xc6, 0x1d, 0xe3, 0x6d, 0x85, 0xda, 0x70, 0xa0, 0x42, 0x9d, 0x10, 0x4c, 0xbc,
+	0x71, 0x80, 0xcf, 0xe8, 0xef, 0x3c, 0x87, 0x33, 0x4a, 0x0e, 0xb7, 0xdd, 0x20, 0x8c, 0x33, 0x5b,
+	0x2c, 0x8d, 0x1a, 0x20, 0x49, 0x5d, 0x49, 0xf6, 0xfd, 0x81, 0x2f, 0xc5, 0x9a, 0x58, 0x1a, 0xff,
+	0x26, 0x1d, 0x4e, 0x90, 0x3d, 0xdb, 0xa0, 0x7c, 0x01, 0xca, 0x4d, 0xdf, 0xf7, 0xfc, 0x86, 0xd7,
+	0xc3, 0x54, 0xcd, 0x25, 0x3b, 0x06, 0x90, 0xfe, 0x47, 0x17, 0x7b, 0x38, 0x08, 0xdc, 0x3e, 0xe6,
+	0x03, 0x97, 0x02, 0x23, 0xed, 0xb9, 0x15, 0xec, 0xd6, 0x1f, 0x60, 0x3c, 0xc1, 0x3e, 0x0d, 0xaa,
+	0x92, 0x2d, 0x41, 0xd0, 0x3d, 0xc5, 0x82, 0x3c, 0x6a, 0xae, 0x25, 0xe2, 0x9e, 0xa1, 0x79, 0xe0,
+	0x2b, 0x36, 0x27, 0x0e, 0xf4, 0x46, 0x23, 0x77, 0xdc, 0x63, 0x73, 0x68, 0x31, 0xc5, 0x81, 0x12,
+	0xde, 0x56, 0xa8, 0xd1, 0x5b, 0x50, 0xa1, 0xa1, 0xc4, 0x5f, 0x5f, 0x4a, 0xbe, 0x5e, 0x42, 0xdb,
+	0x32, 0x2d, 0xda, 0x86, 0xe5, 0xc6, 0x70, 0x1a, 0x84, 0xd8, 0x37, 0x31, 0x19, 0x63, 0x02, 0x1e,
+	0x3b, 0xca, 0x3c, 0xa9, 0x52, 0xd8, 0x33, 0x1c, 0xe8, 0x2e, 0x94, 0xe3, 0x5d, 0x0f, 0x50, 0xf6,
+	0x0d, 0x99, 0x3d, 0x42, 0xbe, 0x37, 0xc5, 0xfe, 0xa9, 0x8d, 0x83, 0xe9, 0x30, 0xb4, 0x63, 0x16,
+	0x74, 0x17, 0x40, 0x8a, 0xfc, 0x0a, 0x15, 0xb0, 0x2e, 0x0b, 0x48, 0x06, 0x92, 0x0d, 0x33, 0xd1,
+	0x7f, 0x8c, 0xbb, 0x27, 0xd8, 0x67, 0xdb, 0xdd, 0xc5, 0x14, 0xe3, 0x49, 0x78, 0x5b, 0xa1, 0x36,
+	0xde, 0xa5, 0x43, 0x3e, 0x6b, 0x80, 0x91, 0x59, 0x5e, 0x87, 0x22, 0x83, 0x04, 0x55, 0x8d, 0x96,
+	0xed, 0x2b, 0x09, 0x67, 0x12, 0x2c, 0x77, 0xa5, 0xa0, 0x35, 0x5e, 0x54, 0x1c, 0x41, 0x7a, 0xcd,
+	0x77, 0x68, 0x59, 0xe6, 0xbd, 0x86, 0x2e, 0x8c, 0x1d, 0x58, 0x22, 0x53, 0x66, 0xc7, 0x3d, 0x1a,
+	0xe2, 0x83, 0x00, 0xfb, 0xa4, 0x79, 0x91, 0xdf, 0x71, 0xdc, 0x4c, 0xa3, 0x35, 0xc1, 0x3d, 0x74,
+	0x83, 0xe0, 0x43, 0xcf, 0xef, 0xf1, 0x29, 0x38, 0x5a, 0x1b, 0x3f, 0xd6, 0xa0, 0xc8, 0xc7, 0xeb,
+	0xd4, 0x7e, 0x35, 0xbf, 0x19, 0x2b, 0x83, 0x7a, 0x76, 0x66, 0x50, 0x8f, 0x77, 0xd7, 0x39, 0x79,
+	0x77, 0xbd, 0x4e, 0x4b, 0xbb, 0xda, 0x95, 0x25, 0x88, 0xf1, 0xcb, 0x0c, 0x89, 0xe1, 0xf1, 0xa3,
+	0x41, 0xbf, 0x71, 0xec, 0x8e, 0xfb, 0x18, 0xdd, 0x8e, 0xb4, 0xe3, 0x5b, 0xe1, 0xcb, 0xea, 0xc4,
+	0x41, 0x51, 0xb1, 0x05, 0xd9, 0x77, 0xdc, 0x01, 0x60, 0xec, 0xd2, 0xa4, 0xa2, 0x96, 0x6f, 0xe9,
+	0x15, 0x34, 0xcb, 0x25, 0x7a, 0xd4, 0x81, 0xe5, 0xd6, 0x78, 0x10, 0x0e, 0xdc, 0xe1, 0x1e, 0x1e,
+	0x1d, 0x61, 0x5f, 0x34, 0xdd, 0x6f, 0xce, 0x93, 0x50, 0x53, 0xc9, 0xd9, 0x60, 0x38, 0x23, 0x63,
+	0xad, 0x0e, 0x97, 0x53, 0xc8, 0x2e, 0x74, 0x5a, 0xf0, 0x0a, 0x2c, 0x39, 0xc7, 0xd3, 0xb0, 0xe7,
+	0x7d, 0x38, 0x66, 0x67, 0x3d, 0xc4, 0x37, 0xe4, 0x21, 0x72, 0x99, 0x58, 0x1a, 0x3f, 0xcb, 0xc2,
+	0x25, 0xa7, 0x7b, 0x8c, 0x7b, 0xd3, 0x21, 0xe6, 0x59, 0x9e, 0xea, 0xdd, 0x1b, 0xb0, 0xb4, 0xed,
+	0x79, 0x61, 0x10, 0xfa, 0xee, 0x64, 0x32, 0x18, 0xf7, 0xe9, 0x4b, 0x4b, 0xb6, 0x0a, 0x24, 0xa5,
+	0x81, 0x6f, 0x35, 0xa8, 0x41, 0xb3, 0xd4, 0xa0, 0x4a, 0x69, 0x90, 0xd0, 0xb6, 0x4c, 0xcb, 0x6a,
+	0x52, 0x6c, 0xaa, 0x6a, 0x2e, 0x25, 0xad, 0x24, 0xbc, 0xad, 0x7a, 0xff, 0xde, 0xcc, 0x17, 0xf3,
+	0x56, 0xfc, 0x9c, 0x5a, 0x18, 0x24, 0x02, 0x7b, 0xc6, 0x42, 0x0f, 0x60, 0x85, 0xed, 0xc0, 0xa4,
+	0x2d, 0x59, 0xb5, 0x90, 0x9c, 0x08, 0x12, 0x44, 0x76, 0x92, 0x8f, 0x68, 0x63, 0xe2, 0x21, 0x0e,
+	0x31, 0x6f, 0x7c, 0xd5, 0x62, 0x52, 0x1b, 0x85, 0xc0, 0x56, 0xe9, 0x8d, 0x61, 0x8a, 0x36, 0xe8,
+	0x36, 0xe4, 0x48, 0xa2, 0x56, 0xb5, 0xa4, 0x30, 0x25, 0xc3, 0x79, 0x90, 0x53, 0x62, 0xba, 0xdf,
+	0x72, 0x83, 0x13, 0x32, 0x51, 0x1f, 0x91, 0x5d, 0x0b, 0x8b, 0x15, 0x05, 0x46, 0xc2, 0x45, 0x79,
+	0xfd, 0x19, 0xe1, 0xe2, 0xaa, 0x9d, 0x23, 0x3a, 0xe8, 0xd2, 0xe2, 0x83, 0x2e, 0xf4, 0x0e, 0x94,
+	0x38, 0x8d, 0x38, 0x72, 0x7b, 0x5e, 0x71, 0x83, 0x1a, 0x6d, 0xe2, 0xbc, 0x40, 0xb0, 0x18, 0x7f,
+	0xcc, 0x92, 0xa1, 0x8a, 0xbd, 0x90, 0xd4, 0x6b, 0x71, 0xd6, 0xa8, 0x49, 0x67, 0x8d, 0x5f, 0xad,
+	0xd3, 0xa6, 0x7a, 0x34, 0xd4, 0x97, 0xa8, 0x39, 0x5f, 0x4c, 0x99, 0xb4, 0xe8, 0x01, 0xe6, 0xdc,
+	0x99, 0x5e, 0x39, 0xb0, 0x2a, 0xab, 0x07, 0x56, 0xcf, 0x7c, 0xde, 0xff, 0x95, 0xc6, 0xee, 0x55,
+	0xf8, 0x25, 0x02, 0x55, 0x4f, 0x34, 0xb6, 0xc4, 0x25, 0x02, 0xd9, 0x2d, 0x33, 0x0a, 0x45, 0x6d,
+	0x06, 0x5a, 0xb3, 0xa1, 0x22, 0x21, 0x53, 0x54, 0x7b, 0x4d, 0x55, 0xed, 0xda, 0x1c, 0xcb, 0xc8,
+	0xea, 0x7d, 0x94, 0xa1, 0x07, 0x4c, 0xcf, 0x24, 0xbe, 0xbe, 0x42, 0x67, 0x42, 0xc4, 0xab, 0xe6,
+	0xd3, 0x78, 0xd5, 0xfc, 0xef, 0x7a, 0xd5, 0x4c, 0xf7, 0xea, 0xef, 0xb5, 0xd9, 0xd1, 0x12, 0xbd,
+	0x0e, 0x25, 0xd3, 0x52, 0xf4, 0xbc, 0x9c, 0x22, 0x48, 0xd4, 0x1f, 0x41, 0x4a, 0xd8, 0x1a, 0x82,
+	0x2d, 0x93, 0x64, 0x6b, 0xa8, 0x6c, 0x82, 0x14, 0xbd, 0x49, 0x4f, 0x43, 0x38, 0x1f, 0x8b, 0x86,
+	0xd5, 0xb4, 0xad, 0x24, 0x67, 0x8c, 0x89, 0x8d, 0x1f, 0x69, 0x50, 0xe1, 0xaa, 0xd3, 0x80, 0x7c,
+	0x8b, 0xea, 0xcd, 0xc2, 0x4a, 0xe3, 0x61, 0x15, 0x65, 0x1c, 0xc7, 0x28, 0x03, 0x61, 0x44, 0x8e,
+	0xee, 0x30, 0x25, 0x18, 0x2f, 0x53, 0xbe, 0x2a, 0x65, 0xab, 0xd7, 0x4f, 0x32, 0xc7, 0x0c, 0xc6,
+	0x4f, 0x35, 0xb8, 0xc2, 0x47, 0x0f, 0xae, 0x8f, 0xd8, 0x2f, 0xbe, 0x0c, 0xcb, 0xd6, 0x74, 0xb4,
+	0xff, 0x28, 0x16, 0xce, 0xb2, 0x65, 0x06, 0x4a, 0xa6, 0x04, 0x0a, 0x89, 0xf4, 0x67, 0x93, 0xa0,
+	0x0a, 0x44, 0x9b, 0xa0, 0x0b, 0xbe, 0xe8, 0x5c, 0x99, 0x8d, 0x85, 0x09, 0xb8, 0xf1, 0x83, 0x0c,
+	0x2c, 0x0a, 0x53, 0xcd, 0x4d, 0xd7, 0x2f, 0xf7, 0x81, 0xf8, 0x47, 0x19, 0x7e, 0x6b, 0x47, 0x52,
+	0xef, 0x2e, 0x14, 0x94, 0xd0, 0xd8, 0x48, 0xc4, 0x18, 0xcd, 0x3d, 0x4a, 0xa2, 0xe6, 0x1e, 0xb3,
+	0xfd, 0xdd, 0x28, 0x75, 0x33, 0x67, 0xf1, 0xcf, 0xcd, 0x5d, 0x07, 0x2a, 0x92, 0xf0, 0x94, 0xa9,
+	0xb4, 0xa6, 0xe6, 0xee, 0xdc, 0x0b, 0x29, 0xf9, 0x2c, 0xd2, 0x39, 0xaf, 0x20, 0x9c, 0x27, 0x34,
+	0xad, 0x22, 0xfc, 0x25, 0xab, 0x6e, 0xd4, 0x52, 0x23, 0xe7, 0x9e, 0x92, 0x7a, 0xa9, 0x5d, 0x24,
+	0x46, 0x8b, 0xad, 0xb4, 0x04, 0x22, 0xdb, 0x0e, 0x5e, 0xf0, 0xf8, 0xf9, 0xd3, 0xe5, 0x94, 0x5a,
+	0x28, 0xb6, 0x1d, 0x7c, 0x89, 0xde, 0x88, 0x1d, 0xca, 0xe7, 0xdc, 0xd5, 0x34, 0x37, 0x88, 0xc8,
+	0x89, 0x9c, 0x7f, 0x3b, 0x6a, 0xac, 0xd5, 0x7c, 0xf2, 0x65, 0x0d, 0xf5, 0x65, 0x7c, 0x89, 0x6e,
+	0x89, 0x7b, 0x59, 0x36, 0x94, 0x28, 0x73, 0xa3, 0x38, 0x52, 0x50, 0xee, 0x66, 0x2d, 0x1e, 0x9f,
+	0x7c, 0x4e, 0x63, 0x48, 0xda, 0x11, 0x96, 0xd5, 0x8d, 0x72, 0x92, 0xca, 0x4e, 0xe1, 0x44, 0xcd,
+	0x99, 0x1d, 0x28, 0x3f, 0x31, 0x38, 0x77, 0x80, 0x55, 0xb9, 0x8c, 0xbf, 0x15, 0x41, 0x17, 0xfa,
+	0x46, 0x97, 0x13, 0x69, 0x3e, 0xbd, 0x0a, 0x05, 0x0b, 0x3f, 0x09, 0xa3, 0x7d, 0x28, 0x5f, 0xa1,
+	0x7d, 0xa8, 0xb0, 0xa7, 0xed, 0xd3, 0x07, 0xf8, 0x94, 0xd7, 0xe8, 0xd7, 0xd2, 0xcc, 0x21, 0xc4,
+	0xd7, 0x24, 0x7a, 0x7e, 0x25, 0x20, 0x41, 0xa2, 0xe1, 0x37, 0x27, 0x0d, 0xbf, 0x91, 0xb5, 0xf3,
+	0x5f, 0xc8, 0xda, 0x85, 0xcf, 0x6d, 0xed, 0x1e, 0xe8, 0x33, 0x13, 0x76, 0xc0, 0xaf, 0x2a, 0xb6,
+	0xce, 0xfc, 0xd4, 0x59, 0x26, 0x39, 0xf9, 0x13, 0x12, 0x51, 0x4b, 0x6e, 0x34, 0x6c, 0x2a, 0x7d,
+	0xf5, 0x4c, 0xf1, 0x11, 0x35, 0xb3, 0x63, 0xcc, 0x2d, 0x07, 0x75, 0xf9, 0xa9, 0x83, 0x5a, 0x4a,
+	0x3b, 0xf8, 0x5c, 0x69, 0x57, 0xb9, 0x40, 0xda, 0xcd, 0x14, 0x89, 0xc5, 0x0b, 0x17, 0x89, 0x44,
+	0x06, 0x2c, 0x7d, 0x9e, 0x0c, 0x20, 0x57, 0x3e, 0xb3, 0x01, 0x79, 0xa1, 0x2b, 0x9f, 0x0f, 0xe0,
+	0x4a, 0xaa, 0x97, 0x2f, 0x58, 0x70, 0x95, 0xa3, 0x41, 0x49, 0xfc, 0x1d, 0x58, 0x8e, 0xbc, 0x7a,
+	0x61, 0xe5, 0x8c, 0x16, 0x54, 0xe4, 0xbf, 0x37, 0x7c, 0x81, 0x9b, 0x5a, 0xe3, 0xd7, 0x19, 0x58,
+	0x4d, 0x3b, 0x05, 0x3c, 0xe3, 0xac, 0xf9, 0x61, 0xe2, 0x6f, 0x22, 0xb5, 0xf3, 0xce, 0x14, 0xd5,
+	0xbf, 0x8b, 0x24, 0xba, 0xfc, 0xb3, 0xf9, 0xd3, 0x48, 0xe7, 0xfc, 0x3f, 0x8d, 0x9c, 0x35, 0x2c,
+	0x4b, 0x16, 0x95, 0x6c, 0xbd, 0xf9, 0x21, 0xc0, 0xc1, 0xa4, 0xe7, 0x86, 0xec, 0xe4, 0xe5, 0x1a,
+	0x5c, 0x56, 0xee, 0x68, 0x19, 0x4a, 0x5f, 0x40, 0x57, 0x60, 0x45, 0xdc, 0xcb, 0xb6, 0x1d, 0x8b,
+	0x83, 0x35, 0x74, 0x19, 0x2e, 0x91, 0x70, 0xa4, 0xfa, 0x70, 0x60, 0x06, 0x2d, 0x41, 0xb9, 0xe3,
+	0xec, 0xf3, 0x65, 0x96, 0xb0, 0x46, 0xb7, 0x8b, 0x11, 0x6b, 0x6e, 0xb3, 0x06, 0xe5, 0xe8, 0xaf,
+	0x40, 0xe8, 0x12, 0x54, 0x2c, 0xcf, 0x1f, 0xb9, 0x43, 0xba, 0xd4, 0x17, 0x90, 0x0e, 0x8b, 0x9d,
+	0xc1, 0x08, 0x7b, 0xd3, 0x90, 0x41, 0xb4, 0xcd, 0xbf, 0x66, 0x00, 0xe2, 0x23, 0x76, 0xb4, 0x0c,
+	0xd0, 0x71, 0xf6, 0x0f, 0x0f, 0x1e, 0x9a, 0xf5, 0x4e, 0x53, 0x5f, 0x40, 0x00, 0x85, 0xfa, 0xc3,
+	0x87, 0x4d, 0xcb, 0xd4, 0x35, 0x54, 0x82, 0x9c, 0xdd, 0xac, 0x9b, 0x7a, 0x06, 0x2d, 0x42, 0xa9,
+	0x63, 0x1f, 0x58, 0x0d, 0x42, 0x93, 0x25, 0x42, 0x77, 0x9a, 0x9d, 0xc3, 0x08, 0x92, 0x43, 0x15,
+	0x28, 0x36, 0xf6, 0x2d, 0xab, 0xd9, 0xe8, 0xe8, 0x79, 0x22, 0x92, 0x2f, 0x0e, 0xed, 0x7d, 0xbd,
+	0x80, 0x56, 0x60, 0xa9, 0xbd, 0xbf, 0x73, 0xb8, 0xdb, 0xac, 0xdb, 0x9d, 0xed, 0x66, 0xbd, 0xa3,
+	0x17, 0x89, 0x84, 0x86, 0x25, 0x41, 0x4a, 0x04, 0x62, 0xca, 0x90, 0x32, 0x42, 0xb0, 0xdc, 0xd8,
+	0x6d, 0x36, 0x1e, 0x1c, 0xee, 0xd6, 0x1f, 0x34, 0x9b, 0x0f, 0x9b, 0xb6, 0x0e, 0xc4, 0xae, 0xe4,
+	0xcd, 0x8d, 0xf6, 0x81, 0xd3, 0x69, 0xda, 0x87, 0x66, 0xb3, 0x53, 0x6f, 0xb5, 0x1d, 0xbd, 0x42,
+	0x88, 0x09, 0xc2, 0xd9, 0xad, 0xdb, 0xe6, 0x61, 0xcb, 0xba, 0xbf, 0xaf, 0x2f, 0x52, 0x01, 0xd6,
+	0x61, 0xbd, 0xdd, 0xde, 0x27, 0x5a, 0x1e, 0xb6, 0x4c, 0x7d, 0x89, 0x18, 0x51, 0x16, 0xe0, 0x74,
+	0x88, 0xfe, 0xcb, 0xd4, 0xfe, 0xd4, 0x02, 0x87, 0x0d, 0xeb, 0xb0, 0x5d, 0xdf, 0x6e, 0xb6, 0xf5,
+	0x4b, 0x68, 0x15, 0x74, 0x87, 0xd0, 0xee, 0x5b, 0xce, 0xc1, 0x5e, 0xd3, 0x3e, 0x6c, 0x3b, 0x96,
+	0xae, 0x13, 0xe8, 0xce, 0x2c, 0x74, 0x65, 0xd3, 0x02, 0x88, 0x6f, 0x60, 0x89, 0x05, 0x88, 0x3b,
+	0x19, 0x44, 0x5f, 0x20, 0xe6, 0x6b, 0x8d, 0x43, 0xec, 0x8f, 0xdd, 0xa1, 0xae, 0x11, 0x27, 0xd1,
+	0xe0, 0x88, 0x1c, 0xbd, 0xc2, 0xaf, 0xf9, 0x6d, 0xfc, 0x5d, 0xdc, 0x0d, 0x71, 0x4f, 0xcf, 0x6e,
+	0x6e, 0x42, 0x39, 0xba, 0x8c, 0x24, 0xec, 0x0e, 0x0e, 0xe9, 0x4a, 0x5f, 0x20, 0xec, 0xec, 0x68,
+	0x89, 0x01, 0xb4, 0xcd, 0x9f, 0x67, 0x00, 0x89, 0xe6, 0x20, 0xc5, 0x20, 0xf1, 0xec, 0xa0, 0x7b,
+	0x22, 0x87, 0x9e, 0x74, 0x4f, 0x16, 0x85, 0xde, 0x15, 0x58, 0x31, 0x13, 0xe0, 0x0c, 0xba, 0x0a,
+	0x48, 0xbe, 0x96, 0x8b, 0xa2, 0xf0, 0x12, 0x54, 0x76, 0x70, 0x18, 0x45, 0x74, 0x0e, 0x3d, 0x97,
+	0xa8, 0x80, 0x1c, 0x95, 0x27, 0x0e, 0x70, 0x30, 0x0b, 0x3c, 0x0e, 0x2b, 0xa0, 0x2a, 0xac, 0xaa,
+	0xfb, 0x21, 0x8e, 0x29, 0xa2, 0xeb, 0xf0, 0xbc, 0x83, 0xc3, 0x64, 0xfb, 0xe5, 0x04, 0x25, 0xb4,
+	0x06, 0x57, 0x39, 0x41, 0x54, 0xbf, 0x39, 0xae, 0x4c, 0x4c, 0xc8, 0x9e, 0xb9, 0xd5, 0x74, 0xd8,
+	0xfc, 0x85, 0x06, 0x4b, 0xca, 0x78, 0x40, 0xbc, 0x2c, 0x00, 0x7c, 0x23, 0xa0, 0x2f, 0x10, 0xfd,
+	0x05, 0x50, 0x39, 0x78, 0xd5, 0x35, 0xf4, 0x12, 0x7c, 0x3d, 0x81, 0x12, 0x55, 0xde, 0xc6, 0x5d,
+	0x3c, 0x78, 0x8c, 0x7b, 0x7a, 0x06, 0x3d, 0x0f, 0xd7, 0x12, 0x64, 0xf7, 0xdd, 0xc1, 0x90, 0x38,
+	0x52, 0x7e, 0xa7, 0x3d, 0x1d, 0x8f, 0x89, 0xe0, 0xdc, 0xe6, 0x51, 0xda, 0x80, 0x42, 0x4c, 0xa3,
+	0x40, 0x63, 0x1d, 0x67, 0x31, 0x42, 0x92, 0x96, 0xc0, 0x38, 0xa1, 0x37, 0x99, 0x10, 0xad, 0x36,
+	0x8f, 0x41, 0x97, 0x8f, 0x73, 0x45, 0x48, 0xd4, 0x7b, 0x3d, 0x5e, 0xc1, 0xf4, 0x05, 0x62, 0x35,
+	0x1b, 0x8f, 0xbc, 0xc7, 0x58, 0x80, 0x34, 0x92, 0x87, 0x4e, 0xe8, 0xfa, 0xa1, 0x80, 0x64, 0x88,
+	0xc7, 0x89, 0x54, 0x01, 0xc8, 0x12, 0x29, 0x0f, 0x06, 0xc3, 0xe1, 0xfb, 0xde, 0xe8, 0x68, 0x40,
+	0x2a, 0xd0, 0xdb, 0xca, 0x09, 0x35, 0x41, 0x93, 0x9e, 0xc5, 0x20, 0xfa, 0x02, 0x29, 0x63, 0xa6,
+	0x25, 0x96, 0x1a, 0x59, 0x36, 0xa2, 0x65, 0x66, 0xbb, 0xf9, 0xc9, 0x3f, 0xd6, 0x17, 0x3e, 0xfe,
+	0x6c, 0x5d, 0xfb, 0xe4, 0xb3, 0x75, 0xed, 0xef, 0x9f, 0xad, 0x6b, 0xef, 0xdf, 0x96, 0xfe, 0x82,
+	0x3c, 0x72, 0x43, 0x7f, 0xf0, 0xc4, 0xf3, 0x07, 0xfd, 0xc1, 0x58, 0x2c, 0xc6, 0xf8, 0xd6, 0xe4,
+	0xa4, 0x7f, 0x6b, 0x72, 0x74, 0x2b, 0xae, 0xca, 0x47, 0x05, 0xfa, 0xff, 0xe3, 0xdb, 0xff, 0x19,
+	0x00, 0x62, 0x14, 0x44, 0x78, 0xde, 0x2c, 0x00, 0x00,
 }
 
 func (m *CNStore) Marshal() (dAtA []byte, err error) {
@@ -4221,6 +4279,16 @@ func (m *CNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.TaskServiceCreated {
 		i--
 		if m.TaskServiceCreated {
@@ -4539,6 +4607,40 @@ func (m *RSMState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ConsumerLease) > 0 {
+		for k := range m.ConsumerLease {
+			v := m.ConsumerLease[k]
+			baseI := i
+			i = encodeVarintLogservice(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ConsumerLsn) > 0 {
+		for k := range m.ConsumerLsn {
+			v := m.ConsumerLsn[k]
+			baseI := i
+			i = encodeVarintLogservice(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.LeaseHistory) > 0 {
 		for k := range m.LeaseHistory {
 			v := m.LeaseHistory[k]
@@ -4645,6 +4747,13 @@ func (m *LogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.DNID != 0 {
 		i = encodeVarintLogservice(dAtA, i, uint64(m.DNID))
 		i--
@@ -5615,6 +5724,16 @@ func (m *CNStoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ReadOnly {
+		i--
+		if m.ReadOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Labels) > 0 {
 		for k := range m.Labels {
 			v := m.Labels[k]
@@ -6772,6 +6891,9 @@ func (m *CNStoreHeartbeat) Size() (n int) {
 	if m.TaskServiceCreated {
 		n += 2
 	}
+	if m.ReadOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6919,6 +7041,22 @@ func (m *RSMState) Size() (n int) {
 			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
 		}
 	}
+	if len(m.ConsumerLsn) > 0 {
+		for k, v := range m.ConsumerLsn {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + sovLogservice(uint64(v))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ConsumerLease) > 0 {
+		for k, v := range m.ConsumerLease {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + sovLogservice(uint64(v))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6972,6 +7110,10 @@ func (m *LogRequest) Size() (n int) {
 	if m.DNID != 0 {
 		n += 1 + sovLogservice(uint64(m.DNID))
 	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7410,6 +7552,9 @@ func (m *CNStoreInfo) Size() (n int) {
 			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
 		}
 	}
+	if m.ReadOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9229,6 +9374,26 @@ func (m *CNStoreHeartbeat) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.TaskServiceCreated = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -10144,6 +10309,232 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 			}
 			m.LeaseHistory[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerLsn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerLsn == nil {
+				m.ConsumerLsn = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConsumerLsn[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerLease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerLease == nil {
+				m.ConsumerLease = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConsumerLease[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -10445,6 +10836,38 @@ func (m *LogRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -13334,6 +13757,26 @@ func (m *CNStoreInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Labels[mapkey] = *mapvalue
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -419,3 +419,42 @@ func TestCNLabelUpdate(t *testing.T) {
 		},
 	})
 }
+
+func TestCNReadOnlyLabel(t *testing.T) {
+	state := CNState{Stores: map[string]CNStoreInfo{}}
+	readOnly := metadata.LabelList{Labels: []string{"true"}}
+
+	state.Update(CNStoreHeartbeat{UUID: "cn-1", ReadOnly: true}, 1)
+	assert.True(t, state.Stores["cn-1"].ReadOnly)
+	assert.Equal(t, map[string]metadata.LabelList{
+		ReadOnlyLabelKey: readOnly,
+	}, state.Stores["cn-1"].Labels)
+
+	// the read-only label is kept when labels are updated by users
+	state.UpdateLabel(CNStoreLabel{
+		UUID: "cn-1",
+		Labels: map[string]metadata.LabelList{
+			"role": {Labels: []string{"r1"}},
+		},
+	})
+	assert.Equal(t, map[string]metadata.LabelList{
+		"role":           {Labels: []string{"r1"}},
+		ReadOnlyLabelKey: readOnly,
+	}, state.Stores["cn-1"].Labels)
+
+	// and removed if the cn is not read-only anymore
+	state.Update(CNStoreHeartbeat{UUID: "cn-1"}, 2)
+	assert.False(t, state.Stores["cn-1"].ReadOnly)
+	assert.Equal(t, map[string]metadata.LabelList{
+		"role": {Labels: []string{"r1"}},
+	}, state.Stores["cn-1"].Labels)
+
+	// users can not set the read-only label
+	state.UpdateLabel(CNStoreLabel{
+		UUID: "cn-1",
+		Labels: map[string]metadata.LabelList{
+			ReadOnlyLabelKey: readOnly,
+		},
+	})
+	assert.Equal(t, map[string]metadata.LabelList{}, state.Stores["cn-1"].Labels)
+}
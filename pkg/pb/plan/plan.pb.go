@@ -286,7 +286,7 @@ func (x ForeignKeyDef_RefAction) String() string {
 }
 
 func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{25, 0}
 }
 
 type OrderBySpec_OrderByFlag int32
@@ -323,7 +323,7 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{42, 0}
 }
 
 type Node_NodeType int32
@@ -450,7 +450,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 0}
 }
 
 type Node_JoinType int32
@@ -496,7 +496,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 1}
 }
 
 type Node_AggMode int32
@@ -524,7 +524,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 2}
 }
 
 type Query_StatementType int32
@@ -561,7 +561,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{52, 0}
 }
 
 type TransationControl_TclType int32
@@ -589,7 +589,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{53, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -617,7 +617,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{54, 0}
 }
 
 type DataControl_DclType int32
@@ -657,7 +657,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{59, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -775,7 +775,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{60, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -809,7 +809,41 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{67, 0}
+}
+
+type AlterTablePartition_AlterPartitionType int32
+
+const (
+	AlterTablePartition_ADD_PARTITION        AlterTablePartition_AlterPartitionType = 0
+	AlterTablePartition_DROP_PARTITION       AlterTablePartition_AlterPartitionType = 1
+	AlterTablePartition_TRUNCATE_PARTITION   AlterTablePartition_AlterPartitionType = 2
+	AlterTablePartition_REORGANIZE_PARTITION AlterTablePartition_AlterPartitionType = 3
+	AlterTablePartition_EXCHANGE_PARTITION   AlterTablePartition_AlterPartitionType = 4
+)
+
+var AlterTablePartition_AlterPartitionType_name = map[int32]string{
+	0: "ADD_PARTITION",
+	1: "DROP_PARTITION",
+	2: "TRUNCATE_PARTITION",
+	3: "REORGANIZE_PARTITION",
+	4: "EXCHANGE_PARTITION",
+}
+
+var AlterTablePartition_AlterPartitionType_value = map[string]int32{
+	"ADD_PARTITION":        0,
+	"DROP_PARTITION":       1,
+	"TRUNCATE_PARTITION":   2,
+	"REORGANIZE_PARTITION": 3,
+	"EXCHANGE_PARTITION":   4,
+}
+
+func (x AlterTablePartition_AlterPartitionType) String() string {
+	return proto.EnumName(AlterTablePartition_AlterPartitionType_name, int32(x))
+}
+
+func (AlterTablePartition_AlterPartitionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{76, 0}
 }
 
 type Type struct {
@@ -2551,7 +2585,10 @@ type Default struct {
 	Expr         *Expr  `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString string `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
 	// XXX: Deprecated and to be removed soon.
-	NullAbility          bool     `protobuf:"varint,3,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
+	NullAbility bool `protobuf:"varint,3,opt,name=null_ability,json=nullAbility,proto3" json:"null_ability,omitempty"`
+	// the marshaled constant vector of the value of the column in the rows
+	// written before the column was added, empty means null
+	FillValue            []byte   `protobuf:"bytes,4,opt,name=fill_value,json=fillValue,proto3" json:"fill_value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2611,6 +2648,13 @@ func (m *Default) GetNullAbility() bool {
 	return false
 }
 
+func (m *Default) GetFillValue() []byte {
+	if m != nil {
+		return m.FillValue
+	}
+	return nil
+}
+
 type OnUpdate struct {
 	Expr                 *Expr    `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
 	OriginString         string   `protobuf:"bytes,2,opt,name=origin_string,json=originString,proto3" json:"origin_string,omitempty"`
@@ -2806,6 +2850,73 @@ func (m *PrimaryKeyDef) GetCompPkeyCol() *ColDef {
 	return nil
 }
 
+// TTLDef describes the table level TTL option: rows expire once
+// col_name + expire_seconds is older than the current time.
+type TTLDef struct {
+	ColName       string `protobuf:"bytes,1,opt,name=col_name,json=colName,proto3" json:"col_name,omitempty"`
+	ExpireSeconds int64  `protobuf:"varint,2,opt,name=expire_seconds,json=expireSeconds,proto3" json:"expire_seconds,omitempty"`
+	// time_zone is the time zone of the session creating the table, date and
+	// datetime values of col_name are compared in it
+	TimeZone             string   `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TTLDef) Reset()         { *m = TTLDef{} }
+func (m *TTLDef) String() string { return proto.CompactTextString(m) }
+func (*TTLDef) ProtoMessage()    {}
+func (*TTLDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{23}
+}
+func (m *TTLDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TTLDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TTLDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TTLDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TTLDef.Merge(m, src)
+}
+func (m *TTLDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TTLDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TTLDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TTLDef proto.InternalMessageInfo
+
+func (m *TTLDef) GetColName() string {
+	if m != nil {
+		return m.ColName
+	}
+	return ""
+}
+
+func (m *TTLDef) GetExpireSeconds() int64 {
+	if m != nil {
+		return m.ExpireSeconds
+	}
+	return 0
+}
+
+func (m *TTLDef) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
 type IndexDef struct {
 	// Generate UUID for each index, currently not used
 	IdxId     string `protobuf:"bytes,1,opt,name=idx_id,json=idxId,proto3" json:"idx_id,omitempty"`
@@ -2828,7 +2939,7 @@ func (m *IndexDef) Reset()         { *m = IndexDef{} }
 func (m *IndexDef) String() string { return proto.CompactTextString(m) }
 func (*IndexDef) ProtoMessage()    {}
 func (*IndexDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{23}
+	return fileDescriptor_2d655ab2f7683c23, []int{24}
 }
 func (m *IndexDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2936,7 +3047,7 @@ func (m *ForeignKeyDef) Reset()         { *m = ForeignKeyDef{} }
 func (m *ForeignKeyDef) String() string { return proto.CompactTextString(m) }
 func (*ForeignKeyDef) ProtoMessage()    {}
 func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{24}
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
 func (m *ForeignKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3020,7 +3131,7 @@ func (m *CheckDef) Reset()         { *m = CheckDef{} }
 func (m *CheckDef) String() string { return proto.CompactTextString(m) }
 func (*CheckDef) ProtoMessage()    {}
 func (*CheckDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
 func (m *CheckDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3076,7 +3187,7 @@ func (m *ClusterByDef) Reset()         { *m = ClusterByDef{} }
 func (m *ClusterByDef) String() string { return proto.CompactTextString(m) }
 func (*ClusterByDef) ProtoMessage()    {}
 func (*ClusterByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
 func (m *ClusterByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3131,7 +3242,7 @@ func (m *PropertyDef) Reset()         { *m = PropertyDef{} }
 func (m *PropertyDef) String() string { return proto.CompactTextString(m) }
 func (*PropertyDef) ProtoMessage()    {}
 func (*PropertyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
 }
 func (m *PropertyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3186,7 +3297,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3240,7 +3351,7 @@ func (m *PropertiesDef) Reset()         { *m = PropertiesDef{} }
 func (m *PropertiesDef) String() string { return proto.CompactTextString(m) }
 func (*PropertiesDef) ProtoMessage()    {}
 func (*PropertiesDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
 }
 func (m *PropertiesDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3296,7 +3407,7 @@ func (m *PartitionByDef) Reset()         { *m = PartitionByDef{} }
 func (m *PartitionByDef) String() string { return proto.CompactTextString(m) }
 func (*PartitionByDef) ProtoMessage()    {}
 func (*PartitionByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
 }
 func (m *PartitionByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3407,7 +3518,7 @@ func (m *PartitionExpr) Reset()         { *m = PartitionExpr{} }
 func (m *PartitionExpr) String() string { return proto.CompactTextString(m) }
 func (*PartitionExpr) ProtoMessage()    {}
 func (*PartitionExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
 }
 func (m *PartitionExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3462,7 +3573,7 @@ func (m *PartitionColumns) Reset()         { *m = PartitionColumns{} }
 func (m *PartitionColumns) String() string { return proto.CompactTextString(m) }
 func (*PartitionColumns) ProtoMessage()    {}
 func (*PartitionColumns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
 }
 func (m *PartitionColumns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3522,7 +3633,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,6 +3711,61 @@ func (m *PartitionItem) GetPartitionTableName() string {
 	return ""
 }
 
+type PartitionPrune struct {
+	IsPruned             bool             `protobuf:"varint,1,opt,name=is_pruned,json=isPruned,proto3" json:"is_pruned,omitempty"`
+	SelectedPartitions   []*PartitionItem `protobuf:"bytes,2,rep,name=selected_partitions,json=selectedPartitions,proto3" json:"selected_partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartitionPrune) Reset()         { *m = PartitionPrune{} }
+func (m *PartitionPrune) String() string { return proto.CompactTextString(m) }
+func (*PartitionPrune) ProtoMessage()    {}
+func (*PartitionPrune) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
+}
+func (m *PartitionPrune) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionPrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionPrune.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionPrune) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionPrune.Merge(m, src)
+}
+func (m *PartitionPrune) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PartitionPrune) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionPrune.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionPrune proto.InternalMessageInfo
+
+func (m *PartitionPrune) GetIsPruned() bool {
+	if m != nil {
+		return m.IsPruned
+	}
+	return false
+}
+
+func (m *PartitionPrune) GetSelectedPartitions() []*PartitionItem {
+	if m != nil {
+		return m.SelectedPartitions
+	}
+	return nil
+}
+
 type ViewDef struct {
 	View                 string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3611,7 +3777,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3670,6 +3836,7 @@ type TableDef struct {
 	Name2ColIndex        map[string]int32    `protobuf:"bytes,26,rep,name=name2col_index,json=name2colIndex,proto3" json:"name2col_index,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	IsLocked             bool                `protobuf:"varint,27,opt,name=isLocked,proto3" json:"isLocked,omitempty"`
 	TableLockType        TableLockType       `protobuf:"varint,28,opt,name=tableLockType,proto3,enum=plan.TableLockType" json:"tableLockType,omitempty"`
+	Ttl                  *TTLDef             `protobuf:"bytes,29,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -3679,7 +3846,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3855,6 +4022,13 @@ func (m *TableDef) GetTableLockType() TableLockType {
 	return TableLockType_TableLockNone
 }
 
+func (m *TableDef) GetTtl() *TTLDef {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
 // XXX: Deprecated and to be removed soon.
 type TableDef_DefType struct {
 	// Types that are valid to be assigned to Def:
@@ -3869,7 +4043,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{37, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3943,7 +4117,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4015,7 +4189,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4278,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4151,7 +4325,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4200,7 +4374,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4264,7 +4438,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4330,6 +4504,7 @@ type InsertCtx struct {
 	IdxIdx               []int32          `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
 	ParentIdx            map[string]int32 `protobuf:"bytes,7,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	ClusterTable         *ClusterTable    `protobuf:"bytes,8,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
+	Partition            *DmlPartition    `protobuf:"bytes,9,opt,name=partition,proto3" json:"partition,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -4339,7 +4514,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4424,34 +4599,42 @@ func (m *InsertCtx) GetClusterTable() *ClusterTable {
 	return nil
 }
 
+func (m *InsertCtx) GetPartition() *DmlPartition {
+	if m != nil {
+		return m.Partition
+	}
+	return nil
+}
+
 type UpdateCtx struct {
-	Ref                  []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
-	Idx                  []*IdList    `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
-	TableDefs            []*TableDef  `protobuf:"bytes,3,rep,name=tableDefs,proto3" json:"tableDefs,omitempty"`
-	UpdateCol            []*ColPosMap `protobuf:"bytes,4,rep,name=update_col,json=updateCol,proto3" json:"update_col,omitempty"`
-	IdxRef               []*ObjectRef `protobuf:"bytes,5,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
-	IdxIdx               []int32      `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
-	OnRestrictRef        []*ObjectRef `protobuf:"bytes,7,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
-	OnRestrictIdx        []int32      `protobuf:"varint,8,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
-	OnCascadeRef         []*ObjectRef `protobuf:"bytes,9,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
-	OnCascadeIdx         []*IdList    `protobuf:"bytes,10,rep,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
-	OnCascadeDef         []*TableDef  `protobuf:"bytes,11,rep,name=on_cascade_def,json=onCascadeDef,proto3" json:"on_cascade_def,omitempty"`
-	OnCascadeUpdateCol   []*ColPosMap `protobuf:"bytes,12,rep,name=on_cascade_update_col,json=onCascadeUpdateCol,proto3" json:"on_cascade_update_col,omitempty"`
-	OnSetRef             []*ObjectRef `protobuf:"bytes,13,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
-	OnSetIdx             []*IdList    `protobuf:"bytes,14,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
-	OnSetDef             []*TableDef  `protobuf:"bytes,15,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
-	OnSetUpdateCol       []*ColPosMap `protobuf:"bytes,16,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
-	ParentIdx            []*ColPosMap `protobuf:"bytes,17,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Ref                  []*ObjectRef    `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
+	Idx                  []*IdList       `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
+	TableDefs            []*TableDef     `protobuf:"bytes,3,rep,name=tableDefs,proto3" json:"tableDefs,omitempty"`
+	UpdateCol            []*ColPosMap    `protobuf:"bytes,4,rep,name=update_col,json=updateCol,proto3" json:"update_col,omitempty"`
+	IdxRef               []*ObjectRef    `protobuf:"bytes,5,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
+	IdxIdx               []int32         `protobuf:"varint,6,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
+	OnRestrictRef        []*ObjectRef    `protobuf:"bytes,7,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
+	OnRestrictIdx        []int32         `protobuf:"varint,8,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
+	OnCascadeRef         []*ObjectRef    `protobuf:"bytes,9,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
+	OnCascadeIdx         []*IdList       `protobuf:"bytes,10,rep,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
+	OnCascadeDef         []*TableDef     `protobuf:"bytes,11,rep,name=on_cascade_def,json=onCascadeDef,proto3" json:"on_cascade_def,omitempty"`
+	OnCascadeUpdateCol   []*ColPosMap    `protobuf:"bytes,12,rep,name=on_cascade_update_col,json=onCascadeUpdateCol,proto3" json:"on_cascade_update_col,omitempty"`
+	OnSetRef             []*ObjectRef    `protobuf:"bytes,13,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
+	OnSetIdx             []*IdList       `protobuf:"bytes,14,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
+	OnSetDef             []*TableDef     `protobuf:"bytes,15,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
+	OnSetUpdateCol       []*ColPosMap    `protobuf:"bytes,16,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
+	ParentIdx            []*ColPosMap    `protobuf:"bytes,17,rep,name=parent_idx,json=parentIdx,proto3" json:"parent_idx,omitempty"`
+	Partitions           []*DmlPartition `protobuf:"bytes,18,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateCtx) Reset()         { *m = UpdateCtx{} }
 func (m *UpdateCtx) String() string { return proto.CompactTextString(m) }
 func (*UpdateCtx) ProtoMessage()    {}
 func (*UpdateCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *UpdateCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4599,6 +4782,13 @@ func (m *UpdateCtx) GetParentIdx() []*ColPosMap {
 	return nil
 }
 
+func (m *UpdateCtx) GetPartitions() []*DmlPartition {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
 type AnalyzeInfo struct {
 	InputRows            int64    `protobuf:"varint,1,opt,name=input_rows,json=inputRows,proto3" json:"input_rows,omitempty"`
 	OutputRows           int64    `protobuf:"varint,2,opt,name=output_rows,json=outputRows,proto3" json:"output_rows,omitempty"`
@@ -4623,7 +4813,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4791,18 +4981,19 @@ type Node struct {
 	NotCacheable bool          `protobuf:"varint,29,opt,name=not_cacheable,json=notCacheable,proto3" json:"not_cacheable,omitempty"`
 	InsertCtx    *InsertCtx    `protobuf:"bytes,30,opt,name=insert_ctx,json=insertCtx,proto3" json:"insert_ctx,omitempty"`
 	// used to connect two plans[steps]
-	CurrentStep          int32    `protobuf:"varint,31,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
-	SourceStep           int32    `protobuf:"varint,32,opt,name=source_step,json=sourceStep,proto3" json:"source_step,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CurrentStep          int32           `protobuf:"varint,31,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`
+	SourceStep           int32           `protobuf:"varint,32,opt,name=source_step,json=sourceStep,proto3" json:"source_step,omitempty"`
+	PartitionPrune       *PartitionPrune `protobuf:"bytes,33,opt,name=partition_prune,json=partitionPrune,proto3" json:"partition_prune,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5055,6 +5246,13 @@ func (m *Node) GetSourceStep() int32 {
 	return 0
 }
 
+func (m *Node) GetPartitionPrune() *PartitionPrune {
+	if m != nil {
+		return m.PartitionPrune
+	}
+	return nil
+}
+
 type IdList struct {
 	List                 []int64  `protobuf:"varint,1,rep,packed,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5066,7 +5264,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5113,7 +5311,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5149,30 +5347,94 @@ func (m *ColPosMap) GetMap() map[string]int32 {
 	return nil
 }
 
+type DmlPartition struct {
+	PartitionTableNames  []string `protobuf:"bytes,1,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
+	Idx                  int32    `protobuf:"varint,2,opt,name=idx,proto3" json:"idx,omitempty"`
+	PartitionExpression  *Expr    `protobuf:"bytes,3,opt,name=partition_expression,json=partitionExpression,proto3" json:"partition_expression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DmlPartition) Reset()         { *m = DmlPartition{} }
+func (m *DmlPartition) String() string { return proto.CompactTextString(m) }
+func (*DmlPartition) ProtoMessage()    {}
+func (*DmlPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
+}
+func (m *DmlPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DmlPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DmlPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DmlPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DmlPartition.Merge(m, src)
+}
+func (m *DmlPartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DmlPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DmlPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DmlPartition proto.InternalMessageInfo
+
+func (m *DmlPartition) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
+func (m *DmlPartition) GetIdx() int32 {
+	if m != nil {
+		return m.Idx
+	}
+	return 0
+}
+
+func (m *DmlPartition) GetPartitionExpression() *Expr {
+	if m != nil {
+		return m.PartitionExpression
+	}
+	return nil
+}
+
 type DeleteCtx struct {
-	Ref                  []*ObjectRef `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
-	Idx                  []*IdList    `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
-	IdxRef               []*ObjectRef `protobuf:"bytes,3,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
-	IdxIdx               []int32      `protobuf:"varint,4,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
-	OnRestrictRef        []*ObjectRef `protobuf:"bytes,5,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
-	OnRestrictIdx        []int32      `protobuf:"varint,6,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
-	OnCascadeRef         []*ObjectRef `protobuf:"bytes,7,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
-	OnCascadeIdx         []int32      `protobuf:"varint,8,rep,packed,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
-	OnSetRef             []*ObjectRef `protobuf:"bytes,9,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
-	OnSetDef             []*TableDef  `protobuf:"bytes,10,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
-	OnSetIdx             []*IdList    `protobuf:"bytes,11,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
-	OnSetUpdateCol       []*ColPosMap `protobuf:"bytes,12,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
-	CanTruncate          bool         `protobuf:"varint,13,opt,name=can_truncate,json=canTruncate,proto3" json:"can_truncate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Ref                  []*ObjectRef    `protobuf:"bytes,1,rep,name=ref,proto3" json:"ref,omitempty"`
+	Idx                  []*IdList       `protobuf:"bytes,2,rep,name=idx,proto3" json:"idx,omitempty"`
+	IdxRef               []*ObjectRef    `protobuf:"bytes,3,rep,name=idx_ref,json=idxRef,proto3" json:"idx_ref,omitempty"`
+	IdxIdx               []int32         `protobuf:"varint,4,rep,packed,name=idx_idx,json=idxIdx,proto3" json:"idx_idx,omitempty"`
+	OnRestrictRef        []*ObjectRef    `protobuf:"bytes,5,rep,name=on_restrict_ref,json=onRestrictRef,proto3" json:"on_restrict_ref,omitempty"`
+	OnRestrictIdx        []int32         `protobuf:"varint,6,rep,packed,name=on_restrict_idx,json=onRestrictIdx,proto3" json:"on_restrict_idx,omitempty"`
+	OnCascadeRef         []*ObjectRef    `protobuf:"bytes,7,rep,name=on_cascade_ref,json=onCascadeRef,proto3" json:"on_cascade_ref,omitempty"`
+	OnCascadeIdx         []int32         `protobuf:"varint,8,rep,packed,name=on_cascade_idx,json=onCascadeIdx,proto3" json:"on_cascade_idx,omitempty"`
+	OnSetRef             []*ObjectRef    `protobuf:"bytes,9,rep,name=on_set_ref,json=onSetRef,proto3" json:"on_set_ref,omitempty"`
+	OnSetDef             []*TableDef     `protobuf:"bytes,10,rep,name=on_set_def,json=onSetDef,proto3" json:"on_set_def,omitempty"`
+	OnSetIdx             []*IdList       `protobuf:"bytes,11,rep,name=on_set_idx,json=onSetIdx,proto3" json:"on_set_idx,omitempty"`
+	OnSetUpdateCol       []*ColPosMap    `protobuf:"bytes,12,rep,name=on_set_update_col,json=onSetUpdateCol,proto3" json:"on_set_update_col,omitempty"`
+	CanTruncate          bool            `protobuf:"varint,13,opt,name=can_truncate,json=canTruncate,proto3" json:"can_truncate,omitempty"`
+	Partitions           []*DmlPartition `protobuf:"bytes,14,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5292,6 +5554,13 @@ func (m *DeleteCtx) GetCanTruncate() bool {
 	return false
 }
 
+func (m *DeleteCtx) GetPartitions() []*DmlPartition {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
 type Query struct {
 	StmtType Query_StatementType `protobuf:"varint,1,opt,name=stmt_type,json=stmtType,proto3,enum=plan.Query_StatementType" json:"stmt_type,omitempty"`
 	// Each step is simply a root node.  Root node refers to other
@@ -5315,7 +5584,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5403,7 +5672,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5507,7 +5776,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5554,7 +5823,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5601,7 +5870,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5655,7 +5924,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5771,7 +6040,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5825,7 +6094,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5965,7 +6234,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6245,7 +6514,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6301,7 +6570,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6363,7 +6632,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6418,7 +6687,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6472,7 +6741,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6529,7 +6798,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6634,7 +6903,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6698,7 +6967,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6770,7 +7039,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6848,7 +7117,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6919,7 +7188,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6976,6 +7245,361 @@ func (m *AlterTableAlterIndex) GetVisible() bool {
 	return false
 }
 
+type AlterColumnPosition struct {
+	Typ                  int32    `protobuf:"varint,1,opt,name=typ,proto3" json:"typ,omitempty"`
+	RelativeColumn       string   `protobuf:"bytes,2,opt,name=relative_column,json=relativeColumn,proto3" json:"relative_column,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterColumnPosition) Reset()         { *m = AlterColumnPosition{} }
+func (m *AlterColumnPosition) String() string { return proto.CompactTextString(m) }
+func (*AlterColumnPosition) ProtoMessage()    {}
+func (*AlterColumnPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
+}
+func (m *AlterColumnPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterColumnPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterColumnPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterColumnPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterColumnPosition.Merge(m, src)
+}
+func (m *AlterColumnPosition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterColumnPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterColumnPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterColumnPosition proto.InternalMessageInfo
+
+func (m *AlterColumnPosition) GetTyp() int32 {
+	if m != nil {
+		return m.Typ
+	}
+	return 0
+}
+
+func (m *AlterColumnPosition) GetRelativeColumn() string {
+	if m != nil {
+		return m.RelativeColumn
+	}
+	return ""
+}
+
+type AlterTableAddColumn struct {
+	Column               *ColDef              `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Position             *AlterColumnPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AlterTableAddColumn) Reset()         { *m = AlterTableAddColumn{} }
+func (m *AlterTableAddColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAddColumn) ProtoMessage()    {}
+func (*AlterTableAddColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
+}
+func (m *AlterTableAddColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableAddColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableAddColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableAddColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAddColumn.Merge(m, src)
+}
+func (m *AlterTableAddColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableAddColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAddColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableAddColumn proto.InternalMessageInfo
+
+func (m *AlterTableAddColumn) GetColumn() *ColDef {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+func (m *AlterTableAddColumn) GetPosition() *AlterColumnPosition {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+type AlterTableModifyColumn struct {
+	OldName              string               `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	Column               *ColDef              `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Position             *AlterColumnPosition `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AlterTableModifyColumn) Reset()         { *m = AlterTableModifyColumn{} }
+func (m *AlterTableModifyColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableModifyColumn) ProtoMessage()    {}
+func (*AlterTableModifyColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
+}
+func (m *AlterTableModifyColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableModifyColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableModifyColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableModifyColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableModifyColumn.Merge(m, src)
+}
+func (m *AlterTableModifyColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableModifyColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableModifyColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableModifyColumn proto.InternalMessageInfo
+
+func (m *AlterTableModifyColumn) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *AlterTableModifyColumn) GetColumn() *ColDef {
+	if m != nil {
+		return m.Column
+	}
+	return nil
+}
+
+func (m *AlterTableModifyColumn) GetPosition() *AlterColumnPosition {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
+type AlterTableRenameColumn struct {
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTableRenameColumn) Reset()         { *m = AlterTableRenameColumn{} }
+func (m *AlterTableRenameColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRenameColumn) ProtoMessage()    {}
+func (*AlterTableRenameColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
+}
+func (m *AlterTableRenameColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTableRenameColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTableRenameColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTableRenameColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRenameColumn.Merge(m, src)
+}
+func (m *AlterTableRenameColumn) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTableRenameColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRenameColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTableRenameColumn proto.InternalMessageInfo
+
+func (m *AlterTableRenameColumn) GetOldName() string {
+	if m != nil {
+		return m.OldName
+	}
+	return ""
+}
+
+func (m *AlterTableRenameColumn) GetNewName() string {
+	if m != nil {
+		return m.NewName
+	}
+	return ""
+}
+
+type AlterTablePartition struct {
+	Typ                  AlterTablePartition_AlterPartitionType `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.AlterTablePartition_AlterPartitionType" json:"typ,omitempty"`
+	NewPartition         *PartitionByDef                        `protobuf:"bytes,2,opt,name=new_partition,json=newPartition,proto3" json:"new_partition,omitempty"`
+	CreateTables         []*TableDef                            `protobuf:"bytes,3,rep,name=create_tables,json=createTables,proto3" json:"create_tables,omitempty"`
+	DropTables           []string                               `protobuf:"bytes,4,rep,name=drop_tables,json=dropTables,proto3" json:"drop_tables,omitempty"`
+	TruncateTables       []string                               `protobuf:"bytes,5,rep,name=truncate_tables,json=truncateTables,proto3" json:"truncate_tables,omitempty"`
+	ExchangeDatabase     string                                 `protobuf:"bytes,7,opt,name=exchange_database,json=exchangeDatabase,proto3" json:"exchange_database,omitempty"`
+	ExchangeTable        string                                 `protobuf:"bytes,8,opt,name=exchange_table,json=exchangeTable,proto3" json:"exchange_table,omitempty"`
+	WithoutValidation    bool                                   `protobuf:"varint,9,opt,name=without_validation,json=withoutValidation,proto3" json:"without_validation,omitempty"`
+	PartitionExpression  *Expr                                  `protobuf:"bytes,12,opt,name=partition_expression,json=partitionExpression,proto3" json:"partition_expression,omitempty"`
+	ExchangePartitionIdx int32                                  `protobuf:"varint,13,opt,name=exchange_partition_idx,json=exchangePartitionIdx,proto3" json:"exchange_partition_idx,omitempty"`
+	ExchangeTableDef     *TableDef                              `protobuf:"bytes,14,opt,name=exchange_table_def,json=exchangeTableDef,proto3" json:"exchange_table_def,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *AlterTablePartition) Reset()         { *m = AlterTablePartition{} }
+func (m *AlterTablePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTablePartition) ProtoMessage()    {}
+func (*AlterTablePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
+}
+func (m *AlterTablePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTablePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTablePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTablePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTablePartition.Merge(m, src)
+}
+func (m *AlterTablePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTablePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTablePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTablePartition proto.InternalMessageInfo
+
+func (m *AlterTablePartition) GetTyp() AlterTablePartition_AlterPartitionType {
+	if m != nil {
+		return m.Typ
+	}
+	return AlterTablePartition_ADD_PARTITION
+}
+
+func (m *AlterTablePartition) GetNewPartition() *PartitionByDef {
+	if m != nil {
+		return m.NewPartition
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetCreateTables() []*TableDef {
+	if m != nil {
+		return m.CreateTables
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetDropTables() []string {
+	if m != nil {
+		return m.DropTables
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetTruncateTables() []string {
+	if m != nil {
+		return m.TruncateTables
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetExchangeDatabase() string {
+	if m != nil {
+		return m.ExchangeDatabase
+	}
+	return ""
+}
+
+func (m *AlterTablePartition) GetExchangeTable() string {
+	if m != nil {
+		return m.ExchangeTable
+	}
+	return ""
+}
+
+func (m *AlterTablePartition) GetWithoutValidation() bool {
+	if m != nil {
+		return m.WithoutValidation
+	}
+	return false
+}
+
+func (m *AlterTablePartition) GetPartitionExpression() *Expr {
+	if m != nil {
+		return m.PartitionExpression
+	}
+	return nil
+}
+
+func (m *AlterTablePartition) GetExchangePartitionIdx() int32 {
+	if m != nil {
+		return m.ExchangePartitionIdx
+	}
+	return 0
+}
+
+func (m *AlterTablePartition) GetExchangeTableDef() *TableDef {
+	if m != nil {
+		return m.ExchangeTableDef
+	}
+	return nil
+}
+
 type AlterTable struct {
 	Database             string               `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	TableDef             *TableDef            `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
@@ -6990,7 +7614,7 @@ func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7053,6 +7677,10 @@ type AlterTable_Action struct {
 	//	*AlterTable_Action_AddFk
 	//	*AlterTable_Action_AddIndex
 	//	*AlterTable_Action_AlterIndex
+	//	*AlterTable_Action_AddColumn
+	//	*AlterTable_Action_ModifyColumn
+	//	*AlterTable_Action_RenameColumn
+	//	*AlterTable_Action_AlterPartition
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -7063,7 +7691,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{77, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7110,11 +7738,27 @@ type AlterTable_Action_AddIndex struct {
 type AlterTable_Action_AlterIndex struct {
 	AlterIndex *AlterTableAlterIndex `protobuf:"bytes,4,opt,name=alter_index,json=alterIndex,proto3,oneof" json:"alter_index,omitempty"`
 }
+type AlterTable_Action_AddColumn struct {
+	AddColumn *AlterTableAddColumn `protobuf:"bytes,5,opt,name=add_column,json=addColumn,proto3,oneof" json:"add_column,omitempty"`
+}
+type AlterTable_Action_ModifyColumn struct {
+	ModifyColumn *AlterTableModifyColumn `protobuf:"bytes,6,opt,name=modify_column,json=modifyColumn,proto3,oneof" json:"modify_column,omitempty"`
+}
+type AlterTable_Action_RenameColumn struct {
+	RenameColumn *AlterTableRenameColumn `protobuf:"bytes,7,opt,name=rename_column,json=renameColumn,proto3,oneof" json:"rename_column,omitempty"`
+}
+type AlterTable_Action_AlterPartition struct {
+	AlterPartition *AlterTablePartition `protobuf:"bytes,8,opt,name=alter_partition,json=alterPartition,proto3,oneof" json:"alter_partition,omitempty"`
+}
 
-func (*AlterTable_Action_Drop) isAlterTable_Action_Action()       {}
-func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()      {}
-func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()   {}
-func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action() {}
+func (*AlterTable_Action_Drop) isAlterTable_Action_Action()           {}
+func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()          {}
+func (*AlterTable_Action_AddIndex) isAlterTable_Action_Action()       {}
+func (*AlterTable_Action_AlterIndex) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()      {}
+func (*AlterTable_Action_ModifyColumn) isAlterTable_Action_Action()   {}
+func (*AlterTable_Action_RenameColumn) isAlterTable_Action_Action()   {}
+func (*AlterTable_Action_AlterPartition) isAlterTable_Action_Action() {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -7151,6 +7795,34 @@ func (m *AlterTable_Action) GetAlterIndex() *AlterTableAlterIndex {
 	return nil
 }
 
+func (m *AlterTable_Action) GetAddColumn() *AlterTableAddColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_AddColumn); ok {
+		return x.AddColumn
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetModifyColumn() *AlterTableModifyColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_ModifyColumn); ok {
+		return x.ModifyColumn
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetRenameColumn() *AlterTableRenameColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_RenameColumn); ok {
+		return x.RenameColumn
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetAlterPartition() *AlterTablePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_AlterPartition); ok {
+		return x.AlterPartition
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -7158,6 +7830,10 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_AddFk)(nil),
 		(*AlterTable_Action_AddIndex)(nil),
 		(*AlterTable_Action_AlterIndex)(nil),
+		(*AlterTable_Action_AddColumn)(nil),
+		(*AlterTable_Action_ModifyColumn)(nil),
+		(*AlterTable_Action_RenameColumn)(nil),
+		(*AlterTable_Action_AlterPartition)(nil),
 	}
 }
 
@@ -7179,7 +7855,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7277,7 +7953,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7340,7 +8016,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7404,7 +8080,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7471,7 +8147,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7515,7 +8191,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7590,7 +8266,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7640,7 +8316,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7704,6 +8380,7 @@ type TruncateTable struct {
 	ClusterTable         *ClusterTable `protobuf:"bytes,4,opt,name=cluster_table,json=clusterTable,proto3" json:"cluster_table,omitempty"`
 	TableId              uint64        `protobuf:"varint,5,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	ForeignTbl           []uint64      `protobuf:"varint,6,rep,packed,name=foreign_tbl,json=foreignTbl,proto3" json:"foreign_tbl,omitempty"`
+	PartitionTableNames  []string      `protobuf:"bytes,7,rep,name=partition_table_names,json=partitionTableNames,proto3" json:"partition_table_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -7713,7 +8390,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7784,6 +8461,13 @@ func (m *TruncateTable) GetForeignTbl() []uint64 {
 	return nil
 }
 
+func (m *TruncateTable) GetPartitionTableNames() []string {
+	if m != nil {
+		return m.PartitionTableNames
+	}
+	return nil
+}
+
 type ClusterTable struct {
 	IsClusterTable         bool     `protobuf:"varint,1,opt,name=is_cluster_table,json=isClusterTable,proto3" json:"is_cluster_table,omitempty"`
 	AccountIDs             []uint32 `protobuf:"varint,2,rep,packed,name=accountIDs,proto3" json:"accountIDs,omitempty"`
@@ -7797,7 +8481,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7859,7 +8543,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7913,7 +8597,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7964,7 +8648,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8042,7 +8726,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8111,7 +8795,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8165,7 +8849,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8213,7 +8897,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8267,7 +8951,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8313,7 +8997,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8360,6 +9044,7 @@ func init() {
 	proto.RegisterEnum("plan.DataControl_DclType", DataControl_DclType_name, DataControl_DclType_value)
 	proto.RegisterEnum("plan.DataDefinition_DdlType", DataDefinition_DdlType_name, DataDefinition_DdlType_value)
 	proto.RegisterEnum("plan.AlterTableDrop_Typ", AlterTableDrop_Typ_name, AlterTableDrop_Typ_value)
+	proto.RegisterEnum("plan.AlterTablePartition_AlterPartitionType", AlterTablePartition_AlterPartitionType_name, AlterTablePartition_AlterPartitionType_value)
 	proto.RegisterType((*Type)(nil), "plan.Type")
 	proto.RegisterType((*Const)(nil), "plan.Const")
 	proto.RegisterType((*ParamRef)(nil), "plan.ParamRef")
@@ -8383,6 +9068,7 @@ func init() {
 	proto.RegisterType((*OnUpdate)(nil), "plan.OnUpdate")
 	proto.RegisterType((*IndexOption)(nil), "plan.IndexOption")
 	proto.RegisterType((*PrimaryKeyDef)(nil), "plan.PrimaryKeyDef")
+	proto.RegisterType((*TTLDef)(nil), "plan.TTLDef")
 	proto.RegisterType((*IndexDef)(nil), "plan.IndexDef")
 	proto.RegisterType((*ForeignKeyDef)(nil), "plan.ForeignKeyDef")
 	proto.RegisterType((*CheckDef)(nil), "plan.CheckDef")
@@ -8394,6 +9080,7 @@ func init() {
 	proto.RegisterType((*PartitionExpr)(nil), "plan.PartitionExpr")
 	proto.RegisterType((*PartitionColumns)(nil), "plan.PartitionColumns")
 	proto.RegisterType((*PartitionItem)(nil), "plan.PartitionItem")
+	proto.RegisterType((*PartitionPrune)(nil), "plan.PartitionPrune")
 	proto.RegisterType((*ViewDef)(nil), "plan.ViewDef")
 	proto.RegisterType((*TableDef)(nil), "plan.TableDef")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.TableDef.Name2colIndexEntry")
@@ -8413,6 +9100,7 @@ func init() {
 	proto.RegisterType((*IdList)(nil), "plan.IdList")
 	proto.RegisterType((*ColPosMap)(nil), "plan.ColPosMap")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.ColPosMap.MapEntry")
+	proto.RegisterType((*DmlPartition)(nil), "plan.DmlPartition")
 	proto.RegisterType((*DeleteCtx)(nil), "plan.DeleteCtx")
 	proto.RegisterType((*Query)(nil), "plan.Query")
 	proto.RegisterType((*TransationControl)(nil), "plan.TransationControl")
@@ -8434,6 +9122,11 @@ func init() {
 	proto.RegisterType((*AlterTableAddIndex)(nil), "plan.AlterTableAddIndex")
 	proto.RegisterType((*AlterTableDropIndex)(nil), "plan.AlterTableDropIndex")
 	proto.RegisterType((*AlterTableAlterIndex)(nil), "plan.AlterTableAlterIndex")
+	proto.RegisterType((*AlterColumnPosition)(nil), "plan.AlterColumnPosition")
+	proto.RegisterType((*AlterTableAddColumn)(nil), "plan.AlterTableAddColumn")
+	proto.RegisterType((*AlterTableModifyColumn)(nil), "plan.AlterTableModifyColumn")
+	proto.RegisterType((*AlterTableRenameColumn)(nil), "plan.AlterTableRenameColumn")
+	proto.RegisterType((*AlterTablePartition)(nil), "plan.AlterTablePartition")
 	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
 	proto.RegisterType((*AlterTable_Action)(nil), "plan.AlterTable.Action")
 	proto.RegisterType((*DropTable)(nil), "plan.DropTable")
@@ -8460,472 +9153,507 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 7436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7c, 0x4b, 0x8c, 0x1b, 0x47,
-	0xda, 0xd8, 0xf0, 0x4d, 0x7e, 0x7c, 0x4c, 0xab, 0xf4, 0xa2, 0x64, 0x59, 0x1e, 0xb7, 0xb5, 0xb6,
-	0x2c, 0x7b, 0x65, 0x7b, 0xfc, 0x76, 0xbc, 0xd8, 0xe5, 0x90, 0xd4, 0x0c, 0x6d, 0x8a, 0x9c, 0x2d,
-	0x72, 0xa4, 0x75, 0x7e, 0x04, 0x44, 0x93, 0xdd, 0x1c, 0xb5, 0xa6, 0xd9, 0x4d, 0x77, 0x37, 0x35,
-	0x33, 0x0b, 0xfc, 0xc0, 0x9e, 0x12, 0xe4, 0x94, 0x43, 0x80, 0x24, 0xc0, 0x06, 0xc8, 0x26, 0x87,
-	0x00, 0xf9, 0x2f, 0x39, 0xe6, 0x92, 0x4b, 0x92, 0x4b, 0x02, 0xe4, 0x90, 0x00, 0xc9, 0x25, 0xb9,
-	0x24, 0x4e, 0xf0, 0xdf, 0x83, 0x3f, 0xc7, 0x1c, 0x82, 0xef, 0xab, 0xea, 0xee, 0x6a, 0x0e, 0xb5,
-	0x92, 0xb5, 0xfe, 0x2f, 0x33, 0x5d, 0xdf, 0xa3, 0xea, 0xab, 0xaa, 0xaf, 0xbe, 0x47, 0x3d, 0x08,
-	0xb0, 0x74, 0x0c, 0xf7, 0xfe, 0xd2, 0xf7, 0x42, 0x8f, 0xe5, 0xf1, 0xfb, 0xe6, 0xcf, 0x8f, 0xed,
-	0xf0, 0xc9, 0x6a, 0x7a, 0x7f, 0xe6, 0x2d, 0x3e, 0x38, 0xf6, 0x8e, 0xbd, 0x0f, 0x08, 0x39, 0x5d,
-	0xcd, 0xa9, 0x44, 0x05, 0xfa, 0x12, 0x4c, 0xfa, 0x3f, 0xcc, 0x40, 0x7e, 0x7c, 0xbe, 0xb4, 0x58,
-	0x03, 0xb2, 0xb6, 0xd9, 0xcc, 0xec, 0x64, 0xee, 0x16, 0x78, 0xd6, 0x36, 0xd9, 0x0e, 0x54, 0x5d,
-	0x2f, 0x1c, 0xac, 0x1c, 0xc7, 0x98, 0x3a, 0x56, 0x33, 0xbb, 0x93, 0xb9, 0x5b, 0xe6, 0x2a, 0x88,
-	0xbd, 0x06, 0x15, 0x63, 0x15, 0x7a, 0x13, 0xdb, 0x9d, 0xf9, 0xcd, 0x1c, 0xe1, 0xcb, 0x08, 0xe8,
-	0xb9, 0x33, 0x9f, 0x5d, 0x81, 0xc2, 0xa9, 0x6d, 0x86, 0x4f, 0x9a, 0x79, 0xaa, 0x51, 0x14, 0x10,
-	0x1a, 0xcc, 0x0c, 0xc7, 0x6a, 0x16, 0x04, 0x94, 0x0a, 0x08, 0x0d, 0xa9, 0x91, 0xe2, 0x4e, 0xe6,
-	0x6e, 0x85, 0x8b, 0x82, 0xfe, 0x9f, 0x0b, 0x50, 0x68, 0x7b, 0x6e, 0x10, 0xb2, 0x6b, 0x50, 0xb4,
-	0x03, 0x77, 0xe5, 0x38, 0x24, 0x5e, 0x99, 0xcb, 0x12, 0xbb, 0x06, 0x05, 0xfb, 0x8b, 0x67, 0x86,
-	0x43, 0xc2, 0x15, 0x0e, 0xb6, 0xb8, 0x28, 0xb2, 0x26, 0x14, 0xed, 0x8f, 0x3e, 0x43, 0x44, 0x4e,
-	0x22, 0x64, 0x99, 0x30, 0x1f, 0xef, 0x22, 0x26, 0x1f, 0x63, 0xa8, 0x4c, 0x98, 0xcf, 0x3e, 0x41,
-	0x0c, 0x8a, 0x96, 0x23, 0x0c, 0x95, 0xb1, 0x95, 0x15, 0xb5, 0x82, 0xd2, 0xd5, 0xb1, 0x95, 0x55,
-	0xd4, 0xca, 0x4a, 0xb4, 0x52, 0x92, 0x08, 0x59, 0x26, 0x8c, 0x68, 0xa5, 0x1c, 0x63, 0xe2, 0x56,
-	0x56, 0xa2, 0x95, 0xca, 0x4e, 0xe6, 0x6e, 0x9e, 0x30, 0xa2, 0x95, 0x2b, 0x90, 0x37, 0x11, 0x0e,
-	0x3b, 0x99, 0xbb, 0x99, 0x83, 0x2d, 0x4e, 0x25, 0x84, 0x06, 0x08, 0xad, 0xe2, 0xc0, 0x20, 0x34,
-	0x90, 0xd0, 0x29, 0x42, 0x6b, 0x38, 0x1a, 0x08, 0x9d, 0x4a, 0xe8, 0x1c, 0xa1, 0xf5, 0x9d, 0xcc,
-	0xdd, 0x2c, 0x42, 0xb1, 0xc4, 0x6e, 0x42, 0xc9, 0x34, 0x42, 0x0b, 0x11, 0x0d, 0xd9, 0xe5, 0x08,
-	0x80, 0xb8, 0xd0, 0x5e, 0x10, 0x6e, 0x5b, 0x76, 0x3a, 0x02, 0x30, 0x1d, 0xaa, 0x48, 0x16, 0xe1,
-	0x35, 0x89, 0x57, 0x81, 0xec, 0x53, 0xa8, 0x99, 0xd6, 0xcc, 0x5e, 0x18, 0x8e, 0xe8, 0xd3, 0xa5,
-	0x9d, 0xcc, 0xdd, 0xea, 0xee, 0xf6, 0x7d, 0xd2, 0xc9, 0x18, 0x73, 0xb0, 0xc5, 0x53, 0x64, 0xec,
-	0x0b, 0xa8, 0xcb, 0xf2, 0x47, 0xbb, 0x34, 0xb0, 0x8c, 0xf8, 0xb4, 0x14, 0xdf, 0x47, 0xbb, 0x5f,
-	0x1c, 0x6c, 0xf1, 0x34, 0x21, 0xbb, 0x03, 0x35, 0x6c, 0x3b, 0x08, 0x8d, 0xc5, 0x12, 0x19, 0x2f,
-	0x4b, 0xa9, 0x52, 0x50, 0xec, 0xd6, 0xd3, 0xc0, 0x73, 0x91, 0xe0, 0x8a, 0x1c, 0xb7, 0x08, 0xc0,
-	0x76, 0x00, 0x4c, 0x6b, 0x6e, 0xac, 0x9c, 0x10, 0xd1, 0x57, 0xe5, 0x00, 0x2a, 0x30, 0x76, 0x1b,
-	0x2a, 0xab, 0x25, 0xf6, 0xf2, 0x91, 0xe1, 0x34, 0xaf, 0x49, 0x82, 0x04, 0x84, 0xca, 0x6a, 0x07,
-	0x7b, 0xb6, 0xdb, 0xbc, 0x4e, 0xba, 0x28, 0x0a, 0xec, 0x16, 0xe4, 0x02, 0x7f, 0xd6, 0x6c, 0x52,
-	0x4f, 0x40, 0xf4, 0xa4, 0x7b, 0xb6, 0xf4, 0x39, 0x82, 0xf7, 0x4a, 0x50, 0x78, 0x66, 0x38, 0x2b,
-	0x4b, 0xbf, 0x05, 0xe5, 0x43, 0xc3, 0x37, 0x16, 0xdc, 0x9a, 0x33, 0x0d, 0x72, 0x4b, 0x2f, 0x90,
-	0x2b, 0x0e, 0x3f, 0xf5, 0x3e, 0x14, 0x1f, 0x19, 0x3e, 0xe2, 0x18, 0xe4, 0x5d, 0x63, 0x61, 0x11,
-	0xb2, 0xc2, 0xe9, 0x1b, 0x57, 0x41, 0x70, 0x1e, 0x84, 0xd6, 0x42, 0xae, 0x45, 0x59, 0x42, 0xf8,
-	0xb1, 0xe3, 0x4d, 0xa5, 0xb6, 0x97, 0xb9, 0x2c, 0xe9, 0x03, 0x28, 0xb6, 0x3d, 0x07, 0x6b, 0xbb,
-	0x0e, 0x25, 0xdf, 0x72, 0x26, 0x49, 0x6b, 0x45, 0xdf, 0x72, 0x0e, 0xbd, 0x00, 0x11, 0x33, 0x4f,
-	0x20, 0xb2, 0x02, 0x31, 0xf3, 0x08, 0x11, 0xb5, 0x9f, 0x4b, 0xda, 0xd7, 0xbf, 0x84, 0x0a, 0x37,
-	0x4e, 0x65, 0x95, 0x57, 0xa1, 0x18, 0x4e, 0x9d, 0x89, 0xb4, 0x18, 0x79, 0x5e, 0x08, 0xa7, 0x4e,
-	0xcf, 0x44, 0x30, 0x56, 0x68, 0x9b, 0x54, 0x5f, 0x9e, 0x17, 0x66, 0x9e, 0xd3, 0x33, 0xf5, 0x31,
-	0x40, 0xdb, 0xf3, 0xfd, 0x57, 0x16, 0xe7, 0x0a, 0x14, 0x4c, 0x6b, 0x19, 0x3e, 0x11, 0xeb, 0x99,
-	0x8b, 0x82, 0x7e, 0x0f, 0xca, 0x38, 0xc4, 0x7d, 0x3b, 0x08, 0xd9, 0x6d, 0xc8, 0x3b, 0x76, 0x10,
-	0x36, 0x33, 0x3b, 0xb9, 0xb5, 0x09, 0x20, 0xb8, 0xbe, 0x03, 0xe5, 0x87, 0xc6, 0xd9, 0x23, 0x9c,
-	0x04, 0xac, 0x8d, 0x66, 0x43, 0x8e, 0xae, 0x9c, 0x9a, 0x7b, 0x00, 0x63, 0xc3, 0x3f, 0xb6, 0x42,
-	0xb2, 0x86, 0xb7, 0x20, 0x17, 0x9e, 0x2f, 0x89, 0x22, 0xae, 0x0e, 0x11, 0x1c, 0xc1, 0xfa, 0x5f,
-	0x65, 0xa0, 0x3a, 0x5a, 0x4d, 0xbf, 0x5f, 0x59, 0xfe, 0x39, 0xf6, 0xe8, 0x6e, 0x42, 0xdd, 0xd8,
-	0xbd, 0x26, 0xa8, 0x15, 0x7c, 0xc2, 0x89, 0x5d, 0x74, 0x3d, 0xd3, 0x8a, 0x46, 0xa8, 0xc0, 0x8b,
-	0x58, 0xec, 0x99, 0x68, 0x7e, 0xbd, 0xa5, 0x1c, 0xef, 0xac, 0xb7, 0x64, 0x3b, 0x50, 0x98, 0x3d,
-	0xb1, 0x1d, 0x93, 0x0c, 0x55, 0xba, 0x47, 0x02, 0xc1, 0x6e, 0x40, 0xd9, 0xf7, 0x4e, 0x27, 0x81,
-	0xfd, 0xdb, 0xc8, 0x9c, 0x96, 0x7c, 0xef, 0x74, 0x64, 0xff, 0xd6, 0xd2, 0xc7, 0xd2, 0xa6, 0x03,
-	0x14, 0x47, 0xed, 0x56, 0xbf, 0xc5, 0xb5, 0x2d, 0xfc, 0xee, 0xfe, 0xa6, 0x37, 0x1a, 0x8f, 0xb4,
-	0x0c, 0x6b, 0x00, 0x0c, 0x86, 0xe3, 0x89, 0x2c, 0x67, 0x59, 0x11, 0xb2, 0xbd, 0x81, 0x96, 0x43,
-	0x1a, 0x84, 0xf7, 0x06, 0x5a, 0x9e, 0x95, 0x20, 0xd7, 0x1a, 0x7c, 0xa7, 0x15, 0xe8, 0xa3, 0xdf,
-	0xd7, 0x8a, 0xfa, 0x3f, 0xcf, 0x42, 0x65, 0x38, 0x7d, 0x6a, 0xcd, 0x42, 0xec, 0x33, 0xaa, 0xa3,
-	0xe5, 0x3f, 0xb3, 0x7c, 0xea, 0x76, 0x8e, 0xcb, 0x12, 0x76, 0xc4, 0x9c, 0x52, 0xe7, 0x72, 0x3c,
-	0x6b, 0x4e, 0x89, 0x6e, 0xf6, 0xc4, 0x5a, 0x18, 0xd4, 0x39, 0xa4, 0xa3, 0x12, 0xaa, 0xbf, 0x37,
-	0x7d, 0x4a, 0xdd, 0xcb, 0x71, 0xfc, 0x64, 0x6f, 0x40, 0x55, 0xd4, 0x31, 0x21, 0xdd, 0x2b, 0xd0,
-	0x58, 0x80, 0x00, 0x0d, 0x70, 0x05, 0x5c, 0x87, 0x92, 0x39, 0x15, 0x48, 0xe1, 0x29, 0x8a, 0xe6,
-	0x94, 0x10, 0xc8, 0x49, 0xb5, 0x0a, 0x64, 0x49, 0x72, 0x12, 0x88, 0x08, 0x6e, 0x40, 0xd9, 0x9b,
-	0x3e, 0x15, 0xd8, 0x32, 0x61, 0x4b, 0xde, 0xf4, 0x29, 0xa1, 0xde, 0x83, 0x4b, 0xc1, 0x6a, 0x1a,
-	0xcc, 0x7c, 0x7b, 0x19, 0xda, 0x9e, 0x2b, 0x68, 0x2a, 0x44, 0xa3, 0xa9, 0x08, 0x22, 0xbe, 0x03,
-	0x8d, 0xe5, 0x6a, 0x3a, 0x31, 0x66, 0x33, 0x6f, 0xe5, 0x86, 0x38, 0x8b, 0x40, 0x23, 0x5f, 0x5b,
-	0xae, 0xa6, 0x2d, 0x01, 0xec, 0x99, 0xfa, 0x3f, 0xce, 0x80, 0x36, 0x52, 0x58, 0x1f, 0x5a, 0xa1,
-	0xb1, 0x71, 0x49, 0xbf, 0x0e, 0xa0, 0x54, 0x25, 0x14, 0xa2, 0x62, 0x44, 0xf5, 0xa8, 0xfd, 0xcd,
-	0xa5, 0xfa, 0xfb, 0x26, 0xd4, 0x22, 0x3e, 0xc2, 0xe6, 0x09, 0x5b, 0x95, 0xb0, 0xa8, 0xc7, 0xc1,
-	0x6a, 0xaa, 0x8e, 0x64, 0x29, 0x58, 0x11, 0xb7, 0xfe, 0x7f, 0x32, 0x50, 0x7e, 0xb0, 0x72, 0x67,
-	0x28, 0x1a, 0x7b, 0x0b, 0xf2, 0xf3, 0x95, 0x3b, 0x93, 0x9a, 0x2e, 0x6d, 0x77, 0x3c, 0xcb, 0x9c,
-	0x90, 0xb8, 0xba, 0x0c, 0xff, 0x18, 0x57, 0xe5, 0x85, 0xd5, 0x85, 0x70, 0xfd, 0x9f, 0xc8, 0x1a,
-	0x1f, 0x38, 0xc6, 0x31, 0x2b, 0x43, 0x7e, 0x30, 0x1c, 0x74, 0xb5, 0x2d, 0x56, 0x83, 0x72, 0x6f,
-	0x30, 0xee, 0xf2, 0x41, 0xab, 0xaf, 0x65, 0x48, 0x19, 0xc7, 0xad, 0xbd, 0x7e, 0x57, 0xcb, 0x22,
-	0xe6, 0xd1, 0xb0, 0xdf, 0x1a, 0xf7, 0xfa, 0x5d, 0x2d, 0x2f, 0x30, 0xbc, 0xd7, 0x1e, 0x6b, 0x65,
-	0xa6, 0x41, 0xed, 0x90, 0x0f, 0x3b, 0x47, 0xed, 0xee, 0x64, 0x70, 0xd4, 0xef, 0x6b, 0x1a, 0xbb,
-	0x0c, 0xdb, 0x31, 0x64, 0x28, 0x80, 0x3b, 0xc8, 0xf2, 0xa8, 0xc5, 0x5b, 0x7c, 0x5f, 0xfb, 0x15,
-	0x2b, 0x43, 0xae, 0xb5, 0xbf, 0xaf, 0xfd, 0x2e, 0x83, 0x5f, 0x8f, 0x7b, 0x03, 0xed, 0x77, 0x59,
-	0xd6, 0x80, 0xca, 0xc3, 0xe1, 0x60, 0x38, 0x1e, 0x0e, 0x7a, 0x6d, 0xed, 0x77, 0x79, 0xfd, 0x5f,
-	0xe4, 0x20, 0x8f, 0x02, 0xff, 0xf1, 0x85, 0xcd, 0x5e, 0x83, 0xcc, 0x8c, 0xe6, 0xa1, 0xba, 0x5b,
-	0x15, 0x38, 0x8a, 0x40, 0x0e, 0xb6, 0x78, 0x06, 0x47, 0x21, 0x23, 0x56, 0x68, 0x75, 0xb7, 0x21,
-	0x90, 0x91, 0x2d, 0x47, 0xfc, 0x92, 0xdd, 0x82, 0xcc, 0x33, 0xb9, 0x5c, 0x6b, 0x02, 0x2f, 0xac,
-	0x39, 0x62, 0x9f, 0xb1, 0x1d, 0xc8, 0xcd, 0x3c, 0x11, 0x5d, 0xc4, 0x78, 0x61, 0x10, 0x0f, 0xb6,
-	0x38, 0xa2, 0xd8, 0x5b, 0x90, 0xf3, 0x8d, 0x53, 0x52, 0xed, 0x78, 0x26, 0x62, 0x8b, 0x8b, 0x44,
-	0xbe, 0x71, 0x8a, 0x42, 0xcc, 0x49, 0xc1, 0x63, 0x21, 0xa2, 0xa9, 0xc4, 0x66, 0xe6, 0xec, 0x67,
-	0x90, 0x0b, 0x56, 0x53, 0x52, 0xf2, 0xea, 0xee, 0xa5, 0x0b, 0xa6, 0x08, 0xab, 0x09, 0x56, 0x53,
-	0xf6, 0x36, 0xe4, 0x67, 0x9e, 0xef, 0x93, 0xa2, 0xc7, 0xae, 0x37, 0xb1, 0xd1, 0x18, 0x3e, 0x20,
-	0x9e, 0xed, 0x40, 0x26, 0x24, 0x1d, 0x8f, 0x89, 0x12, 0x23, 0x89, 0x0d, 0x86, 0xec, 0x8e, 0xb4,
-	0xbc, 0x55, 0x55, 0xa6, 0xc8, 0x2e, 0x63, 0x3d, 0x88, 0x65, 0x3a, 0xe4, 0x16, 0xc6, 0x19, 0x45,
-	0x2c, 0x31, 0x51, 0x64, 0x90, 0x51, 0xa6, 0x85, 0x71, 0xb6, 0x57, 0x84, 0xbc, 0x75, 0xb6, 0xf4,
-	0xf5, 0x1b, 0x50, 0x89, 0xe3, 0x05, 0x56, 0x83, 0x8c, 0x21, 0x2d, 0x4c, 0xc6, 0xd0, 0xef, 0xa2,
-	0xfb, 0x8e, 0x22, 0x82, 0x34, 0x0e, 0x4b, 0x91, 0xdd, 0xc9, 0x4c, 0xf5, 0xaf, 0xa1, 0xc6, 0xad,
-	0x60, 0xe5, 0x84, 0x6d, 0xcf, 0xe9, 0x58, 0x73, 0xf6, 0x3e, 0x40, 0x5c, 0x0e, 0xa4, 0x9b, 0x48,
-	0x66, 0xa1, 0x63, 0xcd, 0xb9, 0x82, 0xd7, 0x7f, 0x9f, 0x23, 0xe7, 0xd9, 0x11, 0x9e, 0x4e, 0xba,
-	0xb4, 0x8c, 0xe2, 0xd2, 0xe2, 0xe5, 0x9c, 0x4d, 0x7b, 0xe8, 0x27, 0xb6, 0x69, 0x5a, 0x6e, 0xe4,
-	0x89, 0x45, 0x89, 0xdd, 0x81, 0x9c, 0xe1, 0x1c, 0x93, 0x6a, 0x34, 0x76, 0x59, 0xd4, 0xe8, 0x62,
-	0xe9, 0x5b, 0x41, 0x20, 0x74, 0xcf, 0x70, 0x8e, 0x23, 0xcd, 0x2c, 0x6c, 0xd6, 0xcc, 0x1b, 0x50,
-	0x76, 0xbd, 0x70, 0x42, 0x51, 0x70, 0x91, 0x6a, 0x2f, 0xc9, 0x58, 0x9c, 0xbd, 0x03, 0x25, 0x19,
-	0xbf, 0x48, 0xc5, 0xa8, 0x0b, 0xe6, 0x8e, 0x00, 0xf2, 0x08, 0xcb, 0x9a, 0xe8, 0x5f, 0x17, 0x0b,
-	0xcb, 0x0d, 0x23, 0x23, 0x28, 0x8b, 0xec, 0x3d, 0xa8, 0x78, 0xee, 0x44, 0x04, 0x39, 0x52, 0x27,
-	0xe4, 0x24, 0x0d, 0xdd, 0x23, 0x82, 0xf2, 0xb2, 0x27, 0xbf, 0x50, 0x14, 0xc7, 0x3b, 0x9d, 0xcc,
-	0x0c, 0x5f, 0x98, 0xbf, 0x32, 0x2f, 0x39, 0xde, 0x69, 0xdb, 0xf0, 0x4d, 0xe1, 0x14, 0xbe, 0x77,
-	0x57, 0x0b, 0x0a, 0x36, 0xeb, 0x5c, 0x96, 0xd8, 0x2d, 0xa8, 0xcc, 0x9c, 0x55, 0x10, 0x5a, 0xfe,
-	0xde, 0x39, 0x69, 0x4a, 0x99, 0x27, 0x00, 0x94, 0x6b, 0xe9, 0xdb, 0x0b, 0xc3, 0x3f, 0x17, 0x21,
-	0x2d, 0x8f, 0x8a, 0xe8, 0xaa, 0x97, 0x27, 0xb6, 0x79, 0x46, 0x41, 0x6d, 0x81, 0x8b, 0x82, 0xfe,
-	0x3d, 0x94, 0x64, 0xdf, 0xd0, 0x32, 0xa1, 0xce, 0xa4, 0xd7, 0xb3, 0xb0, 0x4c, 0x08, 0x67, 0x6f,
-	0x41, 0xdd, 0xf3, 0xed, 0x63, 0xdb, 0x9d, 0x04, 0xa1, 0x6f, 0xbb, 0xc7, 0x72, 0xbe, 0x6a, 0x02,
-	0x38, 0x22, 0x18, 0x9a, 0x53, 0x1c, 0xd7, 0x89, 0x31, 0xb5, 0x1d, 0x3b, 0x3c, 0x97, 0xb3, 0x57,
-	0x45, 0x58, 0x4b, 0x80, 0xf4, 0x21, 0x94, 0xa3, 0x91, 0xf8, 0x49, 0xda, 0xd4, 0xff, 0x06, 0x54,
-	0x7b, 0xae, 0x69, 0x9d, 0x0d, 0xc9, 0x43, 0xb0, 0xf7, 0x81, 0xcd, 0x7c, 0xcb, 0x08, 0xad, 0x89,
-	0x75, 0x16, 0xfa, 0xc6, 0x44, 0xe4, 0x43, 0x22, 0xdd, 0xd1, 0x04, 0xa6, 0x8b, 0x88, 0x31, 0xa5,
-	0x46, 0xff, 0x2d, 0x03, 0xf5, 0x43, 0x31, 0x44, 0xdf, 0x5a, 0xe7, 0x1d, 0x11, 0x30, 0xce, 0x22,
-	0xc5, 0xce, 0x73, 0xfa, 0x66, 0xb7, 0xa1, 0xba, 0x3c, 0xb1, 0xce, 0x27, 0xa9, 0x88, 0xac, 0x82,
-	0xa0, 0x36, 0xa9, 0xf0, 0xbb, 0x50, 0xf4, 0xa8, 0x75, 0x69, 0xd4, 0xa4, 0xb5, 0x50, 0xc4, 0xe2,
-	0x92, 0x80, 0xe9, 0x50, 0x8f, 0xab, 0x52, 0x3d, 0x8e, 0xac, 0x8c, 0x3c, 0xce, 0x15, 0x28, 0x20,
-	0x2a, 0x68, 0x16, 0x76, 0x72, 0x18, 0x56, 0x51, 0x81, 0x7d, 0x08, 0xf5, 0x99, 0xb7, 0x58, 0x4e,
-	0x22, 0x76, 0x69, 0xde, 0xd2, 0x4b, 0xaf, 0x8a, 0x24, 0x87, 0xa2, 0x2e, 0xfd, 0x1f, 0x65, 0xa1,
-	0x4c, 0x32, 0xc8, 0xd5, 0x67, 0x9b, 0x67, 0xd1, 0xea, 0xab, 0xf0, 0x82, 0x6d, 0x9e, 0xf5, 0x4c,
-	0x74, 0x9c, 0x36, 0x92, 0x4c, 0x94, 0x35, 0x58, 0x21, 0x48, 0x24, 0xca, 0xd2, 0xf0, 0xc3, 0xa0,
-	0x99, 0x13, 0xa2, 0x50, 0x01, 0x95, 0x73, 0xe5, 0xda, 0xdf, 0xaf, 0x84, 0xf4, 0x65, 0x2e, 0x4b,
-	0xec, 0x2e, 0x68, 0xa2, 0x32, 0x1a, 0x74, 0xd5, 0x65, 0x36, 0x08, 0x4e, 0x63, 0x1e, 0xc5, 0x19,
-	0x82, 0xc6, 0x3a, 0x43, 0x93, 0x27, 0xd6, 0x21, 0x10, 0xa8, 0x8b, 0x10, 0x75, 0x85, 0x95, 0xd2,
-	0x2b, 0xac, 0x09, 0xa5, 0x67, 0x76, 0x60, 0xe3, 0xac, 0x96, 0x85, 0x8e, 0xcb, 0xa2, 0x32, 0x0d,
-	0x95, 0x17, 0x4c, 0x83, 0xfe, 0x1f, 0xb2, 0x50, 0x7f, 0xe0, 0xf9, 0x96, 0x7d, 0xec, 0x26, 0xf3,
-	0x7e, 0x21, 0xaa, 0x88, 0x74, 0x21, 0xab, 0xe8, 0xc2, 0x1b, 0x50, 0x9d, 0x0b, 0xc6, 0x49, 0x38,
-	0x15, 0x99, 0x42, 0x9e, 0x83, 0x04, 0x8d, 0xa7, 0x0e, 0xae, 0x81, 0x88, 0x80, 0x98, 0xf3, 0xc4,
-	0x1c, 0x31, 0xa1, 0x51, 0x64, 0x5f, 0x91, 0x91, 0x30, 0x2d, 0xc7, 0x0a, 0xc5, 0x00, 0x35, 0x76,
-	0x5f, 0x97, 0x2e, 0x48, 0x95, 0xe9, 0x3e, 0xb7, 0xe6, 0x2d, 0xf2, 0x48, 0x68, 0x33, 0x3a, 0x44,
-	0x2e, 0x79, 0xa5, 0x81, 0x29, 0xbe, 0x24, 0xaf, 0x58, 0x6f, 0xfa, 0x18, 0x2a, 0x31, 0x18, 0x23,
-	0x07, 0xde, 0x95, 0xd1, 0xc2, 0x16, 0xab, 0x42, 0xa9, 0xdd, 0x1a, 0xb5, 0x5b, 0x9d, 0xae, 0x96,
-	0x41, 0xd4, 0xa8, 0x3b, 0x16, 0x11, 0x42, 0x96, 0x6d, 0x43, 0x15, 0x4b, 0x9d, 0xee, 0x83, 0xd6,
-	0x51, 0x7f, 0xac, 0xe5, 0x58, 0x1d, 0x2a, 0x83, 0xe1, 0xa4, 0xd5, 0x1e, 0xf7, 0x86, 0x03, 0x2d,
-	0xaf, 0xff, 0x0a, 0xca, 0xed, 0x27, 0xd6, 0xec, 0xe4, 0x79, 0xa3, 0x48, 0x01, 0xb8, 0x35, 0x3b,
-	0x91, 0xe1, 0xc0, 0x5a, 0x00, 0x6e, 0xcd, 0x4e, 0xf4, 0x0e, 0xd4, 0xda, 0x91, 0x0d, 0xc3, 0x5a,
-	0x76, 0x22, 0xad, 0xbb, 0x98, 0x84, 0x48, 0x0d, 0xdc, 0xe0, 0x34, 0xf4, 0x4f, 0xa1, 0x7a, 0xe8,
-	0x7b, 0x4b, 0xcb, 0x0f, 0xa9, 0x12, 0x0d, 0x72, 0x27, 0xd6, 0xb9, 0x94, 0x04, 0x3f, 0x93, 0x74,
-	0x25, 0xab, 0xa6, 0x2b, 0xbb, 0x50, 0x8e, 0xd8, 0x5e, 0x9a, 0xe7, 0x97, 0x68, 0x35, 0x88, 0xc7,
-	0xb6, 0x02, 0x6c, 0xec, 0x3e, 0xc0, 0x32, 0x06, 0x48, 0xb1, 0xa3, 0xd0, 0x46, 0x56, 0xce, 0x15,
-	0x0a, 0xfd, 0xaf, 0x72, 0xd0, 0x38, 0x34, 0xfc, 0xd0, 0xc6, 0xa9, 0x10, 0x9d, 0x7e, 0x07, 0xf2,
-	0xe1, 0xf9, 0xd2, 0x92, 0xb9, 0xcf, 0xe5, 0x38, 0x2e, 0x12, 0x34, 0xe4, 0xbf, 0x88, 0x80, 0x7d,
-	0x05, 0x8d, 0x65, 0x04, 0x9e, 0x90, 0xfd, 0x14, 0x03, 0xbb, 0xce, 0x42, 0xe3, 0x55, 0x5f, 0xaa,
-	0x45, 0xf6, 0x0b, 0xb8, 0x92, 0xe6, 0xb5, 0x82, 0x20, 0xb1, 0x5b, 0xea, 0x40, 0x5f, 0x4e, 0x31,
-	0x0a, 0x32, 0xd6, 0x86, 0x4b, 0x09, 0xfb, 0xcc, 0x73, 0x56, 0x0b, 0x37, 0x90, 0x81, 0xda, 0xb5,
-	0xb5, 0xd6, 0xdb, 0x02, 0xcb, 0xb5, 0xe5, 0x1a, 0x84, 0xe9, 0x50, 0x8b, 0x61, 0x83, 0xd5, 0x82,
-	0x16, 0x40, 0x9e, 0xa7, 0x60, 0xec, 0x63, 0x80, 0xb8, 0x1c, 0x34, 0x8b, 0x34, 0x9e, 0xeb, 0xfd,
-	0xeb, 0x85, 0xd6, 0x82, 0x2b, 0x64, 0xe8, 0x1b, 0x0d, 0xe7, 0xd8, 0xf3, 0xed, 0xf0, 0xc9, 0x82,
-	0xac, 0x46, 0x8e, 0x27, 0x00, 0x32, 0x4e, 0xc1, 0x04, 0x43, 0xf9, 0x98, 0x45, 0x1a, 0x90, 0x86,
-	0x1d, 0x8c, 0x56, 0xd3, 0xb8, 0x5e, 0x74, 0x3b, 0x49, 0x2f, 0x17, 0xc1, 0xb1, 0x4c, 0x62, 0x12,
-	0x09, 0x1f, 0x06, 0xc7, 0x6c, 0x17, 0xae, 0x26, 0x44, 0x89, 0xbd, 0x0b, 0x9a, 0x40, 0x96, 0x32,
-	0x19, 0xbe, 0xd8, 0xe8, 0x05, 0xfa, 0x37, 0x50, 0x4f, 0xcd, 0xce, 0x0b, 0x1d, 0xe0, 0x0d, 0x28,
-	0xe3, 0x7f, 0x74, 0x7f, 0x52, 0x01, 0x4b, 0x58, 0x1e, 0x85, 0xbe, 0x6e, 0x81, 0xb6, 0x3e, 0xd6,
-	0xec, 0x0e, 0xa5, 0xfd, 0x34, 0x29, 0x17, 0x57, 0x4e, 0x84, 0xc2, 0x3c, 0xed, 0xe2, 0x24, 0x66,
-	0x49, 0xea, 0x0b, 0x93, 0xa5, 0xff, 0xd3, 0xac, 0x22, 0x33, 0x8e, 0x38, 0xfb, 0x99, 0xaa, 0x7e,
-	0xca, 0x62, 0x4f, 0xc6, 0x8c, 0x2c, 0xfc, 0xbb, 0xa0, 0x79, 0xbe, 0x69, 0xbb, 0x06, 0x6d, 0x43,
-	0x88, 0xe1, 0xce, 0x52, 0x28, 0xb3, 0x2d, 0xe1, 0x87, 0x12, 0xcc, 0x76, 0xa0, 0x6a, 0x5a, 0x71,
-	0x8e, 0x27, 0x33, 0x34, 0x15, 0xa4, 0x7a, 0x83, 0x7c, 0xda, 0x1b, 0xbc, 0x03, 0x15, 0xc7, 0x0a,
-	0x82, 0x49, 0xf8, 0xc4, 0x70, 0xc9, 0x5f, 0xa6, 0x3b, 0x5d, 0x46, 0xe4, 0xf8, 0x89, 0xe1, 0x22,
-	0xa1, 0xed, 0x4e, 0x68, 0xf9, 0x46, 0x0a, 0x95, 0x22, 0xb4, 0x5d, 0x0a, 0xa1, 0xd1, 0xcf, 0x5e,
-	0xd9, 0x34, 0xb1, 0xd2, 0x0d, 0xb1, 0x8b, 0xf3, 0xaa, 0xbf, 0x0e, 0xa5, 0x47, 0xb6, 0x75, 0x2a,
-	0xed, 0xdf, 0x33, 0xdb, 0x3a, 0x8d, 0xec, 0x1f, 0x7e, 0xeb, 0xff, 0xa5, 0x04, 0x65, 0x22, 0xee,
-	0x3c, 0x7f, 0xbb, 0xe7, 0xc7, 0x04, 0xc1, 0x3b, 0xd2, 0x2b, 0xe5, 0x37, 0x84, 0xde, 0xc2, 0x47,
-	0xbd, 0x0e, 0xc2, 0x95, 0x4e, 0xc8, 0xa0, 0x08, 0x0f, 0x5c, 0x21, 0x88, 0xdc, 0x92, 0xa9, 0x88,
-	0x40, 0x28, 0xf8, 0xde, 0x91, 0xf9, 0x7f, 0x02, 0x60, 0xf7, 0xa1, 0x8c, 0x12, 0x52, 0x2e, 0x5b,
-	0x52, 0x0d, 0x0b, 0xf5, 0x21, 0xca, 0x91, 0x78, 0x29, 0x9c, 0x3a, 0x58, 0x20, 0x7f, 0x6c, 0xf9,
-	0x41, 0xb4, 0x9c, 0xea, 0x3c, 0x2a, 0xa2, 0x45, 0xc3, 0x60, 0x45, 0x26, 0x34, 0xd1, 0xf2, 0x55,
-	0xa3, 0x2d, 0x4e, 0x04, 0xec, 0x2e, 0x94, 0x28, 0x3e, 0xb0, 0x82, 0x66, 0x4d, 0x35, 0x9d, 0x51,
-	0xf0, 0xc2, 0x23, 0x34, 0x7b, 0x17, 0x0a, 0xf3, 0x13, 0xeb, 0x3c, 0x68, 0xd6, 0x55, 0x93, 0x90,
-	0xf2, 0x7c, 0x5c, 0x50, 0xb0, 0x3b, 0xd0, 0xf0, 0xad, 0xf9, 0x84, 0xb6, 0x78, 0xd0, 0x55, 0x07,
-	0xcd, 0x06, 0x79, 0xe2, 0x9a, 0x6f, 0xcd, 0xdb, 0x08, 0x1c, 0x4f, 0x9d, 0x80, 0xbd, 0x0d, 0x45,
-	0xf2, 0x41, 0x41, 0x73, 0x5b, 0x6d, 0x39, 0x72, 0x68, 0x5c, 0x62, 0xd9, 0x2e, 0x54, 0x12, 0xb3,
-	0x71, 0x95, 0x3a, 0x74, 0x65, 0xcd, 0x1e, 0x91, 0x19, 0xe7, 0x09, 0x19, 0xfb, 0x08, 0x40, 0x86,
-	0xe6, 0x93, 0xe9, 0x39, 0xed, 0x80, 0x56, 0xe3, 0xa4, 0x45, 0x71, 0x77, 0x6a, 0x00, 0xff, 0x0e,
-	0x14, 0xd0, 0x4b, 0x04, 0xcd, 0xeb, 0x24, 0xcd, 0xa5, 0xb4, 0x0b, 0xa1, 0xde, 0x11, 0x9e, 0xdd,
-	0x85, 0x32, 0x2a, 0xd7, 0x04, 0xa7, 0xb0, 0xa9, 0xe6, 0x2a, 0x52, 0x13, 0x31, 0x2a, 0xb2, 0x4e,
-	0x47, 0xdf, 0x3b, 0xec, 0x1e, 0xe4, 0x4d, 0x6b, 0x1e, 0x34, 0x6f, 0x50, 0x8d, 0xd7, 0x94, 0xb9,
-	0xc4, 0x30, 0xa1, 0x63, 0xcd, 0x85, 0x6b, 0x41, 0x1a, 0x76, 0x00, 0x0d, 0x54, 0xbd, 0x5d, 0x0a,
-	0x74, 0x71, 0xc8, 0x9b, 0x37, 0x89, 0xeb, 0xcd, 0x35, 0xae, 0x81, 0x24, 0xa2, 0x09, 0xea, 0xba,
-	0xa1, 0x7f, 0xce, 0xeb, 0xae, 0x0a, 0x63, 0x37, 0xa1, 0x6c, 0x07, 0x7d, 0x6f, 0x76, 0x62, 0x99,
-	0xcd, 0xd7, 0xc4, 0x89, 0x46, 0x54, 0x66, 0x5f, 0x42, 0x9d, 0x94, 0x11, 0x8b, 0xd8, 0x78, 0xf3,
-	0x96, 0xea, 0xf2, 0xc6, 0x2a, 0x8a, 0xa7, 0x29, 0x6f, 0xee, 0x53, 0xc2, 0x42, 0x5a, 0xfc, 0xe9,
-	0x9a, 0xcb, 0x4d, 0xe9, 0x98, 0xe2, 0x9b, 0x0f, 0xb6, 0x54, 0xcf, 0xbb, 0x57, 0x80, 0x9c, 0x69,
-	0xcd, 0x6f, 0xfe, 0x0a, 0xd8, 0xc5, 0x4e, 0xbc, 0xc8, 0xff, 0x17, 0xa4, 0xff, 0xff, 0x2a, 0xfb,
-	0x45, 0x46, 0xff, 0x12, 0xea, 0xa9, 0x15, 0xb1, 0x31, 0xf6, 0x11, 0xf1, 0xb3, 0x21, 0x76, 0x9a,
-	0x6b, 0x5c, 0x14, 0xf4, 0xff, 0x98, 0x81, 0xc2, 0x28, 0x34, 0xc2, 0x80, 0xbd, 0x06, 0x95, 0xa9,
-	0xe3, 0xcd, 0x4e, 0x26, 0x98, 0xe9, 0x89, 0x3d, 0xdc, 0x32, 0x01, 0xd0, 0x09, 0x52, 0xf8, 0x19,
-	0x84, 0xc4, 0x9b, 0xe1, 0xf4, 0x8d, 0x46, 0xc1, 0x5b, 0x85, 0x33, 0x37, 0x24, 0xa3, 0x90, 0xe1,
-	0xb2, 0x84, 0xab, 0xd0, 0xf7, 0x4e, 0x69, 0x0b, 0x33, 0x4f, 0x88, 0xa8, 0x88, 0xf1, 0xe8, 0x13,
-	0x23, 0x78, 0xb2, 0x30, 0x96, 0xc9, 0x0e, 0x67, 0x86, 0x57, 0x25, 0x6c, 0x84, 0x24, 0xaf, 0x81,
-	0xb0, 0x0e, 0x13, 0xac, 0xb7, 0x48, 0xf8, 0x32, 0x01, 0xda, 0x6e, 0x88, 0xd6, 0x39, 0xb0, 0x1c,
-	0x6b, 0x16, 0xda, 0xcf, 0x30, 0xa5, 0x2b, 0x09, 0x76, 0x05, 0xa4, 0xbf, 0x0b, 0x25, 0x34, 0x3f,
-	0x46, 0x68, 0xa0, 0x43, 0x33, 0x8d, 0xd0, 0xd8, 0xb4, 0x7b, 0x8c, 0x70, 0xfd, 0x03, 0x00, 0xee,
-	0x9d, 0x06, 0x56, 0x48, 0xd4, 0x6f, 0x2a, 0xb9, 0x56, 0xac, 0xc0, 0xb2, 0x2a, 0x61, 0xca, 0xf4,
-	0xff, 0x9e, 0x81, 0xea, 0xd0, 0x37, 0x71, 0x71, 0x8c, 0x96, 0xd6, 0xec, 0x85, 0x1e, 0x13, 0x6d,
-	0x9b, 0xe7, 0x38, 0x46, 0xec, 0x6f, 0xd0, 0xb6, 0x45, 0x00, 0xf6, 0x11, 0xe4, 0xe7, 0x8e, 0x71,
-	0x4c, 0x63, 0x17, 0xc7, 0xcd, 0x4a, 0xf5, 0xd1, 0xf7, 0x03, 0xc7, 0x38, 0xe6, 0x44, 0xaa, 0xff,
-	0x59, 0xdc, 0x3e, 0xed, 0xc9, 0xa9, 0x3b, 0x71, 0x5b, 0xb4, 0xa3, 0x3b, 0x6a, 0x6b, 0x19, 0x56,
-	0x86, 0x7c, 0xa7, 0x3b, 0x6a, 0x8b, 0x68, 0x19, 0xe3, 0xe6, 0xd1, 0xe4, 0x41, 0x8f, 0x8f, 0xc6,
-	0x5a, 0x9e, 0xb6, 0x88, 0x09, 0xd0, 0x6f, 0x8d, 0xc6, 0x5a, 0x99, 0x01, 0x14, 0x8f, 0x06, 0xbd,
-	0x5f, 0x1f, 0x75, 0x35, 0x4d, 0xff, 0x7b, 0x19, 0x80, 0xc7, 0xb6, 0x6b, 0x7a, 0xa7, 0xd4, 0xb9,
-	0x9f, 0x2b, 0x91, 0x11, 0x9a, 0x8c, 0x8b, 0xa3, 0x58, 0x5d, 0x26, 0xd6, 0x86, 0xbd, 0x0f, 0x65,
-	0x0f, 0x45, 0x43, 0xd2, 0xac, 0x6a, 0x2f, 0x94, 0x1e, 0xf1, 0x92, 0x27, 0x0a, 0xa8, 0x4d, 0x8e,
-	0x65, 0x98, 0x72, 0xe7, 0x9f, 0xbe, 0x51, 0xdf, 0x71, 0x38, 0xc4, 0xc9, 0x22, 0x7e, 0xea, 0x7f,
-	0xc8, 0x43, 0xa5, 0xe7, 0x06, 0x96, 0x1f, 0xb6, 0xc3, 0x33, 0xf6, 0x26, 0xe4, 0x7c, 0x6b, 0xfe,
-	0xbc, 0x2d, 0x4d, 0xc4, 0xb1, 0xf7, 0x22, 0xdd, 0x31, 0xad, 0xb9, 0x0c, 0x44, 0x1b, 0x69, 0x6b,
-	0x21, 0x75, 0xa9, 0x43, 0xdb, 0xfb, 0x1a, 0x26, 0x3e, 0xab, 0xa5, 0x63, 0xcf, 0x30, 0x45, 0xb7,
-	0xcd, 0x33, 0xca, 0x2c, 0x0b, 0xbc, 0xe1, 0xb9, 0x9d, 0x08, 0xdc, 0x33, 0xcf, 0xd8, 0x21, 0x5c,
-	0x4a, 0x51, 0xd2, 0xa4, 0x0b, 0x8f, 0x77, 0x27, 0x72, 0x0e, 0x52, 0xca, 0xfb, 0xc3, 0x84, 0x15,
-	0x07, 0x49, 0xd8, 0xa3, 0x6d, 0x2f, 0x0d, 0x25, 0x27, 0x63, 0x9e, 0x4d, 0xb0, 0x3f, 0x22, 0x4e,
-	0xb8, 0xd0, 0x1f, 0x4c, 0x90, 0xe5, 0xb1, 0x8a, 0x48, 0x95, 0xcf, 0x28, 0x50, 0x28, 0x10, 0x02,
-	0x85, 0xfa, 0x05, 0x45, 0xa5, 0x16, 0x6d, 0x32, 0x9f, 0x35, 0x4b, 0x54, 0xcb, 0xed, 0x75, 0x69,
-	0x0e, 0x89, 0xa2, 0x67, 0x4a, 0xbb, 0x58, 0x59, 0x46, 0x65, 0xf6, 0x39, 0xd4, 0x23, 0x7f, 0x20,
-	0x76, 0x25, 0xca, 0x1b, 0x5c, 0x02, 0x8d, 0x1a, 0xaf, 0xcd, 0x94, 0xd2, 0xcd, 0x01, 0x5c, 0xd9,
-	0xd4, 0xc7, 0x0d, 0xe6, 0x6a, 0x47, 0x35, 0x57, 0x6b, 0x99, 0x53, 0x6c, 0xba, 0x6e, 0x7e, 0x4d,
-	0xc9, 0x87, 0x22, 0xe5, 0x8f, 0x32, 0x7c, 0x7f, 0x51, 0x84, 0x8a, 0x48, 0x28, 0x53, 0x2a, 0x92,
-	0x7b, 0xae, 0x8a, 0xdc, 0x86, 0x1c, 0x8e, 0x57, 0x56, 0x8d, 0x57, 0x7a, 0x66, 0xdf, 0x0e, 0x42,
-	0x8e, 0x08, 0xf6, 0xbe, 0x54, 0xa1, 0x0e, 0xba, 0xa9, 0x9c, 0xea, 0x86, 0x63, 0x15, 0x4a, 0x08,
-	0x30, 0xd5, 0x12, 0xd9, 0x2f, 0x6d, 0x82, 0xe4, 0xd5, 0x76, 0xdb, 0x74, 0xc8, 0xf5, 0xd0, 0x58,
-	0x46, 0xc7, 0x8c, 0x6d, 0xcf, 0xf9, 0x29, 0xe6, 0xfd, 0x73, 0xd8, 0xf6, 0xdc, 0x89, 0x6f, 0x05,
-	0xa1, 0x6f, 0xcf, 0x42, 0xaa, 0xaa, 0xb4, 0xb9, 0xaa, 0xba, 0xe7, 0x72, 0x49, 0x86, 0x35, 0xbe,
-	0x9d, 0x66, 0xc4, 0x9a, 0xcb, 0x54, 0xb3, 0x42, 0x87, 0x0d, 0x7c, 0x0a, 0x0d, 0x8c, 0xc5, 0x8d,
-	0x60, 0x66, 0x98, 0x16, 0xd5, 0x5f, 0xd9, 0x5c, 0x7f, 0xcd, 0x73, 0xdb, 0x82, 0x0a, 0xab, 0xdf,
-	0x4d, 0xb1, 0x61, 0xed, 0xb0, 0x61, 0x8c, 0x13, 0x1e, 0x6c, 0xea, 0x93, 0x14, 0x0f, 0x2e, 0xda,
-	0xea, 0xc6, 0x11, 0x4f, 0xb8, 0x70, 0xe1, 0xee, 0xc1, 0x55, 0x85, 0x4b, 0x19, 0xff, 0xda, 0xe6,
-	0xf1, 0x67, 0x31, 0xf7, 0x51, 0x3c, 0x11, 0x3f, 0x07, 0xf0, 0xdc, 0x49, 0x60, 0x89, 0x01, 0xac,
-	0x6f, 0xee, 0x60, 0xd9, 0x73, 0x47, 0x16, 0x8d, 0xdd, 0xbd, 0x98, 0x1c, 0x3b, 0xd6, 0xd8, 0xd0,
-	0x31, 0x41, 0xdb, 0x23, 0x0d, 0x8a, 0x68, 0xb1, 0x43, 0xdb, 0x1b, 0x3b, 0x24, 0xa8, 0xb1, 0x33,
-	0x5f, 0x91, 0x6d, 0x41, 0x6a, 0xa5, 0x23, 0xda, 0xe6, 0x8e, 0x34, 0x88, 0x2b, 0xe9, 0xc4, 0xfd,
-	0x94, 0x09, 0xb8, 0xf4, 0x1c, 0xed, 0x8b, 0xd7, 0xbc, 0xfe, 0x97, 0x39, 0xa8, 0xb6, 0x5c, 0xc3,
-	0x39, 0xff, 0xad, 0xd5, 0x73, 0xe7, 0x9e, 0xd8, 0x6f, 0x5b, 0xae, 0xc2, 0x09, 0xba, 0x67, 0xb9,
-	0xe5, 0x5e, 0x21, 0x08, 0xfa, 0x45, 0xf6, 0x06, 0x54, 0xbd, 0x55, 0x18, 0xe3, 0xc5, 0x26, 0x3c,
-	0x08, 0x10, 0x11, 0xc4, 0xfc, 0xe4, 0xcb, 0x73, 0x0a, 0x3f, 0x79, 0xf2, 0x84, 0x3f, 0x0e, 0x05,
-	0x62, 0x7e, 0x22, 0x78, 0x0b, 0xea, 0xa1, 0xbd, 0xc0, 0x2e, 0xbb, 0xc1, 0x6a, 0x61, 0x99, 0xe2,
-	0x92, 0x86, 0x38, 0xf7, 0x6f, 0x4b, 0x18, 0xd6, 0xb2, 0xb0, 0x16, 0x9e, 0x7f, 0x2e, 0x6a, 0x29,
-	0x8a, 0x5a, 0x04, 0x88, 0x6a, 0x79, 0x1f, 0xd8, 0xa9, 0x61, 0x87, 0x93, 0x74, 0x55, 0x22, 0xe5,
-	0xd6, 0x10, 0x33, 0x56, 0xab, 0xbb, 0x06, 0x45, 0xd3, 0x0e, 0x4e, 0x7a, 0x43, 0x32, 0x78, 0x39,
-	0x2e, 0x4b, 0x18, 0x76, 0x04, 0x1f, 0xf7, 0x86, 0x93, 0xe9, 0xb9, 0xdc, 0x2b, 0xcf, 0xf1, 0x32,
-	0x02, 0xf6, 0xce, 0x43, 0xda, 0x4b, 0x24, 0xa4, 0xe8, 0x2d, 0x1d, 0xc7, 0xd1, 0x1e, 0x79, 0x8e,
-	0x37, 0x10, 0xde, 0x43, 0x70, 0x1b, 0xa1, 0xec, 0x1e, 0x5c, 0x22, 0x4a, 0xd9, 0x71, 0x41, 0x5a,
-	0x25, 0xd2, 0x6d, 0x44, 0x0c, 0x09, 0x2e, 0x68, 0x6f, 0x41, 0xc5, 0xb5, 0xc2, 0x53, 0xcf, 0x47,
-	0x69, 0x6a, 0x62, 0xf4, 0x62, 0x00, 0x06, 0xad, 0xc1, 0xcc, 0x70, 0x51, 0x78, 0xda, 0x27, 0x47,
-	0x79, 0x64, 0x99, 0xdd, 0xc6, 0x81, 0x47, 0x1b, 0x4f, 0xd8, 0x86, 0x18, 0x92, 0x04, 0xa2, 0xff,
-	0xeb, 0x6d, 0xc8, 0x0f, 0x3c, 0xd3, 0x62, 0x1f, 0x42, 0x85, 0x0e, 0xa6, 0x2f, 0x6e, 0xe6, 0x20,
-	0x9a, 0xfe, 0x50, 0x64, 0x5b, 0x76, 0xe5, 0xd7, 0xf3, 0x8f, 0xb2, 0xdf, 0x84, 0x42, 0x80, 0x61,
-	0xa2, 0xdc, 0x9e, 0x91, 0x07, 0x69, 0x14, 0x39, 0x72, 0x81, 0x41, 0x91, 0x29, 0xc3, 0xf1, 0x2d,
-	0x97, 0x6c, 0x61, 0x81, 0xc7, 0x65, 0x0a, 0x27, 0x7c, 0x0f, 0x57, 0xd6, 0x84, 0x0e, 0x96, 0x0a,
-	0x1b, 0xc2, 0x09, 0x81, 0xa7, 0x93, 0xff, 0x0f, 0xa1, 0xf2, 0xd4, 0xb3, 0x5d, 0x21, 0x78, 0xf1,
-	0x82, 0xe0, 0xdf, 0x78, 0xb6, 0xd8, 0x85, 0x2a, 0x3f, 0x95, 0x5f, 0xec, 0x2d, 0x28, 0x79, 0xae,
-	0xa8, 0xbb, 0x74, 0xa1, 0xee, 0xa2, 0xe7, 0xf6, 0xc5, 0x81, 0x55, 0x7d, 0xba, 0xc2, 0x1c, 0x0c,
-	0x49, 0xad, 0x79, 0x28, 0x37, 0x5d, 0xaa, 0x04, 0x1c, 0xba, 0x7d, 0x6b, 0x1e, 0xb2, 0xf7, 0xa0,
-	0x3a, 0xb7, 0x1d, 0x74, 0x8c, 0x54, 0x59, 0xe5, 0x42, 0x65, 0x20, 0xd0, 0x54, 0xe1, 0xcf, 0xa0,
-	0x7c, 0xec, 0x7b, 0xab, 0x25, 0x86, 0x3d, 0x70, 0x71, 0x9b, 0x83, 0x70, 0x7b, 0xe7, 0xd8, 0x7b,
-	0xfa, 0xb4, 0xdd, 0x63, 0x5c, 0xeb, 0xd2, 0xcc, 0xa5, 0x7a, 0x1f, 0xe1, 0x47, 0x16, 0xd5, 0x6a,
-	0x1c, 0x1f, 0x8b, 0xf6, 0x6b, 0x17, 0x6b, 0x35, 0x8e, 0x8f, 0xa9, 0xf1, 0xf7, 0xa0, 0x7c, 0x6a,
-	0xbb, 0x93, 0x60, 0x69, 0xcd, 0x48, 0x45, 0xe2, 0xd3, 0xbc, 0x24, 0x8c, 0xe3, 0xa5, 0x53, 0xdb,
-	0xa5, 0x78, 0x4e, 0x0d, 0xd0, 0x1a, 0x2f, 0x0c, 0xd0, 0x76, 0xa0, 0xe0, 0xd8, 0x0b, 0x3b, 0xa4,
-	0x2b, 0x44, 0x6b, 0xbe, 0x9b, 0x10, 0x4c, 0x87, 0xa2, 0x37, 0x9f, 0x63, 0x67, 0xb4, 0x0b, 0x24,
-	0x12, 0xa3, 0xba, 0xc7, 0xf0, 0x2c, 0x7d, 0x91, 0x28, 0x76, 0xda, 0xb1, 0x7b, 0x0c, 0xcf, 0xd2,
-	0xf1, 0x1b, 0x7b, 0x41, 0xfc, 0xb6, 0x2b, 0x33, 0x37, 0x24, 0x9e, 0x3c, 0xb3, 0x66, 0xcd, 0xcb,
-	0x1b, 0x4d, 0x6d, 0x35, 0x62, 0x78, 0x64, 0xcd, 0xd0, 0xff, 0x7a, 0xd3, 0xa7, 0x64, 0xf3, 0xaf,
-	0x6c, 0x8e, 0x23, 0x8b, 0xde, 0xf4, 0x29, 0x5a, 0xfc, 0x8f, 0xa0, 0xea, 0x53, 0x72, 0x30, 0xa1,
-	0x1c, 0xe2, 0xaa, 0x3a, 0xbc, 0x49, 0xd6, 0xc0, 0xc1, 0x4f, 0x32, 0x88, 0xb7, 0xa0, 0x2e, 0x8e,
-	0x79, 0xc4, 0xbe, 0x7e, 0x40, 0x59, 0x76, 0x85, 0xd7, 0x08, 0x28, 0xf6, 0xfc, 0x29, 0x62, 0x10,
-	0x7b, 0xed, 0x34, 0x24, 0xd7, 0x55, 0x21, 0xc4, 0xa6, 0x3a, 0x0d, 0x89, 0x19, 0x7d, 0x62, 0xc6,
-	0x34, 0xb5, 0x5d, 0x13, 0x15, 0x27, 0x34, 0x8e, 0x83, 0x66, 0x93, 0xd6, 0x55, 0x55, 0xc2, 0xc6,
-	0xc6, 0x71, 0xc0, 0x3e, 0x81, 0x9a, 0x21, 0xac, 0xfa, 0xc4, 0x76, 0xe7, 0x5e, 0xf3, 0x86, 0x7a,
-	0xe0, 0xa0, 0xd8, 0x7b, 0x5e, 0x35, 0x14, 0xe3, 0xff, 0x39, 0xb0, 0x68, 0x6b, 0x85, 0x02, 0x5a,
-	0xa1, 0x6d, 0x37, 0x2f, 0x68, 0xdb, 0xb6, 0xdc, 0x5b, 0x89, 0x2f, 0xe5, 0xec, 0x00, 0x06, 0xfe,
-	0x86, 0xe3, 0x58, 0x8e, 0x1d, 0x2c, 0x28, 0xa1, 0x2e, 0x70, 0x15, 0x74, 0x31, 0xb6, 0xbc, 0xf5,
-	0x72, 0xb1, 0x25, 0x8e, 0xa0, 0xeb, 0x85, 0x93, 0x99, 0x31, 0x7b, 0x62, 0x11, 0xe3, 0xeb, 0xb4,
-	0x3c, 0x6b, 0xae, 0x17, 0xb6, 0x23, 0x18, 0x8e, 0xa0, 0x30, 0x75, 0x34, 0x82, 0xb7, 0xd5, 0x11,
-	0x8c, 0x03, 0x5f, 0x74, 0x43, 0x49, 0xde, 0x50, 0x9b, 0xad, 0x7c, 0x72, 0x93, 0x41, 0x68, 0x2d,
-	0x9b, 0x6f, 0x08, 0x81, 0x25, 0x6c, 0x14, 0x5a, 0x4b, 0xba, 0x69, 0xe2, 0xad, 0xfc, 0x99, 0x25,
-	0x28, 0x76, 0x88, 0x02, 0x04, 0x08, 0x09, 0xf4, 0xff, 0x9a, 0x83, 0x72, 0x64, 0x2c, 0x59, 0x15,
-	0x4a, 0x47, 0x83, 0x6f, 0x07, 0xc3, 0xc7, 0x03, 0x6d, 0x0b, 0x33, 0xaa, 0x47, 0xad, 0xfe, 0x51,
-	0x77, 0x32, 0x6a, 0xb7, 0x06, 0xe2, 0x12, 0x0e, 0x5d, 0x87, 0x10, 0xe5, 0x2c, 0xbb, 0x04, 0xf5,
-	0x07, 0x47, 0x03, 0x3a, 0x9e, 0x10, 0xa0, 0x1c, 0x82, 0xba, 0xbf, 0x11, 0x69, 0x9b, 0x00, 0xe5,
-	0x11, 0xf4, 0xb0, 0x35, 0xee, 0xf2, 0x5e, 0x04, 0x2a, 0x60, 0x2b, 0x87, 0x7c, 0xf8, 0x4d, 0xb7,
-	0x3d, 0xd6, 0x80, 0x5d, 0x85, 0x4b, 0x31, 0x4b, 0x54, 0x9d, 0x56, 0xc5, 0x04, 0x30, 0x62, 0xd3,
-	0xae, 0x60, 0x25, 0xbc, 0xdb, 0x3e, 0xe2, 0xa3, 0xde, 0xa3, 0xee, 0xa4, 0x3d, 0xee, 0x6a, 0x57,
-	0x31, 0x15, 0x1c, 0xf5, 0x06, 0xdf, 0x6a, 0xd7, 0x58, 0x1d, 0x2a, 0xf8, 0x25, 0x6a, 0xbf, 0x4e,
-	0xc9, 0xe2, 0xfe, 0xbe, 0x76, 0x1b, 0xab, 0xe8, 0xf4, 0x46, 0xe3, 0xde, 0xa0, 0x3d, 0xd6, 0xde,
-	0xc0, 0x7c, 0xf0, 0x41, 0xaf, 0x3f, 0xee, 0x72, 0x6d, 0x07, 0x79, 0xbf, 0x19, 0xf6, 0x06, 0xda,
-	0x9b, 0x74, 0x93, 0xa3, 0xf5, 0xf0, 0xb0, 0xdf, 0xd5, 0x74, 0xaa, 0x71, 0xc8, 0xc7, 0xda, 0x5b,
-	0xac, 0x02, 0x85, 0xa3, 0x01, 0xca, 0x71, 0x07, 0x2b, 0xa7, 0xcf, 0x49, 0xab, 0xdf, 0xd7, 0x7e,
-	0xa6, 0x64, 0x95, 0x6f, 0xe3, 0xf7, 0xe3, 0xde, 0xa0, 0x33, 0x7c, 0xac, 0xbd, 0x83, 0x64, 0x7b,
-	0x7c, 0xd8, 0xea, 0xb4, 0x31, 0xf9, 0xbc, 0x8b, 0x15, 0x8c, 0x0e, 0xfb, 0xbd, 0xb1, 0xf6, 0x2e,
-	0x52, 0xed, 0xb7, 0xc6, 0x07, 0x5d, 0xae, 0xdd, 0xc3, 0xef, 0xd6, 0x68, 0xd4, 0xe5, 0x63, 0x6d,
-	0x17, 0xbf, 0x7b, 0x03, 0xfa, 0xfe, 0x98, 0x6a, 0x3d, 0xec, 0xb4, 0xc6, 0x5d, 0xed, 0x13, 0xfc,
-	0xee, 0x74, 0xfb, 0xdd, 0x71, 0x57, 0xfb, 0x14, 0x6b, 0xa5, 0x2c, 0x78, 0x84, 0x43, 0xf5, 0x19,
-	0x8e, 0x42, 0x5c, 0x24, 0x79, 0x3e, 0xc7, 0x86, 0x1e, 0xf6, 0x06, 0x47, 0x23, 0xed, 0x0b, 0x24,
-	0xa6, 0x4f, 0xc2, 0x7c, 0xa9, 0x3f, 0x85, 0x72, 0xe4, 0x4a, 0x90, 0xaa, 0x37, 0x18, 0x74, 0xb9,
-	0xb6, 0x85, 0x9d, 0xec, 0x77, 0x1f, 0x8c, 0xb5, 0x0c, 0x02, 0x79, 0x6f, 0xff, 0x60, 0xac, 0x65,
-	0xf1, 0x73, 0x78, 0x84, 0x43, 0x93, 0xa3, 0x41, 0xe8, 0x3e, 0xec, 0x69, 0x79, 0xfc, 0x6a, 0x0d,
-	0xc6, 0x3d, 0xad, 0x40, 0x83, 0xd4, 0x1b, 0xec, 0xf7, 0xbb, 0x5a, 0x11, 0xa1, 0x0f, 0x5b, 0xfc,
-	0x5b, 0xad, 0x84, 0x4c, 0xad, 0xc3, 0xc3, 0xfe, 0x77, 0x5a, 0x59, 0xbf, 0x0b, 0xa5, 0xd6, 0xf1,
-	0xf1, 0x43, 0x74, 0xcb, 0x65, 0xc8, 0x3f, 0x38, 0xea, 0xf7, 0xc5, 0xfd, 0xad, 0xbd, 0xe1, 0x78,
-	0x3c, 0x7c, 0xa8, 0x65, 0x70, 0x4e, 0xc6, 0xc3, 0x43, 0x2d, 0xab, 0xdf, 0x82, 0xa2, 0x88, 0x2a,
-	0x29, 0x4f, 0x8e, 0x2e, 0xc0, 0xe5, 0xe4, 0xa5, 0x37, 0x0f, 0x2a, 0x71, 0x74, 0xc7, 0xee, 0x41,
-	0x6e, 0x61, 0x2c, 0x65, 0xc6, 0xd3, 0x5c, 0x8b, 0xfd, 0xee, 0x3f, 0x34, 0x96, 0x22, 0xf1, 0x43,
-	0xa2, 0x9b, 0x9f, 0x41, 0x39, 0x02, 0xfc, 0xa8, 0x1c, 0xeb, 0x5f, 0xe5, 0xa1, 0xd2, 0x51, 0x0c,
-	0xd2, 0x9f, 0x9c, 0x63, 0x29, 0x59, 0x50, 0xee, 0xa5, 0xb3, 0xa0, 0xfc, 0x8b, 0xb2, 0xa0, 0xc2,
-	0xab, 0x66, 0x41, 0xc5, 0x97, 0xcb, 0x82, 0x4a, 0x2f, 0x93, 0x05, 0xdd, 0xb9, 0x90, 0x05, 0x89,
-	0x1c, 0x2b, 0x9d, 0xf7, 0xa4, 0xb3, 0x8f, 0xca, 0x8b, 0xb2, 0x8f, 0x74, 0x46, 0x01, 0x2f, 0xc8,
-	0x28, 0xd2, 0xb9, 0x4a, 0xf5, 0x8f, 0xe6, 0x2a, 0x1b, 0xb3, 0x8f, 0xda, 0xcb, 0x65, 0x1f, 0x68,
-	0x57, 0x0d, 0x77, 0x12, 0xfa, 0x2b, 0x77, 0x66, 0x84, 0x22, 0x48, 0x2d, 0xf3, 0x2a, 0xc6, 0xa8,
-	0x12, 0xa4, 0xff, 0x45, 0x16, 0x0a, 0xbf, 0x5e, 0x59, 0xfe, 0x39, 0xfb, 0x0c, 0x2a, 0x41, 0xb8,
-	0x08, 0xd5, 0x40, 0xf4, 0x86, 0x68, 0x80, 0xf0, 0x14, 0x47, 0x5a, 0x0b, 0xcb, 0x0d, 0x45, 0x54,
-	0x87, 0xb4, 0xb4, 0x28, 0xaf, 0x60, 0xd4, 0x69, 0x2d, 0xc5, 0x99, 0x50, 0x81, 0x8b, 0x02, 0x46,
-	0x27, 0x18, 0x95, 0x46, 0x09, 0x3a, 0x24, 0x91, 0x21, 0x17, 0x08, 0x8c, 0x4e, 0x68, 0x7b, 0x33,
-	0x3a, 0x99, 0x48, 0x45, 0x27, 0x02, 0x83, 0xe1, 0xea, 0x13, 0xcb, 0x40, 0x37, 0x1a, 0xdd, 0x6e,
-	0x88, 0xcb, 0xac, 0x09, 0x25, 0xc7, 0x33, 0xcc, 0xb1, 0x71, 0x1c, 0xdd, 0xcb, 0x91, 0x45, 0xfd,
-	0x31, 0xd4, 0x53, 0xc2, 0xa6, 0xdd, 0x01, 0x5a, 0x81, 0x6e, 0x1f, 0x2d, 0x51, 0x46, 0x31, 0x5e,
-	0x59, 0xc5, 0x60, 0xe5, 0x14, 0x43, 0x96, 0x27, 0xd3, 0xd4, 0xe5, 0xfb, 0x5d, 0xad, 0xa0, 0xff,
-	0xb3, 0x2c, 0x5c, 0x1a, 0xfb, 0x86, 0x1b, 0x18, 0xe2, 0xcc, 0xcb, 0x0d, 0x7d, 0xcf, 0x61, 0x5f,
-	0x41, 0x39, 0x9c, 0x39, 0xea, 0xb8, 0xbd, 0x21, 0x67, 0x7e, 0x9d, 0xf4, 0xfe, 0x78, 0xe6, 0xd0,
-	0xe8, 0x95, 0x42, 0xf1, 0xc1, 0x7e, 0x0e, 0x85, 0xa9, 0x75, 0x6c, 0xbb, 0x72, 0x03, 0xe6, 0xea,
-	0x3a, 0xe3, 0x1e, 0x22, 0x0f, 0xb6, 0xb8, 0xa0, 0x62, 0x1f, 0x42, 0x71, 0xe6, 0x2d, 0x30, 0xe8,
-	0xcb, 0xa9, 0xa7, 0xa8, 0x6a, 0x43, 0x88, 0x3d, 0xd8, 0xe2, 0x92, 0x8e, 0x7d, 0x06, 0x65, 0xdf,
-	0x73, 0x9c, 0xa9, 0x31, 0x3b, 0x91, 0x27, 0xaf, 0xcd, 0x75, 0x1e, 0x2e, 0xf1, 0x07, 0x5b, 0x3c,
-	0xa6, 0xd5, 0xef, 0x43, 0x49, 0x0a, 0x8b, 0x03, 0xb0, 0xd7, 0xdd, 0xef, 0xc9, 0xb1, 0x6b, 0x0f,
-	0x1f, 0x3e, 0xec, 0x8d, 0xc5, 0xa9, 0x3f, 0x1f, 0xf6, 0xfb, 0x7b, 0xad, 0xf6, 0xb7, 0x5a, 0x76,
-	0xaf, 0x0c, 0x45, 0x83, 0xf6, 0xb5, 0xf5, 0xbf, 0x9d, 0x81, 0xed, 0xb5, 0x0e, 0xb0, 0x2f, 0x20,
-	0xbf, 0xf0, 0xcc, 0x68, 0x78, 0xee, 0x6c, 0xec, 0xa5, 0x52, 0x46, 0x0b, 0xcc, 0x89, 0x43, 0xff,
-	0x12, 0x1a, 0x69, 0xb8, 0x72, 0xcd, 0xb1, 0x0e, 0x15, 0xde, 0x6d, 0x75, 0x26, 0xc3, 0x41, 0xff,
-	0x3b, 0xe1, 0xd7, 0xa9, 0xf8, 0x98, 0xf7, 0xc6, 0x5d, 0x2d, 0xab, 0xff, 0x19, 0x68, 0xeb, 0x03,
-	0xc3, 0xf6, 0x61, 0x7b, 0xe6, 0x2d, 0x96, 0x8e, 0x25, 0x8e, 0xeb, 0x92, 0x29, 0xbb, 0xbd, 0x61,
-	0x24, 0x25, 0x19, 0xcd, 0x58, 0x63, 0x96, 0x2a, 0xeb, 0x7f, 0x0b, 0xd8, 0xc5, 0x11, 0xfc, 0xe9,
-	0xaa, 0xff, 0x9f, 0x19, 0xc8, 0x1f, 0x3a, 0x86, 0xcb, 0xde, 0x82, 0x02, 0x5d, 0x21, 0x94, 0x9b,
-	0xaa, 0x55, 0x65, 0x45, 0xa2, 0x5a, 0x10, 0x8e, 0xbd, 0x07, 0xb9, 0x70, 0xe6, 0x48, 0x1d, 0xba,
-	0xfe, 0x1c, 0xe5, 0x3b, 0xd8, 0xe2, 0x48, 0xc5, 0xee, 0x42, 0xce, 0x34, 0x1d, 0xa9, 0x40, 0xf2,
-	0x50, 0x0a, 0x83, 0xe3, 0x8e, 0x35, 0xb7, 0x5d, 0x5b, 0x5e, 0x68, 0x44, 0x12, 0xf6, 0x33, 0xc8,
-	0x99, 0x33, 0x47, 0xaa, 0xcd, 0xa5, 0x84, 0x52, 0xa9, 0xd0, 0x9c, 0x39, 0xec, 0x3e, 0xd4, 0x5a,
-	0x61, 0x88, 0xc1, 0x9f, 0x89, 0x22, 0xa7, 0x2f, 0xd2, 0x21, 0x84, 0xa7, 0xf0, 0x7b, 0x45, 0xa0,
-	0x07, 0x33, 0xfa, 0xfb, 0x74, 0xc1, 0x6f, 0xb5, 0x70, 0xd1, 0x14, 0x88, 0xaf, 0x0d, 0x5b, 0xd8,
-	0x12, 0xa3, 0xff, 0xbf, 0x2c, 0x54, 0x95, 0xc6, 0xd9, 0x27, 0x50, 0x36, 0xd3, 0xab, 0xee, 0xc6,
-	0x05, 0x09, 0xef, 0x77, 0xa2, 0xf5, 0x66, 0x4a, 0x5d, 0xfe, 0x12, 0xea, 0x68, 0x4a, 0x9f, 0x19,
-	0xbe, 0x8d, 0x66, 0x39, 0x90, 0x63, 0x26, 0xe3, 0xde, 0x91, 0x15, 0x3e, 0x8a, 0x30, 0x07, 0x5b,
-	0xbc, 0x16, 0x28, 0x65, 0xf6, 0x2e, 0x94, 0x96, 0xbe, 0xb5, 0x34, 0x7c, 0x4b, 0x8e, 0x5d, 0x3d,
-	0x3a, 0x3d, 0x22, 0xe0, 0xc1, 0x16, 0x8f, 0xf0, 0x48, 0x6a, 0x9d, 0x59, 0xb3, 0x55, 0x68, 0xc9,
-	0xc1, 0xab, 0x47, 0x1d, 0x22, 0x20, 0x92, 0x4a, 0x3c, 0xdb, 0xc5, 0x64, 0xc3, 0x70, 0x1c, 0x8f,
-	0x0c, 0x74, 0x41, 0xcd, 0x61, 0x3a, 0x31, 0x5c, 0xbc, 0x94, 0x88, 0x4a, 0xfa, 0x31, 0x94, 0x64,
-	0xc7, 0x30, 0x94, 0x1a, 0x75, 0xc7, 0x93, 0x47, 0x2d, 0xde, 0xc3, 0x90, 0x76, 0xa4, 0x6d, 0xe1,
-	0x72, 0xdd, 0xe7, 0xad, 0x81, 0x34, 0x6f, 0xbc, 0xfb, 0x68, 0xf8, 0x6d, 0x57, 0xcb, 0x8a, 0x23,
-	0x87, 0xc1, 0x77, 0x5a, 0x4e, 0x84, 0xad, 0xdd, 0xc3, 0x16, 0x47, 0xeb, 0x56, 0x85, 0x52, 0xf7,
-	0x37, 0xdd, 0xf6, 0xd1, 0xb8, 0xab, 0x15, 0x70, 0x05, 0x75, 0xba, 0xad, 0x7e, 0x7f, 0xd8, 0x46,
-	0xd3, 0x57, 0xdc, 0xab, 0x40, 0x69, 0x26, 0x46, 0x52, 0xff, 0x37, 0x75, 0x68, 0xa4, 0xb5, 0x84,
-	0x7d, 0x0e, 0x65, 0xd3, 0x4c, 0xcd, 0xc0, 0xad, 0x4d, 0xda, 0x74, 0xbf, 0x63, 0x46, 0x93, 0x20,
-	0x3e, 0xd8, 0x9b, 0x91, 0x4e, 0x67, 0x2f, 0xe8, 0x74, 0xa4, 0xd1, 0xbf, 0x84, 0x6d, 0x79, 0x2d,
-	0x0f, 0x73, 0xbb, 0xa9, 0x11, 0x58, 0x69, 0x85, 0x6d, 0x13, 0xb2, 0x23, 0x71, 0x07, 0x5b, 0xbc,
-	0x31, 0x4b, 0x41, 0xd8, 0xd7, 0xd0, 0x30, 0x68, 0x87, 0x20, 0xe6, 0xcf, 0xab, 0x47, 0x7e, 0x2d,
-	0xc4, 0x29, 0xec, 0x75, 0x43, 0x05, 0xa0, 0x9a, 0x98, 0xbe, 0xb7, 0x4c, 0x98, 0x0b, 0xaa, 0x9a,
-	0x74, 0x7c, 0x6f, 0xa9, 0xf0, 0xd6, 0x4c, 0xa5, 0xcc, 0x3e, 0x83, 0x9a, 0x94, 0x3c, 0x79, 0x5a,
-	0x15, 0xaf, 0x1e, 0x21, 0x36, 0x45, 0x04, 0x07, 0x5b, 0xbc, 0x3a, 0x4b, 0x8a, 0xec, 0x63, 0xa8,
-	0x0a, 0x81, 0x05, 0x5b, 0x49, 0xd5, 0x04, 0x92, 0x36, 0xe2, 0x02, 0x23, 0x2e, 0xb1, 0x0f, 0x01,
-	0x48, 0x4e, 0xf5, 0x7c, 0x60, 0x3b, 0x11, 0x32, 0x62, 0xa9, 0x98, 0x51, 0x41, 0x11, 0x4f, 0x1c,
-	0xd8, 0x56, 0x2e, 0x8a, 0x47, 0x07, 0x9c, 0x89, 0x78, 0xe2, 0x80, 0x36, 0x16, 0x4f, 0xb0, 0xc1,
-	0x05, 0xf1, 0x22, 0x2e, 0x21, 0x9e, 0x60, 0x8a, 0xc4, 0x13, 0x3c, 0xd5, 0x75, 0xf1, 0x22, 0x16,
-	0x12, 0x4f, 0x70, 0x7c, 0x0d, 0x8d, 0x28, 0x5a, 0x91, 0x9d, 0xaa, 0xa5, 0xee, 0x14, 0x48, 0x5c,
-	0xd4, 0xb1, 0x7a, 0xa8, 0x02, 0x90, 0x3b, 0x78, 0xe2, 0x9d, 0x2a, 0xcb, 0xbb, 0xae, 0x72, 0x8f,
-	0x9e, 0x78, 0xa7, 0xea, 0xfa, 0xae, 0x07, 0x2a, 0x00, 0xa5, 0x15, 0x5d, 0xa4, 0x2b, 0x19, 0x0d,
-	0x55, 0x5a, 0xea, 0xe1, 0x23, 0xdb, 0x3a, 0x45, 0x69, 0x8d, 0xa8, 0x80, 0x83, 0x42, 0xa7, 0xb1,
-	0xa1, 0x68, 0x6c, 0x5b, 0x1d, 0x14, 0x3a, 0x83, 0x8e, 0x5a, 0x02, 0x27, 0x2e, 0xa1, 0x6e, 0xad,
-	0x5c, 0x95, 0x4d, 0x53, 0x75, 0xeb, 0xc8, 0x4d, 0x31, 0xd6, 0x04, 0xa9, 0x64, 0x4d, 0x56, 0x45,
-	0x60, 0x7d, 0xbf, 0xb2, 0xdc, 0x99, 0x25, 0x77, 0x6c, 0x52, 0xab, 0x62, 0x24, 0x71, 0xc9, 0xaa,
-	0x88, 0x20, 0xb1, 0x5e, 0xc7, 0xec, 0x6c, 0x5d, 0xaf, 0x15, 0x66, 0xd2, 0xeb, 0x98, 0x35, 0x5e,
-	0x50, 0x31, 0xef, 0xe5, 0x0b, 0x0b, 0x4a, 0x61, 0x16, 0x0b, 0x2a, 0x02, 0xe8, 0xff, 0x37, 0x0f,
-	0x25, 0x69, 0x07, 0xd8, 0x65, 0xd8, 0x6e, 0xf3, 0x6e, 0x6b, 0xdc, 0x9d, 0x74, 0x5a, 0xe3, 0xd6,
-	0x5e, 0x6b, 0x84, 0xbe, 0x9c, 0x41, 0xa3, 0x85, 0x59, 0x6d, 0x02, 0xcb, 0xa0, 0x71, 0xeb, 0xf0,
-	0xe1, 0x61, 0x02, 0xca, 0x32, 0x0d, 0x6a, 0x92, 0x57, 0xbc, 0x68, 0xc8, 0xb1, 0x6d, 0xa8, 0x0a,
-	0x46, 0x01, 0xa0, 0x03, 0x54, 0xe2, 0x12, 0xe5, 0x82, 0xc2, 0xd2, 0x1b, 0x74, 0xba, 0xbf, 0xd1,
-	0x8a, 0x09, 0x8b, 0x00, 0x94, 0x62, 0x16, 0x51, 0x2e, 0xa3, 0x30, 0x63, 0x7e, 0x34, 0x68, 0x27,
-	0xed, 0x54, 0x90, 0x49, 0x56, 0xf3, 0xa8, 0xd7, 0x7d, 0xac, 0x01, 0x32, 0x89, 0x5a, 0xa8, 0x5c,
-	0xc5, 0x68, 0x84, 0x2a, 0xa1, 0x62, 0x8d, 0x5d, 0x87, 0xcb, 0xa3, 0x83, 0xe1, 0xe3, 0x89, 0x60,
-	0x8a, 0xbb, 0x50, 0x67, 0x57, 0x40, 0x53, 0x10, 0xa2, 0xfa, 0x06, 0x36, 0x49, 0xd0, 0x88, 0x70,
-	0xa4, 0x6d, 0xd3, 0x4d, 0x4a, 0x84, 0x8d, 0x85, 0x69, 0xd7, 0xb0, 0x2b, 0x82, 0x75, 0xd8, 0x3f,
-	0x7a, 0x38, 0x18, 0x69, 0x97, 0x50, 0x08, 0x82, 0x08, 0xc9, 0x59, 0x5c, 0x4d, 0xe2, 0x10, 0x2e,
-	0x93, 0x8f, 0x40, 0xd8, 0xe3, 0x16, 0x1f, 0xf4, 0x06, 0xfb, 0x23, 0xed, 0x4a, 0x5c, 0x73, 0x97,
-	0xf3, 0x21, 0x1f, 0x69, 0x57, 0x63, 0xc0, 0x68, 0xdc, 0x1a, 0x1f, 0x8d, 0xb4, 0x6b, 0xb1, 0x94,
-	0x87, 0x7c, 0xd8, 0xee, 0x8e, 0x46, 0xfd, 0xde, 0x68, 0xac, 0x5d, 0x67, 0x57, 0xe1, 0x52, 0x22,
-	0x51, 0x44, 0xdc, 0x54, 0x04, 0xe5, 0xfb, 0xdd, 0xb1, 0x76, 0x23, 0x16, 0xa3, 0x3d, 0xec, 0xf7,
-	0x5b, 0xb4, 0x13, 0x72, 0x13, 0x89, 0xfa, 0xc3, 0xf6, 0xb7, 0x51, 0x6f, 0x5e, 0x43, 0xb9, 0x8e,
-	0x06, 0x2a, 0xe8, 0x96, 0xa2, 0x1a, 0xa3, 0xee, 0xaf, 0x8f, 0xba, 0x83, 0x76, 0x57, 0x7b, 0x3d,
-	0x51, 0x8d, 0x18, 0x76, 0x3b, 0x56, 0x8d, 0x18, 0xf4, 0x46, 0xdc, 0x66, 0x04, 0x1a, 0x69, 0x3b,
-	0x7b, 0x35, 0x7a, 0x75, 0x28, 0x1d, 0x91, 0xfe, 0x0d, 0x30, 0xf5, 0x75, 0x90, 0xbc, 0x01, 0xce,
-	0x20, 0x3f, 0xf7, 0xbd, 0x45, 0x74, 0x0f, 0x03, 0xbf, 0x69, 0x03, 0x6d, 0x35, 0xa5, 0xf3, 0xd3,
-	0xe4, 0x62, 0x80, 0x0a, 0xd2, 0x7f, 0x9f, 0x81, 0x46, 0xda, 0x09, 0x31, 0x1d, 0xea, 0xf6, 0x7c,
-	0xe2, 0x7a, 0xa1, 0xb8, 0xa5, 0x1c, 0xc8, 0x5b, 0xe4, 0x55, 0x7b, 0x3e, 0xf0, 0x42, 0xba, 0xa6,
-	0x4c, 0x09, 0x4d, 0xec, 0x53, 0x44, 0xad, 0x71, 0x99, 0xf5, 0xe0, 0x72, 0xea, 0x41, 0x54, 0xea,
-	0x8e, 0x78, 0x33, 0x7e, 0x51, 0xb2, 0x26, 0x3f, 0x67, 0xc1, 0x05, 0x98, 0x7e, 0x00, 0xf5, 0x94,
-	0x87, 0x63, 0xaf, 0x41, 0xc5, 0x9e, 0xa7, 0xe5, 0x2a, 0xdb, 0xf3, 0x17, 0x0b, 0xa5, 0xef, 0x43,
-	0x4d, 0x75, 0x77, 0xaf, 0x5e, 0xd1, 0x1b, 0x50, 0x79, 0x70, 0x12, 0x5d, 0x59, 0x57, 0x6f, 0xcd,
-	0x57, 0xe4, 0xd5, 0x8d, 0xff, 0x9d, 0x85, 0xaa, 0xe2, 0x1f, 0x5f, 0x6a, 0x38, 0x6f, 0x41, 0x25,
-	0xb4, 0x16, 0x4b, 0xcf, 0x37, 0x64, 0x34, 0x51, 0xe6, 0x09, 0x20, 0x25, 0x4e, 0x6e, 0x6d, 0xb0,
-	0x53, 0xfb, 0xd8, 0xf9, 0x17, 0xec, 0x63, 0x7f, 0x04, 0x35, 0xe5, 0xa2, 0x7a, 0x20, 0xf7, 0x31,
-	0x2e, 0x6c, 0x63, 0x27, 0x97, 0xd6, 0x03, 0x76, 0x15, 0x8a, 0xf3, 0x93, 0x89, 0x39, 0x15, 0x97,
-	0x07, 0x2b, 0xbc, 0x30, 0x3f, 0xe9, 0x4c, 0xe9, 0x02, 0xcf, 0x3c, 0x36, 0xfc, 0x25, 0x91, 0xd1,
-	0xce, 0x23, 0xf3, 0x7e, 0x17, 0x4a, 0xf3, 0x13, 0x71, 0x0b, 0xbc, 0xac, 0x26, 0xf8, 0xf1, 0xb8,
-	0xf1, 0xe2, 0xfc, 0x84, 0x6e, 0x84, 0x7f, 0x09, 0xda, 0xda, 0xa5, 0xc3, 0x40, 0xee, 0x51, 0xac,
-	0x0b, 0xb5, 0x9d, 0xbe, 0x80, 0x18, 0xe8, 0xff, 0x2e, 0x03, 0x8d, 0x24, 0x9e, 0xc0, 0xb9, 0x65,
-	0xf7, 0xd4, 0x57, 0x94, 0xcd, 0xf5, 0x90, 0x03, 0x49, 0xee, 0x8f, 0xcf, 0x97, 0xe2, 0x39, 0xcc,
-	0xa6, 0x9b, 0x87, 0x9b, 0xee, 0xf1, 0xe7, 0x36, 0xdd, 0xe3, 0xd7, 0xf7, 0x21, 0x37, 0x3e, 0x5f,
-	0x8a, 0x34, 0x12, 0x4d, 0x98, 0x08, 0x57, 0x85, 0xf1, 0xa2, 0xdd, 0xb5, 0x6f, 0xbb, 0xdf, 0x89,
-	0x4b, 0x31, 0x87, 0xbc, 0xf7, 0xb0, 0xc5, 0xbf, 0x9b, 0x20, 0x80, 0x8c, 0xfc, 0x83, 0x21, 0xef,
-	0xf6, 0xf6, 0x07, 0x04, 0xc8, 0x53, 0x92, 0x99, 0x88, 0xd8, 0x32, 0xcd, 0x07, 0x27, 0xea, 0xab,
-	0xbd, 0x4c, 0xea, 0xd5, 0x5e, 0x7c, 0xbf, 0x51, 0x7d, 0xb4, 0x10, 0xc6, 0x8f, 0x0b, 0x22, 0x65,
-	0xcc, 0x25, 0xca, 0xc8, 0xde, 0x81, 0xfc, 0xfc, 0xc4, 0x3a, 0x4f, 0x07, 0x8d, 0xe9, 0x7b, 0x83,
-	0x44, 0xa0, 0xff, 0x90, 0x01, 0x96, 0x12, 0x44, 0xc4, 0x31, 0xaf, 0x2a, 0xcb, 0xe7, 0xd0, 0x94,
-	0x4f, 0x58, 0x04, 0x95, 0x7c, 0x8f, 0x33, 0x41, 0x59, 0xc4, 0x90, 0x5e, 0x15, 0x78, 0x6a, 0x2e,
-	0xb9, 0x1c, 0xc9, 0x3e, 0x00, 0xf1, 0x0c, 0xa3, 0xe7, 0xce, 0xbd, 0x74, 0xc6, 0xa6, 0xac, 0x29,
-	0x9e, 0xd0, 0xb0, 0x7b, 0x70, 0x49, 0x9d, 0x34, 0xf1, 0xb0, 0xa2, 0x40, 0x4b, 0x68, 0x3b, 0x99,
-	0x35, 0x5a, 0x67, 0xfa, 0x3f, 0xc8, 0xc0, 0xe5, 0xb4, 0x42, 0xfc, 0x69, 0xbd, 0x4c, 0xbf, 0x22,
-	0xc9, 0xad, 0xbf, 0x22, 0xd9, 0xa4, 0x4f, 0xf9, 0x8d, 0xfa, 0xf4, 0x77, 0x32, 0x70, 0x45, 0x19,
-	0xfd, 0x24, 0xf2, 0xfc, 0x6b, 0x92, 0x4c, 0x79, 0x4c, 0x92, 0x4f, 0x3d, 0x26, 0xd1, 0x7f, 0x9f,
-	0x03, 0x48, 0x24, 0x49, 0x99, 0x9e, 0xcc, 0x1f, 0x33, 0x3d, 0x2f, 0x71, 0x05, 0xca, 0x0e, 0x26,
-	0xe9, 0xb3, 0x9a, 0x5c, 0x74, 0x0d, 0x5d, 0x3d, 0xa7, 0x61, 0x1f, 0x41, 0x49, 0xec, 0xc0, 0x44,
-	0x1b, 0x6a, 0xd7, 0xd7, 0x57, 0xf2, 0x7d, 0xf9, 0xc2, 0x23, 0xa2, 0xbb, 0xf9, 0x97, 0x19, 0x28,
-	0xca, 0xe7, 0x1d, 0xf7, 0x20, 0x8f, 0xe1, 0x9e, 0xdc, 0x66, 0xb8, 0xb2, 0xc9, 0x08, 0xd0, 0x8f,
-	0x23, 0xa0, 0xbd, 0xb8, 0x0f, 0x45, 0xc3, 0x34, 0x27, 0xf3, 0x93, 0xf4, 0xae, 0xd5, 0xda, 0x7a,
-	0x3c, 0xd8, 0xe2, 0x05, 0x83, 0x16, 0xe6, 0xe7, 0x50, 0x41, 0x7a, 0x91, 0x05, 0xa4, 0xdc, 0xd9,
-	0xc5, 0x95, 0x73, 0xb0, 0xc5, 0xcb, 0x46, 0xb4, 0x8a, 0x7e, 0x91, 0x4e, 0x3a, 0x84, 0x5a, 0xdf,
-	0xbc, 0xc0, 0xfa, 0x9c, 0xf4, 0x43, 0xd9, 0x93, 0xfa, 0x97, 0x59, 0xa8, 0xc4, 0x09, 0xd1, 0x2b,
-	0xfb, 0xb0, 0xe4, 0xf7, 0x32, 0x72, 0xca, 0xef, 0x65, 0xac, 0xaf, 0x24, 0x71, 0xad, 0x3f, 0x4f,
-	0xc6, 0x64, 0x3b, 0xad, 0xaf, 0xc1, 0xc5, 0x73, 0xb7, 0xc2, 0x4b, 0x9e, 0xbb, 0xdd, 0x00, 0xa1,
-	0x13, 0x13, 0xdb, 0xa4, 0x94, 0x32, 0xcf, 0x4b, 0x54, 0xee, 0x99, 0xeb, 0x4f, 0x8c, 0x4a, 0x74,
-	0x6d, 0x59, 0x7d, 0x62, 0xf4, 0xdc, 0xb7, 0x07, 0xe5, 0xe7, 0xbf, 0x3d, 0xf8, 0x1e, 0x2a, 0x71,
-	0xd2, 0xf3, 0xea, 0x03, 0xf6, 0x63, 0xbc, 0xac, 0xfe, 0xe7, 0x51, 0x44, 0x15, 0xe7, 0x1c, 0x7f,
-	0x6a, 0x44, 0x95, 0x6a, 0x3e, 0xf7, 0x82, 0xe6, 0xcf, 0x44, 0xa4, 0x13, 0x37, 0xfe, 0x13, 0x6b,
-	0x89, 0x3a, 0x81, 0xf9, 0xd4, 0x04, 0xea, 0xdb, 0x32, 0x5a, 0x8b, 0xb3, 0xa5, 0x7f, 0x9b, 0x89,
-	0x42, 0xa1, 0xf8, 0x76, 0xf4, 0x73, 0xad, 0x49, 0xdc, 0x5a, 0x56, 0x6d, 0xed, 0x95, 0xfd, 0xc8,
-	0x3b, 0x50, 0x50, 0x17, 0xdb, 0x06, 0x1f, 0x22, 0xf0, 0xeb, 0x4f, 0xf2, 0x0a, 0xeb, 0x4f, 0xf2,
-	0x74, 0x5d, 0x1a, 0x44, 0xd1, 0x85, 0x2b, 0x51, 0xbd, 0xd1, 0x73, 0x42, 0x2c, 0xa0, 0x1b, 0xaf,
-	0x24, 0xee, 0xe4, 0xc7, 0x77, 0xf3, 0x27, 0x73, 0x24, 0x3f, 0x64, 0xa0, 0x9e, 0xda, 0x5c, 0x78,
-	0x05, 0x61, 0x36, 0xda, 0x81, 0xdc, 0x4b, 0xda, 0x81, 0xfc, 0x2b, 0xd8, 0x81, 0xc2, 0x1f, 0xb5,
-	0x03, 0xc5, 0x75, 0x3b, 0xa0, 0xff, 0xfd, 0x4c, 0xfc, 0x70, 0x4e, 0x54, 0xb6, 0xc9, 0xb9, 0x64,
-	0x36, 0x3a, 0x97, 0xdb, 0xf1, 0x0f, 0x26, 0xf4, 0x3a, 0xe2, 0xa4, 0xa7, 0xce, 0x15, 0x08, 0xfb,
-	0x12, 0x6e, 0x88, 0x7d, 0x5a, 0x61, 0xaa, 0x27, 0xde, 0x7c, 0x12, 0xff, 0x9c, 0x82, 0xbc, 0x42,
-	0x7c, 0x4d, 0x10, 0x88, 0xe7, 0x95, 0xf3, 0xe4, 0x47, 0x1b, 0x7a, 0x50, 0x4f, 0x6d, 0xcc, 0x28,
-	0xbf, 0xab, 0x92, 0x51, 0x7f, 0x57, 0x85, 0xed, 0x40, 0xe1, 0xf4, 0x89, 0xe5, 0x5b, 0x1b, 0x7e,
-	0x0d, 0x41, 0x20, 0xf4, 0xaf, 0xa1, 0xa6, 0x6e, 0xe1, 0xb2, 0xf7, 0xa1, 0x60, 0x87, 0xd6, 0x22,
-	0xba, 0x31, 0x7e, 0xed, 0xe2, 0x2e, 0x2f, 0x3d, 0x0a, 0x13, 0x44, 0xfa, 0x1f, 0x32, 0xa0, 0xad,
-	0xe3, 0x94, 0x1f, 0x7f, 0xc9, 0x3c, 0xe7, 0xc7, 0x5f, 0xb2, 0x29, 0x21, 0x37, 0xfc, 0x80, 0x4b,
-	0x72, 0xcb, 0x36, 0xff, 0x9c, 0x5b, 0xb6, 0xec, 0x6d, 0x28, 0xfb, 0x16, 0xfd, 0xe0, 0x86, 0x99,
-	0xde, 0x3e, 0x17, 0x8f, 0x8d, 0x22, 0x9c, 0xfe, 0x77, 0x33, 0x50, 0x92, 0xfb, 0xcd, 0x1b, 0xdf,
-	0x0f, 0xbc, 0x0b, 0x25, 0xf1, 0xe3, 0x1b, 0xd1, 0x4f, 0x46, 0x5c, 0x38, 0xb2, 0x8c, 0xf0, 0xec,
-	0xb6, 0xd8, 0x85, 0x4f, 0x3f, 0xe5, 0xa3, 0xdd, 0x7a, 0x82, 0xa3, 0x36, 0xd1, 0x21, 0x1c, 0xed,
-	0xef, 0x06, 0xf2, 0x6c, 0x17, 0x08, 0x34, 0x46, 0x88, 0xfe, 0x0b, 0x28, 0xc9, 0xfd, 0xec, 0x8d,
-	0xa2, 0xbc, 0xe8, 0xa7, 0x2b, 0x76, 0x00, 0x92, 0x0d, 0xee, 0x4d, 0x35, 0xe8, 0x8e, 0x7c, 0x31,
-	0xd1, 0xf7, 0x66, 0x27, 0x14, 0xb2, 0x7e, 0x00, 0x65, 0x27, 0x7a, 0x03, 0x92, 0x79, 0xfe, 0x1b,
-	0x90, 0x98, 0x88, 0xdd, 0x83, 0xd8, 0xbc, 0xbf, 0x28, 0xd0, 0xd2, 0x5b, 0x00, 0xc9, 0xce, 0x1b,
-	0xfb, 0x58, 0x86, 0x89, 0x08, 0x8a, 0xd4, 0x67, 0xbd, 0x31, 0xba, 0xb0, 0xa3, 0x90, 0xe9, 0x0d,
-	0xa8, 0xa9, 0xdb, 0x77, 0xf7, 0xde, 0x84, 0x9a, 0xfa, 0x6b, 0x03, 0x74, 0x72, 0xe5, 0xb9, 0x96,
-	0x78, 0x08, 0xd0, 0xff, 0xed, 0x27, 0x5a, 0xe6, 0xde, 0x9f, 0x2b, 0xcf, 0xe5, 0x88, 0x46, 0xe6,
-	0x40, 0x74, 0x6b, 0xa5, 0xdf, 0x1b, 0x74, 0x5b, 0x9c, 0x32, 0x1e, 0x7a, 0x32, 0x70, 0xd0, 0x1a,
-	0x1d, 0x88, 0xec, 0x48, 0x62, 0x08, 0x90, 0xa3, 0x1b, 0x10, 0xad, 0xc1, 0x7e, 0x57, 0xdc, 0x52,
-	0xa1, 0xcf, 0x78, 0x8b, 0xa8, 0x40, 0x37, 0x25, 0x7a, 0xa3, 0xb1, 0x56, 0x64, 0x1a, 0xd4, 0xf0,
-	0x2b, 0xc6, 0x95, 0xee, 0xfd, 0x0a, 0x9a, 0xcf, 0x3b, 0x92, 0xc2, 0x5a, 0xdb, 0x07, 0x2d, 0x3a,
-	0xf6, 0xab, 0x41, 0x79, 0x30, 0x9c, 0x88, 0x52, 0x86, 0x55, 0xa1, 0xc4, 0xbb, 0xfd, 0x2e, 0x6d,
-	0xc8, 0xdd, 0xfb, 0x5d, 0x46, 0x99, 0xa5, 0xe8, 0x48, 0x22, 0x06, 0xc8, 0xee, 0xaa, 0x20, 0x6e,
-	0x19, 0xa6, 0x96, 0x61, 0xd7, 0x80, 0xa5, 0x40, 0x7d, 0x6f, 0x66, 0x38, 0x5a, 0x96, 0xb6, 0xde,
-	0x22, 0xf8, 0x63, 0xdf, 0x0e, 0x2d, 0x2d, 0xc7, 0x5e, 0x87, 0x1b, 0x31, 0xac, 0xef, 0x9d, 0x1e,
-	0xfa, 0xb6, 0xe7, 0xdb, 0xe1, 0xb9, 0x40, 0xe7, 0xf7, 0x7e, 0xf9, 0xef, 0x7f, 0xb8, 0x9d, 0xf9,
-	0x4f, 0x3f, 0xdc, 0xce, 0xfc, 0x8f, 0x1f, 0x6e, 0x6f, 0xfd, 0xe1, 0x7f, 0xdd, 0xce, 0xfc, 0x4d,
-	0xf5, 0xa7, 0xd8, 0x16, 0x46, 0xe8, 0xdb, 0x67, 0xc2, 0xd9, 0x45, 0x05, 0xd7, 0xfa, 0x60, 0x79,
-	0x72, 0xfc, 0xc1, 0x72, 0xfa, 0x01, 0xce, 0xe8, 0xb4, 0x48, 0xbf, 0xc8, 0xf6, 0xf1, 0xff, 0x0f,
-	0x00, 0x00, 0xff, 0xff, 0x29, 0xe6, 0xe0, 0x46, 0xd4, 0x4d, 0x00, 0x00,
+	// 7991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x7c, 0x5b, 0x8f, 0x1b, 0xd9,
+	0xba, 0x50, 0xec, 0xf2, 0xf5, 0xf3, 0xa5, 0x2b, 0x2b, 0x9d, 0xc4, 0xc9, 0xce, 0x64, 0x7a, 0x6a,
+	0xb2, 0x67, 0x32, 0xd9, 0x33, 0x99, 0x3d, 0x3d, 0xf7, 0x61, 0x0f, 0x7b, 0xbb, 0x6d, 0xa7, 0xe3,
+	0x19, 0xb7, 0xdd, 0xbb, 0xec, 0x4e, 0x66, 0xce, 0x11, 0xb2, 0xca, 0xae, 0x72, 0x77, 0xa5, 0xcb,
+	0x55, 0x9e, 0xaa, 0x72, 0xba, 0x7b, 0x4b, 0x47, 0xda, 0x2f, 0x80, 0x78, 0x02, 0x09, 0xe9, 0x80,
+	0x74, 0x90, 0x38, 0xf0, 0x00, 0x82, 0x17, 0x7e, 0x03, 0xf0, 0x02, 0x12, 0x48, 0x80, 0xc4, 0x0b,
+	0x48, 0x08, 0x36, 0x88, 0x17, 0x9e, 0xe0, 0x9c, 0x37, 0x78, 0x40, 0xdf, 0xb7, 0x56, 0x55, 0xad,
+	0xb2, 0xdd, 0x93, 0x4c, 0xf6, 0x9c, 0x97, 0xee, 0xb5, 0xbe, 0xcb, 0xba, 0xd5, 0x5a, 0xdf, 0x6d,
+	0x7d, 0xcb, 0x00, 0x0b, 0xc7, 0x70, 0x1f, 0x2e, 0x7c, 0x2f, 0xf4, 0x58, 0x0e, 0xcb, 0xb7, 0xdf,
+	0x3b, 0xb6, 0xc3, 0x93, 0xe5, 0xe4, 0xe1, 0xd4, 0x9b, 0xbf, 0x7f, 0xec, 0x1d, 0x7b, 0xef, 0x13,
+	0x72, 0xb2, 0x9c, 0x51, 0x8d, 0x2a, 0x54, 0xe2, 0x4c, 0xda, 0xdf, 0xc9, 0x40, 0x6e, 0x74, 0xb1,
+	0xb0, 0x58, 0x1d, 0xb2, 0xb6, 0xd9, 0xc8, 0xec, 0x64, 0xee, 0xe7, 0xf5, 0xac, 0x6d, 0xb2, 0x1d,
+	0xa8, 0xb8, 0x5e, 0xd8, 0x5f, 0x3a, 0x8e, 0x31, 0x71, 0xac, 0x46, 0x76, 0x27, 0x73, 0xbf, 0xa4,
+	0xcb, 0x20, 0xf6, 0x13, 0x28, 0x1b, 0xcb, 0xd0, 0x1b, 0xdb, 0xee, 0xd4, 0x6f, 0x28, 0x84, 0x2f,
+	0x21, 0xa0, 0xeb, 0x4e, 0x7d, 0xb6, 0x0d, 0xf9, 0x33, 0xdb, 0x0c, 0x4f, 0x1a, 0x39, 0x6a, 0x91,
+	0x57, 0x10, 0x1a, 0x4c, 0x0d, 0xc7, 0x6a, 0xe4, 0x39, 0x94, 0x2a, 0x08, 0x0d, 0xa9, 0x93, 0xc2,
+	0x4e, 0xe6, 0x7e, 0x59, 0xe7, 0x15, 0xed, 0xdf, 0xe7, 0x21, 0xdf, 0xf2, 0xdc, 0x20, 0x64, 0x37,
+	0xa0, 0x60, 0x07, 0xee, 0xd2, 0x71, 0x68, 0x78, 0x25, 0x5d, 0xd4, 0xd8, 0x0d, 0xc8, 0xdb, 0x9f,
+	0x3d, 0x37, 0x1c, 0x1a, 0x5c, 0xfe, 0xf1, 0x15, 0x9d, 0x57, 0x59, 0x03, 0x0a, 0xf6, 0x07, 0x9f,
+	0x20, 0x42, 0x11, 0x08, 0x51, 0x27, 0xcc, 0x87, 0xbb, 0x88, 0xc9, 0xc5, 0x98, 0x0f, 0x77, 0x23,
+	0xcc, 0x27, 0x1f, 0x21, 0x06, 0x87, 0xa6, 0x10, 0x86, 0xea, 0xd8, 0xcb, 0x92, 0x7a, 0xc1, 0xd1,
+	0xd5, 0xb0, 0x97, 0x65, 0xd4, 0xcb, 0x92, 0xf7, 0x52, 0x14, 0x08, 0x51, 0x27, 0x0c, 0xef, 0xa5,
+	0x14, 0x63, 0xe2, 0x5e, 0x96, 0xbc, 0x97, 0xf2, 0x4e, 0xe6, 0x7e, 0x8e, 0x30, 0xbc, 0x97, 0x6d,
+	0xc8, 0x99, 0x08, 0x87, 0x9d, 0xcc, 0xfd, 0xcc, 0xe3, 0x2b, 0x7a, 0xce, 0x14, 0xd0, 0x00, 0xa1,
+	0x15, 0x5c, 0x18, 0x84, 0x06, 0x02, 0x3a, 0x41, 0x68, 0x15, 0x57, 0x03, 0xa1, 0x13, 0x01, 0x9d,
+	0x21, 0xb4, 0xb6, 0x93, 0xb9, 0x9f, 0x45, 0x28, 0xd6, 0xd8, 0x6d, 0x28, 0x9a, 0x46, 0x68, 0x21,
+	0xa2, 0x2e, 0xa6, 0x1c, 0x01, 0x10, 0x17, 0xda, 0x73, 0xc2, 0x6d, 0x89, 0x49, 0x47, 0x00, 0xa6,
+	0x41, 0x05, 0xc9, 0x22, 0xbc, 0x2a, 0xf0, 0x32, 0x90, 0x7d, 0x0c, 0x55, 0xd3, 0x9a, 0xda, 0x73,
+	0xc3, 0xe1, 0x73, 0xba, 0xba, 0x93, 0xb9, 0x5f, 0xd9, 0xdd, 0x7a, 0x48, 0x7b, 0x32, 0xc6, 0x3c,
+	0xbe, 0xa2, 0xa7, 0xc8, 0xd8, 0x67, 0x50, 0x13, 0xf5, 0x0f, 0x76, 0x69, 0x61, 0x19, 0xf1, 0xa9,
+	0x29, 0xbe, 0x0f, 0x76, 0x3f, 0x7b, 0x7c, 0x45, 0x4f, 0x13, 0xb2, 0x7b, 0x50, 0xc5, 0xbe, 0x83,
+	0xd0, 0x98, 0x2f, 0x90, 0xf1, 0x9a, 0x18, 0x55, 0x0a, 0x8a, 0xd3, 0x7a, 0x16, 0x78, 0x2e, 0x12,
+	0x6c, 0x8b, 0x75, 0x8b, 0x00, 0x6c, 0x07, 0xc0, 0xb4, 0x66, 0xc6, 0xd2, 0x09, 0x11, 0x7d, 0x5d,
+	0x2c, 0xa0, 0x04, 0x63, 0x77, 0xa1, 0xbc, 0x5c, 0xe0, 0x2c, 0x9f, 0x18, 0x4e, 0xe3, 0x86, 0x20,
+	0x48, 0x40, 0xb8, 0x59, 0xed, 0x60, 0xcf, 0x76, 0x1b, 0x37, 0x11, 0xa7, 0xf3, 0x0a, 0xbb, 0x03,
+	0x4a, 0xe0, 0x4f, 0x1b, 0x0d, 0x9a, 0x09, 0xf0, 0x99, 0x74, 0xce, 0x17, 0xbe, 0x8e, 0xe0, 0xbd,
+	0x22, 0xe4, 0x9f, 0x1b, 0xce, 0xd2, 0xd2, 0xee, 0x40, 0xe9, 0xd0, 0xf0, 0x8d, 0xb9, 0x6e, 0xcd,
+	0x98, 0x0a, 0xca, 0xc2, 0x0b, 0xc4, 0x89, 0xc3, 0xa2, 0xd6, 0x83, 0xc2, 0x13, 0xc3, 0x47, 0x1c,
+	0x83, 0x9c, 0x6b, 0xcc, 0x2d, 0x42, 0x96, 0x75, 0x2a, 0xe3, 0x29, 0x08, 0x2e, 0x82, 0xd0, 0x9a,
+	0x8b, 0xb3, 0x28, 0x6a, 0x08, 0x3f, 0x76, 0xbc, 0x89, 0xd8, 0xed, 0x25, 0x5d, 0xd4, 0xb4, 0x3e,
+	0x14, 0x5a, 0x9e, 0x83, 0xad, 0xdd, 0x84, 0xa2, 0x6f, 0x39, 0xe3, 0xa4, 0xb7, 0x82, 0x6f, 0x39,
+	0x87, 0x5e, 0x80, 0x88, 0xa9, 0xc7, 0x11, 0x59, 0x8e, 0x98, 0x7a, 0x84, 0x88, 0xfa, 0x57, 0x92,
+	0xfe, 0xb5, 0xcf, 0xa1, 0xac, 0x1b, 0x67, 0xa2, 0xc9, 0xeb, 0x50, 0x08, 0x27, 0xce, 0x58, 0x48,
+	0x8c, 0x9c, 0x9e, 0x0f, 0x27, 0x4e, 0xd7, 0x44, 0x30, 0x36, 0x68, 0x9b, 0xd4, 0x5e, 0x4e, 0xcf,
+	0x4f, 0x3d, 0xa7, 0x6b, 0x6a, 0x23, 0x80, 0x96, 0xe7, 0xfb, 0xaf, 0x3c, 0x9c, 0x6d, 0xc8, 0x9b,
+	0xd6, 0x22, 0x3c, 0xe1, 0xe7, 0x59, 0xe7, 0x15, 0xed, 0x01, 0x94, 0x70, 0x89, 0x7b, 0x76, 0x10,
+	0xb2, 0xbb, 0x90, 0x73, 0xec, 0x20, 0x6c, 0x64, 0x76, 0x94, 0x95, 0x0f, 0x40, 0x70, 0x6d, 0x07,
+	0x4a, 0x07, 0xc6, 0xf9, 0x13, 0xfc, 0x08, 0x6c, 0x5b, 0x7c, 0x0d, 0xb1, 0xba, 0xe2, 0xd3, 0x3c,
+	0x00, 0x18, 0x19, 0xfe, 0xb1, 0x15, 0x92, 0x34, 0xbc, 0x03, 0x4a, 0x78, 0xb1, 0x20, 0x8a, 0xb8,
+	0x39, 0x44, 0xe8, 0x08, 0xd6, 0xfe, 0x2c, 0x03, 0x95, 0xe1, 0x72, 0xf2, 0xdd, 0xd2, 0xf2, 0x2f,
+	0x70, 0x46, 0xf7, 0x13, 0xea, 0xfa, 0xee, 0x0d, 0x4e, 0x2d, 0xe1, 0x13, 0x4e, 0x9c, 0xa2, 0xeb,
+	0x99, 0x56, 0xb4, 0x42, 0x79, 0xbd, 0x80, 0xd5, 0xae, 0x89, 0xe2, 0xd7, 0x5b, 0x88, 0xf5, 0xce,
+	0x7a, 0x0b, 0xb6, 0x03, 0xf9, 0xe9, 0x89, 0xed, 0x98, 0x8d, 0x9c, 0x3c, 0x04, 0x9a, 0x11, 0x47,
+	0xb0, 0x5b, 0x50, 0xf2, 0xbd, 0xb3, 0x71, 0x60, 0xff, 0x26, 0x12, 0xa7, 0x45, 0xdf, 0x3b, 0x1b,
+	0xda, 0xbf, 0xb1, 0xb4, 0x91, 0x90, 0xe9, 0x00, 0x85, 0x61, 0xab, 0xd9, 0x6b, 0xea, 0xea, 0x15,
+	0x2c, 0x77, 0xbe, 0xe9, 0x0e, 0x47, 0x43, 0x35, 0xc3, 0xea, 0x00, 0xfd, 0xc1, 0x68, 0x2c, 0xea,
+	0x59, 0x56, 0x80, 0x6c, 0xb7, 0xaf, 0x2a, 0x48, 0x83, 0xf0, 0x6e, 0x5f, 0xcd, 0xb1, 0x22, 0x28,
+	0xcd, 0xfe, 0xb7, 0x6a, 0x9e, 0x0a, 0xbd, 0x9e, 0x5a, 0xd0, 0xfe, 0x51, 0x16, 0xca, 0x83, 0xc9,
+	0x33, 0x6b, 0x1a, 0xe2, 0x9c, 0x71, 0x3b, 0x5a, 0xfe, 0x73, 0xcb, 0xa7, 0x69, 0x2b, 0xba, 0xa8,
+	0xe1, 0x44, 0xcc, 0x09, 0x4d, 0x4e, 0xd1, 0xb3, 0xe6, 0x84, 0xe8, 0xa6, 0x27, 0xd6, 0xdc, 0x68,
+	0x28, 0x82, 0x8e, 0x6a, 0xb8, 0xfd, 0xbd, 0xc9, 0x33, 0x9a, 0x9e, 0xa2, 0x63, 0x91, 0xbd, 0x0e,
+	0x15, 0xde, 0xc6, 0x98, 0xf6, 0x5e, 0x9e, 0xd6, 0x02, 0x38, 0xa8, 0x8f, 0x27, 0xe0, 0x26, 0x14,
+	0xcd, 0x09, 0x47, 0x72, 0x4d, 0x51, 0x30, 0x27, 0x84, 0x40, 0x4e, 0x6a, 0x95, 0x23, 0x8b, 0x82,
+	0x93, 0x40, 0x44, 0x70, 0x0b, 0x4a, 0xde, 0xe4, 0x19, 0xc7, 0x96, 0x08, 0x5b, 0xf4, 0x26, 0xcf,
+	0x08, 0xf5, 0x33, 0xb8, 0x1a, 0x2c, 0x27, 0xc1, 0xd4, 0xb7, 0x17, 0xa1, 0xed, 0xb9, 0x9c, 0xa6,
+	0x4c, 0x34, 0xaa, 0x8c, 0x20, 0xe2, 0x7b, 0x50, 0x5f, 0x2c, 0x27, 0x63, 0x63, 0x3a, 0xf5, 0x96,
+	0x6e, 0x88, 0x5f, 0x11, 0x68, 0xe5, 0xab, 0x8b, 0xe5, 0xa4, 0xc9, 0x81, 0x5d, 0x53, 0xfb, 0x7b,
+	0x19, 0x50, 0x87, 0x12, 0xeb, 0x81, 0x15, 0x1a, 0x1b, 0x8f, 0xf4, 0x6b, 0x00, 0x52, 0x53, 0x7c,
+	0x43, 0x94, 0x8d, 0xa8, 0x1d, 0x79, 0xbe, 0x4a, 0x6a, 0xbe, 0x6f, 0x40, 0x35, 0xe2, 0x23, 0x6c,
+	0x8e, 0xb0, 0x15, 0x01, 0x8b, 0x66, 0x1c, 0x2c, 0x27, 0xf2, 0x4a, 0x16, 0x83, 0x25, 0x71, 0x6b,
+	0xff, 0x3b, 0x03, 0xa5, 0x47, 0x4b, 0x77, 0x8a, 0x43, 0x63, 0x6f, 0x42, 0x6e, 0xb6, 0x74, 0xa7,
+	0x8d, 0x8c, 0x2c, 0xbb, 0xe3, 0xaf, 0xac, 0x13, 0x12, 0x4f, 0x97, 0xe1, 0x1f, 0xe3, 0xa9, 0x5c,
+	0x3b, 0x5d, 0x08, 0xd7, 0xfe, 0xbe, 0x68, 0xf1, 0x91, 0x63, 0x1c, 0xb3, 0x12, 0xe4, 0xfa, 0x83,
+	0x7e, 0x47, 0xbd, 0xc2, 0xaa, 0x50, 0xea, 0xf6, 0x47, 0x1d, 0xbd, 0xdf, 0xec, 0xa9, 0x19, 0xda,
+	0x8c, 0xa3, 0xe6, 0x5e, 0xaf, 0xa3, 0x66, 0x11, 0xf3, 0x64, 0xd0, 0x6b, 0x8e, 0xba, 0xbd, 0x8e,
+	0x9a, 0xe3, 0x18, 0xbd, 0xdb, 0x1a, 0xa9, 0x25, 0xa6, 0x42, 0xf5, 0x50, 0x1f, 0xb4, 0x8f, 0x5a,
+	0x9d, 0x71, 0xff, 0xa8, 0xd7, 0x53, 0x55, 0x76, 0x0d, 0xb6, 0x62, 0xc8, 0x80, 0x03, 0x77, 0x90,
+	0xe5, 0x49, 0x53, 0x6f, 0xea, 0xfb, 0xea, 0xaf, 0x58, 0x09, 0x94, 0xe6, 0xfe, 0xbe, 0xfa, 0xdb,
+	0x0c, 0x96, 0x9e, 0x76, 0xfb, 0xea, 0x6f, 0xb3, 0xac, 0x0e, 0xe5, 0x83, 0x41, 0x7f, 0x30, 0x1a,
+	0xf4, 0xbb, 0x2d, 0xf5, 0xb7, 0x39, 0xed, 0x9f, 0x28, 0x90, 0xc3, 0x01, 0x7f, 0xff, 0xc1, 0x66,
+	0x3f, 0x81, 0xcc, 0x94, 0xbe, 0x43, 0x65, 0xb7, 0xc2, 0x71, 0x64, 0x81, 0x3c, 0xbe, 0xa2, 0x67,
+	0x70, 0x15, 0x32, 0xfc, 0x84, 0x56, 0x76, 0xeb, 0x1c, 0x19, 0xc9, 0x72, 0xc4, 0x2f, 0xd8, 0x1d,
+	0xc8, 0x3c, 0x17, 0xc7, 0xb5, 0xca, 0xf1, 0x5c, 0x9a, 0x23, 0xf6, 0x39, 0xdb, 0x01, 0x65, 0xea,
+	0x71, 0xeb, 0x22, 0xc6, 0x73, 0x81, 0xf8, 0xf8, 0x8a, 0x8e, 0x28, 0xf6, 0x26, 0x28, 0xbe, 0x71,
+	0xd6, 0x28, 0xc8, 0x5f, 0x22, 0x96, 0xb8, 0x48, 0xe4, 0x1b, 0x67, 0x38, 0x88, 0x59, 0xa3, 0x28,
+	0x0f, 0x22, 0xfa, 0x94, 0xd8, 0xcd, 0x8c, 0xfd, 0x14, 0x94, 0x60, 0x39, 0xa1, 0x4d, 0x5e, 0xd9,
+	0xbd, 0xba, 0x26, 0x8a, 0xb0, 0x99, 0x60, 0x39, 0x61, 0x6f, 0x41, 0x6e, 0xea, 0xf9, 0x7e, 0xa3,
+	0x2c, 0xab, 0xde, 0x44, 0x46, 0xa3, 0xf9, 0x80, 0x78, 0xb6, 0x03, 0x99, 0xb0, 0x01, 0x32, 0x51,
+	0x22, 0x24, 0xb1, 0xc3, 0x90, 0xdd, 0x13, 0x92, 0xb7, 0x22, 0x8f, 0x29, 0x92, 0xcb, 0xd8, 0x0e,
+	0x62, 0x99, 0x06, 0xca, 0xdc, 0x38, 0x6f, 0x54, 0x65, 0xa2, 0x48, 0x20, 0xe3, 0x98, 0xe6, 0xc6,
+	0xf9, 0x5e, 0x01, 0x72, 0xd6, 0xf9, 0xc2, 0xd7, 0x6e, 0x41, 0x39, 0xb6, 0x17, 0x58, 0x15, 0x32,
+	0x86, 0x90, 0x30, 0x19, 0x43, 0xbb, 0x0f, 0x20, 0x50, 0x1f, 0xec, 0x7e, 0x96, 0xc6, 0x61, 0x2d,
+	0x92, 0x3b, 0x99, 0x89, 0xf6, 0x0b, 0xa8, 0xea, 0x56, 0xb0, 0x74, 0xc2, 0x96, 0xe7, 0xb4, 0xad,
+	0x19, 0x7b, 0x17, 0x20, 0xae, 0x07, 0x42, 0x4d, 0x24, 0x5f, 0xa1, 0x6d, 0xcd, 0x74, 0x09, 0xaf,
+	0xfd, 0x89, 0x02, 0x05, 0xc1, 0x98, 0xa8, 0xb4, 0x8c, 0xa4, 0xd2, 0xe2, 0xe3, 0x9c, 0x4d, 0x6b,
+	0xe8, 0x13, 0xdb, 0x34, 0x2d, 0x37, 0xd2, 0xc4, 0xbc, 0xc6, 0xee, 0x81, 0x62, 0x38, 0xc7, 0xb4,
+	0x35, 0xea, 0xbb, 0x2c, 0xea, 0x74, 0xbe, 0xf0, 0xad, 0x20, 0xe0, 0x7b, 0xcf, 0x70, 0x8e, 0xa3,
+	0x9d, 0x99, 0xdf, 0xbc, 0x33, 0x6f, 0x41, 0xc9, 0xf5, 0xc2, 0x31, 0x59, 0xc1, 0x05, 0x6a, 0xbd,
+	0x28, 0x6c, 0x71, 0xf6, 0x36, 0x14, 0x85, 0xfd, 0x22, 0x36, 0x46, 0x8d, 0x33, 0xb7, 0x39, 0x50,
+	0x8f, 0xb0, 0xac, 0x81, 0xfa, 0x75, 0x3e, 0xb7, 0xdc, 0x30, 0x12, 0x82, 0xa2, 0xca, 0x7e, 0x06,
+	0x65, 0xcf, 0x1d, 0x73, 0x23, 0xa7, 0x51, 0x96, 0x3f, 0xd2, 0xc0, 0x3d, 0x22, 0xa8, 0x5e, 0xf2,
+	0x44, 0x09, 0x87, 0xe2, 0x78, 0x67, 0xe3, 0xa9, 0xe1, 0x73, 0xf1, 0x57, 0xd2, 0x8b, 0x8e, 0x77,
+	0xd6, 0x32, 0x7c, 0x93, 0x2b, 0x85, 0xef, 0xdc, 0xe5, 0x9c, 0x8c, 0xcd, 0x9a, 0x2e, 0x6a, 0xec,
+	0x0e, 0x94, 0xa7, 0xce, 0x32, 0x08, 0x2d, 0x7f, 0xef, 0x82, 0x76, 0x4a, 0x49, 0x4f, 0x00, 0x38,
+	0xae, 0x85, 0x6f, 0xcf, 0x0d, 0xff, 0x82, 0x9b, 0xb4, 0x7a, 0x54, 0x45, 0x55, 0xbd, 0x38, 0xb5,
+	0xcd, 0x73, 0x32, 0x6a, 0xf3, 0x3a, 0xaf, 0x68, 0x7f, 0x2b, 0x03, 0x45, 0x31, 0x39, 0x76, 0x97,
+	0x6f, 0x9a, 0xf4, 0x81, 0xe6, 0xa2, 0x09, 0xe1, 0xec, 0x4d, 0xa8, 0x79, 0xbe, 0x7d, 0x6c, 0xbb,
+	0xe3, 0x20, 0xf4, 0x6d, 0xf7, 0x58, 0x7c, 0xb0, 0x2a, 0x07, 0x0e, 0x09, 0x86, 0xf2, 0x14, 0x17,
+	0x76, 0x6c, 0x4c, 0x6c, 0xc7, 0x0e, 0x2f, 0xc4, 0xe7, 0xab, 0x20, 0xac, 0xc9, 0x41, 0x28, 0xaa,
+	0x67, 0xb6, 0xe3, 0x8c, 0xb9, 0xe5, 0x80, 0x9f, 0xb2, 0xaa, 0x97, 0x11, 0x42, 0x5b, 0x58, 0x1b,
+	0x40, 0x29, 0x5a, 0xa9, 0x1f, 0x65, 0x48, 0xda, 0x5f, 0x82, 0x4a, 0xd7, 0x35, 0xad, 0xf3, 0x01,
+	0x69, 0x10, 0xf6, 0x2e, 0xb0, 0xa9, 0x6f, 0x19, 0xa1, 0x35, 0xb6, 0xce, 0x43, 0xdf, 0x18, 0x73,
+	0x7f, 0x89, 0xbb, 0x43, 0x2a, 0xc7, 0x74, 0x10, 0x31, 0x22, 0xd7, 0xe9, 0x3f, 0x65, 0xa0, 0x76,
+	0xc8, 0x97, 0xf0, 0x6b, 0xeb, 0xa2, 0xcd, 0x0d, 0xca, 0x69, 0xb4, 0xf1, 0x73, 0x3a, 0x95, 0xd9,
+	0x5d, 0xa8, 0x2c, 0x4e, 0xad, 0x8b, 0x71, 0xca, 0x62, 0x2b, 0x23, 0xa8, 0x45, 0x5b, 0xfc, 0x1d,
+	0x28, 0x78, 0xd4, 0x7b, 0x43, 0x91, 0xa5, 0x89, 0x34, 0x2c, 0x5d, 0x10, 0x30, 0x0d, 0x6a, 0x71,
+	0x53, 0xb2, 0x46, 0x12, 0x8d, 0x91, 0x46, 0xda, 0x86, 0x3c, 0xa2, 0x82, 0x46, 0x7e, 0x47, 0x41,
+	0xb3, 0x8b, 0x2a, 0xec, 0xe7, 0x50, 0x9b, 0x7a, 0xf3, 0xc5, 0x38, 0x62, 0x17, 0xe2, 0x2f, 0x7d,
+	0x34, 0x2b, 0x48, 0x72, 0xc8, 0xdb, 0xd2, 0xbe, 0x82, 0xc2, 0x68, 0xd4, 0xc3, 0x49, 0xdd, 0x82,
+	0x52, 0xdc, 0x61, 0x26, 0xda, 0xd0, 0xbc, 0xb3, 0x9f, 0x42, 0xdd, 0x3a, 0x5f, 0xd8, 0xbe, 0x35,
+	0x0e, 0xac, 0xa9, 0xe7, 0x9a, 0x81, 0x90, 0x0c, 0x35, 0x0e, 0x1d, 0x72, 0xa0, 0xf6, 0x77, 0xb3,
+	0x50, 0xa2, 0xf9, 0x88, 0x93, 0x6e, 0x9b, 0xe7, 0xd1, 0x49, 0x2f, 0xeb, 0x79, 0xdb, 0x3c, 0xef,
+	0x9a, 0xf8, 0xe5, 0x6d, 0x24, 0x19, 0x4b, 0xe7, 0xbd, 0x4c, 0x90, 0x68, 0x5a, 0x0b, 0xc3, 0x0f,
+	0x83, 0x86, 0xc2, 0xa7, 0x45, 0x15, 0x3c, 0x08, 0x4b, 0xd7, 0xfe, 0x4e, 0x6c, 0x95, 0x92, 0x2e,
+	0x6a, 0xec, 0x3e, 0xa8, 0xbc, 0x31, 0xfa, 0x80, 0xb2, 0x7a, 0xae, 0x13, 0x9c, 0xbe, 0x5f, 0x64,
+	0xd3, 0x70, 0x1a, 0xeb, 0x1c, 0xc5, 0x2b, 0x3f, 0xf3, 0x40, 0xa0, 0x0e, 0x42, 0xe4, 0xd3, 0x5c,
+	0x4c, 0x9f, 0xe6, 0x06, 0x14, 0x9f, 0xdb, 0x81, 0x8d, 0x3b, 0xa4, 0xc4, 0xcf, 0x93, 0xa8, 0x4a,
+	0x9f, 0xb4, 0xfc, 0x82, 0x4f, 0xaa, 0xfd, 0xeb, 0x2c, 0xd4, 0x1e, 0x79, 0xbe, 0x65, 0x1f, 0xbb,
+	0xc9, 0x1e, 0x5a, 0xb3, 0x60, 0xa2, 0x7d, 0x95, 0x95, 0xf6, 0xd5, 0xeb, 0x50, 0x99, 0x71, 0xc6,
+	0x71, 0x38, 0xe1, 0x5e, 0x49, 0x4e, 0x07, 0x01, 0x1a, 0x4d, 0x1c, 0x3c, 0x6e, 0x11, 0x01, 0x31,
+	0xe7, 0x88, 0x39, 0x62, 0x42, 0x01, 0xcc, 0xbe, 0x20, 0x81, 0x64, 0x5a, 0x8e, 0x15, 0xf2, 0x05,
+	0xaa, 0xef, 0xbe, 0x26, 0xd4, 0x9d, 0x3c, 0xa6, 0x87, 0xba, 0x35, 0x6b, 0x92, 0xf6, 0x43, 0xf9,
+	0xd4, 0x26, 0x72, 0xf6, 0x85, 0x2c, 0xcc, 0x0a, 0x2f, 0xc9, 0xcb, 0xcf, 0xae, 0x36, 0x82, 0x72,
+	0x0c, 0x46, 0x2b, 0x45, 0xef, 0x08, 0xcb, 0xe4, 0x0a, 0xab, 0x40, 0xb1, 0xd5, 0x1c, 0xb6, 0x9a,
+	0xed, 0x8e, 0x9a, 0x41, 0xd4, 0xb0, 0x33, 0xe2, 0xd6, 0x48, 0x96, 0x6d, 0x41, 0x05, 0x6b, 0xed,
+	0xce, 0xa3, 0xe6, 0x51, 0x6f, 0xa4, 0x2a, 0xac, 0x06, 0xe5, 0xfe, 0x60, 0xdc, 0x6c, 0x8d, 0xba,
+	0x83, 0xbe, 0x9a, 0xd3, 0x7e, 0x05, 0xa5, 0xd6, 0x89, 0x35, 0x3d, 0xbd, 0x6c, 0x15, 0xc9, 0xd8,
+	0xb7, 0xa6, 0xa7, 0x8d, 0xec, 0x9a, 0xc8, 0xe0, 0x08, 0xad, 0x0d, 0xd5, 0x56, 0x24, 0x2f, 0xb1,
+	0x95, 0x9d, 0x68, 0xd7, 0xad, 0x3b, 0x3c, 0x1c, 0xb1, 0x49, 0x41, 0x69, 0x1f, 0x43, 0xe5, 0xd0,
+	0xf7, 0x16, 0x96, 0x1f, 0x52, 0x23, 0x2a, 0x28, 0xa7, 0xd6, 0x85, 0x18, 0x09, 0x16, 0x13, 0xd7,
+	0x28, 0x2b, 0xbb, 0x46, 0xbb, 0x50, 0x8a, 0xd8, 0x5e, 0x9a, 0xe7, 0x97, 0x50, 0x13, 0x3c, 0xb6,
+	0x15, 0x60, 0x67, 0x0f, 0x01, 0x16, 0x31, 0x40, 0x0c, 0x3b, 0x32, 0xa3, 0x44, 0xe3, 0xba, 0x44,
+	0xa1, 0xfd, 0x99, 0x02, 0xf5, 0x43, 0xc3, 0x0f, 0x6d, 0xfc, 0x14, 0x7c, 0xd2, 0x6f, 0x43, 0x2e,
+	0xbc, 0x58, 0x58, 0xc2, 0xcf, 0xba, 0x16, 0xdb, 0x60, 0x9c, 0x86, 0x74, 0x25, 0x11, 0xb0, 0x2f,
+	0xa0, 0xbe, 0x88, 0xc0, 0x63, 0x92, 0xc5, 0x7c, 0x61, 0x57, 0x59, 0x68, 0xbd, 0x6a, 0x0b, 0xb9,
+	0xca, 0xbe, 0x84, 0xed, 0x34, 0xaf, 0x15, 0x04, 0x89, 0x0c, 0x94, 0x17, 0xfa, 0x5a, 0x8a, 0x91,
+	0x93, 0xb1, 0x16, 0x5c, 0x4d, 0xd8, 0xa7, 0x9e, 0xb3, 0x9c, 0xbb, 0x81, 0x30, 0x0a, 0x6f, 0xac,
+	0xf4, 0xde, 0xe2, 0x58, 0x5d, 0x5d, 0xac, 0x40, 0x98, 0x06, 0xd5, 0x18, 0xd6, 0x5f, 0xce, 0xe9,
+	0x00, 0xe4, 0xf4, 0x14, 0x8c, 0x7d, 0x08, 0x10, 0xd7, 0x83, 0x46, 0x61, 0x47, 0xd9, 0x30, 0xbf,
+	0x6e, 0x68, 0xcd, 0x75, 0x89, 0x0c, 0xf5, 0xb0, 0xe1, 0x1c, 0x7b, 0xbe, 0x1d, 0x9e, 0xcc, 0x49,
+	0x6a, 0x28, 0x7a, 0x02, 0x20, 0xe1, 0x14, 0x8c, 0xd1, 0x6d, 0x88, 0x59, 0x84, 0x00, 0xa9, 0xdb,
+	0xc1, 0x70, 0x39, 0x89, 0xdb, 0x45, 0x15, 0x96, 0xcc, 0x72, 0x1e, 0x1c, 0x0b, 0x87, 0x29, 0x19,
+	0xe1, 0x41, 0x70, 0xcc, 0x76, 0xe1, 0x7a, 0x42, 0x94, 0xc8, 0xbb, 0xa0, 0x01, 0x24, 0x29, 0x93,
+	0xe5, 0x8b, 0x85, 0x5e, 0xa0, 0x7d, 0x05, 0xb5, 0xd4, 0xd7, 0x79, 0xa1, 0x32, 0xbd, 0x05, 0x25,
+	0xfc, 0x8f, 0xaa, 0x54, 0x6c, 0xc0, 0x22, 0xd6, 0x87, 0xa1, 0xaf, 0x59, 0xa0, 0xae, 0xae, 0x35,
+	0xbb, 0x47, 0x21, 0x06, 0x2c, 0x6e, 0x38, 0x39, 0x11, 0x0a, 0x7d, 0xc2, 0xf5, 0x8f, 0x98, 0xa5,
+	0x51, 0xaf, 0x7d, 0x2c, 0xed, 0x1f, 0x64, 0xa1, 0x96, 0x5a, 0x71, 0x54, 0x3e, 0x09, 0xbb, 0x74,
+	0xd8, 0x93, 0x35, 0x23, 0x09, 0xff, 0x0e, 0xa8, 0x9e, 0x6f, 0xda, 0xae, 0x41, 0x21, 0x0f, 0xbe,
+	0xdc, 0x59, 0x32, 0x9b, 0xb6, 0x04, 0xfc, 0x50, 0x80, 0x31, 0x18, 0x6b, 0x5a, 0xb1, 0x3f, 0x29,
+	0xbc, 0x41, 0x19, 0x24, 0x6b, 0x83, 0x5c, 0x5a, 0x1b, 0xbc, 0x0d, 0x65, 0xc7, 0x0a, 0x82, 0x71,
+	0x78, 0x62, 0xb8, 0x8d, 0xfc, 0xda, 0xa4, 0x4b, 0x88, 0x1c, 0x9d, 0x18, 0x2e, 0x12, 0xda, 0x2e,
+	0x37, 0x70, 0xa2, 0x0d, 0x95, 0x22, 0xb4, 0x5d, 0xb2, 0x75, 0x50, 0x67, 0x6f, 0x6f, 0xfa, 0xb0,
+	0x42, 0x0d, 0xb1, 0xf5, 0xef, 0xaa, 0x05, 0xd2, 0x59, 0x3e, 0xf4, 0x97, 0x2e, 0x05, 0x8f, 0xed,
+	0x60, 0xbc, 0xc0, 0xb2, 0x29, 0xec, 0x98, 0x92, 0x1d, 0x10, 0xce, 0x64, 0x6d, 0xb8, 0x16, 0x58,
+	0x8e, 0x35, 0x0d, 0x2d, 0x73, 0x2c, 0x6d, 0xf2, 0xec, 0xe5, 0x9b, 0x9c, 0x45, 0xf4, 0x31, 0x38,
+	0xd0, 0x5e, 0x83, 0xe2, 0x13, 0xdb, 0x3a, 0x13, 0x42, 0xf7, 0xb9, 0x6d, 0x9d, 0x45, 0x42, 0x17,
+	0xcb, 0xda, 0x9f, 0x17, 0xa1, 0x44, 0x23, 0x6c, 0x5f, 0x1e, 0xcf, 0xfa, 0x21, 0x56, 0xfe, 0x0e,
+	0xe4, 0x62, 0x6d, 0xb6, 0x6a, 0xc0, 0x10, 0x06, 0x2d, 0x09, 0xbe, 0x5a, 0x24, 0xc5, 0xb8, 0xda,
+	0x2f, 0x13, 0x44, 0xc4, 0x9c, 0xca, 0xdc, 0x92, 0x0b, 0xbe, 0x73, 0x44, 0x80, 0x23, 0x01, 0xb0,
+	0x87, 0x50, 0xc2, 0x11, 0x92, 0xb3, 0x5e, 0x94, 0xa5, 0x19, 0xcd, 0x21, 0x72, 0x02, 0xf5, 0x62,
+	0x38, 0x71, 0xb0, 0x42, 0x46, 0x80, 0xe5, 0x07, 0xd1, 0x19, 0xae, 0xe9, 0x51, 0x15, 0xc5, 0x28,
+	0x5a, 0x5b, 0x8d, 0x8a, 0xdc, 0x4a, 0xca, 0x5c, 0xd4, 0x89, 0x80, 0xdd, 0x87, 0x22, 0x19, 0x25,
+	0x56, 0xd0, 0xa8, 0xca, 0xf2, 0x3a, 0xb2, 0x98, 0xf4, 0x08, 0xcd, 0xde, 0x81, 0xfc, 0xec, 0xd4,
+	0xba, 0x08, 0x1a, 0x35, 0xf9, 0x13, 0xa5, 0xd4, 0xad, 0xce, 0x29, 0x30, 0x84, 0xe2, 0x5b, 0xb3,
+	0x31, 0xc5, 0xb0, 0xd0, 0x3e, 0x08, 0x1a, 0x75, 0x52, 0xff, 0x55, 0xdf, 0x9a, 0xb5, 0x10, 0x38,
+	0x9a, 0x38, 0x01, 0x7b, 0x0b, 0x0a, 0xa4, 0xf8, 0x82, 0xc6, 0x96, 0xdc, 0x73, 0xa4, 0x45, 0x75,
+	0x81, 0x65, 0xbb, 0x50, 0x4e, 0x64, 0xd5, 0x75, 0x9a, 0xd0, 0xf6, 0xca, 0xfe, 0x20, 0xdd, 0xa1,
+	0x27, 0x64, 0xec, 0x03, 0x00, 0xe1, 0x7b, 0x8c, 0x27, 0x17, 0x14, 0xe2, 0xad, 0xc4, 0x5e, 0x99,
+	0xa4, 0x63, 0x65, 0x0f, 0xe5, 0x6d, 0xc8, 0xa3, 0x6a, 0x0a, 0x1a, 0x37, 0x77, 0x94, 0xc4, 0x6c,
+	0x92, 0x74, 0xa9, 0xce, 0xf1, 0xec, 0x3e, 0x94, 0x70, 0x73, 0x8d, 0xf1, 0x13, 0x36, 0x64, 0x67,
+	0x4c, 0xec, 0x44, 0x34, 0xc5, 0xac, 0xb3, 0xe1, 0x77, 0x0e, 0x7b, 0x00, 0x39, 0xd3, 0x9a, 0x05,
+	0x8d, 0x5b, 0x3b, 0x4a, 0xa2, 0x1b, 0xa2, 0xfd, 0x88, 0xbe, 0x1b, 0xd7, 0x67, 0x48, 0xc3, 0x1e,
+	0x43, 0x1d, 0xb7, 0xde, 0x2e, 0x59, 0xea, 0xb8, 0xe4, 0x8d, 0xdb, 0xc4, 0xf5, 0xc6, 0x0a, 0x57,
+	0x5f, 0x10, 0xd1, 0x07, 0xea, 0xb8, 0xa1, 0x7f, 0xa1, 0xd7, 0x5c, 0x19, 0xc6, 0x6e, 0x43, 0xc9,
+	0x0e, 0x7a, 0xde, 0xf4, 0xd4, 0x32, 0x1b, 0x3f, 0x89, 0x4e, 0x1d, 0xaf, 0xb3, 0xcf, 0xa1, 0x46,
+	0x9b, 0x11, 0xab, 0xd8, 0x79, 0xe3, 0x8e, 0xac, 0x67, 0x47, 0x32, 0x4a, 0x4f, 0x53, 0xb2, 0xbb,
+	0xa0, 0x84, 0xa1, 0xd3, 0x78, 0x4d, 0xb6, 0xdd, 0xb9, 0x91, 0xae, 0x23, 0xe2, 0xf6, 0x3e, 0x39,
+	0x6c, 0x44, 0xfa, 0xf1, 0x8a, 0x1d, 0x90, 0xda, 0x83, 0x92, 0xc1, 0x80, 0x61, 0xf9, 0x84, 0x70,
+	0x2f, 0x0f, 0x8a, 0x69, 0xcd, 0x6e, 0xff, 0x0a, 0xd8, 0xfa, 0x24, 0x5f, 0x64, 0x94, 0xe4, 0x85,
+	0x51, 0xf2, 0x45, 0xf6, 0xb3, 0x8c, 0xf6, 0x39, 0xd4, 0x52, 0x27, 0x66, 0xa3, 0x41, 0xc6, 0x8d,
+	0x7a, 0x83, 0x87, 0xda, 0xab, 0x3a, 0xaf, 0x68, 0xff, 0x26, 0x03, 0xf9, 0x61, 0x68, 0x84, 0x01,
+	0x4a, 0xaf, 0x89, 0xe3, 0x4d, 0x4f, 0xc7, 0xe8, 0xea, 0xf2, 0x20, 0x76, 0x89, 0x00, 0xa8, 0x99,
+	0xc9, 0x26, 0x0e, 0x42, 0xe2, 0xcd, 0xe8, 0x54, 0x46, 0xa1, 0xe1, 0x2d, 0xc3, 0xa9, 0x1b, 0x92,
+	0xd0, 0xc8, 0xe8, 0xa2, 0x86, 0xa7, 0xd4, 0xf7, 0xce, 0x28, 0x86, 0x9b, 0x23, 0x44, 0x54, 0x45,
+	0x23, 0xf9, 0xc4, 0x08, 0x4e, 0xe6, 0xc6, 0x22, 0x09, 0xf1, 0x66, 0xf4, 0x8a, 0x80, 0x61, 0x98,
+	0x17, 0x47, 0xc1, 0xe5, 0x09, 0xb6, 0x5b, 0x20, 0x7c, 0x89, 0x00, 0x2d, 0x37, 0x44, 0x95, 0xc1,
+	0x65, 0xa2, 0xfd, 0x1c, 0x5d, 0xda, 0x22, 0x67, 0x97, 0x40, 0xda, 0x3b, 0x50, 0x44, 0xf1, 0x64,
+	0x84, 0x06, 0x6a, 0x59, 0xd3, 0x08, 0x8d, 0x4d, 0xe1, 0x73, 0x84, 0x6b, 0xef, 0x03, 0xe8, 0xde,
+	0x59, 0x60, 0x85, 0x44, 0xfd, 0x86, 0xe4, 0x4c, 0xc6, 0x1b, 0x5c, 0x34, 0xc5, 0x45, 0x9d, 0xf6,
+	0x9f, 0x33, 0x50, 0x19, 0xf8, 0x26, 0x1e, 0x9e, 0xe1, 0xc2, 0x9a, 0xbe, 0x50, 0x8d, 0xa3, 0xec,
+	0xf3, 0x1c, 0xc7, 0x88, 0x95, 0x60, 0x59, 0x4f, 0x00, 0xec, 0x03, 0xc8, 0xcd, 0x1c, 0xe3, 0xb8,
+	0xa1, 0xc8, 0xc6, 0xbc, 0xd4, 0x7c, 0x54, 0xc6, 0xf8, 0xa3, 0x4e, 0xa4, 0xda, 0x1f, 0x42, 0x45,
+	0x02, 0xa6, 0x42, 0x91, 0x57, 0x28, 0xa4, 0x3d, 0x6c, 0xa9, 0x18, 0x30, 0xcc, 0xb5, 0x3b, 0xc3,
+	0x16, 0x37, 0xe1, 0xd1, 0x98, 0x1f, 0x8e, 0x1f, 0x75, 0xf5, 0xe1, 0x48, 0xcd, 0x51, 0x8c, 0x9c,
+	0x00, 0xbd, 0xe6, 0x10, 0x03, 0x93, 0x00, 0x85, 0xa3, 0x7e, 0xf7, 0xd7, 0x47, 0x1d, 0x55, 0xd5,
+	0xfe, 0x66, 0x06, 0xe0, 0xa9, 0xed, 0x9a, 0xde, 0x19, 0x4d, 0xee, 0x3d, 0xc9, 0x5c, 0x43, 0x91,
+	0xb2, 0xbe, 0x8a, 0x95, 0x45, 0x22, 0x8d, 0xd8, 0xbb, 0x50, 0xf2, 0x70, 0x68, 0x48, 0x9a, 0x95,
+	0xe5, 0x89, 0x34, 0x23, 0xbd, 0xe8, 0xf1, 0x0a, 0xee, 0x26, 0xc7, 0x32, 0x4c, 0x71, 0xf5, 0x41,
+	0x65, 0xdc, 0xef, 0xb8, 0x1c, 0xfc, 0x6a, 0x15, 0x8b, 0xda, 0x7f, 0xc8, 0x41, 0xb9, 0xeb, 0x06,
+	0x96, 0x1f, 0xb6, 0xc2, 0x73, 0xf6, 0x06, 0x28, 0xbe, 0x35, 0xbb, 0x2c, 0xa6, 0x8b, 0x38, 0x8c,
+	0xf8, 0xf0, 0xbd, 0x63, 0x5a, 0x33, 0x61, 0x1d, 0xd7, 0xd3, 0xd2, 0x44, 0xec, 0xa5, 0x36, 0xdd,
+	0x6f, 0xa8, 0xe8, 0x8d, 0x2d, 0x17, 0x8e, 0x3d, 0xc5, 0x18, 0x04, 0x46, 0x64, 0xd0, 0xdd, 0xcd,
+	0xeb, 0x75, 0xcf, 0x6d, 0x47, 0xe0, 0xae, 0x79, 0xce, 0x0e, 0xe1, 0x6a, 0x8a, 0x92, 0x3e, 0x3a,
+	0xd7, 0x88, 0xf7, 0x22, 0xe5, 0x21, 0x46, 0xf9, 0x70, 0x90, 0xb0, 0xe2, 0x22, 0x71, 0x79, 0xb5,
+	0xe5, 0xa5, 0xa1, 0xa4, 0x84, 0xcc, 0xf3, 0x31, 0xce, 0x87, 0x1b, 0x2f, 0x6b, 0xf3, 0x41, 0xaf,
+	0x5d, 0xdc, 0x2b, 0x71, 0xff, 0xfd, 0x9c, 0xac, 0x97, 0x3c, 0x21, 0x70, 0x50, 0x5f, 0x92, 0xa9,
+	0x6c, 0x51, 0x94, 0xfd, 0xbc, 0x51, 0xa4, 0x56, 0xee, 0xae, 0x8e, 0xe6, 0x90, 0x28, 0xba, 0xa6,
+	0x90, 0x9b, 0xe5, 0x45, 0x54, 0x67, 0x9f, 0x42, 0x2d, 0xd2, 0x17, 0x3c, 0xec, 0x52, 0xda, 0xa0,
+	0x32, 0x68, 0xd5, 0xf4, 0xea, 0x54, 0xaa, 0xb1, 0x9f, 0xcb, 0xca, 0xa9, 0x2c, 0x33, 0xb5, 0xe7,
+	0x4e, 0xac, 0x9f, 0x24, 0xd5, 0x74, 0xbb, 0x0f, 0xdb, 0x9b, 0x56, 0x65, 0x83, 0x80, 0xdb, 0x91,
+	0x05, 0xdc, 0x8a, 0x03, 0x18, 0x0b, 0xbb, 0xdb, 0xbf, 0x20, 0xbb, 0x4b, 0x9a, 0xd7, 0x0f, 0x12,
+	0x95, 0xff, 0xa5, 0x00, 0x65, 0xee, 0x17, 0xa7, 0x36, 0x95, 0x72, 0xe9, 0xa6, 0xba, 0x0b, 0x0a,
+	0xae, 0x70, 0x56, 0xb6, 0x80, 0xba, 0x26, 0x06, 0x82, 0x75, 0x44, 0xb0, 0x77, 0xc5, 0xa6, 0x6b,
+	0xa3, 0xe2, 0x53, 0x64, 0xc5, 0x1e, 0x6f, 0xba, 0x84, 0x00, 0x3d, 0x46, 0xee, 0xc4, 0x53, 0x5c,
+	0x28, 0x27, 0xf7, 0xdb, 0xa2, 0x7b, 0xc1, 0x03, 0x63, 0x11, 0xdd, 0xcc, 0xb6, 0x3c, 0xe7, 0xc7,
+	0xd8, 0x29, 0x9f, 0xc2, 0x96, 0xe7, 0x8e, 0x7d, 0x0b, 0x03, 0x73, 0xd3, 0x90, 0x9a, 0x2a, 0x6e,
+	0x6e, 0xaa, 0xe6, 0xb9, 0xba, 0x20, 0xc3, 0x16, 0xdf, 0x4a, 0x33, 0x62, 0xcb, 0x25, 0x6a, 0x59,
+	0xa2, 0xc3, 0x0e, 0x3e, 0x86, 0x3a, 0xba, 0x14, 0x46, 0x30, 0x35, 0x4c, 0x8b, 0xda, 0x2f, 0x6f,
+	0x6e, 0xbf, 0xea, 0xb9, 0x2d, 0x4e, 0x85, 0xcd, 0xef, 0xa6, 0xd8, 0xb0, 0x75, 0xd8, 0xb0, 0xc6,
+	0x09, 0x0f, 0x76, 0xf5, 0x51, 0x8a, 0x07, 0x8f, 0x79, 0x65, 0xe3, 0x8a, 0x27, 0x5c, 0x78, 0xd4,
+	0xf7, 0xe0, 0xba, 0xc4, 0x25, 0xad, 0x7f, 0x75, 0xf3, 0xfa, 0xb3, 0x98, 0xfb, 0x28, 0xfe, 0x10,
+	0xef, 0x01, 0x78, 0xee, 0x38, 0xb0, 0xf8, 0x02, 0xd6, 0x36, 0x4f, 0xb0, 0xe4, 0xb9, 0x43, 0x0b,
+	0x4b, 0xec, 0x41, 0x4c, 0x8e, 0x13, 0xab, 0x6f, 0x98, 0x18, 0xa7, 0xed, 0xd2, 0x0e, 0x8a, 0x68,
+	0x71, 0x42, 0x5b, 0x1b, 0x27, 0xc4, 0xa9, 0x71, 0x32, 0x5f, 0xc0, 0x55, 0x41, 0x2d, 0x4d, 0x44,
+	0xdd, 0x3c, 0x91, 0x3a, 0x71, 0x25, 0x93, 0x78, 0x98, 0x12, 0x1a, 0x57, 0x2f, 0xd9, 0x7d, 0x89,
+	0x94, 0xd8, 0x4d, 0xf9, 0xe3, 0x6c, 0x47, 0xb9, 0xe4, 0xb4, 0x4b, 0x54, 0xda, 0xff, 0x54, 0xa0,
+	0xd2, 0x74, 0x0d, 0xe7, 0xe2, 0x37, 0x56, 0xd7, 0x9d, 0x79, 0x3c, 0xd4, 0xb8, 0x58, 0x86, 0x63,
+	0x34, 0x02, 0xc4, 0xcd, 0x46, 0x99, 0x20, 0xa8, 0x7d, 0x31, 0xb0, 0xe6, 0x2d, 0xc3, 0x18, 0xcf,
+	0x23, 0x9a, 0xc0, 0x41, 0x44, 0x10, 0xf3, 0x93, 0xc5, 0xa0, 0x48, 0xfc, 0x64, 0x2f, 0x24, 0xfc,
+	0xb1, 0xc1, 0x11, 0xf3, 0x13, 0xc1, 0x9b, 0x50, 0xc3, 0x4c, 0x8a, 0xf1, 0xd4, 0x73, 0x83, 0xe5,
+	0xdc, 0x32, 0x79, 0x2e, 0x0c, 0x4f, 0xaf, 0x68, 0x09, 0x18, 0xb6, 0x32, 0xb7, 0xe6, 0x9e, 0x7f,
+	0xc1, 0x5b, 0x29, 0xf0, 0x56, 0x38, 0x88, 0x5a, 0x79, 0x17, 0xd8, 0x99, 0x61, 0x87, 0xe3, 0x74,
+	0x53, 0x3c, 0xda, 0xa0, 0x22, 0x66, 0x24, 0x37, 0x77, 0x03, 0x0a, 0xa6, 0x1d, 0x9c, 0x76, 0x07,
+	0x24, 0x56, 0x15, 0x5d, 0xd4, 0xd0, 0xb8, 0x09, 0x3e, 0xec, 0x0e, 0xc6, 0x93, 0x0b, 0x71, 0x25,
+	0xa1, 0xe8, 0x25, 0x04, 0xec, 0x5d, 0x84, 0x14, 0x46, 0x25, 0x24, 0x9f, 0x2d, 0xdd, 0x7a, 0xd2,
+	0x55, 0x84, 0xa2, 0xd7, 0x11, 0xde, 0x45, 0x70, 0x0b, 0xa1, 0xec, 0x01, 0x5c, 0x25, 0x4a, 0x31,
+	0x71, 0x4e, 0x5a, 0x21, 0xd2, 0x2d, 0x44, 0x0c, 0x96, 0x61, 0x4c, 0x7b, 0x07, 0xca, 0xae, 0x15,
+	0x9e, 0x79, 0x3e, 0x8e, 0xa6, 0xca, 0x57, 0x2f, 0x06, 0xa0, 0xe9, 0x1c, 0x4c, 0x0d, 0x17, 0x07,
+	0xdf, 0xa8, 0x89, 0xf1, 0x88, 0x3a, 0xbb, 0x8b, 0x0b, 0x8f, 0x9a, 0x84, 0xb0, 0x75, 0xbe, 0x24,
+	0x09, 0x44, 0xfb, 0xbf, 0x5b, 0x90, 0xeb, 0x7b, 0x26, 0xa9, 0x04, 0xba, 0xff, 0x5f, 0x8f, 0x63,
+	0x21, 0x9a, 0xfe, 0x90, 0x7d, 0x5d, 0x72, 0x45, 0xe9, 0xf2, 0x8c, 0x81, 0x37, 0x20, 0x1f, 0xa0,
+	0x31, 0xda, 0x50, 0xe4, 0xfb, 0x4a, 0xb2, 0x4f, 0x75, 0x8e, 0xc1, 0x21, 0x93, 0x9f, 0xe5, 0x5b,
+	0x2e, 0xc9, 0xcf, 0xbc, 0x1e, 0xd7, 0xc9, 0x68, 0xf1, 0x3d, 0x3c, 0x8d, 0x63, 0xba, 0xbf, 0xcb,
+	0x6f, 0x30, 0x5a, 0x38, 0x9e, 0x12, 0x2c, 0x7e, 0x0e, 0xe5, 0x67, 0x9e, 0xed, 0xf2, 0x81, 0x17,
+	0xd6, 0x06, 0xfe, 0x95, 0x67, 0xf3, 0x00, 0x5c, 0xe9, 0x99, 0x28, 0xb1, 0x37, 0xa1, 0xe8, 0xb9,
+	0xbc, 0xed, 0xe2, 0x5a, 0xdb, 0x05, 0xcf, 0xed, 0xf1, 0x7b, 0xc1, 0xda, 0x64, 0x89, 0x9e, 0x20,
+	0x92, 0x5a, 0xb3, 0x50, 0xc4, 0x9b, 0x2a, 0x04, 0x1c, 0xb8, 0x3d, 0x6b, 0x86, 0x97, 0x53, 0x95,
+	0x99, 0xed, 0xa0, 0xfa, 0xa5, 0xc6, 0xca, 0x6b, 0x8d, 0x01, 0x47, 0x53, 0x83, 0x3f, 0x85, 0xd2,
+	0xb1, 0xef, 0x2d, 0x17, 0x68, 0x5c, 0xc1, 0x1a, 0x65, 0x91, 0x70, 0x7b, 0x17, 0x38, 0x7b, 0x2a,
+	0xda, 0xee, 0x31, 0xca, 0x87, 0x46, 0x65, 0x8d, 0xb4, 0x12, 0xe1, 0x87, 0x16, 0xb5, 0x6a, 0x1c,
+	0x1f, 0xf3, 0xfe, 0xab, 0xeb, 0xad, 0x1a, 0xc7, 0xc7, 0xd4, 0xf9, 0xcf, 0xa0, 0x74, 0x86, 0xd7,
+	0x3a, 0x0b, 0x6b, 0xda, 0xa8, 0xc9, 0x97, 0xa6, 0x89, 0xb1, 0xa8, 0x17, 0xcf, 0x6c, 0x17, 0x0b,
+	0x29, 0x33, 0xb0, 0xfe, 0x42, 0x33, 0x70, 0x07, 0xf2, 0x8e, 0x3d, 0xb7, 0x43, 0xca, 0xd4, 0x5a,
+	0xd1, 0xf7, 0x84, 0x60, 0x1a, 0x14, 0xbc, 0xd9, 0x0c, 0x27, 0xa3, 0xae, 0x91, 0x08, 0x8c, 0xac,
+	0x52, 0xc3, 0xf3, 0x74, 0xbe, 0x56, 0xac, 0xe8, 0x63, 0x95, 0x1a, 0x9e, 0xa7, 0xad, 0x44, 0xf6,
+	0x02, 0x2b, 0x71, 0x17, 0x6a, 0x31, 0xf1, 0xf8, 0xb9, 0x35, 0x6d, 0x5c, 0xdb, 0x28, 0x9e, 0x2b,
+	0x11, 0xc3, 0x13, 0x6b, 0x8a, 0x3a, 0x1b, 0x13, 0x33, 0x50, 0x4f, 0x6c, 0x6f, 0xb6, 0x56, 0x0b,
+	0xde, 0xe4, 0x19, 0x6a, 0x89, 0x0f, 0xa0, 0xe2, 0x93, 0x0b, 0x32, 0x26, 0x4f, 0xe5, 0xba, 0xbc,
+	0xbc, 0x89, 0x6f, 0xa2, 0x83, 0x1f, 0x97, 0x51, 0x9c, 0xf1, 0xdb, 0x32, 0x7e, 0xa5, 0x11, 0x90,
+	0xaf, 0x5f, 0xd6, 0xab, 0x04, 0xe4, 0xd7, 0x1d, 0x64, 0x65, 0xf0, 0x6b, 0x06, 0x5a, 0x92, 0x9b,
+	0xf2, 0x20, 0xf8, 0x7d, 0x02, 0x2d, 0x89, 0x19, 0x15, 0xd1, 0x2f, 0x9b, 0xd8, 0xae, 0x89, 0x1b,
+	0x27, 0x34, 0x8e, 0x83, 0x46, 0x83, 0xce, 0x55, 0x45, 0xc0, 0x46, 0xc6, 0x71, 0xc0, 0x3e, 0x82,
+	0xaa, 0xc1, 0xa5, 0xfa, 0xd8, 0x76, 0x67, 0x5e, 0xe3, 0x96, 0x7c, 0xd7, 0x22, 0xc9, 0x7b, 0xbd,
+	0x62, 0x24, 0x15, 0xf6, 0x29, 0xb0, 0x28, 0xc0, 0x43, 0x66, 0x33, 0xdf, 0x6d, 0xb7, 0xd7, 0x76,
+	0xdb, 0x96, 0x88, 0xf0, 0xc4, 0xb9, 0x4f, 0x3b, 0x80, 0xee, 0x85, 0xe1, 0x38, 0x96, 0x63, 0x07,
+	0x73, 0x72, 0xeb, 0xf3, 0xba, 0x0c, 0x5a, 0xb7, 0x60, 0xef, 0xbc, 0xa4, 0x05, 0xfb, 0x26, 0xd4,
+	0xf0, 0xd6, 0x79, 0x6a, 0x4c, 0x4f, 0x2c, 0x62, 0x7c, 0x8d, 0x8e, 0x67, 0xd5, 0xf5, 0xc2, 0x56,
+	0x04, 0xc3, 0x15, 0xe4, 0xa2, 0x8e, 0x56, 0xf0, 0xae, 0xbc, 0x82, 0xb1, 0x79, 0x8d, 0x6a, 0x28,
+	0xf1, 0x4e, 0xaa, 0xd3, 0xa5, 0x4f, 0xaa, 0x35, 0x08, 0xad, 0x45, 0xe3, 0x75, 0x3e, 0x60, 0x01,
+	0x1b, 0x86, 0xd6, 0x82, 0x12, 0x7a, 0xbc, 0xa5, 0x3f, 0xb5, 0x38, 0xc5, 0x0e, 0x51, 0x00, 0x07,
+	0x11, 0xc1, 0x97, 0xb0, 0x95, 0xb8, 0x5c, 0x14, 0x45, 0x6c, 0xbc, 0xb1, 0x31, 0xfa, 0x43, 0x11,
+	0x45, 0xbd, 0xbe, 0x48, 0xd5, 0xb5, 0xff, 0xa8, 0x40, 0x29, 0x92, 0xb5, 0x78, 0xb1, 0x73, 0xd4,
+	0xff, 0xba, 0x3f, 0x78, 0xda, 0x57, 0xaf, 0xa0, 0xdb, 0xf7, 0xa4, 0xd9, 0x3b, 0xea, 0x8c, 0x87,
+	0xad, 0x66, 0x9f, 0xa7, 0x4a, 0x51, 0xd2, 0x0a, 0xaf, 0x67, 0xd9, 0x55, 0xa8, 0x3d, 0x3a, 0xea,
+	0xd3, 0xc5, 0x0e, 0x07, 0x29, 0x08, 0xea, 0x7c, 0xc3, 0x7d, 0x4b, 0x0e, 0xca, 0x21, 0xe8, 0xa0,
+	0x39, 0xea, 0xe8, 0xdd, 0x08, 0x94, 0xc7, 0x5e, 0x0e, 0xf5, 0xc1, 0x57, 0x9d, 0xd6, 0x48, 0x05,
+	0x76, 0x1d, 0xae, 0xc6, 0x2c, 0x51, 0x73, 0x6a, 0x05, 0xbd, 0xd4, 0x88, 0x4d, 0xdd, 0xc6, 0x46,
+	0xf4, 0x4e, 0xeb, 0x48, 0x1f, 0x76, 0x9f, 0x74, 0xc6, 0xad, 0x51, 0x47, 0xbd, 0x8e, 0xfe, 0xea,
+	0xb0, 0xdb, 0xff, 0x5a, 0xbd, 0x81, 0x37, 0x4c, 0x58, 0xe2, 0xad, 0xdf, 0x24, 0x8f, 0x76, 0x7f,
+	0x5f, 0xbd, 0x8b, 0x4d, 0xb4, 0xbb, 0xc3, 0x51, 0xb7, 0xdf, 0x1a, 0xa9, 0xaf, 0xa3, 0xd3, 0xfa,
+	0xa8, 0xdb, 0x1b, 0x75, 0x74, 0x75, 0x07, 0x79, 0xbf, 0x1a, 0x74, 0xfb, 0xea, 0x1b, 0x08, 0x1d,
+	0x36, 0x0f, 0x0e, 0x7b, 0x1d, 0x55, 0xa3, 0x16, 0x07, 0xfa, 0x48, 0x7d, 0x93, 0x95, 0x21, 0x7f,
+	0xd4, 0xc7, 0x71, 0xdc, 0xc3, 0xc6, 0xa9, 0x38, 0xc6, 0xc4, 0xaf, 0x9f, 0x4a, 0xae, 0xef, 0x5b,
+	0x58, 0x7e, 0xda, 0xed, 0xb7, 0x07, 0x4f, 0xd5, 0xb7, 0x91, 0x6c, 0x4f, 0x1f, 0x34, 0xdb, 0x2d,
+	0xf4, 0x90, 0xef, 0x63, 0x03, 0xc3, 0xc3, 0x5e, 0x77, 0xa4, 0xbe, 0x83, 0x54, 0xfb, 0xcd, 0xd1,
+	0xe3, 0x8e, 0xae, 0x3e, 0xc0, 0x72, 0x73, 0x38, 0xec, 0xe8, 0x23, 0x75, 0x17, 0xcb, 0xdd, 0x3e,
+	0x95, 0x3f, 0xa4, 0x56, 0x0f, 0xdb, 0xcd, 0x51, 0x47, 0xfd, 0x08, 0xcb, 0xed, 0x4e, 0xaf, 0x33,
+	0xea, 0xa8, 0x1f, 0x63, 0xab, 0xe4, 0xaa, 0x0f, 0x71, 0xa9, 0x3e, 0xc1, 0x55, 0x88, 0xab, 0x34,
+	0x9e, 0x4f, 0xb1, 0xa3, 0x83, 0x6e, 0xff, 0x68, 0xa8, 0x7e, 0x86, 0xc4, 0x54, 0x24, 0xcc, 0xe7,
+	0xda, 0x33, 0x28, 0x45, 0x9a, 0x08, 0xa9, 0xba, 0xfd, 0x7e, 0x07, 0x73, 0xdf, 0x4a, 0x90, 0xeb,
+	0x75, 0x1e, 0x8d, 0xd4, 0x0c, 0x02, 0xf5, 0xee, 0xfe, 0xe3, 0x91, 0x9a, 0xc5, 0xe2, 0xe0, 0x08,
+	0x97, 0x46, 0xa1, 0x45, 0xe8, 0x1c, 0x74, 0xd5, 0x1c, 0x96, 0x9a, 0xfd, 0x51, 0x57, 0xcd, 0xd3,
+	0x22, 0x75, 0xfb, 0xfb, 0xbd, 0x8e, 0x5a, 0x40, 0xe8, 0x41, 0x53, 0xff, 0x5a, 0x2d, 0x22, 0x53,
+	0xf3, 0xf0, 0xb0, 0xf7, 0xad, 0x5a, 0xd2, 0xee, 0x43, 0xb1, 0x79, 0x7c, 0x7c, 0x80, 0x5a, 0xbd,
+	0x04, 0xb9, 0x47, 0x78, 0x13, 0x48, 0x59, 0x76, 0x7b, 0x83, 0xd1, 0x68, 0x70, 0xa0, 0x66, 0xf0,
+	0x9b, 0x8c, 0x06, 0x87, 0x6a, 0x56, 0xbb, 0x03, 0x05, 0x6e, 0xc8, 0x92, 0x33, 0x1f, 0xa5, 0x29,
+	0x2a, 0x22, 0x35, 0xd1, 0x83, 0x72, 0x6c, 0x50, 0xb2, 0x07, 0x98, 0x27, 0xb3, 0x10, 0x4e, 0x56,
+	0x63, 0xc5, 0xdc, 0x7c, 0x78, 0x60, 0x2c, 0xb8, 0x77, 0x8a, 0x44, 0xb7, 0x3f, 0x81, 0x52, 0x04,
+	0xf8, 0x41, 0x6e, 0xdd, 0xdf, 0xce, 0x40, 0x55, 0x36, 0x49, 0x2f, 0xbf, 0xa8, 0xc9, 0x5c, 0x7a,
+	0x51, 0x83, 0x1d, 0x72, 0x57, 0x0f, 0x1b, 0xc7, 0xe2, 0xef, 0x79, 0x71, 0xa6, 0xfd, 0xaf, 0x1c,
+	0x94, 0xdb, 0x92, 0x94, 0xfd, 0xbd, 0x9d, 0x4d, 0xc9, 0x1d, 0x54, 0x5e, 0xda, 0x1d, 0xcc, 0xbd,
+	0xc8, 0x1d, 0xcc, 0xbf, 0xaa, 0x3b, 0x58, 0x78, 0x39, 0x77, 0xb0, 0xf8, 0x32, 0xee, 0xe0, 0xbd,
+	0x35, 0x77, 0x90, 0x3b, 0x9b, 0x69, 0x07, 0x30, 0xed, 0x86, 0x95, 0x5f, 0xe4, 0x86, 0xa5, 0x5d,
+	0x2b, 0x78, 0x81, 0x6b, 0x95, 0x76, 0xda, 0x2a, 0xdf, 0xeb, 0xb4, 0x6d, 0x74, 0xc3, 0xaa, 0x2f,
+	0xe7, 0x86, 0xa1, 0xb2, 0x30, 0xdc, 0x71, 0xe8, 0x2f, 0x5d, 0x0c, 0x89, 0x90, 0x59, 0x55, 0xd2,
+	0x2b, 0x68, 0x78, 0x0b, 0xd0, 0x8a, 0xe7, 0x55, 0x7f, 0x29, 0xcf, 0xeb, 0x9f, 0x66, 0x21, 0xff,
+	0x6b, 0xcc, 0x89, 0x63, 0x9f, 0x40, 0x39, 0x08, 0xe7, 0xa1, 0x6c, 0x91, 0xdf, 0xe2, 0xcc, 0x84,
+	0x27, 0x83, 0xda, 0xc2, 0x8b, 0x34, 0x6e, 0xde, 0x22, 0x2d, 0x96, 0xe8, 0x29, 0x43, 0x68, 0x2d,
+	0xf8, 0xad, 0x54, 0x5e, 0xe7, 0x15, 0x34, 0xd3, 0xd0, 0x3c, 0x8f, 0xa2, 0x1b, 0x90, 0x98, 0xc8,
+	0x3a, 0x47, 0xa0, 0x99, 0x46, 0xd1, 0xe4, 0xe8, 0xa2, 0x28, 0x65, 0xa6, 0x71, 0x0c, 0xda, 0xed,
+	0x27, 0x96, 0x81, 0xf6, 0x44, 0x94, 0x2d, 0x13, 0xd7, 0x31, 0x62, 0xec, 0x78, 0x86, 0x39, 0x32,
+	0x8e, 0xa3, 0x3c, 0x30, 0x51, 0xd5, 0x9e, 0x42, 0x2d, 0x35, 0xd8, 0xb4, 0x62, 0x43, 0x79, 0xd6,
+	0xe9, 0xa1, 0x4c, 0xcd, 0x48, 0x62, 0x38, 0x2b, 0x89, 0x5e, 0x45, 0x12, 0xc9, 0x39, 0x12, 0xb2,
+	0x1d, 0x7d, 0xbf, 0xa3, 0xe6, 0xb5, 0x7f, 0x98, 0x85, 0xab, 0x23, 0xdf, 0x70, 0x03, 0x83, 0xdf,
+	0x7b, 0xba, 0xa1, 0xef, 0x39, 0xec, 0x0b, 0x28, 0x85, 0x53, 0x47, 0x5e, 0xb7, 0xd7, 0xc5, 0x6e,
+	0x59, 0x25, 0x7d, 0x38, 0x9a, 0x3a, 0xb4, 0x7a, 0xc5, 0x90, 0x17, 0xd8, 0x7b, 0x90, 0x9f, 0x58,
+	0xc7, 0xb6, 0x2b, 0xa2, 0x57, 0xd7, 0x57, 0x19, 0xf7, 0x10, 0x89, 0x4f, 0x2d, 0x88, 0x8a, 0xfd,
+	0x1c, 0x73, 0xf0, 0xe6, 0x68, 0xfd, 0x2a, 0xf2, 0x4d, 0xba, 0xdc, 0x11, 0x62, 0xf1, 0x39, 0x05,
+	0xa7, 0x63, 0x9f, 0x60, 0x72, 0xb4, 0xe3, 0x4c, 0x8c, 0xe9, 0xa9, 0xb8, 0x7d, 0x6f, 0xac, 0xf2,
+	0xe8, 0x02, 0xff, 0xf8, 0x8a, 0x1e, 0xd3, 0x6a, 0x0f, 0xa1, 0x28, 0x06, 0x8b, 0x0b, 0xb0, 0xd7,
+	0xd9, 0xef, 0x8a, 0xb5, 0x6b, 0x0d, 0x0e, 0x0e, 0xba, 0x23, 0x9e, 0xf9, 0xa1, 0x0f, 0x7a, 0xbd,
+	0xbd, 0x66, 0xeb, 0x6b, 0x35, 0xbb, 0x57, 0x82, 0x82, 0x41, 0xd7, 0x08, 0xda, 0x5f, 0xcb, 0xc0,
+	0xd6, 0xca, 0x04, 0xd8, 0x67, 0x90, 0x9b, 0x7b, 0x66, 0xb4, 0x3c, 0xf7, 0x36, 0xce, 0x52, 0xaa,
+	0xa3, 0x2e, 0xd1, 0x89, 0x43, 0xfb, 0x1c, 0xea, 0x69, 0xb8, 0x94, 0x56, 0x5b, 0x83, 0xb2, 0xde,
+	0x69, 0xb6, 0xc7, 0x83, 0x7e, 0xef, 0x5b, 0x6e, 0xa1, 0x50, 0xf5, 0xa9, 0xde, 0x1d, 0x75, 0xd4,
+	0xac, 0xf6, 0x87, 0xa0, 0xae, 0x2e, 0x0c, 0xdb, 0x87, 0x2d, 0x4c, 0xa1, 0x72, 0x2c, 0x2e, 0xe2,
+	0x93, 0x4f, 0x76, 0x77, 0xc3, 0x4a, 0x0a, 0x32, 0xfa, 0x62, 0xf5, 0x69, 0xaa, 0xae, 0xfd, 0x15,
+	0x60, 0xeb, 0x2b, 0xf8, 0xe3, 0x35, 0xff, 0xdf, 0x32, 0x90, 0x3b, 0x74, 0x0c, 0x4c, 0x30, 0xc8,
+	0x53, 0xca, 0x6a, 0x23, 0x23, 0x3b, 0xb7, 0x74, 0x22, 0x71, 0x5b, 0x10, 0x8e, 0xfd, 0x0c, 0x94,
+	0x70, 0xea, 0x88, 0x3d, 0x74, 0xf3, 0x92, 0xcd, 0x87, 0xd9, 0xa5, 0xe1, 0x14, 0xa3, 0x83, 0x8a,
+	0x69, 0x3a, 0x0d, 0x45, 0xb6, 0x12, 0xd1, 0x4b, 0x68, 0x5b, 0x33, 0xdb, 0xb5, 0x45, 0x02, 0x2d,
+	0x92, 0x60, 0x0a, 0xad, 0x39, 0x75, 0x1a, 0x39, 0xd9, 0x6a, 0x47, 0x4a, 0xa9, 0x41, 0x73, 0x8a,
+	0x01, 0xa2, 0x6a, 0x33, 0x0c, 0xd1, 0x0a, 0x36, 0x71, 0xc8, 0xe9, 0xc4, 0x4d, 0x84, 0xe8, 0x29,
+	0x3c, 0xa6, 0xb7, 0x22, 0x4a, 0x7b, 0x97, 0x12, 0x4a, 0x97, 0x73, 0xcc, 0x9a, 0x13, 0xa5, 0x0d,
+	0x37, 0x06, 0x02, 0xa3, 0xfd, 0xbf, 0x2c, 0x54, 0xa4, 0xce, 0xd9, 0x47, 0x50, 0x32, 0xa7, 0xce,
+	0x06, 0x69, 0x25, 0x11, 0x3d, 0x6c, 0x47, 0xe7, 0xcd, 0xe4, 0x05, 0xbc, 0xda, 0x43, 0xf1, 0xfb,
+	0xdc, 0xf0, 0x6d, 0x14, 0xe5, 0x81, 0x58, 0x33, 0x21, 0x25, 0x87, 0x56, 0xf8, 0x24, 0xc2, 0xe0,
+	0x6b, 0x9a, 0x40, 0xaa, 0xb3, 0x77, 0x30, 0x39, 0xd3, 0x5a, 0x18, 0xbe, 0x25, 0xd6, 0x4e, 0xdc,
+	0xf7, 0x1c, 0x72, 0x20, 0x3e, 0xae, 0x11, 0x78, 0x24, 0xb5, 0xce, 0xad, 0xe9, 0x32, 0xb4, 0x1a,
+	0x39, 0x99, 0xb4, 0xc3, 0x81, 0x48, 0x2a, 0xf0, 0x28, 0xb3, 0x4d, 0xcb, 0x70, 0x1c, 0x8f, 0x84,
+	0x7a, 0x5e, 0x76, 0xe6, 0xda, 0x31, 0x9c, 0xbf, 0xcc, 0x89, 0x6a, 0xda, 0x31, 0x14, 0xc5, 0xc4,
+	0xd0, 0x28, 0xc4, 0x84, 0xab, 0x27, 0x4d, 0xbd, 0x8b, 0xc6, 0xf9, 0x50, 0xbd, 0x82, 0xc7, 0x75,
+	0x5f, 0x6f, 0xf6, 0x85, 0x78, 0xd3, 0x3b, 0x4f, 0x06, 0x5f, 0x63, 0xa6, 0x39, 0xdd, 0xf0, 0xf4,
+	0xbf, 0x55, 0x15, 0x6e, 0x80, 0x77, 0x0e, 0x9b, 0x3a, 0x4a, 0xb7, 0x0a, 0x14, 0x3b, 0xdf, 0x74,
+	0x5a, 0x47, 0xa3, 0x8e, 0x9a, 0xc7, 0x13, 0xd4, 0xee, 0x34, 0x7b, 0xbd, 0x41, 0x0b, 0x45, 0x5f,
+	0x61, 0xaf, 0x8c, 0xb9, 0x14, 0xb4, 0x92, 0xda, 0x3f, 0xaf, 0x41, 0x3d, 0xbd, 0x4b, 0xd8, 0xa7,
+	0x50, 0x32, 0xcd, 0xd4, 0x17, 0xb8, 0xb3, 0x69, 0x37, 0x3d, 0x6c, 0x9b, 0xd1, 0x47, 0xe0, 0x05,
+	0x0c, 0xd8, 0xf0, 0x3d, 0x9d, 0x5d, 0xdb, 0xd3, 0xd1, 0x8e, 0xfe, 0x25, 0x6c, 0x89, 0x34, 0x4f,
+	0x74, 0x72, 0x27, 0x46, 0x60, 0xa5, 0x37, 0x6c, 0x8b, 0x90, 0x6d, 0x81, 0x7b, 0x7c, 0x45, 0xaf,
+	0x4f, 0x53, 0x10, 0xf6, 0x0b, 0xa8, 0x1b, 0x14, 0x2a, 0x89, 0xf9, 0x73, 0xf2, 0x0d, 0x6b, 0x13,
+	0x71, 0x12, 0x7b, 0xcd, 0x90, 0x01, 0xb8, 0x4d, 0x4c, 0xdf, 0x5b, 0x24, 0xcc, 0xf9, 0xd4, 0xa5,
+	0x85, 0xef, 0x2d, 0x24, 0xde, 0xaa, 0x29, 0xd5, 0xd9, 0x27, 0x50, 0x15, 0x23, 0x4f, 0x9e, 0xf2,
+	0xc5, 0xa7, 0x87, 0x0f, 0x9b, 0xac, 0x08, 0x7c, 0x43, 0x36, 0x4d, 0xaa, 0xec, 0x43, 0xa8, 0xf0,
+	0x01, 0x73, 0xb6, 0xa2, 0xbc, 0x13, 0x68, 0xb4, 0x11, 0x17, 0x18, 0x8e, 0x74, 0xb1, 0x02, 0x34,
+	0x4e, 0xf9, 0x3a, 0x66, 0x2b, 0x19, 0x64, 0xc4, 0x52, 0x36, 0xa3, 0x8a, 0x34, 0x3c, 0x7e, 0x7f,
+	0x5e, 0x5e, 0x1f, 0x1e, 0xdd, 0x27, 0x27, 0xc3, 0xa3, 0x6a, 0x32, 0x3c, 0xce, 0x06, 0x6b, 0xc3,
+	0x8b, 0xb8, 0xc0, 0x88, 0x6b, 0xf1, 0xf0, 0x38, 0x4f, 0x65, 0x75, 0x78, 0x11, 0x4b, 0xd9, 0x8c,
+	0x2a, 0xf8, 0xd9, 0x22, 0x0b, 0x47, 0x4c, 0xaa, 0x9a, 0x4a, 0xf1, 0x10, 0xb8, 0x68, 0x62, 0xb5,
+	0x50, 0x06, 0x20, 0x77, 0x70, 0xe2, 0x9d, 0x49, 0xc7, 0xbb, 0x26, 0x73, 0x0f, 0x4f, 0xbc, 0x33,
+	0xf9, 0x7c, 0xd7, 0x02, 0x19, 0x80, 0xa3, 0xe5, 0x53, 0xa4, 0x0c, 0x99, 0xba, 0x3c, 0x5a, 0x9a,
+	0x21, 0x66, 0x2e, 0xe0, 0x68, 0x8d, 0xa8, 0x82, 0x8b, 0x42, 0x97, 0xdf, 0x21, 0xef, 0x6c, 0x4b,
+	0x5e, 0x14, 0x4a, 0x09, 0x88, 0x7a, 0x02, 0x27, 0xae, 0xe1, 0xde, 0x5a, 0xba, 0x32, 0x9b, 0x2a,
+	0xef, 0xad, 0x23, 0x37, 0xc5, 0x58, 0xe5, 0xa4, 0x82, 0x35, 0x39, 0x15, 0x81, 0xf5, 0xdd, 0xd2,
+	0x72, 0xa7, 0x56, 0xe3, 0xea, 0xfa, 0xa9, 0x18, 0x0a, 0x5c, 0x72, 0x2a, 0x22, 0x48, 0xbc, 0xaf,
+	0x63, 0x76, 0xb6, 0xba, 0xaf, 0x25, 0xe6, 0xaa, 0x29, 0xd5, 0x93, 0x03, 0x15, 0xf3, 0x5e, 0x5b,
+	0x3b, 0x50, 0x12, 0x73, 0xcd, 0x90, 0x01, 0xda, 0x9f, 0xe7, 0xa0, 0x28, 0xe4, 0x00, 0xbe, 0x63,
+	0x69, 0xe9, 0x9d, 0xe6, 0xa8, 0x33, 0x6e, 0x37, 0x47, 0xcd, 0xbd, 0xe6, 0x10, 0x75, 0x39, 0x83,
+	0x7a, 0x13, 0xfd, 0xf3, 0x04, 0x96, 0x41, 0xe1, 0xd6, 0xd6, 0x07, 0x87, 0x09, 0x28, 0x8b, 0xaf,
+	0x62, 0x04, 0x2f, 0x7f, 0x41, 0xa3, 0xe0, 0x7d, 0x35, 0x67, 0xe4, 0x00, 0xba, 0xaf, 0x26, 0x2e,
+	0x5e, 0xcf, 0x4b, 0x2c, 0xdd, 0x7e, 0xbb, 0xf3, 0x8d, 0x5a, 0x48, 0x58, 0x38, 0xa0, 0x18, 0xb3,
+	0xf0, 0x7a, 0x09, 0x07, 0x33, 0xd2, 0x8f, 0xfa, 0xad, 0xa4, 0x9f, 0x32, 0x32, 0x89, 0x66, 0x9e,
+	0x74, 0x3b, 0x4f, 0x55, 0x40, 0x26, 0xde, 0x0a, 0xd5, 0x2b, 0x68, 0x8d, 0x50, 0x23, 0x54, 0xad,
+	0xb2, 0x9b, 0x70, 0x6d, 0xf8, 0x78, 0xf0, 0x74, 0xcc, 0x99, 0xe2, 0x29, 0xd4, 0xd8, 0x36, 0xa8,
+	0x12, 0x82, 0x37, 0x5f, 0xc7, 0x2e, 0x09, 0x1a, 0x11, 0x0e, 0xd5, 0x2d, 0xec, 0x92, 0x60, 0x23,
+	0x2e, 0xda, 0x55, 0x9c, 0x0a, 0x67, 0x1d, 0xf4, 0x8e, 0x0e, 0xfa, 0x43, 0xf5, 0x2a, 0x0e, 0x82,
+	0x20, 0x7c, 0xe4, 0x2c, 0x6e, 0x26, 0x51, 0x08, 0xd7, 0x48, 0x47, 0x20, 0xec, 0x69, 0x53, 0xef,
+	0x77, 0xfb, 0xfb, 0x43, 0x75, 0x3b, 0x6e, 0xb9, 0xa3, 0xeb, 0x03, 0x7d, 0xa8, 0x5e, 0x8f, 0x01,
+	0xc3, 0x51, 0x73, 0x74, 0x34, 0x54, 0x6f, 0xc4, 0xa3, 0x3c, 0xd4, 0x07, 0xad, 0xce, 0x70, 0xd8,
+	0xeb, 0x0e, 0x47, 0xea, 0x4d, 0x0c, 0xd7, 0x24, 0x23, 0x8a, 0x88, 0x1b, 0xd2, 0x40, 0xf5, 0xfd,
+	0xce, 0x48, 0xbd, 0x15, 0x0f, 0xa3, 0x35, 0xe8, 0xe1, 0xe3, 0xa6, 0x41, 0x5f, 0xbd, 0x8d, 0x44,
+	0xbd, 0x41, 0xeb, 0xeb, 0x68, 0x36, 0x3f, 0xc1, 0x71, 0x1d, 0xf5, 0x65, 0xd0, 0x1d, 0x69, 0x6b,
+	0x0c, 0x3b, 0xbf, 0x3e, 0xea, 0xf4, 0x5b, 0x1d, 0xf5, 0xb5, 0x64, 0x6b, 0xc4, 0xb0, 0xbb, 0xf1,
+	0xd6, 0x88, 0x41, 0xaf, 0xc7, 0x7d, 0x46, 0xa0, 0xa1, 0xba, 0xb3, 0x57, 0xa5, 0x57, 0xae, 0x42,
+	0x11, 0x69, 0x5f, 0x01, 0x93, 0x5f, 0xa3, 0x89, 0x17, 0x05, 0x0c, 0x72, 0x33, 0xdf, 0x9b, 0x47,
+	0x69, 0x2f, 0x58, 0xa6, 0x48, 0xe2, 0x72, 0x42, 0x97, 0xcf, 0x49, 0x1e, 0x86, 0x0c, 0xd2, 0xfe,
+	0x24, 0x03, 0xf5, 0xb4, 0x12, 0xc2, 0x10, 0xbe, 0x3d, 0x1b, 0x63, 0x98, 0x90, 0x32, 0xd5, 0x03,
+	0x91, 0xcd, 0x57, 0xb1, 0x67, 0x7d, 0x2f, 0xa4, 0x54, 0x75, 0x72, 0x68, 0x62, 0x9d, 0xc2, 0x5b,
+	0x8d, 0xeb, 0xac, 0x0b, 0xd7, 0x52, 0x0f, 0xf0, 0x52, 0x6f, 0x0e, 0x1a, 0xf1, 0x0b, 0xa6, 0x95,
+	0xf1, 0xeb, 0x2c, 0x58, 0x83, 0x69, 0x8f, 0xa1, 0x96, 0xd2, 0x70, 0x94, 0x65, 0x38, 0x4b, 0x8f,
+	0xab, 0x64, 0xcf, 0x5e, 0x3c, 0x28, 0x6d, 0x1f, 0xaa, 0xb2, 0xba, 0x7b, 0xf5, 0x86, 0x5e, 0x87,
+	0xf2, 0xa3, 0xd3, 0xe8, 0x09, 0x84, 0xfc, 0x0a, 0xa3, 0x2c, 0x32, 0x65, 0xfe, 0x47, 0x16, 0x2a,
+	0x92, 0x7e, 0x7c, 0xa9, 0xe5, 0xbc, 0x03, 0xe5, 0xd0, 0x9a, 0x2f, 0x3c, 0xdf, 0x10, 0xd6, 0x44,
+	0x49, 0x4f, 0x00, 0xa9, 0xe1, 0x28, 0x2b, 0x8b, 0x9d, 0x0a, 0xe8, 0xe7, 0x5e, 0x10, 0xd0, 0xff,
+	0x00, 0xaa, 0xd2, 0x63, 0x85, 0x40, 0xc4, 0x3e, 0x56, 0xe9, 0x2b, 0xc9, 0xc3, 0x85, 0x00, 0xf3,
+	0x28, 0x67, 0xa7, 0x63, 0x73, 0xc2, 0x13, 0x48, 0xcb, 0x98, 0xf4, 0xd7, 0x9e, 0x50, 0xbe, 0xd4,
+	0x2c, 0x16, 0xfc, 0x45, 0xc2, 0x94, 0x66, 0x91, 0x78, 0xbf, 0x0f, 0xc5, 0xd9, 0x29, 0x7f, 0x09,
+	0x50, 0x92, 0x83, 0x02, 0xf1, 0xba, 0xe9, 0x85, 0xd9, 0x29, 0xbd, 0x0a, 0xf8, 0x1c, 0xd4, 0x95,
+	0x40, 0x55, 0xd0, 0x28, 0x6f, 0x1c, 0xd4, 0x56, 0x3a, 0x66, 0x15, 0x68, 0xff, 0x32, 0x03, 0xf5,
+	0xc4, 0x9e, 0xc0, 0x6f, 0xcb, 0x1e, 0xf0, 0x07, 0x57, 0xdc, 0x86, 0x6b, 0xac, 0x9a, 0x1c, 0x48,
+	0x82, 0xef, 0xaf, 0xf8, 0xf3, 0xab, 0x4d, 0x89, 0xa0, 0x9b, 0xde, 0x72, 0x28, 0x9b, 0xde, 0x72,
+	0x68, 0xfb, 0xa0, 0x8c, 0x2e, 0x16, 0xdc, 0x8d, 0x44, 0x11, 0xc6, 0xcd, 0x55, 0x2e, 0xbc, 0x28,
+	0x4e, 0xf8, 0x75, 0xe7, 0x5b, 0x9e, 0x83, 0x74, 0xa8, 0x77, 0x0f, 0x9a, 0xfa, 0xb7, 0x63, 0x04,
+	0x90, 0x90, 0x7f, 0x34, 0xd0, 0x3b, 0xdd, 0xfd, 0x3e, 0x01, 0x72, 0xe4, 0x64, 0x26, 0x43, 0x6c,
+	0x9a, 0xe6, 0xa3, 0x53, 0xf9, 0x95, 0x68, 0x26, 0xf5, 0x4a, 0x34, 0x4e, 0x37, 0x95, 0x1f, 0xae,
+	0x84, 0xd1, 0xa0, 0xe2, 0xcd, 0xa8, 0x24, 0x9b, 0x11, 0x53, 0x43, 0x31, 0x4b, 0x33, 0x6d, 0x34,
+	0xa6, 0xd3, 0x38, 0x89, 0x40, 0xfb, 0x5d, 0x06, 0x58, 0x6a, 0x20, 0xdc, 0x8e, 0x79, 0xd5, 0xb1,
+	0x7c, 0x0a, 0x0d, 0xf1, 0x24, 0x8a, 0x53, 0x89, 0xf7, 0x5f, 0x63, 0x1c, 0x0b, 0x5f, 0xd2, 0xeb,
+	0x1c, 0x4f, 0xdd, 0x25, 0xb9, 0xaa, 0xec, 0x7d, 0xe0, 0x4f, 0x71, 0xf0, 0x06, 0x25, 0xed, 0xb1,
+	0x49, 0x67, 0x4a, 0x4f, 0x68, 0xf0, 0x3e, 0x58, 0xfe, 0x68, 0xfc, 0x71, 0x4d, 0x9e, 0x8e, 0xd0,
+	0x56, 0xf2, 0xd5, 0xe8, 0x9c, 0x69, 0x7f, 0x9c, 0x81, 0x6b, 0xe9, 0x0d, 0xf1, 0xfb, 0xcd, 0x32,
+	0xfd, 0x92, 0x48, 0x59, 0x7d, 0x49, 0xb4, 0x69, 0x3f, 0xe5, 0x36, 0xee, 0xa7, 0xbf, 0x9e, 0x81,
+	0x6d, 0x69, 0xf5, 0x13, 0xcb, 0xf3, 0x2f, 0x68, 0x64, 0xd2, 0x83, 0xa2, 0x5c, 0xea, 0x41, 0x91,
+	0x76, 0x28, 0x56, 0x88, 0x27, 0xc3, 0xc7, 0xf9, 0xea, 0x6a, 0x72, 0xb4, 0xf2, 0xfc, 0x00, 0xbd,
+	0x0d, 0x5b, 0xbe, 0xe5, 0x18, 0xa1, 0xfd, 0xdc, 0x12, 0x19, 0xf5, 0x62, 0x14, 0xf5, 0x08, 0xcc,
+	0x9b, 0xd0, 0x7c, 0x79, 0xcd, 0x9b, 0xa6, 0xc9, 0xc1, 0xec, 0x9e, 0xe4, 0x2b, 0xaf, 0x3f, 0x10,
+	0x13, 0x38, 0xf6, 0x31, 0x94, 0x52, 0xa9, 0xf4, 0x95, 0xc8, 0x3b, 0xde, 0x30, 0x48, 0x3d, 0x26,
+	0xc5, 0x07, 0x85, 0x37, 0x92, 0x4e, 0x0f, 0x3c, 0xd3, 0x9e, 0x5d, 0x88, 0x7e, 0xf1, 0xe5, 0xb8,
+	0x63, 0xa6, 0xde, 0x98, 0x79, 0x8e, 0x29, 0x1e, 0x83, 0x17, 0xa4, 0x99, 0xbc, 0xcc, 0x90, 0x94,
+	0x97, 0x1f, 0x52, 0x5f, 0x1e, 0x91, 0x6e, 0x61, 0xff, 0x2f, 0x1e, 0x11, 0x3e, 0x12, 0xb5, 0xce,
+	0xe4, 0x6f, 0x5c, 0x74, 0xad, 0x33, 0xda, 0x32, 0xff, 0x36, 0x2f, 0xaf, 0x6b, 0x12, 0xfb, 0xff,
+	0xcb, 0xb2, 0x10, 0x7c, 0x77, 0x55, 0x08, 0xc6, 0x74, 0x1c, 0x96, 0x7e, 0x6b, 0x43, 0xdf, 0xf5,
+	0x73, 0xa8, 0x61, 0x97, 0x49, 0x9e, 0x5b, 0xf6, 0x7b, 0x92, 0xb0, 0xab, 0xae, 0x75, 0x96, 0x74,
+	0xfd, 0x21, 0xd4, 0x64, 0x97, 0xf1, 0xb2, 0x8c, 0xb0, 0xaa, 0xe4, 0x2e, 0x52, 0x0e, 0x4c, 0xe2,
+	0xfa, 0xf1, 0x18, 0x6a, 0x59, 0x87, 0xd8, 0xd1, 0x43, 0x11, 0xb6, 0x95, 0x76, 0xa5, 0xa2, 0x10,
+	0x6a, 0x3d, 0xe5, 0x34, 0xd1, 0x23, 0x0f, 0xeb, 0x7c, 0x7a, 0x62, 0xb8, 0xc7, 0x92, 0xb7, 0xcd,
+	0x9f, 0x30, 0xa8, 0x11, 0x22, 0xd6, 0xff, 0xf4, 0x9e, 0x50, 0x10, 0x27, 0x5e, 0x67, 0x59, 0xaf,
+	0x45, 0x50, 0xae, 0xbc, 0xdf, 0x03, 0x76, 0x66, 0x87, 0x27, 0xde, 0x12, 0x63, 0x2d, 0x8e, 0x6d,
+	0x1a, 0x71, 0xea, 0x5f, 0x49, 0xbf, 0x2a, 0x30, 0x4f, 0x62, 0xc4, 0xa5, 0x57, 0x26, 0xd5, 0x97,
+	0x7b, 0x6b, 0xf4, 0x11, 0xdc, 0x88, 0x07, 0x95, 0xb4, 0x93, 0x3c, 0x97, 0xdd, 0x8e, 0xb0, 0xc9,
+	0x5b, 0x09, 0x13, 0x7d, 0x4d, 0x96, 0x9e, 0x0a, 0xd9, 0x02, 0xf5, 0x8d, 0xb6, 0x80, 0x9a, 0x9a,
+	0x5e, 0xdb, 0x9a, 0x69, 0x7f, 0x35, 0x12, 0xfc, 0xa9, 0xbd, 0x80, 0x86, 0x69, 0xb3, 0xdd, 0x1e,
+	0x1f, 0x36, 0xf5, 0x51, 0x97, 0x0c, 0x5f, 0x72, 0x6d, 0xc8, 0x56, 0x4d, 0x60, 0x19, 0x76, 0x03,
+	0x58, 0xec, 0x61, 0x24, 0xf0, 0x2c, 0x6b, 0xc0, 0xb6, 0xde, 0x19, 0xe8, 0xfb, 0xcd, 0x7e, 0xf7,
+	0x0f, 0x64, 0x8c, 0x82, 0x1c, 0x9d, 0x6f, 0x5a, 0x8f, 0x9b, 0xfd, 0x7d, 0x19, 0x9e, 0xd3, 0xfe,
+	0x71, 0x1e, 0x20, 0xd9, 0xa7, 0x29, 0x9b, 0x27, 0xf3, 0x7d, 0x36, 0xcf, 0x4b, 0xa4, 0xba, 0xda,
+	0xc1, 0x38, 0x7d, 0x5b, 0xae, 0x44, 0x6f, 0xa0, 0xe4, 0x9b, 0x72, 0xf6, 0x01, 0x14, 0x79, 0xe8,
+	0x37, 0x8a, 0xe4, 0xdf, 0x5c, 0x3d, 0x3d, 0x0f, 0xc5, 0xf3, 0xc2, 0x88, 0xee, 0xf6, 0xff, 0x51,
+	0xa0, 0xc0, 0x61, 0x94, 0xfe, 0xef, 0x7b, 0xd1, 0x0f, 0x11, 0x6c, 0x6f, 0xb2, 0x3e, 0xe8, 0x57,
+	0x80, 0xd0, 0x50, 0x79, 0x08, 0x05, 0xc3, 0x34, 0xc7, 0xb3, 0xd3, 0x74, 0xb8, 0x7c, 0xc5, 0x10,
+	0xc0, 0xb8, 0xa8, 0x81, 0x05, 0xf6, 0x29, 0x94, 0x91, 0x9e, 0x87, 0x1f, 0x52, 0x76, 0xf4, 0xba,
+	0xca, 0xc6, 0xe8, 0xb7, 0x21, 0xca, 0xec, 0xcb, 0x74, 0xb4, 0x83, 0xeb, 0xd3, 0xdb, 0x6b, 0xac,
+	0x97, 0xc5, 0x3d, 0xbe, 0x00, 0xc0, 0x7e, 0x85, 0x50, 0xcc, 0xaf, 0x09, 0xbb, 0xb4, 0x48, 0xa7,
+	0x98, 0x42, 0x54, 0x61, 0x2d, 0xa8, 0xcd, 0x49, 0xee, 0x46, 0xec, 0x3c, 0x80, 0x74, 0x67, 0x95,
+	0x5d, 0x16, 0xce, 0xe8, 0xac, 0xcf, 0xa5, 0x3a, 0x36, 0xe2, 0x93, 0xa8, 0x8c, 0x1a, 0x29, 0x6e,
+	0x6e, 0x44, 0x96, 0xa7, 0xd8, 0x88, 0x2f, 0xd5, 0x59, 0x1b, 0xb6, 0xf8, 0x22, 0xa4, 0x1f, 0xc1,
+	0x6d, 0x98, 0x4a, 0x7c, 0x04, 0x30, 0xe4, 0x60, 0xa4, 0x0e, 0x85, 0x74, 0x31, 0xf0, 0xcf, 0xb2,
+	0x50, 0x8e, 0xa3, 0x52, 0xaf, 0xec, 0x48, 0x24, 0x3f, 0x92, 0xa5, 0x48, 0x3f, 0x92, 0xb5, 0x6a,
+	0xce, 0xf0, 0x6b, 0x5b, 0x2e, 0x14, 0xb7, 0xd2, 0x46, 0x43, 0xb0, 0x9e, 0x05, 0x92, 0x7f, 0xc9,
+	0x2c, 0x90, 0x5b, 0xc0, 0xcf, 0x07, 0xe6, 0xa0, 0x15, 0xe8, 0x79, 0x54, 0x91, 0xea, 0x5d, 0x73,
+	0xf5, 0xad, 0x6f, 0x71, 0x47, 0x59, 0x79, 0xeb, 0x7b, 0xe9, 0xdd, 0x72, 0xe9, 0xf2, 0x47, 0x80,
+	0xdf, 0x41, 0x39, 0x8e, 0x3c, 0xbd, 0xfa, 0x82, 0xfd, 0x10, 0x57, 0x47, 0xfb, 0xa3, 0xc8, 0xad,
+	0x8d, 0x03, 0x3f, 0xbf, 0xaf, 0x5b, 0x9b, 0xea, 0x5e, 0x79, 0x41, 0xf7, 0xe7, 0xdc, 0xdd, 0x8c,
+	0x3b, 0xff, 0x91, 0x77, 0x89, 0xfc, 0x01, 0x73, 0xa9, 0x0f, 0xa8, 0x6d, 0x09, 0x97, 0x39, 0x0e,
+	0x59, 0xfd, 0x8b, 0x4c, 0xe4, 0x8f, 0xc6, 0x2f, 0x86, 0x2e, 0x95, 0xac, 0x71, 0x6f, 0x59, 0xb9,
+	0xb7, 0x57, 0x36, 0xe6, 0xdf, 0x86, 0xbc, 0x2c, 0x78, 0x36, 0x18, 0xf2, 0x1c, 0xbf, 0xfa, 0x36,
+	0x3e, 0xbf, 0xfa, 0x36, 0x5e, 0xd3, 0x84, 0x72, 0xe0, 0x53, 0xd8, 0x8e, 0xda, 0x8d, 0xde, 0xf5,
+	0x63, 0x05, 0x7d, 0xa9, 0x72, 0x62, 0xd3, 0xff, 0xf0, 0x69, 0xfe, 0x68, 0xd6, 0xfc, 0x1f, 0x67,
+	0xa1, 0x96, 0x8a, 0xf0, 0xbe, 0xc2, 0x60, 0x36, 0xca, 0x01, 0xe5, 0x25, 0xe5, 0x40, 0xee, 0x15,
+	0xe4, 0x40, 0xfe, 0x7b, 0xe5, 0x40, 0xe1, 0xe5, 0xe5, 0x40, 0xf1, 0x72, 0x39, 0x80, 0x89, 0x2a,
+	0x29, 0x95, 0xbb, 0x49, 0x39, 0x67, 0x36, 0x2a, 0xe7, 0xbb, 0xf1, 0x2f, 0x2b, 0x75, 0xdb, 0xfc,
+	0x8a, 0xbe, 0xa6, 0x4b, 0x10, 0xf6, 0x39, 0xdc, 0xe2, 0x2a, 0x82, 0xab, 0xba, 0xb1, 0x37, 0x8b,
+	0x7e, 0xd4, 0xa9, 0x1b, 0x3d, 0xb5, 0xb9, 0xc1, 0x09, 0xf8, 0x6f, 0x23, 0xcc, 0x92, 0x5f, 0x77,
+	0xea, 0x42, 0x2d, 0x15, 0x51, 0x97, 0x7e, 0x80, 0x2d, 0x23, 0xff, 0x00, 0x1b, 0xe6, 0x02, 0x9c,
+	0x9d, 0x58, 0xbe, 0xb5, 0xe1, 0x67, 0x93, 0x38, 0x02, 0x7f, 0xa4, 0x46, 0xbe, 0x7b, 0x63, 0xef,
+	0x42, 0xde, 0x0e, 0xad, 0x79, 0xf4, 0xb2, 0xea, 0xc6, 0xfa, 0xf5, 0x1c, 0x3d, 0x76, 0xe5, 0x44,
+	0xda, 0x9f, 0xe2, 0xcf, 0x4c, 0xad, 0xe0, 0xa4, 0x5f, 0x89, 0xcb, 0x5c, 0xf2, 0x2b, 0x71, 0xd9,
+	0xd4, 0x20, 0x37, 0xfc, 0xd2, 0x5b, 0xf2, 0xb6, 0x24, 0x77, 0xc9, 0xdb, 0x12, 0xf6, 0x16, 0x94,
+	0x7c, 0x8b, 0x7e, 0x99, 0xcb, 0x6c, 0xe4, 0xd7, 0x88, 0x62, 0x9c, 0xf6, 0x37, 0x32, 0x50, 0x14,
+	0x17, 0x85, 0x1b, 0xdf, 0xd9, 0xbd, 0x03, 0x45, 0xfe, 0x2b, 0x5d, 0xd1, 0xe3, 0xde, 0xb5, 0xfc,
+	0x94, 0x08, 0x8f, 0x2f, 0xc8, 0x10, 0x95, 0x4e, 0x27, 0xa2, 0x6b, 0x56, 0x82, 0xe3, 0x0e, 0xa4,
+	0xec, 0x09, 0xba, 0x98, 0x0b, 0x44, 0x22, 0x0f, 0x10, 0x08, 0x2d, 0xd4, 0x40, 0xfb, 0x12, 0x8a,
+	0xe2, 0x22, 0x72, 0xe3, 0x50, 0x5e, 0xf4, 0x1b, 0x57, 0x3b, 0x00, 0xc9, 0xcd, 0xe4, 0xa6, 0x16,
+	0x34, 0x47, 0xbc, 0x2c, 0xc4, 0x9b, 0x0c, 0x8a, 0x35, 0xbc, 0x8f, 0x3f, 0x94, 0x23, 0xde, 0x52,
+	0x66, 0x2e, 0x7f, 0x4b, 0x19, 0x13, 0xb1, 0x07, 0x10, 0xab, 0x84, 0x17, 0x19, 0xaa, 0x5a, 0x13,
+	0x20, 0xb9, 0x32, 0xc1, 0x5f, 0x03, 0x88, 0x5f, 0x64, 0x46, 0xdb, 0x67, 0xb5, 0x33, 0x1c, 0x93,
+	0x2e, 0x91, 0x69, 0x75, 0xa8, 0xca, 0xf7, 0x2e, 0x0f, 0xde, 0x80, 0xaa, 0xfc, 0xb3, 0x44, 0x94,
+	0x72, 0xe0, 0xb9, 0x16, 0x7f, 0x30, 0xd7, 0xfb, 0xcd, 0x47, 0x6a, 0xe6, 0xc1, 0x1f, 0x49, 0x6f,
+	0xdd, 0x89, 0x46, 0x04, 0xaf, 0x28, 0x71, 0xb2, 0xd7, 0xed, 0x77, 0x9a, 0x3a, 0x85, 0xaa, 0xe8,
+	0x69, 0xdd, 0xe3, 0xe6, 0xf0, 0x31, 0x0f, 0x6b, 0x09, 0x0c, 0x01, 0x14, 0x4a, 0xc2, 0x43, 0x83,
+	0x9e, 0x27, 0x4a, 0x52, 0x31, 0x8e, 0xed, 0xe7, 0x91, 0x91, 0xc2, 0xee, 0x05, 0x8c, 0xfb, 0x63,
+	0x29, 0xc6, 0x15, 0x1f, 0xfc, 0x0a, 0x1a, 0x97, 0xe5, 0x12, 0x60, 0xab, 0xad, 0xc7, 0x4d, 0xca,
+	0xd7, 0xa8, 0x42, 0xa9, 0x3f, 0x18, 0xf3, 0x5a, 0x06, 0xef, 0x7a, 0xf5, 0x4e, 0xaf, 0x43, 0x37,
+	0x29, 0x0f, 0x7e, 0x9b, 0x91, 0xbe, 0x52, 0xe4, 0xba, 0xc4, 0x00, 0x31, 0x5d, 0x19, 0xa4, 0x5b,
+	0x86, 0x29, 0x3c, 0x17, 0x19, 0xd4, 0xf3, 0xa6, 0x86, 0xa3, 0x66, 0xe9, 0xce, 0x24, 0x82, 0x3f,
+	0xf5, 0xed, 0xd0, 0x52, 0x15, 0xf6, 0x1a, 0xdc, 0x8a, 0x61, 0x3d, 0xef, 0xec, 0xd0, 0xb7, 0x3d,
+	0xdf, 0x0e, 0x2f, 0x38, 0x3a, 0xb7, 0xf7, 0xcb, 0x7f, 0xf5, 0xbb, 0xbb, 0x99, 0x7f, 0xf7, 0xbb,
+	0xbb, 0x99, 0xff, 0xfa, 0xbb, 0xbb, 0x57, 0xfe, 0xf4, 0xbf, 0xdf, 0xcd, 0xfc, 0x81, 0xfc, 0x9b,
+	0xad, 0x73, 0x23, 0xf4, 0xed, 0x73, 0xae, 0x20, 0xa3, 0x8a, 0x6b, 0xbd, 0xbf, 0x38, 0x3d, 0x7e,
+	0x7f, 0x31, 0x79, 0x1f, 0xbf, 0xe8, 0xa4, 0x40, 0x3f, 0xdd, 0xfa, 0xe1, 0xff, 0x1f, 0x00, 0x2f,
+	0x4f, 0x7e, 0x16, 0xfd, 0x55, 0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -10518,6 +11246,13 @@ func (m *Default) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FillValue) > 0 {
+		i -= len(m.FillValue)
+		copy(dAtA[i:], m.FillValue)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.FillValue)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NullAbility {
 		i--
 		if m.NullAbility {
@@ -10723,6 +11458,52 @@ func (m *PrimaryKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TTLDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TTLDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TTLDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExpireSeconds != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.ExpireSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ColName) > 0 {
+		i -= len(m.ColName)
+		copy(dAtA[i:], m.ColName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ColName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *IndexDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -11433,6 +12214,57 @@ func (m *PartitionItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PartitionPrune) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionPrune) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionPrune) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SelectedPartitions) > 0 {
+		for iNdEx := len(m.SelectedPartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelectedPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.IsPruned {
+		i--
+		if m.IsPruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ViewDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -11491,6 +12323,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
 	if m.TableLockType != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.TableLockType))
 		i--
@@ -11618,20 +12464,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA42 := make([]byte, len(m.RefChildTbls)*10)
-		var j41 int
+		dAtA43 := make([]byte, len(m.RefChildTbls)*10)
+		var j42 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j41++
+				j42++
 			}
-			dAtA42[j41] = uint8(num)
-			j41++
+			dAtA43[j42] = uint8(num)
+			j42++
 		}
-		i -= j41
-		copy(dAtA[i:], dAtA42[:j41])
-		i = encodeVarintPlan(dAtA, i, uint64(j41))
+		i -= j42
+		copy(dAtA[i:], dAtA43[:j42])
+		i = encodeVarintPlan(dAtA, i, uint64(j42))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -12133,6 +12979,18 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Partition != nil {
+		{
+			size, err := m.Partition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.ClusterTable != nil {
 		{
 			size, err := m.ClusterTable.MarshalToSizedBuffer(dAtA[:i])
@@ -12163,21 +13021,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA49 := make([]byte, len(m.IdxIdx)*10)
-		var j48 int
+		dAtA51 := make([]byte, len(m.IdxIdx)*10)
+		var j50 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA49[j48] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j48++
+				j50++
 			}
-			dAtA49[j48] = uint8(num)
-			j48++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j48
-		copy(dAtA[i:], dAtA49[:j48])
-		i = encodeVarintPlan(dAtA, i, uint64(j48))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintPlan(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -12222,21 +13080,21 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA52 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j51 int
+		dAtA54 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j53 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j53++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA54[j53] = uint8(num)
+			j53++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintPlan(dAtA, i, uint64(j51))
+		i -= j53
+		copy(dAtA[i:], dAtA54[:j53])
+		i = encodeVarintPlan(dAtA, i, uint64(j53))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -12291,6 +13149,22 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
 	if len(m.ParentIdx) > 0 {
 		for iNdEx := len(m.ParentIdx) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -12422,21 +13296,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA56 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j55 int
+		dAtA58 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j57 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j55++
+				j57++
 			}
-			dAtA56[j55] = uint8(num)
-			j55++
+			dAtA58[j57] = uint8(num)
+			j57++
 		}
-		i -= j55
-		copy(dAtA[i:], dAtA56[:j55])
-		i = encodeVarintPlan(dAtA, i, uint64(j55))
+		i -= j57
+		copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintPlan(dAtA, i, uint64(j57))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -12455,21 +13329,21 @@ func (m *UpdateCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA58 := make([]byte, len(m.IdxIdx)*10)
-		var j57 int
+		dAtA60 := make([]byte, len(m.IdxIdx)*10)
+		var j59 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j57++
+				j59++
 			}
-			dAtA58[j57] = uint8(num)
-			j57++
+			dAtA60[j59] = uint8(num)
+			j59++
 		}
-		i -= j57
-		copy(dAtA[i:], dAtA58[:j57])
-		i = encodeVarintPlan(dAtA, i, uint64(j57))
+		i -= j59
+		copy(dAtA[i:], dAtA60[:j59])
+		i = encodeVarintPlan(dAtA, i, uint64(j59))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -12667,6 +13541,20 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PartitionPrune != nil {
+		{
+			size, err := m.PartitionPrune.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.SourceStep != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.SourceStep))
 		i--
@@ -12759,21 +13647,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA63 := make([]byte, len(m.BindingTags)*10)
-		var j62 int
+		dAtA66 := make([]byte, len(m.BindingTags)*10)
+		var j65 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j65++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA66[j65] = uint8(num)
+			j65++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPlan(dAtA, i, uint64(j62))
+		i -= j65
+		copy(dAtA[i:], dAtA66[:j65])
+		i = encodeVarintPlan(dAtA, i, uint64(j65))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -13026,21 +13914,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA73 := make([]byte, len(m.Children)*10)
-		var j72 int
+		dAtA76 := make([]byte, len(m.Children)*10)
+		var j75 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA73[j72] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j72++
+				j75++
 			}
-			dAtA73[j72] = uint8(num)
-			j72++
+			dAtA76[j75] = uint8(num)
+			j75++
 		}
-		i -= j72
-		copy(dAtA[i:], dAtA73[:j72])
-		i = encodeVarintPlan(dAtA, i, uint64(j72))
+		i -= j75
+		copy(dAtA[i:], dAtA76[:j75])
+		i = encodeVarintPlan(dAtA, i, uint64(j75))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -13094,21 +13982,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA76 := make([]byte, len(m.List)*10)
-		var j75 int
+		dAtA79 := make([]byte, len(m.List)*10)
+		var j78 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA76[j75] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA79[j78] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j75++
+				j78++
 			}
-			dAtA76[j75] = uint8(num)
-			j75++
+			dAtA79[j78] = uint8(num)
+			j78++
 		}
-		i -= j75
-		copy(dAtA[i:], dAtA76[:j75])
-		i = encodeVarintPlan(dAtA, i, uint64(j75))
+		i -= j78
+		copy(dAtA[i:], dAtA79[:j78])
+		i = encodeVarintPlan(dAtA, i, uint64(j78))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -13159,6 +14047,59 @@ func (m *ColPosMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DmlPartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DmlPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DmlPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PartitionExpression != nil {
+		{
+			size, err := m.PartitionExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Idx != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Idx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DeleteCtx) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -13183,6 +14124,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.CanTruncate {
 		i--
 		if m.CanTruncate {
@@ -13250,21 +14205,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnCascadeIdx) > 0 {
-		dAtA78 := make([]byte, len(m.OnCascadeIdx)*10)
-		var j77 int
+		dAtA82 := make([]byte, len(m.OnCascadeIdx)*10)
+		var j81 int
 		for _, num1 := range m.OnCascadeIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA78[j77] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j77++
+				j81++
 			}
-			dAtA78[j77] = uint8(num)
-			j77++
+			dAtA82[j81] = uint8(num)
+			j81++
 		}
-		i -= j77
-		copy(dAtA[i:], dAtA78[:j77])
-		i = encodeVarintPlan(dAtA, i, uint64(j77))
+		i -= j81
+		copy(dAtA[i:], dAtA82[:j81])
+		i = encodeVarintPlan(dAtA, i, uint64(j81))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -13283,21 +14238,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnRestrictIdx) > 0 {
-		dAtA80 := make([]byte, len(m.OnRestrictIdx)*10)
-		var j79 int
+		dAtA84 := make([]byte, len(m.OnRestrictIdx)*10)
+		var j83 int
 		for _, num1 := range m.OnRestrictIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA80[j79] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j79++
+				j83++
 			}
-			dAtA80[j79] = uint8(num)
-			j79++
+			dAtA84[j83] = uint8(num)
+			j83++
 		}
-		i -= j79
-		copy(dAtA[i:], dAtA80[:j79])
-		i = encodeVarintPlan(dAtA, i, uint64(j79))
+		i -= j83
+		copy(dAtA[i:], dAtA84[:j83])
+		i = encodeVarintPlan(dAtA, i, uint64(j83))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13316,21 +14271,21 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.IdxIdx) > 0 {
-		dAtA82 := make([]byte, len(m.IdxIdx)*10)
-		var j81 int
+		dAtA86 := make([]byte, len(m.IdxIdx)*10)
+		var j85 int
 		for _, num1 := range m.IdxIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA86[j85] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j81++
+				j85++
 			}
-			dAtA82[j81] = uint8(num)
-			j81++
+			dAtA86[j85] = uint8(num)
+			j85++
 		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintPlan(dAtA, i, uint64(j81))
+		i -= j85
+		copy(dAtA[i:], dAtA86[:j85])
+		i = encodeVarintPlan(dAtA, i, uint64(j85))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -13451,21 +14406,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA84 := make([]byte, len(m.Steps)*10)
-		var j83 int
+		dAtA88 := make([]byte, len(m.Steps)*10)
+		var j87 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA84[j83] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA88[j87] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j83++
+				j87++
 			}
-			dAtA84[j83] = uint8(num)
-			j83++
+			dAtA88[j87] = uint8(num)
+			j87++
 		}
-		i -= j83
-		copy(dAtA[i:], dAtA84[:j83])
-		i = encodeVarintPlan(dAtA, i, uint64(j83))
+		i -= j87
+		copy(dAtA[i:], dAtA88[:j87])
+		i = encodeVarintPlan(dAtA, i, uint64(j87))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15031,6 +15986,324 @@ func (m *AlterTableAlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterColumnPosition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterColumnPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterColumnPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RelativeColumn) > 0 {
+		i -= len(m.RelativeColumn)
+		copy(dAtA[i:], m.RelativeColumn)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.RelativeColumn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Typ != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Typ))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableAddColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableAddColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableAddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Column != nil {
+		{
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableModifyColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Column != nil {
+		{
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTableRenameColumn) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTableRenameColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableRenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.NewName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OldName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterTablePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTablePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTablePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExchangeTableDef != nil {
+		{
+			size, err := m.ExchangeTableDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ExchangePartitionIdx != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.ExchangePartitionIdx))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PartitionExpression != nil {
+		{
+			size, err := m.PartitionExpression.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.WithoutValidation {
+		i--
+		if m.WithoutValidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ExchangeTable) > 0 {
+		i -= len(m.ExchangeTable)
+		copy(dAtA[i:], m.ExchangeTable)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExchangeTable)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExchangeDatabase) > 0 {
+		i -= len(m.ExchangeDatabase)
+		copy(dAtA[i:], m.ExchangeDatabase)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExchangeDatabase)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TruncateTables) > 0 {
+		for iNdEx := len(m.TruncateTables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TruncateTables[iNdEx])
+			copy(dAtA[i:], m.TruncateTables[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.TruncateTables[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DropTables) > 0 {
+		for iNdEx := len(m.DropTables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DropTables[iNdEx])
+			copy(dAtA[i:], m.DropTables[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.DropTables[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CreateTables) > 0 {
+		for iNdEx := len(m.CreateTables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateTables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NewPartition != nil {
+		{
+			size, err := m.NewPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Typ != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Typ))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15221,6 +16494,90 @@ func (m *AlterTable_Action_AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_AddColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddColumn != nil {
+		{
+			size, err := m.AddColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_ModifyColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_ModifyColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ModifyColumn != nil {
+		{
+			size, err := m.ModifyColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_RenameColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_RenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameColumn != nil {
+		{
+			size, err := m.RenameColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_AlterPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AlterPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AlterPartition != nil {
+		{
+			size, err := m.AlterPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -15255,20 +16612,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA125 := make([]byte, len(m.ForeignTbl)*10)
-		var j124 int
+		dAtA142 := make([]byte, len(m.ForeignTbl)*10)
+		var j141 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA125[j124] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA142[j141] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j124++
+				j141++
 			}
-			dAtA125[j124] = uint8(num)
-			j124++
+			dAtA142[j141] = uint8(num)
+			j141++
 		}
-		i -= j124
-		copy(dAtA[i:], dAtA125[:j124])
-		i = encodeVarintPlan(dAtA, i, uint64(j124))
+		i -= j141
+		copy(dAtA[i:], dAtA142[:j141])
+		i = encodeVarintPlan(dAtA, i, uint64(j141))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -15703,21 +17060,30 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PartitionTableNames) > 0 {
+		for iNdEx := len(m.PartitionTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PartitionTableNames[iNdEx])
+			copy(dAtA[i:], m.PartitionTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.PartitionTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA131 := make([]byte, len(m.ForeignTbl)*10)
-		var j130 int
+		dAtA148 := make([]byte, len(m.ForeignTbl)*10)
+		var j147 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA131[j130] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA148[j147] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j130++
+				j147++
 			}
-			dAtA131[j130] = uint8(num)
-			j130++
+			dAtA148[j147] = uint8(num)
+			j147++
 		}
-		i -= j130
-		copy(dAtA[i:], dAtA131[:j130])
-		i = encodeVarintPlan(dAtA, i, uint64(j130))
+		i -= j147
+		copy(dAtA[i:], dAtA148[:j147])
+		i = encodeVarintPlan(dAtA, i, uint64(j147))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -15794,20 +17160,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA134 := make([]byte, len(m.AccountIDs)*10)
-		var j133 int
+		dAtA151 := make([]byte, len(m.AccountIDs)*10)
+		var j150 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA134[j133] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA151[j150] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j133++
+				j150++
 			}
-			dAtA134[j133] = uint8(num)
-			j133++
+			dAtA151[j150] = uint8(num)
+			j150++
 		}
-		i -= j133
-		copy(dAtA[i:], dAtA134[:j133])
-		i = encodeVarintPlan(dAtA, i, uint64(j133))
+		i -= j150
+		copy(dAtA[i:], dAtA151[:j150])
+		i = encodeVarintPlan(dAtA, i, uint64(j150))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -16019,21 +17385,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA138 := make([]byte, len(m.ParamTypes)*10)
-		var j137 int
+		dAtA155 := make([]byte, len(m.ParamTypes)*10)
+		var j154 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA138[j137] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA155[j154] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j137++
+				j154++
 			}
-			dAtA138[j137] = uint8(num)
-			j137++
+			dAtA155[j154] = uint8(num)
+			j154++
 		}
-		i -= j137
-		copy(dAtA[i:], dAtA138[:j137])
-		i = encodeVarintPlan(dAtA, i, uint64(j137))
+		i -= j154
+		copy(dAtA[i:], dAtA155[:j154])
+		i = encodeVarintPlan(dAtA, i, uint64(j154))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -17086,6 +18452,10 @@ func (m *Default) ProtoSize() (n int) {
 	if m.NullAbility {
 		n += 2
 	}
+	l = len(m.FillValue)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17167,6 +18537,29 @@ func (m *PrimaryKeyDef) ProtoSize() (n int) {
 	return n
 }
 
+func (m *TTLDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ColName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.ExpireSeconds != 0 {
+		n += 1 + sovPlan(uint64(m.ExpireSeconds))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *IndexDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -17492,6 +18885,27 @@ func (m *PartitionItem) ProtoSize() (n int) {
 	return n
 }
 
+func (m *PartitionPrune) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPruned {
+		n += 2
+	}
+	if len(m.SelectedPartitions) > 0 {
+		for _, e := range m.SelectedPartitions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ViewDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -17612,6 +19026,10 @@ func (m *TableDef) ProtoSize() (n int) {
 	if m.TableLockType != 0 {
 		n += 2 + sovPlan(uint64(m.TableLockType))
 	}
+	if m.Ttl != nil {
+		l = m.Ttl.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17846,6 +19264,10 @@ func (m *InsertCtx) ProtoSize() (n int) {
 		l = m.ClusterTable.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.Partition != nil {
+		l = m.Partition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -17962,6 +19384,12 @@ func (m *UpdateCtx) ProtoSize() (n int) {
 			n += 2 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18172,6 +19600,10 @@ func (m *Node) ProtoSize() (n int) {
 	if m.SourceStep != 0 {
 		n += 2 + sovPlan(uint64(m.SourceStep))
 	}
+	if m.PartitionPrune != nil {
+		l = m.PartitionPrune.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -18217,6 +19649,31 @@ func (m *ColPosMap) ProtoSize() (n int) {
 	return n
 }
 
+func (m *DmlPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.Idx != 0 {
+		n += 1 + sovPlan(uint64(m.Idx))
+	}
+	if m.PartitionExpression != nil {
+		l = m.PartitionExpression.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *DeleteCtx) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -18301,6 +19758,12 @@ func (m *DeleteCtx) ProtoSize() (n int) {
 	if m.CanTruncate {
 		n += 2
 	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -19120,6 +20583,148 @@ func (m *AlterTableAlterIndex) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterColumnPosition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Typ != 0 {
+		n += 1 + sovPlan(uint64(m.Typ))
+	}
+	l = len(m.RelativeColumn)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableAddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Position != nil {
+		l = m.Position.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Position != nil {
+		l = m.Position.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableRenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTablePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Typ != 0 {
+		n += 1 + sovPlan(uint64(m.Typ))
+	}
+	if m.NewPartition != nil {
+		l = m.NewPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.CreateTables) > 0 {
+		for _, e := range m.CreateTables {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.DropTables) > 0 {
+		for _, s := range m.DropTables {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.TruncateTables) > 0 {
+		for _, s := range m.TruncateTables {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.ExchangeDatabase)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.ExchangeTable)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.WithoutValidation {
+		n += 2
+	}
+	if m.PartitionExpression != nil {
+		l = m.PartitionExpression.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.ExchangePartitionIdx != 0 {
+		n += 1 + sovPlan(uint64(m.ExchangePartitionIdx))
+	}
+	if m.ExchangeTableDef != nil {
+		l = m.ExchangeTableDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -19212,6 +20817,54 @@ func (m *AlterTable_Action_AlterIndex) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_AddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddColumn != nil {
+		l = m.AddColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_ModifyColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModifyColumn != nil {
+		l = m.ModifyColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_RenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameColumn != nil {
+		l = m.RenameColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_AlterPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AlterPartition != nil {
+		l = m.AlterPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -19454,6 +21107,12 @@ func (m *TruncateTable) ProtoSize() (n int) {
 		}
 		n += 1 + sovPlan(uint64(l)) + l
 	}
+	if len(m.PartitionTableNames) > 0 {
+		for _, s := range m.PartitionTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -23245,6 +24904,40 @@ func (m *Default) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NullAbility = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FillValue = append(m.FillValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.FillValue == nil {
+				m.FillValue = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -23739,6 +25432,140 @@ func (m *PrimaryKeyDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TTLDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TTLDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TTLDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireSeconds", wireType)
+			}
+			m.ExpireSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *IndexDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25725,7 +27552,7 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ViewDef) Unmarshal(dAtA []byte) error {
+func (m *PartitionPrune) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25748,17 +27575,37 @@ func (m *ViewDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ViewDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionPrune: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionPrune: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPruned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPruned = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedPartitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -25768,23 +27615,25 @@ func (m *ViewDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.View = string(dAtA[iNdEx:postIndex])
+			m.SelectedPartitions = append(m.SelectedPartitions, &PartitionItem{})
+			if err := m.SelectedPartitions[len(m.SelectedPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25808,7 +27657,7 @@ func (m *ViewDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TableDef) Unmarshal(dAtA []byte) error {
+func (m *ViewDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25831,34 +27680,117 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ViewDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TblId", wireType)
-			}
-			m.TblId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TblId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.View = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TblId", wireType)
+			}
+			m.TblId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TblId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26603,6 +28535,42 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &TTLDef{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -27994,6 +29962,42 @@ func (m *InsertCtx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Partition == nil {
+				m.Partition = &DmlPartition{}
+			}
+			if err := m.Partition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -28707,6 +30711,40 @@ func (m *UpdateCtx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &DmlPartition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -30151,6 +32189,42 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionPrune", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionPrune == nil {
+				m.PartitionPrune = &PartitionPrune{}
+			}
+			if err := m.PartitionPrune.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -30464,7 +32538,7 @@ func (m *ColPosMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
+func (m *DmlPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30487,17 +32561,17 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCtx: wiretype end group for non-group")
+			return fmt.Errorf("proto: DmlPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DmlPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -30507,28 +32581,166 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ref = append(m.Ref, &ObjectRef{})
-			if err := m.Ref[len(m.Ref)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
+			}
+			m.Idx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Idx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionExpression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionExpression == nil {
+				m.PartitionExpression = &Expr{}
+			}
+			if err := m.PartitionExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteCtx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = append(m.Ref, &ObjectRef{})
+			if err := m.Ref[len(m.Ref)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Idx", wireType)
 			}
 			var msglen int
@@ -31047,155 +33259,189 @@ func (m *DeleteCtx) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.CanTruncate = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Query) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
-			}
-			m.StmtType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StmtType |= Query_StatementType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Steps = append(m.Steps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Steps) == 0 {
-					m.Steps = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Steps = append(m.Steps, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
-			}
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &DmlPartition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Query) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
+			}
+			m.StmtType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StmtType |= Query_StatementType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Steps = append(m.Steps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Steps) == 0 {
+					m.Steps = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Steps = append(m.Steps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33719,13 +35965,716 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
+			m.FkTables = append(m.FkTables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FkCols = append(m.FkCols, &FkColName{})
+			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTables = append(m.PartitionTables, &TableDef{})
+			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fkey == nil {
+				m.Fkey = &ForeignKeyDef{}
+			}
+			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IndexInfo == nil {
+				m.IndexInfo = &CreateTable{}
+			}
+			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IndexTableExist = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FkCols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -33735,31 +36684,29 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FkCols = append(m.FkCols, &FkColName{})
-			if err := m.FkCols[len(m.FkCols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -33769,25 +36716,23 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionTables = append(m.PartitionTables, &TableDef{})
-			if err := m.PartitionTables[len(m.PartitionTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IndexTableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33811,7 +36756,7 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33834,17 +36779,17 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDrop: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDrop: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.Typ = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -33854,14 +36799,27 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Typ |= AlterTableDrop_Typ(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33889,11 +36847,11 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TableName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33921,8 +36879,28 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			m.IndexName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Visible = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -33945,7 +36923,7 @@ func (m *AlterTableDrop) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
+func (m *AlterColumnPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33968,17 +36946,17 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddFk: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterColumnPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddFk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterColumnPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
 			}
-			var stringLen uint64
+			m.Typ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -33988,27 +36966,14 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Typ |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeColumn", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34036,13 +37001,64 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.RelativeColumn = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34052,27 +37068,31 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			if m.Column == nil {
+				m.Column = &ColDef{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34099,10 +37119,10 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fkey == nil {
-				m.Fkey = &ForeignKeyDef{}
+			if m.Position == nil {
+				m.Position = &AlterColumnPosition{}
 			}
-			if err := m.Fkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34128,7 +37148,7 @@ func (m *AlterTableAddFk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTableModifyColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34151,15 +37171,15 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAddIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableModifyColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAddIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableModifyColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34187,13 +37207,13 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.OldName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34203,29 +37223,33 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			if m.Column == nil {
+				m.Column = &ColDef{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34235,29 +37259,84 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
+			if m.Position == nil {
+				m.Position = &AlterColumnPosition{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableRenameColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableRenameColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableRenameColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34267,33 +37346,29 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IndexInfo == nil {
-				m.IndexInfo = &CreateTable{}
-			}
-			if err := m.IndexInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OldName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableExist", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34303,12 +37378,24 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IndexTableExist = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -34331,7 +37418,7 @@ func (m *AlterTableAddIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
+func (m *AlterTablePartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34354,17 +37441,17 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableDropIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTablePartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableDropIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTablePartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
 			}
-			var stringLen uint64
+			m.Typ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34374,29 +37461,16 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Typ |= AlterTablePartition_AlterPartitionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPartition", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34406,29 +37480,33 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			if m.NewPartition == nil {
+				m.NewPartition = &PartitionByDef{}
+			}
+			if err := m.NewPartition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTables", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34438,27 +37516,29 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.CreateTables = append(m.CreateTables, &TableDef{})
+			if err := m.CreateTables[len(m.CreateTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexTableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTables", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34486,62 +37566,11 @@ func (m *AlterTableDropIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexTableName = string(dAtA[iNdEx:postIndex])
+			m.DropTables = append(m.DropTables, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTableAlterIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTables", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34569,11 +37598,11 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			m.TruncateTables = append(m.TruncateTables, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeDatabase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34601,11 +37630,11 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TableName = string(dAtA[iNdEx:postIndex])
+			m.ExchangeDatabase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeTable", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34633,11 +37662,11 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexName = string(dAtA[iNdEx:postIndex])
+			m.ExchangeTable = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Visible", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithoutValidation", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -34654,7 +37683,98 @@ func (m *AlterTableAlterIndex) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Visible = bool(v != 0)
+			m.WithoutValidation = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionExpression", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionExpression == nil {
+				m.PartitionExpression = &Expr{}
+			}
+			if err := m.PartitionExpression.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangePartitionIdx", wireType)
+			}
+			m.ExchangePartitionIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExchangePartitionIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeTableDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExchangeTableDef == nil {
+				m.ExchangeTableDef = &TableDef{}
+			}
+			if err := m.ExchangeTableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -35019,6 +38139,146 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_AlterIndex{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableAddColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AddColumn{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableModifyColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_ModifyColumn{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameColumn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTableRenameColumn{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_RenameColumn{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterPartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTablePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AlterPartition{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -36575,6 +39835,38 @@ func (m *TruncateTable) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ForeignTbl", wireType)
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionTableNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionTableNames = append(m.PartitionTableNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
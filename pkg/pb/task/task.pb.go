@@ -68,6 +68,8 @@ const (
 	TaskCode_MetricLogMerge TaskCode = 2
 	// MetricStorageUsage handle metric server_storage_usage collection
 	TaskCode_MetricStorageUsage TaskCode = 3
+	// ConvertColumn converts a column of a table to a new type for alter table
+	TaskCode_ConvertColumn TaskCode = 4
 )
 
 var TaskCode_name = map[int32]string{
@@ -75,6 +77,7 @@ var TaskCode_name = map[int32]string{
 	1: "SystemInit",
 	2: "MetricLogMerge",
 	3: "MetricStorageUsage",
+	4: "ConvertColumn",
 }
 
 var TaskCode_value = map[string]int32{
@@ -82,6 +85,7 @@ var TaskCode_value = map[string]int32{
 	"SystemInit":         1,
 	"MetricLogMerge":     2,
 	"MetricStorageUsage": 3,
+	"ConvertColumn":      4,
 }
 
 func (x TaskCode) String() string {
@@ -577,50 +581,51 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xda, 0x4c,
-	0x14, 0x65, 0x80, 0xf0, 0x73, 0xf9, 0x91, 0xbf, 0xf9, 0x3e, 0x7d, 0xb2, 0x58, 0x50, 0x84, 0x52,
-	0x09, 0x21, 0x35, 0xa8, 0xb4, 0x5d, 0x74, 0x55, 0x25, 0x90, 0xaa, 0xa8, 0xa1, 0xa9, 0x06, 0xb2,
-	0xe9, 0x6e, 0x30, 0xb7, 0x8e, 0x15, 0xf0, 0x58, 0xe3, 0x71, 0x05, 0x4f, 0xd2, 0x75, 0xdf, 0x26,
-	0xcb, 0x3c, 0x41, 0xd5, 0x46, 0xdd, 0xf7, 0x15, 0xaa, 0x99, 0x01, 0x07, 0x67, 0xdd, 0x9d, 0xcf,
-	0x39, 0x77, 0xee, 0xdc, 0x7b, 0x8e, 0x6d, 0x00, 0xc5, 0xe3, 0x9b, 0x93, 0x48, 0x0a, 0x25, 0x68,
-	0x51, 0x3f, 0xb7, 0x9e, 0xf9, 0x81, 0xba, 0x4e, 0x16, 0x27, 0x9e, 0x58, 0x0f, 0x7c, 0xe1, 0x8b,
-	0x81, 0x11, 0x17, 0xc9, 0x67, 0x83, 0x0c, 0x30, 0x4f, 0xf6, 0x50, 0xf7, 0x2b, 0x81, 0xfa, 0x9c,
-	0xc7, 0x37, 0x53, 0x54, 0x7c, 0xc9, 0x15, 0xa7, 0x4d, 0xc8, 0x4f, 0xc6, 0x2e, 0xe9, 0x90, 0x5e,
-	0x95, 0xe5, 0x27, 0x63, 0xda, 0x87, 0xca, 0xf9, 0x06, 0xbd, 0x44, 0x09, 0xe9, 0xe6, 0x3b, 0xa4,
-	0xd7, 0x1c, 0x36, 0x4f, 0xcc, 0xa5, 0xfa, 0xd4, 0x48, 0x2c, 0x91, 0xa5, 0x3a, 0x75, 0xa1, 0x3c,
-	0x12, 0xa1, 0xc2, 0x8d, 0x72, 0x0b, 0x1d, 0xd2, 0xab, 0xb3, 0x3d, 0xa4, 0xcf, 0xa1, 0x7c, 0x19,
-	0xa9, 0x40, 0x84, 0xb1, 0x5b, 0xec, 0x90, 0x5e, 0x6d, 0xf8, 0xcf, 0x43, 0x93, 0x9d, 0x70, 0x56,
-	0xbc, 0xfd, 0xfe, 0x24, 0xc7, 0xf6, 0x75, 0xdd, 0x6f, 0x04, 0x6a, 0x07, 0x32, 0x3d, 0x86, 0xc6,
-	0x94, 0x6f, 0x18, 0x2a, 0xb9, 0x9d, 0x07, 0x6b, 0x8c, 0xcd, 0x8c, 0x0d, 0x96, 0x25, 0x75, 0x95,
-	0x41, 0x93, 0x50, 0xa1, 0xfc, 0xc2, 0x57, 0x66, 0xe6, 0x02, 0xcb, 0x92, 0xba, 0x6a, 0x8c, 0x2b,
-	0xbe, 0x1d, 0x27, 0x92, 0xeb, 0xee, 0x66, 0xdc, 0x02, 0xcb, 0x92, 0xb4, 0x03, 0xb5, 0x91, 0x08,
-	0xbd, 0x44, 0x4a, 0x0c, 0xbd, 0xad, 0x19, 0xbc, 0xc1, 0x0e, 0xa9, 0xee, 0x7b, 0x68, 0xd8, 0xe5,
-	0x91, 0x61, 0x9c, 0xac, 0x14, 0x3d, 0x86, 0xa2, 0xf6, 0xc4, 0xcc, 0xd6, 0x1c, 0x3a, 0x76, 0x49,
-	0xab, 0x19, 0xaf, 0x8c, 0x4a, 0xff, 0x83, 0xa3, 0x73, 0x29, 0x77, 0x86, 0x56, 0x99, 0x05, 0xdd,
-	0xdf, 0x79, 0x28, 0xea, 0x85, 0x0f, 0x22, 0x28, 0x9a, 0x08, 0x5e, 0x42, 0x65, 0x1f, 0x8f, 0x39,
-	0x51, 0x1b, 0xd2, 0x07, 0xf7, 0xf6, 0xca, 0xce, 0xbe, 0xb4, 0x92, 0x76, 0xa1, 0xfe, 0x91, 0x4b,
-	0x0c, 0x95, 0xae, 0x9a, 0x8c, 0xcd, 0x8a, 0x55, 0x96, 0xe1, 0x68, 0x0f, 0x4a, 0x33, 0xc5, 0x55,
-	0x62, 0x53, 0x49, 0x07, 0xd6, 0xaa, 0xe5, 0xd9, 0x4e, 0xa7, 0x6d, 0x00, 0xcd, 0xb2, 0x24, 0x0c,
-	0x51, 0xba, 0x47, 0xa6, 0xd7, 0x01, 0x63, 0x56, 0x8a, 0x84, 0x77, 0xed, 0x96, 0x8c, 0x4b, 0x16,
-	0x68, 0x9f, 0x2f, 0x78, 0xac, 0xde, 0x21, 0x97, 0x6a, 0x81, 0x5c, 0xb9, 0x65, 0xeb, 0x73, 0x86,
-	0xa4, 0x2d, 0xa8, 0x8c, 0x24, 0x72, 0x85, 0xa7, 0xca, 0xad, 0x98, 0x82, 0x14, 0xdb, 0x0c, 0xd6,
-	0xd1, 0x0a, 0x15, 0x2e, 0x4f, 0x95, 0x5b, 0x35, 0xf2, 0x21, 0x45, 0x5f, 0x3f, 0xca, 0xc0, 0x05,
-	0x63, 0xd1, 0xbf, 0x76, 0x95, 0x8c, 0xc4, 0xb2, 0x95, 0xdd, 0x5f, 0x44, 0xdf, 0x2c, 0xc2, 0xbf,
-	0xe8, 0x7a, 0xcb, 0x76, 0x3c, 0xdf, 0x44, 0x72, 0xe7, 0x78, 0x8a, 0xb5, 0xf6, 0x01, 0x37, 0x4a,
-	0xbf, 0xa8, 0xc6, 0xef, 0x02, 0x4b, 0xb1, 0x4e, 0x6b, 0x2e, 0x03, 0xdf, 0x47, 0x69, 0x5f, 0xee,
-	0x23, 0x33, 0x47, 0x86, 0xcb, 0xf8, 0x54, 0x7a, 0xe4, 0x53, 0x0b, 0x2a, 0x57, 0xd1, 0xd2, 0x6a,
-	0xd6, 0xe4, 0x14, 0xf7, 0x5f, 0xd9, 0xec, 0x76, 0x49, 0xd6, 0xa0, 0x6c, 0x4f, 0x2d, 0x9d, 0x9c,
-	0x06, 0x3a, 0xc0, 0x20, 0xf4, 0x1d, 0x42, 0x1b, 0x50, 0x4d, 0x8d, 0x75, 0xf2, 0xfd, 0x39, 0x54,
-	0xf6, 0xdf, 0x38, 0xad, 0x43, 0x65, 0x8e, 0xb1, 0xba, 0x0c, 0x57, 0x5b, 0x27, 0x47, 0x9b, 0x00,
-	0xb3, 0x6d, 0xac, 0x70, 0x3d, 0x09, 0x03, 0xe5, 0x10, 0x4a, 0xa1, 0x39, 0x45, 0x25, 0x03, 0xef,
-	0x42, 0xf8, 0x53, 0x94, 0x3e, 0x3a, 0x79, 0xfa, 0x3f, 0x50, 0xcb, 0xcd, 0x94, 0x90, 0xdc, 0xc7,
-	0xab, 0x98, 0xfb, 0xe8, 0x14, 0xfa, 0x4f, 0x01, 0x1e, 0xbe, 0x07, 0x7d, 0xff, 0x2c, 0xf1, 0x3c,
-	0x8c, 0x63, 0x27, 0x47, 0x01, 0x4a, 0x6f, 0x79, 0xb0, 0xc2, 0xa5, 0x43, 0xce, 0xde, 0xdc, 0xfd,
-	0x6c, 0x93, 0xdb, 0xfb, 0x36, 0xb9, 0xbb, 0x6f, 0x93, 0x1f, 0xf7, 0x6d, 0xf2, 0xe9, 0xf0, 0xc7,
-	0xb6, 0xe6, 0x4a, 0x06, 0x1b, 0x21, 0x03, 0x3f, 0x08, 0xf7, 0x20, 0xc4, 0x41, 0x74, 0xe3, 0x0f,
-	0xa2, 0xc5, 0x40, 0xa7, 0xb4, 0x28, 0x99, 0xff, 0xdb, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xbd, 0x41, 0x97, 0x03, 0x22, 0x05, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xda, 0x4a,
+	0x14, 0xc5, 0x40, 0xf8, 0xb8, 0x7c, 0xc8, 0x99, 0xf7, 0xf4, 0x64, 0xb1, 0xe0, 0x21, 0x94, 0x27,
+	0x21, 0xa4, 0x17, 0xf4, 0x78, 0xed, 0xa2, 0xab, 0x2a, 0x81, 0x54, 0x45, 0x0d, 0x4d, 0x35, 0x90,
+	0x4d, 0x77, 0x83, 0xb9, 0x75, 0xac, 0xc0, 0x8c, 0x35, 0x1e, 0x47, 0xf0, 0x4b, 0xba, 0xee, 0xbf,
+	0xc9, 0x32, 0xbf, 0xa0, 0x6a, 0xa3, 0xee, 0xfb, 0x17, 0xaa, 0x99, 0x01, 0x07, 0x67, 0xdd, 0x9d,
+	0xcf, 0x39, 0x77, 0xae, 0xef, 0x3d, 0xc7, 0x1e, 0x00, 0xc5, 0xe2, 0xdb, 0xd3, 0x48, 0x0a, 0x25,
+	0x48, 0x51, 0x3f, 0xb7, 0xfe, 0x0d, 0x42, 0x75, 0x93, 0x2c, 0x4e, 0x7d, 0xb1, 0x1e, 0x04, 0x22,
+	0x10, 0x03, 0x23, 0x2e, 0x92, 0x4f, 0x06, 0x19, 0x60, 0x9e, 0xec, 0xa1, 0xee, 0x67, 0x07, 0xea,
+	0x73, 0x16, 0xdf, 0x4e, 0x51, 0xb1, 0x25, 0x53, 0x8c, 0x34, 0x21, 0x3f, 0x19, 0x7b, 0x4e, 0xc7,
+	0xe9, 0x55, 0x69, 0x7e, 0x32, 0x26, 0x7d, 0xa8, 0x5c, 0x6c, 0xd0, 0x4f, 0x94, 0x90, 0x5e, 0xbe,
+	0xe3, 0xf4, 0x9a, 0xc3, 0xe6, 0xa9, 0x79, 0xa9, 0x3e, 0x35, 0x12, 0x4b, 0xa4, 0xa9, 0x4e, 0x3c,
+	0x28, 0x8f, 0x04, 0x57, 0xb8, 0x51, 0x5e, 0xa1, 0xe3, 0xf4, 0xea, 0x74, 0x0f, 0xc9, 0x7f, 0x50,
+	0xbe, 0x8a, 0x54, 0x28, 0x78, 0xec, 0x15, 0x3b, 0x4e, 0xaf, 0x36, 0x3c, 0x7e, 0x6a, 0xb2, 0x13,
+	0xce, 0x8b, 0xf7, 0x5f, 0xff, 0xce, 0xd1, 0x7d, 0x5d, 0xf7, 0x8b, 0x03, 0xb5, 0x03, 0x99, 0x9c,
+	0x40, 0x63, 0xca, 0x36, 0x14, 0x95, 0xdc, 0xce, 0xc3, 0x35, 0xc6, 0x66, 0xc6, 0x06, 0xcd, 0x92,
+	0xba, 0xca, 0xa0, 0x09, 0x57, 0x28, 0xef, 0xd8, 0xca, 0xcc, 0x5c, 0xa0, 0x59, 0x52, 0x57, 0x8d,
+	0x71, 0xc5, 0xb6, 0xe3, 0x44, 0x32, 0xdd, 0xdd, 0x8c, 0x5b, 0xa0, 0x59, 0x92, 0x74, 0xa0, 0x36,
+	0x12, 0xdc, 0x4f, 0xa4, 0x44, 0xee, 0x6f, 0xcd, 0xe0, 0x0d, 0x7a, 0x48, 0x75, 0xdf, 0x41, 0xc3,
+	0x2e, 0x8f, 0x14, 0xe3, 0x64, 0xa5, 0xc8, 0x09, 0x14, 0xb5, 0x27, 0x66, 0xb6, 0xe6, 0xd0, 0xb5,
+	0x4b, 0x5a, 0xcd, 0x78, 0x65, 0x54, 0xf2, 0x27, 0x1c, 0x5d, 0x48, 0xb9, 0x33, 0xb4, 0x4a, 0x2d,
+	0xe8, 0xfe, 0xcc, 0x43, 0x51, 0x2f, 0x7c, 0x10, 0x41, 0xd1, 0x44, 0xf0, 0x02, 0x2a, 0xfb, 0x78,
+	0xcc, 0x89, 0xda, 0x90, 0x3c, 0xb9, 0xb7, 0x57, 0x76, 0xf6, 0xa5, 0x95, 0xa4, 0x0b, 0xf5, 0x0f,
+	0x4c, 0x22, 0x57, 0xba, 0x6a, 0x32, 0x36, 0x2b, 0x56, 0x69, 0x86, 0x23, 0x3d, 0x28, 0xcd, 0x14,
+	0x53, 0x89, 0x4d, 0x25, 0x1d, 0x58, 0xab, 0x96, 0xa7, 0x3b, 0x9d, 0xb4, 0x01, 0x34, 0x4b, 0x13,
+	0xce, 0x51, 0x7a, 0x47, 0xa6, 0xd7, 0x01, 0x63, 0x56, 0x8a, 0x84, 0x7f, 0xe3, 0x95, 0x8c, 0x4b,
+	0x16, 0x68, 0x9f, 0x2f, 0x59, 0xac, 0xde, 0x22, 0x93, 0x6a, 0x81, 0x4c, 0x79, 0x65, 0xeb, 0x73,
+	0x86, 0x24, 0x2d, 0xa8, 0x8c, 0x24, 0x32, 0x85, 0x67, 0xca, 0xab, 0x98, 0x82, 0x14, 0xdb, 0x0c,
+	0xd6, 0xd1, 0x0a, 0x15, 0x2e, 0xcf, 0x94, 0x57, 0x35, 0xf2, 0x21, 0x45, 0x5e, 0x3d, 0xcb, 0xc0,
+	0x03, 0x63, 0xd1, 0x1f, 0x76, 0x95, 0x8c, 0x44, 0xb3, 0x95, 0xdd, 0x1f, 0x8e, 0x7e, 0xb3, 0xe0,
+	0xbf, 0xd1, 0xf5, 0x96, 0xed, 0x78, 0xb1, 0x89, 0xe4, 0xce, 0xf1, 0x14, 0x6b, 0xed, 0x3d, 0x6e,
+	0x94, 0xfe, 0x50, 0x8d, 0xdf, 0x05, 0x9a, 0x62, 0x9d, 0xd6, 0x5c, 0x86, 0x41, 0x80, 0xd2, 0x7e,
+	0xdc, 0x47, 0x66, 0x8e, 0x0c, 0x97, 0xf1, 0xa9, 0xf4, 0xcc, 0xa7, 0x16, 0x54, 0xae, 0xa3, 0xa5,
+	0xd5, 0xac, 0xc9, 0x29, 0xee, 0xbf, 0xb4, 0xd9, 0xed, 0x92, 0xac, 0x41, 0xd9, 0x9e, 0x5a, 0xba,
+	0x39, 0x0d, 0x74, 0x80, 0x21, 0x0f, 0x5c, 0x87, 0x34, 0xa0, 0x9a, 0x1a, 0xeb, 0xe6, 0xfb, 0x01,
+	0x54, 0xf6, 0xff, 0x38, 0xa9, 0x43, 0x65, 0x8e, 0xb1, 0xba, 0xe2, 0xab, 0xad, 0x9b, 0x23, 0x4d,
+	0x80, 0xd9, 0x36, 0x56, 0xb8, 0x9e, 0xf0, 0x50, 0xb9, 0x0e, 0x21, 0xd0, 0x9c, 0xa2, 0x92, 0xa1,
+	0x7f, 0x29, 0x82, 0x29, 0xca, 0x00, 0xdd, 0x3c, 0xf9, 0x0b, 0x88, 0xe5, 0x66, 0x4a, 0x48, 0x16,
+	0xe0, 0x75, 0xcc, 0x02, 0x74, 0x0b, 0xe4, 0x18, 0x1a, 0x23, 0xc1, 0xef, 0x50, 0xaa, 0x91, 0x58,
+	0x25, 0x6b, 0xee, 0x16, 0xfb, 0xff, 0x00, 0x3c, 0xfd, 0x22, 0x7a, 0xa4, 0x59, 0xe2, 0xfb, 0x18,
+	0xc7, 0x6e, 0x8e, 0x00, 0x94, 0xde, 0xb0, 0x70, 0x85, 0x4b, 0xd7, 0x39, 0x7f, 0xfd, 0xf0, 0xbd,
+	0xed, 0xdc, 0x3f, 0xb6, 0x9d, 0x87, 0xc7, 0xb6, 0xf3, 0xed, 0xb1, 0xed, 0x7c, 0x3c, 0xbc, 0xeb,
+	0xd6, 0x4c, 0xc9, 0x70, 0x23, 0x64, 0x18, 0x84, 0x7c, 0x0f, 0x38, 0x0e, 0xa2, 0xdb, 0x60, 0x10,
+	0x2d, 0x06, 0x3a, 0xb8, 0x45, 0xc9, 0x5c, 0x79, 0xff, 0xff, 0x1a, 0x00, 0x19, 0x3c, 0xe1, 0xbe,
+	0x35, 0x05, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
@@ -35,6 +35,10 @@ type FileServiceCounterSet struct {
 		Get         stats.Counter
 		Delete      stats.Counter
 		DeleteMulti stats.Counter
+		// ReadBytes is the number of bytes read from the object storage
+		ReadBytes stats.Counter
+		// WriteBytes is the number of bytes written to the object storage
+		WriteBytes stats.Counter
 	}
 
 	Cache struct {
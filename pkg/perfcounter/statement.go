@@ -0,0 +1,127 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perfcounter
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/util/metric/stats"
+)
+
+// statementCounterSets maps statement ids to the counter sets of the statements.
+// io that does not carry the caller's context, like prefetching or io issued
+// by remote pipelines, is attributed to a statement by looking up its id here
+var statementCounterSets sync.Map // string -> *CounterSet
+
+// RegisterStatement makes set the counter set of the statement id
+func RegisterStatement(id string, set *CounterSet) {
+	if id == "" || set == nil {
+		return
+	}
+	statementCounterSets.Store(id, set)
+}
+
+// UnregisterStatement removes the counter set of the statement id
+func UnregisterStatement(id string) {
+	if id == "" {
+		return
+	}
+	statementCounterSets.Delete(id)
+}
+
+// StatementCounterSet returns the counter set of the statement id, or nil if not registered
+func StatementCounterSet(id string) *CounterSet {
+	if id == "" {
+		return nil
+	}
+	v, ok := statementCounterSets.Load(id)
+	if !ok {
+		return nil
+	}
+	return v.(*CounterSet)
+}
+
+type ctxKeyStatementID struct{}
+
+var CtxKeyStatementID = ctxKeyStatementID{}
+
+// WithStatementID attaches the statement id to ctx, and the counter set of the statement if registered
+func WithStatementID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	ctx = context.WithValue(ctx, CtxKeyStatementID, id)
+	if set := StatementCounterSet(id); set != nil {
+		ctx = WithCounterSet(ctx, set)
+	}
+	return ctx
+}
+
+// StatementIDFromContext returns the statement id attached by WithStatementID
+func StatementIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(CtxKeyStatementID).(string)
+	return id
+}
+
+// statementFileServiceCounters returns the file service counters of set that are
+// carried between cns, the order is part of the encoding, append only
+func statementFileServiceCounters(set *CounterSet) []*stats.Counter {
+	fs := &set.FileService
+	return []*stats.Counter{
+		&fs.S3.List,
+		&fs.S3.Head,
+		&fs.S3.Put,
+		&fs.S3.Get,
+		&fs.S3.Delete,
+		&fs.S3.DeleteMulti,
+		&fs.S3.ReadBytes,
+		&fs.S3.WriteBytes,
+		&fs.Cache.Read,
+		&fs.Cache.Hit,
+		&fs.Cache.Memory.Read,
+		&fs.Cache.Memory.Hit,
+		&fs.Cache.Disk.Read,
+		&fs.Cache.Disk.Hit,
+	}
+}
+
+// EncodeFileServiceCounters encodes the file service counters of set,
+// for a remote pipeline to report its io to the cn running the statement
+func EncodeFileServiceCounters(set *CounterSet) []byte {
+	counters := statementFileServiceCounters(set)
+	data := make([]byte, 8*len(counters))
+	for i, counter := range counters {
+		binary.LittleEndian.PutUint64(data[i*8:], uint64(counter.Load()))
+	}
+	return data
+}
+
+// AddEncodedFileServiceCounters adds the counters encoded by EncodeFileServiceCounters to set.
+// counters unknown to either side are ignored
+func AddEncodedFileServiceCounters(set *CounterSet, data []byte) {
+	for i, counter := range statementFileServiceCounters(set) {
+		if len(data) < (i+1)*8 {
+			return
+		}
+		if n := int64(binary.LittleEndian.Uint64(data[i*8:])); n != 0 {
+			counter.Add(n)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package perfcounter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatementCounterSet(t *testing.T) {
+	ctx := context.Background()
+	var set CounterSet
+
+	// not registered, only the id is attached
+	ctx = WithStatementID(ctx, "foo")
+	assert.Equal(t, "foo", StatementIDFromContext(ctx))
+	Update(ctx, func(c *CounterSet) {
+		c.FileService.S3.Get.Add(1)
+	})
+	assert.Equal(t, int64(0), set.FileService.S3.Get.Load())
+
+	RegisterStatement("foo", &set)
+	assert.Equal(t, &set, StatementCounterSet("foo"))
+	ctx = WithStatementID(context.Background(), "foo")
+	Update(ctx, func(c *CounterSet) {
+		c.FileService.S3.Get.Add(1)
+	})
+	assert.Equal(t, int64(1), set.FileService.S3.Get.Load())
+
+	UnregisterStatement("foo")
+	assert.Nil(t, StatementCounterSet("foo"))
+	assert.Equal(t, "", StatementIDFromContext(context.Background()))
+}
+
+func TestEncodeFileServiceCounters(t *testing.T) {
+	var remote CounterSet
+	remote.FileService.S3.Get.Add(2)
+	remote.FileService.S3.ReadBytes.Add(100)
+	remote.FileService.Cache.Disk.Hit.Add(3)
+	data := EncodeFileServiceCounters(&remote)
+
+	var local CounterSet
+	local.FileService.S3.Get.Add(1)
+	AddEncodedFileServiceCounters(&local, data)
+	assert.Equal(t, int64(3), local.FileService.S3.Get.Load())
+	assert.Equal(t, int64(100), local.FileService.S3.ReadBytes.Load())
+	assert.Equal(t, int64(3), local.FileService.Cache.Disk.Hit.Load())
+
+	// counters missing from an older peer are ignored
+	AddEncodedFileServiceCounters(&local, data[:8*4])
+	assert.Equal(t, int64(5), local.FileService.S3.Get.Load())
+	assert.Equal(t, int64(100), local.FileService.S3.ReadBytes.Load())
+}
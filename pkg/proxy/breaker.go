@@ -0,0 +1,179 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/log"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"go.uber.org/zap"
+)
+
+// cnHealth is the health state of a CN server.
+type cnHealth struct {
+	// failures is the number of consecutive failures.
+	failures int
+	// openUntil is the time until which the CN server is not routed to.
+	openUntil time.Time
+}
+
+// probeFunc checks the health of the CN server.
+type probeFunc func(cn *CNServer, timeout time.Duration) error
+
+// circuitBreaker stops routing to the CN servers which fail the health
+// checks or fail to be connected for some consecutive times. After the open
+// timeout, the CN server is routed to again, and it is opened again by the
+// next failure. A passed health check closes the breaker at once.
+type circuitBreaker struct {
+	logger           *log.MOLogger
+	failureThreshold int
+	openTimeout      time.Duration
+	// probe checks the health of CN servers, it is replaced in tests.
+	probe probeFunc
+	mu    struct {
+		sync.Mutex
+		// cns are the CN servers which failed, the key is the UUID.
+		cns map[string]*cnHealth
+	}
+}
+
+// newCircuitBreaker creates a new circuit breaker.
+func newCircuitBreaker(logger *log.MOLogger, failureThreshold int, openTimeout time.Duration) *circuitBreaker {
+	b := &circuitBreaker{
+		logger:           logger,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		probe:            probeCNServer,
+	}
+	b.mu.cns = make(map[string]*cnHealth)
+	return b
+}
+
+// available returns true if the CN server could be routed to.
+func (b *circuitBreaker) available(uuid string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	h, ok := b.mu.cns[uuid]
+	return !ok || !time.Now().Before(h.openUntil)
+}
+
+// onSuccess resets the state of the CN server when it is connected.
+func (b *circuitBreaker) onSuccess(uuid string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.mu.cns, uuid)
+}
+
+// onFailure records the failure of the CN server, and opens the circuit
+// breaker if the failures reach the threshold.
+func (b *circuitBreaker) onFailure(uuid string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	h, ok := b.mu.cns[uuid]
+	if !ok {
+		h = &cnHealth{}
+		b.mu.cns[uuid] = h
+	}
+	h.failures++
+	if h.failures >= b.failureThreshold {
+		h.openUntil = time.Now().Add(b.openTimeout)
+		b.logger.Warn("circuit breaker of CN server is open",
+			zap.String("uuid", uuid),
+			zap.Int("failures", h.failures),
+			zap.Duration("open timeout", b.openTimeout),
+		)
+	}
+}
+
+// probeCNServer connects to the CN server and reads its handshake packet, the
+// CN server is healthy if the handshake packet is received in time.
+func probeCNServer(cn *CNServer, timeout time.Duration) error {
+	salt := make([]byte, 20)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	c, err := (&CNServer{addr: cn.addr, salt: salt}).Connect()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+	_, err = c.Read(goetty.ReadOptions{Timeout: timeout})
+	return err
+}
+
+// run checks the health of all the CN servers in the cluster periodically.
+func (b *circuitBreaker) run(ctx context.Context, mc clusterservice.MOCluster,
+	interval, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.checkHealth(mc, timeout)
+		case <-ctx.Done():
+			b.logger.Info("circuit breaker health checker ended")
+			return
+		}
+	}
+}
+
+// checkHealth probes all the CN servers concurrently. A failed probe counts
+// as a failure of the CN server, and a passed one resets its state. The
+// states of the CN servers which are not in the cluster are removed.
+func (b *circuitBreaker) checkHealth(mc clusterservice.MOCluster, timeout time.Duration) {
+	var cns []*CNServer
+	mc.GetCNService(clusterservice.NewSelector(), func(s metadata.CNService) bool {
+		cns = append(cns, &CNServer{uuid: s.ServiceID, addr: s.SQLAddress})
+		return true
+	})
+	var wg sync.WaitGroup
+	for _, cn := range cns {
+		wg.Add(1)
+		go func(cn *CNServer) {
+			defer wg.Done()
+			if err := b.probe(cn, timeout); err != nil {
+				b.logger.Debug("health check of CN server failed",
+					zap.String("uuid", cn.uuid), zap.Error(err))
+				b.onFailure(cn.uuid)
+				return
+			}
+			b.onSuccess(cn.uuid)
+		}(cn)
+	}
+	wg.Wait()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for uuid := range b.mu.cns {
+		found := false
+		for _, cn := range cns {
+			if cn.uuid == uuid {
+				found = true
+				break
+			}
+		}
+		if !found {
+			delete(b.mu.cns, uuid)
+		}
+	}
+}
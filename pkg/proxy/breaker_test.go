@@ -0,0 +1,144 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lni/goutils/leaktest"
+	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	b := newCircuitBreaker(runtime.DefaultRuntime().Logger(), 2, time.Hour)
+	require.True(t, b.available("cn1"))
+
+	// The breaker is opened after the consecutive failures.
+	b.onFailure("cn1")
+	require.True(t, b.available("cn1"))
+	b.onFailure("cn1")
+	require.False(t, b.available("cn1"))
+	require.True(t, b.available("cn2"))
+
+	// The success resets the failures.
+	b.onSuccess("cn1")
+	require.True(t, b.available("cn1"))
+	b.onFailure("cn1")
+	require.True(t, b.available("cn1"))
+
+	// The CN server is available after the open timeout, and the next
+	// failure opens the breaker again.
+	b.openTimeout = time.Millisecond
+	b.onFailure("cn1")
+	time.Sleep(time.Millisecond * 10)
+	require.True(t, b.available("cn1"))
+	b.openTimeout = time.Hour
+	b.onFailure("cn1")
+	require.False(t, b.available("cn1"))
+}
+
+func TestCircuitBreakerHealthCheck(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	hc := &mockHAKeeperClient{}
+	hc.updateCN("cn1", "", map[string]metadata.LabelList{})
+	hc.updateCN("cn2", "", map[string]metadata.LabelList{})
+	mc := clusterservice.NewMOCluster(hc, 3*time.Second)
+	defer mc.Close()
+	mc.ForceRefresh()
+	time.Sleep(time.Millisecond * 200)
+
+	healthy := map[string]bool{"cn1": true, "cn2": false}
+	b := newCircuitBreaker(runtime.DefaultRuntime().Logger(), 2, time.Hour)
+	b.probe = func(cn *CNServer, timeout time.Duration) error {
+		if healthy[cn.uuid] {
+			return nil
+		}
+		return moerr.NewInternalErrorNoCtx("unhealthy")
+	}
+
+	// The failed health checks open the breaker.
+	b.checkHealth(mc, time.Second)
+	require.True(t, b.available("cn2"))
+	b.checkHealth(mc, time.Second)
+	require.True(t, b.available("cn1"))
+	require.False(t, b.available("cn2"))
+
+	// A passed health check closes the breaker at once.
+	healthy["cn2"] = true
+	b.checkHealth(mc, time.Second)
+	require.True(t, b.available("cn2"))
+
+	// The states of the CN servers out of the cluster are removed.
+	b.onFailure("cn3")
+	b.checkHealth(mc, time.Second)
+	b.mu.Lock()
+	require.Equal(t, 0, len(b.mu.cns))
+	b.mu.Unlock()
+}
+
+func TestProbeCNServer(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() {
+		_ = l.Close()
+	}()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer func() {
+			_ = c.Close()
+		}()
+		// Read the salt and send a handshake packet.
+		buf := make([]byte, 20)
+		_, _ = c.Read(buf)
+		_, _ = c.Write([]byte{1, 0, 0, 0, 10})
+		_, _ = c.Read(buf)
+	}()
+	require.NoError(t, probeCNServer(&CNServer{addr: l.Addr().String()}, time.Second))
+	<-done
+
+	// The CN server does not send the handshake packet.
+	l2, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() {
+		_ = l2.Close()
+	}()
+	conns := make(chan net.Conn, 1)
+	go func() {
+		c, err := l2.Accept()
+		if err == nil {
+			conns <- c
+		}
+	}()
+	err = probeCNServer(&CNServer{addr: l2.Addr().String()}, time.Millisecond*100)
+	require.Error(t, err)
+	c := <-conns
+	_ = c.Close()
+}
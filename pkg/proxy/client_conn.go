@@ -16,9 +16,13 @@ package proxy
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
 	"net"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/fagongzi/goetty/v2"
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
@@ -32,6 +36,9 @@ import (
 // clientBaseConnID is the base connection ID for client.
 var clientBaseConnID uint32 = 1000
 
+// defaultTLSHandshakeTimeout is the timeout of TLS handshake with clients.
+var defaultTLSHandshakeTimeout = 20 * time.Second
+
 // accountInfo contains username and tenant. It is parsed from
 // user login information.
 type accountInfo struct {
@@ -74,8 +81,9 @@ type ClientConn interface {
 	RawConn() net.Conn
 	// GetTenant returns the tenant which this connection belongs to.
 	GetTenant() Tenant
-	// SendErrToClient sends access error to MySQL client.
-	SendErrToClient(errMsg string)
+	// SendErrToClient sends the error to MySQL client. The error code is
+	// access denied unless the error is a limit error.
+	SendErrToClient(err error)
 	// BuildConnWithServer selects a CN server and connects to it, then
 	// returns the connection. If sendToClient is true, means that the
 	// packet received from CN server should be sent to client because
@@ -86,6 +94,29 @@ type ClientConn interface {
 	// finished already.
 	// If handshake is false, ignore the handshake phase.
 	BuildConnWithServer(handshake bool) (ServerConn, error)
+	// AcquireServerConn takes a server connection from the pool, or selects
+	// a CN server and connects to it if there is no one in the pool, then
+	// restores the session in the server connection. It is used when the
+	// client sends a command after it released the server connection.
+	AcquireServerConn() (ServerConn, error)
+	// RouteQuery returns the routing rule matched by the query, and whether
+	// it is different from the current one. A nil rule means the query is
+	// routed by the labels of the connection.
+	RouteQuery(query []byte) (*RoutingRule, bool)
+	// SetRoute sets the current routing rule, which decides the labels of
+	// CN servers in AcquireServerConn.
+	SetRoute(rule *RoutingRule)
+	// RecordQuery records the command sent to the server connection, query
+	// is nil if it is not a COM_QUERY. After a write, the queries are not
+	// routed to the CN servers which may not see it yet.
+	RecordQuery(query []byte)
+	// WaitQuery waits until the query is allowed by the limits of queries
+	// per second of the account and the user.
+	WaitQuery(ctx context.Context) error
+	// ReleaseServerConn resets the session in the server connection and puts
+	// it into the pool, or closes it if it cannot be reused. It is used when
+	// the client is idle in the pooling mode.
+	ReleaseServerConn(sc ServerConn)
 	// HandleEvent handles event that comes from tunnel data flow.
 	HandleEvent(ctx context.Context, e IEvent, resp chan<- []byte) error
 	// Close closes the client connection.
@@ -105,6 +136,14 @@ type clientConn struct {
 	// handshakePack is a cached info, used in connection migration.
 	// When connection is transferred, we use it to rebuild handshake.
 	handshakePack *frontend.Packet
+	// authSwitchResp is the response of client to the auth switch request
+	// of CN server, and fullAuthResp is the password in clear text sent by
+	// client in the full authentication of caching_sha2_password. They are
+	// replayed when connecting to other CN servers without the client. The
+	// password encrypted by the RSA public key is not kept, as it is bound
+	// to the key of the CN server.
+	authSwitchResp *frontend.Packet
+	fullAuthResp   *frontend.Packet
 	// connID records the connection ID.
 	connID uint32
 	// account is parsed from login information.
@@ -123,6 +162,32 @@ type clientConn struct {
 	// setVarStmts keeps all set user variable statements. When connection
 	// is transferred, set all these variables first.
 	setVarStmts []string
+	// tlsConfig is the TLS config for clients, nil if TLS is not enabled.
+	tlsConfig *tls.Config
+	// sniDomain is the domain of the server names to route clients by.
+	sniDomain string
+	// tlsState is the TLS connection state if the client connects with TLS.
+	tlsState *tls.ConnectionState
+	// pool keeps the idle server connections, nil if pooling is not enabled.
+	pool *serverConnPool
+	// database is the current database changed by the client after login,
+	// empty if it is not changed.
+	database string
+	// routing routes the queries by the rules, nil if there is no rule.
+	routing *routingRules
+	// route is the current routing rule, nil if the queries are routed by
+	// the labels of the connection.
+	route *RoutingRule
+	// writeSent means a command which may write is sent to the server
+	// connection, and it is not known to be done yet.
+	writeSent bool
+	// lastWrite is the time when the last write is known to be done, which
+	// is later than its commit.
+	lastWrite time.Time
+	// limiter limits the connections and queries, nil if there is no limit.
+	limiter *limiter
+	// releaseLimit releases the connection from the limiter.
+	releaseLimit func()
 	// testHelper is used for testing.
 	testHelper struct {
 		connectToBackend func() (ServerConn, error)
@@ -131,6 +196,39 @@ type clientConn struct {
 
 var _ ClientConn = (*clientConn)(nil)
 
+// clientConnOption defines the function to set options of client connection.
+type clientConnOption func(*clientConn)
+
+// withClientTLS sets the TLS config for the client, and the domain of the
+// server names to route clients by.
+func withClientTLS(cfg *tls.Config, sniDomain string) clientConnOption {
+	return func(c *clientConn) {
+		c.tlsConfig = cfg
+		c.sniDomain = sniDomain
+	}
+}
+
+// withServerConnPool sets the pool of server connections.
+func withServerConnPool(pool *serverConnPool) clientConnOption {
+	return func(c *clientConn) {
+		c.pool = pool
+	}
+}
+
+// withRoutingRules sets the rules to route queries.
+func withRoutingRules(rules *routingRules) clientConnOption {
+	return func(c *clientConn) {
+		c.routing = rules
+	}
+}
+
+// withLimiter sets the limiter of connections and queries.
+func withLimiter(l *limiter) clientConnOption {
+	return func(c *clientConn) {
+		c.limiter = l
+	}
+}
+
 // newClientConn creates a new client connection.
 func newClientConn(
 	ctx context.Context,
@@ -140,6 +238,7 @@ func newClientConn(
 	mc clusterservice.MOCluster,
 	router Router,
 	tun *tunnel,
+	opts ...clientConnOption,
 ) (ClientConn, error) {
 	var originIP net.IP
 	host, _, err := net.SplitHostPort(conn.RemoteAddress())
@@ -157,8 +256,13 @@ func newClientConn(
 		tun:        tun,
 		originIP:   originIP,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
 	fp := config.FrontendParameters{}
 	fp.SetDefaultValues()
+	// The proxy asks the client to use TLS in the initial handshake.
+	fp.EnableTls = c.tlsConfig != nil
 	c.mysqlProto = frontend.NewMysqlClientProtocol(c.connID, c.conn, 0, &fp)
 	return c, nil
 }
@@ -195,9 +299,14 @@ func (c *clientConn) GetTenant() Tenant {
 }
 
 // SendErrToClient implements the ClientConn interface.
-func (c *clientConn) SendErrToClient(errMsg string) {
-	accErr := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
-	p := c.mysqlProto.MakeErrPayload(accErr.ErrorCode, accErr.SqlStates[0], errMsg)
+func (c *clientConn) SendErrToClient(err error) {
+	var p []byte
+	if le := (*limitError)(nil); errors.As(err, &le) {
+		p = c.mysqlProto.MakeErrPayload(le.code, le.state, le.msg)
+	} else {
+		accErr := moerr.MysqlErrorMsgRefer[moerr.ER_ACCESS_DENIED_ERROR]
+		p = c.mysqlProto.MakeErrPayload(accErr.ErrorCode, accErr.SqlStates[0], err.Error())
+	}
 	if err := c.mysqlProto.WritePacket(p); err != nil {
 		c.log.Error("failed to send access error to client", zap.Error(err))
 	}
@@ -217,6 +326,16 @@ func (c *clientConn) BuildConnWithServer(handshake bool) (ServerConn, error) {
 			c.log.Error("failed to handle Handshake response", zap.Error(err))
 			return nil, err
 		}
+		// Check the limits of the account before connecting to CN servers.
+		if c.limiter != nil {
+			release, err := c.limiter.acquireConn(c.account)
+			if err != nil {
+				c.counterSet.connRefused.Add(1)
+				c.log.Info("connection is refused by limits", zap.Error(err))
+				return nil, err
+			}
+			c.releaseLimit = release
+		}
 	}
 	// Step 3, proxy connects to a CN server to build connection.
 	conn, err := c.connectToBackend(handshake)
@@ -234,6 +353,12 @@ func (c *clientConn) HandleEvent(ctx context.Context, e IEvent, resp chan<- []by
 		return c.handleKillQuery(ev, resp)
 	case *setVarEvent:
 		return c.handleSetVar(ev)
+	case *changeUserEvent:
+		return c.handleChangeUser(ev)
+	case *resetConnectionEvent:
+		return c.handleResetConnection(ev)
+	case *initDBEvent:
+		return c.handleInitDB(ev)
 	default:
 	}
 	return nil
@@ -270,6 +395,11 @@ func (c *clientConn) handleKillQuery(e *killQueryEvent, resp chan<- []byte) erro
 	}
 	defer func() { _ = sc.Close() }()
 
+	if r, err = c.replayAuth(sc, r); err != nil {
+		c.log.Error("failed to authenticate to backend server", zap.Error(err))
+		sendErr(err.Error())
+		return err
+	}
 	if !isOKPacket(r) {
 		c.log.Error("failed to connect to cn to handle kill query event",
 			zap.String("query", e.stmt), zap.String("error", string(r)))
@@ -291,11 +421,213 @@ func (c *clientConn) handleSetVar(e *setVarEvent) error {
 	return nil
 }
 
+// handleChangeUser handles the change user event. The variables are reset
+// by the server, and the cached handshake response is rebuilt with the new
+// user, so that the connection is migrated as the new user.
+func (c *clientConn) handleChangeUser(e *changeUserEvent) error {
+	c.setVarStmts = nil
+	c.database = ""
+	c.authSwitchResp = nil
+	c.fullAuthResp = nil
+	pack, _, err := makeChangeUserHandshakeResp(c.handshakePack, e.payload)
+	if err != nil {
+		c.log.Error("failed to rebuild handshake response of change user", zap.Error(err))
+		return err
+	}
+	pack, account, err := c.prepareHandshakeResp(pack)
+	if err != nil {
+		return err
+	}
+	c.handshakePack = pack
+	c.account = account
+	c.labelInfo = newLabelInfo(c.account.tenant, c.mysqlProto.GetConnectAttrs())
+	return nil
+}
+
+// handleResetConnection handles the reset connection event.
+func (c *clientConn) handleResetConnection(_ *resetConnectionEvent) error {
+	c.setVarStmts = nil
+	return nil
+}
+
+// handleInitDB handles the init database event.
+func (c *clientConn) handleInitDB(e *initDBEvent) error {
+	c.database = e.db
+	return nil
+}
+
+// AcquireServerConn implements the ClientConn interface.
+func (c *clientConn) AcquireServerConn() (ServerConn, error) {
+	if key, ok := c.poolKey(); ok && c.pool != nil {
+		for sc := c.pool.get(key); sc != nil; sc = c.pool.get(key) {
+			sc.Attach(c.tun)
+			if err := c.restoreSession(sc, key.database); err != nil {
+				c.log.Warn("failed to restore session in pooled connection", zap.Error(err))
+				_ = sc.RawConn().Close()
+				continue
+			}
+			c.counterSet.connPoolReused.Add(1)
+			return sc, nil
+		}
+	}
+	return c.BuildConnWithServer(false)
+}
+
+// ReleaseServerConn implements the ClientConn interface.
+func (c *clientConn) ReleaseServerConn(sc ServerConn) {
+	key, ok := c.poolKey()
+	if !ok || c.pool == nil {
+		_ = sc.RawConn().Close()
+		return
+	}
+	if err := sc.ResetSession(); err != nil {
+		c.log.Warn("failed to reset session of server connection", zap.Error(err))
+		_ = sc.RawConn().Close()
+		return
+	}
+	// Un-track the connection, it is tracked again when it is acquired.
+	_ = sc.Close()
+	c.pool.put(key, sc)
+	c.counterSet.connPoolReleased.Add(1)
+}
+
+// RouteQuery implements the ClientConn interface.
+func (c *clientConn) RouteQuery(query []byte) (*RoutingRule, bool) {
+	if c.routing == nil || query == nil {
+		return c.route, false
+	}
+	// The client sends the query after it receives the response of the
+	// previous command, so the previous write is done.
+	if c.writeSent {
+		c.writeSent = false
+		c.lastWrite = time.Now()
+	}
+	rule := c.routing.match(query, c.account)
+	if rule == c.route {
+		return rule, false
+	}
+	// Read your writes: the session sticks to the CN server of the write
+	// until the snapshots of the CN servers of the rule cover its commit.
+	if !c.lastWrite.IsZero() {
+		if lag := c.routing.lagOf(rule); lag > 0 &&
+			time.Since(c.lastWrite) < lag+maxCommitClockOffset {
+			return c.route, false
+		}
+	}
+	return rule, true
+}
+
+// SetRoute implements the ClientConn interface.
+func (c *clientConn) SetRoute(rule *RoutingRule) {
+	c.route = rule
+}
+
+// RecordQuery implements the ClientConn interface.
+func (c *clientConn) RecordQuery(query []byte) {
+	if c.routing != nil && isWriteQuery(query) {
+		c.writeSent = true
+	}
+}
+
+// routeLabelInfo returns the labels to select CN servers, which are the
+// labels of the connection and the ones of the current routing rule.
+func (c *clientConn) routeLabelInfo() labelInfo {
+	if c.route == nil {
+		return c.labelInfo
+	}
+	labels := make(map[string]string, len(c.labelInfo.Labels)+len(c.route.Labels))
+	for k, v := range c.labelInfo.Labels {
+		labels[k] = v
+	}
+	for k, v := range c.route.Labels {
+		labels[k] = v
+	}
+	return labelInfo{
+		Tenant: c.labelInfo.Tenant,
+		Labels: labels,
+	}
+}
+
+// poolKey returns the key of server connections in the pool, which could
+// be used by this client connection. The second return value is false if
+// the handshake response cannot be parsed, such as the 320 one.
+func (c *clientConn) poolKey() (poolKey, bool) {
+	resp, ok := c.parsedHandshakeResp()
+	if !ok {
+		return poolKey{}, false
+	}
+	label := c.routeLabelInfo()
+	hash, err := label.getHash()
+	if err != nil {
+		return poolKey{}, false
+	}
+	key := poolKey{
+		hash:         hash,
+		username:     string(resp.username),
+		database:     string(resp.database),
+		capabilities: resp.capabilities,
+		charset:      resp.charset,
+	}
+	if len(c.database) > 0 {
+		key.database = c.database
+	}
+	return key, true
+}
+
+// parsedHandshakeResp parses the cached handshake response. The second
+// return value is false if it is not a handshake response 41.
+func (c *clientConn) parsedHandshakeResp() (*handshakeResp41, bool) {
+	pack := c.handshakePack
+	if pack == nil || len(pack.Payload) < 4 ||
+		binary.LittleEndian.Uint32(pack.Payload)&frontend.CLIENT_PROTOCOL_41 == 0 {
+		return nil, false
+	}
+	resp, err := parseHandshakeResp41(pack.Payload)
+	if err != nil {
+		return nil, false
+	}
+	return resp, true
+}
+
+// restoreSession restores the session in the server connection, whose
+// current database is db.
+func (c *clientConn) restoreSession(sc ServerConn, db string) error {
+	// Set the label session variable.
+	label := c.routeLabelInfo()
+	if err := sc.ExecStmt(label.genSetVarStmt(), nil); err != nil {
+		return err
+	}
+	// Set the use defined variables, including session variables and user variables.
+	for _, stmt := range c.setVarStmts {
+		if err := sc.ExecStmt(stmt, nil); err != nil {
+			return err
+		}
+	}
+	// Change the current database if the client changed it after login.
+	if len(c.database) > 0 && c.database != db {
+		if err := sc.ExecStmt("use `"+strings.ReplaceAll(c.database, "`", "``")+"`", nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Close implements the ClientConn interface.
 func (c *clientConn) Close() error {
+	if c.releaseLimit != nil {
+		c.releaseLimit()
+	}
 	return nil
 }
 
+// WaitQuery implements the ClientConn interface.
+func (c *clientConn) WaitQuery(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+	return c.limiter.waitQuery(ctx, c.account)
+}
+
 // connectToBackend connect to the real CN server.
 func (c *clientConn) connectToBackend(sendToClient bool) (ServerConn, error) {
 	// Testing path.
@@ -310,7 +642,7 @@ func (c *clientConn) connectToBackend(sendToClient bool) (ServerConn, error) {
 	// Select the best CN server from backend.
 	//
 	// NB: The selected CNServer must have label hash in it.
-	cn, err := c.router.SelectByLabel(c.labelInfo)
+	cn, err := c.router.SelectByLabel(c.routeLabelInfo())
 	if err != nil {
 		return nil, err
 	}
@@ -321,27 +653,93 @@ func (c *clientConn) connectToBackend(sendToClient bool) (ServerConn, error) {
 		return nil, err
 	}
 	if sendToClient {
-		// r is the packet received from CN server, send r to client.
-		if err := c.mysqlProto.WritePacket(r[4:]); err != nil {
-			return nil, err
-		}
+		r, err = c.relayAuth(sc, r)
+	} else {
+		r, err = c.replayAuth(sc, r)
+	}
+	if err != nil {
+		_ = sc.Close()
+		return nil, err
 	}
 	if !isOKPacket(r) {
+		_ = sc.Close()
 		return nil, withCode(moerr.NewInternalErrorNoCtx("access error"),
 			codeAuthFailed)
 	}
+	// The packets after the OK packet of the authentication are compressed
+	// if the client asked for it.
+	if sendToClient {
+		c.mysqlProto.EnableCompression()
+	}
 
-	// Set the label session variable.
-	if err := sc.ExecStmt(c.labelInfo.genSetVarStmt(), nil); err != nil {
+	var db string
+	if resp, ok := c.parsedHandshakeResp(); ok {
+		db = string(resp.database)
+	}
+	if err := c.restoreSession(sc, db); err != nil {
 		return nil, err
 	}
-	// Set the use defined variables, including session variables and user variables.
-	for _, stmt := range c.setVarStmts {
-		if err := sc.ExecStmt(stmt, nil); err != nil {
+	return sc, nil
+}
+
+// relayAuth relays the authentication between the client and CN server until
+// CN server sends the OK or error packet, which is returned. r is the first
+// packet received from CN server after the handshake response.
+func (c *clientConn) relayAuth(sc ServerConn, r []byte) ([]byte, error) {
+	for {
+		// r is the packet received from CN server, send r to client.
+		if err := c.mysqlProto.WritePacket(r[4:]); err != nil {
+			return nil, err
+		}
+		if !isAuthSwitchPacket(r) && !isAuthMoreDataPacket(r) {
+			return r, nil
+		}
+		var authData *frontend.Packet
+		if !isFastAuthSuccessPacket(r) {
+			pack, err := c.readPacket()
+			if err != nil {
+				return nil, err
+			}
+			c.mysqlProto.AddSequenceId(1)
+			if isAuthSwitchPacket(r) {
+				c.authSwitchResp = pack
+			} else if isFullAuthPacket(r) && c.tlsState != nil &&
+				!(len(pack.Payload) == 1 && pack.Payload[0] == sha2RequestPublicKey) {
+				c.fullAuthResp = pack
+			}
+			authData = pack
+		}
+		resp, err := sc.HandleAuth(authData)
+		if err != nil {
 			return nil, err
 		}
+		r = packetToBytes(resp)
 	}
-	return sc, nil
+}
+
+// replayAuth finishes the authentication with CN server without the client,
+// by the responses of client kept in relayAuth. It returns the OK or error
+// packet from CN server.
+func (c *clientConn) replayAuth(sc ServerConn, r []byte) ([]byte, error) {
+	for isAuthSwitchPacket(r) || isAuthMoreDataPacket(r) {
+		var authData *frontend.Packet
+		switch {
+		case isFastAuthSuccessPacket(r):
+		case isAuthSwitchPacket(r) && c.authSwitchResp != nil:
+			authData = c.authSwitchResp
+		case isFullAuthPacket(r) && c.fullAuthResp != nil:
+			authData = c.fullAuthResp
+		default:
+			return nil, withCode(moerr.NewInternalErrorNoCtx(
+				"cannot authenticate to CN server without client"), codeAuthFailed)
+		}
+		resp, err := sc.HandleAuth(authData)
+		if err != nil {
+			return nil, err
+		}
+		r = packetToBytes(resp)
+	}
+	return r, nil
 }
 
 // readPacket reads MySQL packets from clients. It is mainly used in
@@ -17,6 +17,7 @@ package proxy
 import (
 	"context"
 	"encoding/binary"
+	"errors"
 	"net"
 	"sync"
 	"testing"
@@ -28,6 +29,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
 	"github.com/stretchr/testify/require"
 )
 
@@ -122,7 +124,7 @@ func (c *mockClientConn) GetSalt() []byte                    { return nil }
 func (c *mockClientConn) GetHandshakePack() *frontend.Packet { return nil }
 func (c *mockClientConn) RawConn() net.Conn                  { return c.conn }
 func (c *mockClientConn) GetTenant() Tenant                  { return c.tenant }
-func (c *mockClientConn) SendErrToClient(string)             {}
+func (c *mockClientConn) SendErrToClient(error)              {}
 func (c *mockClientConn) BuildConnWithServer(_ bool) (ServerConn, error) {
 	cn, err := c.router.SelectByLabel(c.labelInfo)
 	if err != nil {
@@ -145,6 +147,16 @@ func (c *mockClientConn) BuildConnWithServer(_ bool) (ServerConn, error) {
 	}
 	return sc, nil
 }
+func (c *mockClientConn) AcquireServerConn() (ServerConn, error) {
+	return c.BuildConnWithServer(false)
+}
+func (c *mockClientConn) RouteQuery(_ []byte) (*RoutingRule, bool) { return nil, false }
+func (c *mockClientConn) SetRoute(_ *RoutingRule)                  {}
+func (c *mockClientConn) RecordQuery(_ []byte)                     {}
+func (c *mockClientConn) WaitQuery(_ context.Context) error        { return nil }
+func (c *mockClientConn) ReleaseServerConn(sc ServerConn) {
+	_ = sc.RawConn().Close()
+}
 func (c *mockClientConn) HandleEvent(ctx context.Context, e IEvent, resp chan<- []byte) error {
 	switch ev := e.(type) {
 	case *killQueryEvent:
@@ -498,6 +510,281 @@ func TestClientConn_SendErrToClient(t *testing.T) {
 	require.Error(t, err) // just test client, no router set
 	require.Equal(t, "tenant1", string(cc.GetTenant()))
 	require.NotNil(t, cc.GetHandshakePack())
-	cc.SendErrToClient("err msg1")
+	cc.SendErrToClient(errors.New("err msg1"))
+	wg.Wait()
+}
+
+func TestDisableCompression(t *testing.T) {
+	payload := make([]byte, 8)
+	binary.LittleEndian.PutUint32(payload, frontend.CLIENT_PROTOCOL_41|
+		frontend.CLIENT_COMPRESS|frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM)
+	pack := &frontend.Packet{Length: 8, SequenceID: 1, Payload: payload}
+	p := disableCompression(pack)
+	require.Equal(t, frontend.CLIENT_PROTOCOL_41, binary.LittleEndian.Uint32(p.Payload))
+	// the cached packet is not changed
+	require.NotEqual(t, frontend.CLIENT_PROTOCOL_41, binary.LittleEndian.Uint32(pack.Payload))
+	require.Equal(t, p, disableCompression(p))
+
+	// handshake response 320
+	binary.LittleEndian.PutUint16(payload, uint16(frontend.CLIENT_COMPRESS|frontend.CLIENT_LONG_PASSWORD))
+	p = disableCompression(pack)
+	require.Equal(t, uint16(frontend.CLIENT_LONG_PASSWORD), binary.LittleEndian.Uint16(p.Payload))
+	require.Equal(t, payload[2:], p.Payload[2:])
+}
+
+func TestClientConn_HandleChangeUser(t *testing.T) {
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c := cc.(*clientConn)
+	data := makeClientHandshakeResp()
+	c.handshakePack = &frontend.Packet{Length: int32(len(data) - 4), SequenceID: 1, Payload: data[4:]}
+	c.setVarStmts = []string{"set @a=1"}
+
+	auth := make([]byte, 20)
+	for i := range auth {
+		auth[i] = byte(i + 1)
+	}
+	payload := append([]byte("tenant2:user2"), 0)
+	payload = append(payload, byte(len(auth)))
+	payload = append(payload, auth...)
+	payload = append(payload, "db2"...)
+	payload = append(payload, 0, 46, 0)
+	payload = append(payload, "mysql_native_password"...)
+	payload = append(payload, 0)
+	require.NoError(t, cc.HandleEvent(context.TODO(), makeChangeUserEvent(payload), nil))
+	require.Nil(t, c.setVarStmts)
+	require.Equal(t, Tenant("tenant2"), cc.GetTenant())
+	require.Equal(t, "user2", c.account.username)
+
+	// the CN server reads the new user from the handshake response
+	pack := cc.GetHandshakePack()
+	require.Equal(t, int(pack.Length), len(pack.Payload))
+	_, err := c.mysqlProto.HandleHandshake(context.TODO(), pack.Payload)
+	require.NoError(t, err)
+	require.Equal(t, "tenant2:user2", c.mysqlProto.GetUserName())
+	require.Equal(t, "db2", c.mysqlProto.GetDatabaseName())
+
+	// the invalid packet does not change the handshake response
+	require.Error(t, cc.HandleEvent(context.TODO(), makeChangeUserEvent([]byte("tenant3")), nil))
+	require.Equal(t, pack, cc.GetHandshakePack())
+
+	c.setVarStmts = []string{"set @a=1"}
+	require.NoError(t, cc.HandleEvent(context.TODO(), makeResetConnectionEvent(), nil))
+	require.Nil(t, c.setVarStmts)
+}
+
+func TestClientConn_RouteQuery(t *testing.T) {
+	cc := &clientConn{
+		account: accountInfo{tenant: "t1", username: "u1"},
+		labelInfo: labelInfo{
+			Tenant: "t1",
+			Labels: map[string]string{"k1": "v1"},
+		},
+	}
+	// No routing rules.
+	rule, changed := cc.RouteQuery([]byte("select 1"))
+	require.Nil(t, rule)
+	require.False(t, changed)
+	require.Equal(t, cc.labelInfo, cc.routeLabelInfo())
+
+	routing, err := newRoutingRules([]RoutingRule{
+		{Name: "read", StmtType: stmtTypeSelect, Labels: map[string]string{"role": "read"}},
+	}, 0)
+	require.NoError(t, err)
+	cc.routing = routing
+	rule, changed = cc.RouteQuery([]byte("select 1"))
+	require.NotNil(t, rule)
+	require.True(t, changed)
+	cc.SetRoute(rule)
+	require.Equal(t, labelInfo{
+		Tenant: "t1",
+		Labels: map[string]string{"k1": "v1", "role": "read"},
+	}, cc.routeLabelInfo())
+
+	// The same rule does not change the route.
+	_, changed = cc.RouteQuery([]byte("show tables"))
+	require.False(t, changed)
+	// No command to route.
+	_, changed = cc.RouteQuery(nil)
+	require.False(t, changed)
+	// Back to the default labels.
+	rule, changed = cc.RouteQuery([]byte("begin"))
+	require.Nil(t, rule)
+	require.True(t, changed)
+	cc.SetRoute(rule)
+	require.Equal(t, cc.labelInfo, cc.routeLabelInfo())
+}
+
+func TestClientConn_RouteQueryAfterWrite(t *testing.T) {
+	cc := &clientConn{
+		account: accountInfo{tenant: "t1", username: "u1"},
+	}
+	routing, err := newRoutingRules([]RoutingRule{
+		{Name: "fresh", Hint: "fresh", Labels: map[string]string{"role": "fresh"}},
+		{Name: "read", StmtType: stmtTypeSelect, Labels: map[string]string{"role": "read"},
+			Lag: toml.Duration{Duration: time.Hour}},
+	}, 0)
+	require.NoError(t, err)
+	cc.routing = routing
+
+	// Reads are routed before any write.
+	rule, changed := cc.RouteQuery([]byte("select 1"))
+	require.True(t, changed)
+	require.Equal(t, "read", rule.Name)
+	cc.SetRoute(rule)
+	cc.RecordQuery([]byte("select 1"))
+	require.False(t, cc.writeSent)
+
+	// The write goes to the default CN servers.
+	rule, changed = cc.RouteQuery([]byte("insert into t values (1)"))
+	require.True(t, changed)
+	cc.SetRoute(rule)
+	cc.RecordQuery([]byte("insert into t values (1)"))
+	require.True(t, cc.writeSent)
+
+	// The CN servers of the rule lag behind, the read sticks to the writer.
+	rule, changed = cc.RouteQuery([]byte("select 1"))
+	require.Nil(t, rule)
+	require.False(t, changed)
+	require.False(t, cc.writeSent)
+	require.False(t, cc.lastWrite.IsZero())
+	// The CN servers without lag see the write.
+	rule, changed = cc.RouteQuery([]byte("select /*+ cn_label(fresh) */ 1"))
+	require.True(t, changed)
+	require.Equal(t, "fresh", rule.Name)
+
+	// The write is older than the lag.
+	cc.lastWrite = time.Now().Add(-time.Hour - maxCommitClockOffset)
+	rule, changed = cc.RouteQuery([]byte("select 1"))
+	require.True(t, changed)
+	require.Equal(t, "read", rule.Name)
+
+	// Other commands are taken as writes.
+	cc.RecordQuery(nil)
+	require.True(t, cc.writeSent)
+}
+
+func TestClientConn_RefusedByLimiter(t *testing.T) {
+	local, remote := net.Pipe()
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c, ok := cc.(*clientConn)
+	require.True(t, ok)
+	c.conn.UseConn(local)
+	l, err := newLimiter([]LimitRule{{Account: "tenant1", MaxConns: 1}})
+	require.NoError(t, err)
+	release, err := l.acquireConn(accountInfo{tenant: "tenant1", username: "user1"})
+	require.NoError(t, err)
+	defer release()
+	c.limiter = l
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		b := make([]byte, 200)
+		// client reads init handshake.
+		_, err := remote.Read(b)
+		require.NoError(t, err)
+		_, err = remote.Write(makeClientHandshakeResp())
+		require.NoError(t, err)
+
+		// client reads the error with the code of too many connections.
+		n, err := remote.Read(b)
+		require.NoError(t, err)
+		require.Less(t, 7, n)
+		require.Equal(t, byte(0xff), b[4])
+		require.Equal(t, moerr.ER_TOO_MANY_USER_CONNECTIONS, binary.LittleEndian.Uint16(b[5:7]))
+	}()
+
+	_, err = cc.BuildConnWithServer(true)
+	require.Error(t, err)
+	require.Equal(t, int64(1), c.counterSet.connRefused.Load())
+	cc.SendErrToClient(err)
 	wg.Wait()
 }
+
+// authServerConn replies the auth data with the packets in order.
+type authServerConn struct {
+	*mockServerConn
+	replies  [][]byte
+	received []*frontend.Packet
+}
+
+func (s *authServerConn) HandleAuth(authData *frontend.Packet) (*frontend.Packet, error) {
+	s.received = append(s.received, authData)
+	if len(s.replies) == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("no more replies")
+	}
+	r := s.replies[0]
+	s.replies = s.replies[1:]
+	return &frontend.Packet{Length: int32(len(r)), SequenceID: 2, Payload: r}, nil
+}
+
+func makeTestPacket(payload []byte) []byte {
+	return packetToBytes(&frontend.Packet{Length: int32(len(payload)), Payload: payload})
+}
+
+func TestClientConn_RelayAuth(t *testing.T) {
+	authSwitch := append([]byte{0xFE}, "caching_sha2_password\x00"...)
+	fastAuth := []byte{0x01, 0x03}
+	fullAuth := []byte{0x01, 0x04}
+
+	t.Run("relay", func(t *testing.T) {
+		cc, cleanup := createNewClientConn(t)
+		defer cleanup()
+		c := cc.(*clientConn)
+		local, remote := net.Pipe()
+		c.conn.UseConn(local)
+		sc := &authServerConn{
+			mockServerConn: newMockServerConn(nil),
+			replies:        [][]byte{fastAuth, {0}},
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p, err := readTestPacket(remote)
+			require.NoError(t, err)
+			require.Equal(t, authSwitch, p)
+			require.NoError(t, writeTestPacket(remote, 3, []byte("scramble")))
+			p, err = readTestPacket(remote)
+			require.NoError(t, err)
+			require.Equal(t, fastAuth, p)
+			p, err = readTestPacket(remote)
+			require.NoError(t, err)
+			require.Equal(t, []byte{0}, p)
+		}()
+
+		r, err := c.relayAuth(sc, makeTestPacket(authSwitch))
+		require.NoError(t, err)
+		require.True(t, isOKPacket(r))
+		wg.Wait()
+		require.Equal(t, 2, len(sc.received))
+		require.Equal(t, "scramble", string(sc.received[0].Payload))
+		require.Nil(t, sc.received[1])
+		require.Equal(t, "scramble", string(c.authSwitchResp.Payload))
+		require.Nil(t, c.fullAuthResp)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		c := &clientConn{
+			authSwitchResp: &frontend.Packet{Payload: []byte("scramble")},
+		}
+		sc := &authServerConn{
+			mockServerConn: newMockServerConn(nil),
+			replies:        [][]byte{fastAuth, {0}},
+		}
+		r, err := c.replayAuth(sc, makeTestPacket(authSwitch))
+		require.NoError(t, err)
+		require.True(t, isOKPacket(r))
+		require.Equal(t, "scramble", string(sc.received[0].Payload))
+
+		// the password is not kept, so the full authentication fails.
+		sc = &authServerConn{mockServerConn: newMockServerConn(nil)}
+		_, err = c.replayAuth(sc, makeTestPacket(fullAuth))
+		require.Error(t, err)
+		require.Equal(t, 0, len(sc.received))
+	})
+}
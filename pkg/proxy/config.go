@@ -15,8 +15,12 @@
 package proxy
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/util/toml"
@@ -31,6 +35,20 @@ var (
 	defaultRebalanceInterval = 30 * time.Second
 	// The default value of rebalnce tolerance.
 	defaultRebalanceTolerance = 0.3
+	// The default value of idle timeout of client connections in pooling mode.
+	defaultPoolIdleTimeout = 10 * time.Second
+	// The default value of max idle server connections of each user.
+	defaultPoolMaxIdleConns = 16
+	// The default value of max idle time of server connections in pool.
+	defaultPoolMaxIdleTime = time.Minute
+	// The default value of consecutive failures to open the circuit breaker.
+	defaultBreakerFailureThreshold = 3
+	// The default value of the time that the circuit breaker keeps open.
+	defaultBreakerOpenTimeout = 30 * time.Second
+	// The default value of the interval of health checks of CN servers.
+	defaultBreakerHealthCheckInterval = 5 * time.Second
+	// The default value of the timeout of a health check of CN server.
+	defaultBreakerHealthCheckTimeout = 3 * time.Second
 )
 
 // Config is the configuration of proxy server.
@@ -56,6 +74,127 @@ type Config struct {
 		// RefreshInterval refresh cluster info from hakeeper interval
 		RefreshInterval toml.Duration `toml:"refresh-interval"`
 	}
+	// Secret is shared with the CN servers, which is proxy-secret in their
+	// frontend configuration. The proxy proves the connections come from
+	// it by the secret, and the CN servers trust the TLS information of the
+	// clients sent by the proxy only if it is proved.
+	Secret string `toml:"secret"`
+	// TLS is the configuration of TLS. The proxy terminates TLS of the
+	// clients, and connects to CN servers with TLS if BackendEnabled is set.
+	TLS struct {
+		// Enabled indicates that the clients could connect to proxy with TLS.
+		Enabled bool `toml:"enabled"`
+		// CertFile and KeyFile are the certificate and key of proxy.
+		CertFile string `toml:"cert-file"`
+		KeyFile  string `toml:"key-file"`
+		// CAFile is the CA to verify the certificates of clients.
+		CAFile string `toml:"ca-file"`
+		// RequireClientCert indicates that the clients must connect with
+		// TLS and present a certificate verified by CAFile.
+		RequireClientCert bool `toml:"require-client-cert"`
+		// SNIDomain is the domain of the server names in TLS. The clients
+		// connected with server name "<account>.<SNIDomain>" are routed to
+		// the CN servers of the account.
+		SNIDomain string `toml:"sni-domain"`
+		// BackendEnabled indicates that proxy connects to CN servers with TLS.
+		BackendEnabled bool `toml:"backend-enabled"`
+		// BackendCAFile is the CA to verify the certificates of CN servers.
+		BackendCAFile string `toml:"backend-ca-file"`
+		// BackendInsecureSkipVerify skips verifying the certificates of CN
+		// servers.
+		BackendInsecureSkipVerify bool `toml:"backend-insecure-skip-verify"`
+	}
+	// Pool is the configuration of connection pooling. In pooling mode, the
+	// client connections which are idle between transactions release their
+	// server connections to a pool, and take one from the pool when they
+	// send the next command.
+	Pool struct {
+		// Enabled indicates that the pooling mode is enabled.
+		Enabled bool `toml:"enabled"`
+		// IdleTimeout is the time that a client connection is idle before
+		// it releases the server connection.
+		IdleTimeout toml.Duration `toml:"idle-timeout"`
+		// MaxIdleConns is the max number of idle server connections in the
+		// pool for each user.
+		MaxIdleConns int `toml:"max-idle-conns"`
+		// MaxIdleTime is the max time that a server connection is idle in
+		// the pool before it is closed.
+		MaxIdleTime toml.Duration `toml:"max-idle-time"`
+	}
+	// Routing is the configuration of query-aware routing. The queries
+	// outside transactions are routed to the CN servers with the labels of
+	// the first matched rule, and the others to the CN servers selected by
+	// the labels of the connection.
+	Routing struct {
+		Rules []RoutingRule `toml:"rules"`
+		// Lag is the snapshot lag of the CN servers selected by the labels
+		// of the connection, like the logtail lag of read-only CN servers.
+		Lag toml.Duration `toml:"lag"`
+	}
+	// Limit is the configuration of the limits of accounts and users. All
+	// the matched rules are applied to a connection. The limits are counted
+	// by each proxy instance, not the whole cluster.
+	Limit struct {
+		Rules []LimitRule `toml:"rules"`
+	}
+	// CircuitBreaker is the configuration of the circuit breaker of CN
+	// servers. A CN server which fails the health checks or fails to be
+	// connected for some consecutive times is not routed to until the open
+	// timeout passes or a health check passes.
+	CircuitBreaker struct {
+		// Disabled indicates that the circuit breaker is disabled.
+		Disabled bool `toml:"disabled"`
+		// FailureThreshold is the number of consecutive failures to open
+		// the circuit breaker of a CN server.
+		FailureThreshold int `toml:"failure-threshold"`
+		// OpenTimeout is the time that the circuit breaker keeps open.
+		OpenTimeout toml.Duration `toml:"open-timeout"`
+		// HealthCheckInterval is the interval of the health checks of CN
+		// servers.
+		HealthCheckInterval toml.Duration `toml:"health-check-interval"`
+		// HealthCheckTimeout is the timeout of a health check, in which the
+		// CN server should send its handshake packet.
+		HealthCheckTimeout toml.Duration `toml:"health-check-timeout"`
+	}
+}
+
+// LimitRule is the limits of the connections and queries of accounts and
+// users. The zero value of a limit means no limit.
+type LimitRule struct {
+	// Account is the account which the rule applies to, empty means each
+	// account.
+	Account string `toml:"account"`
+	// User is the user which the rule applies to, empty means the whole
+	// account.
+	User string `toml:"user"`
+	// MaxConns is the max number of connections.
+	MaxConns int `toml:"max-conns"`
+	// ConnsPerSecond is the max number of new connections per second.
+	ConnsPerSecond int `toml:"conns-per-second"`
+	// QueriesPerSecond is the max number of queries per second. The queries
+	// above the limit are delayed but not rejected.
+	QueriesPerSecond int `toml:"queries-per-second"`
+}
+
+// RoutingRule is the rule to route queries to CN servers. All the non-empty
+// conditions of the rule must be matched.
+type RoutingRule struct {
+	// Name is the name of the rule, which is used in logs.
+	Name string `toml:"name"`
+	// StmtType is the type of the statement, "select" for the read-only
+	// statements, and "dml" for insert, update, delete and so on.
+	StmtType string `toml:"stmt-type"`
+	// Hint is the value of the hint /*+ cn_label(value) */ in the query.
+	Hint string `toml:"hint"`
+	// User is the user of the connection, "user" or "account:user".
+	User string `toml:"user"`
+	// Labels are the labels of the CN servers, which are added to the labels
+	// of the connection.
+	Labels map[string]string `toml:"labels"`
+	// Lag is the snapshot lag of the CN servers of the rule, like the logtail
+	// lag of read-only CN servers. The queries after a write are not routed
+	// to them until the write is older than the lag.
+	Lag toml.Duration `toml:"lag"`
 }
 
 // Option is used to set up configuration.
@@ -82,4 +221,87 @@ func (c *Config) FillDefault() {
 	if c.RebalanceToerance == 0 {
 		c.RebalanceToerance = defaultRebalanceTolerance
 	}
+	if c.Pool.IdleTimeout.Duration == 0 {
+		c.Pool.IdleTimeout.Duration = defaultPoolIdleTimeout
+	}
+	if c.Pool.MaxIdleConns == 0 {
+		c.Pool.MaxIdleConns = defaultPoolMaxIdleConns
+	}
+	if c.Pool.MaxIdleTime.Duration == 0 {
+		c.Pool.MaxIdleTime.Duration = defaultPoolMaxIdleTime
+	}
+	if c.CircuitBreaker.FailureThreshold == 0 {
+		c.CircuitBreaker.FailureThreshold = defaultBreakerFailureThreshold
+	}
+	if c.CircuitBreaker.OpenTimeout.Duration == 0 {
+		c.CircuitBreaker.OpenTimeout.Duration = defaultBreakerOpenTimeout
+	}
+	if c.CircuitBreaker.HealthCheckInterval.Duration == 0 {
+		c.CircuitBreaker.HealthCheckInterval.Duration = defaultBreakerHealthCheckInterval
+	}
+	if c.CircuitBreaker.HealthCheckTimeout.Duration == 0 {
+		c.CircuitBreaker.HealthCheckTimeout.Duration = defaultBreakerHealthCheckTimeout
+	}
+}
+
+// loadCertPool loads the CA certificates from the file.
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	ca, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, moerr.NewInternalErrorNoCtx("no certificate in CA file %s", caFile)
+	}
+	return pool, nil
+}
+
+// serverTLSConfig returns the TLS config for the clients, or nil if TLS is
+// not enabled.
+func (c *Config) serverTLSConfig() (*tls.Config, error) {
+	if !c.TLS.Enabled {
+		return nil, nil
+	}
+	if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+		return nil, moerr.NewInternalErrorNoCtx("cert file or key file of proxy is empty")
+	}
+	cert, err := tls.LoadX509KeyPair(c.TLS.CertFile, c.TLS.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.NoClientCert,
+	}
+	if c.TLS.CAFile != "" {
+		if cfg.ClientCAs, err = loadCertPool(c.TLS.CAFile); err != nil {
+			return nil, err
+		}
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		if c.TLS.RequireClientCert {
+			cfg.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	} else if c.TLS.RequireClientCert {
+		return nil, moerr.NewInternalErrorNoCtx("CA file is required to verify client certificates")
+	}
+	return cfg, nil
+}
+
+// backendTLSConfig returns the TLS config for the CN servers, or nil if TLS
+// is not enabled.
+func (c *Config) backendTLSConfig() (*tls.Config, error) {
+	if !c.TLS.BackendEnabled {
+		return nil, nil
+	}
+	cfg := &tls.Config{
+		InsecureSkipVerify: c.TLS.BackendInsecureSkipVerify,
+	}
+	if c.TLS.BackendCAFile != "" {
+		var err error
+		if cfg.RootCAs, err = loadCertPool(c.TLS.BackendCAFile); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
 }
@@ -15,6 +15,8 @@
 package proxy
 
 import (
+	"crypto/tls"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -29,4 +31,56 @@ func TestFillDefault(t *testing.T) {
 	require.NotEqual(t, 0, c.RebalanceToerance)
 	require.Less(t, c.RebalanceToerance, float64(1))
 	require.NotEqual(t, 0, c.Cluster.RefreshInterval.Duration)
+	require.False(t, c.Pool.Enabled)
+	require.NotEqual(t, 0, c.Pool.IdleTimeout.Duration)
+	require.NotEqual(t, 0, c.Pool.MaxIdleConns)
+	require.NotEqual(t, 0, c.Pool.MaxIdleTime.Duration)
+	require.False(t, c.CircuitBreaker.Disabled)
+	require.NotEqual(t, 0, c.CircuitBreaker.FailureThreshold)
+	require.NotEqual(t, 0, c.CircuitBreaker.OpenTimeout.Duration)
+	require.NotEqual(t, 0, c.CircuitBreaker.HealthCheckInterval.Duration)
+	require.NotEqual(t, 0, c.CircuitBreaker.HealthCheckTimeout.Duration)
+}
+
+func TestTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	newTestCerts(t, dir)
+
+	c := Config{}
+	cfg, err := c.serverTLSConfig()
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+	cfg, err = c.backendTLSConfig()
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	c.TLS.Enabled = true
+	_, err = c.serverTLSConfig()
+	require.Error(t, err)
+
+	c.TLS.CertFile = filepath.Join(dir, "server.pem")
+	c.TLS.KeyFile = filepath.Join(dir, "server.key")
+	cfg, err = c.serverTLSConfig()
+	require.NoError(t, err)
+	require.Equal(t, tls.NoClientCert, cfg.ClientAuth)
+
+	c.TLS.RequireClientCert = true
+	_, err = c.serverTLSConfig()
+	require.Error(t, err)
+
+	c.TLS.CAFile = filepath.Join(dir, "ca.pem")
+	cfg, err = c.serverTLSConfig()
+	require.NoError(t, err)
+	require.Equal(t, tls.RequireAndVerifyClientCert, cfg.ClientAuth)
+	require.NotNil(t, cfg.ClientCAs)
+
+	c.TLS.CAFile = c.TLS.KeyFile
+	_, err = c.serverTLSConfig()
+	require.Error(t, err)
+
+	c.TLS.BackendEnabled = true
+	c.TLS.BackendCAFile = filepath.Join(dir, "ca.pem")
+	cfg, err = c.backendTLSConfig()
+	require.NoError(t, err)
+	require.NotNil(t, cfg.RootCAs)
 }
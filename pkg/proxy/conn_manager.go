@@ -251,3 +251,18 @@ func (m *connManager) getCNServer(connID uint32) *CNServer {
 	}
 	return nil
 }
+
+// getTunnels returns all tunnels in connection manager.
+func (m *connManager) getTunnels() []*tunnel {
+	m.Lock()
+	defer m.Unlock()
+	var tuns []*tunnel
+	for _, ci := range m.conns {
+		for _, ts := range ci.cnTunnels {
+			for t := range ts {
+				tuns = append(tuns, t)
+			}
+		}
+	}
+	return tuns
+}
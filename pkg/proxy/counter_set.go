@@ -54,6 +54,10 @@ func (e *counterLogExporter) Export() []zap.Field {
 		e.counter.connMigrationRequested.Load()))
 	fields = append(fields, zap.Int64("connection migration cannot start",
 		e.counter.connMigrationCannotStart.Load()))
+	fields = append(fields, zap.Int64("server connection released to pool",
+		e.counter.connPoolReleased.Load()))
+	fields = append(fields, zap.Int64("server connection reused from pool",
+		e.counter.connPoolReused.Load()))
 	return fields
 }
 
@@ -68,6 +72,8 @@ type counterSet struct {
 	connMigrationSuccess     stats.Counter
 	connMigrationRequested   stats.Counter
 	connMigrationCannotStart stats.Counter
+	connPoolReleased         stats.Counter
+	connPoolReused           stats.Counter
 }
 
 // newCounterSet creates a new counterSet.
@@ -26,9 +26,24 @@ information, connection attributes, etc., and the proxy will save this part of t
 information and use it when switching CN servers in the future.
 (4) According to the login information of the client, the proxy selects a CN server
 to establish a connection, and uses the handshake response just saved for verification.
-(5) If the verification is successful, return the client OK package, otherwise return
+(5) If the CN server asks for more auth data, such as the auth switch request or the full
+authentication of caching_sha2_password, the proxy relays the packets between the client
+and the CN server. The responses of the client are saved to answer the same requests when
+switching CN servers, except the password encrypted by the RSA key of the CN server.
+(6) If the verification is successful, return the client OK package, otherwise return
 an error package.
 
+With [proxy.tls] enabled, the client could ask for TLS in step (3), and the proxy terminates
+TLS: the packets between the proxy and CN servers are plain, unless backend-enabled is set,
+with which the proxy connects to CN servers with TLS again. If sni-domain is set, a client
+connecting with server name "<account>.<sni-domain>" is routed to the CN servers of the
+account, and the user name without account is logged in as the user of the account. The
+certificate of the client, verified by ca-file, is sent to CN servers in the connection
+attributes, to check the users created with REQUIRE X509, SUBJECT or ISSUER. The attributes
+are trusted by CN servers only if [proxy] secret equals proxy-secret of the CN servers: the
+proxy sends an HMAC of the salt by the secret after the salt, and the attributes of the
+same names sent by the clients are dropped by the proxy.
+
 2. Connection management
 When a client connects to the proxy, its connection will be saved and managed, and the
 number of connections established on each CN server will be recorded in real time.
@@ -55,6 +70,54 @@ the migration cannot be done.
 (3) If you are in a transaction, you cannot do migration. Tracking of transaction state is
 recorded as data is interacted.
 
+COM_CHANGE_USER and COM_RESET_CONNECTION from the client are tracked too. The variables
+set before are dropped, and the cached login information is replaced by the new user, so
+the migrated connection logs in to the new CN server as the user after the change.
+
+With [proxy.pool] enabled, the proxy multiplexes the idle client connections onto a smaller
+pool of connections to CN servers. A client connection which is idle longer than idle-timeout
+releases its server connection, with the same security judgment as migration, and with no
+prepared statements, temporary tables or autocommit set in the session. The session of the
+released connection is reset by COM_RESET_CONNECTION and it is put into the pool of the user.
+When the client sends the next command, it takes a connection of the same user, labels and
+current database from the pool, or connects to a new CN server, and the variables, the role
+and the charset set before and the current database are restored before the command is sent.
+SET ROLE, SET SECONDARY ROLE, SET NAMES and SET CHARACTER SET are replayed in the order they
+are executed, in the same way as migration.
+
+The queries could be routed to CN servers with different labels by [[proxy.routing.rules]].
+A rule matches the statement type ("select" or "dml"), the hint cn_label(name) in the
+optimizer comment at the head of the query, or the user, and the first matched rule adds its labels to the labels of
+the connection. The queries matching no rule go to the CN servers of the connection labels.
+When a query is routed to other labels, the server connection is released to the pool and
+another one is taken by the new labels, in the same way as pooling mode. The pool is created
+for the routing rules even if pooling mode is not enabled, so switching back and forth reuses
+the server connections instead of handshaking again. The routing is done only out of
+transactions and pinned sessions, so a transaction sticks to the CN server where it is started.
+The labels of the sys tenant are ignored when selecting CN servers as before.
+
+The CN servers of a rule may read at a lagged snapshot, like the read-only CN servers, which
+is set by the lag of the rule, or [proxy.routing] lag for the labels of the connection. After a
+write, which is a statement other than select or a command other than COM_QUERY, the session
+sticks to the CN server of the write until the write is older than the lag of the target plus
+the max clock offset, so the session always reads its own writes.
+
+The connections and queries of accounts and users could be limited by [[proxy.limit.rules]].
+A rule with an empty account applies to each account, and a rule with an empty user applies
+to the whole account, and all the matched rules are applied. A new connection above max-conns
+or conns-per-second is refused with the MySQL error ER_TOO_MANY_USER_CONNECTIONS or
+ER_USER_LIMIT_REACHED, and a query above queries-per-second is delayed until it is allowed,
+so one noisy tenant cannot exhaust the shared CN servers. The limits are counted by each
+proxy instance, so with N proxy instances, an account could have up to N times the limits
+in the whole cluster.
+
+The circuit breaker in [proxy.circuit-breaker] checks the health of all the CN servers every
+health-check-interval, by connecting to them and waiting for the handshake packets in
+health-check-timeout. A CN server which fails the health checks or fails to be connected for
+failure-threshold consecutive times is not routed to for open-timeout. After that, it is routed
+to again, and one more failure opens the breaker again. A passed health check closes the
+breaker at once. If all the CN servers are broken, they are routed to anyway.
+
 5. Usage
 Proxy is mainly used on the cloud platform. If you want to use proxy locally, you need to
 add configuration -with-proxy to start the proxy module in launch configuration mode, and
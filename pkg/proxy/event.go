@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -31,6 +32,12 @@ func (t eventType) String() string {
 		return "KillQuery"
 	case TypeSetVar:
 		return "SetVar"
+	case TypeChangeUser:
+		return "ChangeUser"
+	case TypeResetConnection:
+		return "ResetConnection"
+	case TypeInitDB:
+		return "InitDB"
 	}
 	return "Unknown"
 }
@@ -42,6 +49,12 @@ const (
 	TypeKillQuery eventType = 1
 	// TypeSetVar indicates the set variable statement.
 	TypeSetVar eventType = 2
+	// TypeChangeUser indicates the COM_CHANGE_USER command.
+	TypeChangeUser eventType = 3
+	// TypeResetConnection indicates the COM_RESET_CONNECTION command.
+	TypeResetConnection eventType = 4
+	// TypeInitDB indicates the use statement or the COM_INIT_DB command.
+	TypeInitDB eventType = 5
 )
 
 var (
@@ -81,6 +94,10 @@ var patternMap = map[eventType]string{
 		at, varName, // @key
 		spaceAtLeastZero, assign, spaceAtLeastZero, // = or :=
 		varValue), // value
+	// TypeInitDB matches the use statement:
+	//   - use db;
+	//   - use `db`;
+	TypeInitDB: `^[uU][sS][eE]\s+(\S+)$`,
 }
 
 // IEvent is the event interface.
@@ -131,8 +148,24 @@ func makeEvent(req *eventReq) (IEvent, bool) {
 	if req == nil || len(req.msg) < preRecvLen {
 		return nil, true
 	}
+	if isSessionResetCmd(req.msg) {
+		// These events should be sent to dst, so return false.
+		if req.msg[4] == byte(cmdChangeUser) {
+			return makeChangeUserEvent(req.msg[preRecvLen:]), false
+		}
+		return makeResetConnectionEvent(), false
+	}
+	if req.msg[4] == byte(cmdInitDB) {
+		// This event should be sent to dst, so return false.
+		return makeInitDBEvent(string(req.msg[preRecvLen:])), false
+	}
 	if req.msg[4] == byte(cmdQuery) {
 		stmt := getStatement(req.msg)
+		if isStmtSetSession(stmt) {
+			// The role and charset are restored like the variables,
+			// so the event should be sent to dst, return false.
+			return makeSetVarEvent(stmt), false
+		}
 		// Get the event type.
 		var typ eventType
 		var matched bool
@@ -151,6 +184,10 @@ func makeEvent(req *eventReq) (IEvent, bool) {
 		case TypeSetVar:
 			// This event should be sent to dst, so return false,
 			return makeSetVarEvent(stmt), false
+		case TypeInitDB:
+			// This event should be sent to dst, so return false,
+			items := regexp.MustCompile(patternMap[typ]).FindStringSubmatch(stmt)
+			return makeInitDBEvent(strings.Trim(items[1], "`")), false
 		default:
 			return nil, true
 		}
@@ -215,3 +252,78 @@ func makeSetVarEvent(stmt string) IEvent {
 func (e *setVarEvent) eventType() eventType {
 	return TypeSetVar
 }
+
+// isSessionResetCmd returns true if the message is the COM_CHANGE_USER or
+// COM_RESET_CONNECTION command, which resets the session in the server. The
+// command is the first packet from the client, whose sequence ID is 0.
+func isSessionResetCmd(msg []byte) bool {
+	if len(msg) < preRecvLen || msg[3] != 0 {
+		return false
+	}
+	return msg[4] == byte(cmdChangeUser) || msg[4] == byte(cmdResetConnection)
+}
+
+// changeUserEvent is the event that the client changes the user of the
+// connection. We need to rebuild the handshake response with the new user,
+// which is used to connect to the new CN server in connection migration.
+type changeUserEvent struct {
+	baseEvent
+	// payload is the payload of COM_CHANGE_USER without the cmd.
+	payload []byte
+}
+
+// makeChangeUserEvent creates an event with TypeChangeUser type.
+func makeChangeUserEvent(payload []byte) IEvent {
+	e := &changeUserEvent{
+		// The message buffer is reused, so copy the payload.
+		payload: append([]byte(nil), payload...),
+	}
+	e.typ = TypeChangeUser
+	return e
+}
+
+// eventType implements the IEvent interface.
+func (e *changeUserEvent) eventType() eventType {
+	return TypeChangeUser
+}
+
+// resetConnectionEvent is the event that the client resets the session. The
+// variables set in the session are dropped by the server.
+type resetConnectionEvent struct {
+	baseEvent
+}
+
+// makeResetConnectionEvent creates an event with TypeResetConnection type.
+func makeResetConnectionEvent() IEvent {
+	e := &resetConnectionEvent{}
+	e.typ = TypeResetConnection
+	return e
+}
+
+// eventType implements the IEvent interface.
+func (e *resetConnectionEvent) eventType() eventType {
+	return TypeResetConnection
+}
+
+// initDBEvent is the event that the client changes the current database. We
+// need to keep the database in clientConn, which is used to restore the
+// session in a new server connection.
+type initDBEvent struct {
+	baseEvent
+	// db is the new current database.
+	db string
+}
+
+// makeInitDBEvent creates an event with TypeInitDB type.
+func makeInitDBEvent(db string) IEvent {
+	e := &initDBEvent{
+		db: db,
+	}
+	e.typ = TypeInitDB
+	return e
+}
+
+// eventType implements the IEvent interface.
+func (e *initDBEvent) eventType() eventType {
+	return TypeInitDB
+}
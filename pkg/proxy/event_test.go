@@ -50,6 +50,24 @@ func TestMakeEvent(t *testing.T) {
 		require.True(t, r)
 	})
 
+	t.Run("reset session", func(t *testing.T) {
+		msg := []byte{5, 0, 0, 0, byte(cmdChangeUser), 'u', 0, 0, 0}
+		e, r = makeEvent(&eventReq{msg: msg})
+		require.False(t, r)
+		ce, ok := e.(*changeUserEvent)
+		require.True(t, ok)
+		require.Equal(t, []byte{'u', 0, 0, 0}, ce.payload)
+
+		e, r = makeEvent(&eventReq{msg: []byte{1, 0, 0, 0, byte(cmdResetConnection)}})
+		require.False(t, r)
+		require.Equal(t, TypeResetConnection, e.eventType())
+
+		// the packet from the server is not a command
+		e, r = makeEvent(&eventReq{msg: []byte{1, 0, 0, 1, byte(cmdResetConnection)}})
+		require.Nil(t, e)
+		require.True(t, r)
+	})
+
 	t.Run("set var", func(t *testing.T) {
 		stmtsValid := []string{
 			"set session a=1",
@@ -104,6 +122,34 @@ func TestMakeEvent(t *testing.T) {
 			require.True(t, r)
 		}
 	})
+
+	t.Run("set session", func(t *testing.T) {
+		for _, stmt := range []string{"set role r1", "set secondary role all", "set names utf8mb4"} {
+			e, r = makeEvent(&eventReq{msg: makeSimplePacket(stmt)})
+			require.False(t, r)
+			require.Equal(t, TypeSetVar, e.eventType())
+			require.Equal(t, stmt, e.(*setVarEvent).stmt)
+		}
+	})
+
+	t.Run("init db", func(t *testing.T) {
+		e, r = makeEvent(&eventReq{msg: makeSimplePacket("use db1")})
+		require.False(t, r)
+		require.Equal(t, &initDBEvent{baseEvent: baseEvent{typ: TypeInitDB}, db: "db1"}, e)
+
+		e, r = makeEvent(&eventReq{msg: makeSimplePacket("USE `db2`")})
+		require.False(t, r)
+		require.Equal(t, "db2", e.(*initDBEvent).db)
+
+		msg := []byte{4, 0, 0, 0, byte(cmdInitDB), 'd', 'b', '3'}
+		e, r = makeEvent(&eventReq{msg: msg})
+		require.False(t, r)
+		require.Equal(t, "db3", e.(*initDBEvent).db)
+
+		e, r = makeEvent(&eventReq{msg: makeSimplePacket("used db1")})
+		require.Nil(t, e)
+		require.True(t, r)
+	})
 }
 
 func TestKillQueryEvent(t *testing.T) {
@@ -433,4 +479,7 @@ func TestEventType_String(t *testing.T) {
 
 	e3 := setVarEvent{}
 	require.Equal(t, "SetVar", e3.eventType().String())
+
+	e4 := initDBEvent{}
+	require.Equal(t, "InitDB", e4.eventType().String())
 }
@@ -16,6 +16,7 @@ package proxy
 
 import (
 	"context"
+	"crypto/tls"
 	"time"
 
 	"github.com/fagongzi/goetty/v2"
@@ -41,6 +42,14 @@ type handler struct {
 	router Router
 	// counterSet counts the events in proxy.
 	counterSet *counterSet
+	// tlsConfig is the TLS config for clients, nil if TLS is not enabled.
+	tlsConfig *tls.Config
+	// pool keeps the idle server connections, nil if pooling is not enabled.
+	pool *serverConnPool
+	// routing routes the queries by the routing rules, nil if there are no rules.
+	routing *routingRules
+	// limiter limits the connections and queries, nil if there is no limit.
+	limiter *limiter
 }
 
 var ErrNoAvailableCNServers = moerr.NewInternalErrorNoCtx("no available CN servers")
@@ -84,6 +93,48 @@ func newProxyHandler(
 		return nil, err
 	}
 
+	tlsConfig, err := cfg.serverTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	backendTLSConfig, err := cfg.backendTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the server connection pool if pooling mode is enabled. The
+	// routing rules use the pool too, so the server connections are reused
+	// when the queries are routed back and forth.
+	var pool *serverConnPool
+	if cfg.Pool.Enabled || len(cfg.Routing.Rules) > 0 {
+		pool, err = newServerConnPool(st, runtime.Logger(), re.connManager, cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	routing, err := newRoutingRules(cfg.Routing.Rules, cfg.Routing.Lag.Duration)
+	if err != nil {
+		return nil, err
+	}
+	limiter, err := newLimiter(cfg.Limit.Rules)
+	if err != nil {
+		return nil, err
+	}
+	var routerOpts []routerOption
+	routerOpts = append(routerOpts, withBackendTLS(backendTLSConfig), withSecret(cfg.Secret))
+	if !cfg.CircuitBreaker.Disabled {
+		breaker := newCircuitBreaker(runtime.Logger(),
+			cfg.CircuitBreaker.FailureThreshold, cfg.CircuitBreaker.OpenTimeout.Duration)
+		if err := st.RunNamedTask("circuit-breaker-health-checker", func(ctx context.Context) {
+			breaker.run(ctx, mc, cfg.CircuitBreaker.HealthCheckInterval.Duration,
+				cfg.CircuitBreaker.HealthCheckTimeout.Duration)
+		}); err != nil {
+			return nil, err
+		}
+		routerOpts = append(routerOpts, withCircuitBreaker(breaker))
+	}
+
 	return &handler{
 		ctx:        context.Background(),
 		logger:     runtime.Logger(),
@@ -91,7 +142,11 @@ func newProxyHandler(
 		stopper:    st,
 		moCluster:  mc,
 		counterSet: cs,
-		router:     newRouter(mc, re, false),
+		router:     newRouter(mc, re, false, routerOpts...),
+		tlsConfig:  tlsConfig,
+		pool:       pool,
+		routing:    routing,
+		limiter:    limiter,
 	}, nil
 }
 
@@ -107,7 +162,9 @@ func (h *handler) handle(c goetty.IOSession) error {
 		_ = t.Close()
 	}()
 
-	cc, err := newClientConn(h.ctx, h.logger, h.counterSet, c, h.moCluster, h.router, t)
+	cc, err := newClientConn(h.ctx, h.logger, h.counterSet, c, h.moCluster, h.router, t,
+		withClientTLS(h.tlsConfig, h.config.TLS.SNIDomain), withServerConnPool(h.pool), withRoutingRules(h.routing),
+		withLimiter(h.limiter))
 	if err != nil {
 		return err
 	}
@@ -118,17 +175,18 @@ func (h *handler) handle(c goetty.IOSession) error {
 	sc, err := cc.BuildConnWithServer(true)
 	if err != nil {
 		h.counterSet.updateWithErr(err)
-		cc.SendErrToClient(err.Error())
+		cc.SendErrToClient(err)
 		return err
 	}
-	defer func() { _ = sc.Close() }()
-
 	h.logger.Debug("build connection successfully",
 		zap.String("client", cc.RawConn().RemoteAddr().String()),
 		zap.String("server", sc.RawConn().RemoteAddr().String()),
 	)
 
+	// After the tunnel runs, the server connection is owned by it, and may
+	// be released to the pool in pooling mode.
 	if err := t.run(cc, sc); err != nil {
+		_ = sc.Close()
 		return err
 	}
 
@@ -147,8 +205,15 @@ func (h *handler) handle(c goetty.IOSession) error {
 			case r := <-t.respC:
 				if len(r) > 0 {
 					t.mu.Lock()
-					// We must call this method because it locks writeMu.
-					if err := t.mu.serverConn.writeDataDirectly(cc.RawConn(), r); err != nil {
+					// We must call this method because it locks writeMu. If the
+					// server connection is released, nothing else writes to client.
+					var err error
+					if t.mu.serverConn != nil {
+						err = t.mu.serverConn.writeDataDirectly(cc.RawConn(), r)
+					} else {
+						_, err = cc.RawConn().Write(r)
+					}
+					if err != nil {
 						h.logger.Error("failed to write event response",
 							zap.Any("response", r), zap.Error(err))
 					}
@@ -16,7 +16,10 @@ package proxy
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"encoding/binary"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
@@ -36,9 +39,6 @@ func (c *clientConn) handleHandshakeResp() error {
 		return err
 	}
 	c.mysqlProto.AddSequenceId(1)
-	// Save the login packet in client connection, it will be used
-	// in the future.
-	c.handshakePack = pack
 
 	// Parse the login information and returns whether ssl is needed.
 	// Also, we can get connection attributes from client if it sets
@@ -46,21 +46,425 @@ func (c *clientConn) handleHandshakeResp() error {
 	//
 	// TODO(volgariver6): currently, only connectionAttributes in JDBC URI
 	// is supported.
-	// SSL is not supported for now.
-	_, err = c.mysqlProto.HandleHandshake(c.ctx, pack.Payload)
+	isTLSHeader, err := c.mysqlProto.HandleHandshake(c.ctx, pack.Payload)
 	if err != nil {
 		return err
 	}
+	if isTLSHeader {
+		if err := c.upgradeToTLS(); err != nil {
+			return err
+		}
+		// The client sends the handshake response again over TLS.
+		if pack, err = c.readPacket(); err != nil {
+			return err
+		}
+		c.mysqlProto.AddSequenceId(1)
+		if _, err = c.mysqlProto.HandleHandshake(c.ctx, pack.Payload); err != nil {
+			return err
+		}
+	} else if c.tlsConfig != nil && c.tlsConfig.ClientAuth == tls.RequireAndVerifyClientCert {
+		return moerr.NewInternalError(c.ctx, "connections without TLS are not allowed")
+	}
 
-	// parse tenant information from client login request.
-	if err := c.account.parse(c.mysqlProto.GetUserName()); err != nil {
+	// Save the login packet in client connection, it will be used
+	// in the future. Also, parse tenant information from it.
+	pack, account, err := c.prepareHandshakeResp(pack)
+	if err != nil {
 		return err
 	}
-
+	c.handshakePack = pack
+	c.account = account
 	c.labelInfo = newLabelInfo(c.account.tenant, c.mysqlProto.GetConnectAttrs())
 	return nil
 }
 
+// upgradeToTLS does the TLS handshake with the client after the client
+// sends the SSL request.
+func (c *clientConn) upgradeToTLS() error {
+	if c.tlsConfig == nil {
+		return moerr.NewInternalError(c.ctx, "TLS is not enabled in proxy")
+	}
+	conn := tls.Server(c.conn.RawConn(), c.tlsConfig)
+	ctx, cancel := context.WithTimeout(c.ctx, defaultTLSHandshakeTimeout)
+	defer cancel()
+	if err := conn.HandshakeContext(ctx); err != nil {
+		return err
+	}
+	c.conn.UseConn(conn)
+	c.mysqlProto.SetTlsEstablished()
+	state := conn.ConnectionState()
+	c.tlsState = &state
+	return nil
+}
+
+// sniTenant returns the tenant in the server name of TLS, which is
+// "<tenant>.<SNIDomain>". It returns empty if there is no such server name.
+func (c *clientConn) sniTenant() Tenant {
+	if c.tlsState == nil || c.sniDomain == "" {
+		return EmptyTenant
+	}
+	name := strings.ToLower(c.tlsState.ServerName)
+	suffix := "." + strings.ToLower(c.sniDomain)
+	if !strings.HasSuffix(name, suffix) {
+		return EmptyTenant
+	}
+	return Tenant(strings.TrimSuffix(name, suffix))
+}
+
+// prepareHandshakeResp makes the handshake response which is sent to CN
+// servers from the one of the client, and returns it with the account
+// information:
+//  1. the compression and SSL flags are cleared, as they are only between
+//     the client and proxy, so the packets between proxy and CN servers can
+//     be inspected.
+//  2. the tenant in the server name of TLS is added to the user name if
+//     the user name has no tenant.
+//  3. the TLS information of the client is sent in connection attributes,
+//     and the ones sent by the client with the same names are dropped.
+func (c *clientConn) prepareHandshakeResp(pack *frontend.Packet) (*frontend.Packet, accountInfo, error) {
+	var account accountInfo
+	if len(pack.Payload) < 4 ||
+		binary.LittleEndian.Uint32(pack.Payload)&frontend.CLIENT_PROTOCOL_41 == 0 {
+		// The handshake response 320 has no connection attributes.
+		if err := account.parse(c.mysqlProto.GetUserName()); err != nil {
+			return nil, account, err
+		}
+		if c.sniTenant() != EmptyTenant {
+			return nil, account, moerr.NewInternalError(c.ctx,
+				"handshake response 320 is not supported with server name routing")
+		}
+		return disableCompression(pack), account, nil
+	}
+
+	resp, err := parseHandshakeResp41(pack.Payload)
+	if err != nil {
+		return nil, account, err
+	}
+	if err := account.parse(string(resp.username)); err != nil {
+		return nil, account, err
+	}
+	if tenant := c.sniTenant(); tenant != EmptyTenant {
+		if account.tenant == EmptyTenant {
+			account.tenant = tenant
+			resp.username = []byte(string(tenant) + ":" + string(resp.username))
+		} else if !strings.EqualFold(string(account.tenant), string(tenant)) {
+			return nil, account, moerr.NewInternalError(c.ctx,
+				"tenant %s does not match the server name %s", account.tenant, c.tlsState.ServerName)
+		}
+	}
+
+	resp.capabilities &^= frontend.CLIENT_COMPRESS |
+		frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM | frontend.CLIENT_SSL
+	attrs := make([]string, 0, len(resp.attrs)+6)
+	for i := 0; i+1 < len(resp.attrs); i += 2 {
+		if !strings.HasPrefix(resp.attrs[i], frontend.ProxyAttrPrefix) {
+			attrs = append(attrs, resp.attrs[i], resp.attrs[i+1])
+		}
+	}
+	if c.tlsState != nil {
+		attrs = append(attrs, frontend.ProxyAttrTLS, "1")
+		if len(c.tlsState.PeerCertificates) > 0 {
+			cert := c.tlsState.PeerCertificates[0]
+			attrs = append(attrs,
+				frontend.ProxyAttrX509Subject, frontend.X509NameString(cert.Subject),
+				frontend.ProxyAttrX509Issuer, frontend.X509NameString(cert.Issuer),
+			)
+		}
+	}
+	resp.attrs = attrs
+	if len(attrs) > 0 {
+		resp.capabilities |= frontend.CLIENT_CONNECT_ATTRS
+	} else {
+		resp.capabilities &^= frontend.CLIENT_CONNECT_ATTRS
+	}
+	payload := resp.encode()
+	return &frontend.Packet{
+		Length:     int32(len(payload)),
+		SequenceID: pack.SequenceID,
+		Payload:    payload,
+	}, account, nil
+}
+
+// disableCompression clears the compression flags of the handshake response,
+// which is sent to CN servers.
+func disableCompression(pack *frontend.Packet) *frontend.Packet {
+	if len(pack.Payload) < 4 {
+		return pack
+	}
+	flags := frontend.CLIENT_COMPRESS | frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	capabilities := binary.LittleEndian.Uint32(pack.Payload)
+	if capabilities&frontend.CLIENT_PROTOCOL_41 == 0 {
+		// the capabilities of handshake response 320 are 2 bytes.
+		capabilities = uint32(binary.LittleEndian.Uint16(pack.Payload))
+	}
+	if capabilities&flags == 0 {
+		return pack
+	}
+	payload := append([]byte(nil), pack.Payload...)
+	if capabilities&frontend.CLIENT_PROTOCOL_41 != 0 {
+		binary.LittleEndian.PutUint32(payload, capabilities&^flags)
+	} else {
+		binary.LittleEndian.PutUint16(payload, uint16(capabilities&^flags))
+	}
+	return &frontend.Packet{
+		Length:     pack.Length,
+		SequenceID: pack.SequenceID,
+		Payload:    payload,
+	}
+}
+
+// handshakeResp41 is the handshake response 41 from the client.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_connection_phase_packets_protocol_handshake_response.html
+type handshakeResp41 struct {
+	capabilities  uint32
+	maxPacketSize [4]byte
+	charset       byte
+	username      []byte
+	auth          []byte
+	database      []byte
+	plugin        []byte
+	// attrs are the connection attributes, which are keys and values
+	// one by one.
+	attrs     []string
+	zstdLevel byte
+}
+
+// handshakeResp41FixedLen is the length of int<4> capabilities, int<4>
+// max-packet size, int<1> character set and string[23] reserved.
+const handshakeResp41FixedLen = 32
+
+func readStringNUL(data []byte) ([]byte, []byte, bool) {
+	pos := bytes.IndexByte(data, 0)
+	if pos < 0 {
+		return nil, nil, false
+	}
+	return data[:pos], data[pos+1:], true
+}
+
+func readIntLenEnc(data []byte) (uint64, []byte, bool) {
+	if len(data) == 0 {
+		return 0, nil, false
+	}
+	switch data[0] {
+	case 0xfc:
+		if len(data) < 3 {
+			return 0, nil, false
+		}
+		return uint64(binary.LittleEndian.Uint16(data[1:])), data[3:], true
+	case 0xfd:
+		if len(data) < 4 {
+			return 0, nil, false
+		}
+		return uint64(data[1]) | uint64(data[2])<<8 | uint64(data[3])<<16, data[4:], true
+	case 0xfe:
+		if len(data) < 9 {
+			return 0, nil, false
+		}
+		return binary.LittleEndian.Uint64(data[1:]), data[9:], true
+	case 0xfb, 0xff:
+		return 0, nil, false
+	}
+	return uint64(data[0]), data[1:], true
+}
+
+func readStringLenEnc(data []byte) ([]byte, []byte, bool) {
+	l, rest, ok := readIntLenEnc(data)
+	if !ok || uint64(len(rest)) < l {
+		return nil, nil, false
+	}
+	return rest[:l], rest[l:], true
+}
+
+func appendIntLenEnc(data []byte, v uint64) []byte {
+	switch {
+	case v < 0xfb:
+		return append(data, byte(v))
+	case v <= 0xffff:
+		return append(data, 0xfc, byte(v), byte(v>>8))
+	case v <= 0xffffff:
+		return append(data, 0xfd, byte(v), byte(v>>8), byte(v>>16))
+	}
+	data = append(data, 0xfe)
+	return binary.LittleEndian.AppendUint64(data, v)
+}
+
+func appendStringLenEnc(data []byte, s []byte) []byte {
+	return append(appendIntLenEnc(data, uint64(len(s))), s...)
+}
+
+// parseConnectAttrs parses the connection attributes with the length.
+func parseConnectAttrs(data []byte) ([]string, []byte, bool) {
+	l, rest, ok := readIntLenEnc(data)
+	if !ok || uint64(len(rest)) < l {
+		return nil, nil, false
+	}
+	var attrs []string
+	var key, value []byte
+	kvs := rest[:l]
+	for len(kvs) > 0 {
+		if key, kvs, ok = readStringLenEnc(kvs); !ok {
+			return nil, nil, false
+		}
+		if value, kvs, ok = readStringLenEnc(kvs); !ok {
+			return nil, nil, false
+		}
+		attrs = append(attrs, string(key), string(value))
+	}
+	return attrs, rest[l:], true
+}
+
+// parseHandshakeResp41 parses the handshake response 41.
+func parseHandshakeResp41(payload []byte) (*handshakeResp41, error) {
+	errInvalid := moerr.NewInternalErrorNoCtx("invalid handshake response")
+	if len(payload) < handshakeResp41FixedLen {
+		return nil, errInvalid
+	}
+	r := &handshakeResp41{
+		capabilities: binary.LittleEndian.Uint32(payload),
+		charset:      payload[8],
+	}
+	copy(r.maxPacketSize[:], payload[4:8])
+	var ok bool
+	rest := payload[handshakeResp41FixedLen:]
+	if r.username, rest, ok = readStringNUL(rest); !ok {
+		return nil, errInvalid
+	}
+	if r.capabilities&frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA != 0 {
+		r.auth, rest, ok = readStringLenEnc(rest)
+	} else if r.capabilities&frontend.CLIENT_SECURE_CONNECTION != 0 {
+		if ok = len(rest) >= 1 && len(rest) >= 1+int(rest[0]); ok {
+			r.auth, rest = rest[1:1+int(rest[0])], rest[1+int(rest[0]):]
+		}
+	} else {
+		r.auth, rest, ok = readStringNUL(rest)
+	}
+	if !ok {
+		return nil, errInvalid
+	}
+	if r.capabilities&frontend.CLIENT_CONNECT_WITH_DB != 0 {
+		if r.database, rest, ok = readStringNUL(rest); !ok {
+			return nil, errInvalid
+		}
+	}
+	if r.capabilities&frontend.CLIENT_PLUGIN_AUTH != 0 && len(rest) > 0 {
+		if r.plugin, rest, ok = readStringNUL(rest); !ok {
+			return nil, errInvalid
+		}
+	}
+	if r.capabilities&frontend.CLIENT_CONNECT_ATTRS != 0 && len(rest) > 0 {
+		if r.attrs, rest, ok = parseConnectAttrs(rest); !ok {
+			return nil, errInvalid
+		}
+	}
+	if r.capabilities&frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 && len(rest) > 0 {
+		r.zstdLevel = rest[0]
+	}
+	return r, nil
+}
+
+// encode returns the payload of the handshake response 41.
+func (r *handshakeResp41) encode() []byte {
+	payload := make([]byte, handshakeResp41FixedLen,
+		handshakeResp41FixedLen+len(r.username)+len(r.auth)+len(r.database)+len(r.plugin)+64)
+	binary.LittleEndian.PutUint32(payload, r.capabilities)
+	copy(payload[4:8], r.maxPacketSize[:])
+	payload[8] = r.charset
+	payload = append(append(payload, r.username...), 0)
+	if r.capabilities&frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA != 0 {
+		payload = appendStringLenEnc(payload, r.auth)
+	} else if r.capabilities&frontend.CLIENT_SECURE_CONNECTION != 0 {
+		payload = append(append(payload, byte(len(r.auth))), r.auth...)
+	} else {
+		payload = append(append(payload, r.auth...), 0)
+	}
+	if r.capabilities&frontend.CLIENT_CONNECT_WITH_DB != 0 {
+		payload = append(append(payload, r.database...), 0)
+	}
+	if r.capabilities&frontend.CLIENT_PLUGIN_AUTH != 0 {
+		payload = append(append(payload, r.plugin...), 0)
+	}
+	if r.capabilities&frontend.CLIENT_CONNECT_ATTRS != 0 {
+		var kvs []byte
+		for _, s := range r.attrs {
+			kvs = appendStringLenEnc(kvs, []byte(s))
+		}
+		payload = appendStringLenEnc(payload, kvs)
+	}
+	if r.capabilities&frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		payload = append(payload, r.zstdLevel)
+	}
+	return payload
+}
+
+// makeChangeUserHandshakeResp makes the handshake response 41 of the user in
+// COM_CHANGE_USER, with the capabilities of the handshake response of the
+// connection. It returns the new packet and the user name.
+// See https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_change_user.html
+func makeChangeUserHandshakeResp(
+	pack *frontend.Packet, changeUser []byte,
+) (*frontend.Packet, string, error) {
+	if pack == nil || len(pack.Payload) < handshakeResp41FixedLen {
+		return nil, "", moerr.NewInternalErrorNoCtx("invalid handshake response")
+	}
+	r := &handshakeResp41{
+		capabilities: binary.LittleEndian.Uint32(pack.Payload),
+		charset:      pack.Payload[8],
+		plugin:       []byte(frontend.AuthNativePassword),
+	}
+	copy(r.maxPacketSize[:], pack.Payload[4:8])
+	if r.capabilities&frontend.CLIENT_PROTOCOL_41 == 0 {
+		return nil, "", moerr.NewInternalErrorNoCtx("change user is not supported by handshake response 320")
+	}
+
+	errInvalid := moerr.NewInternalErrorNoCtx("invalid change user packet")
+	var ok bool
+	var rest []byte
+	if r.username, rest, ok = readStringNUL(changeUser); !ok {
+		return nil, "", errInvalid
+	}
+	if r.capabilities&frontend.CLIENT_SECURE_CONNECTION != 0 {
+		if len(rest) < 1 || len(rest) < 1+int(rest[0]) {
+			return nil, "", errInvalid
+		}
+		r.auth, rest = rest[1:1+int(rest[0])], rest[1+int(rest[0]):]
+	} else if r.auth, rest, ok = readStringNUL(rest); !ok {
+		return nil, "", errInvalid
+	}
+	if r.database, rest, ok = readStringNUL(rest); !ok {
+		return nil, "", errInvalid
+	}
+	if len(rest) >= 2 {
+		r.charset, rest = rest[0], rest[2:]
+	}
+	if r.capabilities&frontend.CLIENT_PLUGIN_AUTH != 0 && len(rest) > 0 {
+		if r.plugin, rest, ok = readStringNUL(rest); !ok {
+			return nil, "", errInvalid
+		}
+	}
+	// The rest is the connection attributes.
+	if len(rest) > 0 {
+		if r.attrs, _, ok = parseConnectAttrs(rest); !ok {
+			return nil, "", errInvalid
+		}
+	}
+
+	r.capabilities &^= frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA
+	if len(r.database) > 0 {
+		r.capabilities |= frontend.CLIENT_CONNECT_WITH_DB
+	} else {
+		r.capabilities &^= frontend.CLIENT_CONNECT_WITH_DB
+	}
+	if len(r.attrs) == 0 {
+		r.capabilities &^= frontend.CLIENT_CONNECT_ATTRS
+	}
+	payload := r.encode()
+	return &frontend.Packet{
+		Length:     int32(len(payload)),
+		SequenceID: pack.SequenceID,
+		Payload:    payload,
+	}, string(r.username), nil
+}
+
 func (s *serverConn) parseConnID(p *frontend.Packet) error {
 	if len(p.Payload) < 2 {
 		return moerr.NewInternalErrorNoCtx("protocol error: payload is too short")
@@ -101,8 +505,8 @@ func (s *serverConn) writeHandshakeResp(handshakeResp *frontend.Packet) (*fronte
 		return nil, err
 	}
 	// The CN server send a response back to indicate if the auth packet
-	// is OK to login.
-	data, err := s.readPacket()
+	// is OK to login, or it asks for more auth data.
+	data, err := s.readAuthPacket()
 	if err != nil {
 		return nil, err
 	}
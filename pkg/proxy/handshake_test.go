@@ -0,0 +1,342 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/stretchr/testify/require"
+)
+
+// testCerts are the certificates signed by a test CA.
+type testCerts struct {
+	caPEM  []byte
+	ca     *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	server tls.Certificate
+	client tls.Certificate
+}
+
+func (tc *testCerts) issue(t *testing.T, name pkix.Name, isServer bool) (tls.Certificate, []byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      name,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if isServer {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		tmpl.DNSNames = []string{"*.mo.test", "localhost"}
+		tmpl.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tc.ca, &key.PublicKey, tc.caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	require.NoError(t, err)
+	return cert, certPEM, keyPEM
+}
+
+// newTestCerts creates the certificates, and writes the CA, server
+// certificate and key to dir if it is not empty.
+func newTestCerts(t *testing.T, dir string) *testCerts {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Country: []string{"CN"}, Organization: []string{"MO"}, CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	tc := &testCerts{
+		caPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		ca:    ca,
+		caKey: key,
+	}
+	var certPEM, keyPEM []byte
+	tc.server, certPEM, keyPEM = tc.issue(t, pkix.Name{CommonName: "proxy"}, true)
+	tc.client, _, _ = tc.issue(t, pkix.Name{
+		Country: []string{"CN"}, Organization: []string{"MO"}, CommonName: "user1",
+	}, false)
+	if dir != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.pem"), tc.caPEM, 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "server.pem"), certPEM, 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "server.key"), keyPEM, 0600))
+	}
+	return tc
+}
+
+func (tc *testCerts) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(tc.ca)
+	return pool
+}
+
+func makeHandshakeResp41(username string, attrs ...string) *frontend.Packet {
+	r := &handshakeResp41{
+		capabilities: frontend.CLIENT_PROTOCOL_41 | frontend.CLIENT_SECURE_CONNECTION |
+			frontend.CLIENT_PLUGIN_AUTH | frontend.CLIENT_CONNECT_WITH_DB,
+		charset:  45,
+		username: []byte(username),
+		auth:     []byte("01234567890123456789"),
+		database: []byte("db1"),
+		plugin:   []byte(frontend.AuthNativePassword),
+		attrs:    attrs,
+	}
+	if len(attrs) > 0 {
+		r.capabilities |= frontend.CLIENT_CONNECT_ATTRS
+	}
+	payload := r.encode()
+	return &frontend.Packet{Length: int32(len(payload)), SequenceID: 1, Payload: payload}
+}
+
+func TestHandshakeResp41(t *testing.T) {
+	pack := makeHandshakeResp41("tenant1:user1", "k1", "v1", "k2", "")
+	r, err := parseHandshakeResp41(pack.Payload)
+	require.NoError(t, err)
+	require.Equal(t, "tenant1:user1", string(r.username))
+	require.Equal(t, "db1", string(r.database))
+	require.Equal(t, frontend.AuthNativePassword, string(r.plugin))
+	require.Equal(t, []string{"k1", "v1", "k2", ""}, r.attrs)
+	require.Equal(t, pack.Payload, r.encode())
+
+	// the auth response with length encoded integer and zstd level
+	r.capabilities |= frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA |
+		frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	r.auth = make([]byte, 300)
+	r.zstdLevel = 3
+	r2, err := parseHandshakeResp41(r.encode())
+	require.NoError(t, err)
+	require.Equal(t, r, r2)
+
+	_, err = parseHandshakeResp41(pack.Payload[:40])
+	require.Error(t, err)
+	_, err = parseHandshakeResp41(pack.Payload[:len(pack.Payload)-2])
+	require.Error(t, err)
+}
+
+func TestClientConn_PrepareHandshakeResp(t *testing.T) {
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c := cc.(*clientConn)
+	tc := newTestCerts(t, "")
+
+	// the attributes of proxy sent by clients are dropped.
+	pack := makeHandshakeResp41("tenant1:user1", "k1", "v1", frontend.ProxyAttrTLS, "1")
+	pack.Payload[0] |= byte(frontend.CLIENT_COMPRESS)
+	p, account, err := c.prepareHandshakeResp(pack)
+	require.NoError(t, err)
+	require.Equal(t, Tenant("tenant1"), account.tenant)
+	require.Equal(t, "user1", account.username)
+	r, err := parseHandshakeResp41(p.Payload)
+	require.NoError(t, err)
+	require.Equal(t, []string{"k1", "v1"}, r.attrs)
+	require.Zero(t, r.capabilities&frontend.CLIENT_COMPRESS)
+
+	// the TLS information of the client is sent to CN servers.
+	c.sniDomain = "mo.test"
+	cert, err := x509.ParseCertificate(tc.client.Certificate[0])
+	require.NoError(t, err)
+	c.tlsState = &tls.ConnectionState{
+		ServerName:       "Tenant2.mo.test",
+		PeerCertificates: []*x509.Certificate{cert},
+	}
+	pack = makeHandshakeResp41("user2")
+	binary.LittleEndian.PutUint32(pack.Payload,
+		binary.LittleEndian.Uint32(pack.Payload)|frontend.CLIENT_SSL)
+	p, account, err = c.prepareHandshakeResp(pack)
+	require.NoError(t, err)
+	require.Equal(t, Tenant("tenant2"), account.tenant)
+	require.Equal(t, "user2", account.username)
+	r, err = parseHandshakeResp41(p.Payload)
+	require.NoError(t, err)
+	require.Equal(t, "tenant2:user2", string(r.username))
+	require.Zero(t, r.capabilities&frontend.CLIENT_SSL)
+	require.Equal(t, []string{
+		frontend.ProxyAttrTLS, "1",
+		frontend.ProxyAttrX509Subject, "/C=CN/O=MO/CN=user1",
+		frontend.ProxyAttrX509Issuer, "/C=CN/O=MO/CN=ca",
+	}, r.attrs)
+
+	// the CN server reads the user and the attributes.
+	_, err = c.mysqlProto.HandleHandshake(context.TODO(), p.Payload)
+	require.NoError(t, err)
+	require.Equal(t, "tenant2:user2", c.mysqlProto.GetUserName())
+	require.Equal(t, "/C=CN/O=MO/CN=user1", c.mysqlProto.GetConnectAttrs()[frontend.ProxyAttrX509Subject])
+
+	// the tenant in user name must match the server name.
+	_, _, err = c.prepareHandshakeResp(makeHandshakeResp41("TENANT2:user2"))
+	require.NoError(t, err)
+	_, _, err = c.prepareHandshakeResp(makeHandshakeResp41("tenant3:user2"))
+	require.Error(t, err)
+
+	// the server name out of the domain is not routed.
+	c.tlsState.ServerName = "tenant2.other.test"
+	_, account, err = c.prepareHandshakeResp(makeHandshakeResp41("user2"))
+	require.NoError(t, err)
+	require.Equal(t, EmptyTenant, account.tenant)
+}
+
+func TestClientConn_HandshakeWithTLS(t *testing.T) {
+	tc := newTestCerts(t, "")
+	local, remote := net.Pipe()
+	defer func() {
+		_ = local.Close()
+		_ = remote.Close()
+	}()
+	s := goetty.NewIOSession(goetty.WithSessionConn(1, local),
+		goetty.WithSessionCodec(WithProxyProtocolCodec(frontend.NewSqlCodec())))
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{tc.server},
+		ClientCAs:    tc.pool(),
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	cc, err := newClientConn(context.TODO(), runtime.DefaultRuntime().Logger(), newCounterSet(), s, nil, nil, nil,
+		withClientTLS(cfg, "mo.test"))
+	require.NoError(t, err)
+	c := cc.(*clientConn)
+
+	errC := make(chan error, 1)
+	go func() {
+		errC <- func() error {
+			// the initial handshake from proxy advertises SSL.
+			p, err := readTestPacket(remote)
+			if err != nil {
+				return err
+			}
+			if !hasCapability(p, frontend.CLIENT_SSL) {
+				return net.UnknownNetworkError("no SSL capability")
+			}
+			pack := makeHandshakeResp41("user1")
+			binary.LittleEndian.PutUint32(pack.Payload,
+				binary.LittleEndian.Uint32(pack.Payload)|frontend.CLIENT_SSL)
+			if err := writeTestPacket(remote, 1, pack.Payload[:handshakeResp41FixedLen]); err != nil {
+				return err
+			}
+			conn := tls.Client(remote, &tls.Config{
+				ServerName:   "tenant1.mo.test",
+				RootCAs:      tc.pool(),
+				Certificates: []tls.Certificate{tc.client},
+			})
+			if err := conn.Handshake(); err != nil {
+				return err
+			}
+			return writeTestPacket(conn, 2, pack.Payload)
+		}()
+	}()
+
+	require.NoError(t, c.writeInitialHandshake())
+	require.NoError(t, c.handleHandshakeResp())
+	require.NoError(t, <-errC)
+	require.Equal(t, Tenant("tenant1"), c.GetTenant())
+	require.Equal(t, Tenant("tenant1"), c.labelInfo.Tenant)
+	require.NotNil(t, c.tlsState)
+	_, ok := c.RawConn().(*tls.Conn)
+	require.True(t, ok)
+	r, err := parseHandshakeResp41(c.GetHandshakePack().Payload)
+	require.NoError(t, err)
+	require.Equal(t, "tenant1:user1", string(r.username))
+	require.Contains(t, r.attrs, "/C=CN/O=MO/CN=user1")
+}
+
+func TestClientConn_RequireTLS(t *testing.T) {
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c := cc.(*clientConn)
+	c.tlsConfig = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert}
+	local, remote := net.Pipe()
+	defer func() {
+		_ = local.Close()
+		_ = remote.Close()
+	}()
+	c.conn.UseConn(local)
+	go func() {
+		_, _ = remote.Write(makeClientHandshakeResp())
+	}()
+	require.ErrorContains(t, c.handleHandshakeResp(), "without TLS")
+}
+
+func hasCapability(payload []byte, flag uint32) bool {
+	// protocol version, server version, connection id, auth-plugin-data-part-1
+	// and filler, then the lower 2 bytes of the capabilities.
+	pos := 1
+	for pos < len(payload) && payload[pos] != 0 {
+		pos++
+	}
+	pos += 1 + 4 + 8 + 1
+	if pos+2 > len(payload) {
+		return false
+	}
+	return uint32(binary.LittleEndian.Uint16(payload[pos:]))&flag != 0
+}
+
+func readTestPacket(conn net.Conn) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := readFull(conn, header); err != nil {
+		return nil, err
+	}
+	payload := make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+	_, err := readFull(conn, payload)
+	return payload, err
+}
+
+func readFull(conn net.Conn, data []byte) (int, error) {
+	var n int
+	for n < len(data) {
+		m, err := conn.Read(data[n:])
+		if err != nil {
+			return n, err
+		}
+		n += m
+	}
+	return n, nil
+}
+
+func writeTestPacket(conn net.Conn, seq byte, payload []byte) error {
+	l := len(payload)
+	_, err := conn.Write(append([]byte{byte(l), byte(l >> 8), byte(l >> 16), seq}, payload...))
+	return err
+}
@@ -19,6 +19,8 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
+	logpb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 )
 
 // LabelHash defines hash value, which is hashed from labelInfo.
@@ -96,6 +98,19 @@ func (l *labelInfo) isSuperTenant() bool {
 	return false
 }
 
+// isReadOnly returns true if the session asks for the read-only CN servers.
+func (l *labelInfo) isReadOnly() bool {
+	return l.Labels[logpb.ReadOnlyLabelKey] == "true"
+}
+
+// matchReadOnly returns true if the CN server with cnLabels could serve the
+// session. Read-only sessions are only routed to read-only CN servers, and
+// the other sessions never go to them.
+func (l *labelInfo) matchReadOnly(cnLabels map[string]metadata.LabelList) bool {
+	_, readOnlyCN := cnLabels[logpb.ReadOnlyLabelKey]
+	return readOnlyCN == l.isReadOnly()
+}
+
 // genSetVarStmt returns a statement of set session variable.
 func (l *labelInfo) genSetVarStmt() string {
 	var builder strings.Builder
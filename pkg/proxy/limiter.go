@@ -0,0 +1,266 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// limitError is the error returned when a limit is exceeded. It is sent to
+// the client with the MySQL error code.
+type limitError struct {
+	code  uint16
+	state string
+	msg   string
+}
+
+var _ error = (*limitError)(nil)
+
+func (e *limitError) Error() string {
+	return e.msg
+}
+
+// newLimitError creates a limit error with the MySQL error code.
+func newLimitError(code uint16, msg string) error {
+	return &limitError{
+		code:  code,
+		state: moerr.MysqlErrorMsgRefer[code].SqlStates[0],
+		msg:   msg,
+	}
+}
+
+// tokenBucket is the token bucket to limit the rate of events. Its burst is
+// the tokens of one second.
+type tokenBucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket creates a full token bucket with the rate per second.
+func newTokenBucket(rate int, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:   float64(rate),
+		tokens: float64(rate),
+		last:   now,
+	}
+}
+
+// refill adds the tokens generated since the last refill.
+func (b *tokenBucket) refill(now time.Time) {
+	if now.After(b.last) {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.rate {
+			b.tokens = b.rate
+		}
+		b.last = now
+	}
+}
+
+// available returns true if there is a token.
+func (b *tokenBucket) available(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= 1
+}
+
+// full returns true if the bucket is full, which means that it is the same
+// as a new one.
+func (b *tokenBucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.rate
+}
+
+// reserve takes a token and returns the time to wait until the token is
+// generated. The tokens may be negative after it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// limitKey is the key of the limit state. The user is empty if the rule
+// applies to the whole account.
+type limitKey struct {
+	rule   int
+	tenant Tenant
+	user   string
+}
+
+// limitState is the state of a rule for an account or a user.
+type limitState struct {
+	conns int
+	// connBucket limits the new connections, nil if there is no limit.
+	connBucket *tokenBucket
+	// queryBucket limits the queries, nil if there is no limit.
+	queryBucket *tokenBucket
+}
+
+// limiter limits the connections and queries of accounts and users by the
+// rules.
+type limiter struct {
+	rules []LimitRule
+	mu    struct {
+		sync.Mutex
+		states map[limitKey]*limitState
+	}
+}
+
+// newLimiter creates a new limiter, it returns nil if there is no rule.
+func newLimiter(rules []LimitRule) (*limiter, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	for _, r := range rules {
+		if r.MaxConns < 0 || r.ConnsPerSecond < 0 || r.QueriesPerSecond < 0 {
+			return nil, moerr.NewInternalErrorNoCtx("invalid limit rule of %s:%s, negative limit",
+				r.Account, r.User)
+		}
+		if r.MaxConns == 0 && r.ConnsPerSecond == 0 && r.QueriesPerSecond == 0 {
+			return nil, moerr.NewInternalErrorNoCtx("limit rule of %s:%s has no limit",
+				r.Account, r.User)
+		}
+	}
+	l := &limiter{rules: rules}
+	l.mu.states = make(map[limitKey]*limitState)
+	return l, nil
+}
+
+// matchedLimit is a rule matched by an account and its state.
+type matchedLimit struct {
+	rule  *LimitRule
+	key   limitKey
+	state *limitState
+}
+
+// matchLocked returns the rules matched by the account and their states.
+// The states are created if they do not exist.
+func (l *limiter) matchLocked(account accountInfo, now time.Time) []matchedLimit {
+	var matched []matchedLimit
+	tenant := Tenant(strings.ToLower(string(account.tenant)))
+	for i := range l.rules {
+		r := &l.rules[i]
+		if len(r.Account) > 0 && !strings.EqualFold(r.Account, string(tenant)) {
+			continue
+		}
+		if len(r.User) > 0 && r.User != account.username {
+			continue
+		}
+		key := limitKey{rule: i, tenant: tenant}
+		if len(r.User) > 0 {
+			key.user = account.username
+		}
+		s, ok := l.mu.states[key]
+		if !ok {
+			s = &limitState{}
+			if r.ConnsPerSecond > 0 {
+				s.connBucket = newTokenBucket(r.ConnsPerSecond, now)
+			}
+			if r.QueriesPerSecond > 0 {
+				s.queryBucket = newTokenBucket(r.QueriesPerSecond, now)
+			}
+			l.mu.states[key] = s
+		}
+		matched = append(matched, matchedLimit{rule: r, key: key, state: s})
+	}
+	return matched
+}
+
+// acquireConn checks the limits of the new connection of the account. It
+// returns the function to release the connection if the connection is
+// allowed, or the limit error.
+func (l *limiter) acquireConn(account accountInfo) (func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	matched := l.matchLocked(account, now)
+	for _, m := range matched {
+		if m.rule.MaxConns > 0 && m.state.conns >= m.rule.MaxConns {
+			return nil, newLimitError(moerr.ER_TOO_MANY_USER_CONNECTIONS,
+				fmt.Sprintf("User %s already has more than 'max-conns' active connections",
+					account.username))
+		}
+		if m.state.connBucket != nil && !m.state.connBucket.available(now) {
+			return nil, newLimitError(moerr.ER_USER_LIMIT_REACHED,
+				fmt.Sprintf("User '%s' has exceeded the 'conns-per-second' resource (current value: %d)",
+					account.username, m.rule.ConnsPerSecond))
+		}
+	}
+	for _, m := range matched {
+		m.state.conns++
+		if m.state.connBucket != nil {
+			m.state.connBucket.reserve(now)
+		}
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() { l.releaseConn(account) })
+	}, nil
+}
+
+// releaseConn releases the connection of the account. The states which
+// are the same as the new ones are removed.
+func (l *limiter) releaseConn(account accountInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	for _, m := range l.matchLocked(account, now) {
+		if m.state.conns > 0 {
+			m.state.conns--
+		}
+		if m.state.conns == 0 &&
+			(m.state.connBucket == nil || m.state.connBucket.full(now)) &&
+			(m.state.queryBucket == nil || m.state.queryBucket.full(now)) {
+			delete(l.mu.states, m.key)
+		}
+	}
+}
+
+// waitQuery waits until the query of the account is allowed by the limits
+// of queries per second.
+func (l *limiter) waitQuery(ctx context.Context, account accountInfo) error {
+	var wait time.Duration
+	func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		now := time.Now()
+		for _, m := range l.matchLocked(account, now) {
+			if m.state.queryBucket != nil {
+				if d := m.state.queryBucket.reserve(now); d > wait {
+					wait = d
+				}
+			}
+		}
+	}()
+	if wait == 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	b := newTokenBucket(2, now)
+	require.True(t, b.full(now))
+	require.Equal(t, time.Duration(0), b.reserve(now))
+	require.Equal(t, time.Duration(0), b.reserve(now))
+	require.False(t, b.available(now))
+	require.Equal(t, time.Second/2, b.reserve(now))
+
+	now = now.Add(time.Second)
+	require.True(t, b.available(now))
+	require.False(t, b.full(now))
+	now = now.Add(time.Second)
+	require.True(t, b.full(now))
+}
+
+func TestNewLimiter(t *testing.T) {
+	l, err := newLimiter(nil)
+	require.NoError(t, err)
+	require.Nil(t, l)
+
+	_, err = newLimiter([]LimitRule{{Account: "acc1"}})
+	require.Error(t, err)
+	_, err = newLimiter([]LimitRule{{Account: "acc1", MaxConns: -1}})
+	require.Error(t, err)
+	l, err = newLimiter([]LimitRule{{Account: "acc1", MaxConns: 1}})
+	require.NoError(t, err)
+	require.NotNil(t, l)
+}
+
+func TestLimiterMaxConns(t *testing.T) {
+	l, err := newLimiter([]LimitRule{
+		{MaxConns: 3},
+		{Account: "acc1", User: "u1", MaxConns: 1},
+	})
+	require.NoError(t, err)
+
+	u1 := accountInfo{tenant: "acc1", username: "u1"}
+	u2 := accountInfo{tenant: "ACC1", username: "u2"}
+	other := accountInfo{tenant: "acc2", username: "u1"}
+
+	release1, err := l.acquireConn(u1)
+	require.NoError(t, err)
+	// The limit of the user.
+	_, err = l.acquireConn(u1)
+	var le *limitError
+	require.True(t, errors.As(err, &le))
+	require.Equal(t, moerr.ER_TOO_MANY_USER_CONNECTIONS, le.code)
+
+	// The limit of each account.
+	_, err = l.acquireConn(u2)
+	require.NoError(t, err)
+	_, err = l.acquireConn(u2)
+	require.NoError(t, err)
+	_, err = l.acquireConn(u2)
+	require.Error(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = l.acquireConn(other)
+		require.NoError(t, err)
+	}
+
+	// The connection is released only once.
+	release1()
+	release1()
+	_, err = l.acquireConn(u2)
+	require.NoError(t, err)
+	_, err = l.acquireConn(u2)
+	require.Error(t, err)
+}
+
+func TestLimiterConnsPerSecond(t *testing.T) {
+	l, err := newLimiter([]LimitRule{{Account: "acc1", ConnsPerSecond: 1}})
+	require.NoError(t, err)
+
+	account := accountInfo{tenant: "acc1", username: "u1"}
+	release, err := l.acquireConn(account)
+	require.NoError(t, err)
+	release()
+	_, err = l.acquireConn(account)
+	var le *limitError
+	require.True(t, errors.As(err, &le))
+	require.Equal(t, moerr.ER_USER_LIMIT_REACHED, le.code)
+
+	// The other accounts are not limited.
+	_, err = l.acquireConn(accountInfo{tenant: "acc2", username: "u1"})
+	require.NoError(t, err)
+}
+
+func TestLimiterWaitQuery(t *testing.T) {
+	l, err := newLimiter([]LimitRule{{Account: "acc1", QueriesPerSecond: 10}})
+	require.NoError(t, err)
+
+	account := accountInfo{tenant: "acc1", username: "u1"}
+	ctx := context.Background()
+	start := time.Now()
+	for i := 0; i < 12; i++ {
+		require.NoError(t, l.waitQuery(ctx, account))
+	}
+	require.GreaterOrEqual(t, time.Since(start), time.Millisecond*150)
+
+	// The wait is canceled by the context.
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	for i := 0; i < 10; i++ {
+		_ = l.waitQuery(ctx, account)
+	}
+	require.Error(t, l.waitQuery(ctx, account))
+
+	// The other accounts are not limited.
+	require.NoError(t, l.waitQuery(ctx, accountInfo{tenant: "acc2", username: "u1"}))
+}
@@ -50,8 +50,33 @@ const (
 // MySQLCmd is the type indicate the cmd of statement.
 type MySQLCmd byte
 
-// cmdQuery is a query cmd.
-const cmdQuery MySQLCmd = 0x03
+const (
+	// cmdInitDB is the cmd to change the current database.
+	cmdInitDB MySQLCmd = 0x02
+	// cmdQuery is a query cmd.
+	cmdQuery MySQLCmd = 0x03
+	// cmdStmtPrepare is the cmd to prepare a statement.
+	cmdStmtPrepare MySQLCmd = 0x16
+	// cmdStmtExecute is the cmd to execute a prepared statement.
+	cmdStmtExecute MySQLCmd = 0x17
+	// cmdChangeUser is the cmd to change the user of the connection.
+	cmdChangeUser MySQLCmd = 0x11
+	// cmdResetConnection is the cmd to reset the session of the connection.
+	cmdResetConnection MySQLCmd = 0x1f
+)
+
+// pinTag indicates how the message changes the pin state of the session.
+type pinTag uint8
+
+const (
+	// pinNone means the message does not change the pin state.
+	pinNone pinTag = 0
+	// pinSession means the message makes states in the session which cannot
+	// be restored in another server connection, such as prepared statements.
+	pinSession pinTag = 1
+	// unpinSession means the message resets the session.
+	unpinSession pinTag = 2
+)
 
 // MySQLConn contains a buffer to save data which may be only part
 // of a packet.
@@ -143,12 +168,62 @@ func (b *msgBuf) preRecv() (int, txnTag, error) {
 		} else if isStmtBegin(b.buf[b.begin+preRecvLen : b.end]) {
 			txnRet = txnBegin
 		}
+	} else if isSessionResetCmd(b.buf[b.begin : b.begin+preRecvLen]) {
+		// The open transaction is rolled back by the server.
+		txnRet = txnEnd
 	}
 
 	// Data length does not count header length, so header length is added to it.
 	return bodyLen + mysqlHeadLen, txnRet, nil
 }
 
+// pinTag returns how the message received by preRecv changes the pin state
+// of the session. The session is pinned to the server connection, until it
+// is reset by COM_RESET_CONNECTION or COM_CHANGE_USER.
+func (b *msgBuf) pinTag() pinTag {
+	if b.readAvail() < preRecvLen || b.buf[b.begin+3] != 0 {
+		return pinNone
+	}
+	if isSessionResetCmd(b.buf[b.begin : b.begin+preRecvLen]) {
+		return unpinSession
+	}
+	switch MySQLCmd(b.buf[b.begin+mysqlHeadLen]) {
+	case cmdStmtPrepare:
+		return pinSession
+	case cmdQuery:
+		if isStmtPinSession(b.query()) {
+			return pinSession
+		}
+	}
+	return pinNone
+}
+
+// isQuery returns true if the message received by preRecv is a COM_QUERY
+// or a COM_STMT_EXECUTE.
+func (b *msgBuf) isQuery() bool {
+	if b.readAvail() < preRecvLen || b.buf[b.begin+3] != 0 {
+		return false
+	}
+	cmd := MySQLCmd(b.buf[b.begin+mysqlHeadLen])
+	return cmd == cmdQuery || cmd == cmdStmtExecute
+}
+
+// query returns the statement of the COM_QUERY received by preRecv. It may
+// be a part of the statement if the packet is larger than the buffer. It
+// returns nil if the message is not a COM_QUERY.
+func (b *msgBuf) query() []byte {
+	if b.readAvail() < preRecvLen || b.buf[b.begin+3] != 0 ||
+		b.buf[b.begin+mysqlHeadLen] != byte(cmdQuery) {
+		return nil
+	}
+	bodyLen := int(uint32(b.buf[b.begin]) | uint32(b.buf[b.begin+1])<<8 | uint32(b.buf[b.begin+2])<<16)
+	end := b.begin + mysqlHeadLen + bodyLen
+	if end > b.end {
+		end = b.end
+	}
+	return b.buf[b.begin+preRecvLen : end]
+}
+
 // consumeMsg consumes the MySQL packet in the buffer, handles it by event
 // mechanism. Returns true if the command is handled, means it does not need
 // to be sent through tunnel anymore; false otherwise.
@@ -125,6 +125,61 @@ func TestMySQLConnPreRecv(t *testing.T) {
 	})
 }
 
+func TestMySQLConnPinTag(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	kases := []struct {
+		msg []byte
+		tag pinTag
+	}{
+		{makeSimplePacket("select 1"), pinNone},
+		{makeSimplePacket("prepare s1 from 'select 1'"), pinSession},
+		{[]byte{2, 0, 0, 0, byte(cmdStmtPrepare), 's'}, pinSession},
+		{[]byte{1, 0, 0, 0, byte(cmdResetConnection)}, unpinSession},
+		// the packet from the server is not a command
+		{[]byte{2, 0, 0, 1, byte(cmdStmtPrepare), 's'}, pinNone},
+	}
+	for _, kase := range kases {
+		src, dst := net.Pipe()
+		go func(msg []byte) {
+			_, _ = dst.Write(msg)
+		}(kase.msg)
+		sc := newMySQLConn("source", src, 0, nil, nil)
+		_, _, err := sc.preRecv()
+		require.NoError(t, err)
+		require.Equal(t, kase.tag, sc.pinTag())
+		_ = src.Close()
+		_ = dst.Close()
+	}
+}
+
+func TestMySQLConnQuery(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	kases := []struct {
+		msg   []byte
+		query []byte
+	}{
+		{makeSimplePacket("select 1"), []byte("select 1")},
+		{[]byte{2, 0, 0, 0, byte(cmdInitDB), 'd'}, nil},
+		// the packet from the server is not a command
+		{[]byte{2, 0, 0, 1, byte(comQuery), 's'}, nil},
+	}
+	for _, kase := range kases {
+		src, dst := net.Pipe()
+		go func(msg []byte) {
+			_, _ = dst.Write(msg)
+		}(kase.msg)
+		sc := newMySQLConn("source", src, 0, nil, nil)
+		_, _, err := sc.preRecv()
+		require.NoError(t, err)
+		require.Equal(t, kase.query, sc.query())
+		require.Equal(t, kase.query != nil, sc.isQuery())
+		_ = src.Close()
+		_ = dst.Close()
+	}
+}
+
 func TestMySQLConnReceive(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 
@@ -0,0 +1,217 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/log"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"go.uber.org/zap"
+)
+
+// poolKey is the key of server connections in the pool. The server
+// connections could be reused only by the client connections which have
+// the same labels, user, current database and protocol capabilities.
+type poolKey struct {
+	// hash is the hash of the labels of the client connection.
+	hash LabelHash
+	// username is the whole username, including the tenant.
+	username string
+	// database is the current database of the session.
+	database string
+	// capabilities and charset are negotiated in the handshake phase.
+	capabilities uint32
+	charset      uint8
+}
+
+// pooledConn is an idle server connection in the pool.
+type pooledConn struct {
+	sc ServerConn
+	// idleSince is the time that the connection is put into the pool.
+	idleSince time.Time
+}
+
+// serverConnPool keeps the server connections released by the idle client
+// connections, and the client connections take server connections from it
+// when they send the next commands. So the idle client connections are
+// multiplexed onto a smaller number of server connections.
+type serverConnPool struct {
+	stopper *stopper.Stopper
+	logger  *log.MOLogger
+	// connManager is used to find the tunnels on the CN servers.
+	connManager *connManager
+	// idleTimeout is the time that a client connection is idle before it
+	// releases the server connection.
+	idleTimeout time.Duration
+	// detachIdle indicates that the idle client connections release their
+	// server connections, which is the pooling mode. Otherwise the pool only
+	// keeps the server connections released by routing.
+	detachIdle bool
+	// maxIdleConns is the max number of idle server connections of a key.
+	maxIdleConns int
+	// maxIdleTime is the max time that a server connection is idle in the
+	// pool before it is closed.
+	maxIdleTime time.Duration
+
+	mu struct {
+		sync.Mutex
+		// closed indicates that the pool is closed.
+		closed bool
+		// conns is the map poolKey => idle server connections.
+		conns map[poolKey][]pooledConn
+	}
+}
+
+// newServerConnPool creates a new server connection pool.
+func newServerConnPool(
+	stopper *stopper.Stopper, logger *log.MOLogger, cm *connManager, cfg Config,
+) (*serverConnPool, error) {
+	p := &serverConnPool{
+		stopper:      stopper,
+		logger:       logger,
+		connManager:  cm,
+		idleTimeout:  cfg.Pool.IdleTimeout.Duration,
+		detachIdle:   cfg.Pool.Enabled,
+		maxIdleConns: cfg.Pool.MaxIdleConns,
+		maxIdleTime:  cfg.Pool.MaxIdleTime.Duration,
+	}
+	p.mu.conns = make(map[poolKey][]pooledConn)
+
+	// Starts the go-routine to release the server connections of idle client
+	// connections, and close the expired server connections in the pool.
+	if err := p.stopper.RunNamedTask("server-conn-pool", p.run); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// run begins the loop to check the idle connections.
+func (p *serverConnPool) run(ctx context.Context) {
+	ticker := time.NewTicker(p.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if p.detachIdle {
+				p.detachIdleTunnels(ctx)
+			}
+			p.closeExpired()
+		case <-ctx.Done():
+			p.close()
+			p.logger.Info("server connection pool ended")
+			return
+		}
+	}
+}
+
+// detachIdleTunnels makes the tunnels, which are idle longer than the idle
+// timeout, release their server connections.
+func (p *serverConnPool) detachIdleTunnels(ctx context.Context) {
+	for _, t := range p.connManager.getTunnels() {
+		if time.Since(t.lastActiveTime()) < p.idleTimeout {
+			continue
+		}
+		if _, err := t.detach(ctx); err != nil {
+			p.logger.Error("failed to detach tunnel", zap.Error(err))
+		}
+	}
+}
+
+// put puts the server connection into the pool. The connection is closed
+// if the pool is full or closed.
+func (p *serverConnPool) put(key poolKey, sc ServerConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.mu.closed || len(p.mu.conns[key]) >= p.maxIdleConns {
+		_ = sc.RawConn().Close()
+		return
+	}
+	p.mu.conns[key] = append(p.mu.conns[key], pooledConn{
+		sc:        sc,
+		idleSince: time.Now(),
+	})
+}
+
+// get takes a server connection of the key from the pool. It returns nil
+// if there is no one. The most recently used one is returned, so that the
+// others could be expired and closed.
+func (p *serverConnPool) get(key poolKey) ServerConn {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	conns := p.mu.conns[key]
+	if len(conns) == 0 {
+		return nil
+	}
+	pc := conns[len(conns)-1]
+	if len(conns) == 1 {
+		delete(p.mu.conns, key)
+	} else {
+		p.mu.conns[key] = conns[:len(conns)-1]
+	}
+	return pc.sc
+}
+
+// count returns the number of idle server connections in the pool.
+func (p *serverConnPool) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var n int
+	for _, conns := range p.mu.conns {
+		n += len(conns)
+	}
+	return n
+}
+
+// closeExpired closes the server connections which are idle longer than
+// the max idle time.
+func (p *serverConnPool) closeExpired() {
+	var expired []pooledConn
+	func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		for key, conns := range p.mu.conns {
+			// The connections are sorted by the idle time.
+			i := 0
+			for i < len(conns) && time.Since(conns[i].idleSince) >= p.maxIdleTime {
+				i++
+			}
+			expired = append(expired, conns[:i]...)
+			if i == len(conns) {
+				delete(p.mu.conns, key)
+			} else if i > 0 {
+				p.mu.conns[key] = append([]pooledConn(nil), conns[i:]...)
+			}
+		}
+	}()
+	for _, pc := range expired {
+		_ = pc.sc.RawConn().Close()
+	}
+}
+
+// close closes all server connections in the pool.
+func (p *serverConnPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.mu.closed = true
+	for key, conns := range p.mu.conns {
+		for _, pc := range conns {
+			_ = pc.sc.RawConn().Close()
+		}
+		delete(p.mu.conns, key)
+	}
+}
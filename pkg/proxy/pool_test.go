@@ -0,0 +1,186 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lni/goutils/leaktest"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/require"
+)
+
+// testPoolServerConn records the statements and the session resets.
+type testPoolServerConn struct {
+	*mockServerConn
+	stmts    []string
+	reset    int
+	attached *tunnel
+}
+
+func newTestPoolServerConn(conn net.Conn) *testPoolServerConn {
+	return &testPoolServerConn{mockServerConn: newMockServerConn(conn)}
+}
+
+func (s *testPoolServerConn) ExecStmt(stmt string, _ chan<- []byte) error {
+	s.stmts = append(s.stmts, stmt)
+	return nil
+}
+func (s *testPoolServerConn) ResetSession() error {
+	s.reset++
+	return nil
+}
+func (s *testPoolServerConn) Attach(t *tunnel) { s.attached = t }
+
+// isClosed returns true if the peer of the connection is closed.
+func isClosed(peer net.Conn) bool {
+	_ = peer.SetWriteDeadline(time.Now().Add(time.Millisecond * 100))
+	_, err := peer.Write([]byte{0})
+	var netErr net.Error
+	return err != nil && !(errors.As(err, &netErr) && netErr.Timeout())
+}
+
+func newTestServerConnPool(t *testing.T, st *stopper.Stopper, maxIdleConns int) *serverConnPool {
+	cfg := Config{}
+	cfg.Pool.Enabled = true
+	cfg.Pool.IdleTimeout = toml.Duration{Duration: time.Hour}
+	cfg.Pool.MaxIdleConns = maxIdleConns
+	cfg.Pool.MaxIdleTime = toml.Duration{Duration: time.Hour}
+	p, err := newServerConnPool(st, runtime.DefaultRuntime().Logger(), newConnManager(), cfg)
+	require.NoError(t, err)
+	return p
+}
+
+func TestServerConnPool(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	st := stopper.NewStopper("test-pool")
+	defer st.Stop()
+	p := newTestServerConnPool(t, st, 2)
+
+	k1 := poolKey{username: "u1", database: "db1"}
+	k2 := poolKey{username: "u1", database: "db2"}
+	require.Nil(t, p.get(k1))
+
+	var peers []net.Conn
+	var conns []ServerConn
+	for i := 0; i < 3; i++ {
+		c, peer := net.Pipe()
+		peers = append(peers, peer)
+		conns = append(conns, newMockServerConn(c))
+		p.put(k1, conns[i])
+	}
+	// The pool is full, the third one is closed.
+	require.Equal(t, 2, p.count())
+	require.True(t, isClosed(peers[2]))
+	require.Nil(t, p.get(k2))
+
+	// The most recently used one is returned.
+	require.Equal(t, conns[1], p.get(k1))
+	require.Equal(t, 1, p.count())
+
+	// The expired connections are closed.
+	p.maxIdleTime = 0
+	p.closeExpired()
+	require.Equal(t, 0, p.count())
+	require.True(t, isClosed(peers[0]))
+	require.False(t, isClosed(peers[1]))
+
+	// The connections are closed after the pool is closed.
+	c, peer := net.Pipe()
+	p.put(k2, newMockServerConn(c))
+	p.close()
+	require.Equal(t, 0, p.count())
+	require.True(t, isClosed(peer))
+	c, peer = net.Pipe()
+	p.put(k2, newMockServerConn(c))
+	require.True(t, isClosed(peer))
+	_ = peers[1].Close()
+}
+
+func TestClientConn_PoolKey(t *testing.T) {
+	c := &clientConn{}
+	_, ok := c.poolKey()
+	require.False(t, ok)
+
+	c.handshakePack = makeHandshakeResp41("t1:u1")
+	c.labelInfo = newLabelInfo("t1", map[string]string{"k1": "v1"})
+	key, ok := c.poolKey()
+	require.True(t, ok)
+	hash, err := c.labelInfo.getHash()
+	require.NoError(t, err)
+	require.Equal(t, hash, key.hash)
+	require.Equal(t, "t1:u1", key.username)
+	require.Equal(t, "db1", key.database)
+	require.Equal(t, uint8(45), key.charset)
+
+	// The current database is changed by the client.
+	require.NoError(t, c.handleInitDB(&initDBEvent{db: "db2"}))
+	key, ok = c.poolKey()
+	require.True(t, ok)
+	require.Equal(t, "db2", key.database)
+}
+
+func TestClientConn_ReleaseAndAcquire(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	st := stopper.NewStopper("test-pool")
+	defer st.Stop()
+	p := newTestServerConnPool(t, st, 2)
+
+	tu := newTunnel(context.TODO(), nil, newCounterSet())
+	defer func() { _ = tu.Close() }()
+	c := &clientConn{
+		log:           runtime.DefaultRuntime().Logger(),
+		counterSet:    newCounterSet(),
+		handshakePack: makeHandshakeResp41("t1:u1"),
+		labelInfo:     newLabelInfo("t1", nil),
+		tun:           tu,
+		pool:          p,
+		setVarStmts:   []string{"set @a=1"},
+	}
+	conn, peer := net.Pipe()
+	defer func() { _ = peer.Close() }()
+	sc := newTestPoolServerConn(conn)
+	c.ReleaseServerConn(sc)
+	require.Equal(t, 1, sc.reset)
+	require.Equal(t, 1, p.count())
+	require.Equal(t, int64(1), c.counterSet.connPoolReleased.Load())
+
+	// The session is restored in the pooled connection.
+	got, err := c.AcquireServerConn()
+	require.NoError(t, err)
+	require.Equal(t, sc, got)
+	require.Equal(t, tu, sc.attached)
+	require.Equal(t, []string{c.labelInfo.genSetVarStmt(), "set @a=1"}, sc.stmts)
+	require.Equal(t, int64(1), c.counterSet.connPoolReused.Load())
+	require.Equal(t, 0, p.count())
+
+	// The connection of another database is not reused by the client.
+	c.ReleaseServerConn(sc)
+	require.NoError(t, c.handleInitDB(&initDBEvent{db: "db2"}))
+	key, ok := c.poolKey()
+	require.True(t, ok)
+	require.Nil(t, p.get(key))
+	require.Equal(t, 1, p.count())
+}
@@ -148,6 +148,9 @@ func (r *rebalancer) collectTunnels(hash LabelHash) []*tunnel {
 	li := r.connManager.getLabelInfo(hash)
 	var cns []*CNServer
 	r.mc.GetCNService(li.genSelector(), func(s metadata.CNService) bool {
+		if !li.matchReadOnly(s.Labels) {
+			return true
+		}
 		cns = append(cns, &CNServer{
 			hash:     hash,
 			reqLabel: li,
@@ -15,6 +15,7 @@
 package proxy
 
 import (
+	"crypto/tls"
 	"net"
 	"time"
 
@@ -52,6 +53,9 @@ type CNServer struct {
 	// salt is generated in proxy module and will be sent to backend
 	// server when build connection.
 	salt []byte
+	// secret is shared with backend server, the tag of the salt made by
+	// it is sent after the salt if it is set.
+	secret string
 	// reqLabel is the client requests, but not the label which CN server really has.
 	reqLabel labelInfo
 	// cnLabel is the labels that CN server has.
@@ -81,7 +85,11 @@ func (s *CNServer) Connect() (goetty.IOSession, error) {
 	}
 	// When build connection with backend server, proxy send its salt
 	// to make sure the backend server uses the same salt to do authentication.
-	if err := c.Write(s.salt, goetty.WriteOptions{Flush: true}); err != nil {
+	data := s.salt
+	if len(s.secret) != 0 {
+		data = append(append([]byte(nil), s.salt...), frontend.ProxyTag(s.secret, s.salt)...)
+	}
+	if err := c.Write(data, goetty.WriteOptions{Flush: true}); err != nil {
 		return nil, err
 	}
 	return c, nil
@@ -94,21 +102,58 @@ type router struct {
 	rebalancer *rebalancer
 	moCluster  clusterservice.MOCluster
 	test       bool
+	// tlsConfig is the TLS config to connect to CN servers, nil if TLS
+	// is not enabled.
+	tlsConfig *tls.Config
+	// breaker stops routing to the failed CN servers, nil if it is disabled.
+	breaker *circuitBreaker
+	// secret is shared with CN servers to prove the connections come from
+	// proxy.
+	secret string
 }
 
 var _ Router = (*router)(nil)
 
-// newCNConnector creates a Router.
+// routerOption defines the function to set options of router.
+type routerOption func(*router)
+
+// withBackendTLS sets the TLS config to connect to CN servers.
+func withBackendTLS(cfg *tls.Config) routerOption {
+	return func(r *router) {
+		r.tlsConfig = cfg
+	}
+}
+
+// withSecret sets the secret shared with CN servers.
+func withSecret(secret string) routerOption {
+	return func(r *router) {
+		r.secret = secret
+	}
+}
+
+// withCircuitBreaker sets the circuit breaker of CN servers.
+func withCircuitBreaker(b *circuitBreaker) routerOption {
+	return func(r *router) {
+		r.breaker = b
+	}
+}
+
+// newRouter creates a Router.
 func newRouter(
 	mc clusterservice.MOCluster,
 	r *rebalancer,
 	test bool,
+	opts ...routerOption,
 ) Router {
-	return &router{
+	ru := &router{
 		rebalancer: r,
 		moCluster:  mc,
 		test:       test,
 	}
+	for _, opt := range opts {
+		opt(ru)
+	}
+	return ru
 }
 
 // SelectByConnID implements the CNConnector interface.
@@ -127,26 +172,40 @@ func (r *router) SelectByConnID(connID uint32) (*CNServer, error) {
 
 // SelectByLabel implements the CNConnector interface.
 func (r *router) SelectByLabel(label labelInfo) (*CNServer, error) {
-	var cns []*CNServer
+	var cns, brokenCNs []*CNServer
 	var cnEmpty, cnNotEmpty bool
 	selector := label.genSelector()
 	if label.isSuperTenant() {
 		selector = clusterservice.NewSelector()
 	}
 	r.moCluster.GetCNService(selector, func(s metadata.CNService) bool {
-		cns = append(cns, &CNServer{
+		if !label.matchReadOnly(s.Labels) {
+			return true
+		}
+		cn := &CNServer{
 			reqLabel: label,
 			cnLabel:  s.Labels,
 			uuid:     s.ServiceID,
 			addr:     s.SQLAddress,
-		})
-		if len(s.Labels) > 0 {
+		}
+		if r.breaker != nil && !r.breaker.available(s.ServiceID) {
+			brokenCNs = append(brokenCNs, cn)
+			return true
+		}
+		cns = append(cns, cn)
+		return true
+	})
+	// If all the CN servers are broken, try them anyway.
+	if len(cns) == 0 {
+		cns = brokenCNs
+	}
+	for _, cn := range cns {
+		if len(cn.cnLabel) > 0 {
 			cnNotEmpty = true
 		} else {
 			cnEmpty = true
 		}
-		return true
-	})
+	}
 	if len(cns) == 0 {
 		return nil, moerr.NewInternalErrorNoCtx("no available CN server.")
 	} else if len(cns) == 1 {
@@ -173,8 +232,10 @@ func (r *router) Connect(
 	cn *CNServer, handshakeResp *frontend.Packet, t *tunnel,
 ) (_ ServerConn, _ []byte, e error) {
 	// Creates a server connection.
-	sc, err := newServerConn(cn, t, r.rebalancer)
+	cn.secret = r.secret
+	sc, err := newServerConn(cn, t, r.rebalancer, r.tlsConfig)
 	if err != nil {
+		r.onConnectFailure(cn)
 		return nil, nil, err
 	}
 	defer func() {
@@ -195,8 +256,12 @@ func (r *router) Connect(
 	resp, err := sc.HandleHandshake(handshakeResp)
 	if err != nil {
 		r.rebalancer.connManager.disconnect(cn, t)
+		r.onConnectFailure(cn)
 		return nil, nil, err
 	}
+	if r.breaker != nil {
+		r.breaker.onSuccess(cn.uuid)
+	}
 	// After handshake with backend CN server, set the connID of serverConn.
 	cn.connID = sc.ConnID()
 
@@ -205,3 +270,10 @@ func (r *router) Connect(
 
 	return sc, packetToBytes(resp), nil
 }
+
+// onConnectFailure records the failure to connect to the CN server.
+func (r *router) onConnectFailure(cn *CNServer) {
+	if r.breaker != nil {
+		r.breaker.onFailure(cn.uuid)
+	}
+}
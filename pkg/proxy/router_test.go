@@ -17,6 +17,8 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"os"
 	"testing"
 	"time"
@@ -25,6 +27,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/clusterservice"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
+	logpb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
 	"github.com/stretchr/testify/require"
 )
@@ -56,6 +60,41 @@ func TestCNServer(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, c)
 	})
+
+	t.Run("secret", func(t *testing.T) {
+		addr := fmt.Sprintf("%s/%d.sock", temp, time.Now().Nanosecond())
+		require.NoError(t, os.RemoveAll(addr))
+		l, err := net.Listen("unix", addr)
+		require.NoError(t, err)
+		defer func() {
+			_ = l.Close()
+		}()
+		cn := testMakeCNServer("", addr, 0, "", labelInfo{})
+		cn.salt = []byte("01234567890123456789")
+		cn.secret = "secret"
+		received := make(chan []byte, 1)
+		go func() {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer func() {
+				_ = conn.Close()
+			}()
+			data := make([]byte, len(cn.salt)+frontend.ProxyTagLength)
+			if _, err := io.ReadFull(conn, data); err == nil {
+				received <- data
+			}
+		}()
+		c, err := cn.Connect()
+		require.NoError(t, err)
+		defer func() {
+			_ = c.Close()
+		}()
+		data := <-received
+		require.Equal(t, cn.salt, data[:len(cn.salt)])
+		require.Equal(t, frontend.ProxyTag("secret", cn.salt), data[len(cn.salt):])
+	})
 }
 
 func TestRouter_SelectEmptyCN(t *testing.T) {
@@ -163,6 +202,44 @@ func TestRouter_SelectByLabel(t *testing.T) {
 	require.NotNil(t, cn)
 }
 
+func TestRouter_SelectReadOnly(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	rt := runtime.DefaultRuntime()
+	logger := rt.Logger()
+	st := stopper.NewStopper("test-proxy", stopper.WithLogger(rt.Logger().RawLogger()))
+	defer st.Stop()
+	hc := &mockHAKeeperClient{}
+	hc.updateCN("cn1", "", map[string]metadata.LabelList{})
+	hc.updateCN("cn2", "", map[string]metadata.LabelList{
+		logpb.ReadOnlyLabelKey: {Labels: []string{"true"}},
+	})
+
+	mc := clusterservice.NewMOCluster(hc, 3*time.Second)
+	defer mc.Close()
+	mc.ForceRefresh()
+	time.Sleep(time.Millisecond * 200)
+	re := testRebalancer(t, st, logger, mc)
+
+	ru := newRouter(mc, re, true)
+
+	for i := 0; i < 5; i++ {
+		cn, err := ru.SelectByLabel(labelInfo{Tenant: "sys"})
+		require.NoError(t, err)
+		require.Equal(t, "cn1", cn.uuid)
+
+		cn, err = ru.SelectByLabel(labelInfo{
+			Tenant: "sys",
+			Labels: map[string]string{
+				logpb.ReadOnlyLabelKey: "true",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "cn2", cn.uuid)
+	}
+}
+
 func TestRouter_SelectByConnID(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 
@@ -408,3 +485,42 @@ func TestRouter_ConnectAndSelectSpecify(t *testing.T) {
 
 	require.Equal(t, 1, len(connResult))
 }
+
+func TestRouter_SelectWithCircuitBreaker(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	rt := runtime.DefaultRuntime()
+	logger := rt.Logger()
+	st := stopper.NewStopper("test-proxy", stopper.WithLogger(rt.Logger().RawLogger()))
+	defer st.Stop()
+	hc := &mockHAKeeperClient{}
+	// Construct backend CN servers.
+	hc.updateCN("cn1", "", map[string]metadata.LabelList{})
+	hc.updateCN("cn2", "", map[string]metadata.LabelList{})
+
+	mc := clusterservice.NewMOCluster(hc, 3*time.Second)
+	defer mc.Close()
+	mc.ForceRefresh()
+	time.Sleep(time.Millisecond * 200)
+	re := testRebalancer(t, st, logger, mc)
+
+	b := newCircuitBreaker(logger, 1, time.Hour)
+	ru := newRouter(mc, re, false, withCircuitBreaker(b))
+
+	// The failure to connect to cn1 opens its circuit breaker.
+	_, _, err := ru.Connect(&CNServer{uuid: "cn1", addr: "127.0.0.1:0"}, nil, nil)
+	require.Error(t, err)
+	require.False(t, b.available("cn1"))
+	for i := 0; i < 10; i++ {
+		cn, err := ru.SelectByLabel(labelInfo{Tenant: "t1"})
+		require.NoError(t, err)
+		require.Equal(t, "cn2", cn.uuid)
+	}
+
+	// The CN servers are selected anyway if all of them are broken.
+	b.onFailure("cn2")
+	cn, err := ru.SelectByLabel(labelInfo{Tenant: "t1"})
+	require.NoError(t, err)
+	require.NotNil(t, cn)
+}
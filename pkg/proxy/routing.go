@@ -0,0 +1,207 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// stmtTypeSelect is the type of the read-only statements.
+	stmtTypeSelect = "select"
+	// stmtTypeDML is the type of the statements which modify data.
+	stmtTypeDML = "dml"
+)
+
+// maxRoutingQueryLen is the max length of the query prefix which is checked
+// by the routing rules.
+const maxRoutingQueryLen = 1024
+
+// maxCommitClockOffset is the max offset between the clock of proxy and the
+// commit timestamps of the cluster, which is the default max clock offset of
+// the cluster.
+const maxCommitClockOffset = 500 * time.Millisecond
+
+// cnLabelHint is the hint in the query to route it, like
+// "select /*+ cn_label(olap) */ * from t".
+var cnLabelHint = []byte("cn_label(")
+
+// stmtTypes maps the first keyword of the statement to its type.
+var stmtTypes = map[string]string{
+	"select":   stmtTypeSelect,
+	"with":     stmtTypeSelect,
+	"show":     stmtTypeSelect,
+	"explain":  stmtTypeSelect,
+	"desc":     stmtTypeSelect,
+	"describe": stmtTypeSelect,
+	"insert":   stmtTypeDML,
+	"update":   stmtTypeDML,
+	"delete":   stmtTypeDML,
+	"replace":  stmtTypeDML,
+	"load":     stmtTypeDML,
+}
+
+// routingRules routes the queries to CN servers by the rules.
+type routingRules struct {
+	rules []RoutingRule
+	// lag is the snapshot lag of the CN servers selected by the labels of
+	// the connection.
+	lag time.Duration
+}
+
+// newRoutingRules creates the routing rules, it returns nil if there is
+// no rule. lag is the snapshot lag of the CN servers selected by the labels
+// of the connection.
+func newRoutingRules(rules []RoutingRule, lag time.Duration) (*routingRules, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	for i, r := range rules {
+		switch r.StmtType {
+		case "", stmtTypeSelect, stmtTypeDML:
+		default:
+			return nil, moerr.NewInternalErrorNoCtx("invalid statement type %s of routing rule %s",
+				r.StmtType, r.Name)
+		}
+		if len(r.StmtType) == 0 && len(r.Hint) == 0 && len(r.User) == 0 {
+			return nil, moerr.NewInternalErrorNoCtx("routing rule %s has no condition", r.Name)
+		}
+		if len(r.Labels) == 0 {
+			return nil, moerr.NewInternalErrorNoCtx("routing rule %s has no labels", r.Name)
+		}
+		if len(r.Name) == 0 {
+			rules[i].Name = r.StmtType + r.Hint + r.User
+		}
+	}
+	return &routingRules{rules: rules, lag: lag}, nil
+}
+
+// lagOf returns the snapshot lag of the CN servers of the rule. A nil rule
+// means the CN servers selected by the labels of the connection.
+func (r *routingRules) lagOf(rule *RoutingRule) time.Duration {
+	if rule == nil {
+		return r.lag
+	}
+	return rule.Lag.Duration
+}
+
+// isWriteQuery returns true if the query may write data. The statements
+// other than select are taken as writes, including the ones ending
+// transactions. A nil query is a command other than COM_QUERY, like
+// COM_STMT_EXECUTE, which is taken as a write too.
+func isWriteQuery(query []byte) bool {
+	if query == nil {
+		return true
+	}
+	if len(query) > maxRoutingQueryLen {
+		query = query[:maxRoutingQueryLen]
+	}
+	return getStmtType(query) != stmtTypeSelect
+}
+
+// match returns the first rule matched by the query of the account, nil if
+// there is no matched rule.
+func (r *routingRules) match(query []byte, account accountInfo) *RoutingRule {
+	if len(query) > maxRoutingQueryLen {
+		query = query[:maxRoutingQueryLen]
+	}
+	stmtType := getStmtType(query)
+	hint := getCNLabelHint(query)
+	for i := range r.rules {
+		rule := &r.rules[i]
+		if len(rule.StmtType) > 0 && rule.StmtType != stmtType {
+			continue
+		}
+		if len(rule.Hint) > 0 && rule.Hint != hint {
+			continue
+		}
+		if len(rule.User) > 0 && !matchUser(rule.User, account) {
+			continue
+		}
+		return rule
+	}
+	return nil
+}
+
+// matchUser returns true if the account is the user, which is "user" or
+// "account:user".
+func matchUser(user string, account accountInfo) bool {
+	if tenant, name, ok := strings.Cut(user, ":"); ok {
+		return strings.EqualFold(tenant, string(account.tenant)) && name == account.username
+	}
+	return user == account.username
+}
+
+// skipSpacesAndComments skips the leading spaces and comments of the query,
+// including the hints.
+func skipSpacesAndComments(query []byte) []byte {
+	for {
+		query = bytes.TrimLeft(query, " \t\r\n(")
+		switch {
+		case bytes.HasPrefix(query, []byte("/*")):
+			end := bytes.Index(query, []byte("*/"))
+			if end < 0 {
+				return nil
+			}
+			query = query[end+2:]
+		case bytes.HasPrefix(query, []byte("-- ")) || bytes.HasPrefix(query, []byte("#")):
+			end := bytes.IndexByte(query, '\n')
+			if end < 0 {
+				return nil
+			}
+			query = query[end+1:]
+		default:
+			return query
+		}
+	}
+}
+
+// getStmtType returns the type of the statement, empty if it is neither
+// select nor dml.
+func getStmtType(query []byte) string {
+	query = skipSpacesAndComments(query)
+	end := bytes.IndexAny(query, " \t\r\n(/;")
+	if end < 0 {
+		end = len(query)
+	}
+	return stmtTypes[strings.ToLower(string(query[:end]))]
+}
+
+// getCNLabelHint returns the value of the hint /*+ cn_label(value) */ in the
+// query, empty if there is no such hint.
+func getCNLabelHint(query []byte) string {
+	start := bytes.Index(query, []byte("/*+"))
+	if start < 0 {
+		return ""
+	}
+	query = query[start+3:]
+	if end := bytes.Index(query, []byte("*/")); end >= 0 {
+		query = query[:end]
+	}
+	pos := bytes.Index(bytes.ToLower(query), cnLabelHint)
+	if pos < 0 {
+		return ""
+	}
+	query = query[pos+len(cnLabelHint):]
+	end := bytes.IndexByte(query, ')')
+	if end < 0 {
+		return ""
+	}
+	return strings.Trim(strings.TrimSpace(string(query[:end])), "'\"")
+}
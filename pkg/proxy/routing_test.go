@@ -0,0 +1,126 @@
+// Copyright 2021 - 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRoutingRules(t *testing.T) {
+	r, err := newRoutingRules(nil, 0)
+	require.NoError(t, err)
+	require.Nil(t, r)
+
+	labels := map[string]string{"workload": "olap"}
+	_, err = newRoutingRules([]RoutingRule{{StmtType: "ddl", Labels: labels}}, 0)
+	require.Error(t, err)
+	_, err = newRoutingRules([]RoutingRule{{Labels: labels}}, 0)
+	require.Error(t, err)
+	_, err = newRoutingRules([]RoutingRule{{StmtType: stmtTypeSelect}}, 0)
+	require.Error(t, err)
+
+	r, err = newRoutingRules([]RoutingRule{{StmtType: stmtTypeSelect, Labels: labels}}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(r.rules))
+	require.Equal(t, stmtTypeSelect, r.rules[0].Name)
+}
+
+func TestRoutingRulesMatch(t *testing.T) {
+	r, err := newRoutingRules([]RoutingRule{
+		{Name: "hint", Hint: "olap", Labels: map[string]string{"workload": "olap"}},
+		{Name: "report", User: "acc1:report", StmtType: stmtTypeSelect,
+			Labels: map[string]string{"workload": "report"}},
+		{Name: "read", StmtType: stmtTypeSelect, Labels: map[string]string{"role": "read"}},
+		{Name: "write", StmtType: stmtTypeDML, Labels: map[string]string{"role": "write"}},
+	}, 0)
+	require.NoError(t, err)
+
+	user := accountInfo{tenant: "acc1", username: "u1"}
+	report := accountInfo{tenant: "ACC1", username: "report"}
+	kases := []struct {
+		query   string
+		account accountInfo
+		rule    string
+	}{
+		{"select 1", user, "read"},
+		{"select /*+ cn_label(olap) */ count(*) from t", user, "hint"},
+		{"/*+ cn_label('olap') */ select 1", report, "hint"},
+		{"select 1", report, "report"},
+		{"insert into t values (1)", report, "write"},
+		{"begin", user, ""},
+		{"set autocommit = 0", user, ""},
+	}
+	for _, kase := range kases {
+		rule := r.match([]byte(kase.query), kase.account)
+		if kase.rule == "" {
+			require.Nil(t, rule, kase.query)
+		} else {
+			require.NotNil(t, rule, kase.query)
+			require.Equal(t, kase.rule, rule.Name, kase.query)
+		}
+	}
+}
+
+func TestMatchUser(t *testing.T) {
+	account := accountInfo{tenant: "acc1", username: "u1"}
+	require.True(t, matchUser("u1", account))
+	require.True(t, matchUser("ACC1:u1", account))
+	require.False(t, matchUser("acc2:u1", account))
+	require.False(t, matchUser("u2", account))
+}
+
+func TestGetStmtType(t *testing.T) {
+	kases := []struct {
+		query    string
+		stmtType string
+	}{
+		{"select 1", stmtTypeSelect},
+		{"  SELECT 1", stmtTypeSelect},
+		{"(select 1) union (select 2)", stmtTypeSelect},
+		{"/* comment */ select 1", stmtTypeSelect},
+		{"/*+ cn_label(olap) */ select 1", stmtTypeSelect},
+		{"-- comment\nselect 1", stmtTypeSelect},
+		{"# comment\nshow databases", stmtTypeSelect},
+		{"with t as (select 1) select * from t", stmtTypeSelect},
+		{"insert into t values (1)", stmtTypeDML},
+		{"Update t set a = 1", stmtTypeDML},
+		{"delete from t", stmtTypeDML},
+		{"create table t (a int)", ""},
+		{"/* unterminated", ""},
+		{"", ""},
+	}
+	for _, kase := range kases {
+		require.Equal(t, kase.stmtType, getStmtType([]byte(kase.query)), kase.query)
+	}
+}
+
+func TestGetCNLabelHint(t *testing.T) {
+	kases := []struct {
+		query string
+		hint  string
+	}{
+		{"select 1", ""},
+		{"select /*+ cn_label(olap) */ 1", "olap"},
+		{"select /*+ CN_LABEL( 'olap' ) */ 1", "olap"},
+		{"select /*+ other(a) */ 1", ""},
+		{"select /*+ cn_label(olap */ 1", ""},
+		{"select /* cn_label(olap) */ 1", ""},
+	}
+	for _, kase := range kases {
+		require.Equal(t, kase.hint, getCNLabelHint([]byte(kase.query)), kase.query)
+	}
+}
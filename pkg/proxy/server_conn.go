@@ -15,6 +15,9 @@
 package proxy
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -37,10 +40,22 @@ type ServerConn interface {
 	// HandleHandshake handles the handshake communication with CN server.
 	// handshakeResp is a auth packet received from client.
 	HandleHandshake(handshakeResp *frontend.Packet) (*frontend.Packet, error)
+	// HandleAuth handles the rest of the authentication after the handshake
+	// response, such as the auth switch and the full authentication of
+	// caching_sha2_password. It writes authData received from client to CN
+	// server, and returns the next packet from CN server. If authData is nil,
+	// it only reads the next packet.
+	HandleAuth(authData *frontend.Packet) (*frontend.Packet, error)
 	// ExecStmt executes a simple statement, it sends a query to backend server.
 	// After it finished, server connection should be closed immediately because
 	// it is a temp connection.
 	ExecStmt(stmt string, resp chan<- []byte) error
+	// ResetSession resets the session in CN server by COM_RESET_CONNECTION,
+	// so that the connection could be reused by another client connection.
+	ResetSession() error
+	// Attach tracks the connection as the one of the tunnel, when it is
+	// taken from the pool by the client connection of the tunnel.
+	Attach(t *tunnel)
 	// Close closes the connection to CN server.
 	Close() error
 }
@@ -62,12 +77,18 @@ type serverConn struct {
 	rebalancer *rebalancer
 	// tun is the tunnel which this server connection belongs to.
 	tun *tunnel
+	// tlsConfig is the TLS config for CN server, nil if TLS is not enabled.
+	tlsConfig *tls.Config
+	// authSeq is the sequence ID of the next packet sent to CN server
+	// in the authentication phase.
+	authSeq uint8
 }
 
 var _ ServerConn = (*serverConn)(nil)
 
-// newServerConn creates a connection to CN server.
-func newServerConn(cn *CNServer, tun *tunnel, r *rebalancer) (ServerConn, error) {
+// newServerConn creates a connection to CN server. If tlsConfig is not nil,
+// the connection is upgraded to TLS in the handshake phase.
+func newServerConn(cn *CNServer, tun *tunnel, r *rebalancer, tlsConfig *tls.Config) (ServerConn, error) {
 	c, err := cn.Connect()
 	if err != nil {
 		return nil, err
@@ -78,6 +99,7 @@ func newServerConn(cn *CNServer, tun *tunnel, r *rebalancer) (ServerConn, error)
 		connID:     nextServerConnID(),
 		rebalancer: r,
 		tun:        tun,
+		tlsConfig:  tlsConfig,
 	}
 	fp := config.FrontendParameters{}
 	fp.SetDefaultValues()
@@ -128,7 +150,14 @@ func (s *serverConn) HandleHandshake(handshakeResp *frontend.Packet) (*frontend.
 	if err := s.readInitialHandshake(); err != nil {
 		return nil, err
 	}
-	// Step 2, write the handshake response to CN server, which is
+	// Step 2, upgrade the connection to TLS if it is enabled.
+	if s.tlsConfig != nil {
+		var err error
+		if handshakeResp, err = s.upgradeToTLS(handshakeResp); err != nil {
+			return nil, err
+		}
+	}
+	// Step 3, write the handshake response to CN server, which is
 	// received from client earlier.
 	r, err := s.writeHandshakeResp(handshakeResp)
 	if err != nil {
@@ -137,6 +166,52 @@ func (s *serverConn) HandleHandshake(handshakeResp *frontend.Packet) (*frontend.
 	return r, nil
 }
 
+// upgradeToTLS sends the SSL request to CN server and does the TLS handshake.
+// It returns the handshake response with the SSL flag.
+func (s *serverConn) upgradeToTLS(handshakeResp *frontend.Packet) (*frontend.Packet, error) {
+	if len(handshakeResp.Payload) < handshakeResp41FixedLen ||
+		binary.LittleEndian.Uint32(handshakeResp.Payload)&frontend.CLIENT_PROTOCOL_41 == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("TLS to CN server needs handshake response 41")
+	}
+	payload := append([]byte(nil), handshakeResp.Payload...)
+	binary.LittleEndian.PutUint32(payload,
+		binary.LittleEndian.Uint32(payload)|frontend.CLIENT_SSL)
+	// The SSL request is the fixed part of the handshake response.
+	if err := s.mysqlProto.WritePacket(payload[:handshakeResp41FixedLen]); err != nil {
+		return nil, err
+	}
+	cfg := s.tlsConfig
+	if cfg.ServerName == "" && s.cnServer != nil {
+		if host, _, err := net.SplitHostPort(s.cnServer.addr); err == nil {
+			cfg = cfg.Clone()
+			cfg.ServerName = host
+		}
+	}
+	conn := tls.Client(s.conn.RawConn(), cfg)
+	ctx, cancel := context.WithTimeout(context.Background(), defaultConnectTimeout)
+	defer cancel()
+	if err := conn.HandshakeContext(ctx); err != nil {
+		return nil, err
+	}
+	s.conn.UseConn(conn)
+	return &frontend.Packet{
+		Length:     int32(len(payload)),
+		SequenceID: handshakeResp.SequenceID,
+		Payload:    payload,
+	}, nil
+}
+
+// HandleAuth implements the ServerConn interface.
+func (s *serverConn) HandleAuth(authData *frontend.Packet) (*frontend.Packet, error) {
+	if authData != nil {
+		s.mysqlProto.SetSequenceID(s.authSeq)
+		if err := s.mysqlProto.WritePacket(authData.Payload); err != nil {
+			return nil, err
+		}
+	}
+	return s.readAuthPacket()
+}
+
 // ExecStmt implements the ServerConn interface.
 func (s *serverConn) ExecStmt(stmt string, resp chan<- []byte) error {
 	req := make([]byte, 1, len(stmt)+1)
@@ -163,6 +238,28 @@ func (s *serverConn) ExecStmt(stmt string, resp chan<- []byte) error {
 	return nil
 }
 
+// ResetSession implements the ServerConn interface.
+func (s *serverConn) ResetSession() error {
+	s.mysqlProto.SetSequenceID(0)
+	if err := s.mysqlProto.WritePacket([]byte{byte(cmdResetConnection)}); err != nil {
+		return err
+	}
+	res, err := s.readPacket()
+	if err != nil {
+		return err
+	}
+	if bs := packetToBytes(res); !isOKPacket(bs) {
+		return moerr.NewInternalErrorNoCtx("failed to reset session: %s", string(bs))
+	}
+	return nil
+}
+
+// Attach implements the ServerConn interface.
+func (s *serverConn) Attach(t *tunnel) {
+	s.tun = t
+	s.rebalancer.connManager.connect(s.cnServer, t)
+}
+
 // Close implements the ServerConn interface.
 func (s *serverConn) Close() error {
 	// Un-track the connection.
@@ -183,6 +280,17 @@ func (s *serverConn) readPacket() (*frontend.Packet, error) {
 	return packet, nil
 }
 
+// readAuthPacket reads packet from CN server in the authentication phase,
+// and keeps the sequence ID of the next packet to CN server.
+func (s *serverConn) readAuthPacket() (*frontend.Packet, error) {
+	packet, err := s.readPacket()
+	if err != nil {
+		return nil, err
+	}
+	s.authSeq = uint8(packet.SequenceID) + 1
+	return packet, nil
+}
+
 // nextServerConnID increases baseConnID by 1 and returns the result.
 func nextServerConnID() uint32 {
 	return atomic.AddUint32(&serverBaseConnID, 1)
@@ -16,6 +16,8 @@ package proxy
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/binary"
 	"fmt"
 	"net"
 	"os"
@@ -27,6 +29,7 @@ import (
 
 	"github.com/fagongzi/goetty/v2"
 	"github.com/lni/goutils/leaktest"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
@@ -75,10 +78,15 @@ func (s *mockServerConn) RawConn() net.Conn { return s.conn }
 func (s *mockServerConn) HandleHandshake(_ *frontend.Packet) (*frontend.Packet, error) {
 	return nil, nil
 }
+func (s *mockServerConn) HandleAuth(_ *frontend.Packet) (*frontend.Packet, error) {
+	return nil, nil
+}
 func (s *mockServerConn) ExecStmt(stmt string, resp chan<- []byte) error {
 	sendResp(makeOKPacket(), resp)
 	return nil
 }
+func (s *mockServerConn) ResetSession() error { return nil }
+func (s *mockServerConn) Attach(_ *tunnel)    {}
 func (s *mockServerConn) Close() error {
 	if s.conn != nil {
 		_ = s.conn.Close()
@@ -309,7 +317,7 @@ func TestServerConn_Create(t *testing.T) {
 		"k2": "v2",
 	})
 	// server not started.
-	sc, err := newServerConn(cn1, nil, nil)
+	sc, err := newServerConn(cn1, nil, nil, nil)
 	require.Error(t, err)
 	require.Nil(t, sc)
 
@@ -321,7 +329,7 @@ func TestServerConn_Create(t *testing.T) {
 		require.NoError(t, stopFn())
 	}()
 
-	sc, err = newServerConn(cn1, nil, nil)
+	sc, err = newServerConn(cn1, nil, nil, nil)
 	require.NoError(t, err)
 	require.NotNil(t, sc)
 }
@@ -343,7 +351,7 @@ func TestServerConn_Connect(t *testing.T) {
 		require.NoError(t, stopFn())
 	}()
 
-	sc, err := newServerConn(cn1, nil, tp.re)
+	sc, err := newServerConn(cn1, nil, tp.re, nil)
 	require.NoError(t, err)
 	require.NotNil(t, sc)
 	_, err = sc.HandleHandshake(&frontend.Packet{Payload: []byte{1}})
@@ -396,7 +404,7 @@ func TestServerConn_ExecStmt(t *testing.T) {
 		require.NoError(t, stopFn())
 	}()
 
-	sc, err := newServerConn(cn1, nil, tp.re)
+	sc, err := newServerConn(cn1, nil, tp.re, nil)
 	require.NoError(t, err)
 	require.NotNil(t, sc)
 	_, err = sc.HandleHandshake(&frontend.Packet{Payload: []byte{1}})
@@ -457,3 +465,60 @@ func TestServerConnParseConnID(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestServerConn_UpgradeToTLS(t *testing.T) {
+	tc := newTestCerts(t, "")
+	local, remote := net.Pipe()
+	defer func() {
+		_ = local.Close()
+		_ = remote.Close()
+	}()
+	cn := &CNServer{addr: "127.0.0.1:6001", conn: local}
+	sc, err := newServerConn(cn, nil, nil, &tls.Config{RootCAs: tc.pool()})
+	require.NoError(t, err)
+
+	resp := makeHandshakeResp41("tenant1:user1")
+	errC := make(chan error, 1)
+	go func() {
+		errC <- func() error {
+			// the CN server reads the SSL request and the handshake
+			// response over TLS.
+			p, err := readTestPacket(remote)
+			if err != nil {
+				return err
+			}
+			if len(p) != handshakeResp41FixedLen ||
+				binary.LittleEndian.Uint32(p)&frontend.CLIENT_SSL == 0 {
+				return moerr.NewInternalErrorNoCtx("invalid SSL request")
+			}
+			conn := tls.Server(remote, &tls.Config{Certificates: []tls.Certificate{tc.server}})
+			if err := conn.Handshake(); err != nil {
+				return err
+			}
+			if p, err = readTestPacket(conn); err != nil {
+				return err
+			}
+			r, err := parseHandshakeResp41(p)
+			if err != nil {
+				return err
+			}
+			if string(r.username) != "tenant1:user1" || r.capabilities&frontend.CLIENT_SSL == 0 {
+				return moerr.NewInternalErrorNoCtx("invalid handshake response")
+			}
+			return nil
+		}()
+	}()
+
+	s := sc.(*serverConn)
+	pack, err := s.upgradeToTLS(resp)
+	require.NoError(t, err)
+	require.NoError(t, s.mysqlProto.WritePacket(pack.Payload))
+	require.NoError(t, <-errC)
+	_, ok := s.conn.RawConn().(*tls.Conn)
+	require.True(t, ok)
+
+	// the handshake response 320 is not supported.
+	payload := make([]byte, handshakeResp41FixedLen)
+	_, err = s.upgradeToTLS(&frontend.Packet{Payload: payload})
+	require.Error(t, err)
+}
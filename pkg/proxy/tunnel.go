@@ -61,10 +61,18 @@ type tunnel struct {
 		started bool
 		// inTransfer means a transfer of server connection is in progress.
 		inTransfer bool
+		// detached means the server connection is released in pooling mode,
+		// and the tunnel is waiting for the next command from client.
+		detached bool
+		// activeTime is the time that the pipes are created.
+		activeTime time.Time
 		// clientConn is the connection between client and proxy.
 		clientConn *MySQLConn
 		// serverConn is the connection between server and proxy.
 		serverConn *MySQLConn
+		// backend is the server connection which serverConn wraps, it is
+		// released to the pool in pooling mode.
+		backend ServerConn
 		// There are two pipes in a tunnel: client to server and server to client,
 		// which controls the data flow.
 		// csp is a pipe from client to server.
@@ -105,10 +113,10 @@ func (t *tunnel) run(cc ClientConn, sc ServerConn) error {
 		t.cc = cc
 		t.mu.clientConn = newMySQLConn("client", cc.RawConn(), 0, t.reqC, t.respC)
 		t.mu.serverConn = newMySQLConn("server", sc.RawConn(), 0, t.reqC, t.respC)
+		t.mu.backend = sc
 
 		// Create the pipes from client to server and server to client.
-		t.mu.csp = newPipe("client->server", t.mu.clientConn, t.mu.serverConn)
-		t.mu.scp = newPipe("server->client", t.mu.serverConn, t.mu.clientConn)
+		t.newPipesLocked()
 
 		return nil
 	}
@@ -180,8 +188,17 @@ func (t *tunnel) replaceServerConn(newServerConn *MySQLConn) {
 	defer t.mu.Unlock()
 	_ = t.mu.serverConn.Close()
 	t.mu.serverConn = newServerConn
+	t.newPipesLocked()
+}
+
+// newPipesLocked creates the pipes from client to server and server to
+// client. It must be called with t.mu locked.
+func (t *tunnel) newPipesLocked() {
 	t.mu.csp = newPipe("client->server", t.mu.clientConn, t.mu.serverConn)
+	t.mu.csp.reroute = t.reroute
+	t.mu.csp.throttle = t.cc.WaitQuery
 	t.mu.scp = newPipe("server->client", t.mu.serverConn, t.mu.clientConn)
+	t.mu.activeTime = time.Now()
 }
 
 // canStartTransfer checks whether the transfer can be started.
@@ -199,6 +216,11 @@ func (t *tunnel) canStartTransfer() bool {
 		return false
 	}
 
+	// The server connection has been released.
+	if t.mu.detached {
+		return false
+	}
+
 	csp, scp := t.mu.csp, t.mu.scp
 	csp.mu.Lock()
 	scp.mu.Lock()
@@ -215,6 +237,11 @@ func (t *tunnel) canStartTransfer() bool {
 		return false
 	}
 
+	// The session has states which cannot be restored.
+	if csp.mu.pinned {
+		return false
+	}
+
 	// Set the tunnel in transfer and the pipes paused directly.
 	t.mu.inTransfer = true
 	csp.mu.paused = true
@@ -247,12 +274,14 @@ func (t *tunnel) transfer(ctx context.Context) error {
 	if err := scp.pause(ctx); err != nil {
 		return err
 	}
-	newConn, err := t.getNewServerConn(ctx)
+	sc, err := t.getNewServerConn(ctx)
 	if err != nil {
 		t.logger.Error("failed to get a new connection", zap.Error(err))
 		return err
 	}
+	newConn := newMySQLConn("server", sc.RawConn(), 0, t.reqC, t.respC)
 	t.replaceServerConn(newConn)
+	t.setBackend(sc)
 	t.counterSet.connMigrationSuccess.Add(1)
 	t.logger.Info("transfer to a new CN server",
 		zap.String("addr", newConn.RemoteAddr().String()))
@@ -267,15 +296,218 @@ func (t *tunnel) transfer(ctx context.Context) error {
 
 // getNewServerConn selects a new CN server and connects to it then
 // returns the new connection.
-func (t *tunnel) getNewServerConn(ctx context.Context) (*MySQLConn, error) {
+func (t *tunnel) getNewServerConn(ctx context.Context) (ServerConn, error) {
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
-	newConn, err := t.cc.BuildConnWithServer(false)
+	return t.cc.BuildConnWithServer(false)
+}
+
+// setBackend sets the server connection which serverConn wraps.
+func (t *tunnel) setBackend(sc ServerConn) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.mu.backend = sc
+}
+
+// lastActiveTime returns the last time that a command is sent through the
+// tunnel, or the time that the pipes are created if there is no command.
+func (t *tunnel) lastActiveTime() time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	active := t.mu.activeTime
+	for _, p := range []*pipe{t.mu.csp, t.mu.scp} {
+		if p == nil {
+			continue
+		}
+		p.mu.Lock()
+		if p.mu.lastCmdTime.After(active) {
+			active = p.mu.lastCmdTime
+		}
+		p.mu.Unlock()
+	}
+	return active
+}
+
+// detach releases the server connection of the tunnel in pooling mode, when
+// the client is idle. It does the same checks as transfer, so the server
+// connection is released only if it is safe. The tunnel is attached to a
+// server connection again when the client sends the next command. It
+// returns false if it is not safe to detach.
+func (t *tunnel) detach(ctx context.Context) (bool, error) {
+	// Must check if it is safe to release the server connection.
+	if ok := t.canStartTransfer(); !ok {
+		return false, nil
+	}
+	defer func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.mu.inTransfer = false
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTransferTimeout)
+	defer cancel()
+
+	csp, scp := t.getPipes()
+	// Pause pipes before the server connection is released.
+	if err := csp.pause(ctx); err != nil {
+		return false, err
+	}
+	if err := scp.pause(ctx); err != nil {
+		return false, err
+	}
+
+	var sc ServerConn
+	var serverConn *MySQLConn
+	func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		sc, serverConn = t.mu.backend, t.mu.serverConn
+		t.mu.backend, t.mu.serverConn = nil, nil
+		t.mu.detached = true
+	}()
+	if sc == nil || serverConn.readAvail() > 0 {
+		// The connection cannot be reused if there is unexpected data.
+		_ = serverConn.Close()
+	} else {
+		t.cc.ReleaseServerConn(sc)
+	}
+	t.logger.Debug("release the server connection of idle client")
+
+	go t.waitToAttach()
+	return true, nil
+}
+
+// waitToAttach waits for the next command from client, and then attaches
+// the tunnel to a server connection.
+func (t *tunnel) waitToAttach() {
+	cc, _ := t.getConns()
+	// The command is kept in the buffer, and is sent after the pipes start.
+	if _, _, err := cc.preRecv(); err != nil {
+		t.setError(withCode(err, codeClientDisconnect))
+		return
+	}
+	// Route the command before a server connection is taken.
+	if rule, changed := t.cc.RouteQuery(cc.query()); changed {
+		t.cc.SetRoute(rule)
+	}
+	if err := t.attach(); err != nil {
+		t.logger.Error("failed to attach to a server connection", zap.Error(err))
+		t.setError(withCode(err, codeServerDisconnect))
+	}
+}
+
+// attach takes a server connection from the pool, or connects to a new CN
+// server, and then restarts the pipes.
+func (t *tunnel) attach() error {
+	sc, err := t.cc.AcquireServerConn()
+	if err != nil {
+		return err
+	}
+	if err := func() error {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		// The tunnel is closed, the connection would not be closed by it.
+		if t.ctx.Err() != nil {
+			_ = sc.RawConn().Close()
+			return t.ctx.Err()
+		}
+		t.mu.backend = sc
+		t.mu.serverConn = newMySQLConn("server", sc.RawConn(), 0, t.reqC, t.respC)
+		t.newPipesLocked()
+		t.mu.detached = false
+		return nil
+	}(); err != nil {
+		return err
+	}
+	return t.kickoff()
+}
+
+// reroute replaces the server connection if the query is routed to other CN
+// servers by the routing rules. It is called by the pipe from client to
+// server before each command is sent, query is nil if it is not a COM_QUERY.
+// The route is changed only if routable, which means the session is not in
+// a transaction and not pinned, so the transaction sticks to the server
+// connection.
+func (t *tunnel) reroute(query []byte, routable bool) error {
+	defer t.cc.RecordQuery(query)
+	if !routable || query == nil {
+		return nil
+	}
+	rule, changed := t.cc.RouteQuery(query)
+	if !changed {
+		return nil
+	}
+	var csp, scp *pipe
+	var sc ServerConn
+	var serverConn *MySQLConn
+	if ok := func() bool {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		// The server connection is being transferred, keep it this time.
+		if t.mu.inTransfer || t.mu.detached {
+			return false
+		}
+		t.mu.inTransfer = true
+		csp, scp = t.mu.csp, t.mu.scp
+		sc, serverConn = t.mu.backend, t.mu.serverConn
+		return true
+	}(); !ok {
+		return nil
+	}
+	defer func() {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		t.mu.inTransfer = false
+	}()
+
+	ctx, cancel := context.WithTimeout(t.ctx, defaultTransferTimeout)
+	defer cancel()
+
+	// The pipe from client to server is the caller, so only the pipe from
+	// server to client needs to be paused.
+	if err := scp.pause(ctx); err != nil {
+		return err
+	}
+	if sc == nil || serverConn.readAvail() > 0 {
+		_ = serverConn.Close()
+	} else {
+		t.cc.ReleaseServerConn(sc)
+	}
+	t.cc.SetRoute(rule)
+	newSC, err := t.cc.AcquireServerConn()
 	if err != nil {
-		return nil, err
+		return withCode(err, codeServerDisconnect)
 	}
-	return newMySQLConn("server", newConn.RawConn(), 0, t.reqC, t.respC), nil
+	if err := func() error {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		// The tunnel is closed, the connection would not be closed by it.
+		if t.ctx.Err() != nil {
+			_ = newSC.RawConn().Close()
+			return t.ctx.Err()
+		}
+		t.mu.backend = newSC
+		t.mu.serverConn = newMySQLConn("server", newSC.RawConn(), 0, t.reqC, t.respC)
+		csp.dst = t.mu.serverConn
+		t.mu.scp = newPipe("server->client", t.mu.serverConn, t.mu.clientConn)
+		scp = t.mu.scp
+		return nil
+	}(); err != nil {
+		return err
+	}
+	go func() {
+		if err := scp.kickoff(t.ctx); err != nil {
+			t.setError(withCode(err, codeServerDisconnect))
+		}
+	}()
+	if err := scp.waitReady(ctx); err != nil {
+		return err
+	}
+	if rule != nil {
+		t.logger.Debug("route query by rule", zap.String("rule", rule.Name))
+	}
+	return nil
 }
 
 // Close closes the tunnel.
@@ -329,8 +561,20 @@ type pipe struct {
 		// Track last cmd time and whether we are in a transaction.
 		lastCmdTime time.Time
 		inTxn       bool
+		// pinned indicates that the session has states which cannot be
+		// restored in another server connection.
+		pinned bool
 	}
 
+	// reroute is called before a command is sent, to route the query to
+	// other CN servers if routable. It is set only in the pipe from client
+	// to server, and it may replace dst.
+	reroute func(query []byte, routable bool) error
+	// throttle is called before a query is sent, to wait until the query is
+	// allowed by the limits. It is set only in the pipe from client to
+	// server.
+	throttle func(ctx context.Context) error
+
 	testHelper struct {
 		beforeSend func()
 	}
@@ -371,7 +615,7 @@ func (p *pipe) kickoff(ctx context.Context) (e error) {
 		p.mu.started = false
 		p.mu.cond.Broadcast()
 	}
-	prepareNextMessage := func() (terminate bool, err error) {
+	prepareNextMessage := func() (terminate bool, routable bool, err error) {
 		if terminate := func() bool {
 			p.mu.Lock()
 			defer p.mu.Unlock()
@@ -382,7 +626,7 @@ func (p *pipe) kickoff(ctx context.Context) (e error) {
 			p.mu.inPreRecv = true
 			return false
 		}(); terminate {
-			return true, nil
+			return true, false, nil
 		}
 		_, txn, re := p.src.preRecv()
 		p.mu.Lock()
@@ -391,20 +635,29 @@ func (p *pipe) kickoff(ctx context.Context) (e error) {
 
 		var netErr net.Error
 		if p.mu.paused && re == nil {
-			return true, nil
+			return true, false, nil
 		} else if p.mu.paused && errors.As(re, &netErr) && netErr.Timeout() {
 			// The preRecv is cut off by set the connection deadline to a pastime.
-			return true, nil
+			return true, false, nil
 		} else if re != nil {
-			return false, moerr.NewInternalErrorNoCtx("preRecv message: %s, name %s", re.Error(), p.name)
+			return false, false, moerr.NewInternalErrorNoCtx("preRecv message: %s, name %s", re.Error(), p.name)
 		}
 		p.mu.lastCmdTime = time.Now()
+		// The message could be routed only if the session is not in a
+		// transaction and not pinned before it.
+		routable = !p.mu.inTxn && !p.mu.pinned
 		if txn == txnBegin {
 			p.mu.inTxn = true
 		} else if txn == txnEnd {
 			p.mu.inTxn = false
 		}
-		return false, nil
+		switch p.src.pinTag() {
+		case pinSession:
+			p.mu.pinned = true
+		case unpinSession:
+			p.mu.pinned = false
+		}
+		return false, routable, nil
 	}
 
 	started, err := start()
@@ -418,9 +671,20 @@ func (p *pipe) kickoff(ctx context.Context) (e error) {
 	defer finish()
 
 	for ctx.Err() == nil {
-		if terminate, err := prepareNextMessage(); err != nil || terminate {
+		terminate, routable, err := prepareNextMessage()
+		if err != nil || terminate {
 			return err
 		}
+		if p.throttle != nil && p.src.isQuery() {
+			if err := p.throttle(ctx); err != nil {
+				return err
+			}
+		}
+		if p.reroute != nil {
+			if err := p.reroute(p.src.query(), routable); err != nil {
+				return err
+			}
+		}
 		if p.testHelper.beforeSend != nil {
 			p.testHelper.beforeSend()
 		}
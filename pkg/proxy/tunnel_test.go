@@ -21,6 +21,7 @@ import (
 	"io"
 	"math/rand"
 	"net"
+	"strings"
 	"testing"
 	"testing/iotest"
 	"time"
@@ -647,3 +648,183 @@ func TestReplaceServerConn(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "select 1", string(buf[5:n]))
 }
+
+// testPoolClientConn is the client connection which takes server
+// connections from acquireC, and puts the released ones into releaseC.
+type testPoolClientConn struct {
+	*mockClientConn
+	acquireC chan ServerConn
+	releaseC chan ServerConn
+}
+
+func (c *testPoolClientConn) AcquireServerConn() (ServerConn, error) {
+	return <-c.acquireC, nil
+}
+func (c *testPoolClientConn) ReleaseServerConn(sc ServerConn) {
+	c.releaseC <- sc
+}
+
+func TestTunnelDetachAndAttach(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	ctx := context.TODO()
+	clientProxy, client := net.Pipe()
+	serverProxy, server := net.Pipe()
+
+	tu := newTunnel(ctx, runtime.DefaultRuntime().Logger(), nil)
+	defer func() { _ = tu.Close() }()
+
+	cc := &testPoolClientConn{
+		mockClientConn: &mockClientConn{conn: clientProxy, tun: tu},
+		acquireC:       make(chan ServerConn, 1),
+		releaseC:       make(chan ServerConn, 1),
+	}
+	sc := newMockServerConn(serverProxy)
+	require.NoError(t, tu.run(cc, sc))
+
+	// query sends the statement and returns the statement received by server.
+	query := func(server net.Conn, stmt string) string {
+		go func() {
+			_, _ = client.Write(makeSimplePacket(stmt))
+		}()
+		buf := make([]byte, 100)
+		n, err := server.Read(buf)
+		require.NoError(t, err)
+		go func() {
+			_, _ = server.Write(makeOKPacket())
+		}()
+		_, err = client.Read(buf)
+		require.NoError(t, err)
+		return string(buf[5:n])
+	}
+
+	// The session with prepared statements cannot be detached.
+	require.Equal(t, "prepare s1 from 'select 1'", query(server, "prepare s1 from 'select 1'"))
+	ok, err := tu.detach(ctx)
+	require.NoError(t, err)
+	require.False(t, ok)
+	// The session is unpinned after it is reset.
+	csp, _ := tu.getPipes()
+	csp.mu.Lock()
+	require.True(t, csp.mu.pinned)
+	csp.mu.pinned = false
+	csp.mu.Unlock()
+
+	ok, err = tu.detach(ctx)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, sc, <-cc.releaseC)
+	func() {
+		tu.mu.Lock()
+		defer tu.mu.Unlock()
+		require.True(t, tu.mu.detached)
+		require.Nil(t, tu.mu.serverConn)
+	}()
+	require.False(t, tu.canStartTransfer())
+
+	// The tunnel is attached to a new server connection by the next command.
+	newServerProxy, newServer := net.Pipe()
+	cc.acquireC <- newMockServerConn(newServerProxy)
+	require.Equal(t, "select 1", query(newServer, "select 1"))
+	func() {
+		tu.mu.Lock()
+		defer tu.mu.Unlock()
+		require.False(t, tu.mu.detached)
+		require.Equal(t, newServerProxy, tu.mu.serverConn.Conn)
+	}()
+	_ = server.Close()
+}
+
+// testRouteClientConn is the client connection which routes the queries
+// starting with "select" by the rule.
+type testRouteClientConn struct {
+	*testPoolClientConn
+	rule  *RoutingRule
+	route *RoutingRule
+}
+
+func (c *testRouteClientConn) RouteQuery(query []byte) (*RoutingRule, bool) {
+	if query == nil {
+		return c.route, false
+	}
+	var rule *RoutingRule
+	if strings.HasPrefix(string(query), "select") {
+		rule = c.rule
+	}
+	return rule, rule != c.route
+}
+func (c *testRouteClientConn) SetRoute(rule *RoutingRule) {
+	c.route = rule
+}
+func (c *testRouteClientConn) RecordQuery(_ []byte) {}
+
+func TestTunnelReroute(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	ctx := context.TODO()
+	clientProxy, client := net.Pipe()
+	serverProxy, server := net.Pipe()
+
+	tu := newTunnel(ctx, runtime.DefaultRuntime().Logger(), nil)
+	defer func() { _ = tu.Close() }()
+
+	cc := &testRouteClientConn{
+		testPoolClientConn: &testPoolClientConn{
+			mockClientConn: &mockClientConn{conn: clientProxy, tun: tu},
+			acquireC:       make(chan ServerConn, 1),
+			releaseC:       make(chan ServerConn, 1),
+		},
+		rule: &RoutingRule{Name: "read", Labels: map[string]string{"role": "read"}},
+	}
+	sc := newMockServerConn(serverProxy)
+	require.NoError(t, tu.run(cc, sc))
+
+	// query sends the statement and returns the statement received by server.
+	query := func(server net.Conn, stmt string) string {
+		go func() {
+			_, _ = client.Write(makeSimplePacket(stmt))
+		}()
+		buf := make([]byte, 100)
+		n, err := server.Read(buf)
+		require.NoError(t, err)
+		go func() {
+			_, _ = server.Write(makeOKPacket())
+		}()
+		_, err = client.Read(buf)
+		require.NoError(t, err)
+		return string(buf[5:n])
+	}
+
+	// The query not matching the rule is sent to the current server.
+	require.Equal(t, "insert into t values (1)", query(server, "insert into t values (1)"))
+
+	// The query matching the rule is sent to a new server connection.
+	readServerProxy, readServer := net.Pipe()
+	readSC := newMockServerConn(readServerProxy)
+	cc.acquireC <- readSC
+	require.Equal(t, "select 1", query(readServer, "select 1"))
+	require.Equal(t, sc, <-cc.releaseC)
+	require.Equal(t, cc.rule, cc.route)
+
+	// The transaction is routed by the begin statement, and it sticks to the
+	// server connection.
+	txnServerProxy, txnServer := net.Pipe()
+	txnSC := newMockServerConn(txnServerProxy)
+	cc.acquireC <- txnSC
+	require.Equal(t, "begin", query(txnServer, "begin"))
+	require.Equal(t, readSC, <-cc.releaseC)
+	require.Nil(t, cc.route)
+	require.Equal(t, "select 2", query(txnServer, "select 2"))
+	require.Equal(t, "commit", query(txnServer, "commit"))
+	func() {
+		tu.mu.Lock()
+		defer tu.mu.Unlock()
+		require.Equal(t, txnSC, tu.mu.backend)
+		require.Equal(t, txnServerProxy, tu.mu.serverConn.Conn)
+	}()
+	_ = server.Close()
+	_ = readServer.Close()
+	_ = txnServer.Close()
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"sort"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 )
@@ -59,6 +60,45 @@ func isErrPacket(p []byte) bool {
 	return false
 }
 
+// sha2RequestPublicKey is sent by client to ask for the RSA public key of the
+// server in the full authentication of caching_sha2_password.
+const sha2RequestPublicKey byte = 0x02
+
+// isAuthSwitchPacket returns true if []byte is a MySQL auth switch request.
+func isAuthSwitchPacket(p []byte) bool {
+	if len(p) > 4 && p[4] == 0xFE {
+		return true
+	}
+	return false
+}
+
+// isAuthMoreDataPacket returns true if []byte is a MySQL auth more data packet.
+func isAuthMoreDataPacket(p []byte) bool {
+	if len(p) > 4 && p[4] == 0x01 {
+		return true
+	}
+	return false
+}
+
+// isFastAuthSuccessPacket returns true if []byte is the auth more data packet
+// of caching_sha2_password which tells the fast authentication succeeded, after
+// which the OK packet follows.
+func isFastAuthSuccessPacket(p []byte) bool {
+	if len(p) == 6 && p[4] == 0x01 && p[5] == 0x03 {
+		return true
+	}
+	return false
+}
+
+// isFullAuthPacket returns true if []byte is the auth more data packet of
+// caching_sha2_password which asks for the password.
+func isFullAuthPacket(p []byte) bool {
+	if len(p) == 6 && p[4] == 0x01 && p[5] == 0x04 {
+		return true
+	}
+	return false
+}
+
 // packetToBytes convert Packet to bytes.
 func packetToBytes(p *frontend.Packet) []byte {
 	if p == nil || len(p.Payload) == 0 {
@@ -153,6 +193,57 @@ func isStmtRollback(c []byte) bool {
 		(c[7] == 'k' || c[7] == 'K')
 }
 
+// maxPinSessionStmtLen is the max length of the statement prefix which is
+// checked by isStmtPinSession.
+const maxPinSessionStmtLen = 64
+
+// isStmtPinSession returns true iff the statement makes states in the session
+// which cannot be restored by replaying the set variable statements, such as
+// prepared statements, temporary tables and the implicit transactions after
+// autocommit is set.
+func isStmtPinSession(c []byte) bool {
+	if len(c) > maxPinSessionStmtLen {
+		c = c[:maxPinSessionStmtLen]
+	}
+	stmt := strings.ToLower(string(c))
+	fields := strings.Fields(stmt)
+	if len(fields) < 2 {
+		return false
+	}
+	switch fields[0] {
+	case "prepare":
+		return true
+	case "create":
+		return fields[1] == "temporary"
+	case "set":
+		return strings.Contains(stmt, "autocommit")
+	}
+	return false
+}
+
+// isStmtSetSession returns true iff the statement sets the states of the
+// session other than the variables, such as the role and the charset. The
+// statement is replayed like the set variable statements when the session
+// moves to another server connection.
+func isStmtSetSession(stmt string) bool {
+	if len(stmt) > maxPinSessionStmtLen {
+		stmt = stmt[:maxPinSessionStmtLen]
+	}
+	fields := strings.Fields(strings.ToLower(stmt))
+	if len(fields) < 3 || fields[0] != "set" {
+		return false
+	}
+	switch fields[1] {
+	case "role", "names", "charset":
+		return true
+	case "secondary":
+		return fields[2] == "role"
+	case "character":
+		return fields[2] == "set"
+	}
+	return false
+}
+
 // sortMap sorts a complex map instance.
 func sortMap(target map[string]any) map[string]any {
 	sorted := sortSimpleMap(target)
@@ -133,6 +133,58 @@ func TestIsStmtRollback(t *testing.T) {
 	require.True(t, r)
 }
 
+func TestIsStmtPinSession(t *testing.T) {
+	stmts := []string{
+		"prepare s1 from 'select 1'",
+		"PREPARE s1 FROM 'select ?'",
+		"create temporary table t1(a int)",
+		"set autocommit=0",
+		"SET @@session.autocommit = 1",
+	}
+	for _, stmt := range stmts {
+		require.True(t, isStmtPinSession([]byte(stmt)), stmt)
+	}
+	stmts = []string{
+		"",
+		"prepare",
+		"select 1",
+		"create table t1(a int)",
+		"set a=1",
+		"select autocommit",
+	}
+	for _, stmt := range stmts {
+		require.False(t, isStmtPinSession([]byte(stmt)), stmt)
+	}
+}
+
+func TestIsStmtSetSession(t *testing.T) {
+	stmts := []string{
+		"set role r1",
+		"SET ROLE default",
+		"set secondary role all",
+		"SET SECONDARY ROLE NONE",
+		"set names utf8mb4",
+		"SET NAMES 'utf8' COLLATE 'utf8_general_ci'",
+		"set character set utf8",
+		"set charset utf8",
+	}
+	for _, stmt := range stmts {
+		require.True(t, isStmtSetSession(stmt), stmt)
+	}
+	stmts = []string{
+		"",
+		"set role",
+		"set a=1",
+		"set @role=1",
+		"set secondary r1",
+		"set character utf8",
+		"select names from t",
+	}
+	for _, stmt := range stmts {
+		require.False(t, isStmtSetSession(stmt), stmt)
+	}
+}
+
 func TestSortSlice(t *testing.T) {
 	var sorted = []any{"a", "b", "c", "d"}
 	var s1 = []any{"c", "b", "a", "d"}
@@ -154,3 +206,13 @@ func TestRawHash(t *testing.T) {
 	}
 	require.Equal(t, 32, len(rawHash(label)))
 }
+
+func TestAuthPackets(t *testing.T) {
+	require.True(t, isAuthSwitchPacket([]byte{1, 0, 0, 2, 0xFE}))
+	require.False(t, isAuthSwitchPacket([]byte{1, 0, 0, 2, 0x01}))
+	require.True(t, isAuthMoreDataPacket([]byte{2, 0, 0, 2, 0x01, 0x03}))
+	require.True(t, isFastAuthSuccessPacket([]byte{2, 0, 0, 2, 0x01, 0x03}))
+	require.False(t, isFastAuthSuccessPacket([]byte{2, 0, 0, 2, 0x01, 0x04}))
+	require.True(t, isFullAuthPacket([]byte{2, 0, 0, 2, 0x01, 0x04}))
+	require.False(t, isFullAuthPacket([]byte{1, 0, 0, 2, 0x00}))
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+)
+
+// changeSQLs returns the sqls applying the change with the conflict mode,
+// ok is false if the change can not be applied because the row it deletes
+// is unknown.
+//
+// With ConflictOverwrite, the row of the key is deleted before an insert or
+// an update, the local row becomes the same as the publisher's. With
+// ConflictSkip, an insert is skipped if the key exists and an update or a
+// delete only changes the local row if it is the same as the before image,
+// local changes are kept. A delete without the before image deletes the row
+// of the key in both modes.
+func changeSQLs(mode ConflictMode, c Change) (sqls []string, ok bool) {
+	table := quoteIdent(c.Table)
+	switch c.Op {
+	case cdc.OpInsert.String():
+		if c.Key == nil {
+			return []string{insertSQL(table, c.After, "")}, true
+		}
+		if mode == ConflictSkip {
+			return []string{insertSQL(table, c.After, where(c.Key))}, true
+		}
+		return []string{
+			fmt.Sprintf("delete from %s where %s", table, where(c.Key)),
+			insertSQL(table, c.After, ""),
+		}, true
+	case cdc.OpUpdate.String():
+		if mode == ConflictSkip {
+			if c.Before == nil {
+				return nil, false
+			}
+			return []string{
+				fmt.Sprintf("update %s set %s where %s", table, assignments(c.After), where(c.Before)),
+			}, true
+		}
+		return []string{
+			fmt.Sprintf("delete from %s where %s", table, where(c.Key)),
+			insertSQL(table, c.After, ""),
+		}, true
+	case cdc.OpDelete.String():
+		cond := c.Key
+		if (mode == ConflictSkip && c.Before != nil) || cond == nil {
+			cond = c.Before
+		}
+		if cond == nil {
+			return nil, false
+		}
+		return []string{fmt.Sprintf("delete from %s where %s", table, where(cond))}, true
+	}
+	return nil, false
+}
+
+// insertSQL returns the sql inserting the row, the row is only inserted if
+// no row matches the condition when cond is not empty.
+func insertSQL(table string, row cdc.Row, cond string) string {
+	columns := sortedColumns(row)
+	names := make([]string, 0, len(columns))
+	values := make([]string, 0, len(columns))
+	for _, column := range columns {
+		names = append(names, quoteIdent(column))
+		values = append(values, quoteValue(row[column]))
+	}
+	if cond == "" {
+		return fmt.Sprintf("insert into %s (%s) values (%s)",
+			table, strings.Join(names, ", "), strings.Join(values, ", "))
+	}
+	return fmt.Sprintf("insert into %s (%s) select %s from dual where not exists (select 1 from %s where %s)",
+		table, strings.Join(names, ", "), strings.Join(values, ", "), table, cond)
+}
+
+func where(row cdc.Row) string {
+	columns := sortedColumns(row)
+	conds := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if row[column] == nil {
+			conds = append(conds, fmt.Sprintf("%s is null", quoteIdent(column)))
+		} else {
+			conds = append(conds, fmt.Sprintf("%s = %s", quoteIdent(column), quoteValue(row[column])))
+		}
+	}
+	return strings.Join(conds, " and ")
+}
+
+func assignments(row cdc.Row) string {
+	columns := sortedColumns(row)
+	values := make([]string, 0, len(columns))
+	for _, column := range columns {
+		values = append(values, fmt.Sprintf("%s = %s", quoteIdent(column), quoteValue(row[column])))
+	}
+	return strings.Join(values, ", ")
+}
+
+func sortedColumns(row cdc.Row) []string {
+	columns := make([]string, 0, len(row))
+	for column := range row {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+func quoteIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func quoteValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case json.Number:
+		return v.String()
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v)
+	case string:
+		return quoteString(v)
+	default:
+		return quoteString(fmt.Sprint(v))
+	}
+}
+
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
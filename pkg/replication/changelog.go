@@ -0,0 +1,341 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	defaultMaxChanges = 1 << 20
+	defaultCursorTTL  = 10 * time.Minute
+)
+
+// cursor is the position of a subscription in the change log
+type cursor struct {
+	lsn     uint64
+	renewed time.Time
+}
+
+// ChangeLog keeps the latest changes decoded by the cdc consumer of the
+// publisher. It is the sink of the consumer, and the consumer reports its
+// position by Advance after each poll. The changes are persisted by the
+// store when flushed, and loaded again when the publisher restarts.
+//
+// Each subscription has a cursor at the lsn it requested last, the changes
+// before the oldest cursor renewed in the ttl are dropped, and the oldest
+// changes are dropped when there are more than max changes. Subscribers
+// behind the dropped changes have to copy the snapshot again.
+type ChangeLog struct {
+	max   int
+	ttl   time.Duration
+	store ChangeStore
+	// started is when the change log is loaded, the changes are not dropped
+	// by the cursors in the first ttl after it, the cursors are not
+	// persisted and are renewed by the subscribers in the meantime.
+	started time.Time
+
+	mu struct {
+		sync.RWMutex
+		changes []StoredChange
+		// pending are the changes sent but not flushed yet
+		pending []StoredChange
+		// first is the lsn of the oldest change kept
+		first uint64
+		// next is the lsn of the changes not received yet
+		next uint64
+		// persisted is the lsn of the latest change persisted, the changes
+		// sent again by the consumer after a restart are ignored
+		persisted uint64
+		// latest is the commit time of the latest change of each database
+		latest  map[uint64]int64
+		cursors map[string]cursor
+	}
+}
+
+var _ cdc.Sink = (*ChangeLog)(nil)
+
+// NewChangeLog creates a ChangeLog keeping at most max changes, the cursors
+// not renewed in ttl no longer keep changes. The changes are only kept in
+// memory if store is nil.
+func NewChangeLog(max int, ttl time.Duration, store ChangeStore) *ChangeLog {
+	if max <= 0 {
+		max = defaultMaxChanges
+	}
+	if ttl <= 0 {
+		ttl = defaultCursorTTL
+	}
+	l := &ChangeLog{
+		max:     max,
+		ttl:     ttl,
+		store:   store,
+		started: time.Now(),
+	}
+	l.mu.latest = make(map[uint64]int64)
+	l.mu.cursors = make(map[string]cursor)
+	return l
+}
+
+// Load loads the changes persisted by the store
+func (l *ChangeLog) Load(ctx context.Context) error {
+	if l.store == nil {
+		return nil
+	}
+	changes, err := l.store.Load(ctx)
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.started = time.Now()
+	l.mu.changes = changes
+	for _, c := range changes {
+		if c.CommitTS > l.mu.latest[c.DatabaseID] {
+			l.mu.latest[c.DatabaseID] = c.CommitTS
+		}
+	}
+	if len(changes) > 0 {
+		// the changes between the dropped ones and the oldest one kept are
+		// unknown, requests before it are truncated
+		l.mu.first = changes[0].Lsn
+		l.mu.persisted = changes[len(changes)-1].Lsn
+	}
+	return nil
+}
+
+// Reset drops all the changes, it is called if the log after the changes
+// kept is lost and the consumer has to start over.
+func (l *ChangeLog) Reset(ctx context.Context) error {
+	if l.store != nil {
+		if err := l.store.Truncate(ctx, math.MaxUint64); err != nil {
+			return err
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.mu.changes = nil
+	l.mu.pending = nil
+	l.mu.first, l.mu.next, l.mu.persisted = 0, 0, 0
+	return nil
+}
+
+func (l *ChangeLog) Send(ctx context.Context, events []cdc.Event) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, e := range events {
+		// the changes of the catalog are never published, and the changes
+		// persisted by the store itself must not be logged again
+		if e.DatabaseID == catalog.MO_CATALOG_ID {
+			continue
+		}
+		if l.mu.persisted > 0 && e.Lsn <= l.mu.persisted {
+			continue
+		}
+		l.mu.pending = append(l.mu.pending, StoredChange{
+			DatabaseID: e.DatabaseID,
+			Change: Change{
+				Op:       e.Op.String(),
+				Table:    e.Table,
+				CommitTS: e.CommitTS.Physical(),
+				Lsn:      e.Lsn,
+				Key:      e.Key,
+				Before:   e.Before,
+				After:    e.After,
+			},
+		})
+	}
+	return nil
+}
+
+// Flush persists the changes sent and makes them visible. The changes are
+// dropped if they can not be persisted, the consumer sends them again.
+func (l *ChangeLog) Flush(ctx context.Context) error {
+	l.mu.Lock()
+	pending := l.mu.pending
+	l.mu.pending = nil
+	l.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+	if l.store != nil {
+		if err := l.store.Append(ctx, pending); err != nil {
+			return err
+		}
+	}
+
+	l.mu.Lock()
+	for _, c := range pending {
+		l.mu.changes = append(l.mu.changes, c)
+		if c.CommitTS > l.mu.latest[c.DatabaseID] {
+			l.mu.latest[c.DatabaseID] = c.CommitTS
+		}
+	}
+	l.mu.persisted = pending[len(pending)-1].Lsn
+	truncated := l.truncateLocked()
+	first := l.mu.first
+	l.mu.Unlock()
+
+	if truncated && l.store != nil {
+		return l.store.Truncate(ctx, first)
+	}
+	return nil
+}
+
+func (l *ChangeLog) Close() error {
+	return nil
+}
+
+// Advance records that the changes before next have all been received
+func (l *ChangeLog) Advance(next uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.mu.first == 0 {
+		l.mu.first = next
+	}
+	if next > l.mu.next {
+		l.mu.next = next
+	}
+}
+
+// Next returns the lsn of the changes not received yet, 0 if the consumer
+// has not started.
+func (l *ChangeLog) Next() uint64 {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.mu.next
+}
+
+// Register moves the cursor of the subscription to lsn, the changes from
+// lsn are kept for it.
+func (l *ChangeLog) Register(subscription string, lsn uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.mu.cursors[subscription] = cursor{lsn: lsn, renewed: time.Now()}
+}
+
+// Renew renews the cursor of the subscription without moving it, e.g. while
+// the subscription copies the snapshot.
+func (l *ChangeLog) Renew(subscription string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if c, ok := l.mu.cursors[subscription]; ok {
+		c.renewed = time.Now()
+		l.mu.cursors[subscription] = c
+	}
+}
+
+// Changes returns at most max changes of the database from lsn, the changes
+// of a log record are never split. The cursor of the subscription is moved
+// to lsn. The returned ChangeSet is truncated if the changes from lsn are no
+// longer kept.
+func (l *ChangeLog) Changes(ctx context.Context, subscription string, dbID uint64, lsn uint64, max int) (ChangeSet, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.mu.next == 0 {
+		return ChangeSet{}, moerr.NewInvalidState(ctx, "replication change log not started")
+	}
+	cs := ChangeSet{
+		Next:     l.mu.next,
+		LatestTS: l.mu.latest[dbID],
+	}
+	if lsn < l.mu.first {
+		cs.Truncated = true
+		return cs, nil
+	}
+	l.mu.cursors[subscription] = cursor{lsn: lsn, renewed: time.Now()}
+	if lsn > l.mu.next {
+		return cs, nil
+	}
+	for i := l.search(lsn); i < len(l.mu.changes); i++ {
+		c := l.mu.changes[i]
+		if max > 0 && len(cs.Changes) >= max && c.Lsn != cs.Changes[len(cs.Changes)-1].Lsn {
+			cs.Next = c.Lsn
+			break
+		}
+		if c.DatabaseID == dbID {
+			cs.Changes = append(cs.Changes, c.Change)
+		}
+	}
+	return cs, nil
+}
+
+// search returns the index of the first change whose lsn is not less than lsn
+func (l *ChangeLog) search(lsn uint64) int {
+	i, j := 0, len(l.mu.changes)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if l.mu.changes[h].Lsn < lsn {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return i
+}
+
+// truncateLocked drops the changes beyond max and the changes before the
+// oldest cursor, it returns true if first is moved.
+func (l *ChangeLog) truncateLocked() bool {
+	first := l.mu.first
+	if n := len(l.mu.changes) - l.max; n > 0 {
+		i := n
+		// keep the changes of a log record together
+		for i < len(l.mu.changes) && l.mu.changes[i].Lsn == l.mu.changes[i-1].Lsn {
+			i++
+		}
+		if i == len(l.mu.changes) {
+			first = l.mu.changes[i-1].Lsn + 1
+		} else {
+			first = l.mu.changes[i].Lsn
+		}
+	}
+	if lsn, ok := l.oldestCursorLocked(); ok && lsn > first {
+		first = lsn
+	}
+	if first <= l.mu.first {
+		return false
+	}
+	l.mu.first = first
+	l.mu.changes = append(l.mu.changes[:0], l.mu.changes[l.search(first):]...)
+	return true
+}
+
+// oldestCursorLocked returns the lsn of the oldest cursor renewed in the
+// ttl, the expired cursors are removed. It returns next if there is no
+// cursor, a new subscription starts from the snapshot at next.
+func (l *ChangeLog) oldestCursorLocked() (uint64, bool) {
+	now := time.Now()
+	if now.Sub(l.started) < l.ttl || l.mu.next == 0 {
+		return 0, false
+	}
+	oldest := l.mu.next
+	for name, c := range l.mu.cursors {
+		if now.Sub(c.renewed) >= l.ttl {
+			delete(l.mu.cursors, name)
+			continue
+		}
+		if c.lsn < oldest {
+			oldest = c.lsn
+		}
+	}
+	return oldest, true
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/rpc"
+)
+
+// NewEngineSchemaResolver returns the cdc.SchemaResolver reading the
+// schemas of the sys account's tables from the engine. The decoder of the
+// publisher needs the schemas of the tables created before it started to
+// decode the blocks written by CNs, otherwise the rows of the blocks are
+// unknown and their deletes can not be applied by the subscribers.
+func NewEngineSchemaResolver(ctx context.Context, eng engine.Engine, txnClient client.TxnClient) cdc.SchemaResolver {
+	ctx = context.WithValue(ctx, defines.TenantIDKey{}, uint32(0))
+	return func(dbID, tableID uint64) (*catalog.Schema, bool) {
+		txnOp, err := txnClient.New(ctx, timestamp.Timestamp{})
+		if err != nil {
+			return nil, false
+		}
+		defer func() {
+			_ = eng.Rollback(ctx, txnOp)
+			_ = txnOp.Rollback(ctx)
+		}()
+		if err = eng.New(ctx, txnOp); err != nil {
+			return nil, false
+		}
+		_, name, rel, err := eng.GetRelationById(ctx, txnOp, tableID)
+		if err != nil {
+			return nil, false
+		}
+		defs, err := rel.TableDefs(ctx)
+		if err != nil {
+			return nil, false
+		}
+		schema, err := rpc.DefsToSchema(name, defs)
+		if err != nil {
+			return nil, false
+		}
+		// the blocks keep the columns by their seqnums, which are not the
+		// positions of the columns once the table is altered
+		for _, def := range defs {
+			if attr, ok := def.(*engine.AttributeDef); ok {
+				if idx := schema.GetColIdx(attr.Attr.Name); idx >= 0 {
+					schema.ColDefs[idx].SeqNum = attr.Attr.Seqnum
+				}
+			}
+		}
+		return schema, true
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	snapshotPath = "/replication/snapshot"
+	rowsPath     = "/replication/rows"
+	changesPath  = "/replication/changes"
+
+	defaultMaxChangesPerRequest = 10000
+	defaultRowsPerRequest       = 10000
+)
+
+type errorResponse struct {
+	Code    uint16 `json:"code"`
+	Message string `json:"message"`
+}
+
+// Publisher serves the snapshots and the changes of the publications to
+// the subscribers of other clusters:
+//
+//	GET /replication/snapshot?publication=pub&subscription=sub[&table=t]
+//	GET /replication/rows?publication=pub&subscription=sub&table=t[&cursor=c][&limit=n]
+//	GET /replication/changes?publication=pub&subscription=sub&lsn=n[&max=n]
+//
+// Subscribers are authenticated by the account and the token of the basic
+// authentication, requests without a valid credential are rejected.
+type Publisher struct {
+	source      PublicationSource
+	changes     *ChangeLog
+	credentials []Credential
+}
+
+var _ http.Handler = (*Publisher)(nil)
+
+// NewPublisher creates a Publisher
+func NewPublisher(source PublicationSource, changes *ChangeLog, credentials []Credential) *Publisher {
+	return &Publisher{
+		source:      source,
+		changes:     changes,
+		credentials: credentials,
+	}
+}
+
+func (p *Publisher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	account, ok := p.authenticate(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	var v any
+	var err error
+	switch r.URL.Path {
+	case snapshotPath:
+		v, err = p.snapshot(r, account)
+	case rowsPath:
+		v, err = p.getRows(r, account)
+	case changesPath:
+		v, err = p.getChanges(r, account)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// authenticate returns the account of the credential of the request
+func (p *Publisher) authenticate(r *http.Request) (string, bool) {
+	account, token, ok := r.BasicAuth()
+	if !ok || token == "" {
+		return "", false
+	}
+	for _, c := range p.credentials {
+		if c.Account == account &&
+			subtle.ConstantTimeCompare([]byte(token), []byte(c.Token)) == 1 {
+			return account, true
+		}
+	}
+	return "", false
+}
+
+// resolve returns the publication database of the request and the name of
+// the cursor of the subscription
+func (p *Publisher) resolve(r *http.Request, account string) (uint64, string, string, error) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	subscription := query.Get("subscription")
+	if subscription == "" {
+		return 0, "", "", moerr.NewInvalidInput(ctx, "subscription not specified")
+	}
+	dbID, database, err := p.source.Resolve(ctx, query.Get("publication"), account)
+	if err != nil {
+		return 0, "", "", err
+	}
+	return dbID, database, account + "/" + subscription, nil
+}
+
+func (p *Publisher) snapshot(r *http.Request, account string) (*Snapshot, error) {
+	ctx := r.Context()
+	_, database, subscription, err := p.resolve(r, account)
+	if err != nil {
+		return nil, err
+	}
+	// the changes from lsn are applied again to the snapshot
+	lsn := p.changes.Next()
+	if lsn == 0 {
+		return nil, moerr.NewInvalidState(ctx, "replication change log not started")
+	}
+	table := r.URL.Query().Get("table")
+	if table == "" {
+		// the changes from the snapshot are kept while it is copied
+		p.changes.Register(subscription, lsn)
+	}
+	tables, err := p.source.Snapshot(ctx, database, table)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		Database: database,
+		Lsn:      lsn,
+		Tables:   tables,
+	}, nil
+}
+
+func (p *Publisher) getRows(r *http.Request, account string) (*Rows, error) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	_, database, subscription, err := p.resolve(r, account)
+	if err != nil {
+		return nil, err
+	}
+	limit := defaultRowsPerRequest
+	if v := query.Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit <= 0 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid limit '%s'", v)
+		}
+	}
+	p.changes.Renew(subscription)
+	tables, err := p.source.Snapshot(ctx, database, query.Get("table"))
+	if err != nil {
+		return nil, err
+	}
+	if len(tables) == 0 {
+		return nil, moerr.NewNoSuchTable(ctx, database, query.Get("table"))
+	}
+	return p.source.Rows(ctx, database, tables[0], query.Get("cursor"), limit)
+}
+
+func (p *Publisher) getChanges(r *http.Request, account string) (*ChangeSet, error) {
+	ctx := r.Context()
+	query := r.URL.Query()
+	dbID, _, subscription, err := p.resolve(r, account)
+	if err != nil {
+		return nil, err
+	}
+	lsn, err := strconv.ParseUint(query.Get("lsn"), 10, 64)
+	if err != nil {
+		return nil, moerr.NewInvalidInput(ctx, "invalid lsn '%s'", query.Get("lsn"))
+	}
+	max := defaultMaxChangesPerRequest
+	if v := query.Get("max"); v != "" {
+		if max, err = strconv.Atoi(v); err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid max '%s'", v)
+		}
+	}
+	cs, err := p.changes.Changes(ctx, subscription, dbID, lsn, max)
+	if err != nil {
+		return nil, err
+	}
+	return &cs, nil
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	resp := errorResponse{Code: moerr.ErrInternal, Message: err.Error()}
+	status := http.StatusInternalServerError
+	if me, ok := err.(*moerr.Error); ok {
+		resp.Code = me.ErrorCode()
+		switch resp.Code {
+		case moerr.ErrInvalidInput, moerr.ErrNoSuchTable, moerr.ErrBadDB:
+			status = http.StatusBadRequest
+		}
+	}
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
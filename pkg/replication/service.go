@@ -0,0 +1,508 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchSize    = 10000
+
+	// publisherConsumer is the name of the cdc consumer of the publisher,
+	// it is the same on all the CNs so a restarted CN goes on from the
+	// checkpoint of the consumer.
+	publisherConsumer = "replication-publisher"
+)
+
+// Credential authenticates the subscribers of other clusters as an account
+// of the cluster, the subscribers may subscribe the publications published
+// to the account.
+type Credential struct {
+	Account string `toml:"account"`
+	Token   string `toml:"token"`
+}
+
+// Config is the config of the replication of a CN
+type Config struct {
+	// ListenAddress is the https address of the publisher, the publications
+	// are not served to other clusters if it is empty. It should be set on
+	// one CN of the cluster only, the changes of the publisher are shared.
+	ListenAddress string `toml:"listen-address"`
+	// Credentials authenticate the subscribers of other clusters, the
+	// publisher is not started without credentials.
+	Credentials []Credential `toml:"credentials"`
+	// CertFile and KeyFile are the certificate and the key of the publisher
+	CertFile string `toml:"cert-file"`
+	KeyFile  string `toml:"key-file"`
+	// CAFile verifies the certificates of the publishers of the remote
+	// subscriptions, the cas of the system are used if it is empty.
+	CAFile string `toml:"ca-file"`
+	// Insecure allows the publisher and the subscribers to use plain http
+	// without tls, it is only for tests.
+	Insecure bool `toml:"insecure"`
+	// MaxChanges is the max number of the changes kept by the publisher
+	MaxChanges int `toml:"max-changes"`
+	// SubscriptionTTL is how long the changes are kept for a subscription
+	// that stops requesting them
+	SubscriptionTTL toml.Duration `toml:"subscription-ttl"`
+	// EnableSubscriber runs the remote subscriptions on the CN, it should be
+	// enabled on one CN of the cluster only.
+	EnableSubscriber bool `toml:"enable-subscriber"`
+	// PollInterval is the interval to poll the changes when caught up
+	PollInterval toml.Duration `toml:"poll-interval"`
+	// BatchSize is the max number of the changes applied in a transaction
+	BatchSize int `toml:"batch-size"`
+}
+
+// Adjust sets the default values of the config
+func (c *Config) Adjust() {
+	if c.MaxChanges <= 0 {
+		c.MaxChanges = defaultMaxChanges
+	}
+	if c.SubscriptionTTL.Duration <= 0 {
+		c.SubscriptionTTL.Duration = defaultCursorTTL
+	}
+	if c.PollInterval.Duration <= 0 {
+		c.PollInterval.Duration = defaultPollInterval
+	}
+	if c.BatchSize <= 0 {
+		c.BatchSize = defaultBatchSize
+	}
+}
+
+// GetService returns the Service of the process, false if the replication is
+// not started in the process.
+func GetService() (*Service, bool) {
+	rt := runtime.ProcessLevelRuntime()
+	if rt == nil {
+		return nil, false
+	}
+	v, ok := rt.GetGlobalVariables(runtime.ReplicationService)
+	if !ok {
+		return nil, false
+	}
+	return v.(*Service), true
+}
+
+// Option is the option of the Service
+type Option func(*Service)
+
+// WithLogger sets the logger of the Service
+func WithLogger(logger *zap.Logger) Option {
+	return func(s *Service) {
+		s.logger = logger
+	}
+}
+
+// WithPublisher sets the source of the publications, the store of the
+// changes and the factory of the log client read by the cdc consumer of the
+// publisher, the log is decoded with the decoder options.
+func WithPublisher(
+	source PublicationSource,
+	store ChangeStore,
+	resolver cdc.TableResolver,
+	newLogClient func(context.Context) (logservice.Client, error),
+	decoderOpts ...cdc.DecoderOption) Option {
+	return func(s *Service) {
+		s.publisher.source = source
+		s.publisher.store = store
+		s.publisher.resolver = resolver
+		s.publisher.newLogClient = newLogClient
+		s.publisher.decoderOpts = decoderOpts
+	}
+}
+
+type runningSubscriber struct {
+	*Subscriber
+	cancel context.CancelFunc
+}
+
+// Service publishes the publications of the cluster to other clusters, and
+// runs the subscribers of the remote subscriptions of the cluster.
+type Service struct {
+	cfg      Config
+	logger   *zap.Logger
+	executor Executor
+	stopper  *stopper.Stopper
+
+	publisher struct {
+		source       PublicationSource
+		store        ChangeStore
+		resolver     cdc.TableResolver
+		newLogClient func(context.Context) (logservice.Client, error)
+		decoderOpts  []cdc.DecoderOption
+		changes      *ChangeLog
+		server       *http.Server
+		listener     net.Listener
+	}
+
+	mu struct {
+		sync.Mutex
+		tableCreated bool
+		subscribers  map[string]*runningSubscriber
+	}
+}
+
+// NewService creates a Service, the sqls of the subscribers are executed by
+// executor.
+func NewService(cfg Config, executor Executor, opts ...Option) *Service {
+	cfg.Adjust()
+	s := &Service{
+		cfg:      cfg,
+		executor: executor,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.logger == nil {
+		s.logger = logutil.GetGlobalLogger()
+	}
+	s.logger = s.logger.Named("replication")
+	s.stopper = stopper.NewStopper("replication", stopper.WithLogger(s.logger))
+	s.mu.subscribers = make(map[string]*runningSubscriber)
+	return s
+}
+
+// Start starts the publisher and the subscribers
+func (s *Service) Start() error {
+	if s.cfg.ListenAddress != "" && s.publisher.source != nil {
+		if err := s.startPublisher(); err != nil {
+			return err
+		}
+	}
+	if s.cfg.EnableSubscriber {
+		if err := s.stopper.RunNamedTask("replication-subscribers", s.runSubscribers); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close stops the publisher and the subscribers
+func (s *Service) Close() error {
+	s.stopper.Stop()
+	if s.publisher.server != nil {
+		return s.publisher.server.Close()
+	}
+	return nil
+}
+
+func (s *Service) startPublisher() error {
+	if len(s.cfg.Credentials) == 0 {
+		return moerr.NewBadConfigNoCtx("replication publisher without credentials")
+	}
+	for _, c := range s.cfg.Credentials {
+		if c.Account == "" || c.Token == "" {
+			return moerr.NewBadConfigNoCtx("replication credential without account or token")
+		}
+	}
+	if (s.cfg.CertFile == "" || s.cfg.KeyFile == "") && !s.cfg.Insecure {
+		return moerr.NewBadConfigNoCtx("replication publisher without cert-file and key-file")
+	}
+	listener, err := net.Listen("tcp", s.cfg.ListenAddress)
+	if err != nil {
+		return err
+	}
+	s.publisher.changes = NewChangeLog(s.cfg.MaxChanges, s.cfg.SubscriptionTTL.Duration, s.publisher.store)
+	s.publisher.listener = listener
+	s.publisher.server = &http.Server{
+		Handler:   NewPublisher(s.publisher.source, s.publisher.changes, s.cfg.Credentials),
+		TLSConfig: &tls.Config{MinVersion: tls.VersionTLS12},
+	}
+	go func() {
+		var err error
+		if s.cfg.CertFile != "" && s.cfg.KeyFile != "" {
+			err = s.publisher.server.ServeTLS(listener, s.cfg.CertFile, s.cfg.KeyFile)
+		} else {
+			err = s.publisher.server.Serve(listener)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("replication publisher stopped", zap.Error(err))
+		}
+	}()
+	s.logger.Info("replication publisher started", zap.String("address", listener.Addr().String()))
+	return s.stopper.RunNamedTask("replication-publisher", s.runPublisher)
+}
+
+// runPublisher consumes the log into the change log of the publisher
+func (s *Service) runPublisher(ctx context.Context) {
+	var consumer *cdc.Consumer
+	loaded := false
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+		if !loaded {
+			if err := s.publisher.changes.Load(ctx); err != nil {
+				s.logger.Error("load changes of the publisher failed", zap.Error(err))
+				timer.Reset(s.cfg.PollInterval.Duration)
+				continue
+			}
+			loaded = true
+		}
+		if consumer == nil {
+			client, err := s.publisher.newLogClient(ctx)
+			if err != nil {
+				s.logger.Error("create log client of the publisher failed", zap.Error(err))
+				timer.Reset(s.cfg.PollInterval.Duration)
+				continue
+			}
+			defer client.Close()
+			if err = s.checkConsumer(ctx, client); err != nil {
+				s.logger.Error("check cdc consumer of the publisher failed", zap.Error(err))
+				timer.Reset(s.cfg.PollInterval.Duration)
+				continue
+			}
+			consumer, err = cdc.NewConsumer(publisherConsumer, client,
+				cdc.NewTAEDecoder(s.publisher.resolver, s.publisher.decoderOpts...), s.publisher.changes,
+				cdc.WithLogger(s.logger))
+			if err != nil {
+				s.logger.Error("create cdc consumer of the publisher failed", zap.Error(err))
+				return
+			}
+		}
+		n, err := consumer.Poll(ctx)
+		if err != nil {
+			s.logger.Error("consume log failed", zap.Error(err))
+		} else {
+			s.publisher.changes.Advance(consumer.Next())
+		}
+		if n == 0 || err != nil {
+			timer.Reset(s.cfg.PollInterval.Duration)
+		} else {
+			timer.Reset(0)
+		}
+	}
+}
+
+// checkConsumer drops the changes kept if the checkpoint of the consumer is
+// lost, e.g. its lease expired while the publisher was down. The consumer
+// starts from the oldest log kept, the log after the changes may be gone.
+func (s *Service) checkConsumer(ctx context.Context, client logservice.Client) error {
+	checkpoint, ok := client.(logservice.ConsumerClient)
+	if !ok {
+		return moerr.NewNotSupported(ctx, "log client without consumer checkpoints")
+	}
+	lsn, err := checkpoint.GetConsumerLsn(ctx, publisherConsumer)
+	if err != nil || lsn != 0 {
+		return err
+	}
+	return s.publisher.changes.Reset(ctx)
+}
+
+// PublisherAddress returns the address the publisher listens on, empty if
+// the publisher is not started.
+func (s *Service) PublisherAddress() string {
+	if s.publisher.listener == nil {
+		return ""
+	}
+	return s.publisher.listener.Addr().String()
+}
+
+// Subscribe saves a remote subscription, the subscription is replicated by
+// the CN enabling the subscriber.
+func (s *Service) Subscribe(ctx context.Context, name, endpoint, publication string, conflict ConflictMode) error {
+	if _, err := NewClient(ctx, endpoint, name, s.cfg); err != nil {
+		return err
+	}
+	if err := s.createTable(ctx); err != nil {
+		return err
+	}
+	return s.executor.Exec(ctx, "", []string{
+		fmt.Sprintf(insertSubscriptionFormat,
+			quoteString(name), quoteString(endpoint), quoteString(publication), quoteString(string(conflict))),
+	})
+}
+
+// Unsubscribe removes the remote subscription of the name if it exists
+func (s *Service) Unsubscribe(ctx context.Context, name string) error {
+	if err := s.createTable(ctx); err != nil {
+		return err
+	}
+	if err := s.executor.Exec(ctx, "", []string{
+		fmt.Sprintf(deleteSubscriptionFormat, quoteString(name)),
+	}); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r, ok := s.mu.subscribers[name]; ok {
+		r.cancel()
+		delete(s.mu.subscribers, name)
+	}
+	return nil
+}
+
+// Subscriptions returns the status of the remote subscriptions, the lag is
+// only known for the subscriptions running on the CN.
+func (s *Service) Subscriptions(ctx context.Context) ([]Status, error) {
+	subs, err := s.loadSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]Status, 0, len(subs))
+	for _, sub := range subs {
+		if r, ok := s.mu.subscribers[sub.name]; ok {
+			statuses = append(statuses, r.Status())
+			continue
+		}
+		status := Status{
+			Name:        sub.name,
+			Endpoint:    endpointHost(sub.endpoint),
+			Publication: sub.publication,
+			Conflict:    sub.conflict,
+			Lsn:         sub.lsn,
+		}
+		if sub.appliedTS > 0 {
+			status.AppliedTS = time.Unix(0, sub.appliedTS)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// runSubscribers starts the subscribers of the new subscriptions and stops
+// the subscribers of the removed ones periodically.
+func (s *Service) runSubscribers(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if err := s.reconcile(ctx); err != nil {
+				s.logger.Error("load remote subscriptions failed", zap.Error(err))
+			}
+			timer.Reset(s.cfg.PollInterval.Duration)
+		}
+	}
+}
+
+func (s *Service) reconcile(ctx context.Context) error {
+	subs, err := s.loadSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make(map[string]struct{}, len(subs))
+	for _, sub := range subs {
+		names[sub.name] = struct{}{}
+		if _, ok := s.mu.subscribers[sub.name]; ok {
+			continue
+		}
+		subscriber, err := newSubscriber(ctx, sub, s.executor, s.logger, s.cfg)
+		if err != nil {
+			s.logger.Error("invalid remote subscription",
+				zap.String("subscription", sub.name),
+				zap.Error(err))
+			continue
+		}
+		subCtx, cancel := context.WithCancel(ctx)
+		if err := s.stopper.RunNamedTask("replication-subscriber", func(context.Context) {
+			subscriber.Run(subCtx)
+		}); err != nil {
+			cancel()
+			return err
+		}
+		s.mu.subscribers[sub.name] = &runningSubscriber{Subscriber: subscriber, cancel: cancel}
+	}
+	for name, r := range s.mu.subscribers {
+		if _, ok := names[name]; !ok {
+			r.cancel()
+			delete(s.mu.subscribers, name)
+		}
+	}
+	return nil
+}
+
+func (s *Service) loadSubscriptions(ctx context.Context) ([]subscription, error) {
+	if err := s.createTable(ctx); err != nil {
+		return nil, err
+	}
+	rows, err := s.executor.Query(ctx, getSubscriptionsSQL)
+	if err != nil {
+		return nil, err
+	}
+	subs := make([]subscription, 0, len(rows))
+	for _, row := range rows {
+		sub := subscription{
+			name:        fmt.Sprint(row[0]),
+			endpoint:    fmt.Sprint(row[1]),
+			publication: fmt.Sprint(row[2]),
+			conflict:    ConflictMode(fmt.Sprint(row[3])),
+		}
+		if sub.lsn, err = toUint64(row[4]); err != nil {
+			return nil, err
+		}
+		if sub.appliedTS, err = toInt64(row[5]); err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+func (s *Service) createTable(ctx context.Context) error {
+	s.mu.Lock()
+	created := s.mu.tableCreated
+	s.mu.Unlock()
+	if created {
+		return nil
+	}
+	if err := s.executor.Exec(ctx, "", []string{createSubscriptionTableSQL}); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.mu.tableCreated = true
+	s.mu.Unlock()
+	return nil
+}
+
+// endpointHost returns the host of the endpoint, the credential of the
+// endpoint is not shown
+func endpointHost(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
@@ -0,0 +1,419 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	createSubscriptionTableSQL = `create table if not exists mo_catalog.mo_remote_subscriptions(
+		sub_name varchar(5000) primary key,
+		endpoint varchar(5000),
+		pub_name varchar(5000),
+		conflict varchar(32),
+		lsn bigint unsigned,
+		applied_ts bigint
+	)`
+	insertSubscriptionFormat = `insert into mo_catalog.mo_remote_subscriptions(sub_name, endpoint, pub_name, conflict, lsn, applied_ts) values (%s, %s, %s, %s, 0, 0)`
+	deleteSubscriptionFormat = `delete from mo_catalog.mo_remote_subscriptions where sub_name = %s`
+	updateProgressFormat     = `update mo_catalog.mo_remote_subscriptions set lsn = %d, applied_ts = %d where sub_name = %s`
+	getSubscriptionsSQL      = `select sub_name, endpoint, pub_name, conflict, lsn, applied_ts from mo_catalog.mo_remote_subscriptions`
+
+	getPublicationFormat = `select database_id, database_name, all_account, account_list from mo_catalog.mo_pubs where pub_name = %s`
+	getTablesFormat      = `select relname from mo_catalog.mo_tables where reldatabase = %s and account_id = 0 and relkind = 'r' and relname not like '__mo_%%'`
+	getKeyFormat         = `select attname from mo_catalog.mo_columns where account_id = 0 and att_database = %s and att_relname = %s and att_constraint_type = 'p' and attname not like '__mo_%%' order by attnum`
+	getTableNameFormat   = `select d.datname, t.relname from mo_catalog.mo_tables t join mo_catalog.mo_database d on t.reldatabase_id = d.dat_id where t.rel_id = %d`
+
+	createChangeTableSQL = `create table if not exists mo_catalog.mo_replication_changes(
+		lsn bigint unsigned,
+		seq int unsigned,
+		db_id bigint unsigned,
+		data text,
+		primary key(lsn, seq)
+	)`
+	insertChangesPrefix   = `insert into mo_catalog.mo_replication_changes(lsn, seq, db_id, data) values `
+	getChangesSQL         = `select db_id, data from mo_catalog.mo_replication_changes order by lsn, seq`
+	truncateChangesFormat = `delete from mo_catalog.mo_replication_changes where lsn < %d`
+)
+
+type internalExecutor struct {
+	factory func() ie.InternalExecutor
+}
+
+// NewInternalExecutor returns an Executor executing the sqls by the internal
+// executors of the sys account.
+func NewInternalExecutor(factory func() ie.InternalExecutor) Executor {
+	return &internalExecutor{factory: factory}
+}
+
+func (e *internalExecutor) Exec(ctx context.Context, database string, sqls []string) error {
+	opts := ie.NewOptsBuilder().Internal(true)
+	if database != "" {
+		opts = opts.Database(database)
+	}
+	var sb strings.Builder
+	sb.WriteString("begin;")
+	for _, sql := range sqls {
+		sb.WriteString(sql)
+		sb.WriteString(";")
+	}
+	sb.WriteString("commit;")
+	return e.factory().Exec(ctx, sb.String(), opts.Finish())
+}
+
+func (e *internalExecutor) Query(ctx context.Context, sql string) ([][]any, error) {
+	_, rows, err := e.queryColumns(ctx, sql)
+	return rows, err
+}
+
+// queryColumns returns the column names and the rows of the query
+func (e *internalExecutor) queryColumns(ctx context.Context, sql string) ([]string, [][]any, error) {
+	res := e.factory().Query(ctx, sql, ie.NewOptsBuilder().Internal(true).Finish())
+	if err := res.Error(); err != nil {
+		return nil, nil, err
+	}
+	columns := make([]string, 0, res.ColumnCount())
+	for i := uint64(0); i < res.ColumnCount(); i++ {
+		name, _, _, err := res.Column(ctx, i)
+		if err != nil {
+			return nil, nil, err
+		}
+		columns = append(columns, name)
+	}
+	rows := make([][]any, 0, res.RowCount())
+	for i := uint64(0); i < res.RowCount(); i++ {
+		row, err := res.Row(ctx, i)
+		if err != nil {
+			return nil, nil, err
+		}
+		for j, v := range row {
+			row[j] = jsonValue(v)
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows, nil
+}
+
+// jsonValue converts the value of a result set to a value encoded by json
+func jsonValue(v any) any {
+	switch v := v.(type) {
+	case []byte:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// sqlSource is the PublicationSource of the publications of the sys account
+type sqlSource struct {
+	executor *internalExecutor
+}
+
+// NewSQLSource returns the PublicationSource of the publications of the sys
+// account, the publications and the snapshots are read by sqls.
+func NewSQLSource(factory func() ie.InternalExecutor) PublicationSource {
+	return &sqlSource{executor: &internalExecutor{factory: factory}}
+}
+
+func (s *sqlSource) Resolve(ctx context.Context, publication, account string) (uint64, string, error) {
+	rows, err := s.executor.Query(ctx, fmt.Sprintf(getPublicationFormat, quoteString(publication)))
+	if err != nil {
+		return 0, "", err
+	}
+	// a publication not published to the account is not told apart from
+	// a publication that does not exist
+	if len(rows) == 0 || !isPublishedTo(rows[0][2], rows[0][3], account) {
+		return 0, "", moerr.NewInvalidInput(ctx, "publication '%s' does not exist", publication)
+	}
+	id, err := toUint64(rows[0][0])
+	if err != nil {
+		return 0, "", err
+	}
+	return id, fmt.Sprint(rows[0][1]), nil
+}
+
+// isPublishedTo returns true if the publication is published to all the
+// accounts or the account is in its comma separated account list
+func isPublishedTo(allAccount, accountList any, account string) bool {
+	switch v := allAccount.(type) {
+	case bool:
+		if v {
+			return true
+		}
+	default:
+		if s := fmt.Sprint(v); s == "true" || s == "1" {
+			return true
+		}
+	}
+	if accountList == nil {
+		return false
+	}
+	for _, name := range strings.Split(fmt.Sprint(accountList), ",") {
+		if strings.TrimSpace(name) == account {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *sqlSource) Snapshot(ctx context.Context, database, table string) ([]Table, error) {
+	sql := fmt.Sprintf(getTablesFormat, quoteString(database))
+	if table != "" {
+		sql += " and relname = " + quoteString(table)
+	}
+	rows, err := s.executor.Query(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	tables := make([]Table, 0, len(rows))
+	for _, row := range rows {
+		name := fmt.Sprint(row[0])
+		rows, err := s.executor.Query(ctx, "show create table "+qualifiedName(database, name))
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			return nil, moerr.NewNoSuchTable(ctx, database, name)
+		}
+		t := Table{Name: name, CreateSQL: fmt.Sprint(rows[0][1])}
+		if rows, err = s.executor.Query(ctx, fmt.Sprintf(getKeyFormat, quoteString(database), quoteString(name))); err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			t.Key = append(t.Key, fmt.Sprint(row[0]))
+		}
+		tables = append(tables, t)
+	}
+	return tables, nil
+}
+
+// Rows reads the rows after the key of the cursor in the order of the key,
+// the cursor is the json array of the key of the last row read. The rows of
+// a table without a key are read by the offset of the cursor.
+func (s *sqlSource) Rows(ctx context.Context, database string, table Table, cursor string, limit int) (*Rows, error) {
+	sql := "select * from " + qualifiedName(database, table.Name)
+	var offset int
+	if len(table.Key) == 0 {
+		if cursor != "" {
+			var err error
+			if offset, err = strconv.Atoi(cursor); err != nil {
+				return nil, moerr.NewInvalidInput(ctx, "invalid cursor '%s'", cursor)
+			}
+		}
+		sql += fmt.Sprintf(" limit %d offset %d", limit, offset)
+	} else {
+		if cursor != "" {
+			var after []any
+			decoder := json.NewDecoder(strings.NewReader(cursor))
+			decoder.UseNumber()
+			if err := decoder.Decode(&after); err != nil || len(after) != len(table.Key) {
+				return nil, moerr.NewInvalidInput(ctx, "invalid cursor '%s'", cursor)
+			}
+			sql += " where " + afterKey(table.Key, after)
+		}
+		keys := make([]string, 0, len(table.Key))
+		for _, k := range table.Key {
+			keys = append(keys, quoteIdent(k))
+		}
+		sql += fmt.Sprintf(" order by %s limit %d", strings.Join(keys, ", "), limit)
+	}
+	columns, rows, err := s.executor.queryColumns(ctx, sql)
+	if err != nil {
+		return nil, err
+	}
+	page := &Rows{Columns: columns, Rows: rows}
+	if len(rows) < limit {
+		return page, nil
+	}
+	if len(table.Key) == 0 {
+		page.Next = strconv.Itoa(offset + len(rows))
+		return page, nil
+	}
+	last := rows[len(rows)-1]
+	key := make([]any, 0, len(table.Key))
+	for _, k := range table.Key {
+		for i, c := range columns {
+			if c == k {
+				key = append(key, last[i])
+				break
+			}
+		}
+	}
+	next, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	page.Next = string(next)
+	return page, nil
+}
+
+// afterKey returns the condition of the rows after the key values in the
+// order of the key columns
+func afterKey(key []string, values []any) string {
+	conds := make([]string, 0, len(key))
+	for i := range key {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, fmt.Sprintf("%s = %s", quoteIdent(key[j]), quoteValue(values[j])))
+		}
+		terms = append(terms, fmt.Sprintf("%s > %s", quoteIdent(key[i]), quoteValue(values[i])))
+		conds = append(conds, "("+strings.Join(terms, " and ")+")")
+	}
+	return "(" + strings.Join(conds, " or ") + ")"
+}
+
+func qualifiedName(database, table string) string {
+	return quoteIdent(database) + "." + quoteIdent(table)
+}
+
+// sqlChangeStore persists the changes of the ChangeLog in a table of the
+// catalog, the changes of the catalog are not logged by the ChangeLog so
+// the writes of the store are not logged again.
+type sqlChangeStore struct {
+	executor *internalExecutor
+	created  bool
+}
+
+// NewSQLChangeStore returns the ChangeStore persisting the changes in the
+// table mo_catalog.mo_replication_changes
+func NewSQLChangeStore(factory func() ie.InternalExecutor) ChangeStore {
+	return &sqlChangeStore{executor: &internalExecutor{factory: factory}}
+}
+
+func (s *sqlChangeStore) createTable(ctx context.Context) error {
+	if s.created {
+		return nil
+	}
+	if err := s.executor.Exec(ctx, "", []string{createChangeTableSQL}); err != nil {
+		return err
+	}
+	s.created = true
+	return nil
+}
+
+func (s *sqlChangeStore) Load(ctx context.Context) ([]StoredChange, error) {
+	if err := s.createTable(ctx); err != nil {
+		return nil, err
+	}
+	rows, err := s.executor.Query(ctx, getChangesSQL)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]StoredChange, 0, len(rows))
+	for _, row := range rows {
+		c := StoredChange{}
+		if c.DatabaseID, err = toUint64(row[0]); err != nil {
+			return nil, err
+		}
+		decoder := json.NewDecoder(strings.NewReader(fmt.Sprint(row[1])))
+		decoder.UseNumber()
+		if err = decoder.Decode(&c.Change); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	return changes, nil
+}
+
+func (s *sqlChangeStore) Append(ctx context.Context, changes []StoredChange) error {
+	if err := s.createTable(ctx); err != nil {
+		return err
+	}
+	var sqls []string
+	var sb strings.Builder
+	var seq uint32
+	for i, c := range changes {
+		if i > 0 && c.Lsn == changes[i-1].Lsn {
+			seq++
+		} else {
+			seq = 0
+		}
+		data, err := json.Marshal(c.Change)
+		if err != nil {
+			return err
+		}
+		if sb.Len() == 0 {
+			sb.WriteString(insertChangesPrefix)
+		} else {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "(%d, %d, %d, %s)", c.Lsn, seq, c.DatabaseID, quoteString(string(data)))
+		if (i+1)%defaultInsertBatchRows == 0 {
+			sqls = append(sqls, sb.String())
+			sb.Reset()
+		}
+	}
+	if sb.Len() > 0 {
+		sqls = append(sqls, sb.String())
+	}
+	return s.executor.Exec(ctx, "", sqls)
+}
+
+func (s *sqlChangeStore) Truncate(ctx context.Context, lsn uint64) error {
+	if err := s.createTable(ctx); err != nil {
+		return err
+	}
+	return s.executor.Exec(ctx, "", []string{fmt.Sprintf(truncateChangesFormat, lsn)})
+}
+
+// NewSQLTableResolver returns the cdc.TableResolver reading the names from
+// the catalog tables.
+func NewSQLTableResolver(ctx context.Context, factory func() ie.InternalExecutor) cdc.TableResolver {
+	executor := &internalExecutor{factory: factory}
+	return func(dbID, tableID uint64) (string, string, bool) {
+		rows, err := executor.Query(ctx, fmt.Sprintf(getTableNameFormat, tableID))
+		if err != nil || len(rows) == 0 {
+			return "", "", false
+		}
+		return fmt.Sprint(rows[0][0]), fmt.Sprint(rows[0][1]), true
+	}
+}
+
+func toUint64(v any) (uint64, error) {
+	switch v := v.(type) {
+	case uint64:
+		return v, nil
+	case int64:
+		return uint64(v), nil
+	case uint32:
+		return uint64(v), nil
+	case int32:
+		return uint64(v), nil
+	default:
+		return strconv.ParseUint(fmt.Sprint(v), 10, 64)
+	}
+}
+
+func toInt64(v any) (int64, error) {
+	switch v := v.(type) {
+	case int64:
+		return v, nil
+	case uint64:
+		return int64(v), nil
+	default:
+		return strconv.ParseInt(fmt.Sprint(v), 10, 64)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultInsertBatchRows = 1000
+)
+
+// subscription is a remote subscription saved in the catalog
+type subscription struct {
+	name        string
+	endpoint    string
+	publication string
+	conflict    ConflictMode
+	lsn         uint64
+	appliedTS   int64
+}
+
+// Subscriber replicates a publication of another cluster into the local
+// database of the subscription. It copies the snapshot of the publication
+// first, then applies the changes from the lsn of the snapshot. The rows of
+// the snapshot are copied page by page, the lsn of the snapshot is saved
+// once all the pages are copied. The changes and the lsn of the next changes
+// are saved in one transaction, so every change is applied once.
+type Subscriber struct {
+	sub          subscription
+	client       *Client
+	executor     Executor
+	logger       *zap.Logger
+	pollInterval time.Duration
+	batchSize    int
+
+	// tables are the local tables of the subscription
+	tables map[string]struct{}
+
+	mu struct {
+		sync.RWMutex
+		status Status
+	}
+}
+
+func newSubscriber(
+	ctx context.Context,
+	sub subscription,
+	executor Executor,
+	logger *zap.Logger,
+	cfg Config) (*Subscriber, error) {
+	client, err := NewClient(ctx, sub.endpoint, sub.name, cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := &Subscriber{
+		sub:          sub,
+		client:       client,
+		executor:     executor,
+		logger:       logger.With(zap.String("subscription", sub.name)),
+		pollInterval: cfg.PollInterval.Duration,
+		batchSize:    cfg.BatchSize,
+	}
+	s.mu.status = Status{
+		Name:        sub.name,
+		Endpoint:    client.base.Host,
+		Publication: sub.publication,
+		Conflict:    sub.conflict,
+		Lsn:         sub.lsn,
+		Running:     true,
+	}
+	if sub.appliedTS > 0 {
+		s.mu.status.AppliedTS = time.Unix(0, sub.appliedTS)
+	}
+	return s, nil
+}
+
+// Status returns the status of the subscription
+func (s *Subscriber) Status() Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mu.status
+}
+
+// Run replicates the publication until ctx is done
+func (s *Subscriber) Run(ctx context.Context) {
+	s.logger.Info("subscriber started", zap.Uint64("lsn", s.sub.lsn))
+	defer s.logger.Info("subscriber stopped")
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			caughtUp, err := s.step(ctx)
+			s.setError(err)
+			if err != nil && ctx.Err() == nil {
+				s.logger.Error("replicate publication failed", zap.Error(err))
+			}
+			if caughtUp || err != nil {
+				timer.Reset(s.pollInterval)
+			} else {
+				timer.Reset(0)
+			}
+		}
+	}
+}
+
+// step copies the snapshot or applies a batch of changes, it returns true if
+// all the changes known by the publisher have been applied.
+func (s *Subscriber) step(ctx context.Context) (bool, error) {
+	if s.tables == nil {
+		if err := s.loadTables(ctx); err != nil {
+			return false, err
+		}
+	}
+	if s.sub.lsn == 0 {
+		return false, s.copySnapshot(ctx)
+	}
+	cs, err := s.client.Changes(ctx, s.sub.publication, s.sub.lsn, s.batchSize)
+	if err != nil {
+		return false, err
+	}
+	if cs.Truncated {
+		s.logger.Warn("changes truncated by the publisher, copy the snapshot again",
+			zap.Uint64("lsn", s.sub.lsn))
+		s.sub.lsn = 0
+		return false, nil
+	}
+
+	// copy the tables created after the snapshot before applying the batch
+	for _, c := range cs.Changes {
+		if _, ok := s.tables[c.Table]; ok {
+			continue
+		}
+		if err = s.copyTable(ctx, c.Table); err != nil {
+			return false, err
+		}
+	}
+
+	var sqls []string
+	skipped := 0
+	appliedTS := s.sub.appliedTS
+	for _, c := range cs.Changes {
+		if _, ok := s.tables[c.Table]; !ok {
+			// dropped by the publisher
+			continue
+		}
+		changeSQLs, ok := changeSQLs(s.sub.conflict, c)
+		if !ok {
+			skipped++
+			continue
+		}
+		sqls = append(sqls, changeSQLs...)
+		if c.CommitTS > appliedTS {
+			appliedTS = c.CommitTS
+		}
+	}
+	sqls = append(sqls, fmt.Sprintf(updateProgressFormat, cs.Next, appliedTS, quoteString(s.sub.name)))
+	if err := s.executor.Exec(ctx, s.sub.name, sqls); err != nil {
+		return false, err
+	}
+	if skipped > 0 {
+		s.logger.Warn("deletes of unknown rows skipped", zap.Int("count", skipped))
+	}
+
+	caughtUp := len(cs.Changes) == 0
+	s.sub.lsn, s.sub.appliedTS = cs.Next, appliedTS
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.status.Lsn = cs.Next
+	s.mu.status.Skipped += uint64(skipped)
+	if appliedTS > 0 {
+		s.mu.status.AppliedTS = time.Unix(0, appliedTS)
+	}
+	if caughtUp || cs.LatestTS <= appliedTS {
+		s.mu.status.Lag = 0
+	} else {
+		s.mu.status.Lag = time.Duration(cs.LatestTS - appliedTS)
+	}
+	return caughtUp, nil
+}
+
+// loadTables loads the names of the local tables of the subscription
+func (s *Subscriber) loadTables(ctx context.Context) error {
+	rows, err := s.executor.Query(ctx, fmt.Sprintf(getTablesFormat, quoteString(s.sub.name)))
+	if err != nil {
+		return err
+	}
+	s.tables = make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		s.tables[fmt.Sprint(row[0])] = struct{}{}
+	}
+	return nil
+}
+
+// copySnapshot replaces the tables of the subscription with the snapshot of
+// the publication
+func (s *Subscriber) copySnapshot(ctx context.Context) error {
+	snapshot, err := s.client.Snapshot(ctx, s.sub.publication, "")
+	if err != nil {
+		return err
+	}
+	for _, t := range snapshot.Tables {
+		if err = s.copyRows(ctx, t); err != nil {
+			return err
+		}
+	}
+	if err = s.executor.Exec(ctx, s.sub.name, []string{
+		fmt.Sprintf(updateProgressFormat, snapshot.Lsn, s.sub.appliedTS, quoteString(s.sub.name)),
+	}); err != nil {
+		return err
+	}
+	s.logger.Info("snapshot copied",
+		zap.Int("tables", len(snapshot.Tables)),
+		zap.Uint64("lsn", snapshot.Lsn))
+	s.sub.lsn = snapshot.Lsn
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mu.status.Lsn = snapshot.Lsn
+	return nil
+}
+
+// copyTable copies a table created after the snapshot of the publication,
+// nothing is copied if the table no longer exists.
+func (s *Subscriber) copyTable(ctx context.Context, table string) error {
+	snapshot, err := s.client.Snapshot(ctx, s.sub.publication, table)
+	if err != nil {
+		return err
+	}
+	for _, t := range snapshot.Tables {
+		if err = s.copyRows(ctx, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyRows creates the table and copies its rows page by page, a page is
+// inserted in a transaction. The table is dropped if the copy fails, it is
+// copied again.
+func (s *Subscriber) copyRows(ctx context.Context, t Table) (err error) {
+	name := quoteIdent(t.Name)
+	if err = s.executor.Exec(ctx, s.sub.name, []string{
+		"drop table if exists " + name,
+		t.CreateSQL,
+	}); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = s.executor.Exec(ctx, s.sub.name, []string{"drop table if exists " + name})
+		}
+	}()
+	cursor := ""
+	for {
+		var page *Rows
+		if page, err = s.client.Rows(ctx, s.sub.publication, t.Name, cursor, s.batchSize); err != nil {
+			return err
+		}
+		if len(page.Rows) > 0 {
+			if err = s.executor.Exec(ctx, s.sub.name, insertRowsSQLs(t.Name, page)); err != nil {
+				return err
+			}
+		}
+		if page.Next == "" {
+			break
+		}
+		cursor = page.Next
+	}
+	s.tables[t.Name] = struct{}{}
+	return nil
+}
+
+// insertRowsSQLs returns the sqls inserting the rows of the page
+func insertRowsSQLs(table string, page *Rows) []string {
+	columns := make([]string, 0, len(page.Columns))
+	for _, c := range page.Columns {
+		columns = append(columns, quoteIdent(c))
+	}
+	prefix := fmt.Sprintf("insert into %s (%s) values ", quoteIdent(table), strings.Join(columns, ", "))
+	var sqls []string
+	for i := 0; i < len(page.Rows); i += defaultInsertBatchRows {
+		end := i + defaultInsertBatchRows
+		if end > len(page.Rows) {
+			end = len(page.Rows)
+		}
+		var sb strings.Builder
+		sb.WriteString(prefix)
+		for j, row := range page.Rows[i:end] {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("(")
+			for k, v := range row {
+				if k > 0 {
+					sb.WriteString(", ")
+				}
+				sb.WriteString(quoteValue(v))
+			}
+			sb.WriteString(")")
+		}
+		sqls = append(sqls, sb.String())
+	}
+	return sqls
+}
+
+func (s *Subscriber) setError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err == nil {
+		s.mu.status.Error = ""
+		return
+	}
+	s.mu.status.Error = err.Error()
+}
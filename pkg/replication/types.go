@@ -0,0 +1,167 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package replication replicates the published databases of a MatrixOne
+// cluster to the subscriptions of other clusters. The publisher serves the
+// snapshots and the row changes of its publications over http, the
+// subscriber copies the snapshot of a publication into a local database and
+// applies the changes after it.
+package replication
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/cdc"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// ConflictMode decides how a change conflicting with the local rows of the
+// subscription is applied
+type ConflictMode string
+
+const (
+	// ConflictOverwrite makes the local row the same as the publisher's
+	ConflictOverwrite ConflictMode = "overwrite"
+	// ConflictSkip keeps the local row and skips the change
+	ConflictSkip ConflictMode = "skip"
+)
+
+// ParseConflictMode parses the conflict mode, overwrite is the default
+func ParseConflictMode(ctx context.Context, mode string) (ConflictMode, error) {
+	switch strings.ToLower(mode) {
+	case "", string(ConflictOverwrite):
+		return ConflictOverwrite, nil
+	case string(ConflictSkip):
+		return ConflictSkip, nil
+	default:
+		return "", moerr.NewInvalidInput(ctx, "unknown subscription conflict mode '%s'", mode)
+	}
+}
+
+// Table is a published table, its rows are read page by page
+type Table struct {
+	Name string `json:"name"`
+	// CreateSQL is the sql creating the table
+	CreateSQL string `json:"create_sql"`
+	// Key is the primary key of the table, the pages of the rows are read
+	// in the order of the key. Tables without a primary key are read by
+	// offsets, rows changed while they are read may be read twice or missed.
+	Key []string `json:"key,omitempty"`
+}
+
+// Rows is a page of the rows of a published table
+type Rows struct {
+	Columns []string `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+	// Next is the cursor of the next page, empty if it is the last page
+	Next string `json:"next,omitempty"`
+}
+
+// Snapshot is the snapshot of the tables of a publication. The rows of the
+// tables are read after Lsn page by page, so the changes from Lsn may have
+// been applied to them already, they are applied again idempotently.
+type Snapshot struct {
+	Database string  `json:"database"`
+	Lsn      uint64  `json:"lsn"`
+	Tables   []Table `json:"tables"`
+}
+
+// Change is a row change of a published table
+type Change struct {
+	Op    string `json:"op"`
+	Table string `json:"table"`
+	// CommitTS is the physical commit time of the change in nanoseconds
+	CommitTS int64   `json:"commit_ts"`
+	Lsn      uint64  `json:"lsn"`
+	Key      cdc.Row `json:"key,omitempty"`
+	Before   cdc.Row `json:"before,omitempty"`
+	After    cdc.Row `json:"after,omitempty"`
+}
+
+// ChangeSet is a batch of changes of a publication
+type ChangeSet struct {
+	Changes []Change `json:"changes"`
+	// Next is the lsn to request the following changes from
+	Next uint64 `json:"next"`
+	// Truncated is true if the changes requested are no longer kept by the
+	// publisher, the subscriber has to copy the snapshot again.
+	Truncated bool `json:"truncated,omitempty"`
+	// LatestTS is the commit time of the latest change known by the
+	// publisher, it is used to compute the lag of the subscriber.
+	LatestTS int64 `json:"latest_ts"`
+}
+
+// Status is the status of a subscription
+type Status struct {
+	Name        string
+	Endpoint    string
+	Publication string
+	Conflict    ConflictMode
+	// Lsn is the lsn of the next changes to apply
+	Lsn uint64
+	// AppliedTS is the commit time of the latest applied change
+	AppliedTS time.Time
+	// Lag is how far the subscription is behind the publisher
+	Lag time.Duration
+	// Skipped is the number of the deletes skipped because the publisher
+	// does not know the deleted rows
+	Skipped uint64
+	// Running is true if the subscription is replicated by the CN, the lag
+	// is only known by the CN replicating the subscription
+	Running bool
+	Error   string
+}
+
+// PublicationSource provides the publications to the Publisher
+type PublicationSource interface {
+	// Resolve returns the id and the name of the database of the
+	// publication, the publication must be published to the account.
+	Resolve(ctx context.Context, publication, account string) (uint64, string, error)
+	// Snapshot returns the tables of the database, only the specified table
+	// is returned if table is not empty, and nothing is returned if the
+	// table does not exist.
+	Snapshot(ctx context.Context, database, table string) ([]Table, error)
+	// Rows returns at most limit rows of the table from the cursor, the
+	// cursor of the first page is empty.
+	Rows(ctx context.Context, database string, table Table, cursor string, limit int) (*Rows, error)
+}
+
+// StoredChange is a change persisted by a ChangeStore
+type StoredChange struct {
+	DatabaseID uint64 `json:"db_id"`
+	Change
+}
+
+// ChangeStore persists the changes of the ChangeLog, so the publisher does
+// not lose them when it restarts
+type ChangeStore interface {
+	// Load returns the persisted changes in the order of the lsn
+	Load(ctx context.Context) ([]StoredChange, error)
+	// Append persists the changes after the persisted ones
+	Append(ctx context.Context, changes []StoredChange) error
+	// Truncate removes the changes before lsn, all the changes are removed
+	// if lsn is math.MaxUint64.
+	Truncate(ctx context.Context, lsn uint64) error
+}
+
+// Executor executes the sqls of the subscriber in the local cluster
+type Executor interface {
+	// Exec executes the sqls in the database, the sqls are executed in one
+	// transaction.
+	Exec(ctx context.Context, database string, sqls []string) error
+	// Query returns the rows of the query
+	Query(ctx context.Context, sql string) ([][]any, error)
+}
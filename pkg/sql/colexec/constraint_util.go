@@ -68,6 +68,7 @@ func FilterAndUpdateByRowId(
 	updateCols []map[string]int32,
 	parentIdxs []map[string]int32,
 	uniqueRels [][]engine.Relation,
+	partitions []*PartitionSource,
 ) (uint64, error) {
 	var affectedRows uint64
 	var delBatch *batch.Batch
@@ -92,6 +93,18 @@ func FilterAndUpdateByRowId(
 			parentIdx = parentIdxs[i]
 		}
 		info := GetInfoForInsertAndUpdate(tableDef, updateCol)
+		// nil means the table is not partitioned
+		var partition *PartitionSource
+		if len(partitions) > 0 {
+			partition = partitions[i]
+		}
+		rowIdIdx := int32(-1)
+		for _, idx := range setIdxList {
+			if bat.Vecs[idx].GetType().Oid == types.T_Rowid {
+				rowIdIdx = idx
+				break
+			}
+		}
 
 		delBatch, updateBatch, err = filterRowIdForUpdate(proc, bat, setIdxList, info.Attrs, parentIdx)
 		if err != nil {
@@ -103,7 +116,11 @@ func FilterAndUpdateByRowId(
 		affectedRows = affectedRows + uint64(delBatch.Length())
 		if delBatch.Length() > 0 {
 			// delete old rows
-			err = rels[i].Delete(proc.Ctx, delBatch, catalog.Row_ID)
+			if partition != nil {
+				_, err = DelPartitionsByRowId(proc, bat, rowIdIdx, partition.Idx, partition.Rels)
+			} else {
+				err = rels[i].Delete(proc.Ctx, delBatch, catalog.Row_ID)
+			}
 			if err != nil {
 				return 0, err
 			}
@@ -121,6 +138,11 @@ func FilterAndUpdateByRowId(
 				return 0, err
 			}
 
+			// convert the columns whose type is being changed
+			if err = FillConvertColumns(proc, updateBatch, tableDef); err != nil {
+				return 0, err
+			}
+
 			//  append hidden columns
 			//if info.compositePkey != "" {
 			//	util.FillCompositeClusterByBatch(updateBatch, info.compositePkey, proc)
@@ -145,7 +167,11 @@ func FilterAndUpdateByRowId(
 			WriteUniqueTable(nil, proc, updateBatch, tableDef, info.updateNameToPos, info.pkPos, uniqueRel)
 
 			// write origin table
-			err = rels[i].Write(proc.Ctx, updateBatch)
+			if partition != nil {
+				err = WritePartitions(proc, updateBatch, partition.Expr, partition.Rels)
+			} else {
+				err = rels[i].Write(proc.Ctx, updateBatch)
+			}
 			if err != nil {
 				return 0, err
 			}
@@ -0,0 +1,118 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// ConvertVector casts the vector to the type, the result is a new vector.
+// The values not null must be converted to values not null.
+func ConvertVector(proc *process.Process, vec *vector.Vector, typ types.Type) (*vector.Vector, error) {
+	from := *vec.GetType()
+	fid, _, _, err := function.GetFunctionByName(proc.Ctx, "cast", []types.Type{from, typ})
+	if err != nil {
+		return nil, err
+	}
+	toTyp := &plan.Type{Id: int32(typ.Oid), Width: typ.Width, Scale: typ.Scale}
+	expr := &plan.Expr{
+		Typ: toTyp,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fid, ObjName: "cast"},
+				Args: []*plan.Expr{
+					{
+						Typ:  &plan.Type{Id: int32(from.Oid), Width: from.Width, Scale: from.Scale},
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0}},
+					},
+					{
+						Typ:  toTyp,
+						Expr: &plan.Expr_T{T: &plan.TargetType{Typ: toTyp}},
+					},
+				},
+			},
+		},
+	}
+	n := vec.Length()
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vec
+	bat.InitZsOne(n)
+	res, err := EvalExpr(bat, proc, expr)
+	if err != nil {
+		return nil, err
+	}
+	newVec := vector.NewVec(typ)
+	if res.IsConst() {
+		err = newVec.UnionMulti(res, 0, n, proc.Mp())
+	} else {
+		err = newVec.UnionBatch(res, 0, n, nil, proc.Mp())
+	}
+	if res != vec {
+		res.Free(proc.Mp())
+	}
+	if err != nil {
+		newVec.Free(proc.Mp())
+		return nil, err
+	}
+	for i := 0; i < n; i++ {
+		if !newVec.GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		if !vec.IsConstNull() && (vec.IsConst() || !vec.GetNulls().Contains(uint64(i))) {
+			newVec.Free(proc.Mp())
+			return nil, moerr.NewInvalidInput(proc.Ctx, "can not convert the value of row %d to %s", i, typ.String())
+		}
+	}
+	return newVec, nil
+}
+
+// FillConvertColumns computes the hidden columns converting the columns of
+// the table from the columns of the batch. The type of a column is changed
+// by alter table in background, the rows written before the conversion
+// completes have both the column and its converted value.
+func FillConvertColumns(proc *process.Process, bat *batch.Batch, tableDef *plan.TableDef) error {
+	var pos map[string]int
+	for _, col := range tableDef.Cols {
+		name, ok := catalog.ConvertedColName(col.Name)
+		if !ok {
+			continue
+		}
+		if pos == nil {
+			pos = make(map[string]int, len(bat.Attrs))
+			for i, attr := range bat.Attrs {
+				pos[attr] = i
+			}
+		}
+		src, ok1 := pos[name]
+		dst, ok2 := pos[col.Name]
+		if !ok1 || !ok2 {
+			return moerr.NewInternalError(proc.Ctx, "column '%s' converted by '%s' not found", name, col.Name)
+		}
+		vec, err := ConvertVector(proc, bat.Vecs[src], types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale))
+		if err != nil {
+			return err
+		}
+		bat.Vecs[dst].Free(proc.Mp())
+		bat.Vecs[dst] = vec
+	}
+	return nil
+}
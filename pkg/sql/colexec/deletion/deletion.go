@@ -104,6 +104,11 @@ func Call(_ int, proc *process.Process, arg any, isFirst bool, isLast bool) (boo
 			// in a deletion operator
 			// do compaction here
 			p.DeleteCtx.DelSource[0].Delete(proc.Ctx, nil, catalog.Row_ID)
+			if len(p.DeleteCtx.PartitionSources) > 0 && p.DeleteCtx.PartitionSources[0] != nil {
+				for _, rel := range p.DeleteCtx.PartitionSources[0].Rels {
+					rel.Delete(proc.Ctx, nil, catalog.Row_ID)
+				}
+			}
 		}
 		return true, nil
 	}
@@ -146,20 +151,27 @@ func Call(_ int, proc *process.Process, arg any, isFirst bool, isLast bool) (boo
 	}
 
 	// update child table(which ref on delete set null)
-	_, err = colexec.FilterAndUpdateByRowId(proc, bat, delCtx.OnSetIdx, delCtx.OnSetSource, delCtx.OnSetRef, delCtx.OnSetTableDef, delCtx.OnSetUpdateCol, nil, delCtx.OnSetUniqueSource)
+	_, err = colexec.FilterAndUpdateByRowId(proc, bat, delCtx.OnSetIdx, delCtx.OnSetSource, delCtx.OnSetRef, delCtx.OnSetTableDef, delCtx.OnSetUpdateCol, nil, delCtx.OnSetUniqueSource, nil)
 	if err != nil {
 		return false, err
 	}
 
 	// delete origin table
-	idxList := make([]int32, len(delCtx.DelIdx))
 	for i := 0; i < len(delCtx.DelIdx); i++ {
 		// for now, we have row_id & pk. but only use row_id for delete
-		idxList[i] = delCtx.DelIdx[i][0]
-	}
-	affectedRows, err = colexec.FilterAndDelByRowId(proc, bat, idxList, delCtx.DelSource)
-	if err != nil {
-		return false, err
+		rowIdIdx := delCtx.DelIdx[i][0]
+		var rows uint64
+		if len(delCtx.PartitionSources) > 0 && delCtx.PartitionSources[i] != nil {
+			// the rows of a partitioned table are deleted from its partition tables
+			partition := delCtx.PartitionSources[i]
+			rows, err = colexec.DelPartitionsByRowId(proc, bat, rowIdIdx, partition.Idx, partition.Rels)
+		} else {
+			rows, err = colexec.FilterAndDelByRowId(proc, bat, []int32{rowIdIdx}, delCtx.DelSource[i:i+1])
+		}
+		if err != nil {
+			return false, err
+		}
+		affectedRows += rows
 	}
 	atomic.AddUint64(&p.AffectedRows, affectedRows)
 	return false, nil
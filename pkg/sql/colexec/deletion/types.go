@@ -84,6 +84,8 @@ type DeleteCtx struct {
 	DelSource []engine.Relation
 	DelRef    []*plan.ObjectRef
 	DelIdx    [][]int32
+	// nil for the tables not partitioned
+	PartitionSources []*colexec.PartitionSource
 
 	IdxSource []engine.Relation
 	IdxIdx    []int32
@@ -440,7 +440,14 @@ func EvalFilterByZonemap(
 		}
 		return
 	case *plan.Expr_Col:
-		v = meta.MustGetColumn(uint16(columnMap[int(t.Col.ColPos)])).ZoneMap()
+		seqnum := uint16(columnMap[int(t.Col.ColPos)])
+		if !meta.HasColumn(seqnum) {
+			// the column is filled when the block is read, it can not be
+			// filtered by the zonemap
+			v = objectio.NewZM(types.T_bool, 0)
+			return
+		}
+		v = meta.MustGetColumn(seqnum).ZoneMap()
 		return
 	case *plan.Expr_F:
 		var (
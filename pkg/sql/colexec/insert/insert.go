@@ -88,6 +88,12 @@ func Call(idx int, proc *process.Process, arg any, _ bool, _ bool) (bool, error)
 			ap.ctr.state = End
 			return false, err
 		}
+	} else if insertCtx.Partition != nil {
+		// the rows of a partitioned table are written into its partition tables
+		if err := colexec.WritePartitions(proc, bat, insertCtx.Partition.Expr, insertCtx.Partition.Rels); err != nil {
+			ap.ctr.state = End
+			return false, err
+		}
 	} else {
 		// write origin table, bat will be deeply copied into txn's workspace.
 		if err := insertCtx.Rels[0].Write(proc.Ctx, bat); err != nil {
@@ -47,6 +47,9 @@ type InsertCtx struct {
 
 	ParentIdx    map[string]int32
 	ClusterTable *plan.ClusterTable
+	// the partition tables written instead of the origin table, nil if the
+	// table is not partitioned
+	Partition *colexec.PartitionSource
 
 	IdxIdx []int32
 }
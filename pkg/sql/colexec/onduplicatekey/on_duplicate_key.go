@@ -106,6 +106,10 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 	updateExpr := insertArg.OnDuplicateExpr
 	oldRowIdVec := vector.MustFixedCol[types.Rowid](originBatch.Vecs[rowIdIdx])
 	delRowIdVec := vector.NewVec(types.T_Rowid.ToType())
+	var delPartitionVec *vector.Vector
+	if insertArg.Partition != nil {
+		delPartitionVec = vector.NewVec(*originBatch.Vecs[insertArg.Partition.Idx].GetType())
+	}
 
 	var oldUniqueRowIdVec []types.Rowid
 	var oldUniquePkVec *vector.Vector
@@ -177,6 +181,12 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 				if err != nil {
 					return nil, err
 				}
+				if delPartitionVec != nil {
+					err = delPartitionVec.UnionOne(originBatch.Vecs[insertArg.Partition.Idx], int64(i), proc.GetMPool())
+					if err != nil {
+						return nil, err
+					}
+				}
 
 				if len(insertArg.IdxIdx) > 0 {
 					err := vector.AppendFixed(delUniqueRowIdVec, oldUniqueRowIdVec[i], false, proc.GetMPool())
@@ -214,7 +224,15 @@ func resetInsertBatchForOnduplicateKey(proc *process.Process, originBatch *batch
 		deleteBatch.SetVector(0, delRowIdVec)
 
 		// delete origin rows
-		err := insertArg.Source.Delete(proc.Ctx, deleteBatch, catalog.Row_ID)
+		var err error
+		if delPartitionVec != nil {
+			// the rows of a partitioned table are deleted from its partition tables
+			deleteBatch.Attrs = append(deleteBatch.Attrs, "")
+			deleteBatch.Vecs = append(deleteBatch.Vecs, delPartitionVec)
+			_, err = colexec.DelPartitionsByRowId(proc, deleteBatch, 0, 1, insertArg.Partition.Rels)
+		} else {
+			err = insertArg.Source.Delete(proc.Ctx, deleteBatch, catalog.Row_ID)
+		}
 		if err != nil {
 			deleteBatch.Clean(proc.Mp())
 			return nil, err
@@ -15,6 +15,7 @@
 package onduplicatekey
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -37,6 +38,10 @@ type Argument struct {
 	OnDuplicateExpr map[string]*plan.Expr
 
 	IdxIdx []int32
+
+	// the partition tables of a partitioned table, the updated old rows are
+	// deleted from them
+	Partition *colexec.PartitionSource
 }
 
 func (arg *Argument) Free(*process.Process, bool) {}
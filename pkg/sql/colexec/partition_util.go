@@ -0,0 +1,190 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// PartitionSource is the partition tables of a partitioned table changed by
+// the DML, the rows of the table are stored in them
+type PartitionSource struct {
+	Rels []engine.Relation
+	// the position of the partition index of the old rows in the batch
+	Idx int32
+	// evaluates the partition index of the new rows
+	Expr *plan.Expr
+}
+
+// EvalPartitionIndexes evaluates the position of the partition table of every
+// row of the batch by the partition expression, -1 if a row has no partition
+func EvalPartitionIndexes(proc *process.Process, bat *batch.Batch, expr *plan.Expr) ([]int32, error) {
+	vec, err := EvalExpr(bat, proc, expr)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := getPartitionIndexes(proc, vec, bat.Length())
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return indexes, err
+		}
+	}
+	vec.Free(proc.Mp())
+	return indexes, err
+}
+
+func getPartitionIndexes(proc *process.Process, vec *vector.Vector, length int) ([]int32, error) {
+	indexes := make([]int32, length)
+	for i := range indexes {
+		if vec.IsConstNull() || vec.GetNulls().Contains(uint64(i)) {
+			indexes[i] = -1
+			continue
+		}
+		switch vec.GetType().Oid {
+		case types.T_int8:
+			indexes[i] = int32(vector.GetFixedAt[int8](vec, i))
+		case types.T_int16:
+			indexes[i] = int32(vector.GetFixedAt[int16](vec, i))
+		case types.T_int32:
+			indexes[i] = vector.GetFixedAt[int32](vec, i)
+		case types.T_int64:
+			indexes[i] = int32(vector.GetFixedAt[int64](vec, i))
+		case types.T_uint8:
+			indexes[i] = int32(vector.GetFixedAt[uint8](vec, i))
+		case types.T_uint16:
+			indexes[i] = int32(vector.GetFixedAt[uint16](vec, i))
+		case types.T_uint32:
+			indexes[i] = int32(vector.GetFixedAt[uint32](vec, i))
+		case types.T_uint64:
+			indexes[i] = int32(vector.GetFixedAt[uint64](vec, i))
+		default:
+			return nil, moerr.NewInternalError(proc.Ctx, "invalid type %s of the partition index", vec.GetType().String())
+		}
+	}
+	return indexes, nil
+}
+
+// groupByPartition returns the rows of every partition, it returns an error
+// if a row has no partition
+func groupByPartition(proc *process.Process, indexes []int32, partitionNum int) ([][]int32, error) {
+	sels := make([][]int32, partitionNum)
+	for i, idx := range indexes {
+		if idx < 0 || int(idx) >= partitionNum {
+			return nil, moerr.NewInvalidInput(proc.Ctx, "Table has no partition for some existing values")
+		}
+		sels[idx] = append(sels[idx], int32(i))
+	}
+	return sels, nil
+}
+
+// WritePartitions writes the rows of the batch into the partition tables, the
+// partition of a row is evaluated by the partition expression
+func WritePartitions(proc *process.Process, bat *batch.Batch, expr *plan.Expr, rels []engine.Relation) error {
+	indexes, err := EvalPartitionIndexes(proc, bat, expr)
+	if err != nil {
+		return err
+	}
+	sels, err := groupByPartition(proc, indexes, len(rels))
+	if err != nil {
+		return err
+	}
+	for i, sel := range sels {
+		if len(sel) == 0 {
+			continue
+		}
+		// the batch is deeply copied into the workspace of the txn, so it
+		// is written as is if all the rows are in the same partition
+		if len(sel) == bat.Length() {
+			return rels[i].Write(proc.Ctx, bat)
+		}
+		partBat, err := unionBatch(proc, bat, sel)
+		if err != nil {
+			return err
+		}
+		err = rels[i].Write(proc.Ctx, partBat)
+		partBat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unionBatch(proc *process.Process, bat *batch.Batch, sels []int32) (*batch.Batch, error) {
+	newBat := batch.NewWithSize(len(bat.Vecs))
+	newBat.SetAttributes(bat.Attrs)
+	for i, vec := range bat.Vecs {
+		newBat.Vecs[i] = vector.NewVec(*vec.GetType())
+		if err := newBat.Vecs[i].Union(vec, sels, proc.Mp()); err != nil {
+			newBat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	newBat.SetZs(len(sels), proc.Mp())
+	return newBat, nil
+}
+
+// DelPartitionsByRowId deletes the rows from the partition tables, the row ids
+// of the rows are at rowIdIdx of the batch and the positions of their partition
+// tables are at partitionIdx. It returns the number of deleted rows.
+func DelPartitionsByRowId(proc *process.Process, bat *batch.Batch, rowIdIdx, partitionIdx int32, rels []engine.Relation) (uint64, error) {
+	indexes, err := getPartitionIndexes(proc, bat.Vecs[partitionIdx], bat.Length())
+	if err != nil {
+		return 0, err
+	}
+	rowIdVec := bat.Vecs[rowIdIdx]
+	rowIds := vector.MustFixedCol[types.Rowid](rowIdVec)
+	rowIdMap := make(map[types.Rowid]struct{})
+	rowIdLists := make([][]types.Rowid, len(rels))
+	for i, idx := range indexes {
+		if rowIdVec.GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		if _, ok := rowIdMap[rowIds[i]]; ok {
+			continue
+		}
+		if idx < 0 || int(idx) >= len(rels) {
+			return 0, moerr.NewInternalError(proc.Ctx, "invalid partition of the deleted row")
+		}
+		rowIdMap[rowIds[i]] = struct{}{}
+		rowIdLists[idx] = append(rowIdLists[idx], rowIds[i])
+	}
+	for i, rowIdList := range rowIdLists {
+		if len(rowIdList) == 0 {
+			continue
+		}
+		delBatch := batch.NewWithSize(1)
+		delBatch.SetAttributes([]string{catalog.Row_ID})
+		delBatch.SetVector(0, vector.NewVec(types.T_Rowid.ToType()))
+		if err = vector.AppendFixedList(delBatch.Vecs[0], rowIdList, nil, proc.Mp()); err != nil {
+			delBatch.Clean(proc.Mp())
+			return 0, err
+		}
+		delBatch.SetZs(len(rowIdList), proc.Mp())
+		err = rels[i].Delete(proc.Ctx, delBatch, catalog.Row_ID)
+		delBatch.Clean(proc.Mp())
+		if err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(rowIdMap)), nil
+}
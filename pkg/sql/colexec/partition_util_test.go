@@ -0,0 +1,103 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+// newPartitionRels returns the mocked partition tables, the values of the
+// first column of the written rows and the deleted row ids are recorded
+func newPartitionRels(ctrl *gomock.Controller, n int, written [][]int64, deleted [][]types.Rowid) []engine.Relation {
+	rels := make([]engine.Relation, n)
+	for i := range rels {
+		i := i
+		rel := mock_frontend.NewMockRelation(ctrl)
+		rel.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, bat *batch.Batch) error {
+			written[i] = append(written[i], vector.MustFixedCol[int64](bat.Vecs[0])...)
+			return nil
+		}).AnyTimes()
+		rel.EXPECT().Delete(gomock.Any(), gomock.Any(), catalog.Row_ID).DoAndReturn(func(_ context.Context, bat *batch.Batch, _ string) error {
+			deleted[i] = append(deleted[i], vector.MustFixedCol[types.Rowid](bat.Vecs[0])...)
+			return nil
+		}).AnyTimes()
+		rels[i] = rel
+	}
+	return rels
+}
+
+func TestWritePartitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	proc := testutil.NewProcess()
+
+	written := make([][]int64, 3)
+	rels := newPartitionRels(ctrl, 3, written, make([][]types.Rowid, 3))
+	bat := testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(4, types.T_int64.ToType(), proc.Mp(), false, []int64{10, 11, 12, 13}),
+		testutil.NewInt32Vector(4, types.T_int32.ToType(), proc.Mp(), false, []int32{2, 0, 2, 0}),
+	}, nil)
+	expr := &plan.Expr{
+		Typ:  &plan.Type{Id: int32(types.T_int32)},
+		Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 1}},
+	}
+	require.NoError(t, WritePartitions(proc, bat, expr, rels))
+	require.Equal(t, []int64{11, 13}, written[0])
+	require.Empty(t, written[1])
+	require.Equal(t, []int64{10, 12}, written[2])
+
+	// a row without partition is not written
+	bat = testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(2, types.T_int64.ToType(), proc.Mp(), false, []int64{14, 15}),
+		testutil.NewInt32Vector(2, types.T_int32.ToType(), proc.Mp(), false, []int32{1, -1}),
+	}, nil)
+	require.Error(t, WritePartitions(proc, bat, expr, rels))
+	require.Empty(t, written[1])
+}
+
+func TestDelPartitionsByRowId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	proc := testutil.NewProcess()
+
+	deleted := make([][]types.Rowid, 2)
+	rels := newPartitionRels(ctrl, 2, make([][]int64, 2), deleted)
+	rowIds := make([]types.Rowid, 3)
+	for i := range rowIds {
+		rowIds[i][0] = byte(i + 1)
+	}
+	// the same row can be joined more than once
+	bat := testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewRowidVector(4, types.T_Rowid.ToType(), proc.Mp(), false, []types.Rowid{rowIds[0], rowIds[1], rowIds[0], rowIds[2]}),
+		testutil.NewInt32Vector(4, types.T_int32.ToType(), proc.Mp(), false, []int32{1, 0, 1, 1}),
+	}, nil)
+	rows, err := DelPartitionsByRowId(proc, bat, 0, 1, rels)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), rows)
+	require.Equal(t, []types.Rowid{rowIds[1]}, deleted[0])
+	require.Equal(t, []types.Rowid{rowIds[0], rowIds[2]}, deleted[1])
+}
@@ -68,6 +68,12 @@ func Call(idx int, proc *proc, x any, _, _ bool) (bool, error) {
 		return false, err
 	}
 
+	// convert the columns whose type is being changed
+	err = colexec.FillConvertColumns(proc, insertBatch, arg.TableDef)
+	if err != nil {
+		return false, err
+	}
+
 	err = genCompositePrimaryKey(insertBatch, proc, arg.TableDef)
 	if err != nil {
 		return false, err
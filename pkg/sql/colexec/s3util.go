@@ -42,6 +42,8 @@ type S3Writer struct {
 
 	schemaVersion uint32
 	seqnums       []uint16
+	// tableID is the id of the origin table, zero for unique index tables
+	tableID uint64
 
 	writer  *blockio.BlockWriter
 	lengths []uint64
@@ -149,6 +151,7 @@ func AllocS3Writers(tableDef *plan.TableDef) ([]*S3Writer, error) {
 		//handle origin/main table's sort index.
 		if i == 0 {
 			writers[i].schemaVersion = tableDef.Version
+			writers[i].tableID = tableDef.TblId
 			writers[i].seqnums = make([]uint16, 0)
 			for _, colDef := range tableDef.Cols {
 				if colDef.Name != catalog.Row_ID {
@@ -566,7 +569,12 @@ func (w *S3Writer) writeEndBlocks(proc *process.Process) error {
 // writeEndBlocks writes batches in buffer to fileservice(aka s3 in this feature) and get meta data about block on fileservice and put it into metaLocBat
 // For more information, please refer to the comment about func WriteEnd in Writer interface
 func (w *S3Writer) WriteEndBlocks(proc *process.Process) ([]string, error) {
-	blocks, _, err := w.writer.Sync(proc.Ctx)
+	ctx := proc.Ctx
+	if w.tableID != 0 {
+		accountID, _ := ctx.Value(defines.TenantIDKey{}).(uint32)
+		ctx = fileservice.WithTieringTag(ctx, accountID, w.tableID)
+	}
+	blocks, _, err := w.writer.Sync(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package update
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -37,6 +38,8 @@ type UpdateCtx struct {
 	TableDefs    []*plan.TableDef
 	HasAutoCol   []bool
 	UpdateCol    []map[string]int32
+	// nil for the tables not partitioned
+	PartitionSources []*colexec.PartitionSource
 
 	IdxSource []engine.Relation
 	IdxIdx    []int32
@@ -0,0 +1,650 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	"github.com/matrixorigin/matrixone/pkg/txn/client"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The type of a column is changed by alter table without rewriting the
+// table. Alter table adds a hidden column of the new type, the DML on the
+// table writes the converted values of the column to it. A background task
+// converts the rows written before, and replaces the column by the hidden
+// column in one txn when all the rows are converted. The table keeps its id,
+// and the DML on the table continues during the conversion.
+
+const (
+	// the time waiting for the txn of alter table to commit
+	convertColumnWaitTime = 10 * time.Minute
+	// the max number of rows converted in a txn
+	convertColumnTxnRows = 1 << 20
+
+	convertColumnRetryTimes    = 10
+	convertColumnRetryInterval = time.Minute
+)
+
+// convertColumnTask is the context of the task converting a column
+type convertColumnTask struct {
+	AccountId    uint32 `json:"account_id"`
+	UserId       uint32 `json:"user_id"`
+	RoleId       uint32 `json:"role_id"`
+	DatabaseName string `json:"database_name"`
+	TableName    string `json:"table_name"`
+	TableId      uint64 `json:"table_id"`
+	// the name of the column after alter table
+	Column string `json:"column"`
+	// the partition tables converted with the table
+	PartitionTables []string `json:"partition_tables,omitempty"`
+}
+
+// checkAlterColumnValues checks the values of the columns modified by alter
+// table can be converted to the new types, and are not null if the columns
+// are changed to not null.
+func checkAlterColumnValues(c *Compile, rels []engine.Relation, qry *plan.AlterTable) error {
+	for _, action := range qry.Actions {
+		act, ok := action.Action.(*plan.AlterTable_Action_ModifyColumn)
+		if !ok {
+			continue
+		}
+		oldDef := findColDef(qry.TableDef, act.ModifyColumn.OldName)
+		if oldDef == nil {
+			continue
+		}
+		def := act.ModifyColumn.Column
+		convert := !plan2.IsCompatibleColumnType(oldDef.Typ, def.Typ)
+		notNull := !def.Default.GetNullAbility() && (oldDef.Default == nil || oldDef.Default.NullAbility)
+		if !convert && !notNull {
+			continue
+		}
+		typ := types.New(types.T(def.Typ.Id), def.Typ.Width, def.Typ.Scale)
+		for _, rel := range rels {
+			err := scanColumn(c.ctx, rel, oldDef.Name, c.proc.Mp(), func(vec *vector.Vector) error {
+				if notNull && (vec.IsConstNull() || !vec.IsConst() && vec.GetNulls().Any()) {
+					return moerr.NewConstraintViolation(c.ctx, "Column '%s' cannot be null", def.Name)
+				}
+				if !convert {
+					return nil
+				}
+				res, err := colexec.ConvertVector(c.proc, vec, typ)
+				if err != nil {
+					return err
+				}
+				res.Free(c.proc.Mp())
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanColumn calls fn with the values of the column of all the rows
+func scanColumn(ctx context.Context, rel engine.Relation, name string, mp *mpool.MPool, fn func(*vector.Vector) error) error {
+	rds, err := newTableReaders(ctx, rel)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, rd := range rds {
+			rd.Close()
+		}
+	}()
+	attrs := []string{name}
+	for len(rds) > 0 {
+		bat, err := rds[0].Read(ctx, attrs, nil, mp, nil)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			rds[0].Close()
+			rds = rds[1:]
+			continue
+		}
+		err = fn(bat.Vecs[0])
+		bat.Clean(mp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// convertColumns creates the tasks converting the columns changed to the
+// types not compatible with their values
+func convertColumns(c *Compile, dbName string, qry *plan.AlterTable, tblId uint64) error {
+	for _, action := range qry.Actions {
+		act, ok := action.Action.(*plan.AlterTable_Action_ModifyColumn)
+		if !ok {
+			continue
+		}
+		oldDef := findColDef(qry.TableDef, act.ModifyColumn.OldName)
+		if oldDef == nil || plan2.IsCompatibleColumnType(oldDef.Typ, act.ModifyColumn.Column.Typ) {
+			continue
+		}
+		if err := createConvertColumnTask(c, dbName, qry.TableDef, tblId, act.ModifyColumn.Column.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkConvertingColumns returns an error if the columns of the table are
+// being converted, the table can not be altered before the columns are
+// replaced. The tasks of the conversion are created again, in case they are
+// failed.
+func checkConvertingColumns(c *Compile, dbName, tblName string, tblId uint64, tableDef *plan.TableDef) error {
+	for _, col := range tableDef.Cols {
+		name, ok := catalog.ConvertedColName(col.Name)
+		if !ok {
+			continue
+		}
+		if err := createConvertColumnTask(c, dbName, tableDef, tblId, name); err != nil {
+			return err
+		}
+		return moerr.NewNotSupported(c.ctx, "alter table '%s' before the type of column '%s' is changed", tblName, name)
+	}
+	return nil
+}
+
+// createConvertColumnTask creates the task converting the column by the
+// task service. The task is created before the txn of alter table commits,
+// it waits for the hidden column to be visible. Without task service the
+// column is converted by a goroutine of this CN.
+func createConvertColumnTask(c *Compile, dbName string, tableDef *plan.TableDef, tblId uint64, col string) error {
+	t := convertColumnTask{
+		DatabaseName: dbName,
+		TableName:    tableDef.Name,
+		TableId:      tblId,
+		Column:       col,
+	}
+	t.AccountId, _ = c.ctx.Value(defines.TenantIDKey{}).(uint32)
+	t.UserId, _ = c.ctx.Value(defines.UserIDKey{}).(uint32)
+	t.RoleId, _ = c.ctx.Value(defines.RoleIDKey{}).(uint32)
+	if tableDef.Partition != nil {
+		t.PartitionTables = tableDef.Partition.PartitionTableNames
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	if v, ok := runtime.ProcessLevelRuntime().GetGlobalVariables(runtime.TaskService); ok {
+		if ts, ok := v.(taskservice.TaskServiceHolder).Get(); ok {
+			return ts.Create(c.ctx, task.TaskMetadata{
+				ID:       fmt.Sprintf("alter-convert-column/%d/%s/%x", tblId, col, c.proc.TxnOperator.Txn().ID),
+				Executor: task.TaskCode_ConvertColumn,
+				Context:  data,
+				Options: task.TaskOptions{
+					MaxRetryTimes: convertColumnRetryTimes,
+					RetryInterval: int64(convertColumnRetryInterval),
+				},
+			})
+		}
+	}
+
+	cc := &columnConverter{
+		eng:  c.e,
+		cli:  c.proc.TxnClient,
+		fs:   c.proc.FileService,
+		task: t,
+	}
+	go func() {
+		for i := 0; ; i++ {
+			err := cc.run(context.Background())
+			if err == nil {
+				return
+			}
+			logutil.Errorf("convert column %s of table %s.%s failed: %v", col, dbName, tableDef.Name, err)
+			if i == convertColumnRetryTimes {
+				return
+			}
+			time.Sleep(convertColumnRetryInterval)
+		}
+	}()
+	return nil
+}
+
+// ConvertColumnExecutor returns the executor of the tasks converting the
+// columns of the tables changed to new types by alter table
+func ConvertColumnExecutor(eng engine.Engine, cli client.TxnClient, fs fileservice.FileService) taskservice.TaskExecutor {
+	return func(ctx context.Context, t task.Task) error {
+		cc := &columnConverter{
+			eng: eng,
+			cli: cli,
+			fs:  fs,
+		}
+		if err := json.Unmarshal(t.Metadata.Context, &cc.task); err != nil {
+			return err
+		}
+		return cc.run(ctx)
+	}
+}
+
+type columnConverter struct {
+	eng  engine.Engine
+	cli  client.TxnClient
+	fs   fileservice.FileService
+	mp   *mpool.MPool
+	task convertColumnTask
+}
+
+// convertFailure is the error of converting the values of the column, the
+// conversion is given up
+type convertFailure struct {
+	err error
+}
+
+func (f *convertFailure) Error() string {
+	return f.err.Error()
+}
+
+func (cc *columnConverter) run(ctx context.Context) error {
+	ctx = context.WithValue(ctx, defines.TenantIDKey{}, cc.task.AccountId)
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, cc.task.UserId)
+	ctx = context.WithValue(ctx, defines.RoleIDKey{}, cc.task.RoleId)
+	mp, err := mpool.NewMPool("alter_convert_column", 0, mpool.NoFixed)
+	if err != nil {
+		return err
+	}
+	defer mpool.DeleteMPool(mp)
+	cc.mp = mp
+
+	deadline := time.Now().Add(convertColumnWaitTime)
+	for {
+		var found bool
+		err = cc.doTxn(ctx, func(_ *process.Process, rels []engine.Relation) error {
+			found = len(rels) > 0
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if found {
+			break
+		}
+		// the txn of alter table is rolled back, or the column is replaced
+		if time.Now().After(deadline) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+
+	for {
+		var done bool
+		err = cc.doTxn(ctx, func(proc *process.Process, rels []engine.Relation) error {
+			if len(rels) == 0 {
+				done = true
+				return nil
+			}
+			rows, err := cc.convertRows(proc, rels)
+			if err != nil || rows > 0 {
+				return err
+			}
+			done = true
+			return cc.replaceColumn(proc.Ctx, rels)
+		})
+		if failure, ok := err.(*convertFailure); ok {
+			logutil.Errorf("give up changing the type of column %s of table %s.%s: %v",
+				cc.task.Column, cc.task.DatabaseName, cc.task.TableName, failure.err)
+			return cc.doTxn(ctx, func(proc *process.Process, rels []engine.Relation) error {
+				return cc.dropConvertColumn(proc.Ctx, rels)
+			})
+		}
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// doTxn calls fn in a new txn with the table and its partition tables, the
+// relations are nil if the table has no hidden column converting the column
+func (cc *columnConverter) doTxn(ctx context.Context, fn func(*process.Process, []engine.Relation) error) error {
+	op, err := cc.cli.New(ctx, timestamp.Timestamp{})
+	if err != nil {
+		return err
+	}
+	if err = cc.eng.New(ctx, op); err != nil {
+		_ = op.Rollback(ctx)
+		return err
+	}
+	rels, err := cc.getRelations(ctx, op)
+	if err == nil {
+		proc := process.New(ctx, cc.mp, cc.cli, op, cc.fs, nil, nil)
+		err = fn(proc, rels)
+	}
+	if err == nil {
+		err = cc.eng.Commit(ctx, op)
+	}
+	if err != nil {
+		_ = cc.eng.Rollback(ctx, op)
+		_ = op.Rollback(ctx)
+		return err
+	}
+	return op.Commit(ctx)
+}
+
+func (cc *columnConverter) getRelations(ctx context.Context, op client.TxnOperator) ([]engine.Relation, error) {
+	_, _, rel, err := cc.eng.GetRelationById(ctx, op, cc.task.TableId)
+	if err != nil {
+		// the table gets a new id if it is truncated
+		db, err := cc.eng.Database(ctx, cc.task.DatabaseName, op)
+		if err != nil {
+			return nil, nil
+		}
+		if rel, err = db.Relation(ctx, cc.task.TableName); err != nil {
+			return nil, nil
+		}
+	}
+	defs, err := rel.TableDefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if findAttr(defs, catalog.ConvertColName(cc.task.Column)) == nil {
+		return nil, nil
+	}
+	rels := []engine.Relation{rel}
+	if len(cc.task.PartitionTables) > 0 {
+		db, err := cc.eng.Database(ctx, cc.task.DatabaseName, op)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range cc.task.PartitionTables {
+			partRel, err := db.Relation(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			rels = append(rels, partRel)
+		}
+	}
+	return rels, nil
+}
+
+func findAttr(defs []engine.TableDef, name string) *engine.Attribute {
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name == name {
+			return &attr.Attr
+		}
+	}
+	return nil
+}
+
+// convertRows converts the rows not converted yet, the rows are deleted and
+// written again with the converted values. It returns the number of the
+// converted rows, at most convertColumnTxnRows rows are converted in a txn.
+func (cc *columnConverter) convertRows(proc *process.Process, rels []engine.Relation) (int, error) {
+	ctx := proc.Ctx
+	rows := 0
+	for _, rel := range rels {
+		defs, err := rel.TableDefs(ctx)
+		if err != nil {
+			return 0, err
+		}
+		var attrs []string
+		src, dst := -1, -1
+		var typ types.Type
+		for _, def := range defs {
+			attr, ok := def.(*engine.AttributeDef)
+			if !ok || attr.Attr.Name == catalog.Row_ID {
+				continue
+			}
+			switch attr.Attr.Name {
+			case cc.task.Column:
+				src = len(attrs)
+			case catalog.ConvertColName(cc.task.Column):
+				dst = len(attrs)
+				typ = attr.Attr.Type
+			}
+			attrs = append(attrs, attr.Attr.Name)
+		}
+		if src < 0 || dst < 0 {
+			return 0, moerr.NewInternalError(ctx, "column %s converted by alter table not found", cc.task.Column)
+		}
+		notNull := false
+		if attr := findAttr(defs, cc.task.Column); attr.Default != nil {
+			notNull = !attr.Default.NullAbility
+		}
+
+		n, err := cc.convertRelationRows(proc, rel, append(attrs, catalog.Row_ID), src, dst, typ, notNull, convertColumnTxnRows-rows)
+		if err != nil {
+			return 0, err
+		}
+		if rows += n; rows >= convertColumnTxnRows {
+			break
+		}
+	}
+	return rows, nil
+}
+
+func (cc *columnConverter) convertRelationRows(proc *process.Process, rel engine.Relation, attrs []string,
+	src, dst int, typ types.Type, notNull bool, limit int) (int, error) {
+	ctx := proc.Ctx
+	rds, err := newTableReaders(ctx, rel)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		for _, rd := range rds {
+			rd.Close()
+		}
+	}()
+	rows := 0
+	for len(rds) > 0 && rows < limit {
+		bat, err := rds[0].Read(ctx, attrs, nil, cc.mp, nil)
+		if err != nil {
+			return 0, err
+		}
+		if bat == nil {
+			rds[0].Close()
+			rds = rds[1:]
+			continue
+		}
+		bat.SetAttributes(attrs)
+		n, err := cc.convertBatch(proc, rel, bat, src, dst, typ, notNull)
+		bat.Clean(cc.mp)
+		if err != nil {
+			return 0, err
+		}
+		rows += n
+	}
+	return rows, nil
+}
+
+// convertBatch converts the rows of the batch whose hidden column is null
+// and the column is not null, the last column of the batch is row_id
+func (cc *columnConverter) convertBatch(proc *process.Process, rel engine.Relation, bat *batch.Batch,
+	src, dst int, typ types.Type, notNull bool) (int, error) {
+	var sels []int64
+	for i := 0; i < bat.Length(); i++ {
+		switch {
+		case isNullValue(bat.Vecs[src], i):
+			if notNull {
+				return 0, &convertFailure{err: moerr.NewConstraintViolation(proc.Ctx, "Column '%s' cannot be null", cc.task.Column)}
+			}
+		case isNullValue(bat.Vecs[dst], i):
+			sels = append(sels, int64(i))
+		}
+	}
+	if len(sels) == 0 {
+		return 0, nil
+	}
+	bat.Shrink(sels)
+	vec, err := colexec.ConvertVector(proc, bat.Vecs[src], typ)
+	if err != nil {
+		return 0, &convertFailure{err: err}
+	}
+	bat.Vecs[dst].Free(cc.mp)
+	bat.Vecs[dst] = vec
+
+	last := len(bat.Vecs) - 1
+	delBat := batch.NewWithSize(1)
+	delBat.SetAttributes([]string{catalog.Row_ID})
+	delBat.Vecs[0] = bat.Vecs[last]
+	delBat.InitZsOne(len(sels))
+	if err = rel.Delete(proc.Ctx, delBat, catalog.Row_ID); err != nil {
+		return 0, err
+	}
+	insBat := batch.NewWithSize(last)
+	insBat.SetAttributes(bat.Attrs[:last])
+	copy(insBat.Vecs, bat.Vecs[:last])
+	insBat.InitZsOne(len(sels))
+	if err = rel.Write(proc.Ctx, insBat); err != nil {
+		return 0, err
+	}
+	return len(sels), nil
+}
+
+func isNullValue(vec *vector.Vector, i int) bool {
+	if vec.IsConst() {
+		return vec.IsConstNull()
+	}
+	return vec.GetNulls().Contains(uint64(i))
+}
+
+// replaceColumn drops the column and renames the hidden column to the name
+// of the column at its position
+func (cc *columnConverter) replaceColumn(ctx context.Context, rels []engine.Relation) error {
+	name := catalog.ConvertColName(cc.task.Column)
+	for _, rel := range rels {
+		defs, err := rel.TableDefs(ctx)
+		if err != nil {
+			return err
+		}
+		var cols []alterColumn
+		var attr, convAttr *engine.Attribute
+		for _, def := range defs {
+			if d, ok := def.(*engine.AttributeDef); ok && d.Attr.Name != catalog.Row_ID {
+				switch d.Attr.Name {
+				case cc.task.Column:
+					attr = &d.Attr
+				case name:
+					convAttr = &d.Attr
+				}
+				cols = append(cols, alterColumn{name: d.Attr.Name, seqnum: uint32(d.Attr.Seqnum)})
+			}
+		}
+		if attr == nil || convAttr == nil {
+			return moerr.NewInternalError(ctx, "column %s converted by alter table not found", cc.task.Column)
+		}
+		var idx, convIdx int
+		for i, col := range cols {
+			switch col.name {
+			case attr.Name:
+				idx = i
+			case name:
+				convIdx = i
+			}
+		}
+		// the position after the column and the hidden column are taken out
+		pos := idx
+		if convIdx < idx {
+			pos--
+		}
+
+		def := attrToColDef(convAttr)
+		def.Name = attr.Name
+		def.Hidden = false
+		nullAbility := attr.Default == nil || attr.Default.NullAbility
+		def.Typ.NotNullable = !nullAbility
+		def.Default.NullAbility = nullAbility
+		reqs := []*api.AlterTableReq{
+			api.NewRemoveColumnReq(0, 0, uint32(idx), uint32(attr.Seqnum)),
+			api.NewModifyColumnReq(0, 0, def, uint32(convAttr.Seqnum), int32(pos)),
+		}
+		if err = rel.AlterTable(ctx, reqs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropConvertColumn drops the hidden column, the column keeps its type
+func (cc *columnConverter) dropConvertColumn(ctx context.Context, rels []engine.Relation) error {
+	name := catalog.ConvertColName(cc.task.Column)
+	for _, rel := range rels {
+		defs, err := rel.TableDefs(ctx)
+		if err != nil {
+			return err
+		}
+		idx := 0
+		for _, def := range defs {
+			d, ok := def.(*engine.AttributeDef)
+			if !ok || d.Attr.Name == catalog.Row_ID {
+				continue
+			}
+			if d.Attr.Name == name {
+				req := api.NewRemoveColumnReq(0, 0, uint32(idx), uint32(d.Attr.Seqnum))
+				if err = rel.AlterTable(ctx, []*api.AlterTableReq{req}); err != nil {
+					return err
+				}
+				break
+			}
+			idx++
+		}
+	}
+	return nil
+}
+
+func attrToColDef(attr *engine.Attribute) *plan.ColDef {
+	def := &plan.ColDef{
+		Name: attr.Name,
+		Alg:  plan.CompressType_Lz4,
+		Typ: &plan.Type{
+			Id:       int32(attr.Type.Oid),
+			Width:    attr.Type.Width,
+			Scale:    attr.Type.Scale,
+			AutoIncr: attr.AutoIncrement,
+		},
+		Default:   plan2.DeepCopyDefault(attr.Default),
+		OnUpdate:  attr.OnUpdate,
+		Comment:   attr.Comment,
+		Hidden:    attr.IsHidden,
+		ClusterBy: attr.ClusterBy,
+		Seqnum:    uint32(attr.Seqnum),
+	}
+	if def.Default == nil {
+		def.Default = &plan.Default{NullAbility: true}
+	}
+	return def
+}
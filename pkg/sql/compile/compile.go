@@ -446,6 +446,12 @@ func (c *Compile) compileQuery(ctx context.Context, qry *plan.Query) ([]*Scope,
 		}
 	}
 
+	// the old rows of the partitioned tables changed by the query are read
+	// from the partition tables
+	if err = c.movePartitionedRows(qry); err != nil {
+		return nil, err
+	}
+
 	blkNum := 0
 	for _, n := range qry.Nodes {
 		if n.NodeType == plan.Node_TABLE_SCAN {
@@ -459,7 +465,9 @@ func (c *Compile) compileQuery(ctx context.Context, qry *plan.Query) ([]*Scope,
 		insertNode := qry.Nodes[qry.Steps[0]]
 		nodeStats := qry.Nodes[insertNode.Children[0]].Stats
 		if nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag || blkNum >= MinBlockNum {
-			if len(insertNode.InsertCtx.OnDuplicateIdx) > 0 {
+			// the rows of a partitioned table are routed to the partition
+			// tables by the insert operator in the current CN
+			if len(insertNode.InsertCtx.OnDuplicateIdx) > 0 || insertNode.InsertCtx.Partition != nil {
 				c.cnList = engine.Nodes{
 					engine.Node{
 						Addr: c.addr,
@@ -540,6 +548,10 @@ func IsSingleDelete(delCtx *deletion.DeleteCtx) bool {
 	if len(delCtx.DelSource) > 1 {
 		return false
 	}
+
+	if len(delCtx.PartitionSources) > 0 && delCtx.PartitionSources[0] != nil {
+		return false
+	}
 	return true
 }
 
@@ -594,7 +606,8 @@ func (c *Compile) compileApQuery(qry *plan.Query, ss []*Scope) (*Scope, error) {
 		}
 		nodeStats := qry.Nodes[insertNode.Children[0]].Stats
 
-		if nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag {
+		if (nodeStats.GetCost()*float64(SingleLineSizeEstimate) > float64(DistributedThreshold) || qry.LoadTag) &&
+			insertNode.InsertCtx.Partition == nil {
 			// use distributed-insert
 			arg.IsRemote = true
 			for _, scope := range ss {
@@ -1064,6 +1077,125 @@ func (c *Compile) compileTableFunction(n *plan.Node, ss []*Scope) []*Scope {
 	return ss
 }
 
+// scannedPartitionTableNames returns the names of the partition tables to be
+// scanned, only the partitions selected by pruning are scanned
+func scannedPartitionTableNames(n *plan.Node) []string {
+	if n.PartitionPrune != nil && n.PartitionPrune.IsPruned {
+		names := make([]string, len(n.PartitionPrune.SelectedPartitions))
+		for i, p := range n.PartitionPrune.SelectedPartitions {
+			names[i] = p.PartitionTableName
+		}
+		return names
+	}
+	return n.TableDef.Partition.PartitionTableNames
+}
+
+// movePartitionedRows moves the rows stored in the main tables of the
+// partitioned tables updated or deleted by the query to their partition
+// tables. Earlier versions stored the rows of a partitioned table in the main
+// table, the old rows are deleted from the partition tables by their row ids.
+func (c *Compile) movePartitionedRows(qry *plan.Query) error {
+	for _, n := range qry.Nodes {
+		var refs []*plan.ObjectRef
+		var partitions []*plan.DmlPartition
+		switch n.NodeType {
+		case plan.Node_DELETE:
+			refs, partitions = n.DeleteCtx.Ref, n.DeleteCtx.Partitions
+		case plan.Node_UPDATE:
+			refs, partitions = n.UpdateCtx.Ref, n.UpdateCtx.Partitions
+		case plan.Node_INSERT:
+			if len(n.InsertCtx.OnDuplicateIdx) > 0 && n.InsertCtx.Partition != nil {
+				refs = []*plan.ObjectRef{n.InsertCtx.Ref}
+				partitions = []*plan.DmlPartition{n.InsertCtx.Partition}
+			}
+		}
+		for i, partition := range partitions {
+			if len(partition.GetPartitionTableNames()) == 0 {
+				continue
+			}
+			if err := moveMainTableRows(c, refs[i], partition); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// moveMainTableRows writes the rows of the main table into the partition
+// tables by the partition expression, and deletes them from the main table
+func moveMainTableRows(c *Compile, ref *plan.ObjectRef, partition *plan.DmlPartition) error {
+	rel, _, err := getRel(c.ctx, c.proc, c.e, ref, nil)
+	if err != nil {
+		return err
+	}
+	source, err := getPartitionSource(c.ctx, c.proc, c.e, ref, partition)
+	if err != nil {
+		return err
+	}
+	defs, err := rel.TableDefs(c.ctx)
+	if err != nil {
+		return err
+	}
+	// the columns are read in the order of the batch written to the
+	// partition tables, the composite keys are the last ones
+	var attrs, keys []string
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name != catalog.Row_ID {
+			switch {
+			case attr.Attr.Name == catalog.CPrimaryKeyColName:
+				keys = append([]string{attr.Attr.Name}, keys...)
+			case util.JudgeIsCompositeClusterByColumn(attr.Attr.Name):
+				keys = append(keys, attr.Attr.Name)
+			default:
+				attrs = append(attrs, attr.Attr.Name)
+			}
+		}
+	}
+	attrs = append(attrs, keys...)
+	colCnt := len(attrs)
+	attrs = append(attrs, catalog.Row_ID)
+
+	rds, err := newTableReaders(c.ctx, rel)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, rd := range rds {
+			rd.Close()
+		}
+	}()
+	for len(rds) > 0 {
+		bat, err := rds[0].Read(c.ctx, attrs, nil, c.proc.Mp(), nil)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			rds[0].Close()
+			rds = rds[1:]
+			continue
+		}
+		if bat.Length() > 0 {
+			writeBat := batch.NewWithSize(colCnt)
+			writeBat.SetAttributes(attrs[:colCnt])
+			copy(writeBat.Vecs, bat.Vecs[:colCnt])
+			writeBat.Zs = bat.Zs
+			delBat := batch.NewWithSize(1)
+			delBat.SetAttributes([]string{catalog.Row_ID})
+			delBat.SetVector(0, bat.Vecs[colCnt])
+			delBat.Zs = bat.Zs
+			// the batches are deeply copied into the workspace of the txn
+			if err = colexec.WritePartitions(c.proc, writeBat, source.Expr, source.Rels); err == nil {
+				err = rel.Delete(c.ctx, delBat, catalog.Row_ID)
+			}
+		}
+		bat.Clean(c.proc.Mp())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *Compile) compileTableScan(n *plan.Node) ([]*Scope, error) {
 	nodes, err := c.generateNodes(n)
 	if err != nil {
@@ -1157,7 +1289,7 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node) *Scop
 	// prcoess partitioned table
 	var partitionRelNames []string
 	if n.TableDef.Partition != nil {
-		partitionRelNames = append(partitionRelNames, n.TableDef.Partition.PartitionTableNames...)
+		partitionRelNames = append(partitionRelNames, scannedPartitionTableNames(n)...)
 	}
 
 	s = &Scope{
@@ -2087,14 +2219,13 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	if err != nil {
 		return nil, err
 	}
-
 	if n.TableDef.Partition != nil {
+		// the rows are stored in the partition tables, only the partitions
+		// left by the partition pruning are read. The main table is read
+		// until the rows stored in it by earlier versions are moved to the
+		// partition tables.
 		isPartitionTable = true
-		partitionInfo := n.TableDef.Partition
-		partitionNum := int(partitionInfo.PartitionNum)
-		partitionTableNames := partitionInfo.PartitionTableNames
-		for i := 0; i < partitionNum; i++ {
-			partTableName := partitionTableNames[i]
+		for _, partTableName := range scannedPartitionTableNames(n) {
 			subrelation, err := db.Relation(ctx, partTableName)
 			if err != nil {
 				return nil, err
@@ -21,7 +21,9 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -134,3 +136,55 @@ func GetFilePath() string {
 	dir, _ := os.Getwd()
 	return dir
 }
+
+func TestBuildAlterColumnReqs(t *testing.T) {
+	attr := func(name string, seqnum uint16) engine.TableDef {
+		return &engine.AttributeDef{Attr: engine.Attribute{Name: name, Seqnum: seqnum}}
+	}
+	defs := []engine.TableDef{
+		attr("a", 0),
+		attr("b", 1),
+		attr(catalog.CPrimaryKeyColName, 2),
+		attr(catalog.Row_ID, 3),
+	}
+	intCol := &plan.ColDef{Name: "a", Typ: &plan.Type{Id: int32(types.T_int32)}}
+	qry := &plan.AlterTable{
+		TableDef: &plan.TableDef{Name: "t", Cols: []*plan.ColDef{intCol}},
+		Actions: []*plan.AlterTable_Action{
+			{Action: &plan.AlterTable_Action_AddColumn{AddColumn: &plan.AlterTableAddColumn{
+				Column: &plan.ColDef{Name: "c", Typ: &plan.Type{Id: int32(types.T_int32)}},
+			}}},
+			{Action: &plan.AlterTable_Action_ModifyColumn{ModifyColumn: &plan.AlterTableModifyColumn{
+				OldName: "a",
+				Column: &plan.ColDef{
+					Name:    "a",
+					Typ:     &plan.Type{Id: int32(types.T_date)},
+					Default: &plan.Default{NullAbility: true},
+				},
+			}}},
+		},
+	}
+	reqs, names, err := buildAlterColumnReqs(context.Background(), qry, defs)
+	require.NoError(t, err)
+	// the columns are added before the composite primary key
+	require.Equal(t, []string{"a", "b", "c", catalog.ConvertColName("a"), catalog.CPrimaryKeyColName}, names)
+	require.Equal(t, 3, len(reqs))
+	require.Equal(t, int32(2), reqs[0].GetAddColumn().InsertPosition)
+	// the column keeps its type until the values are converted
+	modify := reqs[1].GetModifyColumn()
+	require.Equal(t, uint32(0), modify.SequenceNum)
+	require.Equal(t, int32(types.T_int32), modify.Column.Typ.Id)
+	add := reqs[2].GetAddColumn()
+	require.Equal(t, int32(3), add.InsertPosition)
+	require.True(t, add.Column.Hidden)
+	require.Equal(t, int32(types.T_date), add.Column.Typ.Id)
+
+	partition := &plan.PartitionByDef{
+		PartitionExpression: &plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 1}}},
+	}
+	require.NoError(t, remapPartitionColumns(context.Background(), partition, defs, []string{"c", "a", "b", catalog.CPrimaryKeyColName}))
+	require.Equal(t, int32(2), partition.PartitionExpression.GetCol().ColPos)
+	// the column of the partition expression is dropped
+	partition.PartitionExpression.GetCol().ColPos = 1
+	require.Error(t, remapPartitionColumns(context.Background(), partition, defs, []string{"a", catalog.CPrimaryKeyColName}))
+}
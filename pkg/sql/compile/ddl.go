@@ -23,9 +23,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -198,6 +200,10 @@ func (s *Scope) AlterTable(c *Compile) error {
 	}
 
 	tblId := rel.GetTableID(c.ctx)
+	if err = checkConvertingColumns(c, dbName, tblName, tblId, tableDef); err != nil {
+		return err
+	}
+
 	removeRefChildTbls := make(map[string]uint64)
 	var addRefChildTbls []uint64
 	var newFkeys []*plan.ForeignKeyDef
@@ -335,6 +341,20 @@ func (s *Scope) AlterTable(c *Compile) error {
 		}
 	}
 
+	// add, drop, modify and rename columns, the schema of the table gets a
+	// new version in DN
+	if err = alterColumns(c, dbSource, rel, dbName, qry, oldDefs); err != nil {
+		return err
+	}
+
+	for _, action := range qry.Actions {
+		if act, ok := action.Action.(*plan.AlterTable_Action_AlterPartition); ok {
+			if err = alterTablePartition(c, dbSource, rel, dbName, tableDef, act.AlterPartition); err != nil {
+				return err
+			}
+		}
+	}
+
 	// reset origin table's constraint
 	var oldCt *engine.ConstraintDef
 	newCt := &engine.ConstraintDef{
@@ -390,6 +410,8 @@ func (s *Scope) AlterTable(c *Compile) error {
 			newCt.Cts = append(newCt.Cts, t)
 		case *engine.PrimaryKeyDef:
 			newCt.Cts = append(newCt.Cts, t)
+		case *engine.TTLDef:
+			newCt.Cts = append(newCt.Cts, t)
 		}
 	}
 	if !originHasFkDef {
@@ -436,6 +458,587 @@ func (s *Scope) AlterTable(c *Compile) error {
 	return nil
 }
 
+// alterColumns changes the columns of the table and its partition tables.
+// The values of the columns changed to not null are checked, and the columns
+// changed to incompatible types are converted in background, see
+// convertColumns.
+func alterColumns(c *Compile, dbSource engine.Database, rel engine.Relation,
+	dbName string, qry *plan.AlterTable, oldDefs []engine.TableDef) error {
+	var partNames []string
+	if qry.TableDef.Partition != nil {
+		partNames = qry.TableDef.Partition.PartitionTableNames
+	}
+	rels, err := getPartitionTables(c, dbSource, partNames)
+	if err != nil {
+		return err
+	}
+	rels = append([]engine.Relation{rel}, rels...)
+	if err = checkAlterColumnValues(c, rels, qry); err != nil {
+		return err
+	}
+
+	var names []string
+	for i, r := range rels {
+		defs := oldDefs
+		if i > 0 {
+			if defs, err = r.TableDefs(c.ctx); err != nil {
+				return err
+			}
+		}
+		reqs, cols, err := buildAlterColumnReqs(c.ctx, qry, defs)
+		if err != nil {
+			return err
+		}
+		if len(reqs) == 0 {
+			return nil
+		}
+		if err = r.AlterTable(c.ctx, reqs); err != nil {
+			return err
+		}
+		if i == 0 {
+			names = cols
+		}
+	}
+
+	if qry.TableDef.Partition != nil {
+		// the columns of the partition expression are referenced by their
+		// positions in the table
+		partition := plan2.DeepCopyTableDef(qry.TableDef).Partition
+		if err = remapPartitionColumns(c.ctx, partition, oldDefs, names); err != nil {
+			return err
+		}
+		bytes, err := partition.MarshalPartitionInfo()
+		if err != nil {
+			return err
+		}
+		req := api.NewUpdatePartitionReq(0, 0, string(bytes))
+		if err = rel.AlterTable(c.ctx, []*api.AlterTableReq{req}); err != nil {
+			return err
+		}
+	}
+	return convertColumns(c, dbName, qry, rel.GetTableID(c.ctx))
+}
+
+type alterColumn struct {
+	name   string
+	seqnum uint32
+}
+
+// buildAlterColumnReqs converts the column actions of alter table to the
+// requests of DN, and returns the names of the columns after the actions.
+// The logical index and the position of a column are resolved against the
+// columns changed by the previous actions. A column changed to a type not
+// compatible with its values keeps its type, and a hidden column of the new
+// type is added to replace it when the values are converted.
+func buildAlterColumnReqs(ctx context.Context, qry *plan.AlterTable, defs []engine.TableDef) ([]*api.AlterTableReq, []string, error) {
+	var cols []alterColumn
+	for _, def := range defs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name != catalog.Row_ID {
+			cols = append(cols, alterColumn{
+				name:   attr.Attr.Name,
+				seqnum: uint32(attr.Attr.Seqnum),
+			})
+		}
+	}
+	find := func(name string) (int, error) {
+		for i, col := range cols {
+			if col.name == name {
+				return i, nil
+			}
+		}
+		return -1, moerr.NewBadFieldError(ctx, name, qry.TableDef.Name)
+	}
+	// -1 means the position is not specified
+	position := func(pos *plan.AlterColumnPosition) (int, error) {
+		if pos == nil {
+			return -1, nil
+		}
+		switch tree.ColumnPositionType(pos.Typ) {
+		case tree.ColumnPositionFirst:
+			return 0, nil
+		case tree.ColumnPositionAfter:
+			idx, err := find(pos.RelativeColumn)
+			if err != nil {
+				return -1, err
+			}
+			return idx + 1, nil
+		}
+		return -1, nil
+	}
+	insert := func(idx int, col alterColumn) {
+		if idx < 0 || idx > len(cols) {
+			idx = len(cols)
+		}
+		cols = append(cols[:idx], append([]alterColumn{col}, cols[idx:]...)...)
+	}
+	// the columns are added before the hidden composite keys, which are the
+	// last columns of the rows written by CN
+	lastPosition := func() int {
+		idx := len(cols)
+		for idx > 0 && isCompositeKeyCol(cols[idx-1].name) {
+			idx--
+		}
+		return idx
+	}
+
+	var reqs []*api.AlterTableReq
+	for _, action := range qry.Actions {
+		switch act := action.Action.(type) {
+		case *plan.AlterTable_Action_Drop:
+			if act.Drop.Typ != plan.AlterTableDrop_COLUMN {
+				continue
+			}
+			idx, err := find(act.Drop.Name)
+			if err != nil {
+				return nil, nil, err
+			}
+			reqs = append(reqs, api.NewRemoveColumnReq(0, 0, uint32(idx), cols[idx].seqnum))
+			cols = append(cols[:idx], cols[idx+1:]...)
+		case *plan.AlterTable_Action_AddColumn:
+			pos, err := position(act.AddColumn.Position)
+			if err != nil {
+				return nil, nil, err
+			}
+			if pos < 0 {
+				pos = lastPosition()
+			}
+			reqs = append(reqs, api.NewAddColumnDefReq(0, 0, act.AddColumn.Column, int32(pos)))
+			// the seqnum is allocated by DN, the plan makes sure the new
+			// column is not changed again in the same statement
+			insert(pos, alterColumn{name: act.AddColumn.Column.Name})
+		case *plan.AlterTable_Action_ModifyColumn:
+			idx, err := find(act.ModifyColumn.OldName)
+			if err != nil {
+				return nil, nil, err
+			}
+			col := cols[idx]
+			cols = append(cols[:idx], cols[idx+1:]...)
+			pos, err := position(act.ModifyColumn.Position)
+			if err != nil {
+				return nil, nil, err
+			}
+			def := act.ModifyColumn.Column
+			oldDef := findColDef(qry.TableDef, act.ModifyColumn.OldName)
+			converted := oldDef != nil && !plan2.IsCompatibleColumnType(oldDef.Typ, def.Typ)
+			if converted {
+				def = keepColumnType(def, oldDef)
+			}
+			reqs = append(reqs, api.NewModifyColumnReq(0, 0, def, col.seqnum, int32(pos)))
+			if pos < 0 {
+				pos = idx
+			}
+			col.name = def.Name
+			insert(pos, col)
+			if converted {
+				conv := convertColumnDef(act.ModifyColumn.Column)
+				pos = lastPosition()
+				reqs = append(reqs, api.NewAddColumnDefReq(0, 0, conv, int32(pos)))
+				insert(pos, alterColumn{name: conv.Name})
+			}
+		case *plan.AlterTable_Action_RenameColumn:
+			idx, err := find(act.RenameColumn.OldName)
+			if err != nil {
+				return nil, nil, err
+			}
+			reqs = append(reqs, api.NewRenameColumnReq(0, 0,
+				act.RenameColumn.OldName, act.RenameColumn.NewName, cols[idx].seqnum))
+			cols[idx].name = act.RenameColumn.NewName
+		}
+	}
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = col.name
+	}
+	return reqs, names, nil
+}
+
+func isCompositeKeyCol(name string) bool {
+	return name == catalog.CPrimaryKeyColName || util.JudgeIsCompositeClusterByColumn(name)
+}
+
+func findColDef(tableDef *plan.TableDef, name string) *plan.ColDef {
+	for _, col := range tableDef.Cols {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// keepColumnType returns the definition of the column changed to an
+// incompatible type before its values are converted, it keeps the type, the
+// default value and the on update value of the old definition.
+func keepColumnType(def, oldDef *plan.ColDef) *plan.ColDef {
+	nullAbility := def.Default.GetNullAbility()
+	def = plan2.DeepCopyColDef(def)
+	def.Typ = plan2.DeepCopyType(oldDef.Typ)
+	def.Typ.NotNullable = !nullAbility
+	def.Default = &plan.Default{NullAbility: nullAbility}
+	if oldDef.Default != nil {
+		def.Default = plan2.DeepCopyDefault(oldDef.Default)
+		def.Default.NullAbility = nullAbility
+	}
+	def.OnUpdate = oldDef.OnUpdate
+	return def
+}
+
+// convertColumnDef returns the definition of the hidden column holding the
+// converted values of the column. It is nullable until it replaces the
+// column, the rows written before it is added read null from it.
+func convertColumnDef(def *plan.ColDef) *plan.ColDef {
+	def = plan2.DeepCopyColDef(def)
+	def.Name = catalog.ConvertColName(def.Name)
+	def.Hidden = true
+	def.Primary = false
+	def.Typ.NotNullable = false
+	if def.Default == nil {
+		def.Default = &plan.Default{}
+	}
+	def.Default.NullAbility = true
+	def.Default.FillValue = nil
+	return def
+}
+
+// remapPartitionColumns changes the positions of the columns referenced by
+// the partition expressions from the columns of the old definition to the
+// columns of the names. The composite keys are not the columns of the table
+// definition of plan.
+func remapPartitionColumns(ctx context.Context, partition *plan.PartitionByDef, oldDefs []engine.TableDef, names []string) error {
+	var oldNames []string
+	for _, def := range oldDefs {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name != catalog.Row_ID && !isCompositeKeyCol(attr.Attr.Name) {
+			oldNames = append(oldNames, attr.Attr.Name)
+		}
+	}
+	newPos := make(map[string]int32, len(names))
+	pos := int32(0)
+	for _, name := range names {
+		if !isCompositeKeyCol(name) {
+			newPos[name] = pos
+			pos++
+		}
+	}
+	var remap func(expr *plan.Expr) error
+	remap = func(expr *plan.Expr) error {
+		if expr == nil {
+			return nil
+		}
+		switch e := expr.Expr.(type) {
+		case *plan.Expr_Col:
+			if int(e.Col.ColPos) >= len(oldNames) {
+				return moerr.NewInternalError(ctx, "invalid column of the partition expression")
+			}
+			p, ok := newPos[oldNames[e.Col.ColPos]]
+			if !ok {
+				return moerr.NewInternalError(ctx, "column %s of the partition expression not found", oldNames[e.Col.ColPos])
+			}
+			e.Col.ColPos = p
+		case *plan.Expr_F:
+			for _, arg := range e.F.Args {
+				if err := remap(arg); err != nil {
+					return err
+				}
+			}
+		case *plan.Expr_List:
+			for _, arg := range e.List.List {
+				if err := remap(arg); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := remap(partition.PartitionExpression); err != nil {
+		return err
+	}
+	if partition.PartitionExpr != nil {
+		if err := remap(partition.PartitionExpr.Expr); err != nil {
+			return err
+		}
+	}
+	if partition.PartitionColumns != nil {
+		for _, expr := range partition.PartitionColumns.Columns {
+			if err := remap(expr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// newTableReaders returns the readers of all the rows of the relation
+func newTableReaders(ctx context.Context, rel engine.Relation) ([]engine.Reader, error) {
+	ranges, err := rel.Ranges(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(ranges) == 0, len(ranges) == 1 && len(ranges[0]) == 0:
+		return rel.NewReader(ctx, 1, nil, nil)
+	case len(ranges[0]) == 0:
+		rds, err := rel.NewReader(ctx, 1, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		rds1, err := rel.NewReader(ctx, 1, nil, ranges[1:])
+		if err != nil {
+			return nil, err
+		}
+		return append(rds, rds1...), nil
+	}
+	return rel.NewReader(ctx, 1, nil, ranges)
+}
+
+// alterTablePartition adds, drops, truncates, reorganizes or exchanges the
+// partitions of the table. The rows of a partitioned table are stored in its
+// partition tables, so adding, dropping and truncating partitions only change
+// the partition tables. The rows of the reorganized and exchanged partitions
+// are moved in the txn of the statement.
+func alterTablePartition(c *Compile, dbSource engine.Database, rel engine.Relation,
+	dbName string, tableDef *plan.TableDef, alter *plan.AlterTablePartition) error {
+	var err error
+	switch alter.Typ {
+	case plan.AlterTablePartition_TRUNCATE_PARTITION:
+		for _, name := range alter.TruncateTables {
+			if _, err = dbSource.Truncate(c.ctx, name); err != nil {
+				return err
+			}
+		}
+	case plan.AlterTablePartition_REORGANIZE_PARTITION:
+		err = reorganizePartitions(c, dbSource, tableDef, alter)
+	case plan.AlterTablePartition_EXCHANGE_PARTITION:
+		err = exchangePartition(c, dbSource, rel, dbName, tableDef, alter)
+	default:
+		err = changePartitionTables(c, dbSource, alter)
+	}
+	if err != nil {
+		return err
+	}
+
+	if alter.NewPartition != nil {
+		bytes, err := alter.NewPartition.MarshalPartitionInfo()
+		if err != nil {
+			return err
+		}
+		req := api.NewUpdatePartitionReq(0, 0, string(bytes))
+		if err = rel.AlterTable(c.ctx, []*api.AlterTableReq{req}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changePartitionTables drops the partition tables of the removed partitions
+// and creates the ones of the new partitions
+func changePartitionTables(c *Compile, dbSource engine.Database, alter *plan.AlterTablePartition) error {
+	for _, name := range alter.DropTables {
+		if err := dbSource.Delete(c.ctx, name); err != nil {
+			return err
+		}
+	}
+	for _, table := range alter.CreateTables {
+		storageCols := planColsToExeCols(table.GetCols())
+		storageDefs, err := planDefsToExeDefs(table)
+		if err != nil {
+			return err
+		}
+		if err = dbSource.Create(c.ctx, table.GetName(), append(storageCols, storageDefs...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reorganizePartitions replaces the partition tables of the reorganized
+// partitions by the ones of the new partitions, the rows of the reorganized
+// partitions are routed to the new partitions by the new partition expression.
+func reorganizePartitions(c *Compile, dbSource engine.Database, tableDef *plan.TableDef, alter *plan.AlterTablePartition) error {
+	cols := partitionTableCols(tableDef)
+	var bats []*batch.Batch
+	defer func() {
+		for _, bat := range bats {
+			bat.Clean(c.proc.Mp())
+		}
+	}()
+	// the rows are read before the partition tables are dropped
+	for _, name := range alter.DropTables {
+		partRel, err := dbSource.Relation(c.ctx, name)
+		if err != nil {
+			return err
+		}
+		bat, err := readTableRows(c, partRel, cols)
+		if err != nil {
+			return err
+		}
+		bats = append(bats, bat)
+	}
+
+	if err := changePartitionTables(c, dbSource, alter); err != nil {
+		return err
+	}
+	rels, err := getPartitionTables(c, dbSource, alter.NewPartition.PartitionTableNames)
+	if err != nil {
+		return err
+	}
+	for _, bat := range bats {
+		if bat.Length() == 0 {
+			continue
+		}
+		if err = colexec.WritePartitions(c.proc, bat, alter.PartitionExpression, rels); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exchangePartition swaps the rows of the partition table with the rows of
+// the exchanged table. The hidden fake primary key is not the same in the two
+// tables, so it is generated again, and the auto increment columns of the
+// tables are moved past the values of the exchanged rows.
+func exchangePartition(c *Compile, dbSource engine.Database, rel engine.Relation,
+	dbName string, tableDef *plan.TableDef, alter *plan.AlterTablePartition) error {
+	partName := tableDef.Partition.Partitions[alter.ExchangePartitionIdx].PartitionTableName
+	exDbSource, err := c.e.Database(c.ctx, alter.ExchangeDatabase, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	partRel, err := dbSource.Relation(c.ctx, partName)
+	if err != nil {
+		return err
+	}
+	exRel, err := exDbSource.Relation(c.ctx, alter.ExchangeTable)
+	if err != nil {
+		return err
+	}
+
+	// the rows are read before the tables are truncated
+	cols := partitionTableCols(tableDef)
+	partBat, err := readTableRows(c, partRel, cols)
+	if err != nil {
+		return err
+	}
+	defer partBat.Clean(c.proc.Mp())
+	exBat, err := readTableRows(c, exRel, cols)
+	if err != nil {
+		return err
+	}
+	defer exBat.Clean(c.proc.Mp())
+
+	if !alter.WithoutValidation && exBat.Length() > 0 {
+		indexes, err := colexec.EvalPartitionIndexes(c.proc, exBat, alter.PartitionExpression)
+		if err != nil {
+			return err
+		}
+		for _, idx := range indexes {
+			if idx != alter.ExchangePartitionIdx {
+				return moerr.NewInvalidInput(c.ctx, "found a row that does not match the partition")
+			}
+		}
+	}
+
+	tblId, exTblId := rel.GetTableID(c.ctx), exRel.GetTableID(c.ctx)
+	if _, err = dbSource.Truncate(c.ctx, partName); err != nil {
+		return err
+	}
+	if _, err = exDbSource.Truncate(c.ctx, alter.ExchangeTable); err != nil {
+		return err
+	}
+	if partRel, err = dbSource.Relation(c.ctx, partName); err != nil {
+		return err
+	}
+	if exRel, err = exDbSource.Relation(c.ctx, alter.ExchangeTable); err != nil {
+		return err
+	}
+
+	// the auto increment columns of the partitioned table belong to the main table
+	if err = writeExchangedRows(c, partRel, exBat, cols, tblId, dbName, tableDef.Name); err != nil {
+		return err
+	}
+	return writeExchangedRows(c, exRel, partBat, partitionTableCols(alter.ExchangeTableDef),
+		exTblId, alter.ExchangeDatabase, alter.ExchangeTable)
+}
+
+func writeExchangedRows(c *Compile, rel engine.Relation, bat *batch.Batch,
+	cols []*plan.ColDef, tableID uint64, dbName, tblName string) error {
+	if bat.Length() == 0 {
+		return nil
+	}
+	for i, col := range cols {
+		if col.Name == catalog.FakePrimaryKeyColName {
+			nulls.AddRange(bat.Vecs[i].GetNulls(), 0, uint64(bat.Length()))
+		}
+	}
+	if err := colexec.UpdateInsertBatch(c.proc, cols, bat, tableID, dbName, tblName); err != nil {
+		return err
+	}
+	return rel.Write(c.ctx, bat)
+}
+
+// partitionTableCols returns the columns of the partition tables, they are
+// the columns of the partitioned table except row_id
+func partitionTableCols(tableDef *plan.TableDef) []*plan.ColDef {
+	cols := make([]*plan.ColDef, 0, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		if col.Name != catalog.Row_ID {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+func getPartitionTables(c *Compile, dbSource engine.Database, names []string) ([]engine.Relation, error) {
+	rels := make([]engine.Relation, len(names))
+	for i, name := range names {
+		rel, err := dbSource.Relation(c.ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		rels[i] = rel
+	}
+	return rels, nil
+}
+
+// readTableRows reads all the rows of the columns of the relation into a batch
+func readTableRows(c *Compile, rel engine.Relation, cols []*plan.ColDef) (*batch.Batch, error) {
+	attrs := make([]string, len(cols))
+	res := batch.NewWithSize(len(cols))
+	for i, col := range cols {
+		attrs[i] = col.Name
+		res.Vecs[i] = vector.NewVec(types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale))
+	}
+	res.SetAttributes(attrs)
+
+	rds, err := newTableReaders(c.ctx, rel)
+	if err != nil {
+		res.Clean(c.proc.Mp())
+		return nil, err
+	}
+	defer func() {
+		for _, rd := range rds {
+			rd.Close()
+		}
+	}()
+	for len(rds) > 0 {
+		bat, err := rds[0].Read(c.ctx, attrs, nil, c.proc.Mp(), nil)
+		if err != nil {
+			res.Clean(c.proc.Mp())
+			return nil, err
+		}
+		if bat == nil {
+			rds[0].Close()
+			rds = rds[1:]
+			continue
+		}
+		_, err = res.Append(c.ctx, c.proc.Mp(), bat)
+		bat.Clean(c.proc.Mp())
+		if err != nil {
+			res.Clean(c.proc.Mp())
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (s *Scope) CreateTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
@@ -1018,6 +1621,13 @@ func (s *Scope) TruncateTable(c *Compile) error {
 		return err
 	}
 
+	// truncate the partition tables, they hold the rows of a partitioned table
+	for _, name := range tqry.PartitionTableNames {
+		if _, err = dbSource.Truncate(c.ctx, name); err != nil {
+			return err
+		}
+	}
+
 	// Truncate Index Tables if needed
 	for _, name := range tqry.IndexTableNames {
 		var err error
@@ -1293,6 +1903,12 @@ func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
 		})
 	}
 
+	if tableDef.Ttl != nil {
+		c.Cts = append(c.Cts, &engine.TTLDef{
+			Ttl: tableDef.Ttl,
+		})
+	}
+
 	if len(c.Cts) > 0 {
 		exeDefs = append(exeDefs, c)
 	}
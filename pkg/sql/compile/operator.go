@@ -484,6 +484,16 @@ func constructDeletion(n *plan.Node, eg engine.Engine, proc *process.Process) (*
 			}
 			delCtx.DelSource[i] = rel
 		}
+		if len(oldCtx.Partitions) > 0 {
+			delCtx.PartitionSources = make([]*colexec.PartitionSource, len(oldCtx.Ref))
+			for i, ref := range oldCtx.Ref {
+				partition, err := getPartitionSource(proc.Ctx, proc, eg, ref, oldCtx.Partitions[i])
+				if err != nil {
+					return nil, err
+				}
+				delCtx.PartitionSources[i] = partition
+			}
+		}
 		for i, ref := range oldCtx.IdxRef {
 			rel, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
 			if err != nil {
@@ -526,6 +536,10 @@ func constructOnduplicateKey(n *plan.Node, eg engine.Engine, proc *process.Proce
 	if err != nil {
 		return nil, err
 	}
+	partition, err := getPartitionSource(ctx, proc, eg, oldCtx.Ref, oldCtx.Partition)
+	if err != nil {
+		return nil, err
+	}
 
 	return &onduplicatekey.Argument{
 		Engine:   eg,
@@ -538,6 +552,8 @@ func constructOnduplicateKey(n *plan.Node, eg engine.Engine, proc *process.Proce
 		UniqueSource:    indexRels,
 
 		IdxIdx: oldCtx.IdxIdx,
+
+		Partition: partition,
 	}, nil
 }
 
@@ -582,6 +598,9 @@ func constructInsert(n *plan.Node, eg engine.Engine, proc *process.Process) (*in
 	}
 	newCtx.Rels = append(newCtx.Rels, originRel)
 	newCtx.Rels = append(newCtx.Rels, indexRels...)
+	if newCtx.Partition, err = getPartitionSource(ctx, proc, eg, oldCtx.Ref, oldCtx.Partition); err != nil {
+		return nil, err
+	}
 
 	return &insert.Argument{
 		InsertCtx: newCtx,
@@ -649,6 +668,16 @@ func constructUpdate(n *plan.Node, eg engine.Engine, proc *process.Process) (*up
 		updateCtx.Source[i] = rel
 		updateCtx.UniqueSource[i] = uniqueRels
 	}
+	if len(oldCtx.Partitions) > 0 {
+		updateCtx.PartitionSources = make([]*colexec.PartitionSource, len(oldCtx.Ref))
+		for i, ref := range oldCtx.Ref {
+			partition, err := getPartitionSource(proc.Ctx, proc, eg, ref, oldCtx.Partitions[i])
+			if err != nil {
+				return nil, err
+			}
+			updateCtx.PartitionSources[i] = partition
+		}
+	}
 	for i, ref := range oldCtx.IdxRef {
 		rel, _, err := getRel(proc.Ctx, proc, eg, ref, nil)
 		if err != nil {
@@ -1495,6 +1524,37 @@ func exprRelPos(expr *plan.Expr) int32 {
 	return -1
 }
 
+// getPartitionSource returns the partition tables of the partitioned table
+// changed by the DML, nil if the table is not partitioned
+func getPartitionSource(ctx context.Context, proc *process.Process, eg engine.Engine,
+	ref *plan.ObjectRef, partition *plan.DmlPartition) (*colexec.PartitionSource, error) {
+	if len(partition.GetPartitionTableNames()) == 0 {
+		return nil, nil
+	}
+	dbName := ref.SchemaName
+	if dbName == "" {
+		var err error
+		if dbName, _, _, err = eg.GetRelationById(ctx, proc.TxnOperator, uint64(ref.Obj)); err != nil {
+			return nil, err
+		}
+	}
+	dbSource, err := eg.Database(ctx, dbName, proc.TxnOperator)
+	if err != nil {
+		return nil, err
+	}
+	rels := make([]engine.Relation, len(partition.PartitionTableNames))
+	for i, name := range partition.PartitionTableNames {
+		if rels[i], err = dbSource.Relation(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+	return &colexec.PartitionSource{
+		Rels: rels,
+		Idx:  partition.Idx,
+		Expr: partition.PartitionExpression,
+	}, nil
+}
+
 // Get the 'engine.Relation' of the table by using 'ObjectRef' and 'TableDef', if 'TableDef' is nil, the relations of its index table will not be obtained
 // the first return value is Relation of the original table
 // the second return value is Relations of index tables
@@ -32,6 +32,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/anti"
@@ -149,6 +150,13 @@ func cnMessageHandle(receiver *messageReceiverOnServer) error {
 
 	case pipeline.PipelineMessage:
 		c := receiver.newCompile()
+		// io issued without the pipeline's context, like prefetching,
+		// finds the counter set of the pipeline by this id
+		ioID := uuid.NewString()
+		perfcounter.RegisterStatement(ioID, &c.s3CounterSet)
+		defer perfcounter.UnregisterStatement(ioID)
+		c.proc.Ctx = perfcounter.WithStatementID(c.proc.Ctx, ioID)
+		c.ctx = c.proc.Ctx
 
 		// decode and rewrite the scope.
 		// insert operator needs to fill the engine info.
@@ -172,6 +180,7 @@ func cnMessageHandle(receiver *messageReceiverOnServer) error {
 			c.proc.AnalInfos[c.anal.curr].S3IOOutputCount += c.s3CounterSet.FileService.S3.DeleteMulti.Load()
 		}()
 		receiver.finalAnalysisInfo = c.proc.AnalInfos
+		receiver.finalIOCounterSet = &c.s3CounterSet
 		return nil
 	default:
 		return moerr.NewInternalError(receiver.ctx, "unknown message type")
@@ -212,6 +221,13 @@ func receiveMessageFromCnServer(c *Compile, sender *messageSenderOnClient, nextA
 				}
 				mergeAnalyseInfo(c.anal, ana)
 			}
+			// the io of the remote pipeline is counted in the statement only,
+			// the s3 requests of the compile are already merged from the analysis info
+			if ioData := m.GetData(); len(ioData) > 0 {
+				if set := perfcounter.StatementCounterSet(perfcounter.StatementIDFromContext(c.proc.Ctx)); set != nil {
+					perfcounter.AddEncodedFileServiceCounters(set, ioData)
+				}
+			}
 			return nil
 		}
 		// XXX some order check just for safety ?
@@ -186,6 +186,8 @@ type messageReceiverOnServer struct {
 
 	// result.
 	finalAnalysisInfo []*process.AnalyzeInfo
+	// finalIOCounterSet is the io of the pipeline, reported to the client with the end message
+	finalIOCounterSet *perfcounter.CounterSet
 }
 
 func newMessageReceiverOnServer(
@@ -386,6 +388,9 @@ func (receiver *messageReceiverOnServer) sendEndMessage() error {
 		}
 		message.SetAnalysis(data)
 	}
+	if receiver.finalIOCounterSet != nil {
+		message.SetData(perfcounter.EncodeFileServiceCounters(receiver.finalIOCounterSet))
+	}
 	return receiver.clientSession.Write(receiver.ctx, message)
 }
 
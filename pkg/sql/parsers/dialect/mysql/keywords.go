@@ -22,6 +22,7 @@ func init() {
 		"account":                  ACCOUNT,
 		"accounts":                 ACCOUNTS,
 		"add":                      ADD,
+		"after":                    AFTER,
 		"action":                   ACTION,
 		"against":                  AGAINST,
 		"all":                      ALL,
@@ -59,7 +60,7 @@ func init() {
 		"cascade":                  CASCADE,
 		"case":                     CASE,
 		"cast":                     CAST,
-		"change":                   UNUSED,
+		"change":                   CHANGE,
 		"char":                     CHAR,
 		"character":                CHARACTER,
 		"charset":                  CHARSET,
@@ -85,6 +86,7 @@ func init() {
 		"connect":                  CONNECT,
 		"convert":                  CONVERT,
 		"config":                   CONFIG,
+		"conflict":                 CONFLICT,
 		"cipher":                   CIPHER,
 		"chain":                    CHAIN,
 		"client":                   CLIENT,
@@ -150,6 +152,7 @@ func init() {
 		"extended":                 EXTENDED,
 		"expire":                   EXPIRE,
 		"except":                   EXCEPT,
+		"exchange":                 EXCHANGE,
 		"execute":                  EXECUTE,
 		"errors":                   ERRORS,
 		"event":                    EVENT,
@@ -259,6 +262,7 @@ func init() {
 		"mod":                      MOD,
 		"month":                    MONTH,
 		"mode":                     MODE,
+		"modify":                   MODIFY,
 		"memory":                   MEMORY,
 		"modifies":                 UNUSED,
 		"multilinestring":          MULTILINESTRING,
@@ -418,6 +422,7 @@ func init() {
 		"triggers":                 TRIGGERS,
 		"true":                     TRUE,
 		"truncate":                 TRUNCATE,
+		"ttl":                      TTL,
 		"uncommitted":              UNCOMMITTED,
 		"undo":                     UNUSED,
 		"unknown":                  UNKNOWN,
@@ -433,6 +438,7 @@ func init() {
 		"utc_date":                 UTC_DATE,
 		"utc_time":                 UTC_TIME,
 		"utc_timestamp":            UTC_TIMESTAMP,
+		"validation":               VALIDATION,
 		"values":                   VALUES,
 		"variables":                VARIABLES,
 		"varbinary":                VARBINARY,
@@ -447,6 +453,7 @@ func init() {
 		"where":                    WHERE,
 		"while":                    WHILE,
 		"with":                     WITH,
+		"without":                  WITHOUT,
 		"write":                    WRITE,
 		"warnings":                 WARNINGS,
 		"work":                     WORK,
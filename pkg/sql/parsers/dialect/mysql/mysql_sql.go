@@ -62,499 +62,507 @@ const KEYS = 57384
 const NULLS = 57385
 const FIRST = 57386
 const LAST = 57387
-const VALUES = 57388
-const NEXT = 57389
-const VALUE = 57390
-const SHARE = 57391
-const MODE = 57392
-const SQL_NO_CACHE = 57393
-const SQL_CACHE = 57394
-const JOIN = 57395
-const STRAIGHT_JOIN = 57396
-const LEFT = 57397
-const RIGHT = 57398
-const INNER = 57399
-const OUTER = 57400
-const CROSS = 57401
-const NATURAL = 57402
-const USE = 57403
-const FORCE = 57404
-const LOWER_THAN_ON = 57405
-const ON = 57406
-const USING = 57407
-const SUBQUERY_AS_EXPR = 57408
-const LOWER_THAN_STRING = 57409
-const ID = 57410
-const AT_ID = 57411
-const AT_AT_ID = 57412
-const STRING = 57413
-const VALUE_ARG = 57414
-const LIST_ARG = 57415
-const COMMENT = 57416
-const COMMENT_KEYWORD = 57417
-const QUOTE_ID = 57418
-const INTEGRAL = 57419
-const HEX = 57420
-const BIT_LITERAL = 57421
-const FLOAT = 57422
-const HEXNUM = 57423
-const NULL = 57424
-const TRUE = 57425
-const FALSE = 57426
-const LOWER_THAN_CHARSET = 57427
-const CHARSET = 57428
-const UNIQUE = 57429
-const KEY = 57430
-const OR = 57431
-const PIPE_CONCAT = 57432
-const XOR = 57433
-const AND = 57434
-const NOT = 57435
-const BETWEEN = 57436
-const CASE = 57437
-const WHEN = 57438
-const THEN = 57439
-const ELSE = 57440
-const END = 57441
-const ELSEIF = 57442
-const LOWER_THAN_EQ = 57443
-const LE = 57444
-const GE = 57445
-const NE = 57446
-const NULL_SAFE_EQUAL = 57447
-const IS = 57448
-const LIKE = 57449
-const REGEXP = 57450
-const IN = 57451
-const ASSIGNMENT = 57452
-const ILIKE = 57453
-const SHIFT_LEFT = 57454
-const SHIFT_RIGHT = 57455
-const DIV = 57456
-const MOD = 57457
-const UNARY = 57458
-const COLLATE = 57459
-const BINARY = 57460
-const UNDERSCORE_BINARY = 57461
-const INTERVAL = 57462
-const OUT = 57463
-const INOUT = 57464
-const BEGIN = 57465
-const START = 57466
-const TRANSACTION = 57467
-const COMMIT = 57468
-const ROLLBACK = 57469
-const WORK = 57470
-const CONSISTENT = 57471
-const SNAPSHOT = 57472
-const CHAIN = 57473
-const NO = 57474
-const RELEASE = 57475
-const PRIORITY = 57476
-const QUICK = 57477
-const BIT = 57478
-const TINYINT = 57479
-const SMALLINT = 57480
-const MEDIUMINT = 57481
-const INT = 57482
-const INTEGER = 57483
-const BIGINT = 57484
-const INTNUM = 57485
-const REAL = 57486
-const DOUBLE = 57487
-const FLOAT_TYPE = 57488
-const DECIMAL = 57489
-const NUMERIC = 57490
-const DECIMAL_VALUE = 57491
-const TIME = 57492
-const TIMESTAMP = 57493
-const DATETIME = 57494
-const YEAR = 57495
-const CHAR = 57496
-const VARCHAR = 57497
-const BOOL = 57498
-const CHARACTER = 57499
-const VARBINARY = 57500
-const NCHAR = 57501
-const TEXT = 57502
-const TINYTEXT = 57503
-const MEDIUMTEXT = 57504
-const LONGTEXT = 57505
-const BLOB = 57506
-const TINYBLOB = 57507
-const MEDIUMBLOB = 57508
-const LONGBLOB = 57509
-const JSON = 57510
-const ENUM = 57511
-const UUID = 57512
-const GEOMETRY = 57513
-const POINT = 57514
-const LINESTRING = 57515
-const POLYGON = 57516
-const GEOMETRYCOLLECTION = 57517
-const MULTIPOINT = 57518
-const MULTILINESTRING = 57519
-const MULTIPOLYGON = 57520
-const INT1 = 57521
-const INT2 = 57522
-const INT3 = 57523
-const INT4 = 57524
-const INT8 = 57525
-const S3OPTION = 57526
-const SQL_SMALL_RESULT = 57527
-const SQL_BIG_RESULT = 57528
-const SQL_BUFFER_RESULT = 57529
-const LOW_PRIORITY = 57530
-const HIGH_PRIORITY = 57531
-const DELAYED = 57532
-const CREATE = 57533
-const ALTER = 57534
-const DROP = 57535
-const RENAME = 57536
-const ANALYZE = 57537
-const ADD = 57538
-const RETURNS = 57539
-const SCHEMA = 57540
-const TABLE = 57541
-const SEQUENCE = 57542
-const INDEX = 57543
-const VIEW = 57544
-const TO = 57545
-const IGNORE = 57546
-const IF = 57547
-const PRIMARY = 57548
-const COLUMN = 57549
-const CONSTRAINT = 57550
-const SPATIAL = 57551
-const FULLTEXT = 57552
-const FOREIGN = 57553
-const KEY_BLOCK_SIZE = 57554
-const SHOW = 57555
-const DESCRIBE = 57556
-const EXPLAIN = 57557
-const DATE = 57558
-const ESCAPE = 57559
-const REPAIR = 57560
-const OPTIMIZE = 57561
-const TRUNCATE = 57562
-const MAXVALUE = 57563
-const PARTITION = 57564
-const REORGANIZE = 57565
-const LESS = 57566
-const THAN = 57567
-const PROCEDURE = 57568
-const TRIGGER = 57569
-const STATUS = 57570
-const VARIABLES = 57571
-const ROLE = 57572
-const PROXY = 57573
-const AVG_ROW_LENGTH = 57574
-const STORAGE = 57575
-const DISK = 57576
-const MEMORY = 57577
-const CHECKSUM = 57578
-const COMPRESSION = 57579
-const DATA = 57580
-const DIRECTORY = 57581
-const DELAY_KEY_WRITE = 57582
-const ENCRYPTION = 57583
-const ENGINE = 57584
-const MAX_ROWS = 57585
-const MIN_ROWS = 57586
-const PACK_KEYS = 57587
-const ROW_FORMAT = 57588
-const STATS_AUTO_RECALC = 57589
-const STATS_PERSISTENT = 57590
-const STATS_SAMPLE_PAGES = 57591
-const DYNAMIC = 57592
-const COMPRESSED = 57593
-const REDUNDANT = 57594
-const COMPACT = 57595
-const FIXED = 57596
-const COLUMN_FORMAT = 57597
-const AUTO_RANDOM = 57598
-const RESTRICT = 57599
-const CASCADE = 57600
-const ACTION = 57601
-const PARTIAL = 57602
-const SIMPLE = 57603
-const CHECK = 57604
-const ENFORCED = 57605
-const RANGE = 57606
-const LIST = 57607
-const ALGORITHM = 57608
-const LINEAR = 57609
-const PARTITIONS = 57610
-const SUBPARTITION = 57611
-const SUBPARTITIONS = 57612
-const CLUSTER = 57613
-const TYPE = 57614
-const ANY = 57615
-const SOME = 57616
-const EXTERNAL = 57617
-const LOCALFILE = 57618
-const URL = 57619
-const PREPARE = 57620
-const DEALLOCATE = 57621
-const RESET = 57622
-const EXTENSION = 57623
-const INCREMENT = 57624
-const CYCLE = 57625
-const MINVALUE = 57626
-const PUBLICATION = 57627
-const SUBSCRIPTIONS = 57628
-const PUBLICATIONS = 57629
-const PROPERTIES = 57630
-const PARSER = 57631
-const VISIBLE = 57632
-const INVISIBLE = 57633
-const BTREE = 57634
-const HASH = 57635
-const RTREE = 57636
-const BSI = 57637
-const ZONEMAP = 57638
-const LEADING = 57639
-const BOTH = 57640
-const TRAILING = 57641
-const UNKNOWN = 57642
-const EXPIRE = 57643
-const ACCOUNT = 57644
-const ACCOUNTS = 57645
-const UNLOCK = 57646
-const DAY = 57647
-const NEVER = 57648
-const PUMP = 57649
-const MYSQL_COMPATIBILITY_MODE = 57650
-const SECOND = 57651
-const ASCII = 57652
-const COALESCE = 57653
-const COLLATION = 57654
-const HOUR = 57655
-const MICROSECOND = 57656
-const MINUTE = 57657
-const MONTH = 57658
-const QUARTER = 57659
-const REPEAT = 57660
-const REVERSE = 57661
-const ROW_COUNT = 57662
-const WEEK = 57663
-const REVOKE = 57664
-const FUNCTION = 57665
-const PRIVILEGES = 57666
-const TABLESPACE = 57667
-const EXECUTE = 57668
-const SUPER = 57669
-const GRANT = 57670
-const OPTION = 57671
-const REFERENCES = 57672
-const REPLICATION = 57673
-const SLAVE = 57674
-const CLIENT = 57675
-const USAGE = 57676
-const RELOAD = 57677
-const FILE = 57678
-const TEMPORARY = 57679
-const ROUTINE = 57680
-const EVENT = 57681
-const SHUTDOWN = 57682
-const NULLX = 57683
-const AUTO_INCREMENT = 57684
-const APPROXNUM = 57685
-const SIGNED = 57686
-const UNSIGNED = 57687
-const ZEROFILL = 57688
-const ENGINES = 57689
-const LOW_CARDINALITY = 57690
-const ADMIN_NAME = 57691
-const RANDOM = 57692
-const SUSPEND = 57693
-const ATTRIBUTE = 57694
-const HISTORY = 57695
-const REUSE = 57696
-const CURRENT = 57697
-const OPTIONAL = 57698
-const FAILED_LOGIN_ATTEMPTS = 57699
-const PASSWORD_LOCK_TIME = 57700
-const UNBOUNDED = 57701
-const SECONDARY = 57702
-const USER = 57703
-const IDENTIFIED = 57704
-const CIPHER = 57705
-const ISSUER = 57706
-const X509 = 57707
-const SUBJECT = 57708
-const SAN = 57709
-const REQUIRE = 57710
-const SSL = 57711
-const NONE = 57712
-const PASSWORD = 57713
-const MAX_QUERIES_PER_HOUR = 57714
-const MAX_UPDATES_PER_HOUR = 57715
-const MAX_CONNECTIONS_PER_HOUR = 57716
-const MAX_USER_CONNECTIONS = 57717
-const FORMAT = 57718
-const VERBOSE = 57719
-const CONNECTION = 57720
-const TRIGGERS = 57721
-const PROFILES = 57722
-const LOAD = 57723
-const INFILE = 57724
-const TERMINATED = 57725
-const OPTIONALLY = 57726
-const ENCLOSED = 57727
-const ESCAPED = 57728
-const STARTING = 57729
-const LINES = 57730
-const ROWS = 57731
-const IMPORT = 57732
-const MODUMP = 57733
-const OVER = 57734
-const PRECEDING = 57735
-const FOLLOWING = 57736
-const GROUPS = 57737
-const DATABASES = 57738
-const TABLES = 57739
-const SEQUENCES = 57740
-const EXTENDED = 57741
-const FULL = 57742
-const PROCESSLIST = 57743
-const FIELDS = 57744
-const COLUMNS = 57745
-const OPEN = 57746
-const ERRORS = 57747
-const WARNINGS = 57748
-const INDEXES = 57749
-const SCHEMAS = 57750
-const NODE = 57751
-const LOCKS = 57752
-const ROLES = 57753
-const TABLE_NUMBER = 57754
-const COLUMN_NUMBER = 57755
-const TABLE_VALUES = 57756
-const TABLE_SIZE = 57757
-const NAMES = 57758
-const GLOBAL = 57759
-const SESSION = 57760
-const ISOLATION = 57761
-const LEVEL = 57762
-const READ = 57763
-const WRITE = 57764
-const ONLY = 57765
-const REPEATABLE = 57766
-const COMMITTED = 57767
-const UNCOMMITTED = 57768
-const SERIALIZABLE = 57769
-const LOCAL = 57770
-const EVENTS = 57771
-const PLUGINS = 57772
-const CURRENT_TIMESTAMP = 57773
-const DATABASE = 57774
-const CURRENT_TIME = 57775
-const LOCALTIME = 57776
-const LOCALTIMESTAMP = 57777
-const UTC_DATE = 57778
-const UTC_TIME = 57779
-const UTC_TIMESTAMP = 57780
-const REPLACE = 57781
-const CONVERT = 57782
-const SEPARATOR = 57783
-const TIMESTAMPDIFF = 57784
-const CURRENT_DATE = 57785
-const CURRENT_USER = 57786
-const CURRENT_ROLE = 57787
-const SECOND_MICROSECOND = 57788
-const MINUTE_MICROSECOND = 57789
-const MINUTE_SECOND = 57790
-const HOUR_MICROSECOND = 57791
-const HOUR_SECOND = 57792
-const HOUR_MINUTE = 57793
-const DAY_MICROSECOND = 57794
-const DAY_SECOND = 57795
-const DAY_MINUTE = 57796
-const DAY_HOUR = 57797
-const YEAR_MONTH = 57798
-const SQL_TSI_HOUR = 57799
-const SQL_TSI_DAY = 57800
-const SQL_TSI_WEEK = 57801
-const SQL_TSI_MONTH = 57802
-const SQL_TSI_QUARTER = 57803
-const SQL_TSI_YEAR = 57804
-const SQL_TSI_SECOND = 57805
-const SQL_TSI_MINUTE = 57806
-const RECURSIVE = 57807
-const CONFIG = 57808
-const DRAINER = 57809
-const MATCH = 57810
-const AGAINST = 57811
-const BOOLEAN = 57812
-const LANGUAGE = 57813
-const WITH = 57814
-const QUERY = 57815
-const EXPANSION = 57816
-const ADDDATE = 57817
-const BIT_AND = 57818
-const BIT_OR = 57819
-const BIT_XOR = 57820
-const CAST = 57821
-const COUNT = 57822
-const APPROX_COUNT_DISTINCT = 57823
-const APPROX_PERCENTILE = 57824
-const CURDATE = 57825
-const CURTIME = 57826
-const DATE_ADD = 57827
-const DATE_SUB = 57828
-const EXTRACT = 57829
-const GROUP_CONCAT = 57830
-const MAX = 57831
-const MID = 57832
-const MIN = 57833
-const NOW = 57834
-const POSITION = 57835
-const SESSION_USER = 57836
-const STD = 57837
-const STDDEV = 57838
-const MEDIAN = 57839
-const STDDEV_POP = 57840
-const STDDEV_SAMP = 57841
-const SUBDATE = 57842
-const SUBSTR = 57843
-const SUBSTRING = 57844
-const SUM = 57845
-const SYSDATE = 57846
-const SYSTEM_USER = 57847
-const TRANSLATE = 57848
-const TRIM = 57849
-const VARIANCE = 57850
-const VAR_POP = 57851
-const VAR_SAMP = 57852
-const AVG = 57853
-const RANK = 57854
-const NEXTVAL = 57855
-const SETVAL = 57856
-const CURRVAL = 57857
-const LASTVAL = 57858
-const ARROW = 57859
-const ROW = 57860
-const OUTFILE = 57861
-const HEADER = 57862
-const MAX_FILE_SIZE = 57863
-const FORCE_QUOTE = 57864
-const PARALLEL = 57865
-const UNUSED = 57866
-const BINDINGS = 57867
-const DO = 57868
-const DECLARE = 57869
-const LOOP = 57870
-const WHILE = 57871
-const LEAVE = 57872
-const ITERATE = 57873
-const UNTIL = 57874
-const CALL = 57875
-const SPBEGIN = 57876
-const BACKEND = 57877
-const SERVERS = 57878
-const KILL = 57879
-const QUERY_RESULT = 57880
+const AFTER = 57388
+const VALUES = 57389
+const NEXT = 57390
+const VALUE = 57391
+const SHARE = 57392
+const MODE = 57393
+const SQL_NO_CACHE = 57394
+const SQL_CACHE = 57395
+const JOIN = 57396
+const STRAIGHT_JOIN = 57397
+const LEFT = 57398
+const RIGHT = 57399
+const INNER = 57400
+const OUTER = 57401
+const CROSS = 57402
+const NATURAL = 57403
+const USE = 57404
+const FORCE = 57405
+const LOWER_THAN_ON = 57406
+const ON = 57407
+const USING = 57408
+const SUBQUERY_AS_EXPR = 57409
+const LOWER_THAN_STRING = 57410
+const ID = 57411
+const AT_ID = 57412
+const AT_AT_ID = 57413
+const STRING = 57414
+const VALUE_ARG = 57415
+const LIST_ARG = 57416
+const COMMENT = 57417
+const COMMENT_KEYWORD = 57418
+const QUOTE_ID = 57419
+const INTEGRAL = 57420
+const HEX = 57421
+const BIT_LITERAL = 57422
+const FLOAT = 57423
+const HEXNUM = 57424
+const NULL = 57425
+const TRUE = 57426
+const FALSE = 57427
+const LOWER_THAN_CHARSET = 57428
+const CHARSET = 57429
+const UNIQUE = 57430
+const KEY = 57431
+const OR = 57432
+const PIPE_CONCAT = 57433
+const XOR = 57434
+const AND = 57435
+const NOT = 57436
+const BETWEEN = 57437
+const CASE = 57438
+const WHEN = 57439
+const THEN = 57440
+const ELSE = 57441
+const END = 57442
+const ELSEIF = 57443
+const LOWER_THAN_EQ = 57444
+const LE = 57445
+const GE = 57446
+const NE = 57447
+const NULL_SAFE_EQUAL = 57448
+const IS = 57449
+const LIKE = 57450
+const REGEXP = 57451
+const IN = 57452
+const ASSIGNMENT = 57453
+const ILIKE = 57454
+const SHIFT_LEFT = 57455
+const SHIFT_RIGHT = 57456
+const DIV = 57457
+const MOD = 57458
+const UNARY = 57459
+const COLLATE = 57460
+const BINARY = 57461
+const UNDERSCORE_BINARY = 57462
+const INTERVAL = 57463
+const OUT = 57464
+const INOUT = 57465
+const BEGIN = 57466
+const START = 57467
+const TRANSACTION = 57468
+const COMMIT = 57469
+const ROLLBACK = 57470
+const WORK = 57471
+const CONSISTENT = 57472
+const SNAPSHOT = 57473
+const CHAIN = 57474
+const NO = 57475
+const RELEASE = 57476
+const PRIORITY = 57477
+const QUICK = 57478
+const BIT = 57479
+const TINYINT = 57480
+const SMALLINT = 57481
+const MEDIUMINT = 57482
+const INT = 57483
+const INTEGER = 57484
+const BIGINT = 57485
+const INTNUM = 57486
+const REAL = 57487
+const DOUBLE = 57488
+const FLOAT_TYPE = 57489
+const DECIMAL = 57490
+const NUMERIC = 57491
+const DECIMAL_VALUE = 57492
+const TIME = 57493
+const TIMESTAMP = 57494
+const DATETIME = 57495
+const YEAR = 57496
+const CHAR = 57497
+const VARCHAR = 57498
+const BOOL = 57499
+const CHARACTER = 57500
+const VARBINARY = 57501
+const NCHAR = 57502
+const TEXT = 57503
+const TINYTEXT = 57504
+const MEDIUMTEXT = 57505
+const LONGTEXT = 57506
+const BLOB = 57507
+const TINYBLOB = 57508
+const MEDIUMBLOB = 57509
+const LONGBLOB = 57510
+const JSON = 57511
+const ENUM = 57512
+const UUID = 57513
+const GEOMETRY = 57514
+const POINT = 57515
+const LINESTRING = 57516
+const POLYGON = 57517
+const GEOMETRYCOLLECTION = 57518
+const MULTIPOINT = 57519
+const MULTILINESTRING = 57520
+const MULTIPOLYGON = 57521
+const INT1 = 57522
+const INT2 = 57523
+const INT3 = 57524
+const INT4 = 57525
+const INT8 = 57526
+const S3OPTION = 57527
+const SQL_SMALL_RESULT = 57528
+const SQL_BIG_RESULT = 57529
+const SQL_BUFFER_RESULT = 57530
+const LOW_PRIORITY = 57531
+const HIGH_PRIORITY = 57532
+const DELAYED = 57533
+const CREATE = 57534
+const ALTER = 57535
+const DROP = 57536
+const RENAME = 57537
+const ANALYZE = 57538
+const ADD = 57539
+const RETURNS = 57540
+const MODIFY = 57541
+const CHANGE = 57542
+const SCHEMA = 57543
+const TABLE = 57544
+const SEQUENCE = 57545
+const INDEX = 57546
+const VIEW = 57547
+const TO = 57548
+const IGNORE = 57549
+const IF = 57550
+const PRIMARY = 57551
+const COLUMN = 57552
+const CONSTRAINT = 57553
+const SPATIAL = 57554
+const FULLTEXT = 57555
+const FOREIGN = 57556
+const KEY_BLOCK_SIZE = 57557
+const SHOW = 57558
+const DESCRIBE = 57559
+const EXPLAIN = 57560
+const DATE = 57561
+const ESCAPE = 57562
+const REPAIR = 57563
+const OPTIMIZE = 57564
+const TRUNCATE = 57565
+const MAXVALUE = 57566
+const PARTITION = 57567
+const REORGANIZE = 57568
+const LESS = 57569
+const THAN = 57570
+const PROCEDURE = 57571
+const TRIGGER = 57572
+const EXCHANGE = 57573
+const VALIDATION = 57574
+const WITHOUT = 57575
+const STATUS = 57576
+const VARIABLES = 57577
+const ROLE = 57578
+const PROXY = 57579
+const AVG_ROW_LENGTH = 57580
+const STORAGE = 57581
+const DISK = 57582
+const MEMORY = 57583
+const CHECKSUM = 57584
+const COMPRESSION = 57585
+const DATA = 57586
+const DIRECTORY = 57587
+const DELAY_KEY_WRITE = 57588
+const ENCRYPTION = 57589
+const ENGINE = 57590
+const MAX_ROWS = 57591
+const MIN_ROWS = 57592
+const PACK_KEYS = 57593
+const ROW_FORMAT = 57594
+const STATS_AUTO_RECALC = 57595
+const STATS_PERSISTENT = 57596
+const STATS_SAMPLE_PAGES = 57597
+const DYNAMIC = 57598
+const COMPRESSED = 57599
+const REDUNDANT = 57600
+const COMPACT = 57601
+const FIXED = 57602
+const COLUMN_FORMAT = 57603
+const AUTO_RANDOM = 57604
+const RESTRICT = 57605
+const CASCADE = 57606
+const ACTION = 57607
+const PARTIAL = 57608
+const SIMPLE = 57609
+const CHECK = 57610
+const ENFORCED = 57611
+const RANGE = 57612
+const LIST = 57613
+const ALGORITHM = 57614
+const LINEAR = 57615
+const PARTITIONS = 57616
+const SUBPARTITION = 57617
+const SUBPARTITIONS = 57618
+const CLUSTER = 57619
+const TYPE = 57620
+const ANY = 57621
+const SOME = 57622
+const EXTERNAL = 57623
+const LOCALFILE = 57624
+const URL = 57625
+const PREPARE = 57626
+const DEALLOCATE = 57627
+const RESET = 57628
+const EXTENSION = 57629
+const INCREMENT = 57630
+const CYCLE = 57631
+const MINVALUE = 57632
+const PUBLICATION = 57633
+const SUBSCRIPTIONS = 57634
+const PUBLICATIONS = 57635
+const CONFLICT = 57636
+const PROPERTIES = 57637
+const TTL = 57638
+const PARSER = 57639
+const VISIBLE = 57640
+const INVISIBLE = 57641
+const BTREE = 57642
+const HASH = 57643
+const RTREE = 57644
+const BSI = 57645
+const ZONEMAP = 57646
+const LEADING = 57647
+const BOTH = 57648
+const TRAILING = 57649
+const UNKNOWN = 57650
+const EXPIRE = 57651
+const ACCOUNT = 57652
+const ACCOUNTS = 57653
+const UNLOCK = 57654
+const DAY = 57655
+const NEVER = 57656
+const PUMP = 57657
+const MYSQL_COMPATIBILITY_MODE = 57658
+const SECOND = 57659
+const ASCII = 57660
+const COALESCE = 57661
+const COLLATION = 57662
+const HOUR = 57663
+const MICROSECOND = 57664
+const MINUTE = 57665
+const MONTH = 57666
+const QUARTER = 57667
+const REPEAT = 57668
+const REVERSE = 57669
+const ROW_COUNT = 57670
+const WEEK = 57671
+const REVOKE = 57672
+const FUNCTION = 57673
+const PRIVILEGES = 57674
+const TABLESPACE = 57675
+const EXECUTE = 57676
+const SUPER = 57677
+const GRANT = 57678
+const OPTION = 57679
+const REFERENCES = 57680
+const REPLICATION = 57681
+const SLAVE = 57682
+const CLIENT = 57683
+const USAGE = 57684
+const RELOAD = 57685
+const FILE = 57686
+const TEMPORARY = 57687
+const ROUTINE = 57688
+const EVENT = 57689
+const SHUTDOWN = 57690
+const NULLX = 57691
+const AUTO_INCREMENT = 57692
+const APPROXNUM = 57693
+const SIGNED = 57694
+const UNSIGNED = 57695
+const ZEROFILL = 57696
+const ENGINES = 57697
+const LOW_CARDINALITY = 57698
+const ADMIN_NAME = 57699
+const RANDOM = 57700
+const SUSPEND = 57701
+const ATTRIBUTE = 57702
+const HISTORY = 57703
+const REUSE = 57704
+const CURRENT = 57705
+const OPTIONAL = 57706
+const FAILED_LOGIN_ATTEMPTS = 57707
+const PASSWORD_LOCK_TIME = 57708
+const UNBOUNDED = 57709
+const SECONDARY = 57710
+const USER = 57711
+const IDENTIFIED = 57712
+const CIPHER = 57713
+const ISSUER = 57714
+const X509 = 57715
+const SUBJECT = 57716
+const SAN = 57717
+const REQUIRE = 57718
+const SSL = 57719
+const NONE = 57720
+const PASSWORD = 57721
+const MAX_QUERIES_PER_HOUR = 57722
+const MAX_UPDATES_PER_HOUR = 57723
+const MAX_CONNECTIONS_PER_HOUR = 57724
+const MAX_USER_CONNECTIONS = 57725
+const FORMAT = 57726
+const VERBOSE = 57727
+const CONNECTION = 57728
+const TRIGGERS = 57729
+const PROFILES = 57730
+const LOAD = 57731
+const INFILE = 57732
+const TERMINATED = 57733
+const OPTIONALLY = 57734
+const ENCLOSED = 57735
+const ESCAPED = 57736
+const STARTING = 57737
+const LINES = 57738
+const ROWS = 57739
+const IMPORT = 57740
+const MODUMP = 57741
+const OVER = 57742
+const PRECEDING = 57743
+const FOLLOWING = 57744
+const GROUPS = 57745
+const DATABASES = 57746
+const TABLES = 57747
+const SEQUENCES = 57748
+const EXTENDED = 57749
+const FULL = 57750
+const PROCESSLIST = 57751
+const FIELDS = 57752
+const COLUMNS = 57753
+const OPEN = 57754
+const ERRORS = 57755
+const WARNINGS = 57756
+const INDEXES = 57757
+const SCHEMAS = 57758
+const NODE = 57759
+const LOCKS = 57760
+const ROLES = 57761
+const TABLE_NUMBER = 57762
+const COLUMN_NUMBER = 57763
+const TABLE_VALUES = 57764
+const TABLE_SIZE = 57765
+const NAMES = 57766
+const GLOBAL = 57767
+const SESSION = 57768
+const ISOLATION = 57769
+const LEVEL = 57770
+const READ = 57771
+const WRITE = 57772
+const ONLY = 57773
+const REPEATABLE = 57774
+const COMMITTED = 57775
+const UNCOMMITTED = 57776
+const SERIALIZABLE = 57777
+const LOCAL = 57778
+const EVENTS = 57779
+const PLUGINS = 57780
+const CURRENT_TIMESTAMP = 57781
+const DATABASE = 57782
+const CURRENT_TIME = 57783
+const LOCALTIME = 57784
+const LOCALTIMESTAMP = 57785
+const UTC_DATE = 57786
+const UTC_TIME = 57787
+const UTC_TIMESTAMP = 57788
+const REPLACE = 57789
+const CONVERT = 57790
+const SEPARATOR = 57791
+const TIMESTAMPDIFF = 57792
+const CURRENT_DATE = 57793
+const CURRENT_USER = 57794
+const CURRENT_ROLE = 57795
+const SECOND_MICROSECOND = 57796
+const MINUTE_MICROSECOND = 57797
+const MINUTE_SECOND = 57798
+const HOUR_MICROSECOND = 57799
+const HOUR_SECOND = 57800
+const HOUR_MINUTE = 57801
+const DAY_MICROSECOND = 57802
+const DAY_SECOND = 57803
+const DAY_MINUTE = 57804
+const DAY_HOUR = 57805
+const YEAR_MONTH = 57806
+const SQL_TSI_HOUR = 57807
+const SQL_TSI_DAY = 57808
+const SQL_TSI_WEEK = 57809
+const SQL_TSI_MONTH = 57810
+const SQL_TSI_QUARTER = 57811
+const SQL_TSI_YEAR = 57812
+const SQL_TSI_SECOND = 57813
+const SQL_TSI_MINUTE = 57814
+const RECURSIVE = 57815
+const CONFIG = 57816
+const DRAINER = 57817
+const MATCH = 57818
+const AGAINST = 57819
+const BOOLEAN = 57820
+const LANGUAGE = 57821
+const WITH = 57822
+const QUERY = 57823
+const EXPANSION = 57824
+const ADDDATE = 57825
+const BIT_AND = 57826
+const BIT_OR = 57827
+const BIT_XOR = 57828
+const CAST = 57829
+const COUNT = 57830
+const APPROX_COUNT_DISTINCT = 57831
+const APPROX_PERCENTILE = 57832
+const CURDATE = 57833
+const CURTIME = 57834
+const DATE_ADD = 57835
+const DATE_SUB = 57836
+const EXTRACT = 57837
+const GROUP_CONCAT = 57838
+const MAX = 57839
+const MID = 57840
+const MIN = 57841
+const NOW = 57842
+const POSITION = 57843
+const SESSION_USER = 57844
+const STD = 57845
+const STDDEV = 57846
+const MEDIAN = 57847
+const STDDEV_POP = 57848
+const STDDEV_SAMP = 57849
+const SUBDATE = 57850
+const SUBSTR = 57851
+const SUBSTRING = 57852
+const SUM = 57853
+const SYSDATE = 57854
+const SYSTEM_USER = 57855
+const TRANSLATE = 57856
+const TRIM = 57857
+const VARIANCE = 57858
+const VAR_POP = 57859
+const VAR_SAMP = 57860
+const AVG = 57861
+const RANK = 57862
+const NEXTVAL = 57863
+const SETVAL = 57864
+const CURRVAL = 57865
+const LASTVAL = 57866
+const ARROW = 57867
+const ROW = 57868
+const OUTFILE = 57869
+const HEADER = 57870
+const MAX_FILE_SIZE = 57871
+const FORCE_QUOTE = 57872
+const PARALLEL = 57873
+const UNUSED = 57874
+const BINDINGS = 57875
+const DO = 57876
+const DECLARE = 57877
+const LOOP = 57878
+const WHILE = 57879
+const LEAVE = 57880
+const ITERATE = 57881
+const UNTIL = 57882
+const CALL = 57883
+const SPBEGIN = 57884
+const BACKEND = 57885
+const SERVERS = 57886
+const KILL = 57887
+const QUERY_RESULT = 57888
 
 var yyToknames = [...]string{
 	"$end",
@@ -602,6 +610,7 @@ var yyToknames = [...]string{
 	"NULLS",
 	"FIRST",
 	"LAST",
+	"AFTER",
 	"VALUES",
 	"NEXT",
 	"VALUE",
@@ -771,6 +780,8 @@ var yyToknames = [...]string{
 	"ANALYZE",
 	"ADD",
 	"RETURNS",
+	"MODIFY",
+	"CHANGE",
 	"SCHEMA",
 	"TABLE",
 	"SEQUENCE",
@@ -801,6 +812,9 @@ var yyToknames = [...]string{
 	"THAN",
 	"PROCEDURE",
 	"TRIGGER",
+	"EXCHANGE",
+	"VALIDATION",
+	"WITHOUT",
 	"STATUS",
 	"VARIABLES",
 	"ROLE",
@@ -861,7 +875,9 @@ var yyToknames = [...]string{
 	"PUBLICATION",
 	"SUBSCRIPTIONS",
 	"PUBLICATIONS",
+	"CONFLICT",
 	"PROPERTIES",
+	"TTL",
 	"PARSER",
 	"VISIBLE",
 	"INVISIBLE",
@@ -1125,7 +1141,7 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:9383
+//line mysql_sql.y:9573
 
 //line yacctab:1
 var yyExca = [...]int{
@@ -1133,3805 +1149,4263 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 110,
-	21, 626,
-	-2, 607,
+	21, 647,
+	-2, 628,
 	-1, 124,
-	218, 843,
-	-2, 914,
+	221, 879,
+	-2, 953,
 	-1, 146,
-	42, 447,
-	218, 447,
-	245, 454,
-	246, 454,
-	424, 447,
-	-2, 480,
+	42, 468,
+	221, 468,
+	251, 475,
+	252, 475,
+	432, 468,
+	-2, 501,
 	-1, 182,
-	557, 1573,
-	-2, 365,
-	-1, 499,
-	294, 130,
-	399, 130,
-	-2, 1487,
-	-1, 562,
-	67, 1293,
-	-2, 1627,
-	-1, 563,
-	67, 1311,
-	-2, 1598,
-	-1, 567,
-	67, 1312,
-	-2, 1626,
-	-1, 590,
-	67, 1223,
-	-2, 1688,
-	-1, 591,
-	67, 1224,
-	-2, 1687,
-	-1, 592,
-	67, 1225,
-	-2, 1677,
-	-1, 593,
-	67, 1652,
+	565, 1616,
+	-2, 386,
+	-1, 506,
+	300, 130,
+	407, 130,
+	-2, 1528,
+	-1, 569,
+	68, 1333,
 	-2, 1672,
-	-1, 594,
-	67, 1653,
-	-2, 1673,
-	-1, 595,
-	67, 1654,
-	-2, 1679,
-	-1, 596,
-	67, 1655,
-	-2, 1662,
+	-1, 570,
+	68, 1351,
+	-2, 1641,
+	-1, 574,
+	68, 1352,
+	-2, 1671,
 	-1, 597,
-	67, 1656,
-	-2, 1670,
+	68, 1263,
+	-2, 1735,
 	-1, 598,
-	67, 1657,
-	-2, 1680,
+	68, 1264,
+	-2, 1734,
 	-1, 599,
-	67, 1658,
-	-2, 1681,
+	68, 1265,
+	-2, 1724,
 	-1, 600,
-	67, 1659,
-	-2, 1686,
+	68, 1699,
+	-2, 1719,
 	-1, 601,
-	67, 1660,
-	-2, 1691,
+	68, 1700,
+	-2, 1720,
 	-1, 602,
-	67, 1661,
-	-2, 1692,
+	68, 1701,
+	-2, 1726,
+	-1, 603,
+	68, 1702,
+	-2, 1709,
 	-1, 604,
-	67, 1290,
-	-2, 1479,
+	68, 1703,
+	-2, 1717,
+	-1, 605,
+	68, 1704,
+	-2, 1727,
+	-1, 606,
+	68, 1705,
+	-2, 1728,
+	-1, 607,
+	68, 1706,
+	-2, 1733,
+	-1, 608,
+	68, 1707,
+	-2, 1738,
+	-1, 609,
+	68, 1708,
+	-2, 1739,
 	-1, 611,
-	67, 1299,
-	-2, 1505,
-	-1, 615,
-	67, 1303,
-	-2, 1544,
-	-1, 616,
-	67, 1304,
-	-2, 1622,
-	-1, 624,
-	67, 1314,
-	-2, 1607,
-	-1, 626,
-	67, 1316,
-	-2, 1617,
-	-1, 627,
-	67, 1317,
-	-2, 1642,
-	-1, 638,
-	67, 1201,
-	-2, 1682,
-	-1, 639,
-	67, 1202,
-	-2, 1683,
-	-1, 640,
-	67, 1203,
-	-2, 1684,
-	-1, 644,
-	21, 627,
-	-2, 590,
-	-1, 714,
-	419, 480,
-	420, 480,
-	-2, 448,
-	-1, 755,
-	105, 1479,
-	116, 1479,
-	136, 1479,
-	-2, 1454,
-	-1, 855,
-	21, 627,
-	-2, 590,
-	-1, 954,
-	21, 626,
-	-2, 1106,
-	-1, 1295,
-	67, 1361,
-	-2, 1624,
-	-1, 1296,
-	67, 1362,
-	-2, 1625,
-	-1, 1428,
-	68, 768,
-	-2, 774,
-	-1, 1752,
-	68, 1440,
-	137, 1440,
-	-2, 1609,
-	-1, 1753,
-	68, 1440,
-	137, 1440,
-	-2, 1608,
-	-1, 1754,
-	68, 1418,
-	137, 1418,
-	-2, 1595,
-	-1, 1755,
-	68, 1419,
-	137, 1419,
-	-2, 1600,
-	-1, 1756,
-	68, 1420,
-	137, 1420,
-	-2, 1532,
-	-1, 1757,
-	68, 1421,
-	137, 1421,
-	-2, 1526,
-	-1, 1758,
-	68, 1422,
-	137, 1422,
-	-2, 1470,
-	-1, 1759,
-	68, 1423,
-	137, 1423,
-	-2, 1597,
-	-1, 1760,
-	68, 1424,
-	137, 1424,
-	-2, 1530,
-	-1, 1761,
-	68, 1425,
-	137, 1425,
-	-2, 1525,
-	-1, 1762,
-	68, 1426,
-	137, 1426,
-	-2, 1518,
-	-1, 1764,
-	68, 1429,
-	137, 1429,
-	-2, 1642,
-	-1, 1765,
-	68, 1409,
-	137, 1409,
-	-2, 1627,
-	-1, 1766,
-	68, 1438,
-	137, 1438,
-	-2, 1598,
+	68, 1330,
+	-2, 1520,
+	-1, 618,
+	68, 1339,
+	-2, 1547,
+	-1, 622,
+	68, 1343,
+	-2, 1587,
+	-1, 623,
+	68, 1344,
+	-2, 1667,
+	-1, 631,
+	68, 1354,
+	-2, 1650,
+	-1, 633,
+	68, 1356,
+	-2, 1662,
+	-1, 634,
+	68, 1357,
+	-2, 1687,
+	-1, 645,
+	68, 1241,
+	-2, 1729,
+	-1, 646,
+	68, 1242,
+	-2, 1730,
+	-1, 647,
+	68, 1243,
+	-2, 1731,
+	-1, 651,
+	21, 648,
+	-2, 611,
+	-1, 721,
+	427, 501,
+	428, 501,
+	-2, 469,
+	-1, 762,
+	106, 1520,
+	117, 1520,
+	137, 1520,
+	-2, 1494,
+	-1, 862,
+	21, 648,
+	-2, 611,
+	-1, 961,
+	21, 647,
+	-2, 1146,
+	-1, 1302,
+	68, 1401,
+	-2, 1669,
+	-1, 1303,
+	68, 1402,
+	-2, 1670,
+	-1, 1435,
+	69, 789,
+	-2, 795,
 	-1, 1767,
-	68, 1438,
-	137, 1438,
-	-2, 1626,
+	69, 1480,
+	138, 1480,
+	-2, 1652,
 	-1, 1768,
-	68, 1438,
-	137, 1438,
-	-2, 1488,
+	69, 1480,
+	138, 1480,
+	-2, 1651,
 	-1, 1769,
-	68, 1436,
-	137, 1436,
-	-2, 1617,
+	69, 1458,
+	138, 1458,
+	-2, 1638,
 	-1, 1770,
-	68, 1433,
-	137, 1433,
-	-2, 1510,
+	69, 1459,
+	138, 1459,
+	-2, 1643,
 	-1, 1771,
-	67, 1391,
-	68, 1391,
-	137, 1391,
-	361, 1391,
-	362, 1391,
-	363, 1391,
-	-2, 1469,
+	69, 1460,
+	138, 1460,
+	-2, 1574,
 	-1, 1772,
-	67, 1392,
-	68, 1392,
-	137, 1392,
-	361, 1392,
-	362, 1392,
-	363, 1392,
-	-2, 1471,
+	69, 1461,
+	138, 1461,
+	-2, 1568,
 	-1, 1773,
-	67, 1395,
-	68, 1395,
-	137, 1395,
-	361, 1395,
-	362, 1395,
-	363, 1395,
-	-2, 1599,
+	69, 1462,
+	138, 1462,
+	-2, 1511,
 	-1, 1774,
-	67, 1397,
-	68, 1397,
-	137, 1397,
-	361, 1397,
-	362, 1397,
-	363, 1397,
-	-2, 1582,
+	69, 1463,
+	138, 1463,
+	-2, 1640,
 	-1, 1775,
-	67, 1399,
-	68, 1399,
-	137, 1399,
-	361, 1399,
-	362, 1399,
-	363, 1399,
-	-2, 1531,
+	69, 1464,
+	138, 1464,
+	-2, 1572,
 	-1, 1776,
-	67, 1401,
-	68, 1401,
-	137, 1401,
-	361, 1401,
-	362, 1401,
-	363, 1401,
-	-2, 1514,
+	69, 1465,
+	138, 1465,
+	-2, 1567,
 	-1, 1777,
-	67, 1402,
-	68, 1402,
-	137, 1402,
-	361, 1402,
-	362, 1402,
-	363, 1402,
-	-2, 1515,
-	-1, 1778,
-	67, 1404,
-	68, 1404,
-	137, 1404,
-	361, 1404,
-	362, 1404,
-	363, 1404,
-	-2, 1468,
+	69, 1466,
+	138, 1466,
+	-2, 1560,
 	-1, 1779,
-	68, 1443,
-	137, 1443,
-	361, 1443,
-	362, 1443,
-	363, 1443,
-	-2, 1493,
+	69, 1469,
+	138, 1469,
+	-2, 1687,
 	-1, 1780,
-	68, 1443,
-	137, 1443,
-	361, 1443,
-	362, 1443,
-	363, 1443,
-	-2, 1506,
+	69, 1449,
+	138, 1449,
+	-2, 1672,
 	-1, 1781,
-	68, 1446,
-	137, 1446,
-	361, 1446,
-	362, 1446,
-	363, 1446,
-	-2, 1489,
+	69, 1478,
+	138, 1478,
+	-2, 1641,
 	-1, 1782,
-	68, 1443,
-	137, 1443,
-	361, 1443,
-	362, 1443,
-	363, 1443,
-	-2, 1567,
+	69, 1478,
+	138, 1478,
+	-2, 1671,
+	-1, 1783,
+	69, 1478,
+	138, 1478,
+	-2, 1529,
+	-1, 1784,
+	69, 1476,
+	138, 1476,
+	-2, 1662,
+	-1, 1785,
+	69, 1473,
+	138, 1473,
+	-2, 1552,
+	-1, 1786,
+	68, 1431,
+	69, 1431,
+	138, 1431,
+	369, 1431,
+	370, 1431,
+	371, 1431,
+	-2, 1510,
+	-1, 1787,
+	68, 1432,
+	69, 1432,
+	138, 1432,
+	369, 1432,
+	370, 1432,
+	371, 1432,
+	-2, 1512,
+	-1, 1788,
+	68, 1435,
+	69, 1435,
+	138, 1435,
+	369, 1435,
+	370, 1435,
+	371, 1435,
+	-2, 1642,
+	-1, 1789,
+	68, 1437,
+	69, 1437,
+	138, 1437,
+	369, 1437,
+	370, 1437,
+	371, 1437,
+	-2, 1625,
+	-1, 1790,
+	68, 1439,
+	69, 1439,
+	138, 1439,
+	369, 1439,
+	370, 1439,
+	371, 1439,
+	-2, 1573,
+	-1, 1791,
+	68, 1441,
+	69, 1441,
+	138, 1441,
+	369, 1441,
+	370, 1441,
+	371, 1441,
+	-2, 1556,
+	-1, 1792,
+	68, 1442,
+	69, 1442,
+	138, 1442,
+	369, 1442,
+	370, 1442,
+	371, 1442,
+	-2, 1557,
+	-1, 1793,
+	68, 1444,
+	69, 1444,
+	138, 1444,
+	369, 1444,
+	370, 1444,
+	371, 1444,
+	-2, 1509,
+	-1, 1794,
+	69, 1483,
+	138, 1483,
+	369, 1483,
+	370, 1483,
+	371, 1483,
+	-2, 1534,
 	-1, 1795,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	258, 878,
-	-2, 871,
-	-1, 1905,
-	21, 626,
-	-2, 718,
-	-1, 2083,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	258, 878,
-	-2, 872,
-	-1, 2095,
-	65, 534,
-	137, 534,
-	-2, 1009,
-	-1, 2113,
-	279, 1074,
-	-2, 1053,
-	-1, 2373,
-	279, 1074,
-	-2, 1054,
-	-1, 2506,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	-2, 957,
-	-1, 2509,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	-2, 957,
-	-1, 2519,
-	65, 534,
-	137, 534,
-	-2, 1010,
-	-1, 2617,
-	88, 878,
-	132, 878,
-	171, 878,
-	174, 878,
-	-2, 958,
-	-1, 2907,
-	68, 929,
-	137, 929,
-	-2, 878,
-	-1, 2911,
-	68, 929,
-	137, 929,
-	-2, 878,
-	-1, 2925,
-	68, 933,
-	137, 933,
-	-2, 878,
-	-1, 2930,
-	68, 934,
-	137, 934,
-	-2, 878,
+	69, 1483,
+	138, 1483,
+	369, 1483,
+	370, 1483,
+	371, 1483,
+	-2, 1548,
+	-1, 1796,
+	69, 1486,
+	138, 1486,
+	369, 1486,
+	370, 1486,
+	371, 1486,
+	-2, 1530,
+	-1, 1797,
+	69, 1483,
+	138, 1483,
+	369, 1483,
+	370, 1483,
+	371, 1483,
+	-2, 1610,
+	-1, 1810,
+	89, 917,
+	133, 917,
+	172, 917,
+	175, 917,
+	264, 917,
+	-2, 910,
+	-1, 1932,
+	21, 647,
+	-2, 739,
+	-1, 2105,
+	89, 917,
+	133, 917,
+	172, 917,
+	175, 917,
+	264, 917,
+	-2, 911,
+	-1, 2118,
+	66, 555,
+	138, 555,
+	-2, 1049,
+	-1, 2143,
+	285, 1114,
+	-2, 1093,
+	-1, 2306,
+	20, 871,
+	34, 871,
+	-2, 867,
+	-1, 2425,
+	285, 1114,
+	-2, 1094,
+	-1, 2578,
+	89, 917,
+	133, 917,
+	172, 917,
+	175, 917,
+	-2, 996,
+	-1, 2581,
+	89, 917,
+	133, 917,
+	172, 917,
+	175, 917,
+	-2, 996,
+	-1, 2591,
+	66, 555,
+	138, 555,
+	-2, 1050,
+	-1, 2705,
+	89, 917,
+	133, 917,
+	172, 917,
+	175, 917,
+	-2, 997,
+	-1, 2720,
+	69, 968,
+	138, 968,
+	-2, 917,
+	-1, 2807,
+	69, 968,
+	138, 968,
+	-2, 917,
+	-1, 2926,
+	69, 972,
+	138, 972,
+	-2, 917,
+	-1, 2967,
+	69, 973,
+	138, 973,
+	-2, 917,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 34156
+const yyLast = 38704
 
 var yyAct = [...]int{
-	529, 1214, 1491, 2910, 2911, 2611, 173, 2919, 1276, 2890,
-	510, 508, 2801, 2849, 2841, 2584, 2677, 2589, 531, 2385,
-	2819, 2760, 2649, 2761, 1730, 2728, 2744, 2461, 1089, 2748,
-	2610, 2671, 2609, 2462, 645, 985, 2693, 1205, 2587, 418,
-	2661, 1449, 2638, 559, 2098, 2616, 2350, 1279, 424, 2579,
-	429, 429, 158, 2177, 1139, 2529, 429, 445, 452, 2179,
-	2178, 452, 1272, 1548, 2489, 2171, 2164, 2397, 1833, 2374,
-	2174, 1750, 512, 2459, 1988, 1640, 1609, 2447, 1836, 2430,
-	463, 2325, 2322, 2396, 2200, 1561, 2320, 1523, 2084, 1899,
-	1804, 1047, 754, 1987, 1852, 849, 1748, 1494, 457, 2269,
-	1740, 2348, 1618, 1201, 507, 1938, 1617, 501, 1410, 502,
-	2230, 1636, 53, 2213, 1196, 1610, 1583, 1900, 1541, 760,
-	1213, 1635, 1526, 1888, 2066, 2062, 1063, 691, 2115, 1834,
-	6, 1487, 169, 8, 1418, 1098, 168, 7, 1955, 1803,
-	804, 1436, 1668, 1270, 36, 509, 1637, 511, 1524, 1170,
-	418, 1148, 1746, 1545, 423, 500, 1275, 1206, 109, 35,
-	2030, 1788, 1097, 1461, 1460, 1325, 1309, 1261, 1616, 26,
-	1451, 1078, 1647, 173, 441, 173, 14, 795, 796, 519,
-	866, 1613, 1599, 1177, 15, 1269, 502, 758, 1573, 1131,
-	1021, 746, 1065, 1907, 1435, 1478, 13, 642, 2029, 23,
-	1074, 450, 438, 690, 1330, 1331, 1090, 16, 465, 1123,
-	1169, 10, 159, 451, 155, 152, 1045, 709, 466, 2263,
-	2263, 747, 688, 986, 1990, 791, 449, 793, 2454, 1654,
-	644, 1531, 1644, 448, 1939, 1944, 1941, 1942, 1184, 721,
-	1180, 446, 792, 788, 788, 788, 157, 787, 425, 1110,
-	1182, 2577, 2226, 447, 923, 924, 925, 922, 2224, 1588,
-	417, 2667, 2662, 2580, 2460, 1414, 764, 980, 2737, 434,
-	923, 924, 925, 922, 455, 1612, 643, 653, 886, 156,
-	1975, 428, 428, 2602, 1641, 2601, 2703, 436, 786, 1037,
-	8, 156, 2712, 156, 7, 49, 148, 125, 156, 1228,
-	1983, 156, 461, 49, 148, 125, 2792, 156, 693, 49,
-	148, 125, 761, 763, 2292, 1225, 156, 156, 1221, 1652,
-	462, 156, 633, 1919, 632, 634, 635, 2245, 636, 637,
-	2704, 920, 901, 1792, 1218, 902, 1227, 1559, 1422, 1423,
-	1038, 156, 1920, 1351, 1262, 1956, 1106, 1266, 153, 1107,
-	153, 2064, 2238, 108, 730, 1220, 1246, 1086, 153, 1365,
-	1095, 1096, 1474, 904, 153, 913, 646, 1278, 2837, 918,
-	731, 1265, 654, 153, 153, 1093, 757, 108, 153, 1092,
-	1095, 1096, 894, 2764, 2765, 896, 756, 1723, 2835, 2738,
-	2739, 2597, 2823, 2824, 2672, 2673, 2674, 2675, 153, 2463,
-	2669, 2231, 2730, 2730, 2063, 2232, 2733, 2233, 2665, 2463,
-	869, 735, 1281, 897, 734, 1970, 860, 1542, 2743, 2472,
-	2334, 2490, 1648, 923, 924, 925, 922, 1534, 429, 1257,
-	2607, 2497, 1109, 1879, 2336, 899, 1787, 1596, 429, 859,
-	2685, 2326, 2054, 906, 858, 2256, 907, 1267, 770, 765,
-	769, 771, 2258, 733, 452, 452, 732, 429, 2791, 2392,
-	1183, 1181, 1190, 1189, 916, 917, 915, 1980, 1264, 854,
-	856, 2341, 2331, 2332, 909, 775, 2168, 889, 1881, 768,
-	2688, 2604, 2839, 2578, 2225, 890, 1884, 2333, 798, 2830,
-	718, 124, 2347, 154, 900, 1347, 2753, 739, 694, 1344,
-	2354, 2330, 2091, 1346, 1343, 1345, 1349, 1350, 892, 454,
-	2069, 1348, 453, 146, 736, 956, 2596, 1280, 2749, 759,
-	895, 898, 2598, 2763, 2700, 696, 853, 773, 2639, 2640,
-	2641, 2643, 2642, 1084, 776, 2550, 2794, 2795, 1287, 1290,
-	1291, 881, 1538, 869, 891, 2904, 905, 2405, 2406, 1288,
-	2920, 766, 2858, 2834, 1653, 855, 2803, 764, 2865, 911,
-	912, 859, 2869, 1118, 2719, 903, 990, 1073, 1862, 2542,
-	1557, 1558, 774, 738, 1657, 1659, 1660, 1263, 1861, 1108,
-	2412, 496, 910, 2533, 498, 717, 716, 450, 450, 497,
-	2075, 871, 870, 2537, 2799, 2800, 2328, 2803, 2078, 2079,
-	2080, 2081, 715, 761, 763, 908, 1127, 2555, 2556, 2651,
-	767, 692, 449, 449, 2921, 893, 1126, 862, 863, 448,
-	448, 879, 695, 726, 2891, 2844, 764, 446, 446, 1071,
-	1642, 1070, 1642, 989, 1088, 1087, 1642, 2511, 1069, 447,
-	447, 2915, 2476, 2262, 737, 2694, 722, 1354, 1355, 1356,
-	1357, 1358, 1359, 1352, 1353, 2927, 850, 2308, 1669, 851,
-	958, 959, 960, 961, 1043, 424, 1046, 874, 875, 857,
-	2202, 2204, 761, 763, 1839, 2701, 1018, 878, 723, 727,
-	2575, 772, 788, 886, 1048, 864, 461, 788, 877, 788,
-	691, 788, 2727, 1124, 788, 712, 2702, 710, 714, 730,
-	962, 788, 2840, 711, 708, 707, 1940, 713, 698, 699,
-	697, 700, 701, 702, 703, 1655, 728, 729, 2793, 1643,
-	1095, 1096, 1185, 1976, 871, 870, 1910, 1094, 724, 725,
-	460, 2337, 1543, 2327, 2686, 643, 429, 1091, 1120, 1085,
-	1095, 1096, 2740, 2741, 2845, 503, 2259, 50, 1645, 418,
-	418, 418, 2603, 50, 1143, 1143, 1842, 429, 126, 1049,
-	1050, 1051, 1052, 2608, 1054, 720, 885, 2914, 1058, 1984,
-	126, 1289, 126, 1851, 452, 1046, 424, 126, 1173, 1173,
-	126, 880, 1053, 1535, 2329, 1258, 126, 1150, 2261, 173,
-	2068, 1057, 998, 999, 2650, 126, 126, 1056, 418, 2149,
-	126, 759, 1055, 456, 2538, 2539, 2316, 1658, 1838, 1145,
-	2535, 1656, 1060, 1840, 2534, 2053, 1141, 1141, 1734, 1044,
-	126, 685, 686, 687, 2271, 2270, 2926, 1041, 731, 1425,
-	2203, 1736, 1735, 683, 719, 1426, 1075, 1079, 1079, 1079,
-	1733, 1191, 1424, 2072, 2073, 655, 1212, 656, 1215, 1039,
-	1040, 731, 1023, 1223, 2624, 2870, 921, 2071, 2427, 1075,
-	1075, 1025, 1452, 2423, 1841, 779, 784, 785, 1700, 2842,
-	2843, 1699, 1856, 1244, 1080, 1081, 1743, 2933, 2932, 647,
-	659, 2096, 644, 1229, 1843, 886, 1143, 1958, 1143, 859,
-	1072, 2507, 1239, 1240, 1277, 2345, 1259, 1082, 1537, 1744,
-	1745, 1119, 1452, 1576, 2923, 1100, 1101, 2905, 1103, 1104,
-	1105, 733, 1062, 883, 732, 1897, 789, 790, 1724, 1975,
-	1194, 794, 1197, 1198, 1111, 1112, 2900, 1203, 1204, 1099,
-	1166, 658, 1102, 1898, 733, 661, 660, 732, 1846, 2894,
-	921, 1137, 1138, 2059, 2888, 1728, 921, 921, 1297, 1298,
-	1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308,
-	1125, 647, 764, 2893, 1320, 1321, 764, 1116, 1329, 884,
-	1134, 1135, 1136, 2924, 1151, 2874, 1650, 1368, 1369, 1370,
-	434, 1378, 740, 1898, 1243, 2496, 884, 1164, 1149, 1174,
-	1384, 1165, 1242, 1385, 1219, 2901, 2359, 921, 1226, 1274,
-	1208, 1175, 1211, 2097, 2056, 1392, 1393, 1387, 1650, 450,
-	923, 924, 925, 922, 923, 924, 925, 922, 1253, 1186,
-	2851, 2346, 1898, 2427, 1255, 1574, 2150, 2152, 2153, 2154,
-	2151, 2813, 1650, 1292, 449, 2771, 781, 782, 783, 1235,
-	2766, 448, 1271, 1252, 1650, 2721, 1230, 1408, 429, 446,
-	1434, 1143, 1438, 1790, 1440, 1441, 2290, 2720, 1249, 429,
-	1727, 447, 691, 1231, 1963, 1450, 1845, 1921, 2097, 1143,
-	1248, 1849, 1847, 1251, 644, 1120, 1848, 2717, 1076, 1019,
-	445, 1250, 2716, 1377, 1411, 1247, 2715, 2714, 1260, 2852,
-	1641, 1360, 1361, 1268, 1364, 1827, 1318, 1319, 2689, 1473,
-	2814, 2557, 1379, 1433, 2690, 1273, 2414, 1479, 1479, 2690,
-	1120, 1729, 1120, 1120, 2722, 1386, 429, 1388, 1434, 1434,
-	1477, 2197, 1143, 1521, 1533, 1704, 1808, 2035, 1311, 418,
-	1632, 1143, 1991, 1439, 937, 936, 946, 947, 939, 940,
-	941, 942, 943, 944, 945, 938, 2690, 1442, 1443, 1444,
-	1789, 2690, 1555, 1702, 1061, 2690, 2690, 429, 1434, 1143,
-	1323, 1566, 429, 429, 1569, 1679, 1128, 2690, 2853, 1572,
-	1921, 1517, 1518, 1578, 1554, 2415, 1973, 1363, 1077, 2522,
-	173, 2360, 1967, 173, 173, 1965, 173, 2215, 2099, 1960,
-	1898, 923, 924, 925, 922, 2364, 921, 1978, 1977, 852,
-	1481, 921, 1969, 1824, 1695, 1075, 1389, 1437, 1539, 938,
-	886, 1563, 1453, 1454, 1415, 1953, 1951, 1409, 1949, 1467,
-	1680, 1378, 1378, 1620, 1947, 1455, 1807, 1725, 1378, 1378,
-	1079, 1708, 1587, 1627, 1631, 1590, 1591, 1678, 1593, 1707,
-	1565, 1462, 1544, 1464, 1465, 1808, 1581, 1567, 1568, 1698,
-	926, 1961, 1689, 1466, 1966, 1430, 1470, 1450, 1961, 955,
-	1232, 1143, 1639, 1458, 1459, 1447, 1471, 964, 1472, 1446,
-	1457, 1475, 1476, 1482, 1688, 967, 1483, 1484, 1437, 1431,
-	1468, 1469, 1463, 2883, 1954, 1952, 2355, 1948, 872, 969,
-	1445, 1552, 1553, 1948, 2253, 1808, 1724, 852, 1633, 657,
-	921, 847, 1621, 1480, 845, 1367, 1366, 1066, 921, 1687,
-	1076, 1067, 2871, 1649, 1662, 1271, 1520, 1522, 921, 1853,
-	1540, 921, 1236, 1615, 1549, 1550, 1551, 1909, 1666, 1667,
-	1615, 1132, 1130, 764, 941, 942, 943, 944, 945, 938,
-	764, 1560, 1133, 921, 1564, 2356, 2754, 1485, 937, 936,
-	946, 947, 939, 940, 941, 942, 943, 944, 945, 938,
-	1584, 1390, 1391, 1582, 2625, 1394, 1395, 1396, 1397, 1399,
-	1400, 1401, 1402, 1403, 1404, 1405, 1406, 2428, 921, 761,
-	763, 450, 1650, 1601, 1317, 2514, 761, 763, 1562, 2357,
-	2755, 1237, 1705, 1562, 1562, 2512, 852, 1624, 1398, 1712,
-	1314, 1316, 1313, 2419, 1315, 2416, 449, 2264, 2626, 1622,
-	1077, 1629, 2169, 448, 1129, 662, 1964, 1912, 1630, 861,
-	1625, 446, 1626, 1939, 2452, 764, 1998, 1634, 501, 2515,
-	859, 1783, 1933, 447, 1326, 1751, 1675, 1585, 1178, 2513,
-	1585, 1326, 2217, 429, 429, 429, 1432, 1805, 939, 940,
-	941, 942, 943, 944, 945, 938, 2788, 1812, 1120, 922,
-	1664, 1665, 1670, 925, 922, 1115, 2545, 1117, 1817, 1121,
-	1122, 761, 763, 1661, 923, 924, 925, 922, 2544, 2234,
-	1663, 2007, 1120, 2127, 2126, 2455, 2121, 2119, 2526, 859,
-	1674, 2909, 2897, 1311, 1832, 1382, 1156, 1157, 1158, 1159,
-	1160, 1161, 1162, 1163, 2859, 2854, 1383, 1168, 2605, 844,
-	841, 842, 843, 496, 2804, 2012, 498, 2011, 2010, 2008,
-	2779, 497, 2172, 1172, 1172, 923, 924, 925, 922, 1902,
-	1902, 1533, 1902, 532, 541, 1828, 1943, 2829, 2756, 533,
-	2868, 540, 534, 538, 537, 535, 536, 2606, 859, 923,
-	924, 925, 922, 990, 2705, 1143, 429, 1178, 1784, 929,
-	930, 931, 932, 933, 934, 935, 927, 2663, 1722, 2631,
-	2628, 859, 424, 2627, 2516, 1173, 1751, 1533, 2493, 2321,
-	1928, 2009, 1930, 1737, 2867, 2494, 173, 1855, 1854, 2160,
-	1857, 1858, 1859, 1860, 542, 2335, 1863, 1864, 1865, 1866,
-	1867, 1868, 1869, 1870, 1871, 1872, 1873, 1874, 1875, 1876,
-	1791, 2158, 1826, 1079, 2249, 2229, 2228, 1917, 1906, 1904,
-	989, 1908, 1813, 2156, 2495, 2144, 539, 2143, 2159, 1814,
-	1815, 2142, 1971, 2146, 1821, 1639, 2139, 1822, 1934, 1818,
-	1819, 2133, 1143, 764, 1143, 1825, 1143, 2130, 2129, 1823,
-	2157, 859, 923, 924, 925, 922, 1985, 923, 924, 925,
-	922, 2453, 2155, 1820, 1927, 1604, 2000, 1282, 1283, 1284,
-	1285, 1286, 2145, 1603, 1797, 1798, 1799, 1602, 1598, 1597,
-	1143, 2016, 1882, 923, 924, 925, 922, 1233, 2283, 761,
-	763, 1036, 1935, 2585, 2825, 2789, 2023, 2725, 2687, 1816,
-	2664, 1143, 2615, 1913, 1914, 1915, 1981, 1918, 2583, 2013,
-	2014, 1327, 1328, 2581, 2025, 2561, 2559, 1362, 2165, 2528,
-	2492, 544, 110, 1923, 1926, 1372, 1924, 110, 923, 924,
-	925, 922, 2491, 2282, 2015, 2022, 2488, 1179, 2707, 2481,
-	2027, 2475, 1141, 859, 1925, 1989, 2422, 2420, 2057, 2002,
-	2410, 2409, 2313, 1932, 2312, 2024, 923, 924, 925, 922,
-	2260, 2227, 2208, 1141, 1691, 2147, 1412, 1982, 2140, 2136,
-	1416, 2135, 2134, 1419, 1726, 435, 1996, 1606, 110, 1600,
-	1972, 1974, 589, 588, 2922, 1979, 1421, 1149, 2046, 1234,
-	1143, 997, 993, 2076, 992, 968, 848, 1434, 1271, 2676,
-	2509, 2508, 1994, 2095, 2506, 2480, 2467, 1992, 1993, 2101,
-	2458, 923, 924, 925, 922, 1995, 2457, 1690, 2446, 2006,
-	2445, 2365, 2288, 2060, 2281, 2110, 937, 936, 946, 947,
-	939, 940, 941, 942, 943, 944, 945, 938, 2118, 2273,
-	923, 924, 925, 922, 2268, 2212, 2123, 2124, 2125, 2058,
-	2086, 2055, 2128, 936, 946, 947, 939, 940, 941, 942,
-	943, 944, 945, 938, 2050, 2047, 1902, 1950, 1198, 1946,
-	1945, 1203, 1204, 2092, 1713, 762, 2161, 1412, 1703, 110,
-	1701, 1697, 2065, 1412, 1412, 1434, 859, 1533, 1533, 1533,
-	1533, 2180, 2102, 2085, 110, 1696, 110, 1694, 859, 1533,
-	1685, 1682, 1902, 2180, 2113, 1681, 1605, 2031, 1407, 1381,
-	1380, 1143, 2036, 1371, 2116, 156, 156, 1155, 2116, 148,
-	125, 1153, 429, 429, 1586, 2882, 2876, 1589, 2117, 2866,
-	1592, 2074, 2863, 1594, 2861, 2778, 173, 2100, 1208, 8,
-	1211, 173, 2094, 7, 2723, 987, 1437, 1193, 2647, 2635,
-	2131, 2132, 2632, 2105, 1731, 1732, 2137, 2138, 2193, 2112,
-	2569, 2567, 1378, 2114, 1378, 2552, 2551, 2244, 2120, 2548,
-	2248, 2547, 153, 153, 2167, 2541, 1143, 2501, 1202, 2255,
-	1195, 1064, 2162, 2141, 2122, 2549, 2109, 2104, 2218, 2089,
-	2088, 2106, 2087, 2222, 946, 947, 939, 940, 941, 942,
-	943, 944, 945, 938, 2166, 1207, 2103, 2170, 1210, 923,
-	924, 925, 922, 1199, 2107, 2108, 2045, 1959, 1911, 2196,
-	2192, 2194, 1877, 1411, 1806, 644, 2195, 1312, 2243, 153,
-	2205, 2209, 2206, 1570, 2093, 2181, 2182, 2183, 2184, 2241,
-	1429, 1428, 1256, 2220, 1222, 2247, 2216, 1200, 2276, 1020,
-	2278, 1017, 1016, 2219, 1015, 1014, 1013, 2252, 2257, 1012,
-	859, 1011, 1010, 1009, 2240, 1751, 2324, 1008, 2237, 1672,
-	2242, 1007, 1676, 764, 2235, 1006, 2339, 1005, 429, 1004,
-	764, 1003, 1002, 2437, 2265, 1001, 1000, 2251, 859, 859,
-	859, 996, 995, 1832, 1832, 1832, 994, 1533, 1805, 2266,
-	2363, 991, 984, 983, 2272, 2239, 2367, 923, 924, 925,
-	922, 1686, 2246, 2279, 2280, 2277, 2395, 981, 2398, 1693,
-	2398, 2398, 2274, 2275, 980, 979, 978, 2403, 977, 2315,
-	976, 975, 1143, 1143, 974, 1811, 2758, 1706, 973, 972,
-	1709, 1710, 1711, 971, 970, 1714, 1715, 1716, 1717, 1718,
-	1719, 1720, 1721, 2210, 2211, 966, 2317, 2314, 2309, 923,
-	924, 925, 922, 429, 110, 110, 762, 965, 2324, 888,
-	846, 1794, 2343, 876, 764, 2809, 1434, 1434, 2344, 2431,
-	2432, 2085, 2393, 2394, 2361, 2747, 2407, 2408, 2362, 2358,
-	2351, 2352, 2436, 2807, 1141, 1141, 2591, 2762, 1809, 2434,
-	1677, 2077, 1922, 2399, 2400, 1608, 2319, 887, 923, 924,
-	925, 922, 2189, 2401, 2186, 2185, 2016, 2190, 2187, 923,
-	924, 925, 922, 2188, 764, 2456, 2191, 2293, 1894, 1895,
-	2908, 2294, 2295, 2296, 2297, 954, 2298, 2299, 2300, 2301,
-	2302, 2303, 2304, 2305, 2590, 1968, 2424, 2425, 2413, 2572,
-	2418, 2571, 2421, 2417, 2310, 2311, 2371, 923, 924, 925,
-	922, 426, 429, 2435, 2554, 1962, 2052, 923, 924, 925,
-	922, 96, 52, 1516, 2366, 2478, 51, 2318, 2368, 2369,
-	2286, 2442, 2443, 2444, 2439, 2570, 1187, 923, 924, 925,
-	922, 1957, 1731, 1732, 2451, 1412, 1412, 1412, 923, 924,
-	925, 922, 2370, 923, 924, 925, 922, 1986, 1683, 2342,
-	2285, 1022, 430, 1216, 1785, 2468, 1571, 882, 2742, 2898,
-	1172, 2284, 2469, 2111, 2061, 431, 432, 2044, 2471, 1801,
-	433, 2470, 2043, 923, 924, 925, 922, 1448, 2474, 1427,
-	2816, 2482, 1434, 1880, 923, 924, 925, 922, 2505, 2426,
-	923, 924, 925, 922, 1519, 923, 924, 925, 922, 1902,
-	1533, 2519, 1367, 1366, 2438, 1034, 1035, 1114, 1026, 937,
-	936, 946, 947, 939, 940, 941, 942, 943, 944, 945,
-	938, 1113, 1143, 2484, 923, 924, 925, 922, 2486, 2527,
-	2042, 2487, 914, 429, 1562, 1024, 2441, 648, 649, 650,
-	651, 1628, 2395, 1032, 1033, 1068, 2499, 2877, 2521, 2797,
-	647, 647, 2500, 923, 924, 925, 922, 1030, 1031, 1028,
-	1029, 1999, 2785, 2250, 1434, 2783, 2750, 2735, 859, 2017,
-	2018, 2734, 2041, 2180, 2732, 2724, 2658, 2020, 2021, 2502,
-	2503, 2504, 2393, 2525, 2530, 2657, 2582, 2518, 2517, 2483,
-	2026, 2574, 2465, 2464, 173, 923, 924, 925, 922, 1154,
-	2449, 2563, 1027, 2448, 2880, 2040, 2214, 859, 1452, 2553,
-	1412, 2039, 2180, 2048, 2049, 1419, 1796, 2562, 2811, 2810,
-	2810, 1152, 2558, 2560, 2811, 2599, 435, 2564, 923, 924,
-	925, 922, 2565, 2473, 923, 924, 925, 922, 648, 649,
-	650, 651, 859, 1143, 1143, 2038, 2576, 2543, 859, 2618,
-	110, 647, 2618, 1832, 937, 936, 946, 947, 939, 940,
-	941, 942, 943, 944, 945, 938, 1684, 2586, 923, 924,
-	925, 922, 2520, 873, 2600, 2466, 1083, 2037, 2523, 160,
-	3, 2524, 60, 2, 1556, 1147, 1, 1420, 859, 859,
-	652, 2198, 859, 859, 2619, 2622, 2621, 2613, 2199, 2614,
-	923, 924, 925, 922, 2521, 1141, 2530, 2440, 1450, 2201,
-	2655, 110, 1646, 2034, 1878, 110, 1786, 2338, 2659, 2660,
-	2636, 2637, 2033, 1059, 2645, 2646, 110, 2633, 2032, 684,
-	1373, 1241, 2652, 2644, 778, 110, 923, 924, 925, 922,
-	868, 1238, 2684, 2028, 867, 923, 924, 925, 922, 2653,
-	865, 923, 924, 925, 922, 1324, 546, 1611, 2163, 2019,
-	2696, 2654, 2815, 2848, 2546, 2777, 923, 924, 925, 922,
-	1997, 1890, 1893, 1894, 1895, 1891, 859, 1892, 1896, 2818,
-	1254, 2682, 923, 924, 925, 922, 530, 1322, 859, 2691,
-	2726, 2668, 679, 923, 924, 925, 922, 2781, 2670, 2698,
-	2697, 2588, 1651, 919, 2706, 2236, 705, 582, 2713, 2709,
-	923, 924, 925, 922, 557, 982, 1224, 1217, 2291, 2592,
-	2718, 780, 556, 2498, 2070, 2221, 2699, 2223, 2629, 2630,
-	673, 859, 777, 706, 1595, 2736, 2666, 1188, 2751, 1209,
-	1192, 2623, 2731, 2729, 2510, 1412, 2353, 1885, 2090, 2918,
-	1412, 2907, 2889, 2875, 2802, 2903, 2746, 2833, 2864, 2595,
-	2593, 2745, 2594, 2772, 2775, 2857, 2798, 467, 2752, 1536,
-	1890, 1893, 1894, 1895, 1891, 2757, 1892, 1896, 416, 744,
-	2776, 2767, 2768, 2769, 2770, 2648, 2267, 1607, 2784, 468,
-	2786, 2787, 1810, 2790, 2634, 2782, 2780, 671, 1793, 672,
-	2083, 2082, 1293, 928, 1310, 2306, 2307, 963, 2287, 506,
-	1673, 518, 2796, 2067, 2386, 2207, 59, 58, 681, 2822,
-	676, 2805, 666, 2808, 2806, 2377, 57, 56, 1577, 678,
-	677, 2812, 181, 548, 180, 2774, 2820, 2821, 859, 528,
-	527, 2826, 526, 2831, 525, 2827, 664, 524, 1889, 2387,
-	670, 1887, 1886, 1528, 1527, 2847, 1575, 2836, 2838, 2404,
-	1850, 1844, 2380, 1486, 2759, 2710, 2846, 2850, 2711, 2375,
-	2540, 2855, 2148, 859, 2390, 2391, 2536, 2532, 1277, 2411,
-	2376, 2856, 2617, 2372, 2373, 1532, 2379, 2860, 1800, 2862,
-	803, 675, 799, 2822, 2873, 674, 801, 802, 800, 2005,
-	2001, 663, 859, 1829, 859, 669, 1831, 1277, 2402, 1277,
-	2879, 2821, 2881, 2872, 1830, 2349, 1742, 2381, 2884, 1741,
-	1739, 2850, 667, 859, 2885, 1738, 2828, 1042, 1277, 2899,
-	2892, 2896, 2902, 2683, 2485, 1749, 1747, 2433, 2429, 2340,
-	1619, 110, 1417, 665, 110, 110, 2051, 110, 1529, 1525,
-	2913, 2906, 1351, 1883, 2917, 2916, 156, 682, 49, 148,
-	125, 2925, 1795, 87, 2928, 86, 94, 137, 2913, 2931,
-	2930, 46, 2929, 2917, 165, 164, 149, 167, 166, 163,
-	1936, 668, 762, 141, 1937, 162, 1176, 150, 161, 762,
-	2620, 156, 108, 49, 148, 125, 641, 37, 110, 33,
-	12, 11, 34, 21, 22, 20, 1245, 97, 2389, 19,
-	1837, 149, 25, 153, 32, 31, 30, 103, 141, 102,
-	29, 101, 150, 100, 99, 98, 28, 108, 18, 41,
-	40, 39, 9, 93, 91, 2383, 27, 92, 89, 90,
-	88, 2878, 97, 71, 70, 69, 84, 83, 153, 82,
-	81, 80, 680, 79, 77, 78, 704, 2382, 2384, 68,
-	67, 66, 65, 64, 75, 85, 76, 74, 73, 2477,
-	923, 924, 925, 922, 954, 72, 2479, 63, 62, 61,
-	122, 123, 121, 120, 119, 118, 112, 113, 117, 114,
-	115, 937, 936, 946, 947, 939, 940, 941, 942, 943,
-	944, 945, 938, 116, 1347, 42, 43, 44, 1344, 45,
-	133, 132, 1346, 1343, 1345, 1349, 1350, 134, 136, 138,
-	1348, 112, 113, 135, 114, 115, 130, 128, 131, 129,
-	127, 54, 2392, 17, 24, 4, 0, 0, 0, 0,
-	0, 0, 0, 0, 2378, 0, 0, 0, 0, 1351,
-	2388, 0, 0, 0, 124, 147, 154, 0, 95, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1514, 0,
-	0, 2289, 0, 0, 0, 0, 146, 140, 139, 0,
-	0, 0, 0, 55, 0, 0, 0, 0, 0, 124,
-	147, 154, 0, 95, 0, 0, 0, 0, 0, 0,
-	0, 0, 1516, 0, 0, 0, 0, 0, 0, 0,
-	0, 146, 140, 139, 1412, 0, 0, 2566, 55, 0,
-	2568, 937, 936, 946, 947, 939, 940, 941, 942, 943,
-	944, 945, 938, 0, 2573, 0, 0, 0, 0, 1496,
-	0, 142, 143, 144, 0, 1332, 1333, 1334, 1335, 1336,
-	1337, 1338, 1339, 1340, 1341, 1342, 1354, 1355, 1356, 1357,
-	1358, 1359, 1352, 1353, 0, 0, 0, 151, 0, 0,
-	0, 0, 0, 0, 0, 0, 142, 143, 144, 0,
-	0, 0, 0, 0, 0, 104, 0, 0, 0, 145,
-	0, 105, 1905, 0, 0, 0, 0, 0, 0, 0,
-	0, 1347, 151, 0, 0, 1344, 0, 0, 0, 1346,
-	1343, 1345, 1349, 1350, 0, 0, 0, 1348, 0, 0,
-	104, 0, 0, 0, 145, 0, 105, 0, 0, 0,
-	0, 1671, 0, 0, 0, 1514, 0, 0, 1532, 0,
-	0, 0, 0, 0, 106, 0, 0, 110, 0, 0,
-	0, 0, 0, 0, 48, 937, 936, 946, 947, 939,
-	940, 941, 942, 943, 944, 945, 938, 0, 0, 1516,
-	0, 1490, 1489, 0, 0, 1488, 0, 0, 0, 106,
-	1500, 2681, 0, 0, 0, 0, 0, 0, 0, 48,
-	0, 1504, 0, 0, 0, 0, 2912, 0, 2692, 0,
-	0, 0, 50, 0, 0, 0, 1496, 0, 0, 0,
-	0, 1493, 0, 0, 0, 1495, 1497, 1499, 2708, 1501,
-	1502, 1503, 1505, 1506, 1507, 1509, 1510, 1511, 1512, 0,
-	0, 0, 0, 0, 0, 126, 0, 50, 0, 0,
-	0, 0, 1332, 1333, 1334, 1335, 1336, 1337, 1338, 1339,
-	1340, 1341, 1342, 1354, 1355, 1356, 1357, 1358, 1359, 1352,
-	1353, 0, 0, 0, 2681, 0, 0, 1515, 0, 0,
-	126, 0, 0, 819, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 107,
-	38, 0, 0, 0, 0, 0, 47, 5, 0, 0,
-	111, 0, 0, 0, 1513, 478, 0, 477, 484, 474,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 481,
-	482, 1492, 483, 487, 107, 38, 469, 0, 0, 0,
-	0, 47, 0, 0, 0, 111, 492, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1500, 0, 0,
-	1508, 0, 0, 0, 0, 0, 0, 1498, 1504, 0,
+	536, 515, 2418, 1283, 2920, 517, 173, 2978, 538, 2970,
+	2944, 1505, 1221, 2871, 2679, 2807, 2672, 2877, 2776, 2437,
+	1745, 2878, 1096, 2838, 2740, 2531, 2696, 2806, 2858, 2770,
+	1337, 2532, 2854, 992, 652, 2792, 1212, 2677, 2760, 425,
+	1456, 566, 2729, 2419, 2704, 1458, 1146, 2121, 431, 1286,
+	436, 436, 2695, 2377, 2395, 2667, 436, 452, 459, 2224,
+	2225, 459, 2604, 158, 1563, 2561, 2202, 2421, 2449, 1850,
+	1279, 2426, 2220, 1538, 2479, 1926, 519, 1765, 1546, 2217,
+	2529, 2517, 1655, 2015, 1853, 1624, 2246, 2396, 2500, 1819,
+	2369, 1576, 2223, 1054, 2367, 2209, 2448, 761, 464, 2393,
+	1508, 856, 2106, 470, 1755, 1763, 2276, 508, 514, 2014,
+	1417, 1651, 2316, 36, 1632, 1965, 1625, 1556, 1541, 2259,
+	509, 1633, 1598, 1650, 1203, 1927, 1915, 698, 435, 435,
+	1634, 767, 2089, 1070, 443, 1208, 2145, 1851, 2422, 1495,
+	1849, 1818, 1425, 169, 8, 6, 53, 1443, 168, 7,
+	425, 811, 1683, 1539, 1277, 1982, 1282, 1652, 1177, 1878,
+	1761, 518, 1155, 1803, 109, 1560, 35, 1468, 1662, 516,
+	457, 1872, 1467, 173, 430, 173, 507, 802, 803, 1213,
+	1316, 2057, 1332, 873, 526, 1631, 1268, 765, 1085, 1628,
+	1028, 1184, 26, 1276, 1220, 1614, 15, 509, 13, 1138,
+	1588, 753, 14, 1934, 1442, 697, 1485, 445, 1338, 472,
+	1081, 448, 1130, 23, 649, 16, 473, 10, 159, 1104,
+	1097, 152, 695, 754, 993, 155, 1176, 2310, 458, 2310,
+	1669, 1052, 2017, 1659, 2524, 1971, 798, 799, 800, 1969,
+	1968, 716, 651, 1966, 728, 2056, 1187, 795, 794, 456,
+	1191, 795, 157, 453, 432, 454, 1117, 795, 1189, 455,
+	2665, 2272, 424, 2270, 1603, 2766, 1072, 930, 931, 932,
+	929, 930, 931, 932, 929, 441, 2761, 2668, 2530, 1421,
+	987, 2847, 1627, 650, 660, 156, 2692, 2911, 1358, 462,
+	2827, 893, 156, 2802, 49, 148, 125, 2002, 2010, 2457,
+	771, 8, 156, 793, 156, 156, 7, 1105, 156, 1656,
+	1044, 156, 149, 49, 148, 125, 156, 2691, 768, 141,
+	770, 2817, 156, 150, 49, 148, 125, 156, 1235, 108,
+	156, 468, 49, 148, 125, 2339, 1228, 2803, 469, 2291,
+	1667, 2284, 108, 153, 97, 1232, 1807, 1946, 927, 1947,
+	153, 653, 640, 1225, 639, 641, 642, 1093, 643, 644,
+	153, 1045, 153, 153, 108, 2962, 1234, 1429, 1430, 153,
+	1269, 1983, 1100, 1273, 1227, 1253, 1099, 1102, 1103, 661,
+	153, 908, 1574, 2091, 909, 153, 742, 737, 153, 741,
+	2960, 920, 1113, 1102, 1103, 1114, 1481, 1272, 2881, 2882,
+	901, 1285, 925, 903, 764, 2687, 930, 931, 932, 929,
+	763, 2848, 2849, 1738, 2768, 911, 2948, 2949, 2771, 2772,
+	2773, 2774, 2277, 112, 113, 2533, 114, 115, 2840, 2840,
+	2278, 2843, 2279, 2764, 904, 436, 2090, 2533, 1288, 876,
+	1997, 2910, 867, 1557, 2853, 436, 866, 1354, 2542, 2095,
+	2562, 1351, 1663, 2488, 1549, 1353, 1350, 1352, 1356, 1357,
+	1264, 459, 459, 1355, 436, 2648, 2749, 2480, 1190, 1188,
+	2380, 2378, 1906, 2379, 2381, 746, 861, 863, 1274, 2784,
+	1611, 1116, 1802, 2490, 777, 772, 776, 778, 2444, 906,
+	2081, 124, 147, 154, 743, 95, 2302, 1197, 1196, 1271,
+	922, 805, 2007, 896, 766, 2485, 2486, 2666, 897, 2212,
+	124, 782, 154, 858, 2829, 146, 140, 139, 775, 2564,
+	2487, 2304, 55, 864, 2913, 2914, 2271, 923, 924, 2484,
+	876, 899, 2686, 963, 146, 2207, 1091, 2826, 2688, 860,
+	2880, 1908, 884, 902, 905, 1287, 2746, 2385, 907, 1911,
+	1672, 1674, 1675, 745, 2380, 2378, 2375, 2379, 2381, 2955,
+	2374, 2373, 2703, 457, 457, 2392, 780, 898, 866, 1553,
+	2391, 888, 2964, 783, 862, 2730, 2731, 2732, 2734, 2733,
+	1668, 2799, 2114, 142, 143, 144, 865, 2462, 2463, 2863,
+	918, 919, 773, 2100, 2101, 2102, 2103, 1125, 771, 1361,
+	1362, 1363, 1364, 1365, 1366, 1359, 1360, 913, 1270, 503,
+	914, 151, 505, 781, 2400, 2859, 768, 504, 770, 910,
+	461, 2625, 1572, 1573, 744, 2482, 460, 878, 877, 104,
+	1115, 3030, 2987, 145, 2872, 105, 2918, 2919, 900, 2922,
+	2922, 916, 456, 456, 996, 2959, 453, 453, 454, 454,
+	1657, 774, 455, 455, 2994, 1080, 1657, 2548, 2742, 2309,
+	2824, 869, 870, 1294, 1297, 1298, 2617, 771, 1657, 2999,
+	1888, 1050, 431, 1053, 1295, 1887, 2634, 2635, 2469, 2890,
+	2187, 2097, 885, 1025, 2973, 768, 1134, 770, 106, 881,
+	882, 965, 966, 967, 968, 795, 795, 698, 48, 2608,
+	795, 1133, 1856, 795, 2612, 886, 2912, 795, 997, 795,
+	2801, 1078, 969, 1077, 871, 912, 2889, 1076, 878, 877,
+	1095, 1094, 779, 1670, 1102, 1103, 1967, 1658, 2873, 2811,
+	1192, 1101, 2850, 2851, 2420, 2928, 2793, 2800, 1098, 857,
+	2355, 1871, 1870, 436, 1869, 1127, 50, 1092, 2583, 1102,
+	1103, 917, 1684, 1875, 1873, 650, 425, 425, 425, 1372,
+	2663, 1150, 1150, 2693, 436, 1558, 2481, 1055, 1056, 1057,
+	1058, 1059, 126, 1061, 915, 2011, 50, 1065, 468, 126,
+	2785, 459, 1053, 431, 50, 1180, 1180, 2491, 1157, 126,
+	1673, 126, 126, 766, 2837, 126, 173, 2211, 126, 2965,
+	1005, 1006, 2460, 126, 2974, 425, 887, 2750, 1152, 126,
+	2248, 2250, 2380, 2378, 126, 2379, 2381, 126, 1550, 1131,
+	2483, 1123, 2305, 738, 1265, 893, 2003, 1937, 1660, 1877,
+	1060, 1148, 1148, 107, 38, 2631, 2308, 1064, 1855, 1063,
+	47, 5, 1156, 1857, 111, 1062, 1051, 1198, 2741, 463,
+	2215, 2216, 1859, 1219, 2363, 1222, 1067, 2810, 1671, 2080,
+	1230, 786, 791, 792, 2214, 2318, 2317, 2389, 692, 693,
+	694, 1749, 666, 1030, 1751, 1750, 1246, 1247, 1048, 1032,
+	1251, 1046, 1047, 1432, 1236, 1433, 1748, 1431, 690, 662,
+	663, 2712, 2405, 1150, 3000, 1150, 866, 1858, 1087, 1088,
+	3031, 651, 1758, 1296, 3028, 1459, 740, 1805, 2927, 739,
+	892, 928, 1126, 2188, 2190, 2191, 2192, 2189, 2497, 1069,
+	2456, 2613, 2614, 665, 1459, 1759, 1760, 668, 667, 2493,
+	893, 3022, 2610, 1552, 2971, 2972, 2609, 1201, 1985, 1204,
+	1205, 1118, 1119, 1924, 2119, 1304, 1305, 1306, 1307, 1308,
+	1309, 1310, 1311, 1312, 1313, 1314, 1315, 1173, 1144, 1145,
+	1106, 1327, 1328, 1109, 2120, 1132, 1210, 1211, 1250, 1665,
+	1226, 1079, 2249, 2598, 1233, 1336, 1249, 738, 1089, 1141,
+	1142, 1143, 1860, 3021, 1591, 457, 1107, 1108, 1385, 1110,
+	1111, 1112, 1158, 441, 1260, 1375, 1376, 1377, 2390, 1715,
+	1665, 2579, 1714, 771, 1394, 1804, 1281, 771, 1391, 1171,
+	1370, 1392, 1181, 1172, 1182, 1082, 1086, 1086, 1086, 3004,
+	1993, 1882, 2996, 1399, 1400, 1267, 2980, 1925, 1193, 1215,
+	2969, 1218, 1739, 788, 789, 790, 1284, 747, 1082, 1082,
+	930, 931, 932, 929, 1299, 1262, 2497, 2938, 1415, 1278,
+	2002, 1925, 1665, 1237, 1993, 436, 1925, 1441, 1150, 1445,
+	740, 1447, 1448, 739, 456, 2924, 436, 2120, 453, 698,
+	454, 928, 1457, 1259, 455, 654, 1150, 1256, 2888, 1255,
+	654, 2086, 1127, 1242, 1238, 2083, 2337, 452, 1665, 1743,
+	1990, 928, 1418, 651, 1258, 2981, 1257, 1948, 1254, 928,
+	1656, 1275, 1842, 1083, 510, 2883, 1480, 1589, 2831, 1384,
+	1280, 1266, 2830, 2825, 1486, 1486, 2939, 1127, 1744, 1127,
+	1127, 1719, 890, 436, 1484, 1441, 1441, 1325, 1326, 1150,
+	1536, 1548, 1647, 1438, 2925, 1473, 425, 2822, 1150, 2821,
+	1318, 2820, 2819, 2787, 1452, 1570, 2636, 2788, 893, 1440,
+	1479, 2597, 1068, 1482, 1483, 2471, 1449, 1450, 1451, 930,
+	931, 932, 929, 1446, 436, 1441, 1150, 1330, 1581, 436,
+	436, 1584, 1367, 1368, 2788, 1371, 1587, 2832, 1135, 3018,
+	1593, 1823, 2598, 1386, 891, 2982, 2243, 173, 2594, 2062,
+	173, 173, 2406, 173, 928, 891, 1393, 2018, 1395, 1026,
+	2261, 1492, 2000, 1994, 1084, 1742, 2788, 2647, 2788, 1488,
+	2788, 2788, 2788, 1992, 1444, 1948, 1396, 1532, 1533, 2639,
+	2598, 1422, 2122, 1569, 2472, 859, 1987, 1980, 1385, 1385,
+	1635, 1978, 1462, 2005, 1976, 1385, 1385, 1974, 1554, 2004,
+	1642, 1416, 1577, 1460, 1461, 1996, 1839, 1577, 1577, 1559,
+	1602, 1710, 1695, 1605, 1606, 1925, 1608, 1578, 928, 1822,
+	1582, 1583, 1740, 1646, 1457, 945, 928, 1723, 1150, 1654,
+	1580, 1823, 1988, 1596, 1454, 796, 797, 1722, 1437, 1453,
+	801, 1713, 1993, 1239, 1489, 1444, 1704, 1464, 1694, 974,
+	1478, 1490, 1491, 879, 1470, 1988, 1981, 859, 854, 2410,
+	1979, 852, 2429, 1975, 1648, 1469, 1975, 1471, 1472, 1703,
+	1567, 1568, 1374, 1373, 930, 931, 932, 929, 1636, 1702,
+	1477, 1487, 1278, 2474, 1664, 1681, 1682, 2439, 1823, 2401,
+	2299, 1739, 1677, 1535, 1537, 1243, 928, 1555, 1936, 2864,
+	2432, 1564, 1565, 1566, 1465, 1466, 928, 2427, 1575, 1630,
+	928, 1137, 2442, 2443, 3013, 928, 1630, 457, 2428, 1579,
+	1693, 1475, 1476, 943, 953, 954, 946, 947, 948, 949,
+	950, 951, 952, 945, 771, 1599, 2713, 1083, 928, 2586,
+	664, 771, 1597, 2865, 1082, 1139, 2584, 1073, 928, 2402,
+	3001, 1074, 768, 1665, 770, 2433, 1140, 1879, 1474, 768,
+	2498, 770, 1966, 1616, 1244, 1600, 1405, 859, 2477, 1086,
+	1720, 948, 949, 950, 951, 952, 945, 1727, 2473, 2311,
+	2714, 1639, 2208, 2587, 1637, 1991, 1939, 1640, 1645, 1641,
+	2585, 868, 2522, 2403, 1136, 508, 456, 866, 1798, 2025,
+	453, 1960, 454, 1333, 1333, 1690, 455, 1185, 2263, 1600,
+	436, 436, 436, 1649, 1820, 1644, 946, 947, 948, 949,
+	950, 951, 952, 945, 1827, 1127, 771, 936, 937, 938,
+	939, 940, 941, 942, 934, 1832, 932, 929, 1084, 1685,
+	1439, 2907, 929, 2875, 768, 1676, 770, 2620, 2441, 1127,
+	1854, 1679, 1680, 2619, 2280, 2158, 669, 866, 1866, 1324,
+	2157, 1678, 2151, 2149, 2601, 1318, 930, 931, 932, 929,
+	2998, 1829, 1830, 3032, 1689, 1321, 1323, 1320, 2435, 1322,
+	1389, 1833, 1834, 930, 931, 932, 929, 3025, 1812, 1813,
+	1814, 1390, 2988, 2747, 2525, 930, 931, 932, 929, 2983,
+	2434, 2436, 2923, 1185, 1929, 1929, 1548, 1929, 539, 548,
+	2954, 1844, 2673, 1831, 540, 2997, 547, 541, 545, 544,
+	542, 543, 2898, 866, 930, 931, 932, 929, 2645, 2866,
+	1150, 436, 1799, 2748, 2198, 1970, 2804, 1766, 2196, 930,
+	931, 932, 929, 2194, 1846, 2762, 866, 431, 2523, 2745,
+	1180, 503, 1548, 2722, 505, 1955, 1737, 1957, 2716, 504,
+	2218, 173, 930, 931, 932, 929, 1881, 2715, 2646, 549,
+	2644, 1752, 2588, 1806, 2197, 2489, 933, 2444, 2195, 1933,
+	1931, 2295, 1935, 2193, 2275, 962, 1841, 2274, 2184, 2430,
+	2182, 2181, 2180, 971, 1952, 2440, 2177, 1828, 467, 996,
+	1944, 546, 2171, 1959, 2168, 2167, 1619, 1998, 1618, 1156,
+	1654, 930, 931, 932, 929, 976, 1840, 1150, 1617, 1150,
+	2027, 1150, 1613, 1612, 1961, 1954, 866, 1880, 2183, 1883,
+	1884, 1885, 1886, 1838, 1874, 1889, 1890, 1891, 1892, 1893,
+	1894, 1895, 1896, 1897, 1898, 1899, 1900, 1901, 1902, 1240,
+	1904, 1043, 771, 1746, 1747, 1150, 2043, 2857, 1836, 1909,
+	2368, 1837, 2812, 997, 930, 931, 932, 929, 2950, 2908,
+	768, 2050, 770, 1962, 2835, 2786, 1150, 2763, 2330, 2052,
+	930, 931, 932, 929, 2701, 2676, 1766, 2675, 2671, 2008,
+	1940, 1941, 1942, 1945, 930, 931, 932, 929, 2669, 1692,
+	2643, 2042, 1706, 1186, 1835, 2642, 1950, 2641, 1953, 930,
+	931, 932, 929, 2638, 2203, 2054, 2603, 2569, 866, 2049,
+	2568, 1951, 2051, 2567, 2329, 1148, 2566, 2560, 1397, 1398,
+	2029, 1698, 1401, 1402, 1403, 1404, 1406, 1407, 1408, 1409,
+	1410, 1411, 1412, 1413, 2009, 2553, 1148, 930, 931, 932,
+	929, 2681, 1086, 2547, 2016, 2023, 1705, 930, 931, 932,
+	929, 2775, 2680, 2492, 2073, 1150, 2012, 2001, 2098, 1999,
+	2478, 2006, 1441, 1278, 930, 931, 932, 929, 2118, 930,
+	931, 932, 929, 2467, 2124, 930, 931, 932, 929, 2466,
+	3012, 2360, 2019, 2020, 2629, 2359, 930, 931, 932, 929,
+	2133, 2273, 2254, 2185, 2087, 2033, 866, 2178, 930, 931,
+	932, 929, 2174, 2173, 2022, 2148, 2172, 930, 931, 932,
+	929, 1741, 1635, 1621, 2154, 2155, 2156, 596, 595, 3006,
+	1635, 1635, 2162, 866, 1615, 866, 866, 2127, 2166, 1428,
+	1241, 2129, 1004, 2550, 1000, 999, 975, 2077, 2581, 1179,
+	1179, 855, 1929, 1205, 2115, 2580, 2116, 2074, 2084, 2578,
+	2552, 2537, 2199, 2528, 2108, 866, 930, 931, 932, 929,
+	2527, 2107, 1441, 866, 1548, 1548, 1548, 1548, 1210, 1211,
+	2333, 2516, 2515, 2411, 2335, 866, 1548, 2143, 2328, 1929,
+	2125, 2332, 156, 2139, 2320, 148, 125, 2146, 1150, 2315,
+	2258, 2146, 2085, 930, 931, 932, 929, 2147, 2082, 436,
+	436, 1977, 2096, 1973, 930, 931, 932, 929, 1972, 2117,
+	2163, 1444, 2092, 173, 1728, 8, 1718, 2123, 173, 2058,
+	7, 1716, 1712, 1711, 2063, 1215, 1709, 1218, 1700, 1697,
+	1696, 1620, 2135, 1414, 2239, 2140, 1388, 1387, 2144, 1385,
+	153, 1385, 2153, 2150, 2290, 2331, 1378, 2294, 1162, 2071,
+	2159, 2161, 1160, 1150, 156, 2995, 2301, 2992, 2990, 2897,
+	2874, 2833, 1150, 994, 1200, 2164, 2165, 2179, 930, 931,
+	932, 929, 930, 931, 932, 929, 2264, 2256, 2257, 2738,
+	2726, 2268, 2723, 1289, 1290, 1291, 1292, 1293, 2205, 2657,
+	2655, 2169, 2170, 2630, 2240, 2204, 2627, 2175, 2176, 2626,
+	2238, 1418, 2623, 2226, 2242, 2622, 2289, 2255, 2132, 2616,
+	2241, 2252, 153, 2572, 651, 2226, 2206, 2227, 2228, 2229,
+	2230, 1209, 1202, 1071, 2323, 2287, 2325, 1334, 1335, 2262,
+	2266, 2293, 2307, 1369, 2200, 2152, 2265, 866, 2034, 2138,
+	2112, 1379, 2303, 2371, 2111, 2126, 2110, 2283, 2383, 1214,
+	436, 2285, 2281, 2130, 2131, 2288, 1217, 2298, 2292, 1206,
+	2286, 866, 866, 866, 2297, 2072, 851, 848, 849, 850,
+	1548, 1820, 2039, 2409, 2038, 2037, 2035, 2312, 1986, 2413,
+	2313, 2251, 1419, 1938, 771, 1903, 1423, 866, 1866, 1426,
+	2128, 771, 1821, 866, 2319, 1319, 2447, 153, 2450, 1585,
+	2450, 2450, 1436, 2326, 2327, 1435, 1263, 1229, 2455, 2321,
+	2322, 2070, 2362, 1207, 1027, 866, 655, 656, 657, 658,
+	1150, 1150, 2412, 2069, 2324, 1024, 2414, 2415, 2386, 654,
+	1023, 2356, 1022, 1021, 930, 931, 932, 929, 2361, 2372,
+	2036, 1844, 2364, 2407, 1020, 1019, 930, 931, 932, 929,
+	1018, 436, 2366, 551, 110, 2388, 2107, 2371, 2387, 110,
+	2408, 2397, 2398, 2445, 2446, 1017, 2464, 2465, 2404, 1016,
+	1015, 1014, 1441, 1441, 1846, 1013, 1012, 1766, 2624, 1161,
+	2068, 2458, 1011, 1010, 1009, 771, 1008, 1007, 1003, 1002,
+	1148, 1148, 2067, 1419, 1001, 2453, 2451, 2452, 2417, 1419,
+	1419, 2066, 2459, 930, 931, 932, 929, 442, 998, 991,
+	110, 990, 2043, 2065, 988, 930, 931, 932, 929, 987,
+	2496, 2526, 986, 985, 930, 931, 932, 929, 984, 1577,
+	983, 771, 982, 2423, 981, 2508, 930, 931, 932, 929,
+	1601, 980, 2470, 1604, 2494, 2495, 1607, 2476, 979, 1609,
+	2475, 2340, 2064, 978, 977, 2341, 2342, 2343, 2344, 436,
+	2345, 2346, 2347, 2348, 2349, 2350, 2351, 2352, 2505, 973,
+	972, 895, 853, 2040, 2041, 930, 931, 932, 929, 2501,
+	2502, 1826, 2509, 2512, 2513, 2514, 1809, 883, 2507, 2934,
+	2932, 2879, 2061, 2504, 2521, 944, 943, 953, 954, 946,
+	947, 948, 949, 950, 951, 952, 945, 769, 2099, 1949,
+	1623, 110, 894, 2506, 1717, 930, 931, 932, 929, 2235,
+	2538, 2232, 2060, 2660, 2236, 2659, 110, 2539, 110, 2231,
+	1122, 2541, 1124, 2721, 1128, 1129, 1441, 2545, 2554, 96,
+	52, 1995, 2576, 2577, 2546, 930, 931, 932, 929, 2416,
+	2540, 2059, 51, 2233, 433, 1929, 1548, 2591, 2234, 1989,
+	2658, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 2357,
+	2358, 2599, 1175, 2079, 930, 931, 932, 929, 1531, 2365,
+	2565, 2602, 2852, 1194, 1150, 1687, 1984, 2556, 1691, 1746,
+	1747, 2558, 2559, 438, 439, 436, 2237, 2563, 1921, 1922,
+	2141, 2013, 2142, 2592, 2447, 437, 440, 2593, 2571, 2595,
+	1029, 866, 2596, 2055, 2570, 944, 943, 953, 954, 946,
+	947, 948, 949, 950, 951, 952, 945, 1701, 1223, 2590,
+	2589, 1800, 2543, 1586, 889, 1708, 930, 931, 932, 929,
+	2134, 2088, 1816, 2941, 1441, 2445, 2544, 2600, 866, 1455,
+	1434, 1374, 1373, 1721, 2605, 1907, 1724, 1725, 1726, 1534,
+	2046, 1729, 1730, 1731, 1732, 1733, 1734, 1735, 1736, 1121,
+	2024, 1120, 2628, 2621, 173, 1041, 1042, 2632, 1329, 1039,
+	1040, 2651, 2662, 930, 931, 932, 929, 866, 921, 2637,
+	1037, 1038, 2640, 930, 931, 932, 929, 1031, 2573, 2574,
+	2575, 930, 931, 932, 929, 1035, 1036, 2511, 2652, 1643,
+	1075, 3007, 2916, 2653, 1824, 2904, 2902, 2860, 2689, 655,
+	656, 657, 658, 866, 1150, 1150, 2845, 2844, 2842, 2834,
+	866, 2757, 654, 2260, 2756, 2670, 2650, 2664, 2555, 2535,
+	2706, 2534, 2519, 2706, 1034, 654, 2674, 2518, 1459, 2936,
+	2935, 2633, 953, 954, 946, 947, 948, 949, 950, 951,
+	952, 945, 2690, 2296, 1811, 1699, 880, 2935, 2694, 2936,
+	2699, 2618, 2536, 866, 866, 1090, 2710, 866, 866, 60,
+	2709, 2, 436, 2743, 2707, 2717, 2718, 2700, 2226, 2593,
+	160, 3, 2719, 1571, 1148, 2605, 1154, 1, 1427, 659,
+	2244, 2245, 2510, 110, 110, 769, 1457, 1912, 2754, 2247,
+	2724, 1661, 1905, 1801, 2382, 1066, 2758, 2759, 2735, 1419,
+	1419, 1419, 691, 1380, 2751, 2727, 2728, 2226, 1248, 2736,
+	2737, 1917, 1920, 1921, 1922, 1918, 785, 1919, 1923, 875,
+	1245, 874, 2783, 872, 1179, 1331, 2752, 553, 1626, 1917,
+	1920, 1921, 1922, 1918, 2791, 1919, 1923, 2201, 2753, 2940,
+	2744, 2977, 2896, 2697, 2943, 1261, 537, 2795, 2836, 2767,
+	2900, 2769, 2678, 1666, 961, 926, 2282, 866, 712, 2781,
+	589, 564, 2809, 989, 2789, 1231, 1224, 2338, 787, 866,
+	563, 2649, 2213, 2798, 680, 784, 2797, 2796, 713, 1610,
+	2765, 1195, 2805, 1216, 1199, 2711, 2582, 2399, 2814, 2113,
+	2870, 2720, 3005, 2697, 2697, 2921, 700, 2697, 2697, 3029,
+	2958, 2993, 2685, 2683, 2684, 2986, 2828, 2917, 474, 2818,
+	1551, 423, 751, 2739, 1622, 2376, 2094, 2093, 475, 866,
+	1825, 2823, 2909, 2725, 678, 2026, 2846, 1808, 2682, 2841,
+	2839, 679, 2105, 2044, 2045, 2104, 2861, 1300, 935, 1317,
+	2856, 2047, 2048, 2353, 2354, 2855, 970, 513, 1688, 2869,
+	525, 2210, 2438, 2253, 2053, 59, 2862, 58, 2867, 738,
+	57, 56, 1592, 2891, 2894, 2868, 181, 555, 180, 2893,
+	2945, 535, 534, 533, 1419, 532, 531, 2075, 2076, 1426,
+	1916, 2895, 2884, 2885, 2886, 2887, 1914, 1033, 1913, 2903,
+	1543, 2905, 2906, 2901, 2899, 1542, 1590, 2697, 2461, 1494,
+	1876, 1862, 1493, 2926, 2876, 2815, 2816, 2615, 2915, 2697,
+	2186, 2611, 2607, 2468, 2705, 2424, 2425, 2929, 2431, 1815,
+	810, 806, 808, 809, 807, 2032, 2933, 2028, 2947, 2931,
+	2930, 1848, 1847, 2394, 1757, 1756, 2946, 1754, 2937, 1753,
+	1049, 2782, 740, 2557, 1764, 739, 1762, 866, 2503, 2499,
+	2384, 2951, 1424, 2078, 1544, 1540, 2952, 1910, 1810, 2697,
+	2702, 87, 86, 2961, 2963, 94, 137, 46, 2809, 2966,
+	2976, 2968, 2967, 165, 164, 167, 2975, 2979, 166, 725,
+	163, 1963, 1964, 162, 1183, 161, 2984, 2708, 866, 701,
+	648, 2985, 2989, 37, 2991, 33, 12, 11, 34, 21,
+	1159, 22, 20, 1252, 19, 442, 25, 32, 31, 2869,
+	3026, 30, 2947, 3003, 103, 102, 703, 29, 101, 100,
+	2946, 866, 3002, 866, 3009, 99, 3011, 3014, 98, 110,
+	28, 18, 41, 40, 39, 9, 2979, 93, 3015, 3019,
+	91, 27, 3020, 866, 92, 89, 3024, 90, 88, 3027,
+	71, 70, 69, 84, 156, 83, 49, 148, 125, 82,
+	944, 943, 953, 954, 946, 947, 948, 949, 950, 951,
+	952, 945, 81, 80, 149, 79, 77, 78, 711, 724,
+	723, 141, 68, 67, 66, 150, 65, 2956, 64, 75,
+	110, 108, 85, 76, 110, 74, 73, 72, 722, 63,
+	2953, 2267, 62, 2269, 61, 110, 97, 699, 122, 123,
+	121, 120, 153, 119, 110, 118, 117, 116, 702, 733,
+	42, 1419, 43, 44, 45, 956, 1419, 960, 1284, 133,
+	132, 134, 136, 138, 135, 130, 128, 131, 129, 127,
+	54, 17, 729, 957, 959, 955, 24, 958, 944, 943,
+	953, 954, 946, 947, 948, 949, 950, 951, 952, 945,
+	4, 1284, 2314, 1284, 826, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 730, 734, 3010, 0, 0, 0,
+	0, 1358, 0, 1284, 2334, 112, 113, 0, 114, 115,
+	3008, 719, 0, 717, 721, 737, 0, 0, 0, 718,
+	715, 714, 0, 720, 705, 706, 704, 707, 708, 709,
+	710, 0, 735, 736, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 731, 732, 944, 943, 953, 954,
+	946, 947, 948, 949, 950, 951, 952, 945, 0, 0,
+	944, 943, 953, 954, 946, 947, 948, 949, 950, 951,
+	952, 945, 0, 124, 147, 154, 0, 95, 0, 0,
+	0, 727, 0, 0, 0, 0, 0, 0, 814, 0,
+	0, 0, 0, 0, 0, 0, 0, 146, 140, 139,
+	0, 0, 0, 0, 55, 0, 0, 2454, 834, 838,
+	840, 842, 844, 845, 847, 0, 851, 848, 849, 850,
+	0, 0, 829, 830, 831, 832, 812, 813, 835, 0,
+	815, 0, 816, 817, 818, 819, 820, 821, 822, 823,
+	824, 825, 827, 833, 2336, 0, 0, 0, 0, 0,
+	726, 837, 839, 841, 843, 846, 0, 0, 0, 0,
+	1354, 0, 0, 0, 1351, 142, 143, 144, 1353, 1350,
+	1352, 1356, 1357, 0, 0, 0, 1355, 0, 0, 0,
+	0, 0, 0, 0, 1547, 930, 931, 932, 929, 0,
+	828, 0, 0, 151, 944, 943, 953, 954, 946, 947,
+	948, 949, 950, 951, 952, 945, 0, 0, 0, 0,
+	0, 104, 0, 0, 0, 145, 0, 105, 0, 0,
+	0, 0, 0, 2021, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	110, 0, 826, 110, 110, 0, 110, 944, 943, 953,
+	954, 946, 947, 948, 949, 950, 951, 952, 945, 0,
+	0, 0, 0, 0, 1358, 0, 0, 0, 0, 0,
+	106, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	48, 769, 0, 0, 0, 2549, 0, 0, 769, 0,
+	0, 0, 2551, 0, 0, 0, 0, 110, 0, 0,
+	0, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347,
+	1348, 1349, 1361, 1362, 1363, 1364, 1365, 1366, 1359, 1360,
+	0, 0, 0, 2030, 2031, 0, 0, 0, 50, 0,
+	0, 0, 0, 0, 0, 0, 826, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 814, 0, 0, 0,
+	804, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 126, 0, 0, 0, 0, 834, 838, 840, 842,
+	844, 845, 847, 961, 851, 848, 849, 850, 0, 0,
+	829, 830, 831, 832, 812, 813, 835, 0, 815, 686,
+	816, 817, 818, 819, 820, 821, 822, 823, 824, 825,
+	827, 833, 0, 0, 0, 0, 0, 0, 0, 837,
+	839, 841, 843, 846, 0, 107, 38, 0, 0, 0,
+	0, 0, 47, 1354, 0, 0, 111, 1351, 0, 0,
+	0, 1353, 1350, 1352, 1356, 1357, 0, 0, 0, 1355,
+	814, 0, 0, 0, 0, 0, 0, 0, 828, 836,
+	0, 0, 0, 1419, 0, 0, 2654, 0, 0, 2656,
+	834, 838, 840, 842, 844, 845, 847, 0, 851, 848,
+	849, 850, 0, 2661, 829, 830, 831, 832, 812, 813,
+	835, 0, 815, 0, 816, 817, 818, 819, 820, 821,
+	822, 823, 824, 825, 827, 833, 0, 0, 0, 0,
+	0, 0, 0, 837, 839, 841, 843, 846, 0, 0,
+	0, 0, 1528, 0, 0, 0, 0, 688, 0, 683,
+	0, 673, 0, 0, 0, 0, 0, 0, 685, 684,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 828, 0, 0, 671, 1531, 0, 0, 0,
+	0, 0, 677, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1339, 1340, 1341, 1342, 1343, 1344,
+	1345, 1346, 1347, 1348, 1349, 1361, 1362, 1363, 1364, 1365,
+	1366, 1359, 1360, 1528, 1510, 0, 0, 0, 0, 0,
+	0, 0, 0, 682, 0, 0, 0, 681, 0, 1932,
+	0, 0, 0, 670, 1686, 0, 0, 676, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1531, 0, 0,
+	0, 0, 0, 0, 0, 0, 674, 0, 944, 943,
+	953, 954, 946, 947, 948, 949, 950, 951, 952, 945,
+	2780, 0, 0, 0, 0, 1547, 0, 672, 0, 0,
+	0, 0, 0, 2790, 110, 1510, 0, 1528, 0, 0,
+	0, 689, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2813, 0,
+	0, 0, 0, 0, 0, 675, 0, 0, 0, 0,
+	0, 1531, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 836, 0, 0,
+	0, 0, 0, 0, 0, 0, 1504, 1497, 1503, 2808,
+	1496, 0, 1501, 1502, 0, 0, 0, 1514, 1528, 1510,
+	0, 0, 2780, 0, 0, 0, 0, 0, 1518, 0,
+	0, 0, 0, 0, 0, 0, 1498, 0, 0, 1499,
+	0, 0, 0, 0, 1500, 0, 687, 0, 0, 0,
+	0, 1507, 1531, 0, 0, 1509, 1511, 1513, 0, 1515,
+	1516, 1517, 1519, 1520, 1521, 1523, 1524, 1525, 1526, 0,
+	0, 0, 0, 0, 0, 0, 0, 1504, 2137, 1503,
+	0, 2136, 0, 1501, 1502, 0, 0, 0, 1514, 0,
+	1510, 836, 0, 0, 0, 0, 0, 0, 0, 1518,
+	0, 0, 0, 0, 0, 0, 0, 0, 1529, 1530,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1507, 0, 0, 0, 1509, 1511, 1513, 0,
+	1515, 1516, 1517, 1519, 1520, 1521, 1523, 1524, 1525, 1526,
+	0, 0, 0, 0, 0, 0, 1527, 0, 0, 0,
+	2794, 0, 2780, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1514, 1506, 0, 110, 0, 0, 0, 0,
+	0, 0, 0, 1518, 0, 0, 0, 0, 0, 1529,
+	1530, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1522, 0, 0, 0, 1507, 0, 0, 1512,
+	1509, 1511, 1513, 0, 1515, 1516, 1517, 1519, 1520, 1521,
+	1523, 1524, 1525, 1526, 0, 0, 0, 1527, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1514, 1506, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1518, 0, 3017, 0, 0, 0,
+	0, 0, 0, 1529, 1530, 0, 0, 1547, 1547, 1547,
+	1547, 0, 0, 1522, 0, 0, 0, 1507, 0, 1547,
+	1512, 1509, 1511, 1513, 0, 1515, 1516, 1517, 1519, 1520,
+	1521, 1523, 1524, 1525, 1526, 0, 0, 0, 0, 0,
+	0, 1527, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 110, 0, 1506, 0,
+	0, 110, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1529, 1530, 0, 0, 0, 0,
+	0, 110, 0, 0, 0, 0, 0, 1522, 110, 0,
+	0, 0, 0, 0, 1512, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1527, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1506,
+	0, 355, 571, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 527, 0, 0, 1522, 262,
+	0, 0, 287, 0, 0, 1512, 228, 562, 0, 0,
+	347, 301, 0, 0, 0, 0, 619, 627, 0, 0,
+	0, 0, 110, 0, 0, 0, 0, 0, 520, 0,
+	0, 552, 596, 595, 539, 548, 0, 0, 244, 179,
+	540, 0, 547, 541, 545, 544, 542, 543, 0, 611,
+	0, 0, 0, 1547, 0, 0, 511, 524, 2777, 528,
 	0, 0, 0, 0, 0, 0, 0, 0, 110, 0,
-	0, 0, 0, 0, 2681, 0, 807, 0, 1493, 0,
-	797, 0, 1495, 1497, 1499, 0, 1501, 1502, 1503, 1505,
-	1506, 1507, 1509, 1510, 1511, 1512, 827, 831, 833, 835,
-	837, 838, 840, 0, 844, 841, 842, 843, 0, 0,
-	822, 823, 824, 825, 805, 806, 828, 0, 808, 0,
-	809, 810, 811, 812, 813, 814, 815, 816, 817, 818,
-	820, 826, 0, 0, 1515, 0, 0, 0, 0, 830,
-	832, 834, 836, 839, 0, 0, 0, 0, 1532, 1532,
-	1532, 1532, 0, 0, 0, 0, 0, 2887, 0, 0,
-	1532, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1513, 0, 0, 0, 0, 821, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1492, 0,
-	0, 0, 0, 0, 0, 0, 0, 110, 0, 0,
-	0, 0, 110, 470, 472, 471, 0, 0, 0, 0,
-	0, 0, 0, 476, 0, 0, 0, 1508, 0, 0,
-	0, 0, 110, 0, 1498, 480, 0, 0, 0, 110,
-	0, 0, 495, 0, 0, 0, 0, 0, 0, 473,
-	0, 0, 0, 0, 0, 352, 564, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 521, 522, 0, 0, 0, 0, 572,
+	0, 523, 0, 0, 567, 549, 550, 0, 0, 0,
+	0, 235, 352, 368, 245, 343, 383, 250, 350, 240,
+	317, 340, 0, 0, 237, 366, 349, 298, 281, 282,
+	236, 0, 335, 260, 274, 257, 315, 546, 570, 574,
+	256, 633, 568, 376, 239, 0, 375, 314, 362, 367,
+	299, 293, 238, 364, 297, 292, 285, 264, 634, 278,
+	326, 291, 327, 279, 304, 303, 305, 0, 0, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 302, 0, 565, 0,
+	0, 0, 379, 0, 0, 617, 0, 0, 0, 351,
+	0, 0, 286, 0, 0, 0, 569, 0, 338, 320,
+	630, 512, 0, 336, 289, 363, 328, 369, 267, 377,
+	380, 353, 378, 332, 329, 230, 354, 259, 300, 241,
+	243, 255, 261, 263, 265, 266, 310, 311, 323, 342,
+	356, 357, 358, 258, 251, 337, 252, 276, 253, 231,
+	344, 254, 233, 324, 361, 0, 272, 333, 296, 234,
+	295, 325, 360, 359, 242, 387, 393, 394, 399, 0,
+	400, 0, 0, 0, 408, 413, 414, 415, 417, 418,
+	419, 422, 420, 421, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 392, 270, 226, 227, 429,
+	615, 316, 0, 0, 629, 610, 612, 613, 616, 620,
+	621, 622, 623, 624, 626, 628, 632, 428, 0, 0,
+	0, 0, 0, 427, 322, 0, 341, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 348,
+	371, 385, 403, 406, 0, 0, 0, 232, 405, 1547,
+	2778, 0, 0, 0, 2779, 0, 631, 0, 0, 0,
+	384, 0, 0, 0, 0, 0, 573, 306, 307, 308,
+	309, 618, 0, 249, 404, 331, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 397, 398, 269, 275, 416, 277, 248, 321,
+	271, 382, 283, 0, 409, 0, 410, 0, 0, 0,
+	0, 313, 280, 345, 284, 290, 334, 381, 319, 339,
+	246, 370, 346, 294, 0, 0, 640, 614, 639, 641,
+	642, 638, 643, 644, 625, 530, 0, 577, 636, 635,
+	637, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 288, 110, 330, 268,
+	603, 582, 583, 584, 529, 585, 580, 581, 604, 575,
+	600, 601, 554, 578, 586, 599, 587, 602, 605, 606,
+	645, 646, 593, 647, 590, 607, 598, 597, 588, 576,
+	608, 609, 561, 556, 591, 592, 579, 594, 557, 558,
+	559, 560, 355, 571, 0, 388, 389, 390, 412, 372,
+	0, 426, 0, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 527, 0, 0, 0,
+	262, 0, 0, 287, 0, 0, 0, 228, 562, 0,
+	0, 347, 301, 0, 0, 0, 0, 619, 627, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 520,
-	0, 0, 0, 261, 0, 0, 285, 0, 0, 0,
-	555, 0, 0, 344, 299, 0, 0, 0, 0, 612,
-	620, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 513, 0, 0, 545, 589, 588, 532, 541, 0,
-	0, 243, 179, 533, 0, 540, 534, 538, 537, 535,
-	536, 0, 604, 110, 0, 0, 0, 0, 0, 504,
-	517, 2678, 521, 0, 0, 0, 0, 0, 475, 479,
-	485, 0, 486, 488, 0, 0, 489, 490, 491, 0,
-	0, 493, 494, 0, 0, 0, 514, 515, 1532, 0,
-	0, 0, 565, 0, 516, 0, 0, 560, 542, 543,
-	0, 0, 0, 110, 234, 349, 365, 244, 340, 378,
-	249, 347, 239, 314, 337, 0, 0, 236, 363, 346,
-	296, 279, 280, 235, 0, 332, 259, 272, 256, 312,
-	539, 563, 567, 255, 626, 561, 373, 238, 0, 372,
-	311, 359, 364, 297, 291, 237, 361, 295, 290, 283,
-	263, 627, 276, 323, 289, 324, 277, 301, 300, 302,
-	829, 0, 0, 0, 0, 402, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 558,
-	0, 0, 0, 375, 0, 0, 610, 0, 0, 0,
-	348, 0, 0, 284, 0, 0, 0, 562, 0, 335,
-	317, 623, 505, 0, 333, 287, 360, 325, 366, 350,
-	374, 329, 326, 229, 351, 258, 298, 240, 242, 254,
-	260, 262, 264, 265, 307, 308, 320, 339, 353, 354,
-	355, 257, 250, 334, 251, 274, 252, 230, 341, 253,
-	232, 321, 358, 0, 270, 330, 294, 233, 293, 322,
-	357, 356, 241, 382, 388, 389, 394, 0, 395, 0,
-	0, 0, 403, 408, 409, 410, 412, 413, 414, 415,
-	0, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	0, 387, 268, 226, 227, 422, 608, 313, 0, 0,
-	622, 603, 605, 606, 609, 613, 614, 615, 616, 617,
-	619, 621, 625, 421, 0, 0, 0, 0, 0, 420,
-	319, 0, 338, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 345, 368, 380, 398, 401,
-	0, 0, 0, 231, 400, 0, 2679, 0, 0, 0,
-	2680, 0, 624, 0, 0, 0, 379, 0, 0, 0,
-	0, 1532, 566, 303, 304, 305, 306, 611, 0, 248,
-	399, 328, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 392, 393,
-	267, 273, 411, 275, 247, 318, 269, 377, 281, 0,
-	404, 0, 405, 0, 0, 0, 0, 310, 278, 342,
-	282, 288, 331, 376, 316, 336, 245, 367, 343, 292,
-	0, 0, 633, 607, 632, 634, 635, 631, 636, 637,
-	618, 523, 0, 570, 629, 628, 630, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 110, 0, 0, 0, 0,
-	228, 0, 286, 0, 327, 266, 596, 575, 576, 577,
-	522, 578, 573, 574, 597, 568, 593, 594, 547, 571,
-	579, 592, 580, 595, 598, 599, 638, 639, 586, 640,
-	583, 600, 591, 590, 581, 569, 601, 602, 554, 549,
-	584, 585, 572, 587, 550, 551, 552, 553, 352, 564,
-	0, 383, 384, 385, 407, 369, 0, 419, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 520, 0, 0, 0, 261, 0, 0, 285,
-	0, 0, 0, 555, 0, 0, 344, 299, 0, 0,
-	0, 0, 612, 620, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 513, 0, 0, 545, 589, 588,
-	532, 541, 0, 0, 243, 179, 533, 0, 540, 534,
-	538, 537, 535, 536, 949, 604, 953, 0, 0, 0,
-	0, 0, 504, 517, 0, 521, 0, 0, 0, 0,
-	0, 0, 950, 952, 948, 0, 951, 937, 936, 946,
-	947, 939, 940, 941, 942, 943, 944, 945, 938, 514,
-	515, 0, 0, 0, 0, 565, 0, 516, 0, 0,
-	560, 542, 543, 0, 0, 0, 0, 234, 349, 365,
-	244, 340, 378, 249, 347, 239, 314, 337, 0, 0,
-	236, 363, 346, 296, 279, 280, 235, 0, 332, 259,
-	272, 256, 312, 539, 563, 567, 255, 626, 561, 373,
-	238, 0, 372, 311, 359, 364, 297, 291, 237, 361,
-	295, 290, 283, 263, 627, 276, 323, 289, 324, 277,
-	301, 300, 302, 0, 0, 0, 0, 0, 402, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 558, 0, 0, 0, 375, 0, 0, 610,
-	0, 0, 0, 348, 0, 0, 284, 0, 0, 0,
-	562, 0, 335, 317, 623, 505, 0, 333, 287, 360,
-	325, 366, 350, 374, 329, 326, 229, 351, 258, 298,
-	240, 242, 254, 260, 262, 264, 265, 307, 308, 320,
-	339, 353, 354, 355, 257, 250, 334, 251, 274, 252,
-	230, 341, 253, 232, 321, 358, 0, 270, 330, 294,
-	233, 293, 322, 357, 356, 241, 382, 388, 389, 394,
-	0, 395, 0, 0, 0, 403, 408, 409, 410, 412,
-	413, 414, 415, 0, 0, 0, 0, 397, 0, 0,
-	0, 1375, 1374, 1376, 387, 268, 226, 227, 422, 608,
-	313, 0, 0, 622, 603, 605, 606, 609, 613, 614,
-	615, 616, 617, 619, 621, 625, 421, 0, 0, 0,
-	0, 0, 420, 319, 0, 338, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 345, 368,
-	380, 398, 401, 0, 0, 0, 231, 400, 0, 0,
-	0, 0, 0, 0, 0, 624, 0, 0, 0, 379,
-	0, 0, 0, 0, 0, 566, 303, 304, 305, 306,
-	611, 0, 248, 399, 328, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 392, 393, 267, 273, 411, 275, 247, 318, 269,
-	377, 281, 0, 404, 0, 405, 0, 0, 0, 0,
-	310, 278, 342, 282, 288, 331, 376, 316, 336, 245,
-	367, 343, 292, 0, 0, 633, 607, 632, 634, 635,
-	631, 636, 637, 618, 523, 0, 570, 629, 628, 630,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 286, 0, 327, 266, 596,
-	575, 576, 577, 522, 578, 573, 574, 597, 568, 593,
-	594, 547, 571, 579, 592, 580, 595, 598, 599, 638,
-	639, 586, 640, 583, 600, 591, 590, 581, 569, 601,
-	602, 554, 549, 584, 585, 572, 587, 550, 551, 552,
-	553, 352, 564, 0, 383, 384, 385, 407, 369, 0,
-	419, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 520, 0, 0, 0, 261,
-	0, 0, 285, 0, 0, 0, 555, 0, 0, 344,
-	299, 0, 0, 0, 0, 612, 620, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 513, 0, 0,
-	545, 589, 588, 532, 541, 0, 0, 243, 179, 533,
-	0, 540, 534, 538, 537, 535, 536, 0, 604, 0,
-	0, 0, 0, 0, 0, 504, 517, 0, 521, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 514, 515, 0, 0, 0, 0, 565, 0,
-	516, 0, 0, 560, 542, 543, 0, 0, 0, 0,
-	234, 349, 365, 244, 340, 378, 249, 347, 239, 314,
-	337, 0, 0, 236, 363, 346, 296, 279, 280, 235,
-	0, 332, 259, 272, 256, 312, 539, 563, 567, 255,
-	626, 561, 373, 238, 0, 372, 311, 359, 364, 297,
-	291, 237, 361, 295, 290, 283, 263, 627, 276, 323,
-	289, 324, 277, 301, 300, 302, 0, 0, 0, 0,
-	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 558, 0, 0, 0, 375,
-	0, 0, 610, 0, 0, 0, 348, 0, 0, 284,
-	0, 0, 0, 562, 0, 335, 317, 623, 505, 0,
-	333, 287, 360, 325, 366, 350, 374, 329, 326, 229,
-	351, 258, 298, 240, 242, 254, 260, 262, 264, 265,
-	307, 308, 320, 339, 353, 354, 355, 257, 250, 334,
-	251, 274, 252, 230, 341, 253, 232, 321, 358, 0,
-	270, 330, 294, 233, 293, 322, 357, 356, 241, 382,
-	388, 389, 394, 0, 395, 0, 0, 0, 403, 408,
-	409, 410, 412, 413, 414, 415, 0, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 0, 387, 268, 226,
-	227, 422, 608, 313, 0, 0, 622, 603, 605, 606,
-	609, 613, 614, 615, 616, 617, 619, 621, 625, 421,
-	0, 0, 0, 0, 0, 420, 319, 0, 338, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 345, 368, 380, 398, 401, 0, 0, 0, 231,
-	400, 0, 2679, 0, 0, 0, 2680, 0, 624, 0,
-	0, 0, 379, 0, 0, 0, 0, 0, 566, 303,
-	304, 305, 306, 611, 0, 248, 399, 328, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 392, 393, 267, 273, 411, 275,
-	247, 318, 269, 377, 281, 0, 404, 0, 405, 0,
-	0, 0, 0, 310, 278, 342, 282, 288, 331, 376,
-	316, 336, 245, 367, 343, 292, 0, 0, 633, 607,
-	632, 634, 635, 631, 636, 637, 618, 523, 0, 570,
-	629, 628, 630, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 286, 0,
-	327, 266, 596, 575, 576, 577, 522, 578, 573, 574,
-	597, 568, 593, 594, 547, 571, 579, 592, 580, 595,
-	598, 599, 638, 639, 586, 640, 583, 600, 591, 590,
-	581, 569, 601, 602, 554, 549, 584, 585, 572, 587,
-	550, 551, 552, 553, 352, 564, 0, 383, 384, 385,
-	407, 369, 0, 419, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 520, 0,
-	0, 0, 261, 1413, 0, 285, 0, 0, 0, 555,
-	0, 0, 344, 299, 0, 0, 0, 0, 612, 620,
-	0, 0, 0, 0, 0, 0, 0, 1546, 0, 0,
-	513, 0, 0, 545, 589, 588, 532, 541, 0, 0,
-	243, 179, 533, 0, 540, 534, 538, 537, 535, 536,
-	0, 604, 0, 0, 0, 0, 0, 0, 504, 517,
-	0, 521, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 514, 515, 0, 0, 0,
-	0, 565, 0, 516, 0, 0, 1547, 542, 543, 0,
-	0, 0, 0, 234, 349, 365, 244, 340, 378, 249,
-	347, 239, 314, 337, 0, 0, 236, 363, 346, 296,
-	279, 280, 235, 0, 332, 259, 272, 256, 312, 539,
-	563, 567, 255, 626, 561, 373, 238, 0, 372, 311,
-	359, 364, 297, 291, 237, 361, 295, 290, 283, 263,
-	627, 276, 323, 289, 324, 277, 301, 300, 302, 0,
-	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 558, 0,
-	0, 0, 375, 0, 0, 610, 0, 0, 0, 348,
-	0, 0, 284, 0, 0, 0, 562, 0, 335, 317,
-	623, 505, 0, 333, 287, 360, 325, 366, 350, 374,
-	329, 326, 229, 351, 258, 298, 240, 242, 254, 260,
-	262, 264, 265, 307, 308, 320, 339, 353, 354, 355,
-	257, 250, 334, 251, 274, 252, 230, 341, 253, 232,
-	321, 358, 0, 270, 330, 294, 233, 293, 322, 357,
-	356, 241, 382, 388, 389, 394, 0, 395, 0, 0,
-	0, 403, 408, 409, 410, 412, 413, 414, 415, 0,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 0,
-	387, 268, 226, 227, 422, 608, 313, 0, 0, 622,
-	603, 605, 606, 609, 613, 614, 615, 616, 617, 619,
-	621, 625, 421, 0, 0, 0, 0, 0, 420, 319,
-	0, 338, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 345, 368, 380, 398, 401, 0,
-	0, 0, 231, 400, 0, 0, 0, 0, 0, 0,
-	0, 624, 0, 0, 0, 379, 0, 0, 0, 0,
-	0, 566, 303, 304, 305, 306, 611, 0, 248, 399,
-	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 392, 393, 267,
-	273, 411, 275, 247, 318, 269, 377, 281, 0, 404,
-	0, 405, 0, 0, 0, 0, 310, 278, 342, 282,
-	288, 331, 376, 316, 336, 245, 367, 343, 292, 0,
-	0, 633, 607, 632, 634, 635, 631, 636, 637, 618,
-	523, 0, 570, 629, 628, 630, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 286, 0, 327, 266, 596, 575, 576, 577, 522,
-	578, 573, 574, 597, 568, 593, 594, 547, 571, 579,
-	592, 580, 595, 598, 599, 638, 639, 586, 640, 583,
-	600, 591, 590, 581, 569, 601, 602, 554, 549, 584,
-	585, 572, 587, 550, 551, 552, 553, 156, 352, 564,
-	383, 384, 385, 407, 369, 0, 419, 0, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 520, 0, 0, 0, 261, 0, 0, 285,
-	0, 0, 0, 957, 0, 0, 344, 299, 0, 0,
-	0, 0, 612, 620, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 513, 0, 0, 545, 589, 588,
-	532, 541, 0, 0, 243, 179, 533, 0, 540, 534,
-	538, 537, 535, 536, 0, 604, 0, 0, 0, 0,
-	0, 0, 504, 517, 0, 521, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 514,
-	515, 0, 0, 0, 0, 565, 0, 516, 0, 0,
-	560, 542, 543, 0, 0, 0, 0, 234, 349, 365,
-	244, 340, 378, 249, 347, 239, 314, 337, 0, 0,
-	236, 363, 346, 296, 279, 280, 235, 0, 332, 259,
-	272, 256, 312, 539, 563, 567, 255, 626, 561, 373,
-	238, 0, 372, 311, 359, 364, 297, 291, 237, 361,
-	295, 290, 283, 263, 627, 276, 323, 289, 324, 277,
-	301, 300, 302, 0, 0, 0, 0, 0, 402, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 558, 0, 0, 0, 375, 0, 0, 610,
-	0, 0, 0, 348, 0, 0, 284, 0, 0, 0,
-	562, 0, 335, 317, 623, 505, 0, 333, 287, 360,
-	325, 366, 350, 374, 329, 326, 229, 351, 258, 298,
-	240, 242, 254, 260, 262, 264, 265, 307, 308, 320,
-	339, 353, 354, 355, 257, 250, 334, 251, 274, 252,
-	230, 341, 253, 232, 321, 358, 0, 270, 330, 294,
-	233, 293, 322, 357, 356, 241, 382, 388, 389, 394,
-	0, 395, 0, 0, 0, 403, 408, 409, 410, 412,
-	413, 414, 415, 0, 0, 0, 0, 397, 0, 0,
-	0, 0, 0, 0, 387, 268, 226, 227, 422, 608,
-	313, 0, 0, 622, 603, 605, 606, 609, 613, 614,
-	615, 616, 617, 619, 621, 625, 421, 0, 0, 0,
-	0, 0, 420, 319, 0, 338, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 345, 368,
-	380, 398, 401, 0, 0, 0, 231, 400, 0, 0,
-	0, 0, 0, 0, 0, 624, 0, 0, 0, 379,
-	0, 0, 0, 0, 0, 566, 303, 304, 305, 306,
-	611, 0, 248, 399, 328, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 392, 393, 267, 273, 411, 275, 247, 318, 269,
-	377, 281, 0, 404, 0, 405, 0, 0, 0, 0,
-	310, 278, 342, 282, 288, 331, 376, 316, 336, 245,
-	367, 343, 292, 0, 0, 633, 607, 632, 634, 635,
-	631, 636, 637, 618, 523, 0, 570, 629, 628, 630,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 286, 126, 327, 266, 596,
-	575, 576, 577, 522, 578, 573, 574, 597, 568, 593,
-	594, 547, 571, 579, 592, 580, 595, 598, 599, 638,
-	639, 586, 640, 583, 600, 591, 590, 581, 569, 601,
-	602, 554, 549, 584, 585, 572, 587, 550, 551, 552,
-	553, 352, 564, 0, 383, 384, 385, 407, 369, 0,
-	419, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 520, 0, 0, 0, 261,
-	2886, 0, 285, 0, 0, 0, 555, 0, 0, 344,
-	299, 0, 0, 0, 0, 612, 620, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 513, 0, 0,
-	545, 589, 588, 532, 541, 0, 0, 243, 179, 533,
-	0, 540, 534, 538, 537, 535, 536, 0, 604, 0,
-	0, 0, 0, 0, 0, 504, 517, 0, 521, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 514, 515, 0, 0, 0, 0, 565, 0,
-	516, 0, 0, 560, 542, 543, 0, 0, 0, 0,
-	234, 349, 365, 244, 340, 378, 249, 347, 239, 314,
-	337, 0, 0, 236, 363, 346, 296, 279, 280, 235,
-	0, 332, 259, 272, 256, 312, 539, 563, 567, 255,
-	626, 561, 373, 238, 0, 372, 311, 359, 364, 297,
-	291, 237, 361, 295, 290, 283, 263, 627, 276, 323,
-	289, 324, 277, 301, 300, 302, 0, 0, 0, 0,
-	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 558, 0, 0, 0, 375,
-	0, 0, 610, 0, 0, 0, 348, 0, 0, 284,
-	0, 0, 0, 562, 0, 335, 317, 623, 505, 0,
-	333, 287, 360, 325, 366, 350, 374, 329, 326, 229,
-	351, 258, 298, 240, 242, 254, 260, 262, 264, 265,
-	307, 308, 320, 339, 353, 354, 355, 257, 250, 334,
-	251, 274, 252, 230, 341, 253, 232, 321, 358, 0,
-	270, 330, 294, 233, 293, 322, 357, 356, 241, 382,
-	388, 389, 394, 0, 395, 0, 0, 0, 403, 408,
-	409, 410, 412, 413, 414, 415, 0, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 0, 387, 268, 226,
-	227, 422, 608, 313, 0, 0, 622, 603, 605, 606,
-	609, 613, 614, 615, 616, 617, 619, 621, 625, 421,
-	0, 0, 0, 0, 0, 420, 319, 0, 338, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 345, 368, 380, 398, 401, 0, 0, 0, 231,
-	400, 0, 0, 0, 0, 0, 0, 0, 624, 0,
-	0, 0, 379, 0, 0, 0, 0, 0, 566, 303,
-	304, 305, 306, 611, 0, 248, 399, 328, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 392, 393, 267, 273, 411, 275,
-	247, 318, 269, 377, 281, 0, 404, 0, 405, 0,
-	0, 0, 0, 310, 278, 342, 282, 288, 331, 376,
-	316, 336, 245, 367, 343, 292, 0, 0, 633, 607,
-	632, 634, 635, 631, 636, 637, 618, 523, 0, 570,
-	629, 628, 630, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 286, 0,
-	327, 266, 596, 575, 576, 577, 522, 578, 573, 574,
-	597, 568, 593, 594, 547, 571, 579, 592, 580, 595,
-	598, 599, 638, 639, 586, 640, 583, 600, 591, 590,
-	581, 569, 601, 602, 554, 549, 584, 585, 572, 587,
-	550, 551, 552, 553, 352, 564, 0, 383, 384, 385,
-	407, 369, 0, 419, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 520, 0,
-	0, 0, 261, 1413, 0, 285, 0, 0, 0, 555,
-	0, 0, 344, 299, 0, 0, 0, 0, 612, 620,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	513, 0, 0, 545, 589, 588, 532, 541, 0, 0,
-	243, 179, 533, 0, 540, 534, 538, 537, 535, 536,
-	0, 604, 0, 0, 0, 0, 0, 0, 504, 517,
-	0, 521, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 514, 515, 0, 0, 0,
-	0, 565, 0, 516, 0, 0, 560, 542, 543, 0,
-	0, 0, 0, 234, 349, 365, 244, 340, 378, 249,
-	347, 239, 314, 337, 0, 0, 236, 363, 346, 296,
-	279, 280, 235, 0, 332, 259, 272, 256, 312, 539,
-	563, 567, 255, 626, 561, 373, 238, 0, 372, 311,
-	359, 364, 297, 291, 237, 361, 295, 290, 283, 263,
-	627, 276, 323, 289, 324, 277, 301, 300, 302, 0,
+	0, 0, 552, 596, 595, 539, 548, 0, 0, 244,
+	179, 540, 0, 547, 541, 545, 544, 542, 543, 0,
+	611, 0, 0, 0, 0, 0, 0, 511, 524, 0,
+	528, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 521, 522, 0, 0, 0, 0,
+	572, 0, 523, 0, 0, 567, 549, 550, 0, 0,
+	0, 0, 235, 352, 368, 245, 343, 383, 250, 350,
+	240, 317, 340, 0, 0, 237, 366, 349, 298, 281,
+	282, 236, 0, 335, 260, 274, 257, 315, 546, 570,
+	574, 256, 633, 568, 376, 239, 0, 375, 314, 362,
+	367, 299, 293, 238, 364, 297, 292, 285, 264, 634,
+	278, 326, 291, 327, 279, 304, 303, 305, 0, 0,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 0, 565,
+	0, 0, 0, 379, 0, 0, 617, 0, 0, 0,
+	351, 0, 0, 286, 0, 0, 0, 569, 0, 338,
+	320, 630, 512, 0, 336, 289, 363, 328, 369, 267,
+	377, 380, 353, 378, 332, 329, 230, 354, 259, 300,
+	241, 243, 255, 261, 263, 265, 266, 310, 311, 323,
+	342, 356, 357, 358, 258, 251, 337, 252, 276, 253,
+	231, 344, 254, 233, 324, 361, 0, 272, 333, 296,
+	234, 295, 325, 360, 359, 242, 387, 393, 394, 399,
+	0, 400, 0, 0, 0, 408, 413, 414, 415, 417,
+	418, 419, 422, 420, 421, 0, 0, 0, 0, 402,
+	0, 0, 0, 1382, 1381, 1383, 392, 270, 226, 227,
+	429, 615, 316, 0, 0, 629, 610, 612, 613, 616,
+	620, 621, 622, 623, 624, 626, 628, 632, 428, 0,
+	0, 0, 0, 0, 427, 322, 0, 341, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	348, 371, 385, 403, 406, 0, 0, 0, 232, 405,
+	0, 0, 0, 0, 0, 0, 0, 631, 0, 0,
+	0, 384, 0, 0, 0, 0, 0, 573, 306, 307,
+	308, 309, 618, 0, 249, 404, 331, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 397, 398, 269, 275, 416, 277, 248,
+	321, 271, 382, 283, 0, 409, 0, 410, 0, 0,
+	0, 0, 313, 280, 345, 284, 290, 334, 381, 319,
+	339, 246, 370, 346, 294, 0, 0, 640, 614, 639,
+	641, 642, 638, 643, 644, 625, 530, 0, 577, 636,
+	635, 637, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 229, 0, 288, 0, 330,
+	268, 603, 582, 583, 584, 529, 585, 580, 581, 604,
+	575, 600, 601, 554, 578, 586, 599, 587, 602, 605,
+	606, 645, 646, 593, 647, 590, 607, 598, 597, 588,
+	576, 608, 609, 561, 556, 591, 592, 579, 594, 557,
+	558, 559, 560, 355, 571, 0, 388, 389, 390, 412,
+	372, 0, 426, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 527, 0, 0,
+	0, 262, 0, 0, 287, 0, 0, 0, 228, 562,
+	0, 0, 347, 301, 0, 0, 0, 0, 619, 627,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	520, 0, 0, 552, 596, 595, 539, 548, 0, 0,
+	244, 179, 540, 0, 547, 541, 545, 544, 542, 543,
+	0, 611, 0, 0, 0, 0, 0, 0, 511, 524,
+	0, 528, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 521, 522, 0, 0, 0,
+	0, 572, 0, 523, 0, 0, 567, 549, 550, 0,
+	0, 0, 0, 235, 352, 368, 245, 343, 383, 250,
+	350, 240, 317, 340, 0, 0, 237, 366, 349, 298,
+	281, 282, 236, 0, 335, 260, 274, 257, 315, 546,
+	570, 574, 256, 633, 568, 376, 239, 0, 375, 314,
+	362, 367, 299, 293, 238, 364, 297, 292, 285, 264,
+	634, 278, 326, 291, 327, 279, 304, 303, 305, 0,
+	0, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	565, 0, 0, 0, 379, 0, 0, 617, 0, 0,
+	0, 351, 0, 0, 286, 0, 0, 0, 569, 0,
+	338, 320, 630, 512, 0, 336, 289, 363, 328, 369,
+	267, 377, 380, 353, 378, 332, 329, 230, 354, 259,
+	300, 241, 243, 255, 261, 263, 265, 266, 310, 311,
+	323, 342, 356, 357, 358, 258, 251, 337, 252, 276,
+	253, 231, 344, 254, 233, 324, 361, 0, 272, 333,
+	296, 234, 295, 325, 360, 359, 242, 387, 393, 394,
+	399, 0, 400, 0, 0, 0, 408, 413, 414, 415,
+	417, 418, 419, 422, 420, 421, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 392, 270, 226,
+	227, 429, 615, 316, 0, 0, 629, 610, 612, 613,
+	616, 620, 621, 622, 623, 624, 626, 628, 632, 428,
+	0, 0, 0, 0, 0, 427, 322, 0, 341, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 371, 385, 403, 406, 0, 0, 0, 232,
+	405, 0, 2778, 0, 0, 0, 2779, 0, 631, 0,
+	0, 0, 384, 0, 0, 0, 0, 0, 573, 306,
+	307, 308, 309, 618, 0, 249, 404, 331, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 397, 398, 269, 275, 416, 277,
+	248, 321, 271, 382, 283, 0, 409, 0, 410, 0,
+	0, 0, 0, 313, 280, 345, 284, 290, 334, 381,
+	319, 339, 246, 370, 346, 294, 0, 0, 640, 614,
+	639, 641, 642, 638, 643, 644, 625, 530, 0, 577,
+	636, 635, 637, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 288, 0,
+	330, 268, 603, 582, 583, 584, 529, 585, 580, 581,
+	604, 575, 600, 601, 554, 578, 586, 599, 587, 602,
+	605, 606, 645, 646, 593, 647, 590, 607, 598, 597,
+	588, 576, 608, 609, 561, 556, 591, 592, 579, 594,
+	557, 558, 559, 560, 355, 571, 0, 388, 389, 390,
+	412, 372, 0, 426, 0, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 527, 0,
+	0, 0, 262, 1420, 0, 287, 0, 0, 0, 228,
+	562, 0, 0, 347, 301, 0, 0, 0, 0, 619,
+	627, 0, 0, 0, 0, 0, 0, 0, 1561, 0,
+	0, 520, 0, 0, 552, 596, 595, 539, 548, 0,
+	0, 244, 179, 540, 0, 547, 541, 545, 544, 542,
+	543, 0, 611, 0, 0, 0, 0, 0, 0, 511,
+	524, 0, 528, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 521, 522, 0, 0,
+	0, 0, 572, 0, 523, 0, 0, 1562, 549, 550,
+	0, 0, 0, 0, 235, 352, 368, 245, 343, 383,
+	250, 350, 240, 317, 340, 0, 0, 237, 366, 349,
+	298, 281, 282, 236, 0, 335, 260, 274, 257, 315,
+	546, 570, 574, 256, 633, 568, 376, 239, 0, 375,
+	314, 362, 367, 299, 293, 238, 364, 297, 292, 285,
+	264, 634, 278, 326, 291, 327, 279, 304, 303, 305,
+	0, 0, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	0, 565, 0, 0, 0, 379, 0, 0, 617, 0,
+	0, 0, 351, 0, 0, 286, 0, 0, 0, 569,
+	0, 338, 320, 630, 512, 0, 336, 289, 363, 328,
+	369, 267, 377, 380, 353, 378, 332, 329, 230, 354,
+	259, 300, 241, 243, 255, 261, 263, 265, 266, 310,
+	311, 323, 342, 356, 357, 358, 258, 251, 337, 252,
+	276, 253, 231, 344, 254, 233, 324, 361, 0, 272,
+	333, 296, 234, 295, 325, 360, 359, 242, 387, 393,
+	394, 399, 0, 400, 0, 0, 0, 408, 413, 414,
+	415, 417, 418, 419, 422, 420, 421, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 392, 270,
+	226, 227, 429, 615, 316, 0, 0, 629, 610, 612,
+	613, 616, 620, 621, 622, 623, 624, 626, 628, 632,
+	428, 0, 0, 0, 0, 0, 427, 322, 0, 341,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 348, 371, 385, 403, 406, 0, 0, 0,
+	232, 405, 0, 0, 0, 0, 0, 0, 0, 631,
+	0, 0, 0, 384, 0, 0, 0, 0, 0, 573,
+	306, 307, 308, 309, 618, 0, 249, 404, 331, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 397, 398, 269, 275, 416,
+	277, 248, 321, 271, 382, 283, 0, 409, 0, 410,
+	0, 0, 0, 0, 313, 280, 345, 284, 290, 334,
+	381, 319, 339, 246, 370, 346, 294, 0, 0, 640,
+	614, 639, 641, 642, 638, 643, 644, 625, 530, 0,
+	577, 636, 635, 637, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 229, 0, 288,
+	0, 330, 268, 603, 582, 583, 584, 529, 585, 580,
+	581, 604, 575, 600, 601, 554, 578, 586, 599, 587,
+	602, 605, 606, 645, 646, 593, 647, 590, 607, 598,
+	597, 588, 576, 608, 609, 561, 556, 591, 592, 579,
+	594, 557, 558, 559, 560, 156, 355, 571, 388, 389,
+	390, 412, 372, 0, 426, 0, 0, 318, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	527, 0, 0, 0, 262, 0, 0, 287, 0, 0,
+	0, 228, 964, 0, 0, 347, 301, 0, 0, 0,
+	0, 619, 627, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 520, 0, 0, 552, 596, 595, 539,
+	548, 0, 0, 244, 179, 540, 0, 547, 541, 545,
+	544, 542, 543, 0, 611, 0, 0, 0, 0, 0,
+	0, 511, 524, 0, 528, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 521, 522,
+	0, 0, 0, 0, 572, 0, 523, 0, 0, 567,
+	549, 550, 0, 0, 0, 0, 235, 352, 368, 245,
+	343, 383, 250, 350, 240, 317, 340, 0, 0, 237,
+	366, 349, 298, 281, 282, 236, 0, 335, 260, 274,
+	257, 315, 546, 570, 574, 256, 633, 568, 376, 239,
+	0, 375, 314, 362, 367, 299, 293, 238, 364, 297,
+	292, 285, 264, 634, 278, 326, 291, 327, 279, 304,
+	303, 305, 0, 0, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 0, 565, 0, 0, 0, 379, 0, 0,
+	617, 0, 0, 0, 351, 0, 0, 286, 0, 0,
+	0, 569, 0, 338, 320, 630, 512, 0, 336, 289,
+	363, 328, 369, 267, 377, 380, 353, 378, 332, 329,
+	230, 354, 259, 300, 241, 243, 255, 261, 263, 265,
+	266, 310, 311, 323, 342, 356, 357, 358, 258, 251,
+	337, 252, 276, 253, 231, 344, 254, 233, 324, 361,
+	0, 272, 333, 296, 234, 295, 325, 360, 359, 242,
+	387, 393, 394, 399, 0, 400, 0, 0, 0, 408,
+	413, 414, 415, 417, 418, 419, 422, 420, 421, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	392, 270, 226, 227, 429, 615, 316, 0, 0, 629,
+	610, 612, 613, 616, 620, 621, 622, 623, 624, 626,
+	628, 632, 428, 0, 0, 0, 0, 0, 427, 322,
+	0, 341, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 371, 385, 403, 406, 0,
+	0, 0, 232, 405, 0, 0, 0, 0, 0, 0,
+	0, 631, 0, 0, 0, 384, 0, 0, 0, 0,
+	0, 573, 306, 307, 308, 309, 618, 0, 249, 404,
+	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 397, 398, 269,
+	275, 416, 277, 248, 321, 271, 382, 283, 0, 409,
+	0, 410, 0, 0, 0, 0, 313, 280, 345, 284,
+	290, 334, 381, 319, 339, 246, 370, 346, 294, 0,
+	0, 640, 614, 639, 641, 642, 638, 643, 644, 625,
+	530, 0, 577, 636, 635, 637, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
+	0, 288, 126, 330, 268, 603, 582, 583, 584, 529,
+	585, 580, 581, 604, 575, 600, 601, 554, 578, 586,
+	599, 587, 602, 605, 606, 645, 646, 593, 647, 590,
+	607, 598, 597, 588, 576, 608, 609, 561, 556, 591,
+	592, 579, 594, 557, 558, 559, 560, 355, 571, 0,
+	388, 389, 390, 412, 372, 0, 426, 0, 318, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 527, 0, 0, 0, 262, 3016, 0, 287, 0,
+	0, 0, 228, 562, 0, 0, 347, 301, 0, 0,
+	0, 0, 619, 627, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 520, 0, 0, 552, 596, 595,
+	539, 548, 0, 0, 244, 179, 540, 0, 547, 541,
+	545, 544, 542, 543, 0, 611, 0, 0, 0, 0,
+	0, 0, 511, 524, 0, 528, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 521,
+	522, 0, 0, 0, 0, 572, 0, 523, 0, 0,
+	567, 549, 550, 0, 0, 0, 0, 235, 352, 368,
+	245, 343, 383, 250, 350, 240, 317, 340, 0, 0,
+	237, 366, 349, 298, 281, 282, 236, 0, 335, 260,
+	274, 257, 315, 546, 570, 574, 256, 633, 568, 376,
+	239, 0, 375, 314, 362, 367, 299, 293, 238, 364,
+	297, 292, 285, 264, 634, 278, 326, 291, 327, 279,
+	304, 303, 305, 0, 0, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 302, 0, 565, 0, 0, 0, 379, 0,
+	0, 617, 0, 0, 0, 351, 0, 0, 286, 0,
+	0, 0, 569, 0, 338, 320, 630, 512, 0, 336,
+	289, 363, 328, 369, 267, 377, 380, 353, 378, 332,
+	329, 230, 354, 259, 300, 241, 243, 255, 261, 263,
+	265, 266, 310, 311, 323, 342, 356, 357, 358, 258,
+	251, 337, 252, 276, 253, 231, 344, 254, 233, 324,
+	361, 0, 272, 333, 296, 234, 295, 325, 360, 359,
+	242, 387, 393, 394, 399, 0, 400, 0, 0, 0,
+	408, 413, 414, 415, 417, 418, 419, 422, 420, 421,
 	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 558, 0,
-	0, 0, 375, 0, 0, 610, 0, 0, 0, 348,
-	0, 0, 284, 0, 0, 0, 562, 0, 335, 317,
-	623, 505, 0, 333, 287, 360, 325, 366, 350, 374,
-	329, 326, 229, 351, 258, 298, 240, 242, 254, 260,
-	262, 264, 265, 307, 308, 320, 339, 353, 354, 355,
-	257, 250, 334, 251, 274, 252, 230, 341, 253, 232,
-	321, 358, 0, 270, 330, 294, 233, 293, 322, 357,
-	356, 241, 382, 388, 389, 394, 0, 395, 0, 0,
-	0, 403, 408, 409, 410, 412, 413, 414, 415, 0,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 0,
-	387, 268, 226, 227, 422, 608, 313, 0, 0, 622,
-	603, 605, 606, 609, 613, 614, 615, 616, 617, 619,
-	621, 625, 421, 0, 0, 0, 0, 0, 420, 319,
-	0, 338, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 345, 368, 380, 398, 401, 0,
-	0, 0, 231, 400, 0, 0, 0, 0, 0, 0,
-	0, 624, 0, 0, 0, 379, 0, 0, 0, 0,
-	0, 566, 303, 304, 305, 306, 611, 0, 248, 399,
-	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 392, 393, 267,
-	273, 411, 275, 247, 318, 269, 377, 281, 0, 404,
-	0, 405, 0, 0, 0, 0, 310, 278, 342, 282,
-	288, 331, 376, 316, 336, 245, 367, 343, 292, 0,
-	0, 633, 607, 632, 634, 635, 631, 636, 637, 618,
-	523, 0, 570, 629, 628, 630, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 286, 0, 327, 266, 596, 575, 576, 577, 522,
-	578, 573, 574, 597, 568, 593, 594, 547, 571, 579,
-	592, 580, 595, 598, 599, 638, 639, 586, 640, 583,
-	600, 591, 590, 581, 569, 601, 602, 554, 549, 584,
-	585, 572, 587, 550, 551, 552, 553, 352, 564, 0,
-	383, 384, 385, 407, 369, 0, 419, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 520, 0, 0, 0, 261, 0, 0, 285, 0,
-	0, 0, 555, 0, 0, 344, 299, 0, 0, 0,
-	0, 612, 620, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 513, 0, 0, 545, 589, 588, 532,
-	541, 0, 0, 243, 179, 533, 0, 540, 534, 538,
-	537, 535, 536, 0, 604, 0, 0, 0, 0, 0,
-	0, 504, 517, 0, 521, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 514, 515,
-	1171, 0, 0, 0, 565, 0, 516, 0, 0, 560,
-	542, 543, 0, 0, 0, 0, 234, 349, 365, 244,
-	340, 378, 249, 347, 239, 314, 337, 0, 0, 236,
-	363, 346, 296, 279, 280, 235, 0, 332, 259, 272,
-	256, 312, 539, 563, 567, 255, 626, 561, 373, 238,
-	0, 372, 311, 359, 364, 297, 291, 237, 361, 295,
-	290, 283, 263, 627, 276, 323, 289, 324, 277, 301,
-	300, 302, 0, 0, 0, 0, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 558, 0, 0, 0, 375, 0, 0, 610, 0,
-	0, 0, 348, 0, 0, 284, 0, 0, 0, 562,
-	0, 335, 317, 623, 505, 0, 333, 287, 360, 325,
-	366, 350, 374, 329, 326, 229, 351, 258, 298, 240,
-	242, 254, 260, 262, 264, 265, 307, 308, 320, 339,
-	353, 354, 355, 257, 250, 334, 251, 274, 252, 230,
-	341, 253, 232, 321, 358, 0, 270, 330, 294, 233,
-	293, 322, 357, 356, 241, 382, 388, 389, 394, 0,
-	395, 0, 0, 0, 403, 408, 409, 410, 412, 413,
-	414, 415, 0, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 0, 387, 268, 226, 227, 422, 608, 313,
-	0, 0, 622, 603, 605, 606, 609, 613, 614, 615,
-	616, 617, 619, 621, 625, 421, 0, 0, 0, 0,
-	0, 420, 319, 0, 338, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 345, 368, 380,
-	398, 401, 0, 0, 0, 231, 400, 0, 0, 0,
-	0, 0, 0, 0, 624, 0, 0, 0, 379, 0,
-	0, 0, 0, 0, 566, 303, 304, 305, 306, 611,
-	0, 248, 399, 328, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	392, 393, 267, 273, 411, 275, 247, 318, 269, 377,
-	281, 0, 404, 0, 405, 0, 0, 0, 0, 310,
-	278, 342, 282, 288, 331, 376, 316, 336, 245, 367,
-	343, 292, 0, 0, 633, 607, 632, 634, 635, 631,
-	636, 637, 618, 523, 0, 570, 629, 628, 630, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 286, 0, 327, 266, 596, 575,
-	576, 577, 522, 578, 573, 574, 597, 568, 593, 594,
-	547, 571, 579, 592, 580, 595, 598, 599, 638, 639,
-	586, 640, 583, 600, 591, 590, 581, 569, 601, 602,
-	554, 549, 584, 585, 572, 587, 550, 551, 552, 553,
-	0, 0, 0, 383, 384, 385, 407, 369, 0, 419,
-	352, 564, 0, 0, 1692, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 520, 0, 0, 0, 261, 0,
-	0, 285, 0, 0, 0, 555, 0, 0, 344, 299,
-	0, 0, 0, 0, 612, 620, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 513, 0, 0, 545,
-	589, 588, 532, 541, 0, 0, 243, 179, 533, 0,
-	540, 534, 538, 537, 535, 536, 0, 604, 0, 0,
-	0, 0, 0, 0, 504, 517, 0, 521, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 514, 515, 0, 0, 0, 0, 565, 0, 516,
-	0, 0, 560, 542, 543, 0, 0, 0, 0, 234,
-	349, 365, 244, 340, 378, 249, 347, 239, 314, 337,
-	0, 0, 236, 363, 346, 296, 279, 280, 235, 0,
-	332, 259, 272, 256, 312, 539, 563, 567, 255, 626,
-	561, 373, 238, 0, 372, 311, 359, 364, 297, 291,
-	237, 361, 295, 290, 283, 263, 627, 276, 323, 289,
-	324, 277, 301, 300, 302, 0, 0, 0, 0, 0,
-	402, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 558, 0, 0, 0, 375, 0,
-	0, 610, 0, 0, 0, 348, 0, 0, 284, 0,
-	0, 0, 562, 0, 335, 317, 623, 505, 0, 333,
-	287, 360, 325, 366, 350, 374, 329, 326, 229, 351,
-	258, 298, 240, 242, 254, 260, 262, 264, 265, 307,
-	308, 320, 339, 353, 354, 355, 257, 250, 334, 251,
-	274, 252, 230, 341, 253, 232, 321, 358, 0, 270,
-	330, 294, 233, 293, 322, 357, 356, 241, 382, 388,
-	389, 394, 0, 395, 0, 0, 0, 403, 408, 409,
-	410, 412, 413, 414, 415, 0, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 0, 387, 268, 226, 227,
-	422, 608, 313, 0, 0, 622, 603, 605, 606, 609,
-	613, 614, 615, 616, 617, 619, 621, 625, 421, 0,
-	0, 0, 0, 0, 420, 319, 0, 338, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	345, 368, 380, 398, 401, 0, 0, 0, 231, 400,
-	0, 0, 0, 0, 0, 0, 0, 624, 0, 0,
-	0, 379, 0, 0, 0, 0, 0, 566, 303, 304,
-	305, 306, 611, 0, 248, 399, 328, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 392, 393, 267, 273, 411, 275, 247,
-	318, 269, 377, 281, 0, 404, 0, 405, 0, 0,
-	0, 0, 310, 278, 342, 282, 288, 331, 376, 316,
-	336, 245, 367, 343, 292, 0, 0, 633, 607, 632,
-	634, 635, 631, 636, 637, 618, 523, 0, 570, 629,
-	628, 630, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 286, 0, 327,
-	266, 596, 575, 576, 577, 522, 578, 573, 574, 597,
-	568, 593, 594, 547, 571, 579, 592, 580, 595, 598,
-	599, 638, 639, 586, 640, 583, 600, 591, 590, 581,
-	569, 601, 602, 554, 549, 584, 585, 572, 587, 550,
-	551, 552, 553, 352, 564, 0, 383, 384, 385, 407,
-	369, 0, 419, 0, 315, 0, 0, 0, 0, 0,
+	0, 392, 270, 226, 227, 429, 615, 316, 0, 0,
+	629, 610, 612, 613, 616, 620, 621, 622, 623, 624,
+	626, 628, 632, 428, 0, 0, 0, 0, 0, 427,
+	322, 0, 341, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 348, 371, 385, 403, 406,
+	0, 0, 0, 232, 405, 0, 0, 0, 0, 0,
+	0, 0, 631, 0, 0, 0, 384, 0, 0, 0,
+	0, 0, 573, 306, 307, 308, 309, 618, 0, 249,
+	404, 331, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 397, 398,
+	269, 275, 416, 277, 248, 321, 271, 382, 283, 0,
+	409, 0, 410, 0, 0, 0, 0, 313, 280, 345,
+	284, 290, 334, 381, 319, 339, 246, 370, 346, 294,
+	0, 0, 640, 614, 639, 641, 642, 638, 643, 644,
+	625, 530, 0, 577, 636, 635, 637, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 288, 0, 330, 268, 603, 582, 583, 584,
+	529, 585, 580, 581, 604, 575, 600, 601, 554, 578,
+	586, 599, 587, 602, 605, 606, 645, 646, 593, 647,
+	590, 607, 598, 597, 588, 576, 608, 609, 561, 556,
+	591, 592, 579, 594, 557, 558, 559, 560, 355, 571,
+	0, 388, 389, 390, 412, 372, 0, 426, 0, 318,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 527, 0, 0, 0, 262, 1420, 0, 287,
+	0, 0, 0, 228, 562, 0, 0, 347, 301, 0,
+	0, 0, 0, 619, 627, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 520, 0, 0, 552, 596,
+	595, 539, 548, 0, 0, 244, 179, 540, 0, 547,
+	541, 545, 544, 542, 543, 0, 611, 0, 0, 0,
+	0, 0, 0, 511, 524, 0, 528, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	521, 522, 0, 0, 0, 0, 572, 0, 523, 0,
+	0, 567, 549, 550, 0, 0, 0, 0, 235, 352,
+	368, 245, 343, 383, 250, 350, 240, 317, 340, 0,
+	0, 237, 366, 349, 298, 281, 282, 236, 0, 335,
+	260, 274, 257, 315, 546, 570, 574, 256, 633, 568,
+	376, 239, 0, 375, 314, 362, 367, 299, 293, 238,
+	364, 297, 292, 285, 264, 634, 278, 326, 291, 327,
+	279, 304, 303, 305, 0, 0, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 565, 0, 0, 0, 379,
+	0, 0, 617, 0, 0, 0, 351, 0, 0, 286,
+	0, 0, 0, 569, 0, 338, 320, 630, 512, 0,
+	336, 289, 363, 328, 369, 267, 377, 380, 353, 378,
+	332, 329, 230, 354, 259, 300, 241, 243, 255, 261,
+	263, 265, 266, 310, 311, 323, 342, 356, 357, 358,
+	258, 251, 337, 252, 276, 253, 231, 344, 254, 233,
+	324, 361, 0, 272, 333, 296, 234, 295, 325, 360,
+	359, 242, 387, 393, 394, 399, 0, 400, 0, 0,
+	0, 408, 413, 414, 415, 417, 418, 419, 422, 420,
+	421, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 392, 270, 226, 227, 429, 615, 316, 0,
+	0, 629, 610, 612, 613, 616, 620, 621, 622, 623,
+	624, 626, 628, 632, 428, 0, 0, 0, 0, 0,
+	427, 322, 0, 341, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 348, 371, 385, 403,
+	406, 0, 0, 0, 232, 405, 0, 0, 0, 0,
+	0, 0, 0, 631, 0, 0, 0, 384, 0, 0,
+	0, 0, 0, 573, 306, 307, 308, 309, 618, 0,
+	249, 404, 331, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 397,
+	398, 269, 275, 416, 277, 248, 321, 271, 382, 283,
+	0, 409, 0, 410, 0, 0, 0, 0, 313, 280,
+	345, 284, 290, 334, 381, 319, 339, 246, 370, 346,
+	294, 0, 0, 640, 614, 639, 641, 642, 638, 643,
+	644, 625, 530, 0, 577, 636, 635, 637, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 229, 0, 288, 0, 330, 268, 603, 582, 583,
+	584, 529, 585, 580, 581, 604, 575, 600, 601, 554,
+	578, 586, 599, 587, 602, 605, 606, 645, 646, 593,
+	647, 590, 607, 598, 597, 588, 576, 608, 609, 561,
+	556, 591, 592, 579, 594, 557, 558, 559, 560, 355,
+	571, 0, 388, 389, 390, 412, 372, 0, 426, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 527, 0, 0, 0, 262, 0, 0,
+	287, 0, 0, 0, 228, 562, 0, 0, 347, 301,
+	0, 0, 0, 0, 619, 627, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 520, 0, 0, 552,
+	596, 595, 539, 548, 0, 0, 244, 179, 540, 0,
+	547, 541, 545, 544, 542, 543, 0, 611, 0, 0,
+	0, 0, 0, 0, 511, 524, 0, 528, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 521, 522, 1178, 0, 0, 0, 572, 0, 523,
+	0, 0, 567, 549, 550, 0, 0, 0, 0, 235,
+	352, 368, 245, 343, 383, 250, 350, 240, 317, 340,
+	0, 0, 237, 366, 349, 298, 281, 282, 236, 0,
+	335, 260, 274, 257, 315, 546, 570, 574, 256, 633,
+	568, 376, 239, 0, 375, 314, 362, 367, 299, 293,
+	238, 364, 297, 292, 285, 264, 634, 278, 326, 291,
+	327, 279, 304, 303, 305, 0, 0, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 302, 0, 565, 0, 0, 0,
+	379, 0, 0, 617, 0, 0, 0, 351, 0, 0,
+	286, 0, 0, 0, 569, 0, 338, 320, 630, 512,
+	0, 336, 289, 363, 328, 369, 267, 377, 380, 353,
+	378, 332, 329, 230, 354, 259, 300, 241, 243, 255,
+	261, 263, 265, 266, 310, 311, 323, 342, 356, 357,
+	358, 258, 251, 337, 252, 276, 253, 231, 344, 254,
+	233, 324, 361, 0, 272, 333, 296, 234, 295, 325,
+	360, 359, 242, 387, 393, 394, 399, 0, 400, 0,
+	0, 0, 408, 413, 414, 415, 417, 418, 419, 422,
+	420, 421, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 392, 270, 226, 227, 429, 615, 316,
+	0, 0, 629, 610, 612, 613, 616, 620, 621, 622,
+	623, 624, 626, 628, 632, 428, 0, 0, 0, 0,
+	0, 427, 322, 0, 341, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 371, 385,
+	403, 406, 0, 0, 0, 232, 405, 0, 0, 0,
+	0, 0, 0, 0, 631, 0, 0, 0, 384, 0,
+	0, 0, 0, 0, 573, 306, 307, 308, 309, 618,
+	0, 249, 404, 331, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	397, 398, 269, 275, 416, 277, 248, 321, 271, 382,
+	283, 0, 409, 0, 410, 0, 0, 0, 0, 313,
+	280, 345, 284, 290, 334, 381, 319, 339, 246, 370,
+	346, 294, 0, 0, 640, 614, 639, 641, 642, 638,
+	643, 644, 625, 530, 0, 577, 636, 635, 637, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 288, 0, 330, 268, 603, 582,
+	583, 584, 529, 585, 580, 581, 604, 575, 600, 601,
+	554, 578, 586, 599, 587, 602, 605, 606, 645, 646,
+	593, 647, 590, 607, 598, 597, 588, 576, 608, 609,
+	561, 556, 591, 592, 579, 594, 557, 558, 559, 560,
+	0, 0, 0, 388, 389, 390, 412, 372, 0, 426,
+	355, 571, 0, 0, 1707, 0, 0, 0, 0, 0,
+	0, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 527, 0, 0, 0, 262, 0,
+	0, 287, 0, 0, 0, 228, 562, 0, 0, 347,
+	301, 0, 0, 0, 0, 619, 627, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 520, 0, 0,
-	0, 261, 0, 0, 285, 0, 0, 0, 555, 0,
-	0, 344, 299, 0, 0, 0, 0, 612, 620, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 513,
-	0, 0, 545, 589, 588, 532, 541, 0, 0, 243,
-	179, 533, 0, 540, 534, 538, 537, 535, 536, 0,
-	604, 0, 0, 0, 0, 0, 0, 504, 517, 0,
-	521, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 514, 515, 0, 0, 0, 0,
-	565, 0, 516, 0, 0, 560, 542, 543, 0, 0,
-	0, 0, 234, 349, 365, 244, 340, 378, 249, 347,
-	239, 314, 337, 0, 0, 236, 363, 346, 296, 279,
-	280, 235, 0, 332, 259, 272, 256, 312, 539, 563,
-	567, 255, 626, 561, 373, 238, 0, 372, 311, 359,
-	364, 297, 291, 237, 361, 295, 290, 283, 263, 627,
-	276, 323, 289, 324, 277, 301, 300, 302, 0, 0,
-	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 558, 0, 0,
-	0, 375, 0, 0, 610, 0, 0, 0, 348, 0,
-	0, 284, 0, 0, 0, 562, 0, 335, 317, 623,
-	505, 0, 333, 287, 360, 325, 366, 350, 374, 329,
-	326, 229, 351, 258, 298, 240, 242, 254, 260, 262,
-	264, 265, 307, 308, 320, 339, 353, 354, 355, 257,
-	250, 334, 251, 274, 252, 230, 341, 253, 232, 321,
-	358, 0, 270, 330, 294, 233, 293, 322, 357, 356,
-	241, 382, 388, 389, 394, 0, 395, 0, 0, 0,
-	403, 408, 409, 410, 412, 413, 414, 415, 0, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 0, 387,
-	268, 226, 227, 422, 608, 313, 0, 0, 622, 603,
-	605, 606, 609, 613, 614, 615, 616, 617, 619, 621,
-	625, 421, 0, 0, 0, 0, 0, 420, 319, 0,
-	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 345, 368, 380, 398, 401, 0, 0,
-	0, 231, 400, 0, 0, 0, 0, 0, 0, 0,
-	624, 0, 0, 0, 379, 0, 0, 0, 0, 0,
-	566, 303, 304, 305, 306, 611, 0, 248, 399, 328,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 392, 393, 267, 273,
-	411, 275, 247, 318, 269, 377, 281, 0, 404, 0,
-	405, 0, 0, 0, 0, 310, 278, 342, 282, 288,
-	331, 376, 316, 336, 245, 367, 343, 292, 0, 0,
-	633, 607, 632, 634, 635, 631, 636, 637, 618, 523,
-	0, 570, 629, 628, 630, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
-	286, 0, 327, 266, 596, 575, 576, 577, 522, 578,
-	573, 574, 597, 568, 593, 594, 547, 571, 579, 592,
-	580, 595, 598, 599, 638, 639, 586, 640, 583, 600,
-	591, 590, 581, 569, 601, 602, 554, 549, 584, 585,
-	572, 587, 550, 551, 552, 553, 352, 564, 0, 383,
-	384, 385, 407, 369, 0, 419, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 1294, 0, 0, 0,
-	520, 0, 0, 0, 261, 0, 0, 285, 0, 0,
-	0, 555, 0, 0, 344, 299, 0, 0, 0, 0,
-	612, 620, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 513, 0, 0, 545, 589, 588, 532, 541,
-	0, 0, 243, 179, 533, 0, 540, 534, 538, 537,
-	535, 536, 0, 604, 0, 0, 0, 0, 0, 0,
-	0, 517, 0, 521, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 514, 515, 0,
-	0, 0, 0, 565, 0, 516, 0, 0, 560, 542,
-	543, 0, 0, 0, 0, 234, 349, 365, 244, 340,
-	378, 249, 347, 239, 314, 337, 0, 0, 236, 363,
-	346, 296, 279, 280, 235, 0, 332, 259, 272, 256,
-	312, 539, 563, 567, 255, 626, 561, 373, 238, 0,
-	372, 311, 359, 364, 297, 291, 237, 361, 295, 290,
-	283, 263, 627, 276, 323, 289, 324, 277, 301, 300,
-	302, 0, 0, 0, 0, 0, 402, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	558, 0, 0, 0, 375, 0, 0, 610, 0, 0,
-	0, 348, 0, 0, 284, 0, 0, 0, 562, 0,
-	335, 317, 623, 0, 0, 333, 287, 360, 325, 366,
-	350, 374, 329, 326, 229, 351, 258, 298, 240, 242,
-	254, 260, 262, 264, 265, 307, 308, 320, 339, 353,
-	354, 355, 257, 250, 334, 251, 274, 252, 230, 341,
-	253, 232, 321, 358, 0, 270, 330, 294, 233, 293,
-	322, 357, 356, 241, 382, 1295, 1296, 394, 0, 395,
-	0, 0, 0, 403, 408, 409, 410, 412, 413, 414,
-	415, 0, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 0, 387, 268, 226, 227, 422, 608, 313, 0,
-	0, 622, 603, 605, 606, 609, 613, 614, 615, 616,
-	617, 619, 621, 625, 421, 0, 0, 0, 0, 0,
-	420, 319, 0, 338, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 345, 368, 380, 398,
-	401, 0, 0, 0, 231, 400, 0, 0, 0, 0,
-	0, 0, 0, 624, 0, 0, 0, 379, 0, 0,
-	0, 0, 0, 566, 303, 304, 305, 306, 611, 0,
-	248, 399, 328, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
-	393, 267, 273, 411, 275, 247, 318, 269, 377, 281,
-	0, 404, 0, 405, 0, 0, 0, 0, 310, 278,
-	342, 282, 288, 331, 376, 316, 336, 245, 367, 343,
-	292, 0, 0, 633, 607, 632, 634, 635, 631, 636,
-	637, 618, 523, 0, 570, 629, 628, 630, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 286, 0, 327, 266, 596, 575, 576,
-	577, 522, 578, 573, 574, 597, 568, 593, 594, 547,
-	571, 579, 592, 580, 595, 598, 599, 638, 639, 586,
-	640, 583, 600, 591, 590, 581, 569, 601, 602, 554,
-	549, 584, 585, 572, 587, 550, 551, 552, 553, 352,
-	564, 0, 383, 384, 385, 407, 369, 0, 419, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 520, 0, 0, 0, 261, 0, 0,
-	285, 0, 0, 0, 555, 0, 0, 344, 299, 0,
-	0, 0, 0, 612, 620, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 545, 589,
-	588, 532, 541, 0, 0, 243, 179, 533, 0, 540,
-	534, 538, 537, 535, 536, 0, 604, 0, 0, 0,
-	0, 0, 0, 504, 517, 0, 521, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	514, 515, 0, 0, 0, 0, 565, 0, 516, 0,
-	0, 560, 542, 543, 0, 0, 0, 0, 234, 349,
-	365, 244, 340, 378, 249, 347, 239, 314, 337, 0,
-	0, 236, 363, 346, 296, 279, 280, 235, 0, 332,
-	259, 272, 256, 312, 539, 563, 567, 255, 626, 561,
-	373, 238, 0, 372, 311, 359, 364, 297, 291, 237,
-	361, 295, 290, 283, 263, 627, 276, 323, 289, 324,
-	277, 301, 300, 302, 0, 0, 0, 0, 0, 402,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 558, 0, 0, 0, 375, 0, 0,
-	610, 0, 0, 0, 348, 0, 0, 284, 0, 0,
-	0, 562, 0, 335, 317, 623, 505, 0, 333, 287,
-	360, 325, 366, 350, 374, 329, 326, 229, 351, 258,
-	298, 240, 242, 254, 260, 262, 264, 265, 307, 308,
-	320, 339, 353, 354, 355, 257, 250, 334, 251, 274,
-	252, 230, 341, 253, 232, 321, 358, 0, 270, 330,
-	294, 233, 293, 322, 357, 356, 241, 382, 388, 389,
-	394, 0, 395, 0, 0, 0, 403, 408, 409, 410,
-	412, 413, 414, 415, 0, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 0, 387, 268, 226, 227, 422,
-	608, 313, 0, 0, 622, 603, 605, 606, 609, 613,
-	614, 615, 616, 617, 619, 621, 625, 421, 0, 0,
-	0, 0, 0, 420, 319, 0, 338, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 345,
-	368, 380, 398, 401, 0, 0, 0, 231, 400, 0,
-	0, 0, 0, 0, 0, 0, 624, 0, 0, 0,
-	379, 0, 0, 0, 0, 0, 566, 303, 304, 305,
-	306, 611, 0, 248, 399, 328, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 392, 393, 267, 273, 411, 275, 247, 318,
-	269, 377, 281, 0, 404, 0, 405, 0, 0, 0,
-	0, 310, 278, 342, 282, 288, 331, 376, 316, 336,
-	245, 367, 343, 292, 0, 0, 633, 607, 632, 634,
-	635, 631, 636, 637, 618, 523, 0, 570, 629, 628,
-	630, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 228, 0, 286, 0, 327, 266,
-	596, 575, 576, 577, 522, 578, 573, 574, 597, 568,
-	593, 594, 547, 571, 579, 592, 580, 595, 598, 599,
-	638, 639, 586, 640, 583, 600, 591, 590, 581, 569,
-	601, 602, 554, 549, 584, 585, 572, 587, 550, 551,
-	552, 553, 352, 564, 0, 383, 384, 385, 407, 369,
-	0, 419, 0, 315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 520, 0, 0, 0,
-	261, 0, 0, 285, 0, 0, 0, 555, 0, 0,
-	344, 299, 0, 0, 0, 0, 612, 620, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 513, 0,
-	0, 545, 589, 588, 532, 541, 0, 0, 243, 179,
-	533, 0, 540, 534, 538, 537, 535, 536, 0, 604,
-	0, 0, 0, 0, 0, 0, 0, 517, 0, 521,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 514, 515, 0, 0, 0, 0, 565,
-	0, 516, 0, 0, 560, 542, 543, 0, 0, 0,
-	0, 234, 349, 365, 244, 340, 378, 249, 347, 239,
-	314, 337, 0, 0, 236, 363, 346, 296, 279, 280,
-	235, 0, 332, 259, 272, 256, 312, 539, 563, 567,
-	255, 626, 561, 373, 238, 0, 372, 311, 359, 364,
-	297, 291, 237, 361, 295, 290, 283, 263, 627, 276,
-	323, 289, 324, 277, 301, 300, 302, 0, 0, 0,
-	0, 0, 402, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 558, 0, 0, 0,
-	375, 0, 0, 610, 0, 0, 0, 348, 0, 0,
-	284, 0, 0, 0, 562, 0, 335, 317, 623, 0,
-	0, 333, 287, 360, 325, 366, 350, 374, 329, 326,
-	229, 351, 258, 298, 240, 242, 254, 260, 262, 264,
-	265, 307, 308, 320, 339, 353, 354, 355, 257, 250,
-	334, 251, 274, 252, 230, 341, 253, 232, 321, 358,
-	0, 270, 330, 294, 233, 293, 322, 357, 356, 241,
-	382, 388, 389, 394, 0, 395, 0, 0, 0, 403,
-	408, 409, 410, 412, 413, 414, 415, 0, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 0, 387, 268,
-	226, 227, 422, 608, 313, 0, 0, 622, 603, 605,
-	606, 609, 613, 614, 615, 616, 617, 619, 621, 625,
-	421, 0, 0, 0, 0, 0, 420, 319, 0, 338,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 345, 368, 380, 398, 401, 0, 0, 0,
-	231, 400, 0, 0, 0, 0, 0, 0, 0, 624,
-	0, 0, 0, 379, 0, 0, 0, 0, 0, 566,
-	303, 304, 305, 306, 611, 0, 248, 399, 328, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 392, 393, 267, 273, 411,
-	275, 247, 318, 269, 377, 281, 0, 404, 0, 405,
-	0, 0, 0, 0, 310, 278, 342, 282, 288, 331,
-	376, 316, 336, 245, 367, 343, 292, 0, 0, 633,
-	607, 632, 634, 635, 631, 636, 637, 618, 523, 0,
-	570, 629, 628, 630, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 286,
-	0, 327, 266, 596, 575, 576, 577, 522, 578, 573,
-	574, 597, 568, 593, 594, 547, 571, 579, 592, 580,
-	595, 598, 599, 638, 639, 586, 640, 583, 600, 591,
-	590, 581, 569, 601, 602, 554, 549, 584, 585, 572,
-	587, 550, 551, 552, 553, 0, 0, 0, 383, 384,
-	385, 407, 369, 0, 419, 156, 352, 49, 148, 125,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
+	552, 596, 595, 539, 548, 0, 0, 244, 179, 540,
+	0, 547, 541, 545, 544, 542, 543, 0, 611, 0,
+	0, 0, 0, 0, 0, 511, 524, 0, 528, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 521, 522, 0, 0, 0, 0, 572, 0,
+	523, 0, 0, 567, 549, 550, 0, 0, 0, 0,
+	235, 352, 368, 245, 343, 383, 250, 350, 240, 317,
+	340, 0, 0, 237, 366, 349, 298, 281, 282, 236,
+	0, 335, 260, 274, 257, 315, 546, 570, 574, 256,
+	633, 568, 376, 239, 0, 375, 314, 362, 367, 299,
+	293, 238, 364, 297, 292, 285, 264, 634, 278, 326,
+	291, 327, 279, 304, 303, 305, 0, 0, 0, 0,
+	0, 407, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 0, 565, 0, 0,
+	0, 379, 0, 0, 617, 0, 0, 0, 351, 0,
+	0, 286, 0, 0, 0, 569, 0, 338, 320, 630,
+	512, 0, 336, 289, 363, 328, 369, 267, 377, 380,
+	353, 378, 332, 329, 230, 354, 259, 300, 241, 243,
+	255, 261, 263, 265, 266, 310, 311, 323, 342, 356,
+	357, 358, 258, 251, 337, 252, 276, 253, 231, 344,
+	254, 233, 324, 361, 0, 272, 333, 296, 234, 295,
+	325, 360, 359, 242, 387, 393, 394, 399, 0, 400,
+	0, 0, 0, 408, 413, 414, 415, 417, 418, 419,
+	422, 420, 421, 0, 0, 0, 0, 402, 0, 0,
+	0, 0, 0, 0, 392, 270, 226, 227, 429, 615,
+	316, 0, 0, 629, 610, 612, 613, 616, 620, 621,
+	622, 623, 624, 626, 628, 632, 428, 0, 0, 0,
+	0, 0, 427, 322, 0, 341, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 348, 371,
+	385, 403, 406, 0, 0, 0, 232, 405, 0, 0,
+	0, 0, 0, 0, 0, 631, 0, 0, 0, 384,
+	0, 0, 0, 0, 0, 573, 306, 307, 308, 309,
+	618, 0, 249, 404, 331, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 397, 398, 269, 275, 416, 277, 248, 321, 271,
+	382, 283, 0, 409, 0, 410, 0, 0, 0, 0,
+	313, 280, 345, 284, 290, 334, 381, 319, 339, 246,
+	370, 346, 294, 0, 0, 640, 614, 639, 641, 642,
+	638, 643, 644, 625, 530, 0, 577, 636, 635, 637,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 229, 0, 288, 0, 330, 268, 603,
+	582, 583, 584, 529, 585, 580, 581, 604, 575, 600,
+	601, 554, 578, 586, 599, 587, 602, 605, 606, 645,
+	646, 593, 647, 590, 607, 598, 597, 588, 576, 608,
+	609, 561, 556, 591, 592, 579, 594, 557, 558, 559,
+	560, 355, 571, 0, 388, 389, 390, 412, 372, 0,
+	426, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 527, 0, 0, 0, 262,
+	0, 0, 287, 0, 0, 0, 228, 562, 0, 0,
+	347, 301, 0, 0, 0, 0, 619, 627, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 520, 0,
+	0, 552, 596, 595, 539, 548, 0, 0, 244, 179,
+	540, 0, 547, 541, 545, 544, 542, 543, 0, 611,
+	0, 0, 0, 0, 0, 0, 511, 524, 0, 528,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 521, 522, 0, 0, 0, 0, 572,
+	0, 523, 0, 0, 567, 549, 550, 0, 0, 0,
+	0, 235, 352, 368, 245, 343, 383, 250, 350, 240,
+	317, 340, 0, 0, 237, 366, 349, 298, 281, 282,
+	236, 0, 335, 260, 274, 257, 315, 546, 570, 574,
+	256, 633, 568, 376, 239, 0, 375, 314, 362, 367,
+	299, 293, 238, 364, 297, 292, 285, 264, 634, 278,
+	326, 291, 327, 279, 304, 303, 305, 0, 0, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 302, 0, 565, 0,
+	0, 0, 379, 0, 0, 617, 0, 0, 0, 351,
+	0, 0, 286, 0, 0, 0, 569, 0, 338, 320,
+	630, 512, 0, 336, 289, 363, 328, 369, 267, 377,
+	380, 353, 378, 332, 329, 230, 354, 259, 300, 241,
+	243, 255, 261, 263, 265, 266, 310, 311, 323, 342,
+	356, 357, 358, 258, 251, 337, 252, 276, 253, 231,
+	344, 254, 233, 324, 361, 0, 272, 333, 296, 234,
+	295, 325, 360, 359, 242, 387, 393, 394, 399, 0,
+	400, 0, 0, 0, 408, 413, 414, 415, 417, 418,
+	419, 422, 420, 421, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 392, 270, 226, 227, 429,
+	615, 316, 0, 0, 629, 610, 612, 613, 616, 620,
+	621, 622, 623, 624, 626, 628, 632, 428, 0, 0,
+	0, 0, 0, 427, 322, 0, 341, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 348,
+	371, 385, 403, 406, 0, 0, 0, 232, 405, 0,
+	0, 0, 0, 0, 0, 0, 631, 0, 0, 0,
+	384, 0, 0, 0, 0, 0, 573, 306, 307, 308,
+	309, 618, 0, 249, 404, 331, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 397, 398, 269, 275, 416, 277, 248, 321,
+	271, 382, 283, 0, 409, 0, 410, 0, 0, 0,
+	0, 313, 280, 345, 284, 290, 334, 381, 319, 339,
+	246, 370, 346, 294, 0, 0, 640, 614, 639, 641,
+	642, 638, 643, 644, 625, 530, 0, 577, 636, 635,
+	637, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 288, 0, 330, 268,
+	603, 582, 583, 584, 529, 585, 580, 581, 604, 575,
+	600, 601, 554, 578, 586, 599, 587, 602, 605, 606,
+	645, 646, 593, 647, 590, 607, 598, 597, 588, 576,
+	608, 609, 561, 556, 591, 592, 579, 594, 557, 558,
+	559, 560, 355, 571, 0, 388, 389, 390, 412, 372,
+	0, 426, 0, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 1301, 0, 0, 0, 527, 0, 0, 0,
+	262, 0, 0, 287, 0, 0, 0, 228, 562, 0,
+	0, 347, 301, 0, 0, 0, 0, 619, 627, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 520,
+	0, 0, 552, 596, 595, 539, 548, 0, 0, 244,
+	179, 540, 0, 547, 541, 545, 544, 542, 543, 0,
+	611, 0, 0, 0, 0, 0, 0, 0, 524, 0,
+	528, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 521, 522, 0, 0, 0, 0,
+	572, 0, 523, 0, 0, 567, 549, 550, 0, 0,
+	0, 0, 235, 352, 368, 245, 343, 383, 250, 350,
+	240, 317, 340, 0, 0, 237, 366, 349, 298, 281,
+	282, 236, 0, 335, 260, 274, 257, 315, 546, 570,
+	574, 256, 633, 568, 376, 239, 0, 375, 314, 362,
+	367, 299, 293, 238, 364, 297, 292, 285, 264, 634,
+	278, 326, 291, 327, 279, 304, 303, 305, 0, 0,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 0, 565,
+	0, 0, 0, 379, 0, 0, 617, 0, 0, 0,
+	351, 0, 0, 286, 0, 0, 0, 569, 0, 338,
+	320, 630, 0, 0, 336, 289, 363, 328, 369, 267,
+	377, 380, 353, 378, 332, 329, 230, 354, 259, 300,
+	241, 243, 255, 261, 263, 265, 266, 310, 311, 323,
+	342, 356, 357, 358, 258, 251, 337, 252, 276, 253,
+	231, 344, 254, 233, 324, 361, 0, 272, 333, 296,
+	234, 295, 325, 360, 359, 242, 387, 1302, 1303, 399,
+	0, 400, 0, 0, 0, 408, 413, 414, 415, 417,
+	418, 419, 422, 420, 421, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 392, 270, 226, 227,
+	429, 615, 316, 0, 0, 629, 610, 612, 613, 616,
+	620, 621, 622, 623, 624, 626, 628, 632, 428, 0,
+	0, 0, 0, 0, 427, 322, 0, 341, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	348, 371, 385, 403, 406, 0, 0, 0, 232, 405,
+	0, 0, 0, 0, 0, 0, 0, 631, 0, 0,
+	0, 384, 0, 0, 0, 0, 0, 573, 306, 307,
+	308, 309, 618, 0, 249, 404, 331, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 397, 398, 269, 275, 416, 277, 248,
+	321, 271, 382, 283, 0, 409, 0, 410, 0, 0,
+	0, 0, 313, 280, 345, 284, 290, 334, 381, 319,
+	339, 246, 370, 346, 294, 0, 0, 640, 614, 639,
+	641, 642, 638, 643, 644, 625, 530, 0, 577, 636,
+	635, 637, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 229, 0, 288, 0, 330,
+	268, 603, 582, 583, 584, 529, 585, 580, 581, 604,
+	575, 600, 601, 554, 578, 586, 599, 587, 602, 605,
+	606, 645, 646, 593, 647, 590, 607, 598, 597, 588,
+	576, 608, 609, 561, 556, 591, 592, 579, 594, 557,
+	558, 559, 560, 355, 571, 0, 388, 389, 390, 412,
+	372, 0, 426, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 527, 0, 0,
+	0, 262, 0, 0, 287, 0, 0, 0, 228, 562,
+	0, 0, 347, 301, 0, 0, 0, 0, 619, 627,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 552, 596, 595, 539, 548, 0, 0,
+	244, 179, 540, 0, 547, 541, 545, 544, 542, 543,
+	0, 611, 0, 0, 0, 0, 0, 0, 511, 524,
+	0, 528, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 521, 522, 0, 0, 0,
+	0, 572, 0, 523, 0, 0, 567, 549, 550, 0,
+	0, 0, 0, 235, 352, 368, 245, 343, 383, 250,
+	350, 240, 317, 340, 0, 0, 237, 366, 349, 298,
+	281, 282, 236, 0, 335, 260, 274, 257, 315, 546,
+	570, 574, 256, 633, 568, 376, 239, 0, 375, 314,
+	362, 367, 299, 293, 238, 364, 297, 292, 285, 264,
+	634, 278, 326, 291, 327, 279, 304, 303, 305, 0,
+	0, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	565, 0, 0, 0, 379, 0, 0, 617, 0, 0,
+	0, 351, 0, 0, 286, 0, 0, 0, 569, 0,
+	338, 320, 630, 512, 0, 336, 289, 363, 328, 369,
+	267, 377, 380, 353, 378, 332, 329, 230, 354, 259,
+	300, 241, 243, 255, 261, 263, 265, 266, 310, 311,
+	323, 342, 356, 357, 358, 258, 251, 337, 252, 276,
+	253, 231, 344, 254, 233, 324, 361, 0, 272, 333,
+	296, 234, 295, 325, 360, 359, 242, 387, 393, 394,
+	399, 0, 400, 0, 0, 0, 408, 413, 414, 415,
+	417, 418, 419, 422, 420, 421, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 392, 270, 226,
+	227, 429, 615, 316, 0, 0, 629, 610, 612, 613,
+	616, 620, 621, 622, 623, 624, 626, 628, 632, 428,
+	0, 0, 0, 0, 0, 427, 322, 0, 341, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 371, 385, 403, 406, 0, 0, 0, 232,
+	405, 0, 0, 0, 0, 0, 0, 0, 631, 0,
+	0, 0, 384, 0, 0, 0, 0, 0, 573, 306,
+	307, 308, 309, 618, 0, 249, 404, 331, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 397, 398, 269, 275, 416, 277,
+	248, 321, 271, 382, 283, 0, 409, 0, 410, 0,
+	0, 0, 0, 313, 280, 345, 284, 290, 334, 381,
+	319, 339, 246, 370, 346, 294, 0, 0, 640, 614,
+	639, 641, 642, 638, 643, 644, 625, 530, 0, 577,
+	636, 635, 637, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 288, 0,
+	330, 268, 603, 582, 583, 584, 529, 585, 580, 581,
+	604, 575, 600, 601, 554, 578, 586, 599, 587, 602,
+	605, 606, 645, 646, 593, 647, 590, 607, 598, 597,
+	588, 576, 608, 609, 561, 556, 591, 592, 579, 594,
+	557, 558, 559, 560, 355, 571, 0, 388, 389, 390,
+	412, 372, 0, 426, 0, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 527, 0,
+	0, 0, 262, 0, 0, 287, 0, 0, 0, 228,
+	562, 0, 0, 347, 301, 0, 0, 0, 0, 619,
+	627, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 520, 0, 0, 552, 596, 595, 539, 548, 0,
+	0, 244, 179, 540, 0, 547, 541, 545, 544, 542,
+	543, 0, 611, 0, 0, 0, 0, 0, 0, 0,
+	524, 0, 528, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 521, 522, 0, 0,
+	0, 0, 572, 0, 523, 0, 0, 567, 549, 550,
+	0, 0, 0, 0, 235, 352, 368, 245, 343, 383,
+	250, 350, 240, 317, 340, 0, 0, 237, 366, 349,
+	298, 281, 282, 236, 0, 335, 260, 274, 257, 315,
+	546, 570, 574, 256, 633, 568, 376, 239, 0, 375,
+	314, 362, 367, 299, 293, 238, 364, 297, 292, 285,
+	264, 634, 278, 326, 291, 327, 279, 304, 303, 305,
+	0, 0, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	0, 565, 0, 0, 0, 379, 0, 0, 617, 0,
+	0, 0, 351, 0, 0, 286, 0, 0, 0, 569,
+	0, 338, 320, 630, 0, 0, 336, 289, 363, 328,
+	369, 267, 377, 380, 353, 378, 332, 329, 230, 354,
+	259, 300, 241, 243, 255, 261, 263, 265, 266, 310,
+	311, 323, 342, 356, 357, 358, 258, 251, 337, 252,
+	276, 253, 231, 344, 254, 233, 324, 361, 0, 272,
+	333, 296, 234, 295, 325, 360, 359, 242, 387, 393,
+	394, 399, 0, 400, 0, 0, 0, 408, 413, 414,
+	415, 417, 418, 419, 422, 420, 421, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 392, 270,
+	226, 227, 429, 615, 316, 0, 0, 629, 610, 612,
+	613, 616, 620, 621, 622, 623, 624, 626, 628, 632,
+	428, 0, 0, 0, 0, 0, 427, 322, 0, 341,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 348, 371, 385, 403, 406, 0, 0, 0,
+	232, 405, 0, 0, 0, 0, 0, 0, 0, 631,
+	0, 0, 0, 384, 0, 0, 0, 0, 0, 573,
+	306, 307, 308, 309, 618, 0, 249, 404, 331, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 397, 398, 269, 275, 416,
+	277, 248, 321, 271, 382, 283, 0, 409, 0, 410,
+	0, 0, 0, 0, 313, 280, 345, 284, 290, 334,
+	381, 319, 339, 246, 370, 346, 294, 0, 0, 640,
+	614, 639, 641, 642, 638, 643, 644, 625, 530, 0,
+	577, 636, 635, 637, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 229, 0, 288,
+	0, 330, 268, 603, 582, 583, 584, 529, 585, 580,
+	581, 604, 575, 600, 601, 554, 578, 586, 599, 587,
+	602, 605, 606, 645, 646, 593, 647, 590, 607, 598,
+	597, 588, 576, 608, 609, 561, 556, 591, 592, 579,
+	594, 557, 558, 559, 560, 0, 0, 0, 388, 389,
+	390, 412, 372, 0, 426, 156, 355, 49, 148, 125,
+	0, 0, 0, 0, 0, 0, 0, 318, 0, 0,
 	0, 0, 0, 0, 0, 149, 0, 0, 0, 0,
-	0, 0, 141, 0, 261, 0, 150, 285, 0, 0,
-	0, 108, 0, 0, 344, 299, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
-	0, 0, 153, 0, 0, 178, 0, 0, 0, 0,
-	0, 0, 243, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 170, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 234, 349, 365, 244, 340,
-	378, 249, 347, 239, 314, 337, 0, 0, 236, 363,
-	346, 296, 279, 280, 235, 0, 332, 259, 272, 256,
-	312, 0, 362, 390, 255, 381, 0, 373, 238, 0,
-	372, 311, 359, 364, 297, 291, 237, 361, 295, 290,
-	283, 263, 406, 276, 323, 289, 324, 277, 301, 300,
-	302, 0, 0, 0, 0, 0, 402, 0, 0, 0,
-	0, 0, 0, 124, 147, 154, 0, 95, 0, 0,
-	0, 0, 0, 0, 375, 0, 0, 171, 0, 0,
-	0, 348, 0, 0, 284, 146, 140, 139, 391, 0,
-	335, 317, 55, 0, 0, 333, 287, 360, 325, 366,
-	350, 374, 329, 326, 229, 351, 258, 298, 240, 242,
-	254, 260, 262, 264, 265, 307, 308, 320, 339, 353,
-	354, 355, 257, 250, 334, 251, 274, 252, 230, 341,
-	253, 232, 321, 358, 0, 270, 330, 294, 233, 293,
-	322, 357, 356, 241, 382, 388, 389, 394, 0, 395,
-	142, 143, 144, 403, 408, 409, 410, 412, 413, 414,
-	415, 0, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 0, 387, 268, 226, 227, 370, 0, 313, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 309, 386,
-	174, 0, 0, 0, 182, 0, 0, 0, 145, 0,
-	183, 319, 0, 338, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 345, 368, 380, 398,
-	401, 0, 0, 0, 231, 400, 0, 0, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 379, 0, 0,
-	0, 0, 0, 396, 303, 304, 305, 306, 271, 0,
-	248, 399, 328, 106, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 48, 0, 0, 0, 0, 0, 392,
-	393, 267, 273, 411, 275, 247, 318, 269, 377, 281,
-	0, 404, 0, 405, 0, 0, 0, 0, 310, 278,
-	342, 282, 288, 331, 376, 316, 336, 245, 367, 343,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 50, 0, 0, 221, 0, 0, 0, 0, 0,
+	0, 0, 141, 0, 262, 0, 150, 287, 0, 0,
+	0, 228, 108, 0, 0, 347, 301, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 97, 0, 0,
+	0, 0, 0, 153, 0, 0, 178, 0, 0, 0,
+	0, 0, 0, 244, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 170, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 235, 352, 368, 245,
+	343, 383, 250, 350, 240, 317, 340, 0, 0, 237,
+	366, 349, 298, 281, 282, 236, 0, 335, 260, 274,
+	257, 315, 0, 365, 395, 256, 386, 0, 376, 239,
+	0, 375, 314, 362, 367, 299, 293, 238, 364, 297,
+	292, 285, 264, 411, 278, 326, 291, 327, 279, 304,
+	303, 305, 0, 0, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 0, 124, 147, 154, 0, 95, 0,
+	0, 302, 0, 0, 0, 0, 0, 379, 0, 0,
+	171, 0, 0, 0, 351, 0, 0, 286, 146, 140,
+	139, 396, 0, 338, 320, 55, 0, 0, 336, 289,
+	363, 328, 369, 267, 377, 380, 353, 378, 332, 329,
+	230, 354, 259, 300, 241, 243, 255, 261, 263, 265,
+	266, 310, 311, 323, 342, 356, 357, 358, 258, 251,
+	337, 252, 276, 253, 231, 344, 254, 233, 324, 361,
+	0, 272, 333, 296, 234, 295, 325, 360, 359, 242,
+	387, 393, 394, 399, 0, 400, 142, 143, 144, 408,
+	413, 414, 415, 417, 418, 419, 422, 420, 421, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	392, 270, 226, 227, 373, 0, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 312, 391, 174, 0,
+	0, 0, 182, 0, 0, 0, 145, 0, 183, 322,
+	0, 341, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 371, 385, 403, 406, 0,
+	0, 0, 232, 405, 0, 0, 0, 0, 0, 0,
+	0, 374, 0, 0, 0, 384, 0, 0, 0, 0,
+	0, 401, 306, 307, 308, 309, 273, 0, 249, 404,
+	331, 106, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 48, 0, 0, 0, 0, 0, 397, 398, 269,
+	275, 416, 277, 248, 321, 271, 382, 283, 0, 409,
+	0, 410, 0, 0, 0, 0, 313, 280, 345, 284,
+	290, 334, 381, 319, 339, 246, 370, 346, 294, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 50,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
+	0, 288, 126, 330, 268, 185, 186, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 0, 222, 223, 224, 225, 0, 0, 0,
+	388, 389, 390, 412, 372, 355, 184, 38, 172, 175,
+	177, 176, 0, 47, 5, 0, 318, 111, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 0, 0, 287, 0, 0, 0,
+	228, 0, 0, 0, 347, 301, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 995, 0, 0, 178, 0, 0, 539, 548,
+	0, 0, 244, 179, 540, 0, 547, 541, 545, 544,
+	542, 543, 0, 247, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 549,
+	0, 0, 0, 0, 0, 235, 352, 368, 245, 343,
+	383, 250, 350, 240, 317, 340, 0, 0, 237, 366,
+	349, 298, 281, 282, 236, 0, 335, 260, 274, 257,
+	315, 546, 365, 395, 256, 386, 0, 376, 239, 0,
+	375, 314, 362, 367, 299, 293, 238, 364, 297, 292,
+	285, 264, 411, 278, 326, 291, 327, 279, 304, 303,
+	305, 0, 0, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	302, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 0, 0, 351, 0, 0, 286, 0, 0, 0,
+	396, 0, 338, 320, 0, 0, 0, 336, 289, 363,
+	328, 369, 267, 377, 380, 353, 378, 332, 329, 230,
+	354, 259, 300, 241, 243, 255, 261, 263, 265, 266,
+	310, 311, 323, 342, 356, 357, 358, 258, 251, 337,
+	252, 276, 253, 231, 344, 254, 233, 324, 361, 0,
+	272, 333, 296, 234, 295, 325, 360, 359, 242, 387,
+	393, 394, 399, 0, 400, 0, 0, 0, 408, 413,
+	414, 415, 417, 418, 419, 422, 420, 421, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 392,
+	270, 226, 227, 429, 0, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 391, 0, 0, 0,
+	0, 428, 0, 0, 0, 0, 0, 427, 322, 0,
+	341, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 348, 371, 385, 403, 406, 0, 0,
+	0, 232, 405, 0, 0, 0, 0, 0, 0, 0,
+	374, 0, 0, 0, 384, 0, 0, 0, 0, 0,
+	401, 306, 307, 308, 309, 273, 0, 249, 404, 331,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 397, 398, 269, 275,
+	416, 277, 248, 321, 271, 382, 283, 0, 409, 0,
+	410, 0, 0, 0, 0, 313, 280, 345, 284, 290,
+	334, 381, 319, 339, 246, 370, 346, 294, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 286, 126, 327, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 0,
-	0, 0, 383, 384, 385, 407, 369, 352, 184, 38,
-	172, 175, 177, 176, 0, 47, 5, 0, 315, 111,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 285, 0,
-	0, 0, 0, 0, 0, 344, 299, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 988, 0, 0, 178, 0, 0, 532,
-	541, 0, 0, 243, 179, 533, 0, 540, 534, 538,
-	537, 535, 536, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	542, 0, 0, 0, 0, 0, 234, 349, 365, 244,
-	340, 378, 249, 347, 239, 314, 337, 0, 0, 236,
-	363, 346, 296, 279, 280, 235, 0, 332, 259, 272,
-	256, 312, 539, 362, 390, 255, 381, 0, 373, 238,
-	0, 372, 311, 359, 364, 297, 291, 237, 361, 295,
-	290, 283, 263, 406, 276, 323, 289, 324, 277, 301,
-	300, 302, 0, 0, 0, 0, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 375, 0, 0, 0, 0,
-	0, 0, 348, 0, 0, 284, 0, 0, 0, 391,
-	0, 335, 317, 0, 0, 0, 333, 287, 360, 325,
-	366, 350, 374, 329, 326, 229, 351, 258, 298, 240,
-	242, 254, 260, 262, 264, 265, 307, 308, 320, 339,
-	353, 354, 355, 257, 250, 334, 251, 274, 252, 230,
-	341, 253, 232, 321, 358, 0, 270, 330, 294, 233,
-	293, 322, 357, 356, 241, 382, 388, 389, 394, 0,
-	395, 0, 0, 0, 403, 408, 409, 410, 412, 413,
-	414, 415, 0, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 0, 387, 268, 226, 227, 422, 0, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 309,
-	386, 0, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 420, 319, 0, 338, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 345, 368, 380,
-	398, 401, 0, 0, 0, 231, 400, 0, 0, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 379, 0,
-	0, 0, 0, 0, 396, 303, 304, 305, 306, 271,
-	0, 248, 399, 328, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	392, 393, 267, 273, 411, 275, 247, 318, 269, 377,
-	281, 0, 404, 0, 405, 0, 0, 0, 0, 310,
-	278, 342, 282, 288, 331, 376, 316, 336, 245, 367,
-	343, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	288, 0, 330, 268, 185, 186, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 0, 222, 223, 224, 225, 355, 0, 0, 388,
+	389, 390, 412, 372, 0, 426, 0, 318, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 286, 0, 327, 266, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
-	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
-	0, 0, 0, 383, 384, 385, 407, 369, 0, 419,
-	156, 352, 49, 148, 125, 0, 0, 0, 0, 0,
-	0, 0, 315, 439, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 285, 0, 0, 0, 0, 0, 0, 344,
-	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 444, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 349, 365, 244, 340, 378, 249, 347, 239, 314,
-	337, 0, 0, 236, 363, 346, 296, 279, 280, 235,
-	0, 332, 259, 272, 256, 312, 0, 362, 390, 255,
-	381, 0, 373, 238, 0, 372, 311, 359, 364, 297,
-	291, 237, 361, 295, 290, 283, 263, 406, 276, 323,
-	289, 324, 277, 301, 300, 302, 0, 0, 0, 0,
-	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 443, 0, 0, 0, 0, 0, 0, 375,
-	0, 0, 0, 0, 0, 0, 348, 0, 0, 284,
-	0, 0, 0, 391, 0, 335, 317, 0, 0, 0,
-	333, 287, 360, 325, 366, 350, 374, 329, 326, 229,
-	351, 258, 298, 240, 242, 254, 260, 262, 264, 265,
-	307, 308, 320, 339, 353, 354, 355, 257, 250, 334,
-	251, 274, 252, 230, 341, 253, 232, 321, 358, 0,
-	270, 330, 294, 233, 293, 322, 357, 356, 241, 382,
-	388, 389, 394, 0, 395, 0, 0, 0, 403, 408,
-	409, 410, 412, 413, 414, 415, 0, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 0, 387, 268, 226,
-	227, 422, 0, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 309, 386, 0, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 420, 319, 0, 338, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 345, 368, 380, 398, 401, 0, 0, 0, 231,
-	400, 0, 0, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 379, 0, 0, 0, 0, 0, 396, 303,
-	304, 305, 306, 440, 442, 248, 399, 328, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 392, 393, 267, 273, 411, 275,
-	247, 318, 269, 377, 281, 0, 404, 0, 405, 0,
-	0, 0, 0, 310, 278, 342, 282, 288, 331, 376,
-	316, 336, 245, 367, 343, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 50, 0, 0, 221,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 286, 126,
-	327, 266, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
-	222, 223, 224, 225, 352, 0, 0, 383, 384, 385,
-	407, 369, 0, 419, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 819, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 0, 0, 285, 0, 0, 0, 0,
-	0, 0, 344, 299, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 0, 287, 0, 0,
+	0, 228, 0, 0, 0, 347, 301, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 0, 0, 0, 0,
-	243, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 807, 0, 0, 0,
-	0, 0, 0, 234, 349, 365, 244, 340, 378, 249,
-	347, 239, 314, 337, 0, 0, 1771, 1773, 1774, 1775,
-	1776, 1777, 1778, 0, 1782, 1779, 1780, 1781, 312, 0,
-	1766, 1767, 1768, 1769, 805, 1752, 1772, 0, 1753, 311,
-	1754, 1755, 1756, 1757, 1758, 1759, 1760, 1761, 1762, 1763,
-	1764, 1770, 323, 289, 324, 277, 301, 300, 302, 830,
-	832, 834, 836, 839, 402, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 375, 0, 0, 0, 0, 0, 0, 348,
-	0, 0, 284, 0, 0, 0, 1765, 0, 335, 317,
-	0, 0, 0, 333, 287, 360, 325, 366, 350, 374,
-	329, 326, 229, 351, 258, 298, 240, 242, 254, 260,
-	262, 264, 265, 307, 308, 320, 339, 353, 354, 355,
-	257, 250, 334, 251, 274, 252, 230, 341, 253, 232,
-	321, 358, 0, 270, 330, 294, 233, 293, 322, 357,
-	356, 241, 382, 388, 389, 394, 0, 395, 0, 0,
-	0, 403, 408, 409, 410, 412, 413, 414, 415, 0,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 0,
-	387, 268, 226, 227, 422, 0, 313, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 309, 386, 0, 0,
-	0, 0, 421, 0, 0, 0, 0, 0, 420, 319,
-	0, 338, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 345, 368, 380, 398, 401, 0,
-	0, 0, 231, 400, 0, 0, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 379, 0, 0, 0, 0,
-	0, 396, 303, 304, 305, 306, 271, 0, 248, 399,
-	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 392, 393, 267,
-	273, 411, 275, 247, 318, 269, 377, 281, 0, 404,
-	0, 405, 0, 0, 0, 0, 310, 278, 342, 282,
-	288, 331, 376, 316, 336, 245, 367, 343, 292, 0,
+	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
+	0, 0, 0, 244, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 1856, 1859, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 235, 352, 368, 245,
+	343, 383, 250, 350, 240, 317, 340, 0, 0, 237,
+	366, 349, 298, 281, 282, 236, 0, 335, 260, 274,
+	257, 315, 0, 365, 395, 256, 386, 0, 376, 239,
+	0, 375, 314, 362, 367, 299, 293, 238, 364, 297,
+	292, 285, 264, 411, 278, 326, 291, 327, 279, 304,
+	303, 305, 0, 0, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 0, 0, 0, 0, 1860, 379, 0, 0,
+	0, 1855, 1845, 1854, 351, 1852, 1857, 286, 0, 0,
+	0, 396, 0, 338, 320, 0, 0, 1843, 336, 289,
+	363, 328, 369, 267, 377, 380, 353, 378, 332, 329,
+	230, 354, 259, 300, 241, 243, 255, 261, 263, 265,
+	266, 310, 311, 323, 342, 356, 357, 358, 258, 251,
+	337, 252, 276, 253, 231, 344, 254, 233, 324, 361,
+	1858, 272, 333, 296, 234, 295, 325, 360, 359, 242,
+	387, 393, 394, 399, 0, 400, 0, 0, 0, 408,
+	413, 414, 415, 417, 418, 419, 422, 420, 421, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	392, 270, 226, 227, 429, 0, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 312, 391, 0, 0,
+	0, 0, 428, 0, 0, 0, 0, 0, 427, 322,
+	0, 341, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 371, 385, 403, 406, 0,
+	0, 0, 232, 405, 0, 0, 0, 0, 0, 0,
+	0, 374, 0, 0, 0, 384, 0, 0, 0, 0,
+	0, 401, 306, 307, 308, 309, 273, 0, 249, 404,
+	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 397, 398, 269,
+	275, 416, 277, 248, 321, 271, 382, 283, 0, 409,
+	0, 410, 0, 0, 0, 0, 313, 280, 345, 284,
+	290, 334, 381, 319, 339, 246, 370, 346, 294, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	829, 286, 0, 327, 266, 185, 186, 187, 188, 189,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
+	0, 288, 0, 330, 268, 185, 186, 187, 188, 189,
 	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
 	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
 	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 352, 0, 0,
-	383, 384, 385, 407, 369, 0, 419, 0, 315, 0,
+	219, 220, 0, 222, 223, 224, 225, 0, 0, 0,
+	388, 389, 390, 412, 372, 0, 426, 156, 355, 49,
+	148, 125, 0, 0, 0, 0, 0, 0, 0, 318,
+	446, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 0, 287,
+	0, 0, 0, 228, 0, 0, 0, 347, 301, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 451, 0, 0, 178, 0,
+	0, 0, 0, 0, 0, 244, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 235, 352,
+	368, 245, 343, 383, 250, 350, 240, 317, 340, 0,
+	0, 237, 366, 349, 298, 281, 282, 236, 0, 335,
+	260, 274, 257, 315, 0, 365, 395, 256, 386, 0,
+	376, 239, 0, 375, 314, 362, 367, 299, 293, 238,
+	364, 297, 292, 285, 264, 411, 278, 326, 291, 327,
+	279, 304, 303, 305, 0, 0, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	450, 0, 0, 302, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 351, 0, 0, 286,
+	0, 0, 0, 396, 0, 338, 320, 0, 0, 0,
+	336, 289, 363, 328, 369, 267, 377, 380, 353, 378,
+	332, 329, 230, 354, 259, 300, 241, 243, 255, 261,
+	263, 265, 266, 310, 311, 323, 342, 356, 357, 358,
+	258, 251, 337, 252, 276, 253, 231, 344, 254, 233,
+	324, 361, 0, 272, 333, 296, 234, 295, 325, 360,
+	359, 242, 387, 393, 394, 399, 0, 400, 0, 0,
+	0, 408, 413, 414, 415, 417, 418, 419, 422, 420,
+	421, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 392, 270, 226, 227, 429, 0, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 312, 391,
+	0, 0, 0, 0, 428, 0, 0, 0, 0, 0,
+	427, 322, 0, 341, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 348, 371, 385, 403,
+	406, 0, 0, 0, 232, 405, 0, 0, 0, 0,
+	0, 0, 0, 374, 0, 0, 0, 384, 0, 0,
+	0, 0, 0, 401, 306, 307, 308, 309, 447, 449,
+	249, 404, 331, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 397,
+	398, 269, 275, 416, 277, 248, 321, 271, 382, 283,
+	0, 409, 0, 410, 0, 0, 0, 0, 313, 280,
+	345, 284, 290, 334, 381, 319, 339, 246, 370, 346,
+	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 50, 0, 0, 221, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 285, 0,
-	0, 0, 0, 0, 0, 344, 299, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 1839, 1842, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 349, 365, 244,
-	340, 378, 249, 347, 239, 314, 337, 0, 0, 236,
-	363, 346, 296, 279, 280, 235, 0, 332, 259, 272,
-	256, 312, 0, 362, 390, 255, 381, 0, 373, 238,
-	0, 372, 311, 359, 364, 297, 291, 237, 361, 295,
-	290, 283, 263, 406, 276, 323, 289, 324, 277, 301,
-	300, 302, 0, 0, 0, 0, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1843, 375, 0, 0, 0, 1838,
-	0, 1837, 348, 1835, 1840, 284, 0, 0, 0, 391,
-	0, 335, 317, 0, 0, 0, 333, 287, 360, 325,
-	366, 350, 374, 329, 326, 229, 351, 258, 298, 240,
-	242, 254, 260, 262, 264, 265, 307, 308, 320, 339,
-	353, 354, 355, 257, 250, 334, 251, 274, 252, 230,
-	341, 253, 232, 321, 358, 1841, 270, 330, 294, 233,
-	293, 322, 357, 356, 241, 382, 388, 389, 394, 0,
-	395, 0, 0, 0, 403, 408, 409, 410, 412, 413,
-	414, 415, 0, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 0, 387, 268, 226, 227, 422, 0, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 309,
-	386, 0, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 420, 319, 0, 338, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 345, 368, 380,
-	398, 401, 0, 0, 0, 231, 400, 0, 0, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 379, 0,
-	0, 0, 0, 0, 396, 303, 304, 305, 306, 271,
-	0, 248, 399, 328, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	392, 393, 267, 273, 411, 275, 247, 318, 269, 377,
-	281, 0, 404, 0, 405, 0, 0, 0, 0, 310,
-	278, 342, 282, 288, 331, 376, 316, 336, 245, 367,
-	343, 292, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 229, 0, 288, 126, 330, 268, 185, 186, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 0, 222, 223, 224, 225, 355,
+	0, 0, 388, 389, 390, 412, 372, 0, 426, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 0,
+	287, 0, 0, 0, 228, 0, 0, 0, 347, 301,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	0, 0, 0, 0, 0, 0, 244, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 1856, 1859,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 235,
+	352, 368, 245, 343, 383, 250, 350, 240, 317, 340,
+	0, 0, 237, 366, 349, 298, 281, 282, 236, 0,
+	335, 260, 274, 257, 315, 0, 365, 395, 256, 386,
+	0, 376, 239, 0, 375, 314, 362, 367, 299, 293,
+	238, 364, 297, 292, 285, 264, 411, 278, 326, 291,
+	327, 279, 304, 303, 305, 0, 0, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 302, 0, 0, 0, 0, 1860,
+	379, 0, 0, 0, 1855, 1845, 1854, 351, 1852, 1857,
+	286, 0, 0, 0, 396, 0, 338, 320, 0, 0,
+	0, 336, 289, 363, 328, 369, 267, 377, 380, 353,
+	378, 332, 329, 230, 354, 259, 300, 241, 243, 255,
+	261, 263, 265, 266, 310, 311, 323, 342, 356, 357,
+	358, 258, 251, 337, 252, 276, 253, 231, 344, 254,
+	233, 324, 361, 1858, 272, 333, 296, 234, 295, 325,
+	360, 359, 242, 387, 393, 394, 399, 0, 400, 0,
+	0, 0, 408, 413, 414, 415, 417, 418, 419, 422,
+	420, 421, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 392, 270, 226, 227, 429, 0, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	391, 0, 0, 0, 0, 428, 0, 0, 0, 0,
+	0, 427, 322, 0, 341, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 371, 385,
+	403, 406, 0, 0, 0, 232, 405, 0, 0, 0,
+	0, 0, 0, 0, 374, 0, 0, 0, 384, 0,
+	0, 0, 0, 0, 401, 306, 307, 308, 309, 273,
+	0, 249, 404, 331, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	397, 398, 269, 275, 416, 277, 248, 321, 271, 382,
+	283, 0, 409, 0, 410, 0, 0, 0, 0, 313,
+	280, 345, 284, 290, 334, 381, 319, 339, 246, 370,
+	346, 294, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 286, 0, 327, 266, 185, 186,
+	0, 0, 229, 0, 288, 0, 330, 268, 185, 186,
 	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
 	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
 	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
 	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
-	352, 0, 0, 383, 384, 385, 407, 369, 0, 419,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1579, 0, 0, 0, 0, 261, 0,
-	0, 285, 0, 0, 0, 0, 0, 0, 344, 299,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	0, 0, 1580, 0, 0, 0, 243, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
-	923, 924, 925, 922, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
-	349, 365, 244, 340, 378, 249, 347, 239, 314, 337,
-	0, 0, 236, 363, 346, 296, 279, 280, 235, 0,
-	332, 259, 272, 256, 312, 0, 362, 390, 255, 381,
-	0, 373, 238, 0, 372, 311, 359, 364, 297, 291,
-	237, 361, 295, 290, 283, 263, 406, 276, 323, 289,
-	324, 277, 301, 300, 302, 0, 0, 0, 0, 0,
-	402, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 375, 0,
-	0, 0, 0, 0, 0, 348, 0, 0, 284, 0,
-	0, 0, 391, 0, 335, 317, 0, 0, 0, 333,
-	287, 360, 325, 366, 350, 374, 329, 326, 229, 351,
-	258, 298, 240, 242, 254, 260, 262, 264, 265, 307,
-	308, 320, 339, 353, 354, 355, 257, 250, 334, 251,
-	274, 252, 230, 341, 253, 232, 321, 358, 0, 270,
-	330, 294, 233, 293, 322, 357, 356, 241, 382, 388,
-	389, 394, 0, 395, 0, 0, 0, 403, 408, 409,
-	410, 412, 413, 414, 415, 0, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 0, 387, 268, 226, 227,
-	422, 0, 313, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 309, 386, 0, 0, 0, 0, 421, 0,
-	0, 0, 0, 0, 420, 319, 0, 338, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	345, 368, 380, 398, 401, 0, 0, 0, 231, 400,
-	0, 0, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 379, 0, 0, 0, 0, 0, 396, 303, 304,
-	305, 306, 271, 0, 248, 399, 328, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 392, 393, 267, 273, 411, 275, 247,
-	318, 269, 377, 281, 0, 404, 0, 405, 0, 0,
-	0, 0, 310, 278, 342, 282, 288, 331, 376, 316,
-	336, 245, 367, 343, 292, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 286, 0, 327,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 352, 0, 0, 383, 384, 385, 407,
-	369, 0, 419, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 743, 0, 285, 0, 0, 0, 0, 0,
-	0, 344, 299, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 751, 752, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	755, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	355, 0, 0, 388, 389, 390, 412, 372, 0, 426,
+	0, 318, 0, 0, 0, 0, 0, 0, 0, 826,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	0, 287, 0, 0, 0, 228, 0, 0, 0, 347,
+	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	178, 0, 0, 0, 0, 0, 0, 244, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 814, 0, 0, 0, 0, 0, 0,
+	235, 352, 368, 245, 343, 383, 250, 350, 240, 317,
+	340, 0, 0, 1786, 1788, 1789, 1790, 1791, 1792, 1793,
+	0, 1797, 1794, 1795, 1796, 315, 0, 1781, 1782, 1783,
+	1784, 812, 1767, 1787, 0, 1768, 314, 1769, 1770, 1771,
+	1772, 1773, 1774, 1775, 1776, 1777, 1778, 1779, 1785, 326,
+	291, 327, 279, 304, 303, 305, 837, 839, 841, 843,
+	846, 407, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 0, 0, 0, 351, 0,
+	0, 286, 0, 0, 0, 1780, 0, 338, 320, 0,
+	0, 0, 336, 289, 363, 328, 369, 267, 377, 380,
+	353, 378, 332, 329, 230, 354, 259, 300, 241, 243,
+	255, 261, 263, 265, 266, 310, 311, 323, 342, 356,
+	357, 358, 258, 251, 337, 252, 276, 253, 231, 344,
+	254, 233, 324, 361, 0, 272, 333, 296, 234, 295,
+	325, 360, 359, 242, 387, 393, 394, 399, 0, 400,
+	0, 0, 0, 408, 413, 414, 415, 417, 418, 419,
+	422, 420, 421, 0, 0, 0, 0, 402, 0, 0,
+	0, 0, 0, 0, 392, 270, 226, 227, 429, 0,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	312, 391, 0, 0, 0, 0, 428, 0, 0, 0,
+	0, 0, 427, 322, 0, 341, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 348, 371,
+	385, 403, 406, 0, 0, 0, 232, 405, 0, 0,
+	0, 0, 0, 0, 0, 374, 0, 0, 0, 384,
+	0, 0, 0, 0, 0, 401, 306, 307, 308, 309,
+	273, 0, 249, 404, 331, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 397, 398, 269, 275, 416, 277, 248, 321, 271,
+	382, 283, 0, 409, 0, 410, 0, 0, 0, 0,
+	313, 280, 345, 284, 290, 334, 381, 319, 339, 246,
+	370, 346, 294, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 229, 836, 288, 0, 330, 268, 185,
+	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
+	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
+	225, 355, 0, 0, 388, 389, 390, 412, 372, 0,
+	426, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	0, 0, 287, 0, 0, 0, 228, 0, 0, 0,
+	347, 301, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 0, 0, 0, 0, 0, 0, 244, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	1856, 1859, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 235, 352, 368, 245, 343, 383, 250, 350, 240,
+	317, 340, 0, 0, 237, 366, 349, 298, 281, 282,
+	236, 0, 335, 260, 274, 257, 315, 0, 365, 395,
+	256, 386, 0, 376, 239, 0, 375, 314, 362, 367,
+	299, 293, 238, 364, 297, 292, 285, 264, 411, 278,
+	326, 291, 327, 279, 304, 303, 305, 0, 0, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 302, 0, 0, 0,
+	0, 1860, 379, 0, 0, 0, 1855, 0, 1854, 351,
+	1852, 1857, 286, 0, 0, 0, 396, 0, 338, 320,
+	0, 0, 0, 336, 289, 363, 328, 369, 267, 377,
+	380, 353, 378, 332, 329, 230, 354, 259, 300, 241,
+	243, 255, 261, 263, 265, 266, 310, 311, 323, 342,
+	356, 357, 358, 258, 251, 337, 252, 276, 253, 231,
+	344, 254, 233, 324, 361, 1858, 272, 333, 296, 234,
+	295, 325, 360, 359, 242, 387, 393, 394, 399, 0,
+	400, 0, 0, 0, 408, 413, 414, 415, 417, 418,
+	419, 422, 420, 421, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 392, 270, 226, 227, 429,
+	0, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 312, 391, 0, 0, 0, 0, 428, 0, 0,
+	0, 0, 0, 427, 322, 0, 341, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 348,
+	371, 385, 403, 406, 0, 0, 0, 232, 405, 0,
+	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
+	384, 0, 0, 0, 0, 0, 401, 306, 307, 308,
+	309, 273, 0, 249, 404, 331, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 397, 398, 269, 275, 416, 277, 248, 321,
+	271, 382, 283, 0, 409, 0, 410, 0, 0, 0,
+	0, 313, 280, 345, 284, 290, 334, 381, 319, 339,
+	246, 370, 346, 294, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 349, 365, 244, 340, 378, 249, 347,
-	239, 314, 337, 0, 0, 236, 363, 346, 296, 279,
-	280, 235, 0, 332, 259, 272, 256, 312, 0, 362,
-	390, 255, 381, 733, 373, 238, 732, 372, 311, 359,
-	364, 297, 291, 237, 361, 295, 290, 283, 263, 406,
-	276, 323, 289, 324, 277, 301, 300, 302, 0, 0,
-	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 375, 0, 0, 0, 0, 0, 0, 348, 0,
-	0, 284, 0, 0, 0, 391, 0, 335, 317, 0,
-	0, 0, 333, 287, 360, 325, 366, 350, 374, 741,
-	326, 229, 351, 258, 298, 240, 242, 254, 260, 262,
-	264, 265, 307, 308, 320, 339, 353, 354, 355, 257,
-	250, 334, 251, 274, 252, 230, 341, 253, 232, 321,
-	358, 0, 270, 330, 294, 233, 293, 322, 357, 356,
-	241, 382, 388, 389, 394, 0, 395, 0, 0, 0,
-	403, 408, 409, 410, 412, 413, 414, 415, 0, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 0, 387,
-	268, 226, 227, 422, 0, 313, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 309, 386, 0, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 420, 319, 0,
-	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 345, 368, 380, 398, 401, 0, 0,
-	0, 231, 400, 0, 0, 0, 0, 0, 0, 742,
-	371, 0, 0, 0, 379, 0, 0, 0, 0, 0,
-	745, 303, 304, 305, 306, 271, 0, 248, 399, 328,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 392, 393, 267, 273,
-	411, 275, 247, 318, 269, 377, 281, 0, 404, 0,
-	405, 0, 0, 0, 0, 753, 748, 749, 282, 288,
-	331, 376, 316, 336, 245, 367, 343, 750, 0, 0,
+	0, 0, 0, 0, 229, 0, 288, 0, 330, 268,
+	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 0, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 0, 222, 223,
+	224, 225, 355, 0, 0, 388, 389, 390, 412, 372,
+	0, 426, 0, 318, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 0, 287, 0, 0, 0, 228, 0, 0,
+	0, 347, 301, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
-	286, 0, 327, 266, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 0, 222, 223, 224, 225, 156, 352, 0, 383,
-	384, 385, 407, 369, 0, 419, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 285, 0,
-	0, 0, 108, 0, 0, 344, 299, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 153, 1623, 0, 178, 0, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 349, 365, 244,
-	340, 378, 249, 347, 239, 314, 337, 0, 0, 236,
-	363, 346, 296, 279, 280, 235, 0, 332, 259, 272,
-	256, 312, 0, 362, 390, 255, 381, 0, 373, 238,
-	0, 372, 311, 359, 364, 297, 291, 237, 361, 295,
-	290, 283, 263, 406, 276, 323, 289, 324, 277, 301,
-	300, 302, 0, 0, 0, 0, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 375, 0, 0, 0, 0,
-	0, 0, 348, 0, 0, 284, 0, 0, 0, 391,
-	0, 335, 317, 0, 0, 0, 333, 287, 360, 325,
-	366, 350, 374, 329, 326, 229, 351, 258, 298, 240,
-	242, 254, 260, 262, 264, 265, 307, 308, 320, 339,
-	353, 354, 355, 257, 250, 334, 251, 274, 252, 230,
-	341, 253, 232, 321, 358, 0, 270, 330, 294, 233,
-	293, 322, 357, 356, 241, 382, 388, 389, 394, 0,
-	395, 0, 0, 0, 403, 408, 409, 410, 412, 413,
-	414, 415, 0, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 0, 387, 268, 226, 227, 422, 0, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 309,
-	386, 0, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 420, 319, 0, 338, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 345, 368, 380,
-	398, 401, 0, 0, 0, 231, 400, 0, 0, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 379, 0,
-	0, 0, 0, 0, 396, 303, 304, 305, 306, 271,
-	0, 248, 399, 328, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	392, 393, 267, 273, 411, 275, 247, 318, 269, 377,
-	281, 0, 404, 0, 405, 0, 0, 0, 0, 310,
-	278, 342, 282, 288, 331, 376, 316, 336, 245, 367,
-	343, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 0, 178, 0, 0, 0, 0, 0, 0, 244,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	247, 0, 1864, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 235, 352, 368, 245, 343, 383, 250, 350,
+	240, 317, 340, 0, 0, 237, 366, 349, 298, 281,
+	282, 236, 0, 335, 260, 274, 257, 315, 0, 365,
+	395, 256, 386, 0, 376, 239, 0, 375, 314, 362,
+	367, 299, 293, 238, 364, 297, 292, 285, 264, 411,
+	278, 326, 291, 327, 279, 304, 303, 305, 0, 0,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 0, 0,
+	0, 0, 1863, 379, 0, 0, 0, 1868, 1865, 0,
+	351, 0, 1867, 286, 0, 0, 0, 396, 0, 338,
+	320, 0, 0, 1861, 336, 289, 363, 328, 369, 267,
+	377, 380, 353, 378, 332, 329, 230, 354, 259, 300,
+	241, 243, 255, 261, 263, 265, 266, 310, 311, 323,
+	342, 356, 357, 358, 258, 251, 337, 252, 276, 253,
+	231, 344, 254, 233, 324, 361, 0, 272, 333, 296,
+	234, 295, 325, 360, 359, 242, 387, 393, 394, 399,
+	0, 400, 0, 0, 0, 408, 413, 414, 415, 417,
+	418, 419, 422, 420, 421, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 392, 270, 226, 227,
+	429, 0, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 312, 391, 0, 0, 0, 0, 428, 0,
+	0, 0, 0, 0, 427, 322, 0, 341, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	348, 371, 385, 403, 406, 0, 0, 0, 232, 405,
+	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
+	0, 384, 0, 0, 0, 0, 0, 401, 306, 307,
+	308, 309, 273, 0, 249, 404, 331, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 397, 398, 269, 275, 416, 277, 248,
+	321, 271, 382, 283, 0, 409, 0, 410, 0, 0,
+	0, 0, 313, 280, 345, 284, 290, 334, 381, 319,
+	339, 246, 370, 346, 294, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 286, 126, 327, 266, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
-	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
-	156, 352, 0, 383, 384, 385, 407, 369, 0, 419,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 285, 0, 0, 0, 108, 0, 0, 344,
-	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 153, 1614, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 349, 365, 244, 340, 378, 249, 347, 239, 314,
-	337, 0, 0, 236, 363, 346, 296, 279, 280, 235,
-	0, 332, 259, 272, 256, 312, 0, 362, 390, 255,
-	381, 0, 373, 238, 0, 372, 311, 359, 364, 297,
-	291, 237, 361, 295, 290, 283, 263, 406, 276, 323,
-	289, 324, 277, 301, 300, 302, 0, 0, 0, 0,
-	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
-	0, 0, 0, 0, 0, 0, 348, 0, 0, 284,
-	0, 0, 0, 391, 0, 335, 317, 0, 0, 0,
-	333, 287, 360, 325, 366, 350, 374, 329, 326, 229,
-	351, 258, 298, 240, 242, 254, 260, 262, 264, 265,
-	307, 308, 320, 339, 353, 354, 355, 257, 250, 334,
-	251, 274, 252, 230, 341, 253, 232, 321, 358, 0,
-	270, 330, 294, 233, 293, 322, 357, 356, 241, 382,
-	388, 389, 394, 0, 395, 0, 0, 0, 403, 408,
-	409, 410, 412, 413, 414, 415, 0, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 0, 387, 268, 226,
-	227, 422, 0, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 309, 386, 0, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 420, 319, 0, 338, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 345, 368, 380, 398, 401, 0, 0, 0, 231,
-	400, 0, 0, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 379, 0, 0, 0, 0, 0, 396, 303,
-	304, 305, 306, 271, 0, 248, 399, 328, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 392, 393, 267, 273, 411, 275,
-	247, 318, 269, 377, 281, 0, 404, 0, 405, 0,
-	0, 0, 0, 310, 278, 342, 282, 288, 331, 376,
-	316, 336, 245, 367, 343, 292, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 229, 0, 288, 0, 330,
+	268, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
+	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
+	223, 224, 225, 355, 0, 0, 388, 389, 390, 412,
+	372, 0, 426, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1594, 0, 0, 0,
+	0, 262, 0, 0, 287, 0, 0, 0, 228, 0,
+	0, 0, 347, 301, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 0, 0, 1595, 0, 0, 0,
+	244, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 930, 931, 932, 929, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 235, 352, 368, 245, 343, 383, 250,
+	350, 240, 317, 340, 0, 0, 237, 366, 349, 298,
+	281, 282, 236, 0, 335, 260, 274, 257, 315, 0,
+	365, 395, 256, 386, 0, 376, 239, 0, 375, 314,
+	362, 367, 299, 293, 238, 364, 297, 292, 285, 264,
+	411, 278, 326, 291, 327, 279, 304, 303, 305, 0,
+	0, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 0, 0,
+	0, 351, 0, 0, 286, 0, 0, 0, 396, 0,
+	338, 320, 0, 0, 0, 336, 289, 363, 328, 369,
+	267, 377, 380, 353, 378, 332, 329, 230, 354, 259,
+	300, 241, 243, 255, 261, 263, 265, 266, 310, 311,
+	323, 342, 356, 357, 358, 258, 251, 337, 252, 276,
+	253, 231, 344, 254, 233, 324, 361, 0, 272, 333,
+	296, 234, 295, 325, 360, 359, 242, 387, 393, 394,
+	399, 0, 400, 0, 0, 0, 408, 413, 414, 415,
+	417, 418, 419, 422, 420, 421, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 392, 270, 226,
+	227, 429, 0, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 391, 0, 0, 0, 0, 428,
+	0, 0, 0, 0, 0, 427, 322, 0, 341, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 371, 385, 403, 406, 0, 0, 0, 232,
+	405, 0, 0, 0, 0, 0, 0, 0, 374, 0,
+	0, 0, 384, 0, 0, 0, 0, 0, 401, 306,
+	307, 308, 309, 273, 0, 249, 404, 331, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 397, 398, 269, 275, 416, 277,
+	248, 321, 271, 382, 283, 0, 409, 0, 410, 0,
+	0, 0, 0, 313, 280, 345, 284, 290, 334, 381,
+	319, 339, 246, 370, 346, 294, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 286, 126,
-	327, 266, 185, 186, 187, 188, 189, 190, 191, 192,
+	0, 0, 0, 0, 0, 0, 229, 0, 288, 0,
+	330, 268, 185, 186, 187, 188, 189, 190, 191, 192,
 	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
 	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
 	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
-	222, 223, 224, 225, 156, 352, 0, 383, 384, 385,
-	407, 369, 0, 419, 0, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 285, 0, 0, 0,
-	108, 0, 0, 344, 299, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1530, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 349, 365, 244, 340, 378,
-	249, 347, 239, 314, 337, 0, 0, 236, 363, 346,
-	296, 279, 280, 235, 0, 332, 259, 272, 256, 312,
-	0, 362, 390, 255, 381, 0, 373, 238, 0, 372,
-	311, 359, 364, 297, 291, 237, 361, 295, 290, 283,
-	263, 406, 276, 323, 289, 324, 277, 301, 300, 302,
-	0, 0, 0, 0, 0, 402, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 375, 0, 0, 0, 0, 0, 0,
-	348, 0, 0, 284, 0, 0, 0, 391, 0, 335,
-	317, 0, 0, 0, 333, 287, 360, 325, 366, 350,
-	374, 329, 326, 229, 351, 258, 298, 240, 242, 254,
-	260, 262, 264, 265, 307, 308, 320, 339, 353, 354,
-	355, 257, 250, 334, 251, 274, 252, 230, 341, 253,
-	232, 321, 358, 0, 270, 330, 294, 233, 293, 322,
-	357, 356, 241, 382, 388, 389, 394, 0, 395, 0,
-	0, 0, 403, 408, 409, 410, 412, 413, 414, 415,
-	0, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	0, 387, 268, 226, 227, 422, 0, 313, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 309, 386, 0,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 420,
-	319, 0, 338, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 345, 368, 380, 398, 401,
-	0, 0, 0, 231, 400, 0, 0, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 379, 0, 0, 0,
-	0, 0, 396, 303, 304, 305, 306, 271, 0, 248,
-	399, 328, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 392, 393,
-	267, 273, 411, 275, 247, 318, 269, 377, 281, 0,
-	404, 0, 405, 0, 0, 0, 0, 310, 278, 342,
-	282, 288, 331, 376, 316, 336, 245, 367, 343, 292,
+	222, 223, 224, 225, 355, 0, 0, 388, 389, 390,
+	412, 372, 0, 426, 0, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 750, 0, 287, 0, 0, 0, 228,
+	0, 0, 0, 347, 301, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 758, 759, 0, 0, 0,
+	0, 244, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 762, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 235, 352, 368, 245, 343, 383,
+	250, 350, 240, 317, 340, 0, 0, 237, 366, 349,
+	298, 281, 282, 236, 0, 335, 260, 274, 257, 315,
+	0, 365, 395, 256, 386, 740, 376, 239, 739, 375,
+	314, 362, 367, 299, 293, 238, 364, 297, 292, 285,
+	264, 411, 278, 326, 291, 327, 279, 304, 303, 305,
+	0, 0, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 0,
+	0, 0, 351, 0, 0, 286, 0, 0, 0, 396,
+	0, 338, 320, 0, 0, 0, 336, 289, 363, 328,
+	369, 267, 377, 380, 353, 378, 748, 329, 230, 354,
+	259, 300, 241, 243, 255, 261, 263, 265, 266, 310,
+	311, 323, 342, 356, 357, 358, 258, 251, 337, 252,
+	276, 253, 231, 344, 254, 233, 324, 361, 0, 272,
+	333, 296, 234, 295, 325, 360, 359, 242, 387, 393,
+	394, 399, 0, 400, 0, 0, 0, 408, 413, 414,
+	415, 417, 418, 419, 422, 420, 421, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 392, 270,
+	226, 227, 429, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 312, 391, 0, 0, 0, 0,
+	428, 0, 0, 0, 0, 0, 427, 322, 0, 341,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 348, 371, 385, 403, 406, 0, 0, 0,
+	232, 405, 0, 0, 0, 0, 0, 0, 749, 374,
+	0, 0, 0, 384, 0, 0, 0, 0, 0, 752,
+	306, 307, 308, 309, 273, 0, 249, 404, 331, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 397, 398, 269, 275, 416,
+	277, 248, 321, 271, 382, 283, 0, 409, 0, 410,
+	0, 0, 0, 0, 760, 755, 756, 284, 290, 334,
+	381, 319, 339, 246, 370, 346, 757, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 229, 0, 288,
+	0, 330, 268, 185, 186, 187, 188, 189, 190, 191,
+	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	0, 222, 223, 224, 225, 355, 0, 0, 388, 389,
+	390, 412, 372, 0, 426, 0, 318, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	228, 0, 286, 126, 327, 266, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 0, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 0, 222, 223, 224, 225, 352, 0,
-	0, 383, 384, 385, 407, 369, 0, 419, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 0, 0, 285,
-	0, 0, 0, 0, 0, 0, 344, 299, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 178, 751, 752,
-	0, 0, 0, 0, 243, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 755, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 234, 349, 365,
-	244, 340, 378, 249, 347, 239, 314, 337, 0, 0,
-	236, 363, 346, 296, 279, 280, 235, 0, 332, 259,
-	272, 256, 312, 0, 362, 390, 255, 381, 733, 373,
-	238, 732, 372, 311, 359, 364, 297, 291, 237, 361,
-	295, 290, 283, 263, 406, 276, 323, 289, 324, 277,
-	301, 300, 302, 0, 0, 0, 0, 0, 402, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 375, 0, 0, 0,
-	0, 0, 0, 348, 0, 0, 284, 0, 0, 0,
-	391, 0, 335, 317, 0, 0, 0, 333, 287, 360,
-	325, 366, 350, 374, 329, 326, 229, 351, 258, 298,
-	240, 242, 254, 260, 262, 264, 265, 307, 308, 320,
-	339, 353, 354, 355, 257, 250, 334, 251, 274, 252,
-	230, 341, 253, 232, 321, 358, 0, 270, 330, 294,
-	233, 293, 322, 357, 356, 241, 382, 388, 389, 394,
-	0, 395, 0, 0, 0, 403, 408, 409, 410, 412,
-	413, 414, 415, 0, 0, 0, 0, 397, 0, 0,
-	0, 0, 0, 0, 387, 268, 226, 227, 422, 0,
-	313, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	309, 386, 0, 0, 0, 0, 421, 0, 0, 0,
-	0, 0, 420, 319, 0, 338, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 345, 368,
-	380, 398, 401, 0, 0, 0, 231, 400, 0, 0,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 379,
-	0, 0, 0, 0, 0, 396, 303, 304, 305, 306,
-	271, 0, 248, 399, 328, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 392, 393, 267, 273, 411, 275, 247, 318, 269,
-	377, 281, 0, 404, 0, 405, 0, 0, 0, 0,
-	753, 748, 749, 282, 288, 331, 376, 316, 336, 245,
-	367, 343, 750, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 262, 0, 0, 287, 0, 0, 0,
+	228, 0, 0, 0, 347, 301, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 0, 0, 0, 0,
+	0, 0, 244, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 1864, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 235, 352, 368, 245, 343,
+	383, 250, 350, 240, 317, 340, 0, 0, 237, 366,
+	349, 298, 281, 282, 236, 0, 335, 260, 274, 257,
+	315, 0, 365, 395, 256, 386, 0, 376, 239, 0,
+	375, 314, 362, 367, 299, 293, 238, 364, 297, 292,
+	285, 264, 411, 278, 326, 291, 327, 279, 304, 303,
+	305, 0, 0, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	302, 0, 0, 0, 0, 1863, 379, 0, 0, 0,
+	1868, 1865, 0, 351, 0, 1867, 286, 0, 0, 0,
+	396, 0, 338, 320, 0, 0, 0, 336, 289, 363,
+	328, 369, 267, 377, 380, 353, 378, 332, 329, 230,
+	354, 259, 300, 241, 243, 255, 261, 263, 265, 266,
+	310, 311, 323, 342, 356, 357, 358, 258, 251, 337,
+	252, 276, 253, 231, 344, 254, 233, 324, 361, 0,
+	272, 333, 296, 234, 295, 325, 360, 359, 242, 387,
+	393, 394, 399, 0, 400, 0, 0, 0, 408, 413,
+	414, 415, 417, 418, 419, 422, 420, 421, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 392,
+	270, 226, 227, 429, 0, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 391, 0, 0, 0,
+	0, 428, 0, 0, 0, 0, 0, 427, 322, 0,
+	341, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 348, 371, 385, 403, 406, 0, 0,
+	0, 232, 405, 0, 0, 0, 0, 0, 0, 0,
+	374, 0, 0, 0, 384, 0, 0, 0, 0, 0,
+	401, 306, 307, 308, 309, 273, 0, 249, 404, 331,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 397, 398, 269, 275,
+	416, 277, 248, 321, 271, 382, 283, 0, 409, 0,
+	410, 0, 0, 0, 0, 313, 280, 345, 284, 290,
+	334, 381, 319, 339, 246, 370, 346, 294, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 286, 0, 327, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 352, 0, 0, 383, 384, 385, 407, 369, 0,
-	419, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 2173, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 285, 0, 0, 0, 0, 0, 0, 344,
-	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 349, 365, 244, 340, 378, 249, 347, 239, 314,
-	337, 0, 0, 236, 363, 346, 296, 279, 280, 235,
-	0, 332, 259, 272, 256, 312, 0, 362, 390, 255,
-	381, 0, 373, 238, 0, 372, 311, 359, 364, 297,
-	291, 237, 361, 295, 290, 283, 263, 406, 276, 323,
-	289, 324, 277, 301, 300, 302, 0, 0, 0, 0,
-	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
-	2176, 0, 0, 2175, 0, 0, 0, 0, 0, 375,
-	0, 0, 0, 0, 0, 0, 348, 0, 0, 284,
-	0, 0, 0, 391, 0, 335, 317, 0, 0, 0,
-	333, 287, 360, 325, 366, 350, 374, 329, 326, 229,
-	351, 258, 298, 240, 242, 254, 260, 262, 264, 265,
-	307, 308, 320, 339, 353, 354, 355, 257, 250, 334,
-	251, 274, 252, 230, 341, 253, 232, 321, 358, 0,
-	270, 330, 294, 233, 293, 322, 357, 356, 241, 382,
-	388, 389, 394, 0, 395, 0, 0, 0, 403, 408,
-	409, 410, 412, 413, 414, 415, 0, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 0, 387, 268, 226,
-	227, 422, 0, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 309, 386, 0, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 420, 319, 0, 338, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 345, 368, 380, 398, 401, 0, 0, 0, 231,
-	400, 0, 0, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 379, 0, 0, 0, 0, 0, 396, 303,
-	304, 305, 306, 271, 0, 248, 399, 328, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 392, 393, 267, 273, 411, 275,
-	247, 318, 269, 377, 281, 0, 404, 0, 405, 0,
-	0, 0, 0, 310, 278, 342, 282, 288, 331, 376,
-	316, 336, 245, 367, 343, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	288, 0, 330, 268, 185, 186, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 0, 222, 223, 224, 225, 156, 355, 0, 388,
+	389, 390, 412, 372, 0, 426, 0, 0, 318, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 286, 0,
-	327, 266, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
-	222, 223, 224, 225, 352, 0, 0, 383, 384, 385,
-	407, 369, 0, 419, 0, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 0, 0, 287, 0,
+	0, 0, 228, 108, 0, 0, 347, 301, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 1146, 0, 285, 0, 0, 0, 0,
-	0, 0, 344, 299, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 153, 1638, 0, 178, 0, 0,
+	0, 0, 0, 0, 244, 179, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 1144, 0, 0, 0,
-	243, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1142, 0, 0, 0,
-	0, 0, 0, 234, 349, 365, 244, 340, 378, 249,
-	347, 239, 314, 337, 0, 0, 236, 363, 346, 296,
-	279, 280, 235, 0, 332, 259, 272, 256, 312, 0,
-	362, 390, 255, 381, 0, 373, 238, 0, 372, 311,
-	359, 364, 297, 291, 237, 361, 295, 290, 283, 263,
-	406, 276, 323, 289, 324, 277, 301, 300, 302, 0,
+	0, 0, 0, 0, 0, 0, 0, 235, 352, 368,
+	245, 343, 383, 250, 350, 240, 317, 340, 0, 0,
+	237, 366, 349, 298, 281, 282, 236, 0, 335, 260,
+	274, 257, 315, 0, 365, 395, 256, 386, 0, 376,
+	239, 0, 375, 314, 362, 367, 299, 293, 238, 364,
+	297, 292, 285, 264, 411, 278, 326, 291, 327, 279,
+	304, 303, 305, 0, 0, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 302, 0, 0, 0, 0, 0, 379, 0,
+	0, 0, 0, 0, 0, 351, 0, 0, 286, 0,
+	0, 0, 396, 0, 338, 320, 0, 0, 0, 336,
+	289, 363, 328, 369, 267, 377, 380, 353, 378, 332,
+	329, 230, 354, 259, 300, 241, 243, 255, 261, 263,
+	265, 266, 310, 311, 323, 342, 356, 357, 358, 258,
+	251, 337, 252, 276, 253, 231, 344, 254, 233, 324,
+	361, 0, 272, 333, 296, 234, 295, 325, 360, 359,
+	242, 387, 393, 394, 399, 0, 400, 0, 0, 0,
+	408, 413, 414, 415, 417, 418, 419, 422, 420, 421,
 	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 392, 270, 226, 227, 429, 0, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 312, 391, 0,
+	0, 0, 0, 428, 0, 0, 0, 0, 0, 427,
+	322, 0, 341, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 348, 371, 385, 403, 406,
+	0, 0, 0, 232, 405, 0, 0, 0, 0, 0,
+	0, 0, 374, 0, 0, 0, 384, 0, 0, 0,
+	0, 0, 401, 306, 307, 308, 309, 273, 0, 249,
+	404, 331, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 397, 398,
+	269, 275, 416, 277, 248, 321, 271, 382, 283, 0,
+	409, 0, 410, 0, 0, 0, 0, 313, 280, 345,
+	284, 290, 334, 381, 319, 339, 246, 370, 346, 294,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 375, 0, 0, 0, 0, 0, 0, 348,
-	0, 0, 284, 0, 0, 0, 391, 0, 335, 317,
-	0, 0, 0, 333, 287, 360, 325, 366, 350, 374,
-	329, 326, 229, 351, 258, 298, 240, 242, 254, 260,
-	262, 264, 265, 307, 308, 320, 339, 353, 354, 355,
-	257, 250, 334, 251, 274, 252, 230, 341, 253, 232,
-	321, 358, 0, 270, 330, 294, 233, 293, 322, 357,
-	356, 241, 382, 388, 389, 394, 0, 395, 0, 0,
-	0, 403, 408, 409, 410, 412, 413, 414, 415, 0,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 0,
-	387, 268, 226, 227, 422, 0, 313, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 309, 386, 0, 0,
-	0, 0, 421, 0, 0, 0, 0, 0, 420, 319,
-	0, 338, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 345, 368, 380, 398, 401, 0,
-	0, 0, 231, 400, 0, 0, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 379, 0, 0, 0, 0,
-	0, 396, 303, 304, 305, 306, 271, 0, 248, 399,
-	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 392, 393, 267,
-	273, 411, 275, 247, 318, 269, 377, 281, 0, 404,
-	0, 405, 0, 0, 0, 0, 310, 278, 342, 282,
-	288, 331, 376, 316, 336, 245, 367, 343, 292, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 286, 0, 327, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 352, 0, 0,
-	383, 384, 385, 407, 369, 0, 419, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 1140, 0, 285, 0,
-	0, 0, 0, 0, 0, 344, 299, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 1144,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1142,
-	0, 0, 0, 0, 0, 0, 234, 349, 365, 244,
-	340, 378, 249, 347, 239, 314, 337, 0, 0, 236,
-	363, 346, 296, 279, 280, 235, 0, 332, 259, 272,
-	256, 312, 0, 362, 390, 255, 381, 0, 373, 238,
-	0, 372, 311, 359, 364, 297, 291, 237, 361, 295,
-	290, 283, 263, 406, 276, 323, 289, 324, 277, 301,
-	300, 302, 0, 0, 0, 0, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 375, 0, 0, 0, 0,
-	0, 0, 348, 0, 0, 284, 0, 0, 0, 391,
-	0, 335, 317, 0, 0, 0, 333, 287, 360, 325,
-	366, 350, 374, 329, 326, 229, 351, 258, 298, 240,
-	242, 254, 260, 262, 264, 265, 307, 308, 320, 339,
-	353, 354, 355, 257, 250, 334, 251, 274, 252, 230,
-	341, 253, 232, 321, 358, 0, 270, 330, 294, 233,
-	293, 322, 357, 356, 241, 382, 388, 389, 394, 0,
-	395, 0, 0, 0, 403, 408, 409, 410, 412, 413,
-	414, 415, 0, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 0, 387, 268, 226, 227, 422, 0, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 309,
-	386, 0, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 420, 319, 0, 338, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 345, 368, 380,
-	398, 401, 0, 0, 0, 231, 400, 0, 0, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 379, 0,
-	0, 0, 0, 0, 396, 303, 304, 305, 306, 271,
-	0, 248, 399, 328, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	392, 393, 267, 273, 411, 275, 247, 318, 269, 377,
-	281, 0, 404, 0, 405, 0, 0, 0, 0, 310,
-	278, 342, 282, 288, 331, 376, 316, 336, 245, 367,
-	343, 292, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 288, 126, 330, 268, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 0, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 0, 222, 223, 224, 225, 156, 355,
+	0, 388, 389, 390, 412, 372, 0, 426, 0, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 0,
+	287, 0, 0, 0, 228, 108, 0, 0, 347, 301,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 153, 1629, 0, 178,
+	0, 0, 0, 0, 0, 0, 244, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 235,
+	352, 368, 245, 343, 383, 250, 350, 240, 317, 340,
+	0, 0, 237, 366, 349, 298, 281, 282, 236, 0,
+	335, 260, 274, 257, 315, 0, 365, 395, 256, 386,
+	0, 376, 239, 0, 375, 314, 362, 367, 299, 293,
+	238, 364, 297, 292, 285, 264, 411, 278, 326, 291,
+	327, 279, 304, 303, 305, 0, 0, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 302, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 351, 0, 0,
+	286, 0, 0, 0, 396, 0, 338, 320, 0, 0,
+	0, 336, 289, 363, 328, 369, 267, 377, 380, 353,
+	378, 332, 329, 230, 354, 259, 300, 241, 243, 255,
+	261, 263, 265, 266, 310, 311, 323, 342, 356, 357,
+	358, 258, 251, 337, 252, 276, 253, 231, 344, 254,
+	233, 324, 361, 0, 272, 333, 296, 234, 295, 325,
+	360, 359, 242, 387, 393, 394, 399, 0, 400, 0,
+	0, 0, 408, 413, 414, 415, 417, 418, 419, 422,
+	420, 421, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 392, 270, 226, 227, 429, 0, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	391, 0, 0, 0, 0, 428, 0, 0, 0, 0,
+	0, 427, 322, 0, 341, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 371, 385,
+	403, 406, 0, 0, 0, 232, 405, 0, 0, 0,
+	0, 0, 0, 0, 374, 0, 0, 0, 384, 0,
+	0, 0, 0, 0, 401, 306, 307, 308, 309, 273,
+	0, 249, 404, 331, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	397, 398, 269, 275, 416, 277, 248, 321, 271, 382,
+	283, 0, 409, 0, 410, 0, 0, 0, 0, 313,
+	280, 345, 284, 290, 334, 381, 319, 339, 246, 370,
+	346, 294, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 286, 0, 327, 266, 185, 186,
+	0, 0, 229, 0, 288, 126, 330, 268, 185, 186,
 	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
 	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
 	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
 	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
-	352, 0, 0, 383, 384, 385, 407, 369, 0, 419,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	0, 285, 0, 0, 0, 0, 0, 0, 344, 299,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2817, 0, 178,
-	589, 0, 0, 0, 0, 0, 243, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
-	349, 365, 244, 340, 378, 249, 347, 239, 314, 337,
-	0, 0, 236, 363, 346, 296, 279, 280, 235, 0,
-	332, 259, 272, 256, 312, 0, 362, 390, 255, 381,
-	0, 373, 238, 0, 372, 311, 359, 364, 297, 291,
-	237, 361, 295, 290, 283, 263, 406, 276, 323, 289,
-	324, 277, 301, 300, 302, 0, 0, 0, 0, 0,
-	402, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 375, 0,
-	0, 0, 0, 0, 0, 348, 0, 0, 284, 0,
-	0, 0, 391, 0, 335, 317, 0, 0, 0, 333,
-	287, 360, 325, 366, 350, 374, 329, 326, 229, 351,
-	258, 298, 240, 242, 254, 260, 262, 264, 265, 307,
-	308, 320, 339, 353, 354, 355, 257, 250, 334, 251,
-	274, 252, 230, 341, 253, 232, 321, 358, 0, 270,
-	330, 294, 233, 293, 322, 357, 356, 241, 382, 388,
-	389, 394, 0, 395, 0, 0, 0, 403, 408, 409,
-	410, 412, 413, 414, 415, 0, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 0, 387, 268, 226, 227,
-	422, 0, 313, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 309, 386, 0, 0, 0, 0, 421, 0,
-	0, 0, 0, 0, 420, 319, 0, 338, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	345, 368, 380, 398, 401, 0, 0, 0, 231, 400,
-	0, 0, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 379, 0, 0, 0, 0, 0, 396, 303, 304,
-	305, 306, 271, 0, 248, 399, 328, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 392, 393, 267, 273, 411, 275, 247,
-	318, 269, 377, 281, 0, 404, 0, 405, 0, 0,
-	0, 0, 310, 278, 342, 282, 288, 331, 376, 316,
-	336, 245, 367, 343, 292, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 286, 0, 327,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 352, 0, 0, 383, 384, 385, 407,
-	369, 0, 419, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 285, 0, 0, 0, 0, 0,
-	0, 344, 299, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 1144, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2531, 0, 0, 0, 0,
-	0, 0, 234, 349, 365, 244, 340, 378, 249, 347,
-	239, 314, 337, 0, 0, 236, 363, 346, 296, 279,
-	280, 235, 0, 332, 259, 272, 256, 312, 0, 362,
-	390, 255, 381, 0, 373, 238, 0, 372, 311, 359,
-	364, 297, 291, 237, 361, 295, 290, 283, 263, 406,
-	276, 323, 289, 324, 277, 301, 300, 302, 0, 0,
-	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 375, 0, 0, 0, 0, 0, 0, 348, 0,
-	0, 284, 0, 0, 0, 391, 0, 335, 317, 0,
-	0, 0, 333, 287, 360, 325, 366, 350, 374, 329,
-	326, 229, 351, 258, 298, 240, 242, 254, 260, 262,
-	264, 265, 307, 308, 320, 339, 353, 354, 355, 257,
-	250, 334, 251, 274, 252, 230, 341, 253, 232, 321,
-	358, 0, 270, 330, 294, 233, 293, 322, 357, 356,
-	241, 382, 388, 389, 394, 0, 395, 0, 0, 0,
-	403, 408, 409, 410, 412, 413, 414, 415, 0, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 0, 387,
-	268, 226, 227, 422, 0, 313, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 309, 386, 0, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 420, 319, 0,
-	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 345, 368, 380, 398, 401, 0, 0,
-	0, 231, 400, 0, 0, 0, 0, 0, 0, 0,
-	371, 0, 0, 0, 379, 0, 0, 0, 0, 0,
-	396, 303, 304, 305, 306, 271, 0, 248, 399, 328,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 392, 393, 267, 273,
-	411, 275, 247, 318, 269, 377, 281, 0, 404, 0,
-	405, 0, 0, 0, 0, 310, 278, 342, 282, 288,
-	331, 376, 316, 336, 245, 367, 343, 292, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
-	286, 0, 327, 266, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 0, 222, 223, 224, 225, 352, 0, 0, 383,
-	384, 385, 407, 369, 0, 419, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 0, 0, 285, 0, 0,
-	0, 0, 0, 0, 344, 299, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 178, 0, 0, 1144, 0,
-	0, 0, 243, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1142, 0,
-	0, 0, 0, 0, 0, 234, 349, 365, 244, 340,
-	378, 249, 347, 239, 314, 337, 0, 0, 236, 363,
-	346, 296, 279, 280, 235, 0, 332, 259, 272, 256,
-	312, 0, 362, 390, 255, 381, 0, 373, 238, 0,
-	372, 311, 359, 364, 297, 291, 237, 361, 295, 290,
-	283, 263, 406, 276, 323, 289, 324, 277, 301, 300,
-	302, 0, 0, 0, 0, 0, 402, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 375, 0, 0, 0, 0, 0,
-	0, 348, 0, 0, 284, 0, 0, 0, 391, 0,
-	335, 317, 0, 0, 0, 333, 287, 360, 325, 366,
-	350, 374, 329, 326, 229, 351, 258, 298, 240, 242,
-	254, 260, 262, 264, 265, 307, 308, 320, 339, 353,
-	354, 355, 257, 250, 334, 251, 274, 252, 230, 341,
-	253, 232, 321, 358, 0, 270, 330, 294, 233, 293,
-	322, 357, 356, 241, 382, 388, 389, 394, 0, 395,
-	0, 0, 0, 403, 408, 409, 410, 412, 413, 414,
-	415, 0, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 0, 387, 268, 226, 227, 422, 0, 313, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 309, 386,
-	0, 0, 0, 0, 421, 0, 0, 0, 0, 0,
-	420, 319, 0, 338, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 345, 368, 380, 398,
-	401, 0, 0, 0, 231, 400, 0, 0, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 379, 0, 0,
-	0, 0, 0, 396, 303, 304, 305, 306, 271, 0,
-	248, 399, 328, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
-	393, 267, 273, 411, 275, 247, 318, 269, 377, 281,
-	0, 404, 0, 405, 0, 0, 0, 0, 310, 278,
-	342, 282, 288, 331, 376, 316, 336, 245, 367, 343,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 286, 0, 327, 266, 185, 186, 187,
-	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 352,
-	0, 0, 383, 384, 385, 407, 369, 0, 419, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1901, 0, 0, 0, 0, 261, 0, 0,
-	285, 0, 0, 0, 0, 0, 0, 344, 299, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 1903, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 349,
-	365, 244, 340, 378, 249, 347, 239, 314, 337, 0,
-	0, 236, 363, 346, 296, 279, 280, 235, 0, 332,
-	259, 272, 256, 312, 0, 362, 390, 255, 381, 0,
-	373, 238, 0, 372, 311, 359, 364, 297, 291, 237,
-	361, 295, 290, 283, 263, 406, 276, 323, 289, 324,
-	277, 301, 300, 302, 0, 0, 0, 0, 0, 402,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 375, 0, 0,
-	0, 0, 0, 0, 348, 0, 0, 284, 0, 0,
-	0, 391, 0, 335, 317, 0, 0, 0, 333, 287,
-	360, 325, 366, 350, 374, 329, 326, 229, 351, 258,
-	298, 240, 242, 254, 260, 262, 264, 265, 307, 308,
-	320, 339, 353, 354, 355, 257, 250, 334, 251, 274,
-	252, 230, 341, 253, 232, 321, 358, 0, 270, 330,
-	294, 233, 293, 322, 357, 356, 241, 382, 388, 389,
-	394, 0, 395, 0, 0, 0, 403, 408, 409, 410,
-	412, 413, 414, 415, 0, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 0, 387, 268, 226, 227, 422,
-	0, 313, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 309, 386, 0, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 420, 319, 0, 338, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 345,
-	368, 380, 398, 401, 0, 0, 0, 231, 400, 0,
-	0, 0, 0, 0, 0, 0, 371, 0, 0, 0,
-	379, 0, 0, 0, 0, 0, 396, 303, 304, 305,
-	306, 271, 0, 248, 399, 328, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 392, 393, 267, 273, 411, 275, 247, 318,
-	269, 377, 281, 0, 404, 0, 405, 0, 0, 0,
-	0, 310, 278, 342, 282, 288, 331, 376, 316, 336,
-	245, 367, 343, 292, 0, 0, 0, 0, 0, 0,
+	156, 355, 0, 388, 389, 390, 412, 372, 0, 426,
+	0, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	0, 0, 287, 0, 0, 0, 228, 108, 0, 0,
+	347, 301, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1545, 0,
+	0, 178, 0, 0, 0, 0, 0, 0, 244, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 235, 352, 368, 245, 343, 383, 250, 350, 240,
+	317, 340, 0, 0, 237, 366, 349, 298, 281, 282,
+	236, 0, 335, 260, 274, 257, 315, 0, 365, 395,
+	256, 386, 0, 376, 239, 0, 375, 314, 362, 367,
+	299, 293, 238, 364, 297, 292, 285, 264, 411, 278,
+	326, 291, 327, 279, 304, 303, 305, 0, 0, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 302, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 0, 0, 0, 351,
+	0, 0, 286, 0, 0, 0, 396, 0, 338, 320,
+	0, 0, 0, 336, 289, 363, 328, 369, 267, 377,
+	380, 353, 378, 332, 329, 230, 354, 259, 300, 241,
+	243, 255, 261, 263, 265, 266, 310, 311, 323, 342,
+	356, 357, 358, 258, 251, 337, 252, 276, 253, 231,
+	344, 254, 233, 324, 361, 0, 272, 333, 296, 234,
+	295, 325, 360, 359, 242, 387, 393, 394, 399, 0,
+	400, 0, 0, 0, 408, 413, 414, 415, 417, 418,
+	419, 422, 420, 421, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 392, 270, 226, 227, 429,
+	0, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 312, 391, 0, 0, 0, 0, 428, 0, 0,
+	0, 0, 0, 427, 322, 0, 341, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 348,
+	371, 385, 403, 406, 0, 0, 0, 232, 405, 0,
+	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
+	384, 0, 0, 0, 0, 0, 401, 306, 307, 308,
+	309, 273, 0, 249, 404, 331, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 397, 398, 269, 275, 416, 277, 248, 321,
+	271, 382, 283, 0, 409, 0, 410, 0, 0, 0,
+	0, 313, 280, 345, 284, 290, 334, 381, 319, 339,
+	246, 370, 346, 294, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 228, 0, 286, 0, 327, 266,
+	0, 0, 0, 0, 229, 0, 288, 126, 330, 268,
 	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
 	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
 	205, 206, 0, 207, 208, 209, 210, 211, 212, 213,
 	214, 215, 216, 217, 218, 219, 220, 0, 222, 223,
-	224, 225, 352, 0, 0, 383, 384, 385, 407, 369,
-	0, 419, 0, 315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 1916, 0, 285, 0, 0, 0, 0, 0, 0,
-	344, 299, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 178, 0, 0, 1144, 0, 0, 0, 243, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	224, 225, 355, 0, 0, 388, 389, 390, 412, 372,
+	0, 426, 0, 318, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 0, 287, 0, 0, 0, 228, 0, 0,
+	0, 347, 301, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 758, 759, 0, 0, 0, 0, 244,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	762, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 235, 352, 368, 245, 343, 383, 250, 350,
+	240, 317, 340, 0, 0, 237, 366, 349, 298, 281,
+	282, 236, 0, 335, 260, 274, 257, 315, 0, 365,
+	395, 256, 386, 740, 376, 239, 739, 375, 314, 362,
+	367, 299, 293, 238, 364, 297, 292, 285, 264, 411,
+	278, 326, 291, 327, 279, 304, 303, 305, 0, 0,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 0, 0,
+	351, 0, 0, 286, 0, 0, 0, 396, 0, 338,
+	320, 0, 0, 0, 336, 289, 363, 328, 369, 267,
+	377, 380, 353, 378, 332, 329, 230, 354, 259, 300,
+	241, 243, 255, 261, 263, 265, 266, 310, 311, 323,
+	342, 356, 357, 358, 258, 251, 337, 252, 276, 253,
+	231, 344, 254, 233, 324, 361, 0, 272, 333, 296,
+	234, 295, 325, 360, 359, 242, 387, 393, 394, 399,
+	0, 400, 0, 0, 0, 408, 413, 414, 415, 417,
+	418, 419, 422, 420, 421, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 392, 270, 226, 227,
+	429, 0, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 312, 391, 0, 0, 0, 0, 428, 0,
+	0, 0, 0, 0, 427, 322, 0, 341, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	348, 371, 385, 403, 406, 0, 0, 0, 232, 405,
+	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
+	0, 384, 0, 0, 0, 0, 0, 401, 306, 307,
+	308, 309, 273, 0, 249, 404, 331, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 397, 398, 269, 275, 416, 277, 248,
+	321, 271, 382, 283, 0, 409, 0, 410, 0, 0,
+	0, 0, 760, 755, 756, 284, 290, 334, 381, 319,
+	339, 246, 370, 346, 757, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 234, 349, 365, 244, 340, 378, 249, 347, 239,
-	314, 337, 0, 0, 236, 363, 346, 296, 279, 280,
-	235, 0, 332, 259, 272, 256, 312, 0, 362, 390,
-	255, 381, 0, 373, 238, 0, 372, 311, 359, 364,
-	297, 291, 237, 361, 295, 290, 283, 263, 406, 276,
-	323, 289, 324, 277, 301, 300, 302, 0, 0, 0,
-	0, 0, 402, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 229, 0, 288, 0, 330,
+	268, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
+	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
+	223, 224, 225, 355, 0, 0, 388, 389, 390, 412,
+	372, 0, 426, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 2219, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 0, 287, 0, 0, 0, 228, 0,
+	0, 0, 347, 301, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	375, 0, 0, 0, 0, 0, 0, 348, 0, 0,
-	284, 0, 0, 0, 391, 0, 335, 317, 0, 0,
-	0, 333, 287, 360, 325, 366, 350, 374, 329, 326,
-	229, 351, 258, 298, 240, 242, 254, 260, 262, 264,
-	265, 307, 308, 320, 339, 353, 354, 355, 257, 250,
-	334, 251, 274, 252, 230, 341, 253, 232, 321, 358,
-	0, 270, 330, 294, 233, 293, 322, 357, 356, 241,
-	382, 388, 389, 394, 0, 395, 0, 0, 0, 403,
-	408, 409, 410, 412, 413, 414, 415, 0, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 0, 387, 268,
-	226, 227, 422, 0, 313, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 309, 386, 0, 0, 0, 0,
-	421, 0, 0, 0, 0, 0, 420, 319, 0, 338,
+	0, 0, 0, 178, 0, 0, 0, 0, 0, 0,
+	244, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 235, 352, 368, 245, 343, 383, 250,
+	350, 240, 317, 340, 0, 0, 237, 366, 349, 298,
+	281, 282, 236, 0, 335, 260, 274, 257, 315, 0,
+	365, 395, 256, 386, 0, 376, 239, 0, 375, 314,
+	362, 367, 299, 293, 238, 364, 297, 292, 285, 264,
+	411, 278, 326, 291, 327, 279, 304, 303, 305, 0,
+	0, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 2222, 0, 0, 2221, 0, 302, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 0, 0,
+	0, 351, 0, 0, 286, 0, 0, 0, 396, 0,
+	338, 320, 0, 0, 0, 336, 289, 363, 328, 369,
+	267, 377, 380, 353, 378, 332, 329, 230, 354, 259,
+	300, 241, 243, 255, 261, 263, 265, 266, 310, 311,
+	323, 342, 356, 357, 358, 258, 251, 337, 252, 276,
+	253, 231, 344, 254, 233, 324, 361, 0, 272, 333,
+	296, 234, 295, 325, 360, 359, 242, 387, 393, 394,
+	399, 0, 400, 0, 0, 0, 408, 413, 414, 415,
+	417, 418, 419, 422, 420, 421, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 392, 270, 226,
+	227, 429, 0, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 391, 0, 0, 0, 0, 428,
+	0, 0, 0, 0, 0, 427, 322, 0, 341, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 371, 385, 403, 406, 0, 0, 0, 232,
+	405, 0, 0, 0, 0, 0, 0, 0, 374, 0,
+	0, 0, 384, 0, 0, 0, 0, 0, 401, 306,
+	307, 308, 309, 273, 0, 249, 404, 331, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 397, 398, 269, 275, 416, 277,
+	248, 321, 271, 382, 283, 0, 409, 0, 410, 0,
+	0, 0, 0, 313, 280, 345, 284, 290, 334, 381,
+	319, 339, 246, 370, 346, 294, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 345, 368, 380, 398, 401, 0, 0, 0,
-	231, 400, 0, 0, 0, 0, 0, 0, 0, 371,
-	0, 0, 0, 379, 0, 0, 0, 0, 0, 396,
-	303, 304, 305, 306, 271, 0, 248, 399, 328, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 392, 393, 267, 273, 411,
-	275, 247, 318, 269, 377, 281, 0, 404, 0, 405,
-	0, 0, 0, 0, 310, 278, 342, 282, 288, 331,
-	376, 316, 336, 245, 367, 343, 292, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 229, 0, 288, 0,
+	330, 268, 185, 186, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
+	222, 223, 224, 225, 355, 0, 0, 388, 389, 390,
+	412, 372, 0, 426, 0, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 1153, 0, 287, 0, 0, 0, 228,
+	0, 0, 0, 347, 301, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 0, 0, 1151, 0, 0,
+	0, 244, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 247, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1149, 0, 0,
+	0, 0, 0, 0, 235, 352, 368, 245, 343, 383,
+	250, 350, 240, 317, 340, 0, 0, 237, 366, 349,
+	298, 281, 282, 236, 0, 335, 260, 274, 257, 315,
+	0, 365, 395, 256, 386, 0, 376, 239, 0, 375,
+	314, 362, 367, 299, 293, 238, 364, 297, 292, 285,
+	264, 411, 278, 326, 291, 327, 279, 304, 303, 305,
+	0, 0, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 0,
+	0, 0, 351, 0, 0, 286, 0, 0, 0, 396,
+	0, 338, 320, 0, 0, 0, 336, 289, 363, 328,
+	369, 267, 377, 380, 353, 378, 332, 329, 230, 354,
+	259, 300, 241, 243, 255, 261, 263, 265, 266, 310,
+	311, 323, 342, 356, 357, 358, 258, 251, 337, 252,
+	276, 253, 231, 344, 254, 233, 324, 361, 0, 272,
+	333, 296, 234, 295, 325, 360, 359, 242, 387, 393,
+	394, 399, 0, 400, 0, 0, 0, 408, 413, 414,
+	415, 417, 418, 419, 422, 420, 421, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 392, 270,
+	226, 227, 429, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 312, 391, 0, 0, 0, 0,
+	428, 0, 0, 0, 0, 0, 427, 322, 0, 341,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 348, 371, 385, 403, 406, 0, 0, 0,
+	232, 405, 0, 0, 0, 0, 0, 0, 0, 374,
+	0, 0, 0, 384, 0, 0, 0, 0, 0, 401,
+	306, 307, 308, 309, 273, 0, 249, 404, 331, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 397, 398, 269, 275, 416,
+	277, 248, 321, 271, 382, 283, 0, 409, 0, 410,
+	0, 0, 0, 0, 313, 280, 345, 284, 290, 334,
+	381, 319, 339, 246, 370, 346, 294, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 286,
-	0, 327, 266, 185, 186, 187, 188, 189, 190, 191,
+	0, 0, 0, 0, 0, 0, 0, 229, 0, 288,
+	0, 330, 268, 185, 186, 187, 188, 189, 190, 191,
 	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
 	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
 	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 352, 0, 0, 383, 384,
-	385, 407, 369, 0, 419, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 285, 0, 0, 0,
-	0, 0, 0, 344, 299, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2895, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 349, 365, 244, 340, 378,
-	249, 347, 239, 314, 337, 0, 0, 236, 363, 346,
-	296, 279, 280, 235, 0, 332, 259, 272, 256, 312,
-	0, 362, 390, 255, 381, 0, 373, 238, 0, 372,
-	311, 359, 364, 297, 291, 237, 361, 295, 290, 283,
-	263, 406, 276, 323, 289, 324, 277, 301, 300, 302,
-	0, 0, 0, 0, 0, 402, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 375, 0, 0, 0, 0, 0, 0,
-	348, 0, 0, 284, 0, 0, 0, 391, 0, 335,
-	317, 0, 0, 0, 333, 287, 360, 325, 366, 350,
-	374, 329, 326, 229, 351, 258, 298, 240, 242, 254,
-	260, 262, 264, 265, 307, 308, 320, 339, 353, 354,
-	355, 257, 250, 334, 251, 274, 252, 230, 341, 253,
-	232, 321, 358, 0, 270, 330, 294, 233, 293, 322,
-	357, 356, 241, 382, 388, 389, 394, 0, 395, 0,
-	0, 0, 403, 408, 409, 410, 412, 413, 414, 415,
-	0, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	0, 387, 268, 226, 227, 422, 0, 313, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 309, 386, 0,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 420,
-	319, 0, 338, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 345, 368, 380, 398, 401,
-	0, 0, 0, 231, 400, 0, 0, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 379, 0, 0, 0,
-	0, 0, 396, 303, 304, 305, 306, 271, 0, 248,
-	399, 328, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 392, 393,
-	267, 273, 411, 275, 247, 318, 269, 377, 281, 0,
-	404, 0, 405, 0, 0, 0, 0, 310, 278, 342,
-	282, 288, 331, 376, 316, 336, 245, 367, 343, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	228, 0, 286, 0, 327, 266, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 0, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 0, 222, 223, 224, 225, 352, 0,
-	0, 383, 384, 385, 407, 369, 0, 419, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 0, 0, 285,
-	0, 0, 0, 0, 0, 0, 344, 299, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 178, 589, 0,
-	0, 0, 0, 0, 243, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 234, 349, 365,
-	244, 340, 378, 249, 347, 239, 314, 337, 0, 0,
-	236, 363, 346, 296, 279, 280, 235, 0, 332, 259,
-	272, 256, 312, 0, 362, 390, 255, 381, 0, 373,
-	238, 0, 372, 311, 359, 364, 297, 291, 237, 361,
-	295, 290, 283, 263, 406, 276, 323, 289, 324, 277,
-	301, 300, 302, 0, 0, 0, 0, 0, 402, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 375, 0, 0, 0,
-	0, 0, 0, 348, 0, 0, 284, 0, 0, 0,
-	391, 0, 335, 317, 0, 0, 0, 333, 287, 360,
-	325, 366, 350, 374, 329, 326, 229, 351, 258, 298,
-	240, 242, 254, 260, 262, 264, 265, 307, 308, 320,
-	339, 353, 354, 355, 257, 250, 334, 251, 274, 252,
-	230, 341, 253, 232, 321, 358, 0, 270, 330, 294,
-	233, 293, 322, 357, 356, 241, 382, 388, 389, 394,
-	0, 395, 0, 0, 0, 403, 408, 409, 410, 412,
-	413, 414, 415, 0, 0, 0, 0, 397, 0, 0,
-	0, 0, 0, 0, 387, 268, 226, 227, 422, 0,
-	313, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	309, 386, 0, 0, 0, 0, 421, 0, 0, 0,
-	0, 0, 420, 319, 0, 338, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 345, 368,
-	380, 398, 401, 0, 0, 0, 231, 400, 0, 0,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 379,
-	0, 0, 0, 0, 0, 396, 303, 304, 305, 306,
-	271, 0, 248, 399, 328, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 392, 393, 267, 273, 411, 275, 247, 318, 269,
-	377, 281, 0, 404, 0, 405, 0, 0, 0, 0,
-	310, 278, 342, 282, 288, 331, 376, 316, 336, 245,
-	367, 343, 292, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 286, 0, 327, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 352, 0, 0, 383, 384, 385, 407, 369, 0,
-	419, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 285, 0, 0, 0, 0, 0, 0, 344,
-	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2832, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 349, 365, 244, 340, 378, 249, 347, 239, 314,
-	337, 0, 0, 236, 363, 346, 296, 279, 280, 235,
-	0, 332, 259, 272, 256, 312, 0, 362, 390, 255,
-	381, 0, 373, 238, 0, 372, 311, 359, 364, 297,
-	291, 237, 361, 295, 290, 283, 263, 406, 276, 323,
-	289, 324, 277, 301, 300, 302, 0, 0, 0, 0,
-	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
-	0, 0, 0, 0, 0, 0, 348, 0, 0, 284,
-	0, 0, 0, 391, 0, 335, 317, 0, 0, 0,
-	333, 287, 360, 325, 366, 350, 374, 329, 326, 229,
-	351, 258, 298, 240, 242, 254, 260, 262, 264, 265,
-	307, 308, 320, 339, 353, 354, 355, 257, 250, 334,
-	251, 274, 252, 230, 341, 253, 232, 321, 358, 0,
-	270, 330, 294, 233, 293, 322, 357, 356, 241, 382,
-	388, 389, 394, 0, 395, 0, 0, 0, 403, 408,
-	409, 410, 412, 413, 414, 415, 0, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 0, 387, 268, 226,
-	227, 422, 0, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 309, 386, 0, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 420, 319, 0, 338, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 345, 368, 380, 398, 401, 0, 0, 0, 231,
-	400, 0, 0, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 379, 0, 0, 0, 0, 0, 396, 303,
-	304, 305, 306, 271, 0, 248, 399, 328, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 392, 393, 267, 273, 411, 275,
-	247, 318, 269, 377, 281, 0, 404, 0, 405, 0,
-	0, 0, 0, 310, 278, 342, 282, 288, 331, 376,
-	316, 336, 245, 367, 343, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
+	0, 222, 223, 224, 225, 355, 0, 0, 388, 389,
+	390, 412, 372, 0, 426, 0, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 1147, 0, 287, 0, 0, 0,
+	228, 0, 0, 0, 347, 301, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 0, 0, 1151, 0,
+	0, 0, 244, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1149, 0,
+	0, 0, 0, 0, 0, 235, 352, 368, 245, 343,
+	383, 250, 350, 240, 317, 340, 0, 0, 237, 366,
+	349, 298, 281, 282, 236, 0, 335, 260, 274, 257,
+	315, 0, 365, 395, 256, 386, 0, 376, 239, 0,
+	375, 314, 362, 367, 299, 293, 238, 364, 297, 292,
+	285, 264, 411, 278, 326, 291, 327, 279, 304, 303,
+	305, 0, 0, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	302, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 0, 0, 351, 0, 0, 286, 0, 0, 0,
+	396, 0, 338, 320, 0, 0, 0, 336, 289, 363,
+	328, 369, 267, 377, 380, 353, 378, 332, 329, 230,
+	354, 259, 300, 241, 243, 255, 261, 263, 265, 266,
+	310, 311, 323, 342, 356, 357, 358, 258, 251, 337,
+	252, 276, 253, 231, 344, 254, 233, 324, 361, 0,
+	272, 333, 296, 234, 295, 325, 360, 359, 242, 387,
+	393, 394, 399, 0, 400, 0, 0, 0, 408, 413,
+	414, 415, 417, 418, 419, 422, 420, 421, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 392,
+	270, 226, 227, 429, 0, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 391, 0, 0, 0,
+	0, 428, 0, 0, 0, 0, 0, 427, 322, 0,
+	341, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 348, 371, 385, 403, 406, 0, 0,
+	0, 232, 405, 0, 0, 0, 0, 0, 0, 0,
+	374, 0, 0, 0, 384, 0, 0, 0, 0, 0,
+	401, 306, 307, 308, 309, 273, 0, 249, 404, 331,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 397, 398, 269, 275,
+	416, 277, 248, 321, 271, 382, 283, 0, 409, 0,
+	410, 0, 0, 0, 0, 313, 280, 345, 284, 290,
+	334, 381, 319, 339, 246, 370, 346, 294, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 286, 0,
-	327, 266, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
-	222, 223, 224, 225, 352, 0, 0, 383, 384, 385,
-	407, 369, 0, 419, 0, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	288, 0, 330, 268, 185, 186, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 0, 222, 223, 224, 225, 355, 0, 0, 388,
+	389, 390, 412, 372, 0, 426, 0, 318, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 0, 0, 285, 0, 0, 0, 0,
-	0, 0, 344, 299, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 0, 287, 0, 0,
+	0, 228, 0, 0, 0, 347, 301, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 0, 0, 0, 0,
-	243, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2942, 0, 178, 596, 0, 0,
+	0, 0, 0, 244, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 234, 349, 365, 244, 340, 378, 249,
-	347, 239, 314, 337, 0, 0, 236, 363, 346, 296,
-	279, 280, 235, 0, 332, 259, 272, 256, 312, 0,
-	362, 390, 255, 381, 0, 373, 238, 0, 372, 311,
-	359, 364, 297, 291, 237, 361, 295, 290, 283, 263,
-	406, 276, 323, 289, 324, 277, 301, 300, 302, 0,
-	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 235, 352, 368, 245,
+	343, 383, 250, 350, 240, 317, 340, 0, 0, 237,
+	366, 349, 298, 281, 282, 236, 0, 335, 260, 274,
+	257, 315, 0, 365, 395, 256, 386, 0, 376, 239,
+	0, 375, 314, 362, 367, 299, 293, 238, 364, 297,
+	292, 285, 264, 411, 278, 326, 291, 327, 279, 304,
+	303, 305, 0, 0, 0, 0, 0, 407, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 375, 0, 0, 0, 2773, 0, 0, 348,
-	0, 0, 284, 0, 0, 0, 391, 0, 335, 317,
-	0, 0, 0, 333, 287, 360, 325, 366, 350, 374,
-	329, 326, 229, 351, 258, 298, 240, 242, 254, 260,
-	262, 264, 265, 307, 308, 320, 339, 353, 354, 355,
-	257, 250, 334, 251, 274, 252, 230, 341, 253, 232,
-	321, 358, 0, 270, 330, 294, 233, 293, 322, 357,
-	356, 241, 382, 388, 389, 394, 0, 395, 0, 0,
-	0, 403, 408, 409, 410, 412, 413, 414, 415, 0,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 0,
-	387, 268, 226, 227, 422, 0, 313, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 309, 386, 0, 0,
-	0, 0, 421, 0, 0, 0, 0, 0, 420, 319,
-	0, 338, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 345, 368, 380, 398, 401, 0,
-	0, 0, 231, 400, 0, 0, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 379, 0, 0, 0, 0,
-	0, 396, 303, 304, 305, 306, 271, 0, 248, 399,
-	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 392, 393, 267,
-	273, 411, 275, 247, 318, 269, 377, 281, 0, 404,
-	0, 405, 0, 0, 0, 0, 310, 278, 342, 282,
-	288, 331, 376, 316, 336, 245, 367, 343, 292, 0,
+	0, 302, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 0, 0, 0, 351, 0, 0, 286, 0, 0,
+	0, 396, 0, 338, 320, 0, 0, 0, 336, 289,
+	363, 328, 369, 267, 377, 380, 353, 378, 332, 329,
+	230, 354, 259, 300, 241, 243, 255, 261, 263, 265,
+	266, 310, 311, 323, 342, 356, 357, 358, 258, 251,
+	337, 252, 276, 253, 231, 344, 254, 233, 324, 361,
+	0, 272, 333, 296, 234, 295, 325, 360, 359, 242,
+	387, 393, 394, 399, 0, 400, 0, 0, 0, 408,
+	413, 414, 415, 417, 418, 419, 422, 420, 421, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	392, 270, 226, 227, 429, 0, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 312, 391, 0, 0,
+	0, 0, 428, 0, 0, 0, 0, 0, 427, 322,
+	0, 341, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 371, 385, 403, 406, 0,
+	0, 0, 232, 405, 0, 0, 0, 0, 0, 0,
+	0, 374, 0, 0, 0, 384, 0, 0, 0, 0,
+	0, 401, 306, 307, 308, 309, 273, 0, 249, 404,
+	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 397, 398, 269,
+	275, 416, 277, 248, 321, 271, 382, 283, 0, 409,
+	0, 410, 0, 0, 0, 0, 313, 280, 345, 284,
+	290, 334, 381, 319, 339, 246, 370, 346, 294, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 286, 0, 327, 266, 185, 186, 187, 188, 189,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
+	0, 288, 0, 330, 268, 185, 186, 187, 188, 189,
 	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
 	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
 	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 352, 0, 0,
-	383, 384, 385, 407, 369, 0, 419, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 285, 0,
-	0, 0, 0, 0, 0, 344, 299, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2612, 0, 0, 178, 0, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 349, 365, 244,
-	340, 378, 249, 347, 239, 314, 337, 0, 0, 236,
-	363, 346, 296, 279, 280, 235, 0, 332, 259, 272,
-	256, 312, 0, 362, 390, 255, 381, 0, 373, 238,
-	0, 372, 311, 359, 364, 297, 291, 237, 361, 295,
-	290, 283, 263, 406, 276, 323, 289, 324, 277, 301,
-	300, 302, 0, 0, 0, 0, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 375, 0, 0, 0, 0,
-	0, 0, 348, 0, 0, 284, 0, 0, 0, 391,
-	0, 335, 317, 0, 0, 0, 333, 287, 360, 325,
-	366, 350, 374, 329, 326, 229, 351, 258, 298, 240,
-	242, 254, 260, 262, 264, 265, 307, 308, 320, 339,
-	353, 354, 355, 257, 250, 334, 251, 274, 252, 230,
-	341, 253, 232, 321, 358, 0, 270, 330, 294, 233,
-	293, 322, 357, 356, 241, 382, 388, 389, 394, 0,
-	395, 0, 0, 0, 403, 408, 409, 410, 412, 413,
-	414, 415, 0, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 0, 387, 268, 226, 227, 422, 0, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 309,
-	386, 0, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 420, 319, 0, 338, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 345, 368, 380,
-	398, 401, 0, 0, 0, 231, 400, 0, 0, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 379, 0,
-	0, 0, 0, 0, 396, 303, 304, 305, 306, 271,
-	0, 248, 399, 328, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	392, 393, 267, 273, 411, 275, 247, 318, 269, 377,
-	281, 0, 404, 0, 405, 0, 0, 0, 0, 310,
-	278, 342, 282, 288, 331, 376, 316, 336, 245, 367,
-	343, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 286, 0, 327, 266, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
-	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
-	352, 0, 0, 383, 384, 385, 407, 369, 0, 419,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	0, 285, 0, 0, 0, 0, 0, 0, 344, 299,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	0, 0, 0, 0, 0, 0, 243, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
-	349, 365, 244, 340, 378, 249, 347, 239, 314, 337,
-	0, 0, 236, 363, 346, 296, 279, 280, 235, 0,
-	332, 259, 272, 256, 312, 0, 362, 390, 255, 381,
-	0, 373, 238, 0, 372, 311, 359, 364, 297, 291,
-	237, 361, 295, 290, 283, 263, 406, 276, 323, 289,
-	324, 277, 301, 300, 302, 0, 0, 0, 0, 0,
-	402, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 375, 0,
-	0, 0, 2656, 0, 0, 348, 0, 0, 284, 0,
-	0, 0, 391, 0, 335, 317, 0, 0, 0, 333,
-	287, 360, 325, 366, 350, 374, 329, 326, 229, 351,
-	258, 298, 240, 242, 254, 260, 262, 264, 265, 307,
-	308, 320, 339, 353, 354, 355, 257, 250, 334, 251,
-	274, 252, 230, 341, 253, 232, 321, 358, 0, 270,
-	330, 294, 233, 293, 322, 357, 356, 241, 382, 388,
-	389, 394, 0, 395, 0, 0, 0, 403, 408, 409,
-	410, 412, 413, 414, 415, 0, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 0, 387, 268, 226, 227,
-	422, 0, 313, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 309, 386, 0, 0, 0, 0, 421, 0,
-	0, 0, 0, 0, 420, 319, 0, 338, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	345, 368, 380, 398, 401, 0, 0, 0, 231, 400,
-	0, 0, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 379, 0, 0, 0, 0, 0, 396, 303, 304,
-	305, 306, 271, 0, 248, 399, 328, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 392, 393, 267, 273, 411, 275, 247,
-	318, 269, 377, 281, 0, 404, 0, 405, 0, 0,
-	0, 0, 310, 278, 342, 282, 288, 331, 376, 316,
-	336, 245, 367, 343, 292, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	219, 220, 0, 222, 223, 224, 225, 355, 0, 0,
+	388, 389, 390, 412, 372, 0, 426, 0, 318, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 0, 0, 287, 0,
+	0, 0, 228, 0, 0, 0, 347, 301, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 286, 0, 327,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 352, 0, 0, 383, 384, 385, 407,
-	369, 0, 419, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 285, 0, 0, 0, 0, 0,
-	0, 344, 299, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 0, 0,
+	1151, 0, 0, 0, 244, 179, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2606, 0, 0, 0, 0, 0, 0, 235, 352, 368,
+	245, 343, 383, 250, 350, 240, 317, 340, 0, 0,
+	237, 366, 349, 298, 281, 282, 236, 0, 335, 260,
+	274, 257, 315, 0, 365, 395, 256, 386, 0, 376,
+	239, 0, 375, 314, 362, 367, 299, 293, 238, 364,
+	297, 292, 285, 264, 411, 278, 326, 291, 327, 279,
+	304, 303, 305, 0, 0, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 302, 0, 0, 0, 0, 0, 379, 0,
+	0, 0, 0, 0, 0, 351, 0, 0, 286, 0,
+	0, 0, 396, 0, 338, 320, 0, 0, 0, 336,
+	289, 363, 328, 369, 267, 377, 380, 353, 378, 332,
+	329, 230, 354, 259, 300, 241, 243, 255, 261, 263,
+	265, 266, 310, 311, 323, 342, 356, 357, 358, 258,
+	251, 337, 252, 276, 253, 231, 344, 254, 233, 324,
+	361, 0, 272, 333, 296, 234, 295, 325, 360, 359,
+	242, 387, 393, 394, 399, 0, 400, 0, 0, 0,
+	408, 413, 414, 415, 417, 418, 419, 422, 420, 421,
+	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 392, 270, 226, 227, 429, 0, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 312, 391, 0,
+	0, 0, 0, 428, 0, 0, 0, 0, 0, 427,
+	322, 0, 341, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 348, 371, 385, 403, 406,
+	0, 0, 0, 232, 405, 0, 0, 0, 0, 0,
+	0, 0, 374, 0, 0, 0, 384, 0, 0, 0,
+	0, 0, 401, 306, 307, 308, 309, 273, 0, 249,
+	404, 331, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 397, 398,
+	269, 275, 416, 277, 248, 321, 271, 382, 283, 0,
+	409, 0, 410, 0, 0, 0, 0, 313, 280, 345,
+	284, 290, 334, 381, 319, 339, 246, 370, 346, 294,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2364, 0, 0, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 349, 365, 244, 340, 378, 249, 347,
-	239, 314, 337, 0, 0, 236, 363, 346, 296, 279,
-	280, 235, 0, 332, 259, 272, 256, 312, 0, 362,
-	390, 255, 381, 0, 373, 238, 0, 372, 311, 359,
-	364, 297, 291, 237, 361, 295, 290, 283, 263, 406,
-	276, 323, 289, 324, 277, 301, 300, 302, 0, 0,
-	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 375, 0, 0, 0, 0, 0, 0, 348, 0,
-	0, 284, 0, 0, 0, 391, 0, 335, 317, 0,
-	0, 0, 333, 287, 360, 325, 366, 350, 374, 329,
-	326, 229, 351, 258, 298, 240, 242, 254, 260, 262,
-	264, 265, 307, 308, 320, 339, 353, 354, 355, 257,
-	250, 334, 251, 274, 252, 230, 341, 253, 232, 321,
-	358, 0, 270, 330, 294, 233, 293, 322, 357, 356,
-	241, 382, 388, 389, 394, 0, 395, 0, 0, 0,
-	403, 408, 409, 410, 412, 413, 414, 415, 0, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 0, 387,
-	268, 226, 227, 422, 0, 313, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 309, 386, 0, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 420, 319, 0,
-	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 345, 368, 380, 398, 401, 0, 0,
-	0, 231, 400, 0, 0, 0, 0, 0, 0, 0,
-	371, 0, 0, 0, 379, 0, 0, 0, 0, 0,
-	396, 303, 304, 305, 306, 271, 0, 248, 399, 328,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 392, 393, 267, 273,
-	411, 275, 247, 318, 269, 377, 281, 0, 404, 0,
-	405, 0, 0, 0, 0, 310, 278, 342, 282, 288,
-	331, 376, 316, 336, 245, 367, 343, 292, 0, 0,
+	229, 0, 288, 0, 330, 268, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 0, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 0, 222, 223, 224, 225, 355, 0,
+	0, 388, 389, 390, 412, 372, 0, 426, 0, 318,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 0, 287,
+	0, 0, 0, 228, 0, 0, 0, 347, 301, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
-	286, 0, 327, 266, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 0, 222, 223, 224, 225, 352, 0, 0, 383,
-	384, 385, 407, 369, 0, 419, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 0, 0, 285, 0, 0,
-	0, 0, 0, 0, 344, 299, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1530, 0, 0, 178, 0, 0, 0, 0,
-	0, 0, 243, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 234, 349, 365, 244, 340,
-	378, 249, 347, 239, 314, 337, 0, 0, 236, 363,
-	346, 296, 279, 280, 235, 0, 332, 259, 272, 256,
-	312, 0, 362, 390, 255, 381, 0, 373, 238, 0,
-	372, 311, 359, 364, 297, 291, 237, 361, 295, 290,
-	283, 263, 406, 276, 323, 289, 324, 277, 301, 300,
-	302, 0, 0, 0, 0, 0, 402, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 375, 0, 0, 0, 0, 0,
-	0, 348, 0, 0, 284, 0, 0, 0, 391, 0,
-	335, 317, 0, 0, 0, 333, 287, 360, 325, 366,
-	350, 374, 329, 326, 229, 351, 258, 298, 240, 242,
-	254, 260, 262, 264, 265, 307, 308, 320, 339, 353,
-	354, 355, 257, 250, 334, 251, 274, 252, 230, 341,
-	253, 232, 321, 358, 0, 270, 330, 294, 233, 293,
-	322, 357, 356, 241, 382, 388, 389, 394, 0, 395,
-	0, 0, 0, 403, 408, 409, 410, 412, 413, 414,
-	415, 0, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 0, 387, 268, 226, 227, 422, 0, 313, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 309, 386,
-	0, 0, 0, 0, 421, 0, 0, 0, 0, 0,
-	420, 319, 0, 338, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 345, 368, 380, 398,
-	401, 0, 0, 0, 231, 400, 0, 0, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 379, 0, 0,
-	0, 0, 0, 396, 303, 304, 305, 306, 271, 0,
-	248, 399, 328, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
-	393, 267, 273, 411, 275, 247, 318, 269, 377, 281,
-	0, 404, 0, 405, 0, 0, 0, 0, 310, 278,
-	342, 282, 288, 331, 376, 316, 336, 245, 367, 343,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
+	0, 1151, 0, 0, 0, 244, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1149, 0, 0, 0, 0, 0, 0, 235, 352,
+	368, 245, 343, 383, 250, 350, 240, 317, 340, 0,
+	0, 237, 366, 349, 298, 281, 282, 236, 0, 335,
+	260, 274, 257, 315, 0, 365, 395, 256, 386, 0,
+	376, 239, 0, 375, 314, 362, 367, 299, 293, 238,
+	364, 297, 292, 285, 264, 411, 278, 326, 291, 327,
+	279, 304, 303, 305, 0, 0, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 351, 0, 0, 286,
+	0, 0, 0, 396, 0, 338, 320, 0, 0, 0,
+	336, 289, 363, 328, 369, 267, 377, 380, 353, 378,
+	332, 329, 230, 354, 259, 300, 241, 243, 255, 261,
+	263, 265, 266, 310, 311, 323, 342, 356, 357, 358,
+	258, 251, 337, 252, 276, 253, 231, 344, 254, 233,
+	324, 361, 0, 272, 333, 296, 234, 295, 325, 360,
+	359, 242, 387, 393, 394, 399, 0, 400, 0, 0,
+	0, 408, 413, 414, 415, 417, 418, 419, 422, 420,
+	421, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 392, 270, 226, 227, 429, 0, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 312, 391,
+	0, 0, 0, 0, 428, 0, 0, 0, 0, 0,
+	427, 322, 0, 341, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 348, 371, 385, 403,
+	406, 0, 0, 0, 232, 405, 0, 0, 0, 0,
+	0, 0, 0, 374, 0, 0, 0, 384, 0, 0,
+	0, 0, 0, 401, 306, 307, 308, 309, 273, 0,
+	249, 404, 331, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 397,
+	398, 269, 275, 416, 277, 248, 321, 271, 382, 283,
+	0, 409, 0, 410, 0, 0, 0, 0, 313, 280,
+	345, 284, 290, 334, 381, 319, 339, 246, 370, 346,
+	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 286, 0, 327, 266, 185, 186, 187,
+	0, 229, 0, 288, 0, 330, 268, 185, 186, 187,
 	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
 	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
 	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 352,
-	0, 0, 383, 384, 385, 407, 369, 0, 419, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 261, 0, 0,
-	285, 0, 0, 0, 0, 0, 0, 344, 299, 0,
+	217, 218, 219, 220, 0, 222, 223, 224, 225, 355,
+	0, 0, 388, 389, 390, 412, 372, 0, 426, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1928, 0, 0, 0, 0, 262, 0, 0,
+	287, 0, 0, 0, 228, 0, 0, 0, 347, 301,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 0, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2450, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 349,
-	365, 244, 340, 378, 249, 347, 239, 314, 337, 0,
-	0, 236, 363, 346, 296, 279, 280, 235, 0, 332,
-	259, 272, 256, 312, 0, 362, 390, 255, 381, 0,
-	373, 238, 0, 372, 311, 359, 364, 297, 291, 237,
-	361, 295, 290, 283, 263, 406, 276, 323, 289, 324,
-	277, 301, 300, 302, 0, 0, 0, 0, 0, 402,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 375, 0, 0,
-	0, 0, 0, 0, 348, 0, 0, 284, 0, 0,
-	0, 391, 0, 335, 317, 0, 0, 0, 333, 287,
-	360, 325, 366, 350, 374, 329, 326, 229, 351, 258,
-	298, 240, 242, 254, 260, 262, 264, 265, 307, 308,
-	320, 339, 353, 354, 355, 257, 250, 334, 251, 274,
-	252, 230, 341, 253, 232, 321, 358, 0, 270, 330,
-	294, 233, 293, 322, 357, 356, 241, 382, 388, 389,
-	394, 0, 395, 0, 0, 0, 403, 408, 409, 410,
-	412, 413, 414, 415, 0, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 0, 387, 268, 226, 227, 422,
-	0, 313, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 309, 386, 0, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 420, 319, 0, 338, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 345,
-	368, 380, 398, 401, 0, 0, 0, 231, 400, 0,
-	0, 0, 0, 0, 0, 0, 371, 0, 0, 0,
-	379, 0, 0, 0, 0, 0, 396, 303, 304, 305,
-	306, 271, 0, 248, 399, 328, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 392, 393, 267, 273, 411, 275, 247, 318,
-	269, 377, 281, 0, 404, 0, 405, 0, 0, 0,
-	0, 310, 278, 342, 282, 288, 331, 376, 316, 336,
-	245, 367, 343, 292, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	0, 0, 1930, 0, 0, 0, 244, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 235,
+	352, 368, 245, 343, 383, 250, 350, 240, 317, 340,
+	0, 0, 237, 366, 349, 298, 281, 282, 236, 0,
+	335, 260, 274, 257, 315, 0, 365, 395, 256, 386,
+	0, 376, 239, 0, 375, 314, 362, 367, 299, 293,
+	238, 364, 297, 292, 285, 264, 411, 278, 326, 291,
+	327, 279, 304, 303, 305, 0, 0, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 302, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 351, 0, 0,
+	286, 0, 0, 0, 396, 0, 338, 320, 0, 0,
+	0, 336, 289, 363, 328, 369, 267, 377, 380, 353,
+	378, 332, 329, 230, 354, 259, 300, 241, 243, 255,
+	261, 263, 265, 266, 310, 311, 323, 342, 356, 357,
+	358, 258, 251, 337, 252, 276, 253, 231, 344, 254,
+	233, 324, 361, 0, 272, 333, 296, 234, 295, 325,
+	360, 359, 242, 387, 393, 394, 399, 0, 400, 0,
+	0, 0, 408, 413, 414, 415, 417, 418, 419, 422,
+	420, 421, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 392, 270, 226, 227, 429, 0, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	391, 0, 0, 0, 0, 428, 0, 0, 0, 0,
+	0, 427, 322, 0, 341, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 371, 385,
+	403, 406, 0, 0, 0, 232, 405, 0, 0, 0,
+	0, 0, 0, 0, 374, 0, 0, 0, 384, 0,
+	0, 0, 0, 0, 401, 306, 307, 308, 309, 273,
+	0, 249, 404, 331, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	397, 398, 269, 275, 416, 277, 248, 321, 271, 382,
+	283, 0, 409, 0, 410, 0, 0, 0, 0, 313,
+	280, 345, 284, 290, 334, 381, 319, 339, 246, 370,
+	346, 294, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 288, 0, 330, 268, 185, 186,
+	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
+	355, 0, 0, 388, 389, 390, 412, 372, 0, 426,
+	0, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 1943,
+	0, 287, 0, 0, 0, 228, 0, 0, 0, 347,
+	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	178, 0, 0, 1151, 0, 0, 0, 244, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	235, 352, 368, 245, 343, 383, 250, 350, 240, 317,
+	340, 0, 0, 237, 366, 349, 298, 281, 282, 236,
+	0, 335, 260, 274, 257, 315, 0, 365, 395, 256,
+	386, 0, 376, 239, 0, 375, 314, 362, 367, 299,
+	293, 238, 364, 297, 292, 285, 264, 411, 278, 326,
+	291, 327, 279, 304, 303, 305, 0, 0, 0, 0,
+	0, 407, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 0, 0, 0, 351, 0,
+	0, 286, 0, 0, 0, 396, 0, 338, 320, 0,
+	0, 0, 336, 289, 363, 328, 369, 267, 377, 380,
+	353, 378, 332, 329, 230, 354, 259, 300, 241, 243,
+	255, 261, 263, 265, 266, 310, 311, 323, 342, 356,
+	357, 358, 258, 251, 337, 252, 276, 253, 231, 344,
+	254, 233, 324, 361, 0, 272, 333, 296, 234, 295,
+	325, 360, 359, 242, 387, 393, 394, 399, 0, 400,
+	0, 0, 0, 408, 413, 414, 415, 417, 418, 419,
+	422, 420, 421, 0, 0, 0, 0, 402, 0, 0,
+	0, 0, 0, 0, 392, 270, 226, 227, 429, 0,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	312, 391, 0, 0, 0, 0, 428, 0, 0, 0,
+	0, 0, 427, 322, 0, 341, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 348, 371,
+	385, 403, 406, 0, 0, 0, 232, 405, 0, 0,
+	0, 0, 0, 0, 0, 374, 0, 0, 0, 384,
+	0, 0, 0, 0, 0, 401, 306, 307, 308, 309,
+	273, 0, 249, 404, 331, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 397, 398, 269, 275, 416, 277, 248, 321, 271,
+	382, 283, 0, 409, 0, 410, 0, 0, 0, 0,
+	313, 280, 345, 284, 290, 334, 381, 319, 339, 246,
+	370, 346, 294, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 229, 0, 288, 0, 330, 268, 185,
+	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
+	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
+	225, 355, 0, 0, 388, 389, 390, 412, 372, 0,
+	426, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	0, 0, 287, 0, 0, 0, 228, 0, 0, 0,
+	347, 301, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3023,
+	0, 178, 0, 0, 0, 0, 0, 0, 244, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 235, 352, 368, 245, 343, 383, 250, 350, 240,
+	317, 340, 0, 0, 237, 366, 349, 298, 281, 282,
+	236, 0, 335, 260, 274, 257, 315, 0, 365, 395,
+	256, 386, 0, 376, 239, 0, 375, 314, 362, 367,
+	299, 293, 238, 364, 297, 292, 285, 264, 411, 278,
+	326, 291, 327, 279, 304, 303, 305, 0, 0, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 302, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 0, 0, 0, 351,
+	0, 0, 286, 0, 0, 0, 396, 0, 338, 320,
+	0, 0, 0, 336, 289, 363, 328, 369, 267, 377,
+	380, 353, 378, 332, 329, 230, 354, 259, 300, 241,
+	243, 255, 261, 263, 265, 266, 310, 311, 323, 342,
+	356, 357, 358, 258, 251, 337, 252, 276, 253, 231,
+	344, 254, 233, 324, 361, 0, 272, 333, 296, 234,
+	295, 325, 360, 359, 242, 387, 393, 394, 399, 0,
+	400, 0, 0, 0, 408, 413, 414, 415, 417, 418,
+	419, 422, 420, 421, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 392, 270, 226, 227, 429,
+	0, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 312, 391, 0, 0, 0, 0, 428, 0, 0,
+	0, 0, 0, 427, 322, 0, 341, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 348,
+	371, 385, 403, 406, 0, 0, 0, 232, 405, 0,
+	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
+	384, 0, 0, 0, 0, 0, 401, 306, 307, 308,
+	309, 273, 0, 249, 404, 331, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 397, 398, 269, 275, 416, 277, 248, 321,
+	271, 382, 283, 0, 409, 0, 410, 0, 0, 0,
+	0, 313, 280, 345, 284, 290, 334, 381, 319, 339,
+	246, 370, 346, 294, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 228, 0, 286, 0, 327, 266,
+	0, 0, 0, 0, 229, 0, 288, 0, 330, 268,
 	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
 	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
 	205, 206, 0, 207, 208, 209, 210, 211, 212, 213,
 	214, 215, 216, 217, 218, 219, 220, 0, 222, 223,
-	224, 225, 352, 0, 0, 383, 384, 385, 407, 369,
-	0, 419, 0, 315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 0, 0, 285, 0, 0, 0, 0, 0, 0,
-	344, 299, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 178, 0, 0, 2323, 0, 0, 0, 243, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	224, 225, 355, 0, 0, 388, 389, 390, 412, 372,
+	0, 426, 0, 318, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 0, 287, 0, 0, 0, 228, 0, 0,
+	0, 347, 301, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 596, 0, 0, 0, 0, 0, 244,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	247, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 235, 352, 368, 245, 343, 383, 250, 350,
+	240, 317, 340, 0, 0, 237, 366, 349, 298, 281,
+	282, 236, 0, 335, 260, 274, 257, 315, 0, 365,
+	395, 256, 386, 0, 376, 239, 0, 375, 314, 362,
+	367, 299, 293, 238, 364, 297, 292, 285, 264, 411,
+	278, 326, 291, 327, 279, 304, 303, 305, 0, 0,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 0, 0,
+	351, 0, 0, 286, 0, 0, 0, 396, 0, 338,
+	320, 0, 0, 0, 336, 289, 363, 328, 369, 267,
+	377, 380, 353, 378, 332, 329, 230, 354, 259, 300,
+	241, 243, 255, 261, 263, 265, 266, 310, 311, 323,
+	342, 356, 357, 358, 258, 251, 337, 252, 276, 253,
+	231, 344, 254, 233, 324, 361, 0, 272, 333, 296,
+	234, 295, 325, 360, 359, 242, 387, 393, 394, 399,
+	0, 400, 0, 0, 0, 408, 413, 414, 415, 417,
+	418, 419, 422, 420, 421, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 392, 270, 226, 227,
+	429, 0, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 312, 391, 0, 0, 0, 0, 428, 0,
+	0, 0, 0, 0, 427, 322, 0, 341, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	348, 371, 385, 403, 406, 0, 0, 0, 232, 405,
+	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
+	0, 384, 0, 0, 0, 0, 0, 401, 306, 307,
+	308, 309, 273, 0, 249, 404, 331, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 397, 398, 269, 275, 416, 277, 248,
+	321, 271, 382, 283, 0, 409, 0, 410, 0, 0,
+	0, 0, 313, 280, 345, 284, 290, 334, 381, 319,
+	339, 246, 370, 346, 294, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 229, 0, 288, 0, 330,
+	268, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
+	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
+	223, 224, 225, 355, 0, 0, 388, 389, 390, 412,
+	372, 0, 426, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 0, 287, 0, 0, 0, 228, 0,
+	0, 0, 347, 301, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2957, 0, 0, 178, 0, 0, 0, 0, 0, 0,
+	244, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 235, 352, 368, 245, 343, 383, 250,
+	350, 240, 317, 340, 0, 0, 237, 366, 349, 298,
+	281, 282, 236, 0, 335, 260, 274, 257, 315, 0,
+	365, 395, 256, 386, 0, 376, 239, 0, 375, 314,
+	362, 367, 299, 293, 238, 364, 297, 292, 285, 264,
+	411, 278, 326, 291, 327, 279, 304, 303, 305, 0,
+	0, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 0, 0,
+	0, 351, 0, 0, 286, 0, 0, 0, 396, 0,
+	338, 320, 0, 0, 0, 336, 289, 363, 328, 369,
+	267, 377, 380, 353, 378, 332, 329, 230, 354, 259,
+	300, 241, 243, 255, 261, 263, 265, 266, 310, 311,
+	323, 342, 356, 357, 358, 258, 251, 337, 252, 276,
+	253, 231, 344, 254, 233, 324, 361, 0, 272, 333,
+	296, 234, 295, 325, 360, 359, 242, 387, 393, 394,
+	399, 0, 400, 0, 0, 0, 408, 413, 414, 415,
+	417, 418, 419, 422, 420, 421, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 392, 270, 226,
+	227, 429, 0, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 391, 0, 0, 0, 0, 428,
+	0, 0, 0, 0, 0, 427, 322, 0, 341, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 371, 385, 403, 406, 0, 0, 0, 232,
+	405, 0, 0, 0, 0, 0, 0, 0, 374, 0,
+	0, 0, 384, 0, 0, 0, 0, 0, 401, 306,
+	307, 308, 309, 273, 0, 249, 404, 331, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 397, 398, 269, 275, 416, 277,
+	248, 321, 271, 382, 283, 0, 409, 0, 410, 0,
+	0, 0, 0, 313, 280, 345, 284, 290, 334, 381,
+	319, 339, 246, 370, 346, 294, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 234, 349, 365, 244, 340, 378, 249, 347, 239,
-	314, 337, 0, 0, 236, 363, 346, 296, 279, 280,
-	235, 0, 332, 259, 272, 256, 312, 0, 362, 390,
-	255, 381, 0, 373, 238, 0, 372, 311, 359, 364,
-	297, 291, 237, 361, 295, 290, 283, 263, 406, 276,
-	323, 289, 324, 277, 301, 300, 302, 0, 0, 0,
-	0, 0, 402, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	375, 0, 0, 0, 0, 0, 0, 348, 0, 0,
-	284, 0, 0, 0, 391, 0, 335, 317, 0, 0,
-	0, 333, 287, 360, 325, 366, 350, 374, 329, 326,
-	229, 351, 258, 298, 240, 242, 254, 260, 262, 264,
-	265, 307, 308, 320, 339, 353, 354, 355, 257, 250,
-	334, 251, 274, 252, 230, 341, 253, 232, 321, 358,
-	0, 270, 330, 294, 233, 293, 322, 357, 356, 241,
-	382, 388, 389, 394, 0, 395, 0, 0, 0, 403,
-	408, 409, 410, 412, 413, 414, 415, 0, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 0, 387, 268,
-	226, 227, 422, 0, 313, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 309, 386, 0, 0, 0, 0,
-	421, 0, 0, 0, 0, 0, 420, 319, 0, 338,
+	0, 0, 0, 0, 0, 0, 229, 0, 288, 0,
+	330, 268, 185, 186, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
+	222, 223, 224, 225, 355, 0, 0, 388, 389, 390,
+	412, 372, 0, 426, 0, 318, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 345, 368, 380, 398, 401, 0, 0, 0,
-	231, 400, 0, 0, 0, 0, 0, 0, 0, 371,
-	0, 0, 0, 379, 0, 0, 0, 0, 0, 396,
-	303, 304, 305, 306, 271, 0, 248, 399, 328, 0,
+	0, 0, 262, 0, 0, 287, 0, 0, 0, 228,
+	0, 0, 0, 347, 301, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 392, 393, 267, 273, 411,
-	275, 247, 318, 269, 377, 281, 0, 404, 0, 405,
-	0, 0, 0, 0, 310, 278, 342, 282, 288, 331,
-	376, 316, 336, 245, 367, 343, 292, 0, 0, 0,
+	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
+	0, 244, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 247, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 235, 352, 368, 245, 343, 383,
+	250, 350, 240, 317, 340, 0, 0, 237, 366, 349,
+	298, 281, 282, 236, 0, 335, 260, 274, 257, 315,
+	0, 365, 395, 256, 386, 0, 376, 239, 0, 375,
+	314, 362, 367, 299, 293, 238, 364, 297, 292, 285,
+	264, 411, 278, 326, 291, 327, 279, 304, 303, 305,
+	0, 0, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 2892,
+	0, 0, 351, 0, 0, 286, 0, 0, 0, 396,
+	0, 338, 320, 0, 0, 0, 336, 289, 363, 328,
+	369, 267, 377, 380, 353, 378, 332, 329, 230, 354,
+	259, 300, 241, 243, 255, 261, 263, 265, 266, 310,
+	311, 323, 342, 356, 357, 358, 258, 251, 337, 252,
+	276, 253, 231, 344, 254, 233, 324, 361, 0, 272,
+	333, 296, 234, 295, 325, 360, 359, 242, 387, 393,
+	394, 399, 0, 400, 0, 0, 0, 408, 413, 414,
+	415, 417, 418, 419, 422, 420, 421, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 392, 270,
+	226, 227, 429, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 312, 391, 0, 0, 0, 0,
+	428, 0, 0, 0, 0, 0, 427, 322, 0, 341,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 348, 371, 385, 403, 406, 0, 0, 0,
+	232, 405, 0, 0, 0, 0, 0, 0, 0, 374,
+	0, 0, 0, 384, 0, 0, 0, 0, 0, 401,
+	306, 307, 308, 309, 273, 0, 249, 404, 331, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 397, 398, 269, 275, 416,
+	277, 248, 321, 271, 382, 283, 0, 409, 0, 410,
+	0, 0, 0, 0, 313, 280, 345, 284, 290, 334,
+	381, 319, 339, 246, 370, 346, 294, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 286,
-	0, 327, 266, 185, 186, 187, 188, 189, 190, 191,
+	0, 0, 0, 0, 0, 0, 0, 229, 0, 288,
+	0, 330, 268, 185, 186, 187, 188, 189, 190, 191,
 	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
 	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
 	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 352, 0, 0, 383, 384,
-	385, 407, 369, 0, 419, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 285, 0, 0, 0,
-	0, 0, 0, 344, 299, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2254, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 349, 365, 244, 340, 378,
-	249, 347, 239, 314, 337, 0, 0, 236, 363, 346,
-	296, 279, 280, 235, 0, 332, 259, 272, 256, 312,
-	0, 362, 390, 255, 381, 0, 373, 238, 0, 372,
-	311, 359, 364, 297, 291, 237, 361, 295, 290, 283,
-	263, 406, 276, 323, 289, 324, 277, 301, 300, 302,
-	0, 0, 0, 0, 0, 402, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 375, 0, 0, 0, 0, 0, 0,
-	348, 0, 0, 284, 0, 0, 0, 391, 0, 335,
-	317, 0, 0, 0, 333, 287, 360, 325, 366, 350,
-	374, 329, 326, 229, 351, 258, 298, 240, 242, 254,
-	260, 262, 264, 265, 307, 308, 320, 339, 353, 354,
-	355, 257, 250, 334, 251, 274, 252, 230, 341, 253,
-	232, 321, 358, 0, 270, 330, 294, 233, 293, 322,
-	357, 356, 241, 382, 388, 389, 394, 0, 395, 0,
-	0, 0, 403, 408, 409, 410, 412, 413, 414, 415,
-	0, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	0, 387, 268, 226, 227, 422, 0, 313, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 309, 386, 0,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 420,
-	319, 0, 338, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 345, 368, 380, 398, 401,
-	0, 0, 0, 231, 400, 0, 0, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 379, 0, 0, 0,
-	0, 0, 396, 303, 304, 305, 306, 271, 0, 248,
-	399, 328, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 392, 393,
-	267, 273, 411, 275, 247, 318, 269, 377, 281, 0,
-	404, 0, 405, 0, 0, 0, 0, 310, 278, 342,
-	282, 288, 331, 376, 316, 336, 245, 367, 343, 292,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	228, 0, 286, 0, 327, 266, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 0, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 0, 222, 223, 224, 225, 352, 0,
-	0, 383, 384, 385, 407, 369, 0, 419, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 261, 0, 0, 285,
-	0, 0, 0, 0, 0, 0, 344, 299, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 178, 0, 0,
-	1144, 0, 0, 0, 243, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 234, 349, 365,
-	244, 340, 378, 249, 347, 239, 314, 337, 0, 0,
-	236, 363, 346, 296, 279, 280, 235, 0, 332, 259,
-	272, 256, 312, 0, 362, 390, 255, 381, 0, 373,
-	238, 0, 372, 311, 359, 364, 297, 291, 237, 361,
-	295, 290, 283, 263, 406, 276, 323, 289, 324, 277,
-	301, 300, 302, 0, 0, 0, 0, 0, 402, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 375, 0, 0, 0,
-	0, 0, 0, 348, 0, 0, 284, 0, 0, 0,
-	391, 0, 335, 317, 0, 0, 0, 333, 287, 360,
-	325, 366, 350, 374, 329, 326, 229, 351, 258, 298,
-	240, 242, 254, 260, 262, 264, 265, 307, 308, 320,
-	339, 353, 354, 355, 257, 250, 334, 251, 274, 252,
-	230, 341, 253, 232, 321, 358, 0, 270, 330, 294,
-	233, 293, 322, 357, 356, 241, 382, 388, 389, 394,
-	0, 395, 0, 0, 0, 403, 408, 409, 410, 412,
-	413, 414, 415, 0, 0, 0, 0, 397, 0, 0,
-	0, 0, 0, 0, 387, 268, 226, 227, 422, 0,
-	313, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	309, 386, 0, 0, 0, 0, 421, 0, 0, 0,
-	0, 0, 420, 319, 0, 338, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 345, 368,
-	380, 398, 401, 0, 0, 0, 231, 400, 0, 0,
-	0, 0, 0, 0, 0, 371, 0, 0, 0, 379,
-	0, 0, 0, 0, 0, 396, 303, 304, 305, 306,
-	271, 0, 248, 399, 328, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 392, 393, 267, 273, 411, 275, 247, 318, 269,
-	377, 281, 0, 404, 0, 405, 0, 0, 0, 0,
-	310, 278, 342, 282, 288, 331, 376, 316, 336, 245,
-	367, 343, 292, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 222, 223, 224, 225, 355, 0, 0, 388, 389,
+	390, 412, 372, 0, 426, 0, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 0, 0, 287, 0, 0, 0,
+	228, 0, 0, 0, 347, 301, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2698, 0, 0, 178, 0, 0, 0, 0,
+	0, 0, 244, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 235, 352, 368, 245, 343,
+	383, 250, 350, 240, 317, 340, 0, 0, 237, 366,
+	349, 298, 281, 282, 236, 0, 335, 260, 274, 257,
+	315, 0, 365, 395, 256, 386, 0, 376, 239, 0,
+	375, 314, 362, 367, 299, 293, 238, 364, 297, 292,
+	285, 264, 411, 278, 326, 291, 327, 279, 304, 303,
+	305, 0, 0, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	302, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 0, 0, 351, 0, 0, 286, 0, 0, 0,
+	396, 0, 338, 320, 0, 0, 0, 336, 289, 363,
+	328, 369, 267, 377, 380, 353, 378, 332, 329, 230,
+	354, 259, 300, 241, 243, 255, 261, 263, 265, 266,
+	310, 311, 323, 342, 356, 357, 358, 258, 251, 337,
+	252, 276, 253, 231, 344, 254, 233, 324, 361, 0,
+	272, 333, 296, 234, 295, 325, 360, 359, 242, 387,
+	393, 394, 399, 0, 400, 0, 0, 0, 408, 413,
+	414, 415, 417, 418, 419, 422, 420, 421, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 392,
+	270, 226, 227, 429, 0, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 391, 0, 0, 0,
+	0, 428, 0, 0, 0, 0, 0, 427, 322, 0,
+	341, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 348, 371, 385, 403, 406, 0, 0,
+	0, 232, 405, 0, 0, 0, 0, 0, 0, 0,
+	374, 0, 0, 0, 384, 0, 0, 0, 0, 0,
+	401, 306, 307, 308, 309, 273, 0, 249, 404, 331,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 397, 398, 269, 275,
+	416, 277, 248, 321, 271, 382, 283, 0, 409, 0,
+	410, 0, 0, 0, 0, 313, 280, 345, 284, 290,
+	334, 381, 319, 339, 246, 370, 346, 294, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 228, 0, 286, 0, 327, 266, 185,
-	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
-	225, 352, 0, 0, 383, 384, 385, 407, 369, 0,
-	419, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 285, 0, 0, 0, 0, 0, 0, 344,
-	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 1903, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 349, 365, 244, 340, 378, 249, 347, 239, 314,
-	337, 0, 0, 236, 363, 346, 296, 279, 280, 235,
-	0, 332, 259, 272, 256, 312, 0, 362, 390, 255,
-	381, 0, 373, 238, 0, 372, 311, 359, 364, 297,
-	291, 237, 361, 295, 290, 283, 263, 406, 276, 323,
-	289, 324, 277, 301, 300, 302, 0, 0, 0, 0,
-	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
-	0, 0, 0, 0, 0, 0, 348, 0, 0, 284,
-	0, 0, 0, 391, 0, 335, 317, 0, 0, 0,
-	333, 287, 360, 325, 366, 350, 374, 329, 326, 229,
-	351, 258, 298, 240, 242, 254, 260, 262, 264, 265,
-	307, 308, 320, 339, 353, 354, 355, 257, 250, 334,
-	251, 274, 252, 230, 341, 253, 232, 321, 358, 0,
-	270, 330, 294, 233, 293, 322, 357, 356, 241, 382,
-	388, 389, 394, 0, 395, 0, 0, 0, 403, 408,
-	409, 410, 412, 413, 414, 415, 0, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 0, 387, 268, 226,
-	227, 422, 0, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 309, 386, 0, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 420, 319, 0, 338, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 345, 368, 380, 398, 401, 0, 0, 0, 231,
-	400, 0, 0, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 379, 0, 0, 0, 0, 0, 396, 303,
-	304, 305, 306, 271, 0, 248, 399, 328, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 392, 393, 267, 273, 411, 275,
-	247, 318, 269, 377, 281, 0, 404, 0, 405, 0,
-	0, 0, 0, 310, 278, 342, 282, 288, 331, 376,
-	316, 336, 245, 367, 343, 292, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	288, 0, 330, 268, 185, 186, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 0, 222, 223, 224, 225, 355, 0, 0, 388,
+	389, 390, 412, 372, 0, 426, 0, 318, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 286, 0,
-	327, 266, 185, 186, 187, 188, 189, 190, 191, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
-	222, 223, 224, 225, 352, 0, 0, 383, 384, 385,
-	407, 369, 0, 419, 0, 315, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 0, 287, 0, 0,
+	0, 228, 0, 0, 0, 347, 301, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 0, 0, 285, 0, 0, 0, 0,
-	0, 0, 344, 299, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
+	0, 0, 0, 244, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 235, 352, 368, 245,
+	343, 383, 250, 350, 240, 317, 340, 0, 0, 237,
+	366, 349, 298, 281, 282, 236, 0, 335, 260, 274,
+	257, 315, 0, 365, 395, 256, 386, 0, 376, 239,
+	0, 375, 314, 362, 367, 299, 293, 238, 364, 297,
+	292, 285, 264, 411, 278, 326, 291, 327, 279, 304,
+	303, 305, 0, 0, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 2755, 0, 0, 351, 0, 0, 286, 0, 0,
+	0, 396, 0, 338, 320, 0, 0, 0, 336, 289,
+	363, 328, 369, 267, 377, 380, 353, 378, 332, 329,
+	230, 354, 259, 300, 241, 243, 255, 261, 263, 265,
+	266, 310, 311, 323, 342, 356, 357, 358, 258, 251,
+	337, 252, 276, 253, 231, 344, 254, 233, 324, 361,
+	0, 272, 333, 296, 234, 295, 325, 360, 359, 242,
+	387, 393, 394, 399, 0, 400, 0, 0, 0, 408,
+	413, 414, 415, 417, 418, 419, 422, 420, 421, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	392, 270, 226, 227, 429, 0, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 312, 391, 0, 0,
+	0, 0, 428, 0, 0, 0, 0, 0, 427, 322,
+	0, 341, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 371, 385, 403, 406, 0,
+	0, 0, 232, 405, 0, 0, 0, 0, 0, 0,
+	0, 374, 0, 0, 0, 384, 0, 0, 0, 0,
+	0, 401, 306, 307, 308, 309, 273, 0, 249, 404,
+	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 397, 398, 269,
+	275, 416, 277, 248, 321, 271, 382, 283, 0, 409,
+	0, 410, 0, 0, 0, 0, 313, 280, 345, 284,
+	290, 334, 381, 319, 339, 246, 370, 346, 294, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 0, 0, 0, 0,
-	243, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
+	0, 288, 0, 330, 268, 185, 186, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 0, 222, 223, 224, 225, 355, 0, 0,
+	388, 389, 390, 412, 372, 0, 426, 0, 318, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1638, 0, 0,
+	0, 0, 0, 0, 0, 262, 0, 0, 287, 0,
+	0, 0, 228, 0, 0, 0, 347, 301, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 234, 349, 365, 244, 340, 378, 249,
-	347, 239, 314, 337, 0, 0, 236, 363, 346, 296,
-	279, 280, 235, 0, 332, 259, 272, 256, 312, 0,
-	362, 390, 255, 381, 0, 373, 238, 0, 372, 311,
-	359, 364, 297, 291, 237, 361, 295, 290, 283, 263,
-	406, 276, 323, 289, 324, 277, 301, 300, 302, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 0, 0,
+	0, 0, 0, 0, 244, 179, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2410, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 235, 352, 368,
+	245, 343, 383, 250, 350, 240, 317, 340, 0, 0,
+	237, 366, 349, 298, 281, 282, 236, 0, 335, 260,
+	274, 257, 315, 0, 365, 395, 256, 386, 0, 376,
+	239, 0, 375, 314, 362, 367, 299, 293, 238, 364,
+	297, 292, 285, 264, 411, 278, 326, 291, 327, 279,
+	304, 303, 305, 0, 0, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 302, 0, 0, 0, 0, 0, 379, 0,
+	0, 0, 0, 0, 0, 351, 0, 0, 286, 0,
+	0, 0, 396, 0, 338, 320, 0, 0, 0, 336,
+	289, 363, 328, 369, 267, 377, 380, 353, 378, 332,
+	329, 230, 354, 259, 300, 241, 243, 255, 261, 263,
+	265, 266, 310, 311, 323, 342, 356, 357, 358, 258,
+	251, 337, 252, 276, 253, 231, 344, 254, 233, 324,
+	361, 0, 272, 333, 296, 234, 295, 325, 360, 359,
+	242, 387, 393, 394, 399, 0, 400, 0, 0, 0,
+	408, 413, 414, 415, 417, 418, 419, 422, 420, 421,
 	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 392, 270, 226, 227, 429, 0, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 312, 391, 0,
+	0, 0, 0, 428, 0, 0, 0, 0, 0, 427,
+	322, 0, 341, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 348, 371, 385, 403, 406,
+	0, 0, 0, 232, 405, 0, 0, 0, 0, 0,
+	0, 0, 374, 0, 0, 0, 384, 0, 0, 0,
+	0, 0, 401, 306, 307, 308, 309, 273, 0, 249,
+	404, 331, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 397, 398,
+	269, 275, 416, 277, 248, 321, 271, 382, 283, 0,
+	409, 0, 410, 0, 0, 0, 0, 313, 280, 345,
+	284, 290, 334, 381, 319, 339, 246, 370, 346, 294,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 375, 0, 0, 0, 0, 0, 0, 348,
-	0, 0, 284, 0, 0, 0, 391, 0, 335, 317,
-	0, 0, 0, 333, 287, 360, 325, 366, 350, 374,
-	329, 326, 229, 351, 258, 298, 240, 242, 254, 260,
-	262, 264, 265, 307, 308, 320, 339, 353, 354, 355,
-	257, 250, 334, 251, 274, 252, 230, 341, 253, 232,
-	321, 358, 0, 270, 330, 294, 233, 293, 322, 357,
-	356, 241, 382, 388, 389, 394, 0, 395, 0, 0,
-	0, 403, 408, 409, 410, 412, 413, 414, 415, 0,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 0,
-	387, 268, 226, 227, 422, 0, 313, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 309, 386, 0, 0,
-	0, 0, 421, 0, 0, 0, 0, 0, 420, 319,
-	0, 338, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 345, 368, 380, 398, 401, 0,
-	0, 0, 231, 400, 0, 0, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 379, 0, 0, 0, 0,
-	0, 396, 303, 304, 305, 306, 271, 0, 248, 399,
-	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 392, 393, 267,
-	273, 411, 275, 247, 318, 269, 377, 281, 0, 404,
-	0, 405, 0, 0, 0, 0, 310, 278, 342, 282,
-	288, 331, 376, 316, 336, 245, 367, 343, 292, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 286, 0, 327, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 352, 0, 0,
-	383, 384, 385, 407, 369, 0, 419, 0, 315, 0,
+	229, 0, 288, 0, 330, 268, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 0, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 0, 222, 223, 224, 225, 355, 0,
+	0, 388, 389, 390, 412, 372, 0, 426, 0, 318,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 0, 287,
+	0, 0, 0, 228, 0, 0, 0, 347, 301, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1545, 0, 0, 178, 0,
+	0, 0, 0, 0, 0, 244, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 235, 352,
+	368, 245, 343, 383, 250, 350, 240, 317, 340, 0,
+	0, 237, 366, 349, 298, 281, 282, 236, 0, 335,
+	260, 274, 257, 315, 0, 365, 395, 256, 386, 0,
+	376, 239, 0, 375, 314, 362, 367, 299, 293, 238,
+	364, 297, 292, 285, 264, 411, 278, 326, 291, 327,
+	279, 304, 303, 305, 0, 0, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 351, 0, 0, 286,
+	0, 0, 0, 396, 0, 338, 320, 0, 0, 0,
+	336, 289, 363, 328, 369, 267, 377, 380, 353, 378,
+	332, 329, 230, 354, 259, 300, 241, 243, 255, 261,
+	263, 265, 266, 310, 311, 323, 342, 356, 357, 358,
+	258, 251, 337, 252, 276, 253, 231, 344, 254, 233,
+	324, 361, 0, 272, 333, 296, 234, 295, 325, 360,
+	359, 242, 387, 393, 394, 399, 0, 400, 0, 0,
+	0, 408, 413, 414, 415, 417, 418, 419, 422, 420,
+	421, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 392, 270, 226, 227, 429, 0, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 312, 391,
+	0, 0, 0, 0, 428, 0, 0, 0, 0, 0,
+	427, 322, 0, 341, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 348, 371, 385, 403,
+	406, 0, 0, 0, 232, 405, 0, 0, 0, 0,
+	0, 0, 0, 374, 0, 0, 0, 384, 0, 0,
+	0, 0, 0, 401, 306, 307, 308, 309, 273, 0,
+	249, 404, 331, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 397,
+	398, 269, 275, 416, 277, 248, 321, 271, 382, 283,
+	0, 409, 0, 410, 0, 0, 0, 0, 313, 280,
+	345, 284, 290, 334, 381, 319, 339, 246, 370, 346,
+	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 285, 0,
-	0, 0, 0, 0, 0, 344, 299, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1931, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 349, 365, 244,
-	340, 378, 249, 347, 239, 314, 337, 0, 0, 236,
-	363, 346, 296, 279, 280, 235, 0, 332, 259, 272,
-	256, 312, 0, 362, 390, 255, 381, 0, 373, 238,
-	0, 372, 311, 359, 364, 297, 291, 237, 361, 295,
-	290, 283, 263, 406, 276, 323, 289, 324, 277, 301,
-	300, 302, 0, 0, 0, 0, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 375, 0, 0, 0, 0,
-	0, 0, 348, 0, 0, 284, 0, 0, 0, 391,
-	0, 335, 317, 0, 0, 0, 333, 287, 360, 325,
-	366, 350, 374, 329, 326, 229, 351, 258, 298, 240,
-	242, 254, 260, 262, 264, 265, 307, 308, 320, 339,
-	353, 354, 355, 257, 250, 334, 251, 274, 252, 230,
-	341, 253, 232, 321, 358, 0, 270, 330, 294, 233,
-	293, 322, 357, 356, 241, 382, 388, 389, 394, 0,
-	395, 0, 0, 0, 403, 408, 409, 410, 412, 413,
-	414, 415, 0, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 0, 387, 268, 226, 227, 422, 0, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 309,
-	386, 0, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 420, 319, 0, 338, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 345, 368, 380,
-	398, 401, 0, 0, 0, 231, 400, 0, 0, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 379, 0,
-	0, 0, 0, 0, 396, 303, 304, 305, 306, 271,
-	0, 248, 399, 328, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	392, 393, 267, 273, 411, 275, 247, 318, 269, 377,
-	281, 0, 404, 0, 405, 0, 0, 0, 0, 310,
-	278, 342, 282, 288, 331, 376, 316, 336, 245, 367,
-	343, 292, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 229, 0, 288, 0, 330, 268, 185, 186, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 0, 222, 223, 224, 225, 355,
+	0, 0, 388, 389, 390, 412, 372, 0, 426, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 0,
+	287, 0, 0, 0, 228, 0, 0, 0, 347, 301,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	0, 0, 0, 0, 0, 0, 244, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2520, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 235,
+	352, 368, 245, 343, 383, 250, 350, 240, 317, 340,
+	0, 0, 237, 366, 349, 298, 281, 282, 236, 0,
+	335, 260, 274, 257, 315, 0, 365, 395, 256, 386,
+	0, 376, 239, 0, 375, 314, 362, 367, 299, 293,
+	238, 364, 297, 292, 285, 264, 411, 278, 326, 291,
+	327, 279, 304, 303, 305, 0, 0, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 302, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 351, 0, 0,
+	286, 0, 0, 0, 396, 0, 338, 320, 0, 0,
+	0, 336, 289, 363, 328, 369, 267, 377, 380, 353,
+	378, 332, 329, 230, 354, 259, 300, 241, 243, 255,
+	261, 263, 265, 266, 310, 311, 323, 342, 356, 357,
+	358, 258, 251, 337, 252, 276, 253, 231, 344, 254,
+	233, 324, 361, 0, 272, 333, 296, 234, 295, 325,
+	360, 359, 242, 387, 393, 394, 399, 0, 400, 0,
+	0, 0, 408, 413, 414, 415, 417, 418, 419, 422,
+	420, 421, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 392, 270, 226, 227, 429, 0, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	391, 0, 0, 0, 0, 428, 0, 0, 0, 0,
+	0, 427, 322, 0, 341, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 371, 385,
+	403, 406, 0, 0, 0, 232, 405, 0, 0, 0,
+	0, 0, 0, 0, 374, 0, 0, 0, 384, 0,
+	0, 0, 0, 0, 401, 306, 307, 308, 309, 273,
+	0, 249, 404, 331, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	397, 398, 269, 275, 416, 277, 248, 321, 271, 382,
+	283, 0, 409, 0, 410, 0, 0, 0, 0, 313,
+	280, 345, 284, 290, 334, 381, 319, 339, 246, 370,
+	346, 294, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 286, 0, 327, 266, 185, 186,
+	0, 0, 229, 0, 288, 0, 330, 268, 185, 186,
 	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
 	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
 	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
 	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
-	352, 0, 0, 383, 384, 385, 407, 369, 0, 419,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	0, 285, 0, 0, 0, 0, 0, 0, 344, 299,
+	355, 0, 0, 388, 389, 390, 412, 372, 0, 426,
+	0, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	0, 287, 0, 0, 0, 228, 0, 0, 0, 347,
+	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	178, 0, 0, 2370, 0, 0, 0, 244, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	235, 352, 368, 245, 343, 383, 250, 350, 240, 317,
+	340, 0, 0, 237, 366, 349, 298, 281, 282, 236,
+	0, 335, 260, 274, 257, 315, 0, 365, 395, 256,
+	386, 0, 376, 239, 0, 375, 314, 362, 367, 299,
+	293, 238, 364, 297, 292, 285, 264, 411, 278, 326,
+	291, 327, 279, 304, 303, 305, 0, 0, 0, 0,
+	0, 407, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 0, 0, 0, 0,
+	0, 379, 0, 0, 0, 0, 0, 0, 351, 0,
+	0, 286, 0, 0, 0, 396, 0, 338, 320, 0,
+	0, 0, 336, 289, 363, 328, 369, 267, 377, 380,
+	353, 378, 332, 329, 230, 354, 259, 300, 241, 243,
+	255, 261, 263, 265, 266, 310, 311, 323, 342, 356,
+	357, 358, 258, 251, 337, 252, 276, 253, 231, 344,
+	254, 233, 324, 361, 0, 272, 333, 296, 234, 295,
+	325, 360, 359, 242, 387, 393, 394, 399, 0, 400,
+	0, 0, 0, 408, 413, 414, 415, 417, 418, 419,
+	422, 420, 421, 0, 0, 0, 0, 402, 0, 0,
+	0, 0, 0, 0, 392, 270, 226, 227, 429, 0,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	312, 391, 0, 0, 0, 0, 428, 0, 0, 0,
+	0, 0, 427, 322, 0, 341, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 348, 371,
+	385, 403, 406, 0, 0, 0, 232, 405, 0, 0,
+	0, 0, 0, 0, 0, 374, 0, 0, 0, 384,
+	0, 0, 0, 0, 0, 401, 306, 307, 308, 309,
+	273, 0, 249, 404, 331, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 397, 398, 269, 275, 416, 277, 248, 321, 271,
+	382, 283, 0, 409, 0, 410, 0, 0, 0, 0,
+	313, 280, 345, 284, 290, 334, 381, 319, 339, 246,
+	370, 346, 294, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	0, 0, 1929, 0, 0, 0, 243, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
-	349, 365, 244, 340, 378, 249, 347, 239, 314, 337,
-	0, 0, 236, 363, 346, 296, 279, 280, 235, 0,
-	332, 259, 272, 256, 312, 0, 362, 390, 255, 381,
-	0, 373, 238, 0, 372, 311, 359, 364, 297, 291,
-	237, 361, 295, 290, 283, 263, 406, 276, 323, 289,
-	324, 277, 301, 300, 302, 0, 0, 0, 0, 0,
-	402, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 375, 0,
-	0, 0, 0, 0, 0, 348, 0, 0, 284, 0,
-	0, 0, 391, 0, 335, 317, 0, 0, 0, 333,
-	287, 360, 325, 366, 350, 374, 329, 326, 229, 351,
-	258, 298, 240, 242, 254, 260, 262, 264, 265, 307,
-	308, 320, 339, 353, 354, 355, 257, 250, 334, 251,
-	274, 252, 230, 341, 253, 232, 321, 358, 0, 270,
-	330, 294, 233, 293, 322, 357, 356, 241, 382, 388,
-	389, 394, 0, 395, 0, 0, 0, 403, 408, 409,
-	410, 412, 413, 414, 415, 0, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 0, 387, 268, 226, 227,
-	422, 0, 313, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 309, 386, 0, 0, 0, 0, 421, 0,
-	0, 0, 0, 0, 420, 319, 0, 338, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	345, 368, 380, 398, 401, 0, 0, 0, 231, 400,
-	0, 0, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 379, 0, 0, 0, 0, 0, 396, 303, 304,
-	305, 306, 271, 0, 248, 399, 328, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 392, 393, 267, 273, 411, 275, 247,
-	318, 269, 377, 281, 0, 404, 0, 405, 0, 0,
-	0, 0, 310, 278, 342, 282, 288, 331, 376, 316,
-	336, 245, 367, 343, 292, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 229, 0, 288, 0, 330, 268, 185,
+	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
+	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
+	225, 355, 0, 0, 388, 389, 390, 412, 372, 0,
+	426, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	0, 0, 287, 0, 0, 0, 228, 0, 0, 0,
+	347, 301, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 0, 0, 2306, 0, 0, 0, 244, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 235, 352, 368, 245, 343, 383, 250, 350, 240,
+	317, 340, 0, 0, 237, 366, 349, 298, 281, 282,
+	236, 0, 335, 260, 274, 257, 315, 0, 365, 395,
+	256, 386, 0, 376, 239, 0, 375, 314, 362, 367,
+	299, 293, 238, 364, 297, 292, 285, 264, 411, 278,
+	326, 291, 327, 279, 304, 303, 305, 0, 0, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 302, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 0, 0, 0, 351,
+	0, 0, 286, 0, 0, 0, 396, 0, 338, 320,
+	0, 0, 0, 336, 289, 363, 328, 369, 267, 377,
+	380, 353, 378, 332, 329, 230, 354, 259, 300, 241,
+	243, 255, 261, 263, 265, 266, 310, 311, 323, 342,
+	356, 357, 358, 258, 251, 337, 252, 276, 253, 231,
+	344, 254, 233, 324, 361, 0, 272, 333, 296, 234,
+	295, 325, 360, 359, 242, 387, 393, 394, 399, 0,
+	400, 0, 0, 0, 408, 413, 414, 415, 417, 418,
+	419, 422, 420, 421, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 392, 270, 226, 227, 429,
+	0, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 312, 391, 0, 0, 0, 0, 428, 0, 0,
+	0, 0, 0, 427, 322, 0, 341, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 348,
+	371, 385, 403, 406, 0, 0, 0, 232, 405, 0,
+	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
+	384, 0, 0, 0, 0, 0, 401, 306, 307, 308,
+	309, 273, 0, 249, 404, 331, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 397, 398, 269, 275, 416, 277, 248, 321,
+	271, 382, 283, 0, 409, 0, 410, 0, 0, 0,
+	0, 313, 280, 345, 284, 290, 334, 381, 319, 339,
+	246, 370, 346, 294, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 229, 0, 288, 0, 330, 268,
+	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
+	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	205, 206, 0, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 0, 222, 223,
+	224, 225, 355, 0, 0, 388, 389, 390, 412, 372,
+	0, 426, 0, 318, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 0, 287, 0, 0, 0, 228, 0, 0,
+	0, 347, 301, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 178, 0, 0, 0, 0, 0, 0, 244,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	247, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2300, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 235, 352, 368, 245, 343, 383, 250, 350,
+	240, 317, 340, 0, 0, 237, 366, 349, 298, 281,
+	282, 236, 0, 335, 260, 274, 257, 315, 0, 365,
+	395, 256, 386, 0, 376, 239, 0, 375, 314, 362,
+	367, 299, 293, 238, 364, 297, 292, 285, 264, 411,
+	278, 326, 291, 327, 279, 304, 303, 305, 0, 0,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 0, 0,
+	351, 0, 0, 286, 0, 0, 0, 396, 0, 338,
+	320, 0, 0, 0, 336, 289, 363, 328, 369, 267,
+	377, 380, 353, 378, 332, 329, 230, 354, 259, 300,
+	241, 243, 255, 261, 263, 265, 266, 310, 311, 323,
+	342, 356, 357, 358, 258, 251, 337, 252, 276, 253,
+	231, 344, 254, 233, 324, 361, 0, 272, 333, 296,
+	234, 295, 325, 360, 359, 242, 387, 393, 394, 399,
+	0, 400, 0, 0, 0, 408, 413, 414, 415, 417,
+	418, 419, 422, 420, 421, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 392, 270, 226, 227,
+	429, 0, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 312, 391, 0, 0, 0, 0, 428, 0,
+	0, 0, 0, 0, 427, 322, 0, 341, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	348, 371, 385, 403, 406, 0, 0, 0, 232, 405,
+	0, 0, 0, 0, 0, 0, 0, 374, 0, 0,
+	0, 384, 0, 0, 0, 0, 0, 401, 306, 307,
+	308, 309, 273, 0, 249, 404, 331, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 397, 398, 269, 275, 416, 277, 248,
+	321, 271, 382, 283, 0, 409, 0, 410, 0, 0,
+	0, 0, 313, 280, 345, 284, 290, 334, 381, 319,
+	339, 246, 370, 346, 294, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 228, 0, 286, 0, 327,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	0, 0, 0, 0, 0, 229, 0, 288, 0, 330,
+	268, 185, 186, 187, 188, 189, 190, 191, 192, 193,
 	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
 	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
 	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 0, 0, 0, 383, 384, 385, 407,
-	369, 352, 419, 0, 0, 1802, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
-	0, 0, 285, 0, 0, 0, 0, 0, 0, 344,
-	299, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	178, 0, 0, 0, 0, 0, 0, 243, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	234, 349, 365, 244, 340, 378, 249, 347, 239, 314,
-	337, 0, 0, 236, 363, 346, 296, 279, 280, 235,
-	0, 332, 259, 272, 256, 312, 0, 362, 390, 255,
-	381, 0, 373, 238, 0, 372, 311, 359, 364, 297,
-	291, 237, 361, 295, 290, 283, 263, 406, 276, 323,
-	289, 324, 277, 301, 300, 302, 0, 0, 0, 0,
-	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 375,
-	0, 0, 0, 0, 0, 0, 348, 0, 0, 284,
-	0, 0, 0, 391, 0, 335, 317, 0, 0, 0,
-	333, 287, 360, 325, 366, 350, 374, 329, 326, 229,
-	351, 258, 298, 240, 242, 254, 260, 262, 264, 265,
-	307, 308, 320, 339, 353, 354, 355, 257, 250, 334,
-	251, 274, 252, 230, 341, 253, 232, 321, 358, 0,
-	270, 330, 294, 233, 293, 322, 357, 356, 241, 382,
-	388, 389, 394, 0, 395, 0, 0, 0, 403, 408,
-	409, 410, 412, 413, 414, 415, 0, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 0, 387, 268, 226,
-	227, 422, 0, 313, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 309, 386, 0, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 420, 319, 0, 338, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 345, 368, 380, 398, 401, 0, 0, 0, 231,
-	400, 0, 0, 0, 0, 0, 0, 0, 371, 0,
-	0, 0, 379, 0, 0, 0, 0, 0, 396, 303,
-	304, 305, 306, 271, 0, 248, 399, 328, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 392, 393, 267, 273, 411, 275,
-	247, 318, 269, 377, 281, 0, 404, 0, 405, 0,
-	0, 0, 0, 310, 278, 342, 282, 288, 331, 376,
-	316, 336, 245, 367, 343, 292, 0, 0, 0, 0,
+	223, 224, 225, 355, 0, 0, 388, 389, 390, 412,
+	372, 0, 426, 0, 318, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 0, 287, 0, 0, 0, 228, 0,
+	0, 0, 347, 301, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 0, 0, 1151, 0, 0, 0,
+	244, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 235, 352, 368, 245, 343, 383, 250,
+	350, 240, 317, 340, 0, 0, 237, 366, 349, 298,
+	281, 282, 236, 0, 335, 260, 274, 257, 315, 0,
+	365, 395, 256, 386, 0, 376, 239, 0, 375, 314,
+	362, 367, 299, 293, 238, 364, 297, 292, 285, 264,
+	411, 278, 326, 291, 327, 279, 304, 303, 305, 0,
+	0, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	0, 0, 0, 0, 379, 0, 0, 0, 0, 0,
+	0, 351, 0, 0, 286, 0, 0, 0, 396, 0,
+	338, 320, 0, 0, 0, 336, 289, 363, 328, 369,
+	267, 377, 380, 353, 378, 332, 329, 230, 354, 259,
+	300, 241, 243, 255, 261, 263, 265, 266, 310, 311,
+	323, 342, 356, 357, 358, 258, 251, 337, 252, 276,
+	253, 231, 344, 254, 233, 324, 361, 0, 272, 333,
+	296, 234, 295, 325, 360, 359, 242, 387, 393, 394,
+	399, 0, 400, 0, 0, 0, 408, 413, 414, 415,
+	417, 418, 419, 422, 420, 421, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 392, 270, 226,
+	227, 429, 0, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 391, 0, 0, 0, 0, 428,
+	0, 0, 0, 0, 0, 427, 322, 0, 341, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 371, 385, 403, 406, 0, 0, 0, 232,
+	405, 0, 0, 0, 0, 0, 0, 0, 374, 0,
+	0, 0, 384, 0, 0, 0, 0, 0, 401, 306,
+	307, 308, 309, 273, 0, 249, 404, 331, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 397, 398, 269, 275, 416, 277,
+	248, 321, 271, 382, 283, 0, 409, 0, 410, 0,
+	0, 0, 0, 313, 280, 345, 284, 290, 334, 381,
+	319, 339, 246, 370, 346, 294, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 228, 0, 286, 0,
-	327, 266, 185, 186, 187, 188, 189, 190, 191, 192,
+	0, 0, 0, 0, 0, 0, 229, 0, 288, 0,
+	330, 268, 185, 186, 187, 188, 189, 190, 191, 192,
 	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
 	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
 	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
-	222, 223, 224, 225, 352, 0, 0, 383, 384, 385,
-	407, 369, 0, 419, 0, 315, 0, 0, 0, 0,
+	222, 223, 224, 225, 355, 0, 0, 388, 389, 390,
+	412, 372, 0, 426, 0, 318, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 0, 0, 287, 0, 0, 0, 228,
+	0, 0, 0, 347, 301, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 178, 0, 0, 1930, 0, 0,
+	0, 244, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 247, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 235, 352, 368, 245, 343, 383,
+	250, 350, 240, 317, 340, 0, 0, 237, 366, 349,
+	298, 281, 282, 236, 0, 335, 260, 274, 257, 315,
+	0, 365, 395, 256, 386, 0, 376, 239, 0, 375,
+	314, 362, 367, 299, 293, 238, 364, 297, 292, 285,
+	264, 411, 278, 326, 291, 327, 279, 304, 303, 305,
+	0, 0, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 0,
+	0, 0, 351, 0, 0, 286, 0, 0, 0, 396,
+	0, 338, 320, 0, 0, 0, 336, 289, 363, 328,
+	369, 267, 377, 380, 353, 378, 332, 329, 230, 354,
+	259, 300, 241, 243, 255, 261, 263, 265, 266, 310,
+	311, 323, 342, 356, 357, 358, 258, 251, 337, 252,
+	276, 253, 231, 344, 254, 233, 324, 361, 0, 272,
+	333, 296, 234, 295, 325, 360, 359, 242, 387, 393,
+	394, 399, 0, 400, 0, 0, 0, 408, 413, 414,
+	415, 417, 418, 419, 422, 420, 421, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 392, 270,
+	226, 227, 429, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 312, 391, 0, 0, 0, 0,
+	428, 0, 0, 0, 0, 0, 427, 322, 0, 341,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 348, 371, 385, 403, 406, 0, 0, 0,
+	232, 405, 0, 0, 0, 0, 0, 0, 0, 374,
+	0, 0, 0, 384, 0, 0, 0, 0, 0, 401,
+	306, 307, 308, 309, 273, 0, 249, 404, 331, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 397, 398, 269, 275, 416,
+	277, 248, 321, 271, 382, 283, 0, 409, 0, 410,
+	0, 0, 0, 0, 313, 280, 345, 284, 290, 334,
+	381, 319, 339, 246, 370, 346, 294, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 261, 0, 0, 285, 0, 0, 0, 0,
-	0, 0, 344, 299, 0, 0, 0, 0, 0, 0,
+	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 178, 0, 0, 1144, 0, 0, 0,
-	243, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 229, 0, 288,
+	0, 330, 268, 185, 186, 187, 188, 189, 190, 191,
+	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
+	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	0, 222, 223, 224, 225, 355, 0, 0, 388, 389,
+	390, 412, 372, 0, 426, 0, 318, 0, 0, 0,
+	0, 0, 0, 0, 0, 2160, 0, 0, 0, 0,
+	0, 0, 0, 262, 0, 0, 287, 0, 0, 0,
+	228, 0, 0, 0, 347, 301, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 178, 0, 0, 0, 0,
+	0, 0, 244, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 235, 352, 368, 245, 343,
+	383, 250, 350, 240, 317, 340, 0, 0, 237, 366,
+	349, 298, 281, 282, 236, 0, 335, 260, 274, 257,
+	315, 0, 365, 395, 256, 386, 0, 376, 239, 0,
+	375, 314, 362, 367, 299, 293, 238, 364, 297, 292,
+	285, 264, 411, 278, 326, 291, 327, 279, 304, 303,
+	305, 0, 0, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	302, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 0, 0, 351, 0, 0, 286, 0, 0, 0,
+	396, 0, 338, 320, 0, 0, 0, 336, 289, 363,
+	328, 369, 267, 377, 380, 353, 378, 332, 329, 230,
+	354, 259, 300, 241, 243, 255, 261, 263, 265, 266,
+	310, 311, 323, 342, 356, 357, 358, 258, 251, 337,
+	252, 276, 253, 231, 344, 254, 233, 324, 361, 0,
+	272, 333, 296, 234, 295, 325, 360, 359, 242, 387,
+	393, 394, 399, 0, 400, 0, 0, 0, 408, 413,
+	414, 415, 417, 418, 419, 422, 420, 421, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 392,
+	270, 226, 227, 429, 0, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 391, 0, 0, 0,
+	0, 428, 0, 0, 0, 0, 0, 427, 322, 0,
+	341, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 348, 371, 385, 403, 406, 0, 0,
+	0, 232, 405, 0, 0, 0, 0, 0, 0, 0,
+	374, 0, 0, 0, 384, 0, 0, 0, 0, 0,
+	401, 306, 307, 308, 309, 273, 0, 249, 404, 331,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 397, 398, 269, 275,
+	416, 277, 248, 321, 271, 382, 283, 0, 409, 0,
+	410, 0, 0, 0, 0, 313, 280, 345, 284, 290,
+	334, 381, 319, 339, 246, 370, 346, 294, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	288, 0, 330, 268, 185, 186, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 0, 222, 223, 224, 225, 355, 0, 0, 388,
+	389, 390, 412, 372, 0, 426, 0, 318, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 234, 349, 365, 244, 340, 378, 249,
-	347, 239, 314, 337, 0, 0, 236, 363, 346, 296,
-	279, 280, 235, 0, 332, 259, 272, 256, 312, 0,
-	362, 390, 255, 381, 0, 373, 238, 0, 372, 311,
-	359, 364, 297, 291, 237, 361, 295, 290, 283, 263,
-	406, 276, 323, 289, 324, 277, 301, 300, 302, 0,
-	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 0, 0, 287, 0, 0,
+	0, 228, 0, 0, 0, 347, 301, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 375, 0, 0, 0, 0, 0, 0, 348,
-	0, 0, 284, 0, 0, 0, 391, 0, 335, 317,
-	0, 0, 0, 333, 287, 360, 325, 366, 350, 374,
-	1456, 326, 229, 351, 258, 298, 240, 242, 254, 260,
-	262, 264, 265, 307, 308, 320, 339, 353, 354, 355,
-	257, 250, 334, 251, 274, 252, 230, 341, 253, 232,
-	321, 358, 0, 270, 330, 294, 233, 293, 322, 357,
-	356, 241, 382, 388, 389, 394, 0, 395, 0, 0,
-	0, 403, 408, 409, 410, 412, 413, 414, 415, 0,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 0,
-	387, 268, 226, 227, 422, 0, 313, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 309, 386, 0, 0,
-	0, 0, 421, 0, 0, 0, 0, 0, 420, 319,
-	0, 338, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 345, 368, 380, 398, 401, 0,
-	0, 0, 231, 400, 0, 0, 0, 0, 0, 0,
-	0, 371, 0, 0, 0, 379, 0, 0, 0, 0,
-	0, 396, 303, 304, 305, 306, 271, 0, 248, 399,
-	328, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 392, 393, 267,
-	273, 411, 275, 247, 318, 269, 377, 281, 0, 404,
-	0, 405, 0, 0, 0, 0, 310, 278, 342, 282,
-	288, 331, 376, 316, 336, 245, 367, 343, 292, 0,
+	0, 0, 0, 0, 0, 0, 178, 0, 0, 2109,
+	0, 0, 0, 244, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 228,
-	0, 286, 0, 327, 266, 185, 186, 187, 188, 189,
-	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
-	219, 220, 0, 222, 223, 224, 225, 352, 0, 0,
-	383, 384, 385, 407, 369, 0, 419, 0, 315, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 261, 0, 0, 285, 0,
-	0, 0, 0, 0, 0, 344, 299, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
-	0, 0, 0, 243, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 234, 349, 365, 244,
-	340, 378, 249, 347, 239, 314, 337, 0, 0, 236,
-	363, 346, 296, 279, 280, 235, 0, 332, 259, 272,
-	256, 312, 0, 362, 390, 255, 381, 0, 373, 238,
-	0, 372, 311, 359, 364, 297, 291, 237, 361, 295,
-	290, 283, 263, 406, 276, 323, 289, 324, 277, 301,
-	300, 302, 0, 0, 0, 0, 0, 402, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 375, 0, 0, 1167, 0,
-	0, 0, 348, 0, 0, 284, 0, 0, 0, 391,
-	0, 335, 317, 0, 0, 0, 333, 287, 360, 325,
-	366, 350, 374, 329, 326, 229, 351, 258, 298, 240,
-	242, 254, 260, 262, 264, 265, 307, 308, 320, 339,
-	353, 354, 355, 257, 250, 334, 251, 274, 252, 230,
-	341, 253, 232, 321, 358, 0, 270, 330, 294, 233,
-	293, 322, 357, 356, 241, 382, 388, 389, 394, 0,
-	395, 0, 0, 0, 403, 408, 409, 410, 412, 413,
-	414, 415, 0, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 0, 387, 268, 226, 227, 422, 0, 313,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 309,
-	386, 0, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 420, 319, 0, 338, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 345, 368, 380,
-	398, 401, 0, 0, 0, 231, 400, 0, 0, 0,
-	0, 0, 0, 0, 371, 0, 0, 0, 379, 0,
-	0, 0, 0, 0, 396, 303, 304, 305, 306, 271,
-	0, 248, 399, 328, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	392, 393, 267, 273, 411, 275, 247, 318, 269, 377,
-	281, 0, 404, 0, 405, 0, 0, 0, 0, 310,
-	278, 342, 282, 288, 331, 376, 316, 336, 245, 367,
-	343, 292, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 235, 352, 368, 245,
+	343, 383, 250, 350, 240, 317, 340, 0, 0, 237,
+	366, 349, 298, 281, 282, 236, 0, 335, 260, 274,
+	257, 315, 0, 365, 395, 256, 386, 0, 376, 239,
+	0, 375, 314, 362, 367, 299, 293, 238, 364, 297,
+	292, 285, 264, 411, 278, 326, 291, 327, 279, 304,
+	303, 305, 0, 0, 0, 0, 0, 407, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 0, 0, 0, 351, 0, 0, 286, 0, 0,
+	0, 396, 0, 338, 320, 0, 0, 0, 336, 289,
+	363, 328, 369, 267, 377, 380, 353, 378, 332, 329,
+	230, 354, 259, 300, 241, 243, 255, 261, 263, 265,
+	266, 310, 311, 323, 342, 356, 357, 358, 258, 251,
+	337, 252, 276, 253, 231, 344, 254, 233, 324, 361,
+	0, 272, 333, 296, 234, 295, 325, 360, 359, 242,
+	387, 393, 394, 399, 0, 400, 0, 0, 0, 408,
+	413, 414, 415, 417, 418, 419, 422, 420, 421, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	392, 270, 226, 227, 429, 0, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 312, 391, 0, 0,
+	0, 0, 428, 0, 0, 0, 0, 0, 427, 322,
+	0, 341, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 371, 385, 403, 406, 0,
+	0, 0, 232, 405, 0, 0, 0, 0, 0, 0,
+	0, 374, 0, 0, 0, 384, 0, 0, 0, 0,
+	0, 401, 306, 307, 308, 309, 273, 0, 249, 404,
+	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 397, 398, 269,
+	275, 416, 277, 248, 321, 271, 382, 283, 0, 409,
+	0, 410, 0, 0, 0, 0, 313, 280, 345, 284,
+	290, 334, 381, 319, 339, 246, 370, 346, 294, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 228, 0, 286, 0, 327, 266, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
-	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
-	352, 0, 0, 383, 384, 385, 407, 369, 0, 419,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
-	0, 285, 0, 0, 0, 0, 0, 0, 344, 299,
+	0, 0, 221, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
-	0, 0, 0, 0, 0, 0, 243, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 234,
-	349, 365, 244, 340, 378, 249, 347, 239, 314, 337,
-	0, 0, 236, 363, 346, 296, 279, 280, 235, 0,
-	332, 259, 272, 256, 312, 0, 362, 390, 255, 381,
-	0, 373, 238, 0, 372, 311, 359, 364, 297, 291,
-	237, 361, 295, 290, 283, 263, 406, 276, 323, 289,
-	324, 277, 301, 300, 302, 0, 0, 0, 0, 0,
-	402, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 375, 0,
-	0, 0, 0, 0, 0, 348, 0, 0, 284, 0,
-	0, 0, 391, 0, 335, 317, 0, 0, 0, 333,
-	287, 360, 325, 366, 350, 374, 329, 326, 229, 351,
-	258, 298, 240, 242, 254, 260, 262, 264, 265, 307,
-	308, 320, 339, 353, 354, 355, 257, 250, 334, 251,
-	274, 252, 230, 341, 253, 232, 321, 358, 0, 270,
-	330, 294, 233, 293, 322, 357, 356, 241, 382, 388,
-	389, 394, 0, 395, 0, 0, 0, 403, 408, 409,
-	410, 412, 413, 414, 415, 0, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 0, 387, 268, 226, 227,
-	422, 0, 313, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 309, 386, 0, 0, 0, 0, 421, 0,
-	0, 0, 0, 0, 420, 319, 0, 338, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	345, 368, 380, 398, 401, 0, 0, 0, 231, 400,
-	0, 0, 0, 0, 0, 0, 0, 371, 0, 0,
-	0, 379, 0, 0, 0, 0, 0, 396, 303, 304,
-	305, 306, 271, 0, 248, 399, 328, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 392, 393, 267, 273, 411, 275, 247,
-	318, 269, 377, 281, 0, 404, 0, 405, 0, 0,
-	0, 0, 310, 278, 342, 282, 288, 331, 376, 316,
-	336, 245, 367, 343, 292, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 229,
+	0, 288, 0, 330, 268, 185, 186, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 0, 222, 223, 224, 225, 355, 0, 0,
+	388, 389, 390, 412, 372, 0, 426, 0, 318, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 262, 0, 0, 287, 0,
+	0, 0, 228, 0, 0, 0, 347, 301, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 689, 0, 0, 0, 228, 0, 286, 0, 327,
-	266, 185, 186, 187, 188, 189, 190, 191, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
-	223, 224, 225, 352, 0, 0, 383, 384, 385, 407,
-	369, 0, 419, 0, 315, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 178, 0, 0,
+	0, 0, 0, 0, 244, 179, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 247, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1653, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 235, 352, 368,
+	245, 343, 383, 250, 350, 240, 317, 340, 0, 0,
+	237, 366, 349, 298, 281, 282, 236, 0, 335, 260,
+	274, 257, 315, 0, 365, 395, 256, 386, 0, 376,
+	239, 0, 375, 314, 362, 367, 299, 293, 238, 364,
+	297, 292, 285, 264, 411, 278, 326, 291, 327, 279,
+	304, 303, 305, 0, 0, 0, 0, 0, 407, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 302, 0, 0, 0, 0, 0, 379, 0,
+	0, 0, 0, 0, 0, 351, 0, 0, 286, 0,
+	0, 0, 396, 0, 338, 320, 0, 0, 0, 336,
+	289, 363, 328, 369, 267, 377, 380, 353, 378, 332,
+	329, 230, 354, 259, 300, 241, 243, 255, 261, 263,
+	265, 266, 310, 311, 323, 342, 356, 357, 358, 258,
+	251, 337, 252, 276, 253, 231, 344, 254, 233, 324,
+	361, 0, 272, 333, 296, 234, 295, 325, 360, 359,
+	242, 387, 393, 394, 399, 0, 400, 0, 0, 0,
+	408, 413, 414, 415, 417, 418, 419, 422, 420, 421,
+	0, 0, 0, 0, 402, 0, 0, 0, 0, 0,
+	0, 392, 270, 226, 227, 429, 0, 316, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 312, 391, 0,
+	0, 0, 0, 428, 0, 0, 0, 0, 0, 427,
+	322, 0, 341, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 348, 371, 385, 403, 406,
+	0, 0, 0, 232, 405, 0, 0, 0, 0, 0,
+	0, 0, 374, 0, 0, 0, 384, 0, 0, 0,
+	0, 0, 401, 306, 307, 308, 309, 273, 0, 249,
+	404, 331, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 397, 398,
+	269, 275, 416, 277, 248, 321, 271, 382, 283, 0,
+	409, 0, 410, 0, 0, 0, 0, 313, 280, 345,
+	284, 290, 334, 381, 319, 339, 246, 370, 346, 294,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 261, 0, 0, 285, 0, 0, 0, 0, 0,
-	0, 344, 299, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 178, 0, 0, 0, 0, 0, 0, 243,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	229, 0, 288, 0, 330, 268, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 0, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 0, 222, 223, 224, 225, 355, 0,
+	0, 388, 389, 390, 412, 372, 0, 426, 0, 318,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 0, 287,
+	0, 0, 0, 228, 0, 0, 0, 347, 301, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
+	0, 0, 0, 0, 0, 244, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1958, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 235, 352,
+	368, 245, 343, 383, 250, 350, 240, 317, 340, 0,
+	0, 237, 366, 349, 298, 281, 282, 236, 0, 335,
+	260, 274, 257, 315, 0, 365, 395, 256, 386, 0,
+	376, 239, 0, 375, 314, 362, 367, 299, 293, 238,
+	364, 297, 292, 285, 264, 411, 278, 326, 291, 327,
+	279, 304, 303, 305, 0, 0, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 351, 0, 0, 286,
+	0, 0, 0, 396, 0, 338, 320, 0, 0, 0,
+	336, 289, 363, 328, 369, 267, 377, 380, 353, 378,
+	332, 329, 230, 354, 259, 300, 241, 243, 255, 261,
+	263, 265, 266, 310, 311, 323, 342, 356, 357, 358,
+	258, 251, 337, 252, 276, 253, 231, 344, 254, 233,
+	324, 361, 0, 272, 333, 296, 234, 295, 325, 360,
+	359, 242, 387, 393, 394, 399, 0, 400, 0, 0,
+	0, 408, 413, 414, 415, 417, 418, 419, 422, 420,
+	421, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 392, 270, 226, 227, 429, 0, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 312, 391,
+	0, 0, 0, 0, 428, 0, 0, 0, 0, 0,
+	427, 322, 0, 341, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 348, 371, 385, 403,
+	406, 0, 0, 0, 232, 405, 0, 0, 0, 0,
+	0, 0, 0, 374, 0, 0, 0, 384, 0, 0,
+	0, 0, 0, 401, 306, 307, 308, 309, 273, 0,
+	249, 404, 331, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 397,
+	398, 269, 275, 416, 277, 248, 321, 271, 382, 283,
+	0, 409, 0, 410, 0, 0, 0, 0, 313, 280,
+	345, 284, 290, 334, 381, 319, 339, 246, 370, 346,
+	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 349, 365, 244, 340, 378, 249, 347,
-	239, 314, 337, 0, 0, 236, 363, 346, 296, 279,
-	280, 235, 0, 332, 259, 272, 256, 312, 0, 362,
-	390, 255, 381, 0, 373, 238, 0, 372, 311, 359,
-	364, 297, 291, 237, 361, 295, 290, 283, 263, 406,
-	276, 323, 289, 324, 277, 301, 300, 302, 0, 0,
-	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	0, 229, 0, 288, 0, 330, 268, 185, 186, 187,
+	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
+	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 0, 222, 223, 224, 225, 355,
+	0, 0, 388, 389, 390, 412, 372, 0, 426, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 0,
+	287, 0, 0, 0, 228, 0, 0, 0, 347, 301,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 375, 0, 0, 0, 0, 0, 0, 348, 0,
-	0, 284, 0, 0, 0, 391, 0, 335, 317, 0,
-	0, 0, 333, 287, 360, 325, 366, 350, 374, 458,
-	326, 229, 351, 258, 298, 240, 242, 254, 260, 262,
-	264, 265, 307, 308, 320, 339, 353, 354, 355, 257,
-	250, 334, 251, 274, 252, 230, 341, 253, 232, 321,
-	358, 0, 270, 330, 294, 233, 293, 322, 357, 356,
-	241, 382, 388, 389, 394, 0, 395, 0, 0, 0,
-	403, 408, 409, 410, 412, 413, 414, 415, 0, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 0, 387,
-	268, 226, 227, 422, 0, 313, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 309, 386, 0, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 420, 319, 0,
-	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 345, 368, 380, 398, 401, 0, 0,
-	0, 231, 400, 0, 0, 0, 0, 0, 0, 459,
-	371, 0, 0, 0, 379, 0, 0, 0, 0, 0,
-	396, 303, 304, 305, 306, 271, 0, 248, 399, 328,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 392, 393, 267, 273,
-	411, 275, 247, 318, 269, 377, 281, 0, 404, 0,
-	405, 0, 0, 0, 0, 310, 278, 342, 282, 288,
-	331, 376, 316, 336, 245, 367, 343, 292, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	0, 0, 1956, 0, 0, 0, 244, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 235,
+	352, 368, 245, 343, 383, 250, 350, 240, 317, 340,
+	0, 0, 237, 366, 349, 298, 281, 282, 236, 0,
+	335, 260, 274, 257, 315, 0, 365, 395, 256, 386,
+	0, 376, 239, 0, 375, 314, 362, 367, 299, 293,
+	238, 364, 297, 292, 285, 264, 411, 278, 326, 291,
+	327, 279, 304, 303, 305, 0, 0, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 302, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 351, 0, 0,
+	286, 0, 0, 0, 396, 0, 338, 320, 0, 0,
+	0, 336, 289, 363, 328, 369, 267, 377, 380, 353,
+	378, 332, 329, 230, 354, 259, 300, 241, 243, 255,
+	261, 263, 265, 266, 310, 311, 323, 342, 356, 357,
+	358, 258, 251, 337, 252, 276, 253, 231, 344, 254,
+	233, 324, 361, 0, 272, 333, 296, 234, 295, 325,
+	360, 359, 242, 387, 393, 394, 399, 0, 400, 0,
+	0, 0, 408, 413, 414, 415, 417, 418, 419, 422,
+	420, 421, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 392, 270, 226, 227, 429, 0, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	391, 0, 0, 0, 0, 428, 0, 0, 0, 0,
+	0, 427, 322, 0, 341, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 371, 385,
+	403, 406, 0, 0, 0, 232, 405, 0, 0, 0,
+	0, 0, 0, 0, 374, 0, 0, 0, 384, 0,
+	0, 0, 0, 0, 401, 306, 307, 308, 309, 273,
+	0, 249, 404, 331, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	397, 398, 269, 275, 416, 277, 248, 321, 271, 382,
+	283, 0, 409, 0, 410, 0, 0, 0, 0, 313,
+	280, 345, 284, 290, 334, 381, 319, 339, 246, 370,
+	346, 294, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 228, 0,
-	286, 0, 327, 266, 185, 186, 187, 188, 189, 190,
-	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
-	220, 0, 222, 223, 224, 225, 352, 0, 0, 383,
-	384, 385, 407, 369, 0, 419, 0, 315, 0, 0,
+	0, 0, 229, 0, 288, 0, 330, 268, 185, 186,
+	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
+	0, 0, 0, 388, 389, 390, 412, 372, 355, 426,
+	0, 0, 1817, 0, 0, 0, 0, 0, 0, 318,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 261, 0, 0, 285, 0, 0,
-	0, 0, 0, 0, 344, 299, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 0, 0, 287,
+	0, 0, 0, 228, 0, 0, 0, 347, 301, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 178, 0, 0, 0, 0,
-	0, 0, 243, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 234, 349, 365, 244, 340,
-	378, 249, 347, 239, 314, 337, 0, 0, 236, 363,
-	346, 296, 279, 280, 235, 0, 332, 259, 272, 256,
-	312, 0, 362, 390, 255, 381, 0, 373, 238, 0,
-	372, 311, 359, 364, 297, 291, 237, 361, 295, 290,
-	283, 263, 406, 276, 323, 289, 324, 277, 301, 300,
-	302, 0, 0, 0, 0, 0, 402, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 437, 0, 0, 375, 0, 0, 0, 0, 0,
-	0, 348, 0, 0, 284, 0, 0, 0, 391, 0,
-	335, 317, 0, 0, 0, 333, 287, 360, 325, 366,
-	350, 374, 329, 326, 229, 351, 258, 298, 240, 242,
-	254, 260, 262, 264, 265, 307, 308, 320, 339, 353,
-	354, 355, 257, 250, 334, 251, 274, 252, 230, 341,
-	253, 232, 321, 358, 0, 270, 330, 294, 233, 293,
-	322, 357, 356, 241, 382, 388, 389, 394, 0, 395,
-	0, 0, 0, 403, 408, 409, 410, 412, 413, 414,
-	415, 0, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 0, 387, 268, 226, 227, 422, 0, 313, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 309, 386,
-	0, 0, 0, 0, 421, 0, 0, 0, 0, 0,
-	420, 319, 0, 338, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 345, 368, 380, 398,
-	401, 0, 0, 0, 231, 400, 0, 0, 0, 0,
-	0, 0, 0, 371, 0, 0, 0, 379, 0, 0,
-	0, 0, 0, 396, 303, 304, 305, 306, 271, 0,
-	248, 399, 328, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 392,
-	393, 267, 273, 411, 275, 247, 318, 269, 377, 281,
-	0, 404, 0, 405, 0, 0, 0, 0, 310, 278,
-	342, 282, 288, 331, 376, 316, 336, 245, 367, 343,
-	292, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
+	0, 0, 0, 0, 0, 244, 179, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 247, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 235, 352,
+	368, 245, 343, 383, 250, 350, 240, 317, 340, 0,
+	0, 237, 366, 349, 298, 281, 282, 236, 0, 335,
+	260, 274, 257, 315, 0, 365, 395, 256, 386, 0,
+	376, 239, 0, 375, 314, 362, 367, 299, 293, 238,
+	364, 297, 292, 285, 264, 411, 278, 326, 291, 327,
+	279, 304, 303, 305, 0, 0, 0, 0, 0, 407,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 302, 0, 0, 0, 0, 0, 379,
+	0, 0, 0, 0, 0, 0, 351, 0, 0, 286,
+	0, 0, 0, 396, 0, 338, 320, 0, 0, 0,
+	336, 289, 363, 328, 369, 267, 377, 380, 353, 378,
+	332, 329, 230, 354, 259, 300, 241, 243, 255, 261,
+	263, 265, 266, 310, 311, 323, 342, 356, 357, 358,
+	258, 251, 337, 252, 276, 253, 231, 344, 254, 233,
+	324, 361, 0, 272, 333, 296, 234, 295, 325, 360,
+	359, 242, 387, 393, 394, 399, 0, 400, 0, 0,
+	0, 408, 413, 414, 415, 417, 418, 419, 422, 420,
+	421, 0, 0, 0, 0, 402, 0, 0, 0, 0,
+	0, 0, 392, 270, 226, 227, 429, 0, 316, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 312, 391,
+	0, 0, 0, 0, 428, 0, 0, 0, 0, 0,
+	427, 322, 0, 341, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 348, 371, 385, 403,
+	406, 0, 0, 0, 232, 405, 0, 0, 0, 0,
+	0, 0, 0, 374, 0, 0, 0, 384, 0, 0,
+	0, 0, 0, 401, 306, 307, 308, 309, 273, 0,
+	249, 404, 331, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 397,
+	398, 269, 275, 416, 277, 248, 321, 271, 382, 283,
+	0, 409, 0, 410, 0, 0, 0, 0, 313, 280,
+	345, 284, 290, 334, 381, 319, 339, 246, 370, 346,
+	294, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 221, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 228, 0, 286, 0, 327, 266, 185, 186, 187,
+	0, 229, 0, 288, 0, 330, 268, 185, 186, 187,
 	188, 189, 190, 191, 192, 193, 194, 195, 196, 197,
 	198, 199, 200, 201, 202, 203, 204, 205, 206, 0,
 	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 218, 219, 220, 0, 222, 223, 224, 225, 352,
-	0, 0, 383, 384, 385, 407, 369, 0, 419, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 427, 261, 0, 0,
-	285, 0, 0, 0, 0, 0, 0, 344, 299, 0,
+	217, 218, 219, 220, 0, 222, 223, 224, 225, 355,
+	0, 0, 388, 389, 390, 412, 372, 0, 426, 0,
+	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 0, 0,
+	287, 0, 0, 0, 228, 0, 0, 0, 347, 301,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 178,
+	0, 0, 1151, 0, 0, 0, 244, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 235,
+	352, 368, 245, 343, 383, 250, 350, 240, 317, 340,
+	0, 0, 237, 366, 349, 298, 281, 282, 236, 0,
+	335, 260, 274, 257, 315, 0, 365, 395, 256, 386,
+	0, 376, 239, 0, 375, 314, 362, 367, 299, 293,
+	238, 364, 297, 292, 285, 264, 411, 278, 326, 291,
+	327, 279, 304, 303, 305, 0, 0, 0, 0, 0,
+	407, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 302, 0, 0, 0, 0, 0,
+	379, 0, 0, 0, 0, 0, 0, 351, 0, 0,
+	286, 0, 0, 0, 396, 0, 338, 320, 0, 0,
+	0, 336, 289, 363, 328, 369, 267, 377, 380, 353,
+	378, 1463, 329, 230, 354, 259, 300, 241, 243, 255,
+	261, 263, 265, 266, 310, 311, 323, 342, 356, 357,
+	358, 258, 251, 337, 252, 276, 253, 231, 344, 254,
+	233, 324, 361, 0, 272, 333, 296, 234, 295, 325,
+	360, 359, 242, 387, 393, 394, 399, 0, 400, 0,
+	0, 0, 408, 413, 414, 415, 417, 418, 419, 422,
+	420, 421, 0, 0, 0, 0, 402, 0, 0, 0,
+	0, 0, 0, 392, 270, 226, 227, 429, 0, 316,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 312,
+	391, 0, 0, 0, 0, 428, 0, 0, 0, 0,
+	0, 427, 322, 0, 341, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 371, 385,
+	403, 406, 0, 0, 0, 232, 405, 0, 0, 0,
+	0, 0, 0, 0, 374, 0, 0, 0, 384, 0,
+	0, 0, 0, 0, 401, 306, 307, 308, 309, 273,
+	0, 249, 404, 331, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	397, 398, 269, 275, 416, 277, 248, 321, 271, 382,
+	283, 0, 409, 0, 410, 0, 0, 0, 0, 313,
+	280, 345, 284, 290, 334, 381, 319, 339, 246, 370,
+	346, 294, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 229, 0, 288, 0, 330, 268, 185, 186,
+	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	0, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 0, 222, 223, 224, 225,
+	355, 0, 0, 388, 389, 390, 412, 372, 0, 426,
+	0, 318, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 0,
+	0, 287, 0, 0, 0, 228, 0, 0, 0, 347,
+	301, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	178, 0, 0, 0, 0, 0, 0, 244, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 247, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	235, 352, 368, 245, 343, 383, 250, 350, 240, 317,
+	340, 0, 0, 237, 366, 349, 298, 281, 282, 236,
+	0, 335, 260, 274, 257, 315, 0, 365, 395, 256,
+	386, 0, 376, 239, 0, 375, 314, 362, 367, 299,
+	293, 238, 364, 297, 292, 285, 264, 411, 278, 326,
+	291, 327, 279, 304, 303, 305, 0, 0, 0, 0,
+	0, 407, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 302, 0, 0, 0, 0,
+	0, 379, 0, 0, 1174, 0, 0, 0, 351, 0,
+	0, 286, 0, 0, 0, 396, 0, 338, 320, 0,
+	0, 0, 336, 289, 363, 328, 369, 267, 377, 380,
+	353, 378, 332, 329, 230, 354, 259, 300, 241, 243,
+	255, 261, 263, 265, 266, 310, 311, 323, 342, 356,
+	357, 358, 258, 251, 337, 252, 276, 253, 231, 344,
+	254, 233, 324, 361, 0, 272, 333, 296, 234, 295,
+	325, 360, 359, 242, 387, 393, 394, 399, 0, 400,
+	0, 0, 0, 408, 413, 414, 415, 417, 418, 419,
+	422, 420, 421, 0, 0, 0, 0, 402, 0, 0,
+	0, 0, 0, 0, 392, 270, 226, 227, 429, 0,
+	316, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	312, 391, 0, 0, 0, 0, 428, 0, 0, 0,
+	0, 0, 427, 322, 0, 341, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 348, 371,
+	385, 403, 406, 0, 0, 0, 232, 405, 0, 0,
+	0, 0, 0, 0, 0, 374, 0, 0, 0, 384,
+	0, 0, 0, 0, 0, 401, 306, 307, 308, 309,
+	273, 0, 249, 404, 331, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 397, 398, 269, 275, 416, 277, 248, 321, 271,
+	382, 283, 0, 409, 0, 410, 0, 0, 0, 0,
+	313, 280, 345, 284, 290, 334, 381, 319, 339, 246,
+	370, 346, 294, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 221, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 178, 0,
-	0, 0, 0, 0, 0, 243, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 234, 349,
-	365, 244, 340, 378, 249, 347, 239, 314, 337, 0,
-	0, 236, 363, 346, 296, 279, 280, 235, 0, 332,
-	259, 272, 256, 312, 0, 362, 390, 255, 381, 0,
-	373, 238, 0, 372, 311, 359, 364, 297, 291, 237,
-	361, 295, 290, 283, 263, 406, 276, 323, 289, 324,
-	277, 301, 300, 302, 0, 0, 0, 0, 0, 402,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 375, 0, 0,
-	0, 0, 0, 0, 348, 0, 0, 284, 0, 0,
-	0, 391, 0, 335, 317, 0, 0, 0, 333, 287,
-	360, 325, 366, 350, 374, 329, 326, 229, 351, 258,
-	298, 240, 242, 254, 260, 262, 264, 265, 307, 308,
-	320, 339, 353, 354, 355, 257, 250, 334, 251, 274,
-	252, 230, 341, 253, 232, 321, 358, 0, 270, 330,
-	294, 233, 293, 322, 357, 356, 241, 382, 388, 389,
-	394, 0, 395, 0, 0, 0, 403, 408, 409, 410,
-	412, 413, 414, 415, 0, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 0, 387, 268, 226, 227, 422,
-	0, 313, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 309, 386, 0, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 420, 319, 0, 338, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 345,
-	368, 380, 398, 401, 0, 0, 0, 231, 400, 0,
-	0, 0, 0, 0, 0, 0, 371, 0, 0, 0,
-	379, 0, 0, 0, 0, 0, 396, 303, 304, 305,
-	306, 271, 0, 248, 399, 328, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 392, 393, 267, 273, 411, 275, 247, 318,
-	269, 377, 281, 0, 404, 0, 405, 0, 0, 0,
-	0, 310, 278, 342, 282, 288, 331, 376, 316, 336,
-	245, 367, 343, 292, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 229, 0, 288, 0, 330, 268, 185,
+	186, 187, 188, 189, 190, 191, 192, 193, 194, 195,
+	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
+	206, 0, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 0, 222, 223, 224,
+	225, 355, 0, 0, 388, 389, 390, 412, 372, 0,
+	426, 0, 318, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	0, 0, 287, 0, 0, 0, 228, 0, 0, 0,
+	347, 301, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 178, 0, 0, 0, 0, 0, 0, 244, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 247,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 235, 352, 368, 245, 343, 383, 250, 350, 240,
+	317, 340, 0, 0, 237, 366, 349, 298, 281, 282,
+	236, 0, 335, 260, 274, 257, 315, 0, 365, 395,
+	256, 386, 0, 376, 239, 0, 375, 314, 362, 367,
+	299, 293, 238, 364, 297, 292, 285, 264, 411, 278,
+	326, 291, 327, 279, 304, 303, 305, 0, 0, 0,
+	0, 0, 407, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 302, 0, 0, 0,
+	0, 0, 379, 0, 0, 0, 0, 0, 0, 351,
+	0, 0, 286, 0, 0, 0, 396, 0, 338, 320,
+	0, 0, 0, 336, 289, 363, 328, 369, 267, 377,
+	380, 353, 378, 332, 329, 230, 354, 259, 300, 241,
+	243, 255, 261, 263, 265, 266, 310, 311, 323, 342,
+	356, 357, 358, 258, 251, 337, 252, 276, 253, 231,
+	344, 254, 233, 324, 361, 0, 272, 333, 296, 234,
+	295, 325, 360, 359, 242, 387, 393, 394, 399, 0,
+	400, 0, 0, 0, 408, 413, 414, 415, 417, 418,
+	419, 422, 420, 421, 0, 0, 0, 0, 402, 0,
+	0, 0, 0, 0, 0, 392, 270, 226, 227, 429,
+	0, 316, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 312, 391, 0, 0, 0, 0, 428, 0, 0,
+	0, 0, 0, 427, 322, 0, 341, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 348,
+	371, 385, 403, 406, 0, 0, 0, 232, 405, 0,
+	0, 0, 0, 0, 0, 0, 374, 0, 0, 0,
+	384, 0, 0, 0, 0, 0, 401, 306, 307, 308,
+	309, 273, 0, 249, 404, 331, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 397, 398, 269, 275, 416, 277, 248, 321,
+	271, 382, 283, 0, 409, 0, 410, 0, 0, 0,
+	0, 313, 280, 345, 284, 290, 334, 381, 319, 339,
+	246, 370, 346, 294, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 228, 0, 286, 0, 327, 266,
+	696, 0, 0, 0, 229, 0, 288, 0, 330, 268,
 	185, 186, 187, 188, 189, 190, 191, 192, 193, 194,
 	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
 	205, 206, 0, 207, 208, 209, 210, 211, 212, 213,
 	214, 215, 216, 217, 218, 219, 220, 0, 222, 223,
-	224, 225, 352, 0, 0, 383, 384, 385, 407, 369,
-	0, 419, 0, 315, 0, 0, 0, 0, 0, 0,
+	224, 225, 355, 0, 0, 388, 389, 390, 412, 372,
+	0, 426, 0, 318, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	261, 0, 0, 285, 0, 0, 0, 0, 0, 0,
-	344, 299, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 0, 0, 287, 0, 0, 0, 228, 0, 0,
+	0, 347, 301, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 178, 0, 0, 0, 0, 0, 0, 243, 179,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
+	0, 0, 178, 0, 0, 0, 0, 0, 0, 244,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	247, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 235, 352, 368, 245, 343, 383, 250, 350,
+	240, 317, 340, 0, 0, 237, 366, 349, 298, 281,
+	282, 236, 0, 335, 260, 274, 257, 315, 0, 365,
+	395, 256, 386, 0, 376, 239, 0, 375, 314, 362,
+	367, 299, 293, 238, 364, 297, 292, 285, 264, 411,
+	278, 326, 291, 327, 279, 304, 303, 305, 0, 0,
+	0, 0, 0, 407, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 302, 0, 0,
+	0, 0, 0, 379, 0, 0, 0, 0, 0, 0,
+	351, 0, 0, 286, 0, 0, 0, 396, 0, 338,
+	320, 0, 0, 0, 336, 289, 363, 328, 369, 267,
+	377, 380, 353, 378, 465, 329, 230, 354, 259, 300,
+	241, 243, 255, 261, 263, 265, 266, 310, 311, 323,
+	342, 356, 357, 358, 258, 251, 337, 252, 276, 253,
+	231, 344, 254, 233, 324, 361, 0, 272, 333, 296,
+	234, 295, 325, 360, 359, 242, 387, 393, 394, 399,
+	0, 400, 0, 0, 0, 408, 413, 414, 415, 417,
+	418, 419, 422, 420, 421, 0, 0, 0, 0, 402,
+	0, 0, 0, 0, 0, 0, 392, 270, 226, 227,
+	429, 0, 316, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 312, 391, 0, 0, 0, 0, 428, 0,
+	0, 0, 0, 0, 427, 322, 0, 341, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	348, 371, 385, 403, 406, 0, 0, 0, 232, 405,
+	0, 0, 0, 0, 0, 0, 466, 374, 0, 0,
+	0, 384, 0, 0, 0, 0, 0, 401, 306, 307,
+	308, 309, 273, 0, 249, 404, 331, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 397, 398, 269, 275, 416, 277, 248,
+	321, 271, 382, 283, 0, 409, 0, 410, 0, 0,
+	0, 0, 313, 280, 345, 284, 290, 334, 381, 319,
+	339, 246, 370, 346, 294, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 221, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 229, 0, 288, 0, 330,
+	268, 185, 186, 187, 188, 189, 190, 191, 192, 193,
+	194, 195, 196, 197, 198, 199, 200, 201, 202, 203,
+	204, 205, 206, 0, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 0, 222,
+	223, 224, 225, 355, 0, 0, 388, 389, 390, 412,
+	372, 0, 426, 0, 318, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 0, 0, 287, 0, 0, 0, 228, 0,
+	0, 0, 347, 301, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 178, 0, 0, 0, 0, 0, 0,
+	244, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 235, 352, 368, 245, 343, 383, 250,
+	350, 240, 317, 340, 0, 0, 237, 366, 349, 298,
+	281, 282, 236, 0, 335, 260, 274, 257, 315, 0,
+	365, 395, 256, 386, 0, 376, 239, 0, 375, 314,
+	362, 367, 299, 293, 238, 364, 297, 292, 285, 264,
+	411, 278, 326, 291, 327, 279, 304, 303, 305, 0,
+	0, 0, 0, 0, 407, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 302, 0,
+	0, 444, 0, 0, 379, 0, 0, 0, 0, 0,
+	0, 351, 0, 0, 286, 0, 0, 0, 396, 0,
+	338, 320, 0, 0, 0, 336, 289, 363, 328, 369,
+	267, 377, 380, 353, 378, 332, 329, 230, 354, 259,
+	300, 241, 243, 255, 261, 263, 265, 266, 310, 311,
+	323, 342, 356, 357, 358, 258, 251, 337, 252, 276,
+	253, 231, 344, 254, 233, 324, 361, 0, 272, 333,
+	296, 234, 295, 325, 360, 359, 242, 387, 393, 394,
+	399, 0, 400, 0, 0, 0, 408, 413, 414, 415,
+	417, 418, 419, 422, 420, 421, 0, 0, 0, 0,
+	402, 0, 0, 0, 0, 0, 0, 392, 270, 226,
+	227, 429, 0, 316, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 312, 391, 0, 0, 0, 0, 428,
+	0, 0, 0, 0, 0, 427, 322, 0, 341, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 348, 371, 385, 403, 406, 0, 0, 0, 232,
+	405, 0, 0, 0, 0, 0, 0, 0, 374, 0,
+	0, 0, 384, 0, 0, 0, 0, 0, 401, 306,
+	307, 308, 309, 273, 0, 249, 404, 331, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 397, 398, 269, 275, 416, 277,
+	248, 321, 271, 382, 283, 0, 409, 0, 410, 0,
+	0, 0, 0, 313, 280, 345, 284, 290, 334, 381,
+	319, 339, 246, 370, 346, 294, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 234, 349, 365, 244, 340, 378, 249, 347, 239,
-	314, 337, 0, 0, 236, 363, 346, 296, 279, 280,
-	235, 0, 332, 259, 272, 256, 312, 0, 362, 390,
-	255, 381, 0, 373, 238, 0, 372, 311, 359, 364,
-	297, 291, 237, 361, 295, 290, 283, 263, 406, 276,
-	323, 289, 324, 277, 301, 300, 302, 0, 0, 0,
-	0, 0, 402, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	375, 0, 0, 0, 0, 0, 0, 348, 0, 0,
-	284, 0, 0, 0, 391, 0, 335, 317, 0, 0,
-	0, 333, 287, 360, 325, 366, 350, 374, 329, 326,
-	229, 351, 258, 298, 240, 242, 254, 260, 262, 264,
-	265, 307, 308, 320, 339, 353, 354, 355, 257, 250,
-	334, 251, 274, 252, 230, 341, 253, 232, 321, 358,
-	0, 270, 330, 294, 233, 293, 322, 357, 356, 241,
-	382, 388, 389, 394, 0, 395, 0, 0, 0, 403,
-	408, 409, 410, 412, 413, 414, 415, 0, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 0, 387, 268,
-	226, 227, 422, 0, 313, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 309, 386, 0, 0, 0, 0,
-	421, 0, 0, 0, 0, 0, 420, 319, 0, 338,
+	0, 0, 0, 0, 0, 0, 229, 0, 288, 0,
+	330, 268, 185, 186, 187, 188, 189, 190, 191, 192,
+	193, 194, 195, 196, 197, 198, 199, 200, 201, 202,
+	203, 204, 205, 206, 0, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 0,
+	222, 223, 224, 225, 355, 0, 0, 388, 389, 390,
+	412, 372, 0, 426, 0, 318, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 345, 368, 380, 398, 401, 0, 0, 0,
-	231, 400, 0, 0, 0, 0, 0, 0, 0, 371,
-	0, 0, 0, 379, 0, 0, 0, 0, 0, 396,
-	303, 304, 305, 306, 271, 0, 248, 399, 328, 0,
+	0, 434, 262, 0, 0, 287, 0, 0, 0, 228,
+	0, 0, 0, 347, 301, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 392, 393, 267, 273, 411,
-	275, 247, 318, 269, 377, 281, 0, 404, 0, 405,
-	0, 0, 0, 0, 310, 278, 342, 282, 288, 331,
-	376, 316, 336, 245, 367, 343, 292, 0, 0, 0,
+	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
+	0, 244, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 247, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 235, 352, 368, 245, 343, 383,
+	250, 350, 240, 317, 340, 0, 0, 237, 366, 349,
+	298, 281, 282, 236, 0, 335, 260, 274, 257, 315,
+	0, 365, 395, 256, 386, 0, 376, 239, 0, 375,
+	314, 362, 367, 299, 293, 238, 364, 297, 292, 285,
+	264, 411, 278, 326, 291, 327, 279, 304, 303, 305,
+	0, 0, 0, 0, 0, 407, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 302,
+	0, 0, 0, 0, 0, 379, 0, 0, 0, 0,
+	0, 0, 351, 0, 0, 286, 0, 0, 0, 396,
+	0, 338, 320, 0, 0, 0, 336, 289, 363, 328,
+	369, 267, 377, 380, 353, 378, 332, 329, 230, 354,
+	259, 300, 241, 243, 255, 261, 263, 265, 266, 310,
+	311, 323, 342, 356, 357, 358, 258, 251, 337, 252,
+	276, 253, 231, 344, 254, 233, 324, 361, 0, 272,
+	333, 296, 234, 295, 325, 360, 359, 242, 387, 393,
+	394, 399, 0, 400, 0, 0, 0, 408, 413, 414,
+	415, 417, 418, 419, 422, 420, 421, 0, 0, 0,
+	0, 402, 0, 0, 0, 0, 0, 0, 392, 270,
+	226, 227, 429, 0, 316, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 312, 391, 0, 0, 0, 0,
+	428, 0, 0, 0, 0, 0, 427, 322, 0, 341,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 348, 371, 385, 403, 406, 0, 0, 0,
+	232, 405, 0, 0, 0, 0, 0, 0, 0, 374,
+	0, 0, 0, 384, 0, 0, 0, 0, 0, 401,
+	306, 307, 308, 309, 273, 0, 249, 404, 331, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 397, 398, 269, 275, 416,
+	277, 248, 321, 271, 382, 283, 0, 409, 0, 410,
+	0, 0, 0, 0, 313, 280, 345, 284, 290, 334,
+	381, 319, 339, 246, 370, 346, 294, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	221, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 228, 0, 286,
-	0, 327, 266, 185, 186, 187, 188, 189, 190, 191,
+	0, 0, 0, 0, 0, 0, 0, 229, 0, 288,
+	0, 330, 268, 185, 186, 187, 188, 189, 190, 191,
 	192, 193, 194, 195, 196, 197, 198, 199, 200, 201,
 	202, 203, 204, 205, 206, 0, 207, 208, 209, 210,
 	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
-	0, 222, 223, 224, 225, 352, 0, 0, 383, 384,
-	385, 407, 369, 0, 419, 0, 315, 0, 0, 0,
+	0, 222, 223, 224, 225, 355, 0, 0, 388, 389,
+	390, 412, 372, 0, 426, 0, 318, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 261, 0, 0, 285, 0, 0, 0,
-	0, 0, 0, 344, 299, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 0, 0, 287, 0, 0, 0,
+	228, 0, 0, 0, 347, 301, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 178, 0, 0, 0, 0, 0,
-	0, 243, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 234, 349, 365, 244, 340, 378,
-	249, 347, 239, 314, 337, 0, 0, 236, 363, 346,
-	296, 279, 280, 235, 0, 332, 259, 272, 256, 312,
-	0, 362, 390, 255, 381, 0, 373, 238, 0, 372,
-	311, 359, 364, 297, 291, 237, 361, 295, 290, 283,
-	263, 406, 276, 323, 289, 324, 277, 301, 300, 302,
-	0, 0, 0, 0, 0, 402, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 375, 0, 0, 0, 0, 0, 0,
-	348, 0, 0, 284, 0, 0, 0, 391, 0, 335,
-	317, 0, 0, 0, 333, 287, 360, 325, 366, 350,
-	374, 329, 326, 229, 351, 258, 298, 240, 242, 499,
-	260, 262, 264, 265, 307, 308, 320, 339, 353, 354,
-	355, 257, 250, 334, 251, 274, 252, 230, 341, 253,
-	232, 321, 358, 0, 270, 330, 294, 233, 293, 322,
-	357, 356, 241, 382, 388, 389, 394, 0, 395, 0,
-	0, 0, 403, 408, 409, 410, 412, 413, 414, 415,
-	0, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	0, 387, 268, 226, 227, 422, 0, 313, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 309, 386, 0,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 420,
-	319, 0, 338, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 345, 368, 380, 398, 401,
-	0, 0, 0, 231, 400, 0, 0, 0, 0, 0,
-	0, 0, 371, 0, 0, 0, 379, 0, 0, 0,
-	0, 0, 396, 303, 304, 305, 306, 271, 0, 248,
-	399, 328, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 392, 393,
-	267, 273, 411, 275, 247, 318, 269, 377, 281, 0,
-	404, 0, 405, 0, 0, 819, 0, 310, 278, 342,
-	282, 288, 331, 376, 316, 336, 245, 367, 343, 292,
+	0, 0, 0, 0, 0, 178, 0, 0, 0, 0,
+	0, 0, 244, 179, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 247, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 235, 352, 368, 245, 343,
+	383, 250, 350, 240, 317, 340, 0, 0, 237, 366,
+	349, 298, 281, 282, 236, 0, 335, 260, 274, 257,
+	315, 0, 365, 395, 256, 386, 0, 376, 239, 0,
+	375, 314, 362, 367, 299, 293, 238, 364, 297, 292,
+	285, 264, 411, 278, 326, 291, 327, 279, 304, 303,
+	305, 0, 0, 0, 0, 0, 407, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	302, 0, 0, 0, 0, 0, 379, 0, 0, 0,
+	0, 0, 0, 351, 0, 0, 286, 0, 0, 0,
+	396, 0, 338, 320, 0, 0, 0, 336, 289, 363,
+	328, 369, 267, 377, 380, 353, 378, 332, 329, 230,
+	354, 259, 300, 241, 243, 255, 261, 263, 265, 266,
+	310, 311, 323, 342, 356, 357, 358, 258, 251, 337,
+	252, 276, 253, 231, 344, 254, 233, 324, 361, 0,
+	272, 333, 296, 234, 295, 325, 360, 359, 242, 387,
+	393, 394, 399, 0, 400, 0, 0, 0, 408, 413,
+	414, 415, 417, 418, 419, 422, 420, 421, 0, 0,
+	0, 0, 402, 0, 0, 0, 0, 0, 0, 392,
+	270, 226, 227, 429, 0, 316, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 312, 391, 0, 0, 0,
+	0, 428, 0, 0, 0, 0, 0, 427, 322, 0,
+	341, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 348, 371, 385, 403, 406, 0, 0,
+	0, 232, 405, 0, 0, 0, 0, 0, 0, 0,
+	374, 0, 0, 0, 384, 0, 0, 0, 0, 0,
+	401, 306, 307, 308, 309, 273, 0, 249, 404, 331,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 397, 398, 269, 275,
+	416, 277, 248, 321, 271, 382, 283, 0, 409, 0,
+	410, 0, 0, 0, 0, 313, 280, 345, 284, 290,
+	334, 381, 319, 339, 246, 370, 346, 294, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 221, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 229, 0,
+	288, 0, 330, 268, 185, 186, 187, 188, 189, 190,
+	191, 192, 193, 194, 195, 196, 197, 198, 199, 200,
+	201, 202, 203, 204, 205, 206, 0, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 0, 222, 223, 224, 225, 355, 0, 0, 388,
+	389, 390, 412, 372, 0, 426, 0, 318, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	228, 0, 286, 0, 327, 266, 185, 186, 187, 188,
-	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 819, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	218, 219, 220, 0, 222, 223, 224, 225, 807, 0,
-	0, 383, 384, 385, 407, 369, 0, 419, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 827, 831,
-	833, 835, 837, 838, 840, 1514, 844, 841, 842, 843,
-	0, 0, 822, 823, 824, 825, 805, 806, 828, 0,
-	808, 0, 809, 810, 811, 812, 813, 814, 815, 816,
-	817, 818, 820, 826, 0, 0, 0, 0, 0, 1516,
-	0, 830, 832, 834, 836, 839, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1514, 807, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1496, 0, 821, 0,
-	0, 827, 831, 833, 835, 837, 838, 840, 0, 844,
-	841, 842, 843, 0, 1516, 822, 823, 824, 825, 805,
-	806, 828, 0, 808, 0, 809, 810, 811, 812, 813,
-	814, 815, 816, 817, 818, 820, 826, 0, 0, 0,
-	0, 0, 0, 0, 830, 832, 834, 836, 839, 0,
-	0, 1496, 0, 0, 0, 478, 2695, 477, 484, 474,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 481,
-	482, 0, 483, 487, 0, 0, 469, 0, 0, 0,
-	0, 821, 0, 0, 0, 0, 492, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 496, 0, 0, 498, 0,
-	0, 0, 0, 497, 0, 0, 2003, 2004, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1500, 478, 0,
-	477, 484, 474, 0, 0, 0, 0, 0, 1504, 0,
-	0, 0, 481, 482, 0, 483, 487, 0, 0, 469,
-	0, 0, 0, 0, 0, 0, 0, 0, 1493, 492,
-	0, 0, 1495, 1497, 1499, 0, 1501, 1502, 1503, 1505,
-	1506, 1507, 1509, 1510, 1511, 1512, 0, 0, 0, 0,
-	0, 0, 1500, 0, 0, 0, 0, 0, 496, 0,
-	0, 498, 0, 1504, 0, 0, 497, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1493, 1515, 0, 0, 1495, 1497, 1499,
-	0, 1501, 1502, 1503, 1505, 1506, 1507, 1509, 1510, 1511,
-	1512, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 829, 470, 472, 471, 0, 0, 0, 0,
-	0, 1513, 0, 476, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 480, 0, 0, 1492, 1515,
-	0, 0, 495, 0, 0, 0, 0, 0, 0, 473,
-	0, 0, 0, 464, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1508, 0, 0,
-	0, 0, 0, 0, 1498, 0, 1513, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1492, 0, 829, 470, 472, 471, 0,
-	0, 0, 0, 0, 0, 0, 476, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 480, 0,
-	0, 0, 1508, 0, 0, 495, 0, 0, 0, 1498,
-	0, 0, 473, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 475, 479,
-	485, 0, 486, 488, 0, 0, 489, 490, 491, 0,
-	0, 493, 494, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 475, 479, 485, 0, 486, 488, 0, 0, 489,
-	490, 491, 0, 0, 493, 494,
+	0, 0, 0, 0, 262, 0, 0, 287, 0, 0,
+	0, 228, 0, 0, 0, 347, 301, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 178, 0, 0, 0,
+	0, 0, 0, 244, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 235, 352, 368, 245,
+	343, 383, 250, 350, 240, 317, 340, 0, 0, 237,
+	366, 349, 298, 281, 282, 236, 0, 335, 260, 274,
+	257, 315, 0, 365, 395, 256, 386, 0, 376, 239,
+	0, 375, 314, 362, 367, 299, 293, 238, 364, 297,
+	292, 285, 264, 411, 278, 326, 291, 327, 279, 304,
+	303, 305, 0, 0, 0, 0, 0, 407, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 302, 0, 0, 0, 0, 0, 379, 0, 0,
+	0, 0, 0, 0, 351, 0, 0, 286, 0, 0,
+	0, 396, 0, 338, 320, 0, 0, 0, 336, 289,
+	363, 328, 369, 267, 377, 380, 353, 378, 332, 329,
+	230, 354, 259, 300, 241, 243, 506, 261, 263, 265,
+	266, 310, 311, 323, 342, 356, 357, 358, 258, 251,
+	337, 252, 276, 253, 231, 344, 254, 233, 324, 361,
+	0, 272, 333, 296, 234, 295, 325, 360, 359, 242,
+	387, 393, 394, 399, 0, 400, 0, 0, 0, 408,
+	413, 414, 415, 417, 418, 419, 422, 420, 421, 0,
+	0, 0, 0, 402, 0, 0, 0, 0, 0, 0,
+	392, 270, 226, 227, 429, 0, 316, 0, 0, 0,
+	0, 0, 0, 0, 0, 1528, 312, 391, 0, 0,
+	0, 0, 428, 0, 0, 0, 0, 0, 427, 322,
+	0, 341, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 371, 385, 403, 406, 1531,
+	0, 0, 232, 405, 0, 0, 0, 0, 0, 0,
+	0, 374, 0, 0, 0, 384, 0, 0, 0, 0,
+	0, 401, 306, 307, 308, 309, 273, 0, 249, 404,
+	331, 0, 0, 0, 0, 0, 0, 1510, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 397, 398, 269,
+	275, 416, 277, 248, 321, 271, 382, 283, 0, 409,
+	0, 410, 0, 0, 0, 0, 313, 280, 345, 284,
+	290, 334, 381, 319, 339, 246, 370, 346, 294, 0,
+	485, 0, 484, 491, 481, 0, 0, 0, 0, 0,
+	0, 0, 221, 0, 488, 489, 0, 490, 494, 0,
+	0, 476, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 499, 0, 0, 0, 0, 0, 0, 0, 229,
+	0, 288, 0, 330, 268, 185, 186, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 0, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 0, 222, 223, 224, 225, 0, 0, 0,
+	388, 389, 390, 412, 372, 485, 426, 484, 491, 481,
+	1514, 0, 0, 0, 0, 0, 0, 0, 0, 488,
+	489, 1518, 490, 494, 0, 0, 476, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 499, 0, 0, 0,
+	0, 0, 0, 0, 1507, 0, 0, 0, 1509, 1511,
+	1513, 0, 1515, 1516, 1517, 1519, 1520, 1521, 1523, 1524,
+	1525, 1526, 0, 0, 0, 0, 503, 0, 485, 505,
+	484, 491, 481, 0, 504, 0, 0, 0, 0, 0,
+	0, 0, 488, 489, 0, 490, 494, 0, 0, 476,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 499,
+	0, 1529, 1530, 0, 0, 0, 0, 0, 0, 477,
+	479, 478, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 483, 0, 0, 0, 0, 0, 0, 0, 503,
+	0, 0, 505, 487, 0, 0, 0, 504, 0, 1527,
+	502, 0, 0, 0, 0, 0, 0, 480, 0, 0,
+	0, 0, 0, 0, 0, 0, 1506, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1522, 0, 0, 0, 0,
+	0, 0, 1512, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 477, 479, 478, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 483, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 487, 0,
+	0, 0, 0, 0, 0, 502, 0, 0, 0, 0,
+	0, 0, 480, 0, 0, 0, 0, 0, 0, 471,
+	0, 482, 486, 492, 0, 493, 495, 0, 0, 496,
+	497, 498, 0, 0, 500, 501, 0, 477, 479, 478,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 487, 0, 0, 0, 0, 0, 0, 502, 0,
+	0, 0, 0, 0, 0, 480, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 482, 486, 492, 0,
+	493, 495, 0, 0, 496, 497, 498, 0, 0, 500,
+	501, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 482,
+	486, 492, 0, 493, 495, 0, 0, 496, 497, 498,
+	0, 0, 500, 501,
 }
 
 var yyPact = [...]int{
-	2906, -1000, -1000, -1000, -310, 10515, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	282, -1000, -1000, -1000, -312, 11155, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 32511, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 32511, -307, 31988,
-	31988, -1000, -1000, 1906, -1000, 31465, 11580, 32511, 217, 214,
-	32511, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 37204, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 37204, -309, 36673,
+	36673, -1000, -1000, 1922, -1000, 36142, 12767, 37204, 325, 319,
+	37204, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 587, -1000, 30942, -1000, -1000,
-	-1000, -1000, -1000, -1000, 464, 33715, 33034, 8412, -259, -1000,
-	2492, -118, 700, 705, 786, 786, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2563, 616, 30419, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 282, 195, 616, 13672,
-	-28, -38, 2492, 307, 232, -1000, 834, 2941, 145, -1000,
+	-1000, -1000, -1000, -1000, -1000, 630, -1000, 35611, -1000, -1000,
+	-1000, -1000, -1000, -1000, 553, 38275, 37735, 9020, -260, -1000,
+	2563, -119, 743, 747, 777, 777, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 3449, 662, 35080, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	8412, 8412, 10515, -315, 10515, 8412, 32511, 32511, -1000, -1000,
-	-1000, -1000, 464, 33715, 8412, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2740, 167, 662, 15953,
+	-12, -18, 2563, 295, 265, -1000, 830, 3014, 145, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	9020, 9020, 11155, -328, 11155, 9020, 37204, 37204, -1000, -1000,
+	-1000, -1000, 553, 38275, 9020, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4949,319 +5423,331 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-38, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -18, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 3403, -1000, 1168, -1000,
-	-1000, -1000, -1000, 2103, 1165, 1723, 417, 32511, -1000, 1161,
-	417, -1000, -1000, -1000, 2492, 2492, -1000, 32511, 32511, 21,
-	1314, -1000, 297, 291, 331, 1152, -1000, -1000, -1000, -1000,
-	-1000, 2518, -1000, 32511, 32511, 2108, 32511, -1000, 1443, 374,
-	33798, 2282, 849, 546, 2143, -1000, -1000, 2102, -1000, 136,
-	166, 116, 227, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	146, -1000, 2366, -1000, -1000, 120, -1000, -1000, 115, -1000,
-	-1000, -1000, -45, -1000, -1000, -1000, -1000, -1000, -1000, -114,
-	-1000, -1000, 719, 2016, 8412, -1000, 1454, -1000, 4209, -1000,
-	-1000, -1000, -1000, 5787, 9981, 9981, 9981, 9981, -1000, -1000,
-	1962, 8412, 2100, 2088, -1000, -1000, -1000, -1000, -1000, 1139,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1722, 9458, -1000, 2077, 2076, 2072, 2071, 2067,
-	2064, 2063, 2061, 2059, 2058, 2057, 2050, 2036, 2035, 1878,
-	11046, 2034, 1721, 1719, 2029, 2025, 2024, 1718, 1878, 1878,
-	2019, 2018, 2015, 2014, 2012, 2010, 2008, 2004, 2000, 1996,
-	1995, 1994, 1992, 1989, 1988, 1987, 1985, 1984, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 3362, -1000, 1164, -1000, -1000, -1000, -1000,
+	2254, 1161, 1817, 497, 37204, -1000, 1160, 497, -1000, -1000,
+	-1000, 2563, 2563, -1000, 37204, 37204, 39, 1325, -1000, 320,
+	312, 317, 1156, -1000, -1000, -1000, -1000, -1000, 2601, -1000,
+	37204, 37204, 2271, 37204, -1000, 1520, 452, 38338, 2449, 1057,
+	687, 2297, -1000, -1000, 2253, -1000, 154, 181, 162, 388,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 169, -1000, 2512,
+	-1000, -1000, 146, -1000, -1000, 170, -1000, -1000, -1000, -20,
+	-1000, -1000, -1000, -1000, -1000, -1000, -105, -1000, -1000, 773,
+	1510, 9020, -1000, 1361, -1000, 2999, -1000, -1000, -1000, -1000,
+	6355, 10613, 10613, 10613, 10613, -1000, -1000, 2079, 9020, 2252,
+	2251, -1000, -1000, -1000, -1000, -1000, 1152, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1812,
+	10082, -1000, 2236, 2235, 2230, 2223, 2216, 2214, 2212, 2210,
+	2205, 2204, 2201, 2196, 2193, 2191, 1945, 11694, 2190, 1811,
+	1810, 2176, 2171, 2170, 1808, 1945, 1945, 2169, 2168, 2166,
+	2165, 2164, 2158, 2157, 2153, 2152, 2151, 2147, 2132, 2127,
+	2126, 2115, 2114, 2112, 2107, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 942, -1000, 1982, 2273, 2374, 1905, 2442, 2388, 2386,
-	2372, 2334, 1612, -1000, -1000, -1000, -149, -1000, -1000, 701,
-	-1000, 677, -1000, 32511, 32511, 32511, 462, 462, 462, 462,
-	462, 564, 462, 586, 581, 575, 462, -1000, -1000, -1000,
-	-1000, -1000, -1000, 660, -1000, -1000, -1000, -1000, 1017, 32511,
-	-1000, 1914, 1194, 2382, 393, 386, 384, 1194, 285, -1000,
-	1295, 1295, 1295, 1295, 1194, 315, 389, 2374, 2374, -39,
-	1295, -63, 1194, 1194, -63, 1194, 1194, 1194, 130, -304,
-	-1000, -1000, -1000, 1295, 1295, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 2351, 2337, 464, 32511, 80, 32511, 464, 464,
-	472, 1443, 369, 359, 1029, 1309, -1000, 1226, 32511, 32511,
-	32511, 1226, 1226, 17336, 16813, -1000, 32511, -1000, 2374, 1905,
-	-1000, 1853, 2391, 1849, 1905, 464, 464, 464, 464, 464,
-	464, 464, 464, 32511, 32511, 29896, 464, 7356, 7356, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10515, 1458,
-	1637, 138, -85, -311, 179, -1000, -1000, 32511, 2246, 101,
-	-1000, -1000, -1000, 1880, -1000, 1913, 1913, 1913, 1913, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1946, 1980,
-	-1000, -1000, 1911, 1911, 1911, 1880, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1061, -1000,
+	2096, 2422, 2526, 1994, 2574, 2524, 2509, 2498, 2494, 1611,
+	-1000, -1000, -1000, -136, -1000, -1000, 732, -1000, 727, -1000,
+	37204, 37204, 37204, 542, 542, 542, 542, 542, 609, 542,
+	626, 620, 618, 542, -1000, -1000, -1000, -1000, -1000, -1000,
+	703, -1000, -1000, -1000, -1000, 1014, 37204, -1000, 2005, 1273,
+	2537, 466, 462, 460, 1273, 367, -1000, 1362, 1362, 1362,
+	1362, 1273, 315, 469, 2526, 2526, -50, 1362, -38, 1273,
+	1273, -38, 1273, 1273, 1273, 173, -305, -1000, -1000, -1000,
+	1362, 1362, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2491,
+	2489, 553, 37204, 106, 37204, 553, 553, 595, 1520, 448,
+	433, 1040, 1328, -1000, 1279, 37204, 37204, 37204, 1279, 1279,
+	20204, 19673, -1000, 37204, -1000, 2526, 1994, -1000, 1933, 2160,
+	1929, 1994, 553, 553, 553, 553, 553, 553, 553, 553,
+	37204, 37204, 34549, 553, 7948, 7948, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 11155, 1443, 1642, 143, -85,
+	-307, 179, -1000, -1000, 37204, 2393, 128, -1000, -1000, -1000,
+	1946, -1000, 2004, 2004, 2004, 2004, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2041, 2095, -1000, -1000, 2003,
+	2003, 2003, 1946, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2031, 2031,
+	2038, 2031, 37204, 9020, 37204, 2440, 306, 2089, -1000, 37204,
+	298, 2526, 2422, 2526, -1000, -1000, 1146, 1609, 1806, -1000,
+	-1000, 320, 1266, -1000, 790, -1000, -1000, -1000, -1000, 37204,
+	301, -1000, -1000, 1795, 2088, -1000, 429, 1046, 1010, -1000,
+	151, 38180, 29762, 1520, 29762, 37204, -1000, -1000, -1000, -1000,
+	-1000, -1000, -21, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1938, 1938, 1941, 1938, 32511, 8412, 32511, 2275, 288,
-	1977, -1000, 32511, 269, 2374, 2273, 2374, -1000, -1000, 1124,
-	1608, 1716, -1000, -1000, 297, 1254, -1000, 807, -1000, -1000,
-	-1000, -1000, 32511, 283, -1000, -1000, 1711, 1975, -1000, 398,
-	832, 1073, -1000, 128, 3455, 26227, 1443, 26227, 32511, -1000,
-	-1000, -1000, -1000, -1000, -1000, -47, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 138, -1000, 9020, 9020,
+	9020, 9020, 9020, -1000, 578, 9551, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 10613, 10613, 10613, 10613, 10613, 10613, 10613,
+	10613, 10613, 10613, 10613, 10613, 2077, 1401, 10613, 10613, 10613,
+	10613, 2160, 2459, 1029, 212, -1000, -1000, -1000, -1000, -1000,
+	1344, 1510, 9020, 9020, 37204, -1000, 3243, 9020, 9020, 2980,
+	9020, 2470, 9020, 9020, 9020, 1927, 4761, 37204, 9020, -1000,
+	1918, 1917, -1000, -1000, 1451, 9020, -1000, -1000, 9020, -1000,
+	-1000, 9020, 10613, 9020, -1000, -1000, -1000, 117, 2470, 2470,
+	9020, 9020, 2470, 2470, 2470, 1281, 2470, 2470, 2470, 2470,
+	2470, 2470, 2470, 2470, 1914, 2526, -260, 7417, -1000, -265,
+	2422, 9020, -1000, -1000, 9020, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1805, -80, 740, 733, 736, -1000, 2466,
+	-1000, 2087, 2084, 1141, 37204, 1384, 37204, 29762, 37204, 1520,
+	37204, 37204, 542, 542, 542, 37204, 595, -1000, 37204, 1014,
+	2465, 37204, 2582, 10613, 10613, 34018, 1362, 1273, 1273, -1000,
+	-1000, 37204, -1000, -1000, -1000, 1362, 37204, 1362, 1362, 2582,
+	1362, -1000, -1000, -1000, 1273, 1273, -1000, -1000, -1000, -1000,
+	1362, 1362, -1000, -1000, 2582, 37204, -26, 2582, 2582, -34,
+	-1000, -1000, -1000, 37204, 37204, 542, 37204, -1000, 37204, 37204,
+	-1000, -1000, 37204, 3656, 37204, 37204, 2479, -1000, 29762, 37204,
+	27107, -1000, -1000, 423, 538, 18611, 369, 29762, 5823, -1000,
+	-1000, 1279, 1279, 1279, 5823, 5823, 1090, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1007, -1000, 176, 2422, -1000, -1000,
+	-1000, -1000, -1000, 37204, 37204, 29762, 1520, 37204, 37204, 37204,
+	37204, -1000, 2081, -1000, 2448, 37204, 969, -1000, -1000, 15422,
+	1136, 969, -1000, 1347, -1000, 9020, 11155, -289, 9020, 11155,
+	11155, 9020, 11155, -1000, 9020, 109, -1000, -1000, -1000, -1000,
+	1583, -1000, 1582, -1000, -1000, -1000, 1800, 1800, -1000, 1578,
+	-1000, -1000, -1000, -1000, 1568, -1000, -1000, 1566, -1000, -1000,
+	1912, 773, -1000, 1789, 2295, -261, -1000, 17548, 37204, 37204,
+	-1000, -1000, -261, -1000, 17016, 37204, 2422, -1000, 2422, 37204,
+	-1000, 2536, -1000, 320, 226, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1126, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 994, -1000, 37204, -1000, -1000, 151, 29762, 31886, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 171, -1000, -1000, 161,
+	-1000, 605, 65, 1255, -1000, -1000, 116, 156, 656, 1510,
+	-1000, 1382, 1382, 1387, -1000, 465, -1000, -1000, -1000, -1000,
+	2079, -1000, -1000, -1000, 2481, 1243, -1000, 1286, 1286, 1135,
+	1135, 1135, 1135, 1135, 1333, 1333, -1000, -1000, -1000, 6355,
+	2077, 10613, 10613, 10613, 10613, 515, 515, 2346, 3659, -1000,
+	9020, 1343, -1000, 9020, 1705, 1222, 1115, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 118,
-	-1000, 8412, 8412, 8412, 8412, 8412, -1000, 454, 8935, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 9981, 9981, 9981, 9981,
-	9981, 9981, 9981, 9981, 9981, 9981, 9981, 9981, 1960, 1287,
-	9981, 9981, 9981, 9981, 2391, 2579, 1023, 200, -1000, -1000,
-	-1000, -1000, -1000, 1342, 2016, 8412, 8412, 32511, -1000, 2939,
-	8412, 8412, 2742, 8412, 2331, 8412, 8412, 8412, 1845, 4217,
-	32511, 8412, -1000, 1842, 1841, -1000, -1000, 1427, 8412, -1000,
-	-1000, 8412, -1000, -1000, 8412, 9981, 8412, -1000, -1000, -1000,
-	173, 2331, 2331, 8412, 8412, 2331, 2331, 2331, 1274, 2331,
-	2331, 2331, 2331, 2331, 2331, 2331, 2331, 1840, 2374, -259,
-	6833, -1000, -271, 2273, 8412, -1000, -1000, 8412, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1713, -101, 696, 680,
-	687, -1000, 2305, -1000, 1974, 1973, 1119, 32511, 1351, 32511,
-	26227, 32511, 1443, 32511, 32511, 462, 462, 462, 32511, 472,
-	-1000, 32511, 1017, 2303, 32511, 2452, 9981, 9981, 29373, 1295,
-	1194, 1194, -1000, -1000, 32511, -1000, -1000, -1000, 1295, 32511,
-	1295, 1295, 2452, 1295, -1000, -1000, -1000, 1194, 1194, -1000,
-	-1000, -1000, -1000, 1295, 1295, -1000, -1000, 2452, 32511, -52,
-	2452, 2452, -59, -1000, -1000, -1000, 32511, 32511, 462, 32511,
-	-1000, 32511, 32511, -1000, -1000, 32511, 3122, 32511, 32511, 2324,
-	-1000, 26227, 32511, 24135, -1000, -1000, 396, 511, 15767, 344,
-	26227, 5263, -1000, -1000, 1226, 1226, 1226, 5263, 5263, 1042,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1015, -1000, 132,
-	2273, -1000, -1000, -1000, -1000, -1000, 32511, 32511, 26227, 1443,
-	32511, 32511, 32511, 32511, -1000, 1966, -1000, 2281, 32511, 888,
-	-1000, -1000, 13149, 1110, 888, -1000, 1339, -1000, 8412, 10515,
-	-286, 8412, 10515, 10515, 8412, 10515, -1000, 8412, 74, -1000,
-	-1000, -1000, -1000, 1600, -1000, 1599, -1000, -1000, -1000, 1706,
-	1706, -1000, 1598, -1000, -1000, -1000, -1000, 1594, -1000, -1000,
-	1586, -1000, -1000, 1838, 719, -1000, 1704, 2141, -260, -1000,
-	14720, 32511, 32511, -1000, -1000, -260, -1000, 14196, 32511, 2273,
-	-1000, 2273, 32511, -1000, 2378, -1000, 297, 198, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1098, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 993, -1000, 32511, -1000, -1000, 128,
-	26227, 27273, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 147,
-	-1000, -1000, 161, -1000, 528, 43, 1245, -1000, -1000, 98,
-	156, 610, 2016, -1000, 1370, 1370, 1365, -1000, 490, -1000,
-	-1000, -1000, -1000, 1962, -1000, -1000, -1000, 1874, 1734, -1000,
-	1210, 1210, 1080, 1080, 1080, 1080, 1080, 1326, 1326, -1000,
-	-1000, -1000, 5787, 1960, 9981, 9981, 9981, 9981, 424, 424,
-	1230, 3197, -1000, 8412, 1335, -1000, 8412, 2166, 1100, 1084,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1911, 1910, 1756,
+	2600, 1909, 9020, -1000, -1000, 1250, 1240, 1217, -1000, 1727,
+	8489, -1000, -1000, -1000, 1907, 1114, 1904, -1000, -1000, -1000,
+	1903, 1212, 933, 1902, 2226, 1897, 983, 9020, 9020, 1208,
+	1198, 9020, 9020, 9020, 9020, 1895, 9020, 9020, 9020, 9020,
+	9020, 9020, 9020, 9020, -4, -1000, -1000, 1193, -1000, 1510,
+	-1000, 1787, -1000, 1067, 980, -1000, 1657, -1000, -1000, -1000,
+	-1000, 738, 720, 724, 37204, 786, 13829, 37204, 2005, 2446,
+	108, -1000, 867, -1000, 65, -111, 792, 2270, 2599, 37204,
+	37204, 37204, 2458, 33487, -1000, 2074, 1190, -1000, -1000, 9020,
+	-1000, -1000, 2265, 37204, 37204, 2582, 2582, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 37204, 2582, 2582, 1273, 1362, -1000,
+	-1000, 1362, -1000, -1000, 1362, -1000, 1109, -1000, 37204, -1000,
+	-1000, -1000, 2005, 964, -1000, -1000, 12225, 14891, 502, 500,
+	499, 527, 527, 526, 608, -1000, 1291, 1291, 888, 1291,
+	1291, 1291, 1291, 413, 408, 1291, 1291, 1291, 1291, 1291,
+	1291, 1291, 1291, 1291, 1291, 1291, 1291, 1291, 1291, 2067,
+	1291, -1000, 98, 2475, 208, 867, 222, 2627, 913, -1000,
+	-1000, -1000, -1000, 22328, 22328, 18080, 22328, -1000, 1270, -1000,
+	-1000, 604, -1000, -1000, 792, -1000, -1000, -1000, 2065, 1320,
+	-1000, -1000, 11694, -1000, 5823, 5823, 5823, -1000, -1000, 22859,
+	37204, -1000, -106, -1000, -98, -1000, 959, -1000, -1000, 962,
+	792, 2294, 959, 959, -1000, 13829, 37204, -1000, 2582, 7948,
+	-1000, 27107, -1000, -1000, 32948, -1000, 32417, 2582, 1338, -1000,
+	11155, 1612, 139, -1000, 175, -317, 136, 1472, 132, 1510,
+	-1000, -1000, 1889, 1884, 1168, -1000, 1165, 1882, 1162, 1158,
+	-1000, -56, -1000, 2397, 800, -1000, 2060, -1000, 1157, 2362,
+	-1000, 952, -1000, 1319, 1144, -1000, 800, 1134, 2344, 952,
+	-1000, -1000, 1108, 37, -1000, -1000, 37204, 1795, 1133, 31886,
+	912, -1000, 603, 1102, 1096, -1000, 29762, 149, 29762, -1000,
+	29762, -1000, -1000, 278, -1000, 37204, 2413, -1000, -1000, -1000,
+	1720, -335, -1000, -1000, -1000, -1000, -1000, 1128, -1000, 515,
+	515, 2346, 3278, -1000, 10613, -1000, 10613, 2451, 1336, -1000,
+	9020, 1559, 3104, 1954, 21797, 37204, -1000, -1000, 9020, 9020,
+	-1000, 2441, -1000, -1000, -1000, -1000, 9020, 9020, 1744, -1000,
+	37204, -1000, -1000, -1000, -1000, 21797, -1000, 10613, -1000, 9020,
+	1056, 2394, -4, -4, 2332, 2303, 2273, 1120, -4, 2233,
+	2194, 2182, 2173, 2161, 2104, 2092, 1930, -1000, 2047, 7417,
+	-1000, -56, 9020, 9020, 9020, 2380, -1000, -1000, -1000, -1000,
+	-1000, 708, 129, 1879, 947, -1000, -1000, 37204, -1000, -1000,
+	-1000, 1873, 943, -1000, -1000, -1000, 3456, 2004, 2004, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2041, -1000,
+	-1000, 2003, 2003, 2003, 1946, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2031, 2031, 2038, 2031, -1000, 2457,
+	-1000, 7, 1291, 56, 29762, 428, -1000, 37204, 2293, 276,
+	2388, 31355, 2028, 2026, 2022, 277, 3456, 37204, 929, -1000,
+	1085, 3014, -1000, 37204, 1510, -1000, 1520, -1000, 1273, -1000,
+	-1000, 2582, 1088, -1000, -1000, 2582, 1273, 1273, 1362, 37204,
+	-1000, 2456, 3727, 2021, -1000, 37204, 2406, -1000, -1000, 3456,
+	612, -1000, 761, 542, 37204, 1412, 761, 1411, 2017, -1000,
+	-1000, 37204, -1000, 37204, 37204, 37204, -1000, 1409, 1404, 30824,
+	37204, 37204, 37204, -1000, 37204, 37204, -1000, 37204, 1565, -1000,
+	1564, 1291, 1291, 1562, 1782, 1779, 1778, 1291, 1291, 1556,
+	1773, 30293, 1552, 1551, 1550, 1588, 1769, 640, 1543, 1538,
+	1534, 37204, 2016, 1680, 37204, 7, 1291, 202, 1316, 468,
+	1522, 19142, 37204, 27107, 27107, 27107, 27107, -1000, 2325, 2317,
+	-1000, 2349, 2315, 2392, 37204, 27107, 2005, -1000, 30293, -1000,
+	-1000, -1000, 2160, 1117, 2645, 748, 9020, 29762, 1768, 369,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 37204, 37204,
+	1871, -1000, 2566, -1000, 918, -1000, -1000, 1063, -1000, 2566,
+	1349, -313, 11155, 1303, 1298, -1000, 9020, 11155, 9020, -290,
+	183, -293, -1000, -1000, -1000, 1767, -1000, -1000, -1000, 1547,
+	-1000, 1544, 9, 22, 1403, -261, 7417, 294, 37204, -261,
+	37204, 7417, -1000, 37204, 292, -261, 37204, 1541, -1000, -1000,
+	-1000, 2598, 29762, 1520, 1205, 29231, -1000, 142, -1000, 159,
+	447, 28700, -1000, 617, 91, -1000, 1313, 1720, -1000, -1000,
+	-1000, 10613, -1000, -1000, -1000, -1000, 1510, 9020, 1870, -1000,
+	707, 707, 1865, -1000, 2004, 2004, -1000, 1946, 2003, 1946,
+	707, 707, 1859, -1000, 1695, 1926, -1000, 1862, 1851, 9020,
+	-1000, 1855, 3225, 948, -131, -4, -1000, -1000, -1000, -4,
+	-4, -4, -4, -1000, -4, -4, -4, -4, -4, -4,
+	-4, -4, 498, -1000, 9, 1510, 1510, -1000, -1000, 2375,
+	-1000, 1761, 1757, 786, 3456, 639, 13829, 2389, 275, 1622,
+	-1000, -1000, 28169, 468, -1000, 166, -1000, 37204, 220, 37204,
+	-1000, -1000, -1000, -1000, -1000, 2388, -1000, 734, 262, 257,
+	14360, 14360, 14360, 307, 1309, -1000, 497, 826, 1055, 27107,
+	37204, -1000, 26576, 1854, -1000, 792, 2582, -1000, 37204, -1000,
+	2582, 2582, 1273, -1000, 275, -1000, 13298, 16484, 492, 2406,
+	-1000, -1000, 37204, 1262, -1000, 37204, -1000, 37204, -1000, 37204,
+	37204, 542, 9020, 916, -1000, -1000, -1000, 37204, -1000, 916,
+	-1000, 882, -198, 2406, 37204, 579, 272, -1000, -1000, 21797,
+	21797, -1000, -1000, -1000, -1000, 1755, 1749, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 422,
+	37204, 1086, -1000, 1312, 1200, 1622, 28169, 1302, 1736, 389,
+	-1000, -1000, -1000, 489, 127, 1535, 403, -1000, 1729, -1000,
+	791, 37204, 37204, -1000, 908, -1000, 1294, 2264, 2278, 2264,
+	-1000, -1000, -1000, -1000, 2309, -1000, 2284, -1000, -1000, 908,
+	-1000, -1000, -1000, -1000, -1000, 748, -1000, 2534, 761, 761,
+	761, 1853, 912, 1852, -1000, -1000, -1000, -1000, -1000, 2579,
+	2572, 27638, 2579, -1000, -313, 1329, -1000, 1487, 131, 1431,
+	37204, -1000, -1000, -1000, 1841, 1834, -267, 29, 2571, 2569,
+	2609, -1000, 1832, 894, -261, -1000, -1000, 800, -1000, -1000,
+	-1000, -261, -1000, 800, -1000, -1000, 1520, -1000, 153, -1000,
+	-1000, -1000, -1000, -1000, -1000, 52, -1000, 2462, 37204, -1000,
+	1720, 1719, 89, -1000, 1510, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1837, 1833, 2293, 2511, 1832, 8412, -1000, -1000, 1241, 1206,
-	1184, -1000, 1749, 7889, -1000, -1000, -1000, 1829, 1068, 1827,
-	-1000, -1000, -1000, 1813, 1181, 803, 1812, 1016, 1810, 988,
-	8412, 8412, 1171, 1163, 8412, 8412, 8412, 8412, 1806, 8412,
-	8412, 8412, 8412, 8412, 8412, 8412, 8412, -22, -1000, -1000,
-	1159, -1000, 2016, -1000, 1701, -1000, 923, 974, -1000, 1918,
-	-1000, -1000, -1000, -1000, 693, 668, 682, 32511, 761, 12103,
-	32511, 1914, 2279, 70, -1000, 1013, -1000, 43, -116, 748,
-	2106, 2461, 32511, 32511, 32511, 2295, 28850, -1000, 1957, 1158,
-	-1000, -1000, 8412, -1000, -1000, 2070, 32511, 32511, 2452, 2452,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 32511, 2452, 2452,
-	1194, 1295, -1000, -1000, 1295, -1000, -1000, 1295, -1000, 1067,
-	-1000, 32511, -1000, -1000, -1000, 1914, 958, -1000, 12626, 848,
-	555, -1000, 1214, 1214, 740, 1214, 1214, 1214, 1214, 322,
-	312, 1214, 1214, 1214, 1214, 1214, 1214, 1214, 1214, 1214,
-	1214, 1214, 1214, 1214, 1214, 1955, -1000, 67, 2313, 153,
-	1013, 167, 2677, 885, -1000, -1000, -1000, -1000, 19428, 19428,
-	15244, 19428, -1000, 1260, -1000, -1000, 506, -1000, -1000, 748,
-	-1000, -1000, -1000, 1951, 1312, -1000, -1000, 11046, -1000, 5263,
-	5263, 5263, -1000, -1000, 19951, 32511, -1000, -122, -1000, -97,
-	-1000, 930, -1000, -1000, 953, 748, 2138, 930, 930, -1000,
-	12103, 32511, -1000, 2452, 7356, -1000, 24135, -1000, -1000, 28319,
-	-1000, 27796, 2452, 1330, -1000, 10515, 1592, 131, -1000, 163,
-	-313, 135, 1434, 133, 2016, -1000, -1000, 1802, 1801, 1156,
-	-1000, 1150, 1799, 1148, 1147, -1000, -74, -1000, 2252, 750,
-	-1000, 1950, -1000, 1121, 2219, -1000, 927, -1000, 1311, 1117,
-	-1000, 750, 1114, 2199, 927, -1000, -1000, 1066, 20, -1000,
-	-1000, 32511, 1711, 1108, 27273, 782, -1000, 503, 1062, 1061,
-	-1000, 26227, 122, 26227, -1000, 26227, -1000, -1000, 280, -1000,
-	32511, 2269, -1000, -1000, -1000, 1672, -335, -1000, -1000, -1000,
-	-1000, -1000, 1064, -1000, 424, 424, 1230, 1708, -1000, 9981,
-	-1000, 9981, 2562, 1324, -1000, 8412, 1566, 33435, 1348, 18905,
-	32511, -1000, -1000, 8412, 8412, -1000, 2551, -1000, -1000, -1000,
-	-1000, 8412, 8412, 1720, -1000, 32511, -1000, -1000, -1000, -1000,
-	18905, -1000, 9981, -1000, 8412, 860, 2535, -22, -22, 2520,
-	2514, 2505, 1059, -22, 2469, 2437, 2403, 2397, 2364, 2322,
-	2264, 2259, -1000, 1949, 6833, -1000, -74, 8412, 8412, 8412,
-	2223, -1000, -1000, -1000, -1000, -1000, 665, 89, 1783, 867,
-	-1000, -1000, 32511, -1000, -1000, -1000, 1781, 806, -1000, -1000,
-	-1000, 33518, 1913, 1913, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1946, -1000, -1000, 1911, 1911, 1911, 1880,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1938,
-	1938, 1941, 1938, -1000, 2290, -1000, -17, 1214, 469, 26227,
-	343, -1000, 32511, 2137, 289, 2233, 32511, 1925, 1923, 1922,
-	203, 33518, 32511, 866, -1000, 1052, 2941, -1000, 32511, 2016,
-	-1000, 1443, -1000, 1194, -1000, -1000, 2452, 1063, -1000, -1000,
-	2452, 1194, 1194, 1295, 32511, -1000, 2289, 3122, -1000, -1000,
-	-1000, -1000, 33518, 585, -1000, 666, 462, 32511, 1397, 666,
-	1396, 1917, -1000, -1000, -1000, 32511, 32511, 32511, 1394, 1393,
-	-1000, 32511, 1569, -1000, 1568, 1214, 1214, 1562, 1699, 1698,
-	1696, 1214, 1214, 1557, 1695, 26750, 1552, 1548, 1546, 1593,
-	1692, 759, 1583, 1571, 1549, 32511, 1915, 1645, -17, 1214,
-	151, 1307, 469, 1445, 16290, 32511, 24135, 24135, 24135, 24135,
-	-1000, 2162, 2161, -1000, 2165, 2159, 2173, 32511, 24135, 1914,
-	-1000, 26750, -1000, -1000, -1000, 2391, 1053, 2578, 609, 8412,
-	26227, 1689, 344, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 32511, 32511, 1777, -1000, 2449, -1000, 796, -1000, -1000,
-	1051, -1000, 2449, 1344, -312, 10515, 1336, 1320, -1000, 8412,
-	10515, 8412, -287, 149, -294, -1000, -1000, -1000, 1688, -1000,
-	-1000, -1000, 1537, -1000, 1536, -4, 5, 1389, -260, 6833,
-	306, 32511, -260, 32511, 6833, -1000, 32511, 281, -260, 32511,
-	1535, -1000, -1000, -1000, 2408, 26227, 1443, 1170, 25704, -1000,
-	99, -1000, 143, 379, 1687, -1000, 572, 83, -1000, 1302,
-	1672, -1000, -1000, -1000, 9981, -1000, -1000, -1000, -1000, 2016,
-	8412, 1776, -1000, 667, 667, 1771, -1000, 1913, 1913, -1000,
-	1880, 1911, 1880, 667, 667, 1756, -1000, 1665, 2253, -1000,
-	2242, 2212, 8412, -1000, 1754, 3063, 919, -144, -22, -1000,
-	-1000, -1000, -22, -22, -22, -22, -1000, -22, -22, -22,
-	-22, -22, -22, -22, -22, 418, -1000, -4, 2016, 2016,
-	-1000, -1000, 2210, -1000, 1681, 1679, 761, 33518, 592, 12103,
-	2237, 311, 1502, -1000, -1000, 25181, 364, -1000, -1000, -1000,
-	461, 102, 1516, 355, -1000, 32511, 152, 32511, -1000, -1000,
-	-1000, -1000, -1000, 2233, -1000, 763, 190, 12626, 12626, 12626,
-	199, 1266, -1000, 417, 931, 1045, 24135, 32511, -1000, 23612,
-	1753, -1000, 748, 2452, -1000, 32511, -1000, 2452, 2452, 1194,
-	-1000, 311, -1000, 2745, -1000, 32511, -1000, 32511, -1000, 32511,
-	32511, 462, 8412, -1000, -1000, -1000, 32511, -1000, 240, -1000,
-	-1000, 18905, 18905, -1000, -1000, -1000, -1000, 1678, 1677, -1000,
+	9020, -1000, -1000, -1000, 1814, -1000, -1000, 9020, 1831, 1711,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 330, 32511, 1038, -1000, 1300, 1502, 25181, 1298, 1674,
-	364, -1000, 1673, -1000, 726, 32511, 32511, -1000, 886, -1000,
-	1272, 2115, 2135, 2115, -1000, -1000, -1000, -1000, 2139, -1000,
-	2030, -1000, -1000, 886, -1000, -1000, -1000, -1000, -1000, 609,
-	-1000, 2373, 666, 666, 666, 1752, 782, 1750, -1000, -1000,
-	-1000, -1000, -1000, 2445, 2440, 24658, 2445, -1000, -312, 1322,
-	-1000, 1561, 126, 1383, 32511, -1000, -1000, -1000, 1748, 1742,
-	-273, 9, 2433, 2432, 2522, -1000, 1738, 781, -260, -1000,
-	-1000, 750, -1000, -1000, -1000, -260, -1000, 750, -1000, -1000,
-	1443, -1000, 141, -1000, -1000, -1000, -1000, -1000, -1000, 31,
-	-1000, 32511, -1000, 1672, 1668, 82, -1000, 2016, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 8412, -1000, -1000, -1000, 2207, -1000, -1000,
-	8412, 1737, 1666, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2392, -1000, 2429, -273,
-	-1000, -1000, -1000, -1000, -1000, -1000, 33518, -1000, 1460, -1000,
-	-1000, 1663, 42, -1000, -1000, -1000, 1659, 1647, 1499, -1000,
-	-1000, 1545, 850, 59, -1000, -1000, -1000, -1000, -1000, -1000,
-	1445, 32511, 1910, -1000, 1214, 1214, 1214, 32511, 1736, 754,
-	-1000, 1733, 1732, 399, 1316, 1306, -1000, 1495, 19428, 24135,
-	23612, 846, -1000, 1043, -1000, -1000, -1000, 2452, -1000, -1000,
-	2452, -1000, -1000, 2745, -1000, -1000, 1404, 9981, -1000, -1000,
-	1646, 18382, 543, 553, 1908, -1000, 290, 2494, -1000, 1388,
-	1376, -1000, 32511, -1000, 1904, -1000, 1902, 1920, 246, 1899,
-	1898, 32511, 2196, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 356, 1033, -1000, 1645, 1643, -1000, 42, 1642,
-	-1000, -1000, -1000, 32511, 726, 726, 2392, 32511, 6833, -1000,
-	-1000, 8412, 1894, -1000, 8412, -1000, -1000, -1000, -1000, -1000,
-	1893, 2232, -1000, -1000, -1000, -1000, -1000, -1000, 8412, 8412,
-	-1000, -1000, 458, 10515, -295, 148, -1000, -1000, -1000, -275,
-	1640, -1000, -1000, 2426, 1635, 1620, 32511, -1000, 750, 750,
-	748, -1000, -1000, -59, -1000, -1000, -1000, 2176, -1000, 2128,
-	-22, -1000, 110, 8412, -275, -203, -1000, -1000, -1000, -1000,
-	263, -1000, -1000, 159, -1000, -1000, 1468, 390, -1000, -1000,
-	726, 22566, 18905, 18382, 1629, -1000, 33644, 12626, 118, 33644,
-	713, 1285, -1000, 1494, -1000, 1491, -1000, 2452, 846, 1043,
-	-1000, -1000, 1071, -1000, -1000, -1000, -1000, 1230, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1490, 1885, -126, -1000, -1000, 1882, 22566, 22566, 219,
-	219, 22566, 22566, 1881, 514, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2374, -1000, -1000, 2016, 32511, 2016, 23089,
-	-1000, 2425, 2416, 2016, 719, -1000, -312, 32511, 32511, -277,
-	1488, -1000, 1627, 6, -1000, -1000, 721, -279, -5, -6,
-	-1000, -1000, -1000, 1731, -1000, 3694, -1000, -1000, -1000, 719,
-	-277, 32511, 367, 1625, -1000, -1000, 158, -1000, -1000, 1030,
-	-1000, 1880, 8412, -1000, -1000, -1000, 406, 33589, -1000, -1000,
-	-1000, -59, 406, 375, 207, -1000, 1475, -1000, -1000, 2392,
-	-1000, 1670, 8412, 1878, -193, 22566, 1019, 1018, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1014, 1009, 22566, -1000, -1000,
-	-1000, 284, -1000, 989, 977, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1877, -1000, -1000, 2415, -1000, 1624, 471, -1,
-	-6, -1000, 2414, 4, 2411, 2407, -1000, -1000, 4740, -267,
-	-21, 332, -1000, 2284, -1000, -1000, 30, -1000, -1000, -1000,
-	22566, 2256, 2117, 230, 2406, 33644, -1000, -1000, 230, -1000,
-	196, -1000, 1267, -1000, 1459, -1000, 2374, -1000, 2068, -1000,
-	2133, -1000, 106, 972, -1000, -1000, -1000, -1000, 967, -1000,
-	-1000, -1000, 22043, 32511, 1620, -1000, 1868, 1441, 9, -2,
-	2405, -1000, 1620, 2402, 1620, 1620, 1362, -1000, -1000, -1000,
-	-1000, -1000, 1622, -1000, 229, -1000, -1000, 2256, -1000, 2389,
-	313, -1000, -1000, -1000, -1000, 1435, -1000, -1000, 514, -1000,
-	2129, 2111, 2465, -1000, -1000, -1000, -1000, 229, 229, 229,
-	229, 112, -1000, -1000, 963, -1000, -1000, 2310, 17859, -13,
-	-1000, -1000, -1000, 1621, -1000, 1620, -1000, -1000, 4740, -1000,
-	-1000, 1214, 1464, 183, -1000, -1000, -1000, 21520, 268, 321,
-	301, -1000, 392, -1000, -1000, -1000, -1000, 2471, -1000, 2466,
-	595, 595, -1000, -1000, 32511, -1000, 32511, -1000, 952, -1000,
-	-1000, -1000, 1032, -1000, -1000, -1000, -1000, -1000, 1426, -1000,
-	32511, -1000, 32511, 266, 1425, 9981, 1867, 9981, 1865, 275,
-	1862, -1000, -1000, -1000, 1500, 286, -1000, -1000, 718, -1000,
-	1207, -1000, 20997, 32511, -1000, -1000, 907, 1859, 2387, -1000,
-	2933, 32511, 2396, 32511, 1858, 1178, 9981, -1000, -1000, -1000,
-	32511, 6310, -1000, 808, -1000, -1000, 385, 272, -1000, 895,
-	-1000, 871, 20474, 1413, 2251, -1000, -1000, 2016, 32511, 858,
-	-1000, 32511, 258, -1000, -1000, -1000, 839, -1000, -1000, -1000,
-	-1000, 385, 2184, -1000, 1412, -1000, -1000, 3289, 526, -1000,
-	-1000, 3289, 264, -1000, 372, 1717, -1000, -1000, 836, -1000,
-	32511, 588, 8412, -1000, 264, 33644, -1000, 8412, 810, -1000,
-	33644, 809, -1000, -1000,
+	-1000, -1000, -1000, 2576, -1000, 2568, -267, -1000, -1000, -1000,
+	-1000, -1000, -1000, 3456, -1000, 1474, -1000, -1000, 1693, 63,
+	-1000, -1000, 389, -1000, -1000, -1000, 424, -1000, 1692, 1689,
+	1686, 1683, -1000, -1000, 1522, 37204, 1995, -1000, 1291, 1291,
+	1291, 37204, 37204, 1830, 863, -1000, -1000, 1826, 1819, 507,
+	1306, 1299, -1000, 1532, 22328, 27107, 26576, 889, -1000, 1051,
+	-1000, -1000, -1000, 2582, -1000, -1000, 2582, -1000, 1082, -1000,
+	37204, -1000, -1000, -1000, -1000, 1262, -1000, -1000, 1419, 10613,
+	-1000, -1000, 1682, 21266, 659, 664, 1991, -1000, 381, 2608,
+	-1000, 1402, 1396, -1000, 37204, -1000, 1987, -1000, 1984, 2162,
+	326, 1981, 1978, 37204, 1765, -1000, 1975, 616, -1000, 2406,
+	37204, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 419,
+	1077, -1000, 1680, 1679, 1083, -1000, 63, 1673, -1000, -1000,
+	1671, 1666, 1530, -1000, -1000, 1528, 1071, 85, -1000, -1000,
+	-1000, -1000, -1000, 37204, 791, 791, 2576, 37204, 7417, -1000,
+	-1000, 9020, 1972, -1000, 9020, -1000, -1000, -1000, -1000, -1000,
+	1971, 2356, -1000, -1000, -1000, -1000, -1000, -1000, 9020, 9020,
+	-1000, -1000, 535, 11155, -294, 164, -1000, -1000, -1000, -269,
+	1664, -1000, -1000, 2565, 1654, 1478, 37204, -1000, 800, 800,
+	792, -1000, -1000, 1653, 1651, -34, -1000, -1000, -1000, 1733,
+	-1000, 1722, -4, -1000, 118, 9020, -269, -179, -1000, -1000,
+	-1000, -1000, 266, -1000, 82, -1000, -1000, -1000, -1000, -1000,
+	-1000, 791, 25514, 21797, 21266, 1650, -1000, 251, 38069, 14360,
+	138, 38069, 749, 1296, -1000, 1527, -1000, 1518, -1000, 2582,
+	889, 1051, -1000, -1000, 1174, -1000, -1000, -1000, 492, 2336,
+	-1000, -1000, 2346, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1513, 1964, -104, -1000,
+	-1000, 1962, 25514, 25514, 258, 258, 25514, 25514, 1961, 562,
+	492, 37204, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1509,
+	-1000, -1000, -1000, -1000, 216, -1000, -1000, 1493, 426, -1000,
+	-1000, 2526, -1000, -1000, 1510, 37204, 1510, 26045, -1000, 2564,
+	2561, 1510, 773, -1000, -313, 37204, 37204, -271, 1505, -1000,
+	1643, 23, -1000, -1000, 780, -1000, -1000, -283, 1, 10,
+	-1000, -1000, -1000, 1732, -1000, 4230, -1000, -1000, -1000, 773,
+	-271, 37204, 405, 1641, -1000, 1074, -1000, 1946, 9020, -1000,
+	-1000, -1000, -1000, 37204, 494, 3872, -1000, -1000, -1000, -34,
+	494, 431, 213, -1000, 1496, -1000, -1000, 2576, -1000, -1000,
+	3801, 613, 1633, 9020, 1945, -172, 25514, 1073, 1072, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1070, 1068, 25514, -1000,
+	-1000, -1000, 374, 1044, 40, 2980, -1000, -1000, 184, -1000,
+	-1000, -1000, 1043, 1039, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1943, -1000, -1000, 2559, -1000, 1640, 570, 17, 10,
+	-1000, 2558, 21, 2557, 2556, -1000, -1000, 5292, -262, -7,
+	314, -1000, 2398, -1000, -1000, 48, -1000, -1000, 25514, 2403,
+	1628, -1000, 321, 2547, 38069, -1000, -1000, 321, -1000, 283,
+	-1000, 1259, -1000, 1489, -1000, 2526, -1000, 3801, 342, -1000,
+	483, 1942, -1000, 1414, -1000, 2276, -1000, 115, 1036, -1000,
+	-1000, -1000, -1000, 1009, -1000, -1000, 467, 430, -1000, -1000,
+	-1000, -1000, 24983, 37204, 1478, -1000, 1941, 1482, 29, 16,
+	2546, -1000, 1478, 2545, 1478, 1478, 1386, -1000, -1000, -1000,
+	-1000, -1000, 1635, -1000, 209, -1000, -1000, 2403, -1000, 2542,
+	349, -1000, -1000, -1000, -1000, 1462, -1000, -1000, -1000, -1000,
+	996, -1000, 37204, 667, 9020, 562, -1000, 2275, 2274, 2586,
+	-1000, -1000, -1000, -1000, 209, 209, 209, 209, 133, -1000,
+	-1000, -1000, -1000, 978, -1000, -1000, 2463, 20735, 3, -1000,
+	-1000, -1000, 1634, -1000, 1478, -1000, -1000, 5292, -1000, -1000,
+	1291, 1476, 245, -1000, -1000, -1000, 24452, 354, 322, 297,
+	-1000, 481, -1000, -1000, -1000, 342, 38069, -1000, 9020, 961,
+	-1000, -1000, 2606, -1000, 2603, 654, 654, -1000, -1000, 37204,
+	-1000, 37204, -1000, 957, -1000, -1000, -1000, 1048, -1000, -1000,
+	-1000, -1000, -1000, 1459, -1000, 37204, -1000, 37204, 340, 1452,
+	10613, 1940, 10613, 1939, 365, 1937, -1000, 38069, 953, -1000,
+	-1000, -1000, -1000, 1470, 387, -1000, -1000, 756, -1000, 1284,
+	-1000, 23921, 37204, -1000, -1000, 950, 1801, 2541, -1000, 3091,
+	37204, 3077, 37204, 1762, 1248, 10613, -1000, -1000, -1000, -1000,
+	37204, 6886, -1000, 1042, -1000, -1000, 492, 350, -1000, 914,
+	-1000, 862, 23390, 1447, 2911, -1000, -1000, 1510, 37204, 835,
+	338, -1000, -1000, -1000, 831, -1000, -1000, -1000, -1000, -1000,
+	1433, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 130, 2539, 212, 136, 3095, 52, 211, 196, 184,
-	207, 3094, 3093, 2276, 2272, 3091, 3090, 3089, 3088, 3087,
-	3086, 3083, 3079, 3078, 3077, 3071, 3070, 3069, 3067, 3066,
-	3065, 3063, 3048, 3045, 3044, 3043, 3042, 3041, 3040, 199,
-	3039, 3038, 3037, 3035, 3028, 3027, 3026, 3025, 3024, 3023,
-	3022, 3021, 3020, 3019, 3016, 3015, 3014, 3013, 3011, 3010,
-	3009, 3007, 3006, 3005, 3004, 3003, 3000, 2999, 2998, 2997,
-	169, 2996, 2271, 2994, 2993, 2992, 2991, 2990, 2989, 2988,
-	174, 2986, 2985, 2984, 2983, 2981, 2980, 2979, 2977, 2976,
-	2975, 2974, 2972, 2969, 2966, 2965, 2964, 2963, 176, 2962,
-	132, 159, 2961, 2960, 2959, 2957, 2956, 197, 190, 47,
-	2950, 38, 2948, 183, 2946, 116, 2945, 105, 2944, 2940,
-	2939, 2938, 2937, 2935, 2934, 2931, 2927, 2926, 2925, 2923,
-	65, 2922, 2913, 144, 158, 215, 1721, 214, 210, 149,
-	139, 87, 2909, 2261, 2908, 148, 193, 122, 24, 2906,
-	134, 2902, 119, 34, 28, 206, 102, 41, 126, 95,
-	2900, 181, 70, 2899, 79, 2898, 2897, 216, 152, 2896,
-	96, 2895, 2894, 2893, 2887, 155, 154, 2885, 2880, 100,
-	2879, 2876, 101, 2875, 46, 2874, 129, 2866, 231, 90,
-	85, 2863, 2860, 2859, 71, 2858, 2857, 2856, 2852, 140,
-	2850, 2848, 99, 69, 2846, 2844, 2843, 45, 2842, 54,
-	2839, 55, 2837, 2836, 2832, 2830, 49, 2828, 2825, 14,
-	21, 23, 2824, 19, 2823, 131, 2821, 2820, 2819, 2,
-	2816, 188, 170, 77, 113, 2814, 366, 2813, 2812, 2811,
-	123, 2808, 359, 2807, 2804, 2802, 2800, 2799, 5, 2796,
-	182, 40, 2795, 74, 93, 92, 163, 164, 2794, 2793,
-	2792, 94, 89, 62, 0, 2788, 117, 2787, 2786, 2777,
-	213, 2776, 202, 171, 195, 192, 217, 162, 2775, 2774,
-	68, 2773, 124, 72, 104, 11, 2771, 145, 2770, 745,
-	142, 2769, 166, 2767, 120, 1, 115, 2766, 2765, 35,
-	223, 2764, 2763, 2762, 88, 2761, 2760, 91, 97, 2759,
-	2758, 2757, 32, 2754, 30, 26, 2753, 80, 2752, 218,
-	2749, 156, 111, 143, 141, 121, 185, 194, 59, 60,
-	2747, 1299, 118, 75, 22, 2745, 191, 2739, 221, 189,
-	2738, 153, 2729, 208, 320, 167, 2727, 146, 8, 36,
-	29, 2726, 12, 2725, 198, 160, 2722, 2720, 16, 2719,
-	17, 2718, 2717, 2715, 2714, 9, 2713, 2712, 2711, 4,
-	7, 2709, 3, 179, 2708, 2706, 2704, 2701, 37, 114,
-	2700, 103, 157, 2699, 2697, 73, 2696, 2694, 2693, 730,
-	2692, 2690, 2686, 2684, 2683, 2682, 2681, 2678, 2677, 2676,
-	76, 43, 2675, 2674, 2667, 2666, 63, 108, 2665, 2663,
-	2662, 2661, 31, 138, 2658, 15, 2657, 27, 25, 33,
-	2651, 110, 2650, 18, 147, 2646, 2640, 20, 2639, 2625,
-	10, 13, 2623, 2622, 83, 2621, 67, 42, 128, 78,
-	2618, 66, 168, 106, 2617, 2616, 204, 205, 165, 2615,
-	112, 203, 222, 2610, 180, 2604, 2601, 2600, 2594, 2591,
-	2590, 833, 2589, 2583, 209, 44, 53, 82, 2577, 2576,
-	2574, 64, 125, 86, 81, 172, 2572, 161, 2569, 2567,
-	84, 2558, 2551, 2550, 2547, 2546, 151, 2545, 2544, 2543,
-	2542, 200, 135, 2536,
+	0, 145, 2640, 218, 148, 3130, 63, 217, 198, 196,
+	215, 3116, 3111, 2402, 2390, 3110, 3109, 3108, 3107, 3106,
+	3105, 3104, 3103, 3102, 3101, 3100, 3099, 3094, 3093, 3092,
+	3090, 3087, 3086, 3085, 3083, 3081, 3080, 3079, 3078, 213,
+	3074, 3072, 3069, 3067, 3066, 3065, 3063, 3062, 3059, 3058,
+	3056, 3054, 3053, 3052, 3048, 3047, 3046, 3045, 3043, 3042,
+	3029, 3025, 3023, 3022, 3021, 3020, 3018, 3017, 3015, 3014,
+	192, 3011, 2389, 3010, 3007, 3005, 3004, 3003, 3002, 3001,
+	211, 3000, 2998, 2995, 2989, 2988, 2987, 2985, 2984, 2981,
+	2978, 2977, 2976, 2974, 2973, 2972, 2971, 2969, 202, 2968,
+	143, 166, 2967, 2966, 2965, 2963, 2960, 214, 190, 49,
+	2957, 37, 2955, 191, 2954, 122, 2953, 115, 2952, 2951,
+	2950, 2948, 2945, 2944, 2943, 2937, 2936, 2935, 2932, 2931,
+	79, 2930, 2928, 2927, 113, 164, 221, 2203, 225, 226,
+	158, 141, 73, 2925, 2404, 2924, 153, 203, 118, 20,
+	2923, 142, 2922, 131, 34, 22, 220, 121, 40, 133,
+	101, 130, 189, 72, 2920, 88, 2919, 2918, 231, 160,
+	2916, 105, 2914, 2913, 2911, 2910, 176, 174, 2909, 2907,
+	104, 2905, 2904, 99, 2903, 54, 2902, 137, 2901, 78,
+	89, 91, 87, 2897, 2895, 77, 2894, 2893, 2892, 2891,
+	151, 2890, 2889, 112, 71, 2888, 2886, 2885, 44, 2884,
+	46, 2883, 62, 2882, 2881, 2880, 2877, 55, 2876, 2875,
+	9, 17, 21, 2874, 19, 2872, 139, 2871, 2870, 138,
+	67, 2869, 2868, 11, 2866, 200, 45, 81, 119, 2865,
+	351, 2860, 2858, 2856, 126, 2850, 759, 2846, 2845, 2843,
+	2842, 2841, 140, 2840, 195, 38, 2839, 83, 109, 97,
+	167, 172, 2838, 2837, 2836, 159, 75, 70, 0, 2832,
+	125, 2831, 2830, 2827, 228, 2825, 207, 188, 206, 266,
+	241, 219, 2823, 2822, 69, 2821, 95, 76, 108, 1,
+	2820, 169, 2818, 1104, 152, 2817, 180, 2816, 194, 12,
+	116, 2814, 2813, 33, 224, 2809, 2808, 2807, 102, 2805,
+	2802, 93, 100, 2801, 2797, 2794, 52, 2793, 26, 32,
+	2792, 103, 2790, 216, 2788, 156, 111, 154, 147, 123,
+	193, 204, 2787, 2786, 2785, 53, 60, 59, 2784, 1380,
+	117, 82, 24, 2783, 201, 2782, 223, 199, 2781, 165,
+	2780, 209, 338, 186, 2778, 157, 3, 35, 28, 2777,
+	4, 2775, 245, 181, 2774, 2773, 18, 2772, 14, 2771,
+	2770, 2769, 2765, 43, 2762, 2, 2761, 15, 13, 2760,
+	27, 184, 2759, 2757, 2756, 2755, 36, 124, 2754, 135,
+	179, 2753, 2751, 80, 2750, 2749, 2748, 1638, 2745, 2744,
+	2743, 2742, 2741, 2740, 2738, 2737, 2736, 2735, 85, 41,
+	2733, 2731, 2730, 2728, 64, 110, 2726, 2725, 2723, 2722,
+	29, 155, 2721, 16, 2720, 25, 23, 31, 2719, 106,
+	2718, 8, 161, 2716, 2715, 10, 2714, 2712, 5, 7,
+	2711, 2709, 96, 2708, 68, 42, 136, 84, 2707, 66,
+	185, 114, 2698, 2697, 30, 208, 182, 2695, 146, 205,
+	222, 2693, 183, 2691, 2690, 2689, 2686, 2678, 2673, 888,
+	2672, 2665, 212, 47, 92, 90, 2664, 2663, 2662, 65,
+	132, 94, 74, 168, 2661, 163, 2659, 2652, 86, 2651,
+	2650, 2649, 2648, 2647, 162, 2646, 2643, 2631, 2629, 171,
+	210, 307, 2625,
 }
 
-//line mysql_sql.y:9383
+//line mysql_sql.y:9573
 type yySymType struct {
 	union interface{}
 	id    int
@@ -5315,6 +5801,16 @@ func (st *yySymType) alterAccountAuthOptionUnion() tree.AlterAccountAuthOption {
 	return v
 }
 
+func (st *yySymType) alterColPositionUnion() *tree.ColumnPosition {
+	v, _ := st.union.(*tree.ColumnPosition)
+	return v
+}
+
+func (st *yySymType) alterPartitionOptionUnion() tree.AlterPartitionOption {
+	v, _ := st.union.(tree.AlterPartitionOption)
+	return v
+}
+
 func (st *yySymType) alterTableUnion() tree.AlterTable {
 	v, _ := st.union.(tree.AlterTable)
 	return v
@@ -6126,177 +6622,181 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 485, 489, 489, 5, 5, 2, 6, 6, 3,
+	0, 493, 497, 497, 5, 5, 2, 6, 6, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	1, 1, 1, 1, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 105, 483, 483, 483, 484, 484, 102, 122,
+	4, 4, 105, 491, 491, 491, 492, 492, 102, 122,
 	121, 124, 124, 123, 123, 120, 120, 116, 119, 119,
 	118, 118, 117, 112, 114, 114, 113, 115, 115, 103,
-	91, 104, 433, 433, 432, 432, 431, 431, 386, 386,
-	430, 430, 430, 429, 429, 429, 428, 428, 427, 427,
-	426, 426, 424, 424, 425, 423, 422, 422, 422, 420,
-	420, 420, 416, 416, 418, 417, 417, 419, 411, 411,
-	414, 414, 412, 412, 412, 412, 415, 410, 410, 410,
-	409, 409, 90, 90, 90, 333, 333, 89, 89, 347,
-	347, 347, 347, 347, 345, 345, 345, 345, 345, 345,
-	344, 344, 343, 343, 348, 348, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 346, 346, 346, 346,
-	346, 346, 346, 346, 346, 346, 81, 81, 81, 81,
-	84, 84, 84, 85, 342, 342, 342, 82, 83, 83,
-	332, 332, 337, 337, 336, 336, 336, 336, 336, 336,
-	336, 336, 336, 336, 336, 336, 341, 341, 341, 339,
-	339, 338, 338, 340, 340, 75, 75, 75, 78, 77,
-	331, 331, 331, 331, 331, 331, 331, 331, 331, 76,
+	91, 104, 441, 441, 440, 440, 439, 439, 394, 394,
+	438, 438, 438, 437, 437, 437, 436, 436, 435, 435,
+	434, 434, 432, 432, 433, 431, 430, 430, 430, 428,
+	428, 428, 424, 424, 426, 425, 425, 427, 419, 419,
+	422, 422, 420, 420, 420, 420, 423, 418, 418, 418,
+	417, 417, 90, 90, 90, 341, 341, 89, 89, 355,
+	355, 355, 355, 355, 353, 353, 353, 353, 353, 353,
+	352, 352, 351, 351, 356, 356, 354, 354, 354, 354,
+	354, 354, 354, 354, 354, 354, 354, 354, 354, 354,
+	354, 354, 354, 354, 354, 354, 354, 354, 354, 354,
+	354, 354, 354, 354, 354, 354, 354, 354, 354, 354,
+	354, 354, 354, 354, 354, 354, 354, 354, 354, 354,
+	354, 354, 354, 354, 354, 354, 81, 81, 81, 81,
+	84, 84, 84, 85, 350, 350, 350, 82, 83, 83,
+	340, 340, 345, 345, 344, 344, 344, 344, 344, 344,
+	344, 344, 344, 344, 344, 344, 349, 349, 349, 347,
+	347, 346, 346, 348, 348, 75, 75, 75, 78, 77,
+	339, 339, 339, 339, 339, 339, 339, 339, 339, 76,
 	76, 76, 76, 76, 76, 71, 71, 71, 71, 71,
-	70, 70, 72, 72, 329, 329, 328, 86, 86, 87,
-	487, 487, 486, 488, 488, 488, 488, 88, 94, 94,
+	70, 70, 72, 72, 337, 337, 336, 86, 86, 87,
+	495, 495, 494, 496, 496, 496, 496, 88, 94, 94,
 	94, 94, 94, 94, 94, 93, 93, 96, 96, 95,
 	97, 80, 80, 80, 80, 80, 79, 79, 79, 79,
-	79, 79, 79, 79, 79, 457, 457, 457, 459, 459,
-	268, 269, 490, 271, 267, 267, 267, 453, 453, 454,
-	455, 456, 456, 456, 92, 11, 11, 11, 11, 11,
-	11, 69, 74, 224, 224, 225, 225, 225, 225, 226,
-	226, 226, 226, 226, 227, 228, 228, 67, 73, 73,
-	470, 470, 68, 477, 477, 389, 389, 282, 282, 281,
-	281, 281, 281, 281, 281, 281, 281, 281, 281, 281,
-	281, 281, 281, 281, 281, 393, 394, 278, 39, 39,
+	79, 79, 79, 79, 79, 465, 465, 465, 467, 467,
+	272, 273, 498, 275, 271, 271, 271, 461, 461, 462,
+	463, 464, 464, 464, 92, 11, 11, 11, 11, 11,
+	11, 69, 74, 74, 231, 231, 231, 231, 231, 231,
+	231, 231, 225, 225, 226, 226, 226, 226, 226, 226,
+	226, 226, 226, 499, 499, 230, 230, 229, 229, 227,
+	227, 227, 227, 227, 227, 228, 232, 232, 67, 73,
+	73, 478, 478, 68, 485, 485, 397, 397, 286, 286,
+	285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
+	285, 285, 285, 285, 285, 285, 401, 402, 282, 39,
 	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
 	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 39, 46, 45, 45, 45,
-	318, 318, 44, 491, 491, 257, 257, 56, 55, 48,
-	57, 58, 59, 60, 61, 62, 43, 54, 54, 54,
-	54, 54, 54, 54, 54, 65, 65, 405, 405, 493,
-	493, 493, 63, 64, 388, 388, 388, 53, 52, 51,
-	50, 49, 49, 42, 42, 41, 41, 47, 128, 129,
-	275, 275, 275, 277, 277, 273, 492, 492, 360, 360,
-	276, 276, 40, 40, 40, 40, 66, 274, 274, 256,
-	272, 272, 272, 12, 12, 10, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 23, 24,
-	26, 326, 326, 323, 25, 18, 17, 20, 16, 19,
-	21, 22, 22, 9, 9, 9, 9, 13, 13, 14,
-	140, 140, 189, 189, 465, 465, 461, 461, 462, 462,
-	462, 463, 463, 464, 464, 98, 399, 399, 399, 399,
-	399, 399, 8, 163, 163, 162, 162, 398, 398, 398,
-	398, 398, 398, 330, 330, 442, 442, 442, 443, 161,
-	161, 156, 156, 400, 400, 296, 444, 444, 408, 408,
-	407, 407, 406, 406, 159, 159, 160, 160, 143, 143,
-	108, 108, 413, 413, 413, 413, 421, 421, 385, 385,
-	216, 216, 251, 251, 252, 252, 133, 133, 134, 134,
-	134, 134, 134, 134, 450, 450, 452, 452, 451, 158,
-	158, 154, 154, 155, 155, 155, 153, 153, 152, 151,
-	151, 150, 148, 148, 148, 149, 149, 149, 136, 136,
-	136, 135, 135, 135, 135, 135, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 137, 137,
-	458, 458, 458, 390, 390, 390, 396, 396, 233, 233,
-	234, 234, 232, 232, 138, 138, 139, 139, 139, 139,
-	231, 231, 230, 141, 141, 147, 146, 146, 142, 142,
-	142, 142, 241, 241, 240, 240, 240, 240, 101, 106,
-	106, 107, 166, 166, 239, 238, 238, 238, 165, 165,
-	164, 164, 157, 157, 145, 145, 145, 145, 237, 144,
-	235, 482, 482, 481, 481, 480, 478, 478, 478, 479,
-	479, 479, 479, 435, 435, 435, 435, 435, 262, 262,
-	262, 266, 266, 265, 265, 265, 265, 265, 270, 7,
-	7, 7, 7, 7, 30, 30, 30, 30, 30, 30,
-	30, 30, 36, 174, 175, 37, 176, 176, 177, 177,
-	178, 178, 179, 180, 181, 181, 181, 181, 35, 167,
-	167, 168, 168, 169, 169, 170, 171, 171, 171, 173,
-	172, 34, 27, 466, 469, 467, 467, 471, 471, 471,
-	472, 472, 472, 473, 473, 28, 125, 130, 130, 127,
-	132, 132, 132, 132, 132, 126, 468, 474, 474, 474,
-	327, 327, 324, 325, 325, 322, 321, 321, 321, 476,
-	476, 475, 475, 475, 263, 263, 29, 317, 317, 319,
-	320, 320, 320, 311, 311, 311, 311, 33, 315, 315,
-	316, 316, 316, 316, 316, 312, 312, 314, 314, 310,
-	310, 310, 310, 310, 32, 131, 131, 309, 309, 307,
-	307, 305, 305, 306, 306, 304, 304, 304, 308, 308,
-	31, 31, 31, 110, 109, 109, 109, 254, 254, 253,
-	253, 111, 38, 201, 201, 374, 374, 374, 374, 374,
-	392, 392, 392, 375, 375, 375, 376, 376, 376, 377,
-	377, 377, 377, 377, 391, 391, 349, 349, 350, 350,
-	350, 353, 353, 366, 366, 367, 367, 365, 365, 372,
-	372, 371, 371, 370, 370, 369, 369, 368, 368, 368,
-	368, 363, 363, 362, 362, 351, 351, 351, 351, 351,
-	352, 352, 352, 361, 361, 364, 364, 207, 207, 208,
-	208, 208, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
-	229, 440, 440, 441, 210, 210, 210, 214, 214, 214,
-	214, 214, 214, 209, 209, 211, 211, 190, 190, 188,
-	188, 182, 182, 183, 183, 184, 184, 184, 187, 187,
-	185, 185, 186, 186, 186, 186, 335, 335, 438, 438,
-	439, 439, 434, 434, 434, 437, 437, 437, 437, 437,
-	436, 436, 191, 249, 249, 249, 264, 264, 264, 264,
-	248, 248, 248, 206, 206, 205, 205, 203, 203, 203,
-	203, 203, 203, 203, 203, 203, 203, 203, 203, 203,
-	203, 203, 334, 334, 279, 279, 280, 280, 223, 222,
-	222, 222, 222, 222, 220, 221, 219, 219, 219, 219,
-	219, 218, 218, 217, 217, 217, 313, 313, 215, 215,
-	213, 213, 213, 212, 212, 212, 373, 285, 285, 285,
-	285, 285, 285, 285, 285, 285, 285, 285, 285, 285,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 287, 287, 287, 287, 287, 287, 287, 287, 287,
-	287, 247, 288, 288, 293, 293, 449, 449, 448, 192,
-	192, 192, 193, 193, 193, 193, 193, 193, 193, 193,
-	193, 202, 202, 202, 358, 358, 358, 358, 358, 359,
-	359, 359, 356, 356, 357, 357, 297, 298, 298, 397,
-	397, 354, 354, 355, 246, 246, 246, 246, 246, 246,
-	246, 246, 246, 246, 246, 246, 246, 246, 246, 246,
-	246, 404, 404, 404, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	460, 460, 460, 445, 445, 445, 446, 446, 446, 446,
-	446, 446, 446, 446, 446, 446, 446, 446, 447, 447,
-	447, 447, 447, 447, 447, 447, 447, 447, 447, 447,
-	447, 447, 447, 447, 447, 245, 245, 245, 244, 244,
-	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
-	244, 244, 244, 299, 299, 300, 300, 401, 401, 401,
-	401, 401, 401, 402, 402, 403, 403, 403, 403, 395,
-	395, 395, 395, 395, 395, 395, 395, 395, 395, 395,
-	395, 395, 395, 395, 395, 395, 395, 395, 395, 395,
-	395, 395, 395, 395, 395, 395, 395, 395, 286, 242,
-	242, 242, 301, 294, 294, 295, 295, 289, 289, 289,
-	289, 289, 289, 289, 291, 291, 291, 291, 291, 291,
-	291, 291, 291, 291, 291, 284, 284, 284, 284, 284,
-	284, 284, 284, 284, 284, 284, 290, 290, 292, 292,
-	303, 303, 303, 302, 302, 302, 302, 302, 302, 302,
-	204, 204, 204, 204, 283, 283, 283, 283, 283, 283,
-	283, 283, 283, 283, 283, 194, 194, 194, 194, 198,
-	198, 200, 200, 200, 200, 200, 200, 200, 200, 200,
-	200, 200, 200, 200, 200, 199, 199, 199, 199, 197,
-	197, 197, 197, 197, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
-	99, 100, 100, 196, 250, 250, 378, 378, 381, 381,
-	379, 379, 380, 382, 382, 382, 383, 383, 383, 384,
-	384, 384, 387, 387, 255, 255, 255, 261, 261, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 260, 260, 260, 260, 260, 260, 260, 260,
-	260, 260, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 258, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 258, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 258, 258, 258, 258, 258, 258, 258, 258, 258,
-	258, 258, 258,
+	39, 39, 39, 39, 39, 39, 39, 46, 45, 45,
+	45, 322, 322, 44, 500, 500, 261, 261, 56, 55,
+	48, 57, 58, 59, 60, 61, 62, 43, 54, 54,
+	54, 54, 54, 54, 54, 54, 65, 65, 413, 413,
+	502, 502, 502, 63, 64, 396, 396, 396, 53, 52,
+	51, 50, 49, 49, 42, 42, 41, 41, 47, 128,
+	129, 279, 279, 279, 281, 281, 277, 501, 501, 368,
+	368, 280, 280, 40, 40, 40, 40, 66, 278, 278,
+	260, 276, 276, 276, 12, 12, 10, 15, 15, 15,
+	15, 15, 15, 15, 15, 15, 15, 15, 15, 23,
+	24, 26, 330, 330, 327, 25, 18, 17, 20, 16,
+	19, 21, 22, 22, 9, 9, 9, 9, 13, 13,
+	14, 141, 141, 190, 190, 473, 473, 469, 469, 470,
+	470, 470, 471, 471, 472, 472, 98, 407, 407, 407,
+	407, 407, 407, 8, 164, 164, 163, 163, 406, 406,
+	406, 406, 406, 406, 338, 338, 450, 450, 450, 451,
+	162, 162, 157, 157, 408, 408, 300, 452, 452, 416,
+	416, 415, 415, 414, 414, 160, 160, 161, 161, 144,
+	144, 108, 108, 421, 421, 421, 421, 429, 429, 393,
+	393, 217, 217, 255, 255, 256, 256, 134, 134, 135,
+	135, 135, 135, 135, 135, 458, 458, 460, 460, 459,
+	159, 159, 155, 155, 156, 156, 156, 154, 154, 153,
+	152, 152, 151, 149, 149, 149, 150, 150, 150, 137,
+	137, 137, 136, 136, 136, 136, 136, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 138,
+	138, 466, 466, 466, 398, 398, 398, 404, 404, 237,
+	237, 238, 238, 236, 236, 139, 139, 140, 140, 140,
+	140, 235, 235, 234, 142, 142, 148, 147, 147, 143,
+	143, 143, 143, 245, 245, 244, 244, 244, 244, 101,
+	106, 106, 107, 167, 167, 243, 242, 242, 242, 166,
+	166, 165, 165, 158, 158, 146, 146, 146, 146, 241,
+	145, 239, 490, 490, 489, 489, 488, 486, 486, 486,
+	487, 487, 487, 487, 443, 443, 443, 443, 443, 266,
+	266, 266, 270, 270, 269, 269, 269, 269, 269, 274,
+	7, 7, 7, 7, 7, 30, 30, 30, 30, 30,
+	30, 30, 30, 36, 175, 176, 37, 177, 177, 178,
+	178, 179, 179, 180, 181, 182, 182, 182, 182, 35,
+	168, 168, 169, 169, 170, 170, 171, 172, 172, 172,
+	174, 173, 34, 27, 474, 477, 475, 475, 479, 479,
+	479, 480, 480, 480, 481, 481, 28, 125, 130, 130,
+	127, 133, 133, 133, 133, 133, 126, 476, 482, 482,
+	482, 332, 332, 333, 333, 333, 333, 334, 334, 334,
+	335, 335, 335, 335, 331, 331, 328, 329, 329, 326,
+	325, 325, 325, 484, 484, 483, 483, 483, 483, 483,
+	267, 267, 29, 321, 321, 323, 324, 324, 324, 315,
+	315, 315, 315, 33, 319, 319, 320, 320, 320, 320,
+	320, 316, 316, 318, 318, 314, 314, 314, 314, 314,
+	32, 132, 132, 132, 131, 131, 313, 313, 311, 311,
+	309, 309, 310, 310, 308, 308, 308, 312, 312, 31,
+	31, 31, 110, 109, 109, 109, 258, 258, 257, 257,
+	111, 38, 202, 202, 382, 382, 382, 382, 382, 400,
+	400, 400, 383, 383, 383, 384, 384, 384, 385, 385,
+	385, 385, 385, 399, 399, 357, 357, 358, 358, 358,
+	361, 361, 374, 374, 375, 375, 373, 373, 380, 380,
+	379, 379, 378, 378, 377, 377, 376, 376, 376, 376,
+	371, 371, 370, 370, 359, 359, 359, 359, 359, 360,
+	360, 360, 369, 369, 372, 372, 208, 208, 209, 209,
+	209, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
+	233, 448, 448, 449, 211, 211, 211, 215, 215, 215,
+	215, 215, 215, 210, 210, 212, 212, 191, 191, 189,
+	189, 183, 183, 184, 184, 185, 185, 185, 188, 188,
+	186, 186, 187, 187, 187, 187, 343, 343, 446, 446,
+	447, 447, 442, 442, 442, 445, 445, 445, 445, 445,
+	444, 444, 192, 253, 253, 253, 268, 268, 268, 268,
+	252, 252, 252, 207, 207, 206, 206, 204, 204, 204,
+	204, 204, 204, 204, 204, 204, 204, 204, 204, 204,
+	204, 204, 342, 342, 283, 283, 284, 284, 224, 223,
+	223, 223, 223, 223, 221, 222, 220, 220, 220, 220,
+	220, 219, 219, 218, 218, 218, 317, 317, 216, 216,
+	214, 214, 214, 213, 213, 213, 381, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	291, 291, 291, 291, 291, 291, 291, 291, 291, 291,
+	291, 251, 292, 292, 297, 297, 457, 457, 456, 193,
+	193, 193, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 203, 203, 203, 366, 366, 366, 366, 366, 367,
+	367, 367, 364, 364, 365, 365, 301, 302, 302, 405,
+	405, 362, 362, 363, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 412, 412, 412, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	468, 468, 468, 453, 453, 453, 454, 454, 454, 454,
+	454, 454, 454, 454, 454, 454, 454, 454, 455, 455,
+	455, 455, 455, 455, 455, 455, 455, 455, 455, 455,
+	455, 455, 455, 455, 455, 249, 249, 249, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 303, 303, 304, 304, 409, 409, 409,
+	409, 409, 409, 410, 410, 411, 411, 411, 411, 403,
+	403, 403, 403, 403, 403, 403, 403, 403, 403, 403,
+	403, 403, 403, 403, 403, 403, 403, 403, 403, 403,
+	403, 403, 403, 403, 403, 403, 403, 403, 290, 246,
+	246, 246, 305, 298, 298, 299, 299, 293, 293, 293,
+	293, 293, 293, 293, 295, 295, 295, 295, 295, 295,
+	295, 295, 295, 295, 295, 288, 288, 288, 288, 288,
+	288, 288, 288, 288, 288, 288, 294, 294, 296, 296,
+	307, 307, 307, 306, 306, 306, 306, 306, 306, 306,
+	205, 205, 205, 205, 287, 287, 287, 287, 287, 287,
+	287, 287, 287, 287, 287, 195, 195, 195, 195, 199,
+	199, 201, 201, 201, 201, 201, 201, 201, 201, 201,
+	201, 201, 201, 201, 201, 200, 200, 200, 200, 198,
+	198, 198, 198, 198, 196, 196, 196, 196, 196, 196,
+	196, 196, 196, 196, 196, 196, 196, 196, 196, 196,
+	99, 100, 100, 197, 254, 254, 386, 386, 389, 389,
+	387, 387, 388, 390, 390, 390, 391, 391, 391, 392,
+	392, 392, 395, 395, 259, 259, 259, 265, 265, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 264,
+	264, 264, 264, 264, 264, 264, 264, 264, 264, 263,
+	263, 263, 263, 263, 263, 263, 263, 263, 263, 262,
+	262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
+	262, 262, 262, 262, 262, 262, 262, 262, 262, 262,
 }
 
 var yyR2 = [...]int{
@@ -6334,72 +6834,76 @@ var yyR2 = [...]int{
 	2, 3, 3, 4, 5, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 3, 2,
 	1, 1, 1, 1, 6, 1, 1, 1, 1, 1,
-	1, 7, 4, 1, 3, 2, 2, 2, 1, 2,
-	2, 2, 3, 2, 3, 1, 1, 7, 7, 8,
-	0, 4, 7, 0, 3, 0, 2, 0, 1, 1,
-	1, 1, 4, 2, 2, 3, 3, 4, 5, 3,
-	4, 4, 2, 2, 2, 3, 0, 1, 1, 1,
+	1, 7, 4, 4, 5, 3, 3, 3, 7, 6,
+	8, 8, 1, 3, 2, 4, 3, 4, 5, 5,
+	2, 2, 1, 0, 1, 0, 1, 1, 2, 2,
+	2, 2, 1, 3, 2, 3, 1, 1, 7, 7,
+	8, 0, 4, 7, 0, 3, 0, 2, 0, 1,
+	1, 1, 1, 4, 2, 2, 3, 3, 4, 5,
+	3, 4, 4, 2, 2, 2, 3, 0, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 4, 2, 5, 5,
-	0, 2, 7, 0, 1, 0, 1, 5, 5, 3,
-	3, 2, 4, 4, 4, 4, 4, 1, 1, 1,
-	3, 3, 1, 1, 1, 6, 8, 0, 1, 1,
-	1, 1, 5, 5, 0, 1, 1, 3, 3, 3,
-	4, 6, 7, 4, 4, 7, 8, 3, 3, 3,
-	0, 2, 2, 0, 2, 2, 1, 1, 1, 1,
-	0, 1, 4, 4, 5, 4, 3, 1, 3, 1,
-	1, 3, 5, 2, 3, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
-	4, 1, 3, 1, 4, 6, 4, 4, 4, 3,
-	6, 3, 5, 1, 1, 2, 2, 11, 8, 9,
-	1, 3, 2, 4, 0, 2, 0, 1, 1, 1,
-	1, 0, 1, 0, 1, 4, 2, 1, 5, 4,
-	4, 2, 5, 0, 2, 1, 3, 2, 1, 5,
-	4, 4, 2, 0, 5, 0, 1, 3, 3, 1,
-	3, 1, 3, 1, 3, 4, 0, 1, 0, 1,
-	1, 3, 1, 1, 0, 4, 1, 3, 2, 1,
-	0, 8, 0, 4, 7, 4, 0, 2, 0, 2,
-	0, 2, 0, 4, 1, 3, 1, 1, 4, 3,
-	4, 5, 4, 5, 2, 3, 1, 3, 6, 0,
-	3, 0, 1, 2, 4, 4, 0, 1, 3, 1,
-	3, 3, 0, 1, 1, 0, 2, 2, 3, 3,
-	3, 1, 3, 3, 3, 3, 1, 2, 2, 1,
-	2, 2, 1, 2, 2, 1, 2, 2, 7, 7,
-	1, 1, 1, 0, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 3, 1, 1, 1, 4, 4,
-	4, 3, 2, 2, 2, 3, 2, 3, 4, 1,
-	3, 4, 0, 2, 1, 1, 2, 2, 0, 1,
-	2, 4, 1, 3, 1, 3, 2, 3, 1, 4,
-	3, 0, 1, 1, 2, 5, 2, 2, 2, 0,
-	2, 3, 3, 0, 1, 3, 1, 3, 0, 1,
-	2, 1, 1, 0, 1, 2, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 4, 2, 5,
+	5, 0, 2, 7, 0, 1, 0, 1, 5, 5,
+	3, 3, 2, 4, 4, 4, 4, 4, 1, 1,
+	1, 3, 3, 1, 1, 1, 6, 8, 0, 1,
+	1, 1, 1, 5, 5, 0, 1, 1, 3, 3,
+	3, 4, 6, 7, 4, 4, 7, 8, 3, 3,
+	3, 0, 2, 2, 0, 2, 2, 1, 1, 1,
+	1, 0, 1, 4, 4, 5, 4, 3, 1, 3,
+	1, 1, 3, 5, 2, 3, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
+	4, 4, 1, 3, 1, 4, 6, 4, 4, 4,
+	3, 6, 3, 5, 1, 1, 2, 2, 11, 8,
+	9, 1, 3, 2, 4, 0, 2, 0, 1, 1,
+	1, 1, 0, 1, 0, 1, 4, 2, 1, 5,
+	4, 4, 2, 5, 0, 2, 1, 3, 2, 1,
+	5, 4, 4, 2, 0, 5, 0, 1, 3, 3,
+	1, 3, 1, 3, 1, 3, 4, 0, 1, 0,
+	1, 1, 3, 1, 1, 0, 4, 1, 3, 2,
+	1, 0, 8, 0, 4, 7, 4, 0, 2, 0,
+	2, 0, 2, 0, 4, 1, 3, 1, 1, 4,
+	3, 4, 5, 4, 5, 2, 3, 1, 3, 6,
+	0, 3, 0, 1, 2, 4, 4, 0, 1, 3,
+	1, 3, 3, 0, 1, 1, 0, 2, 2, 3,
+	3, 3, 1, 3, 3, 3, 3, 1, 2, 2,
+	1, 2, 2, 1, 2, 2, 1, 2, 2, 7,
+	7, 1, 1, 1, 0, 1, 1, 1, 1, 0,
+	2, 0, 3, 0, 2, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 3, 1, 1, 1, 4,
+	4, 4, 3, 2, 2, 2, 3, 2, 3, 4,
+	1, 3, 4, 0, 2, 1, 1, 2, 2, 0,
+	1, 2, 4, 1, 3, 1, 3, 2, 3, 1,
+	4, 3, 0, 1, 1, 2, 5, 2, 2, 2,
+	0, 2, 3, 3, 0, 1, 3, 1, 3, 0,
+	1, 2, 1, 1, 0, 1, 2, 1, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 7, 1, 1, 7, 1, 3, 0, 1,
-	1, 3, 1, 3, 0, 1, 1, 1, 12, 1,
-	3, 0, 1, 1, 3, 1, 1, 2, 4, 1,
-	1, 7, 7, 1, 4, 1, 1, 3, 4, 3,
-	0, 1, 1, 0, 2, 7, 8, 0, 2, 6,
-	0, 2, 2, 3, 3, 4, 1, 0, 2, 2,
-	1, 3, 2, 1, 3, 2, 1, 3, 2, 0,
-	1, 3, 4, 3, 1, 1, 4, 1, 3, 1,
-	1, 1, 1, 0, 1, 1, 1, 11, 0, 2,
-	3, 2, 3, 1, 1, 1, 3, 3, 4, 0,
-	2, 2, 2, 2, 6, 0, 4, 1, 1, 0,
-	3, 0, 1, 1, 2, 4, 4, 4, 0, 1,
-	11, 9, 11, 2, 2, 4, 5, 1, 3, 0,
-	3, 5, 10, 0, 2, 0, 3, 2, 4, 3,
-	0, 2, 1, 0, 2, 3, 0, 2, 3, 0,
-	3, 2, 4, 3, 0, 1, 0, 6, 0, 3,
-	5, 0, 4, 0, 3, 1, 3, 4, 5, 0,
-	3, 1, 3, 2, 3, 1, 2, 0, 4, 6,
-	5, 0, 2, 0, 2, 4, 5, 4, 5, 1,
-	5, 6, 5, 0, 3, 0, 1, 0, 1, 1,
-	3, 2, 3, 3, 4, 4, 3, 3, 3, 3,
-	4, 4, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 4, 5,
-	4, 1, 3, 3, 0, 2, 2, 1, 1, 1,
+	1, 1, 1, 7, 1, 1, 7, 1, 3, 0,
+	1, 1, 3, 1, 3, 0, 1, 1, 1, 12,
+	1, 3, 0, 1, 1, 3, 1, 1, 2, 4,
+	1, 1, 7, 7, 1, 4, 1, 1, 3, 4,
+	3, 0, 1, 1, 0, 2, 8, 8, 0, 2,
+	6, 0, 2, 2, 3, 3, 4, 1, 0, 2,
+	2, 0, 1, 2, 2, 2, 2, 1, 3, 2,
+	2, 2, 2, 2, 1, 3, 2, 1, 3, 2,
+	1, 3, 2, 0, 1, 3, 4, 3, 5, 5,
+	1, 1, 4, 1, 3, 1, 1, 1, 1, 0,
+	1, 1, 1, 11, 0, 2, 3, 2, 3, 1,
+	1, 1, 3, 3, 4, 0, 2, 2, 2, 2,
+	6, 0, 4, 5, 0, 2, 1, 1, 0, 3,
+	0, 1, 1, 2, 4, 4, 4, 0, 1, 11,
+	9, 11, 2, 2, 4, 5, 1, 3, 0, 3,
+	5, 10, 0, 2, 0, 3, 2, 4, 3, 0,
+	2, 1, 0, 2, 3, 0, 2, 3, 0, 3,
+	2, 4, 3, 0, 1, 0, 6, 0, 3, 5,
+	0, 4, 0, 3, 1, 3, 4, 5, 0, 3,
+	1, 3, 2, 3, 1, 2, 0, 4, 6, 5,
+	0, 2, 0, 2, 4, 5, 4, 5, 1, 5,
+	6, 5, 0, 3, 0, 1, 0, 1, 1, 3,
+	2, 3, 3, 4, 4, 3, 3, 3, 3, 4,
+	4, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 4, 5, 4,
+	7, 1, 3, 3, 0, 2, 2, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
 	3, 0, 1, 1, 3, 1, 1, 1, 7, 7,
 	2, 1, 7, 7, 8, 5, 0, 1, 0, 1,
@@ -6470,304 +6974,314 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -485, -489, -2, -5, 551, -1, -4, -100, -75,
+	-1000, -493, -497, -2, -5, 559, -1, -4, -100, -75,
 	-7, -102, -103, -8, -98, -9, -10, -12, -79, -93,
 	-95, -97, -96, -39, -11, -92, -70, -71, -81, -86,
-	-89, -90, -91, -104, -99, -101, -133, -105, 544, -76,
-	-77, -78, -30, -29, -28, -27, -125, 550, 408, 12,
-	456, -13, -14, -450, -15, 237, -267, -268, -269, -271,
-	-490, -40, -41, -42, -49, -50, -51, -52, -53, -63,
+	-89, -90, -91, -104, -99, -101, -134, -105, 552, -76,
+	-77, -78, -30, -29, -28, -27, -125, 558, 416, 12,
+	464, -13, -14, -458, -15, 240, -271, -272, -273, -275,
+	-498, -40, -41, -42, -49, -50, -51, -52, -53, -63,
 	-64, -65, -43, -44, -45, -48, -46, -56, -55, -57,
 	-58, -59, -60, -61, -62, -47, -128, -129, -66, -68,
-	-67, -73, -69, -74, -127, 212, -72, 61, -82, -83,
-	-84, -85, -87, -88, 339, 345, 398, 543, 46, -134,
-	-136, 554, 140, 141, 143, 144, -31, -32, -33, -34,
-	-35, -36, -38, -37, 208, 14, 489, -16, -19, -17,
-	-20, -18, -25, -26, -24, -21, -23, -126, -22, 232,
-	231, 37, 295, 296, 297, 343, 230, 209, 13, 30,
-	41, 321, -135, 67, 210, -137, 10, 556, -6, -3,
+	-67, -73, -69, -74, -127, 213, -72, 62, -82, -83,
+	-84, -85, -87, -88, 347, 353, 406, 551, 47, -135,
+	-137, 562, 141, 142, 144, 145, -31, -32, -33, -34,
+	-35, -36, -38, -37, 209, 14, 497, -16, -19, -17,
+	-20, -18, -25, -26, -24, -21, -23, -126, -22, 235,
+	234, 37, 301, 302, 303, 351, 233, 210, 13, 30,
+	41, 329, -136, 68, 211, -138, 10, 564, -6, -3,
 	-2, -112, -116, -120, -123, -124, -121, -122, -4, -100,
-	98, 222, 545, -264, 335, 546, 548, 547, 70, 78,
-	-258, -260, 339, 345, 543, 492, 493, 494, 495, 496,
-	497, 498, 499, 500, 501, 502, 503, 504, 505, 506,
-	507, 508, 509, 510, 511, 512, 513, 515, 516, 517,
-	518, 519, 520, 521, 522, 523, 524, 525, 526, 527,
-	528, 459, 530, 531, 532, 533, 319, 320, 486, 249,
-	273, 369, 276, 283, 140, 159, 153, 181, 173, 148,
-	253, 288, 254, 77, 143, 442, 88, 420, 395, 146,
-	268, 270, 272, 275, 255, 169, 164, 267, 251, 162,
-	256, 39, 257, 186, 258, 259, 491, 416, 318, 422,
-	280, 393, 163, 417, 271, 419, 188, 192, 434, 157,
-	158, 424, 436, 185, 229, 42, 488, 241, 437, 190,
-	184, 180, 445, 284, 282, 183, 156, 179, 252, 50,
-	194, 193, 195, 389, 390, 391, 392, 260, 261, 333,
-	433, 176, 165, 323, 149, 22, 440, 236, 421, 346,
-	262, 277, 285, 189, 191, 243, 248, 490, 397, 247,
-	281, 438, 161, 240, 269, 235, 441, 150, 348, 263,
-	144, 274, 435, 444, 49, 361, 155, 147, 226, 141,
-	245, 250, 11, 264, 265, 266, 287, 286, 278, 177,
-	242, 182, 167, 154, 178, 142, 244, 443, 362, 541,
-	321, 378, 175, 172, 246, 219, 439, 423, 145, 382,
-	363, 170, 289, 537, 538, 539, 334, 317, 290, 291,
-	168, 233, 414, 415, 292, 294, 388, 310, 364, 396,
-	370, 365, 201, 298, 426, 428, 187, 540, 299, 300,
-	301, 418, 302, 303, 304, 305, -340, -338, -264, 543,
-	345, 339, 321, -176, -264, 555, -143, 38, -188, -264,
-	-143, -72, -14, -13, -135, -136, -188, 216, -272, 23,
-	393, -80, 394, 212, 67, -264, -9, -8, -98, -70,
-	-133, -270, -264, 295, 295, -270, 216, -264, 247, 377,
-	-389, 222, -344, -317, 248, -343, -319, -346, -320, 31,
-	208, 210, 209, 244, 14, 343, 218, 12, 10, 344,
-	230, 24, 25, 27, 13, 345, 347, 28, 348, 351,
-	352, 353, 41, 356, 357, 237, 70, 78, 73, 255,
-	-175, -264, -295, -289, 95, 238, -291, -284, -285, -287,
-	-430, -424, -283, 67, 122, 123, 130, 96, -286, -373,
-	35, 98, 496, 457, -243, -244, -245, -246, -247, -264,
-	-425, -423, 73, 79, 82, 85, 86, 84, 83, 166,
-	81, 74, 134, 135, -136, 70, -445, 504, -259, 525,
-	530, 531, 532, 533, 524, 46, -395, -403, 215, -401,
-	133, 171, 233, 167, 12, 128, 388, 168, 501, 521,
-	459, 505, 528, 498, 499, 493, 494, 495, 497, 506,
-	508, 520, -404, 516, 526, 527, 514, 529, 72, 71,
-	519, 518, 507, 502, 503, 509, 492, 500, 510, 511,
-	517, 522, 523, 327, 88, 328, 329, 449, 322, 330,
-	222, 393, 55, 331, 332, 333, 334, 335, 456, 336,
-	56, 337, 326, 237, 378, 338, 170, 187, 461, 460,
-	462, 453, 450, 448, 451, 452, 454, 455, 512, 513,
-	515, -106, -107, 535, -152, -153, -236, 19, 6, 7,
-	8, 9, -483, 395, 490, 145, 142, -331, 145, 94,
-	150, 149, -331, 298, 243, 340, 219, 319, 378, 302,
-	247, -311, -309, -391, 292, 288, 217, 227, 226, 89,
-	449, 215, 354, -461, -462, 205, 206, 207, -452, 482,
-	-451, -264, 329, 26, 216, 340, 243, 428, 426, 427,
-	429, 430, 431, 432, -54, -405, -388, 423, 422, -276,
-	415, 421, 413, 425, 416, 320, 304, 303, 208, 552,
-	483, -255, 364, 396, 446, 447, 341, 397, 434, 435,
-	417, 88, 174, 171, 219, 216, 319, 449, 378, 302,
-	-461, 247, 377, 40, -337, 388, -336, -338, 434, 435,
-	445, 71, 72, 433, -255, 88, 414, 414, -153, -236,
-	-152, -134, -136, -101, -450, 217, 319, 378, 247, 218,
-	216, 219, 449, 295, 340, 243, 302, -390, -458, 31,
-	-396, 202, 203, 204, 32, 33, -1, 102, 556, -289,
-	-289, -6, 557, -6, -289, -264, -264, 137, -194, -198,
-	-195, -197, -196, -200, -199, 171, 172, 133, 175, 177,
-	178, 179, 180, 181, 182, 183, 184, 185, 186, 30,
-	187, 233, 167, 168, 169, 170, 188, 153, 173, 487,
-	196, 154, 197, 155, 198, 156, 199, 157, 158, 200,
-	159, 162, 163, 164, 161, 136, 67, 136, 73, -159,
-	239, -188, 136, -159, -153, -152, -153, -188, -248, -264,
-	395, 105, -80, -80, 394, -453, -454, -455, -457, 212,
-	394, 393, 136, 15, -270, -270, 65, -188, -319, 247,
-	-344, -317, 35, 64, 137, 220, 137, 64, 67, 341,
-	319, 378, 342, 449, 216, 354, 219, 247, 355, 319,
-	378, 216, 219, 449, 247, 319, 216, 219, 378, 247,
-	355, 413, 414, 219, 26, 346, 349, 350, 414, -409,
-	445, 137, 94, 91, 92, 93, -289, 112, -302, 105,
-	106, 107, 108, 109, 110, 111, 119, 118, 129, 122,
-	123, 124, 125, 126, 127, 128, 120, 121, 115, 95,
-	113, 117, 114, 97, -136, -289, -295, 46, -287, -287,
-	-287, -287, -373, -293, -289, 67, 67, 136, 73, -289,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, -402, 67, 67, -299, -300, 67, 67, -283,
-	-248, 67, 73, 73, 67, 67, 67, 73, -300, -300,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 67, 67, 67, 67, 67, -153, 137,
-	67, -108, 38, -155, 21, -137, -136, 20, 31, 32,
-	31, 32, 31, 32, 31, 32, 79, 438, 489, 148,
-	149, 150, -174, -264, -176, -167, -264, -307, 222, -307,
-	-307, -307, -307, 218, -307, 216, 216, 216, -307, -463,
-	152, 137, -452, -158, 67, -275, 113, 117, 23, 245,
-	245, 245, -275, 282, -491, -492, 15, 115, -273, -492,
-	-273, -273, -275, -493, 218, 424, 42, 246, 245, -154,
-	-155, -154, 418, 414, -360, 419, 420, -277, -492, -276,
-	-275, -275, -276, -275, -275, -275, 216, 219, 449, 302,
-	553, -491, -491, 30, 30, -389, -188, -389, 483, -256,
-	-264, -389, -389, -464, 221, -319, 247, 247, 137, 105,
-	23, -339, 105, 116, -338, -338, -338, -339, -339, -209,
-	40, -263, 133, -264, 73, -209, 40, -487, -486, -188,
-	-154, -137, -136, 68, 68, 68, -389, -389, -389, -389,
-	-389, -389, -389, -389, -270, -167, -176, 222, -389, -138,
-	-139, 124, -289, -264, -138, -3, -114, -113, 99, 100,
-	102, 546, 335, 545, 549, 543, -338, 40, -384, 362,
-	361, -378, -380, 67, -379, 67, -379, -379, -379, 67,
-	67, -381, 67, -381, -381, -378, -382, 67, -382, -383,
-	67, -382, -264, -294, -295, -264, 38, -398, 46, -133,
-	67, 30, 67, -264, -399, 46, -133, 67, 30, -154,
-	-108, -155, 136, 79, 73, -80, 68, 137, -456, 85,
-	86, -459, 185, 177, -264, -94, 73, -7, -8, -9,
-	-10, -39, -70, -133, -426, -424, 67, 31, 387, 64,
-	15, -345, 216, 449, 340, 243, 219, 319, -343, -326,
-	-323, -321, -263, -319, -322, -321, -348, -248, 414, -109,
-	399, 294, -289, -289, -289, -289, -289, 84, 95, 317,
-	85, 86, -284, -303, 31, 290, 291, -285, -285, -285,
-	-285, -285, -285, -285, -285, -285, -285, -285, -285, -292,
-	-301, -373, 67, 115, 113, 117, 114, 97, -287, -287,
-	-285, -285, 68, 137, -449, -448, 99, -289, -289, -264,
-	-446, -447, 463, 464, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 331, 326, 332, 330, 322, 338, 333,
-	334, 170, 480, 481, 474, 475, 476, 477, 478, 479,
-	-294, -294, -289, -446, -294, -242, 32, 31, -295, -295,
-	-295, 68, -289, -460, 315, 314, 316, -156, -264, -294,
-	68, 68, 68, 79, -295, -295, -294, -285, -294, -447,
-	-242, -242, -295, -295, -242, -242, -242, -242, 124, -242,
-	-242, -242, -242, -242, -242, -242, -242, 68, -154, -107,
-	-407, -406, -289, 40, 536, -108, -289, -151, -150, -289,
-	-484, 73, 439, 440, 146, 149, 148, 34, 67, 67,
-	136, -188, 95, -466, -264, -327, -324, -321, -264, -317,
-	-264, -264, -307, -307, -307, -188, -464, -451, 34, -157,
-	-264, -232, 16, -287, -287, -321, 247, -491, -275, -275,
-	-257, -256, -277, -272, -277, -277, -232, -492, -275, -275,
-	-277, -273, -232, -264, 414, -232, -232, -360, -274, -264,
-	-274, -307, -256, -257, -257, -188, -224, -225, 213, 210,
-	209, -229, 359, 249, -308, 253, 77, 254, 395, 255,
-	218, 257, 258, 259, 229, 260, 261, 262, 388, 263,
-	264, 265, 266, 342, 6, 305, 40, -466, -466, 30,
-	-327, -264, -146, -141, -145, -142, -147, -235, -237, -144,
-	67, -188, -136, -264, 31, 387, -342, 387, 31, -317,
-	-336, -332, 73, 388, -322, -341, 64, 133, -406, -339,
-	-339, -339, -341, -341, 132, 137, -488, 438, 439, 205,
-	-108, -190, -188, -466, -326, -317, -264, -190, -190, -264,
-	67, 35, -264, -231, 137, -230, 15, -265, -264, 34,
-	73, 136, -231, -115, -113, 101, -289, -6, 545, -289,
-	-6, -6, -289, -6, -289, -387, 363, 79, 79, -250,
-	73, -250, 79, 79, 79, 68, 73, -330, 64, -400,
-	-296, -444, 535, -161, 68, -156, -442, -443, -156, -160,
-	-264, -400, -161, 68, -442, -108, -108, -264, 23, -80,
-	-454, 136, 137, -157, -345, -325, -322, -347, 124, -264,
-	-333, 137, 489, 558, 71, 220, -476, -475, 379, 68,
-	137, -410, 221, 456, 73, 559, 201, 84, 317, 85,
-	86, -373, -295, -292, -287, -287, -285, -285, -290, 234,
-	-290, 94, -289, -288, -448, 101, -289, 34, 137, 65,
-	136, 68, 68, 15, 15, 68, -289, 68, 68, 68,
-	68, 15, 15, -289, 68, 136, 68, 68, 68, 68,
-	65, 68, 137, 68, 137, -295, -289, 68, 68, -289,
-	-289, -289, -295, 68, -289, -289, -289, -289, -289, -289,
-	-289, -289, -355, 409, 137, 68, 73, 137, 22, 137,
-	-148, 36, 37, 147, 150, 150, 149, -175, -177, -178,
-	-179, -180, -181, 115, 138, 139, -168, -169, -170, -171,
-	-194, -248, 172, 175, 177, 178, 179, 180, 181, 182,
-	183, 184, 185, 186, 187, 233, 167, 168, 169, 170,
-	188, 153, 173, 154, 155, 156, 157, 158, 159, 162,
-	163, 164, 161, -264, -158, 35, -469, 366, -477, 137,
-	40, -475, 449, -310, 65, -131, 15, -188, -188, -188,
-	-201, 34, 15, -140, -189, -264, 67, 68, 137, -289,
-	-318, 65, -264, -257, -232, -232, -188, -264, -232, -232,
-	-275, -277, -277, -273, 136, -256, -158, 137, -184, -191,
-	-185, -187, -248, -280, -186, 227, -439, 225, 223, 89,
-	228, 279, 90, 218, -226, 218, 90, 224, 228, 223,
-	-227, 218, -261, 105, -261, -255, 132, -261, -261, -261,
-	-261, 256, 256, -261, -261, -261, -261, -261, -261, -261,
-	-261, -261, -261, -261, -261, -261, -261, 67, -470, 366,
-	30, 325, -477, -132, 319, 30, -238, -239, -240, -241,
-	53, 57, 59, 54, 55, 56, 60, 30, 137, -262,
-	-266, 34, -264, 73, -262, -136, -141, -146, -262, 67,
-	220, 67, 105, -341, -341, -341, 40, -263, -486, 445,
-	439, 137, 64, -168, -176, -232, -139, -141, -264, 73,
-	-264, 124, -232, 102, -6, 100, -119, -118, -117, 103,
-	543, 549, 102, 102, 102, 68, 68, 68, 137, 68,
-	68, 68, 137, 68, 137, -413, 419, 39, 137, 67,
-	68, 137, 46, 137, 105, 68, 137, 68, 46, 136,
-	395, -264, -424, 68, -347, 137, 220, 136, 136, -323,
-	345, -263, -325, 20, 489, -248, 38, -254, -253, 73,
-	559, 68, -290, -290, 94, -287, -284, 68, 102, -289,
-	100, -192, -194, 361, 362, -193, -199, 133, 171, 233,
-	170, 169, 167, 361, 362, -209, -264, -289, -289, 68,
-	-289, -289, 15, -264, -209, -285, -289, -153, 68, -354,
-	-355, -354, 68, 68, 68, 68, -354, 68, 68, 68,
-	68, 68, 68, 68, 68, 67, -406, -413, -289, -289,
-	-150, -149, 43, 150, 353, 68, 137, -248, 68, 137,
-	-194, 34, -472, 421, 368, -261, -282, -281, 321, 41,
-	-393, 388, 374, 375, -324, 247, -264, 64, 309, 310,
-	311, 312, -305, -306, -304, -308, -466, 67, 67, 67,
-	-374, 299, -194, -188, -140, -264, 15, 137, -465, 136,
-	-1, -264, -317, -275, -232, -492, -232, -275, -275, -277,
-	-264, 34, -225, -194, -186, -438, -439, -307, -264, 90,
-	-438, 90, 67, -264, -264, -264, 90, 90, -264, 79,
-	79, -261, -261, 79, 73, 73, 73, -261, -261, 79,
-	73, -266, 79, 79, 79, 79, 40, 73, -214, 40,
-	267, 271, 268, 269, 270, 79, 40, 79, 40, 79,
-	40, -264, 67, -440, -441, 73, -472, -261, 325, 105,
-	-282, -130, 77, 31, -162, 213, 210, -466, -329, -328,
-	-248, -145, -145, -145, -145, 53, 53, 53, 58, 53,
-	58, 53, -240, -329, -147, -158, -266, 68, -482, -481,
-	-480, -478, 61, 221, 62, -294, -325, -278, 73, -332,
-	-188, -188, 68, -234, 17, 136, -234, 98, -6, -115,
-	-117, -289, -6, -289, 545, 335, 546, 73, 79, 79,
-	-421, 405, 400, 402, 90, -296, -408, -407, 46, -133,
-	-156, -400, -443, -406, -264, 46, -133, -400, -264, 79,
-	15, -322, -317, 124, 124, -264, 346, -333, 73, 367,
-	73, 216, 560, 137, 105, -254, -284, -289, 68, -202,
-	158, 157, -202, 68, -379, -379, -378, -381, -378, -202,
-	-202, 68, 68, 23, 68, 68, 68, -289, 68, 68,
-	137, -397, 458, -354, -354, -354, -354, -354, -354, -354,
-	-354, -354, -354, -354, -354, -354, -298, -297, 239, -421,
-	44, 45, 73, 73, -179, -194, 214, -170, 40, -133,
-	-473, 77, -467, 73, -264, -474, 77, 369, 135, 323,
-	40, 370, 371, 385, 318, 79, 79, 376, -468, -264,
-	-163, 319, -188, -304, -255, 132, 258, 302, -182, -183,
-	-184, -182, -182, -375, 301, 20, 79, 123, -159, 65,
-	136, -141, -189, -264, 124, 68, -232, -264, -232, -232,
-	-275, -133, -206, -205, -203, 84, 95, 40, 359, -204,
-	77, 132, 272, 250, 273, -223, -279, 64, 365, 223,
-	89, 90, 347, -280, -436, -264, -434, -436, -264, -434,
-	-434, -307, -289, -264, -228, 307, 308, -209, -209, 73,
-	73, -210, 250, -190, 68, 137, 105, -473, -467, 105,
-	73, -474, 73, 137, -162, -162, -232, 137, 105, -165,
-	-164, 64, 65, -166, 64, -164, 53, 53, -232, -480,
-	-479, 23, -439, -439, -439, 68, 68, -233, 18, 20,
-	124, -233, 102, 100, 102, 102, -264, 68, 68, -385,
-	537, -417, -419, 400, 20, 20, 13, 68, -400, -400,
-	-317, -333, 388, -188, -253, 73, 560, -289, 68, -289,
-	68, 73, -153, 20, -385, -172, -194, -283, 73, -471,
-	379, 73, 73, 79, 40, 79, 135, 372, -394, -130,
-	-162, 67, -261, -261, -261, -264, 68, 137, 68, 68,
-	-376, 238, 79, 123, 79, 123, 79, -262, -141, -264,
-	-232, -465, 136, -232, -232, -203, 84, -285, 73, -211,
-	-263, 133, -212, 40, 271, 267, -213, 40, 251, 252,
-	-215, 67, 279, 13, 90, 90, -188, 67, 67, 65,
-	289, 67, 67, -436, 68, 251, 252, 68, -441, 73,
-	-471, 73, -466, -153, -328, -406, -289, 67, -289, 67,
-	53, 19, 17, -289, -295, 222, -6, 546, 335, -216,
-	538, 73, 20, 73, -415, 73, -329, -111, -411, -360,
-	68, 68, -354, -357, -356, -359, 406, 281, 412, -295,
-	-216, 488, 20, 489, 322, 40, 79, 40, 373, -312,
-	-314, -248, 67, -209, -211, 73, -207, -208, -229, -184,
-	-110, -109, -207, -377, 141, 79, 123, 79, 79, -232,
-	-232, 79, 67, -401, -313, 67, -312, -312, -437, 309,
-	310, 311, 313, 312, -437, -312, -312, 67, -335, -334,
-	280, 95, -154, -157, -435, -264, 223, 20, 20, -264,
-	-264, -251, 539, 79, 73, 402, -386, 540, -420, 405,
-	-414, -412, 400, 401, 402, 403, 68, -358, 97, 372,
-	376, -289, -251, -173, -264, 73, 367, 73, 322, 68,
-	137, -378, -289, -349, 239, 137, -229, -111, -349, -392,
-	149, 300, 489, 79, 123, 79, -153, 68, -289, -299,
-	-218, -217, 485, -312, 68, 68, 68, 68, -312, 280,
-	68, 68, 137, 67, 20, 73, -422, 221, -418, -419,
-	404, -412, 20, 402, 20, 20, -358, 535, 410, 411,
-	410, 411, 34, 388, -315, -314, -148, 68, -350, 288,
-	20, -229, -350, 300, 79, 123, 79, -154, 68, -222,
-	-220, -221, 64, 417, 277, 278, 68, -315, -315, -315,
-	-315, 68, -264, 223, -252, -264, -415, -429, 67, 79,
-	-417, -416, -418, 20, -415, 20, -415, -415, 94, 73,
-	-316, 229, 77, 489, 307, 308, -148, 20, -351, 281,
-	282, -352, -364, 284, 79, -334, -221, 64, -220, 64,
-	14, 13, -223, 68, 137, -433, 30, 68, -428, -427,
-	-249, -423, -264, 405, 406, 73, -415, -358, -261, 73,
-	306, -248, 67, -362, 285, 67, -360, 67, -360, 90,
-	310, -219, 274, 275, 30, 149, -219, -264, -432, -431,
-	-430, 68, 137, 136, 79, -264, -348, -353, 286, 79,
-	-285, 67, -285, 67, -361, 283, 67, 84, 40, 276,
-	137, 105, -427, -264, 68, -366, 67, 20, 68, -348,
-	68, -348, 67, 105, -285, -431, 40, -289, 136, -367,
-	-365, 239, -352, 68, 68, 68, -348, 79, 68, -264,
-	68, 137, -264, -363, 287, 68, -365, -368, 46, 79,
-	-372, -369, 67, -229, 241, 115, -372, -229, -371, -370,
-	286, 242, 67, 68, 137, -264, 238, 67, -295, -370,
-	-369, -295, 68, 68,
+	99, 225, 553, -268, 343, 554, 556, 555, 71, 79,
+	-262, -264, 347, 353, 551, 500, 501, 502, 503, 504,
+	505, 506, 507, 508, 509, 510, 511, 512, 513, 514,
+	515, 516, 517, 518, 519, 520, 521, 523, 524, 525,
+	526, 527, 528, 529, 530, 531, 532, 533, 534, 535,
+	536, 467, 538, 539, 540, 541, 327, 328, 46, 494,
+	255, 279, 377, 282, 289, 141, 160, 154, 182, 174,
+	149, 259, 294, 260, 78, 144, 450, 89, 428, 403,
+	147, 274, 276, 278, 281, 261, 170, 165, 273, 257,
+	163, 262, 39, 263, 187, 264, 265, 248, 499, 424,
+	326, 430, 286, 401, 164, 425, 277, 427, 189, 193,
+	442, 158, 159, 432, 444, 186, 232, 42, 496, 244,
+	445, 191, 185, 181, 453, 290, 288, 184, 157, 180,
+	258, 51, 216, 195, 194, 196, 397, 398, 399, 400,
+	266, 267, 341, 441, 177, 166, 331, 150, 22, 448,
+	239, 429, 354, 268, 283, 291, 190, 192, 246, 254,
+	498, 405, 253, 287, 446, 162, 243, 275, 238, 449,
+	151, 356, 269, 145, 280, 443, 452, 50, 369, 156,
+	148, 229, 142, 251, 256, 11, 270, 271, 272, 293,
+	292, 284, 178, 245, 183, 168, 155, 179, 143, 247,
+	451, 370, 549, 329, 386, 176, 173, 249, 252, 222,
+	250, 447, 431, 146, 390, 371, 171, 295, 545, 546,
+	547, 342, 325, 296, 297, 169, 236, 422, 423, 298,
+	300, 396, 318, 372, 404, 378, 373, 202, 304, 434,
+	436, 188, 548, 305, 306, 307, 426, 308, 309, 310,
+	312, 313, 311, -348, -346, -268, 551, 353, 347, 329,
+	-177, -268, 563, -144, 38, -189, -268, -144, -72, -14,
+	-13, -136, -137, -189, 219, -276, 23, 401, -80, 402,
+	213, 68, -268, -9, -8, -98, -70, -134, -274, -268,
+	301, 301, -274, 219, -268, 253, 385, -397, 225, -352,
+	-321, 254, -351, -323, -354, -324, 31, 209, 211, 210,
+	247, 14, 351, 221, 12, 10, 352, 233, 24, 25,
+	27, 13, 353, 355, 28, 356, 359, 360, 361, 41,
+	364, 365, 240, 71, 79, 74, 261, -176, -268, -299,
+	-293, 96, 241, -295, -288, -289, -291, -438, -432, -287,
+	68, 123, 124, 131, 97, -290, -381, 35, 99, 504,
+	465, -247, -248, -249, -250, -251, -268, -433, -431, 74,
+	80, 83, 86, 87, 85, 84, 167, 82, 75, 135,
+	136, -137, 71, -453, 512, -263, 533, 538, 539, 540,
+	541, 532, 47, -403, -411, 218, -409, 134, 172, 236,
+	168, 12, 129, 396, 169, 509, 529, 467, 513, 536,
+	506, 507, 501, 502, 503, 505, 514, 516, 528, -412,
+	524, 534, 535, 522, 537, 73, 72, 527, 526, 515,
+	510, 511, 517, 500, 508, 518, 519, 525, 530, 531,
+	335, 89, 336, 337, 457, 330, 338, 225, 401, 56,
+	339, 340, 341, 342, 343, 464, 344, 57, 345, 334,
+	240, 386, 346, 171, 188, 469, 468, 470, 461, 458,
+	456, 459, 460, 462, 463, 520, 521, 523, -106, -107,
+	543, -153, -154, -240, 19, 6, 7, 8, 9, -491,
+	403, 498, 146, 143, -339, 146, 95, 151, 150, -339,
+	304, 246, 348, 222, 327, 386, 308, 253, -315, -313,
+	-399, 298, 294, 220, 230, 229, 90, 457, 218, 362,
+	-469, -470, 206, 207, 208, -460, 490, -459, -268, 337,
+	26, 219, 348, 246, 436, 434, 435, 437, 438, 439,
+	440, -54, -413, -396, 431, 430, -280, 423, 429, 421,
+	433, 424, 328, 310, 309, 209, 560, 491, -259, 372,
+	404, 454, 455, 349, 405, 442, 443, 425, 89, 175,
+	172, 222, 219, 327, 457, 386, 308, -469, 253, 385,
+	40, -345, 396, -344, -346, 442, 443, 453, 72, 73,
+	441, -259, 89, 422, 422, -154, -240, -153, -135, -137,
+	-101, -458, 220, 327, 386, 253, 221, 219, 222, 457,
+	301, 348, 246, 308, -398, -466, 31, -404, 203, 204,
+	205, 32, 33, -1, 103, 564, -293, -293, -6, 565,
+	-6, -293, -268, -268, 138, -195, -199, -196, -198, -197,
+	-201, -200, 172, 173, 134, 176, 178, 179, 180, 181,
+	182, 183, 184, 185, 186, 187, 30, 188, 236, 168,
+	169, 170, 171, 189, 154, 174, 495, 197, 155, 198,
+	156, 199, 157, 200, 158, 159, 201, 160, 163, 164,
+	165, 162, 137, 68, 137, 74, -160, 242, -189, 137,
+	-160, -154, -153, -154, -189, -252, -268, 403, 106, -80,
+	-80, 402, -461, -462, -463, -465, 213, 402, 401, 137,
+	15, -274, -274, 66, -189, -323, 253, -352, -321, 35,
+	65, 138, 223, 138, 65, 68, 349, 327, 386, 350,
+	457, 219, 362, 222, 253, 363, 327, 386, 219, 222,
+	457, 253, 327, 219, 222, 386, 253, 363, 421, 422,
+	222, 26, 354, 357, 358, 422, -417, 453, 138, 95,
+	92, 93, 94, -293, 113, -306, 106, 107, 108, 109,
+	110, 111, 112, 120, 119, 130, 123, 124, 125, 126,
+	127, 128, 129, 121, 122, 116, 96, 114, 118, 115,
+	98, -137, -293, -299, 47, -291, -291, -291, -291, -381,
+	-297, -293, 68, 68, 137, 74, -293, 68, 68, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, -410,
+	68, 68, -303, -304, 68, 68, -287, -252, 68, 74,
+	74, 68, 68, 68, 74, -304, -304, 68, 68, 68,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+	68, 68, 68, 68, 68, -154, 138, 68, -108, 38,
+	-156, 21, -138, -137, 20, 31, 32, 31, 32, 31,
+	32, 31, 32, 80, 446, 497, 149, 150, 151, -175,
+	-268, -177, -168, -268, -311, 225, -311, -311, -311, -311,
+	221, -311, 219, 219, 219, -311, -471, 153, 138, -460,
+	-159, 68, -279, 114, 118, 23, 251, 251, 251, -279,
+	288, -500, -501, 15, 116, -277, -501, -277, -277, -279,
+	-502, 221, 432, 42, 252, 251, -155, -156, -155, 426,
+	422, -368, 427, 428, -281, -501, -280, -279, -279, -280,
+	-279, -279, -279, 219, 222, 457, 308, 561, -500, -500,
+	30, 30, -397, -189, -397, 491, -260, -268, -397, -397,
+	-472, 224, -323, 253, 253, 138, 106, 23, -347, 106,
+	117, -346, -346, -346, -347, -347, -210, 40, -267, 134,
+	-268, 74, -210, 40, -495, -494, -189, -155, -138, -137,
+	69, 69, 69, -397, -397, -397, -397, -397, -397, -397,
+	-397, -274, -168, -177, 225, -397, -139, -140, 125, -293,
+	-268, -139, -3, -114, -113, 100, 101, 103, 554, 343,
+	553, 557, 551, -346, 40, -392, 370, 369, -386, -388,
+	68, -387, 68, -387, -387, -387, 68, 68, -389, 68,
+	-389, -389, -386, -390, 68, -390, -391, 68, -390, -268,
+	-298, -299, -268, 38, -406, 47, -134, 68, 30, 68,
+	-268, -407, 47, -134, 68, 30, -155, -108, -156, 137,
+	80, 74, -80, 69, 138, -464, 86, 87, -467, 186,
+	178, -268, -94, 74, -7, -8, -9, -10, -39, -70,
+	-134, -434, -432, 68, 31, 395, 65, 15, -353, 219,
+	457, 348, 246, 222, 327, -351, -330, -327, -325, -267,
+	-323, -326, -325, -356, -252, 422, -109, 407, 300, -293,
+	-293, -293, -293, -293, 85, 96, 325, 86, 87, -288,
+	-307, 31, 296, 297, -289, -289, -289, -289, -289, -289,
+	-289, -289, -289, -289, -289, -289, -296, -305, -381, 68,
+	116, 114, 118, 115, 98, -291, -291, -289, -289, 69,
+	138, -457, -456, 100, -293, -293, -268, -454, -455, 471,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	339, 334, 340, 338, 330, 346, 341, 342, 171, 488,
+	489, 482, 483, 484, 485, 486, 487, -298, -298, -293,
+	-454, -298, -246, 32, 31, -299, -299, -299, 69, -293,
+	-468, 323, 322, 324, -157, -268, -298, 69, 69, 69,
+	80, -299, -299, -298, -289, -298, -455, -246, -246, -299,
+	-299, -246, -246, -246, -246, 125, -246, -246, -246, -246,
+	-246, -246, -246, -246, 69, -155, -107, -415, -414, -293,
+	40, 544, -108, -293, -152, -151, -293, -492, 74, 447,
+	448, 147, 150, 149, 34, 68, 68, 137, -189, 96,
+	-474, -268, -331, -328, -325, -268, -321, -268, -268, -311,
+	-311, -311, -189, -472, -459, 34, -158, -268, -236, 16,
+	-291, -291, -325, 253, -500, -279, -279, -261, -260, -281,
+	-276, -281, -281, -236, -501, -279, -279, -281, -277, -236,
+	-268, 422, -236, -236, -368, -278, -268, -278, -311, -260,
+	-261, -261, -189, -225, -231, -226, 214, 211, 240, 243,
+	248, 216, 217, 212, 210, -233, 367, 255, -312, 259,
+	78, 260, 403, 261, 221, 263, 264, 265, 232, 266,
+	267, 268, 396, 269, 270, 271, 272, 350, 6, 312,
+	313, 40, -474, -474, 30, -331, -268, -147, -142, -146,
+	-143, -148, -239, -241, -145, 68, -189, -137, -268, 31,
+	395, -350, 395, 31, -321, -344, -340, 74, 396, -326,
+	-349, 65, 134, -414, -347, -347, -347, -349, -349, 133,
+	138, -496, 446, 447, 206, -108, -191, -189, -474, -330,
+	-321, -268, -191, -191, -268, 68, 35, -268, -235, 138,
+	-234, 15, -269, -268, 34, 74, 137, -235, -115, -113,
+	102, -293, -6, 553, -293, -6, -6, -293, -6, -293,
+	-395, 371, 80, 80, -254, 74, -254, 80, 80, 80,
+	69, 74, -338, 65, -408, -300, -452, 543, -162, 69,
+	-157, -450, -451, -157, -161, -268, -408, -162, 69, -450,
+	-108, -108, -268, 23, -80, -462, 137, 138, -158, -353,
+	-329, -326, -355, 125, -268, -341, 138, 497, 566, 72,
+	223, -484, -483, 387, 69, 138, -418, 224, 464, 74,
+	567, 202, 85, 325, 86, 87, -381, -299, -296, -291,
+	-291, -289, -289, -294, 237, -294, 95, -293, -292, -456,
+	102, -293, 34, 138, 66, 137, 69, 69, 15, 15,
+	69, -293, 69, 69, 69, 69, 15, 15, -293, 69,
+	137, 69, 69, 69, 69, 66, 69, 138, 69, 138,
+	-299, -293, 69, 69, -293, -293, -293, -299, 69, -293,
+	-293, -293, -293, -293, -293, -293, -293, -363, 417, 138,
+	69, 74, 138, 22, 138, -149, 36, 37, 148, 151,
+	151, 150, -176, -178, -179, -180, -181, -182, 116, 139,
+	140, -169, -170, -171, -172, -195, -252, 173, 176, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	236, 168, 169, 170, 171, 189, 154, 174, 155, 156,
+	157, 158, 159, 160, 163, 164, 165, 162, -268, -159,
+	35, -477, 374, -485, 138, 40, -483, 457, -314, 66,
+	-132, 15, -189, -189, -189, -202, 34, 15, -141, -190,
+	-268, 68, 69, 138, -293, -322, 66, -268, -261, -236,
+	-236, -189, -268, -236, -236, -279, -281, -281, -277, 137,
+	-260, -159, 138, 242, -185, 227, -192, -186, -188, -252,
+	-284, -187, 230, -447, 228, 226, 90, 231, 285, 91,
+	221, 242, -227, 221, 91, 227, -268, 231, 226, 242,
+	242, 242, -499, 227, -499, 227, -228, 221, -265, 106,
+	-265, -259, 133, -265, -265, -265, -265, 262, 262, -265,
+	-265, -265, -265, -265, -265, -265, -265, -265, -265, -265,
+	-265, -265, -265, 68, -265, -478, 374, 30, 333, -485,
+	-133, 327, 30, -242, -243, -244, -245, 54, 58, 60,
+	55, 56, 57, 61, 30, 138, -266, -270, 34, -268,
+	74, -266, -137, -142, -147, -266, 68, 223, 68, 106,
+	-349, -349, -349, 40, -267, -494, 453, 447, 138, 65,
+	-169, -177, -236, -140, -142, -268, 74, -268, 125, -236,
+	103, -6, 101, -119, -118, -117, 104, 551, 557, 103,
+	103, 103, 69, 69, 69, 138, 69, 69, 69, 138,
+	69, 138, -421, 427, 39, 138, 68, 69, 138, 47,
+	138, 106, 69, 138, 69, 47, 137, 403, -268, -432,
+	69, -355, 138, 223, 137, 137, -327, 353, -267, -329,
+	20, 497, -252, 38, -258, -257, 74, 567, 69, -294,
+	-294, 95, -291, -288, 69, 103, -293, 101, -193, -195,
+	369, 370, -194, -200, 134, 172, 236, 171, 170, 168,
+	369, 370, -210, -268, -293, -293, 69, -293, -293, 15,
+	-268, -210, -289, -293, -154, 69, -362, -363, -362, 69,
+	69, 69, 69, -362, 69, 69, 69, 69, 69, 69,
+	69, 69, 68, -414, -421, -293, -293, -151, -150, 43,
+	151, 361, 69, 138, -252, 69, 138, -195, 34, -480,
+	429, 376, -265, -332, -333, 393, -328, 253, -268, 65,
+	317, 318, 319, 320, -309, -310, -308, -312, -474, 74,
+	68, 68, 68, -382, 305, -195, -189, -141, -268, 15,
+	138, -473, 137, -1, -268, -321, -279, -236, -501, -236,
+	-279, -279, -281, -268, 34, -226, 214, 211, 68, -192,
+	-229, 44, 46, -195, -187, -446, -447, -311, -268, 91,
+	-446, 91, 68, -161, -268, -268, -268, 91, 91, -161,
+	31, -161, -268, -192, -252, -252, -268, 80, 80, -265,
+	-265, 80, 74, 74, 74, -265, -265, 80, 74, -270,
+	80, 80, 80, 80, 40, 74, -215, 40, 273, 277,
+	274, 275, 276, 80, 40, 80, 40, 80, 40, -268,
+	68, -448, -449, 74, -252, -480, -265, 333, 106, -286,
+	-285, 329, 41, -401, 396, 382, 383, -130, 78, 31,
+	-163, 214, 211, -474, -337, -336, -252, -146, -146, -146,
+	-146, 54, 54, 54, 59, 54, 59, 54, -244, -337,
+	-148, -159, -270, 69, -490, -489, -488, -486, 62, 224,
+	63, -298, -329, -282, 74, -340, -189, -189, 69, -238,
+	17, 137, -238, 99, -6, -115, -117, -293, -6, -293,
+	553, 343, 554, 74, 80, 80, -429, 413, 408, 410,
+	91, -300, -416, -415, 47, -134, -157, -408, -451, -414,
+	-268, 47, -134, -408, -268, 80, 15, -326, -321, 125,
+	125, -268, 354, -341, 74, 375, 74, -267, 219, 568,
+	138, 106, -258, -288, -293, 69, -203, 159, 158, -203,
+	69, -387, -387, -386, -389, -386, -203, -203, 69, 69,
+	23, 69, 69, 69, -293, 69, 69, 138, -405, 466,
+	-362, -362, -362, -362, -362, -362, -362, -362, -362, -362,
+	-362, -362, -362, -302, -301, 242, -429, 44, 45, 74,
+	74, -180, -195, 215, -171, 40, -134, -481, 78, -475,
+	74, -268, -286, 395, 394, 390, -334, -335, 389, 391,
+	388, 392, -476, -268, -164, 327, -189, -308, -259, 133,
+	264, 308, 308, -183, -184, -185, -192, -183, -183, -383,
+	307, 20, 80, 124, -160, 66, 137, -142, -190, -268,
+	125, 69, -236, -268, -236, -236, -279, -134, -375, -373,
+	242, -230, -229, -252, -207, -206, -204, 85, 96, 40,
+	367, -205, 78, 133, 278, 256, 279, -224, -283, 65,
+	373, 226, 90, 91, 355, -284, -444, -268, -442, -444,
+	-268, -442, -442, -311, -293, -268, 38, 497, -230, -192,
+	223, -232, 315, 316, -210, -210, 74, 74, -211, 256,
+	-191, 69, 138, 106, 123, -481, -475, 106, 74, -482,
+	78, 377, 136, 331, 40, 378, 379, 393, 326, 80,
+	80, 384, 74, 138, -163, -163, -236, 138, 106, -166,
+	-165, 65, 66, -167, 65, -165, 54, 54, -236, -488,
+	-487, 23, -447, -447, -447, 69, 69, -237, 18, 20,
+	125, -237, 103, 101, 103, 103, -268, 69, 69, -393,
+	545, -425, -427, 408, 20, 20, 13, 69, -408, -408,
+	-321, -341, 396, 20, 34, -189, -257, 74, 568, -293,
+	69, -293, 69, 74, -154, 20, -393, -173, -195, -287,
+	74, -479, 387, -482, 95, -335, 74, 74, 74, 74,
+	-130, -163, 68, -265, -265, -265, -268, -268, 69, 138,
+	69, 69, -384, 241, 80, 124, 80, 124, 80, -266,
+	-142, -268, -236, -473, 137, -236, -236, 69, 138, -268,
+	-204, 85, -289, 74, -212, -267, 134, -213, 40, 277,
+	273, -214, 40, 257, 258, -216, 68, 285, 13, 91,
+	91, -189, 68, 68, 66, 295, 68, 68, -444, 69,
+	68, 219, -230, -252, 257, 258, 69, -449, 74, 136,
+	-479, 74, 74, 74, 80, 40, 80, 136, 380, -402,
+	-474, -154, -336, -414, -293, 68, -293, 68, 54, 19,
+	17, -293, -299, 225, -6, 554, 343, -217, 546, 74,
+	20, 74, -423, 74, -337, 74, 74, -111, -419, -368,
+	69, 69, -362, -365, -364, -367, 414, 287, 420, -299,
+	-217, 496, 20, 497, -335, -316, -318, -252, 68, -210,
+	-212, 74, -131, 311, -208, -209, -233, -185, -110, -109,
+	-208, -385, 142, 80, 124, 80, 80, -236, -236, -373,
+	-376, 47, 80, 68, -409, -317, 68, -316, -316, -445,
+	317, 318, 319, 321, 320, -445, -316, -316, 68, -343,
+	-342, 286, 96, -375, -189, 80, 330, 40, 80, 40,
+	381, -155, -158, -443, -268, 226, 20, 20, -268, -268,
+	-255, 547, 80, 74, 410, -394, 548, -428, 413, -422,
+	-420, 408, 409, 410, 411, 69, -366, 98, 380, 384,
+	-293, -255, -174, -268, 74, 375, 74, 69, 138, -386,
+	-293, -268, -357, 242, 138, -233, -111, -357, -400, 150,
+	306, 497, 80, 124, 80, -154, -380, -377, 68, -233,
+	244, 116, 69, -293, -303, -219, -218, 493, -316, 69,
+	69, 69, 69, -316, 286, 69, 497, 250, -454, 330,
+	69, 69, 138, 68, 20, 74, -430, 224, -426, -427,
+	412, -420, 20, 410, 20, 20, -366, 543, 418, 419,
+	418, 419, 34, 396, -319, -318, -149, 69, -358, 294,
+	20, -233, -358, 306, 80, 124, 80, -155, -380, -233,
+	-379, -378, 292, 245, 68, 69, -223, -221, -222, 65,
+	425, 283, 284, 69, -319, -319, -319, -319, 69, 249,
+	249, -268, 226, -256, -268, -423, -437, 68, 80, -425,
+	-424, -426, 20, -423, 20, -423, -423, 95, 74, -320,
+	232, 78, 497, 315, 316, -149, 20, -359, 287, 288,
+	-360, -372, 290, 80, 69, 138, -268, 241, 68, -299,
+	-342, -222, 65, -221, 65, 14, 13, -224, 69, 138,
+	-441, 30, 69, -436, -435, -253, -431, -268, 413, 414,
+	74, -423, -366, -265, 74, 314, -252, 68, -370, 291,
+	68, -368, 68, -368, 91, 318, -378, -377, -299, 69,
+	-220, 280, 281, 30, 150, -220, -268, -440, -439, -438,
+	69, 138, 137, 80, -268, -356, -361, 292, 80, -289,
+	68, -289, 68, -369, 289, 68, 69, 85, 40, 282,
+	138, 106, -435, -268, 69, -374, 68, 20, 69, -356,
+	69, -356, 68, 106, -289, -439, 40, -293, 137, -375,
+	-360, 69, 69, 69, -356, 80, 69, -268, 69, -371,
+	293, 69, 80,
 }
 
 var yyDef = [...]int{
@@ -6775,312 +7289,322 @@ var yyDef = [...]int{
 	24, 25, 26, 27, 28, 29, 30, 31, 32, 33,
 	34, 35, 36, 37, 38, 39, 40, 41, 42, 43,
 	44, 45, 46, 47, 48, 49, 50, 51, 0, 245,
-	246, 247, 749, 750, 751, 752, 753, 0, 0, 0,
-	0, 523, 524, 0, 495, 0, 0, 0, 0, 0,
-	0, 388, 389, 390, 391, 392, 393, 394, 395, 396,
-	397, 398, 399, 400, 401, 402, 403, 404, 405, 406,
-	407, 408, 409, 410, 411, 412, 413, 414, 415, 335,
+	246, 247, 770, 771, 772, 773, 774, 0, 0, 0,
+	0, 544, 545, 0, 516, 0, 0, 0, 0, 0,
+	0, 409, 410, 411, 412, 413, 414, 415, 416, 417,
+	418, 419, 420, 421, 422, 423, 424, 425, 426, 427,
+	428, 429, 430, 431, 432, 433, 434, 435, 436, 335,
 	336, 337, 338, 339, 340, 0, 270, 266, 206, 207,
-	208, 209, 277, 278, 365, 0, 0, 0, 0, 606,
-	-2, 53, 259, 0, 250, 250, 754, 755, 756, 757,
-	758, 759, 760, 761, -2, 536, 0, 496, 497, 498,
-	499, 500, 501, 502, 503, 504, 505, 506, 507, 324,
-	325, 326, 320, 321, 323, 322, -2, 0, 536, 0,
-	0, 0, 626, 0, 0, 641, 663, 23, 0, 7,
+	208, 209, 277, 278, 386, 0, 0, 0, 0, 627,
+	-2, 53, 259, 0, 250, 250, 775, 776, 777, 778,
+	779, 780, 781, 782, -2, 557, 0, 517, 518, 519,
+	520, 521, 522, 523, 524, 525, 526, 527, 528, 324,
+	325, 326, 320, 321, 323, 322, -2, 0, 557, 0,
+	0, 0, 647, 0, 0, 662, 684, 23, 0, 7,
 	9, 10, 11, 12, 13, 14, 15, 16, 17, 18,
-	0, 0, 19, 0, 19, 0, 0, 0, 1046, 1047,
-	1048, 1049, -2, 1513, 1492, 1662, 1663, 1664, 1665, 1666,
-	1667, 1668, 1669, 1670, 1671, 1672, 1673, 1674, 1675, 1676,
-	1677, 1678, 1679, 1680, 1681, 1682, 1683, 1684, 1685, 1686,
-	1687, 1688, 1689, 1690, 1691, 1692, 1693, 1694, 1695, 1696,
-	1697, 1698, 1699, 1700, 1701, 1702, 1459, 1460, 1461, 1462,
-	1463, 1464, 1465, 1466, 1467, 1468, 1469, 1470, 1471, 1472,
-	1473, 1474, 1475, 1476, 1477, 1478, 1479, 1480, 1481, 1482,
-	1483, 1484, 1485, 1486, 1487, 1488, 1489, 1490, 1491, 1493,
-	1494, 1495, 1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503,
-	1504, 1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512, 1514,
-	1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524,
-	1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534,
-	1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544,
-	1545, 1546, 1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554,
-	1555, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564,
-	1565, 1566, 1567, 1568, 1569, 1570, 1571, 1572, 1574, 1575,
-	1576, 1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585,
-	1586, 1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595,
-	1596, 1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605,
-	1606, 1607, 1608, 1609, 1610, 1611, 1612, 1613, 1614, 1615,
-	1616, 1617, 1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625,
+	0, 0, 19, 0, 19, 0, 0, 0, 1086, 1087,
+	1088, 1089, -2, 1555, 1533, 1709, 1710, 1711, 1712, 1713,
+	1714, 1715, 1716, 1717, 1718, 1719, 1720, 1721, 1722, 1723,
+	1724, 1725, 1726, 1727, 1728, 1729, 1730, 1731, 1732, 1733,
+	1734, 1735, 1736, 1737, 1738, 1739, 1740, 1741, 1742, 1743,
+	1744, 1745, 1746, 1747, 1748, 1749, 1499, 1500, 1501, 1502,
+	1503, 1504, 1505, 1506, 1507, 1508, 1509, 1510, 1511, 1512,
+	1513, 1514, 1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522,
+	1523, 1524, 1525, 1526, 1527, 1528, 1529, 1530, 1531, 1532,
+	1534, 1535, 1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543,
+	1544, 1545, 1546, 1547, 1548, 1549, 1550, 1551, 1552, 1553,
+	1554, 1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564,
+	1565, 1566, 1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574,
+	1575, 1576, 1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584,
+	1585, 1586, 1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594,
+	1595, 1596, 1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604,
+	1605, 1606, 1607, 1608, 1609, 1610, 1611, 1612, 1613, 1614,
+	1615, 1617, 1618, 1619, 1620, 1621, 1622, 1623, 1624, 1625,
 	1626, 1627, 1628, 1629, 1630, 1631, 1632, 1633, 1634, 1635,
 	1636, 1637, 1638, 1639, 1640, 1641, 1642, 1643, 1644, 1645,
-	1646, 1647, 1648, 1649, 1650, 1651, 0, 243, 241, 1492,
-	1513, 1573, 1606, 0, 766, 0, 584, 0, 589, 1009,
-	584, 271, 525, 526, 626, 626, 493, 0, 306, 0,
-	1505, 310, 0, 0, 0, 490, 301, 302, 303, 304,
-	305, 0, 748, 0, 0, 297, 0, 265, 1564, 0,
-	0, 0, 0, 0, 0, 150, 837, 152, 839, 156,
-	163, 0, 0, 168, 169, 172, 173, 174, 175, 176,
-	0, 180, 0, 182, 185, 0, 187, 188, 0, 191,
-	192, 193, 0, 203, 204, 205, 840, 841, 842, -2,
-	81, 764, 1430, 1325, 0, 1332, 1333, 1344, 1355, 1119,
-	1120, 1121, 1122, 0, 0, 0, 0, 0, 1129, 1130,
-	0, 1144, 1666, 0, 1136, 1137, 1138, 1139, 1140, 90,
-	102, 103, 1374, 1375, 1376, 1377, 1378, 1379, 1380, 1381,
-	1382, 1383, 0, 1298, 1106, 1046, 0, 1674, 0, 1694,
-	1699, 1700, 1701, 1702, 1693, 0, 0, 1283, 0, 1273,
-	0, 0, -2, -2, 0, 0, 1632, -2, 1671, 1690,
-	1698, 1675, 1697, 1668, 1669, 1663, 1664, 1665, 1667, 1676,
-	1678, 1689, 0, 1685, 1695, 1696, 0, 0, 104, 105,
+	1646, 1647, 1648, 1649, 1650, 1651, 1652, 1653, 1654, 1655,
+	1656, 1657, 1658, 1659, 1660, 1661, 1662, 1663, 1664, 1665,
+	1666, 1667, 1668, 1669, 1670, 1671, 1672, 1673, 1674, 1675,
+	1676, 1677, 1678, 1679, 1680, 1681, 1682, 1683, 1684, 1685,
+	1686, 1687, 1688, 1689, 1690, 1691, 1692, 1693, 1694, 1695,
+	1696, 1697, 1698, 0, 243, 241, 1533, 1555, 1616, 1649,
+	0, 787, 0, 605, 0, 610, 1049, 605, 271, 546,
+	547, 647, 647, 514, 0, 306, 0, 1547, 310, 0,
+	0, 0, 511, 301, 302, 303, 304, 305, 0, 769,
+	0, 0, 297, 0, 265, 1607, 0, 0, 0, 0,
+	0, 0, 150, 873, 152, 875, 156, 163, 0, 0,
+	168, 169, 172, 173, 174, 175, 176, 0, 180, 0,
+	182, 185, 0, 187, 188, 0, 191, 192, 193, 0,
+	203, 204, 205, 876, 877, 878, -2, 81, 785, 1470,
+	1365, 0, 1372, 1373, 1384, 1395, 1159, 1160, 1161, 1162,
+	0, 0, 0, 0, 0, 1169, 1170, 0, 1184, 1713,
+	0, 1176, 1177, 1178, 1179, 1180, 90, 102, 103, 1414,
+	1415, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 0,
+	1338, 1146, 1086, 0, 1721, 0, 1741, 1746, 1747, 1748,
+	1749, 1740, 0, 0, 1323, 0, 1313, 0, 0, -2,
+	-2, 0, 0, 1677, -2, 1718, 1737, 1745, 1722, 1744,
+	1715, 1716, 1710, 1711, 1712, 1714, 1723, 1725, 1736, 0,
+	1732, 1742, 1743, 0, 0, 104, 105, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, 1289, -2, 1291, 1292, 1294, 1295, 1296,
-	1297, -2, 1300, 1301, 1302, -2, -2, 1305, 1306, 1307,
-	1308, 1309, 1310, 1313, -2, 1315, -2, -2, 1285, 1286,
-	1287, 1288, 1277, 1278, 1279, 1280, 1281, 1282, -2, -2,
-	-2, 626, 699, 0, -2, 0, 0, 0, 646, 649,
-	652, 655, 0, 54, 55, 260, 261, 249, 251, 0,
-	255, 0, 248, 0, 0, 0, 869, 869, 869, 869,
-	869, 0, 869, 0, 0, 0, 869, 844, 845, 846,
-	867, 868, 915, 541, 537, 538, 539, 540, 614, 0,
-	616, 619, 470, 417, 0, 0, 0, 470, 0, 431,
-	423, 0, 0, 0, 470, 0, 0, 621, 621, 0,
-	473, 480, 470, 470, -2, 470, 470, 470, 0, 0,
-	437, 438, 439, 423, 423, 442, 443, 444, 455, 456,
-	481, 1454, 0, 0, 365, 0, 365, 0, 365, 365,
-	543, 1564, 0, 0, 217, 1632, 222, 0, 1512, 1578,
-	1527, 0, 0, 1545, 0, -2, 0, 287, 621, 0,
-	627, 0, 626, 0, 0, 365, 365, 365, 365, 365,
-	365, 365, 365, 0, 0, 0, 365, 0, 0, 664,
-	665, 660, 661, 662, 666, 667, 5, 6, 19, 0,
-	0, 0, 0, 0, 0, 60, 59, 0, 1431, 1449,
-	1386, 1387, 1388, 1436, 1390, 1440, 1440, 1440, 1440, 1418,
-	1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 0, 0,
-	1429, 1409, 1438, 1438, 1438, 1436, 1433, 1391, 1392, 1393,
-	1394, 1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403,
-	1404, 1443, 1443, 1446, 1443, 0, 1323, 0, 0, 0,
-	0, 588, 0, 0, 621, -2, 0, 494, 307, 1050,
-	0, 0, 311, 312, 0, 0, 327, 0, 330, 315,
-	316, 317, 0, 0, 299, 300, 0, 0, 267, 0,
-	0, 0, 366, 0, 0, 0, 0, 0, 0, 160,
-	157, 164, 167, 177, 184, 0, 196, 198, 201, 158,
-	165, 170, 171, 178, 199, 159, 161, 162, 166, 200,
-	202, 179, 183, 197, 181, 186, 189, 190, 195, 0,
-	131, 0, 0, 0, 0, 0, 1331, 0, 0, 1363,
-	1364, 1365, 1366, 1367, 1368, 1369, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, -2, 1325, 0, 0, 1125, 1126,
-	1127, 1128, 1131, 0, 1145, 0, 0, 0, 1384, 0,
-	1323, 1323, 0, 1323, 1319, 0, 0, 0, 0, 0,
-	0, 1323, 1259, 0, 0, 1261, 1274, 0, 0, 1263,
-	1264, 0, 1267, 1268, 1323, 0, 1323, 1272, 1255, 1256,
-	0, 1319, 1319, 0, 0, 1319, 1319, 1319, 1319, 1319,
-	1319, 1319, 1319, 1319, 1319, 1319, 1319, 0, 621, 0,
-	0, 609, 0, 590, 0, 643, 645, 0, 647, 648,
-	650, 651, 653, 654, 656, 657, 56, 0, 0, 253,
-	0, 258, 0, 763, 0, 0, 779, 0, 0, 0,
-	0, 0, 0, 0, 0, 869, 869, 869, 0, 543,
-	542, 0, 615, 0, 0, 672, 0, 0, 0, 423,
-	470, 470, 429, 430, 425, 424, 476, 477, 473, 0,
-	473, 473, 672, 0, 449, 450, 451, 470, 470, 457,
-	622, 458, 459, 473, 0, 478, 479, 672, 0, 0,
-	672, 672, 0, 467, 468, 469, 0, 0, 869, 0,
-	486, 425, 425, 1455, 1456, 0, 878, 0, 0, 0,
-	489, 0, 0, 0, 544, 210, 0, 0, 0, 0,
-	0, 0, 239, 240, 0, 0, 0, 0, 0, 230,
-	233, 1003, 1004, 834, 835, 234, 235, 279, 280, 0,
-	590, 642, 644, 638, 639, 640, 0, 0, 0, 0,
-	0, 0, 0, 0, 519, 0, 521, 0, 0, 680,
-	674, 676, 743, 90, 680, 8, 77, 74, 0, 19,
-	0, 0, 19, 19, 0, 19, 244, 0, 1452, 1450,
-	1451, 1389, 1437, 0, 1414, 0, 1415, 1416, 1417, 0,
-	0, 1410, 0, 1411, 1412, 1413, 1405, 0, 1406, 1407,
-	0, 1408, 242, 0, 1324, 767, 0, 563, 576, 558,
-	0, 565, 0, 1010, 545, 576, 547, 0, 565, 590,
-	612, 590, 0, 308, 0, 313, 0, 0, 329, 331,
-	332, 333, 318, 319, 491, 295, 296, 288, 289, 290,
-	291, 292, 293, 294, 298, 100, 0, 268, 269, 0,
-	0, 0, 144, 145, 146, 147, 148, 149, 151, 135,
-	511, 513, 826, 838, 0, 829, 0, 154, 194, 127,
-	0, 0, 1326, 1327, 1328, 1329, 1330, 1334, 0, 1336,
-	1338, 1340, 1342, 0, 1360, -2, -2, 1107, 1108, 1109,
-	1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1345,
-	1358, 1359, 0, 0, 0, 0, 0, 0, 1356, 1356,
-	1351, 0, 1123, 0, 1142, 1146, 0, 0, 0, 91,
-	1318, 1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234,
-	1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244,
-	1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254,
-	0, 0, 1325, 0, 0, 0, 1320, 1321, 0, 0,
-	0, 1214, 0, 0, 1220, 1221, 1222, 0, 571, 0,
-	1284, 1260, 1275, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 698, 700,
-	0, 580, 582, 583, 0, 610, 623, 628, 629, 632,
-	52, 57, 262, 263, 0, 0, 257, 0, -2, 781,
-	0, 619, 0, 0, 793, 363, 820, 0, 0, 836,
-	859, 865, 0, 0, 0, 893, 0, 617, 0, 0,
-	712, 416, 0, 471, 472, 420, 1564, 425, 672, 672,
-	432, 426, 433, 475, 434, 435, 436, 0, 672, 672,
-	470, 473, 460, 474, 473, 463, 464, 0, 482, 487,
-	483, 0, 485, 440, 441, 619, 342, 343, 0, 0,
-	0, 348, 1457, 1457, 0, 1457, 1457, 1457, 1457, 0,
-	0, 1457, 1457, 1457, 1457, 1457, 1457, 1457, 1457, 1457,
-	1457, 1457, 1457, 1457, 1457, 0, 879, 360, 0, 0,
-	363, 810, 685, 0, 686, 687, 683, 714, 738, 738,
-	0, 738, 718, 1009, 211, 212, 0, 214, 215, 216,
-	223, 218, 220, 0, 0, 224, 236, 237, 238, 0,
-	0, 0, 228, 229, 0, 0, 282, 283, 285, 0,
-	608, 508, 1007, 509, 510, 514, 0, 516, 517, 518,
-	781, 0, 815, 672, 0, 681, 0, 677, 744, 0,
-	746, 0, 672, 0, 75, 19, 0, 68, 65, 0,
-	0, 0, 0, 0, 1432, 1385, 1453, 0, 0, 0,
-	1434, 0, 0, 0, 0, 58, 592, 552, 0, 557,
-	573, 0, 577, 0, 0, 569, 562, 566, 0, 0,
-	586, 546, 0, 0, 551, 611, 613, 1051, 0, 314,
-	328, 0, 0, 0, 0, 138, 823, 0, 139, 143,
-	133, 0, 0, 0, 828, 0, 825, 830, 0, 153,
-	0, 0, 128, 129, 884, 889, 0, 1335, 1337, 1339,
-	1341, 1343, 0, 1346, 1356, 1356, 1352, 0, 1347, 0,
-	1349, 0, 1326, 0, 1147, 0, 0, 0, 0, 0,
-	0, 1204, 1205, 0, 0, 1209, 0, 1211, 1212, 1213,
-	1215, 0, 0, 0, 1219, 0, 1258, 1276, 1262, 1265,
-	0, 1269, 0, 1271, 0, 626, 0, 1181, 1181, 0,
-	0, 0, 0, 1181, 0, 0, 0, 0, 0, 0,
-	0, 0, 1141, 0, 0, 701, 592, 0, 0, 0,
-	635, 633, 634, 264, 252, 254, 0, 0, 0, 769,
-	770, 772, 0, 775, 776, 777, 0, 782, 783, 785,
-	786, 0, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, 1497, -2, -2, -2, -2, -2, -2,
+	1329, -2, 1331, 1332, 1334, 1335, 1336, 1337, -2, 1340,
+	1341, 1342, -2, -2, 1345, 1346, 1347, 1348, 1349, 1350,
+	1353, -2, 1355, -2, -2, 1325, 1326, 1327, 1328, 1317,
+	1318, 1319, 1320, 1321, 1322, -2, -2, -2, 647, 720,
+	0, -2, 0, 0, 0, 667, 670, 673, 676, 0,
+	54, 55, 260, 261, 249, 251, 0, 255, 0, 248,
+	0, 0, 0, 908, 908, 908, 908, 908, 0, 908,
+	0, 0, 0, 908, 880, 881, 882, 906, 907, 954,
+	562, 558, 559, 560, 561, 635, 0, 637, 640, 491,
+	438, 0, 0, 0, 491, 0, 452, 444, 0, 0,
+	0, 491, 0, 0, 642, 642, 0, 494, 501, 491,
+	491, -2, 491, 491, 491, 0, 0, 458, 459, 460,
+	444, 444, 463, 464, 465, 476, 477, 502, 1494, 0,
+	0, 386, 0, 386, 0, 386, 386, 564, 1607, 0,
+	0, 217, 1677, 222, 0, 1554, 1621, 1569, 0, 0,
+	1588, 0, -2, 0, 287, 642, 0, 648, 0, 647,
+	0, 0, 386, 386, 386, 386, 386, 386, 386, 386,
+	0, 0, 0, 386, 0, 0, 685, 686, 681, 682,
+	683, 687, 688, 5, 6, 19, 0, 0, 0, 0,
+	0, 0, 60, 59, 0, 1471, 1489, 1426, 1427, 1428,
+	1476, 1430, 1480, 1480, 1480, 1480, 1458, 1459, 1460, 1461,
+	1462, 1463, 1464, 1465, 1466, 0, 0, 1469, 1449, 1478,
+	1478, 1478, 1476, 1473, 1431, 1432, 1433, 1434, 1435, 1436,
+	1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444, 1483, 1483,
+	1486, 1483, 0, 1363, 0, 0, 0, 0, 609, 0,
+	0, 642, -2, 0, 515, 307, 1090, 0, 0, 311,
+	312, 0, 0, 327, 0, 330, 315, 316, 317, 0,
+	0, 299, 300, 0, 0, 267, 0, 0, 0, 387,
+	0, 0, 0, 0, 0, 0, 160, 157, 164, 167,
+	177, 184, 0, 196, 198, 201, 158, 165, 170, 171,
+	178, 199, 159, 161, 162, 166, 200, 202, 179, 183,
+	197, 181, 186, 189, 190, 195, 0, 131, 0, 0,
+	0, 0, 0, 1371, 0, 0, 1403, 1404, 1405, 1406,
+	1407, 1408, 1409, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, -2, 1365, 0, 0, 1165, 1166, 1167, 1168, 1171,
+	0, 1185, 0, 0, 0, 1424, 0, 1363, 1363, 0,
+	1363, 1359, 0, 0, 0, 0, 0, 0, 1363, 1299,
+	0, 0, 1301, 1314, 0, 0, 1303, 1304, 0, 1307,
+	1308, 1363, 0, 1363, 1312, 1295, 1296, 0, 1359, 1359,
+	0, 0, 1359, 1359, 1359, 1359, 1359, 1359, 1359, 1359,
+	1359, 1359, 1359, 1359, 0, 642, 0, 0, 630, 0,
+	611, 0, 664, 666, 0, 668, 669, 671, 672, 674,
+	675, 677, 678, 56, 0, 0, 253, 0, 258, 0,
+	784, 0, 0, 800, 0, 0, 0, 0, 0, 0,
+	0, 0, 908, 908, 908, 0, 564, 563, 0, 636,
+	0, 0, 693, 0, 0, 0, 444, 491, 491, 450,
+	451, 446, 445, 497, 498, 494, 0, 494, 494, 693,
+	0, 470, 471, 472, 491, 491, 478, 643, 479, 480,
+	494, 0, 499, 500, 693, 0, 0, 693, 693, 0,
+	488, 489, 490, 0, 0, 908, 0, 507, 446, 446,
+	1495, 1496, 0, 917, 0, 0, 0, 510, 0, 0,
+	0, 565, 210, 0, 0, 0, 0, 0, 0, 239,
+	240, 0, 0, 0, 0, 0, 230, 233, 1043, 1044,
+	870, 871, 234, 235, 279, 280, 0, 611, 663, 665,
+	659, 660, 661, 0, 0, 0, 0, 0, 0, 0,
+	0, 540, 0, 542, 0, 0, 701, 695, 697, 764,
+	90, 701, 8, 77, 74, 0, 19, 0, 0, 19,
+	19, 0, 19, 244, 0, 1492, 1490, 1491, 1429, 1477,
+	0, 1454, 0, 1455, 1456, 1457, 0, 0, 1450, 0,
+	1451, 1452, 1453, 1445, 0, 1446, 1447, 0, 1448, 242,
+	0, 1364, 788, 0, 584, 597, 579, 0, 586, 0,
+	1050, 566, 597, 568, 0, 586, 611, 633, 611, 0,
+	308, 0, 313, 0, 0, 329, 331, 332, 333, 318,
+	319, 512, 295, 296, 288, 289, 290, 291, 292, 293,
+	294, 298, 100, 0, 268, 269, 0, 0, 0, 144,
+	145, 146, 147, 148, 149, 151, 135, 532, 534, 860,
+	874, 0, 863, 0, 154, 194, 127, 0, 0, 1366,
+	1367, 1368, 1369, 1370, 1374, 0, 1376, 1378, 1380, 1382,
+	0, 1400, -2, -2, 1147, 1148, 1149, 1150, 1151, 1152,
+	1153, 1154, 1155, 1156, 1157, 1158, 1385, 1398, 1399, 0,
+	0, 0, 0, 0, 0, 1396, 1396, 1391, 0, 1163,
+	0, 1182, 1186, 0, 0, 0, 91, 1358, 1266, 1267,
+	1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277,
+	1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287,
+	1288, 1289, 1290, 1291, 1292, 1293, 1294, 0, 0, 1365,
+	0, 0, 0, 1360, 1361, 0, 0, 0, 1254, 0,
+	0, 1260, 1261, 1262, 0, 592, 0, 1324, 1300, 1315,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 719, 721, 0, 601, 603,
+	604, 0, 631, 644, 649, 650, 653, 52, 57, 262,
+	263, 0, 0, 257, 0, -2, 802, 0, 640, 0,
+	0, 814, 384, 854, 0, 0, 872, 895, 901, 0,
+	0, 0, 932, 0, 638, 0, 0, 733, 437, 0,
+	492, 493, 441, 1607, 446, 693, 693, 453, 447, 454,
+	496, 455, 456, 457, 0, 693, 693, 491, 494, 481,
+	495, 494, 484, 485, 0, 503, 508, 504, 0, 506,
+	461, 462, 640, 342, 343, 352, 0, 0, 0, 0,
+	0, 363, 363, 0, 0, 362, 1497, 1497, 0, 1497,
+	1497, 1497, 1497, 0, 0, 1497, 1497, 1497, 1497, 1497,
+	1497, 1497, 1497, 1497, 1497, 1497, 1497, 1497, 1497, 0,
+	1497, 918, 381, 0, 0, 384, 831, 706, 0, 707,
+	708, 704, 735, 759, 759, 0, 759, 739, 1049, 211,
+	212, 0, 214, 215, 216, 223, 218, 220, 0, 0,
+	224, 236, 237, 238, 0, 0, 0, 228, 229, 0,
+	0, 282, 283, 285, 0, 629, 529, 1047, 530, 531,
+	535, 0, 537, 538, 539, 802, 0, 836, 693, 0,
+	702, 0, 698, 765, 0, 767, 0, 693, 0, 75,
+	19, 0, 68, 65, 0, 0, 0, 0, 0, 1472,
+	1425, 1493, 0, 0, 0, 1474, 0, 0, 0, 0,
+	58, 613, 573, 0, 578, 594, 0, 598, 0, 0,
+	590, 583, 587, 0, 0, 607, 567, 0, 0, 572,
+	632, 634, 1091, 0, 314, 328, 0, 0, 0, 0,
+	138, 857, 0, 139, 143, 133, 0, 0, 0, 862,
+	0, 859, 864, 0, 153, 0, 0, 128, 129, 923,
+	928, 0, 1375, 1377, 1379, 1381, 1383, 0, 1386, 1396,
+	1396, 1392, 0, 1387, 0, 1389, 0, 1366, 0, 1187,
+	0, 0, 0, 0, 0, 0, 1244, 1245, 0, 0,
+	1249, 0, 1251, 1252, 1253, 1255, 0, 0, 0, 1259,
+	0, 1298, 1316, 1302, 1305, 0, 1309, 0, 1311, 0,
+	647, 0, 1221, 1221, 0, 0, 0, 0, 1221, 0,
+	0, 0, 0, 0, 0, 0, 0, 1181, 0, 0,
+	722, 613, 0, 0, 0, 656, 654, 655, 264, 252,
+	254, 0, 0, 0, 790, 791, 793, 0, 796, 797,
+	798, 0, 803, 804, 806, 807, 0, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, 1538, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, 780, 0, 870, 800, 1457, 367, 0,
-	0, 822, 0, 0, 0, -2, 0, 0, 0, 0,
-	895, 0, 0, 0, 530, 534, 23, 620, 0, 673,
-	418, 0, 419, 470, 427, 428, 672, 1009, 452, 453,
-	672, 470, 470, 473, 0, 484, 0, 878, 345, 1015,
-	1016, 1017, 0, 0, 1021, 1028, 869, 1076, 0, 1028,
-	0, 0, 1030, 1031, 346, 0, 0, 0, 0, 0,
-	347, 0, 0, 1458, 0, 1457, 1457, 0, 0, 0,
-	0, 1457, 1457, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 800, 1457,
-	0, 0, 367, 807, 0, 0, 0, 0, 0, 0,
-	705, 0, 0, 704, 0, 0, 0, 0, 0, 619,
-	739, 0, 741, 742, 716, -2, 0, 685, 721, 1323,
-	0, 0, 0, 225, 226, 227, 231, 232, 281, 284,
-	286, 0, 0, 0, 522, 670, 675, 682, 745, 747,
-	91, 678, 670, 0, 78, 19, 77, 69, 70, 0,
-	19, 0, 0, 0, 0, 1442, 1441, 1427, 0, 1428,
-	1439, 1444, 0, 1447, 0, 596, 0, 0, 576, 578,
-	0, 0, 576, 0, 0, 585, 0, 0, 576, 0,
-	0, 492, 101, 334, 0, 0, 0, 0, 0, 512,
-	0, 827, 135, 0, 0, 155, 0, 0, 887, 0,
-	889, 1322, 1348, 1350, 0, 1357, 1353, 1124, 1132, 1143,
-	0, 0, 1149, 1161, 1161, 0, 1152, 1440, 1440, 1155,
-	1436, 1438, 1436, 1161, 1161, 0, 92, 0, 0, 1210,
-	0, 0, 0, 572, 0, 0, 0, 1179, 1181, 1186,
-	1182, 1187, 1181, 1181, 1181, 1181, 1192, 1181, 1181, 1181,
-	1181, 1181, 1181, 1181, 1181, 1177, 581, 596, 624, 625,
-	630, 631, 0, 256, 0, 0, 774, 0, 0, 0,
-	787, 0, 803, 801, 802, 0, 817, 368, 369, 370,
-	371, 0, 0, 0, 821, 0, 553, 0, 860, 861,
-	862, 863, 864, -2, 873, 0, 0, 1011, 1011, 1011,
-	903, 0, 894, 584, 0, -2, 0, 0, 532, 0,
-	0, 713, 421, 672, 445, 0, 461, 672, 672, 470,
-	488, 0, 344, -2, 1020, 1040, 1029, 1040, 1077, 1040,
-	1040, 869, 0, 349, 350, 351, 0, 353, 0, 962,
-	963, 0, 0, 966, 967, 968, 969, 0, 0, 972,
-	973, 974, 975, 976, 977, 978, 979, 980, 981, 997,
-	998, 999, 1000, 1001, 1002, 982, 983, 984, 985, 986,
-	987, 994, 0, 0, 991, 0, 803, 0, 0, 0,
-	817, 809, 0, 811, 812, 0, 0, 555, 672, 274,
-	0, 708, 702, 0, 691, 706, 707, 694, 0, 696,
-	0, 692, 693, 672, 684, 715, 740, 717, 720, 722,
-	723, 729, 0, 0, 0, 0, 213, 0, 387, 219,
-	1008, 515, 520, 668, 0, 0, 668, 73, 76, 0,
-	71, 0, 0, 0, 0, 63, 61, 1435, 0, 0,
-	598, 115, 0, 0, 0, 574, 0, 579, 576, 561,
-	570, 560, 567, 568, 587, 576, 550, 549, 1052, 309,
-	0, 824, 135, 140, 141, 142, 136, 134, 831, 0,
-	833, 0, 885, 889, 0, 0, 1354, 1148, 1133, 1150,
-	1162, 1163, 1151, 1134, 1153, 1154, 1156, 1157, 1158, 1159,
-	1160, 1135, 1206, 0, 1208, 1216, 1217, 0, 1266, 1270,
-	0, 0, 0, 1185, 1188, 1189, 1190, 1191, 1193, 1194,
-	1195, 1196, 1197, 1198, 1199, 1200, 626, 1178, 0, 598,
-	636, 637, 762, 765, 771, 773, 0, 784, 0, 791,
-	792, 0, 0, 795, 796, 805, 0, 0, 0, 373,
-	374, 0, 0, 0, 386, 382, 383, 384, 364, 816,
-	807, 0, 0, 874, 1457, 1457, 1457, 0, 0, 1012,
-	1013, 0, 0, 906, 0, 0, 897, 0, 738, 0,
-	0, 672, 531, 534, 535, 618, 422, 672, 465, 462,
-	672, 341, 1042, -2, 1055, 1057, 0, 0, 1060, 1061,
-	0, 0, 0, 0, 1098, 1067, 0, 0, 1071, 0,
-	1372, 1373, 0, 1075, 0, 1041, 0, 1032, 1041, 0,
-	0, 1040, 0, 352, 354, 355, 356, 964, 965, 970,
-	971, 988, 0, 0, 990, 0, 0, 357, 0, 0,
-	358, 362, 808, 0, 813, 814, 626, 0, 0, 688,
-	709, 0, 0, 689, 0, 690, 695, 697, 273, 724,
-	0, 0, 726, 727, 728, 719, 221, 658, 0, 0,
-	679, 659, 0, 19, 0, 0, 66, 1445, 1448, 600,
-	0, 597, 116, 0, 0, 0, 0, 575, 559, 548,
-	137, 132, 832, 118, 888, 890, 886, 0, 1218, 0,
-	1181, 1180, 1174, 0, 600, 0, 790, 788, 804, 794,
-	0, 818, 819, 0, 375, 376, 0, 379, 385, 806,
-	554, 0, 0, 0, 0, 866, -2, 0, 0, -2,
-	909, 0, 904, 0, 896, 0, 899, 672, 672, -2,
-	528, 533, 0, 446, 466, 1056, 1058, 1059, 1062, 1063,
-	1005, 1006, 1064, 1103, 1104, 1105, 1065, 1100, 1101, 1102,
-	1066, 0, 0, 0, 1370, 1371, 1096, 0, 0, 0,
-	0, 0, 0, 0, 1026, 995, 996, 989, 992, 993,
-	361, 359, 556, 621, 275, 276, 710, 0, 703, 733,
-	730, 0, 0, 669, 671, 67, 72, 0, 0, 602,
-	0, 599, 0, 593, 595, 126, 564, 88, 109, 0,
-	1207, 1257, 1184, 0, 1175, 0, 1169, 1170, 1171, 1176,
-	602, 0, 0, 0, 372, 377, 0, 380, 381, 0,
-	855, 1436, 0, 875, 876, 877, 916, -2, 959, 1014,
-	881, 118, 916, 900, 0, 907, 0, 905, 898, 626,
-	529, 0, 0, 1273, 1091, 0, 0, 0, 1033, 1035,
-	1036, 1037, 1038, 1039, 1034, 0, 0, 0, 1025, 1027,
-	1072, 0, 272, 0, 0, 734, 736, 731, 732, 62,
-	64, 79, 0, 601, 117, 0, 80, 0, 106, 0,
-	119, 120, 0, 0, 0, 0, 1183, 1172, 0, 0,
-	0, 0, 591, 0, 789, 797, 0, 799, 378, 848,
-	0, 632, 0, 918, 0, 878, 961, 883, 918, 892,
-	0, 902, 0, 911, 0, 908, 621, 1099, 0, 1070,
-	1079, 1092, 0, 0, 848, 848, 848, 848, 0, 1073,
-	711, 725, 0, 0, 0, 89, 93, 0, 115, 112,
-	0, 121, 0, 0, 0, 0, 0, 1164, 1165, 1167,
-	1166, 1168, 0, 798, 847, 856, 857, 632, 880, 0,
-	955, 960, 882, 901, 910, 0, 913, 527, 1068, 1078,
-	1080, 1081, 0, 1093, 1094, 1095, 1097, 1018, 1019, 1022,
-	1023, 0, 735, 737, 0, 604, 594, 82, 0, 0,
-	110, 111, 113, 0, 122, 0, 124, 125, 0, 778,
-	849, 1457, 0, 0, 853, 854, 858, 0, 943, 0,
-	0, 949, 0, 956, 912, 1069, 1082, 0, 1083, 0,
-	0, 0, 1024, 603, 0, 891, 0, 94, 0, 96,
-	98, 99, 1043, 107, 108, 114, 123, 1173, 0, 851,
-	0, 919, 0, 921, 0, 0, 0, 0, 0, 953,
-	0, 1084, 1086, 1087, 0, 0, 1085, 605, 83, 84,
-	0, 95, 0, 0, 850, 852, 0, 923, 0, 944,
-	0, 0, 0, 0, 0, 0, 0, 1088, 1090, 1089,
-	0, 0, 97, 1044, 920, 917, 0, 955, 945, 0,
-	947, 0, 0, 0, 0, 85, 86, 87, 0, 0,
-	925, 0, 941, 946, 948, 950, 0, 954, 952, 1045,
-	924, 0, 937, 922, 0, 951, 926, -2, 0, 942,
-	927, -2, 0, 935, 0, 0, 928, 936, 0, 931,
-	0, 0, 0, 930, 0, -2, 938, 0, 0, 932,
-	-2, 0, 940, 939,
+	-2, -2, -2, -2, -2, -2, -2, -2, 801, 0,
+	909, 821, 1497, 841, 0, 0, 856, 0, 0, 0,
+	-2, 0, 0, 0, 0, 934, 0, 0, 0, 551,
+	555, 23, 641, 0, 694, 439, 0, 440, 491, 448,
+	449, 693, 1049, 473, 474, 693, 491, 491, 494, 0,
+	505, 0, 917, 0, 354, 0, 1055, 1056, 1057, 0,
+	0, 1061, 1068, 908, 1116, 0, 1068, 0, 0, 1070,
+	1071, 0, 360, 0, 0, 0, 372, 0, 0, 0,
+	0, 0, 0, 364, 0, 0, 361, 0, 0, 1498,
+	0, 1497, 1497, 0, 0, 0, 0, 1497, 1497, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 821, 1497, 0, 0, 388,
+	828, 0, 0, 0, 0, 0, 0, 726, 0, 0,
+	725, 0, 0, 0, 0, 0, 640, 760, 0, 762,
+	763, 737, -2, 0, 706, 742, 1363, 0, 0, 0,
+	225, 226, 227, 231, 232, 281, 284, 286, 0, 0,
+	0, 543, 691, 696, 703, 766, 768, 91, 699, 691,
+	0, 78, 19, 77, 69, 70, 0, 19, 0, 0,
+	0, 0, 1482, 1481, 1467, 0, 1468, 1479, 1484, 0,
+	1487, 0, 617, 0, 0, 597, 599, 0, 0, 597,
+	0, 0, 606, 0, 0, 597, 0, 0, 513, 101,
+	334, 0, 0, 0, 0, 0, 533, 0, 861, 135,
+	0, 0, 155, 0, 0, 926, 0, 928, 1362, 1388,
+	1390, 0, 1397, 1393, 1164, 1172, 1183, 0, 0, 1189,
+	1201, 1201, 0, 1192, 1480, 1480, 1195, 1476, 1478, 1476,
+	1201, 1201, 0, 92, 0, 0, 1250, 0, 0, 0,
+	593, 0, 0, 0, 1219, 1221, 1226, 1222, 1227, 1221,
+	1221, 1221, 1221, 1232, 1221, 1221, 1221, 1221, 1221, 1221,
+	1221, 1221, 1217, 602, 617, 645, 646, 651, 652, 0,
+	256, 0, 0, 795, 0, 0, 0, 808, 0, 824,
+	822, 823, 0, 388, 842, 0, 855, 0, 574, 0,
+	896, 897, 898, 899, 900, -2, 912, 0, 0, 0,
+	1051, 1051, 1051, 942, 0, 933, 605, 0, -2, 0,
+	0, 553, 0, 0, 734, 442, 693, 466, 0, 482,
+	693, 693, 491, 509, 0, 353, 0, 0, 0, 365,
+	356, 367, 0, -2, 1060, 1080, 1069, 1080, 1117, 1080,
+	1080, 908, 0, 345, 369, 370, 371, 0, 374, 346,
+	347, 0, 0, 365, 0, 0, 0, 1001, 1002, 0,
+	0, 1005, 1006, 1007, 1008, 0, 0, 1011, 1012, 1013,
+	1014, 1015, 1016, 1017, 1018, 1019, 1020, 1037, 1038, 1039,
+	1040, 1041, 1042, 1021, 1022, 1023, 1024, 1025, 1026, 1034,
+	0, 0, 1031, 0, 0, 824, 0, 0, 0, 838,
+	389, 390, 391, 392, 0, 0, 0, 830, 0, 832,
+	833, 0, 0, 576, 693, 274, 0, 729, 723, 0,
+	712, 727, 728, 715, 0, 717, 0, 713, 714, 693,
+	705, 736, 761, 738, 741, 743, 744, 750, 0, 0,
+	0, 0, 213, 0, 408, 219, 1048, 536, 541, 689,
+	0, 0, 689, 73, 76, 0, 71, 0, 0, 0,
+	0, 63, 61, 1475, 0, 0, 619, 115, 0, 0,
+	0, 595, 0, 600, 597, 582, 591, 581, 588, 589,
+	608, 597, 571, 570, 1092, 309, 0, 858, 135, 140,
+	141, 142, 136, 134, 865, 0, -2, 0, 0, 924,
+	928, 0, 0, 1394, 1188, 1173, 1190, 1202, 1203, 1191,
+	1174, 1193, 1194, 1196, 1197, 1198, 1199, 1200, 1175, 1246,
+	0, 1248, 1256, 1257, 0, 1306, 1310, 0, 0, 0,
+	1225, 1228, 1229, 1230, 1231, 1233, 1234, 1235, 1236, 1237,
+	1238, 1239, 1240, 647, 1218, 0, 619, 657, 658, 783,
+	786, 792, 794, 0, 805, 0, 812, 813, 0, 0,
+	816, 817, 838, 843, 844, 845, 846, 847, 0, 0,
+	0, 0, 385, 837, 828, 0, 0, 913, 1497, 1497,
+	1497, 0, 0, 0, 1052, 1053, 1055, 0, 0, 945,
+	0, 0, 936, 0, 759, 0, 0, 693, 552, 555,
+	556, 639, 443, 693, 486, 483, 693, 341, 0, 964,
+	0, 355, 366, 368, 1082, -2, 1095, 1097, 0, 0,
+	1100, 1101, 0, 0, 0, 0, 1138, 1107, 0, 0,
+	1111, 0, 1412, 1413, 0, 1115, 0, 1081, 0, 1072,
+	1081, 0, 0, 1080, 0, 373, 0, 0, 357, 365,
+	0, 375, 376, 377, 1003, 1004, 1009, 1010, 1027, 0,
+	0, 1029, 0, 0, 0, 378, 0, 0, 379, 383,
+	0, 0, 0, 394, 395, 0, 0, 0, 407, 403,
+	404, 405, 829, 0, 834, 835, 647, 0, 0, 709,
+	730, 0, 0, 710, 0, 711, 716, 718, 273, 745,
+	0, 0, 747, 748, 749, 740, 221, 679, 0, 0,
+	700, 680, 0, 19, 0, 0, 66, 1485, 1488, 621,
+	0, 618, 116, 0, 0, 0, 0, 596, 580, 569,
+	137, 132, 866, 0, 0, 118, 927, 929, 925, 0,
+	1258, 0, 1221, 1220, 1214, 0, 621, 0, 811, 809,
+	825, 815, 0, 826, 0, 849, 850, 851, 852, 853,
+	827, 575, 0, 0, 0, 0, 902, 904, -2, 0,
+	0, -2, 948, 0, 943, 0, 935, 0, 938, 693,
+	693, -2, 549, 554, 0, 467, 487, 344, 0, 976,
+	1096, 1098, 1099, 1102, 1103, 1045, 1046, 1104, 1143, 1144,
+	1145, 1105, 1140, 1141, 1142, 1106, 0, 0, 0, 1410,
+	1411, 1136, 0, 0, 0, 0, 0, 0, 0, 1066,
+	0, 0, 358, 359, 1035, 1036, 1028, 1032, 1033, 0,
+	382, 380, 839, 840, 0, 396, 397, 0, 400, 406,
+	577, 642, 275, 276, 731, 0, 724, 754, 751, 0,
+	0, 690, 692, 67, 72, 0, 0, 623, 0, 620,
+	0, 614, 616, 126, 585, 868, 869, 88, 109, 0,
+	1247, 1297, 1224, 0, 1215, 0, 1209, 1210, 1211, 1216,
+	623, 0, 0, 0, 848, 0, 891, 1476, 0, 914,
+	915, 916, 903, 0, 955, -2, 998, 1054, 920, 118,
+	955, 939, 0, 946, 0, 944, 937, 647, 550, 965,
+	-2, 0, 0, 0, 1313, 1131, 0, 0, 0, 1073,
+	1075, 1076, 1077, 1078, 1079, 1074, 0, 0, 0, 1065,
+	1067, 1112, 0, 0, 349, 0, 393, 398, 0, 401,
+	402, 272, 0, 0, 755, 757, 752, 753, 62, 64,
+	79, 0, 622, 117, 0, 80, 0, 106, 0, 119,
+	120, 0, 0, 0, 0, 1223, 1212, 0, 0, 0,
+	0, 612, 0, 810, 818, 0, 820, 884, 0, 653,
+	0, 905, 957, 0, 917, 1000, 922, 957, 931, 0,
+	941, 0, 950, 0, 947, 642, 966, -2, 0, 974,
+	0, 0, 1139, 0, 1110, 1119, 1132, 0, 0, 884,
+	884, 884, 884, 0, 1113, 348, 0, 0, 1030, 399,
+	732, 746, 0, 0, 0, 89, 93, 0, 115, 112,
+	0, 121, 0, 0, 0, 0, 0, 1204, 1205, 1207,
+	1206, 1208, 0, 819, 883, 892, 893, 653, 919, 0,
+	994, 999, 921, 940, 949, 0, 952, 548, 967, 975,
+	0, 970, 0, 0, 0, 1108, 1118, 1120, 1121, 0,
+	1133, 1134, 1135, 1137, 1058, 1059, 1062, 1063, 0, 350,
+	351, 756, 758, 0, 625, 615, 82, 0, 0, 110,
+	111, 113, 0, 122, 0, 124, 125, 0, 799, 885,
+	1497, 0, 0, 889, 890, 894, 0, 982, 0, 0,
+	988, 0, 995, 951, 969, 0, -2, 977, 0, 0,
+	1109, 1122, 0, 1123, 0, 0, 0, 1064, 624, 0,
+	930, 0, 94, 0, 96, 98, 99, 1083, 107, 108,
+	114, 123, 1213, 0, 887, 0, 958, 0, 960, 0,
+	0, 0, 0, 0, 992, 0, 971, -2, 0, 979,
+	1124, 1126, 1127, 0, 0, 1125, 626, 83, 84, 0,
+	95, 0, 0, 886, 888, 0, 962, 0, 983, 0,
+	0, 0, 0, 0, 0, 0, 978, 1128, 1130, 1129,
+	0, 0, 97, 1084, 959, 956, 0, 994, 984, 0,
+	986, 0, 0, 0, 0, 85, 86, 87, 0, 0,
+	980, 985, 987, 989, 0, 993, 991, 1085, 963, 961,
+	0, 990, 981,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 96, 3, 3, 3, 127, 119, 3,
-	67, 68, 124, 122, 137, 123, 136, 125, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 557, 556,
-	106, 105, 107, 3, 558, 3, 3, 3, 3, 3,
+	3, 3, 3, 97, 3, 3, 3, 128, 120, 3,
+	68, 69, 125, 123, 138, 124, 137, 126, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 565, 564,
+	107, 106, 108, 3, 566, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 129, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 130, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 559, 118, 560, 130,
+	3, 3, 3, 567, 119, 568, 131,
 }
 
 var yyTok2 = [...]int{
@@ -7090,12 +7614,12 @@ var yyTok2 = [...]int{
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
-	62, 63, 64, 65, 66, 69, 70, 71, 72, 73,
+	62, 63, 64, 65, 66, 67, 70, 71, 72, 73,
 	74, 75, 76, 77, 78, 79, 80, 81, 82, 83,
 	84, 85, 86, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 97, 98, 99, 100, 101, 102, 103, 104,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	120, 121, 126, 128, 131, 132, 133, 134, 135, 138,
+	94, 95, 96, 98, 99, 100, 101, 102, 103, 104,
+	105, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 121, 122, 127, 129, 132, 133, 134, 135, 136,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
@@ -7169,7 +7693,8 @@ var yyTok3 = [...]int{
 	57865, 540, 57866, 541, 57867, 542, 57868, 543, 57869, 544,
 	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
 	57875, 550, 57876, 551, 57877, 552, 57878, 553, 57879, 554,
-	57880, 555, 0,
+	57880, 555, 57881, 556, 57882, 557, 57883, 558, 57884, 559,
+	57885, 560, 57886, 561, 57887, 562, 57888, 563, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -7519,13 +8044,13 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:710
+//line mysql_sql.y:714
 		{
 			yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:717
+//line mysql_sql.y:721
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -7533,7 +8058,7 @@ yydefault:
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:727
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -7542,7 +8067,7 @@ yydefault:
 	case 6:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:731
+//line mysql_sql.y:735
 		{
 			yyLOCAL = tree.NewCompoundStmt(yyDollar[2].statementsUnion())
 		}
@@ -7550,7 +8075,7 @@ yydefault:
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:737
+//line mysql_sql.y:741
 		{
 			yyLOCAL = []tree.Statement{yyDollar[1].statementUnion()}
 		}
@@ -7558,7 +8083,7 @@ yydefault:
 	case 8:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:741
+//line mysql_sql.y:745
 		{
 			yyLOCAL = append(yyDollar[1].statementsUnion(), yyDollar[3].statementUnion())
 		}
@@ -7566,7 +8091,7 @@ yydefault:
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:756
+//line mysql_sql.y:760
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7574,7 +8099,7 @@ yydefault:
 	case 19:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:760
+//line mysql_sql.y:764
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7582,7 +8107,7 @@ yydefault:
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:766
+//line mysql_sql.y:770
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7590,7 +8115,7 @@ yydefault:
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:771
+//line mysql_sql.y:775
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -7598,7 +8123,7 @@ yydefault:
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:775
+//line mysql_sql.y:779
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -7606,7 +8131,7 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:807
+//line mysql_sql.y:811
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -7614,7 +8139,7 @@ yydefault:
 	case 52:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:814
+//line mysql_sql.y:818
 		{
 			var connectionId uint64
 			switch v := yyDollar[3].item.(type) {
@@ -7637,7 +8162,7 @@ yydefault:
 	case 53:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:834
+//line mysql_sql.y:838
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: false,
@@ -7647,7 +8172,7 @@ yydefault:
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:840
+//line mysql_sql.y:844
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7658,7 +8183,7 @@ yydefault:
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:847
+//line mysql_sql.y:851
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -7669,7 +8194,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:855
+//line mysql_sql.y:859
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist: false,
@@ -7679,7 +8204,7 @@ yydefault:
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:861
+//line mysql_sql.y:865
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist:       true,
@@ -7690,7 +8215,7 @@ yydefault:
 	case 58:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:870
+//line mysql_sql.y:874
 		{
 			yyLOCAL = &tree.CallStmt{
 				Name: yyDollar[2].procNameUnion(),
@@ -7701,7 +8226,7 @@ yydefault:
 	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:880
+//line mysql_sql.y:884
 		{
 			yyLOCAL = &tree.LeaveStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
@@ -7711,7 +8236,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:888
+//line mysql_sql.y:892
 		{
 			yyLOCAL = &tree.IterateStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
@@ -7721,7 +8246,7 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:896
+//line mysql_sql.y:900
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: "",
@@ -7733,7 +8258,7 @@ yydefault:
 	case 62:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:904
+//line mysql_sql.y:908
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7745,7 +8270,7 @@ yydefault:
 	case 63:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:914
+//line mysql_sql.y:918
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: "",
@@ -7757,7 +8282,7 @@ yydefault:
 	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:922
+//line mysql_sql.y:926
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7769,7 +8294,7 @@ yydefault:
 	case 65:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:932
+//line mysql_sql.y:936
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: "",
@@ -7780,7 +8305,7 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:939
+//line mysql_sql.y:943
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -7791,7 +8316,7 @@ yydefault:
 	case 67:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:948
+//line mysql_sql.y:952
 		{
 			yyLOCAL = &tree.IfStmt{
 				Cond:  yyDollar[2].exprUnion(),
@@ -7804,7 +8329,7 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:958
+//line mysql_sql.y:962
 		{
 			yyLOCAL = nil
 		}
@@ -7812,7 +8337,7 @@ yydefault:
 	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:962
+//line mysql_sql.y:966
 		{
 			yyLOCAL = yyDollar[1].elseIfClauseListUnion()
 		}
@@ -7820,7 +8345,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:968
+//line mysql_sql.y:972
 		{
 			yyLOCAL = []*tree.ElseIfStmt{yyDollar[1].elseIfClauseUnion()}
 		}
@@ -7828,7 +8353,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:972
+//line mysql_sql.y:976
 		{
 			yyLOCAL = append(yyDollar[1].elseIfClauseListUnion(), yyDollar[2].elseIfClauseUnion())
 		}
@@ -7836,7 +8361,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ElseIfStmt
-//line mysql_sql.y:978
+//line mysql_sql.y:982
 		{
 			yyLOCAL = &tree.ElseIfStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -7847,7 +8372,7 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:987
+//line mysql_sql.y:991
 		{
 			yyLOCAL = &tree.CaseStmt{
 				Expr:  yyDollar[2].exprUnion(),
@@ -7859,7 +8384,7 @@ yydefault:
 	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:997
+//line mysql_sql.y:1001
 		{
 			yyLOCAL = []*tree.WhenStmt{yyDollar[1].whenClause2Union()}
 		}
@@ -7867,7 +8392,7 @@ yydefault:
 	case 75:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:1001
+//line mysql_sql.y:1005
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseList2Union(), yyDollar[2].whenClause2Union())
 		}
@@ -7875,7 +8400,7 @@ yydefault:
 	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.WhenStmt
-//line mysql_sql.y:1007
+//line mysql_sql.y:1011
 		{
 			yyLOCAL = &tree.WhenStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -7886,7 +8411,7 @@ yydefault:
 	case 77:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1016
+//line mysql_sql.y:1020
 		{
 			yyLOCAL = nil
 		}
@@ -7894,7 +8419,7 @@ yydefault:
 	case 78:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1020
+//line mysql_sql.y:1024
 		{
 			yyLOCAL = yyDollar[2].statementsUnion()
 		}
@@ -7902,7 +8427,7 @@ yydefault:
 	case 79:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1026
+//line mysql_sql.y:1030
 		{
 			ep := &tree.ExportParam{
 				Outfile:     true,
@@ -7922,7 +8447,7 @@ yydefault:
 	case 80:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1047
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -7937,7 +8462,7 @@ yydefault:
 	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1060
+//line mysql_sql.y:1064
 		{
 			yyLOCAL = &tree.LoadExtension{
 				Name: tree.Identifier(yyDollar[2].str),
@@ -7947,7 +8472,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1067
+//line mysql_sql.y:1071
 		{
 			yyLOCAL = nil
 		}
@@ -7955,7 +8480,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1071
+//line mysql_sql.y:1075
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
@@ -7963,7 +8488,7 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1077
+//line mysql_sql.y:1081
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
@@ -7971,7 +8496,7 @@ yydefault:
 	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1081
+//line mysql_sql.y:1085
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
@@ -7979,7 +8504,7 @@ yydefault:
 	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1087
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -7990,7 +8515,7 @@ yydefault:
 	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1094
+//line mysql_sql.y:1098
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -8001,7 +8526,7 @@ yydefault:
 	case 88:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1102
+//line mysql_sql.y:1106
 		{
 			yyLOCAL = false
 		}
@@ -8009,7 +8534,7 @@ yydefault:
 	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1106
+//line mysql_sql.y:1110
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -8025,7 +8550,7 @@ yydefault:
 	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1120
+//line mysql_sql.y:1124
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
@@ -8033,7 +8558,7 @@ yydefault:
 	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1124
+//line mysql_sql.y:1128
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
@@ -8041,7 +8566,7 @@ yydefault:
 	case 92:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1128
+//line mysql_sql.y:1132
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
@@ -8049,7 +8574,7 @@ yydefault:
 	case 93:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1133
+//line mysql_sql.y:1137
 		{
 			yyLOCAL = nil
 		}
@@ -8057,7 +8582,7 @@ yydefault:
 	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1137
+//line mysql_sql.y:1141
 		{
 			yyLOCAL = nil
 		}
@@ -8065,7 +8590,7 @@ yydefault:
 	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1141
+//line mysql_sql.y:1145
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
@@ -8073,7 +8598,7 @@ yydefault:
 	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1147
+//line mysql_sql.y:1151
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8086,7 +8611,7 @@ yydefault:
 	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1156
+//line mysql_sql.y:1160
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8099,7 +8624,7 @@ yydefault:
 	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1167
+//line mysql_sql.y:1171
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
@@ -8107,7 +8632,7 @@ yydefault:
 	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1171
+//line mysql_sql.y:1175
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -8115,7 +8640,7 @@ yydefault:
 	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1177
+//line mysql_sql.y:1181
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
@@ -8123,7 +8648,7 @@ yydefault:
 	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1181
+//line mysql_sql.y:1185
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
@@ -8131,7 +8656,7 @@ yydefault:
 	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1187
+//line mysql_sql.y:1191
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -8139,7 +8664,7 @@ yydefault:
 	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1191
+//line mysql_sql.y:1195
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -8147,7 +8672,7 @@ yydefault:
 	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1197
+//line mysql_sql.y:1201
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8173,7 +8698,7 @@ yydefault:
 	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1221
+//line mysql_sql.y:1225
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -8195,7 +8720,7 @@ yydefault:
 	case 106:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1240
+//line mysql_sql.y:1244
 		{
 			yyLOCAL = 0
 		}
@@ -8203,7 +8728,7 @@ yydefault:
 	case 107:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1244
+//line mysql_sql.y:1248
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
@@ -8211,7 +8736,7 @@ yydefault:
 	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1248
+//line mysql_sql.y:1252
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
@@ -8219,7 +8744,7 @@ yydefault:
 	case 109:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1253
+//line mysql_sql.y:1257
 		{
 			yyLOCAL = nil
 		}
@@ -8227,7 +8752,7 @@ yydefault:
 	case 110:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1257
+//line mysql_sql.y:1261
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -8238,7 +8763,7 @@ yydefault:
 	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1264
+//line mysql_sql.y:1268
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[3].str,
@@ -8248,32 +8773,32 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 112:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1272
+//line mysql_sql.y:1276
 		{
 			yyVAL.str = ""
 		}
 	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1279
+//line mysql_sql.y:1283
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 115:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1284
+//line mysql_sql.y:1288
 		{
 			yyVAL.str = "\n"
 		}
 	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1291
+//line mysql_sql.y:1295
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1296
+//line mysql_sql.y:1300
 		{
 			yyLOCAL = nil
 		}
@@ -8281,7 +8806,7 @@ yydefault:
 	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1300
+//line mysql_sql.y:1304
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -8307,7 +8832,7 @@ yydefault:
 	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1324
+//line mysql_sql.y:1328
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
@@ -8315,7 +8840,7 @@ yydefault:
 	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1328
+//line mysql_sql.y:1332
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
@@ -8323,7 +8848,7 @@ yydefault:
 	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1334
+//line mysql_sql.y:1338
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
@@ -8333,7 +8858,7 @@ yydefault:
 	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1340
+//line mysql_sql.y:1344
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -8355,7 +8880,7 @@ yydefault:
 	case 124:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1358
+//line mysql_sql.y:1362
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -8376,7 +8901,7 @@ yydefault:
 	case 125:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1375
+//line mysql_sql.y:1379
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -8397,7 +8922,7 @@ yydefault:
 	case 127:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1398
+//line mysql_sql.y:1402
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
@@ -8405,7 +8930,7 @@ yydefault:
 	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1402
+//line mysql_sql.y:1406
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
@@ -8413,7 +8938,7 @@ yydefault:
 	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1406
+//line mysql_sql.y:1410
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
@@ -8421,7 +8946,7 @@ yydefault:
 	case 130:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1411
+//line mysql_sql.y:1415
 		{
 			yyLOCAL = false
 		}
@@ -8429,7 +8954,7 @@ yydefault:
 	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1415
+//line mysql_sql.y:1419
 		{
 			yyLOCAL = true
 		}
@@ -8437,7 +8962,7 @@ yydefault:
 	case 132:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1421
+//line mysql_sql.y:1425
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -8454,7 +8979,7 @@ yydefault:
 	case 133:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1438
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -8469,7 +8994,7 @@ yydefault:
 	case 134:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1445
+//line mysql_sql.y:1449
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -8485,7 +9010,7 @@ yydefault:
 	case 135:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1458
+//line mysql_sql.y:1462
 		{
 			yyLOCAL = false
 		}
@@ -8493,7 +9018,7 @@ yydefault:
 	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1462
+//line mysql_sql.y:1466
 		{
 			yyLOCAL = true
 		}
@@ -8501,7 +9026,7 @@ yydefault:
 	case 137:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1472
+//line mysql_sql.y:1476
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -8518,7 +9043,7 @@ yydefault:
 	case 138:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1485
+//line mysql_sql.y:1489
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -8533,7 +9058,7 @@ yydefault:
 	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1498
+//line mysql_sql.y:1502
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
@@ -8543,7 +9068,7 @@ yydefault:
 	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1504
+//line mysql_sql.y:1508
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
@@ -8553,7 +9078,7 @@ yydefault:
 	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1510
+//line mysql_sql.y:1514
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -8564,7 +9089,7 @@ yydefault:
 	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1517
+//line mysql_sql.y:1521
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -8576,7 +9101,7 @@ yydefault:
 	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1525
+//line mysql_sql.y:1529
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -8587,7 +9112,7 @@ yydefault:
 	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1534
+//line mysql_sql.y:1538
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
@@ -8595,7 +9120,7 @@ yydefault:
 	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1538
+//line mysql_sql.y:1542
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
@@ -8603,7 +9128,7 @@ yydefault:
 	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1542
+//line mysql_sql.y:1546
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
@@ -8611,7 +9136,7 @@ yydefault:
 	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1546
+//line mysql_sql.y:1550
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
@@ -8619,7 +9144,7 @@ yydefault:
 	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1550
+//line mysql_sql.y:1554
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
@@ -8627,7 +9152,7 @@ yydefault:
 	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1554
+//line mysql_sql.y:1558
 		{
 			yyLOCAL = tree.OBJECT_TYPE_ACCOUNT
 		}
@@ -8635,7 +9160,7 @@ yydefault:
 	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1561
+//line mysql_sql.y:1565
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
@@ -8643,7 +9168,7 @@ yydefault:
 	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1565
+//line mysql_sql.y:1569
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
@@ -8651,7 +9176,7 @@ yydefault:
 	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1571
+//line mysql_sql.y:1575
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8662,7 +9187,7 @@ yydefault:
 	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1578
+//line mysql_sql.y:1582
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -8673,7 +9198,7 @@ yydefault:
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1587
+//line mysql_sql.y:1591
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
@@ -8681,7 +9206,7 @@ yydefault:
 	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1591
+//line mysql_sql.y:1595
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
@@ -8689,7 +9214,7 @@ yydefault:
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1597
+//line mysql_sql.y:1601
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
@@ -8697,7 +9222,7 @@ yydefault:
 	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1601
+//line mysql_sql.y:1605
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ACCOUNT
 		}
@@ -8705,7 +9230,7 @@ yydefault:
 	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1605
+//line mysql_sql.y:1609
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ACCOUNT
 		}
@@ -8713,7 +9238,7 @@ yydefault:
 	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1609
+//line mysql_sql.y:1613
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ACCOUNT
 		}
@@ -8721,7 +9246,7 @@ yydefault:
 	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1613
+//line mysql_sql.y:1617
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
@@ -8729,7 +9254,7 @@ yydefault:
 	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1617
+//line mysql_sql.y:1621
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_TABLE
 		}
@@ -8737,7 +9262,7 @@ yydefault:
 	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1621
+//line mysql_sql.y:1625
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_VIEW
 		}
@@ -8745,7 +9270,7 @@ yydefault:
 	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1625
+//line mysql_sql.y:1629
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
@@ -8753,7 +9278,7 @@ yydefault:
 	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1629
+//line mysql_sql.y:1633
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
@@ -8761,7 +9286,7 @@ yydefault:
 	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1633
+//line mysql_sql.y:1637
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_USER
 		}
@@ -8769,7 +9294,7 @@ yydefault:
 	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1637
+//line mysql_sql.y:1641
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_USER
 		}
@@ -8777,7 +9302,7 @@ yydefault:
 	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1641
+//line mysql_sql.y:1645
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
@@ -8785,7 +9310,7 @@ yydefault:
 	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1645
+//line mysql_sql.y:1649
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
@@ -8793,7 +9318,7 @@ yydefault:
 	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1649
+//line mysql_sql.y:1653
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
@@ -8801,7 +9326,7 @@ yydefault:
 	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1653
+//line mysql_sql.y:1657
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_TABLE
 		}
@@ -8809,7 +9334,7 @@ yydefault:
 	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1657
+//line mysql_sql.y:1661
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_VIEW
 		}
@@ -8817,7 +9342,7 @@ yydefault:
 	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1661
+//line mysql_sql.y:1665
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
@@ -8825,7 +9350,7 @@ yydefault:
 	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1665
+//line mysql_sql.y:1669
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
@@ -8833,7 +9358,7 @@ yydefault:
 	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1669
+//line mysql_sql.y:1673
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
@@ -8841,7 +9366,7 @@ yydefault:
 	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1673
+//line mysql_sql.y:1677
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
@@ -8849,7 +9374,7 @@ yydefault:
 	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1677
+//line mysql_sql.y:1681
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
@@ -8857,7 +9382,7 @@ yydefault:
 	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1681
+//line mysql_sql.y:1685
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE
 		}
@@ -8865,7 +9390,7 @@ yydefault:
 	case 178:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1685
+//line mysql_sql.y:1689
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_DATABASE
 		}
@@ -8873,7 +9398,7 @@ yydefault:
 	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1689
+//line mysql_sql.y:1693
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
@@ -8881,7 +9406,7 @@ yydefault:
 	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1693
+//line mysql_sql.y:1697
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CONNECT
 		}
@@ -8889,7 +9414,7 @@ yydefault:
 	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1697
+//line mysql_sql.y:1701
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_MANAGE_GRANTS
 		}
@@ -8897,7 +9422,7 @@ yydefault:
 	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1701
+//line mysql_sql.y:1705
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP
 		}
@@ -8905,7 +9430,7 @@ yydefault:
 	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1705
+//line mysql_sql.y:1709
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES
 		}
@@ -8913,7 +9438,7 @@ yydefault:
 	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1709
+//line mysql_sql.y:1713
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLE
 		}
@@ -8921,7 +9446,7 @@ yydefault:
 	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1713
+//line mysql_sql.y:1717
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
@@ -8929,7 +9454,7 @@ yydefault:
 	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1717
+//line mysql_sql.y:1721
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
@@ -8937,7 +9462,7 @@ yydefault:
 	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1721
+//line mysql_sql.y:1725
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
@@ -8945,7 +9470,7 @@ yydefault:
 	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1725
+//line mysql_sql.y:1729
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCE
 		}
@@ -8953,7 +9478,7 @@ yydefault:
 	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1729
+//line mysql_sql.y:1733
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
@@ -8961,7 +9486,7 @@ yydefault:
 	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1733
+//line mysql_sql.y:1737
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
@@ -8969,7 +9494,7 @@ yydefault:
 	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1737
+//line mysql_sql.y:1741
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
@@ -8977,7 +9502,7 @@ yydefault:
 	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1741
+//line mysql_sql.y:1745
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
@@ -8985,7 +9510,7 @@ yydefault:
 	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1745
+//line mysql_sql.y:1749
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
@@ -8993,7 +9518,7 @@ yydefault:
 	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1749
+//line mysql_sql.y:1753
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
@@ -9001,7 +9526,7 @@ yydefault:
 	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1753
+//line mysql_sql.y:1757
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
@@ -9009,7 +9534,7 @@ yydefault:
 	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1757
+//line mysql_sql.y:1761
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
@@ -9017,7 +9542,7 @@ yydefault:
 	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1761
+//line mysql_sql.y:1765
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
@@ -9025,7 +9550,7 @@ yydefault:
 	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1765
+//line mysql_sql.y:1769
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
@@ -9033,7 +9558,7 @@ yydefault:
 	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1769
+//line mysql_sql.y:1773
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
@@ -9041,7 +9566,7 @@ yydefault:
 	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1773
+//line mysql_sql.y:1777
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROLE
 		}
@@ -9049,7 +9574,7 @@ yydefault:
 	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1777
+//line mysql_sql.y:1781
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
@@ -9057,7 +9582,7 @@ yydefault:
 	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1781
+//line mysql_sql.y:1785
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
@@ -9065,7 +9590,7 @@ yydefault:
 	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1785
+//line mysql_sql.y:1789
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
@@ -9073,7 +9598,7 @@ yydefault:
 	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1789
+//line mysql_sql.y:1793
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
@@ -9081,7 +9606,7 @@ yydefault:
 	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1793
+//line mysql_sql.y:1797
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRUNCATE
 		}
@@ -9089,7 +9614,7 @@ yydefault:
 	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1805
+//line mysql_sql.y:1809
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole: false,
@@ -9100,7 +9625,7 @@ yydefault:
 	case 211:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1816
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -9111,7 +9636,7 @@ yydefault:
 	case 212:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1819
+//line mysql_sql.y:1823
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -9122,7 +9647,7 @@ yydefault:
 	case 213:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1828
+//line mysql_sql.y:1832
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
@@ -9132,7 +9657,7 @@ yydefault:
 	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1858
+//line mysql_sql.y:1862
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
@@ -9140,7 +9665,7 @@ yydefault:
 	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1862
+//line mysql_sql.y:1866
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
@@ -9148,7 +9673,7 @@ yydefault:
 	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1866
+//line mysql_sql.y:1870
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
@@ -9156,7 +9681,7 @@ yydefault:
 	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1872
+//line mysql_sql.y:1876
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
@@ -9164,7 +9689,7 @@ yydefault:
 	case 218:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1878
+//line mysql_sql.y:1882
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
@@ -9172,21 +9697,21 @@ yydefault:
 	case 219:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1882
+//line mysql_sql.y:1886
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
 	case 221:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1889
+//line mysql_sql.y:1893
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1895
+//line mysql_sql.y:1899
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
@@ -9194,7 +9719,7 @@ yydefault:
 	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1899
+//line mysql_sql.y:1903
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
@@ -9202,7 +9727,7 @@ yydefault:
 	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1905
+//line mysql_sql.y:1909
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9214,7 +9739,7 @@ yydefault:
 	case 225:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1913
+//line mysql_sql.y:1917
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9227,7 +9752,7 @@ yydefault:
 	case 226:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1922
+//line mysql_sql.y:1926
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9239,7 +9764,7 @@ yydefault:
 	case 227:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1930
+//line mysql_sql.y:1934
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -9251,7 +9776,7 @@ yydefault:
 	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1938
+//line mysql_sql.y:1942
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -9278,7 +9803,7 @@ yydefault:
 	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1961
+//line mysql_sql.y:1965
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -9305,7 +9830,7 @@ yydefault:
 	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1984
+//line mysql_sql.y:1988
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9316,7 +9841,7 @@ yydefault:
 	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1991
+//line mysql_sql.y:1995
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9327,7 +9852,7 @@ yydefault:
 	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1998
+//line mysql_sql.y:2002
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     strings.ToLower(yyDollar[1].str),
@@ -9339,7 +9864,7 @@ yydefault:
 	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2006
+//line mysql_sql.y:2010
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9350,7 +9875,7 @@ yydefault:
 	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2013
+//line mysql_sql.y:2017
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9361,7 +9886,7 @@ yydefault:
 	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2020
+//line mysql_sql.y:2024
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -9372,7 +9897,7 @@ yydefault:
 	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2029
+//line mysql_sql.y:2033
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
@@ -9380,7 +9905,7 @@ yydefault:
 	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2033
+//line mysql_sql.y:2037
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
@@ -9388,39 +9913,39 @@ yydefault:
 	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2037
+//line mysql_sql.y:2041
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
 	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2043
+//line mysql_sql.y:2047
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2047
+//line mysql_sql.y:2051
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2053
+//line mysql_sql.y:2057
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
 	case 242:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2057
+//line mysql_sql.y:2061
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare() + "." + yyDollar[3].cstrUnion().Compare()
 		}
 	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2063
+//line mysql_sql.y:2067
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
@@ -9428,7 +9953,7 @@ yydefault:
 	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2067
+//line mysql_sql.y:2071
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
@@ -9436,7 +9961,7 @@ yydefault:
 	case 248:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2078
+//line mysql_sql.y:2082
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
@@ -9444,7 +9969,7 @@ yydefault:
 	case 249:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2084
+//line mysql_sql.y:2088
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
@@ -9452,7 +9977,7 @@ yydefault:
 	case 250:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2089
+//line mysql_sql.y:2093
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -9460,7 +9985,7 @@ yydefault:
 	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2093
+//line mysql_sql.y:2097
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -9468,7 +9993,7 @@ yydefault:
 	case 252:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2097
+//line mysql_sql.y:2101
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
@@ -9476,7 +10001,7 @@ yydefault:
 	case 253:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2101
+//line mysql_sql.y:2105
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
@@ -9484,7 +10009,7 @@ yydefault:
 	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2105
+//line mysql_sql.y:2109
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
@@ -9492,7 +10017,7 @@ yydefault:
 	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2109
+//line mysql_sql.y:2113
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
@@ -9500,7 +10025,7 @@ yydefault:
 	case 256:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2113
+//line mysql_sql.y:2117
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -9508,7 +10033,7 @@ yydefault:
 	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2117
+//line mysql_sql.y:2121
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -9516,7 +10041,7 @@ yydefault:
 	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2121
+//line mysql_sql.y:2125
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
@@ -9524,7 +10049,7 @@ yydefault:
 	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2127
+//line mysql_sql.y:2131
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -9532,7 +10057,7 @@ yydefault:
 	case 260:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2131
+//line mysql_sql.y:2135
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -9540,7 +10065,7 @@ yydefault:
 	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2135
+//line mysql_sql.y:2139
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -9548,7 +10073,7 @@ yydefault:
 	case 262:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2139
+//line mysql_sql.y:2143
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
@@ -9557,7 +10082,7 @@ yydefault:
 	case 263:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2144
+//line mysql_sql.y:2148
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
@@ -9566,7 +10091,7 @@ yydefault:
 	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2149
+//line mysql_sql.y:2153
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
@@ -9574,7 +10099,7 @@ yydefault:
 	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2155
+//line mysql_sql.y:2159
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9585,7 +10110,7 @@ yydefault:
 	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2162
+//line mysql_sql.y:2166
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9595,7 +10120,7 @@ yydefault:
 	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2168
+//line mysql_sql.y:2172
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -9606,7 +10131,7 @@ yydefault:
 	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2175
+//line mysql_sql.y:2179
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -9617,7 +10142,7 @@ yydefault:
 	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2182
+//line mysql_sql.y:2186
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -9628,7 +10153,7 @@ yydefault:
 	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2192
+//line mysql_sql.y:2196
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
@@ -9637,7 +10162,7 @@ yydefault:
 	case 272:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2199
+//line mysql_sql.y:2203
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -9652,7 +10177,7 @@ yydefault:
 	case 273:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2210
+//line mysql_sql.y:2214
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -9665,7 +10190,7 @@ yydefault:
 	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2221
+//line mysql_sql.y:2225
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
@@ -9673,7 +10198,7 @@ yydefault:
 	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2225
+//line mysql_sql.y:2229
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
@@ -9681,7 +10206,7 @@ yydefault:
 	case 276:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:2231
+//line mysql_sql.y:2235
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
@@ -9689,7 +10214,7 @@ yydefault:
 	case 279:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2241
+//line mysql_sql.y:2245
 		{
 			yyLOCAL = &tree.LockTableStmt{TableLocks: yyDollar[3].tableLocksUnion()}
 		}
@@ -9697,7 +10222,7 @@ yydefault:
 	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2247
+//line mysql_sql.y:2251
 		{
 			yyLOCAL = []tree.TableLock{yyDollar[1].tableLockUnion()}
 		}
@@ -9705,7 +10230,7 @@ yydefault:
 	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2251
+//line mysql_sql.y:2255
 		{
 			yyLOCAL = append(yyDollar[1].tableLocksUnion(), yyDollar[3].tableLockUnion())
 		}
@@ -9713,7 +10238,7 @@ yydefault:
 	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLock
-//line mysql_sql.y:2257
+//line mysql_sql.y:2261
 		{
 			yyLOCAL = tree.TableLock{*yyDollar[1].tableNameUnion(), yyDollar[2].tableLockTypeUnion()}
 		}
@@ -9721,7 +10246,7 @@ yydefault:
 	case 283:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2263
+//line mysql_sql.y:2267
 		{
 			yyLOCAL = tree.TableLockRead
 		}
@@ -9729,7 +10254,7 @@ yydefault:
 	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2267
+//line mysql_sql.y:2271
 		{
 			yyLOCAL = tree.TableLockReadLocal
 		}
@@ -9737,7 +10262,7 @@ yydefault:
 	case 285:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2271
+//line mysql_sql.y:2275
 		{
 			yyLOCAL = tree.TableLockWrite
 		}
@@ -9745,7 +10270,7 @@ yydefault:
 	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2275
+//line mysql_sql.y:2279
 		{
 			yyLOCAL = tree.TableLockLowPriorityWrite
 		}
@@ -9753,7 +10278,7 @@ yydefault:
 	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2281
+//line mysql_sql.y:2285
 		{
 			yyLOCAL = &tree.UnLockTableStmt{}
 		}
@@ -9761,7 +10286,7 @@ yydefault:
 	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2293
+//line mysql_sql.y:2297
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -9769,7 +10294,7 @@ yydefault:
 	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2299
+//line mysql_sql.y:2303
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
@@ -9777,7 +10302,7 @@ yydefault:
 	case 296:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2303
+//line mysql_sql.y:2307
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
@@ -9785,7 +10310,7 @@ yydefault:
 	case 297:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2309
+//line mysql_sql.y:2313
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
@@ -9793,7 +10318,7 @@ yydefault:
 	case 298:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2313
+//line mysql_sql.y:2317
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
@@ -9801,7 +10326,7 @@ yydefault:
 	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2319
+//line mysql_sql.y:2323
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
@@ -9809,7 +10334,7 @@ yydefault:
 	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2325
+//line mysql_sql.y:2329
 		{
 			yyLOCAL = tree.NewReset(tree.Identifier(yyDollar[3].str))
 		}
@@ -9817,7 +10342,7 @@ yydefault:
 	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2335
+//line mysql_sql.y:2339
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -9825,7 +10350,7 @@ yydefault:
 	case 306:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2341
+//line mysql_sql.y:2345
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
@@ -9833,7 +10358,7 @@ yydefault:
 	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2345
+//line mysql_sql.y:2349
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
@@ -9841,7 +10366,7 @@ yydefault:
 	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2349
+//line mysql_sql.y:2353
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
@@ -9849,7 +10374,7 @@ yydefault:
 	case 309:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2353
+//line mysql_sql.y:2357
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
@@ -9857,7 +10382,7 @@ yydefault:
 	case 310:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2357
+//line mysql_sql.y:2361
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
@@ -9865,7 +10390,7 @@ yydefault:
 	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2361
+//line mysql_sql.y:2365
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -9877,7 +10402,7 @@ yydefault:
 	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2369
+//line mysql_sql.y:2373
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -9889,7 +10414,7 @@ yydefault:
 	case 313:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2377
+//line mysql_sql.y:2381
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -9903,7 +10428,7 @@ yydefault:
 	case 314:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2387
+//line mysql_sql.y:2391
 		{
 			if tree.IsContainAnalyze(yyDollar[3].epxlainOptionsUnion()) {
 				explainStmt := tree.NewExplainAnalyze(yyDollar[5].statementUnion(), "text")
@@ -9919,7 +10444,7 @@ yydefault:
 	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2428
+//line mysql_sql.y:2432
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
@@ -9927,7 +10452,7 @@ yydefault:
 	case 328:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2432
+//line mysql_sql.y:2436
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
@@ -9935,39 +10460,39 @@ yydefault:
 	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:2438
+//line mysql_sql.y:2442
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
 	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2444
+//line mysql_sql.y:2448
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2449
+//line mysql_sql.y:2453
 		{
 			yyVAL.str = "true"
 		}
 	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2450
+//line mysql_sql.y:2454
 		{
 			yyVAL.str = "false"
 		}
 	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2451
+//line mysql_sql.y:2455
 		{
 			yyVAL.str = yyDollar[1].str
 		}
 	case 334:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2456
+//line mysql_sql.y:2460
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
@@ -9975,7 +10500,7 @@ yydefault:
 	case 341:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2471
+//line mysql_sql.y:2475
 		{
 			yyLOCAL = &tree.AlterView{
 				Name:     yyDollar[4].tableNameUnion(),
@@ -9988,7 +10513,7 @@ yydefault:
 	case 342:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2482
+//line mysql_sql.y:2486
 		{
 			yyLOCAL = &tree.AlterTable{
 				Table:   yyDollar[3].tableNameUnion(),
@@ -9997,25 +10522,121 @@ yydefault:
 		}
 		yyVAL.union = yyLOCAL
 	case 343:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2493
+		{
+			yyLOCAL = &tree.AlterTable{
+				Table:           yyDollar[3].tableNameUnion(),
+				PartitionOption: yyDollar[4].alterPartitionOptionUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 344:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterPartitionOption
+//line mysql_sql.y:2502
+		{
+			yyLOCAL = &tree.AlterPartitionAddPartition{
+				Partitions: yyDollar[4].partitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 345:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterPartitionOption
+//line mysql_sql.y:2508
+		{
+			yyLOCAL = &tree.AlterPartitionDropPartition{
+				PartitionNames: yyDollar[3].identifierListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 346:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterPartitionOption
+//line mysql_sql.y:2514
+		{
+			yyLOCAL = &tree.AlterPartitionTruncatePartition{
+				PartitionNames: yyDollar[3].identifierListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 347:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterPartitionOption
+//line mysql_sql.y:2520
+		{
+			yyLOCAL = &tree.AlterPartitionTruncatePartition{
+				All: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 348:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.AlterPartitionOption
+//line mysql_sql.y:2526
+		{
+			yyLOCAL = &tree.AlterPartitionReorganizePartition{
+				PartitionNames: yyDollar[3].identifierListUnion(),
+				Partitions:     yyDollar[6].partitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 349:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.AlterPartitionOption
+//line mysql_sql.y:2533
+		{
+			yyLOCAL = &tree.AlterPartitionExchangePartition{
+				PartitionName: tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				Table:         yyDollar[6].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 350:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.AlterPartitionOption
+//line mysql_sql.y:2540
+		{
+			yyLOCAL = &tree.AlterPartitionExchangePartition{
+				PartitionName: tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				Table:         yyDollar[6].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 351:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.AlterPartitionOption
+//line mysql_sql.y:2547
+		{
+			yyLOCAL = &tree.AlterPartitionExchangePartition{
+				PartitionName:     tree.Identifier(yyDollar[3].cstrUnion().Compare()),
+				Table:             yyDollar[6].tableNameUnion(),
+				WithoutValidation: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2491
+//line mysql_sql.y:2557
 		{
 			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2495
+//line mysql_sql.y:2561
 		{
 			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 354:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2501
+//line mysql_sql.y:2567
 		{
 			opt := &tree.AlterOptionAdd{
 				Def: yyDollar[2].tableDefUnion(),
@@ -10023,34 +10644,142 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 355:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2574
+		{
+			opt := &tree.AlterOptionAddColumn{
+				Column:   yyDollar[3].columnTableDefUnion(),
+				Position: yyDollar[4].alterColPositionUnion(),
+			}
+			yyLOCAL = tree.AlterTableOption(opt)
+		}
+		yyVAL.union = yyLOCAL
+	case 356:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2582
+		{
+			opt := &tree.AlterOptionAddColumn{
+				Column:   yyDollar[2].columnTableDefUnion(),
+				Position: yyDollar[3].alterColPositionUnion(),
+			}
+			yyLOCAL = tree.AlterTableOption(opt)
+		}
+		yyVAL.union = yyLOCAL
+	case 357:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2590
+		{
+			opt := &tree.AlterOptionModifyColumn{
+				NewColumn: yyDollar[3].columnTableDefUnion(),
+				Position:  yyDollar[4].alterColPositionUnion(),
+			}
+			yyLOCAL = tree.AlterTableOption(opt)
+		}
+		yyVAL.union = yyLOCAL
+	case 358:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2598
+		{
+			opt := &tree.AlterOptionChangeColumn{
+				OldColumnName: yyDollar[3].unresolvedNameUnion(),
+				NewColumn:     yyDollar[4].columnTableDefUnion(),
+				Position:      yyDollar[5].alterColPositionUnion(),
+			}
+			yyLOCAL = tree.AlterTableOption(opt)
+		}
+		yyVAL.union = yyLOCAL
+	case 359:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2607
+		{
+			opt := &tree.AlterOptionRenameColumn{
+				OldColumnName: yyDollar[3].unresolvedNameUnion(),
+				NewColumnName: yyDollar[5].unresolvedNameUnion(),
+			}
+			yyLOCAL = tree.AlterTableOption(opt)
+		}
+		yyVAL.union = yyLOCAL
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2508
+//line mysql_sql.y:2615
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 361:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2512
+//line mysql_sql.y:2619
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2516
+//line mysql_sql.y:2623
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[1].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 363:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:2628
+		{
+		}
+	case 364:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:2630
+		{
+		}
+	case 365:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2633
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 366:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2637
+		{
+			yyLOCAL = yyDollar[1].alterColPositionUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 367:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2643
+		{
+			yyLOCAL = &tree.ColumnPosition{
+				Typ: tree.ColumnPositionFirst,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 368:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ColumnPosition
+//line mysql_sql.y:2649
+		{
+			yyLOCAL = &tree.ColumnPosition{
+				Typ:            tree.ColumnPositionAfter,
+				RelativeColumn: yyDollar[2].unresolvedNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2522
+//line mysql_sql.y:2658
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropIndex,
@@ -10058,10 +10787,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2529
+//line mysql_sql.y:2665
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropKey,
@@ -10069,10 +10798,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2536
+//line mysql_sql.y:2672
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropColumn,
@@ -10080,10 +10809,21 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 372:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2679
+		{
+			yyLOCAL = &tree.AlterOptionDrop{
+				Typ:  tree.AlterTableDropColumn,
+				Name: tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2543
+//line mysql_sql.y:2686
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropForeignKey,
@@ -10091,20 +10831,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2550
+//line mysql_sql.y:2693
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ: tree.AlterTableDropPrimaryKey,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 375:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2558
+//line mysql_sql.y:2701
 		{
 			yyLOCAL = &tree.AlterOptionAlterIndex{
 				Visibility: yyDollar[3].indexVisibilityUnion(),
@@ -10112,26 +10852,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2567
+//line mysql_sql.y:2710
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_VISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2571
+//line mysql_sql.y:2714
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_INVISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 378:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2578
+//line mysql_sql.y:2721
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -10142,10 +10882,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 379:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2590
+//line mysql_sql.y:2733
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				DbName:         yyDollar[3].str,
@@ -10154,10 +10894,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 380:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2598
+//line mysql_sql.y:2741
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				AccountName:    yyDollar[4].str,
@@ -10166,20 +10906,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 381:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2607
+//line mysql_sql.y:2750
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 382:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2613
+//line mysql_sql.y:2756
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist:          true,
@@ -10189,10 +10929,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 383:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2624
+//line mysql_sql.y:2767
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -10203,222 +10943,222 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 384:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2635
+//line mysql_sql.y:2778
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 385:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:2639
+//line mysql_sql.y:2782
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 386:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2644
+//line mysql_sql.y:2787
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 387:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2648
+//line mysql_sql.y:2791
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 388:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2653
+//line mysql_sql.y:2796
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2657
+//line mysql_sql.y:2800
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2673
+//line mysql_sql.y:2816
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2677
+//line mysql_sql.y:2820
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2681
+//line mysql_sql.y:2824
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2685
+//line mysql_sql.y:2828
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2689
+//line mysql_sql.y:2832
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2693
+//line mysql_sql.y:2836
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2697
+//line mysql_sql.y:2840
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2701
+//line mysql_sql.y:2844
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 398:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2705
+//line mysql_sql.y:2848
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 399:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2709
+//line mysql_sql.y:2852
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2713
+//line mysql_sql.y:2856
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 401:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2717
+//line mysql_sql.y:2860
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 402:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2721
+//line mysql_sql.y:2864
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 403:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2725
+//line mysql_sql.y:2868
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2729
+//line mysql_sql.y:2872
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 405:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:2733
+//line mysql_sql.y:2876
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2739
+//line mysql_sql.y:2882
 		{
 			yyVAL.item = nil
 		}
-	case 386:
+	case 407:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2744
+//line mysql_sql.y:2887
 		{
 			yyVAL.item = nil
 		}
-	case 416:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2783
+//line mysql_sql.y:2926
 		{
 			yyLOCAL = &tree.ShowCollation{}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2788
+//line mysql_sql.y:2931
 		{
 			yyLOCAL = &tree.ShowGrants{ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 439:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2792
+//line mysql_sql.y:2935
 		{
 			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname, Roles: yyDollar[5].rolesUnion(), ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 440:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2796
+//line mysql_sql.y:2939
 		{
 			s := &tree.ShowGrants{}
 			roles := []*tree.Role{tree.NewRole(yyDollar[5].cstrUnion().Compare())}
@@ -10427,44 +11167,44 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 441:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2805
+//line mysql_sql.y:2948
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 442:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:2809
+//line mysql_sql.y:2952
 		{
 			yyLOCAL = yyDollar[2].rolesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 443:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2815
+//line mysql_sql.y:2958
 		{
 			yyLOCAL = &tree.ShowTableStatus{DbName: yyDollar[5].str, Like: yyDollar[6].comparisionExprUnion(), Where: yyDollar[7].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2820
+//line mysql_sql.y:2963
 		{
 		}
-	case 425:
+	case 446:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2824
+//line mysql_sql.y:2967
 		{
 		}
-	case 427:
+	case 448:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2829
+//line mysql_sql.y:2972
 		{
 			yyLOCAL = &tree.ShowFunctionOrProcedureStatus{
 				Like:       yyDollar[4].comparisionExprUnion(),
@@ -10473,10 +11213,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 449:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2839
+//line mysql_sql.y:2982
 		{
 			yyLOCAL = &tree.ShowFunctionOrProcedureStatus{
 				Like:       yyDollar[4].comparisionExprUnion(),
@@ -10485,68 +11225,68 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2849
+//line mysql_sql.y:2992
 		{
 			yyLOCAL = &tree.ShowRolesStmt{
 				Like: yyDollar[3].comparisionExprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2857
+//line mysql_sql.y:3000
 		{
 			yyLOCAL = &tree.ShowNodeList{}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 452:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2863
+//line mysql_sql.y:3006
 		{
 			yyLOCAL = &tree.ShowLocks{}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2869
+//line mysql_sql.y:3012
 		{
 			yyLOCAL = &tree.ShowTableNumber{DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2875
+//line mysql_sql.y:3018
 		{
 			yyLOCAL = &tree.ShowColumnNumber{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 455:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2881
+//line mysql_sql.y:3024
 		{
 			yyLOCAL = &tree.ShowTableValues{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 456:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2887
+//line mysql_sql.y:3030
 		{
 			yyLOCAL = &tree.ShowTableSize{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 457:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2893
+//line mysql_sql.y:3036
 		{
 			s := yyDollar[2].statementUnion().(*tree.ShowTarget)
 			s.Like = yyDollar[3].comparisionExprUnion()
@@ -10554,74 +11294,74 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2902
+//line mysql_sql.y:3045
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowConfig}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2906
+//line mysql_sql.y:3049
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2910
+//line mysql_sql.y:3053
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowEngines}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2914
+//line mysql_sql.y:3057
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowTriggers}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2918
+//line mysql_sql.y:3061
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowEvents}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2922
+//line mysql_sql.y:3065
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPlugins}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2926
+//line mysql_sql.y:3069
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPrivileges}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2930
+//line mysql_sql.y:3073
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProfiles}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 466:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2936
+//line mysql_sql.y:3079
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[5].tableNameUnion(),
@@ -10629,10 +11369,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 467:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2943
+//line mysql_sql.y:3086
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[7].cstrUnion().Compare()), ExplicitSchema: true}
 			tbl := tree.NewTableName(tree.Identifier(yyDollar[5].cstrUnion().Compare()), prefix)
@@ -10642,20 +11382,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 468:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2953
+//line mysql_sql.y:3096
 		{
 		}
-	case 448:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2955
+//line mysql_sql.y:3098
 		{
 		}
-	case 452:
+	case 473:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2964
+//line mysql_sql.y:3107
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -10664,10 +11404,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 474:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2974
+//line mysql_sql.y:3117
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -10676,58 +11416,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2983
+//line mysql_sql.y:3126
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2987
+//line mysql_sql.y:3130
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2991
+//line mysql_sql.y:3134
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 478:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2997
+//line mysql_sql.y:3140
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3003
+//line mysql_sql.y:3146
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3009
+//line mysql_sql.y:3152
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 481:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3015
+//line mysql_sql.y:3158
 		{
 			yyLOCAL = &tree.ShowSequences{
 				DBName: yyDollar[3].str,
@@ -10735,10 +11475,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 482:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3024
+//line mysql_sql.y:3167
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -10749,10 +11489,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 483:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3034
+//line mysql_sql.y:3177
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -10763,26 +11503,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 484:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3046
+//line mysql_sql.y:3189
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 485:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3050
+//line mysql_sql.y:3193
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 486:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3056
+//line mysql_sql.y:3199
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -10795,10 +11535,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 487:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3068
+//line mysql_sql.y:3211
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -10811,196 +11551,196 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3082
+//line mysql_sql.y:3225
 		{
 			yyLOCAL = &tree.ShowAccounts{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3088
+//line mysql_sql.y:3231
 		{
 			yyLOCAL = &tree.ShowPublications{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3095
+//line mysql_sql.y:3238
 		{
 			yyLOCAL = &tree.ShowSubscriptions{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 491:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3100
+//line mysql_sql.y:3243
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3104
+//line mysql_sql.y:3247
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3108
+//line mysql_sql.y:3251
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.ILIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3113
+//line mysql_sql.y:3256
 		{
 			yyVAL.str = ""
 		}
-	case 474:
+	case 495:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3117
+//line mysql_sql.y:3260
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 475:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3123
+//line mysql_sql.y:3266
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 501:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3136
+//line mysql_sql.y:3279
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3140
+//line mysql_sql.y:3283
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 503:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3146
+//line mysql_sql.y:3289
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 504:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3151
+//line mysql_sql.y:3294
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 505:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3155
+//line mysql_sql.y:3298
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 506:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3159
+//line mysql_sql.y:3302
 		{
 			yyLOCAL = &tree.ShowCreatePublications{Name: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3165
+//line mysql_sql.y:3308
 		{
 			yyLOCAL = &tree.ShowBackendServers{}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3171
+//line mysql_sql.y:3314
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 509:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3175
+//line mysql_sql.y:3318
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3181
+//line mysql_sql.y:3324
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 490:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3187
+//line mysql_sql.y:3330
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3191
+//line mysql_sql.y:3334
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3195
+//line mysql_sql.y:3338
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3201
+//line mysql_sql.y:3344
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[2].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3205
+//line mysql_sql.y:3348
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 529:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3228
+//line mysql_sql.y:3371
 		{
 			yyLOCAL = &tree.DropSequence{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11008,10 +11748,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 530:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3237
+//line mysql_sql.y:3380
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11019,10 +11759,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3246
+//line mysql_sql.y:3389
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11030,26 +11770,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3255
+//line mysql_sql.y:3398
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3259
+//line mysql_sql.y:3402
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3265
+//line mysql_sql.y:3408
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -11057,10 +11797,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3274
+//line mysql_sql.y:3417
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -11068,10 +11808,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 536:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3283
+//line mysql_sql.y:3426
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].cstrUnion().Compare()),
@@ -11080,42 +11820,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 537:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3293
+//line mysql_sql.y:3436
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 538:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3299
+//line mysql_sql.y:3442
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3305
+//line mysql_sql.y:3448
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 540:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3311
+//line mysql_sql.y:3454
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 541:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3317
+//line mysql_sql.y:3460
 		{
 			yyLOCAL = &tree.DropFunction{
 				Name: yyDollar[3].functionNameUnion(),
@@ -11123,10 +11863,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3326
+//line mysql_sql.y:3469
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[3].procNameUnion(),
@@ -11134,10 +11874,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 543:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3333
+//line mysql_sql.y:3476
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[5].procNameUnion(),
@@ -11145,28 +11885,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3344
+//line mysql_sql.y:3487
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3349
+//line mysql_sql.y:3492
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 548:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3356
+//line mysql_sql.y:3499
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -11183,10 +11923,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 549:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3372
+//line mysql_sql.y:3515
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -11196,10 +11936,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 550:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3385
+//line mysql_sql.y:3528
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -11209,69 +11949,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3396
+//line mysql_sql.y:3539
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3400
+//line mysql_sql.y:3543
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3406
+//line mysql_sql.y:3549
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 554:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3411
+//line mysql_sql.y:3554
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 555:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3418
+//line mysql_sql.y:3561
 		{
 		}
-	case 535:
+	case 556:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3420
+//line mysql_sql.y:3563
 		{
 		}
-	case 536:
+	case 557:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3423
+//line mysql_sql.y:3566
 		{
 		}
-	case 541:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3432
+//line mysql_sql.y:3575
 		{
 		}
-	case 543:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3436
+//line mysql_sql.y:3579
 		{
 		}
-	case 545:
+	case 566:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3441
+//line mysql_sql.y:3584
 		{
 			rep := yyDollar[4].replaceUnion()
 			rep.Table = yyDollar[2].tableExprUnion()
@@ -11279,10 +12019,10 @@ yydefault:
 			yyLOCAL = rep
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3450
+//line mysql_sql.y:3593
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11290,20 +12030,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3457
+//line mysql_sql.y:3600
 		{
 			yyLOCAL = &tree.Replace{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 569:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3463
+//line mysql_sql.y:3606
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11312,10 +12052,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3471
+//line mysql_sql.y:3614
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -11323,10 +12063,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 571:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3478
+//line mysql_sql.y:3621
 		{
 			yyLOCAL = &tree.Replace{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -11334,10 +12074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 572:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3485
+//line mysql_sql.y:3628
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of replace can not be empty")
@@ -11356,10 +12096,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 573:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3505
+//line mysql_sql.y:3648
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -11368,42 +12108,42 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3514
+//line mysql_sql.y:3657
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3518
+//line mysql_sql.y:3661
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3524
+//line mysql_sql.y:3667
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3528
+//line mysql_sql.y:3671
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3534
+//line mysql_sql.y:3677
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11411,20 +12151,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 579:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3541
+//line mysql_sql.y:3684
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 580:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3547
+//line mysql_sql.y:3690
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11433,10 +12173,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 581:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3555
+//line mysql_sql.y:3698
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -11444,10 +12184,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 582:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3562
+//line mysql_sql.y:3705
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -11455,10 +12195,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3569
+//line mysql_sql.y:3712
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -11477,50 +12217,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 584:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3588
+//line mysql_sql.y:3731
 		{
 			yyLOCAL = []*tree.UpdateExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 585:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3592
+//line mysql_sql.y:3735
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3597
+//line mysql_sql.y:3740
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3601
+//line mysql_sql.y:3744
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3605
+//line mysql_sql.y:3748
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:3611
+//line mysql_sql.y:3754
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -11528,155 +12268,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3620
+//line mysql_sql.y:3763
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3624
+//line mysql_sql.y:3767
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3630
+//line mysql_sql.y:3773
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 572:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3634
+//line mysql_sql.y:3777
 		{
 			yyVAL.str = yyDollar[3].cstrUnion().Compare()
 		}
-	case 573:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3640
+//line mysql_sql.y:3783
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:3644
+//line mysql_sql.y:3787
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3650
+//line mysql_sql.y:3793
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3655
+//line mysql_sql.y:3798
 		{
 		}
-	case 578:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3659
+//line mysql_sql.y:3802
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3666
+//line mysql_sql.y:3809
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:3670
+//line mysql_sql.y:3813
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 604:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:3677
+//line mysql_sql.y:3820
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 605:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3682
+//line mysql_sql.y:3825
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3686
+//line mysql_sql.y:3829
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3692
+//line mysql_sql.y:3835
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3696
+//line mysql_sql.y:3839
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 609:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3702
+//line mysql_sql.y:3845
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 610:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:3706
+//line mysql_sql.y:3849
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3711
+//line mysql_sql.y:3854
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 612:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:3715
+//line mysql_sql.y:3858
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -11689,10 +12429,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 613:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3728
+//line mysql_sql.y:3871
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -11700,10 +12440,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3735
+//line mysql_sql.y:3878
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -11711,10 +12451,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 615:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3742
+//line mysql_sql.y:3885
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -11733,10 +12473,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 616:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:3760
+//line mysql_sql.y:3903
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -11755,38 +12495,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 617:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3779
+//line mysql_sql.y:3922
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:3785
+//line mysql_sql.y:3928
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 619:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3792
+//line mysql_sql.y:3935
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3796
+//line mysql_sql.y:3939
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -11799,115 +12539,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3809
+//line mysql_sql.y:3952
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 622:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3813
+//line mysql_sql.y:3956
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 623:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3818
+//line mysql_sql.y:3961
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 624:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3822
+//line mysql_sql.y:3965
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3829
+//line mysql_sql.y:3972
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3834
+//line mysql_sql.y:3977
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3841
+//line mysql_sql.y:3984
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 629:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3847
+//line mysql_sql.y:3990
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3851
+//line mysql_sql.y:3994
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 631:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3855
+//line mysql_sql.y:3998
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 632:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3859
+//line mysql_sql.y:4002
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 633:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3863
+//line mysql_sql.y:4006
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 634:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:3867
+//line mysql_sql.y:4010
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3873
+//line mysql_sql.y:4016
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -11915,10 +12655,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 636:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:3880
+//line mysql_sql.y:4023
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -11926,26 +12666,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 637:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3889
+//line mysql_sql.y:4032
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 638:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:3893
+//line mysql_sql.y:4036
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 639:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:3899
+//line mysql_sql.y:4042
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Cols: yyDollar[2].identifierListUnion()},
@@ -11953,178 +12693,178 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 640:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3907
+//line mysql_sql.y:4050
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3911
+//line mysql_sql.y:4054
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 642:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3916
+//line mysql_sql.y:4059
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3920
+//line mysql_sql.y:4063
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3926
+//line mysql_sql.y:4069
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 645:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3930
+//line mysql_sql.y:4073
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:3934
+//line mysql_sql.y:4077
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 647:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3939
+//line mysql_sql.y:4082
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3943
+//line mysql_sql.y:4086
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3949
+//line mysql_sql.y:4092
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3955
+//line mysql_sql.y:4098
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:3959
+//line mysql_sql.y:4102
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:3965
+//line mysql_sql.y:4108
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 653:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3970
+//line mysql_sql.y:4113
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3974
+//line mysql_sql.y:4117
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:3978
+//line mysql_sql.y:4121
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3983
+//line mysql_sql.y:4126
 		{
 			yyLOCAL = tree.DefaultNullsPosition
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 657:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3987
+//line mysql_sql.y:4130
 		{
 			yyLOCAL = tree.NullsFirst
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 658:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:3991
+//line mysql_sql.y:4134
 		{
 			yyLOCAL = tree.NullsLast
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:3997
+//line mysql_sql.y:4140
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4001
+//line mysql_sql.y:4144
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4005
+//line mysql_sql.y:4148
 		{
 			valuesStmt := yyDollar[2].statementUnion().(*tree.ValuesStatement)
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{
@@ -12137,18 +12877,18 @@ yydefault:
 			}}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4019
+//line mysql_sql.y:4162
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4023
+//line mysql_sql.y:4166
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12159,10 +12899,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 664:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4033
+//line mysql_sql.y:4176
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12173,10 +12913,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4043
+//line mysql_sql.y:4186
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12187,10 +12927,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4053
+//line mysql_sql.y:4196
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -12201,10 +12941,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4065
+//line mysql_sql.y:4208
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12213,10 +12953,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4073
+//line mysql_sql.y:4216
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12225,10 +12965,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 669:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4081
+//line mysql_sql.y:4224
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -12237,10 +12977,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4090
+//line mysql_sql.y:4233
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12249,10 +12989,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 671:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4098
+//line mysql_sql.y:4241
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12261,10 +13001,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 672:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4106
+//line mysql_sql.y:4249
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -12273,10 +13013,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4114
+//line mysql_sql.y:4257
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12285,10 +13025,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 674:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4122
+//line mysql_sql.y:4265
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12297,10 +13037,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 675:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4130
+//line mysql_sql.y:4273
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -12309,10 +13049,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4138
+//line mysql_sql.y:4281
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12321,10 +13061,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 677:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4146
+//line mysql_sql.y:4289
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12333,10 +13073,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4154
+//line mysql_sql.y:4297
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -12345,10 +13085,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 679:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4164
+//line mysql_sql.y:4307
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -12360,10 +13100,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 680:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4175
+//line mysql_sql.y:4318
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
@@ -12376,149 +13116,149 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4189
+//line mysql_sql.y:4332
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 661:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4193
+//line mysql_sql.y:4336
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 662:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4197
+//line mysql_sql.y:4340
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 663:
+	case 684:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4202
+//line mysql_sql.y:4345
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4206
+//line mysql_sql.y:4349
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4210
+//line mysql_sql.y:4353
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 689:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4219
+//line mysql_sql.y:4362
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4223
+//line mysql_sql.y:4366
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 691:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4228
+//line mysql_sql.y:4371
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4232
+//line mysql_sql.y:4375
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 693:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4237
+//line mysql_sql.y:4380
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4241
+//line mysql_sql.y:4384
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4247
+//line mysql_sql.y:4390
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4251
+//line mysql_sql.y:4394
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4257
+//line mysql_sql.y:4400
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4261
+//line mysql_sql.y:4404
 		{
 			yyDollar[2].cstrUnion().SetConfig(0)
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].cstrUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4266
+//line mysql_sql.y:4409
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 700:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4270
+//line mysql_sql.y:4413
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 701:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4275
+//line mysql_sql.y:4418
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -12527,28 +13267,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4283
+//line mysql_sql.y:4426
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4289
+//line mysql_sql.y:4432
 		{
 			yyLOCAL = &tree.From{
 				Tables: tree.TableExprs{yyDollar[2].joinTableExprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4297
+//line mysql_sql.y:4440
 		{
 			if t, ok := yyDollar[1].tableExprUnion().(*tree.JoinTableExpr); ok {
 				yyLOCAL = t
@@ -12557,26 +13297,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4305
+//line mysql_sql.y:4448
 		{
 			yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].joinTableExprUnion(), Right: yyDollar[3].tableExprUnion(), JoinType: tree.JOIN_TYPE_CROSS}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4315
+//line mysql_sql.y:4458
 		{
 			yyLOCAL = yyDollar[1].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 709:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4321
+//line mysql_sql.y:4464
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12586,10 +13326,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 710:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4330
+//line mysql_sql.y:4473
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12599,10 +13339,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 711:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4339
+//line mysql_sql.y:4482
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12612,10 +13352,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4348
+//line mysql_sql.y:4491
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -12624,15 +13364,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4358
+//line mysql_sql.y:4501
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 693:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4362
+//line mysql_sql.y:4505
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -12640,34 +13380,34 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 694:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4372
+//line mysql_sql.y:4515
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 695:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4376
+//line mysql_sql.y:4519
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 696:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4380
+//line mysql_sql.y:4523
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 697:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4384
+//line mysql_sql.y:4527
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 698:
+	case 719:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4390
+//line mysql_sql.y:4533
 		{
 			yyLOCAL = &tree.ValuesStatement{
 				Rows:    yyDollar[2].rowsExprsUnion(),
@@ -12676,130 +13416,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4400
+//line mysql_sql.y:4543
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4404
+//line mysql_sql.y:4547
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 722:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4410
+//line mysql_sql.y:4553
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4416
+//line mysql_sql.y:4559
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4420
+//line mysql_sql.y:4563
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4426
+//line mysql_sql.y:4569
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 705:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4432
+//line mysql_sql.y:4575
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 706:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4436
+//line mysql_sql.y:4579
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 707:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4440
+//line mysql_sql.y:4583
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 708:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4446
+//line mysql_sql.y:4589
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4450
+//line mysql_sql.y:4593
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4456
+//line mysql_sql.y:4599
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 732:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4460
+//line mysql_sql.y:4603
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4466
+//line mysql_sql.y:4609
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4470
+//line mysql_sql.y:4613
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4476
+//line mysql_sql.y:4619
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4480
+//line mysql_sql.y:4623
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -12810,10 +13550,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4490
+//line mysql_sql.y:4633
 		{
 			if yyDollar[2].str != "" {
 				yyLOCAL = &tree.AliasedTableExpr{
@@ -12827,26 +13567,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4503
+//line mysql_sql.y:4646
 		{
 			yyLOCAL = yyDollar[2].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:4509
+//line mysql_sql.y:4652
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[1].selectStatementUnion().(*tree.ParenSelect).Select}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 740:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4515
+//line mysql_sql.y:4658
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].cstrUnion().Compare()))
 			yyLOCAL = &tree.TableFunction{
@@ -12858,10 +13598,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:4528
+//line mysql_sql.y:4671
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -12872,34 +13612,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 742:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4539
+//line mysql_sql.y:4682
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4546
+//line mysql_sql.y:4689
 		{
 			yyLOCAL = []*tree.IndexHint{yyDollar[1].indexHintUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 745:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4550
+//line mysql_sql.y:4693
 		{
 			yyLOCAL = append(yyDollar[1].indexHintListUnion(), yyDollar[2].indexHintUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 746:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.IndexHint
-//line mysql_sql.y:4556
+//line mysql_sql.y:4699
 		{
 			yyLOCAL = &tree.IndexHint{
 				IndexNames: yyDollar[4].strsUnion(),
@@ -12908,176 +13648,176 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4566
+//line mysql_sql.y:4709
 		{
 			yyLOCAL = tree.HintUse
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 748:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4570
+//line mysql_sql.y:4713
 		{
 			yyLOCAL = tree.HintIgnore
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4574
+//line mysql_sql.y:4717
 		{
 			yyLOCAL = tree.HintForce
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 750:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4579
+//line mysql_sql.y:4722
 		{
 			yyLOCAL = tree.HintForScan
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4583
+//line mysql_sql.y:4726
 		{
 			yyLOCAL = tree.HintForJoin
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4587
+//line mysql_sql.y:4730
 		{
 			yyLOCAL = tree.HintForOrderBy
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 753:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4591
+//line mysql_sql.y:4734
 		{
 			yyLOCAL = tree.HintForGroupBy
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 754:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4596
+//line mysql_sql.y:4739
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4600
+//line mysql_sql.y:4743
 		{
 			yyLOCAL = []string{yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4604
+//line mysql_sql.y:4747
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4608
+//line mysql_sql.y:4751
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4612
+//line mysql_sql.y:4755
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 759:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4617
+//line mysql_sql.y:4760
 		{
 			yyVAL.str = ""
 		}
-	case 739:
+	case 760:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4621
+//line mysql_sql.y:4764
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 740:
+	case 761:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4625
+//line mysql_sql.y:4768
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 741:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4631
+//line mysql_sql.y:4774
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 743:
+	case 764:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4637
+//line mysql_sql.y:4780
 		{
 			yyLOCAL = tree.NewCStr("", yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4641
+//line mysql_sql.y:4784
 		{
 			yyLOCAL = yyDollar[1].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 766:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4645
+//line mysql_sql.y:4788
 		{
 			yyLOCAL = yyDollar[2].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 767:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4649
+//line mysql_sql.y:4792
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 768:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:4653
+//line mysql_sql.y:4796
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[2].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4659
+//line mysql_sql.y:4802
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 762:
+	case 783:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4692
+//line mysql_sql.y:4835
 		{
 			yyLOCAL = &tree.CreateExtension{
 				Language: yyDollar[3].str,
@@ -13086,22 +13826,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4702
+//line mysql_sql.y:4845
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 764:
+	case 785:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4708
+//line mysql_sql.y:4851
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 765:
+	case 786:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4714
+//line mysql_sql.y:4857
 		{
 			yyLOCAL = &tree.CreateProcedure{
 				Name: yyDollar[3].procNameUnion(),
@@ -13110,100 +13850,100 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:4724
+//line mysql_sql.y:4867
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[1].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 788:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:4729
+//line mysql_sql.y:4872
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().ToLower()), ExplicitSchema: true}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[3].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 789:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4735
+//line mysql_sql.y:4878
 		{
 			yyLOCAL = tree.ProcedureArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4742
+//line mysql_sql.y:4885
 		{
 			yyLOCAL = tree.ProcedureArgs{yyDollar[1].procArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 792:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:4746
+//line mysql_sql.y:4889
 		{
 			yyLOCAL = append(yyDollar[1].procArgsUnion(), yyDollar[3].procArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 793:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArg
-//line mysql_sql.y:4752
+//line mysql_sql.y:4895
 		{
 			yyLOCAL = tree.ProcedureArg(yyDollar[1].procArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 794:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureArgDecl
-//line mysql_sql.y:4758
+//line mysql_sql.y:4901
 		{
 			yyLOCAL = tree.NewProcedureArgDecl(yyDollar[1].procArgTypeUnion(), yyDollar[2].unresolvedNameUnion(), yyDollar[3].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 795:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4763
+//line mysql_sql.y:4906
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 796:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4767
+//line mysql_sql.y:4910
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4771
+//line mysql_sql.y:4914
 		{
 			yyLOCAL = tree.TYPE_OUT
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:4775
+//line mysql_sql.y:4918
 		{
 			yyLOCAL = tree.TYPE_INOUT
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 799:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4782
+//line mysql_sql.y:4925
 		{
 			yyLOCAL = &tree.CreateFunction{
 				Name:       yyDollar[3].functionNameUnion(),
@@ -13214,98 +13954,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 800:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4794
+//line mysql_sql.y:4937
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 801:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:4799
+//line mysql_sql.y:4942
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 802:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4805
+//line mysql_sql.y:4948
 		{
 			yyLOCAL = tree.FunctionArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4812
+//line mysql_sql.y:4955
 		{
 			yyLOCAL = tree.FunctionArgs{yyDollar[1].funcArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 805:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:4816
+//line mysql_sql.y:4959
 		{
 			yyLOCAL = append(yyDollar[1].funcArgsUnion(), yyDollar[3].funcArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArg
-//line mysql_sql.y:4822
+//line mysql_sql.y:4965
 		{
 			yyLOCAL = tree.FunctionArg(yyDollar[1].funcArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 807:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4828
+//line mysql_sql.y:4971
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(nil, yyDollar[1].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 808:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4832
+//line mysql_sql.y:4975
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 809:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:4836
+//line mysql_sql.y:4979
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 810:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4842
+//line mysql_sql.y:4985
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 790:
+	case 811:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReturnType
-//line mysql_sql.y:4848
+//line mysql_sql.y:4991
 		{
 			yyLOCAL = tree.NewReturnType(yyDollar[1].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 812:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4854
+//line mysql_sql.y:4997
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[4].tableNameUnion(),
@@ -13315,10 +14055,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 813:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4865
+//line mysql_sql.y:5008
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -13329,16 +14069,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 814:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4877
+//line mysql_sql.y:5020
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 794:
+	case 815:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:4883
+//line mysql_sql.y:5026
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -13347,22 +14087,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4893
+//line mysql_sql.y:5036
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 796:
+	case 817:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4897
+//line mysql_sql.y:5040
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 797:
+	case 818:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4903
+//line mysql_sql.y:5046
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -13370,20 +14110,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 819:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4910
+//line mysql_sql.y:5053
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 820:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:4916
+//line mysql_sql.y:5059
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -13391,20 +14131,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 821:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4924
+//line mysql_sql.y:5067
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 822:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4930
+//line mysql_sql.y:5073
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -13412,10 +14152,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 823:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:4937
+//line mysql_sql.y:5080
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -13423,20 +14163,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 824:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4945
+//line mysql_sql.y:5088
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 825:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:4951
+//line mysql_sql.y:5094
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist:   true,
@@ -13444,24 +14184,25 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
-		yyDollar = yyS[yypt-7 : yypt+1]
+	case 826:
+		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4960
+//line mysql_sql.y:5103
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
 				Users:              yyDollar[4].usersUnion(),
 				Role:               yyDollar[5].accountRoleUnion(),
-				MiscOpt:            yyDollar[6].userMiscOptionUnion(),
-				CommentOrAttribute: yyDollar[7].accountCommentOrAttributeUnion(),
+				TlsOpts:            yyDollar[6].tlsOptionsUnion(),
+				MiscOpt:            yyDollar[7].userMiscOptionUnion(),
+				CommentOrAttribute: yyDollar[8].accountCommentOrAttributeUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 827:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4972
+//line mysql_sql.y:5116
 		{
 			yyLOCAL = &tree.CreatePublication{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13472,22 +14213,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 828:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4983
+//line mysql_sql.y:5127
 		{
 			yyVAL.str = ""
 		}
-	case 808:
+	case 829:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4987
+//line mysql_sql.y:5131
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 809:
+	case 830:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4993
+//line mysql_sql.y:5137
 		{
 			yyLOCAL = &tree.AlterPublication{
 				IfExists:    yyDollar[3].boolValUnion(),
@@ -13497,58 +14238,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 831:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5003
+//line mysql_sql.y:5147
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 832:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5007
+//line mysql_sql.y:5151
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				All: true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 833:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5013
+//line mysql_sql.y:5157
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				SetAccounts: yyDollar[2].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 834:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5019
+//line mysql_sql.y:5163
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				AddAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 835:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5025
+//line mysql_sql.y:5169
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				DropAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 836:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5034
+//line mysql_sql.y:5178
 		{
 			yyLOCAL = &tree.DropPublication{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -13556,26 +14297,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5043
+//line mysql_sql.y:5187
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 817:
+	case 838:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5048
+//line mysql_sql.y:5192
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5054
+//line mysql_sql.y:5198
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -13584,10 +14325,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 840:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5062
+//line mysql_sql.y:5206
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -13596,26 +14337,125 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 841:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:5253
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 843:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:5260
+		{
+			t := &tree.TlsOptionNone{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 844:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:5265
+		{
+			t := &tree.TlsOptionSSL{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 845:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:5270
+		{
+			t := &tree.TlsOptionX509{}
+			yyLOCAL = []tree.TlsOption{t}
+		}
+		yyVAL.union = yyLOCAL
+	case 846:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:5275
+		{
+			yyLOCAL = yyDollar[2].tlsOptionsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 847:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:5281
+		{
+			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 848:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:5285
+		{
+			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 849:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.TlsOption
+//line mysql_sql.y:5289
+		{
+			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 850:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line mysql_sql.y:5295
+		{
+			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 851:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line mysql_sql.y:5299
+		{
+			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 852:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line mysql_sql.y:5303
+		{
+			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 853:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TlsOption
+//line mysql_sql.y:5307
+		{
+			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5168
+//line mysql_sql.y:5313
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 855:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5172
+//line mysql_sql.y:5317
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 856:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5178
+//line mysql_sql.y:5323
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -13624,26 +14464,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5188
+//line mysql_sql.y:5333
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 858:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5192
+//line mysql_sql.y:5337
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 859:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5198
+//line mysql_sql.y:5343
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -13652,50 +14492,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 860:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5208
+//line mysql_sql.y:5353
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5212
+//line mysql_sql.y:5357
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5216
+//line mysql_sql.y:5361
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 863:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5221
+//line mysql_sql.y:5366
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5225
+//line mysql_sql.y:5370
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 865:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5231
+//line mysql_sql.y:5376
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -13703,37 +14543,61 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 866:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5238
+//line mysql_sql.y:5383
+		{
+			yyLOCAL = &tree.AccountIdentified{
+				Typ: tree.AccountIdentifiedByRandomPassword,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 867:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.AccountIdentified
+//line mysql_sql.y:5389
+		{
+			yyLOCAL = &tree.AccountIdentified{
+				Typ: tree.AccountIdentifiedWithSSL,
+				Str: yyDollar[3].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 868:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.AccountIdentified
+//line mysql_sql.y:5396
 		{
 			yyLOCAL = &tree.AccountIdentified{
-				Typ: tree.AccountIdentifiedByRandomPassword,
+				Typ:    tree.AccountIdentifiedByPassword,
+				Str:    yyDollar[5].str,
+				Plugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 869:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5244
+//line mysql_sql.y:5404
 		{
 			yyLOCAL = &tree.AccountIdentified{
-				Typ: tree.AccountIdentifiedWithSSL,
-				Str: yyDollar[3].str,
+				Typ:    tree.AccountIdentifiedWithAuthString,
+				Str:    yyDollar[5].str,
+				Plugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5253
+//line mysql_sql.y:5414
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 836:
+	case 872:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5260
+//line mysql_sql.y:5421
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -13741,90 +14605,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 873:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5269
+//line mysql_sql.y:5430
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 874:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5273
+//line mysql_sql.y:5434
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 875:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:5279
+//line mysql_sql.y:5440
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 876:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5293
+//line mysql_sql.y:5454
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 877:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5297
+//line mysql_sql.y:5458
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 878:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5301
+//line mysql_sql.y:5462
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 879:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5306
+//line mysql_sql.y:5467
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 880:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5310
+//line mysql_sql.y:5471
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5314
+//line mysql_sql.y:5475
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5318
+//line mysql_sql.y:5479
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 883:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5324
+//line mysql_sql.y:5485
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -13843,18 +14707,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5343
+//line mysql_sql.y:5504
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5347
+//line mysql_sql.y:5508
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -13875,123 +14739,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 886:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5369
+//line mysql_sql.y:5530
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 887:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5373
+//line mysql_sql.y:5534
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 888:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5377
+//line mysql_sql.y:5538
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5381
+//line mysql_sql.y:5542
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 890:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5385
+//line mysql_sql.y:5546
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5391
+//line mysql_sql.y:5552
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 892:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5395
+//line mysql_sql.y:5556
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 893:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5401
+//line mysql_sql.y:5562
 		{
 			// Order is parsed but just ignored as MySQL dtree.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 894:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:5406
+//line mysql_sql.y:5567
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 895:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5411
+//line mysql_sql.y:5572
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 896:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5415
+//line mysql_sql.y:5576
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 897:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5419
+//line mysql_sql.y:5580
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 898:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5423
+//line mysql_sql.y:5584
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 899:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:5427
+//line mysql_sql.y:5588
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 900:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5433
+//line mysql_sql.y:5594
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -14001,114 +14865,139 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 901:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5444
+//line mysql_sql.y:5605
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 902:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:5448
+//line mysql_sql.y:5609
 		{
 			yyLOCAL = &tree.SubscriptionOption{From: tree.Identifier(yyDollar[2].str), Publication: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 903:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.SubscriptionOption
+//line mysql_sql.y:5613
+		{
+			yyLOCAL = &tree.SubscriptionOption{
+				From:        tree.Identifier(yyDollar[2].str),
+				Publication: tree.Identifier(yyDollar[4].cstrUnion().Compare()),
+				Remote:      true,
+				Conflict:    yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 904:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:5623
+		{
+			yyVAL.str = ""
+		}
+	case 905:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line mysql_sql.y:5627
+		{
+			yyVAL.str = strings.ToLower(yyDollar[2].cstrUnion().Compare())
+		}
+	case 908:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5458
+//line mysql_sql.y:5637
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 909:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5462
+//line mysql_sql.y:5641
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 910:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5467
+//line mysql_sql.y:5646
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 911:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5471
+//line mysql_sql.y:5650
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 912:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5477
+//line mysql_sql.y:5656
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 874:
+	case 913:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:5481
+//line mysql_sql.y:5660
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 875:
+	case 914:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5487
+//line mysql_sql.y:5666
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 915:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5491
+//line mysql_sql.y:5670
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 916:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:5495
+//line mysql_sql.y:5674
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 917:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5500
+//line mysql_sql.y:5679
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5504
+//line mysql_sql.y:5683
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 919:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5510
+//line mysql_sql.y:5689
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -14121,10 +15010,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 920:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5522
+//line mysql_sql.y:5701
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -14134,10 +15023,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 921:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5531
+//line mysql_sql.y:5710
 		{
 			yyLOCAL = &tree.CreateTable{
 				IsClusterTable:  true,
@@ -14150,19 +15039,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 922:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5544
+//line mysql_sql.y:5723
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 923:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5551
+//line mysql_sql.y:5730
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14173,10 +15062,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 924:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5561
+//line mysql_sql.y:5740
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14185,10 +15074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 925:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:5569
+//line mysql_sql.y:5748
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -14198,43 +15087,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5580
+//line mysql_sql.y:5759
 		{
 			yyLOCAL = yyDollar[1].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5584
+//line mysql_sql.y:5763
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].strsUnion()...)
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 928:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5589
+//line mysql_sql.y:5768
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 929:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5593
+//line mysql_sql.y:5772
 		{
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 			yyLOCAL = append(yyLOCAL, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 930:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:5600
+//line mysql_sql.y:5779
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -14245,10 +15134,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 931:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5611
+//line mysql_sql.y:5790
 		{
 			yyLOCAL = &tree.CreateSequence{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -14262,10 +15151,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 932:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5624
+//line mysql_sql.y:5803
 		{
 			locale := ""
 			fstr := "bigint"
@@ -14280,26 +15169,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 933:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5638
+//line mysql_sql.y:5817
 		{
 			yyLOCAL = yyDollar[2].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 934:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5642
+//line mysql_sql.y:5821
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 935:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5646
+//line mysql_sql.y:5825
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -14307,10 +15196,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 936:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5653
+//line mysql_sql.y:5832
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -14318,10 +15207,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 937:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5660
+//line mysql_sql.y:5839
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -14329,10 +15218,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 938:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:5667
+//line mysql_sql.y:5846
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -14340,42 +15229,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 939:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5674
+//line mysql_sql.y:5853
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 940:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5678
+//line mysql_sql.y:5857
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5682
+//line mysql_sql.y:5861
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 942:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5686
+//line mysql_sql.y:5865
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 943:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5690
+//line mysql_sql.y:5869
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: false,
@@ -14383,10 +15272,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:5697
+//line mysql_sql.y:5876
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: true,
@@ -14394,18 +15283,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5704
+//line mysql_sql.y:5883
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 946:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5708
+//line mysql_sql.y:5887
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: false,
@@ -14413,10 +15302,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:5715
+//line mysql_sql.y:5894
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: true,
@@ -14424,18 +15313,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 948:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5722
+//line mysql_sql.y:5901
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 949:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5726
+//line mysql_sql.y:5905
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -14443,10 +15332,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 950:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5733
+//line mysql_sql.y:5912
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -14454,10 +15343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 951:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5740
+//line mysql_sql.y:5919
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -14465,10 +15354,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 952:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:5747
+//line mysql_sql.y:5926
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -14476,34 +15365,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 953:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5754
+//line mysql_sql.y:5933
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5758
+//line mysql_sql.y:5937
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 955:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5763
+//line mysql_sql.y:5942
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 956:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:5767
+//line mysql_sql.y:5946
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -14513,18 +15402,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 957:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5777
+//line mysql_sql.y:5956
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 958:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5781
+//line mysql_sql.y:5960
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: []*tree.UnresolvedName{yyDollar[3].unresolvedNameUnion()},
@@ -14532,28 +15421,28 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 959:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:5788
+//line mysql_sql.y:5967
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: yyDollar[4].unresolveNamesUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 960:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5795
+//line mysql_sql.y:5974
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 961:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5799
+//line mysql_sql.y:5978
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -14562,42 +15451,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 962:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5808
+//line mysql_sql.y:5987
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5812
+//line mysql_sql.y:5991
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 964:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5818
+//line mysql_sql.y:5997
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:5822
+//line mysql_sql.y:6001
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 966:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5828
+//line mysql_sql.y:6007
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14607,10 +15496,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 967:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:5837
+//line mysql_sql.y:6016
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14620,42 +15509,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 968:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5847
+//line mysql_sql.y:6026
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 969:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5851
+//line mysql_sql.y:6030
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5857
+//line mysql_sql.y:6036
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 971:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:5861
+//line mysql_sql.y:6040
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 972:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5867
+//line mysql_sql.y:6046
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14663,10 +15552,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 973:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:5874
+//line mysql_sql.y:6053
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -14674,67 +15563,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 974:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5883
+//line mysql_sql.y:6062
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 975:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:5887
+//line mysql_sql.y:6066
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 976:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5892
+//line mysql_sql.y:6071
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 977:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5896
+//line mysql_sql.y:6075
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 978:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5901
+//line mysql_sql.y:6080
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 979:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:5905
+//line mysql_sql.y:6084
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 980:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5910
+//line mysql_sql.y:6089
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 981:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5914
+//line mysql_sql.y:6093
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -14744,18 +15633,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 982:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5924
+//line mysql_sql.y:6103
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 983:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:5928
+//line mysql_sql.y:6107
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -14765,10 +15654,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 984:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5939
+//line mysql_sql.y:6118
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -14777,10 +15666,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 985:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5947
+//line mysql_sql.y:6126
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -14789,10 +15678,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 986:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5955
+//line mysql_sql.y:6134
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -14801,10 +15690,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 987:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5963
+//line mysql_sql.y:6142
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -14813,10 +15702,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 989:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5974
+//line mysql_sql.y:6153
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14826,10 +15715,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 990:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5983
+//line mysql_sql.y:6162
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -14840,10 +15729,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 991:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:5993
+//line mysql_sql.y:6172
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -14853,487 +15742,495 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 992:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6003
+//line mysql_sql.y:6182
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 993:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6007
+//line mysql_sql.y:6186
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 994:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6012
+//line mysql_sql.y:6191
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6016
+//line mysql_sql.y:6195
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 996:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6021
+//line mysql_sql.y:6200
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6025
+//line mysql_sql.y:6204
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6031
+//line mysql_sql.y:6210
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 999:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6035
+//line mysql_sql.y:6214
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1000:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6039
+//line mysql_sql.y:6218
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1001:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6045
+//line mysql_sql.y:6224
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1002:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6049
+//line mysql_sql.y:6228
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1003:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6053
+//line mysql_sql.y:6232
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1004:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6057
+//line mysql_sql.y:6236
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1005:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6061
+//line mysql_sql.y:6240
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1006:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6065
+//line mysql_sql.y:6244
 		{
 			str := util.DealCommentString(yyDollar[3].str)
 			yyLOCAL = tree.NewTableOptionComment(str)
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1007:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6070
+//line mysql_sql.y:6249
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1008:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6074
+//line mysql_sql.y:6253
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1009:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6078
+//line mysql_sql.y:6257
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1010:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6082
+//line mysql_sql.y:6261
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1011:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6086
+//line mysql_sql.y:6265
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1012:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6090
+//line mysql_sql.y:6269
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1013:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6094
+//line mysql_sql.y:6273
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1014:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6098
+//line mysql_sql.y:6277
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1015:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6102
+//line mysql_sql.y:6281
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1016:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6106
+//line mysql_sql.y:6285
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1017:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6110
+//line mysql_sql.y:6289
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1018:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6114
+//line mysql_sql.y:6293
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1019:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6118
+//line mysql_sql.y:6297
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1020:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6122
+//line mysql_sql.y:6301
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1021:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6126
+//line mysql_sql.y:6305
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1022:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6130
+//line mysql_sql.y:6309
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1023:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6134
+//line mysql_sql.y:6313
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1024:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6138
+//line mysql_sql.y:6317
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1025:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6142
+//line mysql_sql.y:6321
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1026:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6146
+//line mysql_sql.y:6325
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1027:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6150
+//line mysql_sql.y:6329
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].cstrUnion().Compare(), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1028:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6154
+//line mysql_sql.y:6333
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1029:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6158
+//line mysql_sql.y:6337
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1030:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.TableOption
+//line mysql_sql.y:6341
+		{
+			yyLOCAL = tree.NewTableOptionTTL(yyDollar[3].unresolvedNameUnion(), yyDollar[6].item.(int64), yyDollar[7].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 1031:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6165
+//line mysql_sql.y:6348
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1032:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6169
+//line mysql_sql.y:6352
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1033:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:6175
+//line mysql_sql.y:6358
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1034:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6180
+//line mysql_sql.y:6363
 		{
 			yyVAL.str = ""
 		}
-	case 995:
+	case 1035:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6184
+//line mysql_sql.y:6367
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 996:
+	case 1036:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6188
+//line mysql_sql.y:6371
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 997:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6194
+//line mysql_sql.y:6377
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1038:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6198
+//line mysql_sql.y:6381
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6202
+//line mysql_sql.y:6385
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6206
+//line mysql_sql.y:6389
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6210
+//line mysql_sql.y:6393
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1042:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6214
+//line mysql_sql.y:6397
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6228
+//line mysql_sql.y:6411
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1048:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6232
+//line mysql_sql.y:6415
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1049:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6241
+//line mysql_sql.y:6424
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1050:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6246
+//line mysql_sql.y:6429
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1051:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6252
+//line mysql_sql.y:6435
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1053:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6259
+//line mysql_sql.y:6442
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1054:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6263
+//line mysql_sql.y:6446
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1055:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6269
+//line mysql_sql.y:6452
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6273
+//line mysql_sql.y:6456
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1057:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6277
+//line mysql_sql.y:6460
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1058:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6283
+//line mysql_sql.y:6466
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15343,10 +16240,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1059:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6292
+//line mysql_sql.y:6475
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -15370,10 +16267,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1060:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6317
+//line mysql_sql.y:6500
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -15388,18 +16285,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1061:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6331
+//line mysql_sql.y:6514
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1062:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6337
+//line mysql_sql.y:6520
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15409,10 +16306,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1063:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6346
+//line mysql_sql.y:6529
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -15422,10 +16319,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1064:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6355
+//line mysql_sql.y:6538
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -15436,10 +16333,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1065:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:6365
+//line mysql_sql.y:6548
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -15447,297 +16344,297 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1066:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6373
+//line mysql_sql.y:6556
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1068:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6379
+//line mysql_sql.y:6562
 		{
 			yyVAL.str = ""
 		}
-	case 1029:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6383
+//line mysql_sql.y:6566
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1032:
+	case 1072:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6393
+//line mysql_sql.y:6576
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1073:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6399
+//line mysql_sql.y:6582
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1074:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6405
+//line mysql_sql.y:6588
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].cstrUnion().Compare()
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1080:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6419
+//line mysql_sql.y:6602
 		{
 			yyVAL.str = ""
 		}
-	case 1041:
+	case 1081:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6423
+//line mysql_sql.y:6606
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 1042:
+	case 1082:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:6429
+//line mysql_sql.y:6612
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1083:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6435
+//line mysql_sql.y:6618
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1084:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6439
+//line mysql_sql.y:6622
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1085:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6443
+//line mysql_sql.y:6626
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1086:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6449
+//line mysql_sql.y:6632
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1087:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6453
+//line mysql_sql.y:6636
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1088:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6457
+//line mysql_sql.y:6640
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1089:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:6461
+//line mysql_sql.y:6644
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1090:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6467
+//line mysql_sql.y:6650
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1091:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6471
+//line mysql_sql.y:6654
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1092:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:6475
+//line mysql_sql.y:6658
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1093:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6480
+//line mysql_sql.y:6663
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1094:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6484
+//line mysql_sql.y:6667
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1095:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6490
+//line mysql_sql.y:6673
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1096:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:6494
+//line mysql_sql.y:6677
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1097:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6500
+//line mysql_sql.y:6683
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1098:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6504
+//line mysql_sql.y:6687
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1099:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6508
+//line mysql_sql.y:6691
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6512
+//line mysql_sql.y:6695
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6516
+//line mysql_sql.y:6699
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1102:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6520
+//line mysql_sql.y:6703
 		{
 			str := util.DealCommentString(yyDollar[2].str)
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(str), str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1103:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6525
+//line mysql_sql.y:6708
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6529
+//line mysql_sql.y:6712
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6533
+//line mysql_sql.y:6716
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6537
+//line mysql_sql.y:6720
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6541
+//line mysql_sql.y:6724
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1108:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6545
+//line mysql_sql.y:6728
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1109:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6549
+//line mysql_sql.y:6732
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1110:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6553
+//line mysql_sql.y:6736
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			var es tree.Exprs = nil
@@ -15751,58 +16648,58 @@ yydefault:
 			yyLOCAL = tree.NewAttributeOnUpdate(expr)
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:6566
+//line mysql_sql.y:6749
 		{
 			yyLOCAL = tree.NewAttributeLowCardinality()
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6572
+//line mysql_sql.y:6755
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6576
+//line mysql_sql.y:6759
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1114:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6581
+//line mysql_sql.y:6764
 		{
 			yyVAL.str = ""
 		}
-	case 1075:
+	case 1115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6585
+//line mysql_sql.y:6768
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1076:
+	case 1116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6591
+//line mysql_sql.y:6774
 		{
 			yyVAL.str = ""
 		}
-	case 1077:
+	case 1117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6595
+//line mysql_sql.y:6778
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 1078:
+	case 1118:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:6601
+//line mysql_sql.y:6784
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -15813,10 +16710,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1119:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6613
+//line mysql_sql.y:6796
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15824,10 +16721,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6620
+//line mysql_sql.y:6803
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15835,10 +16732,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1121:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6627
+//line mysql_sql.y:6810
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -15846,10 +16743,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6634
+//line mysql_sql.y:6817
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -15857,10 +16754,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:6641
+//line mysql_sql.y:6824
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -15868,339 +16765,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1124:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6650
+//line mysql_sql.y:6833
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1125:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6656
+//line mysql_sql.y:6839
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6662
+//line mysql_sql.y:6845
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6666
+//line mysql_sql.y:6849
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1128:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6670
+//line mysql_sql.y:6853
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1129:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6674
+//line mysql_sql.y:6857
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1130:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:6678
+//line mysql_sql.y:6861
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1131:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6683
+//line mysql_sql.y:6866
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1133:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6690
+//line mysql_sql.y:6873
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1134:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6694
+//line mysql_sql.y:6877
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1135:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:6698
+//line mysql_sql.y:6881
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1136:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6703
+//line mysql_sql.y:6886
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1137:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6707
+//line mysql_sql.y:6890
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1138:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6712
+//line mysql_sql.y:6895
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1139:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6716
+//line mysql_sql.y:6899
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:6732
+//line mysql_sql.y:6915
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6738
+//line mysql_sql.y:6921
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6742
+//line mysql_sql.y:6925
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6746
+//line mysql_sql.y:6929
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1150:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6750
+//line mysql_sql.y:6933
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6754
+//line mysql_sql.y:6937
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1152:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6758
+//line mysql_sql.y:6941
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1153:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6762
+//line mysql_sql.y:6945
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1154:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6766
+//line mysql_sql.y:6949
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1155:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6770
+//line mysql_sql.y:6953
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1156:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6774
+//line mysql_sql.y:6957
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1157:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6778
+//line mysql_sql.y:6961
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1158:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6782
+//line mysql_sql.y:6965
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1159:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6786
+//line mysql_sql.y:6969
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6792
+//line mysql_sql.y:6975
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1161:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6796
+//line mysql_sql.y:6979
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6800
+//line mysql_sql.y:6983
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1163:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6804
+//line mysql_sql.y:6987
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1164:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6808
+//line mysql_sql.y:6991
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6812
+//line mysql_sql.y:6995
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1166:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6816
+//line mysql_sql.y:6999
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1127:
+	case 1167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6820
+//line mysql_sql.y:7003
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1128:
+	case 1168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6824
+//line mysql_sql.y:7007
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1129:
+	case 1169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6828
+//line mysql_sql.y:7011
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6832
+//line mysql_sql.y:7015
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1131:
+	case 1171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6836
+//line mysql_sql.y:7019
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1132:
+	case 1172:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6841
+//line mysql_sql.y:7024
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -16209,26 +17106,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1133:
+	case 1173:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6849
+//line mysql_sql.y:7032
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1134:
+	case 1174:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6854
+//line mysql_sql.y:7037
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1135:
+	case 1175:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6858
+//line mysql_sql.y:7041
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -16238,50 +17135,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1136:
+	case 1176:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6867
+//line mysql_sql.y:7050
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1137:
+	case 1177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6871
+//line mysql_sql.y:7054
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1138:
+	case 1178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6875
+//line mysql_sql.y:7058
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1139:
+	case 1179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6879
+//line mysql_sql.y:7062
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1140:
+	case 1180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6883
+//line mysql_sql.y:7066
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1141:
+	case 1181:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:6889
+//line mysql_sql.y:7072
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16290,58 +17187,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1142:
+	case 1182:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6898
+//line mysql_sql.y:7081
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1143:
+	case 1183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6902
+//line mysql_sql.y:7085
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1144:
+	case 1184:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6907
+//line mysql_sql.y:7090
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1145:
+	case 1185:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:6911
+//line mysql_sql.y:7094
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1146:
+	case 1186:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6917
+//line mysql_sql.y:7100
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1147:
+	case 1187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:6921
+//line mysql_sql.y:7104
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1148:
+	case 1188:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:6927
+//line mysql_sql.y:7110
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -16349,19 +17246,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1149:
+	case 1189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6936
+//line mysql_sql.y:7119
 		{
 			t := yyVAL.columnTypeUnion()
 			if strings.ToLower(t.InternalType.FamilyString) == "binary" {
 				t.InternalType.Scale = -1
 			}
 		}
-	case 1150:
+	case 1190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6942
+//line mysql_sql.y:7125
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -16379,10 +17276,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1151:
+	case 1191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6959
+//line mysql_sql.y:7142
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16397,10 +17294,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1153:
+	case 1193:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6976
+//line mysql_sql.y:7159
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16414,10 +17311,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1154:
+	case 1194:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6989
+//line mysql_sql.y:7172
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16431,10 +17328,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1155:
+	case 1195:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7002
+//line mysql_sql.y:7185
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16447,10 +17344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1156:
+	case 1196:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7014
+//line mysql_sql.y:7197
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16465,10 +17362,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1157:
+	case 1197:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7028
+//line mysql_sql.y:7211
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16484,10 +17381,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1158:
+	case 1198:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7043
+//line mysql_sql.y:7226
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16503,10 +17400,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1159:
+	case 1199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7058
+//line mysql_sql.y:7241
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -16524,10 +17421,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1160:
+	case 1200:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7075
+//line mysql_sql.y:7258
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -16542,83 +17439,83 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1161:
+	case 1201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7090
+//line mysql_sql.y:7273
 		{
 		}
-	case 1164:
+	case 1204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7096
+//line mysql_sql.y:7279
 		{
 			yyLOCAL = &tree.WindowFrameBoundCurrentRow{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1165:
+	case 1205:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7100
+//line mysql_sql.y:7283
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1166:
+	case 1206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7104
+//line mysql_sql.y:7287
 		{
 			yyLOCAL = &tree.WindowFrameBoundPreceding{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1167:
+	case 1207:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7110
+//line mysql_sql.y:7293
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1168:
+	case 1208:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.WindowFrameBound
-//line mysql_sql.y:7114
+//line mysql_sql.y:7297
 		{
 			yyLOCAL = &tree.WindowFrameBoundFollowing{
 				Expr: yyDollar[1].exprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1169:
+	case 1209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7122
+//line mysql_sql.y:7305
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_ROWS
 		}
 		yyVAL.union = yyLOCAL
-	case 1170:
+	case 1210:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7126
+//line mysql_sql.y:7309
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_RANGE
 		}
 		yyVAL.union = yyLOCAL
-	case 1171:
+	case 1211:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.WindowFrameUnits
-//line mysql_sql.y:7130
+//line mysql_sql.y:7313
 		{
 			yyLOCAL = tree.WIN_FRAME_UNIT_GROUPS
 		}
 		yyVAL.union = yyLOCAL
-	case 1172:
+	case 1212:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7136
+//line mysql_sql.y:7319
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -16626,10 +17523,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1173:
+	case 1213:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7143
+//line mysql_sql.y:7326
 		{
 			yyLOCAL = &tree.WindowFrame{
 				Unit:       yyDollar[1].windowFrameUnitUnion(),
@@ -16638,70 +17535,70 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1174:
+	case 1214:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7152
+//line mysql_sql.y:7335
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1175:
+	case 1215:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.WindowFrame
-//line mysql_sql.y:7156
+//line mysql_sql.y:7339
 		{
 			yyLOCAL = yyDollar[1].windowFrameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1176:
+	case 1216:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7163
+//line mysql_sql.y:7346
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1177:
+	case 1217:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7168
+//line mysql_sql.y:7351
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1178:
+	case 1218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7172
+//line mysql_sql.y:7355
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1179:
+	case 1219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7177
+//line mysql_sql.y:7360
 		{
 			yyVAL.str = ","
 		}
-	case 1180:
+	case 1220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7181
+//line mysql_sql.y:7364
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1181:
+	case 1221:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:7186
+//line mysql_sql.y:7369
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1183:
+	case 1223:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:7193
+//line mysql_sql.y:7376
 		{
 			yyLOCAL = &tree.WindowSpec{
 				PartitionBy: yyDollar[3].exprsUnion(),
@@ -16710,10 +17607,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1184:
+	case 1224:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7203
+//line mysql_sql.y:7386
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16725,10 +17622,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1185:
+	case 1225:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7214
+//line mysql_sql.y:7397
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16739,10 +17636,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1186:
+	case 1226:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7224
+//line mysql_sql.y:7407
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16752,10 +17649,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1187:
+	case 1227:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7233
+//line mysql_sql.y:7416
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16765,10 +17662,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1188:
+	case 1228:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7242
+//line mysql_sql.y:7425
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16779,10 +17676,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1189:
+	case 1229:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7252
+//line mysql_sql.y:7435
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16793,10 +17690,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1190:
+	case 1230:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7262
+//line mysql_sql.y:7445
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16807,10 +17704,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1191:
+	case 1231:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7272
+//line mysql_sql.y:7455
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16821,10 +17718,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1192:
+	case 1232:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7282
+//line mysql_sql.y:7465
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -16835,10 +17732,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1193:
+	case 1233:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7292
+//line mysql_sql.y:7475
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16849,10 +17746,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1194:
+	case 1234:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7302
+//line mysql_sql.y:7485
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16863,10 +17760,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1195:
+	case 1235:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7312
+//line mysql_sql.y:7495
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16877,10 +17774,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1196:
+	case 1236:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7322
+//line mysql_sql.y:7505
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16891,10 +17788,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1197:
+	case 1237:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7332
+//line mysql_sql.y:7515
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16905,10 +17802,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1198:
+	case 1238:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7342
+//line mysql_sql.y:7525
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16919,10 +17816,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1199:
+	case 1239:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7352
+//line mysql_sql.y:7535
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16933,10 +17830,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1200:
+	case 1240:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7362
+//line mysql_sql.y:7545
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16947,10 +17844,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1204:
+	case 1244:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7379
+//line mysql_sql.y:7562
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16959,10 +17856,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1205:
+	case 1245:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7387
+//line mysql_sql.y:7570
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16971,10 +17868,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1206:
+	case 1246:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7395
+//line mysql_sql.y:7578
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16983,10 +17880,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1207:
+	case 1247:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7403
+//line mysql_sql.y:7586
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -16995,10 +17892,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1208:
+	case 1248:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7411
+//line mysql_sql.y:7594
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -17009,10 +17906,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1209:
+	case 1249:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7421
+//line mysql_sql.y:7604
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17021,10 +17918,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1210:
+	case 1250:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7429
+//line mysql_sql.y:7612
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17034,10 +17931,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1211:
+	case 1251:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7438
+//line mysql_sql.y:7621
 		{
 			name := tree.SetUnresolvedName("nextval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17046,10 +17943,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1212:
+	case 1252:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7446
+//line mysql_sql.y:7629
 		{
 			name := tree.SetUnresolvedName("setval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17058,10 +17955,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1213:
+	case 1253:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7454
+//line mysql_sql.y:7637
 		{
 			name := tree.SetUnresolvedName("currval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17070,10 +17967,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1214:
+	case 1254:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7462
+//line mysql_sql.y:7645
 		{
 			name := tree.SetUnresolvedName("lastval")
 			yyLOCAL = &tree.FuncExpr{
@@ -17082,10 +17979,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1215:
+	case 1255:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7470
+//line mysql_sql.y:7653
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64)
@@ -17097,10 +17994,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1216:
+	case 1256:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7481
+//line mysql_sql.y:7664
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
@@ -17111,10 +18008,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1217:
+	case 1257:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7491
+//line mysql_sql.y:7674
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(2), "2", false, tree.P_int64)
@@ -17127,10 +18024,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1218:
+	case 1258:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7503
+//line mysql_sql.y:7686
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(3), "3", false, tree.P_int64)
@@ -17142,10 +18039,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1219:
+	case 1259:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7514
+//line mysql_sql.y:7697
 		{
 			column := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
@@ -17155,16 +18052,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1226:
+	case 1266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7536
+//line mysql_sql.y:7719
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1255:
+	case 1295:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7572
+//line mysql_sql.y:7755
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17177,10 +18074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1256:
+	case 1296:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7584
+//line mysql_sql.y:7767
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17193,10 +18090,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1257:
+	case 1297:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7596
+//line mysql_sql.y:7779
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -17207,10 +18104,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1258:
+	case 1298:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7607
+//line mysql_sql.y:7790
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17219,10 +18116,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1259:
+	case 1299:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7615
+//line mysql_sql.y:7798
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17230,10 +18127,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1260:
+	case 1300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7622
+//line mysql_sql.y:7805
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -17241,10 +18138,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1261:
+	case 1301:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7629
+//line mysql_sql.y:7812
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -17257,10 +18154,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1262:
+	case 1302:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7641
+//line mysql_sql.y:7824
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -17269,10 +18166,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1263:
+	case 1303:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7649
+//line mysql_sql.y:7832
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -17283,10 +18180,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1264:
+	case 1304:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7659
+//line mysql_sql.y:7842
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -17297,10 +18194,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1265:
+	case 1305:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7669
+//line mysql_sql.y:7852
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -17309,10 +18206,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1266:
+	case 1306:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7677
+//line mysql_sql.y:7860
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -17324,10 +18221,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1267:
+	case 1307:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7688
+//line mysql_sql.y:7871
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -17337,10 +18234,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1268:
+	case 1308:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7697
+//line mysql_sql.y:7880
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -17350,10 +18247,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1269:
+	case 1309:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7706
+//line mysql_sql.y:7889
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -17362,10 +18259,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1270:
+	case 1310:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7714
+//line mysql_sql.y:7897
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -17376,10 +18273,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1271:
+	case 1311:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7724
+//line mysql_sql.y:7907
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -17388,10 +18285,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1272:
+	case 1312:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7732
+//line mysql_sql.y:7915
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -17401,34 +18298,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1273:
+	case 1313:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7742
+//line mysql_sql.y:7925
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1274:
+	case 1314:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7746
+//line mysql_sql.y:7929
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1275:
+	case 1315:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7752
+//line mysql_sql.y:7935
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1276:
+	case 1316:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7756
+//line mysql_sql.y:7939
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -17439,20 +18336,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1283:
+	case 1323:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7775
+//line mysql_sql.y:7958
 		{
 		}
-	case 1284:
+	case 1324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7777
+//line mysql_sql.y:7960
 		{
 		}
-	case 1318:
+	case 1358:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7818
+//line mysql_sql.y:8001
 		{
 			name := tree.SetUnresolvedName("interval")
 			str := strings.ToLower(yyDollar[3].str)
@@ -17463,90 +18360,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1319:
+	case 1359:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7829
+//line mysql_sql.y:8012
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1320:
+	case 1360:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7833
+//line mysql_sql.y:8016
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 1321:
+	case 1361:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:7837
+//line mysql_sql.y:8020
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1322:
+	case 1362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:7843
+//line mysql_sql.y:8026
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1323:
+	case 1363:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7848
+//line mysql_sql.y:8031
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1324:
+	case 1364:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7852
+//line mysql_sql.y:8035
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1325:
+	case 1365:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7858
+//line mysql_sql.y:8041
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1326:
+	case 1366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:7862
+//line mysql_sql.y:8045
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1327:
+	case 1367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7869
+//line mysql_sql.y:8052
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1328:
+	case 1368:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7873
+//line mysql_sql.y:8056
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1329:
+	case 1369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7877
+//line mysql_sql.y:8060
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -17555,355 +18452,355 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1330:
+	case 1370:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7885
+//line mysql_sql.y:8068
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1331:
+	case 1371:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7889
+//line mysql_sql.y:8072
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1332:
+	case 1372:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7893
+//line mysql_sql.y:8076
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1333:
+	case 1373:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7897
+//line mysql_sql.y:8080
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1334:
+	case 1374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7903
+//line mysql_sql.y:8086
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1335:
+	case 1375:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7907
+//line mysql_sql.y:8090
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1336:
+	case 1376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7911
+//line mysql_sql.y:8094
 		{
 			yyLOCAL = tree.NewIsUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1337:
+	case 1377:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7915
+//line mysql_sql.y:8098
 		{
 			yyLOCAL = tree.NewIsNotUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1338:
+	case 1378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7919
+//line mysql_sql.y:8102
 		{
 			yyLOCAL = tree.NewIsTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1339:
+	case 1379:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7923
+//line mysql_sql.y:8106
 		{
 			yyLOCAL = tree.NewIsNotTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1340:
+	case 1380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7927
+//line mysql_sql.y:8110
 		{
 			yyLOCAL = tree.NewIsFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1341:
+	case 1381:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7931
+//line mysql_sql.y:8114
 		{
 			yyLOCAL = tree.NewIsNotFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1342:
+	case 1382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7935
+//line mysql_sql.y:8118
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1343:
+	case 1383:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7939
+//line mysql_sql.y:8122
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1345:
+	case 1385:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7947
+//line mysql_sql.y:8130
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1346:
+	case 1386:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7951
+//line mysql_sql.y:8134
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1347:
+	case 1387:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7955
+//line mysql_sql.y:8138
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1348:
+	case 1388:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7959
+//line mysql_sql.y:8142
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1349:
+	case 1389:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7963
+//line mysql_sql.y:8146
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1350:
+	case 1390:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7967
+//line mysql_sql.y:8150
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1351:
+	case 1391:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7971
+//line mysql_sql.y:8154
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1352:
+	case 1392:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7975
+//line mysql_sql.y:8158
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1353:
+	case 1393:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7979
+//line mysql_sql.y:8162
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1354:
+	case 1394:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7983
+//line mysql_sql.y:8166
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1356:
+	case 1396:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7989
+//line mysql_sql.y:8172
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1357:
+	case 1397:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7993
+//line mysql_sql.y:8176
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1358:
+	case 1398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7999
+//line mysql_sql.y:8182
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1359:
+	case 1399:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8003
+//line mysql_sql.y:8186
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1360:
+	case 1400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8010
+//line mysql_sql.y:8193
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1361:
+	case 1401:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8014
+//line mysql_sql.y:8197
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1362:
+	case 1402:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8018
+//line mysql_sql.y:8201
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1363:
+	case 1403:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8024
+//line mysql_sql.y:8207
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1364:
+	case 1404:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8028
+//line mysql_sql.y:8211
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1365:
+	case 1405:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8032
+//line mysql_sql.y:8215
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1366:
+	case 1406:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8036
+//line mysql_sql.y:8219
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1367:
+	case 1407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8040
+//line mysql_sql.y:8223
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1368:
+	case 1408:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8044
+//line mysql_sql.y:8227
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1369:
+	case 1409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8048
+//line mysql_sql.y:8231
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1370:
+	case 1410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8054
+//line mysql_sql.y:8237
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1371:
+	case 1411:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8058
+//line mysql_sql.y:8241
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1372:
+	case 1412:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8062
+//line mysql_sql.y:8245
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1373:
+	case 1413:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8066
+//line mysql_sql.y:8249
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1374:
+	case 1414:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8072
+//line mysql_sql.y:8255
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1375:
+	case 1415:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8076
+//line mysql_sql.y:8259
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -17917,59 +18814,59 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1376:
+	case 1416:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8089
+//line mysql_sql.y:8272
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1377:
+	case 1417:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8094
+//line mysql_sql.y:8277
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1378:
+	case 1418:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8098
+//line mysql_sql.y:8281
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1379:
+	case 1419:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8102
+//line mysql_sql.y:8285
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1380:
+	case 1420:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8106
+//line mysql_sql.y:8289
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_hexnum)
 		}
 		yyVAL.union = yyLOCAL
-	case 1381:
+	case 1421:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8110
+//line mysql_sql.y:8293
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1382:
+	case 1422:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8114
+//line mysql_sql.y:8297
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -17984,53 +18881,53 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1383:
+	case 1423:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8128
+//line mysql_sql.y:8311
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1384:
+	case 1424:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8132
+//line mysql_sql.y:8315
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_ScoreBinary)
 		}
 		yyVAL.union = yyLOCAL
-	case 1385:
+	case 1425:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8139
+//line mysql_sql.y:8322
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1389:
+	case 1429:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8150
+//line mysql_sql.y:8333
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1390:
+	case 1430:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8155
+//line mysql_sql.y:8338
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1391:
+	case 1431:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8161
+//line mysql_sql.y:8344
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18043,10 +18940,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1392:
+	case 1432:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8173
+//line mysql_sql.y:8356
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18059,10 +18956,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1393:
+	case 1433:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8185
+//line mysql_sql.y:8368
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18075,10 +18972,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1394:
+	case 1434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8197
+//line mysql_sql.y:8380
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18092,10 +18989,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1395:
+	case 1435:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8210
+//line mysql_sql.y:8393
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18109,10 +19006,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1396:
+	case 1436:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8223
+//line mysql_sql.y:8406
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18126,10 +19023,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1397:
+	case 1437:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8236
+//line mysql_sql.y:8419
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18143,10 +19040,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1398:
+	case 1438:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8249
+//line mysql_sql.y:8432
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18160,10 +19057,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1399:
+	case 1439:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8262
+//line mysql_sql.y:8445
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18177,10 +19074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1400:
+	case 1440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8275
+//line mysql_sql.y:8458
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18194,10 +19091,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1401:
+	case 1441:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8288
+//line mysql_sql.y:8471
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18211,10 +19108,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1402:
+	case 1442:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8301
+//line mysql_sql.y:8484
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18228,10 +19125,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1403:
+	case 1443:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8314
+//line mysql_sql.y:8497
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18245,10 +19142,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1404:
+	case 1444:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8327
+//line mysql_sql.y:8510
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18262,10 +19159,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1405:
+	case 1445:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8342
+//line mysql_sql.y:8525
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -18293,10 +19190,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1406:
+	case 1446:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8369
+//line mysql_sql.y:8552
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -18338,10 +19235,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1407:
+	case 1447:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8411
+//line mysql_sql.y:8594
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Scale != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Scale > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -18378,10 +19275,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1408:
+	case 1448:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8459
+//line mysql_sql.y:8642
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18397,10 +19294,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1409:
+	case 1449:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8476
+//line mysql_sql.y:8659
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18413,10 +19310,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1410:
+	case 1450:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8488
+//line mysql_sql.y:8671
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18437,10 +19334,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1411:
+	case 1451:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8508
+//line mysql_sql.y:8691
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18461,10 +19358,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1412:
+	case 1452:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8528
+//line mysql_sql.y:8711
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -18485,10 +19382,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1413:
+	case 1453:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8548
+//line mysql_sql.y:8731
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18503,10 +19400,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1414:
+	case 1454:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8564
+//line mysql_sql.y:8747
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18520,10 +19417,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1415:
+	case 1455:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8577
+//line mysql_sql.y:8760
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18537,10 +19434,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1416:
+	case 1456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8590
+//line mysql_sql.y:8773
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18554,10 +19451,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1417:
+	case 1457:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8603
+//line mysql_sql.y:8786
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18571,10 +19468,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1418:
+	case 1458:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8616
+//line mysql_sql.y:8799
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18587,10 +19484,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1419:
+	case 1459:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8628
+//line mysql_sql.y:8811
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18603,10 +19500,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1420:
+	case 1460:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8640
+//line mysql_sql.y:8823
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18619,10 +19516,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1421:
+	case 1461:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8652
+//line mysql_sql.y:8835
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18635,10 +19532,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1422:
+	case 1462:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8664
+//line mysql_sql.y:8847
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18651,10 +19548,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1423:
+	case 1463:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8676
+//line mysql_sql.y:8859
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18667,10 +19564,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1424:
+	case 1464:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8688
+//line mysql_sql.y:8871
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18683,10 +19580,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1425:
+	case 1465:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8700
+//line mysql_sql.y:8883
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18699,10 +19596,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1426:
+	case 1466:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8712
+//line mysql_sql.y:8895
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18715,10 +19612,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1427:
+	case 1467:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8724
+//line mysql_sql.y:8907
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18732,10 +19629,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1428:
+	case 1468:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8737
+//line mysql_sql.y:8920
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18749,10 +19646,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1429:
+	case 1469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8750
+//line mysql_sql.y:8933
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18766,20 +19663,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1430:
+	case 1470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8765
+//line mysql_sql.y:8948
 		{
 			yyLOCAL = &tree.Do{
 				Exprs: yyDollar[2].exprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1431:
+	case 1471:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8773
+//line mysql_sql.y:8956
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -18788,10 +19685,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1432:
+	case 1472:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:8782
+//line mysql_sql.y:8965
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -18800,10 +19697,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1433:
+	case 1473:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:8792
+//line mysql_sql.y:8975
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18816,75 +19713,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1434:
+	case 1474:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8815
+//line mysql_sql.y:8998
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1435:
+	case 1475:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:8820
+//line mysql_sql.y:9003
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1436:
+	case 1476:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8826
+//line mysql_sql.y:9009
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1438:
+	case 1478:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8833
+//line mysql_sql.y:9016
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1439:
+	case 1479:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8837
+//line mysql_sql.y:9020
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1440:
+	case 1480:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8842
+//line mysql_sql.y:9025
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1441:
+	case 1481:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8846
+//line mysql_sql.y:9029
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1442:
+	case 1482:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:8852
+//line mysql_sql.y:9035
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1443:
+	case 1483:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8858
+//line mysql_sql.y:9041
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -18892,10 +19789,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1444:
+	case 1484:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8865
+//line mysql_sql.y:9048
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18903,10 +19800,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1445:
+	case 1485:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8872
+//line mysql_sql.y:9055
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18914,10 +19811,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1446:
+	case 1486:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8881
+//line mysql_sql.y:9064
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 38, // this is the default precision for decimal
@@ -18925,10 +19822,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1447:
+	case 1487:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8888
+//line mysql_sql.y:9071
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18936,10 +19833,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1448:
+	case 1488:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:8895
+//line mysql_sql.y:9078
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -18947,52 +19844,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1449:
+	case 1489:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8904
+//line mysql_sql.y:9087
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1450:
+	case 1490:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8908
+//line mysql_sql.y:9091
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1451:
+	case 1491:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8912
+//line mysql_sql.y:9095
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1452:
+	case 1492:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8918
+//line mysql_sql.y:9101
 		{
 		}
-	case 1453:
+	case 1493:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:8920
+//line mysql_sql.y:9103
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1457:
+	case 1497:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8930
+//line mysql_sql.y:9113
 		{
 			yyVAL.str = ""
 		}
-	case 1458:
+	case 1498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:8934
+//line mysql_sql.y:9117
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
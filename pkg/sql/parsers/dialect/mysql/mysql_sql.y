@@ -39,6 +39,8 @@ import (
     alterTable tree.AlterTable
     alterTableOptions tree.AlterTableOptions
     alterTableOption tree.AlterTableOption
+    alterColPosition *tree.ColumnPosition
+    alterPartitionOption tree.AlterPartitionOption
 
     tableDef tree.TableDef
     tableDefs tree.TableDefs
@@ -233,7 +235,7 @@ import (
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR CONNECT MANAGE GRANTS OWNERSHIP REFERENCE
 %nonassoc LOWER_THAN_SET
 %nonassoc <str> SET
-%token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST
+%token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS NULLS FIRST LAST AFTER
 %token <str> VALUES
 %token <str> NEXT VALUE SHARE MODE
 %token <str> SQL_NO_CACHE SQL_CACHE
@@ -291,10 +293,10 @@ import (
 %token <str> LOW_PRIORITY HIGH_PRIORITY DELAYED
 
 // Create Table
-%token <str> CREATE ALTER DROP RENAME ANALYZE ADD RETURNS
+%token <str> CREATE ALTER DROP RENAME ANALYZE ADD RETURNS MODIFY CHANGE
 %token <str> SCHEMA TABLE SEQUENCE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER EXCHANGE VALIDATION WITHOUT
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
@@ -308,11 +310,11 @@ import (
 // Sequence
 %token <str> INCREMENT CYCLE MINVALUE
 // publication
-%token <str> PUBLICATION SUBSCRIPTIONS PUBLICATIONS
+%token <str> PUBLICATION SUBSCRIPTIONS PUBLICATIONS CONFLICT
 
 
 // MO table option
-%token <str> PROPERTIES
+%token <str> PROPERTIES TTL
 
 // Index
 %token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI
@@ -465,7 +467,7 @@ import (
 // iteration
 %type <statement> loop_stmt iterate_stmt leave_stmt repeat_stmt while_stmt
 %type <statement>  create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt
-%type <str> comment_opt
+%type <str> comment_opt subscription_conflict_opt
 %type <subscriptionOption> subcription_opt
 %type <accountsSetOption> alter_publication_accounts_opt
 
@@ -518,6 +520,8 @@ import (
 %type <attributeReference> references_def
 %type <alterTableOptions> alter_option_list
 %type <alterTableOption> alter_option alter_table_drop alter_table_alter
+%type <alterColPosition> column_position column_position_opt
+%type <alterPartitionOption> alter_partition_option
 %type <indexVisibility> visibility
 
 %type <tableOption> table_option
@@ -578,8 +582,8 @@ import (
 %type <usernameRecord> user_name
 %type <user> user_spec drop_user_spec user_spec_with_identified
 %type <users> user_spec_list drop_user_spec_list user_spec_list_of_create_user
-//%type <tlsOptions> require_clause_opt require_clause require_list
-//%type <tlsOption> require_elem
+%type <tlsOptions> require_clause_opt require_clause require_list
+%type <tlsOption> require_elem
 //%type <resourceOptions> conn_option_list conn_options
 //%type <resourceOption> conn_option
 %type <updateExpr> update_value
@@ -2485,6 +2489,68 @@ alter_table_stmt:
             Options: $4,
         }
     }
+|   ALTER TABLE table_name alter_partition_option
+    {
+        $$ = &tree.AlterTable{
+            Table: $3,
+            PartitionOption: $4,
+        }
+    }
+
+alter_partition_option:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = &tree.AlterPartitionAddPartition{
+            Partitions: $4,
+        }
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = &tree.AlterPartitionDropPartition{
+            PartitionNames: $3,
+        }
+    }
+|   TRUNCATE PARTITION partition_id_list
+    {
+        $$ = &tree.AlterPartitionTruncatePartition{
+            PartitionNames: $3,
+        }
+    }
+|   TRUNCATE PARTITION ALL
+    {
+        $$ = &tree.AlterPartitionTruncatePartition{
+            All: true,
+        }
+    }
+|   REORGANIZE PARTITION partition_id_list INTO '(' partition_list ')'
+    {
+        $$ = &tree.AlterPartitionReorganizePartition{
+            PartitionNames: $3,
+            Partitions: $6,
+        }
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name
+    {
+        $$ = &tree.AlterPartitionExchangePartition{
+            PartitionName: tree.Identifier($3.Compare()),
+            Table: $6,
+        }
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name WITH VALIDATION
+    {
+        $$ = &tree.AlterPartitionExchangePartition{
+            PartitionName: tree.Identifier($3.Compare()),
+            Table: $6,
+        }
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name WITHOUT VALIDATION
+    {
+        $$ = &tree.AlterPartitionExchangePartition{
+            PartitionName: tree.Identifier($3.Compare()),
+            Table: $6,
+            WithoutValidation: true,
+        }
+    }
 
 alter_option_list:
 alter_option
@@ -2504,6 +2570,47 @@ ADD table_elem
         }
         $$ = tree.AlterTableOption(opt)
     }
+| ADD COLUMN column_def column_position_opt
+    {
+        opt := &tree.AlterOptionAddColumn{
+            Column:   $3,
+            Position: $4,
+        }
+        $$ = tree.AlterTableOption(opt)
+    }
+| ADD column_def column_position
+    {
+        opt := &tree.AlterOptionAddColumn{
+            Column:   $2,
+            Position: $3,
+        }
+        $$ = tree.AlterTableOption(opt)
+    }
+| MODIFY column_keyword_opt column_def column_position_opt
+    {
+        opt := &tree.AlterOptionModifyColumn{
+            NewColumn: $3,
+            Position:  $4,
+        }
+        $$ = tree.AlterTableOption(opt)
+    }
+| CHANGE column_keyword_opt column_name column_def column_position_opt
+    {
+        opt := &tree.AlterOptionChangeColumn{
+            OldColumnName: $3,
+            NewColumn:     $4,
+            Position:      $5,
+        }
+        $$ = tree.AlterTableOption(opt)
+    }
+| RENAME COLUMN column_name TO column_name
+    {
+        opt := &tree.AlterOptionRenameColumn{
+            OldColumnName: $3,
+            NewColumnName: $5,
+        }
+        $$ = tree.AlterTableOption(opt)
+    }
 | DROP alter_table_drop
     {
         $$ = tree.AlterTableOption($2)
@@ -2517,6 +2624,35 @@ ADD table_elem
         $$ =  tree.AlterTableOption($1)
     }
 
+column_keyword_opt:
+    {}
+|   COLUMN
+    {}
+
+column_position_opt:
+    {
+        $$ = nil
+    }
+|   column_position
+    {
+        $$ = $1
+    }
+
+column_position:
+    FIRST
+    {
+        $$ = &tree.ColumnPosition{
+            Typ: tree.ColumnPositionFirst,
+        }
+    }
+|   AFTER column_name
+    {
+        $$ = &tree.ColumnPosition{
+            Typ:            tree.ColumnPositionAfter,
+            RelativeColumn: $2,
+        }
+    }
+
 alter_table_drop:
     INDEX ident 
     {
@@ -2539,6 +2675,13 @@ alter_table_drop:
             Name: tree.Identifier($2.Compare()),
         }
     }
+|   ident
+    {
+        $$ = &tree.AlterOptionDrop{
+            Typ:  tree.AlterTableDropColumn,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
 |   FOREIGN KEY ident 
     {
         $$ = &tree.AlterOptionDrop{
@@ -4956,14 +5099,15 @@ account_comment_opt:
     }
 
 create_user_stmt:
-    CREATE USER not_exists_opt user_spec_list_of_create_user default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt
+    CREATE USER not_exists_opt user_spec_list_of_create_user default_role_opt require_clause_opt pwd_or_lck_opt user_comment_or_attribute_opt
     {
         $$ = &tree.CreateUser{
             IfNotExists: $3,
             Users: $4,
             Role: $5,
-            MiscOpt: $6,
-            CommentOrAttribute: $7,
+            TlsOpts: $6,
+            MiscOpt: $7,
+            CommentOrAttribute: $8,
         }
     }
 
@@ -5105,64 +5249,65 @@ user_comment_or_attribute_opt:
 //    }
 
 
-//require_clause_opt:
-//    {
-//        $$ = nil
-//    }
-//|   require_clause
-//
-//require_clause:
-//    REQUIRE NONE
-//    {
-//        t := &tree.TlsOptionNone{}
-//        $$ = []tree.TlsOption{t}
-//    }
-//|   REQUIRE SSL
-//    {
-//        t := &tree.TlsOptionSSL{}
-//        $$ = []tree.TlsOption{t}
-//    }
-//|   REQUIRE X509
-//    {
-//        t := &tree.TlsOptionX509{}
-//        $$ = []tree.TlsOption{t}
-//    }
-//|   REQUIRE require_list
-//    {
-//        $$ = $2
-//    }
-//
-//require_list:
-//    require_elem
-//    {
-//        $$ = []tree.TlsOption{$1}
-//    }
-//|   require_list AND require_elem
-//    {
-//        $$ = append($1, $3)
-//    }
-//|   require_list require_elem
-//    {
-//        $$ = append($1, $2)
-//    }
-//
-//require_elem:
-//    ISSUER STRING
-//    {
-//        $$ = &tree.TlsOptionIssuer{Issuer: $2}
-//    }
-//|   SUBJECT STRING
-//    {
-//        $$ = &tree.TlsOptionSubject{Subject: $2}
-//    }
-//|   CIPHER STRING
-//    {
-//        $$ = &tree.TlsOptionCipher{Cipher: $2}
-//    }
-//|   SAN STRING
-//    {
-//        $$ = &tree.TlsOptionSan{San: $2}
-//    }
+require_clause_opt:
+    {
+        $$ = nil
+    }
+|   require_clause
+
+require_clause:
+    REQUIRE NONE
+    {
+        t := &tree.TlsOptionNone{}
+        $$ = []tree.TlsOption{t}
+    }
+|   REQUIRE SSL
+    {
+        t := &tree.TlsOptionSSL{}
+        $$ = []tree.TlsOption{t}
+    }
+|   REQUIRE X509
+    {
+        t := &tree.TlsOptionX509{}
+        $$ = []tree.TlsOption{t}
+    }
+|   REQUIRE require_list
+    {
+        $$ = $2
+    }
+
+require_list:
+    require_elem
+    {
+        $$ = []tree.TlsOption{$1}
+    }
+|   require_list AND require_elem
+    {
+        $$ = append($1, $3)
+    }
+|   require_list require_elem
+    {
+        $$ = append($1, $2)
+    }
+
+require_elem:
+    ISSUER STRING
+    {
+        $$ = &tree.TlsOptionIssuer{Issuer: $2}
+    }
+|   SUBJECT STRING
+    {
+        $$ = &tree.TlsOptionSubject{Subject: $2}
+    }
+|   CIPHER STRING
+    {
+        $$ = &tree.TlsOptionCipher{Cipher: $2}
+    }
+|   SAN STRING
+    {
+        $$ = &tree.TlsOptionSan{San: $2}
+    }
+
 user_spec_list_of_create_user:
     user_spec_with_identified
     {
@@ -5247,6 +5392,22 @@ user_identified:
         Str: $3,
     }
     }
+|   IDENTIFIED WITH name_string BY STRING
+    {
+    $$ = &tree.AccountIdentified{
+        Typ: tree.AccountIdentifiedByPassword,
+        Str: $5,
+        Plugin: $3,
+    }
+    }
+|   IDENTIFIED WITH name_string AS STRING
+    {
+    $$ = &tree.AccountIdentified{
+        Typ: tree.AccountIdentifiedWithAuthString,
+        Str: $5,
+        Plugin: $3,
+    }
+    }
 
 name_string:
     ident
@@ -5448,6 +5609,24 @@ subcription_opt:
     {
    	$$ = &tree.SubscriptionOption{From: tree.Identifier($2), Publication: tree.Identifier($4.Compare())}
     }
+|   FROM STRING PUBLICATION ident subscription_conflict_opt
+    {
+   	$$ = &tree.SubscriptionOption{
+   	    From: tree.Identifier($2),
+   	    Publication: tree.Identifier($4.Compare()),
+   	    Remote: true,
+   	    Conflict: $5,
+   	}
+    }
+
+subscription_conflict_opt:
+    {
+	$$ = ""
+    }
+|   CONFLICT ident
+    {
+	$$ = strings.ToLower($2.Compare())
+    }
 
 
 database_or_schema:
@@ -6158,6 +6337,10 @@ table_option:
     {
         $$ = &tree.TableOptionProperties{Preperties: $3}
     }
+|   TTL equal_opt column_name '+' INTERVAL INTEGRAL time_unit
+    {
+        $$ = tree.NewTableOptionTTL($3, $6.(int64), $7)
+    }
 // |   INSERT_METHOD equal_opt insert_method_options
 
 properties_list:
@@ -9129,6 +9312,7 @@ equal_opt:
 non_reserved_keyword:
     ACCOUNT
 |   ACCOUNTS
+|   AFTER
 |   AGAINST
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
@@ -9168,6 +9352,7 @@ non_reserved_keyword:
 |   ENUM
 |   ENCRYPTION
 |   ENGINE
+|   EXCHANGE
 |   EXPANSION
 |   EXTENDED
 |   EXPIRE
@@ -9203,6 +9388,7 @@ non_reserved_keyword:
 |   MEDIUMTEXT
 |   MEMORY
 |   MODE
+|   MODIFY
 |   MULTILINESTRING
 |   MULTIPOINT
 |   MULTIPOLYGON
@@ -9278,8 +9464,10 @@ non_reserved_keyword:
 |   USER
 |   VARBINARY
 |   VARCHAR
+|   VALIDATION
 |   VARIABLES
 |   VIEW
+|   WITHOUT
 |   WRITE
 |   WARNINGS
 |   WORK
@@ -9320,6 +9508,8 @@ non_reserved_keyword:
 |   SUBSCRIPTIONS
 |   PUBLICATIONS
 |   PROPERTIES
+|   TTL
+|   CONFLICT
 
 func_not_keyword:
     DATE_ADD
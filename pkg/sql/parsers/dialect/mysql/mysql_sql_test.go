@@ -646,6 +646,12 @@ var (
 	}, {
 		input:  "create table t (a int) properties('a' = 'b')",
 		output: "create table t (a int) properties(a = b)",
+	}, {
+		input:  "create table t (a int, b datetime) ttl = b + interval 30 day",
+		output: "create table t (a int, b datetime(26)) ttl = b + interval 30 day",
+	}, {
+		input:  "create table t (a int, ttl timestamp) TTL ttl + INTERVAL 12 HOUR",
+		output: "create table t (a int, ttl timestamp(26)) ttl = ttl + interval 12 hour",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
 	}, {
@@ -1569,6 +1575,15 @@ var (
 			output: "create user if not exists abc1 identified by '******' " +
 				"default role de_role " +
 				"comment 'new comment'",
+		}, {
+			input:  "create user abc1 identified with caching_sha2_password by '123', abc2 identified with 'authentication_ldap_simple' as 'uid=abc2,dc=example'",
+			output: "create user abc1 identified with caching_sha2_password by '******', abc2 identified with authentication_ldap_simple as '******'",
+		}, {
+			input:  "create user abc1 identified by '123' require x509",
+			output: "create user abc1 identified by '******' require x509",
+		}, {
+			input:  "create user abc1 identified by '123' default role r1 require subject '/C=CN/O=MO/CN=abc1' and issuer '/C=CN/O=MO/CN=ca' lock",
+			output: "create user abc1 identified by '******' default role r1 require subject '/C=CN/O=MO/CN=abc1' and issuer '/C=CN/O=MO/CN=ca' lock",
 		}, {
 			input: "create user if not exists abc1 identified by '111' " +
 				"default role de_role",
@@ -2058,6 +2073,78 @@ var (
 			input:  "alter table t1 alter index c invisible",
 			output: "alter table t1 alter index c invisible",
 		},
+		{
+			input:  "alter table t1 add column c int",
+			output: "alter table t1 add column c int",
+		},
+		{
+			input:  "alter table t1 add c varchar(10) first",
+			output: "alter table t1 add column c varchar(10) first",
+		},
+		{
+			input:  "alter table t1 add column c int default null after b",
+			output: "alter table t1 add column c int default null after b",
+		},
+		{
+			input:  "alter table t1 modify c bigint",
+			output: "alter table t1 modify column c bigint",
+		},
+		{
+			input:  "alter table t1 modify column c varchar(20) not null after a",
+			output: "alter table t1 modify column c varchar(20) not null after a",
+		},
+		{
+			input:  "alter table t1 change b c int first",
+			output: "alter table t1 change column b c int first",
+		},
+		{
+			input:  "alter table t1 change column b c int",
+			output: "alter table t1 change column b c int",
+		},
+		{
+			input:  "alter table t1 drop b",
+			output: "alter table t1 drop column b",
+		},
+		{
+			input:  "alter table t1 rename column b to c",
+			output: "alter table t1 rename column b to c",
+		},
+		{
+			input:  "alter table t1 add partition (partition p3 values less than (2000), partition p4 values less than (maxvalue))",
+			output: "alter table t1 add partition (partition p3 values less than (2000), partition p4 values less than (MAXVALUE))",
+		},
+		{
+			input:  "alter table t1 add partition (partition p3 values in (7, 8) comment 'p3')",
+			output: "alter table t1 add partition (partition p3 values in (7, 8) comment = p3)",
+		},
+		{
+			input:  "alter table t1 drop partition p0, p1",
+			output: "alter table t1 drop partition p0, p1",
+		},
+		{
+			input:  "alter table t1 truncate partition p0",
+			output: "alter table t1 truncate partition p0",
+		},
+		{
+			input:  "alter table t1 truncate partition all",
+			output: "alter table t1 truncate partition all",
+		},
+		{
+			input:  "alter table t1 reorganize partition p0, p1 into (partition p2 values less than (2000))",
+			output: "alter table t1 reorganize partition p0, p1 into (partition p2 values less than (2000))",
+		},
+		{
+			input:  "alter table t1 exchange partition p0 with table t2",
+			output: "alter table t1 exchange partition p0 with table t2",
+		},
+		{
+			input:  "alter table t1 exchange partition p0 with table db.t2 with validation",
+			output: "alter table t1 exchange partition p0 with table db.t2",
+		},
+		{
+			input:  "alter table t1 exchange partition p0 with table t2 without validation",
+			output: "alter table t1 exchange partition p0 with table t2 without validation",
+		},
 		{
 			input:  "alter table t1 add constraint uk_6dotkott2kjsp8vw4d0m25fb7 unique key (col3)",
 			output: "alter table t1 add constraint uk_6dotkott2kjsp8vw4d0m25fb7 unique key (col3)",
@@ -2112,6 +2199,13 @@ var (
 		{
 			input: "create database db1 from acc0 publication pub1",
 		},
+		{
+			input: "create database db1 from 'http://token@127.0.0.1:7001' publication pub1",
+		},
+		{
+			input:  "create database db1 from 'http://127.0.0.1:7001' publication pub1 conflict SKIP",
+			output: "create database db1 from 'http://127.0.0.1:7001' publication pub1 conflict skip",
+		},
 		{
 			input: "drop publication pub1",
 		},
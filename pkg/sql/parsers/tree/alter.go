@@ -174,8 +174,9 @@ func (node *AlterDataBaseConfig) GetQueryType() string     { return QueryTypeDDL
 // see https://dev.mysql.com/doc/refman/8.0/en/alter-table.html
 type AlterTable struct {
 	statementImpl
-	Table   *TableName
-	Options AlterTableOptions
+	Table           *TableName
+	Options         AlterTableOptions
+	PartitionOption AlterPartitionOption
 }
 
 func (node *AlterTable) Format(ctx *FmtCtx) {
@@ -188,6 +189,10 @@ func (node *AlterTable) Format(ctx *FmtCtx) {
 		t.Format(ctx)
 		prefix = ", "
 	}
+	if node.PartitionOption != nil {
+		ctx.WriteString(prefix)
+		node.PartitionOption.Format(ctx)
+	}
 }
 
 func (node *AlterTable) GetStatementType() string { return "Alter Table" }
@@ -195,6 +200,108 @@ func (node *AlterTable) GetQueryType() string     { return QueryTypeDDL }
 
 type AlterTableOptions = []AlterTableOption
 
+// AlterPartitionOption is the partition management clause of alter table, it
+// can not be combined with the other alter options
+type AlterPartitionOption interface {
+	NodeFormatter
+}
+
+type alterPartitionOptionImpl struct {
+	AlterPartitionOption
+}
+
+func formatPartitionNames(ctx *FmtCtx, names IdentifierList) {
+	prefix := ""
+	for _, name := range names {
+		ctx.WriteString(prefix)
+		name.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func formatPartitionDefs(ctx *FmtCtx, partitions []*Partition) {
+	ctx.WriteString("(")
+	prefix := ""
+	for _, p := range partitions {
+		ctx.WriteString(prefix)
+		p.Format(ctx)
+		prefix = ", "
+	}
+	ctx.WriteString(")")
+}
+
+// AlterPartitionAddPartition is ADD PARTITION (partition_definition, ...)
+type AlterPartitionAddPartition struct {
+	alterPartitionOptionImpl
+	Partitions []*Partition
+}
+
+func (node *AlterPartitionAddPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("add partition ")
+	formatPartitionDefs(ctx, node.Partitions)
+}
+
+// AlterPartitionDropPartition is DROP PARTITION partition_names
+type AlterPartitionDropPartition struct {
+	alterPartitionOptionImpl
+	PartitionNames IdentifierList
+}
+
+func (node *AlterPartitionDropPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop partition ")
+	formatPartitionNames(ctx, node.PartitionNames)
+}
+
+// AlterPartitionTruncatePartition is TRUNCATE PARTITION {partition_names | ALL}
+type AlterPartitionTruncatePartition struct {
+	alterPartitionOptionImpl
+	PartitionNames IdentifierList
+	All            bool
+}
+
+func (node *AlterPartitionTruncatePartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("truncate partition ")
+	if node.All {
+		ctx.WriteString("all")
+		return
+	}
+	formatPartitionNames(ctx, node.PartitionNames)
+}
+
+// AlterPartitionReorganizePartition is REORGANIZE PARTITION partition_names
+// INTO (partition_definition, ...)
+type AlterPartitionReorganizePartition struct {
+	alterPartitionOptionImpl
+	PartitionNames IdentifierList
+	Partitions     []*Partition
+}
+
+func (node *AlterPartitionReorganizePartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("reorganize partition ")
+	formatPartitionNames(ctx, node.PartitionNames)
+	ctx.WriteString(" into ")
+	formatPartitionDefs(ctx, node.Partitions)
+}
+
+// AlterPartitionExchangePartition is EXCHANGE PARTITION partition_name WITH
+// TABLE tbl_name [{WITH | WITHOUT} VALIDATION]
+type AlterPartitionExchangePartition struct {
+	alterPartitionOptionImpl
+	PartitionName     Identifier
+	Table             *TableName
+	WithoutValidation bool
+}
+
+func (node *AlterPartitionExchangePartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("exchange partition ")
+	node.PartitionName.Format(ctx)
+	ctx.WriteString(" with table ")
+	node.Table.Format(ctx)
+	if node.WithoutValidation {
+		ctx.WriteString(" without validation")
+	}
+}
+
 type AlterTableOption interface {
 	NodeFormatter
 }
@@ -230,6 +337,88 @@ func (node *AlterOptionAdd) Format(ctx *FmtCtx) {
 	node.Def.Format(ctx)
 }
 
+type ColumnPositionType int
+
+const (
+	ColumnPositionNone ColumnPositionType = iota
+	ColumnPositionFirst
+	ColumnPositionAfter
+)
+
+// ColumnPosition is the FIRST | AFTER col clause of add/modify/change column
+type ColumnPosition struct {
+	Typ            ColumnPositionType
+	RelativeColumn *UnresolvedName
+}
+
+func (node *ColumnPosition) Format(ctx *FmtCtx) {
+	switch node.Typ {
+	case ColumnPositionFirst:
+		ctx.WriteString(" first")
+	case ColumnPositionAfter:
+		ctx.WriteString(" after ")
+		node.RelativeColumn.Format(ctx)
+	}
+}
+
+type AlterOptionAddColumn struct {
+	alterOptionImpl
+	Column   *ColumnTableDef
+	Position *ColumnPosition
+}
+
+func (node *AlterOptionAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+	if node.Position != nil {
+		node.Position.Format(ctx)
+	}
+}
+
+type AlterOptionModifyColumn struct {
+	alterOptionImpl
+	NewColumn *ColumnTableDef
+	Position  *ColumnPosition
+}
+
+func (node *AlterOptionModifyColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("modify column ")
+	node.NewColumn.Format(ctx)
+	if node.Position != nil {
+		node.Position.Format(ctx)
+	}
+}
+
+type AlterOptionChangeColumn struct {
+	alterOptionImpl
+	OldColumnName *UnresolvedName
+	NewColumn     *ColumnTableDef
+	Position      *ColumnPosition
+}
+
+func (node *AlterOptionChangeColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("change column ")
+	node.OldColumnName.Format(ctx)
+	ctx.WriteString(" ")
+	node.NewColumn.Format(ctx)
+	if node.Position != nil {
+		node.Position.Format(ctx)
+	}
+}
+
+type AlterOptionRenameColumn struct {
+	alterOptionImpl
+	OldColumnName *UnresolvedName
+	NewColumnName *UnresolvedName
+}
+
+func (node *AlterOptionRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	node.OldColumnName.Format(ctx)
+	ctx.WriteString(" to ")
+	node.NewColumnName.Format(ctx)
+}
+
 type AlterTableDropType int
 
 const (
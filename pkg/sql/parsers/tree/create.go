@@ -92,13 +92,25 @@ type SubscriptionOption struct {
 	statementImpl
 	From        Identifier
 	Publication Identifier
+	// Remote is true if From is the endpoint of the publisher of another cluster
+	Remote bool
+	// Conflict is the conflict handling mode of a remote subscription
+	Conflict string
 }
 
 func (node *SubscriptionOption) Format(ctx *FmtCtx) {
 	ctx.WriteString(" from ")
-	node.From.Format(ctx)
+	if node.Remote {
+		ctx.WriteString(fmt.Sprintf("'%s'", string(node.From)))
+	} else {
+		node.From.Format(ctx)
+	}
 	ctx.WriteString(" publication ")
 	node.Publication.Format(ctx)
+	if node.Conflict != "" {
+		ctx.WriteString(" conflict ")
+		ctx.WriteString(node.Conflict)
+	}
 }
 
 type CreateDatabase struct {
@@ -1570,6 +1582,32 @@ func NewTableOptionEncryption(e string) *TableOptionEncryption {
 	return &TableOptionEncryption{Encryption: e}
 }
 
+// TableOptionTTL is the MO table option `TTL = col + INTERVAL n unit`,
+// rows are expired once the value of col plus the interval is in the past.
+type TableOptionTTL struct {
+	tableOptionImpl
+	Column   *UnresolvedName
+	Interval int64
+	Unit     string
+}
+
+func (node *TableOptionTTL) Format(ctx *FmtCtx) {
+	ctx.WriteString("ttl = ")
+	node.Column.Format(ctx)
+	ctx.WriteString(" + interval ")
+	ctx.WriteString(strconv.FormatInt(node.Interval, 10))
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Unit))
+}
+
+func NewTableOptionTTL(c *UnresolvedName, i int64, u string) *TableOptionTTL {
+	return &TableOptionTTL{
+		Column:   c,
+		Interval: i,
+		Unit:     u,
+	}
+}
+
 type PartitionType interface {
 	NodeFormatter
 }
@@ -2114,8 +2152,9 @@ type TlsOptionCipher struct {
 }
 
 func (node *TlsOptionCipher) Format(ctx *FmtCtx) {
-	ctx.WriteString("cipher ")
+	ctx.WriteString("cipher '")
 	ctx.WriteString(node.Cipher)
+	ctx.WriteByte('\'')
 }
 
 type TlsOptionIssuer struct {
@@ -2124,8 +2163,9 @@ type TlsOptionIssuer struct {
 }
 
 func (node *TlsOptionIssuer) Format(ctx *FmtCtx) {
-	ctx.WriteString("issuer ")
+	ctx.WriteString("issuer '")
 	ctx.WriteString(node.Issuer)
+	ctx.WriteByte('\'')
 }
 
 type TlsOptionSubject struct {
@@ -2134,8 +2174,9 @@ type TlsOptionSubject struct {
 }
 
 func (node *TlsOptionSubject) Format(ctx *FmtCtx) {
-	ctx.WriteString("subject ")
+	ctx.WriteString("subject '")
 	ctx.WriteString(node.Subject)
+	ctx.WriteByte('\'')
 }
 
 type TlsOptionSan struct {
@@ -2144,8 +2185,9 @@ type TlsOptionSan struct {
 }
 
 func (node *TlsOptionSan) Format(ctx *FmtCtx) {
-	ctx.WriteString("san ")
+	ctx.WriteString("san '")
 	ctx.WriteString(node.San)
+	ctx.WriteByte('\'')
 }
 
 type ResourceOption interface {
@@ -2344,6 +2386,7 @@ type CreateUser struct {
 	IfNotExists bool
 	Users       []*User
 	Role        *Role
+	TlsOpts     []TlsOption
 	MiscOpt     UserMiscOption
 	// comment or attribute
 	CommentOrAttribute AccountCommentOrAttribute
@@ -2369,6 +2412,16 @@ func (node *CreateUser) Format(ctx *FmtCtx) {
 		node.Role.Format(ctx)
 	}
 
+	if len(node.TlsOpts) != 0 {
+		ctx.WriteString(" require")
+		prefix := " "
+		for _, opt := range node.TlsOpts {
+			ctx.WriteString(prefix)
+			opt.Format(ctx)
+			prefix = " and "
+		}
+	}
+
 	if node.MiscOpt != nil {
 		ctx.WriteString(" ")
 		node.MiscOpt.Format(ctx)
@@ -2438,21 +2491,35 @@ const (
 	AccountIdentifiedByPassword AccountIdentifiedOption = iota
 	AccountIdentifiedByRandomPassword
 	AccountIdentifiedWithSSL
+	// AccountIdentifiedWithAuthString is IDENTIFIED WITH auth_plugin AS 'auth_string'
+	AccountIdentifiedWithAuthString
 )
 
 type AccountIdentified struct {
 	Typ AccountIdentifiedOption
 	Str string
+	// Plugin is the auth plugin of IDENTIFIED WITH auth_plugin
+	Plugin string
 }
 
 func (node *AccountIdentified) Format(ctx *FmtCtx) {
 	switch node.Typ {
 	case AccountIdentifiedByPassword:
-		ctx.WriteString(" identified by '******'")
+		if len(node.Plugin) != 0 {
+			ctx.WriteString(" identified with ")
+			ctx.WriteString(node.Plugin)
+		} else {
+			ctx.WriteString(" identified")
+		}
+		ctx.WriteString(" by '******'")
 	case AccountIdentifiedByRandomPassword:
 		ctx.WriteString(" identified by random password")
 	case AccountIdentifiedWithSSL:
 		ctx.WriteString(" identified with '******'")
+	case AccountIdentifiedWithAuthString:
+		ctx.WriteString(" identified with ")
+		ctx.WriteString(node.Plugin)
+		ctx.WriteString(" as '******'")
 	}
 }
 
@@ -216,6 +216,15 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 }
 
 func (b *baseBinder) baseBindParam(astExpr *tree.ParamExpr, depth int32, isRoot bool) (expr *plan.Expr, err error) {
+	if b.builder != nil {
+		if params := b.builder.compCtx.ResolveParams(); params != nil {
+			pos := astExpr.Offset - 1
+			if pos < 0 || pos >= len(params) {
+				return nil, moerr.NewInvalidInput(b.GetContext(), "parameter %d is not bound", astExpr.Offset)
+			}
+			return DeepCopyExpr(params[pos]), nil
+		}
+	}
 	return &Expr{
 		Typ: &plan.Type{
 			Id: int32(types.T_any),
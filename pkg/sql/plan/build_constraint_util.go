@@ -59,6 +59,8 @@ type dmlSelectInfo struct {
 	onCascadeUpdateCol []map[string]int32 // name=updated col.Name  value=col position in TableDef.Cols
 
 	parentIdx []map[string]int32
+
+	partitions []*plan.DmlPartition // the partition tables of the changed tables
 }
 
 type dmlTableInfo struct {
@@ -67,10 +69,12 @@ type dmlTableInfo struct {
 	tableDefs      []*TableDef
 	isClusterTable []bool
 	haveConstraint bool
+	havePartition  bool
 	updateCol      []map[string]int32     // name=updated col.Name  value=col position in TableDef.Cols
 	updateKeys     []map[string]tree.Expr // This slice index correspond to tableDefs
 	oldColPosMap   []map[string]int       // origin table values to their position in derived table
 	newColPosMap   []map[string]int       // insert/update values to their position in derived table
+	partColPosMap  []map[string]int       // origin partition key values to their position in derived table
 	nameToIdx      map[string]int         // Mapping of table full path name to tableDefs index，such as： 'tpch.nation -> 0'
 	idToName       map[uint64]string      // Mapping of tableId to full path name of table
 	alias          map[string]int         // Mapping of table aliases to tableDefs array index,If there is no alias, replace it with the original name of the table
@@ -263,6 +267,10 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 				// for these behaviors, instead of using column names, which needs to
 				// be changed after 0.8
 				newCols = append(newCols, col)
+			} else if _, ok := catalog.ConvertedColName(col.Name); ok {
+				// the column converting the type of a column is computed
+				// from the column when the rows are written
+				newCols = append(newCols, col)
 			}
 		} else {
 			newCols = append(newCols, col)
@@ -297,6 +305,10 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 		}
 	}
 
+	if tableDef.Partition != nil {
+		tblInfo.havePartition = true
+	}
+
 	nowIdx := len(tblInfo.tableDefs)
 	tblInfo.isClusterTable = append(tblInfo.isClusterTable, isClusterTable)
 	tblInfo.objRef = append(tblInfo.objRef, &ObjectRef{
@@ -379,6 +391,26 @@ func updateToSelect(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Upda
 		}
 	}
 
+	// append the partition key columns, the partitions of the old rows are
+	// evaluated from them, the old values of the columns with ON UPDATE are
+	// replaced in the project list
+	partColPosMap := make([]map[string]int, len(tableInfo.tableDefs))
+	for i, alias := range aliasList {
+		tableDef := tableInfo.tableDefs[i]
+		if tableDef.Partition == nil {
+			continue
+		}
+		partColPosMap[i] = make(map[string]int)
+		for _, colName := range partitionKeyColNames(tableDef) {
+			e, _ := tree.NewUnresolvedName(builder.GetContext(), alias, colName)
+			partColPosMap[i][colName] = len(selectList)
+			selectList = append(selectList, tree.SelectExpr{
+				Expr: e,
+			})
+		}
+	}
+	tableInfo.partColPosMap = partColPosMap
+
 	// origin table values to their position in dev derived table
 	oldColPosMap := make([]map[string]int, len(tableInfo.tableDefs))
 	// insert/update values to their position in derived table
@@ -444,15 +476,21 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 		for _, col := range tableDef.Cols {
 			// Hide pk can not added, because this column is auto increment
 			// column. This must be fill by auto increment
-			if col.Name != catalog.FakePrimaryKeyColName {
-				insertColumns = append(insertColumns, col.Name)
+			if col.Name == catalog.FakePrimaryKeyColName {
+				continue
 			}
+			// the converting columns are computed by preinsert
+			if _, ok := catalog.ConvertedColName(col.Name); ok {
+				continue
+			}
+			insertColumns = append(insertColumns, col.Name)
 		}
 	} else {
 		syntaxHasColumnNames = true
 		for _, column := range stmt.Columns {
 			colName := string(column)
-			if _, ok := colToIdx[colName]; !ok {
+			_, ok := colToIdx[colName]
+			if _, converting := catalog.ConvertedColName(colName); !ok || converting {
 				return moerr.NewBadFieldError(builder.GetContext(), colName, tableDef.Name)
 			}
 			insertColumns = append(insertColumns, colName)
@@ -672,6 +710,30 @@ func initInsertStmt(builder *QueryBuilder, bindCtx *BindContext, stmt *tree.Inse
 				})
 			}
 
+			// the partition index of the old rows, they are deleted from
+			// their partition tables when they are updated
+			if tableDef.Partition != nil {
+				cols := make(map[string]*Expr, len(rightTableDef.Cols))
+				for i, col := range rightTableDef.Cols {
+					cols[col.Name] = &Expr{
+						Typ: col.Typ,
+						Expr: &plan.Expr_Col{
+							Col: &plan.ColRef{
+								RelPos: rightTag,
+								ColPos: int32(i),
+							},
+						},
+					}
+				}
+				expr, err := buildPartitionIndexExpr(builder.compCtx, tableDef, cols)
+				if err != nil {
+					return err
+				}
+				info.partitions = []*plan.DmlPartition{{Idx: int32(len(info.projectList))}}
+				info.projectList = append(info.projectList, expr)
+				info.idx = info.idx + 1
+			}
+
 			// get join condition
 			var joinConds *Expr
 			joinIdx := 0
@@ -876,6 +938,9 @@ func initDeleteStmt(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelect
 	info.tblInfo.oldColPosMap = oldColPosMap
 	info.tblInfo.newColPosMap = oldColPosMap //we donot need this field in delete statement
 
+	if err = appendPartitionIndexExprs(builder, info, tag, oldColPosMap); err != nil {
+		return err
+	}
 	info.idx = int32(len(info.projectList))
 	return nil
 }
@@ -1025,6 +1090,9 @@ func initUpdateStmt(builder *QueryBuilder, bindCtx *BindContext, info *dmlSelect
 			idx++
 		}
 	}
+	if err = appendPartitionIndexExprs(builder, info, tag, info.tblInfo.partColPosMap); err != nil {
+		return err
+	}
 	info.idx = int32(len(info.projectList))
 	return nil
 }
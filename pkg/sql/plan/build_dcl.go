@@ -176,6 +176,23 @@ func buildExecute(stmt *tree.Execute, ctx CompilerContext) (*Plan, error) {
 	}, nil
 }
 
+// BindConstantExprs binds the expressions which refer to no column, such as
+// the literals taken out of the statement whose plan is cached.
+func BindConstantExprs(ctx CompilerContext, exprs []tree.Expr) ([]*Expr, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	binder := NewWhereBinder(builder, &BindContext{})
+
+	ret := make([]*Expr, len(exprs))
+	for i, e := range exprs {
+		expr, err := binder.baseBindExpr(e, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = expr
+	}
+	return ret, nil
+}
+
 func buildDeallocate(stmt *tree.Deallocate, _ CompilerContext) (*Plan, error) {
 	deallocate := &plan.Deallocate{
 		Name: string(stmt.Name),
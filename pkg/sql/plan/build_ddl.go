@@ -20,16 +20,20 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function/operator"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 )
@@ -368,6 +372,19 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionTTL:
+			ttl, err := buildTTLDef(ctx.GetContext(), opt, createTable.TableDef)
+			if err != nil {
+				return nil, err
+			}
+			// date and datetime values of the ttl column are in the time
+			// zone of the session, it is kept with the ttl
+			var loc *time.Location
+			if proc := ctx.GetProcess(); proc != nil {
+				loc = proc.SessionInfo.TimeZone
+			}
+			ttl.TimeZone = catalog.TTLTimeZone(loc, time.Now())
+			createTable.TableDef.Ttl = ttl
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
@@ -491,6 +508,50 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}, nil
 }
 
+// buildTTLDef checks the ttl option of create table and converts the interval
+// into seconds. Only date, datetime and timestamp columns can be used as ttl
+// column, and the interval unit must have a fixed length.
+func buildTTLDef(ctx context.Context, opt *tree.TableOptionTTL, tableDef *TableDef) (*plan.TTLDef, error) {
+	colName := opt.Column.Parts[0]
+	var colDef *ColDef
+	for _, col := range tableDef.Cols {
+		if col.Name == colName {
+			colDef = col
+			break
+		}
+	}
+	if colDef == nil {
+		return nil, moerr.NewInvalidInput(ctx, "ttl column '%s' does not exist", colName)
+	}
+	switch types.T(colDef.Typ.Id) {
+	case types.T_date, types.T_datetime, types.T_timestamp:
+	default:
+		return nil, moerr.NewInvalidInput(ctx, "ttl column '%s' must be date, datetime or timestamp", colName)
+	}
+	if opt.Interval <= 0 {
+		return nil, moerr.NewInvalidInput(ctx, "ttl interval must be positive")
+	}
+	var unit int64
+	switch strings.ToLower(opt.Unit) {
+	case "second":
+		unit = 1
+	case "minute":
+		unit = 60
+	case "hour":
+		unit = 60 * 60
+	case "day":
+		unit = 24 * 60 * 60
+	case "week":
+		unit = 7 * 24 * 60 * 60
+	default:
+		return nil, moerr.NewNotSupported(ctx, "ttl interval unit '%s'", opt.Unit)
+	}
+	return &plan.TTLDef{
+		ColName:       colName,
+		ExpireSeconds: opt.Interval * unit,
+	}, nil
+}
+
 // addPartitionTableDef constructs the table def for the partition table
 func addPartitionTableDef(ctx context.Context, mainTableName string, createTable *plan.CreateTable) error {
 	//add partition table
@@ -500,24 +561,6 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 		return moerr.NewInvalidInput(ctx, "invalid main table name %s", mainTableName)
 	}
 
-	//common properties
-	partitionProps := []*plan.Property{
-		{
-			Key:   catalog.SystemRelAttr_Kind,
-			Value: catalog.SystemPartitionRel,
-		},
-		{
-			Key:   catalog.SystemRelAttr_CreateSQL,
-			Value: "",
-		},
-	}
-	partitionPropsDef := &plan.TableDef_DefType{
-		Def: &plan.TableDef_DefType_Properties{
-			Properties: &plan.PropertiesDef{
-				Properties: partitionProps,
-			},
-		}}
-
 	partitionDef := createTable.TableDef.Partition
 	partitionTableDefs := make([]*TableDef, partitionDef.PartitionNum)
 
@@ -533,17 +576,43 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 		part.PartitionTableName = partitionTableName
 		partitionTableNames[i] = partitionTableName
 
-		partitionTableDefs[i] = &TableDef{
-			Name: partitionTableName,
-			Cols: createTable.TableDef.Cols, //same as the main table's column defs
-		}
-		partitionTableDefs[i].Defs = append(partitionTableDefs[i].Defs, partitionPropsDef)
+		//same as the main table's column defs
+		partitionTableDefs[i] = newPartitionTableDef(partitionTableName, createTable.TableDef.Cols)
 	}
 	partitionDef.PartitionTableNames = partitionTableNames
 	createTable.PartitionTables = partitionTableDefs
 	return nil
 }
 
+// newPartitionTableDef constructs the table def for a partition table with
+// the column defs of the main table
+func newPartitionTableDef(name string, cols []*ColDef) *TableDef {
+	//common properties
+	partitionProps := []*plan.Property{
+		{
+			Key:   catalog.SystemRelAttr_Kind,
+			Value: catalog.SystemPartitionRel,
+		},
+		{
+			Key:   catalog.SystemRelAttr_CreateSQL,
+			Value: "",
+		},
+	}
+	return &TableDef{
+		Name: name,
+		Cols: cols,
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: partitionProps,
+					},
+				},
+			},
+		},
+	}
+}
+
 // buildPartitionByClause build partition by clause info and semantic check.
 // Currently, sub partition and partition value verification are not supported
 func buildPartitionByClause(ctx context.Context, partitionBinder *PartitionBinder, stmt *tree.CreateTable, tableDef *TableDef) (err error) {
@@ -1165,6 +1234,9 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 				}
 			}
 		}
+		if tableDef.Partition != nil {
+			truncateTable.PartitionTableNames = tableDef.Partition.PartitionTableNames
+		}
 	}
 
 	return &Plan{
@@ -1352,6 +1424,10 @@ func buildCreateDatabase(stmt *tree.CreateDatabase, ctx CompilerContext) (*Plan,
 	}
 
 	if stmt.SubscriptionOption != nil {
+		if stmt.SubscriptionOption.Remote {
+			// remote subscriptions are created by the frontend with the replication service
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "remote subscription in this statement")
+		}
 		accName := string(stmt.SubscriptionOption.From)
 		pubName := string(stmt.SubscriptionOption.Publication)
 		subName := string(stmt.Name)
@@ -1429,6 +1505,9 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 	if obj.PubAccountId != -1 {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "cannot create index in subscription database")
 	}
+	if err := checkNoConvertingColumns(ctx, tableDef, "create index on"); err != nil {
+		return nil, err
+	}
 	// check index
 	indexName := string(stmt.Name)
 	for _, def := range tableDef.Indexes {
@@ -1657,6 +1736,9 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	}
 
 	alterTable.TableDef = tableDef
+	// columns added by this statement, they can not be changed again before
+	// the new schema is committed
+	addedCols := make(map[string]bool)
 
 	for i, option := range stmt.Options {
 		switch opt := option.(type) {
@@ -1668,11 +1750,12 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 			switch opt.Typ {
 			case tree.AlterTableDropColumn:
 				alterTableDrop.Typ = plan.AlterTableDrop_COLUMN
-				for _, col := range tableDef.Cols {
-					if col.Name == constraintName {
-						name_not_found = false
-						break
+				if _, ok := colMap[constraintName]; ok {
+					name_not_found = false
+					if err := checkColumnAlterable(ctx, tableDef, colMap, addedCols, constraintName, "drop"); err != nil {
+						return nil, err
 					}
+					delete(colMap, constraintName)
 				}
 			case tree.AlterTableDropIndex:
 				alterTableDrop.Typ = plan.AlterTableDrop_INDEX
@@ -1717,6 +1800,16 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 
 		case *tree.AlterOptionAdd:
 			switch def := opt.Def.(type) {
+			case *tree.ColumnTableDef:
+				addColumn, err := buildAlterTableAddColumn(ctx, tableDef, colMap, addedCols, def, nil)
+				if err != nil {
+					return nil, err
+				}
+				alterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_AddColumn{
+						AddColumn: addColumn,
+					},
+				}
 			case *tree.ForeignKey:
 				fkData, err := getForeignKeyData(ctx, tableDef, def)
 				if err != nil {
@@ -1807,6 +1900,60 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 
 			}
 
+		case *tree.AlterOptionAddColumn:
+			addColumn, err := buildAlterTableAddColumn(ctx, tableDef, colMap, addedCols, opt.Column, opt.Position)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_AddColumn{
+					AddColumn: addColumn,
+				},
+			}
+
+		case *tree.AlterOptionModifyColumn:
+			modifyColumn, err := buildAlterTableModifyColumn(ctx, tableDef, colMap, addedCols,
+				opt.NewColumn.Name.Parts[0], opt.NewColumn, opt.Position)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_ModifyColumn{
+					ModifyColumn: modifyColumn,
+				},
+			}
+
+		case *tree.AlterOptionChangeColumn:
+			modifyColumn, err := buildAlterTableModifyColumn(ctx, tableDef, colMap, addedCols,
+				opt.OldColumnName.Parts[0], opt.NewColumn, opt.Position)
+			if err != nil {
+				return nil, err
+			}
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_ModifyColumn{
+					ModifyColumn: modifyColumn,
+				},
+			}
+
+		case *tree.AlterOptionRenameColumn:
+			oldName, newName := opt.OldColumnName.Parts[0], opt.NewColumnName.Parts[0]
+			if err := checkColumnAlterable(ctx, tableDef, colMap, addedCols, oldName, "rename"); err != nil {
+				return nil, err
+			}
+			if err := checkNewColumnName(ctx, colMap, newName); err != nil {
+				return nil, err
+			}
+			colMap[newName] = colMap[oldName]
+			delete(colMap, oldName)
+			alterTable.Actions[i] = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_RenameColumn{
+					RenameColumn: &plan.AlterTableRenameColumn{
+						OldName: oldName,
+						NewName: newName,
+					},
+				},
+			}
+
 		case *tree.AlterOptionAlterIndex:
 			alterTableIndex := new(plan.AlterTableAlterIndex)
 			constraintName := string(opt.Name)
@@ -1848,6 +1995,17 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 		}
 	}
 
+	if stmt.PartitionOption != nil {
+		alterPartition, err := buildAlterTablePartition(ctx, stmt, databaseName, tableDef)
+		if err != nil {
+			return nil, err
+		}
+		alterTable.Actions = append(alterTable.Actions, &plan.AlterTable_Action{
+			Action: &plan.AlterTable_Action_AlterPartition{
+				AlterPartition: alterPartition,
+			},
+		})
+	}
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
 			Ddl: &plan.DataDefinition{
@@ -1861,6 +2019,308 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	}, nil
 }
 
+// checkNoConvertingColumns checks no column of the table is converted to a
+// new type. The converted column replaces the column when all the rows are
+// converted, the table can not be changed before that.
+func checkNoConvertingColumns(ctx CompilerContext, tableDef *TableDef, op string) error {
+	for _, col := range tableDef.Cols {
+		if name, ok := catalog.ConvertedColName(col.Name); ok {
+			return moerr.NewNotSupported(ctx.GetContext(), "%s table '%s' before the type of column '%s' is changed", op, tableDef.Name, name)
+		}
+	}
+	return nil
+}
+
+// buildColumnFillValue returns the value of the added column for the rows
+// written before the column is added. The value is evaluated once by alter
+// table and read from the schema, the existing blocks are not rewritten.
+func buildColumnFillValue(ctx CompilerContext, col *ColDef) ([]byte, error) {
+	expr, err := buildColumnFillExpr(ctx, col)
+	if err != nil {
+		return nil, err
+	}
+	proc := ctx.GetProcess()
+	bat := batch.NewWithSize(0)
+	bat.Zs = []int64{1}
+	vec, err := colexec.EvalExpr(bat, proc, expr)
+	if err != nil {
+		return nil, err
+	}
+	defer vec.Free(proc.Mp())
+	if vec.IsConstNull() || vec.GetNulls().Contains(0) {
+		if !col.Default.NullAbility {
+			return nil, moerr.NewConstraintViolation(ctx.GetContext(), "Column '%s' cannot be null", col.Name)
+		}
+		return nil, nil
+	}
+	return vec.ToConst(0, 1, proc.Mp()).MarshalBinary()
+}
+
+func buildColumnFillExpr(ctx CompilerContext, col *ColDef) (*Expr, error) {
+	if col.Default.Expr != nil {
+		return DeepCopyExpr(col.Default.Expr), nil
+	}
+	typ := DeepCopyType(col.Typ)
+	if col.Default.NullAbility {
+		typ.NotNullable = false
+		return &Expr{
+			Typ: typ,
+			Expr: &plan.Expr_C{
+				C: &Const{Isnull: true},
+			},
+		}, nil
+	}
+	// the implicit default value of the not null column as mysql
+	t := types.T(typ.Id)
+	switch {
+	case t == types.T_bool || t.IsInteger() || t.IsFloat() || t.IsDecimal():
+		return appendCastBeforeExpr(ctx.GetContext(), makePlan2Int64ConstExprWithType(0), typ)
+	case t.IsMySQLString():
+		return appendCastBeforeExpr(ctx.GetContext(), makePlan2StringConstExprWithType(""), typ)
+	}
+	return nil, moerr.NewNotSupported(ctx.GetContext(), "add not null column '%s' of type %s without default value", col.Name, t.String())
+}
+
+// checkColumnAlterable checks whether the column can be dropped, renamed or
+// modified in place. The columns referenced by keys, indexes and ttl can not
+// be dropped or renamed.
+func checkColumnAlterable(ctx CompilerContext, tableDef *TableDef, colMap map[string]*ColDef,
+	addedCols map[string]bool, name string, op string) error {
+	col, ok := colMap[name]
+	if !ok {
+		return moerr.NewBadFieldError(ctx.GetContext(), name, tableDef.Name)
+	}
+	if col.Hidden || name == catalog.Row_ID {
+		return moerr.NewInvalidInput(ctx.GetContext(), "can not %s the hidden column '%s'", op, name)
+	}
+	if addedCols[name] {
+		return moerr.NewNotSupported(ctx.GetContext(), "%s column '%s' added by the same statement", op, name)
+	}
+	if op == "modify" {
+		return nil
+	}
+	if col.Primary || col.Typ.AutoIncr {
+		return moerr.NewNotSupported(ctx.GetContext(), "%s the key column '%s'", op, name)
+	}
+	if tableDef.Pkey != nil {
+		for _, part := range tableDef.Pkey.Names {
+			if part == name {
+				return moerr.NewNotSupported(ctx.GetContext(), "%s the key column '%s'", op, name)
+			}
+		}
+	}
+	if tableDef.ClusterBy != nil && util.GetClusterByColumnOrder(tableDef.ClusterBy.Name, name) >= 0 {
+		return moerr.NewNotSupported(ctx.GetContext(), "%s the cluster by column '%s'", op, name)
+	}
+	for _, idx := range tableDef.Indexes {
+		for _, part := range idx.Parts {
+			if part == name {
+				return moerr.NewNotSupported(ctx.GetContext(), "%s the column '%s' used by index '%s'", op, name, idx.IndexName)
+			}
+		}
+	}
+	for _, fk := range tableDef.Fkeys {
+		for _, colId := range fk.Cols {
+			if colId == col.ColId {
+				return moerr.NewNotSupported(ctx.GetContext(), "%s the column '%s' used by foreign key '%s'", op, name, fk.Name)
+			}
+		}
+	}
+	if tableDef.Ttl != nil && tableDef.Ttl.ColName == name {
+		return moerr.NewNotSupported(ctx.GetContext(), "%s the ttl column '%s'", op, name)
+	}
+	if tableDef.Partition != nil {
+		for _, part := range partitionKeyColNames(tableDef) {
+			if part == name {
+				return moerr.NewNotSupported(ctx.GetContext(), "%s the partition key column '%s'", op, name)
+			}
+		}
+	}
+	return nil
+}
+
+func checkNewColumnName(ctx CompilerContext, colMap map[string]*ColDef, name string) error {
+	if _, ok := colMap[name]; ok {
+		return moerr.NewInvalidInput(ctx.GetContext(), "duplicate column name '%s'", name)
+	}
+	if !checkTableColumnNameValid(name) {
+		return moerr.NewInvalidInput(ctx.GetContext(), "table column name '%s' is illegal and conflicts with internal keyword", name)
+	}
+	return nil
+}
+
+func checkColumnPosition(ctx CompilerContext, tableDef *TableDef, colMap map[string]*ColDef,
+	pos *tree.ColumnPosition) (*plan.AlterColumnPosition, error) {
+	if pos == nil || pos.Typ == tree.ColumnPositionNone {
+		return nil, nil
+	}
+	ret := &plan.AlterColumnPosition{Typ: int32(pos.Typ)}
+	if pos.Typ == tree.ColumnPositionAfter {
+		ret.RelativeColumn = pos.RelativeColumn.Parts[0]
+		col, ok := colMap[ret.RelativeColumn]
+		if !ok || col.Hidden {
+			return nil, moerr.NewBadFieldError(ctx.GetContext(), ret.RelativeColumn, tableDef.Name)
+		}
+	}
+	return ret, nil
+}
+
+// buildAlterColumnDef builds the definition of the added or modified column,
+// the keys can not be defined by altering a column.
+func buildAlterColumnDef(ctx CompilerContext, def *tree.ColumnTableDef) (*ColDef, error) {
+	name := def.Name.Parts[0]
+	colType, err := getTypeFromAst(ctx.GetContext(), def.Type)
+	if err != nil {
+		return nil, err
+	}
+	if colType.Id == int32(types.T_char) || colType.Id == int32(types.T_varchar) ||
+		colType.Id == int32(types.T_binary) || colType.Id == int32(types.T_varbinary) {
+		if colType.GetWidth() > types.MaxStringSize {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "string width (%d) is too long", colType.GetWidth())
+		}
+	}
+	var comment string
+	for _, attr := range def.Attributes {
+		switch attribute := attr.(type) {
+		case *tree.AttributePrimaryKey, *tree.AttributeKey, *tree.AttributeUnique, *tree.AttributeUniqueKey:
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "define key on altered column '%s'", name)
+		case *tree.AttributeAutoIncrement:
+			colType.AutoIncr = true
+		case *tree.AttributeComment:
+			comment = attribute.CMT.String()
+			if getNumOfCharacters(comment) > maxLengthOfColumnComment {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "comment for column '%s' is too long", name)
+			}
+		}
+	}
+	defaultValue, err := buildDefaultExpr(def, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, err
+	}
+	onUpdateExpr, err := buildOnUpdate(def, colType, ctx.GetProcess())
+	if err != nil {
+		return nil, err
+	}
+	colType.NotNullable = !defaultValue.NullAbility
+	return &ColDef{
+		Name:     name,
+		Alg:      plan.CompressType_Lz4,
+		Typ:      colType,
+		Default:  defaultValue,
+		OnUpdate: onUpdateExpr,
+		Comment:  comment,
+	}, nil
+}
+
+// buildAlterTableAddColumn builds the action of adding a column. The rows
+// written before the column is added read the fill value of the column: its
+// default value, or the implicit default value if the column is not null.
+func buildAlterTableAddColumn(ctx CompilerContext, tableDef *TableDef, colMap map[string]*ColDef,
+	addedCols map[string]bool, def *tree.ColumnTableDef, pos *tree.ColumnPosition) (*plan.AlterTableAddColumn, error) {
+	col, err := buildAlterColumnDef(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkNewColumnName(ctx, colMap, col.Name); err != nil {
+		return nil, err
+	}
+	if col.Typ.AutoIncr {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "add auto_increment column '%s'", col.Name)
+	}
+	if col.Default.FillValue, err = buildColumnFillValue(ctx, col); err != nil {
+		return nil, err
+	}
+	position, err := checkColumnPosition(ctx, tableDef, colMap, pos)
+	if err != nil {
+		return nil, err
+	}
+	colMap[col.Name] = col
+	addedCols[col.Name] = true
+	return &plan.AlterTableAddColumn{
+		Column:   col,
+		Position: position,
+	}, nil
+}
+
+// buildAlterTableModifyColumn builds the action of MODIFY COLUMN and CHANGE
+// COLUMN. The changes compatible with the stored data are done in place: the
+// same type with a wider width, nullability, default value, comment and
+// position changed. Otherwise the column is converted to the new type in
+// background after the statement commits, see compile.alterTable.
+func buildAlterTableModifyColumn(ctx CompilerContext, tableDef *TableDef, colMap map[string]*ColDef,
+	addedCols map[string]bool, oldName string, def *tree.ColumnTableDef, pos *tree.ColumnPosition) (*plan.AlterTableModifyColumn, error) {
+	col, err := buildAlterColumnDef(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+	op := "modify"
+	if col.Name != oldName {
+		op = "rename"
+	}
+	if err = checkColumnAlterable(ctx, tableDef, colMap, addedCols, oldName, op); err != nil {
+		return nil, err
+	}
+	oldCol := colMap[oldName]
+	if col.Name != oldName {
+		if err = checkNewColumnName(ctx, colMap, col.Name); err != nil {
+			return nil, err
+		}
+	}
+	if !IsCompatibleColumnType(oldCol.Typ, col.Typ) {
+		// the converted values of the keys are not checked against the
+		// existing rows and the referencing tables
+		if err = checkColumnAlterable(ctx, tableDef, colMap, addedCols, oldName, "change the type of"); err != nil {
+			return nil, err
+		}
+		if _, _, _, err = function.GetFunctionByName(ctx.GetContext(), "cast",
+			[]types.Type{makeTypeByPlan2Type(oldCol.Typ), makeTypeByPlan2Type(col.Typ)}); err != nil {
+			return nil, err
+		}
+	} else {
+		col.Default.FillValue = oldCol.Default.GetFillValue()
+	}
+	if col.Typ.AutoIncr != oldCol.Typ.AutoIncr {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "change auto_increment of column '%s'", oldName)
+	}
+	if oldCol.Primary {
+		col.Default.NullAbility = false
+		col.Typ.NotNullable = true
+	}
+	// position is resolved before the column is renamed
+	position, err := checkColumnPosition(ctx, tableDef, colMap, pos)
+	if err != nil {
+		return nil, err
+	}
+	if position != nil && position.RelativeColumn == oldName {
+		return nil, moerr.NewBadFieldError(ctx.GetContext(), oldName, tableDef.Name)
+	}
+	col.Primary = oldCol.Primary
+	col.ColId = oldCol.ColId
+	delete(colMap, oldName)
+	colMap[col.Name] = col
+	return &plan.AlterTableModifyColumn{
+		OldName:  oldName,
+		Column:   col,
+		Position: position,
+	}, nil
+}
+
+// IsCompatibleColumnType returns true if the data of the old type can be read
+// as the new type without conversion
+func IsCompatibleColumnType(oldTyp, newTyp *plan.Type) bool {
+	if oldTyp.Id != newTyp.Id {
+		return false
+	}
+	switch types.T(oldTyp.Id) {
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
+		return newTyp.Width >= oldTyp.Width
+	case types.T_decimal64, types.T_decimal128, types.T_decimal256,
+		types.T_timestamp, types.T_datetime, types.T_time:
+		return newTyp.Width == oldTyp.Width && newTyp.Scale == oldTyp.Scale
+	}
+	return true
+}
+
 func buildLockTables(stmt *tree.LockTableStmt, ctx CompilerContext) (*Plan, error) {
 	lockTables := make([]*plan.TableLockInfo, 0, len(stmt.TableLocks))
 	uniqueTableName := make(map[string]bool)
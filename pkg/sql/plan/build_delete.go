@@ -38,7 +38,9 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 	}
 
 	canTruncate := false
-	if tblInfo.haveConstraint {
+	// the partitions of the deleted rows of a partitioned table are
+	// evaluated from all the columns of the table
+	if tblInfo.haveConstraint || tblInfo.havePartition {
 		bindCtx.groupTag = builder.genNewTag()
 		bindCtx.aggregateTag = builder.genNewTag()
 		bindCtx.projectTag = builder.genNewTag()
@@ -99,6 +101,18 @@ func buildDelete(stmt *tree.Delete, ctx CompilerContext) (*Plan, error) {
 		OnSetIdx:       make([]*plan.IdList, len(rewriteInfo.onSet)),
 		OnSetDef:       rewriteInfo.onSetTableDef,
 		OnSetUpdateCol: make([]*plan.ColPosMap, len(rewriteInfo.onSetUpdateCol)),
+
+		Partitions: rewriteInfo.partitions,
+	}
+	// the rows left in the main table by earlier versions are moved to the
+	// partition tables before they are deleted
+	for i, tableDef := range rewriteInfo.tblInfo.tableDefs {
+		if tableDef.Partition != nil {
+			deleteCtx.Partitions[i].PartitionExpression, err = buildPartitionIndexExpr(ctx, tableDef, partitionWriteColumns(tableDef))
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 	rowIdIdx := int64(0)
 	for i, table_def := range rewriteInfo.tblInfo.tableDefs {
@@ -43,6 +43,10 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Pla
 	if len(stmt.OnDuplicateUpdate) > 0 && clusterTable.IsClusterTable {
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "INSERT ... ON DUPLICATE KEY UPDATE ... for cluster table")
 	}
+	partition, err := buildInsertPartition(ctx, tblDef)
+	if err != nil {
+		return nil, err
+	}
 
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
 	bindCtx := NewBindContext(builder, nil)
@@ -64,6 +68,12 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Pla
 		}
 	}
 
+	// the old rows updated by on duplicate key are deleted from their
+	// partition tables
+	if partition != nil && len(rewriteInfo.partitions) > 0 {
+		partition.Idx = rewriteInfo.partitions[0].Idx
+	}
+
 	// append ProjectNode
 	rewriteInfo.rootId = builder.appendNode(&plan.Node{
 		NodeType:    plan.Node_PROJECT,
@@ -89,6 +99,8 @@ func buildInsert(stmt *tree.Insert, ctx CompilerContext, isReplace bool) (p *Pla
 
 		OnDuplicateIdx:  rewriteInfo.onDuplicateIdx,
 		OnDuplicateExpr: rewriteInfo.onDuplicateExpr,
+
+		Partition: partition,
 	}
 	if len(rewriteInfo.parentIdx) == 1 {
 		insertCtx.ParentIdx = rewriteInfo.parentIdx[0]
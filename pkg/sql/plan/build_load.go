@@ -95,12 +95,17 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext) (*Plan, error) {
 		return nil, err
 	}
 
+	partition, err := buildInsertPartition(ctx, tableDef)
+	if err != nil {
+		return nil, err
+	}
 	// node3.TableDef = tableDef
 	// node3.ObjRef = objRef
 	node3.InsertCtx = &plan.InsertCtx{
 		Ref:      objRef,
 		TableDef: tableDef,
 		// ParentIdx:    map[string]int32{},
+		Partition: partition,
 	}
 
 	stmt.Param.Tail.ColumnList = nil
@@ -59,6 +59,23 @@ func buildShowCreateDatabase(stmt *tree.ShowCreateDatabase,
 	return returnByRewriteSQL(ctx, sqlStr, plan.DataDefinition_SHOW_CREATEDATABASE)
 }
 
+// formatColType returns the column type in the syntax of create table
+func formatColType(colTyp *plan.Type) string {
+	typ := types.T(colTyp.Id).ToType()
+	typeStr := typ.String()
+	if typ.Oid.IsDecimal() { //after decimal fix,remove this
+		typeStr = fmt.Sprintf("DECIMAL(%d,%d)", colTyp.Width, colTyp.Scale)
+	}
+	if typ.Oid == types.T_varchar || typ.Oid == types.T_char ||
+		typ.Oid == types.T_binary || typ.Oid == types.T_varbinary {
+		typeStr += fmt.Sprintf("(%d)", colTyp.Width)
+	}
+	if typ.Oid.IsFloat() && colTyp.Scale != -1 {
+		typeStr += fmt.Sprintf("(%d,%d)", colTyp.Width, colTyp.Scale)
+	}
+	return typeStr
+}
+
 func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Plan, error) {
 	tblName := stmt.Name.Parts[0]
 	dbName := ctx.DefaultDatabase()
@@ -143,18 +160,7 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 		} else {
 			createStr += ",\n"
 		}
-		typ := types.T(col.Typ.Id).ToType()
-		typeStr := typ.String()
-		if typ.Oid.IsDecimal() { //after decimal fix,remove this
-			typeStr = fmt.Sprintf("DECIMAL(%d,%d)", col.Typ.Width, col.Typ.Scale)
-		}
-		if typ.Oid == types.T_varchar || typ.Oid == types.T_char ||
-			typ.Oid == types.T_binary || typ.Oid == types.T_varbinary {
-			typeStr += fmt.Sprintf("(%d)", col.Typ.Width)
-		}
-		if typ.Oid.IsFloat() && col.Typ.Scale != -1 {
-			typeStr += fmt.Sprintf("(%d,%d)", col.Typ.Width, col.Typ.Scale)
-		}
+		typeStr := formatColType(col.Typ)
 
 		updateOpt := ""
 		if col.OnUpdate != nil && col.OnUpdate.Expr != nil {
@@ -18,14 +18,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"go/constant"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // only use in developing
@@ -770,6 +775,19 @@ func TestDdl(t *testing.T) {
 		"unlock tables",
 		"alter table emp drop foreign key fk1",
 		"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(n_nationkey)",
+		"alter table nation add column n_extra int",
+		"alter table nation add n_extra varchar(20) default null after n_name",
+		"alter table nation add column n_extra int first, drop column n_comment",
+		"alter table nation modify n_comment varchar(200)",
+		"alter table nation modify column n_name varchar(25) null first",
+		"alter table nation change n_comment n_desc varchar(152) after n_nationkey",
+		"alter table nation rename column n_comment to n_desc",
+		"alter table nation rename column n_comment to n_desc, drop column n_name",
+		"alter table nation add column n_extra int not null",
+		"alter table nation add column n_extra int default 1",
+		"alter table nation modify n_comment int",
+		"alter table nation modify n_comment varchar(10)",
+		"alter table nation add column n_extra varchar(10) not null first, modify n_name char(10) not null",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -789,6 +807,17 @@ func TestDdl(t *testing.T) {
 		"alter table nation drop foreign key fk1", //key not exists
 		"alter table nation add FOREIGN KEY fk_t1(col_not_exist) REFERENCES nation2(n_nationkey)",
 		"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(col_not_exist)",
+		"alter table nation add column n_name int",                             // duplicate column
+		"alter table nation add column n_extra int after col_not_exist",        // column not exists
+		"alter table nation add column n_extra date not null",                  // no implicit default value
+		"alter table nation modify n_nationkey bigint",                         // type of primary key
+		"alter table nation add column n_extra int, drop column n_extra",       // changed in the same statement
+		"alter table nation modify col_not_exist int",                          // column not exists
+		"alter table nation rename column n_nationkey to n_key",                // primary key
+		"alter table nation rename column n_comment to n_name",                 // duplicate column
+		"alter table nation change n_comment n_name varchar(152)",              // duplicate column
+		"alter table nation drop column n_nationkey",                           // primary key
+		"alter table nation rename column n_comment to n_desc, drop n_comment", // column renamed
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -1018,6 +1047,38 @@ func TestVisitRule(t *testing.T) {
 	}
 }
 
+type paramValuesCompilerContext struct {
+	CompilerContext
+	params []*Expr
+}
+
+func (c *paramValuesCompilerContext) ResolveParams() []*Expr {
+	return c.params
+}
+
+func TestResolveParams(t *testing.T) {
+	sql := "select * from nation where n_nationkey > ? and n_regionkey < ?"
+	mock := NewMockOptimizer(false)
+	stmts, err := mysql.Parse(mock.CurrentContext().GetContext(), sql, 1)
+	require.NoError(t, err)
+
+	params, err := BindConstantExprs(mock.CurrentContext(), []tree.Expr{
+		tree.NewNumValWithType(constant.MakeInt64(10), "10", false, tree.P_int64),
+		tree.NewNumValWithType(constant.MakeInt64(3), "3", false, tree.P_int64),
+	})
+	require.NoError(t, err)
+	require.Len(t, params, 2)
+	logicPlan, err := BuildPlan(&paramValuesCompilerContext{mock.CurrentContext(), params}, stmts[0])
+	require.NoError(t, err)
+	getParamRule := NewGetParamRule()
+	vp := NewVisitPlan(logicPlan, []VisitPlanRule{getParamRule})
+	require.NoError(t, vp.Visit(context.TODO()))
+	require.Empty(t, getParamRule.params)
+
+	_, err = BuildPlan(&paramValuesCompilerContext{mock.CurrentContext(), params[:1]}, stmts[0])
+	require.Error(t, err)
+}
+
 func getJSON(v any, t *testing.T) []byte {
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -1125,3 +1186,37 @@ func Test_mergeContexts(t *testing.T) {
 	assert.Error(t, err)
 	assert.EqualError(t, err, "invalid input: table 'a' specified more than once")
 }
+
+func TestAlterTableAddColumnFillValue(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	fillValue := func(sql string) []byte {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err)
+		action := logicPlan.GetDdl().GetAlterTable().Actions[0]
+		return action.GetAddColumn().Column.Default.FillValue
+	}
+
+	require.Nil(t, fillValue("alter table nation add column n_extra int"))
+
+	vec := vector.NewVec(types.T_int32.ToType())
+	require.NoError(t, vec.UnmarshalBinary(fillValue("alter table nation add column n_extra int not null first")))
+	require.True(t, vec.IsConst())
+	require.Equal(t, int32(0), vector.MustFixedCol[int32](vec)[0])
+
+	vec = vector.NewVec(types.T_varchar.ToType())
+	require.NoError(t, vec.UnmarshalBinary(fillValue("alter table nation add column n_extra varchar(10) default 'abc'")))
+	require.Equal(t, "abc", vec.GetStringAt(0))
+}
+
+func TestAlterTableModifyColumnType(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	logicPlan, err := runOneStmt(mock, t, "alter table nation modify n_comment int")
+	require.NoError(t, err)
+	modify := logicPlan.GetDdl().GetAlterTable().Actions[0].GetModifyColumn()
+	require.Equal(t, int32(types.T_int32), modify.Column.Typ.Id)
+	require.False(t, IsCompatibleColumnType(&plan.Type{Id: int32(types.T_varchar), Width: 152}, modify.Column.Typ))
+
+	// the converted values of the keys are not checked
+	_, err = runOneStmt(mock, t, "alter table nation modify n_nationkey bigint")
+	require.Error(t, err)
+}
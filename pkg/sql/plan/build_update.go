@@ -88,6 +88,8 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		OnSetUpdateCol: make([]*plan.ColPosMap, len(rewriteInfo.onSetUpdateCol)),
 
 		ParentIdx: make([]*plan.ColPosMap, len(rewriteInfo.parentIdx)),
+
+		Partitions: rewriteInfo.partitions,
 	}
 	idx := int64(0)
 	for i, tableDef := range rewriteInfo.tblInfo.tableDefs {
@@ -100,6 +102,12 @@ func buildTableUpdate(stmt *tree.Update, ctx CompilerContext) (p *Plan, err erro
 		updateCtx.Idx[i] = &plan.IdList{
 			List: idxList,
 		}
+		if tableDef.Partition != nil {
+			updateCtx.Partitions[i].PartitionExpression, err = buildPartitionIndexExpr(ctx, tableDef, partitionWriteColumns(tableDef))
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 	for i, idxMap := range rewriteInfo.tblInfo.updateCol {
 		updateCtx.UpdateCol[i] = &plan.ColPosMap{
@@ -70,6 +70,7 @@ func DeepCopyInsertCtx(ctx *plan.InsertCtx) *plan.InsertCtx {
 		IdxRef: make([]*plan.ObjectRef, len(ctx.IdxRef)),
 
 		ClusterTable: DeepCopyClusterTable(ctx.ClusterTable),
+		Partition:    DeepCopyDmlPartition(ctx.Partition),
 	}
 
 	copy(newCtx.OnDuplicateIdx, ctx.OnDuplicateIdx)
@@ -95,6 +96,28 @@ func DeepCopyInsertCtx(ctx *plan.InsertCtx) *plan.InsertCtx {
 	return newCtx
 }
 
+func DeepCopyDmlPartition(partition *plan.DmlPartition) *plan.DmlPartition {
+	if partition == nil {
+		return nil
+	}
+	return &plan.DmlPartition{
+		PartitionTableNames: DeepCopyStringList(partition.PartitionTableNames),
+		Idx:                 partition.Idx,
+		PartitionExpression: DeepCopyExpr(partition.PartitionExpression),
+	}
+}
+
+func DeepCopyDmlPartitions(partitions []*plan.DmlPartition) []*plan.DmlPartition {
+	if partitions == nil {
+		return nil
+	}
+	newPartitions := make([]*plan.DmlPartition, len(partitions))
+	for i, partition := range partitions {
+		newPartitions[i] = DeepCopyDmlPartition(partition)
+	}
+	return newPartitions
+}
+
 func DeepCopyDeleteCtx(ctx *plan.DeleteCtx) *plan.DeleteCtx {
 	if ctx == nil {
 		return nil
@@ -117,6 +140,7 @@ func DeepCopyDeleteCtx(ctx *plan.DeleteCtx) *plan.DeleteCtx {
 		OnSetIdx:       make([]*plan.IdList, len(ctx.OnSetIdx)),
 		Idx:            make([]*plan.IdList, len(ctx.Idx)),
 		OnSetUpdateCol: make([]*plan.ColPosMap, len(ctx.OnSetUpdateCol)),
+		Partitions:     DeepCopyDmlPartitions(ctx.Partitions),
 	}
 
 	copy(newCtx.OnRestrictIdx, ctx.OnRestrictIdx)
@@ -194,6 +218,8 @@ func DeepCopyUpdateCtx(ctx *plan.UpdateCtx) *plan.UpdateCtx {
 		OnSetUpdateCol: make([]*plan.ColPosMap, len(ctx.OnSetUpdateCol)),
 
 		ParentIdx: make([]*plan.ColPosMap, len(ctx.ParentIdx)),
+
+		Partitions: DeepCopyDmlPartitions(ctx.Partitions),
 	}
 
 	for i, ref := range ctx.Ref {
@@ -346,6 +372,16 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		newNode.TableDefVec[i] = DeepCopyTableDef(tbl)
 	}
 
+	if node.PartitionPrune != nil {
+		newNode.PartitionPrune = &plan.PartitionPrune{
+			IsPruned:           node.PartitionPrune.IsPruned,
+			SelectedPartitions: make([]*plan.PartitionItem, len(node.PartitionPrune.SelectedPartitions)),
+		}
+		for i, item := range node.PartitionPrune.SelectedPartitions {
+			newNode.PartitionPrune.SelectedPartitions[i] = DeepCopyPartitionItem(item)
+		}
+	}
+
 	if node.Stats != nil {
 		newNode.Stats = &plan.Stats{
 			BlockNum:    node.Stats.BlockNum,
@@ -401,6 +437,7 @@ func DeepCopyDefault(def *plan.Default) *plan.Default {
 		NullAbility:  def.NullAbility,
 		Expr:         DeepCopyExpr(def.Expr),
 		OriginString: def.OriginString,
+		FillValue:    def.FillValue,
 	}
 }
 
@@ -493,6 +530,21 @@ func DeepCopyTableDefList(src []*plan.TableDef) []*plan.TableDef {
 	return ret
 }
 
+func DeepCopyPartitionItem(item *plan.PartitionItem) *plan.PartitionItem {
+	if item == nil {
+		return nil
+	}
+	return &plan.PartitionItem{
+		PartitionName:      item.PartitionName,
+		OrdinalPosition:    item.OrdinalPosition,
+		Description:        item.Description,
+		Comment:            item.Comment,
+		LessThan:           DeepCopyExprList(item.LessThan),
+		InValues:           DeepCopyExprList(item.InValues),
+		PartitionTableName: item.PartitionTableName,
+	}
+}
+
 func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 	if table == nil {
 		return nil
@@ -570,15 +622,7 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		}
 
 		for i, e := range table.Partition.Partitions {
-			partitionDef.Partitions[i] = &plan.PartitionItem{
-				PartitionName:      e.PartitionName,
-				OrdinalPosition:    e.OrdinalPosition,
-				Description:        e.Description,
-				Comment:            e.Comment,
-				LessThan:           DeepCopyExprList(e.LessThan),
-				InValues:           DeepCopyExprList(e.InValues),
-				PartitionTableName: e.PartitionTableName,
-			}
+			partitionDef.Partitions[i] = DeepCopyPartitionItem(e)
 		}
 		newTable.Partition = partitionDef
 	}
@@ -751,7 +795,6 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 				}
 			}
 		}
-
 		newDf.Definition = &plan.DataDefinition_AlterTable{
 			AlterTable: AlterTable,
 		}
@@ -808,6 +851,8 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 			Table:           df.TruncateTable.Table,
 			ClusterTable:    DeepCopyClusterTable(df.TruncateTable.GetClusterTable()),
 			IndexTableNames: make([]string, len(df.TruncateTable.IndexTableNames)),
+
+			PartitionTableNames: DeepCopyStringList(df.TruncateTable.PartitionTableNames),
 		}
 		copy(truncateTable.IndexTableNames, df.TruncateTable.IndexTableNames)
 		newDf.Definition = &plan.DataDefinition_TruncateTable{
@@ -17,6 +17,7 @@ package explain
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -271,6 +272,15 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, filterInfo)
 	}
 
+	// Get the partitions selected by pruning
+	if ndesc.Node.PartitionPrune != nil && ndesc.Node.PartitionPrune.IsPruned {
+		names := make([]string, len(ndesc.Node.PartitionPrune.SelectedPartitions))
+		for i, p := range ndesc.Node.PartitionPrune.SelectedPartitions {
+			names[i] = p.PartitionName
+		}
+		lines = append(lines, "Partitions: "+strings.Join(names, ", "))
+	}
+
 	// Get Limit And Offset info
 	if ndesc.Node.Limit != nil {
 		var temp string
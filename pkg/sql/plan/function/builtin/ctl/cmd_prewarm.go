@@ -0,0 +1,175 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/ctl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type prewarmParameter struct {
+	db      string
+	table   string
+	columns []string
+}
+
+type prewarmResult struct {
+	Objects int      `json:"objects"`
+	Blocks  int      `json:"blocks"`
+	Columns []string `json:"columns"`
+}
+
+// parsePrewarmParameter parses "DbName.TableName[:Col1,Col2]"
+func parsePrewarmParameter(proc *process.Process, parameter string) (prewarmParameter, error) {
+	var p prewarmParameter
+	name, columns, hasColumns := strings.Cut(parameter, ":")
+	db, table, ok := strings.Cut(name, ".")
+	if !ok || db == "" || table == "" {
+		return p, moerr.NewInvalidInput(proc.Ctx, "prewarm parameter should be DbName.TableName[:Col1,Col2], got %s", parameter)
+	}
+	p.db, p.table = db, table
+	if hasColumns {
+		for _, col := range strings.Split(columns, ",") {
+			if col = strings.TrimSpace(col); col != "" {
+				p.columns = append(p.columns, col)
+			}
+		}
+		if len(p.columns) == 0 {
+			return p, moerr.NewInvalidInput(proc.Ctx, "prewarm columns are empty")
+		}
+	}
+	return p, nil
+}
+
+// handlePrewarm loads the object meta, zonemaps and hot columns of a table into the
+// caches of the current cn.
+func handlePrewarm(proc *process.Process,
+	service serviceType,
+	parameter string,
+	sender requestSender) (pb.CtlResult, error) {
+	if service != cn {
+		return pb.CtlResult{}, moerr.NewNotSupported(proc.Ctx, "service %s not supported", service)
+	}
+	p, err := parsePrewarmParameter(proc, parameter)
+	if err != nil {
+		return pb.CtlResult{}, err
+	}
+	if proc.TxnOperator == nil {
+		return pb.CtlResult{}, moerr.NewInternalError(proc.Ctx, "handlePrewarm: txn operator is nil")
+	}
+	database, err := proc.SessionInfo.StorageEngine.Database(proc.Ctx, p.db, proc.TxnOperator)
+	if err != nil {
+		return pb.CtlResult{}, err
+	}
+	rel, err := database.Relation(proc.Ctx, p.table)
+	if err != nil {
+		return pb.CtlResult{}, err
+	}
+
+	attrs, err := rel.TableColumns(proc.Ctx)
+	if err != nil {
+		return pb.CtlResult{}, err
+	}
+	hotAttrs, err := getPrewarmColumns(proc, attrs, p.columns)
+	if err != nil {
+		return pb.CtlResult{}, err
+	}
+	result := prewarmResult{
+		Columns: make([]string, 0, len(hotAttrs)),
+	}
+	seqnums := make([]uint16, 0, len(hotAttrs))
+	typs := make([]types.Type, 0, len(hotAttrs))
+	for _, attr := range hotAttrs {
+		result.Columns = append(result.Columns, attr.Name)
+		seqnums = append(seqnums, attr.Seqnum)
+		typs = append(typs, attr.Type)
+	}
+
+	ranges, err := rel.Ranges(proc.Ctx, nil)
+	if err != nil {
+		return pb.CtlResult{}, err
+	}
+	readers := make(map[string]*blockio.BlockReader)
+	for _, data := range ranges {
+		if len(data) == 0 {
+			// the in-memory part of the table
+			continue
+		}
+		blk := catalog.DecodeBlockInfo(data)
+		location := blk.MetaLocation()
+		if location.IsEmpty() {
+			continue
+		}
+		name := location.Name().String()
+		reader, ok := readers[name]
+		if !ok {
+			if reader, err = blockio.NewObjectReader(proc.FileService, location); err != nil {
+				return pb.CtlResult{}, err
+			}
+			// object meta contains the zonemaps of the blocks
+			if _, err = reader.LoadObjectMeta(proc.Ctx, proc.Mp()); err != nil {
+				return pb.CtlResult{}, err
+			}
+			readers[name] = reader
+			result.Objects++
+		}
+		if len(seqnums) > 0 {
+			if _, err = reader.LoadColumns(proc.Ctx, seqnums, typs, location.ID(), proc.Mp()); err != nil {
+				return pb.CtlResult{}, err
+			}
+		}
+		result.Blocks++
+	}
+
+	return pb.CtlResult{
+		Method: pb.CmdMethod_Prewarm.String(),
+		Data:   result,
+	}, nil
+}
+
+// getPrewarmColumns returns the attributes of columns, or the primary key and
+// cluster by columns if no column is specified
+func getPrewarmColumns(proc *process.Process, attrs []*engine.Attribute, columns []string) ([]*engine.Attribute, error) {
+	var hotAttrs []*engine.Attribute
+	if len(columns) == 0 {
+		for _, attr := range attrs {
+			if (attr.Primary || attr.ClusterBy) && !attr.IsHidden {
+				hotAttrs = append(hotAttrs, attr)
+			}
+		}
+		return hotAttrs, nil
+	}
+	for _, col := range columns {
+		found := false
+		for _, attr := range attrs {
+			if strings.EqualFold(attr.Name, col) {
+				hotAttrs = append(hotAttrs, attr)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, moerr.NewInvalidInput(proc.Ctx, "column %s not found", col)
+		}
+	}
+	return hotAttrs, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrewarmParameter(t *testing.T) {
+	proc := process.New(context.Background(), nil, nil, nil, nil, nil, nil)
+
+	p, err := parsePrewarmParameter(proc, "db.t")
+	require.NoError(t, err)
+	assert.Equal(t, prewarmParameter{db: "db", table: "t"}, p)
+
+	p, err = parsePrewarmParameter(proc, "db.t:a, b")
+	require.NoError(t, err)
+	assert.Equal(t, prewarmParameter{db: "db", table: "t", columns: []string{"a", "b"}}, p)
+
+	for _, parameter := range []string{"", "db", "db.", ".t", "db.t:", "db.t:,"} {
+		_, err = parsePrewarmParameter(proc, parameter)
+		assert.Error(t, err, parameter)
+	}
+}
+
+func TestGetPrewarmColumns(t *testing.T) {
+	proc := process.New(context.Background(), nil, nil, nil, nil, nil, nil)
+	attrs := []*engine.Attribute{
+		{Name: "a", Primary: true, Seqnum: 0},
+		{Name: "b", Seqnum: 1},
+		{Name: "c", ClusterBy: true, Seqnum: 2},
+		{Name: "__mo_rowid", IsHidden: true, Primary: true, Seqnum: 3},
+	}
+
+	hot, err := getPrewarmColumns(proc, attrs, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*engine.Attribute{attrs[0], attrs[2]}, hot)
+
+	hot, err = getPrewarmColumns(proc, attrs, []string{"B"})
+	require.NoError(t, err)
+	assert.Equal(t, []*engine.Attribute{attrs[1]}, hot)
+
+	_, err = getPrewarmColumns(proc, attrs, []string{"d"})
+	assert.Error(t, err)
+}
@@ -325,6 +325,7 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 			{"creator", types.T_int32, false, 50, 0},
 			{"owner", types.T_int32, false, 50, 0},
 			{"default_role", types.T_int32, false, 50, 0},
+			{"tls_require", types.T_varchar, false, 1024, 0},
 			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
 		},
 	}
@@ -794,6 +795,10 @@ func (m *MockCompilerContext) Stats(obj *ObjectRef, e *Expr) *Stats {
 	return m.stats[obj.ObjName]
 }
 
+func (m *MockCompilerContext) ResolveParams() []*Expr {
+	return nil
+}
+
 func (m *MockCompilerContext) GetStatsCache() *StatsCache {
 	return nil
 }
@@ -0,0 +1,472 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// buildAlterTablePartition builds the action of ALTER TABLE ... ADD, DROP,
+// TRUNCATE, REORGANIZE and EXCHANGE PARTITION.
+// The rows of a partitioned table are stored in its partition tables, so
+// ADD, DROP and TRUNCATE only change the partition tables. REORGANIZE and
+// EXCHANGE move the rows of the changed partition tables, they are routed by
+// the partition expression of the table.
+func buildAlterTablePartition(ctx CompilerContext, stmt *tree.AlterTable, databaseName string, tableDef *TableDef) (*plan.AlterTablePartition, error) {
+	partitionDef := tableDef.Partition
+	if partitionDef == nil {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "partition management on a not partitioned table is not possible")
+	}
+	oldOption, err := buildPartitionSyntax(ctx.GetContext(), partitionDef)
+	if err != nil {
+		return nil, err
+	}
+
+	alterPartition := &plan.AlterTablePartition{}
+	switch opt := stmt.PartitionOption.(type) {
+	case *tree.AlterPartitionAddPartition:
+		alterPartition.Typ = plan.AlterTablePartition_ADD_PARTITION
+		if err = checkPartitionDefsChangeable(ctx.GetContext(), partitionDef, "ADD"); err != nil {
+			return nil, err
+		}
+		newOption := *oldOption
+		newOption.Partitions = append(append([]*tree.Partition{}, oldOption.Partitions...), opt.Partitions...)
+		if err = buildAlteredPartitionDef(ctx, stmt, tableDef, &newOption, alterPartition); err != nil {
+			return nil, err
+		}
+
+	case *tree.AlterPartitionDropPartition:
+		alterPartition.Typ = plan.AlterTablePartition_DROP_PARTITION
+		if err = checkPartitionDefsChangeable(ctx.GetContext(), partitionDef, "DROP"); err != nil {
+			return nil, err
+		}
+		if err = checkPartitionRowsRemovable(ctx.GetContext(), tableDef, "DROP"); err != nil {
+			return nil, err
+		}
+		positions, err := findPartitionPositions(ctx.GetContext(), partitionDef, opt.PartitionNames)
+		if err != nil {
+			return nil, err
+		}
+		if len(positions) == len(partitionDef.Partitions) {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "cannot remove all partitions, use DROP TABLE instead")
+		}
+		newOption := *oldOption
+		newOption.Partitions = nil
+		for i, p := range oldOption.Partitions {
+			if _, ok := positions[i]; !ok {
+				newOption.Partitions = append(newOption.Partitions, p)
+			}
+		}
+		if err = buildAlteredPartitionDef(ctx, stmt, tableDef, &newOption, alterPartition); err != nil {
+			return nil, err
+		}
+
+	case *tree.AlterPartitionTruncatePartition:
+		alterPartition.Typ = plan.AlterTablePartition_TRUNCATE_PARTITION
+		if err = checkPartitionRowsRemovable(ctx.GetContext(), tableDef, "TRUNCATE"); err != nil {
+			return nil, err
+		}
+		positions := make(map[int]struct{})
+		if opt.All {
+			for i := range partitionDef.Partitions {
+				positions[i] = struct{}{}
+			}
+		} else if positions, err = findPartitionPositions(ctx.GetContext(), partitionDef, opt.PartitionNames); err != nil {
+			return nil, err
+		}
+		for i, p := range partitionDef.Partitions {
+			if _, ok := positions[i]; ok {
+				alterPartition.TruncateTables = append(alterPartition.TruncateTables, p.PartitionTableName)
+			}
+		}
+
+	case *tree.AlterPartitionReorganizePartition:
+		alterPartition.Typ = plan.AlterTablePartition_REORGANIZE_PARTITION
+		if err = checkPartitionDefsChangeable(ctx.GetContext(), partitionDef, "REORGANIZE"); err != nil {
+			return nil, err
+		}
+		positions, err := findPartitionPositions(ctx.GetContext(), partitionDef, opt.PartitionNames)
+		if err != nil {
+			return nil, err
+		}
+		first, last := len(partitionDef.Partitions), -1
+		for i := range positions {
+			if i < first {
+				first = i
+			}
+			if i > last {
+				last = i
+			}
+		}
+		if last-first+1 != len(positions) {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "when reorganizing a set of partitions they must be in consecutive order")
+		}
+		if len(opt.Partitions) == 0 {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "the partitions to be reorganized into are missing")
+		}
+		isRange := partitionDef.Type == plan.PartitionType_RANGE || partitionDef.Type == plan.PartitionType_RANGE_COLUMNS
+		if isRange && last != len(partitionDef.Partitions)-1 {
+			// the range covered by the reorganized partitions can not be changed
+			newLast, ok := opt.Partitions[len(opt.Partitions)-1].Values.(*tree.ValuesLessThan)
+			if !ok || tree.String(newLast.ValueList, dialect.MYSQL) != partitionDef.Partitions[last].Description {
+				return nil, moerr.NewInvalidInput(ctx.GetContext(), "reorganize of range partitions cannot change total ranges except for last partition where it can extend the range")
+			}
+		}
+		newOption := *oldOption
+		newOption.Partitions = append([]*tree.Partition{}, oldOption.Partitions[:first]...)
+		newOption.Partitions = append(newOption.Partitions, opt.Partitions...)
+		newOption.Partitions = append(newOption.Partitions, oldOption.Partitions[last+1:]...)
+		if err = buildAlteredPartitionDef(ctx, stmt, tableDef, &newOption, alterPartition); err != nil {
+			return nil, err
+		}
+		// the rows of the reorganized partitions are moved into the new
+		// partition tables, even the ones keeping the same name
+		alterPartition.DropTables = alterPartition.DropTables[:0]
+		for i := first; i <= last; i++ {
+			alterPartition.DropTables = append(alterPartition.DropTables, partitionDef.Partitions[i].PartitionTableName)
+		}
+		cols := partitionTableColumns(tableDef)
+		alterPartition.CreateTables = alterPartition.CreateTables[:0]
+		for i := first; i < first+len(opt.Partitions); i++ {
+			alterPartition.CreateTables = append(alterPartition.CreateTables,
+				newPartitionTableDef(alterPartition.NewPartition.PartitionTableNames[i], cols))
+		}
+		newTableDef := &TableDef{
+			Name:      tableDef.Name,
+			Cols:      tableDef.Cols,
+			Partition: alterPartition.NewPartition,
+		}
+		if alterPartition.PartitionExpression, err = buildPartitionIndexExpr(ctx, newTableDef, partitionWriteColumns(tableDef)); err != nil {
+			return nil, err
+		}
+
+	case *tree.AlterPartitionExchangePartition:
+		alterPartition.Typ = plan.AlterTablePartition_EXCHANGE_PARTITION
+		positions, err := findPartitionPositions(ctx.GetContext(), partitionDef, tree.IdentifierList{opt.PartitionName})
+		if err != nil {
+			return nil, err
+		}
+		if err = checkPartitionRowsRemovable(ctx.GetContext(), tableDef, "EXCHANGE"); err != nil {
+			return nil, err
+		}
+		if err = buildExchangePartition(ctx, opt, databaseName, tableDef, alterPartition); err != nil {
+			return nil, err
+		}
+		for i := range positions {
+			alterPartition.ExchangePartitionIdx = int32(i)
+		}
+		if alterPartition.PartitionExpression, err = buildPartitionIndexExpr(ctx, tableDef, partitionWriteColumns(tableDef)); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "alter partition option %s", tree.String(opt, dialect.MYSQL))
+	}
+	return alterPartition, nil
+}
+
+// checkPartitionDefsChangeable checks whether the partition definitions can
+// be changed, only the partitions of RANGE and LIST can be added, dropped or
+// reorganized. Changing the partitions of HASH and KEY would move every row.
+func checkPartitionDefsChangeable(ctx context.Context, partitionDef *plan.PartitionByDef, op string) error {
+	switch partitionDef.Type {
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS,
+		plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+		return nil
+	}
+	return moerr.NewNotSupported(ctx, "%s PARTITION on the %s partitioned table", op, partitionDef.Type.String())
+}
+
+// checkPartitionRowsRemovable checks whether the rows of a partition table can
+// be removed without deleting them one by one. The unique index tables and the
+// tables referring to the table would keep the entries of the removed rows.
+func checkPartitionRowsRemovable(ctx context.Context, tableDef *TableDef, op string) error {
+	for _, index := range tableDef.Indexes {
+		if index.Unique {
+			return moerr.NewNotSupported(ctx, "%s PARTITION on the partitioned table with unique key", op)
+		}
+	}
+	if len(tableDef.RefChildTbls) > 0 {
+		return moerr.NewNotSupported(ctx, "%s PARTITION on the partitioned table referenced by foreign keys", op)
+	}
+	return nil
+}
+
+// findPartitionPositions returns the positions of the named partitions
+func findPartitionPositions(ctx context.Context, partitionDef *plan.PartitionByDef, names tree.IdentifierList) (map[int]struct{}, error) {
+	positions := make(map[int]struct{}, len(names))
+	for _, name := range names {
+		found := false
+		for i, p := range partitionDef.Partitions {
+			if strings.EqualFold(p.PartitionName, string(name)) {
+				positions[i] = struct{}{}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, moerr.NewInvalidInput(ctx, "unknown partition '%s'", string(name))
+		}
+	}
+	return positions, nil
+}
+
+// buildPartitionSyntax rebuilds the partition clause of the table from its
+// partition definition. The comments of the partitions are not needed to
+// locate rows, so they are set after parsing.
+func buildPartitionSyntax(ctx context.Context, partitionDef *plan.PartitionByDef) (*tree.PartitionOption, error) {
+	var columns string
+	if partitionDef.PartitionColumns != nil {
+		columns = "`" + strings.Join(partitionDef.PartitionColumns.PartitionColumns, "`, `") + "`"
+	}
+	var expr string
+	if partitionDef.PartitionExpr != nil {
+		expr = partitionDef.PartitionExpr.ExprStr
+	}
+
+	var sql string
+	switch partitionDef.Type {
+	case plan.PartitionType_HASH:
+		sql = fmt.Sprintf("partition by hash (%s) partitions %d", expr, partitionDef.PartitionNum)
+	case plan.PartitionType_LINEAR_HASH:
+		sql = fmt.Sprintf("partition by linear hash (%s) partitions %d", expr, partitionDef.PartitionNum)
+	case plan.PartitionType_KEY:
+		sql = fmt.Sprintf("partition by key (%s) partitions %d", columns, partitionDef.PartitionNum)
+	case plan.PartitionType_LINEAR_KEY:
+		sql = fmt.Sprintf("partition by linear key (%s) partitions %d", columns, partitionDef.PartitionNum)
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS,
+		plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+		switch partitionDef.Type {
+		case plan.PartitionType_RANGE:
+			sql = fmt.Sprintf("partition by range (%s) (", expr)
+		case plan.PartitionType_RANGE_COLUMNS:
+			sql = fmt.Sprintf("partition by range columns (%s) (", columns)
+		case plan.PartitionType_LIST:
+			sql = fmt.Sprintf("partition by list (%s) (", expr)
+		default:
+			sql = fmt.Sprintf("partition by list columns (%s) (", columns)
+		}
+		for i, p := range partitionDef.Partitions {
+			if i > 0 {
+				sql += ", "
+			}
+			if partitionDef.Type == plan.PartitionType_RANGE || partitionDef.Type == plan.PartitionType_RANGE_COLUMNS {
+				values, ok := formatPartitionValues(p.LessThan)
+				if !ok {
+					values = p.Description
+				}
+				sql += fmt.Sprintf("partition `%s` values less than (%s)", p.PartitionName, values)
+			} else {
+				if values, ok := formatPartitionValues(p.InValues); ok {
+					sql += fmt.Sprintf("partition `%s` values in (%s)", p.PartitionName, values)
+				} else {
+					sql += fmt.Sprintf("partition `%s` %s", p.PartitionName, p.Description)
+				}
+			}
+		}
+		sql += ")"
+	default:
+		return nil, moerr.NewNotSupported(ctx, "partition type %s", partitionDef.Type.String())
+	}
+
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "create table t (a int) "+sql, 1)
+	if err != nil {
+		return nil, err
+	}
+	option := stmt.(*tree.CreateTable).PartitionOption
+	for i, p := range option.Partitions {
+		if comment := partitionDef.Partitions[i].Comment; comment != "" {
+			p.Options = []tree.TableOption{&tree.TableOptionComment{Comment: comment}}
+		}
+	}
+	return option, nil
+}
+
+// formatPartitionValues formats the bound values of a partition in sql. The
+// description of a partition does not quote the strings, so the values are
+// formatted from the constants, false if there is a value not a constant.
+func formatPartitionValues(exprs []*Expr) (string, bool) {
+	values := make([]string, len(exprs))
+	for i, expr := range exprs {
+		var ok bool
+		if values[i], ok = formatPartitionValue(expr); !ok {
+			return "", false
+		}
+	}
+	return strings.Join(values, ", "), len(values) > 0
+}
+
+func formatPartitionValue(expr *Expr) (string, bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Max:
+		return "MAXVALUE", true
+	case *plan.Expr_List:
+		values, ok := formatPartitionValues(e.List.List)
+		return "(" + values + ")", ok
+	case *plan.Expr_C:
+		if e.C.Isnull {
+			return "null", true
+		}
+		switch v := e.C.Value.(type) {
+		case *plan.Const_I8Val:
+			return strconv.FormatInt(int64(v.I8Val), 10), true
+		case *plan.Const_I16Val:
+			return strconv.FormatInt(int64(v.I16Val), 10), true
+		case *plan.Const_I32Val:
+			return strconv.FormatInt(int64(v.I32Val), 10), true
+		case *plan.Const_I64Val:
+			return strconv.FormatInt(v.I64Val, 10), true
+		case *plan.Const_U8Val:
+			return strconv.FormatUint(uint64(v.U8Val), 10), true
+		case *plan.Const_U16Val:
+			return strconv.FormatUint(uint64(v.U16Val), 10), true
+		case *plan.Const_U32Val:
+			return strconv.FormatUint(uint64(v.U32Val), 10), true
+		case *plan.Const_U64Val:
+			return strconv.FormatUint(v.U64Val, 10), true
+		case *plan.Const_Sval:
+			return "'" + strings.ReplaceAll(v.Sval, "'", "''") + "'", true
+		}
+	}
+	return "", false
+}
+
+// buildAlteredPartitionDef builds the partition definition with the changed
+// partitions, the partition tables of the new partitions are created and the
+// ones of the removed partitions are dropped.
+func buildAlteredPartitionDef(ctx CompilerContext, stmt *tree.AlterTable, tableDef *TableDef,
+	option *tree.PartitionOption, alterPartition *plan.AlterTablePartition) error {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return err
+	}
+	option.PartBy.Num = uint64(len(option.Partitions))
+	newTableDef := &TableDef{
+		Name:    tableDef.Name,
+		Cols:    tableDef.Cols,
+		Pkey:    tableDef.Pkey,
+		Indexes: tableDef.Indexes,
+	}
+	createTable := &tree.CreateTable{
+		Table:           *stmt.Table,
+		PartitionOption: option,
+	}
+	if err := buildPartitionByClause(ctx.GetContext(), NewPartitionBinder(builder, bindContext), createTable, newTableDef); err != nil {
+		return err
+	}
+
+	cols := partitionTableColumns(tableDef)
+	newPartitionDef := newTableDef.Partition
+	newPartitionDef.PartitionTableNames = make([]string, len(newPartitionDef.Partitions))
+	kept := make(map[string]bool)
+	for i, p := range newPartitionDef.Partitions {
+		ok, name := util.MakeNameOfPartitionTable(p.PartitionName, tableDef.Name)
+		if !ok {
+			return moerr.NewInvalidInput(ctx.GetContext(), "invalid partition table name %s", name)
+		}
+		p.PartitionTableName = name
+		newPartitionDef.PartitionTableNames[i] = name
+		kept[name] = true
+	}
+	old := make(map[string]bool)
+	for _, name := range tableDef.Partition.PartitionTableNames {
+		old[name] = true
+		if !kept[name] {
+			alterPartition.DropTables = append(alterPartition.DropTables, name)
+		}
+	}
+	for _, name := range newPartitionDef.PartitionTableNames {
+		if !old[name] {
+			alterPartition.CreateTables = append(alterPartition.CreateTables, newPartitionTableDef(name, cols))
+		}
+	}
+	alterPartition.NewPartition = newPartitionDef
+	return nil
+}
+
+// buildExchangePartition checks the table to be exchanged with the partition,
+// it must be a normal table with the same columns as the partitioned table.
+func buildExchangePartition(ctx CompilerContext, opt *tree.AlterPartitionExchangePartition, databaseName string,
+	tableDef *TableDef, alterPartition *plan.AlterTablePartition) error {
+	exchangeDatabase := string(opt.Table.SchemaName)
+	if exchangeDatabase == "" {
+		exchangeDatabase = ctx.DefaultDatabase()
+	}
+	exchangeTable := string(opt.Table.ObjectName)
+	_, exchangeDef := ctx.Resolve(exchangeDatabase, exchangeTable)
+	if exchangeDef == nil {
+		return moerr.NewNoSuchTable(ctx.GetContext(), exchangeDatabase, exchangeTable)
+	}
+	if exchangeDatabase == databaseName && exchangeTable == tableDef.Name {
+		return moerr.NewInvalidInput(ctx.GetContext(), "cannot exchange a partition with its own table")
+	}
+	if exchangeDef.ViewSql != nil || exchangeDef.Partition != nil ||
+		exchangeDef.TableType != catalog.SystemOrdinaryRel {
+		return moerr.NewInvalidInput(ctx.GetContext(), "table to exchange with partition is partitioned or is not a normal table: '%s'", exchangeTable)
+	}
+	// the rows are moved between the tables as they are, so the hidden
+	// columns must be the same too
+	cols, exchangeCols := partitionTableColumns(tableDef), partitionTableColumns(exchangeDef)
+	if len(cols) != len(exchangeCols) {
+		return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+	}
+	for i, col := range cols {
+		exchangeCol := exchangeCols[i]
+		if col.Name != exchangeCol.Name || col.Primary != exchangeCol.Primary ||
+			formatColType(col.Typ) != formatColType(exchangeCol.Typ) {
+			return moerr.NewInvalidInput(ctx.GetContext(), "tables have different definitions")
+		}
+	}
+	for _, index := range exchangeDef.Indexes {
+		if index.Unique {
+			return moerr.NewNotSupported(ctx.GetContext(), "EXCHANGE PARTITION with the table with unique key")
+		}
+	}
+
+	alterPartition.ExchangeDatabase = exchangeDatabase
+	alterPartition.ExchangeTable = exchangeTable
+	alterPartition.WithoutValidation = opt.WithoutValidation
+	alterPartition.ExchangeTableDef = exchangeDef
+	return nil
+}
+
+// partitionTableColumns returns the columns of the partition tables, they
+// are the same as the ones of the partitioned table
+func partitionTableColumns(tableDef *TableDef) []*ColDef {
+	cols := make([]*ColDef, 0, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		if col.Name != catalog.Row_ID {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
@@ -0,0 +1,256 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPartitionedTable adds the table created by the sql to the mock context
+func mockPartitionedTable(t *testing.T, mock *MockOptimizer, sql string) {
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	tableDef := createTable.TableDef
+	tableDef.TableType = catalog.SystemOrdinaryRel
+	tableDef.Cols = append(tableDef.Cols, MakeRowIdColDef())
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	ctx.tables[tableDef.Name] = tableDef
+	ctx.objects[tableDef.Name] = &ObjectRef{
+		SchemaName:   "tpch",
+		ObjName:      tableDef.Name,
+		PubAccountId: -1,
+	}
+	ctx.stats[tableDef.Name] = DefaultStats()
+}
+
+func getAlterTablePartition(t *testing.T, mock *MockOptimizer, sql string) *plan.AlterTablePartition {
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	actions := logicPlan.GetDdl().GetAlterTable().Actions
+	require.Equal(t, 1, len(actions))
+	return actions[0].GetAlterPartition()
+}
+
+func partitionNames(partitionDef *plan.PartitionByDef) []string {
+	names := make([]string, len(partitionDef.Partitions))
+	for i, p := range partitionDef.Partitions {
+		names[i] = p.PartitionName
+	}
+	return names
+}
+
+func TestAlterTableRangePartition(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionedTable(t, mock, `create table logs (id int, created date)
+		partition by range columns (created) (
+		partition p202301 values less than ('2023-02-01') comment = 'january',
+		partition p202302 values less than ('2023-03-01'),
+		partition p202303 values less than ('2023-04-01'))`)
+
+	alter := getAlterTablePartition(t, mock, "alter table logs add partition (partition p202304 values less than ('2023-05-01'))")
+	require.Equal(t, plan.AlterTablePartition_ADD_PARTITION, alter.Typ)
+	require.Equal(t, []string{"p202301", "p202302", "p202303", "p202304"}, partitionNames(alter.NewPartition))
+	require.Equal(t, "january", alter.NewPartition.Partitions[0].Comment)
+	require.Equal(t, 1, len(alter.CreateTables))
+	require.Equal(t, "%!%p202304%!%logs", alter.CreateTables[0].Name)
+	require.Empty(t, alter.DropTables)
+
+	alter = getAlterTablePartition(t, mock, "alter table logs drop partition p202301")
+	require.Equal(t, plan.AlterTablePartition_DROP_PARTITION, alter.Typ)
+	require.Equal(t, []string{"p202302", "p202303"}, partitionNames(alter.NewPartition))
+	require.Equal(t, []string{"%!%p202301%!%logs"}, alter.DropTables)
+	require.Equal(t, []string{"%!%p202302%!%logs", "%!%p202303%!%logs"}, alter.NewPartition.PartitionTableNames)
+	require.Empty(t, alter.CreateTables)
+
+	alter = getAlterTablePartition(t, mock, "alter table logs truncate partition p202302, p202303")
+	require.Equal(t, plan.AlterTablePartition_TRUNCATE_PARTITION, alter.Typ)
+	require.Nil(t, alter.NewPartition)
+	require.Equal(t, []string{"%!%p202302%!%logs", "%!%p202303%!%logs"}, alter.TruncateTables)
+	require.Empty(t, alter.DropTables)
+
+	alter = getAlterTablePartition(t, mock, "alter table logs truncate partition all")
+	require.Equal(t, 3, len(alter.TruncateTables))
+
+	alter = getAlterTablePartition(t, mock, `alter table logs reorganize partition p202301 into (
+		partition p20230115 values less than ('2023-01-16'),
+		partition p20230131 values less than ('2023-02-01'))`)
+	require.Equal(t, plan.AlterTablePartition_REORGANIZE_PARTITION, alter.Typ)
+	require.Equal(t, []string{"p20230115", "p20230131", "p202302", "p202303"}, partitionNames(alter.NewPartition))
+	require.Equal(t, []string{"%!%p202301%!%logs"}, alter.DropTables)
+	require.Equal(t, 2, len(alter.CreateTables))
+	require.Equal(t, "%!%p20230115%!%logs", alter.CreateTables[0].Name)
+	require.Equal(t, "%!%p20230131%!%logs", alter.CreateTables[1].Name)
+	require.NotNil(t, alter.PartitionExpression)
+
+	// the last partition can extend the range, the kept partition is moved too
+	alter = getAlterTablePartition(t, mock, `alter table logs reorganize partition p202303 into (
+		partition p202303 values less than ('2023-04-01'),
+		partition pmax values less than (maxvalue))`)
+	require.Equal(t, []string{"%!%p202303%!%logs"}, alter.DropTables)
+	require.Equal(t, 2, len(alter.CreateTables))
+	require.Equal(t, "%!%p202303%!%logs", alter.CreateTables[0].Name)
+	require.Equal(t, "%!%pmax%!%logs", alter.CreateTables[1].Name)
+
+	errSqls := []string{
+		"alter table logs drop partition p202301, p202302, p202303",
+		"alter table logs drop partition p_unknown",
+		"alter table logs add partition (partition p202301 values less than ('2023-06-01'))",
+		"alter table logs add partition (partition p_in values in ('2023-06-01'))",
+		"alter table logs reorganize partition p202301, p202303 into (partition p values less than ('2023-04-01'))",
+		"alter table logs reorganize partition p202301 into (partition p values less than ('2023-01-15'))",
+		"alter table nation drop partition p0",
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
+func TestAlterTableListPartition(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionedTable(t, mock, `create table orders (id int, region int)
+		partition by list (region) (
+		partition p0 values in (1, 2),
+		partition p1 values in (3, 4))`)
+
+	alter := getAlterTablePartition(t, mock, "alter table orders add partition (partition p2 values in (5))")
+	require.Equal(t, []string{"p0", "p1", "p2"}, partitionNames(alter.NewPartition))
+
+	alter = getAlterTablePartition(t, mock, "alter table orders reorganize partition p0, p1 into (partition p01 values in (1, 2, 3, 4))")
+	require.Equal(t, []string{"p01"}, partitionNames(alter.NewPartition))
+	require.Equal(t, []string{"%!%p0%!%orders", "%!%p1%!%orders"}, alter.DropTables)
+
+	errSqls := []string{
+		"alter table orders add partition (partition p2 values in (1))",
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
+func TestAlterTableHashPartition(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionedTable(t, mock, `create table users (id int, name varchar(20))
+		partition by hash (id) partitions 4`)
+
+	alter := getAlterTablePartition(t, mock, "alter table users truncate partition p1")
+	require.Equal(t, []string{"%!%p1%!%users"}, alter.TruncateTables)
+
+	errSqls := []string{
+		"alter table users drop partition p1",
+		"alter table users add partition (partition p4)",
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
+func TestAlterTableExchangePartition(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionedTable(t, mock, `create table regions (r_regionkey int primary key, r_name varchar(25), r_comment varchar(152))
+		partition by list (r_regionkey) (
+		partition p0 values in (1, 2),
+		partition p1 values in (3, 4))`)
+
+	alter := getAlterTablePartition(t, mock, "alter table regions exchange partition p1 with table region without validation")
+	require.Equal(t, plan.AlterTablePartition_EXCHANGE_PARTITION, alter.Typ)
+	require.Equal(t, "tpch", alter.ExchangeDatabase)
+	require.Equal(t, "region", alter.ExchangeTable)
+	require.True(t, alter.WithoutValidation)
+	require.Equal(t, int32(1), alter.ExchangePartitionIdx)
+	require.Equal(t, "region", alter.ExchangeTableDef.Name)
+	require.NotNil(t, alter.PartitionExpression)
+
+	errSqls := []string{
+		"alter table regions exchange partition p1 with table nation",
+		"alter table regions exchange partition p1 with table regions",
+		"alter table regions exchange partition p2 with table region",
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
+func getPartitionPrune(t *testing.T, mock *MockOptimizer, sql string) *plan.PartitionPrune {
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			return node.PartitionPrune
+		}
+	}
+	return nil
+}
+
+func prunedPartitionNames(prune *plan.PartitionPrune) []string {
+	names := make([]string, len(prune.SelectedPartitions))
+	for i, p := range prune.SelectedPartitions {
+		names[i] = p.PartitionName
+	}
+	return names
+}
+
+func TestPartitionPrune(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionedTable(t, mock, `create table logs (id int, created date)
+		partition by range columns (created) (
+		partition p202301 values less than ('2023-02-01'),
+		partition p202302 values less than ('2023-03-01'),
+		partition p202303 values less than ('2023-04-01'))`)
+	mockPartitionedTable(t, mock, `create table orders (id int, region int)
+		partition by list (region) (
+		partition p0 values in (1, 2),
+		partition p1 values in (3, 4),
+		partition p2 values in (5, 6))`)
+	mockPartitionedTable(t, mock, `create table evts (id int, created date)
+		partition by range (year(created)) (
+		partition p2021 values less than (2022),
+		partition p2022 values less than (2023),
+		partition p2023 values less than (maxvalue))`)
+	mockPartitionedTable(t, mock, `create table users (id int, name varchar(20))
+		partition by hash (id) partitions 4`)
+
+	cases := []struct {
+		sql      string
+		selected []string
+	}{
+		{"select * from logs where created = '2023-02-15'", []string{"p202302"}},
+		{"select * from logs where created >= '2023-02-01'", []string{"p202302", "p202303"}},
+		{"select * from logs where created < '2023-02-01'", []string{"p202301", "p202302"}},
+		{"select * from logs where created >= '2023-02-01' and created < '2023-03-01'", []string{"p202302", "p202303"}},
+		{"select * from logs where created > '2023-05-01'", []string{}},
+		{"select * from orders where region = 3", []string{"p1"}},
+		{"select * from orders where region in (1, 6)", []string{"p0", "p2"}},
+		{"select * from orders where region = 7", []string{}},
+		{"select * from evts where created >= '2022-06-01'", []string{"p2022", "p2023"}},
+		{"select * from evts where created = '2021-03-01'", []string{"p2021"}},
+		{"select * from users where id = 5", nil},
+	}
+	for _, c := range cases {
+		prune := getPartitionPrune(t, mock, c.sql)
+		require.NotNil(t, prune, c.sql)
+		require.True(t, prune.IsPruned, c.sql)
+		if c.selected != nil {
+			require.Equal(t, c.selected, prunedPartitionNames(prune), c.sql)
+		} else {
+			require.Equal(t, 1, len(prune.SelectedPartitions), c.sql)
+		}
+	}
+
+	for _, sql := range []string{
+		"select * from logs",
+		"select * from logs where id = 1",
+		"select * from orders where region > 3",
+		"select * from orders where region = 1 or id = 2",
+	} {
+		require.Nil(t, getPartitionPrune(t, mock, sql), sql)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// The rows of a partitioned table are stored in its partition tables, the
+// main table holds no rows. The DML on a partitioned table evaluates the
+// partition expression of the table to find the partition table of a row.
+
+// buildPartitionIndexExpr builds the expression evaluating the position of
+// the partition of a row, the columns of the partition expression of the
+// table are replaced by the expressions of the columns with the same name.
+func buildPartitionIndexExpr(ctx CompilerContext, tableDef *TableDef, cols map[string]*Expr) (*Expr, error) {
+	expr := DeepCopyExpr(tableDef.Partition.PartitionExpression)
+	// the values of IN must be constants before evaluating
+	if err := foldConstantLists(expr, ctx.GetProcess()); err != nil {
+		return nil, err
+	}
+	return replacePartitionColumns(ctx.GetContext(), expr, tableDef, cols)
+}
+
+func replacePartitionColumns(ctx context.Context, expr *Expr, tableDef *TableDef, cols map[string]*Expr) (*Expr, error) {
+	var err error
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if int(e.Col.ColPos) >= len(tableDef.Cols) {
+			return nil, moerr.NewInternalError(ctx, "invalid column of the partition expression of table %s", tableDef.Name)
+		}
+		name := tableDef.Cols[e.Col.ColPos].Name
+		col, ok := cols[name]
+		if !ok {
+			return nil, moerr.NewInternalError(ctx, "column %s of the partition expression of table %s not found", name, tableDef.Name)
+		}
+		return DeepCopyExpr(col), nil
+	case *plan.Expr_F:
+		for i, arg := range e.F.Args {
+			if e.F.Args[i], err = replacePartitionColumns(ctx, arg, tableDef, cols); err != nil {
+				return nil, err
+			}
+		}
+	case *plan.Expr_List:
+		for i, arg := range e.List.List {
+			if e.List.List[i], err = replacePartitionColumns(ctx, arg, tableDef, cols); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return expr, nil
+}
+
+// partitionKeyColNames returns the names of the columns used by the partition
+// expression of the table
+func partitionKeyColNames(tableDef *TableDef) []string {
+	keyCols := make(map[int32]bool)
+	collectPartitionKeyCols(tableDef.Partition.PartitionExpression, keyCols)
+	names := make([]string, 0, len(keyCols))
+	for i, col := range tableDef.Cols {
+		if keyCols[int32(i)] {
+			names = append(names, col.Name)
+		}
+	}
+	return names
+}
+
+// partitionWriteColumns returns the columns of the batch written to the
+// partition tables, it has all the columns of the table except row_id.
+func partitionWriteColumns(tableDef *TableDef) map[string]*Expr {
+	cols := make(map[string]*Expr, len(tableDef.Cols))
+	pos := int32(0)
+	for _, col := range tableDef.Cols {
+		if col.Name == catalog.Row_ID {
+			continue
+		}
+		cols[col.Name] = &Expr{
+			Typ: col.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: pos,
+					Name:   col.Name,
+				},
+			},
+		}
+		pos++
+	}
+	return cols
+}
+
+// buildInsertPartition builds the partition tables of the table inserted
+// into, nil if the table is not partitioned
+func buildInsertPartition(ctx CompilerContext, tableDef *TableDef) (*plan.DmlPartition, error) {
+	if tableDef.Partition == nil {
+		return nil, nil
+	}
+	expr, err := buildPartitionIndexExpr(ctx, tableDef, partitionWriteColumns(tableDef))
+	if err != nil {
+		return nil, err
+	}
+	return &plan.DmlPartition{
+		PartitionTableNames: tableDef.Partition.PartitionTableNames,
+		PartitionExpression: expr,
+	}, nil
+}
+
+// appendPartitionIndexExprs appends the partition index of the old rows of
+// the partitioned tables to the project list, the columns of the partition
+// expression are read from their positions in the derived table. The tables
+// not partitioned have an empty entry.
+func appendPartitionIndexExprs(builder *QueryBuilder, info *dmlSelectInfo, tag int32, colPosMaps []map[string]int) error {
+	info.partitions = make([]*plan.DmlPartition, len(info.tblInfo.tableDefs))
+	for i, tableDef := range info.tblInfo.tableDefs {
+		if tableDef.Partition == nil {
+			info.partitions[i] = &plan.DmlPartition{}
+			continue
+		}
+		cols := make(map[string]*Expr)
+		for _, col := range tableDef.Cols {
+			if pos, ok := colPosMaps[i][col.Name]; ok {
+				cols[col.Name] = &Expr{
+					Typ: col.Typ,
+					Expr: &plan.Expr_Col{
+						Col: &plan.ColRef{
+							RelPos: tag,
+							ColPos: int32(pos),
+						},
+					},
+				}
+			}
+		}
+		expr, err := buildPartitionIndexExpr(builder.compCtx, tableDef, cols)
+		if err != nil {
+			return err
+		}
+		info.partitions[i] = &plan.DmlPartition{
+			PartitionTableNames: tableDef.Partition.PartitionTableNames,
+			Idx:                 int32(len(info.projectList)),
+		}
+		info.projectList = append(info.projectList, expr)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func getDmlNode(t *testing.T, mock *MockOptimizer, sql string, nodeType plan.Node_NodeType) *plan.Node {
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err, sql)
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == nodeType {
+			return node
+		}
+	}
+	require.Fail(t, "no dml node", sql)
+	return nil
+}
+
+func TestPartitionDml(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	mockPartitionedTable(t, mock, `create table logs (id int, created date)
+		partition by range columns (created) (
+		partition p202301 values less than ('2023-02-01'),
+		partition p202302 values less than ('2023-03-01'))`)
+	names := []string{"%!%p202301%!%logs", "%!%p202302%!%logs"}
+
+	for _, sql := range []string{
+		"insert into logs values (1, '2023-01-15')",
+		"insert into logs select n_nationkey, '2023-01-15' from nation",
+	} {
+		insertCtx := getDmlNode(t, mock, sql, plan.Node_INSERT).InsertCtx
+		require.Equal(t, names, insertCtx.Partition.PartitionTableNames, sql)
+		require.NotNil(t, insertCtx.Partition.PartitionExpression, sql)
+	}
+	insertCtx := getDmlNode(t, mock, "insert into nation select * from nation2", plan.Node_INSERT).InsertCtx
+	require.Nil(t, insertCtx.Partition)
+
+	for _, sql := range []string{
+		"delete from logs",
+		"delete from logs where created < '2023-02-01'",
+	} {
+		deleteCtx := getDmlNode(t, mock, sql, plan.Node_DELETE).DeleteCtx
+		require.False(t, deleteCtx.CanTruncate, sql)
+		require.Equal(t, 1, len(deleteCtx.Partitions), sql)
+		require.Equal(t, names, deleteCtx.Partitions[0].PartitionTableNames, sql)
+		// the rows left in the main table are moved by it
+		require.NotNil(t, deleteCtx.Partitions[0].PartitionExpression, sql)
+	}
+
+	updateCtx := getDmlNode(t, mock, "update logs set created = '2023-02-15' where id = 1", plan.Node_UPDATE).UpdateCtx
+	require.Equal(t, 1, len(updateCtx.Partitions))
+	require.Equal(t, names, updateCtx.Partitions[0].PartitionTableNames)
+	require.NotNil(t, updateCtx.Partitions[0].PartitionExpression)
+	// the partition index of the old rows follows the columns of the table
+	require.Equal(t, int32(len(updateCtx.TableDefs[0].Cols)), updateCtx.Partitions[0].Idx)
+
+	updateCtx = getDmlNode(t, mock, "update nation set n_name = 'a'", plan.Node_UPDATE).UpdateCtx
+	require.Empty(t, updateCtx.Partitions[0].PartitionTableNames)
+
+	// the columns of the partition tables are changed with the table, except
+	// the columns of the partition expression
+	runTestShouldPass(mock, t, []string{
+		"alter table logs add column c int first",
+		"alter table logs drop column id",
+		"alter table logs modify id varchar(10)",
+	}, false, false)
+	runTestShouldError(mock, t, []string{
+		"alter table logs drop column created",
+		"alter table logs change created created2 date",
+		"alter table logs modify created datetime",
+	})
+}
+
+func TestPartitionOnDuplicateKey(t *testing.T) {
+	mock := NewMockOptimizer(true)
+	mockPartitionedTable(t, mock, `create table visits (id int primary key, name varchar(20))
+		partition by range (id) (
+		partition p0 values less than (100),
+		partition p1 values less than (200))`)
+
+	sql := "insert into visits values (1, 'a') on duplicate key update name = 'b'"
+	logicPlan, err := buildSingleStmt(mock, t, sql)
+	require.NoError(t, err)
+	query := logicPlan.GetQuery()
+	node := query.Nodes[query.Steps[0]]
+	insertCtx := node.InsertCtx
+	require.NotEmpty(t, insertCtx.OnDuplicateIdx)
+	require.Equal(t, []string{"%!%p0%!%visits", "%!%p1%!%visits"}, insertCtx.Partition.PartitionTableNames)
+	require.NotNil(t, insertCtx.Partition.PartitionExpression)
+	// the partition index of the old rows is projected after the old rows
+	project := query.Nodes[node.Children[0]]
+	require.Equal(t, int32(len(project.ProjectList)-1), insertCtx.Partition.Idx)
+}
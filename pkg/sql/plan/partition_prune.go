@@ -0,0 +1,397 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// the max number of the combinations of the partition key values evaluated
+// by the partition expression
+const maxPrunedPartitionKeyValues = 64
+
+// the functions keeping the order of the partition key, the RANGE partitions
+// of them can be pruned by the range filters
+var monotonicPartitionFunctions = map[string]bool{
+	"year":           true,
+	"to_days":        true,
+	"to_seconds":     true,
+	"unix_timestamp": true,
+}
+
+// prunePartitions selects the partitions of the scanned partitioned tables by
+// the filters on the partition key. The equality and IN filters are evaluated
+// by the partition expression of the table, the range filters prune the RANGE
+// partitions if the partition key keeps the order of a single column.
+func (builder *QueryBuilder) prunePartitions(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.prunePartitions(childID)
+	}
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil ||
+		node.TableDef.Partition == nil || len(node.FilterList) == 0 || len(node.BindingTags) == 0 {
+		return
+	}
+	partitionDef := node.TableDef.Partition
+	if partitionDef.PartitionExpression == nil || len(partitionDef.Partitions) == 0 {
+		return
+	}
+	keyCols := make(map[int32]bool)
+	collectPartitionKeyCols(partitionDef.PartitionExpression, keyCols)
+	if len(keyCols) == 0 {
+		return
+	}
+
+	tag := node.BindingTags[0]
+	selected := make([]bool, len(partitionDef.Partitions))
+	for i := range selected {
+		selected[i] = true
+	}
+	pruned := false
+
+	// the partitions of the values of the partition key
+	values := make(map[int32][]*Expr)
+	for _, filter := range node.FilterList {
+		colPos, consts, ok := builder.getPartitionKeyValues(filter, tag)
+		if !ok || !keyCols[colPos] {
+			continue
+		}
+		if old, ok := values[colPos]; !ok || len(consts) < len(old) {
+			values[colPos] = consts
+		}
+	}
+	if len(values) == len(keyCols) {
+		if hits, ok := builder.evalPartitionsOfValues(partitionDef, values); ok {
+			for i := range selected {
+				selected[i] = selected[i] && hits[i]
+			}
+			pruned = true
+		}
+	}
+
+	// the RANGE partitions between the bounds of the partition key
+	if colPos, ok := rangePartitionKeyCol(partitionDef, keyCols); ok {
+		for _, filter := range node.FilterList {
+			fn, c, ok := builder.getPartitionKeyBound(filter, tag, colPos)
+			if !ok {
+				continue
+			}
+			idx, ok := builder.evalPartitionIndex(partitionDef, map[int32]*Expr{colPos: c})
+			if !ok {
+				continue
+			}
+			for i := range selected {
+				switch fn {
+				case ">", ">=":
+					// no partition holds the values greater than the bound
+					if idx < 0 || i < idx {
+						selected[i] = false
+					}
+				case "<", "<=":
+					if idx >= 0 && i > idx {
+						selected[i] = false
+					}
+				}
+			}
+			pruned = true
+		}
+	}
+
+	if !pruned {
+		return
+	}
+	node.PartitionPrune = &plan.PartitionPrune{
+		IsPruned: true,
+	}
+	for i, item := range partitionDef.Partitions {
+		if selected[i] {
+			node.PartitionPrune.SelectedPartitions = append(node.PartitionPrune.SelectedPartitions, item)
+		}
+	}
+}
+
+// collectPartitionKeyCols collects the columns referenced by the partition
+// expression
+func collectPartitionKeyCols(expr *Expr, cols map[int32]bool) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		cols[e.Col.ColPos] = true
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			collectPartitionKeyCols(arg, cols)
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			collectPartitionKeyCols(arg, cols)
+		}
+	}
+}
+
+// rangePartitionKeyCol returns the column of the RANGE partition key if the
+// partition key keeps the order of the column
+func rangePartitionKeyCol(partitionDef *plan.PartitionByDef, keyCols map[int32]bool) (int32, bool) {
+	if len(keyCols) != 1 {
+		return 0, false
+	}
+	var colPos int32
+	for pos := range keyCols {
+		colPos = pos
+	}
+	switch partitionDef.Type {
+	case plan.PartitionType_RANGE_COLUMNS:
+		return colPos, true
+	case plan.PartitionType_RANGE:
+		if partitionDef.PartitionExpr == nil || partitionDef.PartitionExpr.Expr == nil {
+			return 0, false
+		}
+		switch e := partitionDef.PartitionExpr.Expr.Expr.(type) {
+		case *plan.Expr_Col:
+			return colPos, true
+		case *plan.Expr_F:
+			if monotonicPartitionFunctions[e.F.Func.GetObjName()] && len(e.F.Args) == 1 {
+				if _, ok := e.F.Args[0].Expr.(*plan.Expr_Col); ok {
+					return colPos, true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+// getPartitionKeyColumn returns the position of the column if the expr is a
+// column of the scanned table
+func getPartitionKeyColumn(expr *Expr, tag int32) (int32, bool) {
+	if col, ok := expr.Expr.(*plan.Expr_Col); ok && col.Col.RelPos == tag {
+		return col.Col.ColPos, true
+	}
+	return 0, false
+}
+
+// foldPartitionKeyValue folds the expr into a constant of the type of the
+// column
+func (builder *QueryBuilder) foldPartitionKeyValue(expr *Expr, col *Expr) (*Expr, bool) {
+	if expr.Typ == nil || col.Typ == nil || expr.Typ.Id != col.Typ.Id {
+		return nil, false
+	}
+	c, err := EvalPlanExpr(builder.GetContext(), DeepCopyExpr(expr), builder.compCtx.GetProcess())
+	if err != nil {
+		return nil, false
+	}
+	if cExpr, ok := c.Expr.(*plan.Expr_C); !ok || cExpr.C.Isnull {
+		return nil, false
+	}
+	return c, true
+}
+
+// getPartitionKeyValues returns the values of the column from the filter in
+// the form of col = const, col in (const, ...) or the disjunction of them
+func (builder *QueryBuilder) getPartitionKeyValues(filter *Expr, tag int32) (int32, []*Expr, bool) {
+	f, ok := filter.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) != 2 {
+		return 0, nil, false
+	}
+	left, right := f.F.Args[0], f.F.Args[1]
+	switch f.F.Func.GetObjName() {
+	case "=":
+		colPos, ok := getPartitionKeyColumn(left, tag)
+		if !ok {
+			if colPos, ok = getPartitionKeyColumn(right, tag); !ok {
+				return 0, nil, false
+			}
+			left, right = right, left
+		}
+		c, ok := builder.foldPartitionKeyValue(right, left)
+		if !ok {
+			return 0, nil, false
+		}
+		return colPos, []*Expr{c}, true
+
+	case "in":
+		colPos, ok := getPartitionKeyColumn(left, tag)
+		if !ok {
+			return 0, nil, false
+		}
+		list, ok := right.Expr.(*plan.Expr_List)
+		if !ok {
+			return 0, nil, false
+		}
+		consts := make([]*Expr, len(list.List.List))
+		for i, e := range list.List.List {
+			if consts[i], ok = builder.foldPartitionKeyValue(e, left); !ok {
+				return 0, nil, false
+			}
+		}
+		return colPos, consts, true
+
+	case "or":
+		leftPos, leftConsts, ok := builder.getPartitionKeyValues(left, tag)
+		if !ok {
+			return 0, nil, false
+		}
+		rightPos, rightConsts, ok := builder.getPartitionKeyValues(right, tag)
+		if !ok || leftPos != rightPos {
+			return 0, nil, false
+		}
+		return leftPos, append(leftConsts, rightConsts...), true
+	}
+	return 0, nil, false
+}
+
+// getPartitionKeyBound returns the bound of the column from the filter in the
+// form of col op const, the op is normalized as the column is on the left
+func (builder *QueryBuilder) getPartitionKeyBound(filter *Expr, tag int32, colPos int32) (string, *Expr, bool) {
+	f, ok := filter.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) != 2 {
+		return "", nil, false
+	}
+	fn := f.F.Func.GetObjName()
+	left, right := f.F.Args[0], f.F.Args[1]
+	if pos, ok := getPartitionKeyColumn(left, tag); !ok || pos != colPos {
+		if pos, ok = getPartitionKeyColumn(right, tag); !ok || pos != colPos {
+			return "", nil, false
+		}
+		left, right = right, left
+		switch fn {
+		case ">":
+			fn = "<"
+		case ">=":
+			fn = "<="
+		case "<":
+			fn = ">"
+		case "<=":
+			fn = ">="
+		}
+	}
+	switch fn {
+	case ">", ">=", "<", "<=":
+	default:
+		return "", nil, false
+	}
+	c, ok := builder.foldPartitionKeyValue(right, left)
+	if !ok {
+		return "", nil, false
+	}
+	return fn, c, true
+}
+
+// evalPartitionsOfValues evaluates the partitions of all the combinations of
+// the values of the partition key
+func (builder *QueryBuilder) evalPartitionsOfValues(partitionDef *plan.PartitionByDef, values map[int32][]*Expr) ([]bool, bool) {
+	cols := make([]int32, 0, len(values))
+	total := 1
+	for colPos, consts := range values {
+		cols = append(cols, colPos)
+		total *= len(consts)
+		if total > maxPrunedPartitionKeyValues {
+			return nil, false
+		}
+	}
+
+	hits := make([]bool, len(partitionDef.Partitions))
+	combination := make(map[int32]*Expr, len(cols))
+	var eval func(i int) bool
+	eval = func(i int) bool {
+		if i == len(cols) {
+			idx, ok := builder.evalPartitionIndex(partitionDef, combination)
+			if ok && idx >= 0 && idx < len(hits) {
+				hits[idx] = true
+			}
+			return ok
+		}
+		for _, c := range values[cols[i]] {
+			combination[cols[i]] = c
+			if !eval(i + 1) {
+				return false
+			}
+		}
+		return true
+	}
+	if !eval(0) {
+		return nil, false
+	}
+	return hits, true
+}
+
+// evalPartitionIndex evaluates the position of the partition of the values
+// of the partition key, -1 if there is no such partition
+func (builder *QueryBuilder) evalPartitionIndex(partitionDef *plan.PartitionByDef, values map[int32]*Expr) (int, bool) {
+	proc := builder.compCtx.GetProcess()
+	expr := replacePartitionKeyCols(DeepCopyExpr(partitionDef.PartitionExpression), values)
+	// the values of IN must be constants before evaluating
+	if err := foldConstantLists(expr, proc); err != nil {
+		return 0, false
+	}
+	c, err := EvalPlanExpr(builder.GetContext(), expr, proc)
+	if err != nil {
+		return 0, false
+	}
+	cExpr := c.Expr.(*plan.Expr_C)
+	if cExpr.C.Isnull {
+		return -1, true
+	}
+	switch v := cExpr.C.Value.(type) {
+	case *plan.Const_I64Val:
+		return int(v.I64Val), true
+	case *plan.Const_I32Val:
+		return int(v.I32Val), true
+	case *plan.Const_U64Val:
+		return int(v.U64Val), true
+	case *plan.Const_U32Val:
+		return int(v.U32Val), true
+	}
+	return 0, false
+}
+
+func replacePartitionKeyCols(expr *Expr, values map[int32]*Expr) *Expr {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if c, ok := values[e.Col.ColPos]; ok {
+			return DeepCopyExpr(c)
+		}
+	case *plan.Expr_F:
+		for i, arg := range e.F.Args {
+			e.F.Args[i] = replacePartitionKeyCols(arg, values)
+		}
+	case *plan.Expr_List:
+		for i, arg := range e.List.List {
+			e.List.List[i] = replacePartitionKeyCols(arg, values)
+		}
+	}
+	return expr
+}
+
+func foldConstantLists(expr *Expr, proc *process.Process) error {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			if err := foldConstantLists(arg, proc); err != nil {
+				return err
+			}
+		}
+	case *plan.Expr_List:
+		bat := batch.NewWithSize(0)
+		bat.Zs = []int64{1}
+		for i, arg := range e.List.List {
+			folded, err := ConstantFold(bat, arg, proc)
+			if err != nil {
+				return err
+			}
+			e.List.List[i] = folded
+		}
+	}
+	return nil
+}
@@ -60,6 +60,7 @@ type ForeignKeyDef = plan.ForeignKeyDef
 type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
+type TTLDef = plan.TTLDef
 type SubscriptionMeta = plan.SubscriptionMeta
 
 type CompilerContext interface {
@@ -81,6 +82,9 @@ type CompilerContext interface {
 	GetPrimaryKeyDef(dbName string, tableName string) []*ColDef
 	// get estimated stats by table & expr
 	Stats(obj *ObjectRef, e *Expr) *Stats
+	// get the values of the parameters if they are bound when the plan is
+	// built, or nil if the parameters are bound when it is executed
+	ResolveParams() []*Expr
 	// get origin sql string of the root
 	GetRootSql() string
 	// get username of current session
@@ -381,6 +381,20 @@ func (mr *MockCompilerContext2MockRecorder) SetQueryingSubscription(meta interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQueryingSubscription", reflect.TypeOf((*MockCompilerContext2)(nil).SetQueryingSubscription), meta)
 }
 
+// ResolveParams mocks base method.
+func (m *MockCompilerContext2) ResolveParams() []*Expr {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveParams")
+	ret0, _ := ret[0].([]*Expr)
+	return ret0
+}
+
+// ResolveParams indicates an expected call of ResolveParams.
+func (mr *MockCompilerContext2MockRecorder) ResolveParams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveParams", reflect.TypeOf((*MockCompilerContext2)(nil).ResolveParams))
+}
+
 // Stats mocks base method.
 func (m *MockCompilerContext2) Stats(obj *ObjectRef, e *Expr) *Stats {
 	m.ctrl.T.Helper()
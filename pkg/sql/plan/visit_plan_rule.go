@@ -286,6 +286,9 @@ func getVarValue(e *plan.Expr, r *ResetVarRefRule) (*plan.Expr, error) {
 	switch val := getVal.(type) {
 	case string:
 		expr = makePlan2StringConstExprWithType(val)
+	case []byte:
+		// the BLOB and TEXT parameters of the prepared statements
+		expr = makePlan2StringConstExprWithType(string(val))
 	case int:
 		expr = makePlan2Int64ConstExprWithType(int64(val))
 	case uint8:
@@ -19,6 +19,7 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
@@ -88,6 +89,24 @@ func WithEnableRefreshExpression() TxnClientCreateOption {
 	}
 }
 
+// WithSnapshotLag uses the current time minus lag as the snapshot timestamp of the
+// transactions, so the transactions can read the data without waiting for the latest
+// logtail. It is used by the read-only CN, and does not work if WithEnableSacrificingFreshness
+// is used.
+func WithSnapshotLag(lag time.Duration) TxnClientCreateOption {
+	return func(tc *txnClient) {
+		tc.snapshotLag = lag
+	}
+}
+
+// WithReadOnly rejects the writes of all the transactions created by the client, it is
+// used by the read-only CN, so nothing is committed to DN whichever path the write takes.
+func WithReadOnly() TxnClientCreateOption {
+	return func(tc *txnClient) {
+		tc.readOnly = true
+	}
+}
+
 var _ TxnClient = (*txnClient)(nil)
 
 type txnClient struct {
@@ -99,6 +118,8 @@ type txnClient struct {
 	enableCNBasedConsistency   bool
 	enableSacrificingFreshness bool
 	enableRefreshExpression    bool
+	snapshotLag                time.Duration
+	readOnly                   bool
 
 	mu struct {
 		sync.RWMutex
@@ -157,6 +178,9 @@ func (client *txnClient) New(
 
 	client.pushTransaction(txnMeta)
 
+	if client.readOnly {
+		options = append(options, WithTxnRejectWrite())
+	}
 	options = append(options,
 		WithTxnCNCoordinator(),
 		WithTxnClose(client),
@@ -212,6 +236,13 @@ func (client *txnClient) determineTxnSnapshot(
 		// time minus the maximum clock offset as the transaction's snapshotTimestamp to avoid
 		// conflicts due to clock uncertainty.
 		now, _ := client.clock.Now()
+		if client.snapshotLag > 0 {
+			ts := timestamp.Timestamp{PhysicalTime: now.PhysicalTime - int64(client.snapshotLag)}
+			if ts.Less(minTS) {
+				ts = minTS
+			}
+			return ts, nil
+		}
 		return now, nil
 	}
 
@@ -75,6 +75,35 @@ func TestNewTxnWithSnapshotTS(t *testing.T) {
 	assert.Equal(t, txn.TxnStatus_Active, txnMeta.Status)
 }
 
+func TestNewTxnWithSnapshotLag(t *testing.T) {
+	rt := runtime.NewRuntime(metadata.ServiceType_CN, "",
+		logutil.GetPanicLogger(),
+		runtime.WithClock(clock.NewHLCClock(func() int64 {
+			return 100
+		}, 0)))
+	runtime.SetupProcessLevelRuntime(rt)
+	c := NewTxnClient(newTestTxnSender(), WithSnapshotLag(10))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	tx, err := c.New(ctx, newTestTimestamp(0))
+	assert.Nil(t, err)
+	assert.Equal(t, timestamp.Timestamp{PhysicalTime: 90}, tx.(*txnOperator).mu.txn.SnapshotTS)
+
+	tx, err = c.New(ctx, newTestTimestamp(95))
+	assert.Nil(t, err)
+	assert.Equal(t, newTestTimestamp(95), tx.(*txnOperator).mu.txn.SnapshotTS)
+}
+
+func TestNewTxnWithReadOnly(t *testing.T) {
+	runtime.SetupProcessLevelRuntime(runtime.DefaultRuntime())
+	c := NewTxnClient(newTestTxnSender(), WithReadOnly())
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	tx, err := c.New(ctx, newTestTimestamp(0))
+	assert.Nil(t, err)
+	assert.True(t, tx.(*txnOperator).option.rejectWrite)
+}
+
 func newTestTxnSender() *testTxnSender {
 	return &testTxnSender{auto: true}
 }
@@ -67,6 +67,14 @@ func WithTxnReadyOnly() TxnOption {
 	}
 }
 
+// WithTxnRejectWrite setup the txn to reject the writes with an error. Unlike WithTxnReadyOnly,
+// the txn can be used for anything but writing, and it is not a programming error to write.
+func WithTxnRejectWrite() TxnOption {
+	return func(tc *txnOperator) {
+		tc.option.rejectWrite = true
+	}
+}
+
 // WithTxnDisable1PCOpt disable 1pc optimisation on distributed transaction. By default, mo enables 1pc
 // optimization for distributed transactions. For write operations, if all partitions' prepares are
 // executed successfully, then the transaction is considered committed and returned directly to the
@@ -148,6 +156,7 @@ type txnOperator struct {
 
 	option struct {
 		readyOnly              bool
+		rejectWrite            bool
 		enableCacheWrite       bool
 		disable1PCOpt          bool
 		coordinator            bool
@@ -437,6 +446,10 @@ func (tc *txnOperator) doWrite(ctx context.Context, requests []txn.TxnRequest, c
 		util.GetLogger().Fatal("can not write on ready only transaction")
 	}
 
+	if tc.option.rejectWrite && len(requests) > 0 {
+		return nil, moerr.NewNotSupported(ctx, "write on the read-only cn")
+	}
+
 	if commit {
 		tc.mu.Lock()
 		defer func() {
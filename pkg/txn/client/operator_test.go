@@ -443,3 +443,20 @@ func newDNRequest(op uint32, dn uint64) txn.TxnRequest {
 		},
 	})
 }
+
+func TestWriteOnRejectWriteTxn(t *testing.T) {
+	runOperatorTests(t, func(ctx context.Context, tc *txnOperator, ts *testTxnSender) {
+		_, err := tc.Write(ctx, []txn.TxnRequest{newDNRequest(1, 1)})
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+		assert.Empty(t, tc.mu.txn.DNShards)
+
+		_, err = tc.WriteAndCommit(ctx, []txn.TxnRequest{newDNRequest(1, 1)})
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+		assert.Empty(t, ts.getLastRequests())
+
+		// reading and committing nothing are allowed
+		_, err = tc.Read(ctx, []txn.TxnRequest{newDNRequest(1, 1)})
+		assert.NoError(t, err)
+		assert.NoError(t, tc.Commit(ctx))
+	}, WithTxnRejectWrite())
+}
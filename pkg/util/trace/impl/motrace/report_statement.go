@@ -28,6 +28,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 )
 
 var nilTxnID [16]byte
@@ -65,6 +66,11 @@ type StatementInfo struct {
 
 	ResultCount int64 `json:"result_count"` // see EndStatement
 
+	// IOCounterSet counts the io of the statement, see perfcounter.WithCounterSet.
+	// it is registered by the statement id until EndStatement, so io issued
+	// without the statement's context is counted too, see perfcounter.RegisterStatement
+	IOCounterSet perfcounter.CounterSet `json:"-"`
+
 	// flow ctrl
 	end bool // cooperate with mux
 	mux sync.Mutex
@@ -146,6 +152,16 @@ func (s *StatementInfo) FillRow(ctx context.Context, row *table.Row) {
 	row.SetColumnVal(stmtTypeCol, s.StatementType)
 	row.SetColumnVal(queryTypeCol, s.QueryType)
 	row.SetColumnVal(resultCntCol, s.ResultCount)
+	fs := &s.IOCounterSet.FileService
+	row.SetColumnVal(s3GetCol, fs.S3.Get.Load()+fs.S3.Head.Load())
+	row.SetColumnVal(s3PutCol, fs.S3.Put.Load())
+	row.SetColumnVal(ioReadBytesCol, fs.S3.ReadBytes.Load())
+	row.SetColumnVal(ioWriteBytesCol, fs.S3.WriteBytes.Load())
+	row.SetColumnVal(memReadCol, fs.Cache.Memory.Read.Load())
+	row.SetColumnVal(memHitCol, fs.Cache.Memory.Hit.Load())
+	row.SetColumnVal(diskReadCol, fs.Cache.Disk.Read.Load())
+	row.SetColumnVal(diskHitCol, fs.Cache.Disk.Hit.Load())
+	row.SetColumnVal(s3ListCol, fs.S3.List.Load())
 }
 
 // ExecPlan2Json return ExecPlan Serialized json-str
@@ -232,6 +248,8 @@ var EndStatement = func(ctx context.Context, err error, sentRows int64) {
 	if !s.end { // cooperate with s.mux
 		// do report
 		s.end = true
+		// io issued from now on is not counted in the statement
+		perfcounter.UnregisterStatement(uuid.UUID(s.StatementID).String())
 		s.ResultCount = sentRows
 		s.ResponseAt = time.Now()
 		s.Duration = s.ResponseAt.Sub(s.RequestAt)
@@ -16,6 +16,8 @@ package motrace
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/util/export/table"
@@ -68,6 +70,16 @@ var (
 	sqlTypeCol   = table.TextColumn("sql_source_type", "sql statement source type")
 	resultCntCol = table.Int64Column("result_count", "the number of rows of sql execution results")
 
+	s3GetCol        = table.Int64Column("s3_get_requests", "the number of GET and HEAD requests to the object storage")
+	s3PutCol        = table.Int64Column("s3_put_requests", "the number of PUT requests to the object storage")
+	ioReadBytesCol  = table.Int64Column("io_read_bytes", "bytes read from the object storage")
+	ioWriteBytesCol = table.Int64Column("io_write_bytes", "bytes written to the object storage")
+	memReadCol      = table.Int64Column("mem_cache_read", "the number of memory cache reads")
+	memHitCol       = table.Int64Column("mem_cache_hit", "the number of memory cache hits")
+	diskReadCol     = table.Int64Column("disk_cache_read", "the number of disk cache reads")
+	diskHitCol      = table.Int64Column("disk_cache_hit", "the number of disk cache hits")
+	s3ListCol       = table.Int64Column("s3_list_requests", "the number of LIST requests to the object storage")
+
 	SingleStatementTable = &table.Table{
 		Account:  table.AccountAll,
 		Database: StatsDatabase,
@@ -100,6 +112,15 @@ var (
 			roleIdCol,
 			sqlTypeCol,
 			resultCntCol,
+			s3GetCol,
+			s3PutCol,
+			ioReadBytesCol,
+			ioWriteBytesCol,
+			memReadCol,
+			memHitCol,
+			diskReadCol,
+			diskHitCol,
+			s3ListCol,
 		},
 		PrimaryKeyColumn: []table.Column{stmtIDCol},
 		Engine:           table.ExternalTableEngine,
@@ -226,8 +247,25 @@ var (
 
 const (
 	sqlCreateDBConst = `create database if not exists ` + StatsDatabase
+
+	// statementIOStatsView aggregates the io counters of statements per account per hour
+	statementIOStatsView = "statement_io_stats"
 )
 
+// sqlCreateStatementIOStatsView returns the sql to create the statementIOStatsView
+func sqlCreateStatementIOStatsView() string {
+	hour := fmt.Sprintf("date_format(`%s`, '%%Y-%%m-%%d %%H:00:00')", reqAtCol.Name)
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("CREATE VIEW IF NOT EXISTS `%s`.`%s` as select `%s`, %s as `hour`, count(*) as `statements`",
+		StatsDatabase, statementIOStatsView, accountCol.Name, hour))
+	for _, col := range []table.Column{s3GetCol, s3PutCol, s3ListCol, ioReadBytesCol, ioWriteBytesCol, memReadCol, memHitCol, diskReadCol, diskHitCol} {
+		sb.WriteString(fmt.Sprintf(", sum(`%s`) as `%s`", col.Name, col.Name))
+	}
+	sb.WriteString(fmt.Sprintf(" from `%s`.`%s` group by `%s`, %s",
+		SingleStatementTable.Database, SingleStatementTable.Table, accountCol.Name, hour))
+	return sb.String()
+}
+
 var tables = []*table.Table{SingleStatementTable, SingleRowLogTable}
 var views = []*table.View{logView, errorView, spanView}
 
@@ -265,11 +303,43 @@ func InitSchemaByInnerExecutor(ctx context.Context, ieFactory func() ie.Internal
 			return err
 		}
 	}
+	if err := mustExec(sqlCreateStatementIOStatsView()); err != nil {
+		return err
+	}
 
 	createCost = time.Since(instant)
 	return nil
 }
 
+// statementUpgradeColumn is the latest column added to SingleStatementTable.
+// the statement table without it is created by an older version, see GetUpgradeSchemaForAccount
+var statementUpgradeColumn = s3ListCol
+
+// SqlCheckStatementTableUpgraded returns the sql that returns a row
+// if the statement table of the current account has all the columns
+func SqlCheckStatementTableUpgraded() string {
+	return fmt.Sprintf("select attname from mo_catalog.mo_columns where account_id = current_account_id() and att_database = %q and att_relname = %q and attname = %q",
+		SingleStatementTable.Database, SingleStatementTable.Table, statementUpgradeColumn.Name)
+}
+
+// GetUpgradeSchemaForAccount returns the sqls to recreate the statement table of the account,
+// which is created by an older version without the columns added since, see SqlCheckStatementTableUpgraded.
+// the table is external, so only the definition is replaced, and the files written
+// before stay readable with the missing columns as null. new columns are always
+// appended, so the columns of the old files keep their positions.
+// the statementIOStatsView over the table is recreated with it.
+// account is table.AccountAll for the sys account
+func GetUpgradeSchemaForAccount(ctx context.Context, account string) []string {
+	tbl := SingleStatementTable.Clone()
+	tbl.Account = account
+	return []string{
+		fmt.Sprintf("drop view if exists `%s`.`%s`", StatsDatabase, statementIOStatsView),
+		fmt.Sprintf("drop table if exists `%s`.`%s`", tbl.Database, tbl.Table),
+		tbl.ToCreateSql(ctx, true),
+		sqlCreateStatementIOStatsView(),
+	}
+}
+
 // GetSchemaForAccount return account's table, and view's schema
 func GetSchemaForAccount(ctx context.Context, account string) []string {
 	var sqls = make([]string, 0, 1)
@@ -286,6 +356,7 @@ func GetSchemaForAccount(ctx context.Context, account string) []string {
 		}
 
 	}
+	sqls = append(sqls, sqlCreateStatementIOStatsView())
 	return sqls
 }
 
@@ -20,6 +20,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
 	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
 	"github.com/stretchr/testify/require"
 )
@@ -81,7 +82,7 @@ func TestInitSchemaByInnerExecutor(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var wg sync.WaitGroup
-			wg.Add(1 + len(tables) + len(views))
+			wg.Add(1 + len(tables) + len(views) + 1)
 			err := InitSchemaByInnerExecutor(tt.args.ctx, newDummyExecutorFactory(tt.args.ch))
 			require.Equal(t, nil, err)
 			go func() {
@@ -111,6 +112,14 @@ func TestInitSchemaByInnerExecutor(t *testing.T) {
 	}
 }
 
+func TestSqlCreateStatementIOStatsView(t *testing.T) {
+	sql := sqlCreateStatementIOStatsView()
+	require.True(t, strings.HasPrefix(sql, "CREATE VIEW IF NOT EXISTS `system`.`statement_io_stats` as select `account`, date_format(`request_at`, '%Y-%m-%d %H:00:00') as `hour`"))
+	require.Contains(t, sql, "sum(`io_read_bytes`) as `io_read_bytes`")
+	require.Contains(t, sql, "sum(`s3_list_requests`) as `s3_list_requests`")
+	require.True(t, strings.HasSuffix(sql, "from `system`.`statement_info` group by `account`, date_format(`request_at`, '%Y-%m-%d %H:00:00')"))
+}
+
 func TestGetSchemaForAccount(t *testing.T) {
 	type args struct {
 		account string
@@ -139,8 +148,9 @@ func TestGetSchemaForAccount(t *testing.T) {
 					found = true
 				}
 			}
-			require.Equal(t, 1, len(schemas))
+			require.Equal(t, 2, len(schemas))
 			require.Equal(t, true, found)
+			require.Equal(t, sqlCreateStatementIOStatsView(), schemas[1])
 			found = false
 			if strings.Contains(SingleStatementTable.ToCreateSql(ctx, true), "/*/*/*/*/*/statement_info/*") {
 				found = true
@@ -149,3 +159,22 @@ func TestGetSchemaForAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUpgradeSchemaForAccount(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t,
+		"select attname from mo_catalog.mo_columns where account_id = current_account_id() and att_database = \"system\" and att_relname = \"statement_info\" and attname = \"s3_list_requests\"",
+		SqlCheckStatementTableUpgraded())
+
+	sqls := GetUpgradeSchemaForAccount(ctx, "user1")
+	require.Equal(t, 4, len(sqls))
+	require.Equal(t, "drop view if exists `system`.`statement_io_stats`", sqls[0])
+	require.Equal(t, "drop table if exists `system`.`statement_info`", sqls[1])
+	require.Contains(t, sqls[2], "/user1/*/*/*/*/statement_info/*")
+	require.Contains(t, sqls[2], "`s3_list_requests`")
+	require.Equal(t, sqlCreateStatementIOStatsView(), sqls[3])
+
+	sqls = GetUpgradeSchemaForAccount(ctx, table.AccountAll)
+	require.Equal(t, SingleStatementTable.ToCreateSql(ctx, true), sqls[2])
+	require.Equal(t, table.AccountAll, SingleStatementTable.Account)
+}
@@ -42,6 +42,28 @@ func NewCatalog() *CatalogCache {
 	}
 }
 
+// Version returns the version of the catalog cache and the max commit
+// timestamp of the applied changes.
+func (cc *CatalogCache) Version() (uint64, timestamp.Timestamp) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	return cc.mu.version, cc.mu.ts
+}
+
+// updateVersion increases the version after the changes in the batch are
+// applied.
+func (cc *CatalogCache) updateVersion(bat *batch.Batch) {
+	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cc.mu.version++
+	for _, ts := range timestamps {
+		if t := ts.ToTimestamp(); cc.mu.ts.Less(t) {
+			cc.mu.ts = t
+		}
+	}
+}
+
 func (cc *CatalogCache) GC(ts timestamp.Timestamp) {
 	{ // table cache gc
 		var items []*TableItem
@@ -276,6 +298,7 @@ func (cc *CatalogCache) GetDatabase(db *DatabaseItem) bool {
 }
 
 func (cc *CatalogCache) DeleteTable(bat *batch.Batch) {
+	defer cc.updateVersion(bat)
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX))
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
 	for i, rowid := range rowids {
@@ -295,6 +318,7 @@ func (cc *CatalogCache) DeleteTable(bat *batch.Batch) {
 }
 
 func (cc *CatalogCache) DeleteDatabase(bat *batch.Batch) {
+	defer cc.updateVersion(bat)
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX))
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
 	for i, rowid := range rowids {
@@ -315,6 +339,7 @@ func (cc *CatalogCache) DeleteDatabase(bat *batch.Batch) {
 }
 
 func (cc *CatalogCache) InsertTable(bat *batch.Batch) {
+	defer cc.updateVersion(bat)
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX))
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
 	accounts := vector.MustFixedCol[uint32](bat.GetVector(catalog.MO_TABLES_ACCOUNT_ID_IDX + MO_OFF))
@@ -355,6 +380,7 @@ func (cc *CatalogCache) InsertTable(bat *batch.Batch) {
 }
 
 func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
+	defer cc.updateVersion(bat)
 	var tblKey tableItemKey
 
 	mp := make(map[tableItemKey]columns) // TableItem -> columns
@@ -446,6 +472,7 @@ func (cc *CatalogCache) InsertColumns(bat *batch.Batch) {
 }
 
 func (cc *CatalogCache) InsertDatabase(bat *batch.Batch) {
+	defer cc.updateVersion(bat)
 	rowids := vector.MustFixedCol[types.Rowid](bat.GetVector(MO_ROWID_IDX))
 	timestamps := vector.MustFixedCol[types.TS](bat.GetVector(MO_TIMESTAMP_IDX))
 	accounts := vector.MustFixedCol[uint32](bat.GetVector(catalog.MO_DATABASE_ACCOUNT_ID_IDX + MO_OFF))
@@ -80,6 +80,31 @@ func TestGC(t *testing.T) {
 	require.Equal(t, int64(0), mp.CurrNB())
 }
 
+func TestVersion(t *testing.T) {
+	mp := mpool.MustNewZero()
+	cc := NewCatalog()
+	version, ts := cc.Version()
+	require.Equal(t, uint64(0), version)
+	require.True(t, ts.IsEmpty())
+
+	dbBat := newTestDatabaseBatch(mp)
+	cc.InsertDatabase(dbBat)
+	version, ts = cc.Version()
+	require.Equal(t, uint64(1), version)
+	timestamps := vector.MustFixedCol[types.TS](dbBat.GetVector(MO_TIMESTAMP_IDX))
+	for _, t0 := range timestamps {
+		require.False(t, ts.Less(t0.ToTimestamp()))
+	}
+
+	tblBat := newTestTableBatch(mp)
+	cc.InsertTable(tblBat)
+	version, _ = cc.Version()
+	require.Equal(t, uint64(2), version)
+
+	dbBat.Clean(mp)
+	tblBat.Clean(mp)
+}
+
 func TestTables(t *testing.T) {
 	mp := mpool.MustNewZero()
 	cc := NewCatalog()
@@ -16,6 +16,7 @@ package cache
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -38,6 +39,14 @@ const (
 type CatalogCache struct {
 	tables    *tableCache
 	databases *databaseCache
+	// mu protects the version of the catalog cache, the version is increased
+	// after the changes of databases, tables or columns are applied, and ts
+	// is the max commit timestamp of the applied changes.
+	mu struct {
+		sync.Mutex
+		version uint64
+		ts      timestamp.Timestamp
+	}
 }
 
 // database cache:
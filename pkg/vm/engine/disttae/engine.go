@@ -458,6 +458,11 @@ func (e *Engine) Nodes() (engine.Nodes, error) {
 	return nodes, nil
 }
 
+// CatalogVersion implements the engine.CatalogVersioner interface.
+func (e *Engine) CatalogVersion() (uint64, timestamp.Timestamp) {
+	return e.catalog.Version()
+}
+
 func (e *Engine) Hints() (h engine.Hints) {
 	h.CommitOrRollbackTimeout = time.Minute * 5
 	return
@@ -96,6 +96,9 @@ type pushClient struct {
 
 	// timestampWaiter is used to notify the latest commit timestamp
 	timestampWaiter client.TimestampWaiter
+
+	// unusedTableTimeout overrides unsubscribeTimer if set.
+	unusedTableTimeout time.Duration
 }
 
 func (client *pushClient) init(
@@ -339,14 +342,22 @@ func (client *pushClient) receiveTableLogTailContinuously(e *Engine) {
 func (client *pushClient) unusedTableGCTicker() {
 	go func() {
 		ctx := context.TODO()
-		ticker := time.NewTicker(unsubscribeProcessTicker)
+		timeout, period := unsubscribeTimer, unsubscribeProcessTicker
+		if client.unusedTableTimeout > 0 {
+			timeout = client.unusedTableTimeout
+			// scan often enough to keep the tables no more than 1/3 longer than the timeout
+			if timeout/3 < period {
+				period = timeout / 3
+			}
+		}
+		ticker := time.NewTicker(period)
 		for {
 			<-ticker.C
 
 			t := time.Now()
 			client.subscribed.mutex.Lock()
 
-			shouldClean := t.Add(-unsubscribeTimer)
+			shouldClean := t.Add(-timeout)
 			for k, v := range client.subscribed.m {
 				if ifShouldNotDistribute(k.db, k.tbl) {
 					// never unsubscribe the mo_databases, mo_tables, mo_columns.
@@ -619,6 +630,13 @@ func (e *Engine) SetPushModelFlag(turnOn bool) {
 	e.usePushModel = turnOn
 }
 
+// SetUnusedTableTimeout sets how long a table is unused before it is unsubscribed,
+// default is 1 hour. It must be set before InitLogTailPushModel.
+// The read-only cn sets it short to receive the log tail of the tables being read only.
+func (e *Engine) SetUnusedTableTimeout(timeout time.Duration) {
+	e.pClient.unusedTableTimeout = timeout
+}
+
 func (e *Engine) UsePushModelOrNot() bool {
 	return e.usePushModel
 }
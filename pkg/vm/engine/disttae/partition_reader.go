@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/disttae/logtailreplay"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 )
 
 type PartitionReader struct {
@@ -48,7 +49,10 @@ type PartitionReader struct {
 	extendId2s3File map[string]int
 
 	// used to get idx of sepcified col
-	seqnumMp        map[string]int
+	seqnumMp map[string]int
+	// the values of the columns added after the rows were written
+	fillMp          map[string][]byte
+	fillVecs        map[string]*vector.Vector
 	blockBatch      *BlockBatch
 	currentFileName string
 	deletedBlocks   *deletedBlocks
@@ -117,6 +121,33 @@ func (p *PartitionReader) getTyps(colNames []string) (res []types.Type) {
 	return
 }
 
+func (p *PartitionReader) getFills(colNames []string) (res [][]byte) {
+	for _, str := range colNames {
+		if str == catalog.Row_ID {
+			continue
+		}
+		res = append(res, p.fillMp[str])
+	}
+	return
+}
+
+// getFillVector returns the constant vector of the value of the column in the
+// rows written before the column was added
+func (p *PartitionReader) getFillVector(name string, mp *mpool.MPool) (*vector.Vector, error) {
+	if vec, ok := p.fillVecs[name]; ok {
+		return vec, nil
+	}
+	vec, err := containers.FillCNConstVector(1, p.typsMap[name], p.fillMp[name], mp)
+	if err != nil {
+		return nil, err
+	}
+	if p.fillVecs == nil {
+		p.fillVecs = make(map[string]*vector.Vector)
+	}
+	p.fillVecs[name] = vec
+	return vec, nil
+}
+
 func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *plan.Expr, mp *mpool.MPool, vp engine.VectorPool) (*batch.Batch, error) {
 	if p == nil {
 		return nil, nil
@@ -156,7 +187,7 @@ func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *pla
 					return nil, err
 				}
 			}
-			bat, err = p.s3BlockReader.LoadColumns(context.Background(), p.getSeqnums(colNames), p.getTyps(colNames), location.ID(), p.procMPool)
+			bat, err = p.s3BlockReader.LoadColumnsWithFills(context.Background(), p.getSeqnums(colNames), p.getTyps(colNames), p.getFills(colNames), location.ID(), p.procMPool)
 			if err != nil {
 				return nil, err
 			}
@@ -278,7 +309,11 @@ func (p *PartitionReader) Read(ctx context.Context, colNames []string, expr *pla
 			} else {
 				idx := 2 /*rowid and commits*/ + p.seqnumMp[name]
 				if idx >= len(entry.Batch.Vecs) /*add column*/ || entry.Batch.Attrs[idx] == "" /*drop column*/ {
-					if err := vector.AppendAny(b.Vecs[i], nil, true, mp); err != nil {
+					fillVec, err := p.getFillVector(name, mp)
+					if err != nil {
+						return nil, err
+					}
+					if err := appendFuncs[i](b.Vecs[i], fillVec, 0); err != nil {
 						return nil, err
 					}
 				} else {
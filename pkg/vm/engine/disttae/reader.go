@@ -64,6 +64,7 @@ func (r *blockReader) Read(ctx context.Context, cols []string,
 			r.seqnums = make([]uint16, len(cols))
 			r.colTypes = make([]types.Type, len(cols))
 			r.colNulls = make([]bool, len(cols))
+			r.colFills = make([][]byte, len(cols))
 			r.pkidxInColIdxs = -1
 			for i, column := range cols {
 				// sometimes Name2ColIndex have no row_id， sometimes have one
@@ -81,6 +82,7 @@ func (r *blockReader) Read(ctx context.Context, cols []string,
 						r.pkName = column
 					}
 					r.colTypes[i] = types.T(colDef.Typ.Id).ToType()
+					r.colFills[i] = colDef.Default.GetFillValue()
 					if colDef.Default != nil {
 						r.colNulls[i] = colDef.Default.NullAbility
 					}
@@ -93,12 +95,12 @@ func (r *blockReader) Read(ctx context.Context, cols []string,
 
 	//prefetch some objects
 	for len(r.steps) > 0 && r.steps[0] == r.currentStep {
-		blockio.BlockPrefetch(r.prefetchColIdxs, r.fs, [][]*catalog.BlockInfo{r.infos[0]})
+		blockio.BlockPrefetch(ctx, r.prefetchColIdxs, r.fs, [][]*catalog.BlockInfo{r.infos[0]})
 		r.infos = r.infos[1:]
 		r.steps = r.steps[1:]
 	}
 
-	bat, err := blockio.BlockRead(r.ctx, info, r.seqnums, r.colTypes, r.ts, r.fs, mp, vp)
+	bat, err := blockio.BlockRead(r.ctx, info, r.seqnums, r.colTypes, r.colFills, r.ts, r.fs, mp, vp)
 	logutil.Debugf("read %v with %v", cols, r.seqnums)
 	bat.SetAttributes(cols)
 	if err != nil {
@@ -149,6 +151,7 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string,
 			r.seqnums = make([]uint16, len(cols))
 			r.colTypes = make([]types.Type, len(cols))
 			r.colNulls = make([]bool, len(cols))
+			r.colFills = make([][]byte, len(cols))
 			for i, column := range cols {
 				// sometimes Name2ColIndex have no row_id， sometimes have one
 				// sometimes Name2ColIndex have no row_id， sometimes have one
@@ -161,6 +164,7 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string,
 					colDef := r.tableDef.Cols[logicalIdx]
 					r.seqnums[i] = uint16(colDef.Seqnum)
 					r.colTypes[i] = types.T(colDef.Typ.Id).ToType()
+					r.colFills[i] = colDef.Default.GetFillValue()
 					if colDef.Default != nil {
 						r.colNulls[i] = colDef.Default.NullAbility
 					}
@@ -171,7 +175,7 @@ func (r *blockMergeReader) Read(ctx context.Context, cols []string,
 		}
 	}
 
-	bat, err := blockio.BlockRead(r.ctx, info, r.seqnums, r.colTypes, r.ts, r.fs, mp, vp)
+	bat, err := blockio.BlockRead(r.ctx, info, r.seqnums, r.colTypes, r.colFills, r.ts, r.fs, mp, vp)
 	logutil.Debugf("read %v with %v", cols, r.seqnums)
 	bat.SetAttributes(cols)
 	if err != nil {
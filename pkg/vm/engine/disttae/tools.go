@@ -148,6 +148,24 @@ func genTableConstraintTuple(tblId, dbId uint64, tblName, dbName string, constra
 	return bat, nil
 }
 
+// genTableAlterTuple yields a batch for altering the columns of a table, the
+// marshaled api.AlterTableReq is carried after the constraint.
+func genTableAlterTuple(tblId, dbId uint64, tblName, dbName string, constraint []byte,
+	req []byte, m *mpool.MPool) (*batch.Batch, error) {
+	bat, err := genTableConstraintTuple(tblId, dbId, tblName, dbName, constraint, m)
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.NewVec(catalog.MoTablesTypes[catalog.MO_TABLES_CONSTRAINT_IDX])
+	if err = vector.AppendBytes(vec, req, false, m); err != nil {
+		bat.Clean(m)
+		return nil, err
+	}
+	bat.Attrs = append(bat.Attrs, catalog.SystemRelAttr_AlterTable)
+	bat.Vecs = append(bat.Vecs, vec)
+	return bat, nil
+}
+
 // genCreateTableTuple yields a batch for insertion into mo_tables.
 // rowid: rowid of the row.
 // needRowid: true -- there is a rowid vector in position 0 of the batch.
@@ -0,0 +1,176 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// ttlFilter hides the rows expired by the table level ttl. Expired rows are
+// removed physically by DN later, until then they are filtered out at read time
+// with respect to the snapshot timestamp of the transaction.
+type ttlFilter struct {
+	colName string
+	seqnum  uint16
+	cutoff  any
+}
+
+// newTTLFilter returns nil if the table has no ttl
+func newTTLFilter(
+	ttl *plan.TTLDef,
+	tableDef *plan.TableDef,
+	ts timestamp.Timestamp,
+) *ttlFilter {
+	if ttl == nil {
+		return nil
+	}
+	idx, ok := tableDef.Name2ColIndex[ttl.ColName]
+	if !ok {
+		return nil
+	}
+	colDef := tableDef.Cols[idx]
+	cutoff := catalog.TTLCutoff(ttl, types.T(colDef.Typ.Id), time.Unix(0, ts.PhysicalTime))
+	if cutoff == nil {
+		return nil
+	}
+	return &ttlFilter{
+		colName: ttl.ColName,
+		seqnum:  uint16(colDef.Seqnum),
+		cutoff:  cutoff,
+	}
+}
+
+// blockExpired returns true if all the rows of the block are expired
+func (f *ttlFilter) blockExpired(meta objectio.BlockObject) bool {
+	zm := meta.MustGetColumn(f.seqnum).ZoneMap()
+	if !zm.IsInited() {
+		return false
+	}
+	return catalog.TTLExpired(f.cutoff, zm.GetMax())
+}
+
+// aliveRows returns the offsets of the rows not expired, null never expires
+func (f *ttlFilter) aliveRows(vec *vector.Vector) []int64 {
+	sels := make([]int64, 0, vec.Length())
+	switch vec.GetType().Oid {
+	case types.T_timestamp:
+		sels = appendAliveRows(sels, vec, f.cutoff.(types.Timestamp))
+	case types.T_datetime:
+		sels = appendAliveRows(sels, vec, f.cutoff.(types.Datetime))
+	case types.T_date:
+		sels = appendAliveRows(sels, vec, f.cutoff.(types.Date))
+	}
+	return sels
+}
+
+func appendAliveRows[T types.Timestamp | types.Datetime | types.Date](
+	sels []int64, vec *vector.Vector, cutoff T) []int64 {
+	if vec.IsConstNull() {
+		for i := 0; i < vec.Length(); i++ {
+			sels = append(sels, int64(i))
+		}
+		return sels
+	}
+	nsp := vec.GetNulls()
+	if vec.IsConst() {
+		if vector.MustFixedCol[T](vec)[0] >= cutoff {
+			for i := 0; i < vec.Length(); i++ {
+				sels = append(sels, int64(i))
+			}
+		}
+		return sels
+	}
+	for i, v := range vector.MustFixedCol[T](vec) {
+		if v >= cutoff || nulls.Contains(nsp, uint64(i)) {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels
+}
+
+// ttlReader wraps a reader and filters out the expired rows. If the ttl column
+// is not required by the caller, it is read additionally and removed from the
+// result batch.
+type ttlReader struct {
+	rd     engine.Reader
+	filter *ttlFilter
+}
+
+func newTTLReaders(rds []engine.Reader, filter *ttlFilter) []engine.Reader {
+	if filter == nil {
+		return rds
+	}
+	for i, rd := range rds {
+		rds[i] = &ttlReader{
+			rd:     rd,
+			filter: filter,
+		}
+	}
+	return rds
+}
+
+func (r *ttlReader) Close() error {
+	return r.rd.Close()
+}
+
+func (r *ttlReader) Read(ctx context.Context, cols []string,
+	expr *plan.Expr, mp *mpool.MPool, vp engine.VectorPool) (*batch.Batch, error) {
+	idx := -1
+	for i, col := range cols {
+		if col == r.filter.colName {
+			idx = i
+			break
+		}
+	}
+	readCols := cols
+	if idx == -1 {
+		// note: if there is rowId colName, it must be the last one
+		readCols = make([]string, 0, len(cols)+1)
+		if n := len(cols); n > 0 && cols[n-1] == catalog.Row_ID {
+			readCols = append(readCols, cols[:n-1]...)
+			readCols = append(readCols, r.filter.colName, catalog.Row_ID)
+			idx = n - 1
+		} else {
+			readCols = append(readCols, cols...)
+			readCols = append(readCols, r.filter.colName)
+			idx = n
+		}
+	}
+	bat, err := r.rd.Read(ctx, readCols, expr, mp, vp)
+	if err != nil || bat == nil || len(bat.Vecs) == 0 {
+		return bat, err
+	}
+	if sels := r.filter.aliveRows(bat.Vecs[idx]); len(sels) < bat.Length() {
+		bat.Shrink(sels)
+	}
+	if len(readCols) != len(cols) {
+		bat.Vecs[idx].Free(mp)
+		bat.Vecs = append(bat.Vecs[:idx], bat.Vecs[idx+1:]...)
+		bat.SetAttributes(cols)
+	}
+	return bat, nil
+}
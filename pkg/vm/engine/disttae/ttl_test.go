@@ -0,0 +1,57 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+func TestTTLFilter(t *testing.T) {
+	tableDef := &plan.TableDef{
+		Cols: []*plan.ColDef{
+			{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+			{Name: "b", Typ: &plan.Type{Id: int32(types.T_timestamp)}, Seqnum: 1},
+		},
+		Name2ColIndex: map[string]int32{"a": 0, "b": 1},
+	}
+	now := time.Now()
+	ts := timestamp.Timestamp{PhysicalTime: now.UnixNano()}
+
+	require.Nil(t, newTTLFilter(nil, tableDef, ts))
+	require.Nil(t, newTTLFilter(&plan.TTLDef{ColName: "c", ExpireSeconds: 60}, tableDef, ts))
+
+	f := newTTLFilter(&plan.TTLDef{ColName: "b", ExpireSeconds: 60}, tableDef, ts)
+	require.NotNil(t, f)
+	require.Equal(t, uint16(1), f.seqnum)
+
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_timestamp.ToType())
+	defer vec.Free(mp)
+	expired := types.UnixNanoToTimestamp(now.Add(-time.Hour).UnixNano())
+	alive := types.UnixNanoToTimestamp(now.UnixNano())
+	require.NoError(t, vector.AppendFixed(vec, expired, false, mp))
+	require.NoError(t, vector.AppendFixed(vec, alive, false, mp))
+	require.NoError(t, vector.AppendFixed(vec, expired, true, mp))
+	require.Equal(t, []int64{1, 2}, f.aliveRows(vec))
+}
@@ -277,6 +277,11 @@ func (tbl *txnTable) Ranges(ctx context.Context, expr *plan.Expr) (ranges [][]by
 
 	tbl.modifiedBlocks = make([][]ModifyBlockMeta, len(tbl.blockInfos))
 
+	var ttl *ttlFilter
+	if ttl, err = tbl.getTTLFilter(); err != nil {
+		return
+	}
+
 	for _, i := range tbl.dnList {
 		blocks := tbl.blockInfos[i]
 		if len(blocks) == 0 {
@@ -288,6 +293,7 @@ func (tbl *txnTable) Ranges(ctx context.Context, expr *plan.Expr) (ranges [][]by
 			parts[i],
 			tbl.getTableDef(),
 			expr,
+			ttl,
 			blocks,
 			&ranges,
 			&tbl.modifiedBlocks[i],
@@ -307,6 +313,7 @@ func (tbl *txnTable) rangesOnePart(
 	state *logtailreplay.PartitionState, // snapshot state of this transaction
 	tableDef *plan.TableDef, // table definition (schema)
 	expr *plan.Expr, // filter expression
+	ttl *ttlFilter, // filter of expired blocks, nil if the table has no ttl
 	blocks []catalog.BlockInfo, // whole block list
 	ranges *[][]byte, // output marshaled block list after filtering
 	modifies *[]ModifyBlockMeta, // output modified blocks after filtering
@@ -419,6 +426,19 @@ func (tbl *txnTable) rangesOnePart(
 			continue
 		}
 
+		// skip the block if all of its rows are expired by ttl
+		if ttl != nil && !blk.EntryState {
+			location := blk.MetaLocation()
+			if !objectio.IsSameObjectLocVsMeta(location, meta) {
+				if meta, err = objectio.FastLoadObjectMeta(ctx, &location, proc.FileService); err != nil {
+					return
+				}
+			}
+			if ttl.blockExpired(meta.GetBlockMeta(uint32(location.ID()))) {
+				continue
+			}
+		}
+
 		if hasDeletes {
 			// check if the block has deletes
 			// if any, store the block and its deletes in modifies
@@ -473,6 +493,16 @@ func (tbl *txnTable) getTableDef() *plan.TableDef {
 	return tbl.tableDef
 }
 
+// getTTLFilter returns the filter of expired rows with respect to the snapshot
+// timestamp, nil if the table has no ttl.
+func (tbl *txnTable) getTTLFilter() (*ttlFilter, error) {
+	ttl, err := catalog.GetTTLDef(tbl.constraint)
+	if err != nil || ttl == nil {
+		return nil, err
+	}
+	return newTTLFilter(ttl, tbl.getTableDef(), tbl.db.txn.meta.SnapshotTS), nil
+}
+
 func (tbl *txnTable) TableDefs(ctx context.Context) ([]engine.TableDef, error) {
 	//return tbl.defs, nil
 	// I don't understand why the logic now is not to get all the tableDef. Don't understand.
@@ -544,6 +574,29 @@ func (tbl *txnTable) UpdateConstraint(ctx context.Context, c *engine.ConstraintD
 	return nil
 }
 
+// AlterTable sends the requests to DN, where the schema of the table gets a
+// new version. The new columns are visible to CN once the logtail of the new
+// schema is consumed.
+func (tbl *txnTable) AlterTable(ctx context.Context, reqs []*api.AlterTableReq) error {
+	for _, req := range reqs {
+		req.DbId, req.TableId = tbl.db.databaseId, tbl.tableId
+		data, err := req.Marshal()
+		if err != nil {
+			return err
+		}
+		bat, err := genTableAlterTuple(tbl.tableId, tbl.db.databaseId, tbl.tableName, tbl.db.databaseName,
+			tbl.constraint, data, tbl.db.txn.proc.Mp())
+		if err != nil {
+			return err
+		}
+		if err = tbl.db.txn.WriteBatch(UPDATE, catalog.MO_CATALOG_ID, catalog.MO_TABLES_ID,
+			catalog.MO_CATALOG, catalog.MO_TABLES, bat, tbl.db.txn.dnStores[0], -1, false, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (tbl *txnTable) TableColumns(ctx context.Context) ([]*engine.Attribute, error) {
 	var attrs []*engine.Attribute
 	for _, def := range tbl.defs {
@@ -848,6 +901,18 @@ func (tbl *txnTable) GetTableID(ctx context.Context) uint64 {
 }
 
 func (tbl *txnTable) NewReader(ctx context.Context, num int, expr *plan.Expr, ranges [][]byte) ([]engine.Reader, error) {
+	ttl, err := tbl.getTTLFilter()
+	if err != nil {
+		return nil, err
+	}
+	rds, err := tbl.newReaders(ctx, num, expr, ranges)
+	if err != nil {
+		return nil, err
+	}
+	return newTTLReaders(rds, ttl), nil
+}
+
+func (tbl *txnTable) newReaders(ctx context.Context, num int, expr *plan.Expr, ranges [][]byte) ([]engine.Reader, error) {
 	if len(ranges) == 0 {
 		return tbl.newMergeReader(ctx, num, expr)
 	}
@@ -1035,8 +1100,10 @@ func (tbl *txnTable) newReader(
 	readers := make([]engine.Reader, readerNumber)
 
 	seqnumMp := make(map[string]int)
+	fillMp := make(map[string][]byte)
 	for _, coldef := range tbl.tableDef.Cols {
 		seqnumMp[coldef.Name] = int(coldef.Seqnum)
+		fillMp[coldef.Name] = coldef.Default.GetFillValue()
 	}
 
 	mp := make(map[string]types.Type)
@@ -1074,6 +1141,7 @@ func (tbl *txnTable) newReader(
 		deletes:         deletes,
 		iter:            iter,
 		seqnumMp:        seqnumMp,
+		fillMp:          fillMp,
 		extendId2s3File: make(map[string]int),
 		s3FileService:   fs,
 		procMPool:       txn.proc.GetMPool(),
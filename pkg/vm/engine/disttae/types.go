@@ -345,6 +345,7 @@ type blockReader struct {
 	seqnums        []uint16
 	colTypes       []types.Type
 	colNulls       []bool
+	colFills       [][]byte
 	pkidxInColIdxs int
 	pkName         string
 	// binary search info
@@ -365,6 +366,7 @@ type blockMergeReader struct {
 	seqnums  []uint16
 	colTypes []types.Type
 	colNulls []bool
+	colFills [][]byte
 }
 
 type mergeReader struct {
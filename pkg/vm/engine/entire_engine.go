@@ -84,6 +84,15 @@ func (e *EntireEngine) Hints() Hints {
 	return e.Engine.Hints()
 }
 
+// CatalogVersion implements the CatalogVersioner interface, the version is
+// zero if the engine does not track the changes of the catalog.
+func (e *EntireEngine) CatalogVersion() (uint64, timestamp.Timestamp) {
+	if v, ok := e.Engine.(CatalogVersioner); ok {
+		return v.CatalogVersion()
+	}
+	return 0, timestamp.Timestamp{}
+}
+
 func (e *EntireEngine) NewBlockReader(ctx context.Context, num int, ts timestamp.Timestamp,
 	expr *plan.Expr, ranges [][]byte, tblDef *plan.TableDef) ([]Reader, error) {
 	return e.Engine.NewBlockReader(ctx, num, ts, expr, ranges, tblDef)
@@ -81,6 +81,10 @@ func (*CompilerContext) Stats(obj *plan.ObjectRef, e *plan.Expr) *plan.Stats {
 	return plan.DefaultStats()
 }
 
+func (*CompilerContext) ResolveParams() []*plan.Expr {
+	return nil
+}
+
 func (*CompilerContext) GetStatsCache() *plan.StatsCache {
 	return nil
 }
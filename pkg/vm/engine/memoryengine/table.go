@@ -17,6 +17,8 @@ package memoryengine
 import (
 	"context"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 
@@ -273,6 +275,10 @@ func (t *Table) UpdateConstraint(context.Context, *engine.ConstraintDef) error {
 	return nil
 }
 
+func (t *Table) AlterTable(ctx context.Context, _ []*api.AlterTableReq) error {
+	return moerr.NewNYI(ctx, "interface AlterTable is not implemented")
+}
+
 func (t *Table) Update(ctx context.Context, data *batch.Batch) error {
 	data.InitZsOne(data.Length())
 	shards, err := t.engine.shardPolicy.Batch(
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/sm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
@@ -153,7 +154,7 @@ type FetchFunc = func(ctx context.Context, params fetchParams) (any, error)
 type PrefetchFunc = func(params prefetchParams) error
 
 func readColumns(ctx context.Context, params fetchParams) (any, error) {
-	return params.reader.ReadOneBlock(ctx, params.idxes, params.typs, params.blk, nil)
+	return params.reader.ReadOneBlock(ctx, params.idxes, params.typs, params.fills, params.blk, nil)
 }
 
 func noopPrefetch(params prefetchParams) error {
@@ -338,8 +339,8 @@ func (p *IoPipeline) onPrefetch(items ...any) {
 		option := item.(prefetchParams)
 		if len(option.ids) == 0 {
 			job := prefetchMetaJob(
-				context.Background(),
-				item.(prefetchParams),
+				perfcounter.WithStatementID(context.Background(), option.statementID),
+				option,
 			)
 			p.schedulerPrefetch(job)
 			continue
@@ -352,7 +353,7 @@ func (p *IoPipeline) onPrefetch(items ...any) {
 	merged := mergePrefetch(processes)
 	for _, option := range merged {
 		job := prefetchJob(
-			context.Background(),
+			perfcounter.WithStatementID(context.Background(), option.statementID),
 			option,
 		)
 		p.schedulerPrefetch(job)
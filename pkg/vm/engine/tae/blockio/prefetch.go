@@ -25,6 +25,9 @@ import (
 type prefetchParams struct {
 	ids    map[uint16]*objectio.ReadBlockOptions
 	reader *objectio.ObjectReader
+	// statementID is the statement the prefetch is counted in,
+	// merged requests are counted in the first one's statement
+	statementID string
 }
 
 func BuildPrefetchParams(service fileservice.FileService, key objectio.Location) (prefetchParams, error) {
@@ -30,23 +30,26 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
 
-// BlockRead read block data from storage and apply deletes according given timestamp. Caller make sure metaloc is not empty
+// BlockRead read block data from storage and apply deletes according given timestamp. Caller make sure metaloc is not empty.
+// fills are the marshaled values of the columns added after the block was written, nil means null.
 func BlockRead(
 	ctx context.Context,
 	info *pkgcatalog.BlockInfo,
 	seqnums []uint16,
 	colTypes []types.Type,
+	fills [][]byte,
 	ts timestamp.Timestamp,
 	fs fileservice.FileService,
 	mp *mpool.MPool, vp engine.VectorPool) (*batch.Batch, error) {
 
 	// read
 	columnBatch, err := BlockReadInner(
-		ctx, info, seqnums, colTypes,
+		ctx, info, seqnums, colTypes, fills,
 		types.TimestampToTS(ts), fs, mp, vp,
 	)
 	if err != nil {
@@ -94,11 +97,12 @@ func BlockReadInner(
 	info *pkgcatalog.BlockInfo,
 	seqnums []uint16,
 	colTypes []types.Type,
+	fills [][]byte,
 	ts types.TS,
 	fs fileservice.FileService,
 	mp *mpool.MPool, vp engine.VectorPool) (*batch.Batch, error) {
 	var deleteRows []int64
-	columnBatch, deleteRows, err := readBlockData(ctx, seqnums, colTypes, info, ts,
+	columnBatch, deleteRows, err := readBlockData(ctx, seqnums, colTypes, fills, info, ts,
 		fs, mp)
 	if err != nil {
 		return nil, err
@@ -135,7 +139,7 @@ func BlockReadInner(
 	return rbat, nil
 }
 
-func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type) (bool, []uint16, []types.Type) {
+func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type, fills [][]byte) (bool, []uint16, []types.Type, [][]byte) {
 	found := false
 	idx := 0
 	for i, typ := range colTypes {
@@ -146,18 +150,25 @@ func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type) (bool, []uint16,
 		}
 	}
 	if !found {
-		return found, colIndexes, colTypes
+		return found, colIndexes, colTypes, fills
 	}
 	idxes := make([]uint16, 0, len(colTypes)-1)
-	typs := make([]types.Type, len(colTypes)-1)
+	typs := make([]types.Type, 0, len(colTypes)-1)
+	var fillValues [][]byte
+	if len(fills) > 0 {
+		fillValues = make([][]byte, 0, len(colTypes)-1)
+	}
 	for i := range colIndexes {
 		if i == idx {
 			continue
 		}
 		idxes = append(idxes, colIndexes[i])
 		typs = append(typs, colTypes[i])
+		if len(fills) > 0 {
+			fillValues = append(fillValues, fills[i])
+		}
 	}
-	return found, idxes, typs
+	return found, idxes, typs, fillValues
 }
 
 func preparePhyAddrData(typ types.Type, prefix []byte, startRow, length uint32, pool *mpool.MPool) (col *vector.Vector, err error) {
@@ -170,10 +181,10 @@ func preparePhyAddrData(typ types.Type, prefix []byte, startRow, length uint32,
 }
 
 func readBlockData(ctx context.Context, colIndexes []uint16,
-	colTypes []types.Type, info *pkgcatalog.BlockInfo, ts types.TS,
+	colTypes []types.Type, fills [][]byte, info *pkgcatalog.BlockInfo, ts types.TS,
 	fs fileservice.FileService, m *mpool.MPool) (*batch.Batch, []int64, error) {
 	deleteRows := make([]int64, 0)
-	ok, idxes, typs := getRowsIdIndex(colIndexes, colTypes)
+	ok, idxes, typs, fills := getRowsIdIndex(colIndexes, colTypes, fills)
 	id := info.MetaLocation().ID()
 	reader, err := NewObjectReader(fs, info.MetaLocation())
 	if err != nil {
@@ -208,7 +219,7 @@ func readBlockData(ctx context.Context, colIndexes []uint16,
 			bat.Vecs = append(bat.Vecs, rowIdVec)
 			return nil, nil
 		}
-		bats, err := reader.LoadColumns(ctx, idxes, typs, id, nil)
+		bats, err := reader.LoadColumnsWithFills(ctx, idxes, typs, fills, id, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -305,7 +316,9 @@ func recordDeletes(deleteBatch *batch.Batch, ts types.TS) []int64 {
 // columns  Which columns should be taken for columns
 // service  fileservice
 // infos [s3object name][block]
-func BlockPrefetch(idxes []uint16, service fileservice.FileService, infos [][]*pkgcatalog.BlockInfo) error {
+// the io is counted in the statement of ctx, see perfcounter.WithStatementID
+func BlockPrefetch(ctx context.Context, idxes []uint16, service fileservice.FileService, infos [][]*pkgcatalog.BlockInfo) error {
+	statementID := perfcounter.StatementIDFromContext(ctx)
 	// Generate prefetch task
 	for i := range infos {
 		// build reader
@@ -313,14 +326,20 @@ func BlockPrefetch(idxes []uint16, service fileservice.FileService, infos [][]*p
 		if err != nil {
 			return err
 		}
+		pref.statementID = statementID
 		for _, info := range infos[i] {
 			pref.AddBlock(idxes, []uint16{info.MetaLocation().ID()})
 			if !info.DeltaLocation().IsEmpty() {
 				// Need to read all delete
-				err = Prefetch([]uint16{0, 1, 2}, []uint16{info.DeltaLocation().ID()}, service, info.DeltaLocation())
+				delta, err := BuildPrefetchParams(service, info.DeltaLocation())
 				if err != nil {
 					return err
 				}
+				delta.statementID = statementID
+				delta.AddBlock([]uint16{0, 1, 2}, []uint16{info.DeltaLocation().ID()})
+				if err = pipeline.Prefetch(delta); err != nil {
+					return err
+				}
 			}
 		}
 		err = pipeline.Prefetch(pref)
@@ -42,6 +42,7 @@ type BlockReader struct {
 type fetchParams struct {
 	idxes  []uint16
 	typs   []types.Type
+	fills  [][]byte
 	blk    uint16
 	pool   *mpool.MPool
 	reader *objectio.ObjectReader
@@ -92,6 +93,19 @@ func (r *BlockReader) LoadColumns(
 	typs []types.Type,
 	blk uint16,
 	m *mpool.MPool,
+) (bat *batch.Batch, err error) {
+	return r.LoadColumnsWithFills(ctx, cols, typs, nil, blk, m)
+}
+
+// LoadColumnsWithFills is LoadColumns, but the columns added after the block
+// was written are filled with the marshaled values in fills instead of null.
+func (r *BlockReader) LoadColumnsWithFills(
+	ctx context.Context,
+	cols []uint16,
+	typs []types.Type,
+	fills [][]byte,
+	blk uint16,
+	m *mpool.MPool,
 ) (bat *batch.Batch, err error) {
 	metaExt := r.reader.GetMetaExtent()
 	if metaExt == nil || metaExt.End() == 0 {
@@ -103,6 +117,7 @@ func (r *BlockReader) LoadColumns(
 			idxes:  cols,
 			blk:    blk,
 			typs:   typs,
+			fills:  fills,
 			pool:   m,
 			reader: r.reader,
 		}
@@ -112,7 +127,7 @@ func (r *BlockReader) LoadColumns(
 		}
 		ioVectors = v.(*fileservice.IOVector)
 	} else {
-		ioVectors, err = r.reader.ReadOneBlock(ctx, cols, typs, blk, m)
+		ioVectors, err = r.reader.ReadOneBlock(ctx, cols, typs, fills, blk, m)
 		if err != nil {
 			return
 		}
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -413,4 +414,42 @@ func TestAlterSchema(t *testing.T) {
 	require.Equal(t, uint16(5), schema.GetSingleSortKey().SeqNum)
 	require.Equal(t, 5, schema.GetSingleSortKeyIdx())
 
+	req = api.NewRenameColumnReq(0, 0, "xyz", "uvw", 10)
+	require.NoError(t, schema.ApplyAlterTable(req))
+	require.Equal(t, -1, schema.GetColIdx("xyz"))
+	require.Equal(t, 2, schema.GetColIdx("uvw"))
+	require.Equal(t, uint16(10), schema.GetSeqnum("uvw"))
+	require.Equal(t, []string{"mock_3", "xyz"}, schema.Extra.DroppedAttrs)
+	require.Error(t, schema.ApplyAlterTable(api.NewRenameColumnReq(0, 0, "mock_5", "abc", 5)))
+
+	// move the column to the first and make it not null
+	col := &plan.ColDef{
+		Name:    "uvw",
+		Typ:     types.NewProtoType(types.T_int32),
+		Default: &plan.Default{NullAbility: false},
+	}
+	require.NoError(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, col, 10, 0)))
+	require.Equal(t, 11, len(schema.NameMap))
+	require.Equal(t, 11, len(schema.SeqnumMap))
+	require.Equal(t, 0, schema.GetColIdx("uvw"))
+	require.False(t, schema.ColDefs[0].NullAbility)
+	require.Equal(t, 5, schema.GetSingleSortKeyIdx())
+	require.Equal(t, 10, schema.GetColIdx(PhyAddrColumnName))
+
+	// move the column to the last with the old name, rowid is still the last one
+	col.Name = "xyz"
+	require.NoError(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, col, 10, 100)))
+	require.Equal(t, 9, schema.GetColIdx("xyz"))
+	require.Equal(t, 4, schema.GetSingleSortKeyIdx())
+	require.Equal(t, 10, schema.GetColIdx(PhyAddrColumnName))
+	require.Equal(t, []string{"mock_3", "uvw"}, schema.Extra.DroppedAttrs)
+
+	col.Typ = types.NewProtoType(types.T_int64)
+	require.Error(t, schema.ApplyAlterTable(api.NewModifyColumnReq(0, 0, col, 10, -1)))
+
+	require.NoError(t, schema.ApplyAlterTable(api.NewUpdatePartitionReq(0, 0, "partition info")))
+	require.Equal(t, int8(1), schema.Partitioned)
+	require.Equal(t, "partition info", schema.Partition)
+	require.NoError(t, schema.ApplyAlterTable(api.NewUpdatePartitionReq(0, 0, "")))
+	require.Equal(t, int8(0), schema.Partitioned)
 }
@@ -237,6 +237,14 @@ func (cmd *EntryCommand[T, N]) GetID() *common.ID {
 	return cmd.ID
 }
 
+func (cmd *EntryCommand[T, N]) GetMVCCNode() *MVCCNode[T] {
+	return cmd.mvccNode
+}
+
+func (cmd *EntryCommand[T, N]) GetNode() N {
+	return cmd.node
+}
+
 func (cmd *EntryCommand[T, N]) String() string {
 	s := fmt.Sprintf("CmdName=%s;%s;TS=%s;CSN=%d;BaseEntry=%s", CmdName(cmd.cmdType), cmd.IDString(), cmd.GetTs().ToString(), cmd.ID, cmd.mvccNode.String())
 	return s
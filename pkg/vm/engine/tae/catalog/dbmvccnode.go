@@ -53,6 +53,8 @@ type DBNode struct {
 	createSql string
 }
 
+func (node *DBNode) GetName() string { return node.name }
+
 func (node *DBNode) ReadFrom(r io.Reader) (n int64, err error) {
 	var sn int64
 	if node.name, sn, err = objectio.ReadString(r); err != nil {
@@ -75,6 +75,19 @@ func (def *ColDef) IsAutoIncrement() bool { return def.AutoIncrement }
 func (def *ColDef) IsSortKey() bool       { return def.SortKey }
 func (def *ColDef) IsClusterBy() bool     { return def.ClusterBy }
 
+// FillValue returns the marshaled value of the column in the rows written
+// before the column was added, nil means null
+func (def *ColDef) FillValue() []byte {
+	if len(def.Default) == 0 {
+		return nil
+	}
+	dflt := &plan.Default{}
+	if err := types.Decode(def.Default, dflt); err != nil {
+		return nil
+	}
+	return dflt.FillValue
+}
+
 type SortKey struct {
 	Defs      []*ColDef
 	search    map[int]int
@@ -164,6 +177,12 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Constraint = req.GetUpdateCstr().GetConstraints()
 	case apipb.AlterKind_UpdateComment:
 		s.Comment = req.GetUpdateComment().GetComment()
+	case apipb.AlterKind_UpdatePartition:
+		s.Partition = req.GetUpdatePartition().GetPartitionInfo()
+		s.Partitioned = 0
+		if s.Partition != "" {
+			s.Partitioned = 1
+		}
 	case apipb.AlterKind_AddColumn:
 		add := req.GetAddColumn()
 		logutil.Infof("[Alter] add column %s(%s)@%d", add.Column.Name, types.T(add.Column.Typ.Id), add.InsertPosition)
@@ -214,6 +233,99 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Extra.DroppedAttrs = append(s.Extra.DroppedAttrs, coldef.Name)
 		s.Extra.ColumnChanged = true
 		return s.Finalize(true)
+	case apipb.AlterKind_RenameColumn:
+		rename := req.GetRenameColumn()
+		logicalIdx, ok := s.NameMap[rename.OldName]
+		if !ok {
+			return moerr.NewInternalErrorNoCtx("column %s not found", rename.OldName)
+		}
+		coldef := s.ColDefs[logicalIdx]
+		if coldef.SeqNum != uint16(rename.SequenceNum) {
+			return moerr.NewInternalErrorNoCtx("unmatched name and seqnumn")
+		}
+		if coldef.IsAutoIncrement() || coldef.IsClusterBy() || coldef.IsPrimary() || coldef.IsPhyAddr() {
+			return moerr.NewInternalErrorNoCtx("rename a column with constraint")
+		}
+		if _, ok = s.NameMap[rename.NewName]; ok {
+			return moerr.NewInternalErrorNoCtx("duplicate column %s", rename.NewName)
+		}
+		logutil.Infof("[Alter] rename column %s to %s %d", coldef.Name, rename.NewName, coldef.SeqNum)
+		delete(s.NameMap, coldef.Name)
+		s.NameMap[rename.NewName] = logicalIdx
+		coldef.Name = rename.NewName
+		// the row of the old name in mo_columns is deleted as a dropped column
+		s.Extra.DroppedAttrs = append(s.Extra.DroppedAttrs, rename.OldName)
+		s.removeDroppedName(rename.NewName)
+		s.Extra.ColumnChanged = true
+		return s.Finalize(true)
+	case apipb.AlterKind_ModifyColumn:
+		modify := req.GetModifyColumn()
+		oldIdx, ok := s.SeqnumMap[uint16(modify.SequenceNum)]
+		if !ok {
+			return moerr.NewInternalErrorNoCtx("column seqnum %d not found", modify.SequenceNum)
+		}
+		coldef := s.ColDefs[oldIdx]
+		if coldef.IsPhyAddr() {
+			return moerr.NewInternalErrorNoCtx("modify the physical address column")
+		}
+		// old blocks are read with the new definition, so the type can not be
+		// changed in place
+		newcol := colDefFromPlan(modify.Column, oldIdx, coldef.SeqNum)
+		if newcol.Type.Oid != coldef.Type.Oid {
+			return moerr.NewNYINoCtx("modify the type of column %s", coldef.Name)
+		}
+		renamed := newcol.Name != coldef.Name
+		if renamed {
+			if coldef.IsAutoIncrement() || coldef.IsClusterBy() || coldef.IsPrimary() {
+				return moerr.NewInternalErrorNoCtx("rename a column with constraint")
+			}
+			if _, ok = s.NameMap[newcol.Name]; ok {
+				return moerr.NewInternalErrorNoCtx("duplicate column %s", newcol.Name)
+			}
+		}
+		// keys are not changed by modifying a column, the hidden column of a
+		// type conversion becomes visible when it replaces the column
+		if _, ok := pkgcatalog.ConvertedColName(coldef.Name); !ok {
+			newcol.Hidden = coldef.Hidden
+		}
+		newcol.AutoIncrement = coldef.AutoIncrement
+		newcol.Primary = coldef.Primary
+		newcol.SortIdx = coldef.SortIdx
+		newcol.SortKey = coldef.SortKey
+		newcol.ClusterBy = coldef.ClusterBy
+		newcol.FakePK = coldef.FakePK
+		if newcol.Primary {
+			newcol.NullAbility = false
+		}
+		logutil.Infof("[Alter] modify column %s to %s(%s)@%d %d",
+			coldef.Name, newcol.Name, newcol.Type.String(), modify.InsertPosition, coldef.SeqNum)
+
+		// take the column out and insert it at the new position, there is
+		// a rowid column at the end when alter normal table
+		rest := make([]*ColDef, 0, len(s.ColDefs)-1)
+		rest = append(rest, s.ColDefs[:oldIdx]...)
+		rest = append(rest, s.ColDefs[oldIdx+1:]...)
+		logicalIdx := int(modify.InsertPosition)
+		if logicalIdx < 0 {
+			logicalIdx = oldIdx
+		} else if logicalIdx > len(rest)-1 {
+			logicalIdx = len(rest) - 1
+		}
+		s.ColDefs = make([]*ColDef, 0, len(rest)+1)
+		s.ColDefs = append(s.ColDefs, rest[:logicalIdx]...)
+		s.ColDefs = append(s.ColDefs, newcol)
+		s.ColDefs = append(s.ColDefs, rest[logicalIdx:]...)
+		delete(s.NameMap, coldef.Name)
+		for i, col := range s.ColDefs {
+			col.Idx = i
+			s.NameMap[col.Name] = i
+		}
+		if renamed {
+			s.Extra.DroppedAttrs = append(s.Extra.DroppedAttrs, coldef.Name)
+			s.removeDroppedName(newcol.Name)
+		}
+		s.Extra.ColumnChanged = true
+		return s.Finalize(true)
 	default:
 		return moerr.NewNYINoCtx("unsupported alter kind: %v", req.Kind)
 	}
@@ -265,6 +377,19 @@ func (s *Schema) GetPrimaryKey() *ColDef {
 	return s.getFakePrimaryKey()
 }
 
+// GetTTL returns the table level ttl, nil if the table has no ttl.
+func (s *Schema) GetTTL() *plan.TTLDef {
+	ttl, err := pkgcatalog.GetTTLDef(s.Constraint)
+	if err != nil {
+		logutil.Warnf("bad ttl of %s: %v", s.Name, err)
+		return nil
+	}
+	if ttl == nil || s.GetColIdx(ttl.ColName) == -1 {
+		return nil
+	}
+	return ttl
+}
+
 func (s *Schema) MustGetExtraBytes() []byte {
 	data, err := s.Extra.Marshal()
 	if err != nil {
@@ -27,15 +27,30 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 )
 
-func FillConstVector(length int, typ types.Type, defautV any) Vector {
-	// TODO(aptend): use default value
-	vec := movec.NewConstNull(typ, length, common.DefaultAllocator)
-	return ToDNVector(vec)
+// FillConstVector returns the constant vector of a column in the rows written
+// before the column was added, fill is the marshaled value of the column and
+// the vector is null if it is empty
+func FillConstVector(length int, typ types.Type, fill []byte) (Vector, error) {
+	vec, err := FillCNConstVector(length, typ, fill, common.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	return ToDNVector(vec), nil
 }
 
-func FillCNConstVector(length int, typ types.Type, defautV any, m *mpool.MPool) *movec.Vector {
-	// TODO(aptend): use default value
-	return movec.NewConstNull(typ, length, m)
+func FillCNConstVector(length int, typ types.Type, fill []byte, m *mpool.MPool) (*movec.Vector, error) {
+	if len(fill) == 0 {
+		return movec.NewConstNull(typ, length, m), nil
+	}
+	vec := movec.NewVec(typ)
+	if err := vec.UnmarshalBinary(fill); err != nil {
+		return nil, err
+	}
+	if !vec.IsConst() || vec.GetType().Oid != typ.Oid {
+		return nil, moerr.NewInternalErrorNoCtx("bad fill value of type %s", typ.String())
+	}
+	vec.SetLength(length)
+	return vec, nil
 }
 
 // ### Shallow copy Functions
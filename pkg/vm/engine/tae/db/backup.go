@@ -0,0 +1,362 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
+)
+
+// BackupDir is the directory of the backup manifests in a backup location
+const BackupDir = "backup/"
+
+// BackupManifest describes a physical backup. All the files it lists are
+// stored in the backup location with the same path as in the source storage.
+type BackupManifest struct {
+	Name string `json:"name"`
+	// Previous is the backup this one is based on, empty for a full backup
+	Previous string `json:"previous,omitempty"`
+	// TS is the end ts of the latest checkpoint in the backup
+	TS       types.TS `json:"ts"`
+	MetaFile string   `json:"meta_file"`
+	Objects  []string `json:"objects"`
+	// Copied is the number of files copied by this backup
+	Copied int `json:"copied"`
+}
+
+// Backup forces a checkpoint of all the committed data and takes a physical
+// backup of it into dst. If incremental is true, the files already saved in
+// dst by the previous backups are not copied again.
+func (db *DB) Backup(
+	ctx context.Context,
+	dst fileservice.FileService,
+	incremental bool,
+	flushDuration time.Duration) (manifest *BackupManifest, err error) {
+	ts := types.BuildTS(time.Now().UTC().UnixNano(), 0)
+	if err = db.ForceCheckpoint(ctx, ts, flushDuration); err != nil {
+		return
+	}
+	// no checkpoint is taken during the copy, so that the disk cleaner keeps
+	// all the objects referenced by the backup
+	db.BGCheckpointRunner.DisableCheckpoint()
+	defer db.BGCheckpointRunner.EnableCheckpoint()
+	return Backup(ctx, db.Fs.Service, dst, incremental)
+}
+
+// Backup copies the latest checkpoint in src and all the objects referenced
+// by it into dst. src must not be collected during the backup.
+func Backup(
+	ctx context.Context,
+	src, dst fileservice.FileService,
+	incremental bool) (manifest *BackupManifest, err error) {
+	now := time.Now()
+	srcFs := objectio.NewObjectFS(src, "")
+	metaFile, entries, err := checkpoint.LoadCheckpointEntries(ctx, srcFs)
+	if err != nil {
+		return
+	}
+	if len(entries) == 0 {
+		return nil, moerr.NewInternalError(ctx, "no checkpoint to backup")
+	}
+	required, optional, err := collectBackupObjects(ctx, srcFs, entries)
+	if err != nil {
+		return
+	}
+
+	saved := make(map[string]struct{})
+	manifest = &BackupManifest{
+		TS:       entries[len(entries)-1].GetEnd(),
+		MetaFile: metaFile,
+	}
+	manifest.Name = fmt.Sprintf("%d-%d", manifest.TS.Physical(), manifest.TS.Logical())
+	if incremental {
+		var previous []*BackupManifest
+		if previous, err = ListBackups(ctx, dst); err != nil {
+			return
+		}
+		for _, prev := range previous {
+			for _, name := range prev.Objects {
+				saved[name] = struct{}{}
+			}
+			saved[prev.MetaFile] = struct{}{}
+			manifest.Previous = prev.Name
+		}
+	}
+
+	copyFile := func(name string, mustExist bool) error {
+		if _, ok := saved[name]; ok {
+			manifest.Objects = append(manifest.Objects, name)
+			return nil
+		}
+		copied, err := copyBackupFile(ctx, src, dst, name)
+		if err != nil {
+			if !mustExist && moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+				return nil
+			}
+			return err
+		}
+		if copied {
+			manifest.Copied++
+		}
+		manifest.Objects = append(manifest.Objects, name)
+		return nil
+	}
+	for _, name := range required {
+		if err = copyFile(name, true); err != nil {
+			return
+		}
+	}
+	for _, name := range optional {
+		if err = copyFile(name, false); err != nil {
+			return
+		}
+	}
+	if _, ok := saved[metaFile]; !ok {
+		if _, err = copyBackupFile(ctx, src, dst, metaFile); err != nil {
+			return
+		}
+		manifest.Copied++
+	}
+	if err = writeBackupManifest(ctx, dst, manifest); err != nil {
+		return
+	}
+	logutil.Info("backup-tae", common.OperationField("backup"),
+		common.OperandField(manifest.Name),
+		common.AnyField("previous", manifest.Previous),
+		common.AnyField("objects", len(manifest.Objects)),
+		common.AnyField("copied", manifest.Copied),
+		common.AnyField("cost", time.Since(now)))
+	return
+}
+
+// Restore copies the backup name in src into dst, which has to be the storage
+// of a new instance. The latest backup is restored if name is empty.
+func Restore(
+	ctx context.Context,
+	src, dst fileservice.FileService,
+	name string) (manifest *BackupManifest, err error) {
+	now := time.Now()
+	backups, err := ListBackups(ctx, src)
+	if err != nil {
+		return
+	}
+	for _, backup := range backups {
+		if name == "" || backup.Name == name {
+			manifest = backup
+		}
+	}
+	if manifest == nil {
+		return nil, moerr.NewInternalError(ctx, "backup %q is not found", name)
+	}
+	dirs, err := dst.List(ctx, checkpoint.CheckpointDir)
+	if err != nil {
+		return
+	}
+	if len(dirs) > 0 {
+		return nil, moerr.NewInternalError(ctx, "restore target is not empty")
+	}
+	for _, object := range manifest.Objects {
+		if _, err = copyBackupFile(ctx, src, dst, object); err != nil {
+			return
+		}
+	}
+	if _, err = copyBackupFile(ctx, src, dst, manifest.MetaFile); err != nil {
+		return
+	}
+	logutil.Info("backup-tae", common.OperationField("restore"),
+		common.OperandField(manifest.Name),
+		common.AnyField("objects", len(manifest.Objects)),
+		common.AnyField("cost", time.Since(now)))
+	return
+}
+
+// ListBackups returns the manifests of all the backups in fs, ordered by ts
+func ListBackups(ctx context.Context, fs fileservice.FileService) (manifests []*BackupManifest, err error) {
+	entries, err := fs.List(ctx, BackupDir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir {
+			continue
+		}
+		vec := &fileservice.IOVector{
+			FilePath: BackupDir + entry.Name,
+			Entries: []fileservice.IOEntry{
+				{Offset: 0, Size: -1},
+			},
+		}
+		if err = fs.Read(ctx, vec); err != nil {
+			return
+		}
+		manifest := new(BackupManifest)
+		if err = json.Unmarshal(vec.Entries[0].Data, manifest); err != nil {
+			return nil, moerr.NewInternalError(ctx, "bad backup manifest %s: %v", entry.Name, err)
+		}
+		manifests = append(manifests, manifest)
+	}
+	sort.Slice(manifests, func(i, j int) bool {
+		return manifests[i].TS.Less(manifests[j].TS)
+	})
+	return
+}
+
+// collectBackupObjects returns the objects needed to replay the checkpoint
+// entries. required are the checkpoint files used by the replay and the
+// latest locations of the blocks alive in them. optional are the other
+// objects recorded in the checkpoints, which may have been collected.
+func collectBackupObjects(
+	ctx context.Context,
+	fs *objectio.ObjectFS,
+	entries []*checkpoint.CheckpointEntry) (required, optional []string, err error) {
+	globalIdx := 0
+	for i, entry := range entries {
+		if !entry.IsIncremental() {
+			globalIdx = i
+		}
+	}
+	blocks := make(map[types.Blockid][]string)
+	seen := make(map[string]struct{})
+	addLocations := func(id types.Blockid, locs ...[]byte) {
+		names := make([]string, 0, len(locs))
+		for _, loc := range locs {
+			if len(loc) == 0 {
+				continue
+			}
+			name := objectio.Location(loc).Name().String()
+			names = append(names, name)
+			seen[name] = struct{}{}
+		}
+		blocks[id] = names
+	}
+	dropBlocks := func(del, delTxn *containers.Batch) {
+		for i := 0; i < del.Length(); i++ {
+			rowid := del.GetVectorByName(catalog.AttrRowID).Get(i).(types.Rowid)
+			for _, attr := range []string{pkgcatalog.BlockMeta_MetaLoc, pkgcatalog.BlockMeta_DeltaLoc} {
+				if loc := delTxn.GetVectorByName(attr).Get(i).([]byte); len(loc) > 0 {
+					seen[objectio.Location(loc).Name().String()] = struct{}{}
+				}
+			}
+			delete(blocks, *rowid.GetBlockid())
+		}
+	}
+
+	for i, entry := range entries {
+		name := entry.GetLocation().Name().String()
+		if i < globalIdx {
+			optional = append(optional, name)
+			continue
+		}
+		required = append(required, name)
+		var data *logtail.CheckpointData
+		if data, err = entry.Read(ctx, fs); err != nil {
+			return
+		}
+		for _, get := range []func() (*containers.Batch, *containers.Batch, *containers.Batch, *containers.Batch){
+			data.GetBlkBatchs, data.GetDNBlkBatchs,
+		} {
+			ins, _, del, delTxn := get()
+			for j := 0; j < ins.Length(); j++ {
+				addLocations(
+					ins.GetVectorByName(pkgcatalog.BlockMeta_ID).Get(j).(types.Blockid),
+					ins.GetVectorByName(pkgcatalog.BlockMeta_MetaLoc).Get(j).([]byte),
+					ins.GetVectorByName(pkgcatalog.BlockMeta_DeltaLoc).Get(j).([]byte))
+			}
+			dropBlocks(del, delTxn)
+		}
+		data.Close()
+	}
+
+	alive := make(map[string]struct{})
+	for _, names := range blocks {
+		for _, name := range names {
+			alive[name] = struct{}{}
+		}
+	}
+	for name := range alive {
+		required = append(required, name)
+	}
+	for name := range seen {
+		if _, ok := alive[name]; !ok {
+			optional = append(optional, name)
+		}
+	}
+	sort.Strings(required[len(entries)-globalIdx:])
+	sort.Strings(optional)
+	return
+}
+
+// copyBackupFile copies the file name from src to dst. It returns false if
+// the file already exists in dst.
+func copyBackupFile(
+	ctx context.Context,
+	src, dst fileservice.FileService,
+	name string) (copied bool, err error) {
+	entry, err := src.StatFile(ctx, name)
+	if err != nil {
+		return
+	}
+	// the object is streamed from src to dst, never held in memory as a whole
+	var reader io.ReadCloser
+	vec := &fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{Offset: 0, Size: entry.Size, ReadCloserForRead: &reader},
+		},
+	}
+	if err = src.Read(ctx, vec); err != nil {
+		return
+	}
+	defer reader.Close()
+	err = dst.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{Offset: 0, Size: entry.Size, ReaderForWrite: reader},
+		},
+	})
+	if moerr.IsMoErrCode(err, moerr.ErrFileAlreadyExists) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func writeBackupManifest(ctx context.Context, fs fileservice.FileService, manifest *BackupManifest) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return fs.Write(ctx, fileservice.IOVector{
+		FilePath: BackupDir + manifest.Name,
+		Entries: []fileservice.IOEntry{
+			{Offset: 0, Size: int64(len(data)), Data: data},
+		},
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupRestore(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	bats := bat.Split(3)
+
+	backupFs := objectio.TmpNewFileservice(path.Join(tae.Dir, "backup"))
+	_, err := Backup(ctx, tae.Fs.Service, backupFs, false)
+	assert.Error(t, err)
+
+	tae.createRelAndAppend(bats[0], true)
+	tae.compactBlocks(false)
+	full, err := tae.Backup(ctx, backupFs, true, time.Second*10)
+	assert.NoError(t, err)
+	assert.Empty(t, full.Previous)
+	assert.Equal(t, len(full.Objects)+1, full.Copied)
+
+	txn, rel := tae.getRelation()
+	assert.NoError(t, rel.Append(bats[1]))
+	assert.NoError(t, txn.Commit())
+	tae.compactBlocks(false)
+	incr, err := tae.Backup(ctx, backupFs, true, time.Second*10)
+	assert.NoError(t, err)
+	assert.Equal(t, full.Name, incr.Previous)
+	assert.True(t, full.TS.Less(incr.TS))
+	assert.Less(t, incr.Copied, len(incr.Objects)+1)
+
+	// rows appended after the backup are not restored
+	txn, rel = tae.getRelation()
+	assert.NoError(t, rel.Append(bats[2]))
+	assert.NoError(t, txn.Commit())
+
+	backups, err := ListBackups(ctx, backupFs)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(backups))
+
+	restore := func(name string, rows int) {
+		dir := path.Join(tae.Dir, "restore", name)
+		fs := objectio.TmpNewFileservice(path.Join(dir, "data"))
+		_, err := Restore(ctx, backupFs, fs, name)
+		assert.NoError(t, err)
+		_, err = Restore(ctx, backupFs, fs, name)
+		assert.Error(t, err)
+
+		db, err := Open(dir, &options.Options{Fs: fs})
+		assert.NoError(t, err)
+		defer db.Close()
+		txn, rel := getRelation(t, 0, db, defaultTestDB, schema.Name)
+		checkAllColRowsByScan(t, rel, rows, true)
+		assert.NoError(t, txn.Commit())
+	}
+	restore(full.Name, 10)
+	restore("", 20)
+}
@@ -36,6 +36,9 @@ type CheckpointEntry struct {
 	state      State
 	entryType  EntryType
 	location   objectio.Location
+
+	// max lsn covered by the checkpoint, only kept in memory
+	truncateLSN uint64
 }
 
 func NewCheckpointEntry(start, end types.TS, typ EntryType) *CheckpointEntry {
@@ -74,6 +77,18 @@ func (e *CheckpointEntry) SetLocation(location objectio.Location) {
 	e.location = location
 }
 
+func (e *CheckpointEntry) SetTruncateLSN(lsn uint64) {
+	e.Lock()
+	defer e.Unlock()
+	e.truncateLSN = lsn
+}
+
+func (e *CheckpointEntry) GetTruncateLSN() uint64 {
+	e.RLock()
+	defer e.RUnlock()
+	return e.truncateLSN
+}
+
 func (e *CheckpointEntry) GetLocation() objectio.Location {
 	e.RLock()
 	defer e.RUnlock()
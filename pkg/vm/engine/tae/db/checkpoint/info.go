@@ -31,6 +31,7 @@ type RunnerReader interface {
 	CollectCheckpointsInRange(ctx context.Context, start, end types.TS) (ckpLoc string, lastEnd types.TS, err error)
 	ICKPSeekLT(ts types.TS, cnt int) []*CheckpointEntry
 	MaxLSN() uint64
+	LSNToTruncate(lsn uint64) uint64
 }
 
 func (r *runner) collectCheckpointMetadata(start, end types.TS) *containers.Batch {
@@ -77,6 +78,25 @@ func (r *runner) MaxLSNInRange(end types.TS) uint64 {
 	return r.source.GetMaxLSN(types.TS{}, end)
 }
 
+// LSNToTruncate caps lsn so that the WAL entries needed to recover any
+// timestamp in the PITR window are kept
+func (r *runner) LSNToTruncate(lsn uint64) uint64 {
+	if r.options.pitrWindow <= 0 {
+		return lsn
+	}
+	safeTS := types.BuildTS(time.Now().UTC().UnixNano()-r.options.pitrWindow.Nanoseconds(), 0)
+	truncatable := uint64(0)
+	for _, entry := range r.GetAllIncrementalCheckpoints() {
+		if entry.end.LessEq(safeTS) && entry.GetTruncateLSN() > truncatable {
+			truncatable = entry.GetTruncateLSN()
+		}
+	}
+	if truncatable < lsn {
+		return truncatable
+	}
+	return lsn
+}
+
 func (r *runner) MaxGlobalCheckpoint() *CheckpointEntry {
 	r.storage.RLock()
 	defer r.storage.RUnlock()
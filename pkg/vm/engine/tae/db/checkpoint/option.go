@@ -71,3 +71,9 @@ func WithGlobalVersionInterval(interval time.Duration) Option {
 		r.options.globalVersionInterval = interval
 	}
 }
+
+func WithPITRWindow(window time.Duration) Option {
+	return func(r *runner) {
+		r.options.pitrWindow = window
+	}
+}
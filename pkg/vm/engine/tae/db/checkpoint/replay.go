@@ -39,54 +39,21 @@ type metaFile struct {
 }
 
 func (r *runner) Replay(dataFactory catalog.DataFactory) (maxTs types.TS, err error) {
+	return r.ReplayTo(dataFactory, types.MaxTs())
+}
+
+// ReplayTo replays the latest global checkpoint ending no later than ts and the
+// incremental checkpoints following it up to ts. Checkpoints after ts are
+// ignored, so the WAL has to be replayed up to ts to recover the exact state.
+func (r *runner) ReplayTo(dataFactory catalog.DataFactory, ts types.TS) (maxTs types.TS, err error) {
 	ctx := context.Background()
-	dirs, err := r.fs.ListDir(CheckpointDir)
-	if err != nil {
-		return
-	}
-	if len(dirs) == 0 {
-		return
-	}
-	metaFiles := make([]*metaFile, 0)
 	var readDuration, applyDuration time.Duration
-	for i, dir := range dirs {
-		start, end := blockio.DecodeCheckpointMetadataFileName(dir.Name)
-		metaFiles = append(metaFiles, &metaFile{
-			start: start,
-			end:   end,
-			index: i,
-		})
-	}
-	sort.Slice(metaFiles, func(i, j int) bool {
-		return metaFiles[i].end.Less(metaFiles[j].end)
-	})
-	targetIdx := metaFiles[len(metaFiles)-1].index
-	dir := dirs[targetIdx]
-	reader, err := blockio.NewFileReader(r.fs.Service, CheckpointDir+dir.Name)
-	if err != nil {
-		return
-	}
-	bats, err := reader.LoadAllColumns(ctx, nil, common.DefaultAllocator)
-	if err != nil {
+	t0 := time.Now()
+	_, bat, err := loadLatestMetaFile(ctx, r.fs)
+	if err != nil || bat == nil {
 		return
 	}
-	bat := containers.NewBatch()
 	defer bat.Close()
-	colNames := CheckpointSchema.Attrs()
-	colTypes := CheckpointSchema.Types()
-	t0 := time.Now()
-	for i := range colNames {
-		if len(bats) == 0 {
-			continue
-		}
-		var vec containers.Vector
-		if bats[0].Vecs[i].Length() == 0 {
-			vec = containers.MakeVector(colTypes[i])
-		} else {
-			vec = containers.ToDNVector(bats[0].Vecs[i])
-		}
-		bat.AddVector(colNames[i], vec)
-	}
 	readDuration += time.Since(t0)
 	datas := make([]*logtail.CheckpointData, bat.Length())
 	defer func() {
@@ -103,6 +70,9 @@ func (r *runner) Replay(dataFactory catalog.DataFactory) (maxTs types.TS, err er
 	readfn := func(i int, prefetch bool) {
 		start := bat.GetVectorByName(CheckpointAttr_StartTS).Get(i).(types.TS)
 		end := bat.GetVectorByName(CheckpointAttr_EndTS).Get(i).(types.TS)
+		if end.Greater(ts) {
+			return
+		}
 		metaloc := objectio.Location(bat.GetVectorByName(CheckpointAttr_MetaLocation).Get(i).([]byte))
 		isIncremental := bat.GetVectorByName(CheckpointAttr_EntryType).Get(i).(bool)
 		typ := ET_Global
@@ -132,8 +102,17 @@ func (r *runner) Replay(dataFactory catalog.DataFactory) (maxTs types.TS, err er
 			}
 		}
 	}
+	if !ts.Equal(types.MaxTs()) {
+		if err = checkRecoverable(ctx, bat, ts); err != nil {
+			return
+		}
+	}
 	t0 = time.Now()
 	for i := 0; i < bat.Length(); i++ {
+		end := bat.GetVectorByName(CheckpointAttr_EndTS).Get(i).(types.TS)
+		if end.Greater(ts) {
+			continue
+		}
 		metaLoc := objectio.Location(bat.GetVectorByName(CheckpointAttr_MetaLocation).Get(i).([]byte))
 
 		err = blockio.PrefetchMeta(r.fs.Service, metaLoc)
@@ -210,3 +189,117 @@ func (r *runner) Replay(dataFactory catalog.DataFactory) (maxTs types.TS, err er
 	r.source.Init(maxTs)
 	return
 }
+
+// loadLatestMetaFile reads the checkpoint metadata file with the largest end
+// ts, which lists all the retained checkpoint entries. bat is nil if there is
+// no checkpoint yet.
+func loadLatestMetaFile(
+	ctx context.Context,
+	fs *objectio.ObjectFS) (name string, bat *containers.Batch, err error) {
+	dirs, err := fs.ListDir(CheckpointDir)
+	if err != nil {
+		return
+	}
+	if len(dirs) == 0 {
+		return
+	}
+	metaFiles := make([]*metaFile, 0)
+	for i, dir := range dirs {
+		start, end := blockio.DecodeCheckpointMetadataFileName(dir.Name)
+		metaFiles = append(metaFiles, &metaFile{
+			start: start,
+			end:   end,
+			index: i,
+		})
+	}
+	sort.Slice(metaFiles, func(i, j int) bool {
+		return metaFiles[i].end.Less(metaFiles[j].end)
+	})
+	targetIdx := metaFiles[len(metaFiles)-1].index
+	name = CheckpointDir + dirs[targetIdx].Name
+	reader, err := blockio.NewFileReader(fs.Service, name)
+	if err != nil {
+		return
+	}
+	bats, err := reader.LoadAllColumns(ctx, nil, common.DefaultAllocator)
+	if err != nil {
+		return
+	}
+	bat = containers.NewBatch()
+	colNames := CheckpointSchema.Attrs()
+	colTypes := CheckpointSchema.Types()
+	for i := range colNames {
+		if len(bats) == 0 {
+			continue
+		}
+		var vec containers.Vector
+		if bats[0].Vecs[i].Length() == 0 {
+			vec = containers.MakeVector(colTypes[i])
+		} else {
+			vec = containers.ToDNVector(bats[0].Vecs[i])
+		}
+		bat.AddVector(colNames[i], vec)
+	}
+	return
+}
+
+// LoadCheckpointEntries returns the latest checkpoint metadata file and the
+// checkpoint entries listed in it, in the order they were taken.
+func LoadCheckpointEntries(
+	ctx context.Context,
+	fs *objectio.ObjectFS) (metaFile string, entries []*CheckpointEntry, err error) {
+	metaFile, bat, err := loadLatestMetaFile(ctx, fs)
+	if err != nil || bat == nil {
+		return
+	}
+	defer bat.Close()
+	entries = make([]*CheckpointEntry, 0, bat.Length())
+	for i := 0; i < bat.Length(); i++ {
+		typ := ET_Global
+		if bat.GetVectorByName(CheckpointAttr_EntryType).Get(i).(bool) {
+			typ = ET_Incremental
+		}
+		entries = append(entries, &CheckpointEntry{
+			start:     bat.GetVectorByName(CheckpointAttr_StartTS).Get(i).(types.TS),
+			end:       bat.GetVectorByName(CheckpointAttr_EndTS).Get(i).(types.TS),
+			location:  objectio.Location(bat.GetVectorByName(CheckpointAttr_MetaLocation).Get(i).([]byte)),
+			state:     ST_Finished,
+			entryType: typ,
+		})
+	}
+	return
+}
+
+// checkRecoverable returns an error if the history before ts has been
+// collected. The state before the oldest retained global checkpoint, or
+// before the oldest incremental checkpoint if there is no global one,
+// cannot be rebuilt.
+func checkRecoverable(ctx context.Context, bat *containers.Batch, ts types.TS) error {
+	var minGlobal, minIncremental types.TS
+	hasGlobal, hasIncremental := false, false
+	for i := 0; i < bat.Length(); i++ {
+		start := bat.GetVectorByName(CheckpointAttr_StartTS).Get(i).(types.TS)
+		end := bat.GetVectorByName(CheckpointAttr_EndTS).Get(i).(types.TS)
+		if bat.GetVectorByName(CheckpointAttr_EntryType).Get(i).(bool) {
+			if !hasIncremental || start.Less(minIncremental) {
+				minIncremental = start
+			}
+			hasIncremental = true
+		} else {
+			if !hasGlobal || end.Less(minGlobal) {
+				minGlobal = end
+			}
+			hasGlobal = true
+		}
+	}
+	lowerBound := minIncremental
+	if hasGlobal {
+		lowerBound = minGlobal
+	}
+	if ts.Less(lowerBound) {
+		return moerr.NewInternalError(ctx,
+			"cannot recover to %s, the earliest recoverable ts is %s",
+			ts.ToString(), lowerBound.ToString())
+	}
+	return nil
+}
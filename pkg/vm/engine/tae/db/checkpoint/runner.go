@@ -173,6 +173,9 @@ type runner struct {
 		forceFlushTimeout       time.Duration
 		forceFlushCheckInterval time.Duration
 
+		// WAL entries newer than now-pitrWindow are not truncated
+		pitrWindow time.Duration
+
 		dirtyEntryQueueSize int
 		waitQueueSize       int
 		checkpointQueueSize int
@@ -372,6 +375,8 @@ func (r *runner) onIncrementalCheckpointEntries(items ...any) {
 	}
 
 	lsn := r.source.GetMaxLSN(entry.start, entry.end)
+	entry.SetTruncateLSN(lsn)
+	lsn = r.LSNToTruncate(lsn)
 	e, err := r.wal.RangeCheckpoint(1, lsn)
 	if err != nil {
 		panic(err)
@@ -147,6 +147,7 @@ func (r *runner) ForceIncrementalCheckpoint(end types.TS) error {
 		start = prev.end.Next()
 	}
 	entry := NewCheckpointEntry(start, end, ET_Incremental)
+	entry.SetTruncateLSN(r.source.GetMaxLSN(start, end))
 	r.storage.Lock()
 	r.storage.entries.Set(entry)
 	now := time.Now()
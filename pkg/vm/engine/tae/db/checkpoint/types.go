@@ -45,6 +45,7 @@ type Runner interface {
 	Stop()
 	EnqueueWait(any) error
 	Replay(catalog.DataFactory) (types.TS, error)
+	ReplayTo(catalog.DataFactory, types.TS) (types.TS, error)
 
 	FlushTable(ctx context.Context, dbID, tableID uint64, ts types.TS) error
 	GCByTS(ctx context.Context, ts types.TS) error
@@ -68,6 +68,8 @@ type DB struct {
 	BGScanner          wb.IHeartbeater
 	BGCheckpointRunner checkpoint.Runner
 
+	bgScanCancel context.CancelFunc
+
 	DiskCleaner *gc2.DiskCleaner
 	Pipeline    *blockio.IoPipeline
 
@@ -76,6 +78,14 @@ type DB struct {
 	DBLocker io.Closer
 
 	Closed *atomic.Value
+
+	// RestoreTS is not empty if the DB was opened by OpenAt
+	RestoreTS types.TS
+}
+
+// IsRestored returns true if the DB is a read-only view restored to RestoreTS
+func (db *DB) IsRestored() bool {
+	return !db.RestoreTS.IsEmpty()
 }
 
 func (db *DB) FlushTable(
@@ -104,7 +114,7 @@ func (db *DB) ForceCheckpoint(
 	if err = db.BGCheckpointRunner.ForceIncrementalCheckpoint(ts); err != nil {
 		return err
 	}
-	lsn := db.BGCheckpointRunner.MaxLSNInRange(ts)
+	lsn := db.BGCheckpointRunner.LSNToTruncate(db.BGCheckpointRunner.MaxLSNInRange(ts))
 	_, err = db.Wal.RangeCheckpoint(1, lsn)
 	logutil.Debugf("[Force Checkpoint] takes %v", time.Since(t0))
 	return err
@@ -158,15 +168,20 @@ func (db *DB) Close() error {
 		panic(err)
 	}
 	db.Closed.Store(ErrClosed)
-	db.GCManager.Stop()
-	db.BGScanner.Stop()
-	db.BGCheckpointRunner.Stop()
+	if !db.IsRestored() {
+		db.GCManager.Stop()
+		db.bgScanCancel()
+		db.BGScanner.Stop()
+		db.BGCheckpointRunner.Stop()
+	}
 	db.Scheduler.Stop()
 	db.TxnMgr.Stop()
 	db.LogtailMgr.Stop()
 	db.Wal.Close()
 	db.Opts.Catalog.Close()
-	db.DiskCleaner.Stop()
+	if !db.IsRestored() {
+		db.DiskCleaner.Stop()
+	}
 	db.TransferTable.Close()
 	return db.DBLocker.Close()
 }
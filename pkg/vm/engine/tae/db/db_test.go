@@ -32,7 +32,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
@@ -4233,10 +4235,10 @@ func TestBlockRead(t *testing.T) {
 	assert.NoError(t, err)
 	infos := make([][]*pkgcatalog.BlockInfo, 0)
 	infos = append(infos, []*pkgcatalog.BlockInfo{info})
-	err = blockio.BlockPrefetch(colIdxs, fs, infos)
+	err = blockio.BlockPrefetch(context.Background(), colIdxs, fs, infos)
 	assert.NoError(t, err)
 	b1, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		beforeDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
@@ -4244,13 +4246,13 @@ func TestBlockRead(t *testing.T) {
 	assert.Equal(t, 20, b1.Vecs[0].Length())
 
 	b2, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		afterFirstDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
 	assert.Equal(t, 19, b2.Vecs[0].Length())
 	b3, err := blockio.BlockReadInner(
-		context.Background(), info, colIdxs, colTyps,
+		context.Background(), info, colIdxs, colTyps, nil,
 		afterSecondDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
@@ -4261,7 +4263,7 @@ func TestBlockRead(t *testing.T) {
 	b4, err := blockio.BlockReadInner(
 		context.Background(), info,
 		[]uint16{2},
-		[]types.Type{types.T_Rowid.ToType()},
+		[]types.Type{types.T_Rowid.ToType()}, nil,
 		afterSecondDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
@@ -4273,7 +4275,7 @@ func TestBlockRead(t *testing.T) {
 	b5, err := blockio.BlockReadInner(
 		context.Background(), info,
 		[]uint16{2},
-		[]types.Type{types.T_Rowid.ToType()},
+		[]types.Type{types.T_Rowid.ToType()}, nil,
 		afterSecondDel, fs, pool, nil,
 	)
 	assert.NoError(t, err)
@@ -6426,3 +6428,52 @@ func TestCommitS3Blocks(t *testing.T) {
 		assert.NoError(t, txn.Commit())
 	}
 }
+
+func TestDeleteExpiredBlocks(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(15, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	cstr := new(engine.ConstraintDef)
+	require.NoError(t, cstr.UnmarshalBinary(schema.Constraint))
+	cstr.Cts = append(cstr.Cts, &engine.TTLDef{
+		Ttl: &plan.TTLDef{ColName: "mock_14", ExpireSeconds: 3600},
+	})
+	var err error
+	schema.Constraint, err = cstr.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, "mock_14", schema.GetTTL().ColName)
+	tae.bindSchema(schema)
+
+	// mocked timestamps are near zero, all the rows are expired
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+	tae.compactBlocks(false)
+	tae.checkRowsByScan(30, false)
+
+	op := newMergeTaskBuiler(context.Background(), tae.DB)
+	require.NoError(t, op.PreExecute())
+	require.NoError(t, tae.Catalog.RecurLoop(op))
+	require.NoError(t, op.PostExecute())
+	// the zonemaps of the checked objects are kept for the next scans
+	require.NotEmpty(t, op.ttlCache.objects)
+
+	testutils.WaitExpect(4000, func() bool {
+		txn, rel := tae.getRelation()
+		defer func() { _ = txn.Commit() }()
+		return !rel.MakeBlockIt().Valid()
+	})
+	tae.checkRowsByScan(0, false)
+
+	// the deleted objects are removed from the cache by the next scan
+	require.NoError(t, op.PreExecute())
+	require.NoError(t, tae.Catalog.RecurLoop(op))
+	require.NoError(t, op.PostExecute())
+	require.Empty(t, op.ttlCache.objects)
+}
@@ -46,6 +46,10 @@ const (
 )
 
 func Open(dirname string, opts *options.Options) (db *DB, err error) {
+	return open(dirname, opts, types.TS{})
+}
+
+func open(dirname string, opts *options.Options, restoreTS types.TS) (db *DB, err error) {
 	dbLocker, err := createDBLock(dirname)
 
 	logutil.Info("open-tae", common.OperationField("Start"),
@@ -82,6 +86,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		IndexCache: indexCache,
 		Fs:         fs,
 		Closed:     new(atomic.Value),
+		RestoreTS:  restoreTS,
 	}
 
 	switch opts.LogStoreT {
@@ -125,12 +130,21 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 		checkpoint.WithMinCount(int(opts.CheckpointCfg.MinCount)),
 		checkpoint.WithMinIncrementalInterval(opts.CheckpointCfg.IncrementalInterval),
 		checkpoint.WithGlobalMinCount(int(opts.CheckpointCfg.GlobalMinCount)),
-		checkpoint.WithGlobalVersionInterval(opts.CheckpointCfg.GlobalVersionInterval))
+		checkpoint.WithGlobalVersionInterval(opts.CheckpointCfg.GlobalVersionInterval),
+		checkpoint.WithPITRWindow(opts.GCCfg.PITRWindow))
 
 	now := time.Now()
-	checkpointed, err := db.BGCheckpointRunner.Replay(dataFactory)
-	if err != nil {
-		panic(err)
+	var checkpointed types.TS
+	if db.IsRestored() {
+		checkpointed, err = db.BGCheckpointRunner.ReplayTo(dataFactory, restoreTS)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		checkpointed, err = db.BGCheckpointRunner.Replay(dataFactory)
+		if err != nil {
+			panic(err)
+		}
 	}
 	logutil.Info("open-tae", common.OperationField("replay"),
 		common.OperandField("checkpoints"),
@@ -146,9 +160,20 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 
 	db.DBLocker, dbLocker = dbLocker, nil
 
+	// A restored instance is a read-only view of the history. Nothing may be
+	// flushed, checkpointed or collected, and the WAL is not started, so it is
+	// never checkpointed or truncated. Otherwise the newer state of the source
+	// storage would be corrupted.
+	if db.IsRestored() {
+		return
+	}
+
 	// Init timed scanner
+	// the IO of the running scan is canceled when the DB is closed
+	scanCtx, scanCancel := context.WithCancel(context.Background())
+	db.bgScanCancel = scanCancel
 	scanner := NewDBScanner(db, nil)
-	mergeOp := newMergeTaskBuiler(db)
+	mergeOp := newMergeTaskBuiler(scanCtx, db)
 	scanner.RegisterOp(mergeOp)
 	db.Wal.Start()
 	db.BGCheckpointRunner.Start()
@@ -163,7 +188,11 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	db.DiskCleaner.AddChecker(
 		func(item any) bool {
 			checkpoint := item.(*checkpoint.CheckpointEntry)
-			ts := types.BuildTS(time.Now().UTC().UnixNano()-int64(opts.GCCfg.GCTTL), 0)
+			ttl := opts.GCCfg.GCTTL
+			if ttl < opts.GCCfg.PITRWindow {
+				ttl = opts.GCCfg.PITRWindow
+			}
+			ts := types.BuildTS(time.Now().UTC().UnixNano()-int64(ttl), 0)
 			return !checkpoint.GetEnd().GreaterEq(ts)
 		})
 	// Init gc manager at last
@@ -195,7 +224,11 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 				if consumed == nil {
 					return nil
 				}
-				return db.BGCheckpointRunner.GCByTS(ctx, consumed.GetEnd())
+				ts, ok := db.pitrSafeGCTS(consumed.GetEnd())
+				if !ok {
+					return nil
+				}
+				return db.BGCheckpointRunner.GCByTS(ctx, ts)
 			}),
 		gc.WithCronJob(
 			"catalog-gc",
@@ -208,7 +241,11 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 				if consumed == nil {
 					return nil
 				}
-				db.Catalog.GCByTS(ctx, consumed.GetEnd())
+				ts, ok := db.pitrSafeGCTS(consumed.GetEnd())
+				if !ok {
+					return nil
+				}
+				db.Catalog.GCByTS(ctx, ts)
 				return nil
 			}),
 		gc.WithCronJob(
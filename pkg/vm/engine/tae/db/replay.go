@@ -79,6 +79,9 @@ func (replayer *Replayer) Replay() {
 	if err := replayer.db.Wal.Replay(replayer.OnReplayEntry); err != nil {
 		panic(err)
 	}
+	if replayer.db.IsRestored() {
+		return
+	}
 	if _, err := replayer.db.Wal.Checkpoint(replayer.staleIndexes); err != nil {
 		panic(err)
 	}
@@ -124,6 +127,9 @@ func (replayer *Replayer) OnReplayTxn(cmd txnif.TxnCmd, walIdx *wal.Index, lsn u
 	if txnCmd.PrepareTS.LessEq(replayer.maxTs) {
 		return
 	}
+	if replayer.db.IsRestored() && txnCmd.PrepareTS.Greater(replayer.db.RestoreTS) {
+		return
+	}
 	txn := txnimpl.MakeReplayTxn(replayer.db.TxnMgr, txnCmd.TxnCtx, lsn,
 		txnCmd, replayer, replayer.db.Catalog, replayer.DataFactory, replayer.db.Wal)
 	if err = replayer.db.TxnMgr.OnReplayTxn(txn); err != nil {
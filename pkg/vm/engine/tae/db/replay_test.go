@@ -28,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
 	"github.com/panjf2000/ants/v2"
@@ -1366,3 +1367,123 @@ func TestReplayDatabaseEntry(t *testing.T) {
 	assert.Equal(t, datypStr, dbEntry.GetDatType())
 	assert.Equal(t, createSqlStr, dbEntry.GetCreateSql())
 }
+
+func TestReplayToTS(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := new(options.Options)
+	options.WithPITRWindow(time.Hour)(opts)
+	tae := newTestEngine(t, opts)
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	bats := bat.Split(3)
+
+	tae.createRelAndAppend(bats[0], true)
+	tae.compactBlocks(false)
+	ckpTS := tae.TxnMgr.StatMaxCommitTS()
+	assert.NoError(t, tae.incrementalCheckpoint(ckpTS, false, true, false))
+
+	txn, rel := tae.getRelation()
+	assert.NoError(t, rel.Append(bats[1]))
+	assert.NoError(t, txn.Commit())
+	restoreTS := txn.GetCommitTS()
+
+	txn, rel = tae.getRelation()
+	assert.NoError(t, rel.Append(bats[2]))
+	assert.NoError(t, txn.Commit())
+	tae.Close()
+
+	src, err := OpenAt(tae.Dir, nil, restoreTS)
+	assert.NoError(t, err)
+	assert.True(t, src.IsRestored())
+	txn, rel = getDefaultRelation(t, src, schema.Name)
+	checkAllColRowsByScan(t, rel, 20, true)
+	assert.NoError(t, txn.Commit())
+	assert.NoError(t, src.Close())
+
+	// materialize the history as a new cluster
+	dst, err := Open(testutils.InitTestEnv(ModuleName+"_dst", t), nil)
+	assert.NoError(t, err)
+	assert.NoError(t, RestoreCluster(context.Background(), tae.Dir, nil, dst, ckpTS))
+	txn, rel = getDefaultRelation(t, dst, schema.Name)
+	checkAllColRowsByScan(t, rel, 10, true)
+	assert.NoError(t, txn.Commit())
+	// the logservice WAL of dst only keeps the entries of its PITR window
+	srcOpts := &options.Options{LogStoreT: options.LogstoreLogservice}
+	assert.Error(t, RestoreCluster(context.Background(), tae.Dir, srcOpts, dst, ckpTS))
+	assert.NoError(t, dst.Close())
+
+	// the source is left untouched
+	tae.DB, err = Open(tae.Dir, nil)
+	assert.NoError(t, err)
+	txn, rel = tae.getRelation()
+	checkAllColRowsByScan(t, rel, 30, true)
+	assert.NoError(t, txn.Commit())
+	assert.NoError(t, tae.Close())
+}
+
+func TestRestoreDatabase(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+	opts := new(options.Options)
+	options.WithPITRWindow(time.Hour)(opts)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	bats := bat.Split(3)
+
+	tae.createRelAndAppend(bats[0], true)
+	tae.compactBlocks(false)
+	txn, rel := tae.getRelation()
+	assert.NoError(t, rel.Append(bats[1]))
+	assert.NoError(t, txn.Commit())
+	ts := txn.GetCommitTS()
+
+	assert.NoError(t, tae.deleteAll(true))
+	tae.truncate()
+	txn, rel = tae.getRelation()
+	assert.NoError(t, rel.Append(bats[2]))
+	assert.NoError(t, txn.Commit())
+
+	assert.NoError(t, tae.RestoreDatabase(ctx, defaultTestDB, "db_restored", ts))
+	txn, rel = getRelation(t, 0, tae.DB, "db_restored", schema.Name)
+	checkAllColRowsByScan(t, rel, 20, true)
+	assert.NoError(t, txn.Commit())
+	tae.checkRowsByScan(10, true)
+
+	// the target exists
+	assert.Error(t, tae.RestoreDatabase(ctx, defaultTestDB, "db_restored", ts))
+	// out of the pitr window
+	old := types.BuildTS(time.Now().Add(-2*time.Hour).UnixNano(), 0)
+	assert.Error(t, tae.RestoreDatabase(ctx, defaultTestDB, "db_old", old))
+}
+
+func TestPITRSafeGCTS(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	opts := new(options.Options)
+	options.WithPITRWindow(time.Hour)(opts)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+
+	// neither the checkpoints nor the catalog are gced without a global
+	// checkpoint older than the window
+	now := types.BuildTS(time.Now().UnixNano(), 0)
+	_, ok := tae.pitrSafeGCTS(now)
+	assert.False(t, ok)
+
+	tae.Opts.GCCfg.PITRWindow = 0
+	ts, ok := tae.pitrSafeGCTS(now)
+	assert.True(t, ok)
+	assert.Equal(t, now, ts)
+}
@@ -0,0 +1,261 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+)
+
+// OpenAt opens the storage in dirname as a read-only view of its state at ts.
+// The latest checkpoints ending no later than ts are replayed, followed by the
+// WAL entries prepared no later than ts. The returned DB runs no flush,
+// checkpoint or GC job, so the source storage is left untouched. The source
+// must not be opened by others at the same time.
+func OpenAt(dirname string, opts *options.Options, ts types.TS) (db *DB, err error) {
+	if ts.IsEmpty() {
+		return nil, moerr.NewInvalidInputNoCtx("restore ts is empty")
+	}
+	return open(dirname, opts, ts)
+}
+
+// RestoreCluster materializes the state of the storage in srcDir at ts into
+// dst, which is usually a newly created instance. All the user databases and
+// the user tables in mo_catalog are copied. Table and database ids are
+// allocated by dst.
+//
+// With the logservice WAL, the source replays the log service shard of
+// srcOpts, which is usually the one of dst. dst truncates its WAL after
+// checkpoints, so ts has to be in the PITR window of dst, where the entries
+// after the checkpoints ending no later than ts are kept.
+func RestoreCluster(
+	ctx context.Context,
+	srcDir string,
+	srcOpts *options.Options,
+	dst *DB,
+	ts types.TS) (err error) {
+	now := time.Now()
+	if srcOpts != nil && srcOpts.LogStoreT == options.LogstoreLogservice {
+		if err = dst.checkPITRWindow(ctx, ts); err != nil {
+			return
+		}
+	}
+	src, err := OpenAt(srcDir, srcOpts, ts)
+	if err != nil {
+		return
+	}
+	defer src.Close()
+
+	srcTxn, err := src.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	defer srcTxn.Rollback()
+	dstTxn, err := dst.StartTxn(nil)
+	if err != nil {
+		return
+	}
+
+	ids := make([]uint64, 0)
+	it := src.Catalog.MakeDBIt(true)
+	for ; it.Valid(); it.Next() {
+		ids = append(ids, it.Get().GetPayload().GetID())
+	}
+	for _, id := range ids {
+		var database handle.Database
+		if database, err = srcTxn.GetDatabaseByID(id); err != nil {
+			if moerr.IsMoErrCode(err, moerr.OkExpectedEOB) ||
+				moerr.IsMoErrCode(err, moerr.ErrBadDB) {
+				err = nil
+				continue
+			}
+			break
+		}
+		meta := database.GetMeta().(*catalog.DBEntry)
+		if meta.IsSystemDB() {
+			var sysDB handle.Database
+			if sysDB, err = dstTxn.GetDatabaseByID(pkgcatalog.MO_CATALOG_ID); err != nil {
+				break
+			}
+			err = copyRelations(database, sysDB)
+		} else {
+			err = copyDatabase(database, dstTxn, meta.GetName())
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err != nil {
+		_ = dstTxn.Rollback()
+		return
+	}
+	if err = dstTxn.Commit(); err != nil {
+		return
+	}
+	logutil.Info("restore-tae", common.OperationField("restore-cluster"),
+		common.OperandField(srcDir),
+		common.AnyField("ts", ts.ToString()),
+		common.AnyField("cost", time.Since(now)))
+	return
+}
+
+// RestoreDatabase creates the database dstName with the content of the
+// database srcName at ts. ts has to be in the PITR window.
+func (db *DB) RestoreDatabase(
+	ctx context.Context,
+	srcName, dstName string,
+	ts types.TS) (err error) {
+	if err = db.checkPITRWindow(ctx, ts); err != nil {
+		return
+	}
+	now := time.Now()
+	srcTxn, err := db.TxnMgr.GetOrCreateTxnWithMeta(nil, db.TxnMgr.IdAlloc.Alloc(), ts)
+	if err != nil {
+		return
+	}
+	defer srcTxn.Rollback()
+	database, err := srcTxn.GetDatabase(srcName)
+	if err != nil {
+		return
+	}
+	dstTxn, err := db.StartTxn(nil)
+	if err != nil {
+		return
+	}
+	if err = copyDatabase(database, dstTxn, dstName); err != nil {
+		_ = dstTxn.Rollback()
+		return
+	}
+	if err = dstTxn.Commit(); err != nil {
+		return
+	}
+	logutil.Info("restore-tae", common.OperationField("restore-database"),
+		common.OperandField(srcName),
+		common.AnyField("to", dstName),
+		common.AnyField("ts", ts.ToString()),
+		common.AnyField("cost", time.Since(now)))
+	return
+}
+
+func (db *DB) checkPITRWindow(ctx context.Context, ts types.TS) error {
+	window := db.Opts.GCCfg.PITRWindow
+	if window <= 0 {
+		return moerr.NewInternalError(ctx, "pitr is not enabled")
+	}
+	now := time.Now().UTC().UnixNano()
+	if ts.Physical() < now-window.Nanoseconds() {
+		return moerr.NewInternalError(ctx, "ts %s is out of the pitr window %v", ts.ToString(), window)
+	}
+	if ts.Physical() > now {
+		return moerr.NewInternalError(ctx, "ts %s is in the future", ts.ToString())
+	}
+	return nil
+}
+
+// pitrSafeGCTS caps the checkpoint gc ts, so that a global checkpoint ending
+// before the PITR window is kept as the base to recover any ts in the window.
+// It returns false if there is no such checkpoint yet.
+func (db *DB) pitrSafeGCTS(ts types.TS) (types.TS, bool) {
+	window := db.Opts.GCCfg.PITRWindow
+	if window <= 0 {
+		return ts, true
+	}
+	safeTS := types.BuildTS(time.Now().UTC().UnixNano()-window.Nanoseconds(), 0)
+	var base *checkpoint.CheckpointEntry
+	for _, global := range db.BGCheckpointRunner.GetAllGlobalCheckpoints() {
+		if global.GetEnd().LessEq(safeTS) {
+			base = global
+		}
+	}
+	if base == nil {
+		return ts, false
+	}
+	if end := base.GetEnd().Prev(); end.Less(ts) {
+		ts = end
+	}
+	return ts, true
+}
+
+func copyDatabase(src handle.Database, dstTxn txnif.AsyncTxn, name string) (err error) {
+	meta := src.GetMeta().(*catalog.DBEntry)
+	dstTxn.BindAccessInfo(meta.GetTenantID(), meta.GetUserID(), meta.GetRoleID())
+	dst, err := dstTxn.CreateDatabase(name, meta.GetCreateSql(), meta.GetDatType())
+	if err != nil {
+		return
+	}
+	return copyRelations(src, dst)
+}
+
+func copyRelations(src, dst handle.Database) (err error) {
+	it := src.MakeRelationIt()
+	for ; it.Valid(); it.Next() {
+		rel := it.GetRelation()
+		if rel.GetMeta().(*catalog.TableEntry).IsVirtual() {
+			continue
+		}
+		schema := rel.Schema().(*catalog.Schema).Clone()
+		var dstRel handle.Relation
+		if dstRel, err = dst.CreateRelation(schema); err != nil {
+			return
+		}
+		if err = copyRows(rel, dstRel); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func copyRows(src, dst handle.Relation) (err error) {
+	schema := src.Schema().(*catalog.Schema)
+	attrs := schema.Attrs()
+	it := src.MakeBlockIt()
+	for ; it.Valid(); it.Next() {
+		view, err := it.GetBlock().GetColumnDataByNames(attrs)
+		if err != nil {
+			return err
+		}
+		if view == nil {
+			continue
+		}
+		bat := containers.NewBatch()
+		for _, attr := range attrs {
+			bat.AddVector(attr, view.GetColumnData(schema.GetColIdx(attr)))
+		}
+		rows := bat.CloneWindow(0, bat.Length())
+		rows.Deletes = view.DeleteMask
+		view.Close()
+		rows.Compact()
+		if rows.Length() > 0 {
+			err = dst.Append(rows)
+		}
+		rows.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return
+}
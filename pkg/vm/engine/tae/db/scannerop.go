@@ -16,6 +16,7 @@ package db
 
 import (
 	"container/heap"
+	"context"
 	"fmt"
 	"sort"
 	"sync/atomic"
@@ -25,7 +26,10 @@ import (
 	"github.com/shirou/gopsutil/v3/mem"
 	"go.uber.org/zap"
 
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
@@ -174,6 +178,129 @@ func (d *deletableSegBuilder) finish() []*catalog.SegmentEntry {
 	return ret
 }
 
+// ttlChecker founds out the blocks whose rows are all expired by the table level
+// ttl. These blocks are deleted as a whole, no need to merge them.
+type ttlChecker struct {
+	ctx    context.Context
+	fs     *objectio.ObjectFS
+	cache  *ttlZoneMapCache
+	seqnum uint16
+	cutoff any
+}
+
+// newTTLChecker returns nil if the table has no ttl
+func newTTLChecker(
+	ctx context.Context,
+	fs *objectio.ObjectFS,
+	cache *ttlZoneMapCache,
+	schema *catalog.Schema,
+) *ttlChecker {
+	ttl := schema.GetTTL()
+	if ttl == nil {
+		return nil
+	}
+	def := schema.ColDefs[schema.GetColIdx(ttl.ColName)]
+	cutoff := pkgcatalog.TTLCutoff(ttl, def.Type.Oid, time.Now())
+	if cutoff == nil {
+		return nil
+	}
+	return &ttlChecker{
+		ctx:    ctx,
+		fs:     fs,
+		cache:  cache,
+		seqnum: def.SeqNum,
+		cutoff: cutoff,
+	}
+}
+
+// blockExpired checks the max value in the zonemap of the ttl column
+func (c *ttlChecker) blockExpired(entry *catalog.BlockEntry) bool {
+	loc := entry.GetMetaLoc()
+	if loc.IsEmpty() {
+		return false
+	}
+	maxes, err := c.cache.get(c.ctx, c.fs, loc, c.seqnum)
+	if err != nil {
+		return false
+	}
+	if id := int(loc.ID()); id < len(maxes) && maxes[id] != nil {
+		return pkgcatalog.TTLExpired(c.cutoff, maxes[id])
+	}
+	return false
+}
+
+// ttlZoneMapCache keeps the max values of the ttl column of the blocks of the
+// checked objects. Objects are immutable, so the meta of an object is read only
+// once, and only the cutoff moves between the scans. The objects not met in a
+// scan are removed from the cache.
+type ttlZoneMapCache struct {
+	objects map[objectio.ObjectNameShort]*ttlObject
+	round   uint64
+}
+
+type ttlObject struct {
+	seqnum uint16
+	round  uint64
+	// the max values of the ttl column of the blocks, nil if it is unknown
+	maxes []any
+}
+
+func newTTLZoneMapCache() *ttlZoneMapCache {
+	return &ttlZoneMapCache{
+		objects: make(map[objectio.ObjectNameShort]*ttlObject),
+	}
+}
+
+func (c *ttlZoneMapCache) get(
+	ctx context.Context,
+	fs *objectio.ObjectFS,
+	loc objectio.Location,
+	seqnum uint16,
+) ([]any, error) {
+	name := *loc.ShortName()
+	if obj, ok := c.objects[name]; ok && obj.seqnum == seqnum {
+		obj.round = c.round
+		return obj.maxes, nil
+	}
+	reader, err := blockio.NewObjectReader(fs.Service, loc)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := reader.LoadObjectMeta(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	obj := &ttlObject{
+		seqnum: seqnum,
+		round:  c.round,
+		maxes:  make([]any, meta.BlockCount()),
+	}
+	for i := range obj.maxes {
+		// the ttl column may be added after the block was written
+		col, err := meta.GetBlockMeta(uint32(i)).GetColumn(seqnum)
+		if err != nil {
+			continue
+		}
+		if zm := col.ZoneMap(); zm.IsInited() {
+			obj.maxes[i] = zm.GetMax()
+		}
+	}
+	c.objects[name] = obj
+	return obj.maxes, nil
+}
+
+func (c *ttlZoneMapCache) nextRound() {
+	c.round++
+}
+
+func (c *ttlZoneMapCache) pruneStale() {
+	for name, obj := range c.objects {
+		if obj.round != c.round {
+			delete(c.objects, name)
+		}
+	}
+}
+
 type stat struct {
 	ttl          time.Time
 	lastTotalRow int
@@ -258,7 +385,8 @@ func (ml *mergeLimiter) String() string {
 }
 
 type MergeTaskBuilder struct {
-	db *DB
+	ctx context.Context
+	db  *DB
 	*catalog.LoopProcessor
 	runCnt      int
 	tableRowCnt int
@@ -266,11 +394,17 @@ type MergeTaskBuilder struct {
 	limiter     *mergeLimiter
 	segBuilder  *deletableSegBuilder
 	blkBuilder  *mergedBlkBuilder
+	ttl         *ttlChecker
+	ttlCache    *ttlZoneMapCache
+	segSorted   bool
+	expiredBlks []*catalog.BlockEntry
 }
 
-func newMergeTaskBuiler(db *DB) *MergeTaskBuilder {
+func newMergeTaskBuiler(ctx context.Context, db *DB) *MergeTaskBuilder {
 	op := &MergeTaskBuilder{
+		ctx:           ctx,
 		db:            db,
+		ttlCache:      newTTLZoneMapCache(),
 		LoopProcessor: new(catalog.LoopProcessor),
 		limiter: &mergeLimiter{
 			stats:                make(map[uint64]*stat),
@@ -297,6 +431,7 @@ func (s *MergeTaskBuilder) trySchedMergeTask() {
 	if s.tid == 0 {
 		return
 	}
+	s.trySchedDelExpiredBlks()
 	// compactable blks
 	mergedBlks := s.blkBuilder.finish()
 	// deletable segs
@@ -351,14 +486,40 @@ func (s *MergeTaskBuilder) trySchedMergeTask() {
 	}
 }
 
+func (s *MergeTaskBuilder) trySchedDelExpiredBlks() {
+	if len(s.expiredBlks) == 0 {
+		return
+	}
+	delBlks := make([]*catalog.BlockEntry, len(s.expiredBlks))
+	copy(delBlks, s.expiredBlks)
+	scopes := make([]common.ID, len(delBlks))
+	for i, blk := range delBlks {
+		scopes[i] = *blk.AsCommonID()
+	}
+	factory := func(ctx *tasks.Context, txn txnif.AsyncTxn) (tasks.Task, error) {
+		return jobs.NewDelBlkTask(ctx, txn, delBlks), nil
+	}
+	_, err := s.db.Scheduler.ScheduleMultiScopedTxnTask(nil, tasks.DataCompactionTask, scopes, factory)
+	if err != nil {
+		if err != tasks.ErrScheduleScopeConflict {
+			logutil.Infof("[Mergeblocks] Schedule del expired blk errinfo=%v", err)
+		}
+		return
+	}
+	logutil.Infof("[Mergeblocks] Scheduled | del %d expired blk", len(delBlks))
+}
+
 func (s *MergeTaskBuilder) resetForTable(tid uint64) {
 	s.tableRowCnt = 0
 	s.tid = tid
+	s.ttl = nil
+	s.expiredBlks = s.expiredBlks[:0]
 	s.segBuilder.reset()
 	s.blkBuilder.reset()
 }
 
 func (s *MergeTaskBuilder) PreExecute() error {
+	s.ttlCache.nextRound()
 	// clean stale stats for every 10min (default)
 	if s.runCnt++; s.runCnt >= 120 {
 		s.runCnt = 0
@@ -385,6 +546,7 @@ func (s *MergeTaskBuilder) PreExecute() error {
 func (s *MergeTaskBuilder) PostExecute() error {
 	s.trySchedMergeTask()
 	s.resetForTable(0)
+	s.ttlCache.pruneStale()
 	if cnt := atomic.LoadInt32(&s.limiter.activeMergeCount); cnt > 0 {
 		logutil.Infof("Mergeblocks current big active task: %d", cnt)
 	}
@@ -396,12 +558,19 @@ func (s *MergeTaskBuilder) onTable(tableEntry *catalog.TableEntry) (err error) {
 	s.resetForTable(tableEntry.ID)
 	if !tableEntry.IsActive() {
 		err = moerr.GetOkStopCurrRecur()
+		return
 	}
+	s.ttl = newTTLChecker(s.ctx, s.db.Fs, s.ttlCache, tableEntry.GetLastestSchema())
 	return
 }
 
 func (s *MergeTaskBuilder) onSegment(segmentEntry *catalog.SegmentEntry) (err error) {
-	if !segmentEntry.IsActive() || (!segmentEntry.IsAppendable() && segmentEntry.IsSorted()) {
+	if !segmentEntry.IsActive() {
+		return moerr.GetOkStopCurrRecur()
+	}
+	// sorted segs are not merged again, but their blocks may be expired by ttl
+	s.segSorted = !segmentEntry.IsAppendable() && segmentEntry.IsSorted()
+	if s.segSorted && s.ttl == nil {
 		return moerr.GetOkStopCurrRecur()
 	}
 	// handle appendable segs
@@ -432,8 +601,16 @@ func (s *MergeTaskBuilder) onBlock(entry *catalog.BlockEntry) (err error) {
 	}
 
 	entry.RUnlock()
+	expired := s.ttl != nil && s.ttl.blockExpired(entry)
 	rows := entry.GetBlockData().Rows()
 	entry.RLock()
+	if expired {
+		s.expiredBlks = append(s.expiredBlks, entry)
+		return nil
+	}
+	if s.segSorted {
+		return nil
+	}
 	s.tableRowCnt += rows
 	s.blkBuilder.push(&mItem{row: rows, entry: entry})
 	return nil
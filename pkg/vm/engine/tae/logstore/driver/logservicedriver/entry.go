@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"io"
 	"math"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -349,3 +350,39 @@ func (r *recordEntry) readEntry(lsn uint64) *entry.Entry {
 	e.Lsn = lsn
 	return e
 }
+
+// DecodeRecord decodes the entries of a user record read from the
+// logservice and calls fn on them in the order they were appended.
+// Records written by the replay are skipped.
+func DecodeRecord(r logservice.LogRecord, fn func(e *entry.Entry)) error {
+	payload := r.Payload()
+	if len(payload) < 4 {
+		return moerr.NewInternalErrorNoCtx("bad wal record size %d", len(payload))
+	}
+	head := objectio.DecodeIOEntryHeader(payload[:4])
+	if head.Type != IOET_WALRecord {
+		return moerr.NewInternalErrorNoCtx("bad wal record type %d", head.Type)
+	}
+	v, err := objectio.GetIOEntryCodec(*head).Decode(payload[4:])
+	if err != nil {
+		return err
+	}
+	record := v.(*baseEntry)
+	if record.meta.metaType != TNormal {
+		return nil
+	}
+	offsets := make([]uint64, 0, len(record.meta.addr))
+	for _, offset := range record.meta.addr {
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	for _, offset := range offsets {
+		e := entry.NewEmptyEntry()
+		if _, err = e.ReadFrom(bytes.NewBuffer(record.payload[offset:])); err != nil {
+			return err
+		}
+		fn(e)
+		e.Entry.Free()
+	}
+	return nil
+}
@@ -53,6 +53,9 @@ type CheckpointCfg struct {
 type GCCfg struct {
 	GCTTL          time.Duration
 	ScanGCInterval time.Duration
+	// PITRWindow is how long checkpoints, WAL entries and the objects they
+	// reference are retained for point-in-time recovery. 0 disables PITR.
+	PITRWindow time.Duration
 }
 
 type CatalogCfg struct {
@@ -117,6 +117,15 @@ func WithDisableGCCatalog() func(*Options) {
 	}
 }
 
+func WithPITRWindow(window time.Duration) func(*Options) {
+	return func(o *Options) {
+		if o.GCCfg == nil {
+			o.GCCfg = new(GCCfg)
+		}
+		o.GCCfg.PITRWindow = window
+	}
+}
+
 func (o *Options) FillDefaults(dirname string) *Options {
 	if o == nil {
 		o = &Options{}
@@ -551,6 +551,13 @@ func (h *Handle) HandlePreCommitWrite(
 					cmd.DatabaseId,
 					cmd.TableId,
 					string(cmd.Constraint))
+				if len(cmd.AlterTable) > 0 {
+					req = new(apipb.AlterTableReq)
+					if err = req.Unmarshal(cmd.AlterTable); err != nil {
+						return err
+					}
+					req.DbId, req.TableId = cmd.DatabaseId, cmd.TableId
+				}
 				if err = h.CacheTxnRequest(ctx, meta, req, nil); err != nil {
 					return err
 				}
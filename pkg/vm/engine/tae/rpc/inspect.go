@@ -15,15 +15,23 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/spf13/cobra"
 )
 
@@ -90,6 +98,149 @@ func runCatalog(arg *catalogArg, respWriter io.Writer) {
 	}
 }
 
+type restoreArg struct {
+	ctx     *inspectContext
+	srcName string
+	dstName string
+	ts      types.TS
+}
+
+func (c *restoreArg) fromCommand(cmd *cobra.Command) (err error) {
+	c.ctx = cmd.Flag("ictx").Value.(*inspectContext)
+	c.srcName, _ = cmd.Flags().GetString("db")
+	c.dstName, _ = cmd.Flags().GetString("name")
+	if c.srcName == "" || c.dstName == "" {
+		return moerr.NewInvalidInputNoCtx("both source and target database are required")
+	}
+	ts, _ := cmd.Flags().GetString("ts")
+	c.ts, err = parseRestoreTS(ts)
+	return
+}
+
+// parseRestoreTS accepts an UTC datetime or a timestamp in physical-logical format
+func parseRestoreTS(s string) (types.TS, error) {
+	if t, err := time.ParseInLocation("2006-01-02 15:04:05.999999", s, time.UTC); err == nil {
+		return types.BuildTS(t.UnixNano(), 0), nil
+	}
+	parts := strings.Split(s, "-")
+	if len(parts) == 2 {
+		physical, err1 := strconv.ParseInt(parts[0], 10, 64)
+		logical, err2 := strconv.ParseUint(parts[1], 10, 32)
+		if err1 == nil && err2 == nil {
+			return types.BuildTS(physical, uint32(logical)), nil
+		}
+	}
+	return types.TS{}, moerr.NewInvalidInputNoCtx("bad restore ts %q", s)
+}
+
+func runRestore(arg *restoreArg, respWriter io.Writer) {
+	err := arg.ctx.db.RestoreDatabase(context.Background(), arg.srcName, arg.dstName, arg.ts)
+	if err != nil {
+		respWriter.Write([]byte(fmt.Sprintf("restore %s failed: %v", arg.srcName, err)))
+		return
+	}
+	respWriter.Write([]byte(fmt.Sprintf("restore %s to %s at %s done", arg.srcName, arg.dstName, arg.ts.ToString())))
+}
+
+type restoreClusterArg struct {
+	ctx *inspectContext
+	dir string
+	ts  types.TS
+}
+
+func (c *restoreClusterArg) fromCommand(cmd *cobra.Command) (err error) {
+	c.ctx = cmd.Flag("ictx").Value.(*inspectContext)
+	c.dir, _ = cmd.Flags().GetString("path")
+	if c.dir == "" {
+		return moerr.NewInvalidInputNoCtx("source path is required")
+	}
+	ts, _ := cmd.Flags().GetString("ts")
+	c.ts, err = parseRestoreTS(ts)
+	return
+}
+
+// runRestoreCluster copies the user data of the storage in arg.dir, like a
+// restored backup, as of arg.ts into the running instance. The source shares
+// the WAL of the running instance, so with the logservice WAL the entries
+// after the checkpoints in arg.dir are replayed from the log service shard.
+func runRestoreCluster(arg *restoreClusterArg, respWriter io.Writer) {
+	opts := arg.ctx.db.Opts
+	srcOpts := &options.Options{
+		Fs:        objectio.TmpNewFileservice(path.Join(arg.dir, "data")),
+		Lc:        opts.Lc,
+		Shard:     opts.Shard,
+		LogStoreT: opts.LogStoreT,
+	}
+	err := db.RestoreCluster(context.Background(), arg.dir, srcOpts, arg.ctx.db, arg.ts)
+	if err != nil {
+		respWriter.Write([]byte(fmt.Sprintf("restore cluster from %s failed: %v", arg.dir, err)))
+		return
+	}
+	respWriter.Write([]byte(fmt.Sprintf("restore cluster from %s at %s done", arg.dir, arg.ts.ToString())))
+}
+
+type backupArg struct {
+	ctx         *inspectContext
+	dir         string
+	incremental bool
+	timeout     time.Duration
+}
+
+func (c *backupArg) fromCommand(cmd *cobra.Command) (err error) {
+	c.ctx = cmd.Flag("ictx").Value.(*inspectContext)
+	c.dir, _ = cmd.Flags().GetString("path")
+	if c.dir == "" {
+		return moerr.NewInvalidInputNoCtx("backup path is required")
+	}
+	c.incremental, _ = cmd.Flags().GetBool("incremental")
+	c.timeout, _ = cmd.Flags().GetDuration("timeout")
+	return
+}
+
+func runBackup(arg *backupArg, respWriter io.Writer) {
+	dst := objectio.TmpNewFileservice(arg.dir)
+	manifest, err := arg.ctx.db.Backup(context.Background(), dst, arg.incremental, arg.timeout)
+	if err != nil {
+		respWriter.Write([]byte(fmt.Sprintf("backup failed: %v", err)))
+		return
+	}
+	respWriter.Write([]byte(fmt.Sprintf("backup %s at %s done, %d objects, %d copied",
+		manifest.Name, manifest.TS.ToString(), len(manifest.Objects), manifest.Copied)))
+}
+
+type rotateKeysArg struct {
+	ctx    *inspectContext
+	dir    string
+	reload bool
+}
+
+func (c *rotateKeysArg) fromCommand(cmd *cobra.Command) (err error) {
+	c.ctx = cmd.Flag("ictx").Value.(*inspectContext)
+	c.dir, _ = cmd.Flags().GetString("path")
+	c.reload, _ = cmd.Flags().GetBool("reload")
+	return
+}
+
+func runRotateKeys(arg *rotateKeysArg, respWriter io.Writer) {
+	fs, ok := arg.ctx.db.Fs.Service.(fileservice.KeyRotatingFileService)
+	if !ok {
+		respWriter.Write([]byte("rotate keys failed: file service is not encrypted"))
+		return
+	}
+	if arg.reload {
+		if err := fs.ReloadKeys(); err != nil {
+			respWriter.Write([]byte(fmt.Sprintf("rotate keys failed: %v", err)))
+			return
+		}
+	}
+	n, err := fileservice.RotateKeys(context.Background(), fs, arg.dir)
+	if err != nil {
+		respWriter.Write([]byte(fmt.Sprintf("rotate keys failed after %d files: %v", n, err)))
+		return
+	}
+	respWriter.Write([]byte(fmt.Sprintf("rotate keys done, %d files re-encrypted", n)))
+}
+
 func initCommand(ctx *inspectContext) *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use: "inspect",
@@ -107,6 +258,58 @@ func initCommand(ctx *inspectContext) *cobra.Command {
 		},
 	}
 
+	restoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restore a database to a point in time as a new database",
+		Run: func(cmd *cobra.Command, args []string) {
+			arg := &restoreArg{}
+			if err := arg.fromCommand(cmd); err != nil {
+				cmd.OutOrStdout().Write([]byte(err.Error()))
+				return
+			}
+			runRestore(arg, cmd.OutOrStdout())
+		},
+	}
+
+	restoreClusterCmd := &cobra.Command{
+		Use:   "restore-cluster",
+		Short: "copy the user data of another storage at a point in time into this one",
+		Run: func(cmd *cobra.Command, args []string) {
+			arg := &restoreClusterArg{}
+			if err := arg.fromCommand(cmd); err != nil {
+				cmd.OutOrStdout().Write([]byte(err.Error()))
+				return
+			}
+			runRestoreCluster(arg, cmd.OutOrStdout())
+		},
+	}
+
+	backupCmd := &cobra.Command{
+		Use:   "backup",
+		Short: "take a physical backup of the checkpointed data",
+		Run: func(cmd *cobra.Command, args []string) {
+			arg := &backupArg{}
+			if err := arg.fromCommand(cmd); err != nil {
+				cmd.OutOrStdout().Write([]byte(err.Error()))
+				return
+			}
+			runBackup(arg, cmd.OutOrStdout())
+		},
+	}
+
+	rotateKeysCmd := &cobra.Command{
+		Use:   "rotate-keys",
+		Short: "re-encrypt the files not under the current keys",
+		Run: func(cmd *cobra.Command, args []string) {
+			arg := &rotateKeysArg{}
+			if err := arg.fromCommand(cmd); err != nil {
+				cmd.OutOrStdout().Write([]byte(err.Error()))
+				return
+			}
+			runRotateKeys(arg, cmd.OutOrStdout())
+		},
+	}
+
 	rootCmd.PersistentFlags().VarPF(ctx, "ictx", "", "").Hidden = true
 
 	rootCmd.SetArgs(ctx.args)
@@ -116,6 +319,24 @@ func initCommand(ctx *inspectContext) *cobra.Command {
 	catalogCmd.Flags().CountP("verbose", "v", "verbose level")
 	catalogCmd.Flags().StringP("outfile", "o", "", "write output to a file")
 	rootCmd.AddCommand(catalogCmd)
+
+	restoreCmd.Flags().StringP("db", "d", "", "database to restore")
+	restoreCmd.Flags().StringP("name", "n", "", "name of the restored database")
+	restoreCmd.Flags().StringP("ts", "t", "", "target timestamp, an UTC datetime or physical-logical")
+	rootCmd.AddCommand(restoreCmd)
+
+	restoreClusterCmd.Flags().StringP("path", "p", "", "storage directory on the dn host, like a restored backup")
+	restoreClusterCmd.Flags().StringP("ts", "t", "", "target timestamp, an UTC datetime or physical-logical")
+	rootCmd.AddCommand(restoreClusterCmd)
+
+	backupCmd.Flags().StringP("path", "p", "", "backup directory on the dn host")
+	backupCmd.Flags().BoolP("incremental", "i", false, "copy only the files not in the previous backups")
+	backupCmd.Flags().Duration("timeout", time.Minute, "timeout to flush the data before the checkpoint")
+	rootCmd.AddCommand(backupCmd)
+
+	rotateKeysCmd.Flags().StringP("path", "p", "", "directory to re-encrypt, default all files")
+	rotateKeysCmd.Flags().BoolP("reload", "r", true, "reload the key file before re-encrypting")
+	rootCmd.AddCommand(rotateKeysCmd)
 	return rootCmd
 }
 
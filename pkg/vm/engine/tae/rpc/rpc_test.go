@@ -37,6 +37,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -1719,3 +1720,48 @@ func TestHandle_MVCCVisibility(t *testing.T) {
 	assert.Nil(t, err)
 	wg.Wait()
 }
+
+func TestHandle_InspectRestoreCluster(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.Name = "tbtest"
+	bat := catalog.MockBatch(schema, 10)
+	defer bat.Close()
+
+	srcDir := testutils.InitTestEnv(ModuleName+"_src", t)
+	src, err := db.Open(srcDir, nil)
+	assert.NoError(t, err)
+	txn, err := src.StartTxn(nil)
+	assert.NoError(t, err)
+	dbH, err := txn.CreateDatabase("dbtest", "", "")
+	assert.NoError(t, err)
+	rel, err := dbH.CreateRelation(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, rel.Append(bat))
+	assert.NoError(t, txn.Commit())
+	restoreTS := txn.GetCommitTS()
+	assert.NoError(t, src.ForceCheckpoint(ctx, restoreTS.Next(), time.Minute))
+	assert.NoError(t, src.Close())
+
+	handle := mockTAEHandle(t, nil)
+	defer handle.HandleClose(ctx)
+	resp := &db.InspectResp{}
+	req := &db.InspectDN{
+		Operation: "restore-cluster -p " + srcDir + " -t " + restoreTS.ToString(),
+	}
+	assert.NoError(t, handle.HandleInspectDN(ctx, *mock1PCTxn(handle.db), req, resp))
+	assert.Contains(t, resp.Message, "done")
+
+	txn, err = handle.db.StartTxn(nil)
+	assert.NoError(t, err)
+	dbH, err = txn.GetDatabase("dbtest")
+	assert.NoError(t, err)
+	_, err = dbH.GetRelationByName(schema.Name)
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Commit())
+
+	req.Operation = "restore-cluster -t " + restoreTS.ToString()
+	assert.NoError(t, handle.HandleInspectDN(ctx, *mock1PCTxn(handle.db), req, resp))
+	assert.Contains(t, resp.Message, "source path is required")
+}
@@ -17,6 +17,7 @@ package jobs
 import (
 	"context"
 
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -82,6 +83,8 @@ func (task *flushBlkTask) Execute() error {
 			return err
 		}
 	}
-	task.blocks, _, err = writer.Sync(context.Background())
+	table := task.meta.GetSegment().GetTable()
+	ctx := fileservice.WithTieringTag(context.Background(), table.GetDB().GetTenantID(), table.ID)
+	task.blocks, _, err = writer.Sync(ctx)
 	return err
 }
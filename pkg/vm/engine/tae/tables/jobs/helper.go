@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 	"go.uber.org/zap"
@@ -68,3 +69,54 @@ func (t *delSegTask) Execute() (err error) {
 	logutil.Info("Mergeblocks delete merged segments [Done]", zap.String("task", tdesc))
 	return
 }
+
+type delBlkTask struct {
+	*tasks.BaseTask
+	delBlks []*catalog.BlockEntry
+	txn     txnif.AsyncTxn
+}
+
+// NewDelBlkTask soft deletes whole blocks without merging, e.g. the blocks
+// whose rows are all expired by the table level ttl.
+func NewDelBlkTask(ctx *tasks.Context, txn txnif.AsyncTxn, delBlks []*catalog.BlockEntry) *delBlkTask {
+	task := &delBlkTask{
+		delBlks: delBlks,
+		txn:     txn,
+	}
+	task.BaseTask = tasks.NewBaseTask(task, tasks.DataCompactionTask, ctx)
+	return task
+}
+
+func (t *delBlkTask) String() string {
+	blks := "DelBlk:"
+	for _, blk := range t.delBlks {
+		blks = fmt.Sprintf("%s%s,", blks, blk.ID.String())
+	}
+	return blks
+}
+
+func (t *delBlkTask) Execute() (err error) {
+	tdesc := t.String()
+	logutil.Info("Mergeblocks delete expired blocks [Start]", zap.String("task", tdesc))
+	dbId := t.delBlks[0].GetSegment().GetTable().GetDB().ID
+	database, err := t.txn.GetDatabaseByID(dbId)
+	if err != nil {
+		return
+	}
+	relId := t.delBlks[0].GetSegment().GetTable().ID
+	rel, err := database.GetRelationByID(relId)
+	if err != nil {
+		return
+	}
+	for _, entry := range t.delBlks {
+		var seg handle.Segment
+		if seg, err = rel.GetSegment(&entry.GetSegment().ID); err != nil {
+			return
+		}
+		if err = seg.SoftDeleteBlock(entry.ID); err != nil {
+			return
+		}
+	}
+	logutil.Info("Mergeblocks delete expired blocks [Done]", zap.String("task", tdesc))
+	return
+}
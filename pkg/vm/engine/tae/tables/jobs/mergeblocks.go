@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -346,7 +347,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			return err
 		}
 	}
-	blocks, _, err := writer.Sync(context.Background())
+	tableEntry := task.toSegEntry.GetTable()
+	ctx := fileservice.WithTieringTag(context.Background(), tableEntry.GetDB().GetTenantID(), tableEntry.ID)
+	blocks, _, err := writer.Sync(ctx)
 	if err != nil {
 		return err
 	}
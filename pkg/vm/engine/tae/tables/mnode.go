@@ -131,7 +131,8 @@ func (node *memoryNode) GetColumnDataWindow(
 ) (vec containers.Vector, err error) {
 	idx, ok := node.writeSchema.SeqnumMap[readSchema.ColDefs[col].SeqNum]
 	if !ok {
-		return containers.FillConstVector(int(to-from), readSchema.ColDefs[col].Type, nil), nil
+		def := readSchema.ColDefs[col]
+		return containers.FillConstVector(int(to-from), def.Type, def.FillValue())
 	}
 	data := node.data.Vecs[idx]
 	vec = data.CloneWindow(int(from), int(to-from), common.DefaultAllocator)
@@ -162,7 +163,10 @@ func (node *memoryNode) GetDataWindow(
 		idx, ok := node.writeSchema.SeqnumMap[col.SeqNum]
 		var vec containers.Vector
 		if !ok {
-			vec = containers.FillConstVector(int(from-to), col.Type, nil)
+			if vec, err = containers.FillConstVector(int(to-from), col.Type, col.FillValue()); err != nil {
+				bat.Close()
+				return nil, err
+			}
 		} else {
 			vec = node.data.Vecs[idx].CloneWindow(int(from), int(to-from), common.DefaultAllocator)
 		}
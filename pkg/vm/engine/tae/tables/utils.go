@@ -50,7 +50,14 @@ func LoadPersistedColumnData(
 	if err != nil {
 		return
 	}
-	bat, err := reader.LoadColumns(context.Background(), []uint16{uint16(def.SeqNum)}, []types.Type{def.Type}, location.ID(), nil)
+	bat, err := reader.LoadColumnsWithFills(
+		context.Background(),
+		[]uint16{uint16(def.SeqNum)},
+		[]types.Type{def.Type},
+		[][]byte{def.FillValue()},
+		location.ID(),
+		nil,
+	)
 	if err != nil {
 		return
 	}
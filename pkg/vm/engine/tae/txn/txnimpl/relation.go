@@ -177,6 +177,10 @@ func (h *txnRelation) Append(data *containers.Batch) error {
 func (h *txnRelation) AddBlksWithMetaLoc(
 	zm []objectio.ZoneMap,
 	metaLocs []objectio.Location) error {
+	// the blocks are written by CN with the columns of the local schema
+	if !h.table.GetLocalSchema().IsSameColumns(h.table.GetMeta().GetLastestSchema()) {
+		return moerr.NewInternalErrorNoCtx("schema changed, please rollback and retry")
+	}
 	return h.Txn.GetStore().AddBlksWithMetaLoc(
 		h.table.entry.GetDB().ID,
 		h.table.entry.GetID(),
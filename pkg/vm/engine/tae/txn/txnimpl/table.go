@@ -814,7 +814,8 @@ func (tbl *txnTable) UpdateDeltaLoc(id *common.ID, deltaloc objectio.Location) (
 
 func (tbl *txnTable) AlterTable(ctx context.Context, req *apipb.AlterTableReq) error {
 	switch req.Kind {
-	case apipb.AlterKind_UpdateConstraint, apipb.AlterKind_UpdateComment, apipb.AlterKind_AddColumn, apipb.AlterKind_DropColumn:
+	case apipb.AlterKind_UpdateConstraint, apipb.AlterKind_UpdateComment, apipb.AlterKind_AddColumn, apipb.AlterKind_DropColumn,
+		apipb.AlterKind_RenameColumn, apipb.AlterKind_ModifyColumn, apipb.AlterKind_UpdatePartition:
 	default:
 		return moerr.NewNYI(ctx, "alter table %s", req.Kind.String())
 	}
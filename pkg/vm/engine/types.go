@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
@@ -151,6 +152,10 @@ type RefChildTableDef struct {
 	Tables []uint64
 }
 
+type TTLDef struct {
+	Ttl *plan.TTLDef
+}
+
 type TableDef interface {
 	tableDef()
 
@@ -286,6 +291,7 @@ const (
 	RefChildTable
 	ForeignKey
 	PrimaryKey
+	TTL
 )
 
 type EngineType int8
@@ -361,6 +367,18 @@ func (def *ConstraintDef) MarshalBinary() (data []byte, err error) {
 				return nil, err
 			}
 			buf.Write(bytes)
+		case *TTLDef:
+			if err := binary.Write(buf, binary.BigEndian, TTL); err != nil {
+				return nil, err
+			}
+			bytes, err := def.Ttl.Marshal()
+			if err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+				return nil, err
+			}
+			buf.Write(bytes)
 		}
 	}
 	return buf.Bytes(), nil
@@ -429,6 +447,17 @@ func (def *ConstraintDef) UnmarshalBinary(data []byte) error {
 			}
 			l += int(length)
 			def.Cts = append(def.Cts, &PrimaryKeyDef{pkey})
+
+		case TTL:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			ttl := &plan.TTLDef{}
+			err := ttl.Unmarshal(data[l : l+int(length)])
+			if err != nil {
+				return err
+			}
+			l += int(length)
+			def.Cts = append(def.Cts, &TTLDef{ttl})
 		}
 	}
 	return nil
@@ -457,6 +486,9 @@ func (def *ConstraintPB) FromPBVersion() Constraint {
 	if r := def.GetIndexDef(); r != nil {
 		return r
 	}
+	if r := def.GetTTLDef(); r != nil {
+		return r
+	}
 	panic("no corresponding type")
 }
 
@@ -470,6 +502,16 @@ func (def *ConstraintDef) GetPrimaryKeyDef() *PrimaryKeyDef {
 	return nil
 }
 
+// get the ttl definition in the constraint, and return null if the table has no ttl
+func (def *ConstraintDef) GetTTLDef() *TTLDef {
+	for _, ct := range def.Cts {
+		if ctVal, ok := ct.(*TTLDef); ok {
+			return ctVal
+		}
+	}
+	return nil
+}
+
 type Constraint interface {
 	constraint()
 
@@ -482,6 +524,7 @@ func (*ForeignKeyDef) constraint()    {}
 func (*PrimaryKeyDef) constraint()    {}
 func (*RefChildTableDef) constraint() {}
 func (*IndexDef) constraint()         {}
+func (*TTLDef) constraint()           {}
 
 func (def *ForeignKeyDef) ToPBVersion() ConstraintPB {
 	return ConstraintPB{
@@ -511,6 +554,13 @@ func (def *IndexDef) ToPBVersion() ConstraintPB {
 		},
 	}
 }
+func (def *TTLDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_TTLDef{
+			TTLDef: def,
+		},
+	}
+}
 
 type Relation interface {
 	Statistics
@@ -536,6 +586,10 @@ type Relation interface {
 	// only ConstraintDef can be modified
 	UpdateConstraint(context.Context, *ConstraintDef) error
 
+	// AlterTable changes the columns in place, the schema is versioned and
+	// the existing blocks are not rewritten
+	AlterTable(context.Context, []*api.AlterTableReq) error
+
 	GetTableID(context.Context) uint64
 
 	// second argument is the number of reader, third argument is the filter extend, foruth parameter is the payload required by the engine
@@ -610,6 +664,14 @@ type VectorPool interface {
 	GetVector(typ types.Type) *vector.Vector
 }
 
+// CatalogVersioner is implemented by the engines which track the changes of
+// the catalog. The version is increased after the changes of databases,
+// tables or columns are applied, and ts is the max commit timestamp of the
+// applied changes.
+type CatalogVersioner interface {
+	CatalogVersion() (version uint64, ts timestamp.Timestamp)
+}
+
 type Hints struct {
 	CommitOrRollbackTimeout time.Duration
 }
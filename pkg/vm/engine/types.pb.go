@@ -520,6 +520,46 @@ func (m *IndexDef) GetIndexes() []*plan.IndexDef {
 	return nil
 }
 
+func (m *TTLDef) Reset()         { *m = TTLDef{} }
+func (m *TTLDef) String() string { return proto.CompactTextString(m) }
+func (*TTLDef) ProtoMessage()    {}
+func (*TTLDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{12}
+}
+func (m *TTLDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TTLDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TTLDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TTLDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TTLDef.Merge(m, src)
+}
+func (m *TTLDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TTLDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TTLDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TTLDef proto.InternalMessageInfo
+
+func (m *TTLDef) GetTtl() *plan.TTLDef {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
 // PB version of ConstraintDef
 type ConstraintDefPB struct {
 	Cts []ConstraintPB `protobuf:"bytes,1,rep,name=Cts,proto3" json:"Cts"`
@@ -529,7 +569,7 @@ func (m *ConstraintDefPB) Reset()         { *m = ConstraintDefPB{} }
 func (m *ConstraintDefPB) String() string { return proto.CompactTextString(m) }
 func (*ConstraintDefPB) ProtoMessage()    {}
 func (*ConstraintDefPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{12}
+	return fileDescriptor_d938547f84707355, []int{13}
 }
 func (m *ConstraintDefPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -572,6 +612,7 @@ type ConstraintPB struct {
 	//	*ConstraintPB_PrimaryKeyDef
 	//	*ConstraintPB_RefChildTableDef
 	//	*ConstraintPB_IndexDef
+	//	*ConstraintPB_TTLDef
 	Ct isConstraintPB_Ct `protobuf_oneof:"ct"`
 }
 
@@ -579,7 +620,7 @@ func (m *ConstraintPB) Reset()         { *m = ConstraintPB{} }
 func (m *ConstraintPB) String() string { return proto.CompactTextString(m) }
 func (*ConstraintPB) ProtoMessage()    {}
 func (*ConstraintPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{13}
+	return fileDescriptor_d938547f84707355, []int{14}
 }
 func (m *ConstraintPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -626,11 +667,15 @@ type ConstraintPB_RefChildTableDef struct {
 type ConstraintPB_IndexDef struct {
 	IndexDef *IndexDef `protobuf:"bytes,4,opt,name=IndexDef,proto3,oneof" json:"IndexDef,omitempty"`
 }
+type ConstraintPB_TTLDef struct {
+	TTLDef *TTLDef `protobuf:"bytes,5,opt,name=TTLDef,proto3,oneof" json:"TTLDef,omitempty"`
+}
 
 func (*ConstraintPB_ForeignKeyDef) isConstraintPB_Ct()    {}
 func (*ConstraintPB_PrimaryKeyDef) isConstraintPB_Ct()    {}
 func (*ConstraintPB_RefChildTableDef) isConstraintPB_Ct() {}
 func (*ConstraintPB_IndexDef) isConstraintPB_Ct()         {}
+func (*ConstraintPB_TTLDef) isConstraintPB_Ct()           {}
 
 func (m *ConstraintPB) GetCt() isConstraintPB_Ct {
 	if m != nil {
@@ -667,6 +712,13 @@ func (m *ConstraintPB) GetIndexDef() *IndexDef {
 	return nil
 }
 
+func (m *ConstraintPB) GetTTLDef() *TTLDef {
+	if x, ok := m.GetCt().(*ConstraintPB_TTLDef); ok {
+		return x.TTLDef
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -674,6 +726,7 @@ func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 		(*ConstraintPB_PrimaryKeyDef)(nil),
 		(*ConstraintPB_RefChildTableDef)(nil),
 		(*ConstraintPB_IndexDef)(nil),
+		(*ConstraintPB_TTLDef)(nil),
 	}
 }
 
@@ -696,7 +749,7 @@ func (m *TableDefPB) Reset()         { *m = TableDefPB{} }
 func (m *TableDefPB) String() string { return proto.CompactTextString(m) }
 func (*TableDefPB) ProtoMessage()    {}
 func (*TableDefPB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{14}
+	return fileDescriptor_d938547f84707355, []int{15}
 }
 func (m *TableDefPB) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +911,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{15}
+	return fileDescriptor_d938547f84707355, []int{16}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,7 +958,7 @@ func (m *Attribute) Reset()         { *m = Attribute{} }
 func (m *Attribute) String() string { return proto.CompactTextString(m) }
 func (*Attribute) ProtoMessage()    {}
 func (*Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d938547f84707355, []int{16}
+	return fileDescriptor_d938547f84707355, []int{17}
 }
 func (m *Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,6 +1077,7 @@ func init() {
 	proto.RegisterType((*PrimaryKeyDef)(nil), "engine.PrimaryKeyDef")
 	proto.RegisterType((*RefChildTableDef)(nil), "engine.RefChildTableDef")
 	proto.RegisterType((*IndexDef)(nil), "engine.IndexDef")
+	proto.RegisterType((*TTLDef)(nil), "engine.TTLDef")
 	proto.RegisterType((*ConstraintDefPB)(nil), "engine.ConstraintDefPB")
 	proto.RegisterType((*ConstraintPB)(nil), "engine.ConstraintPB")
 	proto.RegisterType((*TableDefPB)(nil), "engine.TableDefPB")
@@ -1034,68 +1088,70 @@ func init() {
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 966 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x77, 0x62, 0xe7, 0xdf, 0x4b, 0xb2, 0x5b, 0x4c, 0x01, 0xab, 0x42, 0x49, 0x55, 0x90, 0x36,
-	0xb0, 0x4b, 0xb2, 0x2a, 0x15, 0x42, 0x91, 0x8a, 0xa8, 0x53, 0xaa, 0x44, 0x2b, 0x20, 0x1a, 0x95,
-	0xde, 0x9d, 0x64, 0x92, 0xb5, 0x9a, 0xd8, 0x96, 0x3d, 0xd1, 0x6e, 0xbe, 0xc5, 0x7e, 0x04, 0x38,
-	0xf2, 0x4d, 0xf6, 0xd8, 0xe3, 0x8a, 0x43, 0x05, 0xcd, 0x17, 0xe0, 0xdc, 0x13, 0x9a, 0x99, 0x37,
-	0xb6, 0x27, 0xb9, 0x70, 0x9b, 0x37, 0xbf, 0xdf, 0x7b, 0x33, 0xfe, 0xbd, 0xe7, 0x9f, 0x0d, 0x75,
-	0xb6, 0x89, 0x68, 0xd2, 0x8d, 0xe2, 0x90, 0x85, 0x76, 0x99, 0x06, 0x0b, 0x3f, 0xa0, 0x47, 0xdf,
-	0x2c, 0x7c, 0xf6, 0x7a, 0x3d, 0xe9, 0x4e, 0xc3, 0x55, 0x6f, 0x11, 0x2e, 0xc2, 0x9e, 0x80, 0x27,
-	0xeb, 0xb9, 0x88, 0x44, 0x20, 0x56, 0x32, 0xed, 0x08, 0xa2, 0xa5, 0x17, 0xc8, 0xf5, 0xc9, 0x0b,
-	0x80, 0x41, 0xb8, 0x5a, 0xd1, 0x80, 0x5d, 0xd2, 0xb9, 0xed, 0x40, 0x05, 0x23, 0xa7, 0x70, 0x5c,
-	0xe8, 0xd4, 0x88, 0x0a, 0xfb, 0xd6, 0xbf, 0x7f, 0xb4, 0x0d, 0xce, 0xbe, 0xa1, 0x71, 0xe2, 0x87,
-	0x01, 0xb2, 0x31, 0x12, 0xec, 0x26, 0x51, 0x21, 0xb2, 0x4f, 0xa1, 0x31, 0xf6, 0x62, 0xe6, 0x33,
-	0xe4, 0x7f, 0x0e, 0xb5, 0x34, 0xc6, 0xfa, 0xd9, 0x06, 0xe6, 0x7c, 0x01, 0x95, 0x1b, 0x9f, 0xbe,
-	0xe1, 0x74, 0x1b, 0x2c, 0xbe, 0x44, 0xa6, 0x58, 0x23, 0xe9, 0x02, 0x1a, 0x17, 0x8c, 0xc5, 0xfe,
-	0x64, 0xcd, 0x28, 0x67, 0x3e, 0x07, 0x8b, 0xc7, 0x82, 0x59, 0x3f, 0xfd, 0xa8, 0x2b, 0x65, 0xe9,
-	0xa6, 0x1c, 0xd7, 0x7a, 0x7f, 0xdf, 0x36, 0x88, 0x20, 0x61, 0x89, 0x29, 0x34, 0x47, 0xc1, 0x8c,
-	0xbe, 0xbd, 0xf6, 0x26, 0x4b, 0x2a, 0x2f, 0x67, 0x5e, 0x6f, 0x22, 0x51, 0xa2, 0xe4, 0xc2, 0xe3,
-	0x7d, 0xbb, 0x2c, 0x71, 0xc2, 0xb7, 0xed, 0x23, 0xa8, 0x0e, 0xc2, 0xe5, 0x2f, 0xde, 0x8a, 0x26,
-	0x4e, 0xf1, 0xd8, 0xec, 0xd4, 0x48, 0x1a, 0xf3, 0x7b, 0xf2, 0x85, 0x63, 0xca, 0x7b, 0xf2, 0x35,
-	0x1e, 0xf2, 0x33, 0x34, 0xc7, 0x71, 0x18, 0xd1, 0x98, 0xf9, 0x34, 0xe1, 0x87, 0x7c, 0x07, 0x90,
-	0x6d, 0x38, 0x85, 0x63, 0xb3, 0x53, 0x3f, 0x3d, 0x50, 0xd7, 0x45, 0x64, 0x83, 0xb7, 0xcd, 0x31,
-	0xb1, 0x5c, 0x07, 0x1a, 0x83, 0xe5, 0x3a, 0x61, 0x34, 0x76, 0x37, 0x28, 0x90, 0x38, 0xb8, 0xb0,
-	0x77, 0xf0, 0x8f, 0xd0, 0xbc, 0x0a, 0x63, 0xea, 0x2f, 0x82, 0x57, 0x54, 0x50, 0xbf, 0x82, 0xd2,
-	0xd5, 0x2d, 0xdd, 0xa8, 0x33, 0x3f, 0xee, 0x8a, 0x11, 0xd0, 0x38, 0x44, 0x32, 0xb0, 0xc2, 0x0f,
-	0xfc, 0xea, 0xfe, 0xca, 0x8b, 0x37, 0x58, 0xe1, 0x19, 0x58, 0xe3, 0x5b, 0xba, 0x41, 0x8d, 0xb1,
-	0x80, 0x46, 0x21, 0x82, 0x80, 0xf9, 0x2f, 0xe1, 0x80, 0xd0, 0xf9, 0xe0, 0xb5, 0xbf, 0x9c, 0xa5,
-	0x12, 0x7f, 0x0a, 0x65, 0xb1, 0x96, 0xb7, 0xb0, 0x08, 0x46, 0x98, 0xd1, 0x87, 0xaa, 0x50, 0x9c,
-	0x33, 0x3b, 0x50, 0x11, 0xeb, 0x54, 0xa4, 0x27, 0xf2, 0x3c, 0x45, 0x20, 0x0a, 0xc6, 0xdc, 0x9f,
-	0xe0, 0xe9, 0x20, 0x0c, 0x12, 0x16, 0x7b, 0xbe, 0x18, 0xe4, 0xb1, 0x6b, 0xbf, 0x00, 0x73, 0xc0,
-	0x54, 0xfa, 0xa1, 0xd2, 0x38, 0x63, 0x8d, 0x5d, 0xd4, 0x99, 0xd3, 0x44, 0x99, 0xc2, 0xc9, 0xbb,
-	0x22, 0x34, 0xf2, 0x0c, 0xfb, 0x7c, 0x47, 0x47, 0x7c, 0xfa, 0x4f, 0x54, 0x39, 0x0d, 0x1c, 0x1a,
-	0x64, 0x47, 0xf5, 0xf3, 0x1d, 0x11, 0x9d, 0xa2, 0x9e, 0xae, 0x81, 0x3c, 0x5d, 0x97, 0xfc, 0x6a,
-	0x5f, 0x43, 0x31, 0x64, 0xf5, 0x53, 0x47, 0x55, 0xd8, 0xc5, 0x87, 0x06, 0xd9, 0xd7, 0xbd, 0x9b,
-	0x29, 0xeb, 0x58, 0x22, 0x3f, 0x9d, 0x39, 0xb5, 0x3f, 0x34, 0x48, 0xca, 0x91, 0x62, 0xb8, 0x16,
-	0x14, 0xa7, 0xec, 0xe4, 0x4f, 0x0b, 0x40, 0x15, 0x1a, 0xbb, 0xf6, 0x59, 0xde, 0x2e, 0x50, 0x0d,
-	0x3b, 0x13, 0x57, 0x21, 0x43, 0x83, 0xe4, 0x6d, 0xa5, 0xaf, 0x1b, 0x01, 0xca, 0x90, 0x36, 0x25,
-	0x8f, 0x0d, 0x0d, 0xa2, 0x9b, 0xc6, 0xf3, 0xd4, 0x10, 0xf0, 0xd9, 0x9f, 0xaa, 0x34, 0xdc, 0x1e,
-	0x1a, 0x24, 0xb5, 0x8c, 0xbe, 0x6e, 0x0c, 0xf8, 0xb4, 0x87, 0x7b, 0x86, 0x80, 0x07, 0x69, 0x26,
-	0x72, 0xbe, 0xe3, 0x08, 0x4e, 0x49, 0x6f, 0x96, 0x06, 0xf2, 0x66, 0xe9, 0xfe, 0x71, 0xbe, 0xf3,
-	0xae, 0x3b, 0xe5, 0xdd, 0x5e, 0xe7, 0x40, 0xd9, 0xeb, 0xbc, 0x33, 0xf4, 0xf5, 0x77, 0xdb, 0xa9,
-	0xe8, 0x37, 0xcf, 0x63, 0xfc, 0xe6, 0x9a, 0x0f, 0x0c, 0xf6, 0xa6, 0xdf, 0xa9, 0x8a, 0xf4, 0xcf,
-	0xf6, 0xc7, 0x5e, 0xc0, 0x43, 0x83, 0xec, 0xbd, 0x2f, 0x67, 0x79, 0x6b, 0x77, 0x6a, 0x7a, 0x67,
-	0x33, 0x84, 0x77, 0x36, 0x8b, 0x70, 0x54, 0x4a, 0x60, 0xce, 0xe8, 0xfc, 0xe4, 0x7b, 0xa8, 0x2a,
-	0x0f, 0xb3, 0x0f, 0xc0, 0x7c, 0x85, 0x6e, 0x51, 0x23, 0x7c, 0x69, 0x1f, 0x42, 0xe9, 0xc6, 0x5b,
-	0xae, 0xa9, 0xe8, 0x7e, 0x8d, 0xc8, 0x00, 0xdf, 0xdf, 0x0f, 0x26, 0xd4, 0xd2, 0x66, 0x70, 0xb3,
-	0x1d, 0x25, 0x43, 0x7f, 0x36, 0xa3, 0xf2, 0x33, 0x51, 0x25, 0x69, 0xcc, 0xbf, 0x39, 0xa3, 0x84,
-	0x84, 0x6f, 0x46, 0x33, 0x51, 0xa7, 0x4a, 0x54, 0x68, 0x3f, 0x81, 0xe2, 0xe8, 0x52, 0xcc, 0x88,
-	0x45, 0x8a, 0xa3, 0xcb, 0xd4, 0x1d, 0xad, 0xcc, 0x1d, 0xed, 0x2b, 0x30, 0x2f, 0x96, 0x0b, 0xd1,
-	0xd9, 0xa6, 0x7b, 0xf6, 0x78, 0xdf, 0x7e, 0x99, 0xfb, 0x72, 0xae, 0x3c, 0x16, 0xfb, 0x6f, 0xc3,
-	0xd8, 0x5f, 0xf8, 0x81, 0x0a, 0x02, 0xda, 0x8b, 0x6e, 0x17, 0xbd, 0x69, 0xb8, 0x8a, 0x62, 0x9a,
-	0x24, 0xdd, 0x6b, 0xc2, 0x0b, 0xd8, 0x37, 0x60, 0x5d, 0x6f, 0x22, 0x2a, 0x7a, 0xdc, 0x70, 0x5d,
-	0xee, 0x23, 0x7f, 0xdd, 0xb7, 0xfb, 0xff, 0xb7, 0x58, 0xc0, 0x3c, 0x3f, 0xa0, 0x71, 0x4f, 0x7e,
-	0xcb, 0x79, 0x25, 0x22, 0xea, 0xd9, 0xcf, 0xa0, 0x72, 0x49, 0xe7, 0xde, 0x7a, 0xc9, 0x70, 0x00,
-	0x9a, 0xd2, 0xf7, 0x70, 0x93, 0x28, 0xd4, 0xfe, 0x1a, 0xaa, 0xbf, 0x06, 0xbf, 0x45, 0x33, 0x8f,
-	0x51, 0xec, 0x35, 0x3a, 0xa4, 0xda, 0x25, 0x29, 0xce, 0x25, 0x43, 0x5f, 0x11, 0x6d, 0xad, 0x12,
-	0x15, 0xf2, 0x0f, 0x72, 0x3a, 0x48, 0x0e, 0x08, 0x2c, 0xdb, 0xc8, 0xff, 0x0c, 0xd4, 0xb5, 0x9f,
-	0x01, 0xfb, 0x4b, 0x68, 0x5e, 0xac, 0x59, 0x38, 0x0a, 0xa6, 0x31, 0x15, 0x78, 0x43, 0xe4, 0xea,
-	0x9b, 0xb2, 0xb5, 0xee, 0xf1, 0xdd, 0x3f, 0x2d, 0xe3, 0xfd, 0x43, 0xab, 0x70, 0xf7, 0xd0, 0x2a,
-	0xfc, 0xfd, 0xd0, 0x32, 0xde, 0x6d, 0x5b, 0xc6, 0xef, 0xdb, 0x56, 0xe1, 0x6e, 0xdb, 0x32, 0x3e,
-	0x6c, 0x5b, 0xc6, 0xa4, 0x2c, 0xfe, 0x44, 0xbe, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x29, 0x5a,
-	0x0b, 0x2f, 0xdb, 0x08, 0x00, 0x00,
+	// 1001 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6e, 0x22, 0xc7,
+	0x13, 0x1e, 0x98, 0xe1, 0x5f, 0x01, 0x5e, 0xff, 0xfa, 0xb7, 0x49, 0x46, 0x56, 0x04, 0xc8, 0x89,
+	0xb4, 0x24, 0xbb, 0x81, 0x95, 0x63, 0x45, 0x11, 0xd2, 0x46, 0xf1, 0xe0, 0x58, 0xa0, 0xcd, 0x1f,
+	0xd4, 0x22, 0xbe, 0x0f, 0xd0, 0xb0, 0x23, 0xc3, 0x0c, 0x9a, 0x69, 0xb4, 0xcb, 0x5b, 0xe4, 0x11,
+	0x92, 0x63, 0x5e, 0x21, 0x4f, 0xb0, 0x47, 0x1f, 0x57, 0x39, 0x58, 0x89, 0xfd, 0x02, 0x39, 0xef,
+	0x29, 0xea, 0xee, 0xea, 0x61, 0x1a, 0x2e, 0xb9, 0x75, 0xd5, 0xf7, 0x55, 0x75, 0xa9, 0xbe, 0x9a,
+	0xea, 0x81, 0x2a, 0xdf, 0xae, 0x59, 0xd2, 0x59, 0xc7, 0x11, 0x8f, 0x48, 0x91, 0x85, 0x8b, 0x20,
+	0x64, 0x27, 0x5f, 0x2c, 0x02, 0xfe, 0x6a, 0x33, 0xe9, 0x4c, 0xa3, 0x55, 0x77, 0x11, 0x2d, 0xa2,
+	0xae, 0x84, 0x27, 0x9b, 0xb9, 0xb4, 0xa4, 0x21, 0x4f, 0x2a, 0xec, 0x04, 0xd6, 0x4b, 0x3f, 0x54,
+	0xe7, 0xd3, 0x67, 0x00, 0xfd, 0x68, 0xb5, 0x62, 0x21, 0xbf, 0x64, 0x73, 0xe2, 0x42, 0x09, 0x2d,
+	0x37, 0xd7, 0xca, 0xb5, 0x2b, 0x54, 0x9b, 0x3d, 0xe7, 0x9f, 0xdf, 0x9a, 0x96, 0x60, 0x5f, 0xb3,
+	0x38, 0x09, 0xa2, 0x10, 0xd9, 0x68, 0x49, 0x76, 0x9d, 0x6a, 0x13, 0xd9, 0x67, 0x50, 0x1b, 0xf9,
+	0x31, 0x0f, 0x38, 0xf2, 0x3f, 0x86, 0x4a, 0x6a, 0x63, 0xfe, 0x9d, 0x03, 0x63, 0x3e, 0x81, 0xd2,
+	0x75, 0xc0, 0x5e, 0x0b, 0x3a, 0x01, 0x47, 0x1c, 0x91, 0x29, 0xcf, 0x48, 0xba, 0x80, 0xda, 0x05,
+	0xe7, 0x71, 0x30, 0xd9, 0x70, 0x26, 0x98, 0x4f, 0xc1, 0x11, 0xb6, 0x64, 0x56, 0xcf, 0xfe, 0xd7,
+	0x51, 0x6d, 0xe9, 0xa4, 0x1c, 0xcf, 0x79, 0x7b, 0xd7, 0xb4, 0xa8, 0x24, 0x61, 0x8a, 0x29, 0xd4,
+	0x87, 0xe1, 0x8c, 0xbd, 0x19, 0xfb, 0x93, 0x25, 0x53, 0xc5, 0xd9, 0xe3, 0xed, 0x5a, 0xa6, 0x28,
+	0x78, 0xf0, 0xfe, 0xae, 0x59, 0x54, 0x38, 0x15, 0x6e, 0x72, 0x02, 0xe5, 0x7e, 0xb4, 0xfc, 0xd1,
+	0x5f, 0xb1, 0xc4, 0xcd, 0xb7, 0xec, 0x76, 0x85, 0xa6, 0xb6, 0xa8, 0x53, 0x1c, 0x5c, 0x5b, 0xd5,
+	0x29, 0xce, 0x78, 0xc9, 0x0f, 0x50, 0x1f, 0xc5, 0xd1, 0x9a, 0xc5, 0x3c, 0x60, 0x89, 0xb8, 0xe4,
+	0x2b, 0x80, 0x9d, 0xc3, 0xcd, 0xb5, 0xec, 0x76, 0xf5, 0xec, 0x58, 0x97, 0x8b, 0xc8, 0x16, 0xab,
+	0xcd, 0x30, 0x31, 0x5d, 0x1b, 0x6a, 0xfd, 0xe5, 0x26, 0xe1, 0x2c, 0xf6, 0xb6, 0xd8, 0x20, 0x79,
+	0x71, 0xee, 0xe0, 0xe2, 0x6f, 0xa1, 0x7e, 0x15, 0xc5, 0x2c, 0x58, 0x84, 0x2f, 0x99, 0xa4, 0x7e,
+	0x06, 0x85, 0xab, 0x1b, 0xb6, 0xd5, 0x77, 0xfe, 0xbf, 0x23, 0x47, 0xc0, 0xe0, 0x50, 0xc5, 0xc0,
+	0x0c, 0xdf, 0x88, 0xd2, 0x83, 0x95, 0x1f, 0x6f, 0x31, 0xc3, 0x13, 0x70, 0x46, 0x37, 0x6c, 0x8b,
+	0x3d, 0xc6, 0x04, 0x06, 0x85, 0x4a, 0x02, 0xc6, 0x3f, 0x87, 0x63, 0xca, 0xe6, 0xfd, 0x57, 0xc1,
+	0x72, 0x96, 0xb6, 0xf8, 0x43, 0x28, 0xca, 0xb3, 0xaa, 0xc2, 0xa1, 0x68, 0x61, 0x44, 0x0f, 0xca,
+	0xb2, 0xe3, 0x82, 0xd9, 0x86, 0x92, 0x3c, 0xa7, 0x4d, 0x3a, 0x52, 0xf7, 0x69, 0x02, 0xd5, 0x30,
+	0xc6, 0x76, 0xa0, 0x38, 0x1e, 0x7f, 0x2f, 0x22, 0x1b, 0x60, 0x8f, 0xf9, 0x12, 0xab, 0xac, 0xa9,
+	0x28, 0x05, 0x51, 0x01, 0x20, 0xff, 0x3b, 0x78, 0xd4, 0x8f, 0xc2, 0x84, 0xc7, 0x7e, 0x20, 0x07,
+	0x7f, 0xe4, 0x91, 0x67, 0x60, 0xf7, 0xb9, 0xbe, 0xee, 0xb1, 0xd6, 0x64, 0xc7, 0x1a, 0x79, 0xa8,
+	0x8b, 0xa0, 0xc9, 0x34, 0xb9, 0xd3, 0x3f, 0xf2, 0x50, 0xcb, 0x32, 0xc8, 0x8b, 0xbd, 0xbe, 0x63,
+	0x1d, 0x1f, 0xe8, 0x74, 0x06, 0x38, 0xb0, 0xe8, 0x9e, 0x4a, 0x2f, 0xf6, 0x9a, 0xee, 0xe6, 0xcd,
+	0x70, 0x03, 0x14, 0xe1, 0xa6, 0x44, 0x57, 0x87, 0x3d, 0x97, 0x43, 0x59, 0x3d, 0x73, 0x75, 0x86,
+	0x7d, 0x7c, 0x60, 0xd1, 0x43, 0x9d, 0x3a, 0x3b, 0x25, 0x5c, 0xa7, 0x95, 0xcb, 0xce, 0xa8, 0xf6,
+	0x0f, 0x2c, 0x9a, 0x55, 0x0b, 0xbb, 0xef, 0x16, 0x24, 0xfb, 0x48, 0xb3, 0x95, 0x77, 0x60, 0x51,
+	0xc4, 0x55, 0xdb, 0x3c, 0x07, 0xf2, 0x53, 0x7e, 0xfa, 0xbb, 0x03, 0xa0, 0xaf, 0x1c, 0x79, 0xe4,
+	0x3c, 0xbb, 0x88, 0xb0, 0x6f, 0x64, 0x27, 0x83, 0x46, 0x06, 0x16, 0xcd, 0x2e, 0xac, 0x9e, 0xb9,
+	0x62, 0xb0, 0x61, 0xa9, 0x7c, 0x59, 0x6c, 0x60, 0x51, 0x73, 0x1d, 0x3d, 0x4d, 0x57, 0x0d, 0x76,
+	0xe9, 0x91, 0x0e, 0x43, 0xf7, 0xc0, 0xa2, 0xe9, 0x32, 0xea, 0x99, 0x2b, 0xc7, 0x75, 0xcc, 0x8b,
+	0xb2, 0x98, 0xb8, 0x28, 0x6b, 0x0b, 0x59, 0x8d, 0x5d, 0xe3, 0x16, 0x4c, 0x59, 0x0d, 0x50, 0xc8,
+	0x6a, 0x38, 0xd4, 0x54, 0x64, 0xb6, 0x88, 0x5b, 0xdc, 0x9f, 0x8a, 0x0c, 0xa8, 0xa6, 0x22, 0xe3,
+	0x20, 0x3d, 0x73, 0x6b, 0xb8, 0x25, 0xb3, 0xf2, 0x2c, 0x26, 0x2a, 0xcf, 0xda, 0xa4, 0x7f, 0xf0,
+	0x9d, 0xb8, 0x65, 0x19, 0xfe, 0xd1, 0xe1, 0x07, 0x22, 0xe1, 0x81, 0x45, 0x0f, 0xbe, 0xac, 0xf3,
+	0xec, 0xa3, 0xe1, 0x56, 0x4c, 0x65, 0x77, 0x88, 0x50, 0x76, 0x67, 0xe1, 0xa8, 0x14, 0xc0, 0x9e,
+	0xb1, 0xf9, 0xe9, 0xd7, 0x50, 0xd6, 0xdb, 0x91, 0x1c, 0x83, 0xfd, 0x12, 0xf7, 0x50, 0x85, 0x8a,
+	0x23, 0x79, 0x0c, 0x85, 0x6b, 0x7f, 0xb9, 0x61, 0x52, 0xfd, 0x0a, 0x55, 0x06, 0x7e, 0xe9, 0xef,
+	0x6c, 0xa8, 0xa4, 0x62, 0x88, 0x35, 0x3e, 0x4c, 0x06, 0xc1, 0x6c, 0xc6, 0xd4, 0x03, 0x54, 0xa6,
+	0xa9, 0x2d, 0x5e, 0xb3, 0x61, 0x42, 0xa3, 0xd7, 0xc3, 0x99, 0xcc, 0x53, 0xa6, 0xda, 0x24, 0x47,
+	0x90, 0x1f, 0x5e, 0xca, 0x19, 0x71, 0x68, 0x7e, 0x78, 0x99, 0xee, 0x5d, 0x67, 0xb7, 0x77, 0xc9,
+	0x15, 0xd8, 0x17, 0xcb, 0x85, 0x54, 0xb6, 0xee, 0x9d, 0xbf, 0xbf, 0x6b, 0x3e, 0xcf, 0xbc, 0xc9,
+	0x2b, 0x9f, 0xc7, 0xc1, 0x9b, 0x28, 0x0e, 0x16, 0x41, 0xa8, 0x8d, 0x90, 0x75, 0xd7, 0x37, 0x8b,
+	0xee, 0x34, 0x5a, 0xad, 0x63, 0x96, 0x24, 0x9d, 0x31, 0x15, 0x09, 0xc8, 0x35, 0x38, 0xe3, 0xed,
+	0x9a, 0x49, 0x8d, 0x6b, 0x9e, 0x27, 0x36, 0xce, 0x9f, 0x77, 0xcd, 0xde, 0x7f, 0x4d, 0x16, 0x72,
+	0x3f, 0x08, 0x59, 0xdc, 0x55, 0x7f, 0x09, 0x22, 0x13, 0x95, 0xf9, 0xc8, 0x13, 0x28, 0x5d, 0xb2,
+	0xb9, 0xbf, 0x59, 0x72, 0x1c, 0x80, 0xba, 0xda, 0x8d, 0xe8, 0xa4, 0x1a, 0x25, 0x9f, 0x43, 0xf9,
+	0xa7, 0xf0, 0xe7, 0xf5, 0xcc, 0xe7, 0x0c, 0xb5, 0xc6, 0xdd, 0xab, 0xbd, 0x34, 0xc5, 0x45, 0xcb,
+	0x70, 0x03, 0x49, 0x59, 0xcb, 0x54, 0x9b, 0xe2, 0xa9, 0x4f, 0x07, 0xc9, 0x05, 0x89, 0xed, 0x1c,
+	0xd9, 0xdf, 0x8c, 0xaa, 0xf1, 0x9b, 0x41, 0x3e, 0x85, 0xfa, 0xc5, 0x86, 0x47, 0xc3, 0x70, 0x1a,
+	0x33, 0x89, 0xd7, 0x64, 0xac, 0xe9, 0x54, 0xd2, 0x7a, 0xad, 0xdb, 0xbf, 0x1b, 0xd6, 0xdb, 0xfb,
+	0x46, 0xee, 0xf6, 0xbe, 0x91, 0xfb, 0xeb, 0xbe, 0x61, 0xfd, 0xf2, 0xd0, 0xb0, 0x7e, 0x7d, 0x68,
+	0xe4, 0x6e, 0x1f, 0x1a, 0xd6, 0xbb, 0x87, 0x86, 0x35, 0x29, 0xca, 0x7f, 0x9c, 0x2f, 0xff, 0x1d,
+	0x00, 0xee, 0xc3, 0x11, 0xbe, 0x35, 0x09, 0x00, 0x00,
 }
 
 func (m *CommentDef) Marshal() (dAtA []byte, err error) {
@@ -1510,6 +1566,41 @@ func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TTLDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TTLDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TTLDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ttl != nil {
+		{
+			size, err := m.Ttl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConstraintDefPB) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1663,6 +1754,27 @@ func (m *ConstraintPB_IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ConstraintPB_TTLDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_TTLDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TTLDef != nil {
+		{
+			size, err := m.TTLDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TableDefPB) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2234,6 +2346,19 @@ func (m *IndexDef) ProtoSize() (n int) {
 	return n
 }
 
+func (m *TTLDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ttl != nil {
+		l = m.Ttl.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *ConstraintDefPB) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2309,6 +2434,18 @@ func (m *ConstraintPB_IndexDef) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *ConstraintPB_TTLDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TTLDef != nil {
+		l = m.TTLDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *TableDefPB) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -3577,6 +3714,92 @@ func (m *IndexDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TTLDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TTLDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TTLDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ttl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ttl == nil {
+				m.Ttl = &plan.TTLDef{}
+			}
+			if err := m.Ttl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConstraintDefPB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3830,6 +4053,41 @@ func (m *ConstraintPB) Unmarshal(dAtA []byte) error {
 			}
 			m.Ct = &ConstraintPB_IndexDef{v}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TTLDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_TTLDef{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])